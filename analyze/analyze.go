@@ -0,0 +1,69 @@
+// Package analyze scans the library of a moggio server for EBU R128
+// loudness, which sets the songs' ReplayGain, and shows its progress.
+package analyze
+
+import (
+	"flag"
+	"fmt"
+	"os"
+	"os/signal"
+	"time"
+
+	"github.com/mjibson/moggio/client"
+)
+
+// pollInterval is how often the scan's progress is checked.
+const pollInterval = time.Second
+
+// Main scans with args, the command line after "analyze": flags, then the
+// IDs of songs whose albums are scanned, or none to scan the whole library.
+// An interrupt cancels the scan.
+func Main(args []string) error {
+	fs := flag.NewFlagSet("analyze", flag.ExitOnError)
+	newClient := client.Flags(fs)
+	tags := fs.Bool("tags", false, "write ReplayGain tags to the songs' files")
+	workers := fs.Int("workers", 0, "number of songs scanned at once; 0 is the server's number of CPUs")
+	fs.Usage = func() {
+		fmt.Fprintf(fs.Output(), "usage: moggio analyze [flags] [song ID...]\n")
+		fs.PrintDefaults()
+	}
+	fs.Parse(args)
+	c := newClient()
+	if err := c.Analyze(fs.Args(), *tags, *workers); err != nil {
+		return fmt.Errorf("analyze: %s: %v", c.URL, err)
+	}
+	interrupt := make(chan os.Signal, 1)
+	signal.Notify(interrupt, os.Interrupt)
+	tick := time.NewTicker(pollInterval)
+	defer tick.Stop()
+	for {
+		select {
+		case <-interrupt:
+			signal.Stop(interrupt)
+			if err := c.AnalyzeCancel(); err != nil {
+				return fmt.Errorf("analyze: %v", err)
+			}
+		case <-tick.C:
+		}
+		s, err := c.LoudnessScan()
+		if err != nil {
+			return fmt.Errorf("analyze: %v", err)
+		}
+		fmt.Printf("\rscanned %d of %d songs", s.Scanned, s.Total)
+		if s.Running {
+			continue
+		}
+		fmt.Println()
+		for _, e := range s.Errors {
+			name := e.ID.UID
+			if e.Info != nil && e.Info.Title != "" {
+				name = e.Info.Title
+			}
+			fmt.Printf("%s: %s\n", name, e.Error)
+		}
+		if len(s.Errors) > 0 {
+			return fmt.Errorf("analyze: %d errors", len(s.Errors))
+		}
+		return nil
+	}
+}
@@ -0,0 +1,271 @@
+// Package client calls the HTTP API of a moggio server, for the terminal,
+// notification, tray, and analyze modes.
+package client
+
+import (
+	"bytes"
+	"encoding/json"
+	"flag"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"log"
+	"net/http"
+	"net/url"
+	"strings"
+	"time"
+
+	"github.com/mjibson/moggio/codec"
+	"golang.org/x/net/websocket"
+)
+
+// Playback states, as the server numbers them.
+const (
+	StatePlay = iota
+	StateStop
+	StatePause
+)
+
+const (
+	// DefaultURL is the URL of a server on this machine.
+	DefaultURL = "http://localhost:6601"
+	// reconnectWait is the wait before reconnecting to the event stream.
+	reconnectWait = time.Second * 5
+)
+
+type SongID struct {
+	UID string
+}
+
+type Item struct {
+	ID   SongID
+	Info *codec.SongInfo
+}
+
+type Status struct {
+	State    int
+	Song     SongID
+	SongInfo codec.SongInfo
+	Elapsed  time.Duration
+	Time     time.Duration
+	Volume   float64
+	Random   bool
+	Repeat   bool
+}
+
+// LoudnessScan is the progress of a loudness scan of the library.
+type LoudnessScan struct {
+	Running bool
+	Scanned int
+	Total   int
+	Errors  []struct {
+		ID    SongID
+		Info  *codec.SongInfo
+		Error string
+	}
+}
+
+// Client calls the API of the server at URL.
+type Client struct {
+	URL   string
+	Token string
+	HTTP  *http.Client
+}
+
+// Flags defines the -server and -auth flags of fs, returning a function that
+// makes a Client with their values once parsed.
+func Flags(fs *flag.FlagSet) func() *Client {
+	addr := fs.String("server", DefaultURL, "URL of the moggio server")
+	auth := fs.String("auth", "", "auth token, if the server requires one")
+	return func() *Client {
+		return &Client{
+			URL:   strings.TrimSuffix(*addr, "/"),
+			Token: *auth,
+			HTTP:  &http.Client{Timeout: time.Second * 10},
+		}
+	}
+}
+
+func (c *Client) request(method, u string, body io.Reader) (*http.Response, error) {
+	req, err := http.NewRequest(method, u, body)
+	if err != nil {
+		return nil, err
+	}
+	if c.Token != "" {
+		req.Header.Set("Authorization", "Bearer "+c.Token)
+	}
+	resp, err := c.HTTP.Do(req)
+	if err != nil {
+		return nil, err
+	}
+	if resp.StatusCode != http.StatusOK {
+		b, _ := ioutil.ReadAll(resp.Body)
+		resp.Body.Close()
+		return nil, fmt.Errorf("%s: %s", resp.Status, bytes.TrimSpace(b))
+	}
+	return resp, nil
+}
+
+func (c *Client) do(method, path string, form url.Values, body, res interface{}) error {
+	u := c.URL + path
+	if len(form) > 0 {
+		u += "?" + form.Encode()
+	}
+	var r io.Reader
+	if body != nil {
+		b, err := json.Marshal(body)
+		if err != nil {
+			return err
+		}
+		r = bytes.NewReader(b)
+	}
+	resp, err := c.request(method, u, r)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+	if res == nil {
+		return nil
+	}
+	return json.NewDecoder(resp.Body).Decode(res)
+}
+
+// data gets the data of type typ, like status or playlist, into v.
+func (c *Client) data(typ string, v interface{}) error {
+	res := struct {
+		Data interface{}
+	}{v}
+	return c.do("GET", "/api/data/"+typ, nil, nil, &res)
+}
+
+func (c *Client) Status() (Status, error) {
+	var s Status
+	err := c.data("status", &s)
+	return s, err
+}
+
+func (c *Client) Queue() ([]Item, error) {
+	var p struct {
+		Queue []Item
+	}
+	err := c.data("playlist", &p)
+	return p.Queue, err
+}
+
+// Tracks returns the songs of the library.
+func (c *Client) Tracks() ([]Item, error) {
+	var t struct {
+		Tracks []Item
+	}
+	err := c.data("tracks", &t)
+	return t.Tracks, err
+}
+
+// Cmd runs the command name with form.
+func (c *Client) Cmd(name string, form url.Values) error {
+	return c.do("POST", "/api/cmd/"+name, form, nil, nil)
+}
+
+func (c *Client) PlayTrack(id SongID) error {
+	return c.do("POST", "/api/cmd/play_track", nil, id.UID, nil)
+}
+
+// QueueChange changes the queue with a list of commands like
+// ["add", uid] and ["rem", index].
+func (c *Client) QueueChange(change [][]string) error {
+	return c.do("POST", "/api/queue/change", nil, change, nil)
+}
+
+// Analyze starts a loudness scan of the albums of songs, or of the whole
+// library if none, writing ReplayGain tags if tags is set. Workers is the
+// number of songs scanned at once, or the server's number of CPUs if 0.
+func (c *Client) Analyze(songs []string, tags bool, workers int) error {
+	return c.do("POST", "/api/analyze", nil, struct {
+		Albums  []string
+		Tags    bool
+		Workers int
+	}{songs, tags, workers}, nil)
+}
+
+// LoudnessScan returns the progress of the last loudness scan.
+func (c *Client) LoudnessScan() (LoudnessScan, error) {
+	var s LoudnessScan
+	err := c.do("GET", "/api/analyze", nil, nil, &s)
+	return s, err
+}
+
+// AnalyzeCancel stops the running loudness scan.
+func (c *Client) AnalyzeCancel() error {
+	return c.do("POST", "/api/analyze/cancel", nil, nil, nil)
+}
+
+// Art returns the artwork of a song with info, from its image URL or the
+// server.
+func (c *Client) Art(id SongID, info codec.SongInfo) ([]byte, error) {
+	u := info.ImageURL
+	if !strings.HasPrefix(u, "http") {
+		u = c.URL + "/api/art/" + url.PathEscape(id.UID)
+	}
+	resp, err := c.request("GET", u, nil)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+	return ioutil.ReadAll(resp.Body)
+}
+
+// WebURL returns the URL of the web UI.
+func (c *Client) WebURL() string {
+	if c.Token == "" {
+		return c.URL + "/"
+	}
+	return c.URL + "/?auth=" + url.QueryEscape(c.Token)
+}
+
+// Statuses sends the status each time it changes, reconnecting to the
+// server's event stream if it drops.
+func (c *Client) Statuses() <-chan Status {
+	ch := make(chan Status)
+	go func() {
+		for {
+			if err := c.watch(ch); err != nil {
+				log.Printf("client: %v", err)
+			}
+			time.Sleep(reconnectWait)
+		}
+	}()
+	return ch
+}
+
+func (c *Client) watch(ch chan<- Status) error {
+	u := "ws" + strings.TrimPrefix(c.URL, "http") + "/ws/"
+	config, err := websocket.NewConfig(u, c.URL+"/")
+	if err != nil {
+		return err
+	}
+	if c.Token != "" {
+		config.Header.Set("Authorization", "Bearer "+c.Token)
+	}
+	ws, err := websocket.DialConfig(config)
+	if err != nil {
+		return err
+	}
+	defer ws.Close()
+	for {
+		var e struct {
+			Type string
+			Data json.RawMessage
+		}
+		if err := websocket.JSON.Receive(ws, &e); err != nil {
+			return err
+		}
+		if e.Type != "status" {
+			continue
+		}
+		var s Status
+		if err := json.Unmarshal(e.Data, &s); err != nil {
+			return err
+		}
+		ch <- s
+	}
+}
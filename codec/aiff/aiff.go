@@ -0,0 +1,330 @@
+// Package aiff decodes AIFF and AIFF-C files of integer or floating point
+// PCM, with the metadata of their ID3 and text chunks.
+package aiff
+
+import (
+	"bytes"
+	"encoding/binary"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"math"
+	"strings"
+	"time"
+
+	"github.com/dhowden/tag"
+	"github.com/mjibson/moggio/codec"
+)
+
+func init() {
+	codec.RegisterCodec("AIFF", []string{"FORM????AIFF", "FORM????AIFC"}, []string{"aif", "aiff", "aifc"}, New, nil)
+	codec.RegisterSong("AIFF", &Aiff{})
+}
+
+func New(rf codec.Reader) (codec.Songs, error) {
+	a := &Aiff{
+		Reader: rf,
+	}
+	return codec.Songs{codec.None: a}, nil
+}
+
+// maxCommonSize bounds the size of COMM chunks, which are small but for the
+// name of an AIFF-C compression.
+const maxCommonSize = 1 << 16
+
+type Aiff struct {
+	Reader codec.Reader
+	r      io.ReadCloser
+	c      *common
+	data   io.Reader
+	info   *codec.SongInfo
+}
+
+func (a *Aiff) Init() (sampleRate, channels int, err error) {
+	if a.c == nil {
+		r, _, err := a.Reader()
+		if err != nil {
+			return 0, 0, err
+		}
+		var c *common
+		err = readChunks(r, func(id string, size int64, cr io.Reader) (bool, error) {
+			switch id {
+			case "COMM":
+				if size > maxCommonSize {
+					return false, fmt.Errorf("aiff: bad COMM size")
+				}
+				b := make([]byte, size)
+				if _, err := io.ReadFull(cr, b); err != nil {
+					return false, err
+				}
+				c, err = parseCommon(b)
+				return false, err
+			case "SSND":
+				data, err := soundData(cr)
+				a.data = data
+				return true, err
+			}
+			return false, nil
+		})
+		if err == nil && (c == nil || a.data == nil) {
+			err = io.ErrUnexpectedEOF
+		}
+		if err != nil {
+			r.Close()
+			return 0, 0, err
+		}
+		a.r = r
+		a.c = c
+	}
+	return a.c.sampleRate, a.c.channels, nil
+}
+
+func (a *Aiff) BitDepth() int {
+	if a.c.float {
+		return 0
+	}
+	return a.c.bits
+}
+
+func (a *Aiff) Info() (info codec.SongInfo, err error) {
+	if a.info != nil {
+		return *a.info, nil
+	}
+	r, _, err := a.Reader()
+	if err != nil {
+		return
+	}
+	defer r.Close()
+	var c *common
+	var id3, text codec.SongInfo
+	err = readChunks(r, func(id string, size int64, cr io.Reader) (bool, error) {
+		switch id {
+		case "COMM", "NAME", "AUTH", "ID3 ", "id3 ":
+		default:
+			return false, nil
+		}
+		b, err := ioutil.ReadAll(cr)
+		if err != nil {
+			return false, err
+		}
+		switch id {
+		case "COMM":
+			c, err = parseCommon(b)
+		case "NAME":
+			text.Title = strings.TrimSpace(string(b))
+		case "AUTH":
+			text.Artist = strings.TrimSpace(string(b))
+		default:
+			if m, err := tag.ReadID3v2Tags(bytes.NewReader(b)); err == nil {
+				track, _ := m.Track()
+				id3 = codec.SongInfo{
+					Artist: m.Artist(),
+					Title:  m.Title(),
+					Album:  m.Album(),
+					Track:  float64(track),
+					Genre:  m.Genre(),
+
+					AlbumArtist: m.AlbumArtist(),
+					Compilation: codec.IsCompilation(m),
+					Explicit:    codec.IsExplicit(m),
+				}
+				codec.ClassicalTags(m, &id3)
+				codec.DiscTags(m, &id3)
+				codec.DateTags(m, &id3)
+			}
+		}
+		return false, err
+	})
+	if err != nil {
+		return
+	}
+	if c == nil {
+		return info, fmt.Errorf("aiff: missing COMM chunk")
+	}
+	info = id3
+	if info.Title == "" {
+		info.Title = text.Title
+	}
+	if info.Artist == "" {
+		info.Artist = text.Artist
+	}
+	info.Time = time.Duration(c.frames) * time.Second / time.Duration(c.sampleRate)
+	a.info = &info
+	return info, nil
+}
+
+func (a *Aiff) Play(n int) ([]float32, error) {
+	frames := n / a.c.channels
+	if frames < 1 {
+		frames = 1
+	}
+	b := make([]byte, frames*a.c.frameSize())
+	m, err := io.ReadFull(a.data, b)
+	samples := a.c.decode(b[:m])
+	if len(samples) == 0 {
+		if err == nil || err == io.ErrUnexpectedEOF {
+			err = io.EOF
+		}
+		return nil, err
+	}
+	if err == io.EOF || err == io.ErrUnexpectedEOF {
+		err = nil
+	}
+	return samples, err
+}
+
+func (a *Aiff) Close() {
+	if a.r != nil {
+		a.r.Close()
+		a.r = nil
+	}
+	a.c = nil
+	a.data = nil
+}
+
+// common is the COMM chunk of an AIFF file.
+type common struct {
+	channels   int
+	frames     int64
+	bits       int
+	sampleRate int
+	// float is set for floating point samples, and little for the little
+	// endian integer samples of sowt compression.
+	float  bool
+	little bool
+}
+
+// parseCommon parses the COMM chunk b, which for AIFF-C files names their
+// compression.
+func parseCommon(b []byte) (*common, error) {
+	if len(b) < 18 {
+		return nil, fmt.Errorf("aiff: bad COMM size")
+	}
+	be := binary.BigEndian
+	c := &common{
+		channels:   int(be.Uint16(b)),
+		frames:     int64(be.Uint32(b[2:])),
+		bits:       int(be.Uint16(b[6:])),
+		sampleRate: int(extended(b[8:18])),
+	}
+	if len(b) >= 22 {
+		switch compression := string(b[18:22]); compression {
+		case "NONE", "twos":
+		case "sowt":
+			c.little = true
+		case "fl32", "FL32":
+			c.float, c.bits = true, 32
+		case "fl64", "FL64":
+			c.float, c.bits = true, 64
+		default:
+			return nil, fmt.Errorf("aiff: unsupported compression %q", compression)
+		}
+	}
+	if c.channels == 0 || c.sampleRate == 0 {
+		return nil, fmt.Errorf("aiff: no channels or sample rate")
+	}
+	if c.bits < 1 || c.bits > 32 && !c.float {
+		return nil, fmt.Errorf("aiff: unsupported sample size %d", c.bits)
+	}
+	return c, nil
+}
+
+// extended returns the 80-bit IEEE 754 extended precision float b.
+func extended(b []byte) float64 {
+	exp := int(binary.BigEndian.Uint16(b) & 0x7fff)
+	mant := binary.BigEndian.Uint64(b[2:])
+	v := math.Ldexp(float64(mant), exp-16383-63)
+	if b[0]&0x80 != 0 {
+		v = -v
+	}
+	return v
+}
+
+// frameSize returns the size of a sample frame: each sample is in as many
+// bytes as its bits need, left justified.
+func (c *common) frameSize() int {
+	return (c.bits + 7) / 8 * c.channels
+}
+
+// decode converts the samples of the whole frames of b to floats.
+func (c *common) decode(b []byte) []float32 {
+	size := (c.bits + 7) / 8
+	frames := len(b) / c.frameSize()
+	out := make([]float32, frames*c.channels)
+	for i := range out {
+		s := b[i*size : (i+1)*size]
+		switch {
+		case c.float && size == 4:
+			out[i] = math.Float32frombits(binary.BigEndian.Uint32(s))
+		case c.float:
+			out[i] = float32(math.Float64frombits(binary.BigEndian.Uint64(s)))
+		default:
+			// Samples are signed, even of 8 bits.
+			var n int32
+			if c.little {
+				n = int32(int8(s[size-1]))
+				for j := size - 2; j >= 0; j-- {
+					n = n<<8 | int32(s[j])
+				}
+			} else {
+				n = int32(int8(s[0]))
+				for _, v := range s[1:] {
+					n = n<<8 | int32(v)
+				}
+			}
+			out[i] = float32(float64(n) / float64(int64(1)<<uint(size*8-1)))
+		}
+	}
+	return out
+}
+
+// soundData returns the samples of the SSND chunk r, after its offset.
+func soundData(r io.Reader) (io.Reader, error) {
+	b := make([]byte, 8)
+	if _, err := io.ReadFull(r, b); err != nil {
+		return nil, err
+	}
+	offset := int64(binary.BigEndian.Uint32(b))
+	if _, err := io.CopyN(ioutil.Discard, r, offset); err != nil {
+		return nil, err
+	}
+	return r, nil
+}
+
+// readChunks reads the chunks of the AIFF file r, calling fn with each. The
+// rest of a chunk fn doesn't read is skipped, unless fn returns stop.
+func readChunks(r io.Reader, fn func(id string, size int64, r io.Reader) (stop bool, err error)) error {
+	b := make([]byte, 12)
+	if _, err := io.ReadFull(r, b); err != nil {
+		return err
+	}
+	if string(b[:4]) != "FORM" || string(b[8:12]) != "AIFF" && string(b[8:12]) != "AIFC" {
+		return fmt.Errorf("aiff: missing FORM AIFF header")
+	}
+	for {
+		if _, err := io.ReadFull(r, b[:8]); err == io.EOF {
+			return nil
+		} else if err != nil {
+			return err
+		}
+		id := string(b[:4])
+		size := int64(binary.BigEndian.Uint32(b[4:]))
+		lr := &io.LimitedReader{R: r, N: size}
+		stop, err := fn(id, size, lr)
+		if err != nil || stop {
+			return err
+		}
+		// Chunks are padded to an even size.
+		n := lr.N + size%2
+		if s, ok := r.(io.Seeker); ok {
+			_, err = s.Seek(n, io.SeekCurrent)
+		} else {
+			_, err = io.CopyN(ioutil.Discard, r, n)
+		}
+		if err == io.EOF {
+			return nil
+		} else if err != nil {
+			return err
+		}
+	}
+}
@@ -64,6 +64,10 @@ type codec struct {
 	extensions []string
 	decode     func(Reader) (Songs, error)
 	get        func(Reader, ID) (Song, error)
+	// song is a song of the codec's type, if registered, and errors its
+	// decode errors.
+	song   Song
+	errors *decodeErrors
 }
 
 var (
@@ -87,6 +91,7 @@ func RegisterCodec(name string, magic, extensions []string, decode func(Reader)
 		extensions: extensions,
 		decode:     decode,
 		get:        get,
+		errors:     new(decodeErrors),
 	}
 	for _, e := range extensions {
 		if v, ok := allExtensions[e]; ok {
@@ -167,8 +172,19 @@ func (rf Reader) Metadata(ft tag.FileType) (*SongInfo, tag.Metadata, []byte, err
 		Title:    m.Title(),
 		Album:    m.Album(),
 		Track:    float64(track),
+		Genre:    m.Genre(),
 		ImageURL: dataURL(m),
+
+		AlbumArtist: m.AlbumArtist(),
+		Compilation: IsCompilation(m),
+		Explicit:    IsExplicit(m),
+
+		TrackGain: ParseGain(RawTag(m, "REPLAYGAIN_TRACK_GAIN")),
+		AlbumGain: ParseGain(RawTag(m, "REPLAYGAIN_ALBUM_GAIN")),
 	}
+	ClassicalTags(m, si)
+	DiscTags(m, si)
+	DateTags(m, si)
 	return si, m, b, nil
 }
 
@@ -199,6 +215,9 @@ func Decode(rf Reader) (Songs, string, error) {
 		return nil, "", ErrFormat
 	}
 	m, err := f.decode(rf)
+	if err != nil {
+		f.errors.add(err)
+	}
 	return m, f.name, err
 }
 
@@ -221,6 +240,9 @@ func ByExtension(path string, rf Reader) (Songs, string, error) {
 		return nil, "", err
 	}
 	songs, err := c.decode(rf)
+	if err != nil {
+		c.errors.add(err)
+	}
 	return songs, c.name, err
 }
 
@@ -230,10 +252,15 @@ func ByExtensionID(path string, id ID, rf Reader) (Song, error) {
 		return nil, err
 	}
 	if c.get != nil {
-		return c.get(rf, id)
+		song, err := c.get(rf, id)
+		if err != nil {
+			c.errors.add(err)
+		}
+		return song, err
 	}
 	songs, err := c.decode(rf)
 	if err != nil {
+		c.errors.add(err)
 		return nil, err
 	}
 	song, ok := songs[id]
@@ -0,0 +1,85 @@
+package codec
+
+import (
+	"context"
+	"io"
+	"sync"
+)
+
+// WithContext returns a Reader whose files are closed once ctx is done, so
+// reads blocked on a slow network return promptly with ctx's error. Opening
+// fails immediately once ctx is done, even if rf is still opening.
+func (rf Reader) WithContext(ctx context.Context) Reader {
+	return func() (io.ReadCloser, int64, error) {
+		if err := ctx.Err(); err != nil {
+			return nil, 0, err
+		}
+		type opened struct {
+			r    io.ReadCloser
+			size int64
+			err  error
+		}
+		ch := make(chan opened, 1)
+		go func() {
+			r, size, err := rf()
+			ch <- opened{r, size, err}
+		}()
+		select {
+		case o := <-ch:
+			if o.err != nil {
+				return nil, 0, o.err
+			}
+			return newContextReader(ctx, o.r), o.size, nil
+		case <-ctx.Done():
+			// Close the file when it finally opens.
+			go func() {
+				if o := <-ch; o.err == nil {
+					o.r.Close()
+				}
+			}()
+			return nil, 0, ctx.Err()
+		}
+	}
+}
+
+type contextReader struct {
+	r    io.ReadCloser
+	ctx  context.Context
+	stop func() bool
+	once sync.Once
+	err  error
+}
+
+func newContextReader(ctx context.Context, r io.ReadCloser) *contextReader {
+	c := &contextReader{
+		r:   r,
+		ctx: ctx,
+	}
+	c.stop = context.AfterFunc(ctx, func() {
+		c.close()
+	})
+	return c
+}
+
+func (c *contextReader) Read(p []byte) (int, error) {
+	if err := c.ctx.Err(); err != nil {
+		return 0, err
+	}
+	n, err := c.r.Read(p)
+	if err != nil && c.ctx.Err() != nil {
+		err = c.ctx.Err()
+	}
+	return n, err
+}
+
+func (c *contextReader) close() error {
+	c.once.Do(func() {
+		c.err = c.r.Close()
+	})
+	return c.err
+}
+
+func (c *contextReader) Close() error {
+	c.stop()
+	return c.close()
+}
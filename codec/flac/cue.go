@@ -0,0 +1,187 @@
+package flac
+
+import (
+	"bufio"
+	"io"
+	"io/ioutil"
+	"strconv"
+	"strings"
+
+	"gopkg.in/mewkiz/flac.v1"
+	"gopkg.in/mewkiz/flac.v1/frame"
+	"gopkg.in/mewkiz/flac.v1/meta"
+)
+
+// cueTrack is a track of the cue sheet embedded in a FLAC file, in a
+// CUESHEET block, whose tracks are exposed as separate songs.
+type cueTrack struct {
+	num int
+	// start and end are the numbers of the first sample of the track and
+	// of the next one.
+	start, end uint64
+	// Names are from the text cue sheet in the CUESHEET Vorbis comment,
+	// which rippers often write beside the block.
+	title     string
+	performer string
+	album     string
+}
+
+// cueTracks returns the audio tracks of the cue sheet of fv, or nil if it
+// has fewer than two.
+func cueTracks(fv *flac.Stream) []cueTrack {
+	var cs *meta.CueSheet
+	var text string
+	for _, b := range fv.Blocks {
+		switch v := b.Body.(type) {
+		case *meta.CueSheet:
+			cs = v
+		case *meta.VorbisComment:
+			for _, tag := range v.Tags {
+				if strings.EqualFold(tag[0], "CUESHEET") {
+					text = tag[1]
+				}
+			}
+		}
+	}
+	if cs == nil {
+		return nil
+	}
+	album, names := parseCueText(text)
+	var tracks []cueTrack
+	// The last track is the lead-out, which only marks the end.
+	for i := 0; i+1 < len(cs.Tracks); i++ {
+		t := cs.Tracks[i]
+		if !t.IsAudio {
+			continue
+		}
+		ct := cueTrack{
+			num:   int(t.Num),
+			start: trackStart(t),
+			end:   trackStart(cs.Tracks[i+1]),
+			album: album.title,
+		}
+		if n := fv.Info.NSamples; n > 0 && ct.end > n {
+			ct.end = n
+		}
+		if ct.end <= ct.start {
+			continue
+		}
+		if name, ok := names[ct.num]; ok {
+			ct.title = name.title
+			ct.performer = name.performer
+		}
+		if ct.performer == "" {
+			ct.performer = album.performer
+		}
+		tracks = append(tracks, ct)
+	}
+	if len(tracks) < 2 {
+		return nil
+	}
+	return tracks
+}
+
+// trackStart returns the sample number of index 1 of t, where its audio
+// starts after any pregap, or of its first index.
+func trackStart(t meta.CueSheetTrack) uint64 {
+	for _, idx := range t.Indicies {
+		if idx.Num == 1 {
+			return t.Offset + idx.Offset
+		}
+	}
+	if len(t.Indicies) > 0 {
+		return t.Offset + t.Indicies[0].Offset
+	}
+	return t.Offset
+}
+
+type cueNames struct {
+	title, performer string
+}
+
+// parseCueText returns the names of the disc and of its tracks by number
+// from the text cue sheet s.
+func parseCueText(s string) (album cueNames, tracks map[int]cueNames) {
+	tracks = make(map[int]cueNames)
+	track := 0
+	for _, line := range strings.Split(s, "\n") {
+		line = strings.TrimSpace(line)
+		i := strings.IndexAny(line, " \t")
+		if i < 0 {
+			continue
+		}
+		cmd, value := strings.ToUpper(line[:i]), strings.TrimSpace(line[i+1:])
+		if v, err := strconv.Unquote(value); err == nil {
+			value = v
+		} else {
+			value = strings.Trim(value, `"`)
+		}
+		switch cmd {
+		case "TRACK":
+			// Like TRACK 01 AUDIO.
+			track, _ = strconv.Atoi(strings.Fields(value)[0])
+		case "TITLE", "PERFORMER":
+			n := album
+			if track > 0 {
+				n = tracks[track]
+			}
+			if cmd == "TITLE" {
+				n.title = value
+			} else {
+				n.performer = value
+			}
+			if track > 0 {
+				tracks[track] = n
+			} else {
+				album = n
+			}
+		}
+	}
+	return album, tracks
+}
+
+// seekTrack starts decoding at the last seek point before the start of the
+// track, by reopening the file there, so the tracks before it aren't
+// decoded.
+func (f *Flac) seekTrack() error {
+	var point *meta.SeekPoint
+	audio := int64(4 + 4 + 34)
+	for _, b := range f.f.Blocks {
+		audio += 4 + b.Length
+		st, ok := b.Body.(*meta.SeekTable)
+		if !ok {
+			continue
+		}
+		for i, p := range st.Points {
+			// Placeholder points have the highest sample number.
+			if p.SampleNum <= f.track.start && (point == nil || p.SampleNum > point.SampleNum) {
+				point = &st.Points[i]
+			}
+		}
+	}
+	if point == nil || point.SampleNum == 0 {
+		return nil
+	}
+	r, _, err := f.Reader()
+	if err != nil {
+		return err
+	}
+	off := audio + int64(point.Offset)
+	if s, ok := r.(io.Seeker); ok {
+		_, err = s.Seek(off, io.SeekStart)
+	} else {
+		_, err = io.CopyN(ioutil.Discard, r, off)
+	}
+	if err != nil {
+		r.Close()
+		return err
+	}
+	br := bufio.NewReader(r)
+	f.r.Close()
+	f.r = r
+	f.next = func() (*frame.Frame, error) {
+		return frame.Parse(br)
+	}
+	f.pos = point.SampleNum
+	return nil
+}
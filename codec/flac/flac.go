@@ -2,12 +2,15 @@ package flac
 
 import (
 	"bytes"
+	"crypto/md5"
 	"encoding/base64"
 	"fmt"
+	"hash"
 	"io"
 	"io/ioutil"
-	"math"
 	"strconv"
+	"strings"
+	"sync"
 	"time"
 
 	"github.com/mjibson/moggio/codec"
@@ -18,13 +21,37 @@ import (
 
 func init() {
 	codec.RegisterCodec("FLAC", []string{"fLaC"}, []string{"flac"}, New, nil)
+	codec.RegisterSong("FLAC", &Flac{})
 }
 
+// New returns the song of the FLAC file rf, or the songs of its tracks if
+// it embeds a cue sheet of several.
 func New(rf codec.Reader) (codec.Songs, error) {
-	f := Flac{
-		Reader: rf,
+	single := codec.Songs{codec.None: &Flac{Reader: rf}}
+	r, _, err := rf()
+	if err != nil {
+		return nil, err
+	}
+	defer r.Close()
+	// Errors are left to Init and Info, as if the file weren't read here.
+	buf := new(bytes.Buffer)
+	fv, err := flac.Parse(io.TeeReader(r, buf))
+	if err != nil {
+		return single, nil
+	}
+	tracks := cueTracks(fv)
+	if tracks == nil {
+		return single, nil
 	}
-	return codec.Songs{codec.None: &f}, nil
+	songs := make(codec.Songs, len(tracks))
+	for i := range tracks {
+		songs[codec.Int(i)] = &Flac{
+			Reader:  rf,
+			initbuf: buf.Bytes(),
+			track:   &tracks[i],
+		}
+	}
+	return songs, nil
 }
 
 type Flac struct {
@@ -33,6 +60,19 @@ type Flac struct {
 	initbuf []byte
 	f       *flac.Stream
 	samples []int32
+	// next parses the next frame, and pos is the number of the first sample
+	// of it.
+	next func() (*frame.Frame, error)
+	pos  uint64
+	// track is the track of the embedded cue sheet played, if any.
+	track *cueTrack
+	// md5 hashes the decoded audio as STREAMINFO's MD5, want, does if it's
+	// set; sum is its sum at the end of the stream.
+	md5  hash.Hash
+	want []byte
+	buf  []byte
+	mu   sync.Mutex
+	sum  []byte
 }
 
 func (f *Flac) Init() (sampleRate, channels int, err error) {
@@ -52,10 +92,25 @@ func (f *Flac) Init() (sampleRate, channels int, err error) {
 		}
 		f.r = r
 		f.f = fr
+		f.next = fr.ParseNext
+		if f.track != nil {
+			if err := f.seekTrack(); err != nil {
+				f.Close()
+				return 0, 0, err
+			}
+		} else if fr.Info.MD5sum != [md5.Size]uint8{} {
+			// Only whole streams can be verified.
+			f.md5 = md5.New()
+			f.want = fr.Info.MD5sum[:]
+		}
 	}
 	return int(f.f.Info.SampleRate), int(f.f.Info.NChannels), nil
 }
 
+func (f *Flac) BitDepth() int {
+	return int(f.f.Info.BitsPerSample)
+}
+
 func (f *Flac) Info() (info codec.SongInfo, err error) {
 	var r io.ReadCloser
 	if len(f.initbuf) != 0 {
@@ -75,20 +130,78 @@ func (f *Flac) Info() (info codec.SongInfo, err error) {
 	si := codec.SongInfo{
 		Time: time.Duration(fv.Info.NSamples) / time.Duration(fv.Info.SampleRate) * time.Second,
 	}
+	defer func() {
+		if t := f.track; t != nil {
+			si.Time = time.Duration(t.end-t.start) * time.Second / time.Duration(fv.Info.SampleRate)
+			si.Track = float64(t.num)
+			si.Title = t.title
+			if si.Title == "" {
+				si.Title = fmt.Sprintf("Track %d", t.num)
+			}
+			if t.performer != "" {
+				si.Artist = t.performer
+			}
+			if si.Album == "" {
+				si.Album = t.album
+			}
+			info = si
+		}
+	}()
 	for _, b := range fv.Blocks {
 		switch v := b.Body.(type) {
 		case *meta.VorbisComment:
 			for _, tag := range v.Tags {
-				switch tag[0] {
+				switch strings.ToUpper(tag[0]) {
 				case "TITLE":
 					si.Title = tag[1]
 				case "ARTIST":
 					si.Artist = tag[1]
 				case "ALBUM":
 					si.Album = tag[1]
+				case "GENRE":
+					si.Genre = tag[1]
+				case "ALBUMARTIST", "ALBUM ARTIST":
+					si.AlbumArtist = tag[1]
+				case "COMPILATION":
+					si.Compilation = tag[1] == "1"
+				case "ITUNESADVISORY":
+					si.Explicit = tag[1] == "1" || tag[1] == "4"
+				case "DISCNUMBER":
+					si.Disc = codec.ParseNumber(tag[1])
+					if i := strings.IndexByte(tag[1], '/'); i >= 0 && si.Discs == 0 {
+						si.Discs = codec.ParseNumber(tag[1][i+1:])
+					}
+				case "DISCTOTAL", "TOTALDISCS":
+					si.Discs = codec.ParseNumber(tag[1])
+				case "DISCSUBTITLE":
+					si.DiscSubtitle = tag[1]
+				case "DATE":
+					si.Date, si.Year = codec.ParseDate(tag[1])
+				case "YEAR":
+					if si.Date == "" {
+						si.Date, si.Year = codec.ParseDate(tag[1])
+					}
+				case "ORIGINALDATE":
+					si.OriginalDate, si.OriginalYear = codec.ParseDate(tag[1])
+				case "ORIGINALYEAR":
+					if si.OriginalDate == "" {
+						si.OriginalDate, si.OriginalYear = codec.ParseDate(tag[1])
+					}
+				case "COMPOSER":
+					si.Composer = tag[1]
+				case "WORK":
+					si.Work = tag[1]
+				case "MOVEMENTNAME":
+					si.Movement = tag[1]
+				case "MOVEMENT":
+					si.MovementNumber = codec.ParseNumber(tag[1])
 				case "TRACKNUMBER":
 					n, _ := strconv.Atoi(tag[1])
 					si.Track = float64(n)
+				case "REPLAYGAIN_TRACK_GAIN":
+					si.TrackGain = codec.ParseGain(tag[1])
+				case "REPLAYGAIN_ALBUM_GAIN":
+					si.AlbumGain = codec.ParseGain(tag[1])
 				}
 			}
 		case *meta.Picture:
@@ -106,27 +219,83 @@ func (f *Flac) Play(n int) ([]float32, error) {
 	var err error
 	var frame *frame.Frame
 	for len(f.samples) < n && err == nil {
-		frame, err = f.f.ParseNext()
+		if f.track != nil && f.pos >= f.track.end {
+			err = io.EOF
+			break
+		}
+		frame, err = f.next()
 		if err != nil {
+			if err == io.EOF && f.md5 != nil {
+				f.mu.Lock()
+				f.sum = f.md5.Sum(nil)
+				f.mu.Unlock()
+			}
 			break
 		}
-		for i := 0; i < int(frame.BlockSize); i++ {
+		// Only the part of the frame in the track is played.
+		from, to := 0, int(frame.BlockSize)
+		if t := f.track; t != nil {
+			if f.pos < t.start {
+				from = int(min64(t.start-f.pos, uint64(to)))
+			}
+			if f.pos+uint64(to) > t.end {
+				to = int(t.end - f.pos)
+			}
+		}
+		f.pos += uint64(frame.BlockSize)
+		start := len(f.samples)
+		for i := from; i < to; i++ {
 			for _, sf := range frame.Subframes {
 				f.samples = append(f.samples, sf.Samples[i])
 			}
 		}
+		if f.md5 != nil {
+			f.hash(f.samples[start:])
+		}
 	}
 	if n > len(f.samples) {
 		n = len(f.samples)
 	}
 	ret := make([]float32, n)
+	// Scale by the stream's depth, not 16 bits, so 24 bit samples keep their
+	// precision; float32 holds them exactly.
+	scale := 1 / float32(int64(1)<<(f.f.Info.BitsPerSample-1))
 	for i, s := range f.samples[:n] {
-		ret[i] = (float32(s) - math.MinInt16) / (math.MaxInt16 - math.MinInt16)
+		ret[i] = float32(s) * scale
 	}
 	f.samples = f.samples[n:]
 	return ret, err
 }
 
+func min64(a, b uint64) uint64 {
+	if a < b {
+		return a
+	}
+	return b
+}
+
+// hash writes samples to the MD5 as little endian integers of the
+// stream's depth rounded up to bytes.
+func (f *Flac) hash(samples []int32) {
+	size := (int(f.f.Info.BitsPerSample) + 7) / 8
+	f.buf = f.buf[:0]
+	for _, s := range samples {
+		for i := 0; i < size; i++ {
+			f.buf = append(f.buf, byte(s>>(8*uint(i))))
+		}
+	}
+	f.md5.Write(f.buf)
+}
+
+func (f *Flac) Verified() (match, ok bool) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	if f.sum == nil {
+		return false, false
+	}
+	return bytes.Equal(f.sum, f.want), true
+}
+
 func (f *Flac) Close() {
 	if f.r != nil {
 		f.r.Close()
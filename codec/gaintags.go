@@ -0,0 +1,372 @@
+package codec
+
+import (
+	"bytes"
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+	"unicode/utf16"
+)
+
+// ErrTagsUnsupported indicates that tags can't be written to a file's
+// format.
+var ErrTagsUnsupported = errors.New("codec: writing tags of this format is unsupported")
+
+// tagPadding is the padding added when tags no longer fit in a file's
+// existing space, so later changes can be written in place.
+const tagPadding = 4096
+
+// ReplayGain are the ReplayGain tags of a song. Gains are in dB and peaks
+// linear amplitudes.
+type ReplayGain struct {
+	TrackGain float64
+	TrackPeak float64
+	// AlbumGain and AlbumPeak are written only if Album is set.
+	Album     bool
+	AlbumGain float64
+	AlbumPeak float64
+}
+
+// tags returns the names and values of the tags of g.
+func (g ReplayGain) tags() [][2]string {
+	gain := func(f float64) string {
+		return strconv.FormatFloat(f, 'f', 2, 64) + " dB"
+	}
+	peak := func(f float64) string {
+		return strconv.FormatFloat(f, 'f', 6, 64)
+	}
+	t := [][2]string{
+		{"REPLAYGAIN_TRACK_GAIN", gain(g.TrackGain)},
+		{"REPLAYGAIN_TRACK_PEAK", peak(g.TrackPeak)},
+	}
+	if g.Album {
+		t = append(t,
+			[2]string{"REPLAYGAIN_ALBUM_GAIN", gain(g.AlbumGain)},
+			[2]string{"REPLAYGAIN_ALBUM_PEAK", peak(g.AlbumPeak)},
+		)
+	}
+	return t
+}
+
+// isGainTag reports whether name is a ReplayGain tag, which are all replaced
+// when writing, so stale album tags don't remain.
+func isGainTag(name string) bool {
+	return strings.HasPrefix(strings.ToUpper(name), "REPLAYGAIN_")
+}
+
+// WriteReplayGain replaces the ReplayGain tags of the file at path. FLAC's
+// Vorbis comments and MP3's ID3v2 tags are supported; other formats return
+// ErrTagsUnsupported.
+func WriteReplayGain(path string, g ReplayGain) error {
+	f, err := os.OpenFile(path, os.O_RDWR, 0)
+	if err != nil {
+		return err
+	}
+	defer f.Close()
+	magic := make([]byte, 4)
+	if _, err := io.ReadFull(f, magic); err != nil && err != io.ErrUnexpectedEOF {
+		return err
+	}
+	var meta []byte
+	var audio int64
+	switch {
+	case string(magic) == "fLaC":
+		meta, audio, err = flacGain(f, g)
+	case string(magic[:3]) == "ID3", strings.EqualFold(filepath.Ext(path), ".mp3"):
+		meta, audio, err = id3Gain(f, g)
+	default:
+		return ErrTagsUnsupported
+	}
+	if err != nil {
+		return err
+	}
+	if int64(len(meta)) == audio {
+		_, err := f.WriteAt(meta, 0)
+		return err
+	}
+	return rewrite(f, path, meta, audio)
+}
+
+// rewrite replaces the contents of f, at path, before offset audio with
+// meta, through a temporary file renamed over it.
+func rewrite(f *os.File, path string, meta []byte, audio int64) error {
+	fi, err := f.Stat()
+	if err != nil {
+		return err
+	}
+	tmp, err := ioutil.TempFile(filepath.Dir(path), "."+filepath.Base(path)+".")
+	if err != nil {
+		return err
+	}
+	defer os.Remove(tmp.Name())
+	if _, err := tmp.Write(meta); err != nil {
+		tmp.Close()
+		return err
+	}
+	if _, err := io.Copy(tmp, io.NewSectionReader(f, audio, fi.Size()-audio)); err != nil {
+		tmp.Close()
+		return err
+	}
+	if err := tmp.Chmod(fi.Mode()); err != nil {
+		tmp.Close()
+		return err
+	}
+	if err := tmp.Close(); err != nil {
+		return err
+	}
+	return os.Rename(tmp.Name(), path)
+}
+
+// fit pads the tags written by write, whose padding is its argument, to
+// size if they fit, or with tagPadding if not.
+func fit(size int, write func(padding int) []byte) []byte {
+	if b := write(0); len(b) == size {
+		return b
+	} else if len(b) < size {
+		if b := write(size - len(b)); len(b) == size {
+			return b
+		}
+	}
+	return write(tagPadding)
+}
+
+// FLAC metadata block types.
+const (
+	flacVorbisComment = 4
+	flacPadding       = 1
+)
+
+// flacGain returns the metadata of the FLAC file f with the ReplayGain
+// Vorbis comments of g, and the offset of its audio frames.
+func flacGain(f io.ReaderAt, g ReplayGain) ([]byte, int64, error) {
+	type block struct {
+		typ  byte
+		data []byte
+	}
+	var blocks []block
+	var comment []byte
+	off := int64(4)
+	for {
+		h := make([]byte, 4)
+		if _, err := f.ReadAt(h, off); err != nil {
+			return nil, 0, err
+		}
+		n := int64(h[1])<<16 | int64(h[2])<<8 | int64(h[3])
+		data := make([]byte, n)
+		if _, err := f.ReadAt(data, off+4); err != nil {
+			return nil, 0, err
+		}
+		off += 4 + n
+		switch typ := h[0] & 0x7f; typ {
+		case flacVorbisComment:
+			comment = data
+		case flacPadding:
+		default:
+			blocks = append(blocks, block{typ, data})
+		}
+		if h[0]&0x80 != 0 {
+			break
+		}
+	}
+	if len(blocks) == 0 {
+		return nil, 0, fmt.Errorf("flac: no stream info")
+	}
+	vendor, comments, err := parseVorbisComment(comment)
+	if err != nil {
+		return nil, 0, err
+	}
+	kept := comments[:0]
+	for _, c := range comments {
+		if !isGainTag(strings.SplitN(c, "=", 2)[0]) {
+			kept = append(kept, c)
+		}
+	}
+	for _, t := range g.tags() {
+		kept = append(kept, t[0]+"="+t[1])
+	}
+	// The comment goes after the stream info, which must be first.
+	blocks = append(blocks[:1], append([]block{{flacVorbisComment, vorbisComment(vendor, kept)}}, blocks[1:]...)...)
+	meta := fit(int(off), func(padding int) []byte {
+		var buf bytes.Buffer
+		buf.WriteString("fLaC")
+		all := blocks
+		if padding > 0 {
+			// The padding block's header is part of the space.
+			if padding < 4 {
+				return nil
+			}
+			all = append(all[:len(all):len(all)], block{flacPadding, make([]byte, padding-4)})
+		}
+		for i, b := range all {
+			typ := b.typ
+			if i == len(all)-1 {
+				typ |= 0x80
+			}
+			n := len(b.data)
+			buf.Write([]byte{typ, byte(n >> 16), byte(n >> 8), byte(n)})
+			buf.Write(b.data)
+		}
+		return buf.Bytes()
+	})
+	return meta, off, nil
+}
+
+func parseVorbisComment(b []byte) (vendor string, comments []string, err error) {
+	if b == nil {
+		return "moggio", nil, nil
+	}
+	r := bytes.NewReader(b)
+	str := func() (string, error) {
+		var n uint32
+		if err := binary.Read(r, binary.LittleEndian, &n); err != nil {
+			return "", err
+		}
+		if int64(n) > int64(r.Len()) {
+			return "", io.ErrUnexpectedEOF
+		}
+		s := make([]byte, n)
+		_, err := io.ReadFull(r, s)
+		return string(s), err
+	}
+	if vendor, err = str(); err != nil {
+		return "", nil, fmt.Errorf("flac: vorbis comment: %v", err)
+	}
+	var n uint32
+	if err := binary.Read(r, binary.LittleEndian, &n); err != nil {
+		return "", nil, fmt.Errorf("flac: vorbis comment: %v", err)
+	}
+	for i := uint32(0); i < n; i++ {
+		c, err := str()
+		if err != nil {
+			return "", nil, fmt.Errorf("flac: vorbis comment: %v", err)
+		}
+		comments = append(comments, c)
+	}
+	return vendor, comments, nil
+}
+
+func vorbisComment(vendor string, comments []string) []byte {
+	var buf bytes.Buffer
+	str := func(s string) {
+		binary.Write(&buf, binary.LittleEndian, uint32(len(s)))
+		buf.WriteString(s)
+	}
+	str(vendor)
+	binary.Write(&buf, binary.LittleEndian, uint32(len(comments)))
+	for _, c := range comments {
+		str(c)
+	}
+	return buf.Bytes()
+}
+
+// id3Gain returns the ID3v2 tag of the MP3 file f with the ReplayGain TXXX
+// frames of g, and the offset of its audio frames. Files without a tag are
+// given a version 2.3 one.
+func id3Gain(f io.ReaderAt, g ReplayGain) ([]byte, int64, error) {
+	h := make([]byte, 10)
+	if _, err := f.ReadAt(h, 0); err != nil && err != io.EOF {
+		return nil, 0, err
+	}
+	version := byte(3)
+	var frames []byte
+	var audio int64
+	if string(h[:3]) == "ID3" {
+		version = h[3]
+		if version != 3 && version != 4 {
+			return nil, 0, fmt.Errorf("id3: version 2.%d: %v", version, ErrTagsUnsupported)
+		}
+		// Unsynchronisation, extended headers, and footers.
+		if h[5]&0xd0 != 0 {
+			return nil, 0, fmt.Errorf("id3: flags %#x: %v", h[5], ErrTagsUnsupported)
+		}
+		size := syncsafe(h[6:10])
+		audio = 10 + int64(size)
+		tag := make([]byte, size)
+		if _, err := f.ReadAt(tag, 10); err != nil {
+			return nil, 0, err
+		}
+		for len(tag) >= 10 && tag[0] != 0 {
+			n := int(binary.BigEndian.Uint32(tag[4:8]))
+			if version == 4 {
+				n = syncsafe(tag[4:8])
+			}
+			if 10+n > len(tag) {
+				return nil, 0, fmt.Errorf("id3: bad frame size")
+			}
+			if string(tag[:4]) != "TXXX" || !isGainTag(txxxDescription(tag[10:10+n])) {
+				frames = append(frames, tag[:10+n]...)
+			}
+			tag = tag[10+n:]
+		}
+	}
+	for _, t := range g.tags() {
+		body := append(append([]byte{0}, t[0]...), 0)
+		body = append(body, t[1]...)
+		fh := []byte("TXXX\x00\x00\x00\x00\x00\x00")
+		if version == 4 {
+			putSyncsafe(fh[4:8], len(body))
+		} else {
+			binary.BigEndian.PutUint32(fh[4:8], uint32(len(body)))
+		}
+		frames = append(frames, fh...)
+		frames = append(frames, body...)
+	}
+	meta := fit(int(audio), func(padding int) []byte {
+		b := make([]byte, 10, 10+len(frames)+padding)
+		copy(b, "ID3")
+		b[3] = version
+		putSyncsafe(b[6:10], len(frames)+padding)
+		b = append(b, frames...)
+		return append(b, make([]byte, padding)...)
+	})
+	return meta, audio, nil
+}
+
+// txxxDescription returns the description of the TXXX frame body b.
+func txxxDescription(b []byte) string {
+	if len(b) == 0 {
+		return ""
+	}
+	switch enc, b := b[0], b[1:]; enc {
+	case 1, 2:
+		// UTF-16, with a byte order mark for 1.
+		order := binary.ByteOrder(binary.BigEndian)
+		if enc == 1 && len(b) >= 2 {
+			if b[0] == 0xff && b[1] == 0xfe {
+				order = binary.LittleEndian
+			}
+			b = b[2:]
+		}
+		var u []uint16
+		for i := 0; i+1 < len(b); i += 2 {
+			c := order.Uint16(b[i:])
+			if c == 0 {
+				break
+			}
+			u = append(u, c)
+		}
+		return string(utf16.Decode(u))
+	default:
+		if i := bytes.IndexByte(b, 0); i >= 0 {
+			b = b[:i]
+		}
+		return string(b)
+	}
+}
+
+func syncsafe(b []byte) int {
+	return int(b[0]&0x7f)<<21 | int(b[1]&0x7f)<<14 | int(b[2]&0x7f)<<7 | int(b[3]&0x7f)
+}
+
+func putSyncsafe(b []byte, n int) {
+	b[0] = byte(n>>21) & 0x7f
+	b[1] = byte(n>>14) & 0x7f
+	b[2] = byte(n>>7) & 0x7f
+	b[3] = byte(n) & 0x7f
+}
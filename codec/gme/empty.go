@@ -1,4 +0,0 @@
-package gme
-
-// This file is here to satisfy Go's requirement that there is at least one
-// buildable file in a package since the other file only builds with cgo.
@@ -3,19 +3,63 @@
 package gme
 
 import (
+	"bytes"
+	"compress/gzip"
 	"io"
 	"io/ioutil"
-	"math"
 	"strconv"
+	"time"
 
 	"github.com/mjibson/gme"
 	"github.com/mjibson/moggio/codec"
 )
 
 func init() {
-	codec.RegisterCodec("SPC", []string{"SNES-SPC"}, []string{"spc"}, NewSongs, GetSong)
+	codec.RegisterCodec("AY", []string{"ZXAYEMUL"}, []string{"ay"}, NewSongs, GetSong)
+	codec.RegisterCodec("GBS", []string{"GBS\u0001"}, []string{"gbs"}, NewSongs, GetSong)
+	codec.RegisterCodec("GYM", []string{"GYMX"}, []string{"gym"}, NewSongs, GetSong)
+	codec.RegisterCodec("HES", []string{"HESM"}, []string{"hes"}, NewSongs, GetSong)
+	codec.RegisterCodec("KSS", []string{"KSCC", "KSSX"}, []string{"kss"}, NewSongs, GetSong)
 	codec.RegisterCodec("NSF", []string{"NESM\u001a"}, []string{"nsf"}, NewSongs, GetSong)
 	codec.RegisterCodec("NSFE", []string{"NSFE"}, []string{"nsfe"}, NewSongs, GetSong)
+	codec.RegisterCodec("SAP", []string{"SAP\r\n"}, []string{"sap"}, NewSongs, GetSong)
+	codec.RegisterCodec("SPC", []string{"SNES-SPC"}, []string{"spc"}, NewSongs, GetSong)
+	codec.RegisterCodec("VGM", []string{"Vgm "}, []string{"vgm"}, NewSongs, GetSong)
+	// VGZ files are gzipped VGM files. They have no magic of their own, so
+	// are only found by extension.
+	codec.RegisterCodec("VGZ", nil, []string{"vgz"}, NewSongs, GetSong)
+
+	names := []string{"AY", "GBS", "GYM", "HES", "KSS", "NSF", "NSFE", "SAP", "SPC", "VGM", "VGZ"}
+	for _, name := range names {
+		codec.RegisterSong(name, &Track{})
+	}
+	codec.RegisterOptions("GME", names, []codec.Option{
+		{
+			Name:        "loops",
+			Description: "Times the loop of a track that has one plays before it fades out",
+			Default:     float64(DefaultOptions.Loops),
+			Min:         1,
+			Max:         maxLoops,
+		},
+		{
+			Name:        "fade",
+			Description: "Length of the fade out ending tracks, in seconds",
+			Default:     DefaultOptions.Fade.Seconds(),
+			Max:         maxFade.Seconds(),
+		},
+		{
+			Name:        "separation",
+			Description: "Stereo separation, from 0 for mono to 1 for full stereo",
+			Default:     DefaultOptions.Separation,
+			Max:         1,
+		},
+	}, func(v map[string]float64) error {
+		return SetOptions(Options{
+			Loops:      int(v["loops"]),
+			Fade:       time.Duration(v["fade"] * float64(time.Second)),
+			Separation: v["separation"],
+		})
+	})
 }
 
 const (
@@ -42,10 +86,11 @@ func NewSongs(rf codec.Reader) (codec.Songs, error) {
 	if err != nil {
 		return nil, err
 	}
-	gg, err := gme.New(b, defaultSampleRate)
+	gg, err := gme.New(b, gme.InfoOnly)
 	if err != nil {
 		return nil, err
 	}
+	defer gg.Close()
 	songs := make(codec.Songs)
 	for i, tr := 0, gg.Tracks(); i < tr; i++ {
 		songs[codec.Int(i)] = &Track{
@@ -60,6 +105,38 @@ type Track struct {
 	r     *reader
 	g     *gme.GME
 	track int
+
+	// pos is the number of samples played, which fade out from fade until
+	// they end at end.
+	pos, fade, end int
+	// The emulator fades out looping tracks itself after two loops, so
+	// they are instead restarted when the emulator's position, epos,
+	// reaches restart, and skipped a loop earlier, to skip, for as many
+	// loops as the options want.
+	epos, restart, skip int
+	// separation is the stereo separation the track plays with.
+	separation float32
+}
+
+// times returns when track info, played with options o, starts to fade out and
+// ends. Looping tracks play their intro and loops, then fade out. Other tracks
+// end where the emulator would start its fade, after their length or two and a
+// half minutes if it isn't known, and fade out before then.
+func times(info gme.Track, o Options) (fade, end time.Duration) {
+	if info.Length <= 0 && info.IntroLength >= 0 && info.LoopLength > 0 {
+		fade = info.IntroLength + time.Duration(o.Loops)*info.LoopLength
+		return fade, fade + o.Fade
+	}
+	end = info.PlayLength
+	if fade = end - o.Fade; fade < 0 {
+		fade = 0
+	}
+	return fade, end
+}
+
+// samples returns the number of samples in d.
+func samples(d time.Duration) int {
+	return int(d*defaultSampleRate/time.Second) * defaultChannels
 }
 
 func (t *Track) Info() (codec.SongInfo, error) {
@@ -74,29 +151,84 @@ func (t *Track) Info() (codec.SongInfo, error) {
 	}
 	info, err := g.Track(t.track)
 	g.Close()
+	if err != nil {
+		return si, err
+	}
+	_, end := times(info, GetOptions())
 	return codec.SongInfo{
-		Time:   info.PlayLength + gme.FadeLength,
+		Time:   end,
 		Artist: info.Author,
 		Title:  info.Song,
 		Album:  info.Game,
 		Track:  float64(t.track),
-	}, err
+	}, nil
 }
 
 func (t *Track) Init() (sampleRate, channels int, err error) {
-	b, err := t.r.get()
+	g, err := t.start()
 	if err != nil {
 		return 0, 0, err
 	}
-	g, err := gme.New(b, defaultSampleRate)
+	info, err := g.Track(t.track)
 	if err != nil {
+		g.Close()
 		return 0, 0, err
 	}
+	o := GetOptions()
+	fade, end := times(info, o)
+	t.g = g
+	t.separation = float32(o.Separation)
+	t.pos, t.epos = 0, 0
+	t.fade, t.end = samples(fade), samples(end)
+	t.restart, t.skip = 0, 0
+	if info.Length <= 0 && info.IntroLength >= 0 && info.LoopLength > 0 && end > info.PlayLength {
+		t.restart = samples(info.PlayLength)
+		t.skip = samples(info.PlayLength - info.LoopLength)
+	}
+	return defaultSampleRate, defaultChannels, nil
+}
+
+// start returns a new emulator started at the track.
+func (t *Track) start() (*gme.GME, error) {
+	b, err := t.r.get()
+	if err != nil {
+		return nil, err
+	}
+	g, err := gme.New(b, defaultSampleRate)
+	if err != nil {
+		return nil, err
+	}
 	if err := g.Start(t.track); err != nil {
-		return 0, 0, nil
+		g.Close()
+		return nil, err
+	}
+	return g, nil
+}
+
+// loop replaces the emulator, which is about to fade out, with a new one
+// skipped to a loop earlier.
+func (t *Track) loop() error {
+	g, err := t.start()
+	if err != nil {
+		return err
+	}
+	data := make([]int16, 8192)
+	for n := 0; n < t.skip; n += len(data) {
+		if t.skip-n < len(data) {
+			data = data[:t.skip-n]
+		}
+		if err := g.Play(data); err != nil {
+			g.Close()
+			if err == io.EOF {
+				err = io.ErrUnexpectedEOF
+			}
+			return err
+		}
 	}
+	t.g.Close()
 	t.g = g
-	return defaultSampleRate, defaultChannels, nil
+	t.epos = t.skip
+	return nil
 }
 
 func (t *Track) Close() {
@@ -117,24 +249,69 @@ func (d *reader) get() ([]byte, error) {
 		if err != nil {
 			return nil, err
 		}
+		defer r.Close()
 		b, err := ioutil.ReadAll(r)
 		if err != nil {
 			return nil, err
 		}
+		// The emulator can't read VGZ files, whose VGM is gzipped.
+		if bytes.HasPrefix(b, []byte{0x1f, 0x8b}) {
+			z, err := gzip.NewReader(bytes.NewReader(b))
+			if err != nil {
+				return nil, err
+			}
+			if b, err = ioutil.ReadAll(z); err != nil {
+				return nil, err
+			}
+		}
 		d.b = b
 	}
 	return d.b, nil
 }
 
 func (t *Track) Play(n int) ([]float32, error) {
+	if t.pos >= t.end {
+		return nil, io.EOF
+	}
+	if t.restart > 0 && t.epos >= t.restart {
+		if err := t.loop(); err != nil {
+			return nil, err
+		}
+	}
+	if n > t.end-t.pos {
+		n = t.end - t.pos
+	}
+	if t.restart > 0 && n > t.restart-t.epos {
+		n = t.restart - t.epos
+	}
+	// The emulator plays whole stereo frames.
+	if n -= n % defaultChannels; n == 0 {
+		n = defaultChannels
+	}
 	data := make([]int16, n)
 	err := t.g.Play(data)
-	if err != nil && err != io.EOF {
+	if err == io.EOF {
+		// The track ended early, in silence.
+		t.end = t.pos + n
+	} else if err != nil {
 		return nil, err
 	}
 	ret := make([]float32, n)
 	for i, s := range data {
-		ret[i] = (float32(s) - math.MinInt16) / (math.MaxInt16 - math.MinInt16)
+		v := float32(s) / 32768
+		if p := t.pos + i; p >= t.fade {
+			v *= float32(t.end-p) / float32(t.end-t.fade)
+		}
+		ret[i] = v
+	}
+	if t.separation < 1 {
+		for i := 0; i+1 < n; i += 2 {
+			mid := (ret[i] + ret[i+1]) / 2
+			side := (ret[i] - ret[i+1]) / 2 * t.separation
+			ret[i], ret[i+1] = mid+side, mid-side
+		}
 	}
-	return ret, err
+	t.pos += n
+	t.epos += n
+	return ret, nil
 }
@@ -0,0 +1,61 @@
+package gme
+
+import (
+	"fmt"
+	"sync"
+	"time"
+)
+
+// Options are the playback options of game music tracks.
+type Options struct {
+	// Loops is how many times the loop of a track that has one plays
+	// before it fades out.
+	Loops int
+	// Fade is the length of the fade out that ends a track.
+	Fade time.Duration
+	// Separation is the stereo separation, from 0 for mono to 1 for the
+	// full width of the emulated sound chips.
+	Separation float64
+}
+
+const (
+	maxLoops = 100
+	maxFade  = time.Minute
+)
+
+// DefaultOptions are the options tracks play with until SetOptions is called:
+// the emulator's own two loops, eight second fade, and full stereo.
+var DefaultOptions = Options{
+	Loops:      2,
+	Fade:       8 * time.Second,
+	Separation: 1,
+}
+
+var (
+	optionsMu sync.Mutex
+	options   = DefaultOptions
+)
+
+// SetOptions sets the options of tracks started afterward.
+func SetOptions(o Options) error {
+	if o.Loops < 1 || o.Loops > maxLoops {
+		return fmt.Errorf("gme: loops must be between 1 and %d", maxLoops)
+	}
+	if o.Fade < 0 || o.Fade > maxFade {
+		return fmt.Errorf("gme: fade must be between 0 and %v", maxFade)
+	}
+	if o.Separation < 0 || o.Separation > 1 {
+		return fmt.Errorf("gme: separation must be between 0 and 1")
+	}
+	optionsMu.Lock()
+	options = o
+	optionsMu.Unlock()
+	return nil
+}
+
+// GetOptions returns the options tracks play with.
+func GetOptions() Options {
+	optionsMu.Lock()
+	defer optionsMu.Unlock()
+	return options
+}
@@ -1,24 +1,27 @@
 package mpa
 
 import (
+	"bufio"
 	"bytes"
 	"io"
-	"math"
+	"io/ioutil"
 	"time"
 
 	"github.com/dhowden/tag"
 	"github.com/korandiz/mpa"
-	"github.com/korandiz/mpseek"
 	"github.com/mjibson/moggio/codec"
 )
 
 func init() {
 	codec.RegisterCodec("MP3",
-		[]string{"\xff\xfb", string([]byte{49, 44, 33})},
+		// ID3v2 tags and MPEG-1 frames of each layer, with and
+		// without CRCs.
+		[]string{"ID3", "\xff\xfb", "\xff\xfa", "\xff\xfd", "\xff\xfc", "\xff\xff", "\xff\xfe"},
 		[]string{"mp3"},
 		NewSongs,
 		nil,
 	)
+	codec.RegisterSong("MP3", &Song{})
 }
 
 func NewSongs(rf codec.Reader) (codec.Songs, error) {
@@ -35,6 +38,14 @@ type Song struct {
 	decoder *mpa.Decoder
 	buff    [2][]float32
 	info    *codec.SongInfo
+
+	// frames is the decoder's input, vbr its stream's header, and audio
+	// the offset of its first audio frame. size is the file's size, or 0
+	// if unknown.
+	frames *frames
+	vbr    *vbr
+	audio  int64
+	size   int64
 }
 
 func NewSong(rf codec.Reader) (*Song, error) {
@@ -43,11 +54,19 @@ func NewSong(rf codec.Reader) (*Song, error) {
 }
 
 func (s *Song) Init() (sampleRate, channels int, err error) {
-	r, _, err := s.Reader()
+	r, size, err := s.Reader()
+	if err != nil {
+		return 0, 0, err
+	}
+	f := newFrames(r)
+	v, err := readHeader(f)
 	if err != nil {
+		r.Close()
 		return 0, 0, err
 	}
-	s.decoder = &mpa.Decoder{Input: r}
+	s.frames, s.vbr, s.size = f, v, size
+	s.audio = f.off - int64(len(f.frame))
+	s.decoder = &mpa.Decoder{Input: f}
 	s.r = r
 	if err := s.decode(); err != nil {
 		r.Close()
@@ -60,19 +79,145 @@ func (s *Song) Info() (info codec.SongInfo, err error) {
 	if s.info != nil {
 		return *s.info, nil
 	}
-	si, _, b, err := s.Reader.Metadata(tag.MP3)
+	si, _, b, err := s.tagReader().Metadata(tag.MP3)
 	if err != nil {
 		return
 	}
-	table, err := mpseek.CreateTable(bytes.NewReader(b), math.MaxFloat64)
-	if err != nil {
-		return
+	// Variable bitrate streams record their number of frames in a header.
+	f := newFrames(bytes.NewReader(b))
+	if v, err := readHeader(f); err == nil && v.frames > 0 {
+		si.Time = frameTime(f.first, v.frames)
 	}
-	si.Time = time.Duration(table.Length()) * time.Second
 	s.info = si
 	return *si, nil
 }
 
+// tagReader returns the reader of s starting at its ID3v2 tag, since junk
+// can precede it.
+func (s *Song) tagReader() codec.Reader {
+	return func() (io.ReadCloser, int64, error) {
+		r, size, err := s.Reader()
+		if err != nil {
+			return nil, 0, err
+		}
+		br := bufio.NewReaderSize(r, tagSearch)
+		b, _ := br.Peek(tagSearch)
+		if i := findID3v2(b); i > 0 {
+			br.Discard(i)
+			size -= int64(i)
+		}
+		return readCloser{br, r}, size, nil
+	}
+}
+
+type readCloser struct {
+	io.Reader
+	io.Closer
+}
+
+// Duration finds the song's duration from its VBR header or, since MP3
+// doesn't otherwise record it, by counting all its frames.
+func (s *Song) Duration() (time.Duration, error) {
+	r, _, err := s.Reader()
+	if err != nil {
+		return 0, err
+	}
+	defer r.Close()
+	f := newFrames(r)
+	v, err := readHeader(f)
+	if err == io.EOF {
+		return 0, mpa.MalformedStream("no frames found")
+	} else if err != nil {
+		return 0, err
+	}
+	if v.frames > 0 {
+		return frameTime(f.first, v.frames), nil
+	}
+	// A header frame, which has no audio, was dropped.
+	header := int64(0)
+	if f.frame == nil {
+		header = 1
+	}
+	for {
+		if err := f.skip(); err == io.EOF {
+			break
+		} else if err != nil {
+			return 0, err
+		}
+	}
+	return frameTime(f.first, int64(f.n)-header), nil
+}
+
+// Seek continues playback from the entry of the song's table of contents at
+// or before d, or for streams without one the frame found from their
+// bitrate.
+func (s *Song) Seek(d time.Duration) (time.Duration, error) {
+	off, at := s.seekPoint(d)
+	pos := s.frames.start + off
+	if pos < s.audio {
+		pos = s.audio
+	}
+	r, _, err := s.Reader()
+	if err != nil {
+		return 0, err
+	}
+	if sk, ok := r.(io.Seeker); ok {
+		_, err = sk.Seek(pos, io.SeekStart)
+	} else {
+		_, err = io.CopyN(ioutil.Discard, r, pos)
+	}
+	if err != nil {
+		r.Close()
+		return 0, err
+	}
+	f := newFrames(r)
+	f.first, f.free, f.start, f.off = s.frames.first, s.frames.free, s.frames.start, pos
+	// Tables of contents and bitrates only estimate where frames are.
+	f.lost = pos != s.audio
+	s.r.Close()
+	s.r, s.frames = r, f
+	s.decoder = &mpa.Decoder{Input: f}
+	s.buff[0], s.buff[1] = nil, nil
+	return at, nil
+}
+
+// seekPoint returns the offset from the first frame to seek to for d, and
+// its time.
+func (s *Song) seekPoint(d time.Duration) (int64, time.Duration) {
+	h := s.frames.first
+	points := s.vbr.points
+	if points == nil && s.vbr.xing != nil && s.vbr.frames > 0 {
+		size := s.vbr.bytes
+		if size == 0 && s.size > 0 {
+			size = s.size - s.frames.start
+		}
+		dur := frameTime(h, s.vbr.frames)
+		for i, c := range s.vbr.xing {
+			if size == 0 {
+				break
+			}
+			points = append(points, seekPoint{dur * time.Duration(i) / 100, int64(c) * size / 256})
+		}
+	}
+	if len(points) > 0 {
+		p := points[0]
+		for _, q := range points {
+			if q.t > d {
+				break
+			}
+			p = q
+		}
+		return p.off, p.t
+	}
+	// Constant bitrate frames are all about the same size.
+	n := int64(d.Seconds() * float64(h.sampleRate()) / float64(h.samples()))
+	size := h.meanSize(s.frames.free)
+	if s.vbr.frames > 0 && s.vbr.bytes > 0 {
+		size = float64(s.vbr.bytes) / float64(s.vbr.frames)
+	}
+	return int64(float64(n) * size), frameTime(h, n)
+}
+
 func (s *Song) decode() error {
 	s.buff[0] = nil
 	s.buff[1] = nil
@@ -82,6 +227,12 @@ func (s *Song) decode() error {
 			case mpa.MalformedStream:
 				continue
 			}
+			if err == io.ErrUnexpectedEOF {
+				// The last frame of a free format stream, which is
+				// read up to the next frame's header, is complete,
+				// since frames drops truncated frames.
+				err = io.EOF
+			}
 			return err
 		}
 		break
@@ -113,4 +264,5 @@ func (s *Song) Close() {
 		s.r.Close()
 	}
 	s.decoder, s.buff[0], s.buff[1], s.r = nil, nil, nil, nil
+	s.frames, s.vbr = nil, nil
 }
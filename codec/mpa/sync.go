@@ -0,0 +1,297 @@
+package mpa
+
+import (
+	"bufio"
+	"encoding/binary"
+	"io"
+)
+
+const (
+	// syncRun is the number of consecutive frames that must be found where
+	// the stream is synchronized, so false syncwords in junk and tags
+	// aren't taken for frames.
+	syncRun = 3
+	// maxFreeSize bounds the search for the second frame of a free format
+	// stream, whose size isn't in its headers: the largest MPEG-1 frame is
+	// 2880 bytes, of layer III at 640 kbps and 32 kHz.
+	maxFreeSize = 4096
+	// tagSearch is how far into a file an ID3v2 tag is looked for.
+	tagSearch = 64 << 10
+)
+
+// header is an MPEG-1 audio frame header.
+type header uint32
+
+// streamMask masks the fields of a header that are the same for all frames
+// of a stream: the syncword, version, layer, and sample rate.
+const streamMask = 0xfffe0c00
+
+var (
+	bitrates = [3][15]int{
+		{0, 32, 64, 96, 128, 160, 192, 224, 256, 288, 320, 352, 384, 416, 448},
+		{0, 32, 48, 56, 64, 80, 96, 112, 128, 160, 192, 224, 256, 320, 384},
+		{0, 32, 40, 48, 56, 64, 80, 96, 112, 128, 160, 192, 224, 256, 320},
+	}
+	sampleRates = [3]int{44100, 48000, 32000}
+)
+
+// valid reports whether h is an MPEG-1 frame header, the only version the
+// decoder supports.
+func (h header) valid() bool {
+	return h>>20 == 0xfff && h>>19&1 == 1 && h>>17&3 != 0 && h>>12&15 != 15 && h>>10&3 != 3
+}
+
+func (h header) layer() int {
+	return 4 - int(h>>17&3)
+}
+
+// free reports whether h is of a free format frame, whose bitrate isn't one
+// of the standard ones, so its size must be found from the next frame.
+func (h header) free() bool {
+	return h>>12&15 == 0
+}
+
+func (h header) sampleRate() int {
+	return sampleRates[h>>10&3]
+}
+
+func (h header) samples() int {
+	if h.layer() == 1 {
+		return 384
+	}
+	return 1152
+}
+
+// size returns the size of h's frame in bytes. Free format frames are free
+// bytes, plus their padding.
+func (h header) size(free int) int {
+	pad := int(h >> 9 & 1)
+	if h.layer() == 1 {
+		pad *= 4
+	}
+	if h.free() {
+		return free + pad
+	}
+	br := bitrates[h.layer()-1][h>>12&15] * 1000
+	if h.layer() == 1 {
+		return 12*br/h.sampleRate()*4 + pad
+	}
+	return 144*br/h.sampleRate() + pad
+}
+
+// same reports whether h and o are frames of the same stream.
+func (h header) same(o header) bool {
+	return h&streamMask == o&streamMask && h.free() == o.free()
+}
+
+// frames reads the frames of an MPEG audio file, skipping anything else: junk
+// before the first frame, ID3 tags anywhere in the stream, and garbage
+// between frames, after which it resynchronizes on the next run of frames.
+// The decoder, which gives up on free format streams it doesn't find at their
+// start, reads it.
+type frames struct {
+	r *bufio.Reader
+	// first is the header of the first frame, which later ones match, and
+	// free the size of free format frames without padding.
+	first header
+	free  int
+	frame []byte
+	// n is the number of frames read, and off the offset in the file of
+	// the reader's position.
+	n   int
+	off int64
+	// start is the offset of the first frame, and tag the ID3v2 tag before
+	// it, if any.
+	start int64
+	tag   []byte
+	// lost is set when the reader's position is not known to be at a
+	// frame, so a run of frames must be found.
+	lost bool
+}
+
+func newFrames(r io.Reader) *frames {
+	return &frames{r: bufio.NewReaderSize(r, syncRun*maxFreeSize)}
+}
+
+func (f *frames) Read(p []byte) (int, error) {
+	if len(f.frame) == 0 {
+		b, err := f.next()
+		if err != nil {
+			return 0, err
+		}
+		f.frame = b
+	}
+	n := copy(p, f.frame)
+	f.frame = f.frame[n:]
+	return n, nil
+}
+
+// next returns the next frame. A truncated last frame is dropped.
+func (f *frames) next() ([]byte, error) {
+	size, err := f.sync()
+	if err != nil {
+		return nil, err
+	}
+	b := make([]byte, size)
+	n, err := io.ReadFull(f.r, b)
+	f.off += int64(n)
+	if err == io.ErrUnexpectedEOF {
+		return nil, io.EOF
+	} else if err != nil {
+		return nil, err
+	}
+	f.n++
+	return b, nil
+}
+
+// skip skips the next frame, for counting frames without copying them.
+func (f *frames) skip() error {
+	size, err := f.sync()
+	if err != nil {
+		return err
+	}
+	if err := f.discard(size); err != nil {
+		return err
+	}
+	f.n++
+	return nil
+}
+
+func (f *frames) discard(n int) error {
+	d, err := f.r.Discard(n)
+	f.off += int64(d)
+	return err
+}
+
+// sync skips to the next frame and returns its size. Trailing data that
+// isn't a frame ends the stream.
+func (f *frames) sync() (int, error) {
+	resync := f.lost
+	f.lost = false
+	for {
+		b, err := f.r.Peek(10)
+		if len(b) < 4 {
+			if err == nil || err == io.ErrUnexpectedEOF {
+				err = io.EOF
+			}
+			return 0, err
+		}
+		if n := tagSize(b); n > 0 {
+			if f.first == 0 && f.tag == nil && isID3v2(b) {
+				f.tag = make([]byte, n)
+				m, err := io.ReadFull(f.r, f.tag)
+				f.off += int64(m)
+				if err != nil {
+					return 0, err
+				}
+			} else if err := f.discard(n); err != nil {
+				return 0, err
+			}
+			continue
+		}
+		h := header(binary.BigEndian.Uint32(b))
+		// Frames following frames are trusted without looking ahead.
+		if f.first != 0 && !resync && h.valid() && h.same(f.first) {
+			return h.size(f.free), nil
+		}
+		if size, ok := f.check(h); ok {
+			return size, nil
+		}
+		resync = true
+		if err := f.discard(1); err != nil {
+			return 0, err
+		}
+	}
+}
+
+// check reports whether a run of frames starts at the reader's position, whose
+// first four bytes are h, and returns the size of its first frame. Shorter
+// runs are accepted at the end of the stream or before a tag.
+func (f *frames) check(h header) (int, bool) {
+	if !h.valid() || f.first != 0 && !h.same(f.first) {
+		return 0, false
+	}
+	free := f.free
+	if h.free() && free == 0 {
+		if free = f.freeSize(h); free == 0 {
+			return 0, false
+		}
+	}
+	first := h.size(free)
+	off, size := 0, first
+	for i := 1; i < syncRun; i++ {
+		off += size
+		b, _ := f.r.Peek(off + 10)
+		if len(b) < off+4 || tagSize(b[off:]) > 0 {
+			break
+		}
+		n := header(binary.BigEndian.Uint32(b[off:]))
+		if !n.valid() || !n.same(h) {
+			return 0, false
+		}
+		size = n.size(free)
+	}
+	if f.first == 0 {
+		f.first, f.free = h, free
+		f.start = f.off
+	}
+	return first, true
+}
+
+// freeSize returns the size without padding of the free format frame h at
+// the reader's position, from the distance to the next frame, or 0 if there
+// isn't one.
+func (f *frames) freeSize(h header) int {
+	b, _ := f.r.Peek(maxFreeSize + 4)
+	pad := h.size(0)
+	for i := 4; i+4 <= len(b); i++ {
+		n := header(binary.BigEndian.Uint32(b[i:]))
+		if n.valid() && n.same(h) && i > pad {
+			return i - pad
+		}
+	}
+	return 0
+}
+
+// tagSize returns the size of the ID3 tag that b starts with, or 0 if it
+// doesn't.
+func tagSize(b []byte) int {
+	if len(b) >= 3 && string(b[:3]) == "TAG" {
+		return 128
+	}
+	if !isID3v2(b) {
+		return 0
+	}
+	n := 10 + (int(b[6])<<21 | int(b[7])<<14 | int(b[8])<<7 | int(b[9]))
+	if b[5]&0x10 != 0 {
+		// Footer.
+		n += 10
+	}
+	return n
+}
+
+// isID3v2 reports whether b starts with an ID3v2 tag header.
+func isID3v2(b []byte) bool {
+	if len(b) < 10 || string(b[:3]) != "ID3" {
+		return false
+	}
+	if b[3] < 2 || b[3] > 4 || b[4] == 0xff {
+		return false
+	}
+	for _, c := range b[6:10] {
+		if c >= 0x80 {
+			return false
+		}
+	}
+	return true
+}
+
+// findID3v2 returns the offset of the first ID3v2 tag in b, or -1.
+func findID3v2(b []byte) int {
+	for i := 0; i+10 <= len(b); i++ {
+		if isID3v2(b[i:]) {
+			return i
+		}
+	}
+	return -1
+}
@@ -0,0 +1,165 @@
+package mpa
+
+import (
+	"bytes"
+	"encoding/binary"
+	"time"
+
+	"github.com/dhowden/tag"
+)
+
+// seekPoint is an entry of a table of contents: the time of a frame and its
+// offset from the first frame.
+type seekPoint struct {
+	t   time.Duration
+	off int64
+}
+
+// vbr is what an MP3's first frame or tag records about its stream, which
+// variable bitrate streams need for their duration and seeking, since their
+// frames vary in size.
+type vbr struct {
+	// frames is the number of audio frames and bytes their size, or 0 if
+	// unknown.
+	frames int64
+	bytes  int64
+	// xing is the table of contents of a Xing header: the offsets of each
+	// percent of the song, in 256ths of bytes.
+	xing []byte
+	// points is the table of contents of a VBRI header or MLLT frame.
+	points []seekPoint
+}
+
+// frameTime returns the time of frame n of the stream of h.
+func frameTime(h header, n int64) time.Duration {
+	return time.Duration(float64(n) * float64(h.samples()) / float64(h.sampleRate()) * float64(time.Second))
+}
+
+// meanSize returns the mean size in bytes of the frames of a constant bitrate
+// stream of h, some of which are padded to make up a fraction of a byte.
+func (h header) meanSize(free int) float64 {
+	if h.free() {
+		return float64(free)
+	}
+	br := float64(bitrates[h.layer()-1][h>>12&15] * 1000)
+	if h.layer() == 1 {
+		return 48 * br / float64(h.sampleRate())
+	}
+	return 144 * br / float64(h.sampleRate())
+}
+
+// readHeader reads the first frame of f and returns the Xing or VBRI header
+// in it, or the MLLT frame of the tag before it. A header frame is dropped,
+// since it has no audio; an audio frame is left for f's next Read.
+func readHeader(f *frames) (*vbr, error) {
+	b, err := f.next()
+	if err != nil {
+		return nil, err
+	}
+	v := parseVBR(b, f.first)
+	if v == nil {
+		v = new(vbr)
+		f.frame = b
+	}
+	if f.tag != nil {
+		if m, err := tag.ReadID3v2Tags(bytes.NewReader(f.tag)); err == nil {
+			if b, ok := m.Raw()["MLLT"].([]byte); ok {
+				if p := parseMLLT(b); len(p) > 1 {
+					v.points = p
+				}
+			}
+		}
+	}
+	return v, nil
+}
+
+// parseVBR returns the Xing, Info, or VBRI header of the first frame b, whose
+// header is h, or nil if it has none.
+func parseVBR(b []byte, h header) *vbr {
+	// Xing headers follow the side information, which is shorter for
+	// mono.
+	o := 4 + 32
+	if h>>6&3 == 3 {
+		o = 4 + 17
+	}
+	if len(b) >= o+8 && (string(b[o:o+4]) == "Xing" || string(b[o:o+4]) == "Info") {
+		v := new(vbr)
+		flags := binary.BigEndian.Uint32(b[o+4:])
+		p := b[o+8:]
+		if flags&1 != 0 && len(p) >= 4 {
+			v.frames = int64(binary.BigEndian.Uint32(p))
+			p = p[4:]
+		}
+		if flags&2 != 0 && len(p) >= 4 {
+			v.bytes = int64(binary.BigEndian.Uint32(p))
+			p = p[4:]
+		}
+		if flags&4 != 0 && len(p) >= 100 {
+			v.xing = p[:100]
+		}
+		return v
+	}
+	// VBRI headers are at a fixed offset.
+	o = 4 + 32
+	if len(b) < o+26 || string(b[o:o+4]) != "VBRI" {
+		return nil
+	}
+	p := b[o+4:]
+	v := &vbr{
+		bytes:  int64(binary.BigEndian.Uint32(p[6:])),
+		frames: int64(binary.BigEndian.Uint32(p[10:])),
+	}
+	entries := int(binary.BigEndian.Uint16(p[14:]))
+	scale := int64(binary.BigEndian.Uint16(p[16:]))
+	size := int(binary.BigEndian.Uint16(p[18:]))
+	per := int64(binary.BigEndian.Uint16(p[20:]))
+	p = p[22:]
+	if size < 1 || size > 4 {
+		return v
+	}
+	v.points = []seekPoint{{}}
+	var off int64
+	for i := 1; i <= entries && len(p) >= size; i++ {
+		var e int64
+		for _, c := range p[:size] {
+			e = e<<8 | int64(c)
+		}
+		p = p[size:]
+		off += e * scale
+		v.points = append(v.points, seekPoint{frameTime(h, int64(i)*per), off})
+	}
+	return v
+}
+
+// parseMLLT returns the table of contents of the ID3v2 MPEG location lookup
+// table frame b, whose references are each a number of bytes and
+// milliseconds after the last, plus deviations packed in bits.
+func parseMLLT(b []byte) []seekPoint {
+	if len(b) < 10 {
+		return nil
+	}
+	bytesBetween := int64(b[2])<<16 | int64(b[3])<<8 | int64(b[4])
+	msBetween := int64(b[5])<<16 | int64(b[6])<<8 | int64(b[7])
+	byteBits, msBits := uint(b[8]), uint(b[9])
+	if byteBits > 32 || msBits > 32 || byteBits+msBits == 0 {
+		return nil
+	}
+	b = b[10:]
+	pos := uint(0)
+	read := func(n uint) int64 {
+		var v int64
+		for i := uint(0); i < n; i++ {
+			v = v<<1 | int64(b[pos/8]>>(7-pos%8)&1)
+			pos++
+		}
+		return v
+	}
+	points := []seekPoint{{}}
+	var off, ms int64
+	for pos+byteBits+msBits <= uint(len(b))*8 {
+		off += bytesBetween + read(byteBits)
+		ms += msBetween + read(msBits)
+		points = append(points, seekPoint{time.Duration(ms) * time.Millisecond, off})
+	}
+	return points
+}
@@ -5,6 +5,8 @@ package nsf
 import (
 	"fmt"
 	"strconv"
+	"sync"
+	"time"
 
 	"github.com/mjibson/moggio/codec"
 	"github.com/mjibson/nsf"
@@ -13,6 +15,40 @@ import (
 func init() {
 	codec.RegisterCodec("NSF", []string{"NESM\u001a"}, []string{"nsf"}, ReadNSFSongs, Get)
 	codec.RegisterCodec("NSFE", []string{"NSFE"}, []string{"nsfe"}, ReadNSFSongs, Get)
+	codec.RegisterSong("NSF", &NSFSong{})
+	codec.RegisterSong("NSFE", &NSFSong{})
+
+	codec.RegisterOptions("NSF", []string{"NSF", "NSFE"}, []codec.Option{
+		{
+			Name:        "length",
+			Description: "Length of songs that don't give their own, in seconds",
+			Default:     nsf.DefaultDuration.Seconds(),
+			Min:         10,
+			Max:         3600,
+		},
+	}, func(v map[string]float64) error {
+		lengthMu.Lock()
+		length = time.Duration(v["length"] * float64(time.Second))
+		lengthMu.Unlock()
+		return nil
+	})
+}
+
+var (
+	lengthMu sync.Mutex
+	// length is the length of songs without their own, which NSF files
+	// don't have and NSFE files may not.
+	length = nsf.DefaultDuration
+)
+
+// duration returns the length s plays for.
+func duration(s nsf.Song) time.Duration {
+	if s.Duration != nsf.DefaultDuration {
+		return s.Duration
+	}
+	lengthMu.Lock()
+	defer lengthMu.Unlock()
+	return length
 }
 
 func ReadNSFSongs(rf codec.Reader) (codec.Songs, error) {
@@ -66,7 +102,16 @@ func (n *NSFSong) Init() (sampleRate, channels int, err error) {
 			return 0, 0, err
 		}
 	}
-	n.NSF.Init(n.Index)
+	if i := n.Index - 1; i >= 0 && i < len(n.NSF.Songs) {
+		// Init plays songs for their duration, which is set for this
+		// play only.
+		s := n.NSF.Songs[i]
+		n.NSF.Songs[i].Duration = duration(s)
+		n.NSF.Init(n.Index)
+		n.NSF.Songs[i] = s
+	} else {
+		n.NSF.Init(n.Index)
+	}
 	n.Playing = true
 	return int(n.NSF.SampleRate), 1, nil
 }
@@ -93,15 +138,18 @@ func (n *NSFSong) Info() (si codec.SongInfo, err error) {
 			return si, err
 		}
 	}
-	s := n.NSF.Songs[n.Index-1]
+	if n.Index < 1 || n.Index > len(ns.Songs) {
+		return si, fmt.Errorf("nsf: no song %d", n.Index)
+	}
+	s := ns.Songs[n.Index-1]
 	title := s.Name
 	if title == "" {
-		title = fmt.Sprintf("%s:%02d", n.NSF.Game, n.Index)
+		title = fmt.Sprintf("%s:%02d", ns.Game, n.Index)
 	}
 	si = codec.SongInfo{
-		Time:   s.Duration,
-		Artist: n.NSF.Artist,
-		Album:  n.NSF.Game,
+		Time:   duration(s),
+		Artist: ns.Artist,
+		Album:  ns.Game,
 		Track:  float64(n.Index),
 		Title:  title,
 	}
@@ -0,0 +1,109 @@
+package codec
+
+import (
+	"fmt"
+	"sort"
+	"sync"
+)
+
+// Option is a playback option of codecs, like the loop count of game music,
+// tunable without recompiling. Values are numbers, durations in seconds.
+type Option struct {
+	Name        string
+	Description string
+	Default     float64
+	Min, Max    float64
+}
+
+// Options are the options of a codec package and their values.
+type Options struct {
+	// Name is the name the options were registered with. Codecs are the
+	// codecs they apply to.
+	Name    string
+	Codecs  []string
+	Options []Option
+	Values  map[string]float64
+}
+
+type options struct {
+	Options
+	set func(map[string]float64) error
+}
+
+var (
+	optionsMu    sync.Mutex
+	codecOptions = make(map[string]*options)
+)
+
+// RegisterOptions registers the options of the codecs, under name. Set is
+// called with the value of each option when they change.
+func RegisterOptions(name string, codecs []string, opts []Option, set func(values map[string]float64) error) {
+	optionsMu.Lock()
+	defer optionsMu.Unlock()
+	if _, ok := codecOptions[name]; ok {
+		panic(fmt.Errorf("%v options already registered", name))
+	}
+	o := &options{
+		Options: Options{
+			Name:    name,
+			Codecs:  codecs,
+			Options: opts,
+			Values:  make(map[string]float64),
+		},
+		set: set,
+	}
+	for _, opt := range opts {
+		o.Values[opt.Name] = opt.Default
+	}
+	codecOptions[name] = o
+}
+
+// ListOptions returns the registered options, sorted by name.
+func ListOptions() []Options {
+	optionsMu.Lock()
+	defer optionsMu.Unlock()
+	var list []Options
+	for _, o := range codecOptions {
+		l := o.Options
+		l.Values = make(map[string]float64)
+		for k, v := range o.Values {
+			l.Values[k] = v
+		}
+		list = append(list, l)
+	}
+	sort.Slice(list, func(i, j int) bool {
+		return list[i].Name < list[j].Name
+	})
+	return list
+}
+
+// SetOptions sets the options registered under name to values, and the ones
+// not in values to their defaults.
+func SetOptions(name string, values map[string]float64) error {
+	optionsMu.Lock()
+	defer optionsMu.Unlock()
+	o, ok := codecOptions[name]
+	if !ok {
+		return fmt.Errorf("unknown codec options: %s", name)
+	}
+	set := make(map[string]float64)
+	for _, opt := range o.Options.Options {
+		v, ok := values[opt.Name]
+		if !ok {
+			v = opt.Default
+		} else if v < opt.Min || v > opt.Max {
+			return fmt.Errorf("%s %s must be between %v and %v", name, opt.Name, opt.Min, opt.Max)
+		}
+		set[opt.Name] = v
+	}
+	for k := range values {
+		if _, ok := set[k]; !ok {
+			return fmt.Errorf("unknown %s option: %s", name, k)
+		}
+	}
+	if err := o.set(set); err != nil {
+		return err
+	}
+	o.Values = set
+	return nil
+}
@@ -0,0 +1,113 @@
+package codec
+
+import (
+	"fmt"
+	"reflect"
+	"sort"
+	"sync"
+	"time"
+)
+
+// Codec describes a registered codec, for diagnostics.
+type Codec struct {
+	Name       string
+	Magic      []string
+	Extensions []string
+	// Song is the type of the codec's songs, or "" if it isn't registered,
+	// in which case the capabilities below are unknown and false.
+	Song string
+	// Seek reports whether its songs seek without decoding the audio
+	// before the position, ScanDuration whether their durations must be
+	// found by decoding them entirely, and Verify whether they check
+	// their audio against checksums.
+	Seek         bool
+	ScanDuration bool
+	Verify       bool
+	// Errors is the number of decode errors since start, of which Recent
+	// are the latest. Codecs sharing a song type share their errors.
+	Errors int
+	Recent []DecodeError
+}
+
+// DecodeError is an error decoding a song.
+type DecodeError struct {
+	Time  time.Time
+	Error string
+}
+
+// recentErrors is the number of decode errors kept of each codec.
+const recentErrors = 10
+
+// decodeErrors are the decode errors of a song type.
+type decodeErrors struct {
+	sync.Mutex
+	n      int
+	recent []DecodeError
+}
+
+func (e *decodeErrors) add(err error) {
+	e.Lock()
+	defer e.Unlock()
+	e.n++
+	e.recent = append(e.recent, DecodeError{
+		Time:  time.Now(),
+		Error: err.Error(),
+	})
+	if n := len(e.recent) - recentErrors; n > 0 {
+		e.recent = append(e.recent[:0], e.recent[n:]...)
+	}
+}
+
+// songTypes are the decode errors of registered song types.
+var songTypes = make(map[reflect.Type]*decodeErrors)
+
+// RegisterSong registers the type of the songs of the codec name, whose
+// methods tell its capabilities, and to which errors reported by ReportError
+// are attributed.
+func RegisterSong(name string, song Song) {
+	c, ok := codecs[name]
+	if !ok {
+		panic(fmt.Errorf("%v not registered", name))
+	}
+	t := reflect.TypeOf(song)
+	if e, ok := songTypes[t]; ok {
+		c.errors = e
+	} else {
+		songTypes[t] = c.errors
+	}
+	c.song = song
+}
+
+// ReportError records err, an error playing song, against its codec.
+func ReportError(song Song, err error) {
+	if e, ok := songTypes[reflect.TypeOf(song)]; ok {
+		e.add(err)
+	}
+}
+
+// Codecs returns the registered codecs, sorted by name.
+func Codecs() []Codec {
+	var list []Codec
+	for _, c := range codecs {
+		d := Codec{
+			Name:       c.name,
+			Magic:      c.magic,
+			Extensions: c.extensions,
+		}
+		if c.song != nil {
+			d.Song = reflect.TypeOf(c.song).String()
+			_, d.Seek = c.song.(Seeker)
+			_, d.ScanDuration = c.song.(Durationer)
+			_, d.Verify = c.song.(Verifier)
+		}
+		c.errors.Lock()
+		d.Errors = c.errors.n
+		d.Recent = append([]DecodeError(nil), c.errors.recent...)
+		c.errors.Unlock()
+		list = append(list, d)
+	}
+	sort.Slice(list, func(i, j int) bool {
+		return list[i].Name < list[j].Name
+	})
+	return list
+}
@@ -1,6 +1,9 @@
 package codec
 
-import "time"
+import (
+	"sync"
+	"time"
+)
 
 type Song interface {
 	// Info returns information about a song.
@@ -16,15 +19,120 @@ type Song interface {
 	Close()
 }
 
+// A BitDepther is a Song decoded from integer samples of a known bit depth.
+// It is used to send lossless audio to the device at its native depth.
+type BitDepther interface {
+	// BitDepth returns the depth of the source samples, valid after Init.
+	BitDepth() int
+}
+
+// A Durationer is a Song whose format doesn't record its duration, so it
+// must be found by scanning the whole song. Its Info leaves Time 0, and
+// Duration finds it.
+type Durationer interface {
+	Duration() (time.Duration, error)
+}
+
+// A Verifier is a Song whose format records a checksum of its audio, like
+// FLAC's MD5. Once the song is played to its end from its start, Verified
+// reports whether the decoded audio matched; ok is false before then, or if
+// the song has no checksum. It may be called concurrently with Play.
+type Verifier interface {
+	Verified() (match, ok bool)
+}
+
+// A Seeker is a Song that can continue playing from a position without
+// decoding the audio before it, like an MP3 with a table of contents. Seek
+// returns the position Play continues from, at or before d. It isn't called
+// concurrently with Play.
+type Seeker interface {
+	Seek(d time.Duration) (time.Duration, error)
+}
+
+// A StreamTitler is a Song whose stream names the song playing in it, which
+// can change during playback, like a chained Ogg stream of Internet radio.
+// It may be called concurrently with Play.
+type StreamTitler interface {
+	// StreamTitle returns the title of the song playing, or "" if unknown.
+	StreamTitle() string
+}
+
 type SongInfo struct {
 	Time     time.Duration
 	Artist   string
 	Title    string
 	Album    string
 	Track    float64
+	Genre    string `json:",omitempty"`
 	ImageURL string `json:",omitempty"`
+	// AlbumArtist is the artist of the song's album, if tagged, and
+	// Compilation set if the album is by various artists.
+	AlbumArtist string `json:",omitempty"`
+	Compilation bool   `json:",omitempty"`
+	// Explicit is set if the song is tagged with explicit content.
+	Explicit bool `json:",omitempty"`
+	// Disc is the song's disc of a multi-disc album, of Discs, and
+	// DiscSubtitle the disc's title.
+	Disc         int    `json:",omitempty"`
+	Discs        int    `json:",omitempty"`
+	DiscSubtitle string `json:",omitempty"`
+	// Date is the release date, like "2011", "2011-03", or "2011-03-14", and
+	// Year its year. OriginalDate and OriginalYear are of the first release,
+	// if the song was reissued.
+	Date         string `json:",omitempty"`
+	Year         int    `json:",omitempty"`
+	OriginalDate string `json:",omitempty"`
+	OriginalYear int    `json:",omitempty"`
+	// TrackGain and AlbumGain are the ReplayGain adjustments in dB.
+	TrackGain float64 `json:",omitempty"`
+	AlbumGain float64 `json:",omitempty"`
+	// BPM is the tempo in beats per minute and Key the musical key, like
+	// "A" or "F#m", as found by analysis.
+	BPM float64 `json:",omitempty"`
+	Key string  `json:",omitempty"`
+	// Featured are the featured artists, moved from the artist or title by
+	// tag rules.
+	Featured string `json:",omitempty"`
+	// Composer, Work, and Movement are of classical music: the work a song
+	// is a movement of, and its name and number in the work.
+	Composer       string `json:",omitempty"`
+	Work           string `json:",omitempty"`
+	Movement       string `json:",omitempty"`
+	MovementNumber int    `json:",omitempty"`
 
 	// SongTitle, if set, is the currently playing song title. Needed for
 	// streaming.
 	SongTitle string
 }
+
+// Compact interns the strings of si that many songs share, so large
+// libraries hold one copy of each artist, album, and genre.
+func (si *SongInfo) Compact() {
+	si.Artist = Intern(si.Artist)
+	si.Album = Intern(si.Album)
+	si.AlbumArtist = Intern(si.AlbumArtist)
+	si.Composer = Intern(si.Composer)
+	si.Work = Intern(si.Work)
+	si.Genre = Intern(si.Genre)
+	si.Key = Intern(si.Key)
+}
+
+var interned = struct {
+	sync.Mutex
+	m map[string]string
+}{m: make(map[string]string)}
+
+// Intern returns a string equal to s, sharing memory with earlier equal
+// strings.
+func Intern(s string) string {
+	if s == "" {
+		return ""
+	}
+	interned.Lock()
+	defer interned.Unlock()
+	if i, ok := interned.m[s]; ok {
+		return i
+	}
+	interned.m[s] = s
+	return s
+}
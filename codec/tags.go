@@ -0,0 +1,142 @@
+package codec
+
+import (
+	"fmt"
+	"regexp"
+	"strconv"
+	"strings"
+
+	"github.com/dhowden/tag"
+)
+
+// RawTag returns the value of the named tag from m's raw tags, or "" if not
+// present. Name is matched case insensitively against Vorbis comments, MP4
+// atoms, and ID3v2 frame IDs or TXXX descriptions.
+func RawTag(m tag.Metadata, name string) string {
+	for k, v := range m.Raw() {
+		switch v := v.(type) {
+		case string:
+			if strings.EqualFold(k, name) {
+				return v
+			}
+		case *tag.Comm:
+			if strings.EqualFold(v.Description, name) {
+				return v.Text
+			}
+		}
+	}
+	return ""
+}
+
+// firstTag returns the value of the first of the named tags of m present.
+func firstTag(m tag.Metadata, names ...string) string {
+	for _, n := range names {
+		if v := strings.TrimSpace(RawTag(m, n)); v != "" {
+			return v
+		}
+	}
+	return ""
+}
+
+// ClassicalTags sets the composer, work, and movement of si from the tags
+// of m: Vorbis comments, iTunes atoms, or ID3v2 frames.
+func ClassicalTags(m tag.Metadata, si *SongInfo) {
+	si.Composer = m.Composer()
+	si.Work = firstTag(m, "WORK", "\xa9wrk")
+	si.Movement = firstTag(m, "MOVEMENTNAME", "MVNM", "\xa9mvn")
+	si.MovementNumber = ParseNumber(firstTag(m, "MOVEMENT", "MVIN", "\xa9mvi"))
+}
+
+// DateTags sets the release and original release dates of si from the tags
+// of m: full dates of ID3v2.4 and Vorbis comments, or years and the day of
+// ID3v2.3.
+func DateTags(m tag.Metadata, si *SongInfo) {
+	date := firstTag(m, "TDRC", "DATE", "\xa9day", "TYER", "TYE", "YEAR")
+	// TDAT is the DDMM of TYER.
+	if d := RawTag(m, "TDAT"); len(date) == 4 && len(d) == 4 {
+		date += "-" + d[2:] + "-" + d[:2]
+	}
+	si.Date, si.Year = ParseDate(date)
+	si.OriginalDate, si.OriginalYear = ParseDate(firstTag(m, "TDOR", "ORIGINALDATE", "TORY", "TOR", "ORIGINALYEAR"))
+}
+
+// dateRE matches the start of dates like "2011", "2011-03", or
+// "2011-03-14T08:00:00Z".
+var dateRE = regexp.MustCompile(`^(\d{4})(?:[-/.](\d{1,2})(?:[-/.](\d{1,2}))?)?`)
+
+// ParseDate parses a tagged date, returning it as "2011", "2011-03", or
+// "2011-03-14", and its year, or "" and 0.
+func ParseDate(s string) (string, int) {
+	m := dateRE.FindStringSubmatch(strings.TrimSpace(s))
+	if m == nil {
+		return "", 0
+	}
+	year, _ := strconv.Atoi(m[1])
+	if year == 0 {
+		return "", 0
+	}
+	date := m[1]
+	if month, _ := strconv.Atoi(m[2]); month >= 1 && month <= 12 {
+		date += fmt.Sprintf("-%02d", month)
+		if day, _ := strconv.Atoi(m[3]); day >= 1 && day <= 31 {
+			date += fmt.Sprintf("-%02d", day)
+		}
+	}
+	return date, year
+}
+
+// DiscTags sets the disc number, total, and subtitle of si from the tags of
+// m.
+func DiscTags(m tag.Metadata, si *SongInfo) {
+	si.Disc, si.Discs = m.Disc()
+	si.DiscSubtitle = RawTag(m, "DISCSUBTITLE")
+	if si.DiscSubtitle == "" {
+		si.DiscSubtitle = RawTag(m, "TSST")
+	}
+}
+
+// ParseNumber parses the number of a track, disc, or movement, like "2" or
+// "2/4", or returns 0.
+func ParseNumber(s string) int {
+	if i := strings.IndexByte(s, '/'); i >= 0 {
+		s = s[:i]
+	}
+	n, _ := strconv.Atoi(strings.TrimSpace(s))
+	return n
+}
+
+// IsExplicit reports whether m has the iTunes advisory of explicit content.
+func IsExplicit(m tag.Metadata) bool {
+	switch firstTag(m, "ITUNESADVISORY") {
+	case "1", "4":
+		return true
+	}
+	return false
+}
+
+// IsCompilation reports whether m has the compilation flag, set by iTunes
+// and others on songs of albums by various artists.
+func IsCompilation(m tag.Metadata) bool {
+	for k, v := range m.Raw() {
+		switch strings.ToUpper(k) {
+		case "TCMP", "TCP", "CPIL", "COMPILATION":
+		default:
+			continue
+		}
+		switch v := v.(type) {
+		case int:
+			return v != 0
+		case string:
+			return strings.Trim(v, " \x00") == "1"
+		}
+	}
+	return false
+}
+
+// ParseGain parses a ReplayGain value like "-6.20 dB".
+func ParseGain(s string) float64 {
+	s = strings.TrimSpace(s)
+	s = strings.TrimSuffix(strings.TrimSuffix(s, "dB"), "db")
+	f, _ := strconv.ParseFloat(strings.TrimSpace(s), 64)
+	return f
+}
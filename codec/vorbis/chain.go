@@ -0,0 +1,98 @@
+package vorbis
+
+import (
+	"bufio"
+	"encoding/binary"
+	"io"
+)
+
+// Ogg page header fields.
+const (
+	pageHeaderSize = 27
+	pageBOS        = 2
+)
+
+// chain reads a chained Ogg stream, like those of Internet radio which start
+// a new logical stream, with new headers, at each song, one logical stream at
+// a time. It ends with io.EOF before the first page of the next logical
+// stream, which next makes readable.
+type chain struct {
+	r       *bufio.Reader
+	page    []byte
+	serial  uint32
+	started bool
+	// chained is set when the current logical stream has ended at the
+	// start of another.
+	chained bool
+}
+
+func newChain(r io.Reader) *chain {
+	return &chain{r: bufio.NewReader(r)}
+}
+
+func (c *chain) Read(p []byte) (int, error) {
+	if len(c.page) == 0 {
+		if c.chained {
+			return 0, io.EOF
+		}
+		if err := c.readPage(); err != nil {
+			return 0, err
+		}
+	}
+	n := copy(p, c.page)
+	c.page = c.page[n:]
+	return n, nil
+}
+
+// readPage reads the next page into c.page, unless it begins another logical
+// stream. Data that isn't an Ogg page is passed through, for the decoder to
+// report.
+func (c *chain) readPage() error {
+	h, err := c.r.Peek(pageHeaderSize)
+	if err != nil || string(h[:4]) != "OggS" {
+		c.page = make([]byte, c.r.Buffered())
+		if len(c.page) == 0 {
+			c.page = make([]byte, 1)
+		}
+		n, rerr := c.r.Read(c.page)
+		c.page = c.page[:n]
+		if n > 0 {
+			return nil
+		}
+		return rerr
+	}
+	flags := h[5]
+	serial := binary.LittleEndian.Uint32(h[14:18])
+	segments := int(h[26])
+	if c.started && flags&pageBOS != 0 && serial != c.serial {
+		c.chained = true
+		return io.EOF
+	}
+	c.started = true
+	c.serial = serial
+	h, err = c.r.Peek(pageHeaderSize + segments)
+	if err != nil {
+		return err
+	}
+	size := pageHeaderSize + segments
+	for _, s := range h[pageHeaderSize:] {
+		size += int(s)
+	}
+	c.page = make([]byte, size)
+	_, err = io.ReadFull(c.r, c.page)
+	if err == io.EOF {
+		err = io.ErrUnexpectedEOF
+	}
+	return err
+}
+
+// next reports whether another logical stream follows the current one and,
+// if so, makes it readable.
+func (c *chain) next() bool {
+	if !c.chained {
+		return false
+	}
+	c.chained = false
+	c.started = false
+	return true
+}
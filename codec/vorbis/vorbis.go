@@ -2,7 +2,10 @@ package vorbis
 
 import (
 	"bytes"
+	"fmt"
 	"io"
+	"strings"
+	"sync"
 	"time"
 
 	"github.com/dhowden/tag"
@@ -13,6 +16,7 @@ import (
 
 func init() {
 	codec.RegisterCodec("VORBIS", []string{"OggS"}, []string{"ogg"}, NewSongs, nil)
+	codec.RegisterSong("VORBIS", &Vorbis{})
 }
 
 func NewSongs(rf codec.Reader) (codec.Songs, error) {
@@ -34,23 +38,35 @@ type Vorbis struct {
 	Reader  codec.Reader
 	r       io.ReadCloser
 	v       *vorbis.Vorbis
+	chain   *chain
 	samples []float32
 	info    *codec.SongInfo
+
+	// mu guards title, the song playing in a stream, from its comments.
+	mu    sync.Mutex
+	title string
 }
 
 func (v *Vorbis) Init() (sampleRate, channels int, err error) {
 	if v.v == nil {
-		r, _, err := v.Reader()
+		r, size, err := v.Reader()
 		if err != nil {
 			return 0, 0, err
 		}
-		vr, err := vorbis.Open(r)
+		c := newChain(r)
+		vr, err := vorbis.Open(c)
 		if err != nil {
 			r.Close()
 			return 0, 0, err
 		}
 		v.r = r
 		v.v = vr
+		v.chain = c
+		// Files are named by their tags, but streams only by their
+		// comments.
+		if size == 0 {
+			v.setTitle(vr.Comments())
+		}
 	}
 	return v.v.SampleRate(), v.v.Channels(), nil
 }
@@ -82,15 +98,24 @@ func (v *Vorbis) Play(n int) ([]float32, error) {
 	var samples [][]float32
 	for len(v.samples) < n && err == nil {
 		samples, err = v.v.DecodePacket()
+		if err == io.EOF && v.chain.next() {
+			err = v.openNext()
+			continue
+		}
 		if len(samples) == 0 {
 			break
 		}
 		n := len(samples[0])
 		c := len(samples)
 		data := make([]float32, c*n)
+		order := vorbisOrder[c]
 		for i, cs := range samples {
+			o := i
+			if order != nil {
+				o = order[i]
+			}
 			for j, s := range cs {
-				data[j*c+i] = s
+				data[j*c+o] = s
 			}
 		}
 		v.samples = append(v.samples, data...)
@@ -103,10 +128,72 @@ func (v *Vorbis) Play(n int) ([]float32, error) {
 	return ret, err
 }
 
+// openNext continues decoding with the next logical stream of a chained
+// stream, which has its own headers, and takes the song's title from its
+// comments.
+func (v *Vorbis) openNext() error {
+	vr, err := vorbis.Open(v.chain)
+	if err != nil {
+		return err
+	}
+	if vr.SampleRate() != v.v.SampleRate() || vr.Channels() != v.v.Channels() {
+		return fmt.Errorf("vorbis: chained stream changed format from %d Hz, %d channels to %d Hz, %d channels",
+			v.v.SampleRate(), v.v.Channels(), vr.SampleRate(), vr.Channels())
+	}
+	v.v = vr
+	v.setTitle(vr.Comments())
+	return nil
+}
+
+// setTitle sets the title of the song playing from the comments of its
+// logical stream, as "artist - title" like ICY stream titles.
+func (v *Vorbis) setTitle(comments []string) {
+	var artist, title string
+	for _, c := range comments {
+		sp := strings.SplitN(c, "=", 2)
+		if len(sp) != 2 {
+			continue
+		}
+		switch strings.ToUpper(sp[0]) {
+		case "ARTIST":
+			artist = sp[1]
+		case "TITLE":
+			title = sp[1]
+		}
+	}
+	if artist != "" && title != "" {
+		title = artist + " - " + title
+	} else if title == "" {
+		title = artist
+	}
+	v.mu.Lock()
+	v.title = title
+	v.mu.Unlock()
+}
+
+// StreamTitle returns the title of the song playing in a stream, which
+// changes as a chained stream continues.
+func (v *Vorbis) StreamTitle() string {
+	v.mu.Lock()
+	defer v.mu.Unlock()
+	return v.title
+}
+
+// vorbisOrder maps the Vorbis channel order of multichannel streams to WAVE
+// order: for each channel count, the WAVE position of each Vorbis channel.
+var vorbisOrder = map[int][]int{
+	3: {0, 2, 1},
+	5: {0, 2, 1, 3, 4},
+	6: {0, 2, 1, 4, 5, 3},
+	7: {0, 2, 1, 5, 6, 4, 3},
+	8: {0, 2, 1, 6, 7, 4, 5, 3},
+}
+
 func (v *Vorbis) Close() {
 	if v.r != nil {
 		v.r.Close()
 		v.r = nil
 	}
 	v.v = nil
+	v.chain = nil
 }
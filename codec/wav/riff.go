@@ -0,0 +1,314 @@
+package mpa
+
+import (
+	"bytes"
+	"encoding/binary"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"math"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/dhowden/tag"
+	"github.com/mjibson/moggio/codec"
+)
+
+// Audio formats of the fmt chunk. Extensible formats give theirs in the
+// first two bytes of their sub format GUID.
+const (
+	formatPCM        = 1
+	formatFloat      = 3
+	formatExtensible = 0xfffe
+)
+
+// format is the fmt chunk of a WAV file.
+type format struct {
+	code       uint16
+	channels   int
+	sampleRate int
+	blockAlign int
+	// bits is the size of each sample's container, and valid the bits of
+	// it used, which for extensible formats can be fewer.
+	bits  int
+	valid int
+	// mask has a bit set for each speaker position of the channels of
+	// extensible formats. Channels are in the order of the bits, which is
+	// the order played, so they need no reordering.
+	mask uint32
+}
+
+func parseFormat(b []byte) (*format, error) {
+	if len(b) < 16 {
+		return nil, fmt.Errorf("wav: bad fmt size")
+	}
+	le := binary.LittleEndian
+	f := &format{
+		code:       le.Uint16(b),
+		channels:   int(le.Uint16(b[2:])),
+		sampleRate: int(le.Uint32(b[4:])),
+		blockAlign: int(le.Uint16(b[12:])),
+		bits:       int(le.Uint16(b[14:])),
+	}
+	f.valid = f.bits
+	if f.code == formatExtensible {
+		if len(b) < 40 {
+			return nil, fmt.Errorf("wav: bad extensible fmt size")
+		}
+		if v := int(le.Uint16(b[18:])); v > 0 && v <= f.bits {
+			f.valid = v
+		}
+		f.mask = le.Uint32(b[20:])
+		f.code = le.Uint16(b[24:])
+	}
+	if f.channels == 0 || f.sampleRate == 0 {
+		return nil, fmt.Errorf("wav: no channels or sample rate")
+	}
+	size := f.bits / 8
+	switch {
+	case f.code == formatPCM && size >= 1 && size <= 4:
+	case f.code == formatFloat && (size == 4 || size == 8):
+	default:
+		return nil, fmt.Errorf("wav: unsupported format %#x of %d bits", f.code, f.bits)
+	}
+	if f.bits%8 != 0 || f.blockAlign < size*f.channels {
+		return nil, fmt.Errorf("wav: bad block align %d for %d channels of %d bits", f.blockAlign, f.channels, f.bits)
+	}
+	return f, nil
+}
+
+// decode converts the samples of the whole blocks of b to floats.
+func (f *format) decode(b []byte) []float32 {
+	size := f.bits / 8
+	blocks := len(b) / f.blockAlign
+	out := make([]float32, 0, blocks*f.channels)
+	for i := 0; i < blocks; i++ {
+		block := b[i*f.blockAlign:]
+		for c := 0; c < f.channels; c++ {
+			s := block[c*size : (c+1)*size]
+			var v float32
+			switch {
+			case f.code == formatFloat && size == 4:
+				v = math.Float32frombits(binary.LittleEndian.Uint32(s))
+			case f.code == formatFloat:
+				v = float32(math.Float64frombits(binary.LittleEndian.Uint64(s)))
+			case size == 1:
+				// 8-bit samples are unsigned.
+				v = float32(int(s[0])-128) / 128
+			default:
+				// Sign extend from the top byte; valid bits are the
+				// most significant.
+				n := int32(int8(s[size-1]))
+				for j := size - 2; j >= 0; j-- {
+					n = n<<8 | int32(s[j])
+				}
+				v = float32(float64(n) / float64(int64(1)<<uint(f.bits-1)))
+			}
+			out = append(out, v)
+		}
+	}
+	return out
+}
+
+// sizeUnknown is the size of a data chunk of a file still being written, or
+// streamed, which goes to the end of the file.
+const sizeUnknown = 0xffffffff
+
+// readChunks reads the chunks of the WAV file r, calling fn with each. The
+// rest of a chunk fn doesn't read is skipped, unless fn returns stop.
+func readChunks(r io.Reader, fn func(id string, size int64, r io.Reader) (stop bool, err error)) error {
+	b := make([]byte, 12)
+	if _, err := io.ReadFull(r, b); err != nil {
+		return err
+	}
+	if string(b[:4]) != "RIFF" || string(b[8:12]) != "WAVE" {
+		return fmt.Errorf("wav: missing RIFF WAVE header")
+	}
+	for {
+		if _, err := io.ReadFull(r, b[:8]); err == io.EOF {
+			return nil
+		} else if err != nil {
+			return err
+		}
+		id := string(b[:4])
+		size := int64(binary.LittleEndian.Uint32(b[4:]))
+		lr := &io.LimitedReader{R: r, N: size}
+		if size == sizeUnknown && id == "data" {
+			lr.N = 1<<63 - 1
+		}
+		stop, err := fn(id, size, lr)
+		if err != nil || stop {
+			return err
+		}
+		// Chunks are padded to an even size.
+		n := lr.N + size%2
+		if s, ok := r.(io.Seeker); ok {
+			_, err = s.Seek(n, io.SeekCurrent)
+		} else {
+			_, err = io.CopyN(ioutil.Discard, r, n)
+		}
+		if err == io.EOF {
+			return nil
+		} else if err != nil {
+			return err
+		}
+	}
+}
+
+// readInfo returns the info of the WAV file r from its format, the size of
+// its data, and its metadata: ID3 tags, LIST INFO chunks, and the broadcast
+// extension's bext chunk, in that order of precedence.
+func readInfo(r io.Reader) (*codec.SongInfo, error) {
+	var f *format
+	var data int64
+	var id3, list, bext codec.SongInfo
+	err := readChunks(r, func(id string, size int64, r io.Reader) (bool, error) {
+		switch id {
+		case "fmt ", "LIST", "bext", "id3 ", "ID3 ":
+		case "data":
+			data = size
+			return false, nil
+		default:
+			return false, nil
+		}
+		b, err := ioutil.ReadAll(r)
+		if err != nil {
+			return false, err
+		}
+		switch id {
+		case "fmt ":
+			f, err = parseFormat(b)
+		case "LIST":
+			parseListInfo(b, &list)
+		case "bext":
+			parseBext(b, &bext)
+		default:
+			if m, err := tag.ReadID3v2Tags(bytes.NewReader(b)); err == nil {
+				track, _ := m.Track()
+				id3 = codec.SongInfo{
+					Artist: m.Artist(),
+					Title:  m.Title(),
+					Album:  m.Album(),
+					Track:  float64(track),
+					Genre:  m.Genre(),
+
+					AlbumArtist: m.AlbumArtist(),
+					Compilation: codec.IsCompilation(m),
+					Explicit:    codec.IsExplicit(m),
+				}
+				codec.ClassicalTags(m, &id3)
+				codec.DiscTags(m, &id3)
+				codec.DateTags(m, &id3)
+			}
+		}
+		return false, err
+	})
+	if err != nil {
+		return nil, err
+	}
+	if f == nil {
+		return nil, fmt.Errorf("wav: missing fmt chunk")
+	}
+	info := merge(id3, list, bext)
+	if data != sizeUnknown {
+		info.Time = time.Duration(data/int64(f.blockAlign)) * time.Second / time.Duration(f.sampleRate)
+	}
+	return &info, nil
+}
+
+// merge returns the fields of infos, each from the first that has it.
+func merge(infos ...codec.SongInfo) codec.SongInfo {
+	var m codec.SongInfo
+	for _, i := range infos {
+		if m.Artist == "" {
+			m.Artist = i.Artist
+		}
+		if m.Title == "" {
+			m.Title = i.Title
+		}
+		if m.Album == "" {
+			m.Album = i.Album
+		}
+		if m.Track == 0 {
+			m.Track = i.Track
+		}
+		if m.Genre == "" {
+			m.Genre = i.Genre
+		}
+		if m.AlbumArtist == "" {
+			m.AlbumArtist = i.AlbumArtist
+		}
+		m.Compilation = m.Compilation || i.Compilation
+		m.Explicit = m.Explicit || i.Explicit
+		if m.Disc == 0 {
+			m.Disc = i.Disc
+			m.Discs = i.Discs
+			m.DiscSubtitle = i.DiscSubtitle
+		}
+		if m.Date == "" {
+			m.Date, m.Year = i.Date, i.Year
+		}
+		if m.OriginalDate == "" {
+			m.OriginalDate, m.OriginalYear = i.OriginalDate, i.OriginalYear
+		}
+		if m.Work == "" {
+			m.Composer = i.Composer
+			m.Work = i.Work
+			m.Movement = i.Movement
+			m.MovementNumber = i.MovementNumber
+		}
+	}
+	return m
+}
+
+// parseListInfo sets the fields of info from the LIST chunk b, if it's of
+// type INFO, whose subchunks are NUL terminated strings.
+func parseListInfo(b []byte, info *codec.SongInfo) {
+	if len(b) < 4 || string(b[:4]) != "INFO" {
+		return
+	}
+	b = b[4:]
+	for len(b) >= 8 {
+		id := string(b[:4])
+		size := int(binary.LittleEndian.Uint32(b[4:]))
+		b = b[8:]
+		if size > len(b) {
+			return
+		}
+		v := strings.TrimSpace(strings.TrimRight(string(b[:size]), "\x00"))
+		if size += size % 2; size > len(b) {
+			size = len(b)
+		}
+		b = b[size:]
+		switch id {
+		case "INAM":
+			info.Title = v
+		case "IART":
+			info.Artist = v
+		case "IPRD":
+			info.Album = v
+		case "IGNR":
+			info.Genre = v
+		case "ITRK", "IPRT":
+			info.Track, _ = strconv.ParseFloat(v, 64)
+		}
+	}
+}
+
+// parseBext sets the fields of info from the broadcast audio extension chunk
+// b, whose description and originator are the closest it has to a title and
+// artist.
+func parseBext(b []byte, info *codec.SongInfo) {
+	str := func(b []byte) string {
+		if i := bytes.IndexByte(b, 0); i >= 0 {
+			b = b[:i]
+		}
+		return strings.TrimSpace(string(b))
+	}
+	if len(b) < 256+32 {
+		return
+	}
+	info.Title = str(b[:256])
+	info.Artist = str(b[256 : 256+32])
+}
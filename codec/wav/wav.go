@@ -1,16 +1,15 @@
 package mpa
 
 import (
-	"bytes"
+	"fmt"
 	"io"
-	"io/ioutil"
 
-	"github.com/mjibson/go-dsp/wav"
 	"github.com/mjibson/moggio/codec"
 )
 
 func init() {
 	codec.RegisterCodec("WAV", []string{"RIFF????WAVE"}, []string{"wav"}, New, nil)
+	codec.RegisterSong("WAV", &Wav{})
 }
 
 func New(rf codec.Reader) (codec.Songs, error) {
@@ -20,64 +19,108 @@ func New(rf codec.Reader) (codec.Songs, error) {
 	return codec.Songs{codec.None: &w}, nil
 }
 
+// maxFormatSize bounds the size of fmt chunks, which are at most 40 bytes
+// but for any extra data of unsupported formats.
+const maxFormatSize = 1 << 16
+
+// Wav is a WAV file of integer or floating point PCM, including extensible
+// and broadcast WAV files.
 type Wav struct {
-	Reader  codec.Reader
-	r       io.ReadCloser
-	initbuf []byte
-	w       *wav.Wav
+	Reader codec.Reader
+	r      io.ReadCloser
+	f      *format
+	data   io.Reader
+	info   *codec.SongInfo
 }
 
 func (w *Wav) Init() (sampleRate, channels int, err error) {
-	if w.w == nil {
+	if w.f == nil {
 		r, _, err := w.Reader()
 		if err != nil {
 			return 0, 0, err
 		}
-		buf := new(bytes.Buffer)
-		defer func() {
-			w.initbuf = buf.Bytes()
-		}()
-		wr, err := wav.New(io.TeeReader(r, buf))
+		var f *format
+		err = readChunks(r, func(id string, size int64, cr io.Reader) (bool, error) {
+			switch id {
+			case "fmt ":
+				if size > maxFormatSize {
+					return false, fmt.Errorf("wav: bad fmt size")
+				}
+				b := make([]byte, size)
+				if _, err := io.ReadFull(cr, b); err != nil {
+					return false, err
+				}
+				f, err = parseFormat(b)
+				return false, err
+			case "data":
+				w.data = cr
+				return true, nil
+			}
+			return false, nil
+		})
+		if err == nil && (f == nil || w.data == nil) {
+			err = io.ErrUnexpectedEOF
+		}
 		if err != nil {
 			r.Close()
 			return 0, 0, err
 		}
 		w.r = r
-		w.w = wr
+		w.f = f
 	}
-	return int(w.w.SampleRate), int(w.w.NumChannels), nil
+	return w.f.sampleRate, w.f.channels, nil
+}
+
+func (w *Wav) BitDepth() int {
+	if w.f.code != formatPCM {
+		// Floating point.
+		return 0
+	}
+	return w.f.valid
 }
 
 func (w *Wav) Info() (info codec.SongInfo, err error) {
-	var r io.ReadCloser
-	if len(w.initbuf) != 0 {
-		r = ioutil.NopCloser(bytes.NewBuffer(w.initbuf))
+	if w.info != nil {
+		return *w.info, nil
 	}
-	if r == nil {
-		r, _, err = w.Reader()
-		if err != nil {
-			return
-		}
+	r, _, err := w.Reader()
+	if err != nil {
+		return
 	}
-	wv, err := wav.New(r)
-	r.Close()
+	defer r.Close()
+	si, err := readInfo(r)
 	if err != nil {
 		return
 	}
-	return codec.SongInfo{
-		Time: wv.Duration,
-	}, nil
+	w.info = si
+	return *si, nil
 }
 
 func (w *Wav) Play(n int) ([]float32, error) {
-	return w.w.ReadFloats(n)
+	blocks := n / w.f.channels
+	if blocks < 1 {
+		blocks = 1
+	}
+	b := make([]byte, blocks*w.f.blockAlign)
+	m, err := io.ReadFull(w.data, b)
+	samples := w.f.decode(b[:m])
+	if len(samples) == 0 {
+		if err == nil || err == io.ErrUnexpectedEOF {
+			err = io.EOF
+		}
+		return nil, err
+	}
+	if err == io.EOF || err == io.ErrUnexpectedEOF {
+		err = nil
+	}
+	return samples, err
 }
 
 func (w *Wav) Close() {
 	if w.r != nil {
 		w.r.Close()
+		w.r = nil
 	}
-	if w.w != nil {
-		w.w = nil
-	}
+	w.f = nil
+	w.data = nil
 }
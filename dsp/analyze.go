@@ -0,0 +1,134 @@
+package dsp
+
+import (
+	"math"
+	"math/cmplx"
+	"sync"
+)
+
+const (
+	// analyzeSize is the number of mono samples in each spectrum.
+	analyzeSize = 2048
+	// SpectrumBands is the number of log spaced bands in a Levels spectrum.
+	SpectrumBands = 32
+	// waveformPoints is the number of points in a Levels waveform.
+	waveformPoints = 256
+	// minDB is the floor of reported levels.
+	minDB = -96
+)
+
+// Levels describes a stream's recent audio for visualization.
+type Levels struct {
+	// Peak and RMS are the levels, in dBFS, of each channel since the
+	// previous Levels.
+	Peak []float64
+	RMS  []float64
+	// Spectrum is the level, in dBFS, of SpectrumBands bands spaced
+	// logarithmically from 20 Hz to the Nyquist frequency.
+	Spectrum []float64
+	// Waveform is the most recent mono samples, downsampled.
+	Waveform []float32
+}
+
+// Analyzer watches the samples of a stream and reports its levels. It is
+// safe for concurrent use.
+type Analyzer struct {
+	mu         sync.Mutex
+	sampleRate int
+	channels   int
+	ring       []float32
+	pos        int
+	peak       []float64
+	sum        []float64
+	n          int
+	window     []float64
+}
+
+// NewAnalyzer returns an analyzer for a stream of the given format.
+func NewAnalyzer(sampleRate, channels int) *Analyzer {
+	return &Analyzer{
+		sampleRate: sampleRate,
+		channels:   channels,
+		ring:       make([]float32, analyzeSize),
+		peak:       make([]float64, channels),
+		sum:        make([]float64, channels),
+		window:     Hann(analyzeSize),
+	}
+}
+
+// Write records samples as played.
+func (a *Analyzer) Write(samples []float32) {
+	a.mu.Lock()
+	defer a.mu.Unlock()
+	for i := 0; i+a.channels <= len(samples); i += a.channels {
+		var mono float32
+		for c := 0; c < a.channels; c++ {
+			v := float64(samples[i+c])
+			if math.Abs(v) > a.peak[c] {
+				a.peak[c] = math.Abs(v)
+			}
+			a.sum[c] += v * v
+			mono += samples[i+c]
+		}
+		a.ring[a.pos] = mono / float32(a.channels)
+		a.pos = (a.pos + 1) % len(a.ring)
+		a.n++
+	}
+}
+
+// Levels returns the current levels and resets the peak and RMS. ok is false
+// if no samples were written since the last call.
+func (a *Analyzer) Levels() (l Levels, ok bool) {
+	a.mu.Lock()
+	defer a.mu.Unlock()
+	if a.n == 0 {
+		return l, false
+	}
+	l.Peak = make([]float64, a.channels)
+	l.RMS = make([]float64, a.channels)
+	for c := range a.peak {
+		l.Peak[c] = dbfs(a.peak[c])
+		l.RMS[c] = dbfs(math.Sqrt(a.sum[c] / float64(a.n)))
+		a.peak[c], a.sum[c] = 0, 0
+	}
+	a.n = 0
+
+	x := make([]complex128, len(a.ring))
+	var wsum float64
+	for i := range x {
+		s := a.ring[(a.pos+i)%len(a.ring)]
+		x[i] = complex(float64(s)*a.window[i], 0)
+		wsum += a.window[i]
+	}
+	FFT(x)
+	// Band edges are spaced evenly in log frequency.
+	nyquist := float64(a.sampleRate) / 2
+	binHz := float64(a.sampleRate) / float64(len(x))
+	l.Spectrum = make([]float64, SpectrumBands)
+	for b := range l.Spectrum {
+		lo := 20 * math.Pow(nyquist/20, float64(b)/SpectrumBands)
+		hi := 20 * math.Pow(nyquist/20, float64(b+1)/SpectrumBands)
+		var m float64
+		for k := int(lo / binHz); k <= int(hi/binHz) && k < len(x)/2; k++ {
+			if v := cmplx.Abs(x[k]); v > m {
+				m = v
+			}
+		}
+		l.Spectrum[b] = dbfs(m * 2 / wsum)
+	}
+
+	l.Waveform = make([]float32, waveformPoints)
+	step := len(a.ring) / waveformPoints
+	for i := range l.Waveform {
+		l.Waveform[i] = a.ring[(a.pos+i*step)%len(a.ring)]
+	}
+	return l, true
+}
+
+// dbfs converts a linear level to dBFS, floored at minDB.
+func dbfs(v float64) float64 {
+	if v <= 0 {
+		return minDB
+	}
+	return math.Max(20*math.Log10(v), minDB)
+}
@@ -0,0 +1,127 @@
+package dsp
+
+import "math"
+
+// Speaker positions, named as ALSA and PipeWire name them.
+const (
+	Mono       = "MONO"
+	FrontLeft  = "FL"
+	FrontRight = "FR"
+	Center     = "FC"
+	LFE        = "LFE"
+	RearLeft   = "RL"
+	RearRight  = "RR"
+	RearCenter = "RC"
+	SideLeft   = "SL"
+	SideRight  = "SR"
+)
+
+// layouts are the speaker positions of interleaved channels by channel count,
+// in WAVE order, which FLAC and WAV files use. Decoders of formats with other
+// orders reorder their channels to these.
+var layouts = [][]string{
+	1: {Mono},
+	2: {FrontLeft, FrontRight},
+	3: {FrontLeft, FrontRight, Center},
+	4: {FrontLeft, FrontRight, RearLeft, RearRight},
+	5: {FrontLeft, FrontRight, Center, RearLeft, RearRight},
+	6: {FrontLeft, FrontRight, Center, LFE, RearLeft, RearRight},
+	7: {FrontLeft, FrontRight, Center, LFE, RearCenter, SideLeft, SideRight},
+	8: {FrontLeft, FrontRight, Center, LFE, RearLeft, RearRight, SideLeft, SideRight},
+}
+
+// Layout returns the speaker positions of audio with the given number of
+// channels, or nil if they are unknown.
+func Layout(channels int) []string {
+	if channels < 1 || channels >= len(layouts) {
+		return nil
+	}
+	return layouts[channels]
+}
+
+// downmixLeft is the gain of each position in the left channel of a stereo
+// downmix, from ITU-R BS.775. The right channel mirrors it. LFE is dropped.
+var downmixLeft = map[string]float64{
+	Mono:       math.Sqrt2 / 2,
+	FrontLeft:  1,
+	Center:     math.Sqrt2 / 2,
+	RearLeft:   math.Sqrt2 / 2,
+	SideLeft:   math.Sqrt2 / 2,
+	RearCenter: 0.5,
+}
+
+var mirror = map[string]string{
+	FrontLeft: FrontRight,
+	RearLeft:  RearRight,
+	SideLeft:  SideRight,
+}
+
+type downmix struct {
+	in, out int
+	// left and right are the gains of each input channel.
+	left, right []float32
+}
+
+// NewDownmix returns a stage downmixing interleaved audio with in channels
+// to out channels, which is 1 or 2. Gains are normalized so the downmix
+// can't clip. Audio of unknown layout uses its first channels.
+func NewDownmix(in, out int) Stage {
+	d := &downmix{
+		in:    in,
+		out:   out,
+		left:  make([]float32, in),
+		right: make([]float32, in),
+	}
+	layout := Layout(in)
+	if layout == nil {
+		d.left[0] = 1
+		if in > 1 {
+			d.right[1] = 1
+		} else {
+			d.right[0] = 1
+		}
+	}
+	var sum float64
+	for i, p := range layout {
+		if g, ok := downmixLeft[p]; ok {
+			d.left[i] = float32(g)
+			sum += g
+			if p == Center || p == RearCenter || p == Mono {
+				d.right[i] = float32(g)
+			}
+		}
+		for l, r := range mirror {
+			if p == r {
+				d.right[i] = float32(downmixLeft[l])
+			}
+		}
+	}
+	if sum > 0 {
+		for i := range d.left {
+			d.left[i] /= float32(sum)
+			d.right[i] /= float32(sum)
+		}
+	}
+	return d
+}
+
+func (d *downmix) Process(samples []float32) []float32 {
+	if d.in == d.out || d.in < 1 {
+		return samples
+	}
+	frames := len(samples) / d.in
+	// Fewer channels come out than go in, so write in place.
+	for f := 0; f < frames; f++ {
+		var l, r float32
+		for c, v := range samples[f*d.in : (f+1)*d.in] {
+			l += v * d.left[c]
+			r += v * d.right[c]
+		}
+		if d.out == 1 {
+			samples[f] = (l + r) / 2
+		} else {
+			samples[f*2], samples[f*2+1] = l, r
+		}
+	}
+	return samples[:frames*d.out]
+}
@@ -0,0 +1,62 @@
+package dsp
+
+import "math"
+
+// Dither quantizes float32 samples to integers with triangular probability
+// density function (TPDF) dither, which decorrelates the quantization error
+// from the signal. It should be applied only once, at the final conversion to
+// the output's integer format.
+type Dither struct {
+	state uint32
+}
+
+// next returns a uniform random number in [0, 1) using xorshift, which is
+// much cheaper than math/rand and good enough for dither noise.
+func (d *Dither) next() float32 {
+	if d.state == 0 {
+		d.state = 2463534242
+	}
+	d.state ^= d.state << 13
+	d.state ^= d.state >> 17
+	d.state ^= d.state << 5
+	return float32(d.state) / (1 << 32)
+}
+
+// Int16 converts s, nominally in [-1, 1], to a dithered int16.
+func (d *Dither) Int16(s float32) int16 {
+	return int16(d.quantize(s, 16))
+}
+
+// Int32 converts s to a dithered signed integer of the given bit depth.
+func (d *Dither) Int32(s float32, bits uint) int32 {
+	return d.quantize(s, bits)
+}
+
+func (d *Dither) quantize(s float32, bits uint) int32 {
+	max := int32(1<<(bits-1) - 1)
+	// The scale, max+1, overflows int32 at 32 bits.
+	v := float64(s)*float64(int64(1)<<(bits-1)) + float64(d.next()-d.next())
+	v = math.Floor(v + 0.5)
+	switch {
+	case v > float64(max):
+		return max
+	case v < float64(-max-1):
+		return -max - 1
+	}
+	return int32(v)
+}
+
+// Quantize converts s to a signed integer of the given bit depth by rounding,
+// without dither. It is lossless for samples that originated at that depth.
+func Quantize(s float32, bits uint) int32 {
+	max := int32(1<<(bits-1) - 1)
+	// The scale, max+1, overflows int32 at 32 bits.
+	v := math.Floor(float64(s)*float64(int64(1)<<(bits-1)) + 0.5)
+	switch {
+	case v > float64(max):
+		return max
+	case v < float64(-max-1):
+		return -max - 1
+	}
+	return int32(v)
+}
@@ -0,0 +1,69 @@
+// Package dsp implements audio processing stages applied to decoded samples
+// before output.
+package dsp
+
+import "math"
+
+// Stage processes interleaved float32 samples. Stages may modify samples in
+// place and may return a slice of a different length.
+type Stage interface {
+	Process(samples []float32) []float32
+}
+
+// Chain is a sequence of stages applied in order.
+type Chain []Stage
+
+func (c Chain) Process(samples []float32) []float32 {
+	for _, s := range c {
+		samples = s.Process(samples)
+	}
+	return samples
+}
+
+// Gain scales samples by a linear factor.
+type Gain float32
+
+func (g Gain) Process(samples []float32) []float32 {
+	if g == 1 {
+		return samples
+	}
+	for i := range samples {
+		samples[i] *= float32(g)
+	}
+	return samples
+}
+
+// Fader is a gain that may be changed as it plays. Changes ramp over the
+// next block of samples, so they don't click.
+type Fader struct {
+	gain, target float32
+}
+
+// NewFader returns a Fader of the linear gain g.
+func NewFader(g float64) *Fader {
+	return &Fader{gain: float32(g), target: float32(g)}
+}
+
+// Set changes the gain to g.
+func (f *Fader) Set(g float64) {
+	f.target = float32(g)
+}
+
+func (f *Fader) Process(samples []float32) []float32 {
+	if f.gain == f.target {
+		return Gain(f.gain).Process(samples)
+	}
+	step := (f.target - f.gain) / float32(len(samples))
+	for i := range samples {
+		samples[i] *= f.gain + step*float32(i)
+	}
+	if len(samples) > 0 {
+		f.gain = f.target
+	}
+	return samples
+}
+
+// DB converts decibels to a linear gain factor.
+func DB(db float64) float64 {
+	return math.Pow(10, db/20)
+}
@@ -0,0 +1,150 @@
+package dsp
+
+import (
+	"fmt"
+	"math"
+)
+
+// GraphicFreqs are the center frequencies in Hz of the standard 10-band
+// graphic equalizer.
+var GraphicFreqs = []float64{31, 62, 125, 250, 500, 1000, 2000, 4000, 8000, 16000}
+
+// graphicQ is the Q of a one octave wide band.
+const graphicQ = 1.41
+
+// Presets are gains in dB for the bands of GraphicFreqs.
+var Presets = map[string][]float64{
+	"flat":         {0, 0, 0, 0, 0, 0, 0, 0, 0, 0},
+	"bass boost":   {6, 5, 4, 2, 0, 0, 0, 0, 0, 0},
+	"treble boost": {0, 0, 0, 0, 0, 1, 2, 4, 5, 6},
+	"loudness":     {5, 4, 2, 0, -1, 0, -1, 1, 4, 5},
+	"rock":         {4, 3, 2, 0, -1, -1, 1, 2, 3, 4},
+	"pop":          {-1, 0, 2, 3, 4, 3, 1, 0, -1, -1},
+	"jazz":         {3, 2, 1, 2, -1, -1, 0, 1, 2, 3},
+	"classical":    {4, 3, 2, 1, 0, 0, 0, 1, 2, 3},
+	"vocal":        {-2, -2, -1, 1, 3, 4, 3, 1, 0, -1},
+}
+
+// Band is a peaking filter.
+type Band struct {
+	// Freq is the center frequency in Hz.
+	Freq float64
+	// Gain is the boost or cut in dB.
+	Gain float64
+	// Q is the quality factor; higher is narrower.
+	Q float64
+}
+
+// EQ is an equalizer configuration.
+type EQ struct {
+	Enabled bool
+	// Preset is the name of the graphic preset the bands were created from,
+	// if any.
+	Preset string `json:",omitempty"`
+	Bands  []Band
+}
+
+// PresetEQ returns an enabled graphic EQ using the named preset.
+func PresetEQ(name string) (EQ, error) {
+	gains, ok := Presets[name]
+	if !ok {
+		return EQ{}, fmt.Errorf("unknown preset: %v", name)
+	}
+	eq := EQ{
+		Enabled: true,
+		Preset:  name,
+	}
+	for i, f := range GraphicFreqs {
+		eq.Bands = append(eq.Bands, Band{
+			Freq: f,
+			Gain: gains[i],
+			Q:    graphicQ,
+		})
+	}
+	return eq, nil
+}
+
+// Validate checks that the bands are usable.
+func (e EQ) Validate() error {
+	for _, b := range e.Bands {
+		switch {
+		case b.Freq <= 0:
+			return fmt.Errorf("bad band frequency: %v", b.Freq)
+		case b.Q <= 0:
+			return fmt.Errorf("bad band Q: %v", b.Q)
+		case math.Abs(b.Gain) > 24:
+			return fmt.Errorf("band gain out of range: %v", b.Gain)
+		}
+	}
+	return nil
+}
+
+// biquad is a second order IIR filter in direct form I.
+type biquad struct {
+	b0, b1, b2, a1, a2 float64
+}
+
+type biquadState struct {
+	x1, x2, y1, y2 float64
+}
+
+// peaking returns the peaking EQ filter from the Audio EQ Cookbook by Robert
+// Bristow-Johnson.
+func peaking(b Band, sampleRate int) biquad {
+	a := math.Pow(10, b.Gain/40)
+	w0 := 2 * math.Pi * b.Freq / float64(sampleRate)
+	alpha := math.Sin(w0) / (2 * b.Q)
+	cos := math.Cos(w0)
+	a0 := 1 + alpha/a
+	return biquad{
+		b0: (1 + alpha*a) / a0,
+		b1: -2 * cos / a0,
+		b2: (1 - alpha*a) / a0,
+		a1: -2 * cos / a0,
+		a2: (1 - alpha/a) / a0,
+	}
+}
+
+type eqStage struct {
+	filters  []biquad
+	state    [][]biquadState // [channel][filter]
+	channels int
+}
+
+// NewEQ returns a stage applying e to interleaved audio.
+func NewEQ(e EQ, sampleRate, channels int) Stage {
+	s := &eqStage{
+		channels: channels,
+		state:    make([][]biquadState, channels),
+	}
+	for _, b := range e.Bands {
+		// Filters above Nyquist are unstable; skip them and flat bands.
+		if b.Gain == 0 || b.Freq >= float64(sampleRate)/2 {
+			continue
+		}
+		s.filters = append(s.filters, peaking(b, sampleRate))
+	}
+	for i := range s.state {
+		s.state[i] = make([]biquadState, len(s.filters))
+	}
+	return s
+}
+
+func (s *eqStage) Process(samples []float32) []float32 {
+	if len(s.filters) == 0 || s.channels == 0 {
+		return samples
+	}
+	for i, v := range samples {
+		c := i % s.channels
+		x := float64(v)
+		for j, f := range s.filters {
+			st := &s.state[c][j]
+			y := f.b0*x + f.b1*st.x1 + f.b2*st.x2 - f.a1*st.y1 - f.a2*st.y2
+			st.x2, st.x1 = st.x1, x
+			st.y2, st.y1 = st.y1, y
+			x = y
+		}
+		samples[i] = float32(x)
+	}
+	return samples
+}
@@ -0,0 +1,119 @@
+package dsp
+
+import (
+	"math"
+	"math/cmplx"
+)
+
+const (
+	keySize  = 8192
+	keyMinHz = 80
+	keyMaxHz = 5000
+)
+
+var keyNames = [12]string{"C", "C#", "D", "Eb", "E", "F", "F#", "G", "Ab", "A", "Bb", "B"}
+
+// Krumhansl-Kessler key profiles, starting at the tonic.
+var (
+	majorProfile = [12]float64{6.35, 2.23, 3.48, 2.33, 4.38, 4.09, 2.52, 5.19, 2.39, 3.66, 2.29, 2.88}
+	minorProfile = [12]float64{6.33, 2.68, 3.52, 5.38, 2.60, 3.53, 2.54, 4.75, 3.98, 2.69, 3.34, 3.17}
+)
+
+// KeyDetector estimates the musical key of a stream from its pitch class
+// distribution.
+type KeyDetector struct {
+	f      framer
+	window []float64
+	fft    []complex128
+	// class is the pitch class of each FFT bin, or -1 if out of range.
+	class  []int
+	chroma [12]float64
+}
+
+// NewKeyDetector returns a key detector for a stream of the given format.
+func NewKeyDetector(sampleRate, channels int) *KeyDetector {
+	k := &KeyDetector{
+		f: framer{
+			channels: channels,
+			size:     keySize,
+			hop:      keySize,
+		},
+		window: Hann(keySize),
+		fft:    make([]complex128, keySize),
+		class:  make([]int, keySize/2),
+	}
+	for i := range k.class {
+		k.class[i] = -1
+		hz := float64(i) * float64(sampleRate) / keySize
+		if hz < keyMinHz || hz > keyMaxHz {
+			continue
+		}
+		midi := int(math.Round(69 + 12*math.Log2(hz/440)))
+		k.class[i] = midi % 12
+	}
+	return k
+}
+
+func (k *KeyDetector) Write(samples []float32) {
+	k.f.write(samples, k.frame)
+}
+
+func (k *KeyDetector) frame(x []float64) {
+	for i, v := range x {
+		k.fft[i] = complex(v*k.window[i], 0)
+	}
+	FFT(k.fft)
+	for i, c := range k.class {
+		if c >= 0 {
+			k.chroma[c] += cmplx.Abs(k.fft[i])
+		}
+	}
+}
+
+// Key returns the estimated key, like "A" or "F#m", or "" if there is too
+// little tonal content to tell.
+func (k *KeyDetector) Key() string {
+	var sum float64
+	for _, v := range k.chroma {
+		sum += v
+	}
+	if sum == 0 {
+		return ""
+	}
+	best, bestR := "", math.Inf(-1)
+	for tonic := range keyNames {
+		var rotated [12]float64
+		for i := range rotated {
+			rotated[i] = k.chroma[(tonic+i)%12]
+		}
+		if r := correlate(rotated, majorProfile); r > bestR {
+			best, bestR = keyNames[tonic], r
+		}
+		if r := correlate(rotated, minorProfile); r > bestR {
+			best, bestR = keyNames[tonic]+"m", r
+		}
+	}
+	return best
+}
+
+// correlate returns the Pearson correlation of a and b.
+func correlate(a, b [12]float64) float64 {
+	var ma, mb float64
+	for i := range a {
+		ma += a[i]
+		mb += b[i]
+	}
+	ma /= 12
+	mb /= 12
+	var ab, aa, bb float64
+	for i := range a {
+		da, db := a[i]-ma, b[i]-mb
+		ab += da * db
+		aa += da * da
+		bb += db * db
+	}
+	if aa == 0 || bb == 0 {
+		return 0
+	}
+	return ab / math.Sqrt(aa*bb)
+}
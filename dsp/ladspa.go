@@ -0,0 +1,394 @@
+// +build cgo,!windows
+
+package dsp
+
+/*
+#cgo linux LDFLAGS: -ldl
+#include <dlfcn.h>
+#include <stdlib.h>
+
+// From ladspa.h, version 1.1.
+
+typedef float LADSPA_Data;
+typedef void *LADSPA_Handle;
+
+typedef struct {
+	int HintDescriptor;
+	LADSPA_Data LowerBound;
+	LADSPA_Data UpperBound;
+} LADSPA_PortRangeHint;
+
+typedef struct _LADSPA_Descriptor {
+	unsigned long UniqueID;
+	const char *Label;
+	int Properties;
+	const char *Name;
+	const char *Maker;
+	const char *Copyright;
+	unsigned long PortCount;
+	const int *PortDescriptors;
+	const char * const *PortNames;
+	const LADSPA_PortRangeHint *PortRangeHints;
+	void *ImplementationData;
+	LADSPA_Handle (*instantiate)(const struct _LADSPA_Descriptor *, unsigned long);
+	void (*connect_port)(LADSPA_Handle, unsigned long, LADSPA_Data *);
+	void (*activate)(LADSPA_Handle);
+	void (*run)(LADSPA_Handle, unsigned long);
+	void (*run_adding)(LADSPA_Handle, unsigned long);
+	void (*set_run_adding_gain)(LADSPA_Handle, LADSPA_Data);
+	void (*deactivate)(LADSPA_Handle);
+	void (*cleanup)(LADSPA_Handle);
+} LADSPA_Descriptor;
+
+typedef const LADSPA_Descriptor *(*LADSPA_Descriptor_Function)(unsigned long);
+
+static const LADSPA_Descriptor *ladspa_descriptor(void *fn, unsigned long i) {
+	return ((LADSPA_Descriptor_Function)fn)(i);
+}
+
+static LADSPA_Handle ladspa_instantiate(const LADSPA_Descriptor *d, unsigned long rate) {
+	return d->instantiate(d, rate);
+}
+
+static void ladspa_connect(const LADSPA_Descriptor *d, LADSPA_Handle h, unsigned long port, LADSPA_Data *data) {
+	d->connect_port(h, port, data);
+}
+
+static void ladspa_activate(const LADSPA_Descriptor *d, LADSPA_Handle h) {
+	if (d->activate)
+		d->activate(h);
+}
+
+static void ladspa_run(const LADSPA_Descriptor *d, LADSPA_Handle h, unsigned long n) {
+	d->run(h, n);
+}
+
+static void ladspa_cleanup(const LADSPA_Descriptor *d, LADSPA_Handle h) {
+	if (d->deactivate)
+		d->deactivate(h);
+	d->cleanup(h);
+}
+
+static int ladspa_port(const LADSPA_Descriptor *d, unsigned long i) {
+	return d->PortDescriptors[i];
+}
+
+static const char *ladspa_port_name(const LADSPA_Descriptor *d, unsigned long i) {
+	return d->PortNames[i];
+}
+
+static LADSPA_PortRangeHint ladspa_hint(const LADSPA_Descriptor *d, unsigned long i) {
+	return d->PortRangeHints[i];
+}
+*/
+import "C"
+
+import (
+	"fmt"
+	"math"
+	"runtime"
+	"sync"
+	"unsafe"
+)
+
+// Port descriptors and range hints of ladspa.h.
+const (
+	portInput   = 0x1
+	portOutput  = 0x2
+	portControl = 0x4
+	portAudio   = 0x8
+
+	hintBoundedBelow = 0x1
+	hintBoundedAbove = 0x2
+	hintToggled      = 0x4
+	hintSampleRate   = 0x8
+	hintLogarithmic  = 0x10
+	hintInteger      = 0x20
+	hintDefaultMask  = 0x3c0
+	hintDefaultMin   = 0x40
+	hintDefaultLow   = 0x80
+	hintDefaultMid   = 0xc0
+	hintDefaultHigh  = 0x100
+	hintDefaultMax   = 0x140
+	hintDefault0     = 0x200
+	hintDefault1     = 0x240
+	hintDefault100   = 0x280
+	hintDefault440   = 0x2c0
+)
+
+// ladspaLibs are the opened plugin libraries' descriptor functions by path.
+// Libraries are never closed, since plugins may be in use.
+var ladspaLibs = struct {
+	sync.Mutex
+	fns map[string]unsafe.Pointer
+}{fns: make(map[string]unsafe.Pointer)}
+
+func ladspaDescriptors(path string) ([]*C.LADSPA_Descriptor, error) {
+	ladspaLibs.Lock()
+	defer ladspaLibs.Unlock()
+	fn, ok := ladspaLibs.fns[path]
+	if !ok {
+		cpath := C.CString(path)
+		defer C.free(unsafe.Pointer(cpath))
+		lib := C.dlopen(cpath, C.RTLD_NOW|C.RTLD_LOCAL)
+		if lib == nil {
+			return nil, fmt.Errorf("ladspa: %s", C.GoString(C.dlerror()))
+		}
+		sym := C.CString("ladspa_descriptor")
+		defer C.free(unsafe.Pointer(sym))
+		fn = C.dlsym(lib, sym)
+		if fn == nil {
+			C.dlclose(lib)
+			return nil, fmt.Errorf("ladspa: %s is not a LADSPA plugin library", path)
+		}
+		ladspaLibs.fns[path] = fn
+	}
+	var ds []*C.LADSPA_Descriptor
+	for i := 0; ; i++ {
+		d := C.ladspa_descriptor(fn, C.ulong(i))
+		if d == nil {
+			return ds, nil
+		}
+		ds = append(ds, d)
+	}
+}
+
+func pluginInfos(path string) ([]PluginInfo, error) {
+	ds, err := ladspaDescriptors(path)
+	if err != nil {
+		return nil, err
+	}
+	var infos []PluginInfo
+	for _, d := range ds {
+		info := PluginInfo{
+			Path:  path,
+			Label: C.GoString(d.Label),
+			Name:  C.GoString(d.Name),
+			Maker: C.GoString(d.Maker),
+		}
+		for i := C.ulong(0); i < d.PortCount; i++ {
+			p := C.ladspa_port(d, i)
+			switch {
+			case p&portAudio != 0 && p&portInput != 0:
+				info.Inputs++
+			case p&portAudio != 0 && p&portOutput != 0:
+				info.Outputs++
+			case p&portControl != 0 && p&portInput != 0:
+				info.Controls = append(info.Controls, controlInfo(d, i, 48000))
+			}
+		}
+		infos = append(infos, info)
+	}
+	return infos, nil
+}
+
+// controlInfo describes control port i at sampleRate.
+func controlInfo(d *C.LADSPA_Descriptor, i C.ulong, sampleRate int) PluginControl {
+	h := C.ladspa_hint(d, i)
+	hint := int(h.HintDescriptor)
+	c := PluginControl{
+		Name:    C.GoString(C.ladspa_port_name(d, i)),
+		Min:     float64(h.LowerBound),
+		Max:     float64(h.UpperBound),
+		HasMin:  hint&hintBoundedBelow != 0,
+		HasMax:  hint&hintBoundedAbove != 0,
+		Toggle:  hint&hintToggled != 0,
+		Integer: hint&hintInteger != 0,
+	}
+	if hint&hintSampleRate != 0 {
+		c.Min *= float64(sampleRate)
+		c.Max *= float64(sampleRate)
+	}
+	// between returns the point f of the way from Min to Max.
+	between := func(f float64) float64 {
+		if hint&hintLogarithmic != 0 && c.Min > 0 && c.Max > 0 {
+			return math.Exp(math.Log(c.Min)*(1-f) + math.Log(c.Max)*f)
+		}
+		return c.Min*(1-f) + c.Max*f
+	}
+	switch hint & hintDefaultMask {
+	case hintDefaultMin:
+		c.Default = c.Min
+	case hintDefaultLow:
+		c.Default = between(0.25)
+	case hintDefaultMid:
+		c.Default = between(0.5)
+	case hintDefaultHigh:
+		c.Default = between(0.75)
+	case hintDefaultMax:
+		c.Default = c.Max
+	case hintDefault1:
+		c.Default = 1
+	case hintDefault100:
+		c.Default = 100
+	case hintDefault440:
+		c.Default = 440
+	default:
+		if c.HasMin && c.Min > 0 {
+			c.Default = c.Min
+		} else if c.HasMax && c.Max < 0 {
+			c.Default = c.Max
+		}
+	}
+	if c.Integer {
+		c.Default = math.Round(c.Default)
+	}
+	return c
+}
+
+// ladspaPlugin runs instances of a plugin. Plugins with one audio input and
+// output are run once per channel; others must have one per channel.
+type ladspaPlugin struct {
+	d         *C.LADSPA_Descriptor
+	instances []C.LADSPA_Handle
+	channels  int
+	// ins and outs are the audio ports, and controls the control port
+	// values, of each instance.
+	ins, outs []C.ulong
+	controls  *C.LADSPA_Data
+	// bufs are the deinterleaved input and output buffers of each channel,
+	// of size samples, allocated in C since plugins keep them.
+	bufs []*C.LADSPA_Data
+	size int
+}
+
+// NewPlugin returns a stage running p at sampleRate.
+func NewPlugin(p Plugin, sampleRate, channels int) (Stage, error) {
+	path, err := pluginPath(p.Path)
+	if err != nil {
+		return nil, err
+	}
+	ds, err := ladspaDescriptors(path)
+	if err != nil {
+		return nil, err
+	}
+	var d *C.LADSPA_Descriptor
+	for _, v := range ds {
+		if C.GoString(v.Label) == p.Label {
+			d = v
+		}
+	}
+	if d == nil {
+		return nil, fmt.Errorf("ladspa: no plugin %q in %s", p.Label, p.Path)
+	}
+	lp := &ladspaPlugin{
+		d:        d,
+		channels: channels,
+	}
+	var controls []C.ulong
+	var values []float64
+	for i := C.ulong(0); i < d.PortCount; i++ {
+		port := C.ladspa_port(d, i)
+		switch {
+		case port&portAudio != 0 && port&portInput != 0:
+			lp.ins = append(lp.ins, i)
+		case port&portAudio != 0 && port&portOutput != 0:
+			lp.outs = append(lp.outs, i)
+		case port&portControl != 0:
+			c := controlInfo(d, i, sampleRate)
+			v, ok := p.Controls[c.Name]
+			if !ok {
+				v = c.Default
+			}
+			controls = append(controls, i)
+			values = append(values, v)
+		}
+	}
+	n := 1
+	switch {
+	case len(lp.ins) == 1 && len(lp.outs) == 1:
+		n = channels
+	case len(lp.ins) == channels && len(lp.outs) == channels:
+	default:
+		return nil, fmt.Errorf("ladspa: %s has %d inputs and %d outputs; need 1 or %d of each", p.Label, len(lp.ins), len(lp.outs), channels)
+	}
+	for c := range p.Controls {
+		found := false
+		for i := range controls {
+			found = found || C.GoString(C.ladspa_port_name(d, controls[i])) == c
+		}
+		if !found {
+			return nil, fmt.Errorf("ladspa: %s has no control %q", p.Label, c)
+		}
+	}
+	// Output control ports are written by the plugin, so each instance
+	// gets its own values.
+	lp.controls = (*C.LADSPA_Data)(C.calloc(C.size_t(len(controls)*n+1), C.sizeof_LADSPA_Data))
+	vals := (*[1 << 20]C.LADSPA_Data)(unsafe.Pointer(lp.controls))[: len(controls)*n : len(controls)*n]
+	runtime.SetFinalizer(lp, (*ladspaPlugin).free)
+	for j := 0; j < n; j++ {
+		h := C.ladspa_instantiate(d, C.ulong(sampleRate))
+		if h == nil {
+			return nil, fmt.Errorf("ladspa: could not instantiate %s", p.Label)
+		}
+		lp.instances = append(lp.instances, h)
+		for i, port := range controls {
+			k := j*len(controls) + i
+			vals[k] = C.LADSPA_Data(values[i])
+			C.ladspa_connect(d, h, port, &vals[k])
+		}
+		C.ladspa_activate(d, h)
+	}
+	return lp, nil
+}
+
+func (lp *ladspaPlugin) free() {
+	for _, h := range lp.instances {
+		C.ladspa_cleanup(lp.d, h)
+	}
+	lp.instances = nil
+	for _, b := range lp.bufs {
+		C.free(unsafe.Pointer(b))
+	}
+	lp.bufs = nil
+	C.free(unsafe.Pointer(lp.controls))
+}
+
+// alloc makes the buffers hold n samples per channel and connects them.
+func (lp *ladspaPlugin) alloc(n int) {
+	if n <= lp.size {
+		return
+	}
+	for _, b := range lp.bufs {
+		C.free(unsafe.Pointer(b))
+	}
+	lp.bufs = lp.bufs[:0]
+	for i := 0; i < lp.channels*2; i++ {
+		lp.bufs = append(lp.bufs, (*C.LADSPA_Data)(C.calloc(C.size_t(n), C.sizeof_LADSPA_Data)))
+	}
+	lp.size = n
+	// Channel c's input is bufs[c] and its output bufs[channels+c].
+	for c := 0; c < lp.channels; c++ {
+		if len(lp.instances) == 1 {
+			C.ladspa_connect(lp.d, lp.instances[0], lp.ins[c], lp.bufs[c])
+			C.ladspa_connect(lp.d, lp.instances[0], lp.outs[c], lp.bufs[lp.channels+c])
+		} else {
+			C.ladspa_connect(lp.d, lp.instances[c], lp.ins[0], lp.bufs[c])
+			C.ladspa_connect(lp.d, lp.instances[c], lp.outs[0], lp.bufs[lp.channels+c])
+		}
+	}
+}
+
+func (lp *ladspaPlugin) Process(samples []float32) []float32 {
+	n := len(samples) / lp.channels
+	if n == 0 {
+		return samples
+	}
+	lp.alloc(n)
+	for c := 0; c < lp.channels; c++ {
+		in := (*[1 << 28]C.LADSPA_Data)(unsafe.Pointer(lp.bufs[c]))[:n:n]
+		for i := range in {
+			in[i] = C.LADSPA_Data(samples[i*lp.channels+c])
+		}
+	}
+	for _, h := range lp.instances {
+		C.ladspa_run(lp.d, h, C.ulong(n))
+	}
+	for c := 0; c < lp.channels; c++ {
+		out := (*[1 << 28]C.LADSPA_Data)(unsafe.Pointer(lp.bufs[lp.channels+c]))[:n:n]
+		for i, v := range out {
+			samples[i*lp.channels+c] = float32(v)
+		}
+	}
+	return samples
+}
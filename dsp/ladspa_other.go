@@ -0,0 +1,14 @@
+// +build !cgo windows
+
+package dsp
+
+import "fmt"
+
+func pluginInfos(path string) ([]PluginInfo, error) {
+	return nil, fmt.Errorf("ladspa: plugins require cgo")
+}
+
+// NewPlugin returns a stage running p at sampleRate.
+func NewPlugin(p Plugin, sampleRate, channels int) (Stage, error) {
+	return nil, fmt.Errorf("ladspa: plugins require cgo")
+}
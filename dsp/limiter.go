@@ -0,0 +1,119 @@
+package dsp
+
+import "math"
+
+const (
+	// limiterLookahead and limiterRelease are the times, in seconds, the
+	// limiter lowers its gain before peaks and recovers after them.
+	limiterLookahead = 0.005
+	limiterRelease   = 0.1
+)
+
+// Limiter is a lookahead peak limiter. It keeps samples under its ceiling
+// by lowering the gain smoothly before peaks, instead of clipping them,
+// which delays audio by the lookahead.
+type Limiter struct {
+	channels int
+	ceiling  float64
+	release  float64
+	// delay are the last frames, of which the oldest is output.
+	delay []float32
+	// targets are the gains of the frames of the lookahead needed to keep
+	// them under the ceiling, as a queue of increasing gains, whose first
+	// is their minimum. held are the gains after the release, summed in
+	// sum, whose mean is the gain applied.
+	targets []limiterTarget
+	held    []float64
+	sum     float64
+	gain    float64
+	n       int
+	// reduction is the lowest gain applied since it was last read.
+	reduction float64
+}
+
+type limiterTarget struct {
+	frame int
+	gain  float64
+}
+
+// NewLimiter returns a limiter of samples to ceiling dBFS.
+func NewLimiter(ceiling float64, sampleRate, channels int) *Limiter {
+	frames := int(limiterLookahead * float64(sampleRate))
+	if frames < 1 {
+		frames = 1
+	}
+	l := &Limiter{
+		channels:  channels,
+		ceiling:   DB(ceiling),
+		release:   1 - math.Exp(-1/(limiterRelease*float64(sampleRate))),
+		delay:     make([]float32, frames*channels),
+		held:      make([]float64, frames),
+		sum:       float64(frames),
+		gain:      1,
+		reduction: 1,
+	}
+	for i := range l.held {
+		l.held[i] = 1
+	}
+	return l
+}
+
+func (l *Limiter) Process(samples []float32) []float32 {
+	frames := len(l.held)
+	out := 0
+	for i := 0; i+l.channels <= len(samples); i += l.channels {
+		var peak float64
+		for c := 0; c < l.channels; c++ {
+			if a := math.Abs(float64(samples[i+c])); a > peak {
+				peak = a
+			}
+		}
+		target := 1.0
+		if peak > l.ceiling {
+			target = l.ceiling / peak
+		}
+		// The minimum gain of the lookahead's frames.
+		for len(l.targets) > 0 && l.targets[len(l.targets)-1].gain >= target {
+			l.targets = l.targets[:len(l.targets)-1]
+		}
+		l.targets = append(l.targets, limiterTarget{l.n, target})
+		if l.targets[0].frame <= l.n-frames {
+			l.targets = l.targets[1:]
+		}
+		l.gain += (1 - l.gain) * l.release
+		if min := l.targets[0].gain; min < l.gain {
+			l.gain = min
+		}
+		// Averaging the gains of the lookahead makes the attack smooth, yet
+		// each is at most the gain of the frame output, since each
+		// lookahead includes it.
+		slot := l.n % frames
+		l.sum += l.gain - l.held[slot]
+		l.held[slot] = l.gain
+		g := l.sum / float64(frames)
+		if g > 1 {
+			g = 1
+		}
+		copy(l.delay[slot*l.channels:], samples[i:i+l.channels])
+		if l.n >= frames-1 {
+			if g < l.reduction {
+				l.reduction = g
+			}
+			old := (l.n + 1) % frames * l.channels
+			for c := 0; c < l.channels; c++ {
+				samples[out+c] = l.delay[old+c] * float32(g)
+			}
+			out += l.channels
+		}
+		l.n++
+	}
+	return samples[:out]
+}
+
+// Reduction returns the largest gain reduction in dB since it was last
+// called.
+func (l *Limiter) Reduction() float64 {
+	r := -20 * math.Log10(l.reduction)
+	l.reduction = 1
+	return r
+}
@@ -0,0 +1,180 @@
+package dsp
+
+import (
+	"math"
+)
+
+const (
+	// loudnessStep is the hop, in seconds, between the 400 ms gating
+	// blocks, which overlap by 75%.
+	loudnessStep = 0.1
+	// loudnessGate is the absolute gate in LUFS, and loudnessRelative the
+	// relative gate in LU below the loudness of the blocks passing it.
+	loudnessGate     = -70
+	loudnessRelative = -10
+)
+
+// LoudnessMeter measures the integrated loudness of a stream as specified by
+// ITU-R BS.1770-4 and EBU R128.
+type LoudnessMeter struct {
+	channels int
+	weights  []float64
+	// filters are the two stages of the K-weighting filter.
+	filters [2]biquad
+	state   [][2]biquadState // [channel][stage]
+	// stepFrames is the number of frames in each step, and frames the
+	// number in the current one, whose weighted energy is sum.
+	stepFrames int
+	frames     int
+	sum        float64
+	// steps are the mean energies of the last four steps, which make a
+	// block.
+	steps  []float64
+	blocks []float64
+	peak   float64
+}
+
+// NewLoudnessMeter returns a loudness meter for a stream of the given
+// format.
+func NewLoudnessMeter(sampleRate, channels int) *LoudnessMeter {
+	m := &LoudnessMeter{
+		channels:   channels,
+		weights:    make([]float64, channels),
+		filters:    kWeighting(float64(sampleRate)),
+		state:      make([][2]biquadState, channels),
+		stepFrames: int(float64(sampleRate) * loudnessStep),
+	}
+	if m.stepFrames < 1 {
+		m.stepFrames = 1
+	}
+	for c := range m.weights {
+		m.weights[c] = 1
+	}
+	if channels == 6 {
+		// 5.1: the LFE channel is excluded and surrounds weighted up.
+		m.weights[3] = 0
+		m.weights[4] = 1.41
+		m.weights[5] = 1.41
+	}
+	return m
+}
+
+// kWeighting returns the high shelf and high pass filters of K-weighting at
+// sampleRate, with the coefficients of libebur128 so any rate is exact.
+func kWeighting(sampleRate float64) [2]biquad {
+	f0 := 1681.974450955533
+	g := 3.999843853973347
+	q := 0.7071752369554196
+	k := math.Tan(math.Pi * f0 / sampleRate)
+	vh := math.Pow(10, g/20)
+	vb := math.Pow(vh, 0.4996667741545416)
+	a0 := 1 + k/q + k*k
+	shelf := biquad{
+		b0: (vh + vb*k/q + k*k) / a0,
+		b1: 2 * (k*k - vh) / a0,
+		b2: (vh - vb*k/q + k*k) / a0,
+		a1: 2 * (k*k - 1) / a0,
+		a2: (1 - k/q + k*k) / a0,
+	}
+	f0 = 38.13547087602444
+	q = 0.5003270373238773
+	k = math.Tan(math.Pi * f0 / sampleRate)
+	a0 = 1 + k/q + k*k
+	highPass := biquad{
+		b0: 1,
+		b1: -2,
+		b2: 1,
+		a1: 2 * (k*k - 1) / a0,
+		a2: (1 - k/q + k*k) / a0,
+	}
+	return [2]biquad{shelf, highPass}
+}
+
+func (m *LoudnessMeter) Write(samples []float32) {
+	if m.channels == 0 {
+		return
+	}
+	for i := 0; i+m.channels <= len(samples); i += m.channels {
+		for c := 0; c < m.channels; c++ {
+			x := float64(samples[i+c])
+			if a := math.Abs(x); a > m.peak {
+				m.peak = a
+			}
+			if m.weights[c] == 0 {
+				continue
+			}
+			for j, f := range m.filters {
+				st := &m.state[c][j]
+				y := f.b0*x + f.b1*st.x1 + f.b2*st.x2 - f.a1*st.y1 - f.a2*st.y2
+				st.x2, st.x1 = st.x1, x
+				st.y2, st.y1 = st.y1, y
+				x = y
+			}
+			m.sum += m.weights[c] * x * x
+		}
+		m.frames++
+		if m.frames == m.stepFrames {
+			m.step()
+		}
+	}
+}
+
+func (m *LoudnessMeter) step() {
+	m.steps = append(m.steps, m.sum/float64(m.frames))
+	m.sum = 0
+	m.frames = 0
+	if len(m.steps) < 4 {
+		return
+	}
+	m.steps = m.steps[len(m.steps)-4:]
+	var e float64
+	for _, s := range m.steps {
+		e += s
+	}
+	m.blocks = append(m.blocks, e/4)
+}
+
+// Blocks returns the energies of the gating blocks measured, for the
+// loudness of several streams together, like an album.
+func (m *LoudnessMeter) Blocks() []float64 {
+	return m.blocks
+}
+
+// Integrated returns the integrated loudness in LUFS, or -Inf if the stream
+// is silent or shorter than a block.
+func (m *LoudnessMeter) Integrated() float64 {
+	return GatedLoudness(m.blocks)
+}
+
+// Peak returns the sample peak as a linear amplitude.
+func (m *LoudnessMeter) Peak() float64 {
+	return m.peak
+}
+
+// GatedLoudness returns the integrated loudness in LUFS of the gating blocks
+// with energies blocks, or -Inf if all are gated.
+func GatedLoudness(blocks []float64) float64 {
+	gate := func(threshold float64) float64 {
+		var sum float64
+		n := 0
+		for _, e := range blocks {
+			if energyLoudness(e) > threshold {
+				sum += e
+				n++
+			}
+		}
+		if n == 0 {
+			return math.Inf(-1)
+		}
+		return energyLoudness(sum / float64(n))
+	}
+	l := gate(loudnessGate)
+	if math.IsInf(l, -1) {
+		return l
+	}
+	return gate(math.Max(l+loudnessRelative, loudnessGate))
+}
+
+func energyLoudness(e float64) float64 {
+	return -0.691 + 10*math.Log10(e)
+}
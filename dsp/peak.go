@@ -0,0 +1,102 @@
+package dsp
+
+import "math"
+
+const (
+	// truePeakOversample is the oversampling of true peak measurement, and
+	// truePeakTaps the length of each phase of its interpolation filter,
+	// as in ITU-R BS.1770-4 Annex 2.
+	truePeakOversample = 4
+	truePeakTaps       = 12
+)
+
+// truePeakPhases are the interpolation filters of the points between
+// samples, a Kaiser windowed sinc.
+var truePeakPhases [truePeakOversample - 1][truePeakTaps]float64
+
+func init() {
+	const beta = 7
+	half := float64(truePeakTaps) / 2
+	i0b := besselI0(beta)
+	for k := range truePeakPhases {
+		for j := range truePeakPhases[k] {
+			// Distance from the point to tap j, the center between
+			// taps half-1 and half.
+			x := float64(j) - half + float64(k+1)/truePeakOversample
+			w := x / (half + 1)
+			truePeakPhases[k][j] = sinc(x) * besselI0(beta*math.Sqrt(1-w*w)) / i0b
+		}
+	}
+}
+
+// PeakMeter measures the sample peak and true peak, the peak of the
+// reconstructed signal between samples, of a stream, and counts the
+// samples that clip: those beyond full scale, which outputs of integer
+// samples cut off.
+type PeakMeter struct {
+	channels int
+	// hist are the last truePeakTaps samples of each channel, most recent
+	// at pos-1.
+	hist     [][truePeakTaps]float64
+	pos      int
+	peak     float64
+	truePeak float64
+	clipped  int64
+}
+
+// NewPeakMeter returns a peak meter for a stream of the given channels.
+func NewPeakMeter(channels int) *PeakMeter {
+	if channels < 1 {
+		channels = 1
+	}
+	return &PeakMeter{
+		channels: channels,
+		hist:     make([][truePeakTaps]float64, channels),
+	}
+}
+
+func (m *PeakMeter) Write(samples []float32) {
+	for i := 0; i+m.channels <= len(samples); i += m.channels {
+		for c := 0; c < m.channels; c++ {
+			v := float64(samples[i+c])
+			a := math.Abs(v)
+			if a > 1 {
+				m.clipped++
+			}
+			if a > m.peak {
+				m.peak = a
+			}
+			h := &m.hist[c]
+			h[m.pos] = v
+			for k := range truePeakPhases {
+				var y float64
+				for j, t := range truePeakPhases[k] {
+					// Tap j is the sample j after the oldest.
+					y += t * h[(m.pos+1+j)%truePeakTaps]
+				}
+				if y = math.Abs(y); y > m.truePeak {
+					m.truePeak = y
+				}
+			}
+		}
+		m.pos = (m.pos + 1) % truePeakTaps
+	}
+	if m.peak > m.truePeak {
+		m.truePeak = m.peak
+	}
+}
+
+// Peak returns the sample peak in dBFS.
+func (m *PeakMeter) Peak() float64 {
+	return dbfs(m.peak)
+}
+
+// TruePeak returns the true peak in dBTP.
+func (m *PeakMeter) TruePeak() float64 {
+	return dbfs(m.truePeak)
+}
+
+// Clipped returns the number of samples that clip.
+func (m *PeakMeter) Clipped() int64 {
+	return m.clipped
+}
@@ -0,0 +1,110 @@
+package dsp
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+)
+
+// Plugin is a LADSPA plugin stage.
+type Plugin struct {
+	// Path is the file name of the plugin library in the LADSPA
+	// directories, like cmt.so, and Label the plugin in it.
+	Path  string
+	Label string
+	// Controls are the values of control input ports by name. Others use
+	// their defaults.
+	Controls map[string]float64 `json:",omitempty"`
+}
+
+// PluginInfo describes a LADSPA plugin.
+type PluginInfo struct {
+	Path  string
+	Label string
+	Name  string
+	Maker string
+	// Inputs and Outputs are the number of audio ports.
+	Inputs, Outputs int
+	Controls        []PluginControl
+}
+
+// PluginControl is a control input port of a plugin.
+type PluginControl struct {
+	Name string
+	// Min and Max are the bounds of the value, if HasMin and HasMax, and
+	// Default its default.
+	Min, Max       float64
+	HasMin, HasMax bool
+	Default        float64
+	Toggle         bool
+	Integer        bool
+}
+
+// pluginDirs returns the directories searched for LADSPA plugins:
+// LADSPA_PATH, or the usual system directories.
+func pluginDirs() []string {
+	if p := os.Getenv("LADSPA_PATH"); p != "" {
+		return filepath.SplitList(p)
+	}
+	dirs := []string{
+		"/usr/lib/ladspa",
+		"/usr/local/lib/ladspa",
+		"/usr/lib64/ladspa",
+	}
+	if m, _ := filepath.Glob("/usr/lib/*-linux-gnu*/ladspa"); m != nil {
+		dirs = append(dirs, m...)
+	}
+	if home, err := os.UserHomeDir(); err == nil {
+		dirs = append(dirs, filepath.Join(home, ".ladspa"))
+	}
+	return dirs
+}
+
+// pluginPath returns the path of the plugin library name, a file name like
+// cmt.so, in the first of pluginDirs that has it. Only those directories
+// are searched, so no other library can be loaded.
+func pluginPath(name string) (string, error) {
+	if name == "" || name == "." || name == ".." || strings.ContainsAny(name, `/\`) {
+		return "", fmt.Errorf("ladspa: plugin library must be a file name in the LADSPA directories: %q", name)
+	}
+	for _, dir := range pluginDirs() {
+		p := filepath.Join(dir, name)
+		if fi, err := os.Stat(p); err == nil && fi.Mode().IsRegular() {
+			return p, nil
+		}
+	}
+	return "", fmt.Errorf("ladspa: no plugin library %s", name)
+}
+
+// Plugins lists the LADSPA plugins installed, sorted by name.
+func Plugins() []PluginInfo {
+	var infos []PluginInfo
+	seen := make(map[string]bool)
+	for _, dir := range pluginDirs() {
+		files, _ := filepath.Glob(filepath.Join(dir, "*.so"))
+		for _, f := range files {
+			// Libraries are loaded by file name from the first directory
+			// with it, so later ones of the same name are hidden.
+			name := filepath.Base(f)
+			resolved, err := filepath.EvalSymlinks(f)
+			if err != nil || seen[resolved] || seen[name] {
+				continue
+			}
+			seen[resolved], seen[name] = true, true
+			is, err := pluginInfos(f)
+			if err != nil {
+				continue
+			}
+			for i := range is {
+				is[i].Path = name
+			}
+			infos = append(infos, is...)
+		}
+	}
+	sort.Slice(infos, func(i, j int) bool {
+		return strings.ToLower(infos[i].Name) < strings.ToLower(infos[j].Name)
+	})
+	return infos
+}
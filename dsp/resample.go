@@ -0,0 +1,153 @@
+package dsp
+
+import (
+	"fmt"
+	"math"
+)
+
+// Quality selects the resampler's filter length.
+type Quality string
+
+const (
+	QualityFast   Quality = "fast"
+	QualityMedium Quality = "medium"
+	QualityBest   Quality = "best"
+)
+
+type qualityParams struct {
+	// zeros is the number of sinc zero crossings on each side of the kernel.
+	zeros int
+	// beta is the Kaiser window shape parameter.
+	beta float64
+}
+
+var qualities = map[Quality]qualityParams{
+	QualityFast:   {zeros: 4, beta: 5},
+	QualityMedium: {zeros: 12, beta: 7},
+	QualityBest:   {zeros: 32, beta: 9},
+}
+
+// ParseQuality returns the named quality.
+func ParseQuality(s string) (Quality, error) {
+	q := Quality(s)
+	if _, ok := qualities[q]; !ok {
+		return "", fmt.Errorf("unknown resampler quality: %v", s)
+	}
+	return q, nil
+}
+
+// oversample is the number of kernel table entries per zero crossing.
+const oversample = 256
+
+// Resampler converts sample rates with a Kaiser windowed sinc interpolator.
+type Resampler struct {
+	channels int
+	step     float64 // input frames per output frame
+	cutoff   float64 // normalized to the input rate's Nyquist
+	width    int     // kernel half width in input frames
+	table    []float64
+	// in is buffered input; pos is the position of the next output frame in
+	// it, in frames.
+	in  []float32
+	pos float64
+}
+
+// NewResampler returns a stage converting from inRate to outRate.
+func NewResampler(inRate, outRate float64, channels int, q Quality) *Resampler {
+	p, ok := qualities[q]
+	if !ok {
+		p = qualities[QualityMedium]
+	}
+	r := &Resampler{
+		channels: channels,
+		step:     inRate / outRate,
+		cutoff:   1,
+	}
+	// When downsampling, lower the cutoff to the output Nyquist to prevent
+	// aliasing, widening the kernel proportionally.
+	if r.step > 1 {
+		r.cutoff = 1 / r.step
+	}
+	r.width = int(math.Ceil(float64(p.zeros) / r.cutoff))
+	r.table = make([]float64, p.zeros*oversample+2)
+	i0b := besselI0(p.beta)
+	for i := range r.table {
+		x := float64(i) / oversample
+		if x > float64(p.zeros) {
+			break
+		}
+		w := x / float64(p.zeros)
+		r.table[i] = sinc(x) * besselI0(p.beta*math.Sqrt(1-w*w)) / i0b
+	}
+	// Start with silence so the first output frame is centered on the first
+	// input frame.
+	r.in = make([]float32, r.width*channels)
+	r.pos = float64(r.width)
+	return r
+}
+
+func sinc(x float64) float64 {
+	if x == 0 {
+		return 1
+	}
+	return math.Sin(math.Pi*x) / (math.Pi * x)
+}
+
+// besselI0 is the zeroth order modified Bessel function of the first kind.
+func besselI0(x float64) float64 {
+	sum, term := 1.0, 1.0
+	for k := 1; k < 50; k++ {
+		term *= (x / 2) / float64(k)
+		sum += term * term
+		if term*term < sum*1e-12 {
+			break
+		}
+	}
+	return sum
+}
+
+// kernel returns the filter coefficient at distance x input frames.
+func (r *Resampler) kernel(x float64) float64 {
+	x = math.Abs(x) * r.cutoff * oversample
+	i := int(x)
+	if i+1 >= len(r.table) {
+		return 0
+	}
+	frac := x - float64(i)
+	return (r.table[i] + (r.table[i+1]-r.table[i])*frac) * r.cutoff
+}
+
+func (r *Resampler) Process(samples []float32) []float32 {
+	if r.channels == 0 {
+		return samples
+	}
+	r.in = append(r.in, samples...)
+	frames := len(r.in) / r.channels
+	var out []float32
+	acc := make([]float64, r.channels)
+	for int(r.pos)+r.width < frames {
+		center := int(r.pos)
+		for c := range acc {
+			acc[c] = 0
+		}
+		for k := center - r.width + 1; k <= center+r.width; k++ {
+			h := r.kernel(r.pos - float64(k))
+			if h == 0 {
+				continue
+			}
+			for c := range acc {
+				acc[c] += h * float64(r.in[k*r.channels+c])
+			}
+		}
+		for _, v := range acc {
+			out = append(out, float32(v))
+		}
+		r.pos += r.step
+	}
+	// Keep only the history needed for future output.
+	if drop := int(r.pos) - r.width; drop > 0 {
+		r.in = append(r.in[:0], r.in[drop*r.channels:]...)
+		r.pos -= float64(drop)
+	}
+	return out
+}
@@ -0,0 +1,121 @@
+package dsp
+
+import "math"
+
+// SilenceThreshold is the absolute sample level (-60 dBFS) below which audio
+// is considered silent.
+const SilenceThreshold = 0.001
+
+// maxHeldSilence is the longest trailing silence held back, in seconds. Longer
+// silences are played, since they are probably gaps before hidden tracks.
+const maxHeldSilence = 60
+
+type silence struct {
+	channels int
+	started  bool
+	held     []float32
+	maxHeld  int
+}
+
+// NewSilenceTrimmer returns a stage that drops leading silence and holds back
+// silence until more audio arrives, so silence at the end of a song is never
+// played.
+func NewSilenceTrimmer(sampleRate, channels int) Stage {
+	return &silence{
+		channels: channels,
+		maxHeld:  sampleRate * channels * maxHeldSilence,
+	}
+}
+
+// loud returns whether frame i of samples is above the silence threshold.
+func (s *silence) loud(samples []float32, i int) bool {
+	for c := 0; c < s.channels; c++ {
+		v := samples[i*s.channels+c]
+		if v > SilenceThreshold || v < -SilenceThreshold {
+			return true
+		}
+	}
+	return false
+}
+
+func (s *silence) Process(samples []float32) []float32 {
+	if s.channels == 0 {
+		return samples
+	}
+	frames := len(samples) / s.channels
+	if !s.started {
+		i := 0
+		for i < frames && !s.loud(samples, i) {
+			i++
+		}
+		if i == frames {
+			return samples[:0]
+		}
+		s.started = true
+		samples = samples[i*s.channels:]
+		frames -= i
+	}
+	last := frames
+	for last > 0 && !s.loud(samples, last-1) {
+		last--
+	}
+	if last == 0 {
+		s.held = append(s.held, samples...)
+		if len(s.held) > s.maxHeld {
+			out := s.held
+			s.held = nil
+			return out
+		}
+		return samples[:0]
+	}
+	out := append(s.held, samples[:last*s.channels]...)
+	s.held = append([]float32(nil), samples[last*s.channels:]...)
+	return out
+}
+
+// Level returns the RMS level of samples in dBFS.
+func Level(samples []float32) float64 {
+	if len(samples) == 0 {
+		return math.Inf(-1)
+	}
+	var sum float64
+	for _, v := range samples {
+		sum += float64(v) * float64(v)
+	}
+	return 10 * math.Log10(sum/float64(len(samples)))
+}
+
+// Crossfade mixes the end of one song into the start of the next with equal
+// power curves.
+type Crossfade struct {
+	tail     []float32
+	channels int
+	pos      int
+}
+
+// NewCrossfade returns a stage that fades out tail while fading in the
+// samples passed to Process.
+func NewCrossfade(tail []float32, channels int) *Crossfade {
+	return &Crossfade{
+		tail:     tail,
+		channels: channels,
+	}
+}
+
+// Done reports whether all of the tail has been mixed.
+func (x *Crossfade) Done() bool {
+	return x.pos >= len(x.tail)
+}
+
+func (x *Crossfade) Process(samples []float32) []float32 {
+	frames := len(x.tail) / x.channels
+	for i := range samples {
+		if x.pos >= len(x.tail) {
+			break
+		}
+		t := float64(x.pos/x.channels) / float64(frames) * math.Pi / 2
+		samples[i] = samples[i]*float32(math.Sin(t)) + x.tail[x.pos]*float32(math.Cos(t))
+		x.pos++
+	}
+	return samples
+}
@@ -0,0 +1,124 @@
+package dsp
+
+import (
+	"fmt"
+	"math"
+)
+
+// Stereo holds the channel mixing options.
+type Stereo struct {
+	// Crossfeed mixes some of each channel, low-passed, into the other, like
+	// speakers are heard, for listening on headphones.
+	Crossfeed bool
+	// Mono downmixes all channels to each.
+	Mono bool
+	// Balance is the left-right balance in [-1, 1]: -1 is left only, 0
+	// centered, and 1 right only.
+	Balance float64
+}
+
+// Validate checks that the options are usable.
+func (s Stereo) Validate() error {
+	if s.Balance < -1 || s.Balance > 1 || math.IsNaN(s.Balance) {
+		return fmt.Errorf("balance must be between -1 and 1: %v", s.Balance)
+	}
+	return nil
+}
+
+// Enabled reports whether s changes the audio.
+func (s Stereo) Enabled() bool {
+	return s.Crossfeed || s.Mono || s.Balance != 0
+}
+
+const (
+	// crossfeedCut is the cut frequency in Hz of the low-passed feed, and
+	// crossfeedLevel the difference in dB at low frequencies between the
+	// direct and crossed signals. These are the defaults of Boris Mikhaylov's
+	// bs2b, which implements Benjamin Bauer's crossfeed.
+	crossfeedCut   = 700
+	crossfeedLevel = 4.5
+)
+
+type stereo struct {
+	Stereo
+	channels int
+
+	// Crossfeed filter coefficients and state, from bs2b.
+	a0Lo, b1Lo       float64
+	a0Hi, a1Hi, b1Hi float64
+	gain             float64
+	lo, hi, asis     [2]float64
+}
+
+// NewStereo returns a stage applying s to interleaved audio. Crossfeed and
+// balance only apply to stereo audio.
+func NewStereo(s Stereo, sampleRate, channels int) Stage {
+	st := &stereo{
+		Stereo:   s,
+		channels: channels,
+	}
+	if s.Crossfeed && channels == 2 {
+		gbLo := crossfeedLevel*-5/6 - 3
+		gbHi := crossfeedLevel/6 - 3
+		gLo := math.Pow(10, gbLo/20)
+		gHi := 1 - math.Pow(10, gbHi/20)
+		cutHi := crossfeedCut * math.Pow(2, (gbLo-20*math.Log10(gHi))/12)
+
+		x := math.Exp(-2 * math.Pi * crossfeedCut / float64(sampleRate))
+		st.b1Lo = x
+		st.a0Lo = gLo * (1 - x)
+		x = math.Exp(-2 * math.Pi * cutHi / float64(sampleRate))
+		st.b1Hi = x
+		st.a0Hi = 1 - gHi*(1-x)
+		st.a1Hi = -x
+		st.gain = 1 / (1 - gHi + gLo)
+	}
+	return st
+}
+
+func (s *stereo) Process(samples []float32) []float32 {
+	if s.channels < 1 {
+		return samples
+	}
+	if s.Mono && s.channels > 1 {
+		for i := 0; i+s.channels <= len(samples); i += s.channels {
+			var sum float32
+			for _, v := range samples[i : i+s.channels] {
+				sum += v
+			}
+			sum /= float32(s.channels)
+			for c := 0; c < s.channels; c++ {
+				samples[i+c] = sum
+			}
+		}
+	}
+	if s.channels != 2 {
+		return samples
+	}
+	// Crossfeed does nothing to mono audio.
+	if s.Crossfeed && !s.Mono {
+		for i := 0; i+1 < len(samples); i += 2 {
+			in := [2]float64{float64(samples[i]), float64(samples[i+1])}
+			for c := range in {
+				s.lo[c] = s.a0Lo*in[c] + s.b1Lo*s.lo[c]
+				s.hi[c] = s.a0Hi*in[c] + s.a1Hi*s.asis[c] + s.b1Hi*s.hi[c]
+			}
+			s.asis = in
+			samples[i] = float32((s.hi[0] + s.lo[1]) * s.gain)
+			samples[i+1] = float32((s.hi[1] + s.lo[0]) * s.gain)
+		}
+	}
+	if s.Balance != 0 {
+		left, right := float32(1), float32(1)
+		if s.Balance > 0 {
+			left = float32(1 - s.Balance)
+		} else {
+			right = float32(1 + s.Balance)
+		}
+		for i := 0; i+1 < len(samples); i += 2 {
+			samples[i] *= left
+			samples[i+1] *= right
+		}
+	}
+	return samples
+}
@@ -0,0 +1,135 @@
+package dsp
+
+import "math"
+
+// NewSpeed returns a stage that changes playback speed by factor speed
+// (e.g., 1.5 plays 50% faster) and shifts the pitch by semitones. Tempo is
+// changed without affecting pitch using WSOLA (waveform similarity
+// overlap-add). Pitch shifting is done by time-stretching and then
+// resampling back to the desired duration.
+func NewSpeed(speed, semitones float64, sampleRate, channels int, q Quality) Stage {
+	ratio := math.Pow(2, semitones/12)
+	var chain Chain
+	if tempo := speed / ratio; tempo != 1 {
+		chain = append(chain, newWSOLA(tempo, sampleRate, channels))
+	}
+	if ratio != 1 {
+		chain = append(chain, NewResampler(ratio, 1, channels, q))
+	}
+	return chain
+}
+
+type wsola struct {
+	tempo    float64
+	channels int
+	// size is the frame length and hop the synthesis hop, both in frames.
+	size, hop, tolerance int
+	window               []float32
+
+	// in holds buffered input starting at absolute frame base.
+	in   []float32
+	base int
+	// nominal is the next ideal analysis position, and prev the position of
+	// the previously chosen segment.
+	nominal float64
+	prev    int
+	overlap []float32
+	started bool
+}
+
+func newWSOLA(tempo float64, sampleRate, channels int) *wsola {
+	size := sampleRate * 30 / 1000
+	size -= size % 2
+	w := &wsola{
+		tempo:     tempo,
+		channels:  channels,
+		size:      size,
+		hop:       size / 2,
+		tolerance: sampleRate * 8 / 1000,
+		window:    make([]float32, size),
+	}
+	// A periodic Hann window sums to 1 at 50% overlap.
+	for i := range w.window {
+		w.window[i] = float32(0.5 - 0.5*math.Cos(2*math.Pi*float64(i)/float64(size)))
+	}
+	w.overlap = make([]float32, w.hop*channels)
+	return w
+}
+
+// frames returns the number of buffered input frames.
+func (w *wsola) frames() int {
+	return len(w.in) / w.channels
+}
+
+// mono returns the channel average of absolute frame f.
+func (w *wsola) mono(f int) float32 {
+	i := (f - w.base) * w.channels
+	var s float32
+	for c := 0; c < w.channels; c++ {
+		s += w.in[i+c]
+	}
+	return s
+}
+
+// best returns the position near nominal whose overlap region best matches
+// the natural continuation of the previous segment.
+func (w *wsola) best() int {
+	nominal := int(w.nominal)
+	if !w.started {
+		return nominal
+	}
+	natural := w.prev + w.hop
+	lo := nominal - w.tolerance
+	if lo < w.base {
+		lo = w.base
+	}
+	bestPos, bestCorr := nominal, math.Inf(-1)
+	for p := lo; p <= nominal+w.tolerance; p++ {
+		var corr float64
+		// Subsample the correlation; the full sum is unnecessarily slow.
+		for i := 0; i < w.hop; i += 4 {
+			corr += float64(w.mono(p+i) * w.mono(natural+i))
+		}
+		if corr > bestCorr {
+			bestPos, bestCorr = p, corr
+		}
+	}
+	return bestPos
+}
+
+func (w *wsola) Process(samples []float32) []float32 {
+	w.in = append(w.in, samples...)
+	var out []float32
+	for {
+		// The search window and natural continuation must be buffered.
+		need := int(w.nominal) + w.tolerance + w.size
+		if n := w.prev + w.hop + w.size; n > need {
+			need = n
+		}
+		if need > w.base+w.frames() {
+			break
+		}
+		pos := w.best()
+		start := (pos - w.base) * w.channels
+		for i := 0; i < w.hop; i++ {
+			for c := 0; c < w.channels; c++ {
+				j := i*w.channels + c
+				out = append(out, w.overlap[j]+w.window[i]*w.in[start+j])
+				w.overlap[j] = w.window[i+w.hop] * w.in[start+w.hop*w.channels+j]
+			}
+		}
+		w.prev = pos
+		w.started = true
+		w.nominal += float64(w.hop) * w.tempo
+		// Discard input that can no longer be used.
+		keep := int(w.nominal) - w.tolerance
+		if w.prev+w.hop < keep {
+			keep = w.prev + w.hop
+		}
+		if drop := keep - w.base; drop > 0 {
+			w.in = append(w.in[:0], w.in[drop*w.channels:]...)
+			w.base = keep
+		}
+	}
+	return out
+}
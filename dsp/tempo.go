@@ -0,0 +1,140 @@
+package dsp
+
+import (
+	"math"
+	"math/cmplx"
+)
+
+// framer splits a stream into overlapping mono frames.
+type framer struct {
+	channels int
+	size     int
+	hop      int
+	buf      []float64
+}
+
+// write downmixes samples and calls fn with each complete frame.
+func (f *framer) write(samples []float32, fn func(frame []float64)) {
+	for i := 0; i+f.channels <= len(samples); i += f.channels {
+		var mono float64
+		for c := 0; c < f.channels; c++ {
+			mono += float64(samples[i+c])
+		}
+		f.buf = append(f.buf, mono/float64(f.channels))
+		if len(f.buf) == f.size {
+			fn(f.buf)
+			f.buf = append(f.buf[:0], f.buf[f.hop:]...)
+		}
+	}
+}
+
+const (
+	tempoSize = 1024
+	tempoHop  = 512
+	minBPM    = 60
+	maxBPM    = 200
+	// tempoCenter is the tempo, in BPM, preferred when choosing between
+	// multiples of the beat.
+	tempoCenter = 120
+)
+
+// TempoDetector estimates the tempo of a stream from the periodicity of its
+// onsets.
+type TempoDetector struct {
+	sampleRate int
+	f          framer
+	window     []float64
+	fft        []complex128
+	prev       []float64
+	// onsets is the spectral flux of each frame.
+	onsets []float64
+}
+
+// NewTempoDetector returns a tempo detector for a stream of the given format.
+func NewTempoDetector(sampleRate, channels int) *TempoDetector {
+	return &TempoDetector{
+		sampleRate: sampleRate,
+		f: framer{
+			channels: channels,
+			size:     tempoSize,
+			hop:      tempoHop,
+		},
+		window: Hann(tempoSize),
+		fft:    make([]complex128, tempoSize),
+		prev:   make([]float64, tempoSize/2),
+	}
+}
+
+func (t *TempoDetector) Write(samples []float32) {
+	t.f.write(samples, t.frame)
+}
+
+func (t *TempoDetector) frame(x []float64) {
+	for i, v := range x {
+		t.fft[i] = complex(v*t.window[i], 0)
+	}
+	FFT(t.fft)
+	var flux float64
+	for i := range t.prev {
+		m := math.Log1p(100 * cmplx.Abs(t.fft[i]))
+		if d := m - t.prev[i]; d > 0 {
+			flux += d
+		}
+		t.prev[i] = m
+	}
+	t.onsets = append(t.onsets, flux)
+}
+
+// BPM returns the estimated tempo in beats per minute, or 0 if there is too
+// little rhythmic content to tell.
+func (t *TempoDetector) BPM() float64 {
+	fps := float64(t.sampleRate) / tempoHop
+	minLag := int(60 * fps / maxBPM)
+	maxLag := int(60*fps/minBPM) + 1
+	if minLag < 1 || len(t.onsets) < 4*maxLag {
+		return 0
+	}
+	var mean float64
+	for _, v := range t.onsets {
+		mean += v
+	}
+	mean /= float64(len(t.onsets))
+	env := make([]float64, len(t.onsets))
+	var energy float64
+	for i, v := range t.onsets {
+		env[i] = v - mean
+		energy += env[i] * env[i]
+	}
+	if energy == 0 {
+		return 0
+	}
+	energy /= float64(len(env))
+	// Autocorrelate the onsets, weighted toward tempoCenter to avoid
+	// picking half or double the tempo.
+	score := make([]float64, maxLag+2)
+	best := 0
+	for lag := minLag - 1; lag <= maxLag+1; lag++ {
+		if lag < 1 {
+			continue
+		}
+		var r float64
+		for i := lag; i < len(env); i++ {
+			r += env[i] * env[i-lag]
+		}
+		r /= float64(len(env)-lag) * energy
+		octaves := math.Log2(60 * fps / float64(lag) / tempoCenter)
+		score[lag] = r * math.Exp(-octaves*octaves)
+		if lag >= minLag && lag <= maxLag && (best == 0 || score[lag] > score[best]) {
+			best = lag
+		}
+	}
+	if score[best] < 0.05 {
+		return 0
+	}
+	// Interpolate the peak for a fractional lag.
+	lag := float64(best)
+	if a, b, c := score[best-1], score[best], score[best+1]; a-2*b+c < 0 {
+		lag += 0.5 * (a - c) / (a - 2*b + c)
+	}
+	return math.Round(600*fps/lag) / 10
+}
@@ -0,0 +1,76 @@
+package dsp
+
+// PeaksPerSecond is the resolution of waveform overviews.
+const PeaksPerSecond = 10
+
+// Peaks computes a waveform overview of a stream: the peak level of each
+// 1/PeaksPerSecond of it, scaled to 0-255.
+type Peaks struct {
+	// frames is the number of frames per peak.
+	frames   int
+	channels int
+	n        int
+	max      float32
+	peaks    []byte
+}
+
+// NewPeaks returns a waveform overview of a stream of the given format.
+func NewPeaks(sampleRate, channels int) *Peaks {
+	frames := sampleRate / PeaksPerSecond
+	if frames < 1 {
+		frames = 1
+	}
+	return &Peaks{
+		frames:   frames,
+		channels: channels,
+	}
+}
+
+func (p *Peaks) Write(samples []float32) {
+	for _, s := range samples {
+		if s < 0 {
+			s = -s
+		}
+		if s > p.max {
+			p.max = s
+		}
+		p.n++
+		if p.n == p.frames*p.channels {
+			p.flush()
+		}
+	}
+}
+
+func (p *Peaks) flush() {
+	if p.max > 1 {
+		p.max = 1
+	}
+	p.peaks = append(p.peaks, byte(p.max*255+0.5))
+	p.n, p.max = 0, 0
+}
+
+// Peaks returns the overview of the stream written so far.
+func (p *Peaks) Peaks() []byte {
+	if p.n > 0 {
+		p.flush()
+	}
+	return p.peaks
+}
+
+// DownsamplePeaks reduces peaks to at most n points, each the maximum of
+// the peaks it spans.
+func DownsamplePeaks(peaks []byte, n int) []byte {
+	if n <= 0 || len(peaks) <= n {
+		return peaks
+	}
+	d := make([]byte, n)
+	for i := range d {
+		start, end := i*len(peaks)/n, (i+1)*len(peaks)/n
+		for _, v := range peaks[start:end] {
+			if v > d[i] {
+				d[i] = v
+			}
+		}
+	}
+	return d
+}
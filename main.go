@@ -7,13 +7,19 @@ import (
 	"os"
 	"path/filepath"
 	"runtime"
+	"strconv"
 	"strings"
 	"time"
 
 	"github.com/facebookgo/httpcontrol"
+	"github.com/mjibson/moggio/analyze"
+	"github.com/mjibson/moggio/notify"
 	"github.com/mjibson/moggio/server"
+	"github.com/mjibson/moggio/tray"
+	"github.com/mjibson/moggio/tui"
 
 	// codecs
+	_ "github.com/mjibson/moggio/codec/aiff"
 	_ "github.com/mjibson/moggio/codec/flac"
 	_ "github.com/mjibson/moggio/codec/gme"
 	_ "github.com/mjibson/moggio/codec/mpa"
@@ -24,6 +30,7 @@ import (
 
 	// protocols
 	_ "github.com/mjibson/moggio/protocol/bandcamp"
+	_ "github.com/mjibson/moggio/protocol/beets"
 	"github.com/mjibson/moggio/protocol/drive"
 	"github.com/mjibson/moggio/protocol/dropbox"
 	_ "github.com/mjibson/moggio/protocol/file"
@@ -38,11 +45,37 @@ var (
 	flagDropbox    = flag.String("dropbox", "rnhpqsbed2q2ezn:ldref688unj74ld", "Dropbox API credentials of the form ClientID:ClientSecret")
 	flagSoundcloud = flag.String("soundcloud", "ec28c2226a0838d01edc6ed0014e462e:a115e94029d698f541960c8dc8560978", "SoundCloud API credentials of the form ClientID:ClientSecret")
 	flagDev        = flag.Bool("dev", false, "enable dev mode")
+	flagAuth       = flag.String("auth", "", "owner auth token; if set, required for full control (see party mode)")
+	flagOutput     = flag.String("output", "", "audio output backend (e.g., pulse, pw-cat, aplay); overrides the saved setting")
+	flagLastFM     = flag.String("lastfm", "", "Last.fm API key for recommendations; ListenBrainz is used if not set")
+	flagSpotify    = flag.String("spotify", "", "Spotify Web API credentials of the form ClientID:ClientSecret, for playlist import")
+	flagRestore    = flag.String("restore", "", "restore the backup archive at this path into the state file and exit")
+	flagCORS       = flag.String("cors", "", "comma-separated origins allowed to call the API from other sites, or * for any")
+	flagCORSHeader = flag.String("cors-headers", "", "comma-separated request headers allowed from other sites in addition to Authorization and Content-Type")
+	flagName       = flag.String("name", "", "name to advertise to other moggio instances on the local network, which can then hand off playback to this one; empty to disable")
+	flagUPnP       = flag.String("upnp", "", "friendly name to advertise as a UPnP/DLNA media renderer and server on the local network; empty to disable")
+	flagUPnPOpen   = flag.Bool("upnp-open", false, "serve the UPnP devices without the -auth token, which UPnP controllers can't send; anyone on the local network may then control playback and stream songs")
+	flagTelegram   = flag.String("telegram", "", "Telegram bot token; the bot controls playback for the chats in -telegram-chats")
+	flagTGChats    = flag.String("telegram-chats", "", "comma-separated IDs of the Telegram chats allowed to control playback; other chats are told their ID")
+	flagDiscord    = flag.String("discord", "", "Discord application ID to publish the playing song as with Rich Presence, which is then toggled in settings; empty to disable")
 	//flagCentral = flag.String("central", "https://moggio-music-client.appspot.com", "Central Moggio data server; empty to disable")
 	stateFile = flag.String("state", "", "specify non-default statefile location")
 )
 
 func main() {
+	// Client modes of a server.
+	modes := map[string]func([]string) error{
+		"tui":     tui.Main,
+		"notify":  notify.Main,
+		"tray":    tray.Main,
+		"analyze": analyze.Main,
+	}
+	if len(os.Args) > 1 && modes[os.Args[1]] != nil {
+		if err := modes[os.Args[1]](os.Args[2:]); err != nil {
+			log.Fatal(err)
+		}
+		return
+	}
 	flag.Parse()
 	http.DefaultClient = &http.Client{
 		Transport: &httpcontrol.Transport{
@@ -91,8 +124,46 @@ func main() {
 			*stateFile = filepath.Join(os.Getenv("HOME"), ".moggio.state")
 		}
 	}
-	log.Fatal(server.ListenAndServe(*stateFile, *flagAddr, "", *flagDev))
+	if *flagRestore != "" {
+		if err := server.RestoreFile(*stateFile, *flagRestore); err != nil {
+			log.Fatal(err)
+		}
+		log.Println("restored", *flagRestore, "to", *stateFile)
+		return
+	}
+	server.OwnerToken = *flagAuth
+	server.OutputBackend = *flagOutput
+	server.LastFMKey = *flagLastFM
+	server.UPnPName = *flagUPnP
+	server.UPnPOpen = *flagUPnPOpen
+	server.InstanceName = *flagName
+	server.DiscordClientID = *flagDiscord
+	if *flagCORS != "" {
+		server.CORSOrigins = strings.Split(*flagCORS, ",")
+	}
+	if *flagCORSHeader != "" {
+		server.CORSHeaders = strings.Split(*flagCORSHeader, ",")
+	}
+	server.TelegramToken = *flagTelegram
+	if *flagTGChats != "" {
+		for _, s := range strings.Split(*flagTGChats, ",") {
+			id, err := strconv.ParseInt(strings.TrimSpace(s), 10, 64)
+			if err != nil {
+				log.Fatalf("bad telegram chat ID %s", s)
+			}
+			server.TelegramChats = append(server.TelegramChats, id)
+		}
+	}
+	if *flagSpotify != "" {
+		sp := strings.Split(*flagSpotify, ":")
+		if len(sp) != 2 {
+			log.Fatalf("bad spotify string %s", *flagSpotify)
+		}
+		server.SpotifyClientID, server.SpotifyClientSecret = sp[0], sp[1]
+	}
+	if err := server.ListenAndServe(*stateFile, *flagAddr, "", *flagDev); err != nil {
+		log.Fatal(err)
+	}
 }
 
 //go:generate browserify -t [ reactify --es6 ] server/static/src/nav.js -o server/static/js/moggio.js
-//go:generate esc -o server/static.go -pkg server -prefix server server/static/index.html server/static/css server/static/fonts server/static/js
@@ -0,0 +1,122 @@
+// Package notify shows desktop notifications of the songs a moggio server
+// plays.
+package notify
+
+import (
+	"flag"
+	"fmt"
+	"io/ioutil"
+	"log"
+	"os"
+	"os/exec"
+	"runtime"
+
+	"github.com/mjibson/moggio/client"
+)
+
+// Main follows the server's events with args, the command line after
+// "notify", and shows a notification with the artwork of each song as it
+// starts.
+func Main(args []string) error {
+	fs := flag.NewFlagSet("notify", flag.ExitOnError)
+	newClient := client.Flags(fs)
+	fs.Parse(args)
+	c := newClient()
+	if _, err := c.Status(); err != nil {
+		return fmt.Errorf("notify: %s: %v", c.URL, err)
+	}
+	// Each song's artwork has its own file, since notification services
+	// may cache images by name.
+	var art string
+	var last string
+	for s := range c.Statuses() {
+		if s.State != client.StatePlay || s.Song.UID == "" || s.Song.UID == last {
+			continue
+		}
+		last = s.Song.UID
+		info := s.SongInfo
+		title := info.Title
+		if title == "" {
+			title = s.Song.UID
+		}
+		body := info.Artist
+		if info.Album != "" {
+			body += "\n" + info.Album
+		}
+		icon := ""
+		if b, err := c.Art(s.Song, info); err == nil && len(b) > 0 {
+			if f, err := ioutil.TempFile("", "moggio-notify-"); err == nil {
+				_, err := f.Write(b)
+				if f.Close() == nil && err == nil {
+					icon = f.Name()
+				}
+			}
+		}
+		if err := show(title, body, icon); err != nil {
+			log.Printf("notify: %v", err)
+		}
+		if art != "" {
+			os.Remove(art)
+		}
+		art = icon
+	}
+	return nil
+}
+
+// windowsToast shows a toast with the text and image in environment
+// variables, which avoids quoting them. Toasts need a registered app ID, so
+// PowerShell's is used.
+const windowsToast = `
+[Windows.UI.Notifications.ToastNotificationManager, Windows.UI.Notifications, ContentType = WindowsRuntime] > $null
+$type = [Windows.UI.Notifications.ToastTemplateType]::ToastImageAndText02
+$xml = [Windows.UI.Notifications.ToastNotificationManager]::GetTemplateContent($type)
+$text = $xml.GetElementsByTagName('text')
+$text[0].AppendChild($xml.CreateTextNode($env:MOGGIO_TITLE)) > $null
+$text[1].AppendChild($xml.CreateTextNode($env:MOGGIO_BODY)) > $null
+$xml.GetElementsByTagName('image')[0].SetAttribute('src', $env:MOGGIO_IMAGE)
+$toast = [Windows.UI.Notifications.ToastNotification]::new($xml)
+$toast.Tag = 'moggio'
+$app = '{1AC14E77-02E7-4E5D-B744-2EB1AE5198B7}\WindowsPowerShell\v1.0\powershell.exe'
+[Windows.UI.Notifications.ToastNotificationManager]::CreateToastNotifier($app).Show($toast)
+`
+
+// macNotification shows a notification with the text in environment
+// variables.
+const macNotification = `display notification (system attribute "MOGGIO_BODY") with title (system attribute "MOGGIO_TITLE")`
+
+// show shows a notification with the image at icon, if not empty.
+func show(title, body, icon string) error {
+	var cmd *exec.Cmd
+	switch runtime.GOOS {
+	case "windows":
+		cmd = exec.Command("powershell", "-NoProfile", "-NonInteractive", "-Command", windowsToast)
+	case "darwin":
+		// terminal-notifier shows images; AppleScript can't.
+		if _, err := exec.LookPath("terminal-notifier"); err == nil {
+			args := []string{"-title", title, "-message", body, "-group", "moggio"}
+			if icon != "" {
+				args = append(args, "-contentImage", icon)
+			}
+			cmd = exec.Command("terminal-notifier", args...)
+		} else {
+			cmd = exec.Command("osascript", "-e", macNotification)
+		}
+	default:
+		// notify-send sends to the freedesktop notification service on
+		// D-Bus. The hint replaces the previous song's notification.
+		args := []string{"--app-name=moggio", "--hint=string:x-canonical-private-synchronous:moggio"}
+		if icon != "" {
+			args = append(args, "--icon="+icon)
+		}
+		cmd = exec.Command("notify-send", append(args, title, body)...)
+	}
+	cmd.Env = append(os.Environ(),
+		"MOGGIO_TITLE="+title,
+		"MOGGIO_BODY="+body,
+		"MOGGIO_IMAGE="+icon,
+	)
+	if out, err := cmd.CombinedOutput(); err != nil {
+		return fmt.Errorf("%s: %v: %s", cmd.Path, err, out)
+	}
+	return nil
+}
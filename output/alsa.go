@@ -0,0 +1,100 @@
+// +build linux
+
+package output
+
+import (
+	"bufio"
+	"bytes"
+	"fmt"
+	"os/exec"
+	"strconv"
+	"strings"
+	"time"
+)
+
+func init() {
+	register("aplay", &backend{
+		open:    openALSA,
+		devices: alsaDevices,
+	})
+}
+
+// openALSA plays through an aplay subprocess, which talks to ALSA
+// directly, rather than through cgo bindings to libasound. With a hw: device
+// there is no mixer or conversion, so output is bit-perfect.
+func openALSA(f Format) (Output, error) {
+	if _, err := exec.LookPath("aplay"); err != nil {
+		return nil, err
+	}
+	enc := newEncoder(f)
+	format := map[int]string{
+		0:  "FLOAT_LE",
+		16: "S16_LE",
+		24: "S24_3LE",
+		32: "S32_LE",
+	}[enc.bits]
+	args := []string{
+		"-q",
+		"-t", "raw",
+		"-f", format,
+		"-r", strconv.Itoa(f.SampleRate),
+		"-c", strconv.Itoa(f.Channels),
+	}
+	if m := channelMap(f.Channels); m != "" {
+		args = append(args, "--chmap", m)
+	}
+	if f.Device != "" {
+		args = append(args, "-D", f.Device)
+	}
+	if f.Latency > 0 {
+		args = append(args, "-B", fmt.Sprint(int64(f.Latency/time.Microsecond)))
+	}
+	args = append(args, "-")
+	o := &pipeOutput{
+		f:    f,
+		name: "aplay",
+		args: args,
+		enc:  enc,
+	}
+	o.capacity = f.Latency
+	if o.capacity <= 0 {
+		o.capacity = time.Millisecond * 500
+	}
+	if err := o.start(); err != nil {
+		return nil, err
+	}
+	return o, nil
+}
+
+// alsaDevices parses the PCM list from aplay -L, which prints each name
+// followed by indented description lines.
+func alsaDevices() ([]Device, error) {
+	b, err := exec.Command("aplay", "-L").Output()
+	if err != nil {
+		return nil, err
+	}
+	var devs []Device
+	sc := bufio.NewScanner(bytes.NewReader(b))
+	for sc.Scan() {
+		t := sc.Text()
+		if t == "" {
+			continue
+		}
+		if t[0] != ' ' && t[0] != '\t' {
+			devs = append(devs, Device{
+				Name:    t,
+				Default: t == "default",
+			})
+			continue
+		}
+		if len(devs) == 0 {
+			continue
+		}
+		d := &devs[len(devs)-1]
+		if d.Description != "" {
+			d.Description += ", "
+		}
+		d.Description += strings.TrimSpace(t)
+	}
+	return devs, nil
+}
@@ -4,8 +4,10 @@ package output
 
 import (
 	"syscall"
+	"time"
 	"unsafe"
 
+	"github.com/mjibson/moggio/dsp"
 	"github.com/oov/directsound-go/dsound"
 )
 
@@ -18,10 +20,7 @@ var (
 	GetDesktopWindow = user32.MustFindProc("GetDesktopWindow")
 )
 
-const (
-	numBlock = 8
-	bits     = 16
-)
+const numBlock = 8
 
 const (
 	WAIT_OBJECT_0  = 0x00000000
@@ -30,6 +29,7 @@ const (
 )
 
 type output struct {
+	meter
 	ch      chan float32
 	stopped bool
 
@@ -40,23 +40,63 @@ type output struct {
 	blockAlign  int
 	bytesPerSec int
 
-	offset uint32
+	offset    uint32
+	bits      int
+	exclusive bool
+	dither    dsp.Dither
+}
+
+func init() {
+	register("directsound", &backend{
+		open:     openDirectSound,
+		devices:  directSoundDevices,
+		priority: 2,
+	})
 }
 
-func get(sampleRate, channels int) (Output, error) {
+func openDirectSound(f Format) (Output, error) {
 	var err error
+	sampleRate, channels := f.SampleRate, f.Channels
 	o := output{
-		sr:    sampleRate,
-		chans: channels,
-		ch:    make(chan float32, 4096*4),
+		sr:        sampleRate,
+		chans:     channels,
+		ch:        make(chan float32, 4096*4),
+		bits:      f.Bits,
+		exclusive: f.Exclusive,
+	}
+	switch o.bits {
+	case 16, 24, 32:
+	default:
+		o.bits = 16
+	}
+	bits := o.bits
+	level := dsound.DSSCL_PRIORITY
+	if f.Exclusive {
+		// Since Vista, DirectSound treats exclusive like priority, but the
+		// primary buffer format is still set to the stream's.
+		level = dsound.DSSCL_EXCLUSIVE
 	}
 
-	o.ds, err = dsound.DirectSoundCreate(nil)
+	var guid *dsound.GUID
+	if f.Device != "" {
+		err := dsound.DirectSoundEnumerate(func(g *dsound.GUID, description, module string) bool {
+			if g != nil && description == f.Device {
+				c := *g
+				guid = &c
+				return false
+			}
+			return true
+		})
+		if err != nil {
+			return nil, err
+		}
+	}
+	o.ds, err = dsound.DirectSoundCreate(guid)
 	if err != nil {
-		panic(err)
+		return nil, err
 	}
 	desktopWindow, _, err := GetDesktopWindow.Call()
-	err = o.ds.SetCooperativeLevel(syscall.Handle(desktopWindow), dsound.DSSCL_PRIORITY)
+	err = o.ds.SetCooperativeLevel(syscall.Handle(desktopWindow), level)
 	if err != nil {
 		panic(err)
 	}
@@ -68,12 +108,18 @@ func get(sampleRate, channels int) (Output, error) {
 	}
 	o.blockAlign = channels * bits / 8
 	o.bytesPerSec = sampleRate * o.blockAlign
-	o.blockSize = uint32((sampleRate / numBlock) * o.blockAlign)
+	// The buffer holds one second unless a latency was requested.
+	frames := sampleRate / numBlock
+	if f.Latency > 0 {
+		frames = int(int64(sampleRate) * int64(f.Latency) / int64(time.Second) / numBlock)
+	}
+	o.blockSize = uint32(frames * o.blockAlign)
+	o.capacity = time.Duration(frames*numBlock) * time.Second / time.Duration(sampleRate)
 	format := &dsound.WaveFormatEx{
 		FormatTag:      dsound.WAVE_FORMAT_PCM,
 		Channels:       uint16(channels),
 		SamplesPerSec:  uint32(sampleRate),
-		BitsPerSample:  bits,
+		BitsPerSample:  uint16(bits),
 		BlockAlign:     uint16(o.blockAlign),
 		AvgBytesPerSec: uint32(o.bytesPerSec),
 	}
@@ -94,7 +140,31 @@ func get(sampleRate, channels int) (Output, error) {
 	return &o, nil
 }
 
+func directSoundDevices() ([]Device, error) {
+	var devs []Device
+	err := dsound.DirectSoundEnumerate(func(g *dsound.GUID, description, module string) bool {
+		d := Device{
+			Name:        description,
+			Description: description,
+			Channels:    2,
+			// The first enumerated device, with a nil GUID, is the primary
+			// sound driver.
+			Default: g == nil,
+		}
+		if ds, err := dsound.DirectSoundCreate(g); err == nil {
+			if caps, err := ds.GetCaps(); err == nil {
+				d.Rates = []int{int(caps.MinSecondarySampleRate), int(caps.MaxSecondarySampleRate)}
+			}
+			ds.Release()
+		}
+		devs = append(devs, d)
+		return true
+	})
+	return devs, err
+}
+
 func (o *output) Push(samples []float32) {
+	o.pushed()
 	for _, s := range samples {
 		o.ch <- s
 	}
@@ -146,25 +216,33 @@ func (o *output) start() {
 }
 
 func (o *output) fill(block int) {
-	b1, b2, err := o.buf2.LockInt16s(uint32(block)*o.blockSize, o.blockSize, 0)
+	b1, b2, err := o.buf2.LockBytes(uint32(block)*o.blockSize, o.blockSize, 0)
 	if err != nil {
 		panic(err)
 	}
-	i16 := make([]int16, len(b1)+len(b2))
+	size := o.bits / 8
+	buf := make([]byte, len(b1)+len(b2))
 Loop:
-	for i := range i16 {
+	for i := 0; i+size <= len(buf); i += size {
 		select {
 		case s := <-o.ch:
-			i16[i] = int16(s * 32767)
+			var v int32
+			if o.exclusive {
+				v = dsp.Quantize(s, uint(o.bits))
+			} else {
+				v = o.dither.Int32(s, uint(o.bits))
+			}
+			for j := 0; j < size; j++ {
+				buf[i+j] = byte(v >> uint(8*j))
+			}
 		default:
+			o.starved()
 			break Loop
 		}
 	}
-	n := copy(b1, i16)
-	i16 = i16[n:]
-	n = copy(b2, i16)
-	i16 = i16[n:]
-	o.buf2.UnlockInt16s(b1, b2)
+	n := copy(b1, buf)
+	copy(b2, buf[n:])
+	o.buf2.UnlockBytes(b1, b2)
 }
 
 func (o *output) Stop() {
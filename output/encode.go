@@ -0,0 +1,71 @@
+package output
+
+import (
+	"encoding/binary"
+	"math"
+
+	"github.com/mjibson/moggio/dsp"
+)
+
+// encoder converts samples to little endian bytes: float32 if bits is 0,
+// otherwise 16, 24, or 32 bit integers.
+type encoder struct {
+	bits   int
+	dither *dsp.Dither
+}
+
+func newEncoder(f Format) *encoder {
+	e := &encoder{bits: f.Bits}
+	switch f.Bits {
+	case 16, 24, 32:
+		if !f.Exclusive {
+			e.dither = new(dsp.Dither)
+		}
+	default:
+		e.bits = 0
+	}
+	return e
+}
+
+// sampleSize returns the number of bytes per sample.
+func (e *encoder) sampleSize() int {
+	if e.bits == 0 {
+		return 4
+	}
+	return e.bits / 8
+}
+
+func (e *encoder) encode(samples []float32) []byte {
+	var buf []byte
+	switch e.bits {
+	case 0:
+		buf = make([]byte, len(samples)*4)
+		for i, s := range samples {
+			binary.LittleEndian.PutUint32(buf[i*4:], math.Float32bits(s))
+		}
+	case 16:
+		buf = make([]byte, len(samples)*2)
+		for i, s := range samples {
+			binary.LittleEndian.PutUint16(buf[i*2:], uint16(e.quantize(s)))
+		}
+	case 24:
+		buf = make([]byte, len(samples)*3)
+		for i, s := range samples {
+			v := e.quantize(s)
+			buf[i*3], buf[i*3+1], buf[i*3+2] = byte(v), byte(v>>8), byte(v>>16)
+		}
+	case 32:
+		buf = make([]byte, len(samples)*4)
+		for i, s := range samples {
+			binary.LittleEndian.PutUint32(buf[i*4:], uint32(e.quantize(s)))
+		}
+	}
+	return buf
+}
+
+func (e *encoder) quantize(s float32) int32 {
+	if e.dither != nil {
+		return e.dither.Int32(s, uint(e.bits))
+	}
+	return dsp.Quantize(s, uint(e.bits))
+}
@@ -0,0 +1,299 @@
+package output
+
+import (
+	"encoding/binary"
+	"fmt"
+	"io"
+	"log"
+	"os"
+	"path/filepath"
+	"strings"
+	"sync"
+	"time"
+)
+
+func init() {
+	register("file", &backend{
+		open:      openFile,
+		devices:   fileDevices,
+		priority:  -2,
+		anyDevice: true,
+	})
+	register("null", &backend{
+		open:     openNull,
+		devices:  nullDevices,
+		priority: -1,
+	})
+}
+
+const defaultFile = "moggio.wav"
+
+// sampleWriter encodes samples to a file.
+type sampleWriter interface {
+	write(samples []float32) error
+	close() error
+}
+
+// fileOutput writes samples to the file named by the device, as WAV or FLAC
+// by its extension. It isn't paced, so songs render as fast as they
+// decode.
+type fileOutput struct {
+	meter
+	f    Format
+	path string
+	file *os.File
+	w    sampleWriter
+}
+
+// files holds the paths written by this process. If another format is
+// opened with the same path, the file is given a numbered suffix instead of
+// overwriting the first.
+var files = struct {
+	sync.Mutex
+	paths map[string]bool
+}{
+	paths: make(map[string]bool),
+}
+
+func uniquePath(path string) string {
+	files.Lock()
+	defer files.Unlock()
+	ext := filepath.Ext(path)
+	p := path
+	for i := 2; files.paths[p]; i++ {
+		p = fmt.Sprintf("%s-%d%s", strings.TrimSuffix(path, ext), i, ext)
+	}
+	files.paths[p] = true
+	return p
+}
+
+func openFile(f Format) (Output, error) {
+	path := f.Device
+	if path == "" {
+		path = defaultFile
+	}
+	switch strings.ToLower(filepath.Ext(path)) {
+	case ".wav", ".flac":
+	default:
+		return nil, fmt.Errorf("file: unsupported file type: %s", path)
+	}
+	o := &fileOutput{
+		f:    f,
+		path: path,
+	}
+	if err := o.create(); err != nil {
+		return nil, err
+	}
+	return o, nil
+}
+
+func (o *fileOutput) create() error {
+	path := uniquePath(o.path)
+	file, err := os.Create(path)
+	if err != nil {
+		return err
+	}
+	o.w, err = newSampleWriter(file, path, o.f)
+	if err != nil {
+		file.Close()
+		return err
+	}
+	log.Println("file: writing", path)
+	o.file = file
+	return nil
+}
+
+// newSampleWriter returns a writer of FLAC or WAV to file, by the extension
+// of path.
+func newSampleWriter(file *os.File, path string, f Format) (sampleWriter, error) {
+	if strings.ToLower(filepath.Ext(path)) == ".flac" {
+		return newFLACWriter(file, f)
+	}
+	return newWAVWriter(file, f)
+}
+
+// FileWriter writes samples to a WAV or FLAC file, like the file backend,
+// but reports errors to its caller.
+type FileWriter struct {
+	file *os.File
+	w    sampleWriter
+}
+
+// CreateFile creates the file path, as WAV or FLAC by its extension, of
+// samples of format f.
+func CreateFile(path string, f Format) (*FileWriter, error) {
+	switch strings.ToLower(filepath.Ext(path)) {
+	case ".wav", ".flac":
+	default:
+		return nil, fmt.Errorf("file: unsupported file type: %s", path)
+	}
+	file, err := os.Create(path)
+	if err != nil {
+		return nil, err
+	}
+	w, err := newSampleWriter(file, path, f)
+	if err != nil {
+		file.Close()
+		return nil, err
+	}
+	return &FileWriter{file, w}, nil
+}
+
+// Write writes samples, interleaved.
+func (w *FileWriter) Write(samples []float32) error {
+	return w.w.write(samples)
+}
+
+// Close finishes and closes the file.
+func (w *FileWriter) Close() error {
+	err := w.w.close()
+	if cerr := w.file.Close(); err == nil {
+		err = cerr
+	}
+	return err
+}
+
+func (o *fileOutput) Push(samples []float32) {
+	if o.file == nil {
+		if err := o.create(); err != nil {
+			log.Println("file:", err)
+			return
+		}
+	}
+	if err := o.w.write(samples); err != nil {
+		log.Println("file:", err)
+	}
+}
+
+func (o *fileOutput) Start() {
+}
+
+// Stop finishes and closes the file. Pushing again starts a new one.
+func (o *fileOutput) Stop() {
+	if o.file == nil {
+		return
+	}
+	if err := o.w.close(); err != nil {
+		log.Println("file:", err)
+	}
+	o.file.Close()
+	o.file, o.w = nil, nil
+}
+
+func fileDevices() ([]Device, error) {
+	return []Device{{
+		Name:        defaultFile,
+		Description: "any .wav or .flac path",
+		Default:     true,
+	}}, nil
+}
+
+// wavWriter writes a WAV file of 16, 24, or 32 bit integers, or float32
+// samples. The header's sizes are kept current so the file is always
+// playable.
+type wavWriter struct {
+	w   io.WriterAt
+	enc *encoder
+	n   int64
+}
+
+func newWAVWriter(w io.WriterAt, f Format) (*wavWriter, error) {
+	ww := &wavWriter{
+		w:   w,
+		enc: newEncoder(f),
+	}
+	tag, bits := 1, ww.enc.bits
+	if bits == 0 {
+		// IEEE float.
+		tag, bits = 3, 32
+	}
+	blockAlign := f.Channels * bits / 8
+	h := make([]byte, 44)
+	copy(h, "RIFF")
+	copy(h[8:], "WAVEfmt ")
+	binary.LittleEndian.PutUint32(h[16:], 16)
+	binary.LittleEndian.PutUint16(h[20:], uint16(tag))
+	binary.LittleEndian.PutUint16(h[22:], uint16(f.Channels))
+	binary.LittleEndian.PutUint32(h[24:], uint32(f.SampleRate))
+	binary.LittleEndian.PutUint32(h[28:], uint32(f.SampleRate*blockAlign))
+	binary.LittleEndian.PutUint16(h[32:], uint16(blockAlign))
+	binary.LittleEndian.PutUint16(h[34:], uint16(bits))
+	copy(h[36:], "data")
+	if _, err := w.WriteAt(h, 0); err != nil {
+		return nil, err
+	}
+	return ww, ww.sync()
+}
+
+func (w *wavWriter) sync() error {
+	b := make([]byte, 4)
+	binary.LittleEndian.PutUint32(b, uint32(36+w.n))
+	if _, err := w.w.WriteAt(b, 4); err != nil {
+		return err
+	}
+	binary.LittleEndian.PutUint32(b, uint32(w.n))
+	_, err := w.w.WriteAt(b, 40)
+	return err
+}
+
+func (w *wavWriter) write(samples []float32) error {
+	b := w.enc.encode(samples)
+	if _, err := w.w.WriteAt(b, 44+w.n); err != nil {
+		return err
+	}
+	w.n += int64(len(b))
+	return w.sync()
+}
+
+func (w *wavWriter) close() error {
+	return w.sync()
+}
+
+// nullOutput discards samples. By default it waits as long as they would
+// take to play, like a device; the "fast" device doesn't wait.
+type nullOutput struct {
+	meter
+	f    Format
+	fast bool
+	// until is when the pushed samples would finish playing.
+	until time.Time
+}
+
+func openNull(f Format) (Output, error) {
+	return &nullOutput{
+		f:    f,
+		fast: f.Device == "fast",
+	}, nil
+}
+
+func (o *nullOutput) Push(samples []float32) {
+	if o.fast {
+		return
+	}
+	now := time.Now()
+	if o.until.Before(now) {
+		o.until = now
+	}
+	o.until = o.until.Add(duration(len(samples), o.f))
+	// Keep a little buffered, as a device would.
+	time.Sleep(o.until.Sub(now) - time.Millisecond*100)
+}
+
+func (o *nullOutput) Start() {
+}
+
+func (o *nullOutput) Stop() {
+}
+
+func nullDevices() ([]Device, error) {
+	return []Device{
+		{
+			Description: "discard in real time",
+			Default:     true,
+		},
+		{
+			Name:        "fast",
+			Description: "discard as fast as possible",
+		},
+	}, nil
+}
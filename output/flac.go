@@ -0,0 +1,312 @@
+package output
+
+import (
+	"crypto/md5"
+	"encoding/binary"
+	"hash"
+	"io"
+	"math/bits"
+)
+
+// flacBlockSize is the number of samples per channel in each FLAC frame.
+const flacBlockSize = 4096
+
+// flacWriter writes a FLAC stream. Each channel is coded independently with
+// the best fixed predictor, so compression is close to flac -1.
+type flacWriter struct {
+	w        io.WriterAt
+	off      int64
+	enc      *encoder
+	channels int
+	rate     int
+	bits     int
+
+	pending []int32
+	frame   uint64
+	total   uint64
+	md5     hash.Hash
+}
+
+func newFLACWriter(w io.WriterAt, f Format) (*flacWriter, error) {
+	fw := &flacWriter{
+		w:        w,
+		channels: f.Channels,
+		rate:     f.SampleRate,
+		bits:     16,
+		md5:      md5.New(),
+	}
+	// Float samples keep more than 16 bits of precision.
+	if f.Bits == 0 || f.Bits > 16 {
+		fw.bits = 24
+	}
+	fw.enc = newEncoder(Format{Bits: fw.bits, Exclusive: f.Exclusive})
+	header := make([]byte, 42)
+	copy(header, "fLaC")
+	// Last metadata block, STREAMINFO, 34 bytes long.
+	header[4] = 0x80
+	header[7] = 34
+	binary.BigEndian.PutUint16(header[8:], flacBlockSize)
+	binary.BigEndian.PutUint16(header[10:], flacBlockSize)
+	if _, err := w.WriteAt(header, 0); err != nil {
+		return nil, err
+	}
+	fw.off = int64(len(header))
+	return fw, fw.sync()
+}
+
+// sync updates STREAMINFO with the frames written so far.
+func (fw *flacWriter) sync() error {
+	b := make([]byte, 24)
+	v := uint64(fw.rate)<<44 | uint64(fw.channels-1)<<41 | uint64(fw.bits-1)<<36 | fw.total
+	binary.BigEndian.PutUint64(b, v)
+	copy(b[8:], fw.md5.Sum(nil))
+	_, err := fw.w.WriteAt(b, 18)
+	return err
+}
+
+func (fw *flacWriter) write(samples []float32) error {
+	for _, s := range samples {
+		fw.pending = append(fw.pending, fw.enc.quantize(s))
+	}
+	n := flacBlockSize * fw.channels
+	for len(fw.pending) >= n {
+		if err := fw.writeFrame(fw.pending[:n]); err != nil {
+			return err
+		}
+		fw.pending = fw.pending[n:]
+	}
+	return fw.sync()
+}
+
+// close writes any remaining samples as a short final frame.
+func (fw *flacWriter) close() error {
+	if n := len(fw.pending) / fw.channels; n > 0 {
+		if err := fw.writeFrame(fw.pending[:n*fw.channels]); err != nil {
+			return err
+		}
+	}
+	fw.pending = nil
+	return fw.sync()
+}
+
+func (fw *flacWriter) writeFrame(samples []int32) error {
+	n := len(samples) / fw.channels
+	var bw bitWriter
+	bw.write(0xFFF8, 16)
+	// Block size as a 16 bit field at the end of the header; sample rate
+	// from STREAMINFO.
+	bw.write(0x70, 8)
+	size := uint64(4)
+	if fw.bits == 24 {
+		size = 6
+	}
+	bw.write(uint64(fw.channels-1)<<4|size<<1, 8)
+	bw.buf = appendUTF8(bw.buf, fw.frame)
+	bw.write(uint64(n-1), 16)
+	bw.write(uint64(crc8(bw.buf)), 8)
+
+	ch := make([]int32, n)
+	mb := make([]byte, 4)
+	for c := 0; c < fw.channels; c++ {
+		for i := range ch {
+			ch[i] = samples[i*fw.channels+c]
+		}
+		fw.writeSubframe(&bw, ch)
+	}
+	bw.align()
+	crc := crc16(bw.buf)
+	bw.buf = append(bw.buf, byte(crc>>8), byte(crc))
+	if _, err := fw.w.WriteAt(bw.buf, fw.off); err != nil {
+		return err
+	}
+	// The MD5 is of the little endian samples.
+	for _, s := range samples {
+		binary.LittleEndian.PutUint32(mb, uint32(s))
+		fw.md5.Write(mb[:fw.bits/8])
+	}
+	fw.off += int64(len(bw.buf))
+	fw.frame++
+	fw.total += uint64(n)
+	return nil
+}
+
+func (fw *flacWriter) writeSubframe(bw *bitWriter, x []int32) {
+	bps := uint(fw.bits)
+	mask := uint64(1)<<bps - 1
+	constant := true
+	for _, v := range x {
+		if v != x[0] {
+			constant = false
+			break
+		}
+	}
+	if constant {
+		bw.write(0, 8)
+		bw.write(uint64(uint32(x[0]))&mask, bps)
+		return
+	}
+	// Find the fixed predictor order with the smallest residual.
+	var res [5][]int32
+	best, bestSum := 0, uint64(0)
+	for order := 0; order <= 4 && order < len(x); order++ {
+		res[order] = fixedResidual(x, order)
+		var sum uint64
+		for _, r := range res[order] {
+			sum += uint64(zigzag(r))
+		}
+		if order == 0 || sum < bestSum {
+			best, bestSum = order, sum
+		}
+	}
+	r := res[best]
+	k, cost := riceParam(r, bestSum)
+	if cost >= uint64(len(x))*uint64(bps) {
+		// Verbatim.
+		bw.write(0x02, 8)
+		for _, v := range x {
+			bw.write(uint64(uint32(v))&mask, bps)
+		}
+		return
+	}
+	bw.write(uint64(0x10|best<<1), 8)
+	for _, v := range x[:best] {
+		bw.write(uint64(uint32(v))&mask, bps)
+	}
+	// Rice coding with a single partition.
+	bw.write(0, 2)
+	bw.write(0, 4)
+	bw.write(uint64(k), 4)
+	for _, v := range r {
+		u := zigzag(v)
+		bw.unary(u >> k)
+		bw.write(uint64(u)&(1<<k-1), k)
+	}
+}
+
+// fixedResidual returns the residual of x from the fixed predictor of the
+// given order.
+func fixedResidual(x []int32, order int) []int32 {
+	r := make([]int32, len(x)-order)
+	for i := order; i < len(x); i++ {
+		var p int32
+		switch order {
+		case 1:
+			p = x[i-1]
+		case 2:
+			p = 2*x[i-1] - x[i-2]
+		case 3:
+			p = 3*x[i-1] - 3*x[i-2] + x[i-3]
+		case 4:
+			p = 4*x[i-1] - 6*x[i-2] + 4*x[i-3] - x[i-4]
+		}
+		r[i-order] = x[i] - p
+	}
+	return r
+}
+
+func zigzag(v int32) uint32 {
+	return uint32(v<<1) ^ uint32(v>>31)
+}
+
+// riceParam returns the Rice parameter that codes r, whose zigzag values
+// total sum, in the fewest bits, and that number of bits.
+func riceParam(r []int32, sum uint64) (uint, uint64) {
+	n := uint64(len(r))
+	if n == 0 {
+		return 0, 0
+	}
+	est := uint(bits.Len64(sum / n))
+	lo := est
+	if lo > 0 {
+		lo--
+	}
+	best, bestCost := uint(0), ^uint64(0)
+	for k := lo; k <= est+1 && k <= 14; k++ {
+		var cost uint64
+		for _, v := range r {
+			cost += uint64(zigzag(v)>>k) + 1 + uint64(k)
+		}
+		if cost < bestCost {
+			best, bestCost = k, cost
+		}
+	}
+	return best, bestCost
+}
+
+type bitWriter struct {
+	buf []byte
+	acc uint64
+	n   uint
+}
+
+// write writes the low bits of v, at most 32.
+func (b *bitWriter) write(v uint64, bits uint) {
+	b.acc = b.acc<<bits | v&(1<<bits-1)
+	b.n += bits
+	for b.n >= 8 {
+		b.n -= 8
+		b.buf = append(b.buf, byte(b.acc>>b.n))
+	}
+	b.acc &= 1<<b.n - 1
+}
+
+// unary writes q zeros and a one.
+func (b *bitWriter) unary(q uint32) {
+	for ; q >= 32; q -= 32 {
+		b.write(0, 32)
+	}
+	b.write(1, uint(q)+1)
+}
+
+func (b *bitWriter) align() {
+	if b.n > 0 {
+		b.write(0, 8-b.n)
+	}
+}
+
+// appendUTF8 appends v in the extended UTF-8 coding FLAC uses for frame
+// numbers.
+func appendUTF8(buf []byte, v uint64) []byte {
+	if v < 0x80 {
+		return append(buf, byte(v))
+	}
+	n := 2
+	for v >= 1<<uint(5*n+1) {
+		n++
+	}
+	buf = append(buf, byte(uint16(0xFF00)>>uint(n))|byte(v>>uint(6*(n-1))))
+	for i := n - 2; i >= 0; i-- {
+		buf = append(buf, 0x80|byte(v>>uint(6*i))&0x3F)
+	}
+	return buf
+}
+
+func crc8(b []byte) byte {
+	var crc byte
+	for _, c := range b {
+		crc ^= c
+		for i := 0; i < 8; i++ {
+			if crc&0x80 != 0 {
+				crc = crc<<1 ^ 0x07
+			} else {
+				crc <<= 1
+			}
+		}
+	}
+	return crc
+}
+
+func crc16(b []byte) uint16 {
+	var crc uint16
+	for _, c := range b {
+		crc ^= uint16(c) << 8
+		for i := 0; i < 8; i++ {
+			if crc&0x8000 != 0 {
+				crc = crc<<1 ^ 0x8005
+			} else {
+				crc <<= 1
+			}
+		}
+	}
+	return crc
+}
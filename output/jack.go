@@ -0,0 +1,300 @@
+// +build jack
+
+package output
+
+import (
+	"fmt"
+	"log"
+	"strings"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"github.com/xthexder/go-jack"
+)
+
+const (
+	// jackRingFrames is the length of the buffer between Push and the
+	// process callback, and jackPoll how often a full buffer is checked
+	// for room.
+	jackRingFrames = 1 << 13
+	jackPoll       = time.Millisecond * 5
+)
+
+// JACK runs at the server's sample rate and buffer size, so a single client
+// with left and right ports is shared by all outputs; the active one is fed
+// to the ports from the process callback. Build with -tags jack.
+var jackState struct {
+	sync.Mutex
+	client *jack.Client
+	ports  []*jack.Port
+	active *jackOutput
+}
+
+// jackRT holds the *jackRealtime read by the process callback, which runs
+// on JACK's realtime thread so mustn't lock or allocate.
+var jackRT atomic.Value
+
+// jackRealtime is the state of the process callback, replaced whenever
+// jackState changes.
+type jackRealtime struct {
+	active *jackOutput
+	ports  []*jack.Port
+	// bufs are the ports' buffers, used only by the callback.
+	bufs [][]jack.AudioSample
+}
+
+// jackPublish makes the current jackState visible to the process callback.
+// It must be called with jackState locked.
+func jackPublish() {
+	jackRT.Store(&jackRealtime{
+		active: jackState.active,
+		ports:  jackState.ports,
+		bufs:   make([][]jack.AudioSample, len(jackState.ports)),
+	})
+}
+
+type jackOutput struct {
+	// ring holds pushed samples, without locks since only Push advances
+	// write and only the process callback advances read. They are first to
+	// be aligned for atomic access.
+	read, write uint64
+	// underrun is set by the callback when it ran out of samples, to be
+	// counted by Push.
+	underrun uint32
+	ring     []float32
+	meter
+	channels int
+	rate     int
+	device   string
+}
+
+func init() {
+	register("jack", &backend{
+		open:    openJack,
+		devices: jackDevices,
+		rate:    jackRate,
+	})
+}
+
+// jackConnect connects to the JACK server if not already connected. It must
+// be called with jackState locked.
+func jackConnect() (*jack.Client, error) {
+	if jackState.client != nil {
+		return jackState.client, nil
+	}
+	client, status := jack.ClientOpen("moggio", jack.NoStartServer)
+	if status != 0 {
+		return nil, fmt.Errorf("jack: %v", jack.StrError(status))
+	}
+	for _, name := range []string{"left", "right"} {
+		p := client.PortRegister(name, jack.DEFAULT_AUDIO_TYPE, jack.PortIsOutput, 0)
+		if p == nil {
+			client.Close()
+			return nil, fmt.Errorf("jack: could not register port %s", name)
+		}
+		jackState.ports = append(jackState.ports, p)
+	}
+	if code := client.SetProcessCallback(jackProcess); code != 0 {
+		client.Close()
+		return nil, fmt.Errorf("jack: %v", jack.StrError(code))
+	}
+	client.OnShutdown(func() {
+		log.Println("jack: server shut down")
+		jackState.Lock()
+		jackState.client, jackState.ports = nil, nil
+		jackPublish()
+		jackState.Unlock()
+	})
+	if code := client.Activate(); code != 0 {
+		client.Close()
+		return nil, fmt.Errorf("jack: %v", jack.StrError(code))
+	}
+	log.Printf("jack: connected at %d Hz, %d frame buffer", client.GetSampleRate(), client.GetBufferSize())
+	jackState.client = client
+	return client, nil
+}
+
+func jackRate() int {
+	jackState.Lock()
+	defer jackState.Unlock()
+	client, err := jackConnect()
+	if err != nil {
+		return 0
+	}
+	return int(client.GetSampleRate())
+}
+
+// openJack returns an output feeding moggio's ports. f.Device names a
+// client whose playback ports they are connected to; by default they go to
+// the physical outputs. f.Latency is ignored: JACK's buffer size is set by
+// the server.
+func openJack(f Format) (Output, error) {
+	jackState.Lock()
+	defer jackState.Unlock()
+	client, err := jackConnect()
+	if err != nil {
+		return nil, err
+	}
+	if f.SampleRate != int(client.GetSampleRate()) {
+		return nil, fmt.Errorf("jack: server runs at %d Hz, not %d", client.GetSampleRate(), f.SampleRate)
+	}
+	return &jackOutput{
+		ring:     make([]float32, jackRingFrames*f.Channels),
+		channels: f.Channels,
+		rate:     f.SampleRate,
+		device:   f.Device,
+	}, nil
+}
+
+// connect routes moggio's ports to the playback ports of o's device. It must
+// be called with jackState locked.
+func (o *jackOutput) connect() {
+	client := jackState.client
+	var dests []string
+	if o.device == "" {
+		dests = client.GetPorts("", "", jack.PortIsPhysical|jack.PortIsInput)
+	} else {
+		dests = client.GetPorts(o.device+":", jack.DEFAULT_AUDIO_TYPE, jack.PortIsInput)
+	}
+	for i, p := range jackState.ports {
+		for _, c := range p.GetConnections() {
+			client.Disconnect(p.GetName(), c)
+		}
+		if i < len(dests) {
+			client.Connect(p.GetName(), dests[i])
+		}
+	}
+}
+
+// Push copies samples to the ring, waiting for room while it is full.
+func (o *jackOutput) Push(samples []float32) {
+	if atomic.SwapUint32(&o.underrun, 0) != 0 {
+		o.starved()
+	}
+	o.pushed()
+	size := uint64(len(o.ring))
+	for len(samples) > 0 {
+		w := atomic.LoadUint64(&o.write)
+		free := int(size - (w - atomic.LoadUint64(&o.read)))
+		if free == 0 {
+			time.Sleep(jackPoll)
+			continue
+		}
+		if free > len(samples) {
+			free = len(samples)
+		}
+		for i, s := range samples[:free] {
+			o.ring[(w+uint64(i))%size] = s
+		}
+		atomic.StoreUint64(&o.write, w+uint64(free))
+		samples = samples[free:]
+	}
+}
+
+// Delay returns the duration of the samples in the ring.
+func (o *jackOutput) Delay() time.Duration {
+	n := atomic.LoadUint64(&o.write) - atomic.LoadUint64(&o.read)
+	return time.Duration(n) * time.Second / time.Duration(o.rate*o.channels)
+}
+
+func (o *jackOutput) Start() {
+	jackState.Lock()
+	defer jackState.Unlock()
+	if _, err := jackConnect(); err != nil {
+		log.Println(err)
+		return
+	}
+	o.connect()
+	jackState.active = o
+	jackPublish()
+}
+
+func (o *jackOutput) Stop() {
+	jackState.Lock()
+	if jackState.active == o {
+		jackState.active = nil
+		jackPublish()
+	}
+	jackState.Unlock()
+}
+
+// jackProcess is JACK's process callback. It deinterleaves samples from the
+// ring of the active output into the ports, or writes silence if none are
+// ready.
+func jackProcess(nframes uint32) int {
+	rt, _ := jackRT.Load().(*jackRealtime)
+	if rt == nil {
+		return 0
+	}
+	for i, p := range rt.ports {
+		rt.bufs[i] = p.GetBuffer(nframes)
+		for j := range rt.bufs[i] {
+			rt.bufs[i][j] = 0
+		}
+	}
+	o := rt.active
+	if o == nil {
+		return 0
+	}
+	r := atomic.LoadUint64(&o.read)
+	frames := int(atomic.LoadUint64(&o.write)-r) / o.channels
+	if frames < int(nframes) {
+		atomic.StoreUint32(&o.underrun, 1)
+	} else {
+		frames = int(nframes)
+	}
+	size := uint64(len(o.ring))
+	for i := 0; i < frames; i++ {
+		f := r + uint64(i*o.channels)
+		if o.channels == 1 {
+			// Play mono on both ports.
+			for _, b := range rt.bufs {
+				b[i] = jack.AudioSample(o.ring[f%size])
+			}
+		} else {
+			for c := 0; c < o.channels && c < len(rt.bufs); c++ {
+				rt.bufs[c][i] = jack.AudioSample(o.ring[(f+uint64(c))%size])
+			}
+		}
+	}
+	atomic.StoreUint64(&o.read, r+uint64(frames*o.channels))
+	return 0
+}
+
+// jackDevices lists the clients with audio input ports moggio can be
+// connected to.
+func jackDevices() ([]Device, error) {
+	jackState.Lock()
+	defer jackState.Unlock()
+	client, err := jackConnect()
+	if err != nil {
+		return nil, err
+	}
+	rate := []int{int(client.GetSampleRate())}
+	devs := []Device{{
+		Description: "physical outputs",
+		Channels:    len(client.GetPorts("", "", jack.PortIsPhysical|jack.PortIsInput)),
+		Rates:       rate,
+		Default:     true,
+	}}
+	index := make(map[string]int)
+	for _, p := range client.GetPorts("", jack.DEFAULT_AUDIO_TYPE, jack.PortIsInput) {
+		name := p[:strings.Index(p, ":")]
+		if name == "moggio" {
+			continue
+		}
+		i, ok := index[name]
+		if !ok {
+			i = len(devs)
+			index[name] = i
+			devs = append(devs, Device{
+				Name:        name,
+				Description: name,
+				Rates:       rate,
+			})
+		}
+		devs[i].Channels++
+	}
+	return devs, nil
+}
@@ -0,0 +1,94 @@
+package output
+
+import (
+	"sync"
+	"sync/atomic"
+	"time"
+)
+
+var underruns uint64
+
+// Underruns returns the number of times a device ran out of samples while
+// audio was playing.
+func Underruns() uint64 {
+	return atomic.LoadUint64(&underruns)
+}
+
+// meter counts underruns for an output. Outputs fed by the device call
+// starved when it needs samples that haven't been pushed. Outputs that
+// block in Push instead call queued with each push, and underruns are
+// estimated from the amount of audio queued. Running out after Idle is
+// expected and not counted.
+type meter struct {
+	mu      sync.Mutex
+	playing bool
+	// drained is when the queued audio finishes playing. capacity is the
+	// device's buffer length.
+	drained  time.Time
+	capacity time.Duration
+	// tracked is set once drained is known.
+	tracked bool
+}
+
+// Idle marks the end of pushed audio.
+func (m *meter) Idle() {
+	m.mu.Lock()
+	m.playing = false
+	m.mu.Unlock()
+}
+
+func (m *meter) pushed() {
+	m.mu.Lock()
+	m.playing = true
+	m.mu.Unlock()
+}
+
+// Delay returns the audio queued ahead of audio pushed now: until drained
+// if known, or else the device's buffer length.
+func (m *meter) Delay() time.Duration {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	if !m.tracked {
+		return m.capacity
+	}
+	if d := time.Until(m.drained); d > 0 {
+		return d
+	}
+	return 0
+}
+
+func (m *meter) starved() {
+	m.mu.Lock()
+	if m.playing {
+		atomic.AddUint64(&underruns, 1)
+		m.playing = false
+	}
+	m.mu.Unlock()
+}
+
+// queued records d of audio whose write began at start and just finished.
+func (m *meter) queued(start time.Time, d time.Duration) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	if start.After(m.drained) {
+		if m.playing {
+			atomic.AddUint64(&underruns, 1)
+		}
+		m.drained = start
+	}
+	m.drained = m.drained.Add(d)
+	m.tracked = true
+	// A write that waited for room filled the device's buffer.
+	if now := time.Now(); now.Sub(start) > d/4 {
+		m.drained = now.Add(m.capacity)
+	}
+	m.playing = true
+}
+
+// duration returns the play time of n interleaved samples.
+func duration(n int, f Format) time.Duration {
+	if f.SampleRate == 0 || f.Channels == 0 {
+		return 0
+	}
+	return time.Duration(n) * time.Second / time.Duration(f.SampleRate*f.Channels)
+}
@@ -0,0 +1,92 @@
+// +build oto
+
+package output
+
+import (
+	"log"
+	"time"
+
+	"github.com/hajimehoshi/oto"
+)
+
+// otoOutput plays through oto, a pure Go library with no system
+// dependencies beyond the platform's audio API. Build with -tags oto.
+type otoOutput struct {
+	meter
+	f   Format
+	enc *encoder
+
+	ctx    *oto.Context
+	player *oto.Player
+}
+
+func init() {
+	register("oto", &backend{
+		open:    openOto,
+		devices: otoDevices,
+	})
+}
+
+func openOto(f Format) (Output, error) {
+	if f.Device != "" {
+		log.Println("oto: device selection not supported; using default")
+	}
+	// oto takes 16 bit samples only.
+	f.Bits = 16
+	o := &otoOutput{
+		f:   f,
+		enc: newEncoder(f),
+	}
+	if err := o.open(); err != nil {
+		return nil, err
+	}
+	return o, nil
+}
+
+// open creates the oto context. Only one may exist at a time, so it is
+// closed when the output stops.
+func (o *otoOutput) open() error {
+	latency := o.f.Latency
+	if latency <= 0 {
+		latency = time.Second / 10
+	}
+	o.capacity = latency
+	size := int(int64(o.f.SampleRate)*int64(latency)/int64(time.Second)) * o.f.Channels * 2
+	ctx, err := oto.NewContext(o.f.SampleRate, o.f.Channels, 2, size)
+	if err != nil {
+		return err
+	}
+	o.ctx = ctx
+	o.player = ctx.NewPlayer()
+	return nil
+}
+
+func (o *otoOutput) Push(samples []float32) {
+	if o.ctx == nil {
+		if err := o.open(); err != nil {
+			log.Println("oto:", err)
+			return
+		}
+	}
+	start := time.Now()
+	if _, err := o.player.Write(o.enc.encode(samples)); err != nil {
+		log.Println("oto:", err)
+	}
+	o.queued(start, duration(len(samples), o.f))
+}
+
+func (o *otoOutput) Start() {
+}
+
+func (o *otoOutput) Stop() {
+	if o.ctx == nil {
+		return
+	}
+	o.player.Close()
+	o.ctx.Close()
+	o.ctx, o.player = nil, nil
+}
+
+func otoDevices() ([]Device, error) {
+	return []Device{{Description: "default", Channels: 2, Default: true}}, nil
+}
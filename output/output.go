@@ -1,29 +1,248 @@
 package output
 
+import (
+	"fmt"
+	"log"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/mjibson/moggio/dsp"
+)
+
 type Output interface {
 	// Push puts the sample on the output buffer.
 	Push(samples []float32)
 	Stop()
 	Start()
+	// Idle marks the end of pushed audio, so running out of samples is not
+	// an underrun.
+	Idle()
+}
+
+// A Delayer is an Output that knows how long audio pushed now waits before
+// it is heard.
+type Delayer interface {
+	Delay() time.Duration
+}
+
+// Format describes the audio stream an output is opened with.
+type Format struct {
+	SampleRate int
+	Channels   int
+	// Bits is the integer sample depth sent to the device, or 0 for the
+	// backend's default.
+	Bits int
+	// Exclusive requests exclusive access to the device so the system mixer
+	// can't alter samples. Samples are quantized without dither.
+	Exclusive bool
+	// Device is the name of the device to play on, or empty for the system
+	// default.
+	Device string
+	// Backend is the name of the backend to use, or empty for Default.
+	Backend string
+	// Latency is the requested buffer latency, or 0 for the backend's
+	// default.
+	Latency time.Duration
+}
+
+// Device describes an audio output device.
+type Device struct {
+	// Name identifies the device in Format.
+	Name        string
+	Description string
+	Channels    int
+	// Rates are the sample rates known to be supported. Most backends
+	// convert others as needed.
+	Rates   []int
+	Default bool
 }
 
-var outputs = make(map[config]Output)
+type backend struct {
+	open    func(Format) (Output, error)
+	devices func() ([]Device, error)
+	// priority orders backends to choose Default.
+	priority int
+	// rate, if set, returns the sample rate the backend requires, which
+	// songs must be resampled to.
+	rate func() int
+	// anyDevice is set if the backend accepts device names it doesn't
+	// list, such as file paths.
+	anyDevice bool
+}
+
+var backends = make(map[string]*backend)
+
+// Default is the backend used when a Format doesn't name one. It is the
+// preferred backend available on this platform.
+var Default string
+
+// register adds a backend. Default is set to the registered backend with the
+// highest priority.
+func register(name string, b *backend) {
+	if _, ok := backends[name]; ok {
+		panic(fmt.Errorf("output: %v already registered", name))
+	}
+	backends[name] = b
+	if d, ok := backends[Default]; !ok || b.priority > d.priority {
+		Default = name
+	}
+}
 
-type config struct {
-	sr, ch int
+// Rate returns the sample rate backend requires, or 0 if it accepts any.
+func Rate(backend string) int {
+	b, err := getBackend(backend)
+	if err != nil || b.rate == nil {
+		return 0
+	}
+	return b.rate()
 }
 
-func Get(sampleRate, channels int) (Output, error) {
-	c := config{sampleRate, channels}
-	if p, ok := outputs[c]; ok {
+// Backends returns the names of the available backends.
+func Backends() []string {
+	var names []string
+	for name := range backends {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return names
+}
+
+// renamed maps the former names of backends to their names, which say the
+// subprocess they play through.
+var renamed = map[string]string{
+	"alsa":     "aplay",
+	"pipewire": "pw-cat",
+}
+
+// Name returns the name of backend, which may be a former name.
+func Name(backend string) string {
+	if n, ok := renamed[backend]; ok {
+		return n
+	}
+	return backend
+}
+
+func getBackend(name string) (*backend, error) {
+	if name == "" {
+		name = Default
+	}
+	b, ok := backends[Name(name)]
+	if !ok {
+		return nil, fmt.Errorf("output: unknown backend %q", name)
+	}
+	return b, nil
+}
+
+// Devices lists the output devices available to backend.
+func Devices(backend string) ([]Device, error) {
+	b, err := getBackend(backend)
+	if err != nil {
+		return nil, err
+	}
+	devs, err := b.devices()
+	if err != nil {
+		return nil, err
+	}
+	channelsMu.Lock()
+	channels[backend] = devs
+	channelsMu.Unlock()
+	return devs, nil
+}
+
+var (
+	// channels are the devices last listed by backend, to look up their
+	// channel counts.
+	channels   = make(map[string][]Device)
+	channelsMu sync.Mutex
+)
+
+// Channels returns the number of channels of device, or of the default
+// device if device is empty, or 0 if unknown.
+func Channels(backend, device string) int {
+	channelsMu.Lock()
+	devs, ok := channels[backend]
+	channelsMu.Unlock()
+	if !ok {
+		devs, _ = Devices(backend)
+	}
+	for _, d := range devs {
+		if d.Name == device || device == "" && d.Default {
+			return d.Channels
+		}
+	}
+	return 0
+}
+
+// channelMap returns the speaker positions of audio with the given number of
+// channels, comma separated, if it has more than two.
+func channelMap(n int) string {
+	if n <= 2 {
+		return ""
+	}
+	return strings.Join(dsp.Layout(n), ",")
+}
+
+var outputs = make(map[Format]Output)
+
+// A releaser is an Output holding its device while stopped, until release
+// frees it.
+type releaser interface {
+	release()
+}
+
+// Close stops o and releases its device, so another format may open it.
+// The next Get of its format opens a new output.
+func Close(o Output) {
+	o.Stop()
+	for f, p := range outputs {
+		if p == o {
+			delete(outputs, f)
+		}
+	}
+	if r, ok := o.(releaser); ok {
+		r.release()
+	}
+}
+
+// Get returns an output for format f. If f's device is not present (it may
+// have been unplugged), the default device is used instead.
+func Get(f Format) (Output, error) {
+	if f.Backend == "" {
+		f.Backend = Default
+	}
+	b, err := getBackend(f.Backend)
+	if err != nil {
+		return nil, err
+	}
+	if f.Device != "" && !b.anyDevice && !present(f.Backend, f.Device) {
+		log.Printf("output: device %q not found; using default", f.Device)
+		f.Device = ""
+	}
+	if p, ok := outputs[f]; ok {
 		p.Start()
 		return p, nil
 	}
-	p, err := get(sampleRate, channels)
+	p, err := b.open(f)
 	if err != nil {
 		return nil, err
 	}
-	outputs[c] = p
+	outputs[f] = p
 	p.Start()
 	return p, nil
 }
+
+func present(backend, device string) bool {
+	devs, err := Devices(backend)
+	if err != nil {
+		// Can't tell; let the backend try.
+		return true
+	}
+	for _, d := range devs {
+		if d.Name == device {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,67 @@
+// +build linux
+
+package output
+
+import (
+	"io"
+	"log"
+	"os/exec"
+	"time"
+)
+
+// pipeOutput plays samples by writing them to the standard input of a
+// player command, such as aplay.
+type pipeOutput struct {
+	meter
+	f    Format
+	name string
+	args []string
+	enc  *encoder
+
+	cmd *exec.Cmd
+	w   io.WriteCloser
+}
+
+func (o *pipeOutput) start() error {
+	cmd := exec.Command(o.name, o.args...)
+	w, err := cmd.StdinPipe()
+	if err != nil {
+		return err
+	}
+	if err := cmd.Start(); err != nil {
+		return err
+	}
+	o.cmd, o.w = cmd, w
+	return nil
+}
+
+func (o *pipeOutput) Push(samples []float32) {
+	if o.cmd == nil {
+		if err := o.start(); err != nil {
+			log.Printf("%s: %v", o.name, err)
+			return
+		}
+	}
+	start := time.Now()
+	_, err := o.w.Write(o.enc.encode(samples))
+	o.queued(start, duration(len(samples), o.f))
+	if err != nil {
+		// The player exits if its device goes away. Restart it on the
+		// next push; these samples are dropped.
+		log.Printf("%s: %v", o.name, err)
+		o.Stop()
+	}
+}
+
+func (o *pipeOutput) Start() {
+}
+
+// Stop closes the player, which exits after playing what it has buffered.
+func (o *pipeOutput) Stop() {
+	if o.cmd == nil {
+		return
+	}
+	o.w.Close()
+	o.cmd.Wait()
+	o.cmd, o.w = nil, nil
+}
@@ -0,0 +1,131 @@
+// +build linux
+
+package output
+
+import (
+	"encoding/json"
+	"fmt"
+	"os/exec"
+	"strconv"
+	"time"
+)
+
+func init() {
+	register("pw-cat", &backend{
+		open:     openPipeWire,
+		devices:  pipeWireDevices,
+		priority: 1,
+	})
+}
+
+// openPipeWire plays through a pw-cat subprocess, rather than through cgo
+// bindings to libpipewire. pw-cat connects to PipeWire natively rather than
+// through its PulseAudio emulation.
+func openPipeWire(f Format) (Output, error) {
+	if _, err := exec.LookPath("pw-cat"); err != nil {
+		return nil, err
+	}
+	enc := newEncoder(f)
+	format := map[int]string{
+		0:  "f32",
+		16: "s16",
+		24: "s24",
+		32: "s32",
+	}[enc.bits]
+	args := []string{
+		"--playback",
+		"--media-role", "Music",
+		"--format", format,
+		"--rate", strconv.Itoa(f.SampleRate),
+		"--channels", strconv.Itoa(f.Channels),
+	}
+	if m := channelMap(f.Channels); m != "" {
+		args = append(args, "--channel-map", m)
+	}
+	if f.Device != "" {
+		args = append(args, "--target", f.Device)
+	}
+	if f.Latency > 0 {
+		args = append(args, "--latency", fmt.Sprintf("%dms", f.Latency.Nanoseconds()/1e6))
+	}
+	args = append(args, "-")
+	o := &pipeOutput{
+		f:    f,
+		name: "pw-cat",
+		args: args,
+		enc:  enc,
+	}
+	o.capacity = f.Latency
+	if o.capacity <= 0 {
+		o.capacity = time.Millisecond * 100
+	}
+	if err := o.start(); err != nil {
+		return nil, err
+	}
+	return o, nil
+}
+
+// pipeWireObject is the subset of pw-dump output needed to list sinks.
+type pipeWireObject struct {
+	Type string
+	Info struct {
+		Props struct {
+			MediaClass  string `json:"media.class"`
+			Name        string `json:"node.name"`
+			Description string `json:"node.description"`
+			Channels    int    `json:"audio.channels"`
+			Rate        int    `json:"audio.rate"`
+		}
+	}
+	Props struct {
+		Name string `json:"metadata.name"`
+	}
+	Metadata []struct {
+		Key   string
+		Value json.RawMessage
+	}
+}
+
+func pipeWireDevices() ([]Device, error) {
+	b, err := exec.Command("pw-dump").Output()
+	if err != nil {
+		return nil, err
+	}
+	var objs []pipeWireObject
+	if err := json.Unmarshal(b, &objs); err != nil {
+		return nil, err
+	}
+	var def string
+	for _, o := range objs {
+		if o.Type != "PipeWire:Interface:Metadata" || o.Props.Name != "default" {
+			continue
+		}
+		for _, m := range o.Metadata {
+			if m.Key != "default.audio.sink" {
+				continue
+			}
+			var v struct{ Name string }
+			if json.Unmarshal(m.Value, &v) == nil {
+				def = v.Name
+			}
+		}
+	}
+	var devs []Device
+	for _, o := range objs {
+		p := o.Info.Props
+		if o.Type != "PipeWire:Interface:Node" || p.MediaClass != "Audio/Sink" {
+			continue
+		}
+		d := Device{
+			Name:        p.Name,
+			Description: p.Description,
+			Channels:    p.Channels,
+			Default:     p.Name == def,
+		}
+		if p.Rate > 0 {
+			d.Rates = []int{p.Rate}
+		}
+		devs = append(devs, d)
+	}
+	return devs, nil
+}
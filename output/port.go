@@ -1,10 +1,15 @@
-// +build darwin
+// +build darwin portaudio
 
 package output
 
-import "github.com/helinwang/portaudio"
+import (
+	"log"
+
+	"github.com/helinwang/portaudio"
+)
 
 type port struct {
+	meter
 	st   *portaudio.Stream
 	ch   chan []float32
 	over []float32
@@ -12,21 +17,84 @@ type port struct {
 
 func init() {
 	portaudio.Initialize()
+	register("portaudio", &backend{
+		open:     openPort,
+		devices:  portDevices,
+		priority: 1,
+	})
 }
 
-func get(sampleRate, channels int) (Output, error) {
+func openPort(f Format) (Output, error) {
 	o := &port{
 		ch: make(chan []float32),
 	}
-	var err error
-	o.st, err = portaudio.OpenDefaultStream(0, channels, float64(sampleRate), 1024, o.Fetch)
+	if f.Exclusive {
+		// PortAudio streams float32 to Core Audio at the requested rate,
+		// which is lossless for integer sources, but can't hog the device.
+		log.Println("portaudio: exclusive mode not supported; using shared stream")
+	}
+	dev, err := portaudio.DefaultOutputDevice()
+	if err != nil {
+		return nil, err
+	}
+	if f.Device != "" {
+		devs, err := portaudio.Devices()
+		if err != nil {
+			return nil, err
+		}
+		for _, d := range devs {
+			if d.Name == f.Device && d.MaxOutputChannels > 0 {
+				dev = d
+			}
+		}
+	}
+	latency := dev.DefaultHighOutputLatency
+	if f.Latency > 0 {
+		latency = f.Latency
+	}
+	o.capacity = latency
+	o.st, err = portaudio.OpenStream(portaudio.StreamParameters{
+		Output: portaudio.StreamDeviceParameters{
+			Device:   dev,
+			Channels: f.Channels,
+			Latency:  latency,
+		},
+		SampleRate:      float64(f.SampleRate),
+		FramesPerBuffer: 1024,
+	}, o.Fetch)
 	if err != nil {
 		return nil, err
 	}
 	return o, nil
 }
 
+func portDevices() ([]Device, error) {
+	devs, err := portaudio.Devices()
+	if err != nil {
+		return nil, err
+	}
+	def, err := portaudio.DefaultOutputDevice()
+	if err != nil {
+		return nil, err
+	}
+	var r []Device
+	for _, d := range devs {
+		if d.MaxOutputChannels == 0 {
+			continue
+		}
+		r = append(r, Device{
+			Name:        d.Name,
+			Description: d.Name + " (" + d.HostApi.Name + ")",
+			Channels:    d.MaxOutputChannels,
+			Rates:       []int{int(d.DefaultSampleRate)},
+			Default:     d.Name == def.Name,
+		})
+	}
+	return r, nil
+}
+
 func (p *port) Push(samples []float32) {
+	p.pushed()
 	p.ch <- samples
 }
 
@@ -46,6 +114,7 @@ func (p *port) Fetch(out []float32) {
 			}
 			i += n
 		default:
+			p.starved()
 			z := make([]float32, len(out)-i)
 			copy(out[i:], z)
 			return
@@ -57,6 +126,11 @@ func (p *port) Stop() {
 	p.st.Stop()
 }
 
+// release closes the stream, freeing its device.
+func (p *port) release() {
+	p.st.Close()
+}
+
 func (p *port) Start() {
 	p.st.Start()
 }
@@ -3,41 +3,194 @@
 package output
 
 import (
+	"bufio"
 	"bytes"
-	"encoding/binary"
 	"log"
+	"os/exec"
+	"strconv"
+	"strings"
+	"time"
 
 	"github.com/mesilliac/pulse-simple"
 )
 
-type output struct {
-	st *pulse.Stream
+type pulseOutput struct {
+	meter
+	st     *pulse.Stream
+	f      Format
+	ss     pulse.SampleSpec
+	cmap   *pulse.ChannelMap
+	attr   *pulse.BufferAttr
+	device string
+	enc    *encoder
 }
 
-func get(sampleRate, channels int) (Output, error) {
-	o := new(output)
-	var err error
-	ss := pulse.SampleSpec{pulse.SAMPLE_FLOAT32LE, uint32(sampleRate), uint8(channels)}
-	o.st, err = pulse.Playback("moggio", "moggio", &ss)
-	if err != nil {
+func init() {
+	register("pulse", &backend{
+		open:     openPulse,
+		devices:  pulseDevices,
+		priority: 2,
+	})
+}
+
+func openPulse(f Format) (Output, error) {
+	o := &pulseOutput{
+		f:      f,
+		device: f.Device,
+		enc:    newEncoder(f),
+	}
+	// PulseAudio buffers 2 seconds by default.
+	o.capacity = time.Second * 2
+	format := pulse.SAMPLE_FLOAT32LE
+	switch o.enc.bits {
+	case 16:
+		format = pulse.SAMPLE_S16LE
+	case 24:
+		format = pulse.SAMPLE_S24LE
+	case 32:
+		format = pulse.SAMPLE_S32LE
+	}
+	if f.Exclusive {
+		// The simple API has no way to bypass the server's mixer, but it
+		// won't resample or convert if the sink already matches the format.
+		log.Println("pulse: exclusive mode not supported; using shared stream")
+	}
+	o.ss = pulse.SampleSpec{
+		Format:   format,
+		Rate:     uint32(f.SampleRate),
+		Channels: uint8(f.Channels),
+	}
+	if f.Channels > 2 {
+		// The default map isn't the WAVE order samples are in.
+		o.cmap = new(pulse.ChannelMap)
+		if err := o.cmap.InitAuto(uint(f.Channels), pulse.CHANNEL_MAP_WAVEEX); err != nil {
+			return nil, err
+		}
+	}
+	if f.Latency > 0 {
+		o.attr = pulse.NewBufferAttr()
+		o.attr.Tlength = uint32(int64(f.SampleRate) * int64(f.Latency) / int64(time.Second) * int64(f.Channels*o.enc.sampleSize()))
+		o.capacity = f.Latency
+	}
+	if err := o.open(); err != nil {
 		return nil, err
 	}
 	return o, nil
 }
 
-func (o *output) Push(samples []float32) {
-	buf := new(bytes.Buffer)
-	for _, s := range samples {
-		_ = binary.Write(buf, binary.LittleEndian, s)
+func (o *pulseOutput) open() error {
+	st, err := pulse.NewStream("", "moggio", pulse.STREAM_PLAYBACK, o.device, "moggio", &o.ss, o.cmap, o.attr)
+	if err != nil {
+		o.st = nil
+		return err
 	}
-	_, err := o.st.Write(buf.Bytes())
+	o.st = st
+	return nil
+}
+
+func (o *pulseOutput) Push(samples []float32) {
+	if o.st == nil {
+		if err := o.open(); err != nil {
+			log.Println("pulse:", err)
+			return
+		}
+	}
+	start := time.Now()
+	_, err := o.st.Write(o.enc.encode(samples))
+	o.queued(start, duration(len(samples), o.f))
 	if err != nil {
-		log.Println(err)
+		// The sink may have been removed. Reconnect, falling back to the
+		// default sink; these samples are dropped.
+		log.Println("pulse:", err)
+		o.st.Free()
+		o.st = nil
+		if o.device != "" && !present("pulse", o.device) {
+			o.device = ""
+		}
+		if err := o.open(); err != nil {
+			log.Println("pulse: reconnect:", err)
+		}
 	}
 }
 
-func (o *output) Start() {
+// Start returns to the requested sink if the stream fell back to the
+// default one and the sink is back, as after a Bluetooth speaker
+// reconnects.
+func (o *pulseOutput) Start() {
+	if o.device == o.f.Device || !present("pulse", o.f.Device) {
+		return
+	}
+	if o.st != nil {
+		o.st.Free()
+	}
+	o.device = o.f.Device
+	if err := o.open(); err != nil {
+		log.Println("pulse: reconnect:", err)
+		o.device = ""
+		if err := o.open(); err != nil {
+			log.Println("pulse: reconnect:", err)
+		}
+	}
+}
+
+func (o *pulseOutput) Stop() {
+}
+
+// release frees the stream, and with it the server's resources for it.
+func (o *pulseOutput) release() {
+	if o.st != nil {
+		o.st.Free()
+		o.st = nil
+	}
+}
+
+// pulseDevices lists sinks with pactl, since the simple API can't enumerate
+// them.
+func pulseDevices() ([]Device, error) {
+	b, err := exec.Command("pactl", "list", "short", "sinks").Output()
+	if err != nil {
+		// No pactl; only the default sink is available.
+		return []Device{{Name: "", Description: "default", Channels: 2, Default: true}}, nil
+	}
+	def := defaultSink()
+	var devs []Device
+	sc := bufio.NewScanner(bytes.NewReader(b))
+	for sc.Scan() {
+		// index, name, driver, sample spec ("s16le 2ch 44100Hz"), state
+		sp := strings.Split(sc.Text(), "\t")
+		if len(sp) < 4 {
+			continue
+		}
+		d := Device{
+			Name:        sp[1],
+			Description: sp[1],
+			Default:     sp[1] == def,
+		}
+		for _, f := range strings.Fields(sp[3]) {
+			switch {
+			case strings.HasSuffix(f, "ch"):
+				d.Channels, _ = strconv.Atoi(strings.TrimSuffix(f, "ch"))
+			case strings.HasSuffix(f, "Hz"):
+				if r, err := strconv.Atoi(strings.TrimSuffix(f, "Hz")); err == nil {
+					d.Rates = append(d.Rates, r)
+				}
+			}
+		}
+		devs = append(devs, d)
+	}
+	return devs, nil
 }
 
-func (o *output) Stop() {
+func defaultSink() string {
+	b, err := exec.Command("pactl", "info").Output()
+	if err != nil {
+		return ""
+	}
+	sc := bufio.NewScanner(bytes.NewReader(b))
+	for sc.Scan() {
+		if t := sc.Text(); strings.HasPrefix(t, "Default Sink: ") {
+			return strings.TrimPrefix(t, "Default Sink: ")
+		}
+	}
+	return ""
 }
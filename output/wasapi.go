@@ -0,0 +1,713 @@
+// +build windows,!386,!arm
+
+package output
+
+import (
+	"fmt"
+	"log"
+	"runtime"
+	"sync"
+	"syscall"
+	"time"
+	"unicode/utf16"
+	"unsafe"
+
+	"github.com/mjibson/moggio/dsp"
+)
+
+// WASAPI is used through its COM interfaces directly. Methods are called by
+// their index in the interface's vtable, listed below.
+const (
+	methodRelease = 2
+
+	// IMMDeviceEnumerator
+	methodEnumAudioEndpoints       = 3
+	methodGetDefaultAudioEndpoint  = 4
+	methodGetDevice                = 5
+	methodRegisterEndpointCallback = 6
+
+	// IMMDeviceCollection
+	methodGetCount = 3
+	methodItem     = 4
+
+	// IMMDevice
+	methodActivate          = 3
+	methodOpenPropertyStore = 4
+	methodGetID             = 5
+	methodGetState          = 6
+
+	// IPropertyStore
+	methodGetValue = 5
+
+	// IAudioClient
+	methodInitialize        = 3
+	methodGetBufferSize     = 4
+	methodGetCurrentPadding = 6
+	methodIsFormatSupported = 7
+	methodGetMixFormat      = 8
+	methodStart             = 10
+	methodStop              = 11
+	methodSetEventHandle    = 13
+	methodGetService        = 14
+
+	// IAudioRenderClient
+	methodGetBuffer     = 3
+	methodReleaseBuffer = 4
+)
+
+const (
+	eRender           = 0
+	eConsole          = 0
+	deviceStateActive = 1
+	clsctxAll         = 0x17
+	stgmRead          = 0
+	vtLPWSTR          = 31
+
+	audclntShareModeShared    = 0
+	audclntShareModeExclusive = 1
+
+	audclntStreamFlagsEventCallback     = 0x00040000
+	audclntStreamFlagsSrcDefaultQuality = 0x08000000
+	audclntStreamFlagsAutoConvertPCM    = 0x80000000
+
+	audclntEDeviceInvalidated    = 0x88890004
+	audclntEBufferSizeNotAligned = 0x88890019
+
+	waveFormatTagExtensible = 0xFFFE
+)
+
+var (
+	clsidMMDeviceEnumerator  = guid{0xBCDE0395, 0xE52F, 0x467C, [8]byte{0x8E, 0x3D, 0xC4, 0x57, 0x92, 0x91, 0x69, 0x2E}}
+	iidIMMDeviceEnumerator   = guid{0xA95664D2, 0x9614, 0x4F35, [8]byte{0xA7, 0x46, 0xDE, 0x8D, 0xB6, 0x36, 0x17, 0xE6}}
+	iidIAudioClient          = guid{0x1CB9AD4C, 0xDBFA, 0x4C32, [8]byte{0xB1, 0x78, 0xC2, 0xF5, 0x68, 0xA7, 0x03, 0xB2}}
+	iidIAudioRenderClient    = guid{0xF294ACFC, 0x3146, 0x4483, [8]byte{0xA7, 0xBF, 0xAD, 0xDC, 0xA7, 0xC2, 0x60, 0xE2}}
+	iidIMMNotificationClient = guid{0x7991EEC9, 0x7E89, 0x4D85, [8]byte{0x83, 0x90, 0x6C, 0x70, 0x3C, 0xEC, 0x60, 0xC0}}
+	iidIUnknown              = guid{0x00000000, 0x0000, 0x0000, [8]byte{0xC0, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x46}}
+	subtypePCM               = guid{0x00000001, 0x0000, 0x0010, [8]byte{0x80, 0x00, 0x00, 0xAA, 0x00, 0x38, 0x9B, 0x71}}
+	subtypeFloat             = guid{0x00000003, 0x0000, 0x0010, [8]byte{0x80, 0x00, 0x00, 0xAA, 0x00, 0x38, 0x9B, 0x71}}
+
+	pkeyDeviceFriendlyName = propertyKey{guid{0xA45C254E, 0xDF1C, 0x4EFD, [8]byte{0x80, 0x20, 0x67, 0xD1, 0x46, 0xA8, 0x50, 0xE0}}, 14}
+)
+
+var (
+	ole32            = syscall.MustLoadDLL("ole32")
+	coInitializeEx   = ole32.MustFindProc("CoInitializeEx")
+	coCreateInstance = ole32.MustFindProc("CoCreateInstance")
+	coTaskMemFree    = ole32.MustFindProc("CoTaskMemFree")
+	propVariantClear = ole32.MustFindProc("PropVariantClear")
+)
+
+type guid struct {
+	Data1 uint32
+	Data2 uint16
+	Data3 uint16
+	Data4 [8]byte
+}
+
+type propertyKey struct {
+	fmtid guid
+	pid   uint32
+}
+
+type propVariant struct {
+	vt                   uint16
+	reserved1, reserved2 uint16
+	reserved3            uint16
+	val                  *uint16
+	pad                  uintptr
+}
+
+type waveFormatEx struct {
+	FormatTag      uint16
+	Channels       uint16
+	SamplesPerSec  uint32
+	AvgBytesPerSec uint32
+	BlockAlign     uint16
+	BitsPerSample  uint16
+	Size           uint16
+}
+
+// waveFormatExtensible can't embed waveFormatEx, which Go pads to 20 bytes.
+type waveFormatExtensible struct {
+	FormatTag          uint16
+	Channels           uint16
+	SamplesPerSec      uint32
+	AvgBytesPerSec     uint32
+	BlockAlign         uint16
+	BitsPerSample      uint16
+	Size               uint16
+	ValidBitsPerSample uint16
+	ChannelMask        uint32
+	SubFormat          guid
+}
+
+// hresult is a failed COM call's result.
+type hresult uint32
+
+func (h hresult) Error() string {
+	return fmt.Sprintf("wasapi: HRESULT 0x%08X", uint32(h))
+}
+
+// comObject is a pointer to a COM interface, whose first word is its
+// vtable.
+type comObject struct {
+	vtbl *[16]uintptr
+}
+
+func (o *comObject) call(method int, args ...uintptr) error {
+	var a [9]uintptr
+	a[0] = uintptr(unsafe.Pointer(o))
+	copy(a[1:], args)
+	r, _, _ := syscall.Syscall9(o.vtbl[method], uintptr(len(args)+1), a[0], a[1], a[2], a[3], a[4], a[5], a[6], a[7], a[8])
+	if int32(r) < 0 {
+		return hresult(r)
+	}
+	return nil
+}
+
+func (o *comObject) release() {
+	o.call(methodRelease)
+}
+
+func wstring(p *uint16) string {
+	if p == nil {
+		return ""
+	}
+	var s []uint16
+	for ; *p != 0; p = (*uint16)(unsafe.Pointer(uintptr(unsafe.Pointer(p)) + 2)) {
+		s = append(s, *p)
+	}
+	return string(utf16.Decode(s))
+}
+
+var wasapi struct {
+	sync.Mutex
+	enumerator *comObject
+	err        error
+	// changed is closed and replaced when endpoints change.
+	changed chan struct{}
+}
+
+func init() {
+	register("wasapi", &backend{
+		open:     openWASAPI,
+		devices:  wasapiDevices,
+		priority: 3,
+	})
+}
+
+// wasapiEnumerator returns the device enumerator, creating it and
+// registering for device notifications on first use.
+func wasapiEnumerator() (*comObject, error) {
+	wasapi.Lock()
+	defer wasapi.Unlock()
+	if wasapi.enumerator != nil || wasapi.err != nil {
+		return wasapi.enumerator, wasapi.err
+	}
+	// Go routines move between threads, so use the multithreaded apartment,
+	// which other threads join implicitly.
+	coInitializeEx.Call(0, 0)
+	var e *comObject
+	r, _, _ := coCreateInstance.Call(
+		uintptr(unsafe.Pointer(&clsidMMDeviceEnumerator)),
+		0,
+		clsctxAll,
+		uintptr(unsafe.Pointer(&iidIMMDeviceEnumerator)),
+		uintptr(unsafe.Pointer(&e)),
+	)
+	if int32(r) < 0 {
+		wasapi.err = hresult(r)
+		return nil, wasapi.err
+	}
+	wasapi.changed = make(chan struct{})
+	if err := e.call(methodRegisterEndpointCallback, uintptr(unsafe.Pointer(&notifier))); err != nil {
+		log.Println("wasapi: no device notifications:", err)
+	}
+	wasapi.enumerator = e
+	return e, nil
+}
+
+// wasapiChanged returns a channel closed when endpoints next change.
+func wasapiChanged() <-chan struct{} {
+	wasapi.Lock()
+	defer wasapi.Unlock()
+	return wasapi.changed
+}
+
+func notifyChanged() {
+	wasapi.Lock()
+	close(wasapi.changed)
+	wasapi.changed = make(chan struct{})
+	wasapi.Unlock()
+}
+
+// notifier implements IMMNotificationClient. Any change to endpoints makes
+// open outputs check that they are still on the right device.
+var notifier = struct {
+	vtbl *[8]uintptr
+}{
+	&[8]uintptr{
+		syscall.NewCallback(func(this uintptr, iid *guid, ppv *uintptr) uintptr {
+			// QueryInterface
+			if *iid != iidIUnknown && *iid != iidIMMNotificationClient {
+				*ppv = 0
+				return 0x80004002 // E_NOINTERFACE
+			}
+			*ppv = this
+			return 0
+		}),
+		syscall.NewCallback(func(this uintptr) uintptr { return 1 }), // AddRef
+		syscall.NewCallback(func(this uintptr) uintptr { return 1 }), // Release
+		syscall.NewCallback(func(this, id, state uintptr) uintptr { // OnDeviceStateChanged
+			notifyChanged()
+			return 0
+		}),
+		syscall.NewCallback(func(this, id uintptr) uintptr { return 0 }), // OnDeviceAdded
+		syscall.NewCallback(func(this, id uintptr) uintptr { // OnDeviceRemoved
+			notifyChanged()
+			return 0
+		}),
+		syscall.NewCallback(func(this, flow, role, id uintptr) uintptr { // OnDefaultDeviceChanged
+			if flow == eRender && role == eConsole {
+				notifyChanged()
+			}
+			return 0
+		}),
+		syscall.NewCallback(func(this, id, key uintptr) uintptr { return 0 }), // OnPropertyValueChanged
+	},
+}
+
+// wasapiDevice returns the endpoint named id, or the default endpoint if id
+// is empty or not active.
+func wasapiDevice(id string) (*comObject, error) {
+	e, err := wasapiEnumerator()
+	if err != nil {
+		return nil, err
+	}
+	var dev *comObject
+	if id != "" {
+		p, err := syscall.UTF16PtrFromString(id)
+		if err != nil {
+			return nil, err
+		}
+		if e.call(methodGetDevice, uintptr(unsafe.Pointer(p)), uintptr(unsafe.Pointer(&dev))) == nil {
+			var state uint32
+			if dev.call(methodGetState, uintptr(unsafe.Pointer(&state))) == nil && state == deviceStateActive {
+				return dev, nil
+			}
+			dev.release()
+		}
+		log.Printf("wasapi: device %s not active; using default", id)
+	}
+	if err := e.call(methodGetDefaultAudioEndpoint, eRender, eConsole, uintptr(unsafe.Pointer(&dev))); err != nil {
+		return nil, err
+	}
+	return dev, nil
+}
+
+func deviceID(dev *comObject) string {
+	var p *uint16
+	if dev.call(methodGetID, uintptr(unsafe.Pointer(&p))) != nil {
+		return ""
+	}
+	defer coTaskMemFree.Call(uintptr(unsafe.Pointer(p)))
+	return wstring(p)
+}
+
+func friendlyName(dev *comObject) string {
+	var store *comObject
+	if dev.call(methodOpenPropertyStore, stgmRead, uintptr(unsafe.Pointer(&store))) != nil {
+		return ""
+	}
+	defer store.release()
+	var v propVariant
+	if store.call(methodGetValue, uintptr(unsafe.Pointer(&pkeyDeviceFriendlyName)), uintptr(unsafe.Pointer(&v))) != nil {
+		return ""
+	}
+	defer propVariantClear.Call(uintptr(unsafe.Pointer(&v)))
+	if v.vt != vtLPWSTR {
+		return ""
+	}
+	return wstring(v.val)
+}
+
+func activate(dev *comObject) (*comObject, error) {
+	var client *comObject
+	err := dev.call(methodActivate, uintptr(unsafe.Pointer(&iidIAudioClient)), clsctxAll, 0, uintptr(unsafe.Pointer(&client)))
+	return client, err
+}
+
+func mixFormat(client *comObject) (channels, rate int, err error) {
+	var wf *waveFormatEx
+	if err := client.call(methodGetMixFormat, uintptr(unsafe.Pointer(&wf))); err != nil {
+		return 0, 0, err
+	}
+	defer coTaskMemFree.Call(uintptr(unsafe.Pointer(wf)))
+	return int(wf.Channels), int(wf.SamplesPerSec), nil
+}
+
+// speakerMasks are the WAVE speaker position bits.
+var speakerMasks = map[string]uint32{
+	dsp.Mono:       0x4,
+	dsp.FrontLeft:  0x1,
+	dsp.FrontRight: 0x2,
+	dsp.Center:     0x4,
+	dsp.LFE:        0x8,
+	dsp.RearLeft:   0x10,
+	dsp.RearRight:  0x20,
+	dsp.RearCenter: 0x100,
+	dsp.SideLeft:   0x200,
+	dsp.SideRight:  0x400,
+}
+
+func pcmFormat(rate, channels, bits int, float bool) *waveFormatExtensible {
+	wf := &waveFormatExtensible{
+		FormatTag:          waveFormatTagExtensible,
+		Channels:           uint16(channels),
+		SamplesPerSec:      uint32(rate),
+		BitsPerSample:      uint16(bits),
+		BlockAlign:         uint16(channels * bits / 8),
+		Size:               22,
+		ValidBitsPerSample: uint16(bits),
+		SubFormat:          subtypePCM,
+	}
+	wf.AvgBytesPerSec = uint32(rate) * uint32(wf.BlockAlign)
+	for _, p := range dsp.Layout(channels) {
+		wf.ChannelMask |= speakerMasks[p]
+	}
+	if float {
+		wf.SubFormat = subtypeFloat
+	}
+	return wf
+}
+
+func supported(client *comObject, wf *waveFormatExtensible) bool {
+	r, _, _ := syscall.Syscall6(client.vtbl[methodIsFormatSupported], 4,
+		uintptr(unsafe.Pointer(client)),
+		audclntShareModeExclusive,
+		uintptr(unsafe.Pointer(wf)),
+		0, 0, 0)
+	return r == 0
+}
+
+var commonRates = []int{44100, 48000, 88200, 96000, 176400, 192000}
+
+func wasapiDevices() ([]Device, error) {
+	e, err := wasapiEnumerator()
+	if err != nil {
+		return nil, err
+	}
+	var def string
+	if dev, err := wasapiDevice(""); err == nil {
+		def = deviceID(dev)
+		dev.release()
+	}
+	var coll *comObject
+	if err := e.call(methodEnumAudioEndpoints, eRender, deviceStateActive, uintptr(unsafe.Pointer(&coll))); err != nil {
+		return nil, err
+	}
+	defer coll.release()
+	var n uint32
+	if err := coll.call(methodGetCount, uintptr(unsafe.Pointer(&n))); err != nil {
+		return nil, err
+	}
+	var devs []Device
+	for i := uint32(0); i < n; i++ {
+		var dev *comObject
+		if coll.call(methodItem, uintptr(i), uintptr(unsafe.Pointer(&dev))) != nil {
+			continue
+		}
+		d := Device{
+			Name:        deviceID(dev),
+			Description: friendlyName(dev),
+		}
+		d.Default = d.Name == def
+		if client, err := activate(dev); err == nil {
+			var rate int
+			d.Channels, rate, _ = mixFormat(client)
+			d.Rates = []int{rate}
+			// List other rates the device can play exclusively.
+			for _, r := range commonRates {
+				if r != rate && supported(client, pcmFormat(r, d.Channels, 16, false)) {
+					d.Rates = append(d.Rates, r)
+				}
+			}
+			client.release()
+		}
+		dev.release()
+		devs = append(devs, d)
+	}
+	return devs, nil
+}
+
+// wasapiOutput plays through an event driven WASAPI stream. In exclusive
+// mode samples go to the device unaltered.
+type wasapiOutput struct {
+	meter
+	f     Format
+	ch    chan []float32
+	over  []float32
+	event syscall.Handle
+	// done is closed by release to end loop.
+	done chan struct{}
+
+	// mu protects the fields below, which change when the stream is
+	// reopened on another device.
+	mu      sync.Mutex
+	id      string
+	client  *comObject
+	render  *comObject
+	enc     *encoder
+	frames  uint32
+	running bool
+}
+
+func openWASAPI(f Format) (Output, error) {
+	h, _, err := CreateEvent.Call(0, 0, 0, 0)
+	if h == 0 {
+		return nil, err
+	}
+	o := &wasapiOutput{
+		f:     f,
+		ch:    make(chan []float32, 4),
+		event: syscall.Handle(h),
+		done:  make(chan struct{}),
+	}
+	if err := o.open(); err != nil {
+		syscall.CloseHandle(o.event)
+		return nil, err
+	}
+	go o.loop()
+	return o, nil
+}
+
+// open initializes a stream on the configured device, or the default if
+// it isn't present. It must be called with o.mu locked or before loop
+// starts.
+func (o *wasapiOutput) open() error {
+	dev, err := wasapiDevice(o.f.Device)
+	if err != nil {
+		return err
+	}
+	defer dev.release()
+	o.id = deviceID(dev)
+	client, err := activate(dev)
+	if err != nil {
+		return err
+	}
+	mode := uintptr(audclntShareModeShared)
+	flags := uintptr(audclntStreamFlagsEventCallback)
+	var wf *waveFormatExtensible
+	if o.f.Exclusive {
+		// Try the source's depth, then containers that hold it losslessly.
+		mode = audclntShareModeExclusive
+		for _, bits := range []int{o.f.Bits, 32, 24, 16} {
+			if bits == 0 || bits < o.f.Bits {
+				continue
+			}
+			if w := pcmFormat(o.f.SampleRate, o.f.Channels, bits, false); supported(client, w) {
+				wf = w
+				break
+			}
+		}
+		if wf == nil {
+			client.release()
+			return fmt.Errorf("wasapi: device can't play %d Hz %d bit exclusively", o.f.SampleRate, o.f.Bits)
+		}
+		o.enc = newEncoder(Format{Bits: int(wf.BitsPerSample), Exclusive: true})
+	} else {
+		// The shared mixer converts our float samples to its rate.
+		flags |= audclntStreamFlagsAutoConvertPCM | audclntStreamFlagsSrcDefaultQuality
+		wf = pcmFormat(o.f.SampleRate, o.f.Channels, 32, true)
+		o.enc = newEncoder(Format{})
+	}
+	latency := o.f.Latency
+	if latency <= 0 {
+		latency = time.Millisecond * 40
+	}
+	o.capacity = latency
+	// Durations are in 100ns units. Event driven exclusive streams use the
+	// buffer duration as the period; shared streams require 0.
+	duration := int64(latency / 100)
+	initialize := func() error {
+		var period int64
+		if o.f.Exclusive {
+			period = duration
+		}
+		return client.call(methodInitialize, mode, flags, uintptr(duration), uintptr(period), uintptr(unsafe.Pointer(wf)), 0)
+	}
+	err = initialize()
+	if err == hresult(audclntEBufferSizeNotAligned) {
+		// Retry with the nearest duration the device allows, which needs
+		// a new client.
+		var frames uint32
+		client.call(methodGetBufferSize, uintptr(unsafe.Pointer(&frames)))
+		client.release()
+		duration = (10000000*int64(frames) + int64(o.f.SampleRate)/2) / int64(o.f.SampleRate)
+		if client, err = activate(dev); err != nil {
+			return err
+		}
+		err = initialize()
+	}
+	if err != nil {
+		client.release()
+		return err
+	}
+	var render *comObject
+	if err := client.call(methodGetBufferSize, uintptr(unsafe.Pointer(&o.frames))); err != nil {
+		client.release()
+		return err
+	}
+	if err := client.call(methodSetEventHandle, uintptr(o.event)); err != nil {
+		client.release()
+		return err
+	}
+	if err := client.call(methodGetService, uintptr(unsafe.Pointer(&iidIAudioRenderClient)), uintptr(unsafe.Pointer(&render))); err != nil {
+		client.release()
+		return err
+	}
+	o.client, o.render = client, render
+	if o.running {
+		o.client.call(methodStart)
+	}
+	return nil
+}
+
+func (o *wasapiOutput) close() {
+	if o.client == nil {
+		return
+	}
+	o.client.call(methodStop)
+	o.render.release()
+	o.client.release()
+	o.client, o.render = nil, nil
+}
+
+// reopen moves the stream to the right device after a device change or
+// error: back to the configured device when it returns, or to the current
+// default.
+func (o *wasapiOutput) reopen(force bool) {
+	o.mu.Lock()
+	defer o.mu.Unlock()
+	select {
+	case <-o.done:
+		// Released.
+		return
+	default:
+	}
+	if !force && o.client != nil {
+		dev, err := wasapiDevice(o.f.Device)
+		if err != nil {
+			return
+		}
+		id := deviceID(dev)
+		dev.release()
+		if id == o.id {
+			return
+		}
+	}
+	o.close()
+	if err := o.open(); err != nil {
+		log.Println("wasapi: reopen:", err)
+		return
+	}
+	log.Println("wasapi: playing on", o.id)
+}
+
+// loop fills the device buffer each time WASAPI signals it needs more.
+func (o *wasapiOutput) loop() {
+	runtime.LockOSThread()
+	changed := wasapiChanged()
+	for {
+		select {
+		case <-o.done:
+			syscall.CloseHandle(o.event)
+			return
+		case <-changed:
+			changed = wasapiChanged()
+			o.reopen(false)
+		default:
+		}
+		ev, _ := syscall.WaitForSingleObject(o.event, 500)
+		if ev != syscall.WAIT_OBJECT_0 {
+			continue
+		}
+		if err := o.fill(); err != nil {
+			log.Println("wasapi:", err)
+			o.reopen(err == hresult(audclntEDeviceInvalidated))
+		}
+	}
+}
+
+func (o *wasapiOutput) fill() error {
+	o.mu.Lock()
+	defer o.mu.Unlock()
+	if o.client == nil {
+		return nil
+	}
+	n := o.frames
+	if !o.f.Exclusive {
+		var padding uint32
+		if err := o.client.call(methodGetCurrentPadding, uintptr(unsafe.Pointer(&padding))); err != nil {
+			return err
+		}
+		n -= padding
+	}
+	if n == 0 {
+		return nil
+	}
+	// Samples not ready in time are left as silence.
+	samples := make([]float32, int(n)*o.f.Channels)
+	i := copy(samples, o.over)
+	o.over = o.over[i:]
+Loop:
+	for i < len(samples) {
+		select {
+		case s := <-o.ch:
+			c := copy(samples[i:], s)
+			o.over = s[c:]
+			i += c
+		default:
+			o.starved()
+			break Loop
+		}
+	}
+	b := o.enc.encode(samples)
+	var data *byte
+	if err := o.render.call(methodGetBuffer, uintptr(n), uintptr(unsafe.Pointer(&data))); err != nil {
+		return err
+	}
+	copy((*[1 << 30]byte)(unsafe.Pointer(data))[:len(b):len(b)], b)
+	return o.render.call(methodReleaseBuffer, uintptr(n), 0)
+}
+
+func (o *wasapiOutput) Push(samples []float32) {
+	o.pushed()
+	o.ch <- samples
+}
+
+func (o *wasapiOutput) Start() {
+	o.mu.Lock()
+	defer o.mu.Unlock()
+	o.running = true
+	if o.client != nil {
+		o.client.call(methodStart)
+	}
+}
+
+// release closes the stream, freeing an exclusive device, and ends loop.
+func (o *wasapiOutput) release() {
+	o.mu.Lock()
+	defer o.mu.Unlock()
+	o.close()
+	close(o.done)
+}
+
+func (o *wasapiOutput) Stop() {
+	o.mu.Lock()
+	defer o.mu.Unlock()
+	o.running = false
+	if o.client != nil {
+		o.client.call(methodStop)
+	}
+}
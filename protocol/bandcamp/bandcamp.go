@@ -2,6 +2,7 @@ package bandcamp
 
 import (
 	"bytes"
+	"context"
 	"encoding/gob"
 	"encoding/json"
 	"fmt"
@@ -56,20 +57,39 @@ func (b *Bandcamp) Info(id codec.ID) (*codec.SongInfo, error) {
 }
 
 func (b *Bandcamp) GetSong(id codec.ID) (codec.Song, error) {
+	return b.GetSongContext(context.Background(), id)
+}
+
+func (b *Bandcamp) GetSongContext(ctx context.Context, id codec.ID) (codec.Song, error) {
 	t := b.Tracks[id]
 	if t == nil {
 		return nil, fmt.Errorf("missing %v", id)
 	}
+	rf := protocol.HTTPReader(ctx, nil, "bandcamp", t.File.Mp3_128)
 	return mpa.NewSong(func() (io.ReadCloser, int64, error) {
 		log.Println("BANDCAMP", id)
-		res, err := http.Get(t.File.Mp3_128)
-		if err != nil {
-			return nil, 0, err
-		}
-		return res.Body, 0, nil
+		return rf()
 	})
 }
 
+// Download returns a reader of the track's MP3.
+func (b *Bandcamp) Download(ctx context.Context, id codec.ID) (string, codec.Reader, error) {
+	t := b.Tracks[id]
+	if t == nil {
+		return "", nil, fmt.Errorf("missing %v", id)
+	}
+	return t.Title + ".mp3", protocol.HTTPReader(ctx, nil, "bandcamp", t.File.Mp3_128), nil
+}
+
+// Probe checks that the track's MP3 can be read.
+func (b *Bandcamp) Probe(ctx context.Context, id codec.ID) error {
+	t := b.Tracks[id]
+	if t == nil {
+		return fmt.Errorf("missing %v", id)
+	}
+	return protocol.ProbeURL(ctx, nil, "bandcamp", t.File.Mp3_128)
+}
+
 func (b *Bandcamp) List() (protocol.SongList, error) {
 	if len(b.Songs) == 0 {
 		return b.Refresh()
@@ -0,0 +1,342 @@
+// Package beets is a protocol for the songs of a beets library, read from
+// its SQLite database.
+package beets
+
+import (
+	"encoding/gob"
+	"fmt"
+	"io"
+	"log"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"reflect"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/mjibson/moggio/codec"
+	"github.com/mjibson/moggio/protocol"
+	"golang.org/x/oauth2"
+)
+
+func init() {
+	protocol.Register("beets", []string{"library database", "write play counts (yes/no)"}, New, reflect.TypeOf(&Beets{}))
+	gob.Register(new(Beets))
+}
+
+// Command is the beets command, used to write play counts.
+var Command = "beet"
+
+func New(params []string, token *oauth2.Token) (protocol.Instance, error) {
+	if len(params) < 1 || len(params) > 2 {
+		return nil, fmt.Errorf("expected one or two parameters")
+	}
+	p, err := filepath.Abs(params[0])
+	if err != nil {
+		return nil, err
+	}
+	b := &Beets{
+		Path: p,
+	}
+	if len(params) == 2 {
+		switch strings.ToLower(strings.TrimSpace(params[1])) {
+		case "yes", "y", "true", "1":
+			b.Write = true
+		case "", "no", "n", "false", "0":
+		default:
+			return nil, fmt.Errorf("write play counts: expected yes or no")
+		}
+	}
+	if _, err := b.Refresh(); err != nil {
+		return nil, err
+	}
+	return b, nil
+}
+
+type Beets struct {
+	// Path is the path of the library database.
+	Path string
+	// Write is set if play counts are written to the library, as the
+	// play_count and last_played flexible attributes.
+	Write bool
+	Songs protocol.SongList
+	// Files and Art are the paths of songs and their album art.
+	Files map[codec.ID]string
+	Art   map[codec.ID]string
+	// Attributes are the flexible attributes of songs and their albums.
+	Attributes map[codec.ID]map[string]string
+
+	mu sync.Mutex
+	// plays are the plays of songs since Attributes were read.
+	plays map[codec.ID]int
+}
+
+func (b *Beets) Key() string {
+	return b.Path
+}
+
+func (b *Beets) Info(id codec.ID) (*codec.SongInfo, error) {
+	if _, ok := b.Songs[id]; !ok {
+		if _, err := b.List(); err != nil {
+			return nil, err
+		}
+	}
+	v := b.Songs[id]
+	if v == nil {
+		return nil, fmt.Errorf("could not find %v", id)
+	}
+	return v, nil
+}
+
+func (b *Beets) GetSong(id codec.ID) (codec.Song, error) {
+	path, ok := b.Files[id]
+	if !ok {
+		return nil, fmt.Errorf("could not find %v", id)
+	}
+	return codec.ByExtensionID(path, codec.None, fileReader(path))
+}
+
+func (b *Beets) List() (protocol.SongList, error) {
+	if len(b.Songs) == 0 {
+		return b.Refresh()
+	}
+	return b.Songs, nil
+}
+
+func (b *Beets) Refresh() (protocol.SongList, error) {
+	db, err := openSQLite(b.Path)
+	if err != nil {
+		return nil, err
+	}
+	defer db.Close()
+	albums, err := db.table("albums")
+	if err != nil {
+		return nil, err
+	}
+	items, err := db.table("items")
+	if err != nil {
+		return nil, err
+	}
+	itemAttrs, err := attributes(db, "item_attributes")
+	if err != nil {
+		return nil, err
+	}
+	albumAttrs, err := attributes(db, "album_attributes")
+	if err != nil {
+		return nil, err
+	}
+	art := make(map[int64]string)
+	for _, a := range albums {
+		if p := str(a["artpath"]); p != "" {
+			art[num(a["id"])] = p
+		}
+	}
+	songs := make(protocol.SongList)
+	files := make(map[codec.ID]string)
+	arts := make(map[codec.ID]string)
+	attrs := make(map[codec.ID]map[string]string)
+	for _, it := range items {
+		path := str(it["path"])
+		if path == "" {
+			continue
+		}
+		id := codec.Int64(num(it["id"]))
+		info := &codec.SongInfo{
+			Time:   time.Duration(float(it["length"]) * float64(time.Second)),
+			Artist: str(it["artist"]),
+			Title:  str(it["title"]),
+			Album:  str(it["album"]),
+			Track:  float64(num(it["track"])),
+			Genre:  str(it["genre"]),
+
+			AlbumArtist: str(it["albumartist"]),
+			Compilation: num(it["comp"]) != 0,
+
+			Disc:         int(num(it["disc"])),
+			Discs:        int(num(it["disctotal"])),
+			DiscSubtitle: str(it["disctitle"]),
+
+			Composer:       str(it["composer"]),
+			Work:           str(it["work"]),
+			Movement:       str(it["mvname"]),
+			MovementNumber: int(num(it["mvindex"])),
+		}
+		info.Date, info.Year = date(it["year"], it["month"], it["day"])
+		info.OriginalDate, info.OriginalYear = date(it["original_year"], it["original_month"], it["original_day"])
+		if info.Title == "" {
+			info.Title = filepath.Base(path)
+		}
+		songs[id] = info
+		files[id] = path
+		albumID := num(it["album_id"])
+		if p, ok := art[albumID]; ok {
+			arts[id] = p
+		}
+		// Item attributes override those of its album.
+		m := make(map[string]string)
+		for k, v := range albumAttrs[albumID] {
+			m[k] = v
+		}
+		for k, v := range itemAttrs[num(it["id"])] {
+			m[k] = v
+		}
+		if len(m) > 0 {
+			attrs[id] = m
+		}
+	}
+	b.mu.Lock()
+	b.Songs, b.Files, b.Art, b.Attributes = songs, files, arts, attrs
+	b.plays = nil
+	b.mu.Unlock()
+	return songs, nil
+}
+
+// attributes returns the flexible attributes of a table, by entity ID.
+func attributes(db *sqliteDB, table string) (map[int64]map[string]string, error) {
+	rows, err := db.table(table)
+	if err != nil {
+		return nil, err
+	}
+	m := make(map[int64]map[string]string)
+	for _, r := range rows {
+		id := num(r["entity_id"])
+		if m[id] == nil {
+			m[id] = make(map[string]string)
+		}
+		m[id][str(r["key"])] = str(r["value"])
+	}
+	return m, nil
+}
+
+func (b *Beets) SongPath(id codec.ID) (string, error) {
+	path, ok := b.Files[id]
+	if !ok {
+		return "", fmt.Errorf("could not find %v", id)
+	}
+	return path, nil
+}
+
+func (b *Beets) SongFile(id codec.ID) (*os.File, error) {
+	path, err := b.SongPath(id)
+	if err != nil {
+		return nil, err
+	}
+	return os.Open(path)
+}
+
+func (b *Beets) ArtFile(id codec.ID) (*os.File, error) {
+	path, ok := b.Art[id]
+	if !ok {
+		return nil, os.ErrNotExist
+	}
+	return os.Open(path)
+}
+
+// SongAttributes returns the flexible attributes of a song.
+func (b *Beets) SongAttributes(id codec.ID) map[string]string {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	m := make(map[string]string)
+	for k, v := range b.Attributes[id] {
+		m[k] = v
+	}
+	return m
+}
+
+// Played increments the play_count of a song and sets its last_played, if
+// Write is set.
+func (b *Beets) Played(id codec.ID) error {
+	if !b.Write {
+		return nil
+	}
+	b.mu.Lock()
+	if b.plays == nil {
+		b.plays = make(map[codec.ID]int)
+	}
+	b.plays[id]++
+	n, _ := strconv.Atoi(b.Attributes[id]["play_count"])
+	args := []string{
+		"-l", b.Path, "modify", "-y",
+		"id:" + string(id),
+		"play_count=" + strconv.Itoa(n+b.plays[id]),
+		"last_played=" + strconv.FormatInt(time.Now().Unix(), 10),
+	}
+	b.mu.Unlock()
+	out, err := exec.Command(Command, args...).CombinedOutput()
+	if err != nil {
+		return fmt.Errorf("%s: %v: %s", Command, err, out)
+	}
+	return nil
+}
+
+func str(v interface{}) string {
+	switch v := v.(type) {
+	case string:
+		return v
+	case []byte:
+		return string(v)
+	case int64:
+		return strconv.FormatInt(v, 10)
+	case float64:
+		return strconv.FormatFloat(v, 'g', -1, 64)
+	}
+	return ""
+}
+
+func num(v interface{}) int64 {
+	switch v := v.(type) {
+	case int64:
+		return v
+	case float64:
+		return int64(v)
+	case string:
+		i, _ := strconv.ParseInt(v, 10, 64)
+		return i
+	}
+	return 0
+}
+
+func float(v interface{}) float64 {
+	switch v := v.(type) {
+	case int64:
+		return float64(v)
+	case float64:
+		return v
+	case string:
+		f, _ := strconv.ParseFloat(v, 64)
+		return f
+	}
+	return 0
+}
+
+// date returns the date of a year, month, and day, each 0 if unknown.
+func date(year, month, day interface{}) (string, int) {
+	y, m, d := num(year), num(month), num(day)
+	switch {
+	case y == 0:
+		return "", 0
+	case m == 0:
+		return codec.ParseDate(fmt.Sprintf("%04d", y))
+	case d == 0:
+		return codec.ParseDate(fmt.Sprintf("%04d-%02d", y, m))
+	}
+	return codec.ParseDate(fmt.Sprintf("%04d-%02d-%02d", y, m, d))
+}
+
+func fileReader(path string) codec.Reader {
+	return func() (io.ReadCloser, int64, error) {
+		log.Println("open file", path)
+		f, err := os.Open(path)
+		if err != nil {
+			return nil, 0, err
+		}
+		fi, err := f.Stat()
+		if err != nil {
+			f.Close()
+			return nil, 0, err
+		}
+		return f, fi.Size(), nil
+	}
+}
@@ -0,0 +1,317 @@
+package beets
+
+import (
+	"bytes"
+	"encoding/binary"
+	"fmt"
+	"math"
+	"os"
+	"strings"
+)
+
+// sqliteDB reads tables of an SQLite 3 database file. Only what's needed to
+// read beets libraries is supported: UTF-8 databases, and table b-trees.
+// Changes still in a write-ahead log aren't seen.
+type sqliteDB struct {
+	f        *os.File
+	pageSize int
+	usable   int
+}
+
+func openSQLite(path string) (*sqliteDB, error) {
+	f, err := os.Open(path)
+	if err != nil {
+		return nil, err
+	}
+	var h [100]byte
+	if _, err := f.ReadAt(h[:], 0); err != nil {
+		f.Close()
+		return nil, err
+	}
+	if !bytes.HasPrefix(h[:], []byte("SQLite format 3\x00")) {
+		f.Close()
+		return nil, fmt.Errorf("%s: not an SQLite database", path)
+	}
+	if enc := binary.BigEndian.Uint32(h[56:]); enc > 1 {
+		f.Close()
+		return nil, fmt.Errorf("%s: unsupported text encoding", path)
+	}
+	db := &sqliteDB{
+		f:        f,
+		pageSize: int(binary.BigEndian.Uint16(h[16:])),
+	}
+	if db.pageSize == 1 {
+		db.pageSize = 65536
+	}
+	db.usable = db.pageSize - int(h[20])
+	return db, nil
+}
+
+func (db *sqliteDB) Close() error {
+	return db.f.Close()
+}
+
+func (db *sqliteDB) page(n uint32) ([]byte, error) {
+	if n == 0 {
+		return nil, fmt.Errorf("sqlite: bad page 0")
+	}
+	b := make([]byte, db.pageSize)
+	if _, err := db.f.ReadAt(b, int64(n-1)*int64(db.pageSize)); err != nil {
+		return nil, err
+	}
+	return b, nil
+}
+
+// sqliteRow is a row of a table, by column name. Integers are int64, reals
+// float64, text string, and blobs []byte.
+type sqliteRow map[string]interface{}
+
+// table returns the rows of the named table.
+func (db *sqliteDB) table(name string) ([]sqliteRow, error) {
+	var root uint32
+	var sql string
+	err := db.walk(1, func(rowid int64, rec []interface{}) error {
+		if len(rec) < 5 {
+			return nil
+		}
+		if typ, _ := rec[0].(string); typ != "table" {
+			return nil
+		}
+		if n, _ := rec[1].(string); !strings.EqualFold(n, name) {
+			return nil
+		}
+		r, _ := rec[3].(int64)
+		root = uint32(r)
+		sql, _ = rec[4].(string)
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	if root == 0 {
+		return nil, fmt.Errorf("sqlite: no table %s", name)
+	}
+	cols, rowidCol := tableColumns(sql)
+	var rows []sqliteRow
+	err = db.walk(root, func(rowid int64, rec []interface{}) error {
+		row := make(sqliteRow, len(cols))
+		for i, c := range cols {
+			if i < len(rec) {
+				row[c] = rec[i]
+			}
+		}
+		if rowidCol != "" {
+			row[rowidCol] = rowid
+		}
+		rows = append(rows, row)
+		return nil
+	})
+	return rows, err
+}
+
+// tableColumns returns the column names of a CREATE TABLE statement, and
+// the INTEGER PRIMARY KEY column, an alias of the rowid, if any.
+func tableColumns(sql string) (cols []string, rowid string) {
+	start, end := strings.Index(sql, "("), strings.LastIndex(sql, ")")
+	if start < 0 || end < start {
+		return nil, ""
+	}
+	var defs []string
+	depth, last := 0, start+1
+	for i := start + 1; i < end; i++ {
+		switch sql[i] {
+		case '(':
+			depth++
+		case ')':
+			depth--
+		case ',':
+			if depth == 0 {
+				defs = append(defs, sql[last:i])
+				last = i + 1
+			}
+		}
+	}
+	defs = append(defs, sql[last:end])
+	for _, def := range defs {
+		fields := strings.Fields(def)
+		if len(fields) == 0 {
+			continue
+		}
+		switch strings.ToUpper(fields[0]) {
+		case "PRIMARY", "UNIQUE", "CHECK", "FOREIGN", "CONSTRAINT":
+			continue
+		}
+		name := strings.Trim(fields[0], "\"`[]'")
+		cols = append(cols, name)
+		if strings.Contains(strings.ToUpper(strings.Join(fields[1:], " ")), "INTEGER PRIMARY KEY") {
+			rowid = name
+		}
+	}
+	return cols, rowid
+}
+
+// walk calls fn with the rowid and record of each row of the table b-tree
+// rooted at page n.
+func (db *sqliteDB) walk(n uint32, fn func(rowid int64, rec []interface{}) error) error {
+	b, err := db.page(n)
+	if err != nil {
+		return err
+	}
+	h := b
+	if n == 1 {
+		h = b[100:]
+	}
+	ncells := int(binary.BigEndian.Uint16(h[3:]))
+	switch h[0] {
+	case 0x05: // interior table page
+		ptrs := h[12:]
+		for i := 0; i < ncells; i++ {
+			off := int(binary.BigEndian.Uint16(ptrs[i*2:]))
+			if off+4 > len(b) {
+				return fmt.Errorf("sqlite: bad cell")
+			}
+			if err := db.walk(binary.BigEndian.Uint32(b[off:]), fn); err != nil {
+				return err
+			}
+		}
+		return db.walk(binary.BigEndian.Uint32(h[8:]), fn)
+	case 0x0d: // leaf table page
+		ptrs := h[8:]
+		for i := 0; i < ncells; i++ {
+			off := int(binary.BigEndian.Uint16(ptrs[i*2:]))
+			if off >= len(b) {
+				return fmt.Errorf("sqlite: bad cell")
+			}
+			size, w := varint(b[off:])
+			off += w
+			rowid, w := varint(b[off:])
+			off += w
+			payload, err := db.payload(b[off:], int(size))
+			if err != nil {
+				return err
+			}
+			rec, err := record(payload)
+			if err != nil {
+				return err
+			}
+			if err := fn(int64(rowid), rec); err != nil {
+				return err
+			}
+		}
+		return nil
+	}
+	return fmt.Errorf("sqlite: unexpected page type %d", h[0])
+}
+
+// payload returns the payload of size bytes of a cell starting at b,
+// following overflow pages.
+func (db *sqliteDB) payload(b []byte, size int) ([]byte, error) {
+	u := db.usable
+	x := u - 35
+	local := size
+	if size > x {
+		m := (u-12)*32/255 - 23
+		local = m + (size-m)%(u-4)
+		if local > x {
+			local = m
+		}
+	}
+	if local > len(b) {
+		return nil, fmt.Errorf("sqlite: bad payload")
+	}
+	p := make([]byte, 0, size)
+	p = append(p, b[:local]...)
+	if local == size {
+		return p, nil
+	}
+	if local+4 > len(b) {
+		return nil, fmt.Errorf("sqlite: bad payload")
+	}
+	next := binary.BigEndian.Uint32(b[local:])
+	for len(p) < size {
+		if next == 0 {
+			return nil, fmt.Errorf("sqlite: truncated overflow")
+		}
+		o, err := db.page(next)
+		if err != nil {
+			return nil, err
+		}
+		next = binary.BigEndian.Uint32(o)
+		n := size - len(p)
+		if n > u-4 {
+			n = u - 4
+		}
+		p = append(p, o[4:4+n]...)
+	}
+	return p, nil
+}
+
+// varint decodes an SQLite variable-length integer, returning it and its
+// length.
+func varint(b []byte) (uint64, int) {
+	var v uint64
+	for i := 0; i < 9 && i < len(b); i++ {
+		if i == 8 {
+			return v<<8 | uint64(b[i]), 9
+		}
+		v = v<<7 | uint64(b[i]&0x7f)
+		if b[i] < 0x80 {
+			return v, i + 1
+		}
+	}
+	return v, len(b)
+}
+
+// record decodes the values of a record.
+func record(p []byte) ([]interface{}, error) {
+	hsize, n := varint(p)
+	if int(hsize) > len(p) || n == 0 {
+		return nil, fmt.Errorf("sqlite: bad record")
+	}
+	header, body := p[n:hsize], p[hsize:]
+	var vals []interface{}
+	for len(header) > 0 {
+		t, w := varint(header)
+		header = header[w:]
+		var size int
+		switch {
+		case t >= 12:
+			size = int(t-12) / 2
+		case t >= 1 && t <= 4:
+			size = int(t)
+		case t == 5:
+			size = 6
+		case t == 6 || t == 7:
+			size = 8
+		}
+		if size > len(body) {
+			return nil, fmt.Errorf("sqlite: bad record")
+		}
+		v := body[:size]
+		body = body[size:]
+		switch {
+		case t == 0:
+			vals = append(vals, nil)
+		case t >= 1 && t <= 6:
+			// Sign extend the big-endian integer.
+			i := int64(int8(v[0]))
+			for _, c := range v[1:] {
+				i = i<<8 | int64(c)
+			}
+			vals = append(vals, i)
+		case t == 7:
+			vals = append(vals, math.Float64frombits(binary.BigEndian.Uint64(v)))
+		case t == 8:
+			vals = append(vals, int64(0))
+		case t == 9:
+			vals = append(vals, int64(1))
+		case t >= 12 && t%2 == 0:
+			vals = append(vals, append([]byte(nil), v...))
+		case t >= 13:
+			vals = append(vals, string(v))
+		default:
+			return nil, fmt.Errorf("sqlite: bad serial type %d", t)
+		}
+	}
+	return vals, nil
+}
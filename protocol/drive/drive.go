@@ -1,11 +1,15 @@
 package drive
 
 import (
+	"context"
 	"encoding/gob"
 	"fmt"
 	"io"
 	"log"
+	"net/url"
+	"path"
 	"reflect"
+	"strings"
 
 	"github.com/mjibson/moggio/codec"
 	"github.com/mjibson/moggio/protocol"
@@ -64,6 +68,15 @@ func New(params []string, token *oauth2.Token) (protocol.Instance, error) {
 	return d, nil
 }
 
+func (d *Drive) Connect() error {
+	service, err := d.getService()
+	if err != nil {
+		return err
+	}
+	_, err = service.About.Get().Fields("user").Do()
+	return err
+}
+
 func (d *Drive) Key() string {
 	return d.Name
 }
@@ -76,44 +89,139 @@ func (d *Drive) Info(id codec.ID) (*codec.SongInfo, error) {
 	return s, nil
 }
 
+// List returns the saved songs. Instances with none are refreshed after
+// connecting.
 func (d *Drive) List() (protocol.SongList, error) {
-	if len(d.Songs) == 0 {
-		return d.Refresh()
-	}
 	return d.Songs, nil
 }
 
 func (d *Drive) GetSong(id codec.ID) (codec.Song, error) {
+	return d.GetSongContext(context.Background(), id)
+}
+
+func (d *Drive) GetSongContext(ctx context.Context, id codec.ID) (codec.Song, error) {
 	path, child := id.Pop()
 	f := d.Files[path]
 	if f == nil {
 		return nil, fmt.Errorf("missing %v", path)
 	}
-	return codec.ByExtensionID(f.FileExtension, child, d.reader(path))
+	return codec.ByExtensionID(f.FileExtension, child, d.reader(ctx, path))
 }
 
-func (d *Drive) reader(id string) codec.Reader {
+// Download returns a reader of the song's file.
+func (d *Drive) Download(ctx context.Context, id codec.ID) (string, codec.Reader, error) {
+	path, _ := id.Pop()
+	f := d.Files[path]
+	if f == nil {
+		return "", nil, fmt.Errorf("missing %v", path)
+	}
+	return f.Name, d.reader(ctx, path), nil
+}
+
+// downloadURL is the URL of the contents of a file, by ID.
+const downloadURL = "https://www.googleapis.com/drive/v3/files/%s?alt=media"
+
+// Probe checks that the contents of the song's file can be read.
+func (d *Drive) Probe(ctx context.Context, id codec.ID) error {
+	path, _ := id.Pop()
+	if d.Files[path] == nil {
+		return fmt.Errorf("missing %v", path)
+	}
+	c := config.Client(oauth2.NoContext, d.Token)
+	return protocol.ProbeURL(ctx, c, "drive:"+d.Name, fmt.Sprintf(downloadURL, url.PathEscape(path)))
+}
+
+func (d *Drive) reader(ctx context.Context, id string) codec.Reader {
+	c := config.Client(oauth2.NoContext, d.Token)
+	rf := protocol.HTTPReader(ctx, c, "drive:"+d.Name, fmt.Sprintf(downloadURL, url.PathEscape(id)))
 	return func() (io.ReadCloser, int64, error) {
 		log.Println("DRIVE", id)
-		service, err := d.getService()
-		if err != nil {
-			return nil, 0, err
+		return rf()
+	}
+}
+
+// folderType is the MIME type of Drive folders. Files of other Google types,
+// like documents, have no contents to download.
+const (
+	folderType  = "application/vnd.google-apps.folder"
+	googleTypes = "application/vnd.google-apps."
+)
+
+// MirrorFiles lists the files below folder, a path of folder names from the
+// root of the drive.
+func (d *Drive) MirrorFiles(ctx context.Context, folder string) ([]protocol.RemoteFile, error) {
+	service, err := d.getService()
+	if err != nil {
+		return nil, err
+	}
+	quote := strings.NewReplacer(`\`, `\\`, `'`, `\'`).Replace
+	id := "root"
+	for _, name := range strings.Split(folder, "/") {
+		if name == "" {
+			continue
 		}
-		fgc := service.Files.Get(id)
-		file, err := fgc.Do()
+		fl, err := service.Files.
+			List().
+			Q(fmt.Sprintf("'%s' in parents and name = '%s' and mimeType = '%s' and trashed = false", id, quote(name), folderType)).
+			Fields("files(id)").
+			Context(ctx).
+			Do()
 		if err != nil {
-			return nil, 0, err
+			return nil, err
 		}
-		resp, err := fgc.Download()
-		if err != nil {
-			return nil, 0, err
+		if len(fl.Files) == 0 {
+			return nil, fmt.Errorf("drive: no folder %s", folder)
 		}
-		if resp.StatusCode != 200 {
-			resp.Body.Close()
-			return nil, 0, fmt.Errorf(resp.Status)
+		id = fl.Files[0].Id
+	}
+	type dir struct {
+		id, path string
+	}
+	dirs := []dir{{id: id}}
+	var files []protocol.RemoteFile
+	for len(dirs) > 0 {
+		dr := dirs[0]
+		dirs = dirs[1:]
+		var nextPage string
+		for {
+			fl, err := service.Files.
+				List().
+				Q(fmt.Sprintf("'%s' in parents and trashed = false", dr.id)).
+				PageToken(nextPage).
+				Fields("nextPageToken", "files(id,name,mimeType,size,md5Checksum,modifiedTime)").
+				PageSize(1000).
+				Context(ctx).
+				Do()
+			if err != nil {
+				return nil, err
+			}
+			for _, f := range fl.Files {
+				p := path.Join(dr.path, f.Name)
+				switch {
+				case f.MimeType == folderType:
+					dirs = append(dirs, dir{f.Id, p})
+				case strings.HasPrefix(f.MimeType, googleTypes):
+				default:
+					version := f.Md5Checksum
+					if version == "" {
+						version = f.ModifiedTime
+					}
+					files = append(files, protocol.RemoteFile{
+						Path:    p,
+						Size:    f.Size,
+						Version: version,
+						MD5:     f.Md5Checksum,
+						Open:    d.reader(ctx, f.Id),
+					})
+				}
+			}
+			nextPage = fl.NextPageToken
+			if nextPage == "" {
+				break
+			}
 		}
-		return resp.Body, file.Size, nil
 	}
+	return files, nil
 }
 
 func (d *Drive) Refresh() (protocol.SongList, error) {
@@ -137,7 +245,7 @@ func (d *Drive) Refresh() (protocol.SongList, error) {
 		}
 		nextPage = fl.NextPageToken
 		for _, f := range fl.Files {
-			ss, _, err = codec.ByExtension(f.FileExtension, d.reader(f.Id))
+			ss, _, err = codec.ByExtension(f.FileExtension, d.reader(context.Background(), f.Id))
 			if err != nil || len(ss) == 0 {
 				continue
 			}
@@ -1,12 +1,14 @@
 package dropbox
 
 import (
+	"context"
 	"encoding/gob"
 	"fmt"
 	"io"
 	"log"
 	"path"
 	"reflect"
+	"strings"
 
 	"github.com/mjibson/moggio/codec"
 	"github.com/mjibson/moggio/protocol"
@@ -64,6 +66,15 @@ func New(params []string, token *oauth2.Token) (protocol.Instance, error) {
 	return d, nil
 }
 
+func (d *Dropbox) Connect() error {
+	service, err := d.getService()
+	if err != nil {
+		return err
+	}
+	_, err = service.Account().Do()
+	return err
+}
+
 func (d *Dropbox) Key() string {
 	return d.Name
 }
@@ -76,20 +87,53 @@ func (d *Dropbox) Info(id codec.ID) (*codec.SongInfo, error) {
 	return s, nil
 }
 
+// List returns the saved songs. Instances with none are refreshed after
+// connecting.
 func (d *Dropbox) List() (protocol.SongList, error) {
-	if len(d.Songs) == 0 {
-		return d.Refresh()
-	}
 	return d.Songs, nil
 }
 
 func (d *Dropbox) GetSong(id codec.ID) (codec.Song, error) {
+	return d.GetSongContext(context.Background(), id)
+}
+
+func (d *Dropbox) GetSongContext(ctx context.Context, id codec.ID) (codec.Song, error) {
 	path, child := id.Pop()
 	f := d.Files[path]
 	if f == nil {
 		return nil, fmt.Errorf("missing %v", path)
 	}
-	return codec.ByExtensionID(path, child, d.reader(path, f.Bytes))
+	return codec.ByExtensionID(path, child, d.reader(path, f.Bytes).WithContext(ctx))
+}
+
+// Download returns a reader of the song's file.
+func (d *Dropbox) Download(ctx context.Context, id codec.ID) (string, codec.Reader, error) {
+	p, _ := id.Pop()
+	f := d.Files[p]
+	if f == nil {
+		return "", nil, fmt.Errorf("missing %v", p)
+	}
+	return path.Base(p), d.reader(p, f.Bytes).WithContext(ctx), nil
+}
+
+// Probe checks that the song's file can be read, by reading its first byte,
+// since the API has no way to check without downloading it.
+func (d *Dropbox) Probe(ctx context.Context, id codec.ID) error {
+	path, _ := id.Pop()
+	f := d.Files[path]
+	if f == nil {
+		return fmt.Errorf("missing %v", path)
+	}
+	r, _, err := d.reader(path, f.Bytes).WithContext(ctx)()
+	if err != nil {
+		return err
+	}
+	defer r.Close()
+	_, err = r.Read(make([]byte, 1))
+	if err == io.EOF && f.Bytes == 0 {
+		err = nil
+	}
+	return err
 }
 
 func (d *Dropbox) reader(id string, size int64) codec.Reader {
@@ -107,6 +151,49 @@ func (d *Dropbox) reader(id string, size int64) codec.Reader {
 	}
 }
 
+// MirrorFiles lists the files below folder. Dropbox has no checksums of
+// files, so their revisions tell when they change.
+func (d *Dropbox) MirrorFiles(ctx context.Context, folder string) ([]protocol.RemoteFile, error) {
+	service, err := d.getService()
+	if err != nil {
+		return nil, err
+	}
+	root := strings.Trim(folder, "/")
+	dirs := []string{root}
+	var files []protocol.RemoteFile
+	for len(dirs) > 0 {
+		if err := ctx.Err(); err != nil {
+			return nil, err
+		}
+		dir := dirs[0]
+		dirs = dirs[1:]
+		list, err := service.List().Path(dir).Do()
+		if err != nil {
+			return nil, err
+		}
+		for _, f := range list.Contents {
+			if f.IsDir {
+				dirs = append(dirs, f.Path)
+				continue
+			}
+			// Paths are case insensitive, so may differ in case from
+			// folder.
+			rel := f.Path
+			if prefix := "/" + root; len(rel) >= len(prefix) && strings.EqualFold(rel[:len(prefix)], prefix) {
+				rel = rel[len(prefix):]
+			}
+			rel = strings.TrimPrefix(rel, "/")
+			files = append(files, protocol.RemoteFile{
+				Path:    rel,
+				Size:    f.Bytes,
+				Version: f.Rev,
+				Open:    d.reader(f.Path, f.Bytes).WithContext(ctx),
+			})
+		}
+	}
+	return files, nil
+}
+
 func (d *Dropbox) Refresh() (protocol.SongList, error) {
 	service, err := d.getService()
 	if err != nil {
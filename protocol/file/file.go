@@ -4,10 +4,16 @@ import (
 	"encoding/gob"
 	"fmt"
 	"io"
+	"io/ioutil"
 	"log"
+	"net/url"
 	"os"
 	"path/filepath"
 	"reflect"
+	"runtime"
+	"strings"
+	"sync"
+	"time"
 
 	"github.com/mjibson/moggio/codec"
 	"github.com/mjibson/moggio/protocol"
@@ -41,6 +47,8 @@ func New(params []string, token *oauth2.Token) (protocol.Instance, error) {
 type File struct {
 	Path  string
 	Songs protocol.SongList
+	// Scanned are the files whose tags were read, by path.
+	Scanned map[string]*scanned
 }
 
 func (f *File) Key() string {
@@ -72,44 +80,180 @@ func (f *File) List() (protocol.SongList, error) {
 	return f.Songs, nil
 }
 
+// scanned records the size and modification time of a file when its tags
+// were read, and the songs found in it, so unchanged files aren't read again.
+type scanned struct {
+	Size    int64
+	ModTime time.Time
+	// IDs are the songs' IDs in the file.
+	IDs []string
+}
+
+// scanWorkers bounds the number of files read at once.
+var scanWorkers = runtime.NumCPU() * 2
+
+// artURL is the path the server serves the artwork of a song at. Embedded
+// artwork is served from there instead of being held in memory as a data URL
+// for every song.
+const artURL = "/api/art/"
+
 func (f *File) Refresh() (protocol.SongList, error) {
+	type job struct {
+		path string
+		info os.FileInfo
+	}
+	type result struct {
+		path  string
+		scan  *scanned
+		songs protocol.SongList
+	}
+	jobs := make(chan job)
+	results := make(chan result)
+	var wg sync.WaitGroup
+	for i := 0; i < scanWorkers; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for j := range jobs {
+				sc, songs := f.scanFile(j.path)
+				if sc != nil {
+					sc.Size, sc.ModTime = j.info.Size(), j.info.ModTime()
+				}
+				results <- result{j.path, sc, songs}
+			}
+		}()
+	}
 	songs := make(protocol.SongList)
+	scans := make(map[string]*scanned)
+	done := make(chan struct{})
+	go func() {
+		for r := range results {
+			if r.scan == nil {
+				continue
+			}
+			scans[r.path] = r.scan
+			for id, info := range r.songs {
+				songs[id] = info
+			}
+		}
+		close(done)
+	}()
 	err := filepath.Walk(f.Path, func(path string, info os.FileInfo, err error) error {
 		if err != nil {
 			return err
 		}
 		if info.IsDir() {
+			// Hidden directories, like the trash of sync jobs, aren't
+			// music.
+			if path != f.Path && strings.HasPrefix(info.Name(), ".") {
+				return filepath.SkipDir
+			}
 			return nil
 		}
-		f, err := os.Open(path)
-		if err != nil {
-			return nil
-		}
-		defer f.Close()
-		ss, _, err := codec.ByExtension(path, fileReader(path))
-		if err != nil || len(ss) == 0 {
-			return nil
-		}
-		for i, s := range ss {
-			info, _ := s.Info()
-			if info.Title == "" {
-				title := filepath.Base(path)
-				if len(ss) != 1 {
-					title += fmt.Sprintf(":%v", i)
+		if sc := f.Scanned[path]; sc != nil && sc.Size == info.Size() && sc.ModTime.Equal(info.ModTime()) {
+			cached := make(protocol.SongList)
+			for _, i := range sc.IDs {
+				id := codec.NewID(path, i)
+				if f.Songs[id] == nil {
+					// Removed from the list since; read it again.
+					cached = nil
+					break
 				}
-				info.Title = title
+				cached[id] = f.Songs[id]
 			}
-			if info.Album == "" {
-				info.Album = filepath.Base(filepath.Dir(path))
+			if cached != nil {
+				results <- result{path, sc, cached}
+				return nil
 			}
-			songs[codec.NewID(path, string(i))] = &info
 		}
+		jobs <- job{path, info}
 		return nil
 	})
+	close(jobs)
+	wg.Wait()
+	close(results)
+	<-done
 	f.Songs = songs
+	f.Scanned = scans
 	return songs, err
 }
 
+// scanFile reads the tags of the songs in the file at path. It returns nil
+// if the file isn't a song.
+func (f *File) scanFile(path string) (*scanned, protocol.SongList) {
+	ss, _, err := codec.ByExtension(path, fileReader(path))
+	if err != nil || len(ss) == 0 {
+		return nil, nil
+	}
+	sc := new(scanned)
+	songs := make(protocol.SongList)
+	for i, s := range ss {
+		info, _ := s.Info()
+		if info.Title == "" {
+			title := filepath.Base(path)
+			if len(ss) != 1 {
+				title += fmt.Sprintf(":%v", i)
+			}
+			info.Title = title
+		}
+		if info.Album == "" {
+			info.Album = filepath.Base(filepath.Dir(path))
+		}
+		id := codec.NewID(path, string(i))
+		if strings.HasPrefix(info.ImageURL, "data:") {
+			info.ImageURL = artURL + url.PathEscape(string(codec.NewID("file", f.Path, string(id))))
+		}
+		info.Compact()
+		songs[id] = &info
+		sc.IDs = append(sc.IDs, string(i))
+	}
+	return sc, songs
+}
+
+func (f *File) SongPath(id codec.ID) (string, error) {
+	if _, ok := f.Songs[id]; !ok {
+		return "", fmt.Errorf("could not find %v", id)
+	}
+	path, _ := id.Pop()
+	return path, nil
+}
+
+func (f *File) SongFile(id codec.ID) (*os.File, error) {
+	path, err := f.SongPath(id)
+	if err != nil {
+		return nil, err
+	}
+	return os.Open(path)
+}
+
+// artNames are the names, without extension, of artwork images looked for
+// in a song's directory.
+var artNames = []string{"cover", "folder", "front", "album"}
+
+var artExts = []string{".jpg", ".jpeg", ".png"}
+
+func (f *File) ArtFile(id codec.ID) (*os.File, error) {
+	if _, ok := f.Songs[id]; !ok {
+		return nil, fmt.Errorf("could not find %v", id)
+	}
+	path, _ := id.Pop()
+	dir := filepath.Dir(path)
+	names, err := ioutil.ReadDir(dir)
+	if err != nil {
+		return nil, err
+	}
+	for _, name := range artNames {
+		for _, ext := range artExts {
+			for _, fi := range names {
+				if strings.EqualFold(fi.Name(), name+ext) {
+					return os.Open(filepath.Join(dir, fi.Name()))
+				}
+			}
+		}
+	}
+	return nil, os.ErrNotExist
+}
+
 func fileReader(path string) codec.Reader {
 	return func() (io.ReadCloser, int64, error) {
 		log.Println("open file", path)
@@ -1,6 +1,7 @@
 package gmusic
 
 import (
+	"context"
 	"encoding/gob"
 	"fmt"
 	"io"
@@ -62,11 +63,29 @@ func (g *GMusic) List() (protocol.SongList, error) {
 }
 
 func (g *GMusic) GetSong(id codec.ID) (codec.Song, error) {
+	return g.GetSongContext(context.Background(), id)
+}
+
+func (g *GMusic) GetSongContext(ctx context.Context, id codec.ID) (codec.Song, error) {
 	f := g.Tracks[id]
 	if f == nil {
 		return nil, fmt.Errorf("missing %v", id)
 	}
-	return mpa.NewSong(func() (io.ReadCloser, int64, error) {
+	return mpa.NewSong(g.reader(id, f).WithContext(ctx))
+}
+
+// Download returns a reader of the track's stream, which is an MP3.
+func (g *GMusic) Download(ctx context.Context, id codec.ID) (string, codec.Reader, error) {
+	f := g.Tracks[id]
+	if f == nil {
+		return "", nil, fmt.Errorf("missing %v", id)
+	}
+	return f.Title + ".mp3", g.reader(id, f).WithContext(ctx), nil
+}
+
+// reader reads the stream of track id, f.
+func (g *GMusic) reader(id codec.ID, f *gmusic.Track) codec.Reader {
+	return func() (io.ReadCloser, int64, error) {
 		log.Println("GMUSIC", id)
 		r, err := g.GMusic.GetStream(string(id))
 		if err != nil {
@@ -74,7 +93,7 @@ func (g *GMusic) GetSong(id codec.ID) (codec.Song, error) {
 		}
 		size, _ := strconv.ParseInt(f.EstimatedSize, 10, 64)
 		return r.Body, size, nil
-	})
+	}
 }
 
 func (g *GMusic) Refresh() (protocol.SongList, error) {
@@ -0,0 +1,30 @@
+package protocol
+
+import (
+	"context"
+
+	"github.com/mjibson/moggio/codec"
+)
+
+// Mirrorer is implemented by instances with songs in cloud folders, which
+// can be mirrored to local disk. MirrorFiles lists all the files below
+// folder, a slash-separated path from the instance's root, "" for all of
+// them; not only songs, so artwork and cue sheets are mirrored with them.
+type Mirrorer interface {
+	MirrorFiles(ctx context.Context, folder string) ([]RemoteFile, error)
+}
+
+// RemoteFile is a file of a Mirrorer.
+type RemoteFile struct {
+	// Path is the file's slash-separated path below the folder listed.
+	Path string
+	// Size is the file's size, or 0 if unknown.
+	Size int64
+	// Version changes when the file's contents do.
+	Version string
+	// MD5 is the hex MD5 checksum of the file's contents, if the service
+	// has one.
+	MD5 string
+	// Open reads the file.
+	Open codec.Reader `json:"-"`
+}
@@ -0,0 +1,72 @@
+package protocol
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"net/http"
+
+	"github.com/mjibson/moggio/codec"
+)
+
+// Prober is implemented by instances that read songs over the network.
+// Probe checks that a song can still be read before its turn to play, so one
+// that can't is skipped instead of stalling playback until it times out.
+// Instances reading songs from temporary URLs resolve them, and use them for
+// the song's next GetSong while they're fresh.
+type Prober interface {
+	Probe(ctx context.Context, id codec.ID) error
+}
+
+// ProbeURL checks that u can be read, with a HEAD request, or a GET of its
+// first byte from servers that don't allow HEAD, like those of signed URLs.
+// Failures count against the source's breaker, like failed reads.
+func ProbeURL(ctx context.Context, client *http.Client, source, u string) error {
+	if client == nil {
+		client = http.DefaultClient
+	}
+	b := getBreaker(source)
+	if err := b.allow(); err != nil {
+		return err
+	}
+	status, err := probe(ctx, client, "HEAD", u)
+	if err == nil && status/100 != 2 {
+		status, err = probe(ctx, client, "GET", u)
+	}
+	switch {
+	case err != nil && ctx.Err() != nil:
+		return err
+	case err != nil || status == http.StatusTooManyRequests || status >= 500:
+		b.failure()
+	default:
+		b.success()
+	}
+	if err != nil {
+		return err
+	}
+	if status/100 != 2 {
+		return fmt.Errorf("%s: %d %s", u, status, http.StatusText(status))
+	}
+	return nil
+}
+
+// probe requests u with method, of which GETs read only the first byte, and
+// returns the response's status.
+func probe(ctx context.Context, client *http.Client, method, u string) (int, error) {
+	req, err := http.NewRequestWithContext(ctx, method, u, nil)
+	if err != nil {
+		return 0, err
+	}
+	if method == "GET" {
+		req.Header.Set("Range", "bytes=0-0")
+	}
+	resp, err := client.Do(req)
+	if err != nil {
+		return 0, err
+	}
+	// Servers ignoring the range send it all, so don't drain it.
+	io.CopyN(ioutil.Discard, resp.Body, 1)
+	resp.Body.Close()
+	return resp.StatusCode, nil
+}
@@ -1,10 +1,20 @@
 package protocol
 
 import (
+	"context"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/base64"
 	"encoding/gob"
+	"encoding/json"
 	"fmt"
 	"io"
+	"io/ioutil"
+	"net/http"
+	"net/url"
+	"os"
 	"reflect"
+	"time"
 
 	"github.com/mjibson/moggio/codec"
 	"golang.org/x/oauth2"
@@ -15,6 +25,8 @@ type Protocol struct {
 	OAuth       *oauth2.Config
 	newInstance func([]string, *oauth2.Token) (Instance, error)
 	instType    reflect.Type
+	// verifier is the PKCE code verifier, if used.
+	verifier string
 }
 
 type Params struct {
@@ -35,8 +47,90 @@ type Instance interface {
 	GetSong(codec.ID) (codec.Song, error)
 }
 
+// FileInstance is implemented by instances with songs stored in local
+// files, which can be served directly.
+type FileInstance interface {
+	// SongPath returns the path of the file of a song.
+	SongPath(codec.ID) (string, error)
+	// SongFile opens the file of a song.
+	SongFile(codec.ID) (*os.File, error)
+	// ArtFile opens the artwork image of a song.
+	ArtFile(codec.ID) (*os.File, error)
+}
+
+// Downloader is implemented by instances with songs in remote files, which
+// can be copied as they are. Songs that are tracks of a larger file download
+// the whole file.
+type Downloader interface {
+	// Download returns the name of the file of a song and its reader. The
+	// file isn't read until the reader is called.
+	Download(ctx context.Context, id codec.ID) (name string, r codec.Reader, err error)
+}
+
+// Connector is implemented by instances that need a remote service. Connect
+// checks that the service can be reached with the instance's credentials.
+// It is called in the background after the instance is restored; until then
+// the instance lists its saved songs, and may fail to play them.
+type Connector interface {
+	Connect() error
+}
+
+// ContextGetter is implemented by instances that read songs over the
+// network. Reads of a song from GetSongContext fail once ctx is done, so a
+// song no longer wanted doesn't hold a connection open.
+type ContextGetter interface {
+	GetSongContext(ctx context.Context, id codec.ID) (codec.Song, error)
+}
+
+// GetSong returns a playable song of inst, read with ctx if inst supports it.
+func GetSong(ctx context.Context, inst Instance, id codec.ID) (codec.Song, error) {
+	if g, ok := inst.(ContextGetter); ok && ctx != nil {
+		return g.GetSongContext(ctx, id)
+	}
+	return inst.GetSong(id)
+}
+
+// Recorder is implemented by instances of live streams, like internet
+// radio, which can be recorded as they're broadcast.
+type Recorder interface {
+	// Record opens a connection to the stream of id, separate from any
+	// playing it, reading its audio as it's sent until ctx is done.
+	Record(ctx context.Context, id codec.ID) (Recording, error)
+}
+
+// Recording is the audio of a live stream being recorded.
+type Recording interface {
+	io.ReadCloser
+	// Title returns the title of the song the stream is playing, or "" if
+	// unknown. It changes between reads, at the start of the next song.
+	Title() string
+	// Format is the format of the audio, "mp3" or "ogg".
+	Format() string
+}
+
+// PlayCounter is implemented by instances that record plays of their
+// songs.
+type PlayCounter interface {
+	// Played records that a song started playing.
+	Played(codec.ID) error
+}
+
+// Attributer is implemented by instances with attributes of songs beyond
+// their SongInfo, like ratings.
+type Attributer interface {
+	// SongAttributes returns the attributes of a song by name.
+	SongAttributes(codec.ID) map[string]string
+}
+
 type SongList map[codec.ID]*codec.SongInfo
 
+// Compact interns the shared strings of the songs of l.
+func (l SongList) Compact() {
+	for _, info := range l {
+		info.Compact()
+	}
+}
+
 func (p *Protocol) NewInstance(params []string, token *oauth2.Token) (Instance, error) {
 	return p.newInstance(params, token)
 }
@@ -76,6 +170,74 @@ func RegisterOAuth(name string, config *oauth2.Config, newInstance func([]string
 	}
 }
 
+// RegisterOAuthPKCE registers an OAuth protocol whose authorization uses
+// PKCE (RFC 7636).
+func RegisterOAuthPKCE(name string, config *oauth2.Config, newInstance func([]string, *oauth2.Token) (Instance, error), instType reflect.Type) {
+	b := make([]byte, 32)
+	if _, err := rand.Read(b); err != nil {
+		panic(err)
+	}
+	verifier := base64.RawURLEncoding.EncodeToString(b)
+	challenge := sha256.Sum256([]byte(verifier))
+	protocols[name] = &Protocol{
+		Params: &Params{
+			OAuthURL: config.AuthCodeURL("",
+				oauth2.SetAuthURLParam("code_challenge", base64.RawURLEncoding.EncodeToString(challenge[:])),
+				oauth2.SetAuthURLParam("code_challenge_method", "S256"),
+			),
+		},
+		OAuth:       config,
+		newInstance: newInstance,
+		instType:    instType,
+		verifier:    verifier,
+	}
+}
+
+// Exchange converts an authorization code into a token.
+func (p *Protocol) Exchange(ctx context.Context, code string) (*oauth2.Token, error) {
+	if p.verifier == "" {
+		return p.OAuth.Exchange(ctx, code)
+	}
+	c := p.OAuth
+	resp, err := http.PostForm(c.Endpoint.TokenURL, url.Values{
+		"grant_type":    {"authorization_code"},
+		"client_id":     {c.ClientID},
+		"client_secret": {c.ClientSecret},
+		"redirect_uri":  {c.RedirectURL},
+		"code":          {code},
+		"code_verifier": {p.verifier},
+	})
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode != http.StatusOK {
+		b, _ := ioutil.ReadAll(io.LimitReader(resp.Body, 1<<10))
+		return nil, fmt.Errorf("oauth2: cannot fetch token: %v: %s", resp.Status, b)
+	}
+	var t struct {
+		AccessToken  string `json:"access_token"`
+		TokenType    string `json:"token_type"`
+		RefreshToken string `json:"refresh_token"`
+		ExpiresIn    int64  `json:"expires_in"`
+	}
+	if err := json.NewDecoder(resp.Body).Decode(&t); err != nil {
+		return nil, err
+	}
+	if t.AccessToken == "" {
+		return nil, fmt.Errorf("oauth2: server response missing access_token")
+	}
+	tok := &oauth2.Token{
+		AccessToken:  t.AccessToken,
+		TokenType:    t.TokenType,
+		RefreshToken: t.RefreshToken,
+	}
+	if t.ExpiresIn > 0 {
+		tok.Expiry = time.Now().Add(time.Duration(t.ExpiresIn) * time.Second)
+	}
+	return tok, nil
+}
+
 func ByName(name string) (*Protocol, error) {
 	p, ok := protocols[name]
 	if !ok {
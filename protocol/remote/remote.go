@@ -0,0 +1,176 @@
+// Package remote is a protocol for songs at HTTP URLs, like those pushed by
+// UPnP controllers.
+package remote
+
+import (
+	"context"
+	"encoding/gob"
+	"fmt"
+	"io"
+	"log"
+	"net/url"
+	"path"
+	"reflect"
+
+	"github.com/mjibson/moggio/codec"
+	"github.com/mjibson/moggio/protocol"
+	"golang.org/x/oauth2"
+)
+
+func init() {
+	protocol.Register("remote", []string{"URL"}, New, reflect.TypeOf(&Remote{}))
+	gob.Register(new(Remote))
+}
+
+// maxSongs is the number of songs an instance keeps; the oldest are
+// removed.
+const maxSongs = 500
+
+// New returns an instance with the song at a URL.
+func New(params []string, token *oauth2.Token) (protocol.Instance, error) {
+	if len(params) != 1 {
+		return nil, fmt.Errorf("expected one parameter")
+	}
+	r := &Remote{
+		Name: params[0],
+	}
+	id, err := r.Add(params[0], nil)
+	if err != nil {
+		return nil, err
+	}
+	// Check that it's playable.
+	song, err := r.GetSong(id)
+	if err != nil {
+		return nil, err
+	}
+	info, err := song.Info()
+	song.Close()
+	if err == nil && info.Title != "" {
+		r.Songs[id] = &info
+	}
+	return r, nil
+}
+
+type Remote struct {
+	Name  string
+	Songs protocol.SongList
+	// Order is the IDs of Songs, oldest first.
+	Order []codec.ID
+}
+
+func (r *Remote) Key() string {
+	return r.Name
+}
+
+// Add adds the song at u with info, which may be nil, and returns its ID.
+func (r *Remote) Add(u string, info *codec.SongInfo) (codec.ID, error) {
+	p, err := url.Parse(u)
+	if err != nil {
+		return "", err
+	}
+	if p.Scheme != "http" && p.Scheme != "https" {
+		return "", fmt.Errorf("unsupported URL: %s", u)
+	}
+	if info == nil {
+		info = new(codec.SongInfo)
+	}
+	if info.Title == "" {
+		info.Title = path.Base(p.Path)
+	}
+	if r.Songs == nil {
+		r.Songs = make(protocol.SongList)
+	}
+	id := codec.ID(u)
+	if _, ok := r.Songs[id]; !ok {
+		r.Order = append(r.Order, id)
+	}
+	r.Songs[id] = info
+	for len(r.Order) > maxSongs {
+		delete(r.Songs, r.Order[0])
+		r.Order = r.Order[1:]
+	}
+	return id, nil
+}
+
+func (r *Remote) Info(id codec.ID) (*codec.SongInfo, error) {
+	v := r.Songs[id]
+	if v == nil {
+		return nil, fmt.Errorf("could not find %v", id)
+	}
+	return v, nil
+}
+
+func (r *Remote) List() (protocol.SongList, error) {
+	return r.Songs, nil
+}
+
+func (r *Remote) Refresh() (protocol.SongList, error) {
+	return r.Songs, nil
+}
+
+func (r *Remote) GetSong(id codec.ID) (codec.Song, error) {
+	return r.GetSongContext(context.Background(), id)
+}
+
+func (r *Remote) GetSongContext(ctx context.Context, id codec.ID) (codec.Song, error) {
+	if _, ok := r.Songs[id]; !ok {
+		return nil, fmt.Errorf("could not find %v", id)
+	}
+	u := string(id)
+	rf := httpReader(ctx, u)
+	p, _ := url.Parse(u)
+	if song, err := codec.ByExtensionID(p.Path, codec.None, rf); err == nil {
+		return song, nil
+	}
+	// Without a known extension, sniff the format.
+	songs, _, err := codec.Decode(rf)
+	if err != nil {
+		return nil, err
+	}
+	for _, song := range songs {
+		return song, nil
+	}
+	return nil, fmt.Errorf("no songs at %s", u)
+}
+
+// Download returns a reader of the song's URL, named by the last element of
+// its path.
+func (r *Remote) Download(ctx context.Context, id codec.ID) (string, codec.Reader, error) {
+	if _, ok := r.Songs[id]; !ok {
+		return "", nil, fmt.Errorf("could not find %v", id)
+	}
+	u := string(id)
+	name := "song"
+	if p, err := url.Parse(u); err == nil {
+		if b := path.Base(p.Path); b != "." && b != "/" {
+			name = b
+		}
+	}
+	return name, httpReader(ctx, u), nil
+}
+
+// Probe checks that the song's URL can be read.
+func (r *Remote) Probe(ctx context.Context, id codec.ID) error {
+	if _, ok := r.Songs[id]; !ok {
+		return fmt.Errorf("could not find %v", id)
+	}
+	u := string(id)
+	return protocol.ProbeURL(ctx, nil, source(u), u)
+}
+
+// source returns the source failures reading u count against: its host.
+func source(u string) string {
+	if p, err := url.Parse(u); err == nil {
+		return p.Host
+	}
+	return u
+}
+
+// httpReader reads u, counting failures against its host.
+func httpReader(ctx context.Context, u string) codec.Reader {
+	rf := protocol.HTTPReader(ctx, nil, source(u), u)
+	return func() (io.ReadCloser, int64, error) {
+		log.Println("open url", u)
+		return rf()
+	}
+}
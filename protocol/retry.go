@@ -0,0 +1,235 @@
+package protocol
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"log"
+	"net/http"
+	"strconv"
+	"sync"
+	"time"
+
+	"github.com/mjibson/moggio/codec"
+)
+
+const (
+	// maxRetries is the number of times a failed request is retried.
+	maxRetries = 5
+	// retryBase is the wait before the first retry, doubled for each
+	// after, up to retryMax.
+	retryBase = time.Millisecond * 250
+	retryMax  = time.Second * 8
+	// breakerFailures is the number of consecutive transient failures of
+	// a source that trip its breaker, which then fails requests without
+	// making them for breakerTimeout.
+	breakerFailures = 5
+	breakerTimeout  = time.Minute
+)
+
+// ErrCircuitOpen is returned for requests to a source that has failed too
+// often recently.
+var ErrCircuitOpen = errors.New("protocol: source failing, retrying later")
+
+// breaker is the circuit breaker of a source.
+type breaker struct {
+	sync.Mutex
+	failures int
+	until    time.Time
+}
+
+var breakers = struct {
+	sync.Mutex
+	m map[string]*breaker
+}{m: make(map[string]*breaker)}
+
+func getBreaker(source string) *breaker {
+	breakers.Lock()
+	defer breakers.Unlock()
+	b := breakers.m[source]
+	if b == nil {
+		b = new(breaker)
+		breakers.m[source] = b
+	}
+	return b
+}
+
+// allow returns ErrCircuitOpen if the breaker is tripped. After it times
+// out, requests are allowed again; the next failure trips it again.
+func (b *breaker) allow() error {
+	b.Lock()
+	defer b.Unlock()
+	if time.Now().Before(b.until) {
+		return ErrCircuitOpen
+	}
+	return nil
+}
+
+func (b *breaker) success() {
+	b.Lock()
+	b.failures = 0
+	b.until = time.Time{}
+	b.Unlock()
+}
+
+func (b *breaker) failure() {
+	b.Lock()
+	defer b.Unlock()
+	b.failures++
+	if b.failures >= breakerFailures {
+		b.until = time.Now().Add(breakerTimeout)
+	}
+}
+
+// HTTPReader returns a Reader of the body of u, fetched with client.
+// Network errors and 429 and 5xx responses are retried with exponential
+// backoff, and reads that fail partway resume where they stopped with a
+// range request. Failures are counted by source, an instance or host, whose
+// requests fail immediately for a while if it keeps failing, so one flaky
+// service doesn't hold up playback of others.
+func HTTPReader(ctx context.Context, client *http.Client, source, u string) codec.Reader {
+	if client == nil {
+		client = http.DefaultClient
+	}
+	return func() (io.ReadCloser, int64, error) {
+		r := &retryReader{
+			ctx:     ctx,
+			client:  client,
+			url:     u,
+			source:  source,
+			breaker: getBreaker(source),
+		}
+		if err := r.open(); err != nil {
+			return nil, 0, err
+		}
+		return r, r.size, nil
+	}
+}
+
+type retryReader struct {
+	ctx     context.Context
+	client  *http.Client
+	url     string
+	source  string
+	breaker *breaker
+
+	body io.ReadCloser
+	// offset is the number of bytes read, and size the length of the
+	// body, or 0 if unknown.
+	offset, size int64
+	// resumes is the number of times reading resumed after failing.
+	resumes int
+	err     error
+}
+
+// open requests the body from offset, retrying transient failures.
+func (r *retryReader) open() error {
+	for attempt := 0; ; attempt++ {
+		if err := r.breaker.allow(); err != nil {
+			return err
+		}
+		wait, err := r.request()
+		if err == nil {
+			r.breaker.success()
+			return nil
+		}
+		if wait < 0 || r.ctx.Err() != nil {
+			return err
+		}
+		r.breaker.failure()
+		if attempt == maxRetries {
+			return err
+		}
+		if wait == 0 {
+			wait = retryBase << uint(attempt)
+		}
+		if wait > retryMax {
+			wait = retryMax
+		}
+		log.Printf("%s: %v; retrying in %v", r.source, err, wait)
+		t := time.NewTimer(wait)
+		select {
+		case <-t.C:
+		case <-r.ctx.Done():
+			t.Stop()
+			return r.ctx.Err()
+		}
+	}
+}
+
+// request makes one request. If it fails, wait is negative if it shouldn't
+// be retried, or else how long the server asked to wait, if it did.
+func (r *retryReader) request() (wait time.Duration, err error) {
+	req, err := http.NewRequestWithContext(r.ctx, "GET", r.url, nil)
+	if err != nil {
+		return -1, err
+	}
+	if r.offset > 0 {
+		req.Header.Set("Range", fmt.Sprintf("bytes=%d-", r.offset))
+	}
+	resp, err := r.client.Do(req)
+	if err != nil {
+		return 0, err
+	}
+	switch {
+	case resp.StatusCode == http.StatusOK:
+		if r.offset == 0 {
+			if resp.ContentLength > 0 {
+				r.size = resp.ContentLength
+			}
+			break
+		}
+		// The server ignored the range: skip what was read.
+		if _, err := io.CopyN(ioutil.Discard, resp.Body, r.offset); err != nil {
+			resp.Body.Close()
+			return 0, err
+		}
+	case resp.StatusCode == http.StatusPartialContent && r.offset > 0:
+	case resp.StatusCode == http.StatusTooManyRequests || resp.StatusCode >= 500:
+		resp.Body.Close()
+		wait, _ := strconv.Atoi(resp.Header.Get("Retry-After"))
+		return time.Duration(wait) * time.Second, fmt.Errorf("%s: %s", r.url, resp.Status)
+	default:
+		resp.Body.Close()
+		return -1, fmt.Errorf("%s: %s", r.url, resp.Status)
+	}
+	r.body = resp.Body
+	return 0, nil
+}
+
+func (r *retryReader) Read(p []byte) (int, error) {
+	if r.err != nil {
+		return 0, r.err
+	}
+	n, err := r.body.Read(p)
+	r.offset += int64(n)
+	if err == nil || err == io.EOF || r.ctx.Err() != nil {
+		return n, err
+	}
+	if n > 0 {
+		// Return what was read; the next read fails again and resumes.
+		return n, nil
+	}
+	r.body.Close()
+	r.body = nil
+	if r.resumes == maxRetries {
+		r.err = err
+		return 0, err
+	}
+	r.resumes++
+	log.Printf("%s: %v; resuming at byte %d", r.source, err, r.offset)
+	if err := r.open(); err != nil {
+		r.err = err
+		return 0, err
+	}
+	return r.Read(p)
+}
+
+func (r *retryReader) Close() error {
+	if r.body == nil {
+		return nil
+	}
+	return r.body.Close()
+}
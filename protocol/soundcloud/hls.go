@@ -0,0 +1,121 @@
+package soundcloud
+
+import (
+	"bufio"
+	"context"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"strings"
+
+	"github.com/mjibson/moggio/protocol"
+	"google.golang.org/api/googleapi"
+)
+
+// hlsReader returns the concatenated segments of the HLS playlist at u.
+// Segments are fetched with retries, failures counted against source.
+func hlsReader(ctx context.Context, client *http.Client, source, u string) (io.ReadCloser, error) {
+	segments, err := hlsSegments(client, u, 0)
+	if err != nil {
+		return nil, err
+	}
+	if len(segments) == 0 {
+		return nil, fmt.Errorf("empty playlist: %v", u)
+	}
+	return &segmentReader{
+		ctx:      ctx,
+		client:   client,
+		source:   source,
+		segments: segments,
+	}, nil
+}
+
+// hlsSegments returns the segment URLs of the playlist at u, following the
+// first variant of a master playlist.
+func hlsSegments(client *http.Client, u string, depth int) ([]string, error) {
+	base, err := url.Parse(u)
+	if err != nil {
+		return nil, err
+	}
+	res, err := client.Get(u)
+	if err != nil {
+		return nil, err
+	}
+	defer res.Body.Close()
+	if err := googleapi.CheckResponse(res); err != nil {
+		return nil, err
+	}
+	var segments []string
+	variant := false
+	sc := bufio.NewScanner(res.Body)
+	for sc.Scan() {
+		line := strings.TrimSpace(sc.Text())
+		switch {
+		case line == "":
+		case strings.HasPrefix(line, "#EXT-X-KEY:") && !strings.Contains(line, "METHOD=NONE"):
+			return nil, fmt.Errorf("encrypted streams not supported")
+		case strings.HasPrefix(line, "#EXT-X-STREAM-INF:"):
+			variant = true
+		case strings.HasPrefix(line, "#"):
+		default:
+			ref, err := base.Parse(line)
+			if err != nil {
+				return nil, err
+			}
+			if variant {
+				if depth > 0 {
+					return nil, fmt.Errorf("nested master playlist")
+				}
+				return hlsSegments(client, ref.String(), depth+1)
+			}
+			segments = append(segments, ref.String())
+		}
+	}
+	return segments, sc.Err()
+}
+
+// segmentReader reads segments in order, fetching each as needed.
+type segmentReader struct {
+	ctx      context.Context
+	client   *http.Client
+	source   string
+	segments []string
+	cur      io.ReadCloser
+}
+
+func (r *segmentReader) Read(b []byte) (int, error) {
+	for {
+		if r.cur == nil {
+			if len(r.segments) == 0 {
+				return 0, io.EOF
+			}
+			cur, _, err := protocol.HTTPReader(r.ctx, r.client, r.source, r.segments[0])()
+			if err != nil {
+				return 0, err
+			}
+			r.segments = r.segments[1:]
+			r.cur = cur
+		}
+		n, err := r.cur.Read(b)
+		if err == io.EOF {
+			r.cur.Close()
+			r.cur = nil
+			if n == 0 {
+				continue
+			}
+			err = nil
+		}
+		return n, err
+	}
+}
+
+func (r *segmentReader) Close() error {
+	r.segments = nil
+	if r.cur == nil {
+		return nil
+	}
+	err := r.cur.Close()
+	r.cur = nil
+	return err
+}
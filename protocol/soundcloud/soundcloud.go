@@ -1,12 +1,13 @@
 package soundcloud
 
 import (
+	"context"
 	"encoding/gob"
 	"fmt"
 	"io"
-	"log"
 	"net/http"
 	"reflect"
+	"sync"
 	"time"
 
 	"github.com/mjibson/moggio/codec"
@@ -14,41 +15,91 @@ import (
 	"github.com/mjibson/moggio/protocol"
 	"github.com/mjibson/moggio/protocol/soundcloud/soundcloud"
 	"golang.org/x/oauth2"
-	"google.golang.org/api/googleapi"
 )
 
 var config *oauth2.Config
-var oauthClientID string
+
+const tokenURL = "https://secure.soundcloud.com/oauth/token"
 
 func init() {
 	gob.Register(new(Soundcloud))
+	// The token endpoint wants the client credentials in the form.
+	oauth2.RegisterBrokenAuthHeaderProvider(tokenURL)
 }
 
 func Init(clientID, clientSecret, redirect string) {
-	config := &oauth2.Config{
+	config = &oauth2.Config{
 		ClientID:     clientID,
 		ClientSecret: clientSecret,
 		RedirectURL:  redirect + "soundcloud",
-		Scopes:       []string{"non-expiring"},
 		Endpoint: oauth2.Endpoint{
-			AuthURL:  "https://soundcloud.com/connect",
-			TokenURL: "https://api.soundcloud.com/oauth2/token",
+			AuthURL:  "https://secure.soundcloud.com/authorize",
+			TokenURL: tokenURL,
 		},
 	}
-	oauthClientID = clientID
-	protocol.RegisterOAuth("soundcloud", config, New, reflect.TypeOf(&Soundcloud{}))
+	protocol.RegisterOAuthPKCE("soundcloud", config, New, reflect.TypeOf(&Soundcloud{}))
+}
+
+// token returns a token source that refreshes s.Token, keeping it current
+// so the refreshed token is saved.
+func (s *Soundcloud) token() oauth2.TokenSource {
+	s.mu.Lock()
+	t := s.Token
+	s.mu.Unlock()
+	return &savingSource{s, config.TokenSource(oauth2.NoContext, t)}
+}
+
+type savingSource struct {
+	s   *Soundcloud
+	src oauth2.TokenSource
+}
+
+func (ss *savingSource) Token() (*oauth2.Token, error) {
+	t, err := ss.src.Token()
+	if err != nil {
+		return nil, err
+	}
+	// SoundCloud wants "OAuth" in the Authorization header.
+	t.TokenType = "OAuth"
+	ss.s.mu.Lock()
+	ss.s.Token = t
+	ss.s.mu.Unlock()
+	return t, nil
 }
 
 func (s *Soundcloud) getService() (*soundcloud.Service, *http.Client, error) {
-	c := config.Client(oauth2.NoContext, s.Token)
-	svc, err := soundcloud.New(c, s.Token)
+	c := oauth2.NewClient(oauth2.NoContext, s.token())
+	svc, err := soundcloud.New(c)
 	return svc, c, err
 }
 
 type Soundcloud struct {
-	Token     *oauth2.Token
-	Name      string
-	Favorites map[codec.ID]*soundcloud.Favorite
+	Token *oauth2.Token
+	Name  string
+	// Tracks are the user's liked tracks and those of their playlists.
+	Tracks map[codec.ID]*Track
+
+	mu sync.Mutex
+	// streams are the stream URLs resolved by Probe for the next play of
+	// their tracks.
+	streams map[codec.ID]stream
+}
+
+// stream is the URL of a track's MP3 stream, which is an HLS playlist if hls
+// is set. Stream URLs are signed to expire, so ones resolved ahead of a play
+// are only used until streamLifetime after at.
+type stream struct {
+	url string
+	hls bool
+	at  time.Time
+}
+
+const streamLifetime = time.Minute * 5
+
+// Track is a track and the playlist it was found in, if not liked.
+type Track struct {
+	*soundcloud.Track
+	Playlist string
 }
 
 func New(params []string, token *oauth2.Token) (protocol.Instance, error) {
@@ -70,62 +121,152 @@ func New(params []string, token *oauth2.Token) (protocol.Instance, error) {
 	return s, nil
 }
 
+func (s *Soundcloud) Connect() error {
+	service, _, err := s.getService()
+	if err != nil {
+		return err
+	}
+	_, err = service.Me().Do()
+	return err
+}
+
 func (s *Soundcloud) Key() string {
 	return s.Name
 }
 
 func (s *Soundcloud) Info(id codec.ID) (*codec.SongInfo, error) {
-	f := s.Favorites[id]
-	if f == nil {
+	t := s.Tracks[id]
+	if t == nil {
 		return nil, fmt.Errorf("could not find %v", id)
 	}
-	return toInfo(f), nil
+	return toInfo(t), nil
 }
 
-func toInfo(f *soundcloud.Favorite) *codec.SongInfo {
+func toInfo(t *Track) *codec.SongInfo {
 	return &codec.SongInfo{
-		Time:     time.Duration(f.Duration) * time.Millisecond,
-		Artist:   f.User.Username,
-		Title:    f.Title,
-		ImageURL: f.ArtworkURL,
+		Time:     time.Duration(t.Duration) * time.Millisecond,
+		Artist:   t.User.Username,
+		Title:    t.Title,
+		Album:    t.Playlist,
+		Genre:    t.Genre,
+		ImageURL: t.ArtworkURL,
 	}
 }
 
 func (s *Soundcloud) SongList() protocol.SongList {
 	m := make(protocol.SongList)
-	for k, f := range s.Favorites {
-		m[k] = toInfo(f)
+	for k, t := range s.Tracks {
+		m[k] = toInfo(t)
 	}
 	return m
 }
 
+// List returns the saved songs. Instances with none are refreshed after
+// connecting.
 func (s *Soundcloud) List() (protocol.SongList, error) {
-	if len(s.Favorites) == 0 {
-		return s.Refresh()
-	}
 	return s.SongList(), nil
 }
 
 func (s *Soundcloud) GetSong(id codec.ID) (codec.Song, error) {
-	log.Println("SOUNDCLOUD", id)
-	_, client, err := s.getService()
+	return s.GetSongContext(context.Background(), id)
+}
+
+func (s *Soundcloud) GetSongContext(ctx context.Context, id codec.ID) (codec.Song, error) {
+	service, client, err := s.getService()
 	if err != nil {
 		return nil, err
 	}
-	f := s.Favorites[id]
-	if f == nil {
+	t := s.Tracks[id]
+	if t == nil {
 		return nil, fmt.Errorf("bad id: %v", id)
 	}
-	return mpa.NewSong(func() (io.ReadCloser, int64, error) {
-		res, err := client.Get(f.StreamURL + "?client_id=" + oauthClientID)
+	return mpa.NewSong(s.reader(ctx, service, client, id, t).WithContext(ctx))
+}
+
+// Download returns a reader of the track's MP3 stream.
+func (s *Soundcloud) Download(ctx context.Context, id codec.ID) (string, codec.Reader, error) {
+	service, client, err := s.getService()
+	if err != nil {
+		return "", nil, err
+	}
+	t := s.Tracks[id]
+	if t == nil {
+		return "", nil, fmt.Errorf("bad id: %v", id)
+	}
+	return t.Title + ".mp3", s.reader(ctx, service, client, id, t).WithContext(ctx), nil
+}
+
+// reader reads the stream of track id, t.
+func (s *Soundcloud) reader(ctx context.Context, service *soundcloud.Service, client *http.Client, id codec.ID, t *Track) codec.Reader {
+	return func() (io.ReadCloser, int64, error) {
+		st, err := s.stream(service, id, t)
 		if err != nil {
 			return nil, 0, err
 		}
-		if err := googleapi.CheckResponse(res); err != nil {
-			return nil, 0, err
+		source := "soundcloud:" + s.Name
+		if st.hls {
+			r, err := hlsReader(ctx, client, source, st.url)
+			return r, 0, err
 		}
-		return res.Body, 0, nil
-	})
+		r, _, err := protocol.HTTPReader(ctx, client, source, st.url)()
+		return r, 0, err
+	}
+}
+
+// stream returns the stream of track id: the one Probe resolved, if still
+// fresh, or else a new one.
+func (s *Soundcloud) stream(service *soundcloud.Service, id codec.ID, t *Track) (stream, error) {
+	s.mu.Lock()
+	st, ok := s.streams[id]
+	delete(s.streams, id)
+	s.mu.Unlock()
+	if ok && time.Since(st.at) < streamLifetime {
+		return st, nil
+	}
+	return resolve(service, t)
+}
+
+// resolve returns a new stream of t.
+func resolve(service *soundcloud.Service, t *Track) (stream, error) {
+	streams, err := service.Streams(t.ID).Do()
+	if err != nil {
+		return stream{}, err
+	}
+	// Only MP3 streams can be decoded.
+	switch {
+	case streams.HLSMP3128URL != "":
+		return stream{streams.HLSMP3128URL, true, time.Now()}, nil
+	case streams.HTTPMP3128URL != "":
+		return stream{streams.HTTPMP3128URL, false, time.Now()}, nil
+	}
+	return stream{}, fmt.Errorf("no mp3 stream for %v", t.Title)
+}
+
+// Probe resolves the stream of the track and checks that it can be read,
+// keeping it for the track's next play.
+func (s *Soundcloud) Probe(ctx context.Context, id codec.ID) error {
+	service, client, err := s.getService()
+	if err != nil {
+		return err
+	}
+	t := s.Tracks[id]
+	if t == nil {
+		return fmt.Errorf("bad id: %v", id)
+	}
+	st, err := resolve(service, t)
+	if err != nil {
+		return err
+	}
+	if err := protocol.ProbeURL(ctx, client, "soundcloud:"+s.Name, st.url); err != nil {
+		return err
+	}
+	s.mu.Lock()
+	if s.streams == nil {
+		s.streams = make(map[codec.ID]stream)
+	}
+	s.streams[id] = st
+	s.mu.Unlock()
+	return nil
 }
 
 func (s *Soundcloud) Refresh() (protocol.SongList, error) {
@@ -133,14 +274,27 @@ func (s *Soundcloud) Refresh() (protocol.SongList, error) {
 	if err != nil {
 		return nil, err
 	}
-	favorites, err := service.Favorites().Do()
+	likes, err := service.Likes().Do()
+	if err != nil {
+		return nil, err
+	}
+	playlists, err := service.Playlists().Do()
 	if err != nil {
 		return nil, err
 	}
-	favs := make(map[codec.ID]*soundcloud.Favorite)
-	for _, f := range favorites {
-		favs[codec.Int64(f.ID)] = f
+	tracks := make(map[codec.ID]*Track)
+	for _, p := range playlists {
+		for _, t := range p.Tracks {
+			if t.Streamable {
+				tracks[codec.Int64(t.ID)] = &Track{t, p.Title}
+			}
+		}
+	}
+	for _, t := range likes {
+		if t.Streamable {
+			tracks[codec.Int64(t.ID)] = &Track{Track: t}
+		}
 	}
-	s.Favorites = favs
+	s.Tracks = tracks
 	return s.SongList(), err
 }
@@ -3,65 +3,86 @@ package soundcloud
 import (
 	"encoding/json"
 	"errors"
+	"fmt"
 	"net/http"
 	"net/url"
 
-	"golang.org/x/oauth2"
 	"google.golang.org/api/googleapi"
 )
 
 const basePath = "https://api.soundcloud.com/"
 
-func New(client *http.Client, token *oauth2.Token) (*Service, error) {
+// pageSize is the number of items requested per page of a listing.
+const pageSize = 200
+
+// New returns a service using client, which must authorize its requests.
+func New(client *http.Client) (*Service, error) {
 	if client == nil {
 		return nil, errors.New("client is nil")
 	}
-	if token == nil {
-		return nil, errors.New("token is nil")
-	}
 	base, err := url.Parse(basePath)
 	if err != nil {
 		return nil, err
 	}
-	s := &Service{client: client, token: token, BasePath: base}
+	s := &Service{client: client, BasePath: base}
 	return s, nil
 }
 
 type Service struct {
 	client   *http.Client
-	token    *oauth2.Token
 	BasePath *url.URL
 }
 
-func (s *Service) Me() *MeCall {
-	c := &MeCall{s: s, opt_: make(map[string]interface{})}
-	return c
-}
-
-type MeCall struct {
-	s    *Service
-	opt_ map[string]interface{}
-}
-
-func (c *MeCall) Do() (*Me, error) {
-	params := make(url.Values)
-	params.Set("oauth_token", c.s.token.AccessToken)
-	urls, err := c.s.BasePath.Parse("me.json")
+// get decodes the JSON response of the GET request of path, which may be an
+// absolute URL.
+func (s *Service) get(path string, params url.Values, ret interface{}) error {
+	urls, err := s.BasePath.Parse(path)
 	if err != nil {
-		return nil, err
+		return err
+	}
+	if params != nil {
+		urls.RawQuery = params.Encode()
 	}
-	urls.RawQuery = params.Encode()
 	req, _ := http.NewRequest("GET", urls.String(), nil)
-	res, err := c.s.client.Do(req)
+	req.Header.Set("Accept", "application/json; charset=utf-8")
+	res, err := s.client.Do(req)
 	if err != nil {
-		return nil, err
+		return err
 	}
 	defer res.Body.Close()
 	if err := googleapi.CheckResponse(res); err != nil {
-		return nil, err
+		return err
 	}
+	return json.NewDecoder(res.Body).Decode(ret)
+}
+
+// getPages decodes each page of a paginated listing, calling fn after each.
+func (s *Service) getPages(path string, params url.Values, page interface{ next() string }, fn func()) error {
+	params.Set("linked_partitioning", "true")
+	params.Set("limit", fmt.Sprint(pageSize))
+	for path != "" {
+		if err := s.get(path, params, page); err != nil {
+			return err
+		}
+		fn()
+		// The next URL includes the parameters.
+		path, params = page.next(), nil
+	}
+	return nil
+}
+
+func (s *Service) Me() *MeCall {
+	c := &MeCall{s: s}
+	return c
+}
+
+type MeCall struct {
+	s *Service
+}
+
+func (c *MeCall) Do() (*Me, error) {
 	var ret *Me
-	if err := json.NewDecoder(res.Body).Decode(&ret); err != nil {
+	if err := c.s.get("me", nil, &ret); err != nil {
 		return nil, err
 	}
 	return ret, nil
@@ -105,41 +126,103 @@ type Me struct {
 	WebsiteTitle      interface{}   `json:"website_title"`
 }
 
-func (s *Service) Favorites() *FavoritesCall {
-	c := &FavoritesCall{s: s, opt_: make(map[string]interface{})}
+// Likes lists the tracks the user liked.
+func (s *Service) Likes() *LikesCall {
+	c := &LikesCall{s: s}
 	return c
 }
 
-type FavoritesCall struct {
-	s    *Service
-	opt_ map[string]interface{}
+type LikesCall struct {
+	s *Service
+}
+
+type trackPage struct {
+	Collection []*Track `json:"collection"`
+	NextHref   string   `json:"next_href"`
 }
 
-func (c *FavoritesCall) Do() ([]*Favorite, error) {
-	params := make(url.Values)
-	params.Set("oauth_token", c.s.token.AccessToken)
-	urls, err := c.s.BasePath.Parse("me/favorites.json")
+func (p *trackPage) next() string { return p.NextHref }
+
+func (c *LikesCall) Do() ([]*Track, error) {
+	var ret []*Track
+	var page trackPage
+	err := c.s.getPages("me/likes/tracks", url.Values{"access": {"playable"}}, &page, func() {
+		ret = append(ret, page.Collection...)
+		page = trackPage{}
+	})
 	if err != nil {
 		return nil, err
 	}
-	urls.RawQuery = params.Encode()
-	req, _ := http.NewRequest("GET", urls.String(), nil)
-	res, err := c.s.client.Do(req)
+	return ret, nil
+}
+
+// Playlist is a SoundCloud playlist or album.
+type Playlist struct {
+	ID         int64    `json:"id"`
+	Title      string   `json:"title"`
+	TrackCount int64    `json:"track_count"`
+	Tracks     []*Track `json:"tracks"`
+}
+
+// Playlists lists the user's playlists with their tracks.
+func (s *Service) Playlists() *PlaylistsCall {
+	c := &PlaylistsCall{s: s}
+	return c
+}
+
+type PlaylistsCall struct {
+	s *Service
+}
+
+type playlistPage struct {
+	Collection []*Playlist `json:"collection"`
+	NextHref   string      `json:"next_href"`
+}
+
+func (p *playlistPage) next() string { return p.NextHref }
+
+func (c *PlaylistsCall) Do() ([]*Playlist, error) {
+	var ret []*Playlist
+	var page playlistPage
+	err := c.s.getPages("me/playlists", url.Values{"show_tracks": {"true"}}, &page, func() {
+		ret = append(ret, page.Collection...)
+		page = playlistPage{}
+	})
 	if err != nil {
 		return nil, err
 	}
-	defer res.Body.Close()
-	if err := googleapi.CheckResponse(res); err != nil {
-		return nil, err
-	}
-	var ret []*Favorite
-	if err := json.NewDecoder(res.Body).Decode(&ret); err != nil {
+	return ret, nil
+}
+
+// Streams are the stream URLs of a track.
+type Streams struct {
+	HTTPMP3128URL    string `json:"http_mp3_128_url"`
+	HLSMP3128URL     string `json:"hls_mp3_128_url"`
+	HLSAAC160URL     string `json:"hls_aac_160_url"`
+	PreviewMP3128URL string `json:"preview_mp3_128_url"`
+}
+
+// Streams returns the stream URLs of the track with id.
+func (s *Service) Streams(id int64) *StreamsCall {
+	c := &StreamsCall{s: s, id: id}
+	return c
+}
+
+type StreamsCall struct {
+	s  *Service
+	id int64
+}
+
+func (c *StreamsCall) Do() (*Streams, error) {
+	var ret *Streams
+	if err := c.s.get(fmt.Sprintf("tracks/%d/streams", c.id), nil, &ret); err != nil {
 		return nil, err
 	}
 	return ret, nil
 }
 
-type Favorite struct {
+// Track is a SoundCloud track.
+type Track struct {
 	ArtworkURL          string      `json:"artwork_url"`
 	AttachmentsUri      string      `json:"attachments_uri"`
 	Bpm                 interface{} `json:"bpm"`
@@ -181,6 +264,7 @@ type Favorite struct {
 	Title               string      `json:"title"`
 	TrackType           string      `json:"track_type"`
 	Uri                 string      `json:"uri"`
+	Urn                 string      `json:"urn"`
 	User                struct {
 		AvatarURL    string `json:"avatar_url"`
 		ID           int64  `json:"id"`
@@ -3,6 +3,7 @@ package stream
 import (
 	"bufio"
 	"bytes"
+	"context"
 	"encoding/gob"
 	"fmt"
 	"io"
@@ -19,6 +20,7 @@ import (
 
 	"github.com/mjibson/moggio/codec"
 	"github.com/mjibson/moggio/codec/mpa"
+	"github.com/mjibson/moggio/codec/vorbis"
 	"github.com/mjibson/moggio/protocol"
 	"golang.org/x/oauth2"
 )
@@ -111,6 +113,10 @@ type Stream struct {
 	body           io.ReadCloser
 	title          string
 	songtitle      string
+
+	// Ogg is set if the stream is Ogg Vorbis, whose songs are chained
+	// logical streams, instead of MP3.
+	Ogg bool
 }
 
 type dialer struct {
@@ -163,11 +169,16 @@ var client = &http.Client{
 }
 
 func (s *Stream) get() (*http.Response, error) {
+	return s.getContext(context.Background())
+}
+
+func (s *Stream) getContext(ctx context.Context) (*http.Response, error) {
 	req, err := http.NewRequest("GET", s.URL, nil)
 	if err != nil {
 		panic(err)
 		log.Fatal(err)
 	}
+	req = req.WithContext(ctx)
 	req.Header.Add("Icy-MetaData", "1")
 	log.Println("stream open", req.URL)
 	resp, err := client.Do(req)
@@ -177,13 +188,28 @@ func (s *Stream) get() (*http.Response, error) {
 	if resp.StatusCode != 200 {
 		return nil, fmt.Errorf("stream status: %v", resp.Status)
 	}
-	s.metaint, err = strconv.Atoi(resp.Header.Get("Icy-Metaint"))
-	if err != nil {
-		return nil, err
+	s.Ogg = isOgg(resp.Header.Get("Content-Type"))
+	s.metaint = 0
+	// Ogg streams name their songs in their headers instead of ICY
+	// metadata.
+	if mi := resp.Header.Get("Icy-Metaint"); mi != "" || !s.Ogg {
+		s.metaint, err = strconv.Atoi(mi)
+		if err != nil {
+			resp.Body.Close()
+			return nil, err
+		}
 	}
 	return resp, nil
 }
 
+func isOgg(contentType string) bool {
+	switch strings.TrimSpace(strings.SplitN(contentType, ";", 2)[0]) {
+	case "application/ogg", "audio/ogg", "audio/vorbis":
+		return true
+	}
+	return false
+}
+
 func (s *Stream) info() *codec.SongInfo {
 	return &codec.SongInfo{
 		Title: s.Name,
@@ -217,8 +243,15 @@ func (s *Stream) Info(codec.ID) (*codec.SongInfo, error) {
 	return i, nil
 }
 
-func (s *Stream) GetSong(codec.ID) (codec.Song, error) {
-	return mpa.NewSong(s.reader())
+func (s *Stream) GetSong(id codec.ID) (codec.Song, error) {
+	return s.GetSongContext(context.Background(), id)
+}
+
+func (s *Stream) GetSongContext(ctx context.Context, _ codec.ID) (codec.Song, error) {
+	if s.Ogg {
+		return vorbis.NewSong(s.reader().WithContext(ctx))
+	}
+	return mpa.NewSong(s.reader().WithContext(ctx))
 }
 
 func (s *Stream) reader() codec.Reader {
@@ -234,6 +267,39 @@ func (s *Stream) reader() codec.Reader {
 	}
 }
 
+// Record opens a new connection to the stream, so it's recorded whether or
+// not it's playing.
+func (s *Stream) Record(ctx context.Context, _ codec.ID) (protocol.Recording, error) {
+	r := &Stream{
+		Orig: s.Orig,
+		URL:  s.URL,
+		Host: s.Host,
+		Name: s.Name,
+	}
+	resp, err := r.getContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+	r.body = resp.Body
+	return recording{r}, nil
+}
+
+// recording is a Stream being recorded.
+type recording struct {
+	*Stream
+}
+
+func (r recording) Title() string {
+	return r.songtitle
+}
+
+func (r recording) Format() string {
+	if r.Ogg {
+		return "ogg"
+	}
+	return "mp3"
+}
+
 var titleRE = regexp.MustCompile("StreamTitle='(.*?)';")
 
 func (s *Stream) Read(p []byte) (n int, err error) {
@@ -0,0 +1,144 @@
+package server
+
+import (
+	"path"
+	"regexp"
+	"sort"
+	"strconv"
+
+	"github.com/mjibson/moggio/codec"
+)
+
+// variousArtists is the album artist of compilations without one.
+const variousArtists = "Various Artists"
+
+// compilationArtists is the fewest artists of a compilation found by its
+// songs, which must also be by mostly different artists. Fewer are a split
+// album or collaboration, grouped by artist.
+const compilationArtists = 3
+
+// discFolderRE matches folders of the discs of an album, like "CD1" or
+// "Disc 2".
+var discFolderRE = regexp.MustCompile(`(?i)^(?:cd|disc|disk)\s*(\d+)$`)
+
+// albumFolder returns the folder of the album of id, the parent of a disc
+// folder, and the disc number of that folder, or 0.
+func albumFolder(id SongID) (string, int) {
+	dir := songFolder(id)
+	m := discFolderRE.FindStringSubmatch(path.Base(dir))
+	if m == nil {
+		return dir, 0
+	}
+	disc, _ := strconv.Atoi(m[1])
+	return path.Dir(dir), disc
+}
+
+// groupAlbums sets the album artist of the songs of items without one, so
+// albums are grouped by it: variousArtists for compilations, else the
+// song's artist. Compilations are flagged so by their tags, or are albums
+// in one folder, or its disc folders, whose songs are by mostly different
+// artists. Untagged discs are numbered by their folders. Infos changed are
+// copies.
+func groupAlbums(items []listItem) {
+	type album struct {
+		songs       []int
+		artists     map[string]bool
+		compilation bool
+	}
+	albums := make(map[string]*album)
+	for i, it := range items {
+		if it.Info == nil || it.Info.Album == "" {
+			continue
+		}
+		folder, disc := albumFolder(it.ID)
+		if disc > 0 && it.Info.Disc == 0 {
+			info := *it.Info
+			info.Disc = disc
+			items[i].Info = &info
+			it = items[i]
+		}
+		if it.Info.AlbumArtist != "" {
+			continue
+		}
+		key := string(codec.NewID(it.ID.Protocol(), it.ID.Key(), folder, normalize(it.Info.Album)))
+		a := albums[key]
+		if a == nil {
+			a = &album{artists: make(map[string]bool)}
+			albums[key] = a
+		}
+		a.songs = append(a.songs, i)
+		a.artists[normalize(it.Info.Artist)] = true
+		if it.Info.Compilation {
+			a.compilation = true
+		}
+	}
+	for _, a := range albums {
+		n := len(a.artists)
+		various := a.compilation || n >= compilationArtists && n*2 > len(a.songs)
+		for _, i := range a.songs {
+			info := *items[i].Info
+			if various {
+				info.AlbumArtist = variousArtists
+				info.Compilation = true
+			} else if info.Artist != "" {
+				info.AlbumArtist = info.Artist
+			} else {
+				continue
+			}
+			items[i].Info = &info
+		}
+	}
+}
+
+// albumKey returns the key of the album of it, grouped by groupAlbums, or ""
+// if it has none.
+func albumKey(it listItem) string {
+	if it.Info == nil || it.Info.Album == "" {
+		return ""
+	}
+	return string(codec.NewID(it.ID.Protocol(), it.ID.Key(), normalize(it.Info.AlbumArtist), normalize(it.Info.Album)))
+}
+
+// instanceItems returns the songs of the instance of id, with their albums
+// grouped. It should only be called by the commands() function.
+func (srv *Server) instanceItems(id SongID) ([]listItem, error) {
+	if _, err := srv.getSong(id); err != nil {
+		return nil, err
+	}
+	inst, err := srv.getInstance(id.Protocol(), id.Key())
+	if err != nil {
+		return nil, err
+	}
+	list, err := inst.List()
+	if err != nil {
+		return nil, err
+	}
+	top := codec.NewID(id.Protocol(), id.Key())
+	items := make([]listItem, 0, len(list))
+	for cid, info := range list {
+		if info == nil {
+			continue
+		}
+		sid := SongID(top.Push(string(cid)))
+		items = append(items, listItem{
+			ID:   sid,
+			Info: srv.songInfo(sid, info),
+		})
+	}
+	groupAlbums(items)
+	return items, nil
+}
+
+// sortTracks sorts the songs of an album in disc and track order.
+func sortTracks(songs []listItem) {
+	sort.Slice(songs, func(i, j int) bool {
+		a, b := songs[i].Info, songs[j].Info
+		if a.Disc != b.Disc {
+			return a.Disc < b.Disc
+		}
+		if a.Track != b.Track {
+			return a.Track < b.Track
+		}
+		return songs[i].ID < songs[j].ID
+	})
+}
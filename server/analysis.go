@@ -0,0 +1,247 @@
+package server
+
+import (
+	"bytes"
+	"context"
+	"encoding/gob"
+	"errors"
+	"io"
+	"log"
+	"sync"
+	"time"
+
+	"github.com/boltdb/bolt"
+	"github.com/mjibson/moggio/codec"
+	"github.com/mjibson/moggio/dsp"
+)
+
+const dbAnalysis = "analysis"
+
+// localProtocols are the protocols whose songs are on the local machine.
+// Only their songs are analyzed when scanned, since analysis must fetch the
+// entire song; others are analyzed when first played.
+var localProtocols = map[string]bool{
+	"beets": true,
+	"file":  true,
+}
+
+// maxAnalysis is the longest part of a song analyzed, in case it is a
+// stream that doesn't end.
+const maxAnalysis = 3 * time.Hour
+
+// errUnknownLength is the error of songs not analyzed since they may be
+// streams that don't end.
+var errUnknownLength = errors.New("song of unknown length not analyzed")
+
+// Analysis holds the properties of a song found by decoding it.
+type Analysis struct {
+	BPM float64
+	Key string
+}
+
+// analyses is the queue of songs to analyze. Songs are analyzed one at a
+// time, since each decodes the entire song.
+type analyses struct {
+	sync.Mutex
+	pending map[SongID]bool
+	queue   []SongID
+	wake    chan struct{}
+}
+
+// add queues id for analysis, at the front if first is set.
+func (a *analyses) add(id SongID, first bool) {
+	a.Lock()
+	defer a.Unlock()
+	if a.pending == nil {
+		a.pending = make(map[SongID]bool)
+	}
+	if a.pending[id] {
+		if !first {
+			return
+		}
+		// Move it to the front if it's not already being analyzed.
+		i := 0
+		for i < len(a.queue) && a.queue[i] != id {
+			i++
+		}
+		if i == len(a.queue) {
+			return
+		}
+		a.queue = append(a.queue[:i], a.queue[i+1:]...)
+	}
+	a.pending[id] = true
+	if first {
+		a.queue = append([]SongID{id}, a.queue...)
+	} else {
+		a.queue = append(a.queue, id)
+	}
+	select {
+	case a.wake <- struct{}{}:
+	default:
+	}
+}
+
+func (a *analyses) next() (SongID, bool) {
+	a.Lock()
+	defer a.Unlock()
+	if len(a.queue) == 0 {
+		return "", false
+	}
+	id := a.queue[0]
+	a.queue = a.queue[1:]
+	return id, true
+}
+
+func (a *analyses) done(id SongID) {
+	a.Lock()
+	delete(a.pending, id)
+	a.Unlock()
+}
+
+// analyzeSongs analyzes queued songs.
+func (srv *Server) analyzeSongs() {
+	for range srv.analyses.wake {
+		for {
+			id, ok := srv.analyses.next()
+			if !ok {
+				break
+			}
+			if err := srv.analyzeSong(id); err != nil {
+				log.Printf("analyze %v: %v", id, err)
+			}
+			srv.analyses.done(id)
+		}
+	}
+}
+
+func (srv *Server) analyzeSong(id SongID) error {
+	ch := make(chan songResult)
+	srv.ch <- cmdGetSong{
+		id:   id,
+		done: ch,
+	}
+	r := <-ch
+	if r.err != nil {
+		return r.err
+	}
+	song := r.song
+	defer song.Close()
+	sr, channels, err := song.Init()
+	if err != nil {
+		return err
+	}
+	peaks := dsp.NewPeaks(sr, channels)
+	tempo := dsp.NewTempoDetector(sr, channels)
+	key := dsp.NewKeyDetector(sr, channels)
+	n := sr * channels
+	for read := 0; read < int(maxAnalysis.Seconds())*n; {
+		samples, err := song.Play(n)
+		if err != nil && err != io.EOF {
+			return err
+		}
+		read += len(samples)
+		peaks.Write(samples)
+		tempo.Write(samples)
+		key.Write(samples)
+		// Some decoders return io.EOF with their last samples.
+		if err == io.EOF || len(samples) < n {
+			break
+		}
+	}
+	a := Analysis{
+		BPM: tempo.BPM(),
+		Key: key.Key(),
+	}
+	srv.saveWaveform(id, peaks.Peaks())
+	if err := srv.saveAnalysis(id, a); err != nil {
+		return err
+	}
+	srv.ch <- cmdAnalyzed{
+		id:       id,
+		analysis: a,
+	}
+	return nil
+}
+
+func (srv *Server) saveAnalysis(id SongID, a Analysis) error {
+	var buf bytes.Buffer
+	if err := gob.NewEncoder(&buf).Encode(a); err != nil {
+		return err
+	}
+	return srv.update(func(tx *bolt.Tx) error {
+		b, err := tx.CreateBucketIfNotExists([]byte(dbAnalysis))
+		if err != nil {
+			return err
+		}
+		return b.Put([]byte(id), buf.Bytes())
+	})
+}
+
+func (srv *Server) loadAnalyses() (map[SongID]Analysis, error) {
+	m := make(map[SongID]Analysis)
+	err := srv.view(func(tx *bolt.Tx) error {
+		b := tx.Bucket([]byte(dbAnalysis))
+		if b == nil {
+			return nil
+		}
+		return b.ForEach(func(k, v []byte) error {
+			var a Analysis
+			if err := gob.NewDecoder(bytes.NewReader(v)).Decode(&a); err != nil {
+				return err
+			}
+			m[SongID(k)] = a
+			return nil
+		})
+	})
+	return m, err
+}
+
+// songInfo returns info with the analysis, measured duration, and scanned
+// loudness of id added, the tag rules applied, and its work parsed from its
+// title. It should only be called by the commands() function.
+func (srv *Server) songInfo(id SongID, info *codec.SongInfo) *codec.SongInfo {
+	if info == nil {
+		return nil
+	}
+	a, ok := srv.analysis[id]
+	d := srv.durations[id]
+	l, scanned := srv.loudness[id]
+	if !ok && !scanned && (info.Time > 0 || d == 0) {
+		return parseWork(srv.tagRules.apply(info))
+	}
+	i := *info
+	if ok {
+		i.BPM = a.BPM
+		i.Key = a.Key
+	}
+	if scanned {
+		rg := l.replayGain()
+		i.TrackGain = rg.TrackGain
+		if rg.Album {
+			i.AlbumGain = rg.AlbumGain
+		}
+	}
+	if i.Time == 0 {
+		i.Time = d
+	}
+	srv.tagRules.applyTo(&i)
+	parseWorkTo(&i)
+	return &i
+}
+
+type songResult struct {
+	song codec.Song
+	err  error
+}
+
+type cmdGetSong struct {
+	id SongID
+	// ctx cancels reads of the song. If nil, they are canceled at shutdown.
+	ctx  context.Context
+	done chan songResult
+}
+
+type cmdAnalyzed struct {
+	id       SongID
+	analysis Analysis
+}
@@ -0,0 +1,288 @@
+package server
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"log"
+	"net/http"
+	"net/url"
+	"os/exec"
+	"strings"
+	"time"
+
+	"github.com/julienschmidt/httprouter"
+	"github.com/mjibson/moggio/codec"
+	"github.com/mjibson/moggio/dsp"
+)
+
+const (
+	// announceTimeout bounds how long the extra command and speech
+	// synthesis may run.
+	announceTimeout = time.Second * 30
+	// announceMax bounds the length of announcements.
+	announceMax = time.Second * 30
+	// defaultAnnounceTemplate is the text of announcements if no template
+	// is set.
+	defaultAnnounceTemplate = "Now playing {title} by {artist}."
+	// defaultAnnounceDuck is the gain in dB of songs under announcements if
+	// none is set.
+	defaultAnnounceDuck = -12
+)
+
+// Announcer speaks a short announcement, like "Now playing X by Y", over
+// the start of songs, like a radio DJ. Speech is synthesized while the
+// previous song plays, by a local text-to-speech engine or an HTTP service,
+// either of which produces audio in any format moggio plays.
+type Announcer struct {
+	Enabled bool
+	// Template is the text spoken, with {title}, {artist}, {album}, {time},
+	// and {extra} replaced.
+	Template string `json:",omitempty"`
+	// Command runs a local engine writing the speech to its standard
+	// output, with {text} replaced in its arguments, like ["espeak-ng",
+	// "--stdout", "{text}"], or else given on its standard input. An
+	// argument starting with {text} follows a "--", so titles aren't taken
+	// as options.
+	Command []string `json:",omitempty"`
+	// URL is the speech service: a GET of it with {text} replaced, or else
+	// a POST of the text to it.
+	URL string `json:",omitempty"`
+	// Extra is a plug-in command whose output, like the weather, is the
+	// {extra} of the template.
+	Extra []string `json:",omitempty"`
+	// Duck is the gain in dB of songs under the announcement, or 0 for
+	// defaultAnnounceDuck.
+	Duck float64 `json:",omitempty"`
+}
+
+// Validate checks that the announcer is usable.
+func (a Announcer) Validate() error {
+	if !a.Enabled {
+		return nil
+	}
+	if (len(a.Command) == 0) == (a.URL == "") {
+		return fmt.Errorf("announcer needs one of a command or URL")
+	}
+	if a.URL != "" {
+		u, err := url.Parse(a.URL)
+		if err != nil {
+			return err
+		}
+		if u.Scheme != "http" && u.Scheme != "https" {
+			return fmt.Errorf("announcer URL must be http or https: %s", a.URL)
+		}
+	}
+	if a.Duck > 0 {
+		return fmt.Errorf("duck must not be positive")
+	}
+	return nil
+}
+
+// text returns the announcement of info, with the output of the extra
+// command.
+func (a Announcer) text(ctx context.Context, info *codec.SongInfo) string {
+	t := a.Template
+	if t == "" {
+		t = defaultAnnounceTemplate
+	}
+	var extra string
+	if len(a.Extra) > 0 && strings.Contains(t, "{extra}") {
+		out, err := exec.CommandContext(ctx, a.Extra[0], a.Extra[1:]...).Output()
+		if err != nil {
+			log.Printf("announce extra: %v", err)
+		}
+		extra = strings.TrimSpace(string(out))
+		if len(extra) > 500 {
+			extra = extra[:500]
+		}
+	}
+	return strings.NewReplacer(
+		"{title}", info.Title,
+		"{artist}", info.Artist,
+		"{album}", info.Album,
+		"{time}", time.Now().Format("3:04 PM"),
+		"{extra}", extra,
+	).Replace(t)
+}
+
+// speak returns the speech of text.
+func (a Announcer) speak(ctx context.Context, text string) ([]byte, error) {
+	if len(a.Command) > 0 {
+		var args []string
+		stdin, dashes := true, false
+		for _, arg := range a.Command[1:] {
+			if strings.Contains(arg, "{text}") {
+				stdin = false
+				if strings.HasPrefix(arg, "{text}") && !dashes {
+					args = append(args, "--")
+					dashes = true
+				}
+			}
+			if arg == "--" {
+				dashes = true
+			}
+			args = append(args, strings.Replace(arg, "{text}", text, -1))
+		}
+		var stderr bytes.Buffer
+		cmd := exec.CommandContext(ctx, a.Command[0], args...)
+		if stdin {
+			cmd.Stdin = strings.NewReader(text)
+		}
+		cmd.Stderr = &stderr
+		b, err := cmd.Output()
+		if err != nil {
+			return nil, fmt.Errorf("%v: %s", err, bytes.TrimSpace(stderr.Bytes()))
+		}
+		return b, nil
+	}
+	var req *http.Request
+	var err error
+	if strings.Contains(a.URL, "{text}") {
+		req, err = http.NewRequest("GET", strings.Replace(a.URL, "{text}", url.QueryEscape(text), -1), nil)
+	} else {
+		req, err = http.NewRequest("POST", a.URL, strings.NewReader(text))
+		if req != nil {
+			req.Header.Set("Content-Type", "text/plain; charset=utf-8")
+		}
+	}
+	if err != nil {
+		return nil, err
+	}
+	resp, err := http.DefaultClient.Do(req.WithContext(ctx))
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("speech service: %s", resp.Status)
+	}
+	return ioutil.ReadAll(io.LimitReader(resp.Body, 32<<20))
+}
+
+// announcement is the decoded speech announcing a song.
+type announcement struct {
+	id      SongID
+	samples []float32
+	sr, ch  int
+}
+
+// decodeSpeech decodes the audio of b, at most announceMax of it.
+func decodeSpeech(b []byte) (*announcement, error) {
+	songs, _, err := codec.Decode(func() (io.ReadCloser, int64, error) {
+		return ioutil.NopCloser(bytes.NewReader(b)), int64(len(b)), nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	for _, song := range songs {
+		defer song.Close()
+	}
+	var song codec.Song
+	for _, s := range songs {
+		song = s
+		break
+	}
+	if song == nil {
+		return nil, fmt.Errorf("no speech")
+	}
+	sr, ch, err := song.Init()
+	if err != nil {
+		return nil, err
+	}
+	a := &announcement{sr: sr, ch: ch}
+	max := int(announceMax/time.Second) * sr * ch
+	for len(a.samples) < max {
+		s, err := song.Play(sr * ch)
+		a.samples = append(a.samples, s...)
+		if err != nil || len(s) < sr*ch {
+			break
+		}
+	}
+	if len(a.samples) > max {
+		a.samples = a.samples[:max]
+	}
+	return a, nil
+}
+
+// announce synthesizes the announcement of id, the next song, and sends it
+// to be played over its start.
+func (srv *Server) announce(a Announcer, id SongID, info codec.SongInfo) {
+	ctx, cancel := context.WithTimeout(srv.ctx, announceTimeout)
+	defer cancel()
+	text := a.text(ctx, &info)
+	b, err := a.speak(ctx, text)
+	if err != nil {
+		log.Printf("announce %v: %v", id, err)
+		return
+	}
+	an, err := decodeSpeech(b)
+	if err != nil {
+		log.Printf("announce %v: %v", id, err)
+		return
+	}
+	an.id = id
+	srv.ch <- cmdAnnounced{an}
+}
+
+// mix returns play with the announcement, converted to sr and ch, mixed
+// over its start, ducked by duck dB.
+func (an *announcement) mix(play func(int) ([]float32, error), sr, ch int, duck float64) func(int) ([]float32, error) {
+	speech := remix(an.samples, an.ch, ch)
+	if an.sr != sr {
+		r := dsp.NewResampler(float64(an.sr), float64(sr), ch, dsp.QualityMedium)
+		// Silence flushes the resampler's delay.
+		tail := make([]float32, an.sr/10*ch)
+		speech = append(r.Process(speech), r.Process(tail)...)
+	}
+	if duck == 0 {
+		duck = defaultAnnounceDuck
+	}
+	gain := float32(dsp.DB(duck))
+	pos := 0
+	return func(n int) ([]float32, error) {
+		s, err := play(n)
+		for i := range s {
+			if pos >= len(speech) {
+				break
+			}
+			s[i] = s[i]*gain + speech[pos]
+			pos++
+		}
+		return s, err
+	}
+}
+
+// GetAnnouncer returns the settings of announcements.
+func (srv *Server) GetAnnouncer(body io.Reader, form url.Values, ps httprouter.Params) (interface{}, error) {
+	ch := make(chan Announcer)
+	srv.ch <- cmdGetAnnouncer(ch)
+	return <-ch, nil
+}
+
+// SetAnnouncer sets the settings of announcements. It is served by
+// localSettings, since the announcer runs commands.
+func (srv *Server) SetAnnouncer(body io.Reader, form url.Values, ps httprouter.Params) (interface{}, error) {
+	var a Announcer
+	if err := json.NewDecoder(body).Decode(&a); err != nil {
+		return nil, err
+	}
+	if err := a.Validate(); err != nil {
+		return nil, err
+	}
+	srv.audit(ps, "announcer", fmt.Sprintf("enabled %v", a.Enabled))
+	srv.ch <- cmdSetAnnouncer(a)
+	return nil, nil
+}
+
+type cmdGetAnnouncer chan Announcer
+
+type cmdSetAnnouncer Announcer
+
+// cmdAnnounced is the announcement of the next song.
+type cmdAnnounced struct {
+	*announcement
+}
@@ -6,15 +6,36 @@ import (
 	"log"
 	"time"
 
+	"github.com/mjibson/moggio/dsp"
 	"github.com/mjibson/moggio/output"
 )
 
+// tailWait is how long the held tail of a song waits for the next song to
+// open to be mixed with, after which it is played alone.
+const tailWait = time.Second
+
 func (srv *Server) audio() {
 	var out output.Output
 	var t chan interface{}
 	var seek *Seek
+	var pf *prefetch
+	// wait is set while waiting for the pre-roll, during which ticks stop.
+	var wait <-chan time.Time
 	var dur time.Duration
 	var err error
+	var conf dspConfig
+	var chain dsp.Chain
+	var sr, ch, bits int
+	// songDur is the duration of the current song. The last conf.crossfade of
+	// it is held in tail to be mixed with the start of the next song by xfade.
+	var songDur time.Duration
+	var tail []float32
+	// flush fires when the next song has taken too long to open to mix the
+	// tail into, which is then played alone.
+	var flush <-chan time.Time
+	var xfade *dsp.Crossfade
+	// loop is the section of the song repeated, if not nil.
+	var loop *Loop
 	send := func(v interface{}) {
 		go func() {
 			srv.ch <- v
@@ -26,23 +47,111 @@ func (srv *Server) audio() {
 			force:    force,
 		})
 	}
+	push := func(samples []float32) {
+		if xfade != nil {
+			samples = xfade.Process(samples)
+			if xfade.Done() {
+				xfade = nil
+			}
+		}
+		// Levels are of the audio output, shown as it's heard.
+		var delay time.Duration
+		if d, ok := out.(output.Delayer); ok {
+			delay = d.Delay()
+		}
+		srv.vis.write(samples, delay)
+		out.Push(samples)
+	}
+	// flushTail plays the held tail of the previous song alone, since no
+	// song follows it soon.
+	flushTail := func() {
+		flush = nil
+		if len(tail) > 0 && out != nil {
+			push(tail)
+			out.Idle()
+		}
+		tail = nil
+	}
+	// endSong handles the held tail of the previous song when the next song
+	// with params c starts.
+	endSong := func(c audioSetParams) {
+		flush = nil
+		defer func() {
+			tail = nil
+		}()
+		if len(tail) == 0 {
+			return
+		}
+		if c.dsp.format(c.sr, c.ch, c.bits) == conf.format(sr, ch, bits) && !flows(tail, sr, conf.channels(ch)) {
+			xfade = dsp.NewCrossfade(tail, conf.channels(ch))
+			return
+		}
+		push(tail)
+	}
 	tick := func() {
 		const expected = 4096
-		if seek == nil {
+		if seek == nil || out == nil {
+			return
+		}
+		if !pf.ready() {
+			// Wait for the pre-roll without blocking commands.
+			t = nil
+			wait = time.After(prerollWait)
 			return
 		}
-		next, err := seek.Read(expected)
+		n := expected
+		if loop != nil {
+			pos := seek.Pos()
+			if pos >= loop.B {
+				if err := seek.Seek(loop.A); err != nil {
+					send(cmdError(err))
+					loop = nil
+				}
+				// Discard filter state from B, as doSeek does.
+				chain = conf.chain(sr, ch)
+				tail = nil
+				pos = seek.Pos()
+			}
+			// Stop reading at B, at a frame boundary.
+			if loop != nil && pos < loop.B {
+				if left := int((loop.B - pos) / dur); left < n {
+					n = left - left%ch
+					if n < ch {
+						n = ch
+					}
+				}
+			}
+		}
+		next, err := seek.Read(n)
 		if len(next) > 0 {
-			out.Push(next)
+			// Copy since seek retains its buffer and the DSP modifies in place.
+			buf := make([]float32, len(next))
+			copy(buf, next)
+			buf = chain.Process(buf)
+			srv.levels.write(buf, chain)
+			// A looped section is never followed by the next song, so its
+			// end isn't held.
+			if xf := conf.xfade(); xf > 0 && loop == nil && songDur > xf*2 && seek.Pos() >= songDur-xf {
+				tail = append(tail, buf...)
+			} else if len(buf) > 0 {
+				push(buf)
+			}
 			setTime(false)
 		}
 		if err != nil {
 			seek = nil
+			pf.close()
+			if len(tail) > 0 {
+				flush = time.After(tailWait)
+			}
+			out.Idle()
 		}
 		if err == io.ErrUnexpectedEOF {
 			send(cmdRestartSong)
-		} else if err != nil {
+		} else if err == io.EOF {
 			send(cmdNext)
+		} else if err != nil {
+			send(cmdDecodeError{err})
 		}
 	}
 	doSeek := func(c cmdSeek) {
@@ -54,16 +163,48 @@ func (srv *Server) audio() {
 			send(cmdError(err))
 			return
 		}
+		// Discard filter state and held audio from the old position.
+		chain = conf.chain(sr, ch)
+		tail = nil
+		flush = nil
 		setTime(true)
 	}
 	setParams := func(c audioSetParams) {
-		out, err = output.Get(c.sr, c.ch)
+		xfade = nil
+		f := c.dsp.format(c.sr, c.ch, c.bits)
+		if out != nil {
+			endSong(c)
+			// Release the device, which exclusive outputs otherwise hold
+			// against the new format.
+			if f != conf.format(sr, ch, bits) {
+				output.Close(out)
+			}
+		}
+		out, err = output.Get(f)
 		if err != nil {
-			c.err <- fmt.Errorf("moggio: could not open audio (%v, %v): %v", c.sr, c.ch, err)
+			c.err <- fmt.Errorf("moggio: could not open audio (%+v): %v", f, err)
 			return
 		}
+		sr, ch, bits = c.sr, c.ch, c.bits
+		conf = c.dsp
+		srv.vis.reset(conf.rate(sr), conf.channels(ch))
+		srv.levels.start(c.levels, conf.channels(ch))
+		chain = conf.chain(sr, ch)
+		songDur = c.dur
+		loop = nil
 		dur = time.Second / (time.Duration(c.sr * c.ch))
-		seek = NewSeek(c.dur > 0, dur, c.play)
+		if pf != nil {
+			pf.close()
+		}
+		pf = newPrefetch(c.play, conf.prerollSamples(c.sr, c.ch), dur, &srv.buffered)
+		seek = NewSeek(c.dur > 0, dur, pf.Play)
+		if c.seek != nil {
+			pf := pf
+			seek.SeekSong(c.ch, func(d time.Duration) (time.Duration, error) {
+				return pf.seek(c.seek, d)
+			})
+		}
+		wait = nil
 		t = make(chan interface{})
 		close(t)
 		c.err <- nil
@@ -72,11 +213,27 @@ func (srv *Server) audio() {
 		select {
 		case <-t:
 			tick()
+		case <-wait:
+			wait = nil
+			t = make(chan interface{})
+			close(t)
+		case <-flush:
+			flushTail()
 		case c := <-srv.audioch:
 			log.Printf("%T\n", c)
 			switch c := c.(type) {
 			case audioStop:
 				t = nil
+				wait = nil
+				if out != nil {
+					out.Idle()
+				}
+			case audioEnd:
+				// A song stopped partway doesn't play its tail.
+				if seek != nil {
+					tail = nil
+				}
+				flushTail()
 			case audioPlay:
 				t = make(chan interface{})
 				close(t)
@@ -84,6 +241,40 @@ func (srv *Server) audio() {
 				setParams(c)
 			case cmdSeek:
 				doSeek(c)
+			case audioLoop:
+				loop = c.loop
+				// Play the end held before the loop was set.
+				if loop != nil && len(tail) > 0 && out != nil {
+					push(tail)
+					tail = nil
+				}
+			case audioReopen:
+				if out == nil {
+					break
+				}
+				output.Close(out)
+				f := conf.format(sr, ch, bits)
+				out, err = output.Get(f)
+				if err != nil {
+					send(cmdError(fmt.Errorf("moggio: could not open audio (%+v): %v", f, err)))
+				}
+			case audioDSP:
+				if f := dspConfig(c).format(sr, ch, bits); out != nil && f != conf.format(sr, ch, bits) {
+					output.Close(out)
+					out, err = output.Get(f)
+					if err != nil {
+						send(cmdError(fmt.Errorf("moggio: could not open audio (%+v): %v", f, err)))
+					}
+				}
+				prev := conf
+				conf = dspConfig(c)
+				// A change of volume alone keeps the chain's state.
+				if f := fader(chain); f != nil && conf.sameChain(prev) {
+					f.Set(conf.gain)
+					break
+				}
+				chain = conf.chain(sr, ch)
+				srv.vis.reset(conf.rate(sr), conf.channels(ch))
 			default:
 				panic("unknown type")
 			}
@@ -91,14 +282,44 @@ func (srv *Server) audio() {
 	}
 }
 
+// flows reports whether the end of tail is loud enough that the song
+// probably continues into the next one without a gap, as in live albums.
+func flows(tail []float32, sampleRate, channels int) bool {
+	const gapLevel = -30 // dBFS
+	n := sampleRate * channels / 2
+	if n > len(tail) {
+		n = len(tail)
+	}
+	return dsp.Level(tail[len(tail)-n:]) > gapLevel
+}
+
 type audioSetParams struct {
 	sr   int
 	ch   int
+	bits int
 	dur  time.Duration
 	play func(int) ([]float32, error)
-	err  chan error
+	// seek seeks the song, if it can.
+	seek func(time.Duration) (time.Duration, error)
+	dsp  dspConfig
+	// levels starts the report of the song's levels.
+	levels LevelReport
+	err    chan error
 }
 
 type audioStop struct{}
 
 type audioPlay struct{}
+
+// audioEnd marks the end of playback, after which no song follows the
+// current one.
+type audioEnd struct{}
+
+// audioLoop sets the section of the current song repeated, or none if nil.
+type audioLoop struct {
+	loop *Loop
+}
+
+// audioReopen reopens the output, which may have fallen back to the default
+// device while its own was gone.
+type audioReopen struct{}
@@ -0,0 +1,155 @@
+package server
+
+import (
+	"encoding/binary"
+	"encoding/json"
+	"fmt"
+	"io"
+	"log"
+	"net/http"
+	"net/url"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/boltdb/bolt"
+	"github.com/julienschmidt/httprouter"
+)
+
+const dbAudit = "audit"
+
+const (
+	// auditSize is the number of audit log entries kept.
+	auditSize = 10000
+	// defaultAuditEntries is the number of entries returned if not
+	// specified.
+	defaultAuditEntries = 100
+	// maxAuditDetail is the length detail is truncated to.
+	maxAuditDetail = 200
+)
+
+// AuditEntry records a control action: who did what, and when.
+type AuditEntry struct {
+	Time time.Time
+	// Who is the user's name, or owner or guest.
+	Who    string
+	Addr   string
+	Action string
+	Detail string `json:",omitempty"`
+}
+
+// audit records action by the maker of the JSON handler request with ps.
+func (srv *Server) audit(ps httprouter.Params, action, detail string) {
+	srv.addAudit(AuditEntry{
+		Who:    ps.ByName(paramWho),
+		Addr:   ps.ByName(paramAddr),
+		Action: action,
+		Detail: detail,
+	})
+}
+
+// auditRequest records action by the maker of r.
+func (srv *Server) auditRequest(r *http.Request, action, detail string) {
+	srv.addAudit(AuditEntry{
+		Who:    requestActor(r).who(),
+		Addr:   remoteIP(r),
+		Action: action,
+		Detail: detail,
+	})
+}
+
+func (srv *Server) addAudit(e AuditEntry) {
+	e.Time = time.Now().UTC()
+	if len(e.Detail) > maxAuditDetail {
+		e.Detail = e.Detail[:maxAuditDetail] + "..."
+	}
+	b, err := json.Marshal(e)
+	if err != nil {
+		log.Println(err)
+		return
+	}
+	err = srv.update(func(tx *bolt.Tx) error {
+		bk, err := tx.CreateBucketIfNotExists([]byte(dbAudit))
+		if err != nil {
+			return err
+		}
+		seq, err := bk.NextSequence()
+		if err != nil {
+			return err
+		}
+		key := make([]byte, 8)
+		binary.BigEndian.PutUint64(key, seq)
+		if err := bk.Put(key, b); err != nil {
+			return err
+		}
+		// Keys are sequential, so the first is the oldest.
+		c := bk.Cursor()
+		for k, _ := c.First(); k != nil && binary.BigEndian.Uint64(k)+auditSize <= seq; k, _ = c.First() {
+			if err := c.Delete(); err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+	if err != nil {
+		log.Println("audit:", err)
+	}
+}
+
+// Audit returns the most recent audit log entries, newest first. The n
+// parameter sets the number of entries, and the who and action parameters
+// filter them.
+func (srv *Server) Audit(body io.Reader, form url.Values, ps httprouter.Params) (interface{}, error) {
+	n := defaultAuditEntries
+	if s := form.Get("n"); s != "" {
+		var err error
+		n, err = strconv.Atoi(s)
+		if err != nil || n < 1 {
+			return nil, fmt.Errorf("bad n: %v", s)
+		}
+	}
+	who, action := form.Get("who"), form.Get("action")
+	entries := []AuditEntry{}
+	err := srv.view(func(tx *bolt.Tx) error {
+		bk := tx.Bucket([]byte(dbAudit))
+		if bk == nil {
+			return nil
+		}
+		c := bk.Cursor()
+		for k, v := c.Last(); k != nil && len(entries) < n; k, v = c.Prev() {
+			var e AuditEntry
+			if err := json.Unmarshal(v, &e); err != nil {
+				return err
+			}
+			if who != "" && e.Who != who {
+				continue
+			}
+			if action != "" && !strings.HasPrefix(e.Action, action) {
+				continue
+			}
+			entries = append(entries, e)
+		}
+		return nil
+	})
+	return entries, err
+}
+
+// describeChange returns a summary of the operations of plc.
+func describeChange(plc PlaylistChange) string {
+	ops := make([]string, len(plc))
+	for i, op := range plc {
+		ops[i] = strings.Join(op, " ")
+	}
+	return strings.Join(ops, ", ")
+}
+
+// auditForm returns form, without any auth token, for the audit log.
+func auditForm(form url.Values) string {
+	v := make(url.Values)
+	for k, vs := range form {
+		if k != "auth" {
+			v[k] = vs
+		}
+	}
+	return v.Encode()
+}
@@ -0,0 +1,277 @@
+package server
+
+import (
+	"archive/zip"
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"log"
+	"net/http"
+	"net/url"
+	"os"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/julienschmidt/httprouter"
+	"github.com/mjibson/moggio/codec"
+	"github.com/mjibson/moggio/protocol"
+)
+
+// maxBackupSize is the largest backup archive that can be restored.
+const maxBackupSize = 1 << 28
+
+// secretFields are the Server fields excluded from backups without secrets.
+var secretFields = map[string]bool{
+	"Token":    true,
+	"Webhooks": true,
+}
+
+// backupManifest describes a backup archive.
+type backupManifest struct {
+	Version       int
+	MoggioVersion string
+	Created       time.Time
+	// Secrets is whether credentials are included. Without them, protocol
+	// instances that need credentials are excluded and listed in Excluded.
+	Secrets   bool
+	Excluded  []ProtocolData `json:",omitempty"`
+	Protocols map[string]ProtocolData
+}
+
+type backup struct {
+	state     stateSnapshot
+	playlists map[string]PlaylistInfo
+	excluded  []ProtocolData
+}
+
+// hasSecrets reports whether instances of the named protocol hold
+// credentials.
+func hasSecrets(name string) bool {
+	p, err := protocol.ByName(name)
+	if err != nil {
+		return true
+	}
+	if p.OAuthURL != "" {
+		return true
+	}
+	for _, param := range p.Params.Params {
+		if strings.Contains(param, "password") {
+			return true
+		}
+	}
+	return false
+}
+
+// backup collects the state to back up. It should only be called by the
+// commands() function.
+func (srv *Server) backup(secrets bool) backup {
+	b := backup{
+		state:     srv.snapshot(),
+		playlists: map[string]PlaylistInfo{"Queue": srv.playlistInfo(srv.Queue)},
+	}
+	for name, p := range srv.Playlists {
+		b.playlists["Playlist: "+name] = srv.playlistInfo(p)
+	}
+	if secrets {
+		return b
+	}
+	for name := range secretFields {
+		delete(b.state.fields, name)
+	}
+	for id := range b.state.protocols {
+		name, key := codec.ID(id).Pop()
+		if hasSecrets(name) {
+			delete(b.state.protocols, id)
+			b.excluded = append(b.excluded, ProtocolData{name, string(key)})
+		}
+	}
+	return b
+}
+
+// Backup serves a zip archive of the settings, playlists, and protocol
+// instances. Credentials are excluded unless the secrets parameter is set.
+// The archive also contains playlists.json, a readable export of the queue
+// and playlists.
+func (srv *Server) Backup(w http.ResponseWriter, r *http.Request, ps httprouter.Params) {
+	secrets, _ := strconv.ParseBool(r.FormValue("secrets"))
+	ch := make(chan backup)
+	srv.ch <- cmdBackup{
+		secrets: secrets,
+		done:    ch,
+	}
+	b := <-ch
+	if b.state.err != nil {
+		serveError(w, b.state.err)
+		return
+	}
+	var buf bytes.Buffer
+	if err := writeBackup(&buf, b, secrets); err != nil {
+		serveError(w, err)
+		return
+	}
+	name := fmt.Sprintf("moggio-backup-%s.zip", time.Now().Format("2006-01-02"))
+	w.Header().Set("Content-Type", "application/zip")
+	w.Header().Set("Content-Disposition", fmt.Sprintf("attachment; filename=%q", name))
+	w.Write(buf.Bytes())
+}
+
+func writeBackup(w io.Writer, b backup, secrets bool) error {
+	z := zip.NewWriter(w)
+	add := func(name string, data []byte) error {
+		f, err := z.Create(name)
+		if err != nil {
+			return err
+		}
+		_, err = f.Write(data)
+		return err
+	}
+	addJSON := func(name string, v interface{}) error {
+		data, err := json.MarshalIndent(v, "", "\t")
+		if err != nil {
+			return err
+		}
+		return add(name, data)
+	}
+	m := backupManifest{
+		Version:       stateVersion,
+		MoggioVersion: MoggioVersion,
+		Created:       time.Now().UTC(),
+		Secrets:       secrets,
+		Excluded:      b.excluded,
+		Protocols:     make(map[string]ProtocolData),
+	}
+	for name, data := range b.state.fields {
+		if err := add("state/"+name, data); err != nil {
+			return err
+		}
+	}
+	i := 0
+	for id, data := range b.state.protocols {
+		name, key := codec.ID(id).Pop()
+		entry := fmt.Sprintf("protocols/%d", i)
+		i++
+		m.Protocols[entry] = ProtocolData{name, string(key)}
+		if err := add(entry, data); err != nil {
+			return err
+		}
+	}
+	if err := addJSON("manifest.json", m); err != nil {
+		return err
+	}
+	if err := addJSON("playlists.json", b.playlists); err != nil {
+		return err
+	}
+	return z.Close()
+}
+
+// readBackup reads a backup archive.
+func readBackup(r io.Reader) (stateSnapshot, error) {
+	s := stateSnapshot{
+		fields:    make(map[string][]byte),
+		protocols: make(map[string][]byte),
+	}
+	data, err := ioutil.ReadAll(io.LimitReader(r, maxBackupSize+1))
+	if err != nil {
+		return s, err
+	}
+	if len(data) > maxBackupSize {
+		return s, fmt.Errorf("backup larger than %d bytes", maxBackupSize)
+	}
+	z, err := zip.NewReader(bytes.NewReader(data), int64(len(data)))
+	if err != nil {
+		return s, err
+	}
+	files := make(map[string][]byte)
+	for _, f := range z.File {
+		rc, err := f.Open()
+		if err != nil {
+			return s, err
+		}
+		b, err := ioutil.ReadAll(rc)
+		rc.Close()
+		if err != nil {
+			return s, err
+		}
+		files[f.Name] = b
+	}
+	var m backupManifest
+	if err := json.Unmarshal(files["manifest.json"], &m); err != nil {
+		return s, fmt.Errorf("bad manifest: %v", err)
+	}
+	if m.Version > stateVersion {
+		return s, fmt.Errorf("backup version %d is newer than supported version %d", m.Version, stateVersion)
+	}
+	for name, b := range files {
+		if strings.HasPrefix(name, "state/") {
+			s.fields[strings.TrimPrefix(name, "state/")] = b
+		}
+	}
+	for entry, pd := range m.Protocols {
+		b, ok := files[entry]
+		if !ok {
+			return s, fmt.Errorf("missing %s", entry)
+		}
+		s.protocols[string(codec.NewID(pd.Protocol, pd.Key))] = b
+	}
+	return s, nil
+}
+
+// Restore restores a backup archive posted as the request body, replacing
+// the settings and playlists it contains and adding its protocol instances.
+// The hooks and announcer, which run commands, are kept; RestoreFile
+// restores them.
+func (srv *Server) Restore(body io.Reader, form url.Values, ps httprouter.Params) (interface{}, error) {
+	s, err := readBackup(body)
+	if err != nil {
+		return nil, err
+	}
+	srv.audit(ps, "restore", "")
+	ch := make(chan error)
+	srv.ch <- cmdRestore{
+		state: s,
+		err:   ch,
+	}
+	return nil, <-ch
+}
+
+// RestoreFile restores the backup archive at name into the state database
+// at stateFile, which must not be in use.
+func RestoreFile(stateFile, name string) error {
+	f, err := os.Open(name)
+	if err != nil {
+		return err
+	}
+	defer f.Close()
+	s, err := readBackup(f)
+	if err != nil {
+		return err
+	}
+	srv := &Server{
+		Protocols: protocol.Map(),
+		Playlists: make(map[string]Playlist),
+	}
+	if err := srv.openDB(stateFile); err != nil {
+		if srv.db == nil {
+			return err
+		}
+		log.Println(err)
+	}
+	defer srv.db.Close()
+	if err := srv.apply(s); err != nil {
+		return err
+	}
+	return srv.writeState(srv.snapshot())
+}
+
+type cmdBackup struct {
+	secrets bool
+	done    chan backup
+}
+
+type cmdRestore struct {
+	state stateSnapshot
+	err   chan error
+}
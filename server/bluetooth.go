@@ -0,0 +1,330 @@
+package server
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"log"
+	"net/url"
+	"os/exec"
+	"runtime"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/julienschmidt/httprouter"
+	"github.com/mjibson/moggio/output"
+)
+
+// Bluetooth holds the settings of the Bluetooth speaker used as the output.
+type Bluetooth struct {
+	// Address is the speaker's address, like "00:11:22:33:44:55", or empty
+	// if none is selected.
+	Address string
+	// Sink is the name of the speaker's output device when it was last
+	// connected.
+	Sink string
+	// Offset is the speaker's latency. It is subtracted from the reported
+	// playback position so it matches what's heard.
+	Offset time.Duration
+}
+
+const (
+	// bluetoothInterval is how often the selected speaker is checked and
+	// reconnected if needed.
+	bluetoothInterval = time.Second * 30
+	// bluetoothWait is how long to wait for a connected speaker's output
+	// device to appear.
+	bluetoothWait = time.Second * 10
+	// audioSinkUUID is the A2DP audio sink profile.
+	audioSinkUUID = "0000110b-0000-1000-8000-00805f9b34fb"
+)
+
+// BluetoothDevice is an audio device known to BlueZ.
+type BluetoothDevice struct {
+	Address   string
+	Name      string
+	Paired    bool
+	Trusted   bool
+	Connected bool
+	// Sink is the name of its output device in the current backend, if
+	// connected.
+	Sink string `json:",omitempty"`
+
+	path string
+}
+
+// busctl runs busctl against BlueZ on the system bus.
+func busctl(args ...string) ([]byte, error) {
+	if runtime.GOOS != "linux" {
+		return nil, fmt.Errorf("bluetooth: only supported on Linux")
+	}
+	out, err := exec.Command("busctl", append([]string{"--system", "--json=short"}, args...)...).Output()
+	if ee, ok := err.(*exec.ExitError); ok && len(ee.Stderr) > 0 {
+		err = fmt.Errorf("bluetooth: %s", strings.TrimSpace(string(ee.Stderr)))
+	}
+	return out, err
+}
+
+// bluetoothDevices lists the audio devices known to BlueZ, sorted by name.
+func bluetoothDevices() ([]BluetoothDevice, error) {
+	b, err := busctl("call", "org.bluez", "/", "org.freedesktop.DBus.ObjectManager", "GetManagedObjects")
+	if err != nil {
+		return nil, err
+	}
+	type variant struct {
+		Data json.RawMessage `json:"data"`
+	}
+	var objs struct {
+		Data []map[string]map[string]map[string]variant `json:"data"`
+	}
+	if err := json.Unmarshal(b, &objs); err != nil {
+		return nil, fmt.Errorf("bluetooth: %v", err)
+	}
+	var devs []BluetoothDevice
+	for _, m := range objs.Data {
+		for path, ifaces := range m {
+			props, ok := ifaces["org.bluez.Device1"]
+			if !ok {
+				continue
+			}
+			var icon string
+			var uuids []string
+			json.Unmarshal(props["Icon"].Data, &icon)
+			json.Unmarshal(props["UUIDs"].Data, &uuids)
+			audio := strings.HasPrefix(icon, "audio-")
+			for _, u := range uuids {
+				audio = audio || strings.EqualFold(u, audioSinkUUID)
+			}
+			if !audio {
+				continue
+			}
+			d := BluetoothDevice{path: path}
+			json.Unmarshal(props["Address"].Data, &d.Address)
+			json.Unmarshal(props["Alias"].Data, &d.Name)
+			json.Unmarshal(props["Paired"].Data, &d.Paired)
+			json.Unmarshal(props["Trusted"].Data, &d.Trusted)
+			json.Unmarshal(props["Connected"].Data, &d.Connected)
+			if d.Name == "" {
+				d.Name = d.Address
+			}
+			devs = append(devs, d)
+		}
+	}
+	sort.Slice(devs, func(i, j int) bool {
+		return devs[i].Name < devs[j].Name
+	})
+	return devs, nil
+}
+
+func bluetoothDevice(addr string) (*BluetoothDevice, error) {
+	devs, err := bluetoothDevices()
+	if err != nil {
+		return nil, err
+	}
+	for i := range devs {
+		if strings.EqualFold(devs[i].Address, addr) {
+			return &devs[i], nil
+		}
+	}
+	return nil, fmt.Errorf("bluetooth: unknown device: %s", addr)
+}
+
+// connect pairs with d, trusts it so it may reconnect on its own, and
+// connects to it.
+func (d *BluetoothDevice) connect() error {
+	if !d.Paired {
+		if _, err := busctl("call", "org.bluez", d.path, "org.bluez.Device1", "Pair"); err != nil {
+			return err
+		}
+	}
+	if !d.Trusted {
+		if _, err := busctl("set-property", "org.bluez", d.path, "org.bluez.Device1", "Trusted", "b", "true"); err != nil {
+			return err
+		}
+	}
+	if !d.Connected {
+		if _, err := busctl("call", "org.bluez", d.path, "org.bluez.Device1", "Connect"); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// bluetoothSink returns the name of the device of devs for the Bluetooth
+// device at addr, or "" if there is none. PulseAudio and PipeWire name them
+// like bluez_sink.00_11_22_33_44_55.a2dp_sink, and BlueALSA like
+// bluealsa:DEV=00:11:22:33:44:55.
+func bluetoothSink(devs []output.Device, addr string) string {
+	addr = strings.ToUpper(addr)
+	under := strings.Replace(addr, ":", "_", -1)
+	for _, d := range devs {
+		name := strings.ToUpper(d.Name)
+		if strings.Contains(name, under) || strings.Contains(name, addr) {
+			return d.Name
+		}
+	}
+	return ""
+}
+
+// outputDevices lists the devices of the current output backend.
+func (srv *Server) outputDevices() ([]output.Device, error) {
+	ch := make(chan *waitData)
+	srv.ch <- cmdWaitData{
+		wt:   waitOutputs,
+		done: ch,
+	}
+	return output.Devices((<-ch).Data.(outputSettings).Backend)
+}
+
+// waitSink waits for the output device of the Bluetooth device at addr to
+// appear, and returns its name.
+func (srv *Server) waitSink(addr string) (string, error) {
+	deadline := time.Now().Add(bluetoothWait)
+	for {
+		devs, err := srv.outputDevices()
+		if err != nil {
+			return "", err
+		}
+		if sink := bluetoothSink(devs, addr); sink != "" {
+			return sink, nil
+		}
+		if time.Now().After(deadline) {
+			return "", fmt.Errorf("bluetooth: %s connected but has no output device", addr)
+		}
+		time.Sleep(time.Second / 2)
+	}
+}
+
+// watchBluetooth reconnects the selected speaker when it is disconnected,
+// and reopens the output on it when it returns.
+func (srv *Server) watchBluetooth() {
+	if runtime.GOOS != "linux" {
+		return
+	}
+	present := true
+	for range time.Tick(bluetoothInterval) {
+		ch := make(chan Bluetooth)
+		srv.ch <- cmdGetBluetooth(ch)
+		bt := <-ch
+		if bt.Address == "" {
+			continue
+		}
+		d, err := bluetoothDevice(bt.Address)
+		if err != nil {
+			continue
+		}
+		if !d.Connected {
+			present = false
+			if err := d.connect(); err != nil {
+				continue
+			}
+			log.Println("bluetooth: reconnected", d.Name)
+		}
+		devs, err := srv.outputDevices()
+		if err != nil {
+			continue
+		}
+		sink := bluetoothSink(devs, bt.Address)
+		if sink == "" {
+			present = false
+			continue
+		}
+		if !present || sink != bt.Sink {
+			bt.Sink = sink
+			srv.ch <- cmdSetBluetooth(bt)
+			srv.ch <- cmdDevice(sink)
+			srv.ch <- cmdReopenOutput{}
+		}
+		present = true
+	}
+}
+
+// GetBluetooth returns the Bluetooth settings and audio devices.
+func (srv *Server) GetBluetooth(body io.Reader, form url.Values, ps httprouter.Params) (interface{}, error) {
+	devs, err := bluetoothDevices()
+	if err != nil {
+		return nil, err
+	}
+	if outs, err := srv.outputDevices(); err == nil {
+		for i := range devs {
+			if devs[i].Connected {
+				devs[i].Sink = bluetoothSink(outs, devs[i].Address)
+			}
+		}
+	}
+	ch := make(chan Bluetooth)
+	srv.ch <- cmdGetBluetooth(ch)
+	return struct {
+		Settings Bluetooth
+		Devices  []BluetoothDevice
+	}{
+		Settings: <-ch,
+		Devices:  devs,
+	}, nil
+}
+
+// BluetoothSettings selects the Bluetooth speaker, connecting to it and
+// making it the output device, and sets its latency offset, like "150ms".
+// An empty Address deselects the speaker; the output device is unchanged.
+func (srv *Server) BluetoothSettings(body io.Reader, form url.Values, ps httprouter.Params) (interface{}, error) {
+	var s struct {
+		Address *string
+		Offset  *string
+	}
+	if err := json.NewDecoder(body).Decode(&s); err != nil {
+		return nil, err
+	}
+	ch := make(chan Bluetooth)
+	srv.ch <- cmdGetBluetooth(ch)
+	bt := <-ch
+	if s.Offset != nil {
+		d, err := time.ParseDuration(*s.Offset)
+		if err != nil {
+			return nil, err
+		}
+		if d < 0 {
+			return nil, fmt.Errorf("offset must not be negative")
+		}
+		bt.Offset = d
+	}
+	var sink string
+	if s.Address != nil {
+		bt.Address, bt.Sink = *s.Address, ""
+		if bt.Address != "" {
+			d, err := bluetoothDevice(bt.Address)
+			if err != nil {
+				return nil, err
+			}
+			if err := d.connect(); err != nil {
+				return nil, err
+			}
+			sink, err = srv.waitSink(d.Address)
+			if err != nil {
+				return nil, err
+			}
+			bt.Address, bt.Sink = d.Address, sink
+		}
+		srv.audit(ps, "bluetooth", bt.Address)
+	}
+	srv.ch <- cmdSetBluetooth(bt)
+	if sink != "" {
+		srv.ch <- cmdDevice(sink)
+	}
+	return nil, nil
+}
+
+// bluetoothOffset returns the latency offset of the output device.
+func (srv *Server) bluetoothOffset() time.Duration {
+	if srv.Bluetooth.Sink == "" || srv.Device != srv.Bluetooth.Sink {
+		return 0
+	}
+	return srv.Bluetooth.Offset
+}
+
+type cmdGetBluetooth chan Bluetooth
+
+type cmdSetBluetooth Bluetooth
+
+// cmdReopenOutput reopens the output device, as after it reconnects.
+type cmdReopenOutput struct{}
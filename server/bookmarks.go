@@ -0,0 +1,159 @@
+package server
+
+import (
+	"fmt"
+	"io"
+	"net/url"
+	"sort"
+	"time"
+
+	"github.com/julienschmidt/httprouter"
+	"github.com/mjibson/moggio/codec"
+)
+
+// Bookmark is a named position in a song.
+type Bookmark struct {
+	Name     string
+	Position time.Duration
+}
+
+// SongBookmarks are the bookmarks of a song, ordered by position.
+type SongBookmarks struct {
+	ID        SongID
+	Info      *codec.SongInfo `json:",omitempty"`
+	Bookmarks []Bookmark
+}
+
+// bookmarks returns the bookmarks of user, or the owner's if empty. It
+// should only be called by the commands() function.
+func (srv *Server) bookmarks(user string) map[SongID][]Bookmark {
+	if user == "" {
+		if srv.Bookmarks == nil {
+			srv.Bookmarks = make(map[SongID][]Bookmark)
+		}
+		return srv.Bookmarks
+	}
+	u := srv.Users[user]
+	if u == nil {
+		return nil
+	}
+	if u.Bookmarks == nil {
+		u.Bookmarks = make(map[SongID][]Bookmark)
+	}
+	return u.Bookmarks
+}
+
+// songBookmarks returns the bookmarks of user of the song id, or of all
+// songs if id is empty. It should only be called by the commands()
+// function.
+func (srv *Server) songBookmarks(user string, id SongID) []SongBookmarks {
+	r := []SongBookmarks{}
+	for sid, bs := range srv.bookmarks(user) {
+		if id != "" && sid != id {
+			continue
+		}
+		info, _ := srv.getSong(sid)
+		r = append(r, SongBookmarks{
+			ID:        sid,
+			Info:      info,
+			Bookmarks: bs,
+		})
+	}
+	sort.Slice(r, func(i, j int) bool {
+		return r[i].ID < r[j].ID
+	})
+	return r
+}
+
+// GetBookmarks returns the bookmarks of the song id, or of all songs.
+func (srv *Server) GetBookmarks(body io.Reader, form url.Values, ps httprouter.Params) (interface{}, error) {
+	ch := make(chan []SongBookmarks)
+	srv.ch <- cmdGetBookmarks{
+		user: ps.ByName(paramUser),
+		id:   SongID(form.Get("id")),
+		done: ch,
+	}
+	return <-ch, nil
+}
+
+// AddBookmark adds the bookmark name at pos to the song id, replacing any
+// with that name. Without id and pos, it's at the current position of the
+// current song.
+func (srv *Server) AddBookmark(body io.Reader, form url.Values, ps httprouter.Params) (interface{}, error) {
+	name := form.Get("name")
+	if name == "" {
+		return nil, fmt.Errorf("no name")
+	}
+	c := cmdAddBookmark{
+		user: ps.ByName(paramUser),
+		id:   SongID(form.Get("id")),
+		b:    Bookmark{Name: name, Position: -1},
+		done: make(chan error),
+	}
+	if p := form.Get("pos"); p != "" {
+		d, err := time.ParseDuration(p)
+		if err != nil {
+			return nil, err
+		}
+		if d < 0 {
+			return nil, fmt.Errorf("negative position")
+		}
+		c.b.Position = d
+	}
+	srv.ch <- c
+	return nil, <-c.done
+}
+
+// RemoveBookmark removes the bookmark name of the song id.
+func (srv *Server) RemoveBookmark(body io.Reader, form url.Values, ps httprouter.Params) (interface{}, error) {
+	srv.ch <- cmdRemoveBookmark{
+		user: ps.ByName(paramUser),
+		id:   SongID(form.Get("id")),
+		name: form.Get("name"),
+	}
+	return nil, nil
+}
+
+// JumpBookmark plays the song id from its bookmark name. A song not in the
+// queue is inserted after the current song.
+func (srv *Server) JumpBookmark(body io.Reader, form url.Values, ps httprouter.Params) (interface{}, error) {
+	srv.playing.interrupt()
+	c := cmdJumpBookmark{
+		user: ps.ByName(paramUser),
+		id:   SongID(form.Get("id")),
+		name: form.Get("name"),
+		done: make(chan error),
+	}
+	srv.ch <- cmdListen{
+		user: c.user,
+		cmd:  c,
+	}
+	return nil, <-c.done
+}
+
+type cmdGetBookmarks struct {
+	user string
+	id   SongID
+	done chan []SongBookmarks
+}
+
+type cmdAddBookmark struct {
+	user string
+	id   SongID
+	// b's Position is -1 for the current position.
+	b    Bookmark
+	done chan error
+}
+
+type cmdRemoveBookmark struct {
+	user string
+	id   SongID
+	name string
+}
+
+type cmdJumpBookmark struct {
+	user string
+	id   SongID
+	name string
+	done chan error
+}
@@ -0,0 +1,374 @@
+package server
+
+import (
+	"container/list"
+	"crypto/sha1"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"log"
+	"net/url"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/julienschmidt/httprouter"
+)
+
+// CacheSettings are the limits of the disk cache, in bytes.
+type CacheSettings struct {
+	// Quota bounds the size of all the cache. If 0, defaultCacheQuota
+	// does.
+	Quota int64
+	// Limits bound the size of categories, by name, below the quota.
+	Limits map[string]int64 `json:",omitempty"`
+}
+
+// Categories of the disk cache.
+const (
+	// cacheArtwork are artwork thumbnails.
+	cacheArtwork = "artwork"
+	// cacheWaveform are the waveforms of analyzed songs, made again by
+	// analysis if evicted.
+	cacheWaveform = "waveform"
+)
+
+// cacheCategories are the categories of the disk cache. The songs read
+// ahead of playback are held in memory, not cached.
+var cacheCategories = []string{cacheArtwork, cacheWaveform}
+
+// defaultCacheQuota is the size of the disk cache if its quota isn't set.
+const defaultCacheQuota = 512 << 20
+
+// CacheUsage is the use of a category of the disk cache.
+type CacheUsage struct {
+	Category string
+	Size     int64
+	Files    int
+	// Limit is the category's limit, or 0 if only the quota bounds it.
+	Limit  int64
+	Hits   int
+	Misses int
+}
+
+// diskCache holds files made from songs, like thumbnails, in categories
+// under dir, evicting the least recently used when over its limits. Use
+// survives restarts as the files' modification times.
+type diskCache struct {
+	dir string
+
+	mu       sync.Mutex
+	settings CacheSettings
+	// lru has the entries, least recently used first; entries indexes it
+	// by path.
+	lru     *list.List
+	entries map[string]*list.Element
+	usage   map[string]*CacheUsage
+}
+
+type cacheEntry struct {
+	category string
+	path     string
+	size     int64
+}
+
+// cacheDir returns the directory of the disk cache of the state file
+// stateFile, beside it.
+func cacheDir(stateFile string) string {
+	return strings.TrimSuffix(stateFile, filepath.Ext(stateFile)) + ".cache"
+}
+
+// newDiskCache returns the cache in dir, reading the files already there.
+func newDiskCache(dir string) *diskCache {
+	c := &diskCache{
+		dir:     dir,
+		lru:     list.New(),
+		entries: make(map[string]*list.Element),
+		usage:   make(map[string]*CacheUsage),
+	}
+	for _, cat := range cacheCategories {
+		c.usage[cat] = &CacheUsage{Category: cat}
+	}
+	var files []os.FileInfo
+	var entries []*cacheEntry
+	for _, cat := range cacheCategories {
+		fis, _ := ioutil.ReadDir(filepath.Join(dir, cat))
+		for _, fi := range fis {
+			if fi.IsDir() || strings.HasPrefix(fi.Name(), ".") {
+				continue
+			}
+			files = append(files, fi)
+			entries = append(entries, &cacheEntry{
+				category: cat,
+				path:     filepath.Join(dir, cat, fi.Name()),
+				size:     fi.Size(),
+			})
+		}
+	}
+	idx := make([]int, len(files))
+	for i := range idx {
+		idx[i] = i
+	}
+	sort.Slice(idx, func(i, j int) bool {
+		return files[idx[i]].ModTime().Before(files[idx[j]].ModTime())
+	})
+	for _, i := range idx {
+		c.add(entries[i])
+	}
+	return c
+}
+
+// add adds e as the most recently used. c.mu must be held, unless c isn't
+// shared yet.
+func (c *diskCache) add(e *cacheEntry) {
+	c.entries[e.path] = c.lru.PushBack(e)
+	u := c.usage[e.category]
+	u.Size += e.size
+	u.Files++
+}
+
+func (c *diskCache) remove(el *list.Element) {
+	e := c.lru.Remove(el).(*cacheEntry)
+	delete(c.entries, e.path)
+	u := c.usage[e.category]
+	u.Size -= e.size
+	u.Files--
+	if err := os.Remove(e.path); err != nil && !os.IsNotExist(err) {
+		log.Printf("cache: %v", err)
+	}
+}
+
+// path returns the file of key in category.
+func (c *diskCache) path(category, key string) string {
+	h := sha1.Sum([]byte(key))
+	return filepath.Join(c.dir, category, hex.EncodeToString(h[:]))
+}
+
+// get returns the data of key in category, if cached.
+func (c *diskCache) get(category, key string) ([]byte, bool) {
+	p := c.path(category, key)
+	c.mu.Lock()
+	el, ok := c.entries[p]
+	if ok {
+		c.lru.MoveToBack(el)
+		c.usage[category].Hits++
+	} else {
+		c.usage[category].Misses++
+	}
+	c.mu.Unlock()
+	if !ok {
+		return nil, false
+	}
+	b, err := ioutil.ReadFile(p)
+	if err != nil {
+		c.mu.Lock()
+		if el, ok := c.entries[p]; ok {
+			c.remove(el)
+		}
+		c.mu.Unlock()
+		return nil, false
+	}
+	now := time.Now()
+	os.Chtimes(p, now, now)
+	return b, true
+}
+
+// put caches data as key in category, evicting the least recently used
+// files over the limits.
+func (c *diskCache) put(category, key string, data []byte) {
+	p := c.path(category, key)
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if el, ok := c.entries[p]; ok {
+		c.remove(el)
+	}
+	if int64(len(data)) > c.limit(category) {
+		return
+	}
+	if err := writeCacheFile(p, data); err != nil {
+		log.Printf("cache: %v", err)
+		return
+	}
+	c.add(&cacheEntry{
+		category: category,
+		path:     p,
+		size:     int64(len(data)),
+	})
+	c.evict()
+}
+
+func writeCacheFile(p string, data []byte) error {
+	if err := os.MkdirAll(filepath.Dir(p), 0755); err != nil {
+		return err
+	}
+	tmp := filepath.Join(filepath.Dir(p), "."+filepath.Base(p))
+	if err := ioutil.WriteFile(tmp, data, 0644); err != nil {
+		return err
+	}
+	return os.Rename(tmp, p)
+}
+
+// limit returns the limit of category: its own, or the quota. c.mu must be
+// held.
+func (c *diskCache) limit(category string) int64 {
+	quota := c.quota()
+	if l := c.settings.Limits[category]; l > 0 && l < quota {
+		return l
+	}
+	return quota
+}
+
+func (c *diskCache) quota() int64 {
+	if c.settings.Quota > 0 {
+		return c.settings.Quota
+	}
+	return defaultCacheQuota
+}
+
+// evict removes the least recently used files until the categories and
+// the whole cache are within their limits. c.mu must be held.
+func (c *diskCache) evict() {
+	var total int64
+	for _, u := range c.usage {
+		total += u.Size
+	}
+	over := func() bool {
+		if total > c.quota() {
+			return true
+		}
+		for cat, u := range c.usage {
+			if u.Size > c.limit(cat) {
+				return true
+			}
+		}
+		return false
+	}
+	for el := c.lru.Front(); el != nil && over(); {
+		next := el.Next()
+		e := el.Value.(*cacheEntry)
+		if total > c.quota() || c.usage[e.category].Size > c.limit(e.category) {
+			total -= e.size
+			c.remove(el)
+		}
+		el = next
+	}
+}
+
+// setSettings sets the limits of the cache, evicting files over them.
+func (c *diskCache) setSettings(s CacheSettings) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.settings = s
+	c.evict()
+}
+
+// clear removes the files of category, or of all of them if "".
+func (c *diskCache) clear(category string) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	for el := c.lru.Front(); el != nil; {
+		next := el.Next()
+		if e := el.Value.(*cacheEntry); category == "" || e.category == category {
+			c.remove(el)
+		}
+		el = next
+	}
+}
+
+// getUsage returns the use of the categories, and the quota.
+func (c *diskCache) getUsage() ([]CacheUsage, int64) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	var us []CacheUsage
+	for _, cat := range cacheCategories {
+		u := *c.usage[cat]
+		if l := c.limit(cat); l < c.quota() {
+			u.Limit = l
+		}
+		us = append(us, u)
+	}
+	return us, c.quota()
+}
+
+func checkCacheCategory(category string) error {
+	for _, c := range cacheCategories {
+		if c == category {
+			return nil
+		}
+	}
+	return fmt.Errorf("unknown cache category: %s", category)
+}
+
+func checkCacheSettings(s CacheSettings) error {
+	if s.Quota < 0 {
+		return fmt.Errorf("cache quota must not be negative")
+	}
+	for cat, l := range s.Limits {
+		if err := checkCacheCategory(cat); err != nil {
+			return err
+		}
+		if l < 0 {
+			return fmt.Errorf("%s cache limit must not be negative", cat)
+		}
+	}
+	return nil
+}
+
+// GetCache returns the settings and usage of the disk cache.
+func (srv *Server) GetCache(body io.Reader, form url.Values, ps httprouter.Params) (interface{}, error) {
+	ch := make(chan CacheSettings)
+	srv.ch <- cmdGetCache(ch)
+	usage, quota := srv.cache.getUsage()
+	var total int64
+	for _, u := range usage {
+		total += u.Size
+	}
+	return struct {
+		Settings CacheSettings
+		Quota    int64
+		Total    int64
+		Usage    []CacheUsage
+	}{
+		Settings: <-ch,
+		Quota:    quota,
+		Total:    total,
+		Usage:    usage,
+	}, nil
+}
+
+// SetCache sets the quota and limits of the disk cache.
+func (srv *Server) SetCache(body io.Reader, form url.Values, ps httprouter.Params) (interface{}, error) {
+	var s CacheSettings
+	if err := json.NewDecoder(body).Decode(&s); err != nil {
+		return nil, err
+	}
+	if err := checkCacheSettings(s); err != nil {
+		return nil, err
+	}
+	srv.audit(ps, "cache settings", fmt.Sprintf("quota %d", s.Quota))
+	srv.ch <- cmdSetCache(s)
+	return nil, nil
+}
+
+// ClearCache removes the files of the category parameter's category of the
+// disk cache, or all of them if not given.
+func (srv *Server) ClearCache(body io.Reader, form url.Values, ps httprouter.Params) (interface{}, error) {
+	cat := form.Get("category")
+	if cat != "" {
+		if err := checkCacheCategory(cat); err != nil {
+			return nil, err
+		}
+	}
+	srv.audit(ps, "cache clear", cat)
+	srv.cache.clear(cat)
+	return nil, nil
+}
+
+type cmdGetCache chan CacheSettings
+
+type cmdSetCache CacheSettings
@@ -0,0 +1,68 @@
+package server
+
+import (
+	"context"
+	"sync"
+)
+
+// songContext holds the context of reads of the playing song, canceled when
+// the song stops so reads blocked on a slow network return. Songs are
+// opened by the commands() function, which a slow open blocks, so commands
+// that change songs interrupt an open from the HTTP handler.
+type songContext struct {
+	mu      sync.Mutex
+	cancel  context.CancelFunc
+	opening bool
+}
+
+// start cancels the previous song and returns the context of the next,
+// which is opening until opened is called.
+func (s *songContext) start(parent context.Context) context.Context {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if s.cancel != nil {
+		s.cancel()
+	}
+	ctx, cancel := context.WithCancel(parent)
+	s.cancel = cancel
+	s.opening = true
+	return ctx
+}
+
+func (s *songContext) opened() {
+	s.mu.Lock()
+	s.opening = false
+	s.mu.Unlock()
+}
+
+// adopt cancels the previous song and makes cancel that of the next, which
+// was opened ahead of its turn.
+func (s *songContext) adopt(cancel context.CancelFunc) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if s.cancel != nil {
+		s.cancel()
+	}
+	s.cancel = cancel
+	s.opening = false
+}
+
+// stop cancels the song.
+func (s *songContext) stop() {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if s.cancel != nil {
+		s.cancel()
+		s.cancel = nil
+	}
+	s.opening = false
+}
+
+// interrupt cancels the song if it is still opening.
+func (s *songContext) interrupt() {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if s.opening && s.cancel != nil {
+		s.cancel()
+	}
+}
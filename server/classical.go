@@ -0,0 +1,213 @@
+package server
+
+import (
+	"io"
+	"net/url"
+	"regexp"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/julienschmidt/httprouter"
+	"github.com/mjibson/moggio/codec"
+)
+
+// movementRE matches titles of movements with their work, like "Symphony
+// No. 5: II. Andante" or "Sonata in A - 3. Rondo".
+var movementRE = regexp.MustCompile(`^(.+?)\s*(?::|\s-|\s–)\s*([IVXLC]+|\d+)[.:)]\s+(.+)$`)
+
+// romans are the values of Roman numerals.
+var romans = map[byte]int{'I': 1, 'V': 5, 'X': 10, 'L': 50, 'C': 100}
+
+// parseRoman returns the value of the Roman numeral s, or 0 if it's not
+// one.
+func parseRoman(s string) int {
+	n := 0
+	for i := 0; i < len(s); i++ {
+		v := romans[s[i]]
+		if v == 0 {
+			return 0
+		}
+		if i+1 < len(s) && romans[s[i+1]] > v {
+			n -= v
+		} else {
+			n += v
+		}
+	}
+	return n
+}
+
+// parseMovement returns the work, movement number, and movement name of a
+// title of a movement, or ok false if it isn't one.
+func parseMovement(title string) (work string, n int, movement string, ok bool) {
+	m := movementRE.FindStringSubmatch(title)
+	if m == nil {
+		return "", 0, "", false
+	}
+	if n, _ = strconv.Atoi(m[2]); n == 0 {
+		n = parseRoman(m[2])
+	}
+	if n == 0 {
+		return "", 0, "", false
+	}
+	return strings.TrimSpace(m[1]), n, strings.TrimSpace(m[3]), true
+}
+
+// parseWork returns info with the work and movement parsed from its title
+// if untagged, or info itself if it has none.
+func parseWork(info *codec.SongInfo) *codec.SongInfo {
+	if info.Movement != "" || info.Title == "" {
+		return info
+	}
+	i := *info
+	if !parseWorkTo(&i) {
+		return info
+	}
+	return &i
+}
+
+func parseWorkTo(i *codec.SongInfo) bool {
+	if i.Movement != "" || i.Title == "" {
+		return false
+	}
+	work, n, movement, ok := parseMovement(i.Title)
+	switch {
+	case ok && (i.Work == "" || normalize(i.Work) == normalize(work)):
+		i.Work = work
+		i.Movement = movement
+		if i.MovementNumber == 0 {
+			i.MovementNumber = n
+		}
+	case i.Work != "":
+		// Tagged works name their movements in the title.
+		i.Movement = i.Title
+	default:
+		return false
+	}
+	return true
+}
+
+// workKey returns the key of the work of it, a recording of it on an
+// album, or "" if it isn't a movement.
+func workKey(it listItem) string {
+	if it.Info == nil || it.Info.Work == "" {
+		return ""
+	}
+	return string(codec.NewID(it.ID.Protocol(), it.ID.Key(), normalize(it.Info.Composer), normalize(it.Info.Work), normalize(it.Info.Album)))
+}
+
+// sortMovements sorts the songs of a work in movement order.
+func sortMovements(songs []listItem) {
+	sort.Slice(songs, func(i, j int) bool {
+		a, b := songs[i].Info, songs[j].Info
+		if a.MovementNumber != b.MovementNumber {
+			return a.MovementNumber < b.MovementNumber
+		}
+		if a.Disc != b.Disc {
+			return a.Disc < b.Disc
+		}
+		if a.Track != b.Track {
+			return a.Track < b.Track
+		}
+		return songs[i].ID < songs[j].ID
+	})
+}
+
+// ClassicalWork is a recording of a work, with its movements in order.
+type ClassicalWork struct {
+	Composer string
+	Work     string
+	Album    string
+	// Artist are the performers.
+	Artist string
+	Songs  []listItem
+}
+
+// Composer is a composer of works in the library.
+type Composer struct {
+	Composer string
+	Works    int
+	Songs    int
+}
+
+// classicalWorks returns the works of items, by composer and work.
+func classicalWorks(items []listItem) []*ClassicalWork {
+	works := make(map[string]*ClassicalWork)
+	var list []*ClassicalWork
+	for _, it := range items {
+		key := workKey(it)
+		if key == "" {
+			continue
+		}
+		w := works[key]
+		if w == nil {
+			w = &ClassicalWork{
+				Composer: it.Info.Composer,
+				Work:     it.Info.Work,
+				Album:    it.Info.Album,
+				Artist:   it.Info.Artist,
+			}
+			works[key] = w
+			list = append(list, w)
+		}
+		w.Songs = append(w.Songs, it)
+	}
+	for _, w := range list {
+		sortMovements(w.Songs)
+	}
+	sort.Slice(list, func(i, j int) bool {
+		a, b := list[i], list[j]
+		if ca, cb := strings.ToLower(a.Composer), strings.ToLower(b.Composer); ca != cb {
+			return ca < cb
+		}
+		if wa, wb := strings.ToLower(a.Work), strings.ToLower(b.Work); wa != wb {
+			return wa < wb
+		}
+		return strings.ToLower(a.Album) < strings.ToLower(b.Album)
+	})
+	return list
+}
+
+// libraryItems returns the songs of the library, as sent to clients.
+func (srv *Server) libraryItems() []listItem {
+	ch := make(chan *waitData)
+	srv.ch <- cmdWaitData{
+		wt:   waitTracks,
+		done: ch,
+	}
+	return (<-ch).Data.(tracksData).Tracks
+}
+
+// ClassicalComposers returns the composers of works in the library, and
+// their number of works and movements.
+func (srv *Server) ClassicalComposers(body io.Reader, form url.Values, ps httprouter.Params) (interface{}, error) {
+	composers := []*Composer{}
+	byName := make(map[string]*Composer)
+	for _, w := range classicalWorks(srv.libraryItems()) {
+		c := byName[normalize(w.Composer)]
+		if c == nil {
+			c = &Composer{Composer: w.Composer}
+			byName[normalize(w.Composer)] = c
+			composers = append(composers, c)
+		}
+		c.Works++
+		c.Songs += len(w.Songs)
+	}
+	return composers, nil
+}
+
+// ClassicalWorks returns the works of the composer parameter's composer, or
+// of all, each recording with its movements in order. Queue a whole work
+// with the append_work command.
+func (srv *Server) ClassicalWorks(body io.Reader, form url.Values, ps httprouter.Params) (interface{}, error) {
+	works := []*ClassicalWork{}
+	composer, all := form.Get("composer"), len(form["composer"]) == 0
+	for _, w := range classicalWorks(srv.libraryItems()) {
+		if all || normalize(w.Composer) == normalize(composer) {
+			works = append(works, w)
+		}
+	}
+	return works, nil
+}
+
+type cmdAppendWork SongID
@@ -0,0 +1,116 @@
+package server
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/url"
+
+	"github.com/julienschmidt/httprouter"
+)
+
+// Cleanup are the policies removing played songs from the queue and
+// history. They're applied by the server, so all clients see the same
+// queue.
+type Cleanup struct {
+	// Consume removes songs from the queue once played or skipped, like
+	// MPD's consume mode.
+	Consume bool
+	// KeepPlayed is the number of played songs kept in the queue before
+	// the current one; older ones are removed. 0 keeps all. It's unused in
+	// random order, where songs before the current one aren't the played
+	// ones.
+	KeepPlayed int
+	// HistorySize is the number of played songs remembered in History; 0
+	// is historySize.
+	HistorySize int
+}
+
+func (c Cleanup) validate() error {
+	if c.KeepPlayed < 0 {
+		return fmt.Errorf("negative keep played")
+	}
+	if c.HistorySize < 0 {
+		return fmt.Errorf("negative history size")
+	}
+	return nil
+}
+
+func (c Cleanup) historySize() int {
+	if c.HistorySize > 0 {
+		return c.HistorySize
+	}
+	return historySize
+}
+
+// cleanQueue applies the cleanup policies after the song at index played
+// was played and PlaylistIndex advanced, reporting whether the queue
+// changed. It should only be called by the commands() function.
+func (srv *Server) cleanQueue(played int) bool {
+	if played < 0 || played >= len(srv.Queue) {
+		return false
+	}
+	switch c := srv.Cleanup; {
+	case c.Consume:
+		// A new slice, since the old one may be shared with a playlist.
+		q := make(Playlist, 0, len(srv.Queue)-1)
+		q = append(q, srv.Queue[:played]...)
+		srv.Queue = append(q, srv.Queue[played+1:]...)
+		if srv.PlaylistIndex > played {
+			srv.PlaylistIndex--
+		}
+		return true
+	case c.KeepPlayed > 0 && !srv.Random:
+		n := srv.PlaylistIndex - c.KeepPlayed
+		if n > len(srv.Queue) {
+			n = len(srv.Queue)
+		}
+		if n <= 0 {
+			return false
+		}
+		srv.Queue = append(Playlist(nil), srv.Queue[n:]...)
+		srv.PlaylistIndex -= n
+		return true
+	}
+	return false
+}
+
+// trimHistory shortens the histories to the history size. It should only
+// be called by the commands() function.
+func (srv *Server) trimHistory() {
+	size := srv.Cleanup.historySize()
+	trim := func(h []SongID) []SongID {
+		if n := len(h) - size; n > 0 {
+			return append(h[:0], h[n:]...)
+		}
+		return h
+	}
+	srv.History = trim(srv.History)
+	for _, u := range srv.Users {
+		u.History = trim(u.History)
+	}
+}
+
+// GetCleanup returns the queue cleanup policies.
+func (srv *Server) GetCleanup(body io.Reader, form url.Values, ps httprouter.Params) (interface{}, error) {
+	ch := make(chan Cleanup)
+	srv.ch <- cmdGetCleanup(ch)
+	return <-ch, nil
+}
+
+// SetCleanup sets the queue cleanup policies from the JSON body.
+func (srv *Server) SetCleanup(body io.Reader, form url.Values, ps httprouter.Params) (interface{}, error) {
+	var c Cleanup
+	if err := json.NewDecoder(body).Decode(&c); err != nil {
+		return nil, err
+	}
+	if err := c.validate(); err != nil {
+		return nil, err
+	}
+	srv.ch <- cmdSetCleanup(c)
+	return nil, nil
+}
+
+type cmdGetCleanup chan Cleanup
+
+type cmdSetCleanup Cleanup
@@ -0,0 +1,170 @@
+package server
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"log"
+	"net/url"
+	"runtime/debug"
+	"strings"
+	"time"
+
+	"github.com/julienschmidt/httprouter"
+	"github.com/mjibson/moggio/codec"
+)
+
+// Codecs are the registered codecs, with their capabilities and decode
+// errors, and their options.
+type Codecs struct {
+	Codecs  []codec.Codec
+	Options []codec.Options
+}
+
+// CodecOptions are the playback options of a codec package, like the loop
+// count of game music.
+type CodecOptions struct {
+	Name   string
+	Values map[string]float64
+}
+
+// applyCodecOptions sets the saved codec options. Options of codecs not built
+// in are kept for builds that have them.
+func (srv *Server) applyCodecOptions() {
+	for name, values := range srv.CodecOptions {
+		if err := codec.SetOptions(name, values); err != nil {
+			log.Printf("codec options %s: %v", name, err)
+		}
+	}
+}
+
+// GetCodecs returns the codecs and their options.
+func (srv *Server) GetCodecs(body io.Reader, form url.Values, ps httprouter.Params) (interface{}, error) {
+	return Codecs{
+		Codecs:  codec.Codecs(),
+		Options: codec.ListOptions(),
+	}, nil
+}
+
+// SetCodecOptions sets the options of a codec package from the JSON body.
+// Options not given are reset to their defaults.
+func (srv *Server) SetCodecOptions(body io.Reader, form url.Values, ps httprouter.Params) (interface{}, error) {
+	var o CodecOptions
+	if err := json.NewDecoder(body).Decode(&o); err != nil {
+		return nil, err
+	}
+	if err := codec.SetOptions(o.Name, o.Values); err != nil {
+		return nil, err
+	}
+	srv.ch <- cmdSetCodecOptions(o)
+	return nil, nil
+}
+
+type cmdSetCodecOptions CodecOptions
+
+// DecodeTest is the result of decoding a song entirely, detailed for bug
+// reports.
+type DecodeTest struct {
+	ID SongID
+	// Type is the Go type of the song, which names its codec's package.
+	Type       string
+	Info       *codec.SongInfo
+	SampleRate int
+	Channels   int
+	BitDepth   int
+	// Samples are the samples decoded, Decoded their length, and Elapsed
+	// how long decoding them took.
+	Samples int
+	Decoded time.Duration
+	Elapsed time.Duration
+	// Checksum is "match" or "mismatch" for songs whose formats record a
+	// checksum of their audio.
+	Checksum string
+	// Stage is where decoding failed with Error: "open", "info", "init",
+	// or "play"; Position is where in the song playing failed. Stack is
+	// the stack of a panicking decoder.
+	Stage    string
+	Error    string
+	Position time.Duration
+	Stack    string
+}
+
+// TestDecode decodes the song id entirely, returning how it went.
+func (srv *Server) TestDecode(body io.Reader, form url.Values, ps httprouter.Params) (interface{}, error) {
+	id := SongID(strings.TrimPrefix(ps.ByName("id"), "/"))
+	ch := make(chan songResult)
+	srv.ch <- cmdGetSong{
+		id:   id,
+		done: ch,
+	}
+	r := <-ch
+	t := &DecodeTest{ID: id}
+	if r.err != nil {
+		t.Stage, t.Error = "open", r.err.Error()
+		return t, nil
+	}
+	testDecode(t, r.song)
+	return t, nil
+}
+
+func testDecode(t *DecodeTest, song codec.Song) {
+	defer song.Close()
+	t.Type = fmt.Sprintf("%T", song)
+	fail := func(stage string, err error) {
+		t.Stage, t.Error = stage, err.Error()
+		codec.ReportError(song, err)
+	}
+	start := time.Now()
+	defer func() {
+		if e := recover(); e != nil {
+			t.Stack = string(debug.Stack())
+			fail(t.Stage, fmt.Errorf("panic: %v", e))
+		}
+		t.Elapsed = time.Since(start)
+	}()
+	t.Stage = "info"
+	info, err := song.Info()
+	if err != nil {
+		fail("info", err)
+		return
+	}
+	t.Info = &info
+	t.Stage = "init"
+	t.SampleRate, t.Channels, err = song.Init()
+	if err == nil && (t.SampleRate <= 0 || t.Channels <= 0) {
+		err = fmt.Errorf("bad format: %d Hz, %d channels", t.SampleRate, t.Channels)
+	}
+	if err != nil {
+		fail("init", err)
+		return
+	}
+	if d, ok := song.(codec.BitDepther); ok {
+		t.BitDepth = d.BitDepth()
+	}
+	t.Stage = "play"
+	n := t.SampleRate * t.Channels
+	for {
+		samples, err := song.Play(n)
+		t.Samples += len(samples)
+		t.Decoded = time.Duration(t.Samples/t.Channels) * time.Second / time.Duration(t.SampleRate)
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			t.Position = t.Decoded
+			fail("play", err)
+			return
+		}
+		if len(samples) < n {
+			break
+		}
+	}
+	t.Stage = ""
+	if v, ok := song.(codec.Verifier); ok {
+		if match, ok := v.Verified(); ok && match {
+			t.Checksum = "match"
+		} else if ok {
+			t.Checksum = "mismatch"
+		}
+	}
+}
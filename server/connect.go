@@ -0,0 +1,34 @@
+package server
+
+import (
+	"time"
+
+	"github.com/mjibson/moggio/codec"
+)
+
+// Connection states of protocol instances.
+const (
+	connConnecting = "connecting"
+	connConnected  = "connected"
+	connFailed     = "failed"
+)
+
+// reconnectInterval is how long after failing to connect an instance is
+// tried again.
+const reconnectInterval = time.Minute
+
+// Connection is the state of a protocol instance's connection to its
+// service. Instances connect in the background after startup, listing
+// their saved songs meanwhile.
+type Connection struct {
+	State string
+	// Error is why the last attempt failed.
+	Error string `json:",omitempty"`
+	// Since is when State last changed.
+	Since time.Time
+}
+
+type cmdConnected struct {
+	id  codec.ID
+	err error
+}
@@ -9,10 +9,14 @@ import (
 	"log"
 	"math/rand"
 	"net/http"
+	"path/filepath"
+	"sort"
+	"strconv"
+	"strings"
 	"time"
 
-	"github.com/bradfitz/slice"
 	"github.com/mjibson/moggio/codec"
+	"github.com/mjibson/moggio/dsp"
 	"github.com/mjibson/moggio/models"
 	"github.com/mjibson/moggio/protocol"
 	"golang.org/x/net/websocket"
@@ -23,37 +27,127 @@ func (srv *Server) commands() {
 	srv.state = stateStop
 	var next, stop, tick, play, pause, prev func()
 	var timer <-chan time.Time
-	waiters := make(map[*websocket.Conn]chan struct{})
+	// positionChanged is set if a song's resume position changed, to be
+	// saved.
+	var positionChanged bool
+	waiters := make(map[*websocket.Conn]*waiter)
+	sessions := make(map[string]*wsSession)
+	// seq is the sequence number of the last event. lastSeq is that of the
+	// last event of each type, and errs are the recent error events, for
+	// replay to reconnecting clients.
+	var seq uint64
+	lastSeq := make(map[waitType]uint64)
+	var errs []*waitData
+	send := func(ws *websocket.Conn, wd *waitData) {
+		enc := websocket.JSON
+		if w := waiters[ws]; w != nil {
+			enc = w.codec
+			if w.mobile {
+				wd = srv.mobileData(wd)
+			}
+		}
+		go func() {
+			if err := enc.Send(ws, wd); err != nil {
+				srv.ch <- cmdDeleteWS(ws)
+			}
+		}()
+	}
 	broadcastData := func(wd *waitData) {
 		for ws := range waiters {
-			go func(ws *websocket.Conn) {
-				if err := websocket.JSON.Send(ws, wd); err != nil {
-					srv.ch <- cmdDeleteWS(ws)
-				}
-			}(ws)
+			send(ws, wd)
 		}
 	}
 	broadcast := func(wt waitType) {
-		wd := srv.makeWaitData(wt)
-		broadcastData(wd)
+		seq++
+		lastSeq[wt] = seq
+		if wt != waitPlaylist {
+			wd := srv.makeWaitData(wt, "")
+			wd.Seq = seq
+			broadcastData(wd)
+			return
+		}
+		// Playlists differ by user.
+		data := make(map[string]*waitData)
+		for ws, w := range waiters {
+			wd := data[w.user]
+			if wd == nil {
+				wd = srv.makeWaitData(wt, w.user)
+				wd.Seq = seq
+				data[w.user] = wd
+			}
+			send(ws, wd)
+		}
 	}
-	broadcastErr := func(err error) {
+	// broadcastSongErr sends err to clients, with the song it's about, if
+	// any.
+	broadcastSongErr := func(id SongID, err error) {
 		printErr(err)
 		v := struct {
 			Time  time.Time
 			Error string
+			Song  SongID `json:",omitempty"`
 		}{
 			time.Now().UTC(),
 			err.Error(),
+			id,
 		}
-		broadcastData(&waitData{
+		seq++
+		wd := &waitData{
 			Type: waitError,
 			Data: v,
-		})
+			Seq:  seq,
+		}
+		errs = append(errs, wd)
+		if n := len(errs) - replayErrors; n > 0 {
+			errs = append(errs[:0], errs[n:]...)
+		}
+		broadcastData(wd)
+	}
+	broadcastErr := func(err error) {
+		broadcastSongErr("", err)
 	}
 	newWS := func(c cmdNewWS) {
 		ws := (*websocket.Conn)(c.ws)
-		waiters[ws] = c.done
+		w := &waiter{
+			done:  c.done,
+			user:  requestUser(ws.Request()),
+			codec: websocket.JSON,
+		}
+		if acceptsMsgpack(ws.Request()) {
+			w.codec = msgpackCodec
+		}
+		w.mobile = requestProfile(ws.Request()) == profileMobile
+		waiters[ws] = w
+		now := time.Now()
+		for token, s := range sessions {
+			if s.ws == nil && now.Sub(s.left) > sessionTTL {
+				delete(sessions, token)
+			}
+		}
+		q := ws.Request().URL.Query()
+		token := q.Get("resume")
+		since, err := strconv.ParseUint(q.Get("since"), 10, 64)
+		sess := sessions[token]
+		// Events can only be replayed to the same user and since a
+		// sequence number of this server's.
+		replay := sess != nil && sess.user == w.user && err == nil && since <= seq
+		if !replay {
+			token = newSessionToken()
+			sess = &wsSession{user: w.user}
+			sessions[token] = sess
+		}
+		if old := waiters[sess.ws]; old != nil {
+			// The client reconnected before its old connection was
+			// found closed.
+			close(old.done)
+			delete(waiters, sess.ws)
+		}
+		sess.ws = ws
+		send(ws, &waitData{
+			Type: waitSession,
+			Data: struct{ Token string }{token},
+			Seq:  seq,
+		})
 		inits := []waitType{
 			waitPlaylist,
 			waitProtocols,
@@ -61,23 +155,77 @@ func (srv *Server) commands() {
 			waitTracks,
 		}
 		for _, wt := range inits {
-			data := srv.makeWaitData(wt)
-			go func() {
-				if err := websocket.JSON.Send(ws, data); err != nil {
-					srv.ch <- cmdDeleteWS(ws)
-					return
+			// Each event has all data of its type, so only the
+			// last of each type missed is needed.
+			if replay && lastSeq[wt] <= since {
+				continue
+			}
+			wd := srv.makeWaitData(wt, w.user)
+			wd.Seq = lastSeq[wt]
+			send(ws, wd)
+		}
+		if replay {
+			for _, wd := range errs {
+				if wd.Seq > since {
+					send(ws, wd)
 				}
-			}()
+			}
 		}
 	}
 	deleteWS := func(c cmdDeleteWS) {
 		ws := (*websocket.Conn)(c)
-		ch := waiters[ws]
-		if ch == nil {
+		w := waiters[ws]
+		if w == nil {
 			return
 		}
-		close(ch)
+		close(w.done)
 		delete(waiters, ws)
+		for _, s := range sessions {
+			if s.ws == ws {
+				s.ws = nil
+				s.left = time.Now()
+			}
+		}
+	}
+	// emit fires the hooks and webhooks of an event of type t, and updates
+	// the Discord presence and amplifier relay.
+	emit := func(t string, song SongID, info *codec.SongInfo) {
+		e := Event{
+			Type:   t,
+			Time:   time.Now(),
+			State:  srv.state.String(),
+			Song:   song,
+			Info:   info,
+			Volume: srv.volume(),
+			Queue:  len(srv.Queue),
+		}
+		for _, h := range srv.Hooks {
+			if !h.fires(t) {
+				continue
+			}
+			select {
+			case srv.hooks <- hookRun{h, e}:
+			default:
+				log.Printf("hook %s: too many waiting, dropped", t)
+			}
+		}
+		srv.setPresence()
+		srv.setRelay()
+		for _, w := range srv.Webhooks {
+			if !(Hook{Events: w.Events}).fires(t) {
+				continue
+			}
+			select {
+			case srv.webhooks <- webhookDelivery{w, e}:
+			default:
+				log.Printf("webhook %s: too many waiting, dropped", w.URL)
+			}
+		}
+	}
+	// emitSong fires the hooks of an event about the current song.
+	emitSong := func(t string) {
+		info := srv.info
+		emit(t, srv.songID, &info)
 	}
 	prev = func() {
 		log.Println("prev")
@@ -88,7 +236,9 @@ func (srv *Server) commands() {
 		if srv.PlaylistIndex < 0 {
 			srv.PlaylistIndex = 0
 		}
-		next()
+		// Not next, since going back doesn't consume the song.
+		stop()
+		play()
 	}
 	pause = func() {
 		log.Println("pause")
@@ -97,22 +247,54 @@ func (srv *Server) commands() {
 			log.Println("pause: resume")
 			srv.audioch <- audioPlay{}
 			srv.state = statePlay
+			if srv.song != nil {
+				emitSong(EventResume)
+			}
 		case statePlay:
 			log.Println("pause: pause")
+			if srv.rememberPosition() {
+				positionChanged = true
+			}
 			srv.audioch <- audioStop{}
 			srv.state = statePause
+			emitSong(EventPause)
 		}
 	}
 	next = func() {
 		log.Println("next")
+		played := -1
+		if srv.song != nil {
+			played = srv.PlaylistIndex
+		}
 		stop()
+		if srv.cleanQueue(played) {
+			broadcast(waitPlaylist)
+			emit(EventQueue, "", nil)
+		}
 		play()
 	}
 	var forceNext = false
+	// ahead decodes the playing song if it was opened ahead of its turn.
+	var ahead *prefetch
 	stop = func() {
 		log.Println("stop")
+		if ahead != nil {
+			ahead.close()
+			ahead = nil
+		}
+		if srv.rememberPosition() {
+			positionChanged = true
+		}
 		srv.state = stateStop
 		srv.audioch <- audioStop{}
+		if srv.song != nil {
+			emitSong(EventTrackStop)
+			if srv.VerifyPlayback {
+				if err := srv.verifyPlayed(); err != nil {
+					broadcastErr(err)
+				}
+			}
+		}
 		if srv.song != nil || forceNext {
 			if srv.Random && len(srv.Queue) > 1 {
 				n := srv.PlaylistIndex
@@ -125,8 +307,38 @@ func (srv *Server) commands() {
 			}
 		}
 		forceNext = false
+		srv.loop = nil
 		srv.song = nil
 		srv.elapsed = 0
+		srv.playing.stop()
+	}
+	// analyze queues id for analysis if it hasn't been analyzed. Songs
+	// not on the local machine must have a duration, since streams, like
+	// internet radio, never end.
+	analyze := func(id SongID, first bool) error {
+		if _, ok := srv.analysis[id]; ok {
+			return nil
+		}
+		info, _ := srv.getSong(id)
+		if info == nil {
+			return fmt.Errorf("unknown song: %v", id)
+		}
+		if info.Time <= 0 && !localProtocols[id.Protocol()] {
+			return errUnknownLength
+		}
+		srv.analyses.add(id, first)
+		return nil
+	}
+	// measure queues id to have its duration found if its info lacks it.
+	// Only local songs are measured, since it reads the entire song.
+	measure := func(id SongID, info *codec.SongInfo, first bool) {
+		if info == nil || info.Time > 0 || !localProtocols[id.Protocol()] {
+			return
+		}
+		if _, ok := srv.durations[id]; ok {
+			return
+		}
+		srv.measures.add(id, first)
 	}
 	var inst protocol.Instance
 	var sid SongID
@@ -135,6 +347,58 @@ func (srv *Server) commands() {
 			srv.ch <- cmdNext
 		}()
 	}
+	// probeNext probes the song after the current one, if its protocol
+	// can, and opens it ahead of its turn, once per song.
+	probeNext := func() {
+		id, ok := srv.nextSong()
+		if !ok || id == srv.probed || id == srv.songID {
+			return
+		}
+		srv.probed = id
+		inst := srv.Protocols[id.Protocol()][id.Key()]
+		if inst == nil {
+			return
+		}
+		if p, ok := inst.(protocol.Prober); ok {
+			go srv.probe(p, id)
+		}
+		go srv.preopen(inst, id, srv.preroll())
+		if srv.Announce.Enabled {
+			if info, err := srv.getSong(id); err == nil {
+				go srv.announce(srv.Announce, id, *srv.songInfo(id, info))
+			}
+		}
+	}
+	// failed handles the current song failing to open or decode with
+	// problem, one of the library health report's: the song is reported
+	// there and to clients, and skipped unless StopOnError is set.
+	failed := func(problem string, err error) {
+		log.Printf("%v: %s: %v", srv.songID, problem, err)
+		info := srv.info
+		srv.health.found(HealthIssue{
+			ID:      srv.songID,
+			Info:    &info,
+			Problem: problem,
+			Error:   err.Error(),
+		})
+		if srv.song != nil {
+			codec.ReportError(srv.song, err)
+		}
+		title := info.Title
+		if title == "" {
+			title = string(srv.songID)
+		}
+		broadcastSongErr(srv.songID, fmt.Errorf("%s: %v", title, err))
+		if srv.StopOnError {
+			stop()
+			return
+		}
+		if srv.song == nil {
+			// Not opened, so stop wouldn't otherwise advance past it.
+			forceNext = true
+		}
+		sendNext()
+	}
 	nextOpen := time.After(0)
 	tick = func() {
 		const expected = 4096
@@ -146,9 +410,18 @@ func (srv *Server) commands() {
 			<-nextOpen
 			nextOpen = time.After(time.Second / 2)
 			defer broadcast(waitStatus)
+			if srv.Radio && srv.PlaylistIndex >= len(srv.Queue) && (len(srv.Queue) == 0 || !srv.Repeat) {
+				if id, ok := srv.radioNext(); ok {
+					log.Println("radio", id)
+					srv.Queue = append(srv.Queue, id)
+					srv.PlaylistIndex = len(srv.Queue) - 1
+					broadcast(waitPlaylist)
+				}
+			}
 			if len(srv.Queue) == 0 {
 				log.Println("empty queue")
 				stop()
+				srv.audioch <- audioEnd{}
 				return
 			}
 			if srv.PlaylistIndex >= len(srv.Queue) {
@@ -157,43 +430,103 @@ func (srv *Server) commands() {
 				} else {
 					log.Println("end of queue", srv.PlaylistIndex, len(srv.Queue))
 					stop()
+					srv.audioch <- audioEnd{}
 					return
 				}
 			}
 
 			srv.songID = srv.Queue[srv.PlaylistIndex]
 			sid = srv.songID
-			if info, err := srv.getSong(sid); err != nil {
-				broadcastErr(err)
+			if err, ok := srv.unreachable[sid]; ok {
+				delete(srv.unreachable, sid)
+				log.Printf("skipping unreachable %v: %v", sid, err)
 				forceNext = true
 				sendNext()
 				return
-			} else {
-				srv.info = *info
 			}
-			inst = srv.Protocols[sid.Protocol()][sid.Key()]
-			song, err := inst.GetSong(sid.ID())
-			if err != nil {
+			if srv.blocked(sid) {
+				log.Printf("skipping %v blocked by parental filter", sid)
 				forceNext = true
-				broadcastErr(err)
 				sendNext()
 				return
 			}
-			srv.song = song
-			sr, ch, err := srv.song.Init()
-			if err != nil {
-				srv.song.Close()
-				srv.song = nil
+			if info, err := srv.getSong(sid); err != nil {
 				broadcastErr(err)
+				forceNext = true
 				sendNext()
 				return
+			} else {
+				srv.info = *info
+			}
+			inst = srv.Protocols[sid.Protocol()][sid.Key()]
+			pre := srv.preopened
+			srv.preopened = nil
+			if pre != nil && pre.id != sid {
+				pre.close()
+				pre = nil
+			}
+			var sr, ch int
+			var play func(int) ([]float32, error)
+			if pre != nil {
+				log.Println("opened ahead", sid)
+				srv.playing.adopt(pre.cancel)
+				srv.song = pre.song
+				sr, ch = pre.sr, pre.ch
+				ahead = pre.pf
+				play = ahead.Play
+			} else {
+				song, err := protocol.GetSong(srv.playing.start(srv.ctx), inst, sid.ID())
+				if err != nil {
+					srv.playing.stop()
+					failed(openProblem(err), err)
+					return
+				}
+				srv.song = song
+				sr, ch, err = srv.song.Init()
+				srv.playing.opened()
+				if err != nil {
+					srv.playing.stop()
+					codec.ReportError(srv.song, err)
+					srv.song.Close()
+					srv.song = nil
+					failed(openProblem(err), err)
+					return
+				}
+				play = srv.song.Play
+			}
+			if an := srv.announcement; an != nil {
+				srv.announcement = nil
+				if an.id == sid && srv.Announce.Enabled {
+					play = an.mix(play, sr, ch, srv.Announce.Duck)
+				}
+			}
+			var bits int
+			if d, ok := srv.song.(codec.BitDepther); ok {
+				bits = d.BitDepth()
 			}
 			params := audioSetParams{
 				sr:   sr,
 				ch:   ch,
+				bits: bits,
 				dur:  srv.info.Time,
-				play: srv.song.Play,
-				err:  make(chan error),
+				play: play,
+				dsp:  srv.dspConfig(),
+				levels: LevelReport{
+					Song:  sid,
+					Title: srv.info.Title,
+					Gain:  srv.gainDB(&srv.info),
+					EQ:    srv.EQ.Enabled,
+				},
+				err: make(chan error),
+			}
+			if sk, ok := srv.song.(codec.Seeker); ok {
+				params.seek = sk.Seek
+				if pre != nil {
+					pf := pre.pf
+					params.seek = func(d time.Duration) (time.Duration, error) {
+						return pf.seek(sk.Seek, d)
+					}
+				}
 			}
 			srv.audioch <- params
 			if err := <-params.err; err != nil {
@@ -202,8 +535,27 @@ func (srv *Server) commands() {
 				return
 			}
 			srv.elapsed = 0
+			srv.sampleRate = sr
+			srv.skipVotes = nil
 			log.Println("playing", srv.info.Title, sr, ch)
 			srv.state = statePlay
+			srv.addHistory(sid)
+			emitSong(EventTrackStart)
+			if pc, ok := inst.(protocol.PlayCounter); ok {
+				go func(id codec.ID) {
+					if err := pc.Played(id); err != nil {
+						log.Printf("played %v: %v", sid, err)
+					}
+				}(sid.ID())
+			}
+			if err := analyze(sid, true); err != nil && err != errUnknownLength {
+				log.Printf("analyze %v: %v", sid, err)
+			}
+			measure(sid, &srv.info, true)
+			if pos := srv.resumePosition(sid, &srv.info); pos > 0 {
+				log.Println("resuming at", pos)
+				srv.audioch <- cmdSeek(pos)
+			}
 		}
 	}
 	infoTimer := func() {
@@ -212,9 +564,21 @@ func (srv *Server) commands() {
 			return
 		}
 		// Check for updated song info.
-		if info, err := inst.Info(sid.ID()); err != nil {
+		info, err := inst.Info(sid.ID())
+		if err != nil {
 			broadcastErr(err)
-		} else if srv.info != *info {
+			return
+		}
+		info = srv.songInfo(sid, info)
+		// Streams like chained Ogg name their songs in the audio.
+		if st, ok := srv.song.(codec.StreamTitler); ok {
+			if t := st.StreamTitle(); t != "" && t != info.SongTitle {
+				i := *info
+				i.SongTitle = t
+				info = &i
+			}
+		}
+		if srv.info != *info {
 			srv.info = *info
 			broadcast(waitStatus)
 		}
@@ -238,67 +602,210 @@ func (srv *Server) commands() {
 		srv.PlaylistIndex = int(c)
 		play()
 	}
+	// album returns the songs of the album of t in disc and track order.
+	album := func(t SongID) (Playlist, error) {
+		items, err := srv.instanceItems(t)
+		if err != nil {
+			return nil, err
+		}
+		var key string
+		for _, it := range items {
+			if it.ID == t {
+				key = albumKey(it)
+			}
+		}
+		var songs []listItem
+		for _, it := range items {
+			if it.ID == t || key != "" && albumKey(it) == key {
+				songs = append(songs, it)
+			}
+		}
+		sortTracks(songs)
+		var pl Playlist
+		for _, it := range songs {
+			pl = append(pl, it.ID)
+		}
+		return pl, nil
+	}
+	// work returns the movements of the work of t in order, or its album if
+	// it isn't a movement.
+	work := func(t SongID) (Playlist, error) {
+		items, err := srv.instanceItems(t)
+		if err != nil {
+			return nil, err
+		}
+		var key string
+		for _, it := range items {
+			if it.ID == t {
+				key = workKey(it)
+			}
+		}
+		if key == "" {
+			return album(t)
+		}
+		var songs []listItem
+		for _, it := range items {
+			if workKey(it) == key {
+				songs = append(songs, it)
+			}
+		}
+		sortMovements(songs)
+		var pl Playlist
+		for _, it := range songs {
+			pl = append(pl, it.ID)
+		}
+		return pl, nil
+	}
+	// exportList sends the songs of the album or playlist of c.
+	exportList := func(c cmdExportList) {
+		var l exportList
+		switch {
+		case c.album != "":
+			info, err := srv.getSong(c.album)
+			if err != nil {
+				l.err = err
+				break
+			}
+			l.name = info.Album
+			if info.Artist != "" {
+				l.name = info.Artist + " - " + info.Album
+			}
+			l.songs, l.err = album(c.album)
+		case c.playlist != "":
+			playlists := srv.playlists(c.user)
+			p, ok := playlists[c.playlist]
+			if !ok {
+				l.err = fmt.Errorf("unknown playlist: %s", c.playlist)
+				break
+			}
+			l.name = c.playlist
+			l.songs = append(Playlist(nil), p...)
+		default:
+			l.err = fmt.Errorf("album or playlist required")
+		}
+		c.done <- l
+	}
+	// playFrom replaces the queue with p and plays its song at idx.
+	playFrom := func(p Playlist, idx int) {
+		stop()
+		srv.Queue = p
+		srv.PlaylistIndex = idx
+		play()
+		emit(EventQueue, "", nil)
+		broadcast(waitPlaylist)
+	}
+	// unblocked returns the songs of p the parental filter doesn't block.
+	unblocked := func(p Playlist) Playlist {
+		if !srv.Parental.Enabled {
+			return p
+		}
+		var n Playlist
+		for _, id := range p {
+			if !srv.blocked(id) {
+				n = append(n, id)
+			}
+		}
+		return n
+	}
 	playTrack := func(c cmdPlayTrack) {
 		t := SongID(c)
-		info, err := srv.getSong(t)
-		if err != nil {
-			broadcastErr(err)
+		if srv.blocked(t) {
+			broadcastErr(errBlocked(t))
 			return
 		}
-		album := info.Album
-		p, err := srv.getInstance(t.Protocol(), t.Key())
+		n, err := album(t)
 		if err != nil {
 			broadcastErr(err)
 			return
 		}
-		list, err := p.List()
-		if err != nil {
-			broadcastErr(err)
+		n = unblocked(n)
+		idx := 0
+		for i, s := range n {
+			if s == t {
+				idx = i
+			}
+		}
+		playFrom(n, idx)
+	}
+	playPlaylist := func(c cmdPlayPlaylist) {
+		p := srv.playlists(c.user)[c.name]
+		if len(p) == 0 {
+			broadcastErr(fmt.Errorf("unknown playlist: %v", c.name))
+			return
+		}
+		if c.idx < 0 || c.idx >= len(p) {
+			broadcastErr(fmt.Errorf("unknown index: %v", c.idx))
 			return
 		}
-		top := codec.NewID(t.Protocol(), t.Key())
-		var ids []codec.ID
-		for id, si := range list {
-			if si.Album == album {
-				ids = append(ids, id)
+		playFrom(append(Playlist(nil), p...), c.idx)
+	}
+	// insertNext inserts songs after the current song, or before the song
+	// play starts with if stopped.
+	insertNext := func(c cmdInsertNext) {
+		for _, id := range c {
+			if !srv.hasSong(id) {
+				broadcastErr(fmt.Errorf("unknown song: %v", id))
+				return
+			}
+			if srv.blocked(id) {
+				broadcastErr(errBlocked(id))
+				return
 			}
 		}
-		slice.Sort(ids, func(i, j int) bool {
-			a := list[ids[i]]
-			b := list[ids[j]]
-			return a.Track < b.Track
-		})
-		plc := PlaylistChange{[]string{"clear"}}
-		for _, v := range ids {
-			plc = append(plc, []string{"add", string(top.Push(string(v)))})
+		i := srv.PlaylistIndex
+		if srv.song != nil {
+			i++
 		}
-		n, _, err := srv.playlistChange(srv.Queue, plc)
+		if i > len(srv.Queue) {
+			i = len(srv.Queue)
+		}
+		n := make(Playlist, 0, len(srv.Queue)+len(c))
+		n = append(n, srv.Queue[:i]...)
+		n = append(n, c...)
+		srv.Queue = append(n, srv.Queue[i:]...)
+		emit(EventQueue, "", nil)
+		broadcast(waitPlaylist)
+	}
+	appendAlbum := func(c cmdAppendAlbum) {
+		n, err := album(SongID(c))
 		if err != nil {
 			broadcastErr(err)
 			return
 		}
-		stop()
-		srv.Queue = n
-		srv.PlaylistIndex = 0
-		for i, s := range srv.Queue {
-			if s == t {
-				srv.PlaylistIndex = i
-			}
+		srv.Queue = append(srv.Queue, unblocked(n)...)
+		emit(EventQueue, "", nil)
+		broadcast(waitPlaylist)
+	}
+	appendWork := func(c cmdAppendWork) {
+		n, err := work(SongID(c))
+		if err != nil {
+			broadcastErr(err)
+			return
 		}
-		play()
+		srv.Queue = append(srv.Queue, unblocked(n)...)
+		emit(EventQueue, "", nil)
 		broadcast(waitPlaylist)
 	}
-	removeDeleted := func() {
-		for n, p := range srv.Playlists {
-			p = srv.removeDeleted(p)
-			if len(p) == 0 {
-				delete(srv.Playlists, n)
-			} else {
-				srv.Playlists[n] = p
+	removeDeleted := func(c cmdRemoveDeleted) {
+		if c.prev != nil {
+			srv.remapSongs(c.protocol, c.key, c.prev)
+		}
+		removePlaylists := func(playlists map[string]Playlist) {
+			for n, p := range playlists {
+				p = srv.removeDeleted(p)
+				if len(p) == 0 {
+					delete(playlists, n)
+				} else {
+					playlists[n] = p
+				}
 			}
 		}
-		srv.Queue = srv.removeDeleted(srv.Queue)
-		if info, _ := srv.getSong(srv.songID); info == nil {
+		removePlaylists(srv.Playlists)
+		for _, u := range srv.Users {
+			removePlaylists(u.Playlists)
+		}
+		srv.Queue = srv.removeVanished(srv.removeDeleted(srv.Queue))
+		if info, _ := srv.getSong(srv.songID); info == nil || srv.Hidden[srv.songID] {
 			playing := srv.state == statePlay
 			stop()
 			if playing {
@@ -314,6 +821,10 @@ func (srv *Server) commands() {
 			return
 		}
 		delete(prots, c.key)
+		srv.changedInstance(c.protocol, c.key)
+		delete(srv.RefreshInterval[c.protocol], c.key)
+		delete(srv.nextRefresh, codec.NewID(c.protocol, c.key))
+		delete(srv.connections, codec.NewID(c.protocol, c.key))
 		if srv.Token != "" {
 			d := models.Delete{
 				Protocol: c.protocol,
@@ -334,6 +845,21 @@ func (srv *Server) commands() {
 	removeInProgress := func(c cmdRemoveInProgress) {
 		delete(srv.inprogress, codec.ID(c))
 		broadcast(waitProtocols)
+		name, key := codec.ID(c).Pop()
+		srv.changedInstance(name, string(key))
+		if !localProtocols[name] {
+			return
+		}
+		inst, err := srv.getInstance(name, string(key))
+		if err != nil {
+			return
+		}
+		songs, _ := inst.List()
+		for id, info := range songs {
+			sid := SongID(codec.NewID(name, string(key), string(id)))
+			analyze(sid, false)
+			measure(sid, info, false)
+		}
 	}
 	protocolAdd := func(c cmdProtocolAdd) {
 		name, key := c.Name, c.Instance.Key()
@@ -367,6 +893,14 @@ func (srv *Server) commands() {
 	}
 	protocolAddInstance := func(c cmdProtocolAddInstance) {
 		srv.Protocols[c.Name][c.Instance.Key()] = c.Instance
+		srv.changedInstance(c.Name, c.Instance.Key())
+		if _, ok := c.Instance.(protocol.Connector); ok {
+			// New instances connected when created.
+			srv.connections[codec.NewID(c.Name, c.Instance.Key())] = Connection{
+				State: connConnected,
+				Since: time.Now(),
+			}
+		}
 		if srv.Token != "" {
 			srv.ch <- cmdPutSource{
 				protocol: c.Name,
@@ -377,6 +911,12 @@ func (srv *Server) commands() {
 		broadcast(waitProtocols)
 	}
 	queueChange := func(c cmdQueueChange) {
+		for _, ch := range c {
+			if len(ch) > 1 && ch[0] == "add" && srv.blocked(SongID(ch[1])) {
+				broadcastErr(errBlocked(SongID(ch[1])))
+				return
+			}
+		}
 		n, clear, err := srv.playlistChange(srv.Queue, PlaylistChange(c))
 		if err != nil {
 			broadcastErr(err)
@@ -387,19 +927,25 @@ func (srv *Server) commands() {
 			stop()
 			srv.PlaylistIndex = 0
 		}
+		emit(EventQueue, "", nil)
 		broadcast(waitPlaylist)
 	}
 	playlistChange := func(c cmdPlaylistChange) {
-		p := srv.Playlists[c.name]
+		playlists := srv.playlists(c.user)
+		if playlists == nil {
+			broadcastErr(fmt.Errorf("unknown user: %v", c.user))
+			return
+		}
+		p := playlists[c.name]
 		n, _, err := srv.playlistChange(p, c.plc)
 		if err != nil {
 			broadcastErr(err)
 			return
 		}
 		if len(n) == 0 {
-			delete(srv.Playlists, c.name)
+			delete(playlists, c.name)
 		} else {
-			srv.Playlists[c.name] = n
+			playlists[c.name] = n
 		}
 		broadcast(waitPlaylist)
 	}
@@ -424,7 +970,7 @@ func (srv *Server) commands() {
 				c.done <- err
 				return
 			}
-			t, err := prot.OAuth.Exchange(oauth2.NoContext, c.r.FormValue("code"))
+			t, err := prot.Exchange(oauth2.NoContext, c.r.FormValue("code"))
 			if err != nil {
 				c.done <- err
 				return
@@ -447,12 +993,121 @@ func (srv *Server) commands() {
 	setMinDuration := func(c cmdMinDuration) {
 		srv.MinDuration = time.Duration(c)
 	}
+	setPosition := func(c cmdSetPosition) {
+		if srv.Positions == nil {
+			srv.Positions = make(map[SongID]time.Duration)
+		}
+		srv.Positions[c.id] = c.pos
+	}
+	clearPositions := func(c cmdClearPositions) {
+		if c == "" {
+			srv.Positions = nil
+		} else {
+			delete(srv.Positions, SongID(c))
+		}
+	}
 	doSeek := func(c cmdSeek) {
 		if time.Duration(c) > srv.info.Time {
 			return
 		}
 		srv.audioch <- c
 	}
+	setLoop := func(c cmdLoop) {
+		if l := c.loop; l != nil {
+			if srv.song == nil {
+				broadcastErr(fmt.Errorf("not playing"))
+				return
+			}
+			if l.B > srv.info.Time {
+				broadcastErr(fmt.Errorf("loop past end of song: %v", l.B))
+				return
+			}
+			// Start at A unless already in the loop.
+			if srv.elapsed < l.A || srv.elapsed >= l.B {
+				srv.audioch <- cmdSeek(l.A)
+			}
+		}
+		srv.loop = c.loop
+		srv.audioch <- audioLoop(c)
+		broadcast(waitStatus)
+	}
+	addBookmark := func(c cmdAddBookmark) error {
+		bookmarks := srv.bookmarks(c.user)
+		if bookmarks == nil {
+			return fmt.Errorf("unknown user: %v", c.user)
+		}
+		if c.id == "" {
+			c.id = srv.songID
+		}
+		if c.b.Position < 0 {
+			if srv.song == nil || c.id != srv.songID {
+				return fmt.Errorf("not playing: %v", c.id)
+			}
+			c.b.Position = srv.elapsed
+		}
+		if !srv.hasSong(c.id) {
+			return fmt.Errorf("unknown song: %v", c.id)
+		}
+		bs := []Bookmark{c.b}
+		for _, b := range bookmarks[c.id] {
+			if b.Name != c.b.Name {
+				bs = append(bs, b)
+			}
+		}
+		sort.Slice(bs, func(i, j int) bool {
+			return bs[i].Position < bs[j].Position
+		})
+		bookmarks[c.id] = bs
+		return nil
+	}
+	removeBookmark := func(c cmdRemoveBookmark) {
+		bookmarks := srv.bookmarks(c.user)
+		var bs []Bookmark
+		for _, b := range bookmarks[c.id] {
+			if b.Name != c.name {
+				bs = append(bs, b)
+			}
+		}
+		if len(bs) == 0 {
+			delete(bookmarks, c.id)
+		} else {
+			bookmarks[c.id] = bs
+		}
+	}
+	jumpBookmark := func(c cmdJumpBookmark) error {
+		var pos time.Duration
+		found := false
+		for _, b := range srv.bookmarks(c.user)[c.id] {
+			if b.Name == c.name {
+				pos, found = b.Position, true
+			}
+		}
+		if !found {
+			return fmt.Errorf("unknown bookmark: %v", c.name)
+		}
+		if srv.song == nil || srv.songID != c.id {
+			idx := -1
+			for i, id := range srv.Queue {
+				if id == c.id {
+					idx = i
+					break
+				}
+			}
+			if idx < 0 {
+				insertNext(cmdInsertNext{c.id})
+				idx = srv.PlaylistIndex
+				if srv.song != nil {
+					idx++
+				}
+			}
+			playIdx(cmdPlayIdx(idx))
+		}
+		if srv.song == nil {
+			return fmt.Errorf("could not play: %v", c.id)
+		}
+		doSeek(cmdSeek(pos))
+		return nil
+	}
 	setUsername := func(c cmdSetUsername) {
 		srv.Username = string(c)
 	}
@@ -588,7 +1243,7 @@ func (srv *Server) commands() {
 		}()
 	}
 	sendWaitData := func(c cmdWaitData) {
-		c.done <- srv.makeWaitData(c.wt)
+		c.done <- srv.makeWaitData(c.wt, c.user)
 	}
 	protocolRefresh := func(c cmdProtocolRefresh) {
 		id := codec.NewID(c.protocol, c.key)
@@ -608,8 +1263,17 @@ func (srv *Server) commands() {
 				srv.ch <- cmdRemoveInProgress(id)
 			}()
 			f := inst.Refresh
+			var prev protocol.SongList
 			if c.list {
 				f = inst.List
+			} else if c.doDelete {
+				// Keep the songs listed before the refresh to
+				// find those moved.
+				songs, _ := inst.List()
+				prev = make(protocol.SongList, len(songs))
+				for k, v := range songs {
+					prev[k] = v
+				}
 			}
 			songs, err := f()
 			if err != nil {
@@ -622,7 +1286,11 @@ func (srv *Server) commands() {
 				}
 			}
 			if c.doDelete {
-				srv.ch <- cmdRemoveDeleted{}
+				srv.ch <- cmdRemoveDeleted{
+					protocol: c.protocol,
+					key:      c.key,
+					prev:     prev,
+				}
 			}
 			if srv.Token != "" {
 				srv.ch <- cmdPutSource{
@@ -633,24 +1301,832 @@ func (srv *Server) commands() {
 			c.err <- nil
 		}()
 	}
-	ch := make(chan interface{})
-	go func() {
-		for c := range srv.ch {
-			go func(c interface{}) {
-				timer := time.AfterFunc(time.Second*10, func() {
-					log.Printf("%T: %#v\n", c, c)
-					panic("delay timer expired")
-				})
-				ch <- c
-				timer.Stop()
-			}(c)
-		}
+	// scheduleRefresh sets the next automatic refresh of an instance.
+	scheduleRefresh := func(name, key string) {
+		id := codec.NewID(name, key)
+		if d := srv.RefreshInterval[name][key]; d > 0 {
+			srv.nextRefresh[id] = time.Now().Add(jitter(d))
+		} else {
+			delete(srv.nextRefresh, id)
+		}
+	}
+	setRefresh := func(c cmdSetRefresh) {
+		if _, err := srv.getInstance(c.protocol, c.key); err != nil {
+			c.err <- err
+			return
+		}
+		if srv.RefreshInterval == nil {
+			srv.RefreshInterval = make(map[string]map[string]time.Duration)
+		}
+		if srv.RefreshInterval[c.protocol] == nil {
+			srv.RefreshInterval[c.protocol] = make(map[string]time.Duration)
+		}
+		if c.interval == 0 {
+			delete(srv.RefreshInterval[c.protocol], c.key)
+		} else {
+			srv.RefreshInterval[c.protocol][c.key] = c.interval
+		}
+		scheduleRefresh(c.protocol, c.key)
+		c.err <- nil
+		broadcast(waitProtocols)
+	}
+	setPauseMeteredRefresh := func(c cmdPauseMeteredRefresh) {
+		srv.PauseMeteredRefresh = bool(c)
+		broadcast(waitProtocols)
+	}
+	autoRefresh := func() {
+		now := time.Now()
+		// Only check for a metered connection if needed.
+		checked, isMetered := false, false
+		for id, t := range srv.nextRefresh {
+			if now.Before(t) {
+				continue
+			}
+			name, key := id.Pop()
+			if srv.PauseMeteredRefresh && srv.state == statePlay && !localProtocols[name] {
+				if !checked {
+					checked, isMetered = true, metered()
+				}
+				if isMetered {
+					continue
+				}
+			}
+			log.Println("auto refresh", name, key)
+			scheduleRefresh(name, string(key))
+			ch := make(chan error, 1)
+			protocolRefresh(cmdProtocolRefresh{
+				protocol: name,
+				key:      string(key),
+				doDelete: true,
+				err:      ch,
+			})
+			go func() {
+				if err := <-ch; err != nil {
+					srv.ch <- cmdError(err)
+				}
+			}()
+		}
+	}
+	for name, keys := range srv.RefreshInterval {
+		for key := range keys {
+			scheduleRefresh(name, key)
+		}
+	}
+	// connect connects inst to its service in the background, if it needs
+	// one, so a service that is down doesn't hold up anything else.
+	connect := func(name, key string, inst protocol.Instance) {
+		c, ok := inst.(protocol.Connector)
+		if !ok {
+			return
+		}
+		id := codec.NewID(name, key)
+		srv.connections[id] = Connection{
+			State: connConnecting,
+			Since: time.Now(),
+		}
+		go func() {
+			srv.ch <- cmdConnected{
+				id:  id,
+				err: c.Connect(),
+			}
+		}()
+	}
+	connectAll := func() {
+		srv.connections = make(map[codec.ID]Connection)
+		for name, insts := range srv.Protocols {
+			for key, inst := range insts {
+				connect(name, key, inst)
+			}
+		}
+		broadcast(waitProtocols)
+	}
+	// reconnect retries instances that failed to connect.
+	reconnect := func() {
+		now := time.Now()
+		for id, c := range srv.connections {
+			if c.State != connFailed || now.Sub(c.Since) < reconnectInterval {
+				continue
+			}
+			name, key := id.Pop()
+			if inst, err := srv.getInstance(name, string(key)); err == nil {
+				connect(name, string(key), inst)
+				broadcast(waitProtocols)
+			}
+		}
+	}
+	connected := func(c cmdConnected) {
+		if _, ok := srv.connections[c.id]; !ok {
+			// Removed while connecting.
+			return
+		}
+		conn := Connection{
+			State: connConnected,
+			Since: time.Now(),
+		}
+		if c.err != nil {
+			log.Printf("connect %s: %v", c.id, c.err)
+			conn.State = connFailed
+			conn.Error = c.err.Error()
+		}
+		srv.connections[c.id] = conn
+		broadcast(waitProtocols)
+		name, key := c.id.Pop()
+		inst, err := srv.getInstance(name, string(key))
+		if c.err != nil || err != nil {
+			return
+		}
+		// Connecting may have refreshed credentials.
+		srv.changedInstance(name, string(key))
+		// Fetch the songs of instances that have none saved.
+		if songs, _ := inst.List(); len(songs) == 0 {
+			ch := make(chan error, 1)
+			protocolRefresh(cmdProtocolRefresh{
+				protocol: name,
+				key:      string(key),
+				err:      ch,
+			})
+			go func() {
+				if err := <-ch; err != nil {
+					srv.ch <- cmdError(err)
+				}
+			}()
+		}
+	}
+	connectAll()
+	setDSP := func() {
+		srv.audioch <- audioDSP(srv.dspConfig())
+	}
+	setVolume := func(c cmdDeviceVolume) {
+		if srv.Volumes == nil {
+			srv.Volumes = make(map[string]float64)
+		}
+		srv.Volumes[c.device] = c.volume
+		if c.device == srv.Device {
+			setDSP()
+			emit(EventVolume, "", nil)
+		}
+	}
+	setPreamp := func(c cmdPreamp) {
+		srv.Preamp = float64(c)
+		setDSP()
+	}
+	setReplayGain := func(c cmdReplayGain) {
+		srv.ReplayGain = string(c)
+		setDSP()
+	}
+	setEQ := func(c cmdSetEQ) {
+		srv.EQ = dsp.EQ(c)
+		setDSP()
+		broadcast(waitEQ)
+	}
+	setSpeed := func(c cmdSpeed) {
+		srv.Speed = float64(c)
+		setDSP()
+	}
+	setPitch := func(c cmdPitch) {
+		srv.Pitch = float64(c)
+		setDSP()
+	}
+	setCrossfade := func(c cmdCrossfade) {
+		srv.Crossfade = time.Duration(c)
+		setDSP()
+	}
+	setPreroll := func(c cmdPreroll) {
+		srv.Preroll = time.Duration(c)
+		setDSP()
+	}
+	setOutputRate := func(c cmdOutputRate) {
+		srv.OutputRate = int(c)
+		setDSP()
+	}
+	setResampler := func(c cmdResampler) {
+		srv.Resampler = dsp.Quality(c)
+		setDSP()
+	}
+	setDevice := func(c cmdDevice) {
+		v := srv.volume()
+		srv.Device = string(c)
+		setDSP()
+		if srv.volume() != v {
+			emit(EventVolume, "", nil)
+		}
+	}
+	setBackend := func(c cmdBackend) {
+		srv.Backend = string(c)
+		// Device names are specific to a backend.
+		srv.Device = ""
+		setDSP()
+	}
+	setPlugins := func(c cmdSetPlugins) {
+		if srv.Plugins == nil {
+			srv.Plugins = make(map[string][]dsp.Plugin)
+		}
+		if len(c.Chain) == 0 {
+			delete(srv.Plugins, c.Device)
+		} else {
+			srv.Plugins[c.Device] = c.Chain
+		}
+		setDSP()
+	}
+	setHooks := func(c cmdSetHooks) {
+		srv.Hooks = []Hook(c)
+	}
+	getWebhooks := func(c cmdGetWebhooks) {
+		var infos []webhookInfo
+		for _, w := range srv.Webhooks {
+			infos = append(infos, w.info())
+		}
+		sort.Slice(infos, func(i, j int) bool {
+			return infos[i].URL < infos[j].URL
+		})
+		c <- infos
+	}
+	setWebhook := func(c cmdSetWebhook) {
+		w := c.hook
+		if c.update {
+			old, ok := srv.Webhooks[w.ID]
+			if !ok {
+				c.err <- fmt.Errorf("unknown webhook: %v", w.ID)
+				return
+			}
+			if w.Secret == "" {
+				w.Secret = old.Secret
+			}
+		}
+		if srv.Webhooks == nil {
+			srv.Webhooks = make(map[string]Webhook)
+		}
+		srv.Webhooks[w.ID] = w
+		c.err <- nil
+	}
+	removeWebhook := func(c cmdRemoveWebhook) {
+		if _, ok := srv.Webhooks[c.id]; !ok {
+			c.err <- fmt.Errorf("unknown webhook: %v", c.id)
+			return
+		}
+		delete(srv.Webhooks, c.id)
+		c.err <- nil
+	}
+	setStereo := func(c cmdSetStereo) {
+		if srv.Stereo == nil {
+			srv.Stereo = make(map[string]dsp.Stereo)
+		}
+		if !c.Stereo.Enabled() {
+			delete(srv.Stereo, c.Device)
+		} else {
+			srv.Stereo[c.Device] = c.Stereo
+		}
+		setDSP()
+	}
+	setLatency := func(c cmdLatency) {
+		if srv.Latency == nil {
+			srv.Latency = make(map[string]time.Duration)
+		}
+		srv.Latency[c.backend] = c.latency
+		setDSP()
+	}
+	waveform := func(c cmdWaveform) {
+		if _, ok := srv.analysis[c.id]; ok {
+			// Analyzed, but its waveform was evicted from the cache.
+			srv.analyses.add(c.id, true)
+			c.err <- nil
+			return
+		}
+		c.err <- analyze(c.id, true)
+	}
+	getSong := func(c cmdGetSong) {
+		if !srv.hasSong(c.id) {
+			c.done <- songResult{err: fmt.Errorf("unknown song: %v", c.id)}
+			return
+		}
+		ctx := c.ctx
+		if ctx == nil {
+			ctx = srv.ctx
+		}
+		song, err := protocol.GetSong(ctx, srv.Protocols[c.id.Protocol()][c.id.Key()], c.id.ID())
+		c.done <- songResult{song, err}
+	}
+	mergeDuplicates := func(c cmdMergeDuplicates) {
+		m := c.m
+		if c.all {
+			m = make(map[SongID]SongID)
+			for _, items := range srv.duplicates() {
+				for _, it := range items[1:] {
+					m[it.ID] = items[0].ID
+				}
+			}
+		}
+		err := srv.mergeDuplicates(m)
+		c.done <- err
+		if err == nil {
+			broadcast(waitTracks)
+			broadcast(waitPlaylist)
+		}
+	}
+	unhide := func(c cmdUnhide) {
+		for _, id := range c {
+			delete(srv.Hidden, id)
+		}
+		broadcast(waitTracks)
+	}
+	removeSongs := func(c cmdRemoveSongs) {
+		if srv.Hidden == nil {
+			srv.Hidden = make(map[SongID]bool)
+		}
+		for _, id := range c {
+			if srv.hasSong(id) {
+				srv.Hidden[id] = true
+			}
+		}
+		removeDeleted(cmdRemoveDeleted{})
+		broadcast(waitTracks)
+	}
+	relocate := func(c cmdRelocate) {
+		m := c.m
+		if c.all {
+			m = make(map[SongID]SongID)
+			for _, r := range srv.relocations() {
+				if len(r.Candidates) == 1 {
+					m[r.ID] = r.Candidates[0].ID
+				}
+			}
+			for old, n := range c.m {
+				m[old] = n
+			}
+		}
+		err := srv.relocate(m)
+		c.done <- relocateResult{m, err}
+		if err == nil {
+			broadcast(waitTracks)
+			broadcast(waitPlaylist)
+		}
+	}
+	dismissVanished := func(c cmdDismissVanished) {
+		for _, id := range c {
+			delete(srv.Vanished, id)
+		}
+		removeDeleted(cmdRemoveDeleted{})
+	}
+	refreshLibrary := func(dir string) {
+		within := func(path, root string) bool {
+			return path == root || strings.HasPrefix(path, root+string(filepath.Separator))
+		}
+		for key := range srv.Protocols["file"] {
+			if !within(dir, key) && !within(key, dir) {
+				continue
+			}
+			ch := make(chan error, 1)
+			protocolRefresh(cmdProtocolRefresh{
+				protocol: "file",
+				key:      key,
+				err:      ch,
+			})
+			go func() {
+				if err := <-ch; err != nil {
+					srv.ch <- cmdError(err)
+				}
+			}()
+		}
+	}
+	getSyncJobs := func(c cmdGetSyncJobs) {
+		var jobs []syncJob
+		for _, j := range srv.SyncJobs {
+			inst, _ := srv.Protocols[j.Protocol][j.Key].(protocol.Mirrorer)
+			jobs = append(jobs, syncJob{j, inst})
+		}
+		c <- jobs
+	}
+	setSyncJobs := func(c cmdSetSyncJobs) {
+		for _, j := range c.jobs {
+			inst, err := srv.getInstance(j.Protocol, j.Key)
+			if err != nil {
+				c.err <- err
+				return
+			}
+			if _, ok := inst.(protocol.Mirrorer); !ok {
+				c.err <- fmt.Errorf("%s sources can't be synced", j.Protocol)
+				return
+			}
+		}
+		srv.SyncJobs = c.jobs
+		c.err <- nil
+	}
+	// recorder returns the stream of id to record.
+	recorder := func(id SongID) (protocol.Recorder, error) {
+		inst, err := srv.getInstance(id.Protocol(), id.Key())
+		if err != nil {
+			return nil, err
+		}
+		rec, ok := inst.(protocol.Recorder)
+		if !ok {
+			return nil, fmt.Errorf("%s songs can't be recorded", id.Protocol())
+		}
+		return rec, nil
+	}
+	recordSource := func(c cmdRecordSource) {
+		src := recordSource{
+			id:  c.id,
+			dir: srv.Record.Dir,
+		}
+		if src.id == "" {
+			src.id = srv.songID
+		}
+		switch {
+		case src.dir == "":
+			src.err = fmt.Errorf("no recording directory")
+		case src.id == "":
+			src.err = fmt.Errorf("nothing playing")
+		default:
+			src.rec, src.err = recorder(src.id)
+		}
+		if src.err == nil {
+			src.station = string(src.id)
+			if info, err := srv.getSong(src.id); err == nil && info.Title != "" {
+				src.station = info.Title
+			}
+		}
+		c.ch <- src
+	}
+	setRecordings := func(c cmdSetRecordings) {
+		for _, s := range c.r.Schedule {
+			if _, err := recorder(s.ID); err != nil {
+				c.err <- fmt.Errorf("%s: %v", s.Name, err)
+				return
+			}
+		}
+		srv.Record = c.r
+		c.err <- nil
+	}
+	// synced adds the songs of dir, the local directory of a sync job, to
+	// the library: refreshing the file sources containing it, or adding
+	// one for it.
+	synced := func(dir string) {
+		for key := range srv.Protocols["file"] {
+			if dir == key || strings.HasPrefix(dir, key+string(filepath.Separator)) {
+				refreshLibrary(dir)
+				return
+			}
+		}
+		prot, err := protocol.ByName("file")
+		if err != nil {
+			broadcastErr(err)
+			return
+		}
+		inst, err := prot.NewInstance([]string{dir}, nil)
+		if err != nil {
+			broadcastErr(err)
+			return
+		}
+		protocolAdd(cmdProtocolAdd{
+			Name:     "file",
+			Instance: inst,
+		})
+	}
+	songAttributes := func(c cmdSongAttributes) {
+		inst, err := srv.getInstance(c.id.Protocol(), c.id.Key())
+		if err != nil {
+			c.done <- attributesResult{err: err}
+			return
+		}
+		attrs := map[string]string{}
+		if a, ok := inst.(protocol.Attributer); ok {
+			attrs = a.SongAttributes(c.id.ID())
+		}
+		c.done <- attributesResult{attrs: attrs}
+	}
+	importITunes := func(c cmdImportITunes) {
+		playlists := srv.playlists(c.user)
+		if playlists == nil {
+			playlists = make(map[string]Playlist)
+		}
+		c.done <- srv.importITunes(playlists, c.lib)
+		broadcast(waitPlaylist)
+	}
+	setRating := func(c cmdSetRating) {
+		if !srv.hasSong(c.id) {
+			c.err <- fmt.Errorf("unknown song: %v", c.id)
+			return
+		}
+		srv.songStats(c.id).Rating = c.rating
+		c.err <- nil
+	}
+	rendererPush := func(c cmdRendererPush) {
+		id, err := srv.rendererSong(c.uri, c.info)
+		if err != nil {
+			c.done <- rendererResult{err: err}
+			return
+		}
+		// Insert after the current song, or before the next if none is loaded.
+		i := srv.PlaylistIndex
+		if srv.song != nil {
+			i++
+		}
+		if i < 0 {
+			i = 0
+		} else if i > len(srv.Queue) {
+			i = len(srv.Queue)
+		}
+		q := make(Playlist, 0, len(srv.Queue)+1)
+		q = append(q, srv.Queue[:i]...)
+		q = append(q, id)
+		srv.Queue = append(q, srv.Queue[i:]...)
+		if !c.next {
+			playing := srv.state == statePlay
+			stop()
+			srv.PlaylistIndex = i
+			if playing {
+				play()
+			}
+		}
+		broadcast(waitPlaylist)
+		c.done <- rendererResult{id: id}
+	}
+	getHandoff := func(c cmdGetHandoff) {
+		h := Handoff{
+			Index: srv.PlaylistIndex,
+			Play:  srv.state == statePlay,
+		}
+		for _, item := range srv.playlistInfo(srv.Queue) {
+			h.Queue = append(h.Queue, HandoffSong{string(item.ID), item.Info})
+		}
+		if srv.song != nil {
+			h.Position = srv.elapsed - srv.bluetoothOffset()
+			if h.Position < 0 {
+				h.Position = 0
+			}
+		}
+		if h.Index >= len(h.Queue) {
+			h.Index = 0
+		}
+		c <- h
+	}
+	receiveHandoff := func(c cmdReceiveHandoff) {
+		q, i, pos, res := srv.receiveHandoff(c.h)
+		if len(q) > 0 {
+			stop()
+			srv.Queue = q
+			srv.PlaylistIndex = i
+			if c.h.Play {
+				play()
+				if pos > 0 {
+					doSeek(cmdSeek(pos))
+				}
+			}
+			broadcast(waitPlaylist)
+		}
+		c.done <- res
+	}
+	openFile := func(c cmdOpenFile) {
+		inst, err := srv.getInstance(c.id.Protocol(), c.id.Key())
+		if err != nil {
+			c.done <- fileResult{err: err}
+			return
+		}
+		fi, ok := inst.(protocol.FileInstance)
+		if !ok {
+			c.done <- fileResult{err: fmt.Errorf("%s songs are not files", c.id.Protocol())}
+			return
+		}
+		open := fi.SongFile
+		if c.art {
+			open = fi.ArtFile
+		}
+		f, err := open(c.id.ID())
+		c.done <- fileResult{f, err}
+	}
+	// openDownload sends the file of c.id. Remote files are read by the
+	// receiver.
+	openDownload := func(c cmdOpenDownload) {
+		inst, err := srv.getInstance(c.id.Protocol(), c.id.Key())
+		if err != nil {
+			c.done <- downloadResult{err: err}
+			return
+		}
+		switch inst := inst.(type) {
+		case protocol.FileInstance:
+			f, err := inst.SongFile(c.id.ID())
+			if err != nil {
+				c.done <- downloadResult{err: err}
+				return
+			}
+			c.done <- downloadResult{name: filepath.Base(f.Name()), f: f}
+		case protocol.Downloader:
+			name, r, err := inst.Download(c.ctx, c.id.ID())
+			c.done <- downloadResult{name: name, r: r, err: err}
+		default:
+			c.done <- downloadResult{err: fmt.Errorf("%s songs can't be downloaded", c.id.Protocol())}
+		}
+	}
+	analyzed := func(c cmdAnalyzed) {
+		if srv.analysis == nil {
+			srv.analysis = make(map[SongID]Analysis)
+		}
+		srv.analysis[c.id] = c.analysis
+		if c.id == srv.songID && srv.song != nil {
+			srv.info.BPM = c.analysis.BPM
+			srv.info.Key = c.analysis.Key
+			broadcast(waitStatus)
+		}
+	}
+	// tracksChanged sends clients the tracks after a while, so a library
+	// backfill doesn't resend them for every song.
+	tracksChanged := func() {
+		if srv.durationsPending {
+			return
+		}
+		srv.durationsPending = true
+		time.AfterFunc(durationBroadcast, func() {
+			srv.ch <- cmdBroadcastDurations{}
+		})
+	}
+	measured := func(c cmdMeasured) {
+		if srv.durations == nil {
+			srv.durations = make(map[SongID]time.Duration)
+		}
+		srv.durations[c.id] = c.duration
+		tracksChanged()
+	}
+	loudness := func(c cmdLoudness) {
+		if srv.loudness == nil {
+			srv.loudness = make(map[SongID]Loudness)
+		}
+		srv.loudness[c.id] = c.loudness
+		if c.id == srv.songID && srv.song != nil {
+			if info, err := srv.getSong(c.id); err == nil {
+				srv.info = *info
+				setDSP()
+				broadcast(waitStatus)
+			}
+		}
+		tracksChanged()
+	}
+	importPlaylist := func(c cmdImportPlaylist) {
+		playlists := srv.playlists(c.user)
+		if playlists == nil {
+			c.done <- importReport{Playlist: c.name}
+			return
+		}
+		c.done <- srv.importPlaylist(playlists, c.name, c.tracks)
+		broadcast(waitPlaylist)
+	}
+	recommend := func(c cmdRecommend) {
+		c.done <- srv.recommend(c.recs)
+	}
+	search := func(c cmdSearch) {
+		c.done <- srv.search(c.q, c.all)
+	}
+	partyAdd := func(c cmdPartyAdd) {
+		if !srv.hasSong(c.id) {
+			c.err <- fmt.Errorf("unknown song: %v", c.id)
+			return
+		}
+		if srv.blocked(c.id) {
+			c.err <- errBlocked(c.id)
+			return
+		}
+		srv.Queue = append(srv.Queue, c.id)
+		c.err <- nil
+		broadcast(waitPlaylist)
+	}
+	partySkip := func(c cmdPartySkip) {
+		if !srv.Party.Enabled {
+			c.err <- fmt.Errorf("party mode not enabled")
+			return
+		}
+		if srv.song == nil {
+			c.err <- fmt.Errorf("no song playing")
+			return
+		}
+		if srv.skipVotes == nil {
+			srv.skipVotes = make(map[string]bool)
+		}
+		srv.skipVotes[c.ip] = true
+		c.err <- nil
+		if len(srv.skipVotes) >= srv.Party.SkipVotes {
+			log.Println("party: skip vote passed")
+			next()
+		}
+	}
+	makeBackup := func(c cmdBackup) {
+		c.done <- srv.backup(c.secrets)
+	}
+	restoreBackup := func(c cmdRestore) {
+		stop()
+		// Hooks and the announcer run commands, so aren't set by backups
+		// sent to the API.
+		hooks, announce := srv.Hooks, srv.Announce
+		if err := srv.apply(c.state); err != nil {
+			c.err <- err
+			return
+		}
+		srv.Hooks, srv.Announce = hooks, announce
+		srv.guests.set(srv.Party)
+		srv.tokens.set(srv.Users)
+		srv.applyCodecOptions()
+		srv.cache.setSettings(srv.Cache)
+		srv.applyTagRules()
+		srv.listener = ""
+		srv.nextRefresh = make(map[codec.ID]time.Time)
+		for name, keys := range srv.RefreshInterval {
+			for key := range keys {
+				scheduleRefresh(name, key)
+			}
+		}
+		connectAll()
+		setDSP()
+		c.err <- nil
+		broadcast(waitProtocols)
+		broadcast(waitTracks)
+		broadcast(waitPlaylist)
+	}
+	userAdd := func(c cmdUserAdd) {
+		if _, ok := srv.Users[c.name]; ok {
+			c.err <- fmt.Errorf("user exists: %v", c.name)
+			return
+		}
+		for _, u := range srv.Users {
+			if u.Token == c.token {
+				c.err <- fmt.Errorf("token in use")
+				return
+			}
+		}
+		srv.Users[c.name] = &User{
+			Token:     c.token,
+			Role:      c.role,
+			Playlists: make(map[string]Playlist),
+		}
+		srv.tokens.set(srv.Users)
+		c.err <- nil
+	}
+	userRemove := func(c cmdUserRemove) {
+		if _, ok := srv.Users[c.name]; !ok {
+			c.err <- fmt.Errorf("unknown user: %v", c.name)
+			return
+		}
+		delete(srv.Users, c.name)
+		srv.tokens.set(srv.Users)
+		if srv.listener == c.name {
+			srv.listener = ""
+		}
+		c.err <- nil
+	}
+	userSetRole := func(c cmdUserSetRole) {
+		u := srv.Users[c.name]
+		if u == nil {
+			c.err <- fmt.Errorf("unknown user: %v", c.name)
+			return
+		}
+		u.Role = c.role
+		srv.tokens.set(srv.Users)
+		c.err <- nil
+	}
+	history := func(c cmdHistory) {
+		h := srv.history(c.user)
+		p := make(Playlist, len(h))
+		for i, id := range h {
+			p[len(h)-1-i] = id
+		}
+		c.done <- srv.playlistInfo(p)
+	}
+	listen := func(c cmdListen) {
+		if _, ok := srv.Users[c.user]; ok || c.user == "" {
+			srv.listener = c.user
+		}
+		go func() {
+			srv.ch <- c.cmd
+		}()
+	}
+	setParty := func(c cmdSetParty) {
+		srv.Party = Party(c)
+		srv.guests.set(srv.Party)
+		srv.skipVotes = nil
+	}
+	ch := make(chan interface{})
+	go func() {
+		for c := range srv.ch {
+			go func(c interface{}) {
+				timer := time.AfterFunc(time.Second*10, func() {
+					log.Printf("%T: %#v\n", c, c)
+					panic("delay timer expired")
+				})
+				ch <- c
+				timer.Stop()
+			}(c)
+		}
 	}()
 	infoTimer()
+	refreshTicker := time.NewTicker(time.Minute)
 	for {
 		select {
 		case <-timer:
 			infoTimer()
+		case <-refreshTicker.C:
+			autoRefresh()
+			reconnect()
+			// Remember the position of long songs as they play.
+			if srv.rememberPosition() {
+				queueSave()
+			}
 		case c := <-ch:
 			if c, ok := c.(cmdSetTime); ok {
 				d := c.duration
@@ -661,6 +2137,10 @@ func (srv *Server) commands() {
 				srv.elapsed = d
 				if c.force || change > time.Second {
 					broadcast(waitStatus)
+					srv.setPresence()
+				}
+				if srv.song != nil && (srv.info.Time <= 0 || d >= srv.info.Time-probeAhead) {
+					probeNext()
 				}
 				continue
 			}
@@ -677,6 +2157,7 @@ func (srv *Server) commands() {
 				case cmdStop:
 					save = false
 					stop()
+					srv.audioch <- audioEnd{}
 				case cmdNext:
 					next()
 				case cmdPause:
@@ -688,8 +2169,28 @@ func (srv *Server) commands() {
 					srv.Random = !srv.Random
 				case cmdRepeat:
 					srv.Repeat = !srv.Repeat
+				case cmdRadio:
+					srv.Radio = !srv.Radio
+				case cmdConsume:
+					srv.Cleanup.Consume = !srv.Cleanup.Consume
 				case cmdRestartSong:
 					restart()
+				case cmdVerifyPlayback:
+					srv.VerifyPlayback = !srv.VerifyPlayback
+				case cmdStopOnError:
+					srv.StopOnError = !srv.StopOnError
+				case cmdTrimSilence:
+					srv.TrimSilence = !srv.TrimSilence
+					setDSP()
+				case cmdLimiter:
+					srv.Limiter = !srv.Limiter
+					setDSP()
+				case cmdBitPerfect:
+					srv.BitPerfect = !srv.BitPerfect
+					setDSP()
+				case cmdDiscord:
+					srv.DiscordPresence = !srv.DiscordPresence
+					srv.setPresence()
 				default:
 					panic(c)
 				}
@@ -697,6 +2198,14 @@ func (srv *Server) commands() {
 				playIdx(c)
 			case cmdPlayTrack:
 				playTrack(c)
+			case cmdPlayPlaylist:
+				playPlaylist(c)
+			case cmdInsertNext:
+				insertNext(c)
+			case cmdAppendAlbum:
+				appendAlbum(c)
+			case cmdAppendWork:
+				appendWork(c)
 			case cmdProtocolRemove:
 				protocolRemove(c)
 			case cmdQueueChange:
@@ -719,6 +2228,28 @@ func (srv *Server) commands() {
 				doSeek(c)
 			case cmdMinDuration:
 				setMinDuration(c)
+			case cmdResumeMin:
+				srv.ResumeMin = time.Duration(c)
+			case cmdGetPositions:
+				save = false
+				c <- srv.positions()
+			case cmdSetPosition:
+				setPosition(c)
+			case cmdClearPositions:
+				clearPositions(c)
+			case cmdLoop:
+				save = false
+				setLoop(c)
+			case cmdGetBookmarks:
+				save = false
+				c.done <- srv.songBookmarks(c.user, c.id)
+			case cmdAddBookmark:
+				c.done <- addBookmark(c)
+			case cmdRemoveBookmark:
+				removeBookmark(c)
+			case cmdJumpBookmark:
+				save = false
+				c.done <- jumpBookmark(c)
 			case cmdTokenRegister:
 				tokenRegister(c)
 			case cmdSetUsername:
@@ -730,12 +2261,42 @@ func (srv *Server) commands() {
 			case cmdProtocolAddInstance:
 				protocolAddInstance(c)
 			case cmdRemoveDeleted:
-				removeDeleted()
+				removeDeleted(c)
 			case cmdRemoveInProgress:
 				removeInProgress(c)
 			case cmdError:
 				broadcastErr(error(c))
 				save = false
+			case cmdPreopened:
+				save = false
+				if c.id != srv.probed || c.id == srv.songID {
+					c.close()
+					break
+				}
+				if srv.preopened != nil {
+					srv.preopened.close()
+				}
+				srv.preopened = c.preopened
+			case cmdAnnounced:
+				save = false
+				if c.id == srv.probed && c.id != srv.songID {
+					srv.announcement = c.announcement
+				}
+			case cmdProbeFailed:
+				save = false
+				if srv.unreachable == nil {
+					srv.unreachable = make(map[SongID]error)
+				}
+				srv.unreachable[c.id] = c.err
+				title := string(c.id)
+				if info, _ := srv.getSong(c.id); info != nil && info.Title != "" {
+					title = info.Title
+				}
+				broadcastSongErr(c.id, fmt.Errorf("%s: can't be read, so will be skipped: %v", title, c.err))
+			case cmdDecodeError:
+				if srv.song != nil {
+					failed(healthCorrupt, c.err)
+				}
 			case cmdWaitData:
 				sendWaitData(c)
 				save = false
@@ -744,10 +2305,273 @@ func (srv *Server) commands() {
 				save = false
 			case cmdProtocolRefresh:
 				protocolRefresh(c)
+			case cmdSetRefresh:
+				setRefresh(c)
+			case cmdPauseMeteredRefresh:
+				setPauseMeteredRefresh(c)
+			case cmdImportPlaylist:
+				importPlaylist(c)
+			case cmdRecommend:
+				recommend(c)
+			case cmdSearch:
+				search(c)
+				save = false
+			case cmdPartyAdd:
+				partyAdd(c)
+			case cmdPartySkip:
+				partySkip(c)
+				save = false
+				doDroadcast = true
+			case cmdBackup:
+				save = false
+				makeBackup(c)
+			case cmdRestore:
+				restoreBackup(c)
+			case cmdUsers:
+				save = false
+				c <- srv.users()
+			case cmdUserAdd:
+				userAdd(c)
+			case cmdUserRemove:
+				userRemove(c)
+			case cmdUserSetRole:
+				userSetRole(c)
+			case cmdHistory:
+				save = false
+				history(c)
+			case cmdListen:
+				save = false
+				listen(c)
+			case cmdSetParty:
+				setParty(c)
+			case cmdVolume:
+				setVolume(cmdDeviceVolume{srv.Device, float64(c)})
+			case cmdDeviceVolume:
+				setVolume(c)
+			case cmdPreamp:
+				setPreamp(c)
+			case cmdReplayGain:
+				setReplayGain(c)
+			case cmdSetEQ:
+				setEQ(c)
+			case cmdSpeed:
+				setSpeed(c)
+			case cmdPitch:
+				setPitch(c)
+			case cmdCrossfade:
+				setCrossfade(c)
+			case cmdPreroll:
+				setPreroll(c)
+			case cmdOutputRate:
+				setOutputRate(c)
+			case cmdResampler:
+				setResampler(c)
+			case cmdDevice:
+				setDevice(c)
+			case cmdBackend:
+				setBackend(c)
+			case cmdLatency:
+				setLatency(c)
+			case cmdWaveform:
+				waveform(c)
+			case cmdGetSong:
+				getSong(c)
+			case cmdDuplicates:
+				save = false
+				c <- srv.duplicates()
+			case cmdLibraryRows:
+				save = false
+				c <- srv.libraryRows()
+			case cmdMergeDuplicates:
+				mergeDuplicates(c)
+			case cmdDuplicatePriority:
+				srv.DuplicatePriority = c
+			case cmdUnhide:
+				unhide(c)
+			case cmdLocalSongs:
+				save = false
+				c <- srv.localSongs()
+			case cmdLoudnessGroups:
+				save = false
+				c.done <- srv.loudnessGroups(c.ids)
+			case cmdLoudness:
+				save = false
+				loudness(c)
+			case cmdLoudnessProgress:
+				save = false
+				broadcast(waitAnalyze)
+			case cmdRemoveSongs:
+				removeSongs(c)
+			case cmdRelocations:
+				save = false
+				c <- srv.relocations()
+			case cmdRelocate:
+				relocate(c)
+			case cmdDismissVanished:
+				dismissVanished(c)
+			case cmdGetImport:
+				save = false
+				c <- srv.Import
+			case cmdSetImport:
+				srv.Import = Import(c)
+			case cmdGetCache:
+				save = false
+				c <- srv.Cache
+			case cmdSetCache:
+				srv.Cache = CacheSettings(c)
+				srv.cache.setSettings(srv.Cache)
+			case cmdGetParental:
+				save = false
+				c <- srv.Parental
+			case cmdSetParental:
+				srv.Parental = ParentalFilter(c)
+				broadcast(waitTracks)
+			case cmdBlocked:
+				save = false
+				c.done <- srv.blocked(c.id)
+			case cmdGetAnnouncer:
+				save = false
+				c <- srv.Announce
+			case cmdSetAnnouncer:
+				srv.Announce = Announcer(c)
+				srv.announcement = nil
+			case cmdGetTagRules:
+				save = false
+				c <- srv.TagRules
+			case cmdSetTagRules:
+				srv.TagRules = c
+				srv.applyTagRules()
+				broadcast(waitTracks)
+			case cmdGetSyncJobs:
+				save = false
+				getSyncJobs(c)
+			case cmdSetSyncJobs:
+				setSyncJobs(c)
+			case cmdGetRecordings:
+				save = false
+				c <- srv.Record
+			case cmdSetRecordings:
+				setRecordings(c)
+			case cmdRecordSource:
+				save = false
+				recordSource(c)
+			case cmdSynced:
+				save = false
+				synced(string(c))
+			case cmdGetPlugins:
+				save = false
+				chains := make(map[string][]dsp.Plugin)
+				for d, p := range srv.Plugins {
+					chains[d] = p
+				}
+				c <- chains
+			case cmdSetPlugins:
+				setPlugins(c)
+			case cmdGetHooks:
+				save = false
+				c <- append([]Hook(nil), srv.Hooks...)
+			case cmdSetHooks:
+				setHooks(c)
+			case cmdGetWebhooks:
+				save = false
+				getWebhooks(c)
+			case cmdSetWebhook:
+				setWebhook(c)
+			case cmdRemoveWebhook:
+				removeWebhook(c)
+			case cmdGetStereo:
+				save = false
+				stereo := make(map[string]dsp.Stereo)
+				for d, s := range srv.Stereo {
+					stereo[d] = s
+				}
+				c <- stereo
+			case cmdSetStereo:
+				setStereo(c)
+			case cmdGetBluetooth:
+				save = false
+				c <- srv.Bluetooth
+			case cmdSetBluetooth:
+				srv.Bluetooth = Bluetooth(c)
+				broadcast(waitStatus)
+			case cmdGetInput:
+				save = false
+				c <- srv.Input
+			case cmdSetInput:
+				srv.Input = Input(c)
+			case cmdGetCleanup:
+				save = false
+				c <- srv.Cleanup
+			case cmdSetCleanup:
+				srv.Cleanup = Cleanup(c)
+				srv.trimHistory()
+				broadcast(waitStatus)
+			case cmdSetCodecOptions:
+				if srv.CodecOptions == nil {
+					srv.CodecOptions = make(map[string]map[string]float64)
+				}
+				srv.CodecOptions[c.Name] = c.Values
+			case cmdGetGPIO:
+				save = false
+				c <- srv.GPIO
+			case cmdSetGPIO:
+				srv.GPIO = GPIO(c)
+			case cmdReopenOutput:
+				save = false
+				srv.audioch <- audioReopen{}
+			case cmdRefreshLibrary:
+				save = false
+				refreshLibrary(string(c))
+			case cmdSongAttributes:
+				save = false
+				songAttributes(c)
+			case cmdImportITunes:
+				importITunes(c)
+			case cmdGetStats:
+				save = false
+				if st := srv.Stats[c.id]; st != nil {
+					c.done <- *st
+				} else {
+					c.done <- SongStats{}
+				}
+			case cmdSetRating:
+				setRating(c)
+			case cmdRendererPush:
+				rendererPush(c)
+			case cmdGetHandoff:
+				save = false
+				getHandoff(c)
+			case cmdReceiveHandoff:
+				receiveHandoff(c)
+			case cmdOpenFile:
+				save = false
+				openFile(c)
+			case cmdOpenDownload:
+				save = false
+				openDownload(c)
+			case cmdRenderPlan:
+				save = false
+				c.done <- srv.renderPlan(c.playlist, c.user, c.rate)
+			case cmdExportList:
+				save = false
+				exportList(c)
+			case cmdAnalyzed:
+				analyzed(c)
+			case cmdConnected:
+				save = false
+				connected(c)
+			case cmdMeasured:
+				save = false
+				measured(c)
+			case cmdBroadcastDurations:
+				save = false
+				srv.durationsPending = false
+				broadcast(waitTracks)
 			default:
 				panic(c)
 			}
-			if save {
+			if save || positionChanged {
+				positionChanged = false
 				queueSave()
 			}
 			if save || doDroadcast {
@@ -767,15 +2591,30 @@ const (
 	cmdPrev
 	cmdRandom
 	cmdRepeat
+	cmdRadio
+	cmdConsume
 	cmdStop
 	cmdRestartSong
+	cmdTrimSilence
+	cmdLimiter
+	cmdVerifyPlayback
+	cmdStopOnError
+	cmdBitPerfect
+	cmdDiscord
 )
 
 type cmdSeek time.Duration
 
 type cmdPlayIdx int
 
-type cmdRemoveDeleted struct{}
+// cmdRemoveDeleted removes songs no longer listed from the queue and
+// playlists. If prev, the songs of the protocol instance listed before a
+// refresh, is set, moved songs are first remapped.
+type cmdRemoveDeleted struct {
+	protocol string
+	key      string
+	prev     protocol.SongList
+}
 
 type cmdProtocolRemove struct {
 	protocol, key string
@@ -786,6 +2625,7 @@ type cmdQueueChange PlaylistChange
 type cmdPlaylistChange struct {
 	plc  PlaylistChange
 	name string
+	user string
 }
 
 type cmdDoSave struct{}
@@ -805,6 +2645,11 @@ type cmdSetTime struct {
 
 type cmdError error
 
+// cmdDecodeError is an error decoding the current song, which is skipped.
+type cmdDecodeError struct {
+	err error
+}
+
 type cmdTokenRegister string
 
 type cmdSetUsername string
@@ -822,6 +2667,7 @@ type cmdRemoveInProgress codec.ID
 
 type cmdWaitData struct {
 	wt   waitType
+	user string
 	done chan<- *waitData
 }
 
@@ -836,3 +2682,17 @@ type cmdProtocolRefresh struct {
 }
 
 type cmdPlayTrack SongID
+
+type cmdLoop struct {
+	loop *Loop
+}
+
+type cmdPlayPlaylist struct {
+	name string
+	user string
+	idx  int
+}
+
+type cmdInsertNext []SongID
+
+type cmdAppendAlbum SongID
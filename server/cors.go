@@ -0,0 +1,65 @@
+package server
+
+import (
+	"net/http"
+	"strings"
+)
+
+// CORSOrigins are the origins other than the server's own allowed to call
+// the API, or "*" for any. CORSHeaders are request headers allowed in
+// addition to the defaults.
+//
+// Cross-origin clients authenticate with an Authorization: Bearer header.
+// The auth cookie is SameSite and credentials aren't allowed, so other sites
+// can't make requests as the web UI's user.
+var (
+	CORSOrigins []string
+	CORSHeaders []string
+)
+
+var (
+	corsMethods = "GET, POST, OPTIONS"
+	corsHeaders = []string{"Authorization", "Content-Type"}
+)
+
+// corsMaxAge is the number of seconds clients may cache preflight
+// responses.
+const corsMaxAge = "600"
+
+func corsAllowed(origin string) bool {
+	for _, o := range CORSOrigins {
+		if o = strings.TrimSpace(o); o == "*" || strings.EqualFold(o, origin) {
+			return true
+		}
+	}
+	return false
+}
+
+// cors wraps h to add the CORS headers for allowed origins, and respond to
+// preflight requests of /api. Preflight requests carry no credentials, so
+// cors must wrap authorize.
+func cors(h http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		origin := r.Header.Get("Origin")
+		if origin == "" || len(CORSOrigins) == 0 || !strings.HasPrefix(r.URL.Path, "/api/") {
+			h.ServeHTTP(w, r)
+			return
+		}
+		w.Header().Add("Vary", "Origin")
+		if !corsAllowed(origin) {
+			h.ServeHTTP(w, r)
+			return
+		}
+		w.Header().Set("Access-Control-Allow-Origin", origin)
+		if r.Method != "OPTIONS" || r.Header.Get("Access-Control-Request-Method") == "" {
+			h.ServeHTTP(w, r)
+			return
+		}
+		w.Header().Add("Vary", "Access-Control-Request-Method")
+		w.Header().Add("Vary", "Access-Control-Request-Headers")
+		w.Header().Set("Access-Control-Allow-Methods", corsMethods)
+		w.Header().Set("Access-Control-Allow-Headers", strings.Join(append(corsHeaders, CORSHeaders...), ", "))
+		w.Header().Set("Access-Control-Max-Age", corsMaxAge)
+		w.WriteHeader(http.StatusNoContent)
+	})
+}
@@ -0,0 +1,267 @@
+package server
+
+import (
+	"bytes"
+	"encoding/binary"
+	"encoding/json"
+	"fmt"
+	"io"
+	"log"
+	"net"
+	"os"
+	"path/filepath"
+	"runtime"
+	"strconv"
+	"strings"
+	"time"
+	"unicode/utf8"
+)
+
+// DiscordClientID, if set, is the ID of the Discord application the playing
+// song is published as, with Rich Presence.
+var DiscordClientID string
+
+const (
+	// Discord IPC opcodes.
+	discordHandshake = 0
+	discordFrame     = 1
+	discordClose     = 2
+	// discordListening is the activity type shown as "Listening to".
+	discordListening = 2
+	// discordMaxText is the longest text Discord accepts in an activity.
+	discordMaxText = 128
+)
+
+// discordActivity is a Rich Presence activity.
+type discordActivity struct {
+	Type       int                `json:"type"`
+	Details    string             `json:"details,omitempty"`
+	State      string             `json:"state,omitempty"`
+	Timestamps *discordTimestamps `json:"timestamps,omitempty"`
+	Assets     *discordAssets     `json:"assets,omitempty"`
+}
+
+type discordTimestamps struct {
+	Start int64 `json:"start,omitempty"`
+	End   int64 `json:"end,omitempty"`
+}
+
+type discordAssets struct {
+	LargeImage string `json:"large_image,omitempty"`
+	LargeText  string `json:"large_text,omitempty"`
+}
+
+// discordText returns s as Discord accepts it, between 2 and 128
+// characters, or empty.
+func discordText(s string) string {
+	if s == "" {
+		return ""
+	}
+	if utf8.RuneCountInString(s) < 2 {
+		return s + " "
+	}
+	if utf8.RuneCountInString(s) > discordMaxText {
+		r := []rune(s)
+		return string(r[:discordMaxText-1]) + "…"
+	}
+	return s
+}
+
+// presence returns the activity of the current song, or nil if there is none
+// to show.
+func (srv *Server) presence() *discordActivity {
+	if !srv.DiscordPresence || srv.song == nil || srv.state == stateStop {
+		return nil
+	}
+	info := srv.info
+	a := &discordActivity{
+		Type:    discordListening,
+		Details: discordText(info.Title),
+		State:   discordText(info.Artist),
+	}
+	if a.Details == "" {
+		a.Details = discordText(filepath.Base(string(srv.songID.ID())))
+	}
+	if srv.state == statePause {
+		a.Details = discordText(a.Details + " (paused)")
+	} else if srv.Speed == 1 {
+		// Discord counts the elapsed time from the start itself.
+		start := time.Now().Add(-srv.elapsed)
+		a.Timestamps = &discordTimestamps{Start: start.UnixNano() / int64(time.Millisecond)}
+		if info.Time > 0 {
+			a.Timestamps.End = start.Add(info.Time).UnixNano() / int64(time.Millisecond)
+		}
+	}
+	// Discord fetches artwork itself, so only public URLs are shown.
+	if image := info.ImageURL; strings.HasPrefix(image, "https://") {
+		a.Assets = &discordAssets{
+			LargeImage: image,
+			LargeText:  discordText(info.Album),
+		}
+	}
+	return a
+}
+
+// runDiscord publishes activities to the local Discord client. A nil
+// activity clears the presence and closes the connection.
+func (srv *Server) runDiscord() {
+	var d *discordConn
+	for a := range srv.discord {
+		if a == nil && d == nil {
+			continue
+		}
+		// Retry once with a new connection if Discord restarted.
+		for attempt := 0; attempt < 2; attempt++ {
+			if d == nil {
+				var err error
+				d, err = dialDiscord(DiscordClientID)
+				if err != nil {
+					log.Printf("discord: %v", err)
+					break
+				}
+			}
+			err := d.setActivity(a)
+			if err == nil {
+				break
+			}
+			log.Printf("discord: %v", err)
+			d.Close()
+			d = nil
+		}
+		if a == nil && d != nil {
+			d.Close()
+			d = nil
+		}
+	}
+}
+
+// setPresence sends the current activity to runDiscord, replacing any not
+// yet sent.
+func (srv *Server) setPresence() {
+	if DiscordClientID == "" {
+		return
+	}
+	select {
+	case <-srv.discord:
+	default:
+	}
+	srv.discord <- srv.presence()
+}
+
+// discordConn is a connection to the IPC socket of the Discord client.
+type discordConn struct {
+	io.ReadWriteCloser
+	nonce int
+}
+
+// discordPaths returns the paths of the IPC sockets the Discord client may
+// listen on.
+func discordPaths() []string {
+	var paths []string
+	if runtime.GOOS == "windows" {
+		for i := 0; i < 10; i++ {
+			paths = append(paths, fmt.Sprintf(`\\.\pipe\discord-ipc-%d`, i))
+		}
+		return paths
+	}
+	var dirs []string
+	for _, env := range []string{"XDG_RUNTIME_DIR", "TMPDIR", "TMP", "TEMP"} {
+		if d := os.Getenv(env); d != "" {
+			dirs = append(dirs, d)
+		}
+	}
+	dirs = append(dirs, "/tmp")
+	for _, d := range dirs {
+		// Flatpak and Snap installs put the socket in their own directory.
+		for _, sub := range []string{"", "app/com.discordapp.Discord", "snap.discord"} {
+			for i := 0; i < 10; i++ {
+				paths = append(paths, filepath.Join(d, sub, fmt.Sprintf("discord-ipc-%d", i)))
+			}
+		}
+	}
+	return paths
+}
+
+// dialDiscord connects to the Discord client as the application clientID.
+func dialDiscord(clientID string) (*discordConn, error) {
+	for _, p := range discordPaths() {
+		var c io.ReadWriteCloser
+		var err error
+		if runtime.GOOS == "windows" {
+			c, err = os.OpenFile(p, os.O_RDWR, 0)
+		} else {
+			c, err = net.Dial("unix", p)
+		}
+		if err != nil {
+			continue
+		}
+		d := &discordConn{ReadWriteCloser: c}
+		if err := d.call(discordHandshake, map[string]interface{}{
+			"v":         1,
+			"client_id": clientID,
+		}); err != nil {
+			c.Close()
+			return nil, err
+		}
+		return d, nil
+	}
+	return nil, fmt.Errorf("client not running")
+}
+
+func (d *discordConn) setActivity(a *discordActivity) error {
+	d.nonce++
+	return d.call(discordFrame, map[string]interface{}{
+		"cmd": "SET_ACTIVITY",
+		"args": map[string]interface{}{
+			"pid":      os.Getpid(),
+			"activity": a,
+		},
+		"nonce": strconv.Itoa(d.nonce),
+	})
+}
+
+// call writes a frame and reads its reply, returning an error if Discord
+// reported one.
+func (d *discordConn) call(op uint32, payload interface{}) error {
+	b, err := json.Marshal(payload)
+	if err != nil {
+		return err
+	}
+	var buf bytes.Buffer
+	binary.Write(&buf, binary.LittleEndian, op)
+	binary.Write(&buf, binary.LittleEndian, uint32(len(b)))
+	buf.Write(b)
+	if _, err := d.Write(buf.Bytes()); err != nil {
+		return err
+	}
+	var header struct {
+		Op, Length uint32
+	}
+	if err := binary.Read(d, binary.LittleEndian, &header); err != nil {
+		return err
+	}
+	reply := make([]byte, header.Length)
+	if _, err := io.ReadFull(d, reply); err != nil {
+		return err
+	}
+	var r struct {
+		Evt  string
+		Data struct {
+			Code    int
+			Message string
+		}
+		// Close frames have the error at the top level.
+		Code    int
+		Message string
+	}
+	if err := json.Unmarshal(reply, &r); err != nil {
+		return err
+	}
+	switch {
+	case header.Op == discordClose:
+		return fmt.Errorf("closed: %d %s", r.Code, r.Message)
+	case r.Evt == "ERROR":
+		return fmt.Errorf("%d %s", r.Data.Code, r.Data.Message)
+	}
+	return nil
+}
@@ -0,0 +1,193 @@
+package server
+
+import (
+	"archive/zip"
+	"context"
+	"fmt"
+	"io"
+	"log"
+	"net/http"
+	"os"
+	"strings"
+	"time"
+
+	"github.com/julienschmidt/httprouter"
+	"github.com/mjibson/moggio/codec"
+)
+
+// Download serves the original file of a song as an attachment, read from
+// its source if it isn't local, to copy it to another device.
+func (srv *Server) Download(w http.ResponseWriter, r *http.Request, ps httprouter.Params) {
+	id := SongID(strings.TrimPrefix(ps.ByName("id"), "/"))
+	res := srv.openDownload(r.Context(), id)
+	if os.IsNotExist(res.err) {
+		http.NotFound(w, r)
+		return
+	} else if res.err != nil {
+		serveError(w, res.err)
+		return
+	}
+	w.Header().Set("Content-Disposition", fmt.Sprintf("attachment; filename=%q", cleanFileName(res.name)))
+	if res.f != nil {
+		f := res.f
+		defer f.Close()
+		fi, err := f.Stat()
+		if err != nil {
+			serveError(w, err)
+			return
+		}
+		w.Header().Set("ETag", fileETag(fi))
+		http.ServeContent(w, r, res.name, fi.ModTime(), f)
+		return
+	}
+	rc, size, err := res.r()
+	if err != nil {
+		serveError(w, err)
+		return
+	}
+	defer rc.Close()
+	if size > 0 {
+		w.Header().Set("Content-Length", fmt.Sprint(size))
+	}
+	w.Header().Set("Content-Type", "application/octet-stream")
+	if _, err := io.Copy(w, rc); err != nil {
+		log.Printf("download %v: %v", id, err)
+	}
+}
+
+// Export serves a zip of the original files of the songs of an album or
+// playlist. The album parameter is any song of the album; the playlist
+// parameter is the name of one of the user's playlists. Songs that can't be
+// read are listed in errors.txt instead.
+func (srv *Server) Export(w http.ResponseWriter, r *http.Request, ps httprouter.Params) {
+	ch := make(chan exportList)
+	srv.ch <- cmdExportList{
+		album:    SongID(r.FormValue("album")),
+		playlist: r.FormValue("playlist"),
+		user:     requestActor(r).user,
+		done:     ch,
+	}
+	list := <-ch
+	if list.err != nil {
+		serveError(w, list.err)
+		return
+	}
+	w.Header().Set("Content-Type", "application/zip")
+	w.Header().Set("Content-Disposition", fmt.Sprintf("attachment; filename=%q", cleanFileName(list.name)+".zip"))
+	z := zip.NewWriter(w)
+	var errs []string
+	// Tracks of one file, like a cue sheet's, share it.
+	files := make(map[string]bool)
+	for i, id := range list.songs {
+		path, _ := id.ID().Pop()
+		file := fmt.Sprintf("%s|%s|%s", id.Protocol(), id.Key(), path)
+		if files[file] {
+			continue
+		}
+		files[file] = true
+		if err := srv.exportSong(r.Context(), z, i+1, id); err != nil {
+			if r.Context().Err() != nil {
+				return
+			}
+			log.Printf("export %v: %v", id, err)
+			errs = append(errs, fmt.Sprintf("%v: %v", id, err))
+		}
+	}
+	if len(errs) > 0 {
+		if f, err := z.Create("errors.txt"); err == nil {
+			fmt.Fprintln(f, strings.Join(errs, "\n"))
+		}
+	}
+	if err := z.Close(); err != nil {
+		log.Printf("export %s: %v", list.name, err)
+	}
+}
+
+// exportSong copies the file of id, the nth song of an export, to z.
+func (srv *Server) exportSong(ctx context.Context, z *zip.Writer, n int, id SongID) error {
+	res := srv.openDownload(ctx, id)
+	if res.err != nil {
+		return res.err
+	}
+	var rc io.ReadCloser
+	modified := time.Now()
+	if res.f != nil {
+		rc = res.f
+		if fi, err := res.f.Stat(); err == nil {
+			modified = fi.ModTime()
+		}
+	} else {
+		var err error
+		if rc, _, err = res.r(); err != nil {
+			return err
+		}
+	}
+	defer rc.Close()
+	// Audio is already compressed, so files are stored as they are.
+	f, err := z.CreateHeader(&zip.FileHeader{
+		Name:     fmt.Sprintf("%02d %s", n, cleanFileName(res.name)),
+		Method:   zip.Store,
+		Modified: modified,
+	})
+	if err != nil {
+		return err
+	}
+	_, err = io.Copy(f, rc)
+	return err
+}
+
+// openDownload opens the file of id: a local file, or the reader of a remote
+// one.
+func (srv *Server) openDownload(ctx context.Context, id SongID) downloadResult {
+	ch := make(chan downloadResult)
+	srv.ch <- cmdOpenDownload{
+		id:   id,
+		ctx:  ctx,
+		done: ch,
+	}
+	return <-ch
+}
+
+// cleanFileName returns name without the characters file systems disallow.
+func cleanFileName(name string) string {
+	name = strings.Map(func(r rune) rune {
+		switch {
+		case r < ' ', strings.ContainsRune(`/\:*?"<>|`, r):
+			return '_'
+		}
+		return r
+	}, name)
+	name = strings.TrimSpace(name)
+	if name == "" || name == "." || name == ".." {
+		return "song"
+	}
+	return name
+}
+
+// downloadResult is a song's file, f if it's local or else r, and its name.
+type downloadResult struct {
+	name string
+	f    *os.File
+	r    codec.Reader
+	err  error
+}
+
+type cmdOpenDownload struct {
+	id   SongID
+	ctx  context.Context
+	done chan downloadResult
+}
+
+// exportList is the songs of an export, and its name.
+type exportList struct {
+	name  string
+	songs Playlist
+	err   error
+}
+
+type cmdExportList struct {
+	album    SongID
+	playlist string
+	user     string
+	done     chan exportList
+}
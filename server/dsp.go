@@ -0,0 +1,378 @@
+package server
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"log"
+	"math"
+	"net/url"
+	"reflect"
+	"time"
+
+	"github.com/julienschmidt/httprouter"
+	"github.com/mjibson/moggio/codec"
+	"github.com/mjibson/moggio/dsp"
+	"github.com/mjibson/moggio/output"
+)
+
+// ReplayGain modes.
+const (
+	replayGainOff   = "off"
+	replayGainTrack = "track"
+	replayGainAlbum = "album"
+)
+
+// dspConfig is a snapshot of the DSP settings sent to the audio go routine,
+// which builds a dsp.Chain from it for each song.
+type dspConfig struct {
+	// gain is the linear gain combining preamp, volume, and ReplayGain.
+	// Changing it alone doesn't rebuild the chain.
+	gain float64
+	eq   dsp.EQ
+	// stereo are the channel mixing options of the output device.
+	stereo dsp.Stereo
+	// speed is the playback speed factor and pitch the shift in semitones.
+	speed, pitch float64
+	trimSilence  bool
+	crossfade    time.Duration
+	// limiter limits peaks at the end of the chain, so they don't clip.
+	limiter bool
+	// outputRate, if not 0, is the sample rate to resample to with quality.
+	outputRate int
+	quality    dsp.Quality
+	// bitPerfect bypasses all processing and opens the device exclusively
+	// at the song's native format.
+	bitPerfect bool
+	backend    string
+	latency    time.Duration
+	preroll    time.Duration
+	// fixedRate is the sample rate required by the backend, if any.
+	fixedRate int
+	device    string
+	plugins   []dsp.Plugin
+}
+
+// volume returns the volume of the current output device. It should only be
+// called by the commands() function.
+func (srv *Server) volume() float64 {
+	if v, ok := srv.Volumes[srv.Device]; ok {
+		return v
+	}
+	return srv.Volume
+}
+
+// dspConfig should only be called by the commands() function.
+func (srv *Server) dspConfig() dspConfig {
+	return dspConfig{
+		gain:   dsp.DB(srv.gainDB(&srv.info)) * srv.volume(),
+		eq:     srv.EQ,
+		stereo: srv.Stereo[srv.Device],
+		speed:  srv.Speed,
+		pitch:  srv.Pitch,
+
+		trimSilence: srv.TrimSilence,
+		limiter:     srv.Limiter,
+		crossfade:   srv.Crossfade,
+		outputRate:  srv.OutputRate,
+		quality:     srv.Resampler,
+		bitPerfect:  srv.BitPerfect,
+		backend:     srv.Backend,
+		latency:     srv.Latency[srv.Backend],
+		preroll:     srv.preroll(),
+		fixedRate:   output.Rate(srv.Backend),
+		device:      srv.Device,
+		plugins:     srv.Plugins[srv.Device],
+	}
+}
+
+// gainDB returns the gain in dB of songs of info, of the preamp and their
+// ReplayGain.
+func (srv *Server) gainDB(info *codec.SongInfo) float64 {
+	db := srv.Preamp
+	switch srv.ReplayGain {
+	case replayGainTrack:
+		db += info.TrackGain
+	case replayGainAlbum:
+		db += info.AlbumGain
+		if info.AlbumGain == 0 {
+			db += info.TrackGain
+		}
+	}
+	return db
+}
+
+func (c dspConfig) chain(sampleRate, channels int) dsp.Chain {
+	var chain dsp.Chain
+	// Downmix first so later stages process fewer channels.
+	if out := c.channels(channels); out != channels {
+		chain = append(chain, dsp.NewDownmix(channels, out))
+		channels = out
+	}
+	if c.bitPerfect {
+		// Only a backend's fixed rate can force a conversion.
+		if rate := c.rate(sampleRate); rate != sampleRate {
+			chain = append(chain, dsp.NewResampler(float64(sampleRate), float64(rate), channels, c.quality))
+		}
+		return chain
+	}
+	if c.trimSilence {
+		chain = append(chain, dsp.NewSilenceTrimmer(sampleRate, channels))
+	}
+	chain = append(chain, dsp.NewFader(c.gain))
+	if c.eq.Enabled {
+		chain = append(chain, dsp.NewEQ(c.eq, sampleRate, channels))
+	}
+	if c.stereo.Enabled() {
+		chain = append(chain, dsp.NewStereo(c.stereo, sampleRate, channels))
+	}
+	if c.speed != 1 || c.pitch != 0 {
+		chain = append(chain, dsp.NewSpeed(c.speed, c.pitch, sampleRate, channels, c.quality))
+	}
+	if rate := c.rate(sampleRate); rate != sampleRate {
+		chain = append(chain, dsp.NewResampler(float64(sampleRate), float64(rate), channels, c.quality))
+	}
+	for _, p := range c.plugins {
+		s, err := dsp.NewPlugin(p, c.rate(sampleRate), channels)
+		if err != nil {
+			log.Println(err)
+			continue
+		}
+		chain = append(chain, s)
+	}
+	if c.limiter {
+		chain = append(chain, dsp.NewLimiter(limiterCeiling, c.rate(sampleRate), channels))
+	}
+	return chain
+}
+
+// sameChain reports whether c builds the same chain as o but for its gain,
+// which can be changed in place, so a volume change doesn't reset filters
+// or drop the audio stages hold.
+func (c dspConfig) sameChain(o dspConfig) bool {
+	c.gain = o.gain
+	return reflect.DeepEqual(c, o)
+}
+
+// fader returns the gain stage of chain, if any.
+func fader(chain dsp.Chain) *dsp.Fader {
+	for _, s := range chain {
+		if f, ok := s.(*dsp.Fader); ok {
+			return f
+		}
+	}
+	return nil
+}
+
+// rate returns the output sample rate for a song with the given rate.
+func (c dspConfig) rate(sampleRate int) int {
+	if c.fixedRate != 0 {
+		return c.fixedRate
+	}
+	if c.outputRate == 0 || c.bitPerfect {
+		return sampleRate
+	}
+	return c.outputRate
+}
+
+// channels returns the number of output channels for a song with the given
+// number. Multichannel songs are downmixed to stereo unless the device has
+// enough channels.
+func (c dspConfig) channels(channels int) int {
+	if channels <= 2 {
+		return channels
+	}
+	switch n := output.Channels(c.backend, c.device); {
+	case n == 1:
+		return 1
+	case n < channels:
+		// Includes devices of unknown channels.
+		return 2
+	}
+	return channels
+}
+
+// prerollSamples returns the number of samples to decode ahead of playback
+// for a song with the given format.
+func (c dspConfig) prerollSamples(sampleRate, channels int) int {
+	return int(int64(c.preroll) * int64(sampleRate) / int64(time.Second) * int64(channels))
+}
+
+// preroll returns the audio to decode ahead of playback.
+func (srv *Server) preroll() time.Duration {
+	if srv.Preroll == 0 {
+		return defaultPreroll
+	}
+	return srv.Preroll
+}
+
+// GetEQ returns the current equalizer settings and available presets.
+func (srv *Server) GetEQ(body io.Reader, form url.Values, ps httprouter.Params) (interface{}, error) {
+	ch := make(chan *waitData)
+	srv.ch <- cmdWaitData{
+		wt:   waitEQ,
+		done: ch,
+	}
+	return (<-ch).Data, nil
+}
+
+// SetEQ sets the equalizer. The body is either an EQ, or an object with a
+// Preset name to use one of the graphic presets.
+func (srv *Server) SetEQ(body io.Reader, form url.Values, ps httprouter.Params) (interface{}, error) {
+	var eq dsp.EQ
+	if err := json.NewDecoder(body).Decode(&eq); err != nil {
+		return nil, err
+	}
+	if eq.Preset != "" && len(eq.Bands) == 0 {
+		enabled := eq.Enabled
+		var err error
+		eq, err = dsp.PresetEQ(eq.Preset)
+		if err != nil {
+			return nil, err
+		}
+		eq.Enabled = enabled
+	}
+	if err := eq.Validate(); err != nil {
+		return nil, err
+	}
+	srv.ch <- cmdSetEQ(eq)
+	return nil, nil
+}
+
+// GetPlugins returns the LADSPA plugins installed and the plugin chain of
+// each output device.
+func (srv *Server) GetPlugins(body io.Reader, form url.Values, ps httprouter.Params) (interface{}, error) {
+	ch := make(chan map[string][]dsp.Plugin)
+	srv.ch <- cmdGetPlugins(ch)
+	plugins := dsp.Plugins()
+	if plugins == nil {
+		plugins = []dsp.PluginInfo{}
+	}
+	return struct {
+		Plugins []dsp.PluginInfo
+		Chains  map[string][]dsp.Plugin
+	}{
+		Plugins: plugins,
+		Chains:  <-ch,
+	}, nil
+}
+
+// SetPlugins sets the plugin Chain of the output Device, or of the default
+// device if Device is empty. An empty Chain removes it.
+func (srv *Server) SetPlugins(body io.Reader, form url.Values, ps httprouter.Params) (interface{}, error) {
+	var c cmdSetPlugins
+	if err := json.NewDecoder(body).Decode(&c); err != nil {
+		return nil, err
+	}
+	for _, p := range c.Chain {
+		// Check that it loads and that its controls exist.
+		if _, err := dsp.NewPlugin(p, 48000, 2); err != nil {
+			return nil, err
+		}
+	}
+	srv.audit(ps, "plugins", c.Device)
+	srv.ch <- c
+	return nil, nil
+}
+
+// GetStereo returns the crossfeed, mono, and balance settings of each
+// output device.
+func (srv *Server) GetStereo(body io.Reader, form url.Values, ps httprouter.Params) (interface{}, error) {
+	ch := make(chan map[string]dsp.Stereo)
+	srv.ch <- cmdGetStereo(ch)
+	return <-ch, nil
+}
+
+// SetStereo sets the crossfeed, mono, and balance settings of the output
+// Device, or of the default device if Device is empty.
+func (srv *Server) SetStereo(body io.Reader, form url.Values, ps httprouter.Params) (interface{}, error) {
+	var c cmdSetStereo
+	if err := json.NewDecoder(body).Decode(&c); err != nil {
+		return nil, err
+	}
+	if err := c.Stereo.Validate(); err != nil {
+		return nil, err
+	}
+	srv.ch <- c
+	return nil, nil
+}
+
+// format returns the output format for a song with the given format. Bits is
+// the song's native bit depth, or 0 if unknown.
+func (c dspConfig) format(sampleRate, channels, bits int) output.Format {
+	f := output.Format{
+		SampleRate: c.rate(sampleRate),
+		Channels:   c.channels(channels),
+		Device:     c.device,
+		Backend:    c.backend,
+		Latency:    c.latency,
+	}
+	if c.bitPerfect {
+		f.Bits = bits
+		f.Exclusive = true
+	}
+	return f
+}
+
+// xfade returns the crossfade duration, which is disabled in bit perfect
+// mode.
+func (c dspConfig) xfade() time.Duration {
+	if c.bitPerfect {
+		return 0
+	}
+	return c.crossfade
+}
+
+func parseVolume(s string) (float64, error) {
+	var v float64
+	if _, err := fmt.Sscan(s, &v); err != nil {
+		return 0, err
+	}
+	if v < 0 || v > 1 || math.IsNaN(v) {
+		return 0, fmt.Errorf("volume must be between 0 and 1: %v", s)
+	}
+	return v, nil
+}
+
+// cmdVolume sets the volume of the current output device.
+type cmdVolume float64
+
+// cmdDeviceVolume sets the volume of an output device.
+type cmdDeviceVolume struct {
+	device string
+	volume float64
+}
+
+type cmdPreamp float64
+
+type cmdReplayGain string
+
+type cmdSetEQ dsp.EQ
+
+type cmdSpeed float64
+
+type cmdPitch float64
+
+type cmdCrossfade time.Duration
+
+type cmdPreroll time.Duration
+
+type cmdOutputRate int
+
+type cmdResampler dsp.Quality
+
+type cmdGetPlugins chan map[string][]dsp.Plugin
+
+type cmdSetPlugins struct {
+	Device string
+	Chain  []dsp.Plugin
+}
+
+type cmdGetStereo chan map[string]dsp.Stereo
+
+type cmdSetStereo struct {
+	Device string
+	dsp.Stereo
+}
+
+type audioDSP dspConfig
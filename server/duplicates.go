@@ -0,0 +1,186 @@
+package server
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/url"
+	"path/filepath"
+	"sort"
+	"strings"
+
+	"github.com/julienschmidt/httprouter"
+	"github.com/mjibson/moggio/codec"
+)
+
+// defaultDuplicatePriority are the preferred formats of duplicates if
+// Server.DuplicatePriority isn't set: lossless, then lossy.
+var defaultDuplicatePriority = []string{"flac", "wav", "aiff", "ape", "wv", "ogg", "opus", "m4a", "mp3"}
+
+// songFormat returns the file extension of a song, or empty if it isn't
+// known.
+func songFormat(id SongID) string {
+	top, _ := id.ID().Pop()
+	return strings.ToLower(strings.TrimPrefix(filepath.Ext(top), "."))
+}
+
+// formatRank returns the rank of id's format in the duplicate priority,
+// lower being preferred.
+func (srv *Server) formatRank(id SongID) int {
+	priority := srv.DuplicatePriority
+	if len(priority) == 0 {
+		priority = defaultDuplicatePriority
+	}
+	f := songFormat(id)
+	for i, p := range priority {
+		if strings.EqualFold(p, f) {
+			return i
+		}
+	}
+	return len(priority)
+}
+
+// duplicates returns the groups of listed songs with the same fingerprint,
+// each ordered with the preferred copy first. It should only be called by
+// the commands() function.
+func (srv *Server) duplicates() [][]listItem {
+	byPrint := make(map[string][]listItem)
+	for name, protos := range srv.Protocols {
+		for key, inst := range protos {
+			sl, _ := inst.List()
+			for id, info := range sl {
+				sid := SongID(codec.NewID(name, key, string(id)))
+				if srv.Hidden[sid] {
+					continue
+				}
+				if fp := fingerprint(info); fp != "" {
+					byPrint[fp] = append(byPrint[fp], listItem{
+						ID:   sid,
+						Info: info,
+					})
+				}
+			}
+		}
+	}
+	groups := [][]listItem{}
+	for _, items := range byPrint {
+		if len(items) < 2 {
+			continue
+		}
+		sort.Slice(items, func(i, j int) bool {
+			ri, rj := srv.formatRank(items[i].ID), srv.formatRank(items[j].ID)
+			if ri != rj {
+				return ri < rj
+			}
+			return items[i].ID < items[j].ID
+		})
+		groups = append(groups, items)
+	}
+	sort.Slice(groups, func(i, j int) bool {
+		return groups[i][0].ID < groups[j][0].ID
+	})
+	return groups
+}
+
+// mergeDuplicates replaces the songs in the keys of m with the kept songs
+// in their values, and hides them. It should only be called by the
+// commands() function.
+func (srv *Server) mergeDuplicates(m map[SongID]SongID) error {
+	for old, keep := range m {
+		if old == keep {
+			return fmt.Errorf("cannot merge %v into itself", old)
+		}
+		if !srv.hasSong(old) {
+			return fmt.Errorf("unknown song: %v", old)
+		}
+		if !srv.hasSong(keep) || srv.Hidden[keep] {
+			return fmt.Errorf("unknown song: %v", keep)
+		}
+		if _, ok := m[keep]; ok {
+			return fmt.Errorf("cannot keep merged song: %v", keep)
+		}
+	}
+	srv.replaceSongs(m)
+	if srv.Hidden == nil {
+		srv.Hidden = make(map[SongID]bool)
+	}
+	for old := range m {
+		srv.Hidden[old] = true
+	}
+	return nil
+}
+
+// Duplicates returns groups of songs that are copies of each other, the
+// preferred copy first.
+func (srv *Server) Duplicates(body io.Reader, form url.Values, ps httprouter.Params) (interface{}, error) {
+	ch := make(chan [][]listItem)
+	srv.ch <- cmdDuplicates(ch)
+	return <-ch, nil
+}
+
+// DuplicatesMerge merges duplicates: the Remove songs are replaced with
+// Keep in the queue, playlists, and history, and no longer listed. If All
+// is set, every group of duplicates is merged into its preferred copy.
+func (srv *Server) DuplicatesMerge(body io.Reader, form url.Values, ps httprouter.Params) (interface{}, error) {
+	var req struct {
+		Keep   SongID
+		Remove []SongID
+		All    bool
+	}
+	if err := json.NewDecoder(body).Decode(&req); err != nil {
+		return nil, err
+	}
+	if !req.All && (req.Keep == "" || len(req.Remove) == 0) {
+		return nil, fmt.Errorf("missing songs")
+	}
+	m := make(map[SongID]SongID)
+	for _, id := range req.Remove {
+		m[id] = req.Keep
+	}
+	srv.audit(ps, "duplicates merge", fmt.Sprintf("%d songs", len(m)))
+	ch := make(chan error)
+	srv.ch <- cmdMergeDuplicates{
+		m:    m,
+		all:  req.All,
+		done: ch,
+	}
+	return nil, <-ch
+}
+
+// DuplicatesSettings sets the formats, by file extension, preferred when
+// merging duplicates.
+func (srv *Server) DuplicatesSettings(body io.Reader, form url.Values, ps httprouter.Params) (interface{}, error) {
+	var req struct {
+		Priority []string
+	}
+	if err := json.NewDecoder(body).Decode(&req); err != nil {
+		return nil, err
+	}
+	for i, p := range req.Priority {
+		req.Priority[i] = strings.ToLower(strings.TrimPrefix(p, "."))
+	}
+	srv.ch <- cmdDuplicatePriority(req.Priority)
+	return nil, nil
+}
+
+// DuplicatesUnhide lists songs hidden by merging again.
+func (srv *Server) DuplicatesUnhide(body io.Reader, form url.Values, ps httprouter.Params) (interface{}, error) {
+	var ids []SongID
+	if err := json.NewDecoder(body).Decode(&ids); err != nil {
+		return nil, err
+	}
+	srv.ch <- cmdUnhide(ids)
+	return nil, nil
+}
+
+type cmdDuplicates chan [][]listItem
+
+type cmdMergeDuplicates struct {
+	m    map[SongID]SongID
+	all  bool
+	done chan error
+}
+
+type cmdDuplicatePriority []string
+
+type cmdUnhide []SongID
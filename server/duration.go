@@ -0,0 +1,106 @@
+package server
+
+import (
+	"bytes"
+	"encoding/gob"
+	"log"
+	"time"
+
+	"github.com/boltdb/bolt"
+	"github.com/mjibson/moggio/codec"
+)
+
+const (
+	dbDurations = "durations"
+	// durationBroadcast is how long measured durations are collected before
+	// clients are sent the updated tracks, so a library backfill doesn't
+	// resend the track list for every song.
+	durationBroadcast = time.Second * 5
+)
+
+// measureSongs finds the durations of queued songs whose formats don't
+// record them. It shares no queue with analysis, so listings get their
+// durations without waiting for songs to be analyzed.
+func (srv *Server) measureSongs() {
+	for range srv.measures.wake {
+		for {
+			id, ok := srv.measures.next()
+			if !ok {
+				break
+			}
+			if err := srv.measureSong(id); err != nil {
+				log.Printf("duration %v: %v", id, err)
+			}
+			srv.measures.done(id)
+		}
+	}
+}
+
+func (srv *Server) measureSong(id SongID) error {
+	ch := make(chan songResult)
+	srv.ch <- cmdGetSong{
+		id:   id,
+		done: ch,
+	}
+	r := <-ch
+	if r.err != nil {
+		return r.err
+	}
+	defer r.song.Close()
+	var d time.Duration
+	if du, ok := r.song.(codec.Durationer); ok {
+		var err error
+		if d, err = du.Duration(); err != nil {
+			return err
+		}
+	}
+	// Songs with no duration are saved too, so they aren't measured again.
+	if err := srv.saveDuration(id, d); err != nil {
+		return err
+	}
+	srv.ch <- cmdMeasured{
+		id:       id,
+		duration: d,
+	}
+	return nil
+}
+
+func (srv *Server) saveDuration(id SongID, d time.Duration) error {
+	var buf bytes.Buffer
+	if err := gob.NewEncoder(&buf).Encode(d); err != nil {
+		return err
+	}
+	return srv.update(func(tx *bolt.Tx) error {
+		b, err := tx.CreateBucketIfNotExists([]byte(dbDurations))
+		if err != nil {
+			return err
+		}
+		return b.Put([]byte(id), buf.Bytes())
+	})
+}
+
+func (srv *Server) loadDurations() (map[SongID]time.Duration, error) {
+	m := make(map[SongID]time.Duration)
+	err := srv.view(func(tx *bolt.Tx) error {
+		b := tx.Bucket([]byte(dbDurations))
+		if b == nil {
+			return nil
+		}
+		return b.ForEach(func(k, v []byte) error {
+			var d time.Duration
+			if err := gob.NewDecoder(bytes.NewReader(v)).Decode(&d); err != nil {
+				return err
+			}
+			m[SongID(k)] = d
+			return nil
+		})
+	})
+	return m, err
+}
+
+type cmdMeasured struct {
+	id       SongID
+	duration time.Duration
+}
+
+type cmdBroadcastDurations struct{}
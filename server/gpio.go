@@ -0,0 +1,354 @@
+package server
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"log"
+	"net/url"
+	"os"
+	"reflect"
+	"time"
+
+	"github.com/julienschmidt/httprouter"
+)
+
+// GPIO holds the settings of buttons, a rotary encoder, and an amplifier
+// relay wired to the GPIO pins of a Raspberry Pi or similar board. Lines
+// are numbered by their offsets on the chip, which are the BCM numbers on
+// a Raspberry Pi.
+type GPIO struct {
+	// Chip is the GPIO chip device, or empty for /dev/gpiochip0.
+	Chip string `json:",omitempty"`
+	// Buttons maps lines to the actions of Input keys. Buttons connect
+	// their line to ground; the line's pull-up is enabled.
+	Buttons map[int]string `json:",omitempty"`
+	// Encoder is a rotary encoder that changes the volume.
+	Encoder *GPIOEncoder `json:",omitempty"`
+	// Relay is the line of an amplifier relay, set while playing.
+	Relay *GPIORelay `json:",omitempty"`
+}
+
+// GPIOEncoder is a rotary encoder's A and B lines, which connect to ground
+// like buttons.
+type GPIOEncoder struct {
+	A, B int
+	// Reverse swaps the direction.
+	Reverse bool `json:",omitempty"`
+}
+
+// GPIORelay is an output line on while playing.
+type GPIORelay struct {
+	Line int
+	// ActiveLow sets the line low to turn the relay on.
+	ActiveLow bool `json:",omitempty"`
+	// Delay is how long after playback stops or pauses the relay is
+	// turned off, so it doesn't click between songs. If 0,
+	// defaultRelayDelay is used.
+	Delay time.Duration `json:",omitempty"`
+}
+
+const (
+	defaultGPIOChip = "/dev/gpiochip0"
+	// gpioInterval is how often the GPIO settings are checked.
+	gpioInterval = time.Second * 5
+	// gpioDebounce is the time a line must be stable for a change to be
+	// reported. Button presses closer together are ignored.
+	gpioDebounce       = time.Millisecond * 5
+	gpioButtonDebounce = time.Millisecond * 200
+	// encoderSteps is the number of encoder transitions between detents.
+	encoderSteps      = 4
+	defaultRelayDelay = time.Second * 30
+)
+
+func (g GPIO) validate() error {
+	lines := make(map[int]bool)
+	use := func(l int) error {
+		if l < 0 {
+			return fmt.Errorf("bad GPIO line: %d", l)
+		}
+		if lines[l] {
+			return fmt.Errorf("GPIO line %d used twice", l)
+		}
+		lines[l] = true
+		return nil
+	}
+	for l, a := range g.Buttons {
+		if err := use(l); err != nil {
+			return err
+		}
+		if !inputActions[a] {
+			return fmt.Errorf("unknown action: %s", a)
+		}
+	}
+	if e := g.Encoder; e != nil {
+		if err := use(e.A); err != nil {
+			return err
+		}
+		if err := use(e.B); err != nil {
+			return err
+		}
+	}
+	if r := g.Relay; r != nil {
+		if err := use(r.Line); err != nil {
+			return err
+		}
+		if r.Delay < 0 {
+			return fmt.Errorf("negative relay delay")
+		}
+	}
+	return nil
+}
+
+func (g GPIO) chip() string {
+	if g.Chip == "" {
+		return defaultGPIOChip
+	}
+	return g.Chip
+}
+
+func (g GPIO) enabled() bool {
+	return len(g.Buttons) > 0 || g.Encoder != nil || g.Relay != nil
+}
+
+// gpioEvent is an edge on a line, or an error watching lines.
+type gpioEvent struct {
+	req    *gpioRequest
+	offset int
+	rising bool
+	err    error
+}
+
+// gpioKind is how lines are requested.
+type gpioKind int
+
+const (
+	gpioButton gpioKind = iota
+	gpioEncoder
+	gpioOutput
+	gpioOutputActiveLow
+)
+
+// gpioRequest holds lines of a chip, released when closed.
+type gpioRequest struct {
+	f       *os.File
+	offsets []int
+}
+
+func (r *gpioRequest) Close() error {
+	return r.f.Close()
+}
+
+// gpioLines are the lines requested for the current settings.
+type gpioLines struct {
+	buttons, encoder, relay *gpioRequest
+}
+
+func (l *gpioLines) close() {
+	for _, r := range []*gpioRequest{l.buttons, l.encoder, l.relay} {
+		if r != nil {
+			r.Close()
+		}
+	}
+	*l = gpioLines{}
+}
+
+// open requests the lines of g, watching inputs for events.
+func (l *gpioLines) open(g GPIO, events chan<- gpioEvent) error {
+	var buttons []int
+	for line := range g.Buttons {
+		buttons = append(buttons, line)
+	}
+	var err error
+	if len(buttons) > 0 {
+		if l.buttons, err = requestGPIO(g.chip(), buttons, gpioButton); err != nil {
+			return err
+		}
+		go watchGPIO(l.buttons, events)
+	}
+	if e := g.Encoder; e != nil {
+		if l.encoder, err = requestGPIO(g.chip(), []int{e.A, e.B}, gpioEncoder); err != nil {
+			return err
+		}
+		go watchGPIO(l.encoder, events)
+	}
+	if r := g.Relay; r != nil {
+		kind := gpioOutput
+		if r.ActiveLow {
+			kind = gpioOutputActiveLow
+		}
+		if l.relay, err = requestGPIO(g.chip(), []int{r.Line}, kind); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// encoderTransitions are the steps of transitions between the states of an
+// encoder's lines, A<<1|B, indexed by old<<2|new; 0 for none or invalid.
+var encoderTransitions = [16]int{0, -1, 1, 0, 1, 0, 0, -1, -1, 0, 0, 1, 0, 1, -1, 0}
+
+// watchGPIO runs the actions of buttons and the encoder, and turns the
+// relay on and off with playback.
+func (srv *Server) watchGPIO() {
+	if !gpioSupported {
+		return
+	}
+	events := make(chan gpioEvent)
+	var (
+		g     GPIO
+		lines gpioLines
+		// pressed is when buttons were last pressed.
+		pressed = make(map[int]time.Time)
+		// state is the encoder's lines, and steps its transitions since
+		// the last detent.
+		state, steps int
+		volume       float64
+		playing      bool
+		relayOff     <-chan time.Time
+	)
+	action := func(a string) {
+		if err := srv.inputAction("gpio", a, &volume); err != nil {
+			log.Printf("gpio: %s: %v", a, err)
+		}
+	}
+	switchRelay := func(on bool) {
+		if lines.relay == nil {
+			return
+		}
+		if err := setGPIO(lines.relay, on); err != nil {
+			log.Printf("gpio: relay: %v", err)
+		}
+	}
+	update := func() {
+		ch := make(chan GPIO)
+		srv.ch <- cmdGetGPIO(ch)
+		ng := <-ch
+		if reflect.DeepEqual(ng, g) && (lines != gpioLines{} || !g.enabled()) {
+			return
+		}
+		lines.close()
+		g = ng
+		if !g.enabled() {
+			return
+		}
+		if err := lines.open(g, events); err != nil {
+			log.Printf("gpio: %v", err)
+			lines.close()
+			return
+		}
+		log.Println("gpio: using", g.chip())
+		if lines.encoder != nil {
+			if v, err := getGPIO(lines.encoder); err == nil {
+				state = gpioBit(v[0])<<1 | gpioBit(v[1])
+			}
+		}
+		switchRelay(playing)
+	}
+	update()
+	tick := time.NewTicker(gpioInterval)
+	defer tick.Stop()
+	for {
+		select {
+		case <-tick.C:
+			update()
+		case p := <-srv.relay:
+			playing = p
+			relayOff = nil
+			if playing {
+				switchRelay(true)
+			} else if g.Relay != nil {
+				d := g.Relay.Delay
+				if d == 0 {
+					d = defaultRelayDelay
+				}
+				relayOff = time.After(d)
+			}
+		case <-relayOff:
+			relayOff = nil
+			switchRelay(false)
+		case e := <-events:
+			switch {
+			case e.err != nil:
+				// Requests closed by update are already released.
+				if e.req == lines.buttons || e.req == lines.encoder {
+					log.Printf("gpio: %v", e.err)
+					lines.close()
+				}
+			case e.req == lines.buttons && e.rising:
+				if time.Since(pressed[e.offset]) < gpioButtonDebounce {
+					continue
+				}
+				pressed[e.offset] = time.Now()
+				action(g.Buttons[e.offset])
+			case e.req == lines.encoder:
+				bit := 1
+				if e.offset == g.Encoder.A {
+					bit = 2
+				}
+				next := state &^ bit
+				if e.rising {
+					next |= bit
+				}
+				steps += encoderTransitions[state<<2|next]
+				state = next
+				if steps <= -encoderSteps || steps >= encoderSteps {
+					up := steps > 0 != g.Encoder.Reverse
+					steps = 0
+					if up {
+						action("volume_up")
+					} else {
+						action("volume_down")
+					}
+				}
+			}
+		}
+	}
+}
+
+func gpioBit(v bool) int {
+	if v {
+		return 1
+	}
+	return 0
+}
+
+// setRelay sends whether playback is playing to the relay, replacing any
+// not yet received.
+func (srv *Server) setRelay() {
+	if !gpioSupported {
+		return
+	}
+	select {
+	case <-srv.relay:
+	default:
+	}
+	srv.relay <- srv.state == statePlay
+}
+
+func (srv *Server) GetGPIO(body io.Reader, form url.Values, ps httprouter.Params) (interface{}, error) {
+	ch := make(chan GPIO)
+	srv.ch <- cmdGetGPIO(ch)
+	return <-ch, nil
+}
+
+// SetGPIO replaces the GPIO settings. Lines are requested within
+// gpioInterval.
+func (srv *Server) SetGPIO(body io.Reader, form url.Values, ps httprouter.Params) (interface{}, error) {
+	var g GPIO
+	if err := json.NewDecoder(body).Decode(&g); err != nil {
+		return nil, err
+	}
+	if err := g.validate(); err != nil {
+		return nil, err
+	}
+	if g.enabled() && !gpioSupported {
+		return nil, errGPIOUnsupported
+	}
+	srv.ch <- cmdSetGPIO(g)
+	return nil, nil
+}
+
+type cmdGetGPIO chan GPIO
+
+type cmdSetGPIO GPIO
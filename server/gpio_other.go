@@ -0,0 +1,23 @@
+// +build !linux !arm,!arm64
+
+package server
+
+import "fmt"
+
+const gpioSupported = false
+
+var errGPIOUnsupported = fmt.Errorf("gpio: only supported on Linux ARM builds")
+
+func requestGPIO(chip string, offsets []int, kind gpioKind) (*gpioRequest, error) {
+	return nil, errGPIOUnsupported
+}
+
+func getGPIO(r *gpioRequest) ([]bool, error) {
+	return nil, errGPIOUnsupported
+}
+
+func setGPIO(r *gpioRequest, on bool) error {
+	return errGPIOUnsupported
+}
+
+func watchGPIO(r *gpioRequest, events chan<- gpioEvent) {}
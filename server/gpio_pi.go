@@ -0,0 +1,160 @@
+// +build linux,arm linux,arm64
+
+package server
+
+import (
+	"encoding/binary"
+	"fmt"
+	"io"
+	"os"
+	"syscall"
+	"unsafe"
+)
+
+const gpioSupported = true
+
+var errGPIOUnsupported error
+
+// From linux/gpio.h, version 2 of the character device API.
+const (
+	gpioMaxLines = 64
+
+	gpioFlagActiveLow   = 1 << 1
+	gpioFlagInput       = 1 << 2
+	gpioFlagOutput      = 1 << 3
+	gpioFlagEdgeRising  = 1 << 4
+	gpioFlagEdgeFalling = 1 << 5
+	gpioFlagBiasPullUp  = 1 << 8
+
+	gpioAttrDebounce = 3
+	gpioEdgeRising   = 1
+
+	// gpioEventSize is the size of struct gpio_v2_line_event.
+	gpioEventSize = 48
+)
+
+// gpioLineRequest is struct gpio_v2_line_request.
+type gpioLineRequest struct {
+	Offsets   [gpioMaxLines]uint32
+	Consumer  [32]byte
+	Flags     uint64
+	NumAttrs  uint32
+	_         [5]uint32
+	Attrs     [10]gpioConfigAttr
+	NumLines  uint32
+	EventSize uint32
+	_         [5]uint32
+	Fd        int32
+}
+
+// gpioConfigAttr is struct gpio_v2_line_config_attribute.
+type gpioConfigAttr struct {
+	ID    uint32
+	_     uint32
+	Value uint64
+	Mask  uint64
+}
+
+// gpioLineValues is struct gpio_v2_line_values.
+type gpioLineValues struct {
+	Bits, Mask uint64
+}
+
+var (
+	gpioGetLine   = gpioIOWR(0x07, unsafe.Sizeof(gpioLineRequest{}))
+	gpioGetValues = gpioIOWR(0x0e, unsafe.Sizeof(gpioLineValues{}))
+	gpioSetValues = gpioIOWR(0x0f, unsafe.Sizeof(gpioLineValues{}))
+)
+
+func gpioIOWR(nr, size uintptr) uintptr {
+	return 3<<30 | size<<16 | 0xb4<<8 | nr
+}
+
+func gpioIoctl(fd, req uintptr, arg unsafe.Pointer) error {
+	if _, _, errno := syscall.Syscall(syscall.SYS_IOCTL, fd, req, uintptr(arg)); errno != 0 {
+		return errno
+	}
+	return nil
+}
+
+// requestGPIO requests offsets of chip as kind. Inputs are active low with
+// their pull-ups enabled; buttons report presses and encoders both edges.
+func requestGPIO(chip string, offsets []int, kind gpioKind) (*gpioRequest, error) {
+	if len(offsets) > gpioMaxLines {
+		return nil, fmt.Errorf("gpio: too many lines")
+	}
+	c, err := os.Open(chip)
+	if err != nil {
+		return nil, err
+	}
+	defer c.Close()
+	var req gpioLineRequest
+	for i, o := range offsets {
+		req.Offsets[i] = uint32(o)
+	}
+	copy(req.Consumer[:], "moggio")
+	req.NumLines = uint32(len(offsets))
+	switch kind {
+	case gpioButton, gpioEncoder:
+		req.Flags = gpioFlagInput | gpioFlagActiveLow | gpioFlagBiasPullUp | gpioFlagEdgeRising
+		if kind == gpioEncoder {
+			req.Flags |= gpioFlagEdgeFalling
+		}
+		req.NumAttrs = 1
+		req.Attrs[0] = gpioConfigAttr{
+			ID:    gpioAttrDebounce,
+			Value: uint64(gpioDebounce.Microseconds()),
+			Mask:  1<<uint(len(offsets)) - 1,
+		}
+	case gpioOutput:
+		req.Flags = gpioFlagOutput
+	case gpioOutputActiveLow:
+		req.Flags = gpioFlagOutput | gpioFlagActiveLow
+	}
+	if err := gpioIoctl(c.Fd(), gpioGetLine, unsafe.Pointer(&req)); err != nil {
+		return nil, fmt.Errorf("gpio: request lines %v of %s: %v", offsets, chip, err)
+	}
+	return &gpioRequest{
+		f:       os.NewFile(uintptr(req.Fd), chip),
+		offsets: offsets,
+	}, nil
+}
+
+// getGPIO returns the values of the lines of r.
+func getGPIO(r *gpioRequest) ([]bool, error) {
+	v := gpioLineValues{Mask: 1<<uint(len(r.offsets)) - 1}
+	if err := gpioIoctl(r.f.Fd(), gpioGetValues, unsafe.Pointer(&v)); err != nil {
+		return nil, err
+	}
+	values := make([]bool, len(r.offsets))
+	for i := range values {
+		values[i] = v.Bits&(1<<uint(i)) != 0
+	}
+	return values, nil
+}
+
+// setGPIO sets the lines of r.
+func setGPIO(r *gpioRequest, on bool) error {
+	v := gpioLineValues{Mask: 1<<uint(len(r.offsets)) - 1}
+	if on {
+		v.Bits = v.Mask
+	}
+	return gpioIoctl(r.f.Fd(), gpioSetValues, unsafe.Pointer(&v))
+}
+
+// watchGPIO sends the edges of r's lines to events until it is closed.
+func watchGPIO(r *gpioRequest, events chan<- gpioEvent) {
+	b := make([]byte, gpioEventSize)
+	for {
+		if _, err := io.ReadFull(r.f, b); err != nil {
+			events <- gpioEvent{req: r, err: err}
+			return
+		}
+		// Skip the timestamp to the event ID and offset.
+		events <- gpioEvent{
+			req:    r,
+			offset: int(binary.LittleEndian.Uint32(b[12:])),
+			rising: binary.LittleEndian.Uint32(b[8:]) == gpioEdgeRising,
+		}
+	}
+}
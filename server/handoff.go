@@ -0,0 +1,187 @@
+package server
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"net/http"
+	"net/url"
+	"time"
+
+	"github.com/julienschmidt/httprouter"
+	"github.com/mjibson/moggio/codec"
+)
+
+const (
+	// discoverTime is how long to wait for other instances to answer.
+	discoverTime = time.Second * 2
+	// handoffTimeout bounds handing off to another instance.
+	handoffTimeout = time.Second * 30
+)
+
+// Handoff is the playback state handed from one instance to another.
+type Handoff struct {
+	Queue []HandoffSong
+	// Index is the current song in Queue, and Position the time in it.
+	Index    int
+	Position time.Duration
+	Play     bool
+}
+
+// HandoffSong is a song of a handoff. Its info is used to find it if the
+// receiving library doesn't have the same ID.
+type HandoffSong struct {
+	ID   string
+	Info *codec.SongInfo
+}
+
+// HandoffResult is the result of receiving a handoff.
+type HandoffResult struct {
+	// Songs is the number of songs queued, and Unmatched the songs not in
+	// the library.
+	Songs     int
+	Unmatched []HandoffSong
+}
+
+// Instances lists the other moggio instances found on the local network.
+func (srv *Server) Instances(body io.Reader, form url.Values, ps httprouter.Params) (interface{}, error) {
+	instances, err := discoverInstances(discoverTime)
+	if err != nil {
+		return nil, err
+	}
+	if instances == nil {
+		instances = []Instance{}
+	}
+	return instances, nil
+}
+
+// HandoffSend hands off the queue and position to another instance, named
+// by Name as found by Instances, or by its address, Addr, and stops
+// playback here unless Keep is set. Token is the other instance's auth
+// token, if it requires one.
+func (srv *Server) HandoffSend(body io.Reader, form url.Values, ps httprouter.Params) (interface{}, error) {
+	var req struct {
+		Name  string
+		Addr  string
+		Token string
+		Keep  bool
+	}
+	if err := json.NewDecoder(body).Decode(&req); err != nil {
+		return nil, err
+	}
+	if req.Addr == "" {
+		instances, err := discoverInstances(discoverTime)
+		if err != nil {
+			return nil, err
+		}
+		for _, i := range instances {
+			if i.Name == req.Name {
+				req.Addr = i.Addr
+			}
+		}
+		if req.Addr == "" {
+			return nil, fmt.Errorf("instance not found: %s", req.Name)
+		}
+	}
+	ch := make(chan Handoff)
+	srv.ch <- cmdGetHandoff(ch)
+	h := <-ch
+	if len(h.Queue) == 0 {
+		return nil, fmt.Errorf("nothing to hand off")
+	}
+	b, err := json.Marshal(h)
+	if err != nil {
+		return nil, err
+	}
+	r, err := http.NewRequest("POST", "http://"+req.Addr+"/api/handoff/receive", bytes.NewReader(b))
+	if err != nil {
+		return nil, err
+	}
+	r.Header.Set("Content-Type", "application/json")
+	if req.Token != "" {
+		r.Header.Set("Authorization", "Bearer "+req.Token)
+	}
+	client := http.Client{Timeout: handoffTimeout}
+	resp, err := client.Do(r)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode != http.StatusOK {
+		msg, _ := ioutil.ReadAll(io.LimitReader(resp.Body, 1024))
+		return nil, fmt.Errorf("%s: %s: %s", req.Addr, resp.Status, bytes.TrimSpace(msg))
+	}
+	var res HandoffResult
+	if err := json.NewDecoder(resp.Body).Decode(&res); err != nil {
+		return nil, err
+	}
+	srv.audit(ps, "handoff", req.Addr)
+	if !req.Keep {
+		srv.ch <- cmdStop
+	}
+	return res, nil
+}
+
+// HandoffReceive replaces the queue with a handoff from another instance
+// and continues playback from its position. Songs not in this library are
+// matched by artist, title, and duration, or else skipped.
+func (srv *Server) HandoffReceive(body io.Reader, form url.Values, ps httprouter.Params) (interface{}, error) {
+	var h Handoff
+	if err := json.NewDecoder(body).Decode(&h); err != nil {
+		return nil, err
+	}
+	if h.Index < 0 || h.Index >= len(h.Queue) {
+		return nil, fmt.Errorf("bad index: %d", h.Index)
+	}
+	srv.audit(ps, "handoff receive", fmt.Sprintf("%d songs", len(h.Queue)))
+	ch := make(chan HandoffResult)
+	srv.ch <- cmdReceiveHandoff{
+		h:    h,
+		done: ch,
+	}
+	return <-ch, nil
+}
+
+// receiveHandoff maps the songs of h to this library. It returns the
+// queue, the index of the current song, or of the next found if it wasn't,
+// or 0, and the position in it. It should only be called by the commands()
+// function.
+func (srv *Server) receiveHandoff(h Handoff) (q Playlist, index int, pos time.Duration, res HandoffResult) {
+	idx := srv.newTrackIndex()
+	res.Unmatched = []HandoffSong{}
+	index = -1
+	for i, item := range h.Queue {
+		id := SongID(item.ID)
+		if !srv.hasSong(id) || srv.Hidden[id] {
+			id = ""
+			if item.Info != nil {
+				id, _ = idx.match([]string{item.Info.Artist}, item.Info.Title, item.Info.Time)
+			}
+			if id == "" {
+				res.Unmatched = append(res.Unmatched, item)
+				continue
+			}
+		}
+		if i >= h.Index && index < 0 {
+			index = len(q)
+			if i == h.Index {
+				pos = h.Position
+			}
+		}
+		q = append(q, id)
+	}
+	if index < 0 {
+		index = 0
+	}
+	res.Songs = len(q)
+	return q, index, pos, res
+}
+
+type cmdGetHandoff chan Handoff
+
+type cmdReceiveHandoff struct {
+	h    Handoff
+	done chan HandoffResult
+}
@@ -0,0 +1,346 @@
+package server
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"log"
+	"net/url"
+	"os"
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/julienschmidt/httprouter"
+	"github.com/mjibson/moggio/codec"
+)
+
+// healthSeconds is how much of each song is decoded when verifying it.
+const healthSeconds = 5
+
+// Problems of songs found by the library health check.
+const (
+	// healthMissing songs have no file.
+	healthMissing = "missing"
+	// healthUnreadable songs can't be opened or their headers parsed.
+	healthUnreadable = "unreadable"
+	// healthCorrupt songs fail to decode.
+	healthCorrupt = "corrupt"
+	// healthChecksum songs decode to audio that doesn't match the checksum
+	// their format records, like FLAC's MD5.
+	healthChecksum = "checksum"
+)
+
+// openProblem returns the problem of a song that failed to open with err.
+func openProblem(err error) string {
+	if os.IsNotExist(err) {
+		return healthMissing
+	}
+	return healthUnreadable
+}
+
+// HealthIssue is a song the health check found a problem with.
+type HealthIssue struct {
+	ID      SongID
+	Info    *codec.SongInfo
+	Problem string
+	Error   string
+}
+
+// HealthReport is the result of the last health check of the library.
+type HealthReport struct {
+	Running bool
+	// Verify is set if songs with checksums were decoded entirely to
+	// verify them.
+	Verify   bool
+	Checked  int
+	Total    int
+	Started  time.Time
+	Finished time.Time
+	Issues   []HealthIssue
+}
+
+// health is the state of the health check.
+type health struct {
+	sync.Mutex
+	report HealthReport
+}
+
+// start marks a check of total songs as running, and reports false if one
+// already is.
+func (h *health) start(total int, verify bool) bool {
+	h.Lock()
+	defer h.Unlock()
+	if h.report.Running {
+		return false
+	}
+	h.report = HealthReport{
+		Running: true,
+		Verify:  verify,
+		Total:   total,
+		Started: time.Now(),
+		Issues:  []HealthIssue{},
+	}
+	return true
+}
+
+func (h *health) checked(issue *HealthIssue) {
+	h.Lock()
+	defer h.Unlock()
+	h.report.Checked++
+	if issue != nil {
+		h.report.Issues = append(h.report.Issues, *issue)
+	}
+}
+
+// found records issue, found outside a check, replacing any of its song.
+func (h *health) found(issue HealthIssue) {
+	h.Lock()
+	defer h.Unlock()
+	for i, is := range h.report.Issues {
+		if is.ID == issue.ID {
+			h.report.Issues[i] = issue
+			return
+		}
+	}
+	h.report.Issues = append(h.report.Issues, issue)
+}
+
+func (h *health) finish() {
+	h.Lock()
+	defer h.Unlock()
+	h.report.Running = false
+	h.report.Finished = time.Now()
+}
+
+// resolve removes the issues of ids, since they were acted on.
+func (h *health) resolve(ids []SongID) {
+	h.Lock()
+	defer h.Unlock()
+	done := make(map[SongID]bool)
+	for _, id := range ids {
+		done[id] = true
+	}
+	issues := []HealthIssue{}
+	for _, is := range h.report.Issues {
+		if !done[is.ID] {
+			issues = append(issues, is)
+		}
+	}
+	h.report.Issues = issues
+}
+
+func (h *health) get() HealthReport {
+	h.Lock()
+	defer h.Unlock()
+	r := h.report
+	r.Issues = append([]HealthIssue{}, r.Issues...)
+	return r
+}
+
+// checkHealth verifies songs one at a time.
+func (srv *Server) checkHealth(songs []listItem, verify bool) {
+	defer srv.health.finish()
+	for _, it := range songs {
+		var issue *HealthIssue
+		if problem, err := srv.verifySong(it.ID, verify); err != nil {
+			issue = &HealthIssue{
+				ID:      it.ID,
+				Info:    it.Info,
+				Problem: problem,
+				Error:   err.Error(),
+			}
+			log.Printf("health %v: %s: %v", it.ID, problem, err)
+		}
+		srv.health.checked(issue)
+	}
+}
+
+// verifySong checks that the file of id exists, if it has one, and decodes
+// its first seconds. With verify, songs with checksums are decoded entirely
+// and checked against them. It returns the problem with the song if it
+// fails.
+func (srv *Server) verifySong(id SongID, verify bool) (problem string, err error) {
+	fch := make(chan fileResult)
+	srv.ch <- cmdOpenFile{
+		id:   id,
+		done: fch,
+	}
+	if res := <-fch; os.IsNotExist(res.err) {
+		return healthMissing, res.err
+	} else if res.err == nil {
+		res.f.Close()
+	}
+	ch := make(chan songResult)
+	srv.ch <- cmdGetSong{
+		id:   id,
+		done: ch,
+	}
+	r := <-ch
+	if r.err != nil {
+		return healthUnreadable, r.err
+	}
+	song := r.song
+	defer song.Close()
+	// Decoders may panic on garbage.
+	defer func() {
+		if e := recover(); e != nil {
+			problem, err = healthCorrupt, fmt.Errorf("decode: %v", e)
+		}
+	}()
+	sr, channels, err := song.Init()
+	if err != nil {
+		return healthUnreadable, err
+	}
+	if sr <= 0 || channels <= 0 {
+		return healthUnreadable, fmt.Errorf("bad format: %d Hz, %d channels", sr, channels)
+	}
+	v, ok := song.(codec.Verifier)
+	verify = verify && ok
+	n := sr * channels
+	read := 0
+	for i := 0; i < healthSeconds || verify; i++ {
+		samples, err := song.Play(n)
+		read += len(samples)
+		if err == io.EOF {
+			// Some decoders return it with their last samples.
+			break
+		}
+		if err != nil {
+			return healthCorrupt, err
+		}
+		if len(samples) < n {
+			break
+		}
+	}
+	if read == 0 {
+		return healthCorrupt, fmt.Errorf("no audio")
+	}
+	if verify {
+		if match, ok := v.Verified(); ok && !match {
+			return healthChecksum, fmt.Errorf("decoded audio doesn't match its checksum")
+		}
+	}
+	return "", nil
+}
+
+// verifyPlayed records the current song in the health report if it was
+// played to its end and its audio didn't match its checksum, returning the
+// error. It should only be called by the commands() function.
+func (srv *Server) verifyPlayed() error {
+	v, ok := srv.song.(codec.Verifier)
+	if !ok {
+		return nil
+	}
+	if match, ok := v.Verified(); !ok || match {
+		return nil
+	}
+	info := srv.info
+	err := fmt.Errorf("%s: decoded audio doesn't match its checksum", info.Title)
+	srv.health.found(HealthIssue{
+		ID:      srv.songID,
+		Info:    &info,
+		Problem: healthChecksum,
+		Error:   err.Error(),
+	})
+	return err
+}
+
+// localSongs returns the listed songs of local protocols, ordered by ID. It
+// should only be called by the commands() function.
+func (srv *Server) localSongs() []listItem {
+	var songs []listItem
+	for name, protos := range srv.Protocols {
+		if !localProtocols[name] {
+			continue
+		}
+		for key, inst := range protos {
+			sl, _ := inst.List()
+			for id, info := range sl {
+				sid := SongID(codec.NewID(name, key, string(id)))
+				if srv.Hidden[sid] {
+					continue
+				}
+				songs = append(songs, listItem{
+					ID:   sid,
+					Info: info,
+				})
+			}
+		}
+	}
+	sort.Slice(songs, func(i, j int) bool {
+		return songs[i].ID < songs[j].ID
+	})
+	return songs
+}
+
+// LibraryHealth returns the report of the last health check.
+func (srv *Server) LibraryHealth(body io.Reader, form url.Values, ps httprouter.Params) (interface{}, error) {
+	return srv.health.get(), nil
+}
+
+// LibraryHealthCheck starts a health check of the songs of local protocols
+// in the background. Each is checked for a missing file, then a few seconds
+// of it are decoded. With the verify parameter, songs whose formats record
+// checksums, like FLAC's MD5, are decoded entirely and verified against
+// them, finding bit rot.
+func (srv *Server) LibraryHealthCheck(body io.Reader, form url.Values, ps httprouter.Params) (interface{}, error) {
+	ch := make(chan []listItem)
+	srv.ch <- cmdLocalSongs(ch)
+	songs := <-ch
+	verify := form.Get("verify") != ""
+	if !srv.health.start(len(songs), verify) {
+		return nil, fmt.Errorf("health check already running")
+	}
+	srv.audit(ps, "health check", fmt.Sprintf("%d songs, verify: %v", len(songs), verify))
+	go srv.checkHealth(songs, verify)
+	return nil, nil
+}
+
+// LibraryHealthRemove removes songs, usually those found by the health
+// check, from the queue and playlists and no longer lists them.
+func (srv *Server) LibraryHealthRemove(body io.Reader, form url.Values, ps httprouter.Params) (interface{}, error) {
+	var ids []SongID
+	if err := json.NewDecoder(body).Decode(&ids); err != nil {
+		return nil, err
+	}
+	if len(ids) == 0 {
+		return nil, fmt.Errorf("missing songs")
+	}
+	srv.audit(ps, "health remove", fmt.Sprintf("%d songs", len(ids)))
+	srv.ch <- cmdRemoveSongs(ids)
+	srv.health.resolve(ids)
+	return nil, nil
+}
+
+// LibraryHealthRelocate replaces songs, the keys of the request, with
+// their new locations, its values, in the queue, playlists, and history,
+// and no longer lists the old ones.
+func (srv *Server) LibraryHealthRelocate(body io.Reader, form url.Values, ps httprouter.Params) (interface{}, error) {
+	var m map[SongID]SongID
+	if err := json.NewDecoder(body).Decode(&m); err != nil {
+		return nil, err
+	}
+	if len(m) == 0 {
+		return nil, fmt.Errorf("missing songs")
+	}
+	srv.audit(ps, "health relocate", fmt.Sprintf("%d songs", len(m)))
+	ch := make(chan relocateResult)
+	srv.ch <- cmdRelocate{
+		m:    m,
+		done: ch,
+	}
+	if res := <-ch; res.err != nil {
+		return nil, res.err
+	}
+	var ids []SongID
+	for id := range m {
+		ids = append(ids, id)
+	}
+	srv.health.resolve(ids)
+	return nil, nil
+}
+
+type cmdLocalSongs chan []listItem
+
+type cmdRemoveSongs []SongID
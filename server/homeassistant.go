@@ -0,0 +1,293 @@
+package server
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/url"
+	"os"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/julienschmidt/httprouter"
+	"github.com/mjibson/moggio/output"
+)
+
+// The Home Assistant API lets a media_player integration control moggio.
+// Its JSON names match Home Assistant's media_player attributes and service
+// data, so an integration can pass them through. State changes are pushed
+// by the /ws/ websocket's status events or by webhooks; the integration
+// then reads /api/ha/state.
+
+// haFeatures are the media_player features supported.
+var haFeatures = []string{
+	"pause", "seek", "volume_set", "previous_track", "next_track",
+	"play_media", "select_source", "stop", "play", "shuffle_set",
+	"repeat_set", "browse_media",
+}
+
+// haDefaultSource is the source name of the default output device.
+const haDefaultSource = "Default"
+
+// haInfo describes the player for discovery.
+type haInfo struct {
+	Name     string   `json:"name"`
+	Hostname string   `json:"hostname"`
+	Features []string `json:"supported_features"`
+	// Paths of the API.
+	State   string `json:"state"`
+	Service string `json:"service"`
+	Browse  string `json:"browse"`
+	Events  string `json:"events"`
+}
+
+// haState is the state and attributes of a media_player entity.
+type haState struct {
+	State                  string    `json:"state"`
+	MediaContentID         SongID    `json:"media_content_id,omitempty"`
+	MediaContentType       string    `json:"media_content_type,omitempty"`
+	MediaTitle             string    `json:"media_title,omitempty"`
+	MediaArtist            string    `json:"media_artist,omitempty"`
+	MediaAlbumName         string    `json:"media_album_name,omitempty"`
+	MediaTrack             int       `json:"media_track,omitempty"`
+	MediaDuration          float64   `json:"media_duration,omitempty"`
+	MediaPosition          float64   `json:"media_position"`
+	MediaPositionUpdatedAt time.Time `json:"media_position_updated_at"`
+	EntityPicture          string    `json:"entity_picture,omitempty"`
+	VolumeLevel            float64   `json:"volume_level"`
+	Shuffle                bool      `json:"shuffle"`
+	Repeat                 string    `json:"repeat"`
+	Source                 string    `json:"source"`
+	SourceList             []string  `json:"source_list"`
+}
+
+// haBrowse is a node of the library tree, like Home Assistant's BrowseMedia.
+type haBrowse struct {
+	Title            string     `json:"title"`
+	MediaClass       string     `json:"media_class"`
+	MediaContentID   string     `json:"media_content_id"`
+	MediaContentType string     `json:"media_content_type"`
+	CanPlay          bool       `json:"can_play"`
+	CanExpand        bool       `json:"can_expand"`
+	Thumbnail        string     `json:"thumbnail,omitempty"`
+	Children         []haBrowse `json:"children,omitempty"`
+}
+
+func (srv *Server) HAInfo(body io.Reader, form url.Values, ps httprouter.Params) (interface{}, error) {
+	hostname, _ := os.Hostname()
+	name := InstanceName
+	if name == "" {
+		name = UPnPName
+	}
+	return haInfo{
+		Name:     name,
+		Hostname: hostname,
+		Features: haFeatures,
+		State:    "/api/ha/state",
+		Service:  "/api/ha/service/",
+		Browse:   "/api/ha/browse",
+		Events:   "/ws/",
+	}, nil
+}
+
+func (srv *Server) HAState(body io.Reader, form url.Values, ps httprouter.Params) (interface{}, error) {
+	st := srv.rendererStatus()
+	s := haState{
+		State:                  "idle",
+		MediaPosition:          st.Elapsed.Seconds(),
+		MediaPositionUpdatedAt: time.Now().UTC(),
+		VolumeLevel:            st.Volume,
+		Shuffle:                st.Random,
+		Repeat:                 "off",
+		Source:                 haSource(st.Device),
+		SourceList:             []string{haDefaultSource},
+	}
+	if st.Repeat {
+		s.Repeat = "all"
+	}
+	switch st.State {
+	case statePlay:
+		s.State = "playing"
+	case statePause:
+		s.State = "paused"
+	}
+	if st.State != stateStop && st.Song != "" {
+		info := st.SongInfo
+		s.MediaContentID = st.Song
+		s.MediaContentType = "music"
+		s.MediaTitle = info.Title
+		s.MediaArtist = info.Artist
+		s.MediaAlbumName = info.Album
+		s.MediaTrack = int(info.Track)
+		s.MediaDuration = st.Time.Seconds()
+		s.EntityPicture = haPicture(st.Song, info.ImageURL)
+	}
+	if devs, err := output.Devices(st.Backend); err == nil {
+		for _, d := range devs {
+			if d.Name != "" {
+				s.SourceList = append(s.SourceList, d.Name)
+			}
+		}
+	}
+	return s, nil
+}
+
+func haSource(device string) string {
+	if device == "" {
+		return haDefaultSource
+	}
+	return device
+}
+
+// haPicture returns the artwork URL of a song with the given image URL.
+func haPicture(id SongID, image string) string {
+	if localProtocols[id.Protocol()] || strings.HasPrefix(image, artURL) {
+		return artURL + url.PathEscape(string(id))
+	}
+	if strings.HasPrefix(image, "http") {
+		return image
+	}
+	return ""
+}
+
+// HAService calls a media_player service, with its service data in the
+// body, through the same commands as the HTTP API.
+func (srv *Server) HAService(body io.Reader, form url.Values, ps httprouter.Params) (interface{}, error) {
+	var data struct {
+		VolumeLevel      float64 `json:"volume_level"`
+		SeekPosition     float64 `json:"seek_position"`
+		Source           string  `json:"source"`
+		Shuffle          bool    `json:"shuffle"`
+		Repeat           string  `json:"repeat"`
+		MediaContentID   string  `json:"media_content_id"`
+		MediaContentType string  `json:"media_content_type"`
+		Enqueue          string  `json:"enqueue"`
+	}
+	if err := json.NewDecoder(body).Decode(&data); err != nil && err != io.EOF {
+		return nil, err
+	}
+	cmd := func(name string, form url.Values, body interface{}) error {
+		var b bytes.Buffer
+		if body != nil {
+			if err := json.NewEncoder(&b).Encode(body); err != nil {
+				return err
+			}
+		}
+		p := append(httprouter.Params{{Key: "cmd", Value: name}}, ps...)
+		_, err := srv.Cmd(&b, form, p)
+		return err
+	}
+	st := srv.rendererStatus()
+	switch service := ps.ByName("service"); service {
+	case "media_play":
+		switch st.State {
+		case statePause:
+			return nil, cmd("pause", nil, nil)
+		case stateStop:
+			return nil, cmd("play", nil, nil)
+		}
+	case "media_pause":
+		if st.State == statePlay {
+			return nil, cmd("pause", nil, nil)
+		}
+	case "media_play_pause":
+		if st.State == stateStop {
+			return nil, cmd("play", nil, nil)
+		}
+		return nil, cmd("pause", nil, nil)
+	case "media_stop":
+		return nil, cmd("stop", nil, nil)
+	case "media_next_track":
+		return nil, cmd("next", nil, nil)
+	case "media_previous_track":
+		return nil, cmd("prev", nil, nil)
+	case "volume_set":
+		v := strconv.FormatFloat(data.VolumeLevel, 'g', -1, 64)
+		return nil, cmd("volume", url.Values{"v": {v}}, nil)
+	case "media_seek":
+		pos := time.Duration(data.SeekPosition * float64(time.Second))
+		return nil, cmd("seek", url.Values{"pos": {pos.String()}}, nil)
+	case "select_source":
+		name := data.Source
+		if name == haDefaultSource {
+			name = ""
+		}
+		return nil, cmd("device", url.Values{"name": {name}}, nil)
+	case "shuffle_set":
+		if data.Shuffle != st.Random {
+			return nil, cmd("random", nil, nil)
+		}
+	case "repeat_set":
+		if (data.Repeat != "off") != st.Repeat {
+			return nil, cmd("repeat", nil, nil)
+		}
+	case "play_media":
+		id := data.MediaContentID
+		switch data.Enqueue {
+		case "", "play", "replace":
+			return nil, cmd("play_track", nil, id)
+		case "add", "next":
+			// The queue has no insert, so next adds to the end too.
+			b, err := json.Marshal(PlaylistChange{{"add", id}})
+			if err != nil {
+				return nil, err
+			}
+			_, err = srv.QueueChange(bytes.NewReader(b), nil, ps)
+			return nil, err
+		default:
+			return nil, fmt.Errorf("unknown enqueue: %v", data.Enqueue)
+		}
+	default:
+		return nil, fmt.Errorf("unknown service: %v", service)
+	}
+	return nil, nil
+}
+
+// HABrowse returns the library node with the media_content_id parameter,
+// or the root, with its children.
+func (srv *Server) HABrowse(body io.Reader, form url.Values, ps httprouter.Params) (interface{}, error) {
+	id := form.Get("media_content_id")
+	if id == "" {
+		id = "0"
+	}
+	l := srv.upnpLibrary()
+	o := l.objects[id]
+	if o == nil {
+		return nil, fmt.Errorf("unknown media: %v", id)
+	}
+	b := haNode(o)
+	for _, c := range o.children {
+		b.Children = append(b.Children, haNode(c))
+	}
+	return b, nil
+}
+
+func haNode(o *upnpObject) haBrowse {
+	if o.song != nil {
+		info := o.song.Info
+		return haBrowse{
+			Title:            info.Title,
+			MediaClass:       "track",
+			MediaContentID:   string(o.song.ID),
+			MediaContentType: "music",
+			CanPlay:          true,
+			Thumbnail:        haPicture(o.song.ID, info.ImageURL),
+		}
+	}
+	class := "directory"
+	switch o.class {
+	case "object.container.person.musicArtist":
+		class = "artist"
+	case "object.container.album.musicAlbum":
+		class = "album"
+	}
+	return haBrowse{
+		Title:            o.title,
+		MediaClass:       class,
+		MediaContentID:   o.id,
+		MediaContentType: class,
+		CanExpand:        true,
+	}
+}
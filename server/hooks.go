@@ -0,0 +1,202 @@
+package server
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"log"
+	"mime"
+	"net"
+	"net/http"
+	"net/url"
+	"os"
+	"os/exec"
+	"time"
+
+	"github.com/julienschmidt/httprouter"
+	"github.com/mjibson/moggio/codec"
+)
+
+// Events that fire hooks.
+const (
+	EventTrackStart = "track_start"
+	EventTrackStop  = "track_stop"
+	EventPause      = "pause"
+	EventResume     = "resume"
+	EventQueue      = "queue"
+	EventVolume     = "volume"
+)
+
+var events = map[string]bool{
+	EventTrackStart: true,
+	EventTrackStop:  true,
+	EventPause:      true,
+	EventResume:     true,
+	EventQueue:      true,
+	EventVolume:     true,
+}
+
+const (
+	// hookTimeout bounds how long a hook may run.
+	hookTimeout = time.Second * 30
+	// hookQueue is the number of hook runs that may wait. Events past it
+	// are dropped, so a slow hook can't hold up playback.
+	hookQueue = 64
+)
+
+// Hook runs a command or posts to a URL when an event happens, to drive
+// lights, notifications, or custom scrobbling. Scripts run as commands with
+// their interpreter, like ["python3", "/path/to/script.py"].
+type Hook struct {
+	// Events are the events that fire the hook, or all events if empty.
+	Events []string `json:",omitempty"`
+	// Command is run with the event as JSON on its standard input, and the
+	// event type in the MOGGIO_EVENT environment variable.
+	Command []string `json:",omitempty"`
+	// URL is posted the event as JSON.
+	URL string `json:",omitempty"`
+}
+
+// Validate checks that the hook is usable.
+func (h Hook) Validate() error {
+	if (len(h.Command) == 0) == (h.URL == "") {
+		return fmt.Errorf("hook needs one of a command or URL")
+	}
+	if h.URL != "" {
+		u, err := url.Parse(h.URL)
+		if err != nil {
+			return err
+		}
+		if u.Scheme != "http" && u.Scheme != "https" {
+			return fmt.Errorf("hook URL must be http or https: %s", h.URL)
+		}
+	}
+	for _, e := range h.Events {
+		if !events[e] {
+			return fmt.Errorf("unknown event: %s", e)
+		}
+	}
+	return nil
+}
+
+func (h Hook) fires(event string) bool {
+	if len(h.Events) == 0 {
+		return true
+	}
+	for _, e := range h.Events {
+		if e == event {
+			return true
+		}
+	}
+	return false
+}
+
+// Event is the payload of an event.
+type Event struct {
+	Type  string
+	Time  time.Time
+	State string
+	// Song is the playing song, or the song that stopped.
+	Song   SongID          `json:",omitempty"`
+	Info   *codec.SongInfo `json:",omitempty"`
+	Volume float64
+	// Queue is the number of songs in the queue.
+	Queue int
+}
+
+type hookRun struct {
+	hook  Hook
+	event Event
+}
+
+// runHooks runs hooks in the order their events happened.
+func (srv *Server) runHooks() {
+	for r := range srv.hooks {
+		if err := r.hook.run(r.event); err != nil {
+			log.Printf("hook %s: %v", r.event.Type, err)
+		}
+	}
+}
+
+func (h Hook) run(e Event) error {
+	b, err := json.Marshal(e)
+	if err != nil {
+		return err
+	}
+	ctx, cancel := context.WithTimeout(context.Background(), hookTimeout)
+	defer cancel()
+	if len(h.Command) > 0 {
+		cmd := exec.CommandContext(ctx, h.Command[0], h.Command[1:]...)
+		cmd.Stdin = bytes.NewReader(b)
+		cmd.Env = append(os.Environ(), "MOGGIO_EVENT="+e.Type)
+		if out, err := cmd.CombinedOutput(); err != nil {
+			return fmt.Errorf("%v: %s", err, bytes.TrimSpace(out))
+		}
+		return nil
+	}
+	req, err := http.NewRequestWithContext(ctx, "POST", h.URL, bytes.NewReader(b))
+	if err != nil {
+		return err
+	}
+	req.Header.Set("Content-Type", "application/json")
+	resp, err := http.DefaultClient.Do(req)
+	if err != nil {
+		return err
+	}
+	resp.Body.Close()
+	if resp.StatusCode/100 != 2 {
+		return fmt.Errorf("%s: %s", h.URL, resp.Status)
+	}
+	return nil
+}
+
+func (srv *Server) GetHooks(body io.Reader, form url.Values, ps httprouter.Params) (interface{}, error) {
+	ch := make(chan []Hook)
+	srv.ch <- cmdGetHooks(ch)
+	return <-ch, nil
+}
+
+// SetHooks replaces the hooks. It is served by localSettings, since hooks
+// run commands.
+func (srv *Server) SetHooks(body io.Reader, form url.Values, ps httprouter.Params) (interface{}, error) {
+	var hooks []Hook
+	if err := json.NewDecoder(body).Decode(&hooks); err != nil {
+		return nil, err
+	}
+	for _, h := range hooks {
+		if err := h.Validate(); err != nil {
+			return nil, err
+		}
+	}
+	srv.ch <- cmdSetHooks(hooks)
+	return nil, nil
+}
+
+// localSettings wraps h, which sets commands the server runs, so that only
+// the owner may call it from the server's own machine. Without an -auth
+// token every client is the owner, so the token must be set. The body must
+// be JSON, which a cross-site form can't post.
+func localSettings(h httprouter.Handle) httprouter.Handle {
+	return func(w http.ResponseWriter, r *http.Request, ps httprouter.Params) {
+		ct, _, _ := mime.ParseMediaType(r.Header.Get("Content-Type"))
+		ip := net.ParseIP(remoteIP(r))
+		switch {
+		case OwnerToken == "":
+			http.Error(w, "commands can only be set when moggio is run with -auth", http.StatusForbidden)
+		case ip == nil || !ip.IsLoopback():
+			http.Error(w, "commands can only be set from the server's machine", http.StatusForbidden)
+		case ct != "application/json":
+			http.Error(w, "commands must be set as application/json", http.StatusUnsupportedMediaType)
+		case !isOwner(r):
+			http.Error(w, "commands can only be set by the owner", http.StatusForbidden)
+		default:
+			h(w, r, ps)
+		}
+	}
+}
+
+type cmdGetHooks chan []Hook
+
+type cmdSetHooks []Hook
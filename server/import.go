@@ -0,0 +1,393 @@
+package server
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"log"
+	"net/url"
+	"os"
+	"path/filepath"
+	"regexp"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/dhowden/tag"
+	"github.com/julienschmidt/httprouter"
+	"github.com/mjibson/moggio/codec"
+)
+
+// Import holds the settings of importing files dropped in a folder into
+// the library.
+type Import struct {
+	// Incoming is the directory watched for new files. Importing is
+	// disabled if empty.
+	Incoming string
+	// Library is the directory files are moved into. The file protocol
+	// instances containing it are refreshed after importing.
+	Library string
+	// Pattern is the path of imported files in Library, without the
+	// extension. See importFields for its fields, like {album}.
+	Pattern string
+}
+
+const (
+	defaultImportPattern = "{albumartist}/{album}/{track} {title}"
+	// importInterval is how often the incoming directory is checked.
+	importInterval = time.Second * 10
+	// importRecent is the number of import results kept.
+	importRecent = 100
+)
+
+// ImportResult is the result of importing a file.
+type ImportResult struct {
+	Time  time.Time
+	From  string
+	To    string `json:",omitempty"`
+	Error string `json:",omitempty"`
+}
+
+// imports holds the recent import results.
+type imports struct {
+	sync.Mutex
+	// run is held while importing.
+	run    sync.Mutex
+	recent []ImportResult
+	// sizes are the sizes of incoming files when last checked, to import
+	// only those no longer being written.
+	sizes map[string]int64
+}
+
+func (im *imports) add(r ImportResult) {
+	im.Lock()
+	defer im.Unlock()
+	im.recent = append(im.recent, r)
+	if len(im.recent) > importRecent {
+		im.recent = im.recent[len(im.recent)-importRecent:]
+	}
+}
+
+func (im *imports) get() []ImportResult {
+	im.Lock()
+	defer im.Unlock()
+	return append([]ImportResult{}, im.recent...)
+}
+
+// importFields are the fields of import patterns, by name, given a song's
+// info and tags, which may be nil.
+var importFields = map[string]func(info *codec.SongInfo, m tag.Metadata) string{
+	"artist": func(info *codec.SongInfo, m tag.Metadata) string {
+		return info.Artist
+	},
+	"albumartist": func(info *codec.SongInfo, m tag.Metadata) string {
+		if m != nil && m.AlbumArtist() != "" {
+			return m.AlbumArtist()
+		}
+		return info.Artist
+	},
+	"album": func(info *codec.SongInfo, m tag.Metadata) string {
+		return info.Album
+	},
+	"title": func(info *codec.SongInfo, m tag.Metadata) string {
+		return info.Title
+	},
+	"track": func(info *codec.SongInfo, m tag.Metadata) string {
+		if info.Track <= 0 {
+			return ""
+		}
+		return fmt.Sprintf("%02d", int(info.Track))
+	},
+	"disc": func(info *codec.SongInfo, m tag.Metadata) string {
+		if info.Disc > 0 {
+			return fmt.Sprint(info.Disc)
+		}
+		if m == nil {
+			return ""
+		}
+		if d, _ := m.Disc(); d > 0 {
+			return fmt.Sprint(d)
+		}
+		return ""
+	},
+	"year": func(info *codec.SongInfo, m tag.Metadata) string {
+		if m == nil || m.Year() <= 0 {
+			return ""
+		}
+		return fmt.Sprint(m.Year())
+	},
+	"genre": func(info *codec.SongInfo, m tag.Metadata) string {
+		return info.Genre
+	},
+}
+
+var importFieldRE = regexp.MustCompile(`{([a-z]+)}`)
+
+func checkImportPattern(pattern string) error {
+	for _, f := range importFieldRE.FindAllStringSubmatch(pattern, -1) {
+		if importFields[f[1]] == nil {
+			return fmt.Errorf("unknown import field: %s", f[0])
+		}
+	}
+	if filepath.IsAbs(pattern) || strings.Contains(pattern, "..") {
+		return fmt.Errorf("import pattern must be relative")
+	}
+	return nil
+}
+
+// importPath returns the path, relative to the library and without
+// extension, of a song by pattern.
+func importPath(pattern string, info *codec.SongInfo, m tag.Metadata) string {
+	var parts []string
+	split := strings.Split(pattern, "/")
+	for i, part := range split {
+		s := importFieldRE.ReplaceAllStringFunc(part, func(f string) string {
+			return importFields[f[1:len(f)-1]](info, m)
+		})
+		// Leave out directories of missing fields, like {disc}.
+		if strings.TrimSpace(s) == "" && i < len(split)-1 {
+			continue
+		}
+		parts = append(parts, cleanPathPart(s))
+	}
+	return filepath.Join(parts...)
+}
+
+var unsafePathChars = strings.NewReplacer(
+	"/", "_", `\`, "_", ":", "_", "*", "_", "?", "_",
+	`"`, "_", "<", "_", ">", "_", "|", "_",
+)
+
+// cleanPathPart makes s safe as a file or directory name.
+func cleanPathPart(s string) string {
+	s = strings.TrimSpace(unsafePathChars.Replace(s))
+	s = strings.Trim(s, ". ")
+	if s == "" {
+		return "Unknown"
+	}
+	return s
+}
+
+// watchImports imports files from the incoming directory periodically.
+func (srv *Server) watchImports() {
+	for range time.Tick(importInterval) {
+		srv.runImport(false)
+	}
+}
+
+// runImport imports the songs in the incoming directory and refreshes the
+// library if any were. Unless all is set, files that changed since last
+// checked are left for later, since they may still be being written. It
+// returns the number of files imported.
+func (srv *Server) runImport(all bool) int {
+	ch := make(chan Import)
+	srv.ch <- cmdGetImport(ch)
+	im := <-ch
+	if im.Incoming == "" || im.Library == "" {
+		return 0
+	}
+	srv.imports.run.Lock()
+	defer srv.imports.run.Unlock()
+	srv.imports.Lock()
+	prev := srv.imports.sizes
+	sizes := make(map[string]int64)
+	srv.imports.sizes = sizes
+	srv.imports.Unlock()
+	var paths []string
+	filepath.Walk(im.Incoming, func(path string, fi os.FileInfo, err error) error {
+		if err != nil || fi.IsDir() {
+			return nil
+		}
+		sizes[path] = fi.Size()
+		if size, ok := prev[path]; all || ok && size == fi.Size() {
+			paths = append(paths, path)
+		}
+		return nil
+	})
+	n := 0
+	for _, path := range paths {
+		r := ImportResult{
+			Time: time.Now(),
+			From: path,
+		}
+		to, err := importFile(im, path)
+		if err == errNotSong {
+			continue
+		}
+		if err != nil {
+			r.Error = err.Error()
+			log.Printf("import %s: %v", path, err)
+		} else {
+			r.To = to
+			n++
+		}
+		srv.imports.add(r)
+	}
+	if n > 0 {
+		removeEmptyDirs(im.Incoming)
+		srv.ch <- cmdRefreshLibrary(im.Library)
+	}
+	return n
+}
+
+var errNotSong = fmt.Errorf("not a song")
+
+// importFile moves the song at path into the library, and returns its new
+// path.
+func importFile(im Import, path string) (string, error) {
+	ss, _, err := codec.ByExtension(path, func() (io.ReadCloser, int64, error) {
+		f, err := os.Open(path)
+		if err != nil {
+			return nil, 0, err
+		}
+		fi, err := f.Stat()
+		if err != nil {
+			f.Close()
+			return nil, 0, err
+		}
+		return f, fi.Size(), nil
+	})
+	if err != nil || len(ss) == 0 {
+		return "", errNotSong
+	}
+	var info codec.SongInfo
+	for _, song := range ss {
+		if info, err = song.Info(); err != nil {
+			return "", err
+		}
+		break
+	}
+	if info.Title == "" {
+		info.Title = strings.TrimSuffix(filepath.Base(path), filepath.Ext(path))
+	}
+	var m tag.Metadata
+	if f, err := os.Open(path); err == nil {
+		m, _ = tag.ReadFrom(f)
+		f.Close()
+	}
+	pattern := im.Pattern
+	if pattern == "" {
+		pattern = defaultImportPattern
+	}
+	ext := strings.ToLower(filepath.Ext(path))
+	base := filepath.Join(im.Library, importPath(pattern, &info, m))
+	to := base + ext
+	for i := 2; ; i++ {
+		if _, err := os.Stat(to); os.IsNotExist(err) {
+			break
+		}
+		to = fmt.Sprintf("%s (%d)%s", base, i, ext)
+	}
+	if err := os.MkdirAll(filepath.Dir(to), 0755); err != nil {
+		return "", err
+	}
+	return to, moveFile(path, to)
+}
+
+// moveFile renames from to to, or copies it across file systems.
+func moveFile(from, to string) error {
+	if err := os.Rename(from, to); err == nil {
+		return nil
+	}
+	src, err := os.Open(from)
+	if err != nil {
+		return err
+	}
+	defer src.Close()
+	dst, err := os.OpenFile(to, os.O_WRONLY|os.O_CREATE|os.O_EXCL, 0644)
+	if err != nil {
+		return err
+	}
+	if _, err := io.Copy(dst, src); err != nil {
+		dst.Close()
+		os.Remove(to)
+		return err
+	}
+	if err := dst.Close(); err != nil {
+		os.Remove(to)
+		return err
+	}
+	src.Close()
+	return os.Remove(from)
+}
+
+// removeEmptyDirs removes the empty directories below root.
+func removeEmptyDirs(root string) {
+	var dirs []string
+	filepath.Walk(root, func(path string, fi os.FileInfo, err error) error {
+		if err == nil && fi.IsDir() && path != root {
+			dirs = append(dirs, path)
+		}
+		return nil
+	})
+	// Remove the deepest first, so their parents may then be empty.
+	for i := len(dirs) - 1; i >= 0; i-- {
+		os.Remove(dirs[i])
+	}
+}
+
+// GetImport returns the import settings and recent results.
+func (srv *Server) GetImport(body io.Reader, form url.Values, ps httprouter.Params) (interface{}, error) {
+	ch := make(chan Import)
+	srv.ch <- cmdGetImport(ch)
+	return struct {
+		Settings Import
+		Recent   []ImportResult
+	}{
+		Settings: <-ch,
+		Recent:   srv.imports.get(),
+	}, nil
+}
+
+// ImportSettings sets the incoming and library directories and the
+// pattern of imported files.
+func (srv *Server) ImportSettings(body io.Reader, form url.Values, ps httprouter.Params) (interface{}, error) {
+	var im Import
+	if err := json.NewDecoder(body).Decode(&im); err != nil {
+		return nil, err
+	}
+	if im.Pattern == "" {
+		im.Pattern = defaultImportPattern
+	}
+	if err := checkImportPattern(im.Pattern); err != nil {
+		return nil, err
+	}
+	if im.Incoming != "" {
+		for _, p := range []*string{&im.Incoming, &im.Library} {
+			abs, err := filepath.Abs(*p)
+			if err != nil {
+				return nil, err
+			}
+			fi, err := os.Stat(abs)
+			if err != nil {
+				return nil, err
+			}
+			if !fi.IsDir() {
+				return nil, fmt.Errorf("not a directory: %s", abs)
+			}
+			*p = abs
+		}
+		if im.Incoming == im.Library || strings.HasPrefix(im.Incoming, im.Library+string(filepath.Separator)) {
+			return nil, fmt.Errorf("incoming directory must not be in the library")
+		}
+	}
+	srv.audit(ps, "import settings", im.Incoming)
+	srv.ch <- cmdSetImport(im)
+	return nil, nil
+}
+
+// ImportRun imports the files in the incoming directory now, including
+// those that may still be being written.
+func (srv *Server) ImportRun(body io.Reader, form url.Values, ps httprouter.Params) (interface{}, error) {
+	n := srv.runImport(true)
+	srv.audit(ps, "import run", fmt.Sprintf("%d songs", n))
+	return n, nil
+}
+
+type cmdGetImport chan Import
+
+type cmdSetImport Import
+
+// cmdRefreshLibrary refreshes the file protocol instances containing a
+// directory.
+type cmdRefreshLibrary string
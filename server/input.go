@@ -0,0 +1,374 @@
+package server
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io"
+	"log"
+	"net/url"
+	"sort"
+	"strconv"
+	"time"
+
+	"github.com/julienschmidt/httprouter"
+)
+
+// Input holds the settings of input devices attached to the server, like
+// USB media keyboards and IR receivers, read on Linux through evdev.
+type Input struct {
+	Enabled bool
+	// Devices are the paths of the devices read, like
+	// /dev/input/by-id/usb-flirc.tv_flirc-if01-event-kbd. If empty, all
+	// devices with any of the keys in Keys are read.
+	Devices []string `json:",omitempty"`
+	// Keys maps key names, like KEY_PLAYPAUSE, or codes to actions. If
+	// empty, defaultInputKeys is used.
+	Keys map[string]string `json:",omitempty"`
+}
+
+// InputDevice is an input device that may be read.
+type InputDevice struct {
+	Path string
+	Name string
+}
+
+const (
+	// inputInterval is how often the input settings are checked and new
+	// devices opened.
+	inputInterval = time.Second * 5
+	// inputVolumeStep is the change in volume of volume_up and
+	// volume_down, and inputSeekStep the change in position of
+	// seek_forward and seek_back.
+	inputVolumeStep = 0.05
+	inputSeekStep   = time.Second * 10
+)
+
+// inputActions are the actions keys may be mapped to. Most are commands of
+// Cmd.
+var inputActions = map[string]bool{
+	"play":         true,
+	"pause":        true,
+	"stop":         true,
+	"next":         true,
+	"prev":         true,
+	"random":       true,
+	"repeat":       true,
+	"volume_up":    true,
+	"volume_down":  true,
+	"mute":         true,
+	"seek_forward": true,
+	"seek_back":    true,
+}
+
+// inputRepeats are the actions repeated while their key is held.
+var inputRepeats = map[string]bool{
+	"volume_up":    true,
+	"volume_down":  true,
+	"seek_forward": true,
+	"seek_back":    true,
+}
+
+var defaultInputKeys = map[string]string{
+	"KEY_PLAYPAUSE":    "pause",
+	"KEY_PLAYCD":       "pause",
+	"KEY_PAUSECD":      "pause",
+	"KEY_PLAY":         "pause",
+	"KEY_PAUSE":        "pause",
+	"KEY_STOPCD":       "stop",
+	"KEY_STOP":         "stop",
+	"KEY_NEXTSONG":     "next",
+	"KEY_NEXT":         "next",
+	"KEY_PREVIOUSSONG": "prev",
+	"KEY_PREVIOUS":     "prev",
+	"KEY_FASTFORWARD":  "seek_forward",
+	"KEY_FORWARD":      "seek_forward",
+	"KEY_REWIND":       "seek_back",
+	"KEY_VOLUMEUP":     "volume_up",
+	"KEY_VOLUMEDOWN":   "volume_down",
+	"KEY_MUTE":         "mute",
+	"KEY_SHUFFLE":      "random",
+}
+
+// inputKeyCodes are the codes of the key names, from linux/input-event-codes.h.
+// Other keys can be mapped by code.
+var inputKeyCodes = map[string]uint16{
+	"KEY_ESC":          1,
+	"KEY_ENTER":        28,
+	"KEY_SPACE":        57,
+	"KEY_UP":           103,
+	"KEY_LEFT":         105,
+	"KEY_RIGHT":        106,
+	"KEY_DOWN":         108,
+	"KEY_MUTE":         113,
+	"KEY_VOLUMEDOWN":   114,
+	"KEY_VOLUMEUP":     115,
+	"KEY_PAUSE":        119,
+	"KEY_STOP":         128,
+	"KEY_BACK":         158,
+	"KEY_FORWARD":      159,
+	"KEY_NEXTSONG":     163,
+	"KEY_PLAYPAUSE":    164,
+	"KEY_PREVIOUSSONG": 165,
+	"KEY_STOPCD":       166,
+	"KEY_REWIND":       168,
+	"KEY_PLAYCD":       200,
+	"KEY_PAUSECD":      201,
+	"KEY_PLAY":         207,
+	"KEY_FASTFORWARD":  208,
+	"KEY_OK":           352,
+	"KEY_SELECT":       353,
+	"KEY_INFO":         358,
+	"KEY_RED":          398,
+	"KEY_GREEN":        399,
+	"KEY_YELLOW":       400,
+	"KEY_BLUE":         401,
+	"KEY_NEXT":         407,
+	"KEY_PREVIOUS":     412,
+	"KEY_SHUFFLE":      410,
+}
+
+func init() {
+	for i := 1; i <= 9; i++ {
+		inputKeyCodes["KEY_"+strconv.Itoa(i)] = uint16(i + 1)
+	}
+	inputKeyCodes["KEY_0"] = 11
+}
+
+// inputKeyCode returns the code of key, a name or a decimal code.
+func inputKeyCode(key string) (uint16, error) {
+	if c, ok := inputKeyCodes[key]; ok {
+		return c, nil
+	}
+	c, err := strconv.ParseUint(key, 10, 16)
+	if err != nil {
+		return 0, fmt.Errorf("unknown key: %s", key)
+	}
+	return uint16(c), nil
+}
+
+func (in Input) keys() map[string]string {
+	if len(in.Keys) == 0 {
+		return defaultInputKeys
+	}
+	return in.Keys
+}
+
+// keymap returns the actions of key codes.
+func (in Input) keymap() map[uint16]string {
+	m := make(map[uint16]string)
+	for k, a := range in.keys() {
+		if c, err := inputKeyCode(k); err == nil {
+			m[c] = a
+		}
+	}
+	return m
+}
+
+func (in Input) validate() error {
+	for k, a := range in.Keys {
+		if _, err := inputKeyCode(k); err != nil {
+			return err
+		}
+		if !inputActions[a] {
+			return fmt.Errorf("unknown action: %s", a)
+		}
+	}
+	return nil
+}
+
+// watchInput reads the input devices and runs the actions of their keys.
+func (srv *Server) watchInput() {
+	if !inputSupported {
+		return
+	}
+	events := make(chan inputEvent)
+	// open are the devices being read, closed to stop reading them.
+	open := make(map[string]io.Closer)
+	var keymap map[uint16]string
+	// volume is the volume before muting.
+	var volume float64
+	tick := time.NewTicker(inputInterval)
+	defer tick.Stop()
+	update := func() {
+		ch := make(chan Input)
+		srv.ch <- cmdGetInput(ch)
+		in := <-ch
+		keymap = in.keymap()
+		want := make(map[string]bool)
+		if in.Enabled {
+			for _, p := range in.Devices {
+				want[p] = true
+			}
+			if len(in.Devices) == 0 {
+				var codes []uint16
+				for c := range keymap {
+					codes = append(codes, c)
+				}
+				devs, _ := inputDevices(codes)
+				for _, d := range devs {
+					want[d.Path] = true
+				}
+			}
+		}
+		for p, c := range open {
+			if !want[p] {
+				c.Close()
+				delete(open, p)
+			}
+		}
+		for p := range want {
+			if open[p] != nil {
+				continue
+			}
+			c, err := readInput(p, events)
+			if err != nil {
+				log.Printf("input: %v", err)
+				continue
+			}
+			log.Println("input: reading", p)
+			open[p] = c
+		}
+	}
+	update()
+	for {
+		select {
+		case <-tick.C:
+			update()
+		case e := <-events:
+			if e.err != nil {
+				// Devices closed by update are already removed.
+				if open[e.path] == e.dev {
+					log.Printf("input: %s: %v", e.path, e.err)
+					e.dev.Close()
+					delete(open, e.path)
+				}
+				continue
+			}
+			action := keymap[e.code]
+			if action == "" || e.repeat && !inputRepeats[action] {
+				continue
+			}
+			if err := srv.inputAction("input", action, &volume); err != nil {
+				log.Printf("input: %s: %v", action, err)
+			}
+		}
+	}
+}
+
+// inputEvent is a key press, or an error reading a device.
+type inputEvent struct {
+	path   string
+	dev    io.Closer
+	code   uint16
+	repeat bool
+	err    error
+}
+
+// inputAction runs action through Cmd on behalf of who. mute saves the
+// volume in volume to restore on the next mute.
+func (srv *Server) inputAction(who, action string, volume *float64) error {
+	cmd := func(name string, form url.Values) error {
+		ps := httprouter.Params{
+			{Key: "cmd", Value: name},
+			{Key: paramWho, Value: who},
+		}
+		_, err := srv.Cmd(new(bytes.Buffer), form, ps)
+		return err
+	}
+	setVolume := func(v float64) error {
+		if v < 0 {
+			v = 0
+		} else if v > 1 {
+			v = 1
+		}
+		return cmd("volume", url.Values{"v": {strconv.FormatFloat(v, 'g', -1, 64)}})
+	}
+	seek := func(d time.Duration) error {
+		st := srv.rendererStatus()
+		if st.State == stateStop {
+			return nil
+		}
+		pos := st.Elapsed + d
+		if pos < 0 {
+			pos = 0
+		}
+		return cmd("seek", url.Values{"pos": {pos.String()}})
+	}
+	switch action {
+	case "pause":
+		// Play when stopped, so one key plays and pauses.
+		if srv.rendererStatus().State == stateStop {
+			return cmd("play", nil)
+		}
+	case "volume_up":
+		return setVolume(srv.rendererStatus().Volume + inputVolumeStep)
+	case "volume_down":
+		return setVolume(srv.rendererStatus().Volume - inputVolumeStep)
+	case "mute":
+		v := srv.rendererStatus().Volume
+		if v == 0 && *volume > 0 {
+			return setVolume(*volume)
+		}
+		*volume = v
+		return setVolume(0)
+	case "seek_forward":
+		return seek(inputSeekStep)
+	case "seek_back":
+		return seek(-inputSeekStep)
+	}
+	return cmd(action, nil)
+}
+
+func (srv *Server) GetInput(body io.Reader, form url.Values, ps httprouter.Params) (interface{}, error) {
+	ch := make(chan Input)
+	srv.ch <- cmdGetInput(ch)
+	in := <-ch
+	var codes []uint16
+	for c := range in.keymap() {
+		codes = append(codes, c)
+	}
+	devs, err := inputDevices(codes)
+	if err != nil {
+		return nil, err
+	}
+	var actions []string
+	for a := range inputActions {
+		actions = append(actions, a)
+	}
+	sort.Strings(actions)
+	return struct {
+		Settings Input
+		// Keys are the keys mapped, which are the defaults if Settings
+		// has none.
+		Keys    map[string]string
+		Devices []InputDevice
+		Actions []string
+	}{
+		Settings: in,
+		Keys:     in.keys(),
+		Devices:  devs,
+		Actions:  actions,
+	}, nil
+}
+
+// SetInput replaces the input settings. Devices are opened within
+// inputInterval.
+func (srv *Server) SetInput(body io.Reader, form url.Values, ps httprouter.Params) (interface{}, error) {
+	var in Input
+	if err := json.NewDecoder(body).Decode(&in); err != nil {
+		return nil, err
+	}
+	if err := in.validate(); err != nil {
+		return nil, err
+	}
+	if in.Enabled && !inputSupported {
+		return nil, errInputUnsupported
+	}
+	srv.ch <- cmdSetInput(in)
+	return nil, nil
+}
+
+type cmdGetInput chan Input
+
+type cmdSetInput Input
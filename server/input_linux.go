@@ -0,0 +1,113 @@
+// +build linux
+
+package server
+
+import (
+	"encoding/binary"
+	"io"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"strings"
+	"syscall"
+	"unsafe"
+)
+
+const inputSupported = true
+
+var errInputUnsupported error
+
+const (
+	evKey = 1
+	// keyMax is the highest key code.
+	keyMax = 0x2ff
+)
+
+// inputEventSize is the size of struct input_event: a struct timeval,
+// which is the size of two longs, then type, code, and value.
+const inputEventSize = int(unsafe.Sizeof(syscall.Timeval{})) + 8
+
+// eviocgbit is the EVIOCGBIT(EV_KEY, len) ioctl, which gets the keys a
+// device has.
+const eviocgbit = 2<<30 | (keyMax/8+1)<<16 | 'E'<<8 | (0x20 + evKey)
+
+// inputDevices returns the event devices that have any of keys.
+func inputDevices(keys []uint16) ([]InputDevice, error) {
+	paths, err := filepath.Glob("/dev/input/event*")
+	if err != nil {
+		return nil, err
+	}
+	// Prefer the stable by-id names, which survive replugging.
+	byID := make(map[string]string)
+	links, _ := filepath.Glob("/dev/input/by-id/*")
+	for _, l := range links {
+		if p, err := filepath.EvalSymlinks(l); err == nil {
+			byID[p] = l
+		}
+	}
+	var devs []InputDevice
+	for _, p := range paths {
+		if !hasKeys(p, keys) {
+			continue
+		}
+		d := InputDevice{Path: p}
+		if l := byID[p]; l != "" {
+			d.Path = l
+		}
+		name, _ := ioutil.ReadFile(filepath.Join("/sys/class/input", filepath.Base(p), "device/name"))
+		d.Name = strings.TrimSpace(string(name))
+		devs = append(devs, d)
+	}
+	return devs, nil
+}
+
+// hasKeys reports whether the device at path has any of keys.
+func hasKeys(path string, keys []uint16) bool {
+	f, err := os.Open(path)
+	if err != nil {
+		return false
+	}
+	defer f.Close()
+	var bits [keyMax/8 + 1]byte
+	_, _, errno := syscall.Syscall(syscall.SYS_IOCTL, f.Fd(), eviocgbit, uintptr(unsafe.Pointer(&bits[0])))
+	if errno != 0 {
+		return false
+	}
+	for _, k := range keys {
+		// The bits are an array of longs; bytes are in order on little
+		// endian machines.
+		if int(k) <= keyMax && bits[k/8]&(1<<(k%8)) != 0 {
+			return true
+		}
+	}
+	return false
+}
+
+// readInput sends the key presses of the device at path to events until it
+// is closed.
+func readInput(path string, events chan<- inputEvent) (io.Closer, error) {
+	f, err := os.Open(path)
+	if err != nil {
+		return nil, err
+	}
+	go func() {
+		b := make([]byte, inputEventSize)
+		for {
+			if _, err := io.ReadFull(f, b); err != nil {
+				events <- inputEvent{path: path, dev: f, err: err}
+				return
+			}
+			e := b[inputEventSize-8:]
+			typ := binary.LittleEndian.Uint16(e)
+			code := binary.LittleEndian.Uint16(e[2:])
+			value := int32(binary.LittleEndian.Uint32(e[4:]))
+			// Values are 0 for releases, 1 for presses, and 2 for
+			// repeats while held.
+			if typ != evKey || value == 0 {
+				continue
+			}
+			events <- inputEvent{path: path, dev: f, code: code, repeat: value == 2}
+		}
+	}()
+	return f, nil
+}
@@ -0,0 +1,20 @@
+// +build !linux
+
+package server
+
+import (
+	"fmt"
+	"io"
+)
+
+const inputSupported = false
+
+var errInputUnsupported = fmt.Errorf("input: only supported on Linux")
+
+func inputDevices(keys []uint16) ([]InputDevice, error) {
+	return nil, errInputUnsupported
+}
+
+func readInput(path string, events chan<- inputEvent) (io.Closer, error) {
+	return nil, errInputUnsupported
+}
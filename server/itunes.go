@@ -0,0 +1,309 @@
+package server
+
+import (
+	"encoding/xml"
+	"fmt"
+	"io"
+	"net/url"
+	"path/filepath"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/julienschmidt/httprouter"
+	"github.com/mjibson/moggio/codec"
+)
+
+// itunesTrack is a track of an iTunes library.
+type itunesTrack struct {
+	importedTrack
+	Location string
+	// Rating is from 0 to 100, 20 per star.
+	Rating     int
+	Plays      int
+	LastPlayed time.Time
+}
+
+type itunesPlaylist struct {
+	Name   string
+	Tracks []int64
+}
+
+type itunesLibrary struct {
+	Tracks    map[int64]*itunesTrack
+	Playlists []itunesPlaylist
+}
+
+// itunesSkipPlaylists are the kinds of built-in playlists not imported.
+var itunesSkipPlaylists = []string{"Master", "Distinguished Kind", "Music", "Movies", "TV Shows", "Podcasts", "Audiobooks", "Purchased Music"}
+
+// parseITunes parses an "iTunes Music Library.xml" or Music.app library
+// export.
+func parseITunes(r io.Reader) (*itunesLibrary, error) {
+	d := xml.NewDecoder(r)
+	for {
+		t, err := d.Token()
+		if err != nil {
+			return nil, fmt.Errorf("itunes: %v", err)
+		}
+		if se, ok := t.(xml.StartElement); ok && se.Name.Local == "dict" {
+			v, err := plistValue(d, se)
+			if err != nil {
+				return nil, err
+			}
+			root, _ := v.(map[string]interface{})
+			return itunesFromPlist(root), nil
+		}
+	}
+}
+
+// plistValue decodes the property list value started by se.
+func plistValue(d *xml.Decoder, se xml.StartElement) (interface{}, error) {
+	switch se.Name.Local {
+	case "dict":
+		m := make(map[string]interface{})
+		var key string
+		for {
+			t, err := d.Token()
+			if err != nil {
+				return nil, err
+			}
+			switch t := t.(type) {
+			case xml.StartElement:
+				if t.Name.Local == "key" {
+					if err := d.DecodeElement(&key, &t); err != nil {
+						return nil, err
+					}
+					continue
+				}
+				v, err := plistValue(d, t)
+				if err != nil {
+					return nil, err
+				}
+				m[key] = v
+			case xml.EndElement:
+				return m, nil
+			}
+		}
+	case "array":
+		var a []interface{}
+		for {
+			t, err := d.Token()
+			if err != nil {
+				return nil, err
+			}
+			switch t := t.(type) {
+			case xml.StartElement:
+				v, err := plistValue(d, t)
+				if err != nil {
+					return nil, err
+				}
+				a = append(a, v)
+			case xml.EndElement:
+				return a, nil
+			}
+		}
+	case "true", "false":
+		return se.Name.Local == "true", d.Skip()
+	}
+	var s string
+	if err := d.DecodeElement(&s, &se); err != nil {
+		return nil, err
+	}
+	switch se.Name.Local {
+	case "integer":
+		return strconv.ParseInt(strings.TrimSpace(s), 10, 64)
+	case "real":
+		return strconv.ParseFloat(strings.TrimSpace(s), 64)
+	case "date":
+		return time.Parse(time.RFC3339, strings.TrimSpace(s))
+	}
+	return s, nil
+}
+
+func itunesFromPlist(root map[string]interface{}) *itunesLibrary {
+	lib := &itunesLibrary{
+		Tracks: make(map[int64]*itunesTrack),
+	}
+	str := func(m map[string]interface{}, k string) string {
+		s, _ := m[k].(string)
+		return s
+	}
+	num := func(m map[string]interface{}, k string) int64 {
+		i, _ := m[k].(int64)
+		return i
+	}
+	tracks, _ := root["Tracks"].(map[string]interface{})
+	for _, v := range tracks {
+		t, _ := v.(map[string]interface{})
+		if t == nil {
+			continue
+		}
+		it := &itunesTrack{
+			importedTrack: importedTrack{
+				Title: str(t, "Name"),
+				Album: str(t, "Album"),
+				Time:  time.Duration(num(t, "Total Time")) * time.Millisecond,
+			},
+			Rating: int(num(t, "Rating")),
+			Plays:  int(num(t, "Play Count")),
+		}
+		if a := str(t, "Artist"); a != "" {
+			it.Artists = []string{a}
+		}
+		if a := str(t, "Album Artist"); a != "" && a != str(t, "Artist") {
+			it.Artists = append(it.Artists, a)
+		}
+		// Computed ratings are inferred from album ratings.
+		if b, _ := t["Rating Computed"].(bool); b {
+			it.Rating = 0
+		}
+		it.LastPlayed, _ = t["Play Date UTC"].(time.Time)
+		if u, err := url.Parse(str(t, "Location")); err == nil && u.Scheme == "file" {
+			it.Location = u.Path
+		}
+		lib.Tracks[num(t, "Track ID")] = it
+	}
+	playlists, _ := root["Playlists"].([]interface{})
+	for _, v := range playlists {
+		p, _ := v.(map[string]interface{})
+		if p == nil || str(p, "Name") == "" {
+			continue
+		}
+		skip := false
+		for _, k := range itunesSkipPlaylists {
+			if _, ok := p[k]; ok {
+				skip = true
+			}
+		}
+		if b, _ := p["Visible"].(bool); skip || p["Visible"] != nil && !b {
+			continue
+		}
+		pl := itunesPlaylist{
+			Name: str(p, "Name"),
+		}
+		items, _ := p["Playlist Items"].([]interface{})
+		for _, item := range items {
+			if m, _ := item.(map[string]interface{}); m != nil {
+				pl.Tracks = append(pl.Tracks, num(m, "Track ID"))
+			}
+		}
+		if len(pl.Tracks) > 0 {
+			lib.Playlists = append(lib.Playlists, pl)
+		}
+	}
+	return lib
+}
+
+// itunesLocationParts is the number of trailing path elements, usually
+// artist, album, and file, compared when matching tracks by location, since
+// the library was likely elsewhere.
+const itunesLocationParts = 3
+
+func locationKey(path string) string {
+	parts := strings.Split(filepath.ToSlash(path), "/")
+	if len(parts) > itunesLocationParts {
+		parts = parts[len(parts)-itunesLocationParts:]
+	}
+	return strings.ToLower(strings.Join(parts, "/"))
+}
+
+type itunesReport struct {
+	Tracks    int
+	Matched   int
+	Unmatched []importedTrack
+	Playlists []importReport
+}
+
+// importITunes matches the tracks of lib to songs, by location or else
+// metadata, and imports their ratings and play counts and the playlists
+// into playlists. It should only be called by the commands() function.
+func (srv *Server) importITunes(playlists map[string]Playlist, lib *itunesLibrary) itunesReport {
+	byLocation := make(map[string]SongID)
+	for name, protos := range srv.Protocols {
+		if name != "file" {
+			continue
+		}
+		for key, inst := range protos {
+			sl, _ := inst.List()
+			for id := range sl {
+				path, _ := id.Pop()
+				byLocation[locationKey(path)] = SongID(codec.NewID(name, key, string(id)))
+			}
+		}
+	}
+	idx := srv.newTrackIndex()
+	r := itunesReport{
+		Tracks:    len(lib.Tracks),
+		Unmatched: []importedTrack{},
+		Playlists: []importReport{},
+	}
+	songs := make(map[int64]SongID)
+	for tid, t := range lib.Tracks {
+		id, ok := byLocation[locationKey(t.Location)]
+		if t.Location == "" || !ok {
+			id, ok = idx.match(t.Artists, t.Title, t.Time)
+		}
+		if !ok {
+			r.Unmatched = append(r.Unmatched, t.importedTrack)
+			continue
+		}
+		r.Matched++
+		songs[tid] = id
+		st := srv.songStats(id)
+		if t.Plays > st.Plays {
+			st.Plays = t.Plays
+		}
+		if t.LastPlayed.After(st.LastPlayed) {
+			st.LastPlayed = t.LastPlayed
+		}
+		if t.Rating > 0 {
+			st.Rating = (t.Rating + 10) / 20
+		}
+	}
+	for _, pl := range lib.Playlists {
+		rep := importReport{
+			Playlist:  pl.Name,
+			Unmatched: []importedTrack{},
+		}
+		var p Playlist
+		for _, tid := range pl.Tracks {
+			if id, ok := songs[tid]; ok {
+				p = append(p, id)
+			} else if t := lib.Tracks[tid]; t != nil {
+				rep.Unmatched = append(rep.Unmatched, t.importedTrack)
+			}
+		}
+		rep.Matched = len(p)
+		if len(p) > 0 {
+			playlists[pl.Name] = p
+		}
+		r.Playlists = append(r.Playlists, rep)
+	}
+	return r
+}
+
+// ITunesImport imports an iTunes or Music.app library XML export, the
+// request body: playlists, as playlists of the matching songs, and ratings
+// and play counts. Tracks are matched by location, or else by artist,
+// title, and duration. The tracks not found in the library are reported.
+func (srv *Server) ITunesImport(body io.Reader, form url.Values, ps httprouter.Params) (interface{}, error) {
+	lib, err := parseITunes(body)
+	if err != nil {
+		return nil, err
+	}
+	srv.audit(ps, "itunes import", fmt.Sprintf("%d tracks, %d playlists", len(lib.Tracks), len(lib.Playlists)))
+	ch := make(chan itunesReport)
+	srv.ch <- cmdImportITunes{
+		user: ps.ByName(paramUser),
+		lib:  lib,
+		done: ch,
+	}
+	return <-ch, nil
+}
+
+type cmdImportITunes struct {
+	user string
+	lib  *itunesLibrary
+	done chan itunesReport
+}
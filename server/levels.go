@@ -0,0 +1,164 @@
+package server
+
+import (
+	"expvar"
+	"io"
+	"math"
+	"net/url"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"github.com/julienschmidt/httprouter"
+	"github.com/mjibson/moggio/dsp"
+)
+
+const (
+	// limiterCeiling is the level in dBFS the limiter keeps samples under,
+	// below full scale so peaks between samples don't clip either.
+	limiterCeiling = -1
+	// levelHistory is the number of songs whose levels are kept.
+	levelHistory = 100
+)
+
+// clippedSamples and clippedSongs count the samples and songs that clipped
+// since the process started, and truePeak is the bits of the true peak of
+// the current song, for metrics.
+var clippedSamples, clippedSongs, truePeak uint64
+
+func init() {
+	expvar.Publish("output_clipped_samples", expvar.Func(func() interface{} {
+		return atomic.LoadUint64(&clippedSamples)
+	}))
+	expvar.Publish("output_clipped_songs", expvar.Func(func() interface{} {
+		return atomic.LoadUint64(&clippedSongs)
+	}))
+	expvar.Publish("output_true_peak", expvar.Func(func() interface{} {
+		return math.Float64frombits(atomic.LoadUint64(&truePeak))
+	}))
+}
+
+// LevelReport is the peak levels of a song as it played, after the DSP
+// chain, and whether its gain made it clip.
+type LevelReport struct {
+	Time  time.Time
+	Song  SongID
+	Title string `json:",omitempty"`
+	// TruePeak is the peak, between samples too, in dBTP. Clipped counts
+	// the samples beyond full scale, which distort.
+	TruePeak float64
+	Clipped  int64
+	// Limited is the largest gain reduction of the limiter in dB.
+	Limited float64 `json:",omitempty"`
+	// Gain is the preamp and ReplayGain in dB, and EQ whether the
+	// equalizer was on, the usual causes of clipping.
+	Gain float64
+	EQ   bool
+}
+
+// levels measures the peaks of the audio played. It is used by the audio()
+// and HTTP go routines, so has its own lock.
+type levels struct {
+	sync.Mutex
+	meter   *dsp.PeakMeter
+	cur     LevelReport
+	history []LevelReport
+}
+
+// start starts measuring the song of r, of the given output channels,
+// keeping the levels of the previous one.
+func (l *levels) start(r LevelReport, channels int) {
+	l.Lock()
+	defer l.Unlock()
+	l.end()
+	r.Time = time.Now()
+	r.TruePeak = math.Inf(-1)
+	l.cur = r
+	l.meter = dsp.NewPeakMeter(channels)
+}
+
+// end adds the current song to the history, if it played.
+func (l *levels) end() {
+	if l.meter == nil {
+		return
+	}
+	l.meter = nil
+	if math.IsInf(l.cur.TruePeak, -1) {
+		return
+	}
+	if l.cur.Clipped > 0 {
+		atomic.AddUint64(&clippedSongs, 1)
+	}
+	l.history = append(l.history, l.cur)
+	if len(l.history) > levelHistory {
+		l.history = l.history[len(l.history)-levelHistory:]
+	}
+}
+
+// write measures samples, the output of chain.
+func (l *levels) write(samples []float32, chain dsp.Chain) {
+	l.Lock()
+	defer l.Unlock()
+	if l.meter == nil || len(samples) == 0 {
+		return
+	}
+	clipped := l.meter.Clipped()
+	l.meter.Write(samples)
+	atomic.AddUint64(&clippedSamples, uint64(l.meter.Clipped()-clipped))
+	l.cur.Clipped = l.meter.Clipped()
+	l.cur.TruePeak = l.meter.TruePeak()
+	atomic.StoreUint64(&truePeak, math.Float64bits(l.cur.TruePeak))
+	for _, s := range chain {
+		if lim, ok := s.(*dsp.Limiter); ok {
+			if r := lim.Reduction(); r > l.cur.Limited {
+				l.cur.Limited = r
+			}
+		}
+	}
+}
+
+// current returns the levels of the song playing, or nil if none.
+func (l *levels) current() *LevelReport {
+	l.Lock()
+	defer l.Unlock()
+	if l.meter == nil || math.IsInf(l.cur.TruePeak, -1) {
+		return nil
+	}
+	r := l.cur
+	return &r
+}
+
+// get returns the levels of the songs played, most recent first.
+func (l *levels) get() []LevelReport {
+	l.Lock()
+	defer l.Unlock()
+	r := []LevelReport{}
+	if l.meter != nil && !math.IsInf(l.cur.TruePeak, -1) {
+		r = append(r, l.cur)
+	}
+	for i := len(l.history) - 1; i >= 0; i-- {
+		r = append(r, l.history[i])
+	}
+	return r
+}
+
+// Levels returns the peak levels of the songs played, most recent first,
+// and of those the songs that clipped, so users can tell whether their EQ
+// or preamp distort. Turn on the limiter with the limiter command to
+// prevent it.
+func (srv *Server) Levels(body io.Reader, form url.Values, ps httprouter.Params) (interface{}, error) {
+	songs := srv.levels.get()
+	clipped := []LevelReport{}
+	for _, r := range songs {
+		if r.Clipped > 0 {
+			clipped = append(clipped, r)
+		}
+	}
+	return struct {
+		Songs   []LevelReport
+		Clipped []LevelReport
+	}{
+		Songs:   songs,
+		Clipped: clipped,
+	}, nil
+}
@@ -0,0 +1,202 @@
+package server
+
+import (
+	"encoding/csv"
+	"encoding/json"
+	"fmt"
+	"hash/fnv"
+	"log"
+	"net/http"
+	"sort"
+	"strconv"
+	"time"
+
+	"github.com/julienschmidt/httprouter"
+	"github.com/mjibson/moggio/codec"
+	"github.com/mjibson/moggio/protocol"
+)
+
+// libraryRow is a song of a library export.
+type libraryRow struct {
+	ID       SongID
+	Protocol string
+	Artist   string
+	Title    string
+	Album    string
+	Track    float64
+	Genre    string `json:",omitempty"`
+	// Year and OriginalYear are of the release and first release.
+	Year         int `json:",omitempty"`
+	OriginalYear int `json:",omitempty"`
+	// Duration is in seconds.
+	Duration   float64
+	BPM        float64 `json:",omitempty"`
+	Key        string  `json:",omitempty"`
+	TrackGain  float64 `json:",omitempty"`
+	AlbumGain  float64 `json:",omitempty"`
+	Plays      int
+	LastPlayed *time.Time `json:",omitempty"`
+	Rating     int        `json:",omitempty"`
+	// Path is the song's file, if it's local.
+	Path string `json:",omitempty"`
+	// Fingerprint is a hash of the song's tags and duration, equal for
+	// copies of a song.
+	Fingerprint string `json:",omitempty"`
+}
+
+// libraryColumns are the CSV columns of a library export.
+var libraryColumns = []string{"id", "protocol", "artist", "title", "album", "track", "genre", "year", "original_year", "duration", "bpm", "key", "track_gain", "album_gain", "plays", "last_played", "rating", "path", "fingerprint"}
+
+func (r *libraryRow) record() []string {
+	float := func(f float64) string {
+		if f == 0 {
+			return ""
+		}
+		return strconv.FormatFloat(f, 'f', -1, 64)
+	}
+	var last string
+	if r.LastPlayed != nil {
+		last = r.LastPlayed.UTC().Format(time.RFC3339)
+	}
+	return []string{
+		string(r.ID),
+		r.Protocol,
+		r.Artist,
+		r.Title,
+		r.Album,
+		float(r.Track),
+		r.Genre,
+		float(float64(r.Year)),
+		float(float64(r.OriginalYear)),
+		strconv.FormatFloat(r.Duration, 'f', 3, 64),
+		float(r.BPM),
+		r.Key,
+		float(r.TrackGain),
+		float(r.AlbumGain),
+		strconv.Itoa(r.Plays),
+		last,
+		float(float64(r.Rating)),
+		r.Path,
+		r.Fingerprint,
+	}
+}
+
+// printHash returns the hash of a song's fingerprint, or "" if it has none.
+func printHash(info *codec.SongInfo) string {
+	fp := fingerprint(info)
+	if fp == "" {
+		return ""
+	}
+	h := fnv.New64a()
+	h.Write([]byte(fp))
+	return fmt.Sprintf("%016x", h.Sum64())
+}
+
+// libraryRows returns the listed songs of the library for an export,
+// ordered by ID. It should only be called by the commands() function.
+func (srv *Server) libraryRows() []libraryRow {
+	var rows []libraryRow
+	for name, protos := range srv.Protocols {
+		for key, inst := range protos {
+			sl, _ := inst.List()
+			fi, _ := inst.(protocol.FileInstance)
+			for id, info := range sl {
+				sid := SongID(codec.NewID(name, key, string(id)))
+				if srv.Hidden[sid] || info == nil {
+					continue
+				}
+				info = srv.songInfo(sid, info)
+				row := libraryRow{
+					ID:           sid,
+					Protocol:     name,
+					Artist:       info.Artist,
+					Title:        info.Title,
+					Album:        info.Album,
+					Track:        info.Track,
+					Genre:        info.Genre,
+					Year:         info.Year,
+					OriginalYear: info.OriginalYear,
+					Duration:     info.Time.Seconds(),
+					BPM:          info.BPM,
+					Key:          info.Key,
+					TrackGain:    info.TrackGain,
+					AlbumGain:    info.AlbumGain,
+					Fingerprint:  printHash(info),
+				}
+				if st := srv.Stats[sid]; st != nil {
+					row.Plays = st.Plays
+					row.Rating = st.Rating
+					if !st.LastPlayed.IsZero() {
+						last := st.LastPlayed
+						row.LastPlayed = &last
+					}
+				}
+				if fi != nil {
+					row.Path, _ = fi.SongPath(id)
+				}
+				rows = append(rows, row)
+			}
+		}
+	}
+	sort.Slice(rows, func(i, j int) bool { return rows[i].ID < rows[j].ID })
+	return rows
+}
+
+// LibraryExport streams all songs of the library with their tags,
+// durations, play counts, ratings, files, and fingerprints, for analysis in
+// spreadsheets or scripts. The format parameter is "csv", the default, or
+// "json" for JSON lines, one song per line.
+func (srv *Server) LibraryExport(w http.ResponseWriter, r *http.Request, ps httprouter.Params) {
+	format := r.FormValue("format")
+	switch format {
+	case "", "csv":
+		format = "csv"
+		w.Header().Set("Content-Type", "text/csv; charset=utf-8")
+	case "json":
+		w.Header().Set("Content-Type", "application/x-ndjson")
+	default:
+		serveError(w, fmt.Errorf("unknown export format: %s", format))
+		return
+	}
+	ch := make(chan []libraryRow)
+	srv.ch <- cmdLibraryRows(ch)
+	rows := <-ch
+	w.Header().Set("Content-Disposition", fmt.Sprintf("attachment; filename=%q", "moggio-library."+format))
+	flusher, _ := w.(http.Flusher)
+	flush := func(i int) {
+		if flusher != nil && i%1000 == 999 {
+			flusher.Flush()
+		}
+	}
+	var err error
+	if format == "csv" {
+		cw := csv.NewWriter(w)
+		cw.Write(libraryColumns)
+		for i := range rows {
+			if err = cw.Write(rows[i].record()); err != nil {
+				break
+			}
+			if i%1000 == 999 {
+				cw.Flush()
+			}
+			flush(i)
+		}
+		cw.Flush()
+		if err == nil {
+			err = cw.Error()
+		}
+	} else {
+		enc := json.NewEncoder(w)
+		for i := range rows {
+			if err = enc.Encode(&rows[i]); err != nil {
+				break
+			}
+			flush(i)
+		}
+	}
+	if err != nil {
+		log.Printf("library export: %v", err)
+	}
+}
+
+type cmdLibraryRows chan []libraryRow
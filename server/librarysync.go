@@ -0,0 +1,354 @@
+package server
+
+import (
+	"crypto/rand"
+	"encoding/binary"
+	"encoding/hex"
+	"fmt"
+	"hash/fnv"
+	"log"
+	"net/http"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+
+	"github.com/boltdb/bolt"
+	"github.com/julienschmidt/httprouter"
+)
+
+// syncRemovals is the number of removed songs remembered for syncs. Tokens
+// from before the oldest are sent the whole library.
+const syncRemovals = 10000
+
+const (
+	// dbSync is the bucket of the generations of syncs, each in a bucket of
+	// its name holding the syncKey values and the syncSongs and
+	// syncRemoved buckets.
+	dbSync      = "sync"
+	syncID      = "id"
+	syncGen     = "gen"
+	syncHorizon = "horizon"
+	syncSongs   = "songs"
+	syncRemoved = "removed"
+)
+
+// librarySync tracks the changes to the library for syncs, by the hashes of
+// its songs. Changes are numbered by generation when first seen by a sync.
+type librarySync struct {
+	sync.Mutex
+	// srv saves the generations in the bucket of name, so tokens survive
+	// restarts. If nil, they aren't saved.
+	srv  *Server
+	name string
+	// id identifies tokens of this library, since a new one is made if the
+	// saved generations are lost.
+	id  string
+	gen uint64
+	// songs are the hashes of songs and the generation they last changed.
+	songs map[SongID]syncVersion
+	// removed are the generations songs were removed, and horizon the
+	// latest forgotten.
+	removed map[SongID]uint64
+	horizon uint64
+}
+
+type syncVersion struct {
+	hash uint64
+	gen  uint64
+}
+
+// syncChanges are the changes to the library since a sync's token.
+type syncChanges struct {
+	changed map[SongID]bool
+	removed []SongID
+	// full is set if the token was unknown, so all songs changed.
+	full bool
+	// token is the token of the library now.
+	token string
+}
+
+// open sets where the generations are saved. It must be called before the
+// sync is used.
+func (l *librarySync) open(srv *Server, name string) {
+	l.srv, l.name = srv, name
+}
+
+// load reads the saved generations, reporting whether there were any.
+func (l *librarySync) load() bool {
+	if l.srv == nil {
+		return false
+	}
+	err := l.srv.view(func(tx *bolt.Tx) error {
+		b := tx.Bucket([]byte(dbSync))
+		if b != nil {
+			b = b.Bucket([]byte(l.name))
+		}
+		if b == nil {
+			return nil
+		}
+		id, gen, horizon := b.Get([]byte(syncID)), b.Get([]byte(syncGen)), b.Get([]byte(syncHorizon))
+		if id == nil || len(gen) != 8 || len(horizon) != 8 {
+			return nil
+		}
+		l.id = string(id)
+		l.gen = binary.BigEndian.Uint64(gen)
+		l.horizon = binary.BigEndian.Uint64(horizon)
+		if s := b.Bucket([]byte(syncSongs)); s != nil {
+			s.ForEach(func(k, v []byte) error {
+				if len(v) == 16 {
+					l.songs[SongID(k)] = syncVersion{
+						hash: binary.BigEndian.Uint64(v),
+						gen:  binary.BigEndian.Uint64(v[8:]),
+					}
+				}
+				return nil
+			})
+		}
+		if r := b.Bucket([]byte(syncRemoved)); r != nil {
+			r.ForEach(func(k, v []byte) error {
+				if len(v) == 8 {
+					l.removed[SongID(k)] = binary.BigEndian.Uint64(v)
+				}
+				return nil
+			})
+		}
+		return nil
+	})
+	if err != nil {
+		log.Printf("sync %s: %v", l.name, err)
+	}
+	return l.id != ""
+}
+
+// save writes the generation and the songs changed and removed in it.
+func (l *librarySync) save(changed, removed []SongID) {
+	if l.srv == nil {
+		return
+	}
+	u64 := func(v uint64) []byte {
+		b := make([]byte, 8)
+		binary.BigEndian.PutUint64(b, v)
+		return b
+	}
+	err := l.srv.update(func(tx *bolt.Tx) error {
+		root, err := tx.CreateBucketIfNotExists([]byte(dbSync))
+		if err != nil {
+			return err
+		}
+		b, err := root.CreateBucketIfNotExists([]byte(l.name))
+		if err != nil {
+			return err
+		}
+		songs, err := b.CreateBucketIfNotExists([]byte(syncSongs))
+		if err != nil {
+			return err
+		}
+		rm, err := b.CreateBucketIfNotExists([]byte(syncRemoved))
+		if err != nil {
+			return err
+		}
+		for _, id := range changed {
+			v := l.songs[id]
+			if err := songs.Put([]byte(id), append(u64(v.hash), u64(v.gen)...)); err != nil {
+				return err
+			}
+			if err := rm.Delete([]byte(id)); err != nil {
+				return err
+			}
+		}
+		for _, id := range removed {
+			if err := songs.Delete([]byte(id)); err != nil {
+				return err
+			}
+			if g, ok := l.removed[id]; ok {
+				err = rm.Put([]byte(id), u64(g))
+			} else {
+				err = rm.Delete([]byte(id))
+			}
+			if err != nil {
+				return err
+			}
+		}
+		for k, v := range map[string][]byte{
+			syncID:      []byte(l.id),
+			syncGen:     u64(l.gen),
+			syncHorizon: u64(l.horizon),
+		} {
+			if err := b.Put([]byte(k), v); err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+	if err != nil {
+		log.Printf("sync %s: %v", l.name, err)
+	}
+}
+
+// update records the changes between the last library seen and hashes, of
+// its songs by ID, returning the token of hashes.
+func (l *librarySync) update(hashes map[SongID]uint64) string {
+	l.Lock()
+	defer l.Unlock()
+	l.updateLocked(hashes)
+	return l.token()
+}
+
+func (l *librarySync) updateLocked(hashes map[SongID]uint64) {
+	if l.songs == nil {
+		l.songs = make(map[SongID]syncVersion)
+		l.removed = make(map[SongID]uint64)
+		if !l.load() {
+			b := make([]byte, 4)
+			rand.Read(b)
+			l.id = hex.EncodeToString(b)
+		}
+	}
+	gen := l.gen + 1
+	var changed, removed []SongID
+	for id, h := range hashes {
+		if v, ok := l.songs[id]; ok && v.hash == h {
+			continue
+		}
+		l.songs[id] = syncVersion{h, gen}
+		delete(l.removed, id)
+		changed = append(changed, id)
+	}
+	for id := range l.songs {
+		if _, ok := hashes[id]; !ok {
+			delete(l.songs, id)
+			l.removed[id] = gen
+			removed = append(removed, id)
+		}
+	}
+	if len(changed) == 0 && len(removed) == 0 {
+		return
+	}
+	l.gen = gen
+	defer func() {
+		l.save(changed, removed)
+	}()
+	if n := len(l.removed) - syncRemovals; n > 0 {
+		// Forget the oldest removals.
+		gens := make([]uint64, 0, len(l.removed))
+		for _, g := range l.removed {
+			gens = append(gens, g)
+		}
+		sort.Slice(gens, func(i, j int) bool { return gens[i] < gens[j] })
+		l.horizon = gens[n-1]
+		for id, g := range l.removed {
+			if g <= l.horizon {
+				delete(l.removed, id)
+				removed = append(removed, id)
+			}
+		}
+	}
+}
+
+func (l *librarySync) token() string {
+	return l.id + "-" + strconv.FormatUint(l.gen, 10)
+}
+
+// parseToken returns the generation of token if it's of this library.
+func (l *librarySync) parseToken(token string) (uint64, bool) {
+	i := strings.LastIndexByte(token, '-')
+	if i < 0 || token[:i] != l.id {
+		return 0, false
+	}
+	gen, err := strconv.ParseUint(token[i+1:], 10, 64)
+	return gen, err == nil && gen <= l.gen
+}
+
+// since returns the changes between the library at token and hashes.
+func (l *librarySync) since(token string, hashes map[SongID]uint64) syncChanges {
+	l.Lock()
+	defer l.Unlock()
+	l.updateLocked(hashes)
+	c := syncChanges{
+		token: l.token(),
+	}
+	gen, ok := l.parseToken(token)
+	if !ok || gen < l.horizon {
+		c.full = true
+		return c
+	}
+	c.changed = make(map[SongID]bool)
+	for id := range hashes {
+		if l.songs[id].gen > gen {
+			c.changed[id] = true
+		}
+	}
+	for id, g := range l.removed {
+		if g > gen {
+			c.removed = append(c.removed, id)
+		}
+	}
+	sort.Slice(c.removed, func(i, j int) bool { return c.removed[i] < c.removed[j] })
+	return c
+}
+
+// libraryTracks are the changes to the library since a sync.
+type libraryTracks struct {
+	// Tracks are the songs added or changed.
+	Tracks []listItem
+	// Removed are the songs removed.
+	Removed []SongID `json:",omitempty"`
+	// Full is set if Tracks is the whole library, which replaces the
+	// client's, because the sync's token was unknown.
+	Full bool `json:",omitempty"`
+	// Token is the since parameter of the next sync, also sent as the ETag.
+	Token string
+}
+
+// itemHashes returns the hashes of all the info of items, by ID.
+func itemHashes(items []listItem) map[SongID]uint64 {
+	hashes := make(map[SongID]uint64, len(items))
+	for _, it := range items {
+		h := fnv.New64a()
+		if it.Info != nil {
+			fmt.Fprintf(h, "%+v", *it.Info)
+		}
+		hashes[it.ID] = h.Sum64()
+	}
+	return hashes
+}
+
+// LibrarySync sends the songs added or changed, with all their info, and the
+// songs removed, since the library of the since parameter's token, or the
+// If-None-Match header's ETag. Clients keeping a copy of the library fetch
+// only the changes. If the library didn't change, it's answered with 304 Not
+// Modified; if the token is unknown or too old, with the whole
+// library.
+func (srv *Server) LibrarySync(w http.ResponseWriter, r *http.Request, ps httprouter.Params) {
+	ch := make(chan *waitData)
+	srv.ch <- cmdWaitData{
+		wt:   waitTracks,
+		user: requestActor(r).user,
+		done: ch,
+	}
+	items := (<-ch).Data.(tracksData).Tracks
+	token := r.FormValue("since")
+	if token == "" {
+		token = strings.Trim(strings.TrimPrefix(r.Header.Get("If-None-Match"), "W/"), `"`)
+	}
+	c := srv.librarySync.since(token, itemHashes(items))
+	w.Header().Set("ETag", strconv.Quote(c.token))
+	w.Header().Set("Cache-Control", "no-cache")
+	if c.token == token {
+		w.WriteHeader(http.StatusNotModified)
+		return
+	}
+	t := &libraryTracks{
+		Tracks:  []listItem{},
+		Removed: c.removed,
+		Full:    c.full,
+		Token:   c.token,
+	}
+	for _, it := range items {
+		if c.full || c.changed[it.ID] {
+			t.Tracks = append(t.Tracks, it)
+		}
+	}
+	serveData(w, r, t)
+}
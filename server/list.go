@@ -0,0 +1,123 @@
+package server
+
+import (
+	"encoding/base64"
+	"fmt"
+	"net/url"
+	"reflect"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/mjibson/moggio/codec"
+)
+
+// listOptions are the pagination and field selection parameters of listing
+// endpoints: limit, the number of items per page; cursor, the Next of the
+// previous page; and fields, a comma-separated list of ID and SongInfo fields
+// to include. Items with selected fields are flattened: {ID, Title, ...}.
+type listOptions struct {
+	limit  int
+	after  SongID
+	fields map[string]bool
+}
+
+// defaultListLimit is the page size if a cursor or fields but no limit is
+// given.
+const defaultListLimit = 1000
+
+// songInfoFields are the lowercased names of SongInfo's fields.
+var songInfoFields = func() map[string]string {
+	m := make(map[string]string)
+	t := reflect.TypeOf(codec.SongInfo{})
+	for i := 0; i < t.NumField(); i++ {
+		m[strings.ToLower(t.Field(i).Name)] = t.Field(i).Name
+	}
+	return m
+}()
+
+// parseListOptions returns the list options of form, or nil if there are
+// none.
+func parseListOptions(form url.Values) (*listOptions, error) {
+	limit, cursor, fields := form.Get("limit"), form.Get("cursor"), form.Get("fields")
+	if limit == "" && cursor == "" && fields == "" {
+		return nil, nil
+	}
+	o := listOptions{
+		limit: defaultListLimit,
+	}
+	if limit != "" {
+		n, err := strconv.Atoi(limit)
+		if err != nil || n < 1 {
+			return nil, fmt.Errorf("bad limit: %v", limit)
+		}
+		o.limit = n
+	}
+	if cursor != "" {
+		b, err := base64.RawURLEncoding.DecodeString(cursor)
+		if err != nil {
+			return nil, fmt.Errorf("bad cursor: %v", cursor)
+		}
+		o.after = SongID(b)
+	}
+	if fields != "" {
+		o.fields = make(map[string]bool)
+		for _, f := range strings.Split(fields, ",") {
+			f = strings.ToLower(strings.TrimSpace(f))
+			if _, ok := songInfoFields[f]; !ok && f != "id" {
+				return nil, fmt.Errorf("unknown field: %v", f)
+			}
+			o.fields[f] = true
+		}
+	}
+	return &o, nil
+}
+
+// listPage is a page of a listing.
+type listPage struct {
+	Items []interface{}
+	// Next is the cursor of the next page, or empty on the last page.
+	Next string `json:",omitempty"`
+}
+
+// page returns the page of items, ordered by ID, after the cursor.
+func (o *listOptions) page(items []listItem) *listPage {
+	sort.Slice(items, func(i, j int) bool {
+		return items[i].ID < items[j].ID
+	})
+	i := sort.Search(len(items), func(i int) bool {
+		return items[i].ID > o.after
+	})
+	items = items[i:]
+	p := &listPage{
+		Items: []interface{}{},
+	}
+	if len(items) > o.limit {
+		items = items[:o.limit]
+		p.Next = base64.RawURLEncoding.EncodeToString([]byte(items[len(items)-1].ID))
+	}
+	for _, it := range items {
+		p.Items = append(p.Items, o.item(it))
+	}
+	return p
+}
+
+func (o *listOptions) item(it listItem) interface{} {
+	if o.fields == nil {
+		return it
+	}
+	m := make(map[string]interface{}, len(o.fields))
+	if o.fields["id"] {
+		m["ID"] = it.ID
+	}
+	if it.Info == nil {
+		return m
+	}
+	v := reflect.ValueOf(it.Info).Elem()
+	for f := range o.fields {
+		if name, ok := songInfoFields[f]; ok {
+			m[name] = v.FieldByName(name).Interface()
+		}
+	}
+	return m
+}
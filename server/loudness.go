@@ -0,0 +1,456 @@
+package server
+
+import (
+	"bytes"
+	"context"
+	"encoding/gob"
+	"encoding/json"
+	"fmt"
+	"io"
+	"log"
+	"math"
+	"net/url"
+	"runtime"
+	"sync"
+	"time"
+
+	"github.com/boltdb/bolt"
+	"github.com/julienschmidt/httprouter"
+	"github.com/mjibson/moggio/codec"
+	"github.com/mjibson/moggio/dsp"
+)
+
+const (
+	dbLoudness = "loudness"
+	// replayGainReference is the loudness, in LUFS, ReplayGain 2.0 adjusts
+	// songs to.
+	replayGainReference = -18
+	// loudnessProgress is how often clients are sent the progress of a
+	// loudness scan.
+	loudnessProgress = time.Second
+)
+
+// Loudness is the loudness of a song found by a scan, per EBU R128.
+type Loudness struct {
+	// Integrated is the integrated loudness in LUFS, and Peak the sample
+	// peak as a linear amplitude.
+	Integrated float64
+	Peak       float64
+	// Album is set if the song was scanned with its album, whose loudness
+	// and peak are AlbumIntegrated and AlbumPeak.
+	Album           bool
+	AlbumIntegrated float64
+	AlbumPeak       float64
+}
+
+// replayGain returns the ReplayGain of l.
+func (l Loudness) replayGain() codec.ReplayGain {
+	return codec.ReplayGain{
+		TrackGain: replayGainReference - l.Integrated,
+		TrackPeak: l.Peak,
+		Album:     l.Album,
+		AlbumGain: replayGainReference - l.AlbumIntegrated,
+		AlbumPeak: l.AlbumPeak,
+	}
+}
+
+// LoudnessError is a song a loudness scan failed on.
+type LoudnessError struct {
+	ID    SongID
+	Info  *codec.SongInfo
+	Error string
+}
+
+// LoudnessScan is the progress of the last loudness scan.
+type LoudnessScan struct {
+	Running bool
+	Scanned int
+	Total   int
+	// Tags is set if ReplayGain tags are written to the songs' files.
+	Tags     bool
+	Started  time.Time
+	Finished time.Time
+	Errors   []LoudnessError
+}
+
+// loudnessScan is the state of the loudness scan.
+type loudnessScan struct {
+	sync.Mutex
+	report LoudnessScan
+	cancel context.CancelFunc
+	// sent is when the progress was last sent to clients.
+	sent time.Time
+}
+
+// start marks a scan of total songs as running, and reports false if one
+// already is.
+func (s *loudnessScan) start(total int, tags bool, cancel context.CancelFunc) bool {
+	s.Lock()
+	defer s.Unlock()
+	if s.report.Running {
+		return false
+	}
+	s.report = LoudnessScan{
+		Running: true,
+		Total:   total,
+		Tags:    tags,
+		Started: time.Now(),
+		Errors:  []LoudnessError{},
+	}
+	s.cancel = cancel
+	return true
+}
+
+// scanned records a song as scanned, and reports whether clients should be
+// sent the progress.
+func (s *loudnessScan) scanned(e *LoudnessError) bool {
+	s.Lock()
+	defer s.Unlock()
+	s.report.Scanned++
+	if e != nil {
+		s.report.Errors = append(s.report.Errors, *e)
+	}
+	if time.Since(s.sent) < loudnessProgress {
+		return false
+	}
+	s.sent = time.Now()
+	return true
+}
+
+// failed records an error of a song already scanned, like writing its tags.
+func (s *loudnessScan) failed(e LoudnessError) {
+	s.Lock()
+	defer s.Unlock()
+	s.report.Errors = append(s.report.Errors, e)
+}
+
+func (s *loudnessScan) finish() {
+	s.Lock()
+	defer s.Unlock()
+	s.report.Running = false
+	s.report.Finished = time.Now()
+	s.cancel()
+}
+
+func (s *loudnessScan) stop() {
+	s.Lock()
+	defer s.Unlock()
+	if s.report.Running {
+		s.cancel()
+	}
+}
+
+func (s *loudnessScan) get() LoudnessScan {
+	s.Lock()
+	defer s.Unlock()
+	r := s.report
+	r.Errors = append([]LoudnessError{}, r.Errors...)
+	return r
+}
+
+// loudnessGroups returns the songs of local protocols grouped by album,
+// only the albums of ids if any. Songs without an album are alone. It
+// should only be called by the commands() function.
+func (srv *Server) loudnessGroups(ids []SongID) [][]listItem {
+	var groups [][]listItem
+	albums := make(map[string]int)
+	songs := make(map[SongID]int)
+	local := srv.localSongs()
+	groupAlbums(local)
+	for _, it := range local {
+		key := albumKey(it)
+		if key == "" {
+			songs[it.ID] = len(groups)
+			groups = append(groups, []listItem{it})
+			continue
+		}
+		i, ok := albums[key]
+		if !ok {
+			i = len(groups)
+			albums[key] = i
+			groups = append(groups, nil)
+		}
+		songs[it.ID] = i
+		groups[i] = append(groups[i], it)
+	}
+	if len(ids) == 0 {
+		return groups
+	}
+	var selected [][]listItem
+	seen := make(map[int]bool)
+	for _, id := range ids {
+		if i, ok := songs[id]; ok && !seen[i] {
+			seen[i] = true
+			selected = append(selected, groups[i])
+		}
+	}
+	return selected
+}
+
+// scanLoudness measures the loudness of groups, each an album, with
+// workers at once.
+func (srv *Server) scanLoudness(ctx context.Context, groups [][]listItem, tags bool, workers int) {
+	defer func() {
+		srv.loudnessScan.finish()
+		srv.ch <- cmdLoudnessProgress{}
+	}()
+	ch := make(chan []listItem)
+	var wg sync.WaitGroup
+	for i := 0; i < workers; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for g := range ch {
+				srv.scanAlbum(ctx, g, tags)
+			}
+		}()
+	}
+	for _, g := range groups {
+		select {
+		case ch <- g:
+		case <-ctx.Done():
+		}
+	}
+	close(ch)
+	wg.Wait()
+}
+
+// scanAlbum measures the loudness of the songs of an album, and of the
+// album if it has one.
+func (srv *Server) scanAlbum(ctx context.Context, songs []listItem, tags bool) {
+	meters := make([]*dsp.LoudnessMeter, len(songs))
+	for i, it := range songs {
+		if ctx.Err() != nil {
+			return
+		}
+		m, err := srv.measureLoudness(ctx, it.ID)
+		if ctx.Err() != nil {
+			// Canceled, which isn't the song's error.
+			return
+		}
+		var e *LoudnessError
+		if err != nil {
+			log.Printf("loudness %v: %v", it.ID, err)
+			e = &LoudnessError{
+				ID:    it.ID,
+				Info:  it.Info,
+				Error: err.Error(),
+			}
+		}
+		meters[i] = m
+		if srv.loudnessScan.scanned(e) {
+			srv.ch <- cmdLoudnessProgress{}
+		}
+	}
+	var blocks []float64
+	var albumPeak float64
+	for _, m := range meters {
+		if m != nil {
+			blocks = append(blocks, m.Blocks()...)
+			albumPeak = math.Max(albumPeak, m.Peak())
+		}
+	}
+	album := songs[0].Info != nil && songs[0].Info.Album != ""
+	albumIntegrated := integrated(dsp.GatedLoudness(blocks))
+	for i, m := range meters {
+		if m == nil {
+			continue
+		}
+		id := songs[i].ID
+		l := Loudness{
+			Integrated:      integrated(m.Integrated()),
+			Peak:            m.Peak(),
+			Album:           album,
+			AlbumIntegrated: albumIntegrated,
+			AlbumPeak:       albumPeak,
+		}
+		if err := srv.saveLoudness(id, l); err != nil {
+			log.Printf("loudness %v: %v", id, err)
+		}
+		srv.ch <- cmdLoudness{
+			id:       id,
+			loudness: l,
+		}
+		if !tags {
+			continue
+		}
+		if err := srv.writeReplayGain(id, l); err != nil {
+			log.Printf("loudness %v: tags: %v", id, err)
+			srv.loudnessScan.failed(LoudnessError{
+				ID:    id,
+				Info:  songs[i].Info,
+				Error: "tags: " + err.Error(),
+			})
+		}
+	}
+}
+
+// integrated returns the loudness l, or the reference for silence, which
+// isn't adjusted.
+func integrated(l float64) float64 {
+	if math.IsInf(l, -1) {
+		return replayGainReference
+	}
+	return l
+}
+
+// measureLoudness decodes the song id through a loudness meter.
+func (srv *Server) measureLoudness(ctx context.Context, id SongID) (m *dsp.LoudnessMeter, err error) {
+	ch := make(chan songResult)
+	srv.ch <- cmdGetSong{
+		id:   id,
+		ctx:  ctx,
+		done: ch,
+	}
+	r := <-ch
+	if r.err != nil {
+		return nil, r.err
+	}
+	song := r.song
+	defer song.Close()
+	// Decoders may panic on garbage.
+	defer func() {
+		if e := recover(); e != nil {
+			m, err = nil, fmt.Errorf("decode: %v", e)
+		}
+	}()
+	sr, channels, err := song.Init()
+	if err != nil {
+		return nil, err
+	}
+	m = dsp.NewLoudnessMeter(sr, channels)
+	n := sr * channels
+	for {
+		if err := ctx.Err(); err != nil {
+			return nil, err
+		}
+		samples, err := song.Play(n)
+		if err != nil && err != io.EOF {
+			return nil, err
+		}
+		m.Write(samples)
+		// Some decoders return io.EOF with their last samples.
+		if err == io.EOF || len(samples) < n {
+			break
+		}
+	}
+	return m, nil
+}
+
+// writeReplayGain writes the ReplayGain tags of l to the file of id.
+func (srv *Server) writeReplayGain(id SongID, l Loudness) error {
+	ch := make(chan fileResult)
+	srv.ch <- cmdOpenFile{
+		id:   id,
+		done: ch,
+	}
+	res := <-ch
+	if res.err != nil {
+		return res.err
+	}
+	path := res.f.Name()
+	res.f.Close()
+	return codec.WriteReplayGain(path, l.replayGain())
+}
+
+func (srv *Server) saveLoudness(id SongID, l Loudness) error {
+	var buf bytes.Buffer
+	if err := gob.NewEncoder(&buf).Encode(l); err != nil {
+		return err
+	}
+	return srv.update(func(tx *bolt.Tx) error {
+		b, err := tx.CreateBucketIfNotExists([]byte(dbLoudness))
+		if err != nil {
+			return err
+		}
+		return b.Put([]byte(id), buf.Bytes())
+	})
+}
+
+func (srv *Server) loadLoudness() (map[SongID]Loudness, error) {
+	m := make(map[SongID]Loudness)
+	err := srv.view(func(tx *bolt.Tx) error {
+		b := tx.Bucket([]byte(dbLoudness))
+		if b == nil {
+			return nil
+		}
+		return b.ForEach(func(k, v []byte) error {
+			var l Loudness
+			if err := gob.NewDecoder(bytes.NewReader(v)).Decode(&l); err != nil {
+				return err
+			}
+			m[SongID(k)] = l
+			return nil
+		})
+	})
+	return m, err
+}
+
+// GetAnalyze returns the progress of the last loudness scan.
+func (srv *Server) GetAnalyze(body io.Reader, form url.Values, ps httprouter.Params) (interface{}, error) {
+	return srv.loudnessScan.get(), nil
+}
+
+// Analyze starts a loudness scan in the background of the albums of the
+// songs in the JSON body's Albums, or of the whole library if empty. Only
+// songs of local protocols are scanned. Their ReplayGain is then from the
+// scan, and written to their tags if Tags is set.
+func (srv *Server) Analyze(body io.Reader, form url.Values, ps httprouter.Params) (interface{}, error) {
+	var req struct {
+		Albums []SongID
+		Tags   bool
+		// Workers is the number of songs scanned at once, or the number
+		// of CPUs if 0.
+		Workers int
+	}
+	if err := json.NewDecoder(body).Decode(&req); err != nil && err != io.EOF {
+		return nil, err
+	}
+	if req.Workers < 0 {
+		return nil, fmt.Errorf("negative workers")
+	}
+	if req.Workers == 0 {
+		req.Workers = runtime.NumCPU()
+	}
+	ch := make(chan [][]listItem)
+	srv.ch <- cmdLoudnessGroups{
+		ids:  req.Albums,
+		done: ch,
+	}
+	groups := <-ch
+	if len(groups) == 0 {
+		return nil, fmt.Errorf("no songs to scan")
+	}
+	total := 0
+	for _, g := range groups {
+		total += len(g)
+	}
+	ctx, cancel := context.WithCancel(srv.ctx)
+	if !srv.loudnessScan.start(total, req.Tags, cancel) {
+		cancel()
+		return nil, fmt.Errorf("loudness scan already running")
+	}
+	srv.audit(ps, "loudness scan", fmt.Sprintf("%d songs, tags: %v", total, req.Tags))
+	srv.ch <- cmdLoudnessProgress{}
+	go srv.scanLoudness(ctx, groups, req.Tags, req.Workers)
+	return nil, nil
+}
+
+// AnalyzeCancel stops the running loudness scan. Songs already scanned
+// keep their loudness.
+func (srv *Server) AnalyzeCancel(body io.Reader, form url.Values, ps httprouter.Params) (interface{}, error) {
+	srv.loudnessScan.stop()
+	return nil, nil
+}
+
+type cmdLoudnessGroups struct {
+	ids  []SongID
+	done chan [][]listItem
+}
+
+type cmdLoudness struct {
+	id       SongID
+	loudness Loudness
+}
+
+type cmdLoudnessProgress struct{}
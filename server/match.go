@@ -0,0 +1,113 @@
+package server
+
+import (
+	"strings"
+	"time"
+	"unicode"
+
+	"github.com/mjibson/moggio/codec"
+)
+
+// trackIndex finds library songs by metadata, for importing playlists from
+// other services.
+type trackIndex struct {
+	// titles maps a normalized title to its songs.
+	titles map[string][]listItem
+}
+
+// newTrackIndex indexes the library. It should only be called by the
+// commands() function.
+func (srv *Server) newTrackIndex() *trackIndex {
+	idx := &trackIndex{
+		titles: make(map[string][]listItem),
+	}
+	for name, protos := range srv.Protocols {
+		for key, inst := range protos {
+			sl, _ := inst.List()
+			for id, info := range sl {
+				sid := SongID(codec.NewID(name, key, string(id)))
+				if srv.Hidden[sid] {
+					continue
+				}
+				t := normalizeTitle(info.Title)
+				idx.titles[t] = append(idx.titles[t], listItem{
+					ID:   sid,
+					Info: info,
+				})
+			}
+		}
+	}
+	return idx
+}
+
+// match returns the song with title by one of artists, preferring the one
+// closest to dur if it is not 0.
+func (idx *trackIndex) match(artists []string, title string, dur time.Duration) (SongID, bool) {
+	var best SongID
+	var bestDiff time.Duration
+	for _, s := range idx.titles[normalizeTitle(title)] {
+		local := normalize(s.Info.Artist)
+		found := false
+		for _, a := range artists {
+			if a := normalize(a); a != "" && (a == local || strings.Contains(local, a)) {
+				found = true
+				break
+			}
+		}
+		if !found {
+			continue
+		}
+		diff := s.Info.Time - dur
+		if diff < 0 {
+			diff = -diff
+		}
+		if dur == 0 || s.Info.Time == 0 {
+			diff = 0
+		}
+		if best == "" || diff < bestDiff {
+			best, bestDiff = s.ID, diff
+		}
+	}
+	return best, best != ""
+}
+
+// normalize lower cases s and reduces it to letters and digits separated by
+// single spaces.
+func normalize(s string) string {
+	s = strings.Replace(strings.ToLower(s), "&", " and ", -1)
+	return strings.Join(strings.FieldsFunc(s, func(r rune) bool {
+		return !unicode.IsLetter(r) && !unicode.IsNumber(r)
+	}), " ")
+}
+
+// normalizeTitle normalizes s without bracketed parts, like "(feat. X)", or
+// a trailing " - Remastered 2011".
+func normalizeTitle(s string) string {
+	var b strings.Builder
+	depth := 0
+	for _, r := range s {
+		switch r {
+		case '(', '[':
+			depth++
+		case ')', ']':
+			if depth > 0 {
+				depth--
+			}
+		default:
+			if depth == 0 {
+				b.WriteRune(r)
+			}
+		}
+	}
+	s = b.String()
+	if i := strings.LastIndex(s, " - "); i > 0 {
+		suffix := strings.ToLower(s[i:])
+		for _, w := range []string{"remaster", "version", "live", "mix", "edit", "mono", "stereo"} {
+			if strings.Contains(suffix, w) {
+				s = s[:i]
+				break
+			}
+		}
+	}
+	return normalize(s)
+}
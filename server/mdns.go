@@ -0,0 +1,360 @@
+package server
+
+import (
+	"encoding/binary"
+	"fmt"
+	"log"
+	"net"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// InstanceName, if set, is the name moggio advertises to other instances on
+// the local network over mDNS, so they can hand off playback to it.
+var InstanceName string
+
+const (
+	mdnsAddr    = "224.0.0.251:5353"
+	mdnsService = "_moggio._tcp.local."
+	// mdnsTTL is the time to live of advertised records, in seconds.
+	mdnsTTL = 120
+
+	dnsTypeA   = 1
+	dnsTypePTR = 12
+	dnsTypeTXT = 16
+	dnsTypeSRV = 33
+	dnsTypeANY = 255
+	dnsClassIN = 1
+	// dnsCacheFlush marks records as unique to their owner.
+	dnsCacheFlush = 0x8000
+)
+
+// Instance is another moggio on the local network.
+type Instance struct {
+	Name string
+	// Addr is its host and port.
+	Addr string
+}
+
+// dnsRecord is a resource record. Data is the uncompressed record data.
+type dnsRecord struct {
+	name  string
+	typ   uint16
+	class uint16
+	ttl   uint32
+	data  []byte
+}
+
+// dnsMessage is the part of a DNS message used by mDNS.
+type dnsMessage struct {
+	id        uint16
+	response  bool
+	questions []dnsRecord
+	records   []dnsRecord
+}
+
+func appendName(b []byte, name string) []byte {
+	for _, l := range strings.Split(strings.TrimSuffix(name, "."), ".") {
+		if l == "" {
+			continue
+		}
+		b = append(b, byte(len(l)))
+		b = append(b, l...)
+	}
+	return append(b, 0)
+}
+
+func (m *dnsMessage) pack() []byte {
+	b := make([]byte, 12)
+	binary.BigEndian.PutUint16(b, m.id)
+	if m.response {
+		// Authoritative answer.
+		binary.BigEndian.PutUint16(b[2:], 0x8400)
+	}
+	binary.BigEndian.PutUint16(b[4:], uint16(len(m.questions)))
+	binary.BigEndian.PutUint16(b[6:], uint16(len(m.records)))
+	for _, q := range m.questions {
+		b = appendName(b, q.name)
+		b = append(b, byte(q.typ>>8), byte(q.typ), byte(q.class>>8), byte(q.class))
+	}
+	for _, r := range m.records {
+		b = appendName(b, r.name)
+		var h [10]byte
+		binary.BigEndian.PutUint16(h[0:], r.typ)
+		binary.BigEndian.PutUint16(h[2:], r.class)
+		binary.BigEndian.PutUint32(h[4:], r.ttl)
+		binary.BigEndian.PutUint16(h[8:], uint16(len(r.data)))
+		b = append(append(b, h[:]...), r.data...)
+	}
+	return b
+}
+
+// readName reads the possibly compressed name at off in msg, and returns
+// it and the offset after it.
+func readName(msg []byte, off int) (string, int, error) {
+	var labels []string
+	end := -1
+	for jumps := 0; ; {
+		if off >= len(msg) {
+			return "", 0, fmt.Errorf("mdns: short name")
+		}
+		n := int(msg[off])
+		switch {
+		case n == 0:
+			off++
+			if end < 0 {
+				end = off
+			}
+			return strings.Join(labels, ".") + ".", end, nil
+		case n&0xc0 == 0xc0:
+			if off+1 >= len(msg) || jumps > 10 {
+				return "", 0, fmt.Errorf("mdns: bad name pointer")
+			}
+			if end < 0 {
+				end = off + 2
+			}
+			off = int(binary.BigEndian.Uint16(msg[off:]) & 0x3fff)
+			jumps++
+		default:
+			if off+1+n > len(msg) {
+				return "", 0, fmt.Errorf("mdns: short label")
+			}
+			labels = append(labels, string(msg[off+1:off+1+n]))
+			off += 1 + n
+		}
+	}
+}
+
+func parseDNS(msg []byte) (*dnsMessage, error) {
+	if len(msg) < 12 {
+		return nil, fmt.Errorf("mdns: short message")
+	}
+	m := &dnsMessage{
+		id:       binary.BigEndian.Uint16(msg),
+		response: msg[2]&0x80 != 0,
+	}
+	qd := int(binary.BigEndian.Uint16(msg[4:]))
+	rr := int(binary.BigEndian.Uint16(msg[6:])) + int(binary.BigEndian.Uint16(msg[8:])) + int(binary.BigEndian.Uint16(msg[10:]))
+	off := 12
+	for i := 0; i < qd+rr; i++ {
+		name, n, err := readName(msg, off)
+		if err != nil {
+			return nil, err
+		}
+		off = n
+		if i < qd {
+			if off+4 > len(msg) {
+				return nil, fmt.Errorf("mdns: short question")
+			}
+			m.questions = append(m.questions, dnsRecord{
+				name:  name,
+				typ:   binary.BigEndian.Uint16(msg[off:]),
+				class: binary.BigEndian.Uint16(msg[off+2:]),
+			})
+			off += 4
+			continue
+		}
+		if off+10 > len(msg) {
+			return nil, fmt.Errorf("mdns: short record")
+		}
+		r := dnsRecord{
+			name:  name,
+			typ:   binary.BigEndian.Uint16(msg[off:]),
+			class: binary.BigEndian.Uint16(msg[off+2:]),
+			ttl:   binary.BigEndian.Uint32(msg[off+4:]),
+		}
+		size := int(binary.BigEndian.Uint16(msg[off+8:]))
+		off += 10
+		if off+size > len(msg) {
+			return nil, fmt.Errorf("mdns: short record data")
+		}
+		r.data = msg[off : off+size]
+		// Names in PTR and SRV data may be compressed.
+		switch r.typ {
+		case dnsTypePTR:
+			target, _, err := readName(msg, off)
+			if err != nil {
+				return nil, err
+			}
+			r.data = appendName(nil, target)
+		case dnsTypeSRV:
+			if size < 7 {
+				return nil, fmt.Errorf("mdns: short SRV record")
+			}
+			target, _, err := readName(msg, off+6)
+			if err != nil {
+				return nil, err
+			}
+			r.data = appendName(append([]byte(nil), msg[off:off+6]...), target)
+		}
+		off += size
+		m.records = append(m.records, r)
+	}
+	return m, nil
+}
+
+// mdnsLabel escapes s for use as a single DNS label.
+func mdnsLabel(s string) string {
+	s = strings.Replace(s, ".", "-", -1)
+	if len(s) > 63 {
+		s = s[:63]
+	}
+	return s
+}
+
+// mdnsRecords returns the records advertising this instance, served on
+// port, at ip.
+func mdnsRecords(port int, ip net.IP) []dnsRecord {
+	instance := mdnsLabel(InstanceName) + "." + mdnsService
+	host := mdnsLabel(InstanceName) + ".local."
+	srv := make([]byte, 6)
+	binary.BigEndian.PutUint16(srv[4:], uint16(port))
+	// ha is the path of the Home Assistant API, for its discovery.
+	var txt []byte
+	for _, s := range []string{"v=1", "ha=/api/ha"} {
+		txt = append(txt, byte(len(s)))
+		txt = append(txt, s...)
+	}
+	return []dnsRecord{
+		{name: mdnsService, typ: dnsTypePTR, class: dnsClassIN, ttl: mdnsTTL, data: appendName(nil, instance)},
+		{name: instance, typ: dnsTypeSRV, class: dnsClassIN | dnsCacheFlush, ttl: mdnsTTL, data: appendName(srv, host)},
+		{name: instance, typ: dnsTypeTXT, class: dnsClassIN | dnsCacheFlush, ttl: mdnsTTL, data: txt},
+		{name: host, typ: dnsTypeA, class: dnsClassIN | dnsCacheFlush, ttl: mdnsTTL, data: ip.To4()},
+	}
+}
+
+// serveMDNS answers mDNS queries for moggio instances, advertising this one,
+// served on the port of addr, if InstanceName is set.
+func serveMDNS(addr string) {
+	if InstanceName == "" {
+		return
+	}
+	_, p, err := net.SplitHostPort(addr)
+	if err != nil {
+		log.Println("mdns:", err)
+		return
+	}
+	port, err := strconv.Atoi(p)
+	if err != nil {
+		log.Println("mdns:", err)
+		return
+	}
+	group, err := net.ResolveUDPAddr("udp4", mdnsAddr)
+	if err != nil {
+		log.Println("mdns:", err)
+		return
+	}
+	conn, err := net.ListenMulticastUDP("udp4", nil, group)
+	if err != nil {
+		log.Println("mdns:", err)
+		return
+	}
+	buf := make([]byte, 9000)
+	for {
+		n, src, err := conn.ReadFromUDP(buf)
+		if err != nil {
+			log.Println("mdns:", err)
+			return
+		}
+		q, err := parseDNS(buf[:n])
+		if err != nil || q.response {
+			continue
+		}
+		wanted := false
+		for _, qu := range q.questions {
+			if strings.EqualFold(qu.name, mdnsService) && (qu.typ == dnsTypePTR || qu.typ == dnsTypeANY) {
+				wanted = true
+			}
+		}
+		if !wanted {
+			continue
+		}
+		resp := &dnsMessage{
+			response: true,
+			records:  mdnsRecords(port, net.ParseIP(localIP(src.String()))),
+		}
+		to := group
+		if src.Port != 5353 {
+			// Legacy unicast queries are answered directly, echoing the
+			// query.
+			resp.id, resp.questions, to = q.id, q.questions, src
+			for i := range resp.records {
+				resp.records[i].class &^= dnsCacheFlush
+				resp.records[i].ttl = 10
+			}
+		}
+		conn.WriteToUDP(resp.pack(), to)
+	}
+}
+
+// discoverInstances queries for moggio instances on the local network for
+// d, and returns those found other than this one.
+func discoverInstances(d time.Duration) ([]Instance, error) {
+	group, err := net.ResolveUDPAddr("udp4", mdnsAddr)
+	if err != nil {
+		return nil, err
+	}
+	conn, err := net.ListenUDP("udp4", nil)
+	if err != nil {
+		return nil, err
+	}
+	defer conn.Close()
+	q := &dnsMessage{
+		questions: []dnsRecord{{name: mdnsService, typ: dnsTypePTR, class: dnsClassIN}},
+	}
+	if _, err := conn.WriteToUDP(q.pack(), group); err != nil {
+		return nil, err
+	}
+	var records []dnsRecord
+	conn.SetReadDeadline(time.Now().Add(d))
+	buf := make([]byte, 9000)
+	for {
+		n, _, err := conn.ReadFromUDP(buf)
+		if err != nil {
+			break
+		}
+		if m, err := parseDNS(append([]byte(nil), buf[:n]...)); err == nil && m.response {
+			records = append(records, m.records...)
+		}
+	}
+	hosts := make(map[string]net.IP)
+	for _, r := range records {
+		if r.typ == dnsTypeA && len(r.data) == 4 {
+			hosts[strings.ToLower(r.name)] = net.IP(r.data)
+		}
+	}
+	self := strings.ToLower(mdnsLabel(InstanceName) + "." + mdnsService)
+	found := make(map[string]Instance)
+	for _, r := range records {
+		if r.typ != dnsTypeSRV || len(r.data) < 7 || !strings.HasSuffix(strings.ToLower(r.name), mdnsService) {
+			continue
+		}
+		if InstanceName != "" && strings.ToLower(r.name) == self {
+			continue
+		}
+		port := binary.BigEndian.Uint16(r.data[4:])
+		target, _, err := readName(r.data, 6)
+		if err != nil {
+			continue
+		}
+		ip := hosts[strings.ToLower(target)]
+		if ip == nil {
+			continue
+		}
+		name := strings.TrimSuffix(r.name, "."+mdnsService)
+		found[name] = Instance{
+			Name: name,
+			Addr: net.JoinHostPort(ip.String(), strconv.Itoa(int(port))),
+		}
+	}
+	var instances []Instance
+	for _, i := range found {
+		instances = append(instances, i)
+	}
+	sort.Slice(instances, func(i, j int) bool {
+		return instances[i].Name < instances[j].Name
+	})
+	return instances, nil
+}
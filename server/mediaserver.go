@@ -0,0 +1,503 @@
+package server
+
+import (
+	"bytes"
+	"encoding/base64"
+	"encoding/binary"
+	"fmt"
+	"hash/fnv"
+	"io"
+	"log"
+	"net"
+	"net/http"
+	"net/url"
+	"path"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/julienschmidt/httprouter"
+	"github.com/mjibson/moggio/dsp"
+)
+
+const (
+	contentDirectoryType = "urn:schemas-upnp-org:service:ContentDirectory:1"
+	mediaServerType      = "urn:schemas-upnp-org:device:MediaServer:1"
+
+	didlHeader = `<DIDL-Lite xmlns="urn:schemas-upnp-org:metadata-1-0/DIDL-Lite/" xmlns:dc="http://purl.org/dc/elements/1.1/" xmlns:upnp="urn:schemas-upnp-org:metadata-1-0/upnp/">`
+	didlFooter = `</DIDL-Lite>`
+)
+
+// directMIME are the types of song files served as is; others are
+// transcoded to WAV, which all DLNA devices play.
+var directMIME = map[string]string{
+	".mp3":  "audio/mpeg",
+	".flac": "audio/flac",
+	".ogg":  "audio/ogg",
+	".wav":  "audio/wav",
+}
+
+// upnpObject is a container or item of the content directory.
+type upnpObject struct {
+	id, parent, title string
+	// class is the UPnP class of containers.
+	class    string
+	children []*upnpObject
+	song     *listItem
+}
+
+// upnpLibrary is the content directory of a library: songs by artist, by
+// album, and by folder.
+type upnpLibrary struct {
+	objects  map[string]*upnpObject
+	updateID uint32
+}
+
+func (l *upnpLibrary) container(id, parent, title, class string) *upnpObject {
+	if o := l.objects[id]; o != nil {
+		return o
+	}
+	o := &upnpObject{
+		id:     id,
+		parent: parent,
+		title:  title,
+		class:  class,
+	}
+	l.objects[id] = o
+	if p := l.objects[parent]; p != nil {
+		p.children = append(p.children, o)
+	}
+	return o
+}
+
+// songObjectID returns the object ID of a song.
+func songObjectID(id SongID) string {
+	return "song/" + url.PathEscape(string(id))
+}
+
+// songFolder returns the folder of a song relative to its instance, or ""
+// if its ID isn't a path.
+func songFolder(id SongID) string {
+	p, _ := id.ID().Pop()
+	if !path.IsAbs(p) {
+		return ""
+	}
+	rel := strings.TrimPrefix(path.Dir(p), path.Clean(id.Key()))
+	return strings.Trim(rel, "/")
+}
+
+func newUPnPLibrary(tracks []listItem) *upnpLibrary {
+	l := &upnpLibrary{
+		objects: make(map[string]*upnpObject),
+	}
+	const storage = "object.container.storageFolder"
+	l.container("0", "-1", UPnPName, storage)
+	l.container("artists", "0", "Artists", storage)
+	l.container("albums", "0", "Albums", storage)
+	l.container("folders", "0", "Folders", storage)
+	sort.Slice(tracks, func(i, j int) bool {
+		a, b := tracks[i].Info, tracks[j].Info
+		if a.Album != b.Album {
+			return strings.ToLower(a.Album) < strings.ToLower(b.Album)
+		}
+		if a.AlbumArtist != b.AlbumArtist {
+			return strings.ToLower(a.AlbumArtist) < strings.ToLower(b.AlbumArtist)
+		}
+		if a.Disc != b.Disc {
+			return a.Disc < b.Disc
+		}
+		if a.Track != b.Track {
+			return a.Track < b.Track
+		}
+		return a.Title < b.Title
+	})
+	h := fnv.New32a()
+	for i := range tracks {
+		t := &tracks[i]
+		h.Reset()
+		h.Write([]byte(t.ID))
+		l.updateID += h.Sum32()
+		artist, album := t.Info.Artist, t.Info.Album
+		if artist == "" {
+			artist = "Unknown Artist"
+		}
+		if album == "" {
+			album = "Unknown Album"
+		}
+		ar := l.container("artist/"+url.PathEscape(artist), "artists", artist, "object.container.person.musicArtist")
+		// Albums of the same name by different artists are apart, and
+		// compilations together.
+		al := l.container("album/"+url.PathEscape(t.Info.AlbumArtist)+"/"+url.PathEscape(album), "albums", album, "object.container.album.musicAlbum")
+		inst := "folder/" + url.PathEscape(t.ID.Protocol()) + "/" + url.PathEscape(t.ID.Key())
+		f := l.container(inst, "folders", t.ID.Protocol()+": "+t.ID.Key(), storage)
+		if dir := songFolder(t.ID); dir != "" {
+			for _, name := range strings.Split(dir, "/") {
+				f = l.container(f.id+"/"+url.PathEscape(name), f.id, name, storage)
+			}
+		}
+		song := &upnpObject{
+			id:     songObjectID(t.ID),
+			parent: al.id,
+			song:   t,
+		}
+		l.objects[song.id] = song
+		for _, c := range []*upnpObject{ar, al, f} {
+			c.children = append(c.children, song)
+		}
+	}
+	for id, o := range l.objects {
+		if id != "artists" && id != "albums" && !strings.HasPrefix(id, "folder") {
+			continue
+		}
+		// Folders first, by name; songs stay in album order.
+		c := o.children
+		sort.SliceStable(c, func(i, j int) bool {
+			if (c[i].song == nil) != (c[j].song == nil) {
+				return c[i].song == nil
+			}
+			return c[i].song == nil && strings.ToLower(c[i].title) < strings.ToLower(c[j].title)
+		})
+	}
+	l.updateID &= 1<<31 - 1
+	return l
+}
+
+// upnpMediaServer returns the UPnP MediaServer device, which serves the
+// library to TVs and receivers on the local network. Songs are streamed
+// from the HTTP server on port.
+func (srv *Server) upnpMediaServer(port string) (upnpDevice, []upnpService, http.Handler) {
+	d := upnpDevice{
+		uuid: upnpUUID("server"),
+		typ:  mediaServerType,
+		path: "/upnp/server/device.xml",
+	}
+	base := "http://" + net.JoinHostPort(localIP(ssdpAddr), port) + "/upnp/server/"
+	directory := upnpService{
+		name: "ContentDirectory",
+		typ:  contentDirectoryType,
+		vars: []upnpVar{
+			{name: "SearchCapabilities", typ: "string"},
+			{name: "SortCapabilities", typ: "string"},
+			{name: "SystemUpdateID", typ: "ui4", events: true},
+			{name: "A_ARG_TYPE_ObjectID", typ: "string"},
+			{name: "A_ARG_TYPE_Result", typ: "string"},
+			{name: "A_ARG_TYPE_BrowseFlag", typ: "string", allowed: []string{"BrowseMetadata", "BrowseDirectChildren"}},
+			{name: "A_ARG_TYPE_Filter", typ: "string"},
+			{name: "A_ARG_TYPE_SortCriteria", typ: "string"},
+			{name: "A_ARG_TYPE_Index", typ: "ui4"},
+			{name: "A_ARG_TYPE_Count", typ: "ui4"},
+			{name: "A_ARG_TYPE_UpdateID", typ: "ui4"},
+		},
+		actions: []upnpAction{
+			{name: "GetSearchCapabilities", out: []string{"SearchCaps=SearchCapabilities"}},
+			{name: "GetSortCapabilities", out: []string{"SortCaps=SortCapabilities"}},
+			{name: "GetSystemUpdateID", out: []string{"Id=SystemUpdateID"}},
+			{name: "Browse", in: []string{"ObjectID=A_ARG_TYPE_ObjectID", "BrowseFlag=A_ARG_TYPE_BrowseFlag", "Filter=A_ARG_TYPE_Filter", "StartingIndex=A_ARG_TYPE_Index", "RequestedCount=A_ARG_TYPE_Count", "SortCriteria=A_ARG_TYPE_SortCriteria"}, out: []string{"Result=A_ARG_TYPE_Result", "NumberReturned=A_ARG_TYPE_Count", "TotalMatches=A_ARG_TYPE_Count", "UpdateID=A_ARG_TYPE_UpdateID"}},
+		},
+		handle: func(action string, args map[string]string) ([]string, error) {
+			switch action {
+			case "GetSearchCapabilities":
+				return []string{"SearchCaps", ""}, nil
+			case "GetSortCapabilities":
+				return []string{"SortCaps", ""}, nil
+			case "GetSystemUpdateID":
+				return []string{"Id", strconv.FormatUint(uint64(srv.upnpLibrary().updateID), 10)}, nil
+			case "Browse":
+				return srv.upnpBrowse(base, args)
+			}
+			return nil, errInvalidAction
+		},
+	}
+	router := httprouter.New()
+	router.GET("/upnp/server/song/:id", srv.upnpSong)
+	router.HEAD("/upnp/server/song/:id", srv.upnpSong)
+	router.GET("/upnp/server/art/:id", srv.upnpArt)
+	return d, []upnpService{directory, srv.connectionManager(false)}, router
+}
+
+// upnpLibrary returns the content directory of the current library,
+// without the songs the parental filter refuses, since devices play them
+// directly.
+func (srv *Server) upnpLibrary() *upnpLibrary {
+	ch := make(chan *waitData)
+	srv.ch <- cmdWaitData{
+		wt:   waitTracks,
+		done: ch,
+	}
+	tracks := (<-ch).Data.(tracksData).Tracks
+	pc := make(chan ParentalFilter)
+	srv.ch <- cmdGetParental(pc)
+	if f := <-pc; f.Enabled {
+		var allowed []listItem
+		for _, t := range tracks {
+			if !f.blocks(t.Info) {
+				allowed = append(allowed, t)
+			}
+		}
+		tracks = allowed
+	}
+	return newUPnPLibrary(tracks)
+}
+
+// upnpBlocked reports whether the parental filter refuses id, and if so
+// responds not found.
+func (srv *Server) upnpBlocked(w http.ResponseWriter, r *http.Request, id SongID) bool {
+	ch := make(chan bool)
+	srv.ch <- cmdBlocked{
+		id:   id,
+		done: ch,
+	}
+	if <-ch {
+		http.NotFound(w, r)
+		return true
+	}
+	return false
+}
+
+func (srv *Server) upnpBrowse(base string, args map[string]string) ([]string, error) {
+	start, err := strconv.Atoi(args["StartingIndex"])
+	if err != nil || start < 0 {
+		return nil, errInvalidArgs
+	}
+	count, err := strconv.Atoi(args["RequestedCount"])
+	if err != nil || count < 0 {
+		return nil, errInvalidArgs
+	}
+	l := srv.upnpLibrary()
+	o := l.objects[args["ObjectID"]]
+	if o == nil {
+		return nil, upnpError{701, "No such object"}
+	}
+	var b bytes.Buffer
+	b.WriteString(didlHeader)
+	var returned, total int
+	switch args["BrowseFlag"] {
+	case "BrowseMetadata":
+		writeDIDL(&b, base, o, o.parent)
+		returned, total = 1, 1
+	case "BrowseDirectChildren":
+		children := o.children
+		total = len(children)
+		if start > len(children) {
+			start = len(children)
+		}
+		children = children[start:]
+		if count > 0 && count < len(children) {
+			children = children[:count]
+		}
+		for _, c := range children {
+			writeDIDL(&b, base, c, o.id)
+		}
+		returned = len(children)
+	default:
+		return nil, errInvalidArgs
+	}
+	b.WriteString(didlFooter)
+	return []string{
+		"Result", b.String(),
+		"NumberReturned", strconv.Itoa(returned),
+		"TotalMatches", strconv.Itoa(total),
+		"UpdateID", strconv.FormatUint(uint64(l.updateID), 10),
+	}, nil
+}
+
+// writeDIDL writes the DIDL-Lite element of o, a child of parent.
+func writeDIDL(w io.Writer, base string, o *upnpObject, parent string) {
+	if o.song == nil {
+		fmt.Fprintf(w, `<container id="%s" parentID="%s" restricted="1" childCount="%d"><dc:title>%s</dc:title><upnp:class>%s</upnp:class></container>`,
+			xmlEscape(o.id), xmlEscape(parent), len(o.children), xmlEscape(o.title), o.class)
+		return
+	}
+	id, info := o.song.ID, o.song.Info
+	fmt.Fprintf(w, `<item id="%s" parentID="%s" restricted="1"><dc:title>%s</dc:title><upnp:class>object.item.audioItem.musicTrack</upnp:class>`,
+		xmlEscape(o.id), xmlEscape(parent), xmlEscape(info.Title))
+	for _, e := range []struct{ name, value string }{
+		{"dc:creator", info.Artist},
+		{"upnp:artist", info.Artist},
+		{"upnp:album", info.Album},
+		{"upnp:genre", info.Genre},
+	} {
+		if e.value != "" {
+			fmt.Fprintf(w, "<%s>%s</%s>", e.name, xmlEscape(e.value), e.name)
+		}
+	}
+	if info.Track > 0 {
+		fmt.Fprintf(w, "<upnp:originalTrackNumber>%d</upnp:originalTrackNumber>", int(info.Track))
+	}
+	enc := base64.RawURLEncoding.EncodeToString([]byte(id))
+	if localProtocols[id.Protocol()] {
+		fmt.Fprintf(w, "<upnp:albumArtURI>%s</upnp:albumArtURI>", xmlEscape(base+"art/"+enc))
+	} else if strings.HasPrefix(info.ImageURL, "http") {
+		fmt.Fprintf(w, "<upnp:albumArtURI>%s</upnp:albumArtURI>", xmlEscape(info.ImageURL))
+	}
+	duration := ""
+	if info.Time > 0 {
+		duration = fmt.Sprintf(` duration="%s.000"`, upnpTime(info.Time))
+	}
+	if ext, mime := songMIME(id); mime != "" {
+		fmt.Fprintf(w, `<res protocolInfo="http-get:*:%s:*"%s>%s</res>`, mime, duration, xmlEscape(base+"song/"+enc+ext))
+	}
+	fmt.Fprintf(w, `<res protocolInfo="http-get:*:audio/wav:*"%s>%s</res>`, duration, xmlEscape(base+"song/"+enc+".wav?transcode=1"))
+	io.WriteString(w, "</item>")
+}
+
+// songMIME returns the file extension and type of a song served as is, or
+// "" if it must be transcoded.
+func songMIME(id SongID) (ext, mime string) {
+	if !localProtocols[id.Protocol()] {
+		return "", ""
+	}
+	p, rest := id.ID().Pop()
+	if rest != "" {
+		// One of several songs in a file.
+		return "", ""
+	}
+	ext = strings.ToLower(path.Ext(p))
+	if mime = directMIME[ext]; mime == "" {
+		return "", ""
+	}
+	return ext, mime
+}
+
+// upnpSongID returns the song of an encoded ID, as in /upnp/server/song/<id>.
+func upnpSongID(s string) (SongID, error) {
+	if i := strings.IndexByte(s, '.'); i >= 0 {
+		s = s[:i]
+	}
+	b, err := base64.RawURLEncoding.DecodeString(s)
+	return SongID(b), err
+}
+
+// upnpSong streams a song, as is if it is a file of a widely supported
+// type, or else, or if the transcode parameter is set, as WAV.
+func (srv *Server) upnpSong(w http.ResponseWriter, r *http.Request, ps httprouter.Params) {
+	id, err := upnpSongID(ps.ByName("id"))
+	if err != nil {
+		http.NotFound(w, r)
+		return
+	}
+	if srv.upnpBlocked(w, r, id) {
+		return
+	}
+	w.Header().Set("transferMode.dlna.org", "Streaming")
+	if _, mime := songMIME(id); mime != "" && r.FormValue("transcode") == "" {
+		w.Header().Set("Content-Type", mime)
+		srv.serveFile(w, r, httprouter.Params{{Key: "id", Value: string(id)}}, false)
+		return
+	}
+	srv.serveWAV(w, r, id)
+}
+
+func (srv *Server) upnpArt(w http.ResponseWriter, r *http.Request, ps httprouter.Params) {
+	id, err := upnpSongID(ps.ByName("id"))
+	if err != nil {
+		http.NotFound(w, r)
+		return
+	}
+	if srv.upnpBlocked(w, r, id) {
+		return
+	}
+	srv.ArtFile(w, r, httprouter.Params{{Key: "id", Value: string(id)}})
+}
+
+// serveWAV decodes a song and serves it as 16-bit WAV. If its duration is
+// known, the length is exact, padded with silence or truncated to it, so
+// devices can show progress.
+func (srv *Server) serveWAV(w http.ResponseWriter, r *http.Request, id SongID) {
+	ch := make(chan songResult)
+	srv.ch <- cmdGetSong{
+		id:   id,
+		ctx:  r.Context(),
+		done: ch,
+	}
+	res := <-ch
+	if res.err != nil {
+		serveError(w, res.err)
+		return
+	}
+	song := res.song
+	defer song.Close()
+	info, _ := song.Info()
+	sr, channels, err := song.Init()
+	if err != nil {
+		serveError(w, err)
+		return
+	}
+	if sr <= 0 || channels <= 0 {
+		serveError(w, fmt.Errorf("bad format: %d Hz, %d channels", sr, channels))
+		return
+	}
+	// size is the length of the data, or -1 if unknown.
+	size := int64(-1)
+	if info.Time > 0 {
+		size = int64(info.Time.Seconds()*float64(sr)) * int64(channels) * 2
+	}
+	w.Header().Set("Content-Type", "audio/wav")
+	w.Header().Set("Accept-Ranges", "none")
+	if size >= 0 {
+		w.Header().Set("Content-Length", strconv.FormatInt(44+size, 10))
+	}
+	if r.Method == "HEAD" {
+		return
+	}
+	w.Write(wavHeader(sr, channels, size))
+	// Decoders may panic on garbage.
+	defer func() {
+		if e := recover(); e != nil {
+			log.Printf("upnp: decode %s: %v", id, e)
+		}
+	}()
+	n := sr * channels / 10
+	var written int64
+	var dither dsp.Dither
+	buf := make([]byte, 0, n*2)
+	for size < 0 || written < size {
+		samples, err := song.Play(n)
+		if err != nil {
+			log.Printf("upnp: decode %s: %v", id, err)
+			break
+		}
+		buf = buf[:0]
+		for _, s := range samples {
+			buf = append(buf, 0, 0)
+			binary.LittleEndian.PutUint16(buf[len(buf)-2:], uint16(dither.Int16(s)))
+		}
+		if size >= 0 && written+int64(len(buf)) > size {
+			buf = buf[:size-written]
+		}
+		if _, err := w.Write(buf); err != nil {
+			return
+		}
+		written += int64(len(buf))
+		if len(samples) < n {
+			break
+		}
+	}
+	if size > written {
+		w.Write(make([]byte, size-written))
+	}
+}
+
+// wavHeader returns the header of a 16-bit WAV file of size bytes of data,
+// or of unknown length if size is negative.
+func wavHeader(sampleRate, channels int, size int64) []byte {
+	riff, data := uint32(0xFFFFFFFF), uint32(0xFFFFFFFF)
+	if size >= 0 {
+		riff, data = uint32(36+size), uint32(size)
+	}
+	blockAlign := channels * 2
+	h := make([]byte, 44)
+	copy(h, "RIFF")
+	binary.LittleEndian.PutUint32(h[4:], riff)
+	copy(h[8:], "WAVEfmt ")
+	binary.LittleEndian.PutUint32(h[16:], 16)
+	binary.LittleEndian.PutUint16(h[20:], 1)
+	binary.LittleEndian.PutUint16(h[22:], uint16(channels))
+	binary.LittleEndian.PutUint32(h[24:], uint32(sampleRate))
+	binary.LittleEndian.PutUint32(h[28:], uint32(sampleRate*blockAlign))
+	binary.LittleEndian.PutUint16(h[32:], uint16(blockAlign))
+	binary.LittleEndian.PutUint16(h[34:], 16)
+	copy(h[36:], "data")
+	binary.LittleEndian.PutUint32(h[40:], data)
+	return h
+}
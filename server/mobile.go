@@ -0,0 +1,191 @@
+package server
+
+import (
+	"fmt"
+	"hash/fnv"
+	"mime"
+	"net/http"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// The mobile profile is a compact form of the API's data for clients on
+// cellular connections. It's requested with the profile=mobile parameter,
+// or a profile parameter of the Accept media type, like
+// "application/json; profile=mobile". Songs have only their title, artist,
+// album, duration, and artwork thumbnail, and the library is synced by
+// sending only the changes since a token from the last sync.
+const (
+	profileMobile = "mobile"
+	// paramProfile is the httprouter parameter JSON handlers receive the
+	// requested profile in.
+	paramProfile = "moggio-profile"
+	// mobileThumbSize is the size of the artwork thumbnails.
+	mobileThumbSize = 128
+)
+
+// requestProfile returns the profile requested by r, or empty.
+func requestProfile(r *http.Request) string {
+	if p := r.URL.Query().Get("profile"); p != "" {
+		return p
+	}
+	for _, t := range strings.Split(r.Header.Get("Accept"), ",") {
+		if _, params, err := mime.ParseMediaType(t); err == nil && params["profile"] != "" {
+			return params["profile"]
+		}
+	}
+	return ""
+}
+
+// mobileSong is the compact info of a song.
+type mobileSong struct {
+	ID     SongID
+	Title  string `json:",omitempty"`
+	Artist string `json:",omitempty"`
+	Album  string `json:",omitempty"`
+	// Time is the duration in seconds.
+	Time int `json:",omitempty"`
+	// Art is the URL of the artwork, a thumbnail if the server has it.
+	Art string `json:",omitempty"`
+}
+
+func newMobileSong(it listItem) mobileSong {
+	s := mobileSong{ID: it.ID}
+	if info := it.Info; info != nil {
+		s.Title = info.Title
+		s.Artist = info.Artist
+		s.Album = info.Album
+		s.Time = int(info.Time / time.Second)
+		s.Art = info.ImageURL
+		if strings.HasPrefix(s.Art, artURL) {
+			s.Art += "?size=" + strconv.Itoa(mobileThumbSize)
+		}
+	}
+	return s
+}
+
+func (s mobileSong) hash() uint64 {
+	h := fnv.New64a()
+	fmt.Fprintf(h, "%s\x00%s\x00%s\x00%d\x00%s", s.Title, s.Artist, s.Album, s.Time, s.Art)
+	return h.Sum64()
+}
+
+func mobileSongs(items []listItem) []mobileSong {
+	songs := make([]mobileSong, len(items))
+	for i, it := range items {
+		songs[i] = newMobileSong(it)
+	}
+	return songs
+}
+
+type mobileStatus struct {
+	State State
+	Song  *mobileSong `json:",omitempty"`
+	// Elapsed and Time are in seconds.
+	Elapsed int `json:",omitempty"`
+	Time    int `json:",omitempty"`
+	Volume  float64
+	Random  bool `json:",omitempty"`
+	Repeat  bool `json:",omitempty"`
+}
+
+type mobilePlaylists struct {
+	Queue []mobileSong
+	// Playlists are the numbers of songs of the playlists by name.
+	Playlists map[string]int `json:",omitempty"`
+}
+
+// mobileTracks are the changes to the library since a sync.
+type mobileTracks struct {
+	// Tracks are the songs added or changed.
+	Tracks []mobileSong
+	// Removed are the songs removed.
+	Removed []SongID `json:",omitempty"`
+	// Full is set if Tracks is the whole library, which replaces the
+	// client's, because the sync's token was unknown.
+	Full bool `json:",omitempty"`
+	// Token is the since parameter of the next sync.
+	Token string
+}
+
+// mobileData returns the mobile profile of wd. Websocket clients are sent
+// only the sync token of tracks, with which they get the changes.
+func (srv *Server) mobileData(wd *waitData) *waitData {
+	var data interface{}
+	switch d := wd.Data.(type) {
+	case *Status:
+		s := &mobileStatus{
+			State:   d.State,
+			Elapsed: int(d.Elapsed / time.Second),
+			Time:    int(d.Time / time.Second),
+			Volume:  d.Volume,
+			Random:  d.Random,
+			Repeat:  d.Repeat,
+		}
+		if d.Song != "" {
+			song := newMobileSong(listItem{ID: d.Song, Info: &d.SongInfo})
+			s.Song = &song
+		}
+		data = s
+	case tracksData:
+		data = struct {
+			Token string
+		}{srv.mobile.update(mobileSongs(d.Tracks))}
+	case playlistsData:
+		p := &mobilePlaylists{
+			Queue: mobileSongs(d.Queue),
+		}
+		if len(d.Playlists) > 0 {
+			p.Playlists = make(map[string]int)
+			for name, pl := range d.Playlists {
+				p.Playlists[name] = len(pl)
+			}
+		}
+		data = p
+	default:
+		return wd
+	}
+	return &waitData{
+		Type: wd.Type,
+		Data: data,
+		Seq:  wd.Seq,
+	}
+}
+
+// mobileSync syncs the compact songs of the library, which change only with
+// the fields mobile clients have.
+type mobileSync struct {
+	librarySync
+}
+
+// update records the changes between the last library seen and songs,
+// returning the token of songs.
+func (m *mobileSync) update(songs []mobileSong) string {
+	return m.librarySync.update(mobileHashes(songs))
+}
+
+// since returns the changes between the library at token and songs.
+func (m *mobileSync) since(token string, songs []mobileSong) *mobileTracks {
+	c := m.librarySync.since(token, mobileHashes(songs))
+	t := &mobileTracks{
+		Tracks:  []mobileSong{},
+		Removed: c.removed,
+		Full:    c.full,
+		Token:   c.token,
+	}
+	for _, s := range songs {
+		if c.full || c.changed[s.ID] {
+			t.Tracks = append(t.Tracks, s)
+		}
+	}
+	return t
+}
+
+func mobileHashes(songs []mobileSong) map[SongID]uint64 {
+	hashes := make(map[SongID]uint64, len(songs))
+	for _, s := range songs {
+		hashes[s.ID] = s.hash()
+	}
+	return hashes
+}
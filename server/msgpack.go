@@ -0,0 +1,375 @@
+package server
+
+import (
+	"encoding"
+	"encoding/json"
+	"fmt"
+	"math"
+	"net/http"
+	"reflect"
+	"strconv"
+	"strings"
+	"sync"
+
+	"golang.org/x/net/websocket"
+)
+
+// msgpackType is the media type of MessagePack, which clients may request
+// instead of JSON with the Accept header, or the encoding=msgpack parameter
+// of websockets.
+const msgpackType = "application/msgpack"
+
+func acceptsMsgpack(r *http.Request) bool {
+	if r.URL.Query().Get("encoding") == "msgpack" {
+		return true
+	}
+	for _, t := range strings.Split(r.Header.Get("Accept"), ",") {
+		switch strings.TrimSpace(strings.Split(t, ";")[0]) {
+		case msgpackType, "application/x-msgpack":
+			return true
+		}
+	}
+	return false
+}
+
+// msgpackCodec sends websocket messages as binary MessagePack frames.
+var msgpackCodec = websocket.Codec{
+	Marshal: func(v interface{}) ([]byte, byte, error) {
+		b, err := msgpackMarshal(v)
+		return b, websocket.BinaryFrame, err
+	},
+}
+
+// msgpackMarshal returns the MessagePack encoding of v. It is encoded as
+// encoding/json would: with the same field names and omissions, and types
+// with their own JSON encoding encoded as that decodes.
+func msgpackMarshal(v interface{}) ([]byte, error) {
+	var e msgpackEncoder
+	if err := e.encode(reflect.ValueOf(v)); err != nil {
+		return nil, err
+	}
+	return e.buf, nil
+}
+
+type msgpackEncoder struct {
+	buf []byte
+}
+
+var (
+	jsonMarshalerType = reflect.TypeOf((*json.Marshaler)(nil)).Elem()
+	textMarshalerType = reflect.TypeOf((*encoding.TextMarshaler)(nil)).Elem()
+)
+
+func (e *msgpackEncoder) encode(v reflect.Value) error {
+	if !v.IsValid() {
+		e.buf = append(e.buf, 0xc0)
+		return nil
+	}
+	if v.Type().Implements(jsonMarshalerType) {
+		if v.Kind() == reflect.Ptr && v.IsNil() {
+			e.buf = append(e.buf, 0xc0)
+			return nil
+		}
+		b, err := v.Interface().(json.Marshaler).MarshalJSON()
+		if err != nil {
+			return err
+		}
+		var i interface{}
+		if err := json.Unmarshal(b, &i); err != nil {
+			return err
+		}
+		return e.encode(reflect.ValueOf(i))
+	}
+	switch v.Kind() {
+	case reflect.Ptr, reflect.Interface:
+		if v.IsNil() {
+			e.buf = append(e.buf, 0xc0)
+			return nil
+		}
+		return e.encode(v.Elem())
+	case reflect.Bool:
+		if v.Bool() {
+			e.buf = append(e.buf, 0xc3)
+		} else {
+			e.buf = append(e.buf, 0xc2)
+		}
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		e.int(v.Int())
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64, reflect.Uintptr:
+		e.uint(v.Uint())
+	case reflect.Float32:
+		e.buf = append(e.buf, 0xca)
+		e.put32(math.Float32bits(float32(v.Float())))
+	case reflect.Float64:
+		e.buf = append(e.buf, 0xcb)
+		e.put64(math.Float64bits(v.Float()))
+	case reflect.String:
+		e.str(v.String())
+	case reflect.Slice:
+		if v.IsNil() {
+			e.buf = append(e.buf, 0xc0)
+			return nil
+		}
+		if v.Type().Elem().Kind() == reflect.Uint8 {
+			e.bin(v.Bytes())
+			return nil
+		}
+		fallthrough
+	case reflect.Array:
+		e.header(v.Len(), 0x90, 0xdc, 0xdd)
+		for i := 0; i < v.Len(); i++ {
+			if err := e.encode(v.Index(i)); err != nil {
+				return err
+			}
+		}
+	case reflect.Map:
+		if v.IsNil() {
+			e.buf = append(e.buf, 0xc0)
+			return nil
+		}
+		e.header(v.Len(), 0x80, 0xde, 0xdf)
+		iter := v.MapRange()
+		for iter.Next() {
+			k, err := mapKey(iter.Key())
+			if err != nil {
+				return err
+			}
+			e.str(k)
+			if err := e.encode(iter.Value()); err != nil {
+				return err
+			}
+		}
+	case reflect.Struct:
+		var fields []msgpackField
+		for _, f := range structFields(v.Type()) {
+			fv, ok := fieldByIndex(v, f.index)
+			if !ok || f.omitEmpty && isEmptyValue(fv) {
+				continue
+			}
+			f.value = fv
+			fields = append(fields, f)
+		}
+		e.header(len(fields), 0x80, 0xde, 0xdf)
+		for _, f := range fields {
+			e.str(f.name)
+			if err := e.encode(f.value); err != nil {
+				return err
+			}
+		}
+	default:
+		return fmt.Errorf("msgpack: unsupported type: %v", v.Type())
+	}
+	return nil
+}
+
+func (e *msgpackEncoder) int(i int64) {
+	switch {
+	case i >= 0:
+		e.uint(uint64(i))
+	case i >= -32:
+		e.buf = append(e.buf, byte(i))
+	case i >= math.MinInt8:
+		e.buf = append(e.buf, 0xd0, byte(i))
+	case i >= math.MinInt16:
+		e.buf = append(e.buf, 0xd1)
+		e.put16(uint16(i))
+	case i >= math.MinInt32:
+		e.buf = append(e.buf, 0xd2)
+		e.put32(uint32(i))
+	default:
+		e.buf = append(e.buf, 0xd3)
+		e.put64(uint64(i))
+	}
+}
+
+func (e *msgpackEncoder) uint(u uint64) {
+	switch {
+	case u < 128:
+		e.buf = append(e.buf, byte(u))
+	case u <= math.MaxUint8:
+		e.buf = append(e.buf, 0xcc, byte(u))
+	case u <= math.MaxUint16:
+		e.buf = append(e.buf, 0xcd)
+		e.put16(uint16(u))
+	case u <= math.MaxUint32:
+		e.buf = append(e.buf, 0xce)
+		e.put32(uint32(u))
+	default:
+		e.buf = append(e.buf, 0xcf)
+		e.put64(u)
+	}
+}
+
+func (e *msgpackEncoder) str(s string) {
+	if len(s) < 32 {
+		e.buf = append(e.buf, 0xa0|byte(len(s)))
+	} else if len(s) <= math.MaxUint8 {
+		e.buf = append(e.buf, 0xd9, byte(len(s)))
+	} else {
+		e.header(len(s), 0, 0xda, 0xdb)
+	}
+	e.buf = append(e.buf, s...)
+}
+
+func (e *msgpackEncoder) bin(b []byte) {
+	if len(b) <= math.MaxUint8 {
+		e.buf = append(e.buf, 0xc4, byte(len(b)))
+	} else {
+		e.header(len(b), 0, 0xc5, 0xc6)
+	}
+	e.buf = append(e.buf, b...)
+}
+
+func (e *msgpackEncoder) put16(v uint16) {
+	e.buf = append(e.buf, byte(v>>8), byte(v))
+}
+
+func (e *msgpackEncoder) put32(v uint32) {
+	e.put16(uint16(v >> 16))
+	e.put16(uint16(v))
+}
+
+func (e *msgpackEncoder) put64(v uint64) {
+	e.put32(uint32(v >> 32))
+	e.put32(uint32(v))
+}
+
+// header appends the header of an n element value: fix|n if n < 16 and fix
+// is set, else the 16 or 32 bit header.
+func (e *msgpackEncoder) header(n int, fix, h16, h32 byte) {
+	switch {
+	case n < 16 && fix != 0:
+		e.buf = append(e.buf, fix|byte(n))
+	case n <= math.MaxUint16:
+		e.buf = append(e.buf, h16)
+		e.put16(uint16(n))
+	default:
+		e.buf = append(e.buf, h32)
+		e.put32(uint32(n))
+	}
+}
+
+// mapKey returns the string of a map key, as encoding/json would.
+func mapKey(k reflect.Value) (string, error) {
+	if k.Kind() == reflect.String {
+		return k.String(), nil
+	}
+	if k.Type().Implements(textMarshalerType) {
+		b, err := k.Interface().(encoding.TextMarshaler).MarshalText()
+		return string(b), err
+	}
+	switch k.Kind() {
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		return strconv.FormatInt(k.Int(), 10), nil
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64, reflect.Uintptr:
+		return strconv.FormatUint(k.Uint(), 10), nil
+	}
+	return "", fmt.Errorf("msgpack: unsupported map key type: %v", k.Type())
+}
+
+type msgpackField struct {
+	name      string
+	index     []int
+	omitEmpty bool
+	value     reflect.Value
+}
+
+var fieldCache sync.Map // map[reflect.Type][]msgpackField
+
+// structFields returns the fields of t encoding/json encodes, including
+// those of embedded structs.
+func structFields(t reflect.Type) []msgpackField {
+	if f, ok := fieldCache.Load(t); ok {
+		return f.([]msgpackField)
+	}
+	var fields []msgpackField
+	for i := 0; i < t.NumField(); i++ {
+		sf := t.Field(i)
+		tag := sf.Tag.Get("json")
+		if tag == "-" {
+			continue
+		}
+		sp := strings.Split(tag, ",")
+		name := sp[0]
+		if sf.Anonymous && name == "" {
+			ft := sf.Type
+			if ft.Kind() == reflect.Ptr {
+				ft = ft.Elem()
+			}
+			if ft.Kind() == reflect.Struct {
+				for _, f := range structFields(ft) {
+					if fieldIndex(fields, f.name) >= 0 {
+						continue
+					}
+					f.index = append([]int{i}, f.index...)
+					fields = append(fields, f)
+				}
+				continue
+			}
+		}
+		if sf.PkgPath != "" {
+			continue
+		}
+		if name == "" {
+			name = sf.Name
+		}
+		f := msgpackField{
+			name:  name,
+			index: []int{i},
+		}
+		for _, o := range sp[1:] {
+			if o == "omitempty" {
+				f.omitEmpty = true
+			}
+		}
+		// Fields of the outer struct hide embedded ones.
+		if j := fieldIndex(fields, name); j >= 0 {
+			fields = append(fields[:j], fields[j+1:]...)
+		}
+		fields = append(fields, f)
+	}
+	fieldCache.Store(t, fields)
+	return fields
+}
+
+func fieldIndex(fields []msgpackField, name string) int {
+	for i, f := range fields {
+		if f.name == name {
+			return i
+		}
+	}
+	return -1
+}
+
+// fieldByIndex is like v.FieldByIndex, but reports false instead of
+// panicking on nil embedded pointers.
+func fieldByIndex(v reflect.Value, index []int) (reflect.Value, bool) {
+	for i, x := range index {
+		if i > 0 && v.Kind() == reflect.Ptr {
+			if v.IsNil() {
+				return reflect.Value{}, false
+			}
+			v = v.Elem()
+		}
+		v = v.Field(x)
+	}
+	return v, true
+}
+
+func isEmptyValue(v reflect.Value) bool {
+	switch v.Kind() {
+	case reflect.Array, reflect.Map, reflect.Slice, reflect.String:
+		return v.Len() == 0
+	case reflect.Bool:
+		return !v.Bool()
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		return v.Int() == 0
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64, reflect.Uintptr:
+		return v.Uint() == 0
+	case reflect.Float32, reflect.Float64:
+		return v.Float() == 0
+	case reflect.Interface, reflect.Ptr:
+		return v.IsNil()
+	}
+	return false
+}
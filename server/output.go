@@ -0,0 +1,70 @@
+package server
+
+import (
+	"expvar"
+	"fmt"
+	"io"
+	"net/url"
+	"time"
+
+	"github.com/julienschmidt/httprouter"
+	"github.com/mjibson/moggio/output"
+)
+
+// OutputBackend, if set, overrides the saved output backend.
+var OutputBackend string
+
+func init() {
+	expvar.Publish("output_underruns", expvar.Func(func() interface{} {
+		return output.Underruns()
+	}))
+}
+
+type outputSettings struct {
+	Backends []string
+	Backend  string
+	// Latency is the requested latency of each backend, or 0 for its
+	// default.
+	Latency map[string]time.Duration
+	Device  string
+}
+
+// Outputs lists the output backends and their settings, and the audio
+// devices of the selected backend.
+func (srv *Server) Outputs(body io.Reader, form url.Values, ps httprouter.Params) (interface{}, error) {
+	ch := make(chan *waitData)
+	srv.ch <- cmdWaitData{
+		wt:   waitOutputs,
+		done: ch,
+	}
+	settings := (<-ch).Data.(outputSettings)
+	devs, err := output.Devices(settings.Backend)
+	if err != nil {
+		return nil, err
+	}
+	return struct {
+		outputSettings
+		Devices []output.Device
+	}{
+		settings,
+		devs,
+	}, nil
+}
+
+func checkBackend(name string) error {
+	for _, b := range output.Backends() {
+		if b == name {
+			return nil
+		}
+	}
+	return fmt.Errorf("unknown output backend: %v", name)
+}
+
+type cmdDevice string
+
+type cmdBackend string
+
+type cmdLatency struct {
+	backend string
+	latency time.Duration
+}
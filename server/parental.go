@@ -0,0 +1,107 @@
+package server
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/url"
+	"strings"
+
+	"github.com/julienschmidt/httprouter"
+	"github.com/mjibson/moggio/codec"
+)
+
+// ParentalFilter refuses to queue songs unsuitable for families or
+// parties. Only admins may change it.
+type ParentalFilter struct {
+	Enabled bool
+	// Hide also hides filtered songs from the library and search.
+	Hide bool
+	// Explicit filters songs tagged with explicit content.
+	Explicit bool
+	// Genres are genres filtered, and Keywords words filtered in titles,
+	// compared ignoring case and punctuation.
+	Genres   []string `json:",omitempty"`
+	Keywords []string `json:",omitempty"`
+}
+
+// blocks reports whether f filters songs of info.
+func (f *ParentalFilter) blocks(info *codec.SongInfo) bool {
+	if !f.Enabled || info == nil {
+		return false
+	}
+	if f.Explicit && info.Explicit {
+		return true
+	}
+	if len(f.Genres) > 0 && info.Genre != "" {
+		// Songs may have several genres, like "Rock; Metal".
+		genres := strings.FieldsFunc(info.Genre, func(r rune) bool {
+			return r == ';' || r == ',' || r == '/'
+		})
+		for _, g := range genres {
+			g = normalize(g)
+			for _, b := range f.Genres {
+				if g == normalize(b) {
+					return true
+				}
+			}
+		}
+	}
+	if len(f.Keywords) > 0 {
+		title := " " + normalize(info.Title) + " "
+		for _, k := range f.Keywords {
+			if k = normalize(k); k != "" && strings.Contains(title, " "+k+" ") {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+// hides reports whether songs of info are hidden from listings.
+func (f *ParentalFilter) hides(info *codec.SongInfo) bool {
+	return f.Hide && f.blocks(info)
+}
+
+// blocked reports whether the parental filter refuses to queue id. It
+// should only be called by the commands() function.
+func (srv *Server) blocked(id SongID) bool {
+	if !srv.Parental.Enabled {
+		return false
+	}
+	info, err := srv.getSong(id)
+	return err == nil && srv.Parental.blocks(info)
+}
+
+// errBlocked is the error of songs refused by the parental filter.
+func errBlocked(id SongID) error {
+	return fmt.Errorf("blocked by parental filter: %v", id)
+}
+
+// GetParental returns the parental filter.
+func (srv *Server) GetParental(body io.Reader, form url.Values, ps httprouter.Params) (interface{}, error) {
+	ch := make(chan ParentalFilter)
+	srv.ch <- cmdGetParental(ch)
+	return <-ch, nil
+}
+
+// SetParental sets the parental filter.
+func (srv *Server) SetParental(body io.Reader, form url.Values, ps httprouter.Params) (interface{}, error) {
+	var f ParentalFilter
+	if err := json.NewDecoder(body).Decode(&f); err != nil {
+		return nil, err
+	}
+	srv.audit(ps, "parental filter", fmt.Sprintf("enabled %v", f.Enabled))
+	srv.ch <- cmdSetParental(f)
+	return nil, nil
+}
+
+type cmdGetParental chan ParentalFilter
+
+type cmdSetParental ParentalFilter
+
+// cmdBlocked reports whether the parental filter refuses id.
+type cmdBlocked struct {
+	id   SongID
+	done chan bool
+}
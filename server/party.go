@@ -0,0 +1,249 @@
+package server
+
+import (
+	"crypto/subtle"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net"
+	"net/http"
+	"net/url"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/julienschmidt/httprouter"
+)
+
+// OwnerToken, if set, is the auth token required for full control of the
+// server. Requests without it are rejected, or limited to the guest API when
+// party mode is enabled.
+var OwnerToken string
+
+const authCookie = "moggio-auth"
+
+// Party holds the party mode settings.
+type Party struct {
+	Enabled bool
+	// SkipVotes is the number of distinct guests that must vote to skip the
+	// current song.
+	SkipVotes int
+	// Rate is the number of API requests a guest IP may make per minute.
+	Rate int
+}
+
+var defaultParty = Party{
+	SkipVotes: 3,
+	Rate:      30,
+}
+
+// guestRoutes are the method and path prefixes available to guests: users
+// with the guest role, and anyone in party mode.
+var guestRoutes = [][2]string{
+	{"GET", "/api/data/"},
+	{"GET", "/api/search"},
+	{"GET", "/api/waveform/"},
+	{"GET", "/api/art/"},
+	{"POST", "/api/party/"},
+	{"GET", "/ws/"},
+	{"GET", "/static/"},
+}
+
+func guestAllowed(r *http.Request) bool {
+	if r.URL.Path == "/" {
+		return r.Method == "GET"
+	}
+	for _, route := range guestRoutes {
+		if r.Method == route[0] && strings.HasPrefix(r.URL.Path, route[1]) {
+			return true
+		}
+	}
+	return false
+}
+
+// guests tracks party mode state needed by HTTP handlers, which run outside
+// of the commands() go routine.
+type guests struct {
+	sync.Mutex
+	party    Party
+	visitors map[string]*visitor
+	// swept is when visitors were last pruned of those whose limits reset.
+	swept time.Time
+}
+
+type visitor struct {
+	n     int
+	reset time.Time
+}
+
+func (g *guests) set(p Party) {
+	g.Lock()
+	g.party = p
+	g.visitors = make(map[string]*visitor)
+	g.Unlock()
+}
+
+func (g *guests) enabled() bool {
+	g.Lock()
+	defer g.Unlock()
+	return g.party.Enabled
+}
+
+// allow reports whether ip may make another request within its rate limit.
+func (g *guests) allow(ip string) bool {
+	g.Lock()
+	defer g.Unlock()
+	if g.party.Rate <= 0 {
+		return true
+	}
+	now := time.Now()
+	if now.Sub(g.swept) > time.Minute {
+		for k, v := range g.visitors {
+			if now.After(v.reset) {
+				delete(g.visitors, k)
+			}
+		}
+		g.swept = now
+	}
+	v := g.visitors[ip]
+	if v == nil || now.After(v.reset) {
+		v = &visitor{reset: now.Add(time.Minute)}
+		g.visitors[ip] = v
+	}
+	v.n++
+	return v.n <= g.party.Rate
+}
+
+func remoteIP(r *http.Request) string {
+	host, _, err := net.SplitHostPort(r.RemoteAddr)
+	if err != nil {
+		return r.RemoteAddr
+	}
+	return host
+}
+
+// requestToken returns the auth token presented by r, if any.
+func requestToken(r *http.Request) string {
+	if h := r.Header.Get("Authorization"); strings.HasPrefix(h, "Bearer ") {
+		return strings.TrimPrefix(h, "Bearer ")
+	}
+	if t := r.URL.Query().Get("auth"); t != "" {
+		return t
+	}
+	if c, err := r.Cookie(authCookie); err == nil {
+		return c.Value
+	}
+	return ""
+}
+
+// ownerToken reports whether t is the owner token, in constant time so the
+// token can't be guessed from response times.
+func ownerToken(t string) bool {
+	return subtle.ConstantTimeCompare([]byte(t), []byte(OwnerToken)) == 1
+}
+
+func isOwner(r *http.Request) bool {
+	return OwnerToken == "" || ownerToken(requestToken(r))
+}
+
+// authorize wraps h to enforce the owner token, user roles, and party mode
+// restrictions. Requests with a user's token are made as that user. The web
+// UI's assets are public.
+func (srv *Server) authorize(h http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if t := r.URL.Query().Get("auth"); t != "" {
+			if user, _ := srv.tokens.lookup(t); ownerToken(t) || user != "" {
+				// Remember the token so the web UI doesn't need to send it.
+				http.SetCookie(w, &http.Cookie{
+					Name:     authCookie,
+					Value:    t,
+					Path:     "/",
+					HttpOnly: true,
+					SameSite: http.SameSiteLaxMode,
+				})
+			}
+		}
+		if publicAsset(r) {
+			h.ServeHTTP(w, r)
+			return
+		}
+		if user, role := srv.tokens.lookup(requestToken(r)); user != "" {
+			if !role.allowed(r) {
+				http.Error(w, "forbidden", http.StatusForbidden)
+				return
+			}
+			h.ServeHTTP(w, withActor(r, actor{user, role}))
+			return
+		}
+		if isOwner(r) {
+			h.ServeHTTP(w, r)
+			return
+		}
+		if !srv.guests.enabled() || !RoleGuest.allowed(r) {
+			http.Error(w, "unauthorized", http.StatusUnauthorized)
+			return
+		}
+		if strings.HasPrefix(r.URL.Path, "/api/") && !srv.guests.allow(remoteIP(r)) {
+			http.Error(w, "rate limit exceeded", http.StatusTooManyRequests)
+			return
+		}
+		h.ServeHTTP(w, withActor(r, actor{role: RoleGuest}))
+	})
+}
+
+func (srv *Server) PartyAdd(w http.ResponseWriter, r *http.Request, ps httprouter.Params) {
+	var uid string
+	if err := json.NewDecoder(r.Body).Decode(&uid); err != nil {
+		serveError(w, err)
+		return
+	}
+	srv.auditRequest(r, "party add", uid)
+	ch := make(chan error)
+	srv.ch <- cmdPartyAdd{
+		id:  SongID(uid),
+		err: ch,
+	}
+	if err := <-ch; err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+	}
+}
+
+func (srv *Server) PartySkip(w http.ResponseWriter, r *http.Request, ps httprouter.Params) {
+	srv.auditRequest(r, "party skip", "")
+	ch := make(chan error)
+	srv.ch <- cmdPartySkip{
+		ip:  remoteIP(r),
+		err: ch,
+	}
+	if err := <-ch; err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+	}
+}
+
+func (srv *Server) PartySet(body io.Reader, form url.Values, ps httprouter.Params) (interface{}, error) {
+	p := defaultParty
+	if err := json.NewDecoder(body).Decode(&p); err != nil {
+		return nil, err
+	}
+	if p.Enabled && OwnerToken == "" {
+		return nil, fmt.Errorf("party mode requires an owner auth token")
+	}
+	if p.SkipVotes < 1 {
+		return nil, fmt.Errorf("skip votes must be at least 1")
+	}
+	srv.audit(ps, "party set", fmt.Sprintf("enabled: %v", p.Enabled))
+	srv.ch <- cmdSetParty(p)
+	return nil, nil
+}
+
+type cmdPartyAdd struct {
+	id  SongID
+	err chan error
+}
+
+type cmdPartySkip struct {
+	ip  string
+	err chan error
+}
+
+type cmdSetParty Party
@@ -0,0 +1,197 @@
+package server
+
+import (
+	"fmt"
+	"io"
+	"sync"
+	"time"
+)
+
+const (
+	// defaultPreroll is the audio decoded ahead of playback if Preroll isn't
+	// set.
+	defaultPreroll = time.Second * 2
+	// maxPreroll bounds Preroll.
+	maxPreroll = time.Second * 30
+	// prefetchChunk is the number of samples decoded at a time.
+	prefetchChunk = 4096
+	// prerollWait is how often playback checks whether the pre-roll is
+	// ready.
+	prerollWait = time.Millisecond * 20
+)
+
+// prefetch decodes a song ahead of playback in its own go routine, so slow
+// disk or network reads don't starve the output. Playback waits until size
+// samples are buffered, at the start and whenever the buffer runs dry.
+type prefetch struct {
+	play  func(int) ([]float32, error)
+	size  int
+	level *bufferLevel
+	// sample is the duration of one sample.
+	sample time.Duration
+
+	mu   sync.Mutex
+	cond *sync.Cond
+	buf  []float32
+	err  error
+	// done is set when play returned its last samples.
+	done      bool
+	closed    bool
+	buffering bool
+	// stopped is closed when run returns.
+	stopped chan struct{}
+}
+
+func newPrefetch(play func(int) ([]float32, error), size int, sample time.Duration, level *bufferLevel) *prefetch {
+	if size < prefetchChunk {
+		size = prefetchChunk
+	}
+	p := &prefetch{
+		play:      play,
+		size:      size,
+		level:     level,
+		sample:    sample,
+		buffering: true,
+		stopped:   make(chan struct{}),
+	}
+	p.cond = sync.NewCond(&p.mu)
+	level.set(0)
+	go p.run()
+	return p
+}
+
+func (p *prefetch) run() {
+	defer close(p.stopped)
+	for {
+		p.mu.Lock()
+		for !p.closed && len(p.buf) >= p.size {
+			p.cond.Wait()
+		}
+		if p.closed {
+			p.mu.Unlock()
+			return
+		}
+		p.mu.Unlock()
+		// Decoders may panic on garbage; end the song instead.
+		b, err := func() (b []float32, err error) {
+			defer func() {
+				if e := recover(); e != nil {
+					err = fmt.Errorf("decode: %v", e)
+				}
+			}()
+			return p.play(prefetchChunk)
+		}()
+		p.mu.Lock()
+		if p.closed {
+			p.mu.Unlock()
+			return
+		}
+		p.buf = append(p.buf, b...)
+		if err != nil || len(b) == 0 {
+			if err == nil {
+				err = io.EOF
+			}
+			p.err = err
+			p.done = true
+		}
+		if len(p.buf) >= p.size || p.done {
+			p.buffering = false
+		}
+		p.level.set(time.Duration(len(p.buf)) * p.sample)
+		p.cond.Broadcast()
+		done := p.done
+		p.mu.Unlock()
+		if done {
+			return
+		}
+	}
+}
+
+// ready reports whether samples can be read without waiting for the
+// pre-roll.
+func (p *prefetch) ready() bool {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	return !p.buffering
+}
+
+// Play returns up to n decoded samples, waiting for some if none are
+// buffered and decoding wasn't stopped. The song's error, or io.EOF, is
+// returned with its last samples.
+func (p *prefetch) Play(n int) ([]float32, error) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	for len(p.buf) < n && !p.done && !p.closed {
+		if len(p.buf) > 0 && !p.buffering {
+			break
+		}
+		p.cond.Wait()
+	}
+	if n > len(p.buf) {
+		n = len(p.buf)
+	}
+	b := p.buf[:n:n]
+	p.buf = p.buf[n:]
+	p.level.set(time.Duration(len(p.buf)) * p.sample)
+	if len(p.buf) == 0 && !p.done {
+		// Ran dry: wait for the pre-roll again.
+		p.buffering = true
+	}
+	p.cond.Broadcast()
+	if len(p.buf) == 0 && p.done {
+		return b, p.err
+	}
+	return b, nil
+}
+
+// seek seeks the song with f to d, once decoding stopped, and decodes from
+// there, discarding the audio decoded ahead.
+func (p *prefetch) seek(f func(time.Duration) (time.Duration, error), d time.Duration) (time.Duration, error) {
+	p.close()
+	<-p.stopped
+	at, err := f(d)
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	p.buf = nil
+	p.level.set(0)
+	if err != nil {
+		// The song's position is unknown, so it ends.
+		p.err = err
+		p.done = true
+		return 0, err
+	}
+	p.err = nil
+	p.done = false
+	p.closed = false
+	p.buffering = true
+	p.stopped = make(chan struct{})
+	go p.run()
+	return at, nil
+}
+
+// close stops decoding.
+func (p *prefetch) close() {
+	p.mu.Lock()
+	p.closed = true
+	p.cond.Broadcast()
+	p.mu.Unlock()
+}
+
+// bufferLevel is the amount of audio decoded ahead of playback, set by the
+// audio go routine and read for status.
+type bufferLevel struct {
+	mu sync.Mutex
+	d  time.Duration
+}
+
+func (b *bufferLevel) set(d time.Duration) {
+	b.mu.Lock()
+	b.d = d
+	b.mu.Unlock()
+}
+
+func (b *bufferLevel) get() time.Duration {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	return b.d
+}
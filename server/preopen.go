@@ -0,0 +1,77 @@
+package server
+
+import (
+	"context"
+	"log"
+	"time"
+
+	"github.com/mjibson/moggio/codec"
+	"github.com/mjibson/moggio/protocol"
+)
+
+// preopenAhead is the audio of the next song decoded before its turn, unless
+// the pre-roll is longer.
+const preopenAhead = time.Second * 5
+
+// preopened is the next song, opened and its start decoded before its turn so
+// it starts without waiting on its source.
+type preopened struct {
+	id     SongID
+	song   codec.Song
+	sr, ch int
+	pf     *prefetch
+	level  bufferLevel
+	// cancel cancels the song's reads.
+	cancel context.CancelFunc
+}
+
+// preopen opens id, a song of inst, in the background and decodes its first
+// seconds, sending the song to commands once it's open.
+func (srv *Server) preopen(inst protocol.Instance, id SongID, ahead time.Duration) {
+	ctx, cancel := context.WithCancel(srv.ctx)
+	song, err := protocol.GetSong(ctx, inst, id.ID())
+	if err != nil {
+		cancel()
+		log.Printf("preopen %v: %v", id, err)
+		return
+	}
+	sr, ch, err := song.Init()
+	if err != nil {
+		song.Close()
+		cancel()
+		log.Printf("preopen %v: %v", id, err)
+		return
+	}
+	if ahead < preopenAhead {
+		ahead = preopenAhead
+	}
+	p := &preopened{
+		id:     id,
+		song:   song,
+		sr:     sr,
+		ch:     ch,
+		cancel: cancel,
+	}
+	size := int(int64(ahead) * int64(sr) / int64(time.Second) * int64(ch))
+	p.pf = newPrefetch(song.Play, size, time.Second/time.Duration(sr*ch), &p.level)
+	if srv.ctx.Err() != nil {
+		p.close()
+		return
+	}
+	srv.ch <- cmdPreopened{p}
+}
+
+// close stops decoding the song and closes it.
+func (p *preopened) close() {
+	p.pf.close()
+	p.cancel()
+	go func() {
+		<-p.pf.stopped
+		p.song.Close()
+	}()
+}
+
+// cmdPreopened is a song opened before its turn.
+type cmdPreopened struct {
+	*preopened
+}
@@ -0,0 +1,160 @@
+package server
+
+import (
+	"encoding/binary"
+	"fmt"
+	"io"
+	"net/http"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/julienschmidt/httprouter"
+	"github.com/mjibson/moggio/codec"
+	"github.com/mjibson/moggio/dsp"
+)
+
+const (
+	// defaultPreviewLength and maxPreviewLength are the default and
+	// longest lengths of previews.
+	defaultPreviewLength = 3 * time.Second
+	maxPreviewLength     = 10 * time.Second
+	// previewFade is the length of the fades in and out of previews, so
+	// they don't click.
+	previewFade = 10 * time.Millisecond
+)
+
+// parsePreviewTime parses a time of a preview, a duration like 1m30s or a
+// number of seconds.
+func parsePreviewTime(s string) (time.Duration, error) {
+	if f, err := strconv.ParseFloat(s, 64); err == nil {
+		return time.Duration(f * float64(time.Second)), nil
+	}
+	return time.ParseDuration(s)
+}
+
+// Preview serves a short snippet of a song as 16-bit WAV, decoded from the
+// at parameter on for the length parameter, so clients can scrub through
+// songs or preview them at a cursor. The song is opened separately from
+// the one playing, which isn't disturbed. Songs that can't seek are decoded
+// from their start.
+func (srv *Server) Preview(w http.ResponseWriter, r *http.Request, ps httprouter.Params) {
+	id := SongID(strings.TrimPrefix(ps.ByName("id"), "/"))
+	var at time.Duration
+	if s := r.FormValue("at"); s != "" {
+		var err error
+		at, err = parsePreviewTime(s)
+		if err != nil || at < 0 {
+			http.Error(w, fmt.Sprintf("bad at: %v", s), http.StatusBadRequest)
+			return
+		}
+	}
+	length := defaultPreviewLength
+	if s := r.FormValue("length"); s != "" {
+		var err error
+		length, err = parsePreviewTime(s)
+		if err != nil || length <= 0 {
+			http.Error(w, fmt.Sprintf("bad length: %v", s), http.StatusBadRequest)
+			return
+		}
+		if length > maxPreviewLength {
+			length = maxPreviewLength
+		}
+	}
+	ch := make(chan songResult)
+	srv.ch <- cmdGetSong{
+		id:   id,
+		ctx:  r.Context(),
+		done: ch,
+	}
+	res := <-ch
+	if res.err != nil {
+		serveError(w, res.err)
+		return
+	}
+	song := res.song
+	defer song.Close()
+	samples, sr, channels, err := preview(song, at, length)
+	if err != nil {
+		serveError(w, fmt.Errorf("preview %s: %v", id, err))
+		return
+	}
+	size := int64(len(samples)) * 2
+	w.Header().Set("Content-Type", "audio/wav")
+	w.Header().Set("Content-Length", strconv.FormatInt(44+size, 10))
+	if r.Method == "HEAD" {
+		return
+	}
+	buf := wavHeader(sr, channels, size)
+	var dither dsp.Dither
+	for _, s := range samples {
+		buf = append(buf, 0, 0)
+		binary.LittleEndian.PutUint16(buf[len(buf)-2:], uint16(dither.Int16(s)))
+	}
+	w.Write(buf)
+}
+
+// preview decodes length of song from at, faded in and out. It is empty if
+// at is past the end of song.
+func preview(song codec.Song, at, length time.Duration) (samples []float32, sr, channels int, err error) {
+	// Decoders may panic on garbage.
+	defer func() {
+		if e := recover(); e != nil {
+			err = fmt.Errorf("decode: %v", e)
+		}
+	}()
+	sr, channels, err = song.Init()
+	if err != nil {
+		return nil, 0, 0, err
+	}
+	if sr <= 0 || channels <= 0 {
+		return nil, 0, 0, fmt.Errorf("bad format: %d Hz, %d channels", sr, channels)
+	}
+	frames := func(d time.Duration) int {
+		return int(d.Seconds() * float64(sr))
+	}
+	// skip is the number of samples decoded before at, which seeking may
+	// land before.
+	skip := frames(at) * channels
+	if s, ok := song.(codec.Seeker); ok && at > 0 {
+		pos, err := s.Seek(at)
+		if err != nil {
+			return nil, 0, 0, err
+		}
+		skip = frames(at-pos) * channels
+	}
+	n := frames(length) * channels
+	chunk := sr * channels / 10
+	for len(samples) < n {
+		s, err := song.Play(chunk)
+		if len(s) > skip {
+			samples = append(samples, s[skip:]...)
+			skip = 0
+		} else {
+			skip -= len(s)
+		}
+		if err != nil && err != io.EOF {
+			return nil, 0, 0, err
+		}
+		if err == io.EOF || len(s) == 0 {
+			break
+		}
+	}
+	if len(samples) > n {
+		samples = samples[:n]
+	}
+	samples = samples[:len(samples)/channels*channels]
+	fade := frames(previewFade)
+	total := len(samples) / channels
+	if fade > total/2 {
+		fade = total / 2
+	}
+	for i := 0; i < fade; i++ {
+		g := float32(i) / float32(fade)
+		for c := 0; c < channels; c++ {
+			samples[i*channels+c] *= g
+			samples[(total-1-i)*channels+c] *= g
+		}
+	}
+	return samples, sr, channels, nil
+}
@@ -0,0 +1,50 @@
+package server
+
+import (
+	"context"
+	"time"
+
+	"github.com/mjibson/moggio/protocol"
+)
+
+const (
+	// probeAhead is how long before the current song ends the next one is
+	// probed, late enough that the temporary URLs resolved for it are still
+	// fresh when it plays.
+	probeAhead = time.Second * 30
+	// probeTimeout bounds how long a probe may take.
+	probeTimeout = time.Second * 15
+)
+
+// nextSong returns the song that plays after the current one, if it's known:
+// the song after a random one isn't. It should only be called by the
+// commands() function.
+func (srv *Server) nextSong() (SongID, bool) {
+	if srv.Random || srv.loop != nil {
+		return "", false
+	}
+	i := srv.PlaylistIndex + 1
+	if i >= len(srv.Queue) {
+		if !srv.Repeat || len(srv.Queue) == 0 {
+			return "", false
+		}
+		i = 0
+	}
+	return srv.Queue[i], true
+}
+
+// probe checks in the background that id, a song of p, can still be read,
+// sending cmdProbeFailed if it can't.
+func (srv *Server) probe(p protocol.Prober, id SongID) {
+	ctx, cancel := context.WithTimeout(srv.ctx, probeTimeout)
+	defer cancel()
+	if err := p.Probe(ctx, id.ID()); err != nil && srv.ctx.Err() == nil {
+		srv.ch <- cmdProbeFailed{id, err}
+	}
+}
+
+// cmdProbeFailed reports that a queued song couldn't be read when probed.
+type cmdProbeFailed struct {
+	id  SongID
+	err error
+}
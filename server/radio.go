@@ -0,0 +1,106 @@
+package server
+
+import (
+	"math"
+	"math/rand"
+	"strings"
+
+	"github.com/mjibson/moggio/codec"
+)
+
+const (
+	// historySize is the default number of played songs remembered.
+	historySize = 200
+	// radioRecent is the number of most recently played songs radio mode
+	// won't choose again.
+	radioRecent = 50
+	// radioSeeds is the number of most recently played songs radio mode
+	// chooses similar songs to.
+	radioSeeds = 5
+)
+
+// addHistory records id as played, also for the current listener. It should
+// only be called by the commands() function.
+func (srv *Server) addHistory(id SongID) {
+	srv.played(id)
+	size := srv.Cleanup.historySize()
+	srv.History = appendHistory(srv.History, id, size)
+	if u := srv.Users[srv.listener]; u != nil {
+		u.History = appendHistory(u.History, id, size)
+	}
+}
+
+func appendHistory(h []SongID, id SongID, size int) []SongID {
+	h = append(h, id)
+	if n := len(h) - size; n > 0 {
+		h = append(h[:0], h[n:]...)
+	}
+	return h
+}
+
+// radioNext chooses a song to play after the queue ends: one similar in
+// artist, genre, and tempo to the recently played songs, but not played
+// recently itself. It should only be called by the commands() function.
+func (srv *Server) radioNext() (SongID, bool) {
+	avoid := make(map[SongID]bool)
+	for _, id := range srv.Queue {
+		avoid[id] = true
+	}
+	recent := srv.History
+	if len(recent) > radioRecent {
+		recent = recent[len(recent)-radioRecent:]
+	}
+	for _, id := range recent {
+		avoid[id] = true
+	}
+	var seeds []*codec.SongInfo
+	for i := len(srv.History) - 1; i >= 0 && len(seeds) < radioSeeds; i-- {
+		if info, _ := srv.getSong(srv.History[i]); info != nil {
+			seeds = append(seeds, info)
+		}
+	}
+	var best SongID
+	bestScore := math.Inf(-1)
+	for name, protos := range srv.Protocols {
+		for key, inst := range protos {
+			sl, _ := inst.List()
+			for id, info := range sl {
+				sid := SongID(codec.NewID(name, key, string(id)))
+				if avoid[sid] || srv.Hidden[sid] {
+					continue
+				}
+				info = srv.songInfo(sid, info)
+				if srv.Parental.blocks(info) {
+					continue
+				}
+				// Random jitter varies the choice among similar songs.
+				score := similarity(info, seeds) + rand.Float64()
+				if score > bestScore {
+					best, bestScore = sid, score
+				}
+			}
+		}
+	}
+	return best, best != ""
+}
+
+// similarity scores how alike info is to seeds, the most similar first.
+func similarity(info *codec.SongInfo, seeds []*codec.SongInfo) float64 {
+	var score float64
+	for i, s := range seeds {
+		// Earlier seeds count more.
+		weight := 1 / float64(i+1)
+		if info.Artist != "" && strings.EqualFold(info.Artist, s.Artist) {
+			score += 3 * weight
+		}
+		if info.Genre != "" && strings.EqualFold(info.Genre, s.Genre) {
+			score += 2 * weight
+		}
+		if info.BPM > 0 && s.BPM > 0 {
+			// Half and double time mix as well as the same tempo.
+			d := math.Min(math.Abs(info.BPM-s.BPM), math.Min(math.Abs(2*info.BPM-s.BPM), math.Abs(info.BPM-2*s.BPM)))
+			score += 2 * weight * math.Max(0, 1-d/15)
+		}
+	}
+	return score
+}
@@ -0,0 +1,291 @@
+package server
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/julienschmidt/httprouter"
+	"github.com/mjibson/moggio/codec"
+)
+
+// LastFMKey, if set, is the Last.fm API key used for recommendations.
+// Without it, ListenBrainz is used.
+var LastFMKey string
+
+const (
+	recommendLastFM       = "lastfm"
+	recommendListenBrainz = "listenbrainz"
+
+	// recommendLimit is the number of recommendations requested.
+	recommendLimit = 50
+	// recommendArtistSongs is the number of local songs returned for each
+	// recommended artist.
+	recommendArtistSongs = 5
+
+	lastFMURL       = "https://ws.audioscrobbler.com/2.0/"
+	musicBrainzURL  = "https://musicbrainz.org/ws/2/artist/"
+	listenBrainzURL = "https://labs.api.listenbrainz.org/similar-artists/json"
+	// listenBrainzAlgorithm is the similarity dataset ListenBrainz serves.
+	listenBrainzAlgorithm = "session_based_days_7500_session_300_contribution_5_threshold_10_limit_100_filter_True_skip_30"
+)
+
+// recommendation is a track, or if Title is empty an artist, similar to the
+// one requested.
+type recommendation struct {
+	Artist string
+	Title  string `json:",omitempty"`
+	// Match is the similarity, from 0 to 1.
+	Match float64
+	// Songs are the matching songs in the library.
+	Songs []listItem
+	// Search holds search URLs for other sources if there are no Songs.
+	Search map[string]string `json:",omitempty"`
+}
+
+// Recommendations returns tracks and artists similar to the artist and
+// title parameters, or the current song if not set, from Last.fm or
+// ListenBrainz as chosen by the source parameter.
+func (srv *Server) Recommendations(body io.Reader, form url.Values, ps httprouter.Params) (interface{}, error) {
+	artist, title := form.Get("artist"), form.Get("title")
+	if artist == "" {
+		ch := make(chan *waitData)
+		srv.ch <- cmdWaitData{
+			wt:   waitStatus,
+			done: ch,
+		}
+		info := (<-ch).Data.(*Status).SongInfo
+		artist, title = info.Artist, info.Title
+	}
+	if artist == "" {
+		return nil, fmt.Errorf("missing artist")
+	}
+	source := form.Get("source")
+	if source == "" {
+		source = recommendListenBrainz
+		if LastFMKey != "" {
+			source = recommendLastFM
+		}
+	}
+	var recs []recommendation
+	var err error
+	switch source {
+	case recommendLastFM:
+		if LastFMKey == "" {
+			return nil, fmt.Errorf("no Last.fm API key")
+		}
+		recs, err = lastFMSimilar(artist, title)
+	case recommendListenBrainz:
+		recs, err = listenBrainzSimilar(artist)
+	default:
+		return nil, fmt.Errorf("unknown source: %v", source)
+	}
+	if err != nil {
+		return nil, err
+	}
+	ch := make(chan []recommendation)
+	srv.ch <- cmdRecommend{
+		recs: recs,
+		done: ch,
+	}
+	return struct {
+		Source          string
+		Artist          string
+		Title           string
+		Recommendations []recommendation
+	}{
+		Source:          source,
+		Artist:          artist,
+		Title:           title,
+		Recommendations: <-ch,
+	}, nil
+}
+
+func getJSON(u string, dst interface{}) error {
+	req, err := http.NewRequest("GET", u, nil)
+	if err != nil {
+		return err
+	}
+	// MusicBrainz requires a meaningful user agent.
+	req.Header.Set("User-Agent", "moggio/"+MoggioVersion+" (https://github.com/mjibson/moggio)")
+	req.Header.Set("Accept", "application/json")
+	resp, err := http.DefaultClient.Do(req)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode != http.StatusOK {
+		return fmt.Errorf("%s: %s", req.URL.Host, resp.Status)
+	}
+	return json.NewDecoder(resp.Body).Decode(dst)
+}
+
+// lastFMFloat is a number Last.fm sends as either a JSON number or string.
+type lastFMFloat float64
+
+func (f *lastFMFloat) UnmarshalJSON(b []byte) error {
+	v, err := strconv.ParseFloat(strings.Trim(string(b), `"`), 64)
+	*f = lastFMFloat(v)
+	return err
+}
+
+func lastFMSimilar(artist, title string) ([]recommendation, error) {
+	v := url.Values{
+		"api_key": {LastFMKey},
+		"format":  {"json"},
+		"limit":   {strconv.Itoa(recommendLimit)},
+		"artist":  {artist},
+	}
+	var recs []recommendation
+	if title != "" {
+		v.Set("method", "track.getsimilar")
+		v.Set("track", title)
+		var res struct {
+			SimilarTracks struct {
+				Track []struct {
+					Name   string
+					Match  lastFMFloat
+					Artist struct {
+						Name string
+					}
+				}
+			}
+		}
+		if err := getJSON(lastFMURL+"?"+v.Encode(), &res); err != nil {
+			return nil, err
+		}
+		for _, t := range res.SimilarTracks.Track {
+			recs = append(recs, recommendation{
+				Artist: t.Artist.Name,
+				Title:  t.Name,
+				Match:  float64(t.Match),
+			})
+		}
+		v.Del("track")
+	}
+	v.Set("method", "artist.getsimilar")
+	var res struct {
+		SimilarArtists struct {
+			Artist []struct {
+				Name  string
+				Match lastFMFloat
+			}
+		}
+	}
+	if err := getJSON(lastFMURL+"?"+v.Encode(), &res); err != nil {
+		return nil, err
+	}
+	for _, a := range res.SimilarArtists.Artist {
+		recs = append(recs, recommendation{
+			Artist: a.Name,
+			Match:  float64(a.Match),
+		})
+	}
+	return recs, nil
+}
+
+func listenBrainzSimilar(artist string) ([]recommendation, error) {
+	var mb struct {
+		Artists []struct {
+			ID string
+		}
+	}
+	q := url.Values{
+		"query": {`artist:"` + artist + `"`},
+		"fmt":   {"json"},
+		"limit": {"1"},
+	}
+	if err := getJSON(musicBrainzURL+"?"+q.Encode(), &mb); err != nil {
+		return nil, err
+	}
+	if len(mb.Artists) == 0 {
+		return nil, fmt.Errorf("unknown artist: %v", artist)
+	}
+	var similar []struct {
+		Name  string
+		Score float64
+	}
+	q = url.Values{
+		"algorithm":    {listenBrainzAlgorithm},
+		"artist_mbids": {mb.Artists[0].ID},
+	}
+	if err := getJSON(listenBrainzURL+"?"+q.Encode(), &similar); err != nil {
+		return nil, err
+	}
+	var max float64
+	for _, s := range similar {
+		if s.Score > max {
+			max = s.Score
+		}
+	}
+	var recs []recommendation
+	for _, s := range similar {
+		if len(recs) == recommendLimit {
+			break
+		}
+		recs = append(recs, recommendation{
+			Artist: s.Name,
+			Match:  s.Score / max,
+		})
+	}
+	return recs, nil
+}
+
+// recommend finds the songs in the library for recs. It should only be
+// called by the commands() function.
+func (srv *Server) recommend(recs []recommendation) []recommendation {
+	artists := make(map[string][]listItem)
+	for name, protos := range srv.Protocols {
+		for key, inst := range protos {
+			sl, _ := inst.List()
+			for id, info := range sl {
+				sid := SongID(codec.NewID(name, key, string(id)))
+				if srv.Hidden[sid] {
+					continue
+				}
+				a := strings.ToLower(info.Artist)
+				artists[a] = append(artists[a], listItem{
+					ID:   sid,
+					Info: info,
+				})
+			}
+		}
+	}
+	for i := range recs {
+		r := &recs[i]
+		songs := artists[strings.ToLower(r.Artist)]
+		if r.Title == "" {
+			sort.Slice(songs, func(i, j int) bool {
+				return songs[i].Info.Title < songs[j].Info.Title
+			})
+			if len(songs) > recommendArtistSongs {
+				songs = songs[:recommendArtistSongs]
+			}
+			r.Songs = songs
+		} else {
+			for _, s := range songs {
+				if strings.EqualFold(s.Info.Title, r.Title) {
+					r.Songs = append(r.Songs, s)
+				}
+			}
+		}
+		if len(r.Songs) == 0 {
+			q := strings.TrimSpace(r.Artist + " " + r.Title)
+			r.Search = map[string]string{
+				"soundcloud": "https://soundcloud.com/search?q=" + url.QueryEscape(q),
+				"youtube":    "https://www.youtube.com/results?search_query=" + url.QueryEscape(q),
+			}
+		}
+	}
+	return recs
+}
+
+type cmdRecommend struct {
+	recs []recommendation
+	done chan []recommendation
+}
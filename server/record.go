@@ -0,0 +1,749 @@
+package server
+
+import (
+	"bufio"
+	"bytes"
+	"context"
+	"encoding/binary"
+	"encoding/json"
+	"fmt"
+	"io"
+	"log"
+	"net/url"
+	"os"
+	"path/filepath"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/julienschmidt/httprouter"
+	"github.com/mjibson/moggio/protocol"
+)
+
+// Recordings holds the settings of recording internet radio streams.
+type Recordings struct {
+	// Dir is the directory recordings are written to, in a folder of each
+	// stream. Recording is disabled if empty.
+	Dir string
+	// Schedule are the recordings started at times of day.
+	Schedule []ScheduledRecording `json:",omitempty"`
+}
+
+// ScheduledRecording records a stream at a time of day, like a weekly show.
+type ScheduledRecording struct {
+	Name string
+	// ID is the stream recorded.
+	ID SongID
+	// Start is the local time of day recording starts, like "20:00".
+	Start string
+	// Days are the days of the week recorded, like "Mon", or every day if
+	// empty.
+	Days []string `json:",omitempty"`
+	// Duration is how long it records.
+	Duration time.Duration
+}
+
+const (
+	// recordCheck is how often scheduled recordings are checked for being
+	// due.
+	recordCheck = time.Minute
+	// recordRecent is the number of finished recordings kept.
+	recordRecent = 20
+	// recordStartLayout is the layout of the starts of scheduled
+	// recordings.
+	recordStartLayout = "15:04"
+)
+
+// RecordStatus is a stream's recording, running or finished.
+type RecordStatus struct {
+	ID SongID
+	// Station is the name of the stream.
+	Station string
+	// Schedule is the name of the scheduled recording, if it is one.
+	Schedule string    `json:",omitempty"`
+	Started  time.Time `json:",omitempty"`
+	Finished time.Time `json:",omitempty"`
+	// Until is when the recording stops, if it doesn't run until stopped.
+	Until time.Time `json:",omitempty"`
+	// Title is the song being recorded.
+	Title string `json:",omitempty"`
+	Bytes int64
+	// Files are the songs recorded.
+	Files []string `json:",omitempty"`
+	Error string   `json:",omitempty"`
+}
+
+// records holds the recordings running, by stream, and those recently
+// finished.
+type records struct {
+	sync.Mutex
+	active map[SongID]*recordRun
+	recent []RecordStatus
+	// scheduled are the starts of the scheduled recordings last run, by
+	// name.
+	scheduled map[string]time.Time
+}
+
+type recordRun struct {
+	status RecordStatus
+	cancel context.CancelFunc
+}
+
+// start marks the stream of st recording, unless it already is.
+func (r *records) start(st RecordStatus, cancel context.CancelFunc) bool {
+	r.Lock()
+	defer r.Unlock()
+	if r.active == nil {
+		r.active = make(map[SongID]*recordRun)
+	}
+	if r.active[st.ID] != nil {
+		return false
+	}
+	r.active[st.ID] = &recordRun{st, cancel}
+	return true
+}
+
+func (r *records) update(id SongID, f func(st *RecordStatus)) {
+	r.Lock()
+	defer r.Unlock()
+	if run := r.active[id]; run != nil {
+		f(&run.status)
+	}
+}
+
+func (r *records) finish(id SongID, err error) {
+	r.Lock()
+	defer r.Unlock()
+	run := r.active[id]
+	if run == nil {
+		return
+	}
+	delete(r.active, id)
+	st := run.status
+	st.Finished = time.Now()
+	st.Title = ""
+	if err != nil {
+		st.Error = err.Error()
+	}
+	r.recent = append(r.recent, st)
+	if len(r.recent) > recordRecent {
+		r.recent = r.recent[len(r.recent)-recordRecent:]
+	}
+}
+
+// stop stops recording id, reporting whether it was.
+func (r *records) stop(id SongID) bool {
+	r.Lock()
+	defer r.Unlock()
+	run := r.active[id]
+	if run != nil {
+		run.cancel()
+	}
+	return run != nil
+}
+
+// get returns the running recordings and those recently finished, most
+// recent first.
+func (r *records) get() (active, recent []RecordStatus) {
+	r.Lock()
+	defer r.Unlock()
+	active = []RecordStatus{}
+	for _, run := range r.active {
+		st := run.status
+		st.Files = append([]string(nil), st.Files...)
+		active = append(active, st)
+	}
+	recent = []RecordStatus{}
+	for i := len(r.recent) - 1; i >= 0; i-- {
+		recent = append(recent, r.recent[i])
+	}
+	return active, recent
+}
+
+// due reports whether the scheduled recording name starting at start
+// hasn't run, and marks it run.
+func (r *records) due(name string, start time.Time) bool {
+	r.Lock()
+	defer r.Unlock()
+	if r.scheduled == nil {
+		r.scheduled = make(map[string]time.Time)
+	}
+	if !r.scheduled[name].Before(start) {
+		return false
+	}
+	r.scheduled[name] = start
+	return true
+}
+
+// start returns the start of s on the day of now, and whether it
+// records on that day.
+func (s ScheduledRecording) start(now time.Time) (time.Time, bool) {
+	t, err := time.Parse(recordStartLayout, s.Start)
+	if err != nil {
+		return time.Time{}, false
+	}
+	start := time.Date(now.Year(), now.Month(), now.Day(), t.Hour(), t.Minute(), 0, 0, now.Location())
+	if len(s.Days) == 0 {
+		return start, true
+	}
+	day := now.Weekday().String()[:3]
+	for _, d := range s.Days {
+		if strings.EqualFold(d, day) {
+			return start, true
+		}
+	}
+	return start, false
+}
+
+// checkRecordings validates r, making its directory absolute.
+func checkRecordings(r *Recordings) error {
+	if r.Dir != "" {
+		abs, err := filepath.Abs(r.Dir)
+		if err != nil {
+			return err
+		}
+		fi, err := os.Stat(abs)
+		if err != nil {
+			return err
+		}
+		if !fi.IsDir() {
+			return fmt.Errorf("not a directory: %s", abs)
+		}
+		r.Dir = abs
+	}
+	names := make(map[string]bool)
+	for i, s := range r.Schedule {
+		if s.Name == "" {
+			return fmt.Errorf("scheduled recording %d has no name", i+1)
+		}
+		if names[s.Name] {
+			return fmt.Errorf("duplicate scheduled recording: %s", s.Name)
+		}
+		names[s.Name] = true
+		if _, err := time.Parse(recordStartLayout, s.Start); err != nil {
+			return fmt.Errorf("%s: bad start %q, expected like 20:00", s.Name, s.Start)
+		}
+		if s.Duration <= 0 || s.Duration > time.Hour*24 {
+			return fmt.Errorf("%s: duration must be between 0 and 24h", s.Name)
+		}
+		for _, d := range s.Days {
+			ok := false
+			for w := time.Sunday; w <= time.Saturday; w++ {
+				if strings.EqualFold(d, w.String()[:3]) {
+					ok = true
+				}
+			}
+			if !ok {
+				return fmt.Errorf("%s: unknown day: %s", s.Name, d)
+			}
+		}
+	}
+	if len(r.Schedule) > 0 && r.Dir == "" {
+		return fmt.Errorf("scheduled recordings need a directory")
+	}
+	return nil
+}
+
+// recordSource is a stream to record.
+type recordSource struct {
+	id      SongID
+	station string
+	rec     protocol.Recorder
+	dir     string
+	err     error
+}
+
+func (srv *Server) recordSource(id SongID) recordSource {
+	ch := make(chan recordSource)
+	srv.ch <- cmdRecordSource{
+		id: id,
+		ch: ch,
+	}
+	return <-ch
+}
+
+// watchRecordings starts scheduled recordings when they're due, or late, if
+// the server started during them.
+func (srv *Server) watchRecordings() {
+	for range time.Tick(recordCheck) {
+		ch := make(chan Recordings)
+		srv.ch <- cmdGetRecordings(ch)
+		now := time.Now()
+		for _, s := range (<-ch).Schedule {
+			start, ok := s.start(now)
+			end := start.Add(s.Duration)
+			if !ok || now.Before(start) || !now.Before(end) || !srv.records.due(s.Name, start) {
+				continue
+			}
+			src := srv.recordSource(s.ID)
+			if src.err != nil {
+				log.Printf("record %s: %v", s.Name, src.err)
+				continue
+			}
+			log.Printf("record %s: %v until %v", s.Name, s.ID, end.Format(recordStartLayout))
+			if err := srv.startRecording(src, s.Name, end); err != nil {
+				log.Printf("record %s: %v", s.Name, err)
+			}
+		}
+	}
+}
+
+// startRecording starts recording src until until, or until stopped if
+// zero.
+func (srv *Server) startRecording(src recordSource, schedule string, until time.Time) error {
+	ctx, cancel := context.WithCancel(srv.ctx)
+	if !until.IsZero() {
+		ctx, cancel = context.WithDeadline(srv.ctx, until)
+	}
+	st := RecordStatus{
+		ID:       src.id,
+		Station:  src.station,
+		Schedule: schedule,
+		Started:  time.Now(),
+		Until:    until,
+	}
+	if !srv.records.start(st, cancel) {
+		cancel()
+		return fmt.Errorf("already recording: %s", src.station)
+	}
+	go func() {
+		defer cancel()
+		err := srv.record(ctx, src)
+		if ctx.Err() != nil {
+			// Stopped, not failed.
+			err = nil
+		}
+		if err != nil {
+			log.Printf("record %v: %v", src.id, err)
+		}
+		srv.records.finish(src.id, err)
+	}()
+	return nil
+}
+
+// record records src into its own folder of src.dir until ctx is done,
+// splitting it into a file of each song the stream names.
+func (srv *Server) record(ctx context.Context, src recordSource) error {
+	r, err := src.rec.Record(ctx, src.id.ID())
+	if err != nil {
+		return err
+	}
+	defer r.Close()
+	dir := filepath.Join(src.dir, cleanPathPart(src.station))
+	if err := os.MkdirAll(dir, 0755); err != nil {
+		return err
+	}
+	w := &recordWriter{
+		dir:     dir,
+		station: src.station,
+		format:  r.Format(),
+		done: func(name string) {
+			srv.records.update(src.id, func(st *RecordStatus) {
+				st.Files = append(st.Files, name)
+			})
+		},
+	}
+	defer w.close()
+	if w.format == "ogg" {
+		// Ogg streams chain a logical stream of each song, tagged in its
+		// headers.
+		br := bufio.NewReader(r)
+		for {
+			page, bos, err := readOggPage(br)
+			if err != nil {
+				return err
+			}
+			if bos {
+				if err := w.split(vorbisTitle(page)); err != nil {
+					return err
+				}
+			} else if w.f != nil && w.title == "" && w.n < 64*1024 {
+				// The comment header follows the first page.
+				w.title = vorbisTitle(page)
+			}
+			if err := w.write(page); err != nil {
+				return err
+			}
+			srv.records.update(src.id, func(st *RecordStatus) {
+				st.Title = w.title
+				st.Bytes += int64(len(page))
+			})
+		}
+	}
+	buf := make([]byte, 16*1024)
+	named := false
+	for {
+		n, rerr := r.Read(buf)
+		b := buf[:n]
+		if w.pending {
+			// Split at the next frame, so each file starts with one.
+			if i := mpegSync(b); i >= 0 {
+				if err := w.write(b[:i]); err != nil {
+					return err
+				}
+				if err := w.split(w.next); err != nil {
+					return err
+				}
+				w.pending = false
+				b = b[i:]
+			}
+		}
+		if err := w.write(b); err != nil {
+			return err
+		}
+		// Titles change after the audio before them is read.
+		if t := r.Title(); !named && t != "" {
+			named = true
+			w.title = t
+		} else if named && t != w.title && (!w.pending || t != w.next) {
+			w.pending = true
+			w.next = t
+		}
+		srv.records.update(src.id, func(st *RecordStatus) {
+			st.Title = w.title
+			st.Bytes += int64(n)
+		})
+		if rerr != nil {
+			return rerr
+		}
+	}
+}
+
+// recordWriter writes the songs of a recording to files of dir, named
+// and tagged when they end.
+type recordWriter struct {
+	dir, station, format string
+	// f is the temporary file of the song being recorded, title its
+	// title, and n its length.
+	f     *os.File
+	title string
+	n     int64
+	start time.Time
+	// pending is set when the stream names the next song, next, which is
+	// split from the current one at the next frame.
+	pending bool
+	next    string
+	// done is called with the name of each file written.
+	done func(name string)
+}
+
+func (w *recordWriter) write(b []byte) error {
+	if len(b) == 0 {
+		return nil
+	}
+	if w.f == nil {
+		f, err := os.Create(filepath.Join(w.dir, fmt.Sprintf(".recording-%d.%s", time.Now().UnixNano(), w.format)))
+		if err != nil {
+			return err
+		}
+		w.f = f
+		w.n = 0
+		w.start = time.Now()
+	}
+	n, err := w.f.Write(b)
+	w.n += int64(n)
+	return err
+}
+
+// split ends the current song, starting the next, named title.
+func (w *recordWriter) split(title string) error {
+	err := w.close()
+	w.title = title
+	return err
+}
+
+// close names and tags the file of the current song.
+func (w *recordWriter) close() error {
+	f := w.f
+	if f == nil {
+		return nil
+	}
+	w.f = nil
+	tmp := f.Name()
+	defer os.Remove(tmp)
+	if w.n == 0 {
+		f.Close()
+		return nil
+	}
+	artist, title := splitStreamTitle(w.title)
+	if title == "" {
+		title = w.station + " " + w.start.Format("2006-01-02 15.04.05")
+	}
+	base := title
+	if artist != "" {
+		base = artist + " - " + title
+	}
+	base = filepath.Join(w.dir, cleanPathPart(base))
+	name := base + "." + w.format
+	for i := 2; ; i++ {
+		if _, err := os.Stat(name); os.IsNotExist(err) {
+			break
+		}
+		name = fmt.Sprintf("%s (%d).%s", base, i, w.format)
+	}
+	var err error
+	if w.format == "mp3" {
+		err = writeTagged(f, name, id3v2(map[string]string{
+			"TIT2": title,
+			"TPE1": artist,
+			"TALB": w.station,
+			"TDRC": w.start.Format("2006-01-02"),
+		}))
+	} else {
+		err = f.Close()
+		if err == nil {
+			err = os.Rename(tmp, name)
+		}
+	}
+	if err != nil {
+		return err
+	}
+	w.done(name)
+	return nil
+}
+
+// writeTagged writes tag and then the contents of f to a new file, name,
+// closing f.
+func writeTagged(f *os.File, name string, tag []byte) error {
+	defer f.Close()
+	if _, err := f.Seek(0, io.SeekStart); err != nil {
+		return err
+	}
+	out, err := os.OpenFile(name, os.O_WRONLY|os.O_CREATE|os.O_EXCL, 0644)
+	if err != nil {
+		return err
+	}
+	_, err = out.Write(tag)
+	if err == nil {
+		_, err = io.Copy(out, f)
+	}
+	if cerr := out.Close(); err == nil {
+		err = cerr
+	}
+	if err != nil {
+		os.Remove(name)
+	}
+	return err
+}
+
+// splitStreamTitle returns the artist and title of a stream title, which
+// are conventionally like "Artist - Title".
+func splitStreamTitle(s string) (artist, title string) {
+	s = strings.TrimSpace(s)
+	if i := strings.Index(s, " - "); i > 0 {
+		return strings.TrimSpace(s[:i]), strings.TrimSpace(s[i+3:])
+	}
+	return "", s
+}
+
+// id3v2 returns an ID3v2.4 tag of the text frames of frames, in UTF-8.
+func id3v2(frames map[string]string) []byte {
+	var body bytes.Buffer
+	for _, id := range []string{"TIT2", "TPE1", "TALB", "TDRC"} {
+		v := frames[id]
+		if v == "" {
+			continue
+		}
+		body.WriteString(id)
+		body.Write(synchsafe(len(v) + 1))
+		// Flags, then the UTF-8 encoding.
+		body.Write([]byte{0, 0, 3})
+		body.WriteString(v)
+	}
+	tag := append([]byte{'I', 'D', '3', 4, 0, 0}, synchsafe(body.Len())...)
+	return append(tag, body.Bytes()...)
+}
+
+// synchsafe returns n as an ID3v2 synchsafe integer, of 7 bits a byte.
+func synchsafe(n int) []byte {
+	b := make([]byte, 4)
+	binary.BigEndian.PutUint32(b, uint32(n&0x7f|n<<1&0x7f00|n<<2&0x7f0000|n<<3&0x7f000000))
+	return b
+}
+
+// mpegSync returns the index of the first MPEG audio frame header of b, or
+// -1 if it has none.
+func mpegSync(b []byte) int {
+	for i := 0; i+2 < len(b); i++ {
+		if b[i] != 0xff || b[i+1]&0xe0 != 0xe0 {
+			continue
+		}
+		version := b[i+1] >> 3 & 3
+		layer := b[i+1] >> 1 & 3
+		bitrate := b[i+2] >> 4
+		rate := b[i+2] >> 2 & 3
+		if version != 1 && layer != 0 && bitrate != 0 && bitrate != 15 && rate != 3 {
+			return i
+		}
+	}
+	return -1
+}
+
+// readOggPage reads the next page of r, reporting whether it begins a
+// logical stream.
+func readOggPage(r *bufio.Reader) (page []byte, bos bool, err error) {
+	h := make([]byte, 27)
+	if _, err := io.ReadFull(r, h); err != nil {
+		return nil, false, err
+	}
+	if string(h[:4]) != "OggS" {
+		return nil, false, fmt.Errorf("ogg: bad page")
+	}
+	segs := int(h[26])
+	page = make([]byte, 27+segs, 27+segs+255*segs)
+	copy(page, h)
+	if _, err := io.ReadFull(r, page[27:]); err != nil {
+		return nil, false, err
+	}
+	n := 0
+	for _, s := range page[27:] {
+		n += int(s)
+	}
+	page = page[:27+segs+n]
+	if _, err := io.ReadFull(r, page[27+segs:]); err != nil {
+		return nil, false, err
+	}
+	return page, h[5]&2 != 0, nil
+}
+
+// vorbisTitle returns the song of the Vorbis comment header in page, like
+// "Artist - Title", or "" if it has none.
+func vorbisTitle(page []byte) string {
+	i := bytes.Index(page, []byte("\x03vorbis"))
+	if i < 0 {
+		return ""
+	}
+	b := page[i+7:]
+	next := func() ([]byte, bool) {
+		if len(b) < 4 {
+			return nil, false
+		}
+		n := binary.LittleEndian.Uint32(b)
+		if uint32(len(b)-4) < n {
+			return nil, false
+		}
+		v := b[4 : 4+n]
+		b = b[4+n:]
+		return v, true
+	}
+	// The vendor, then the number of comments.
+	if _, ok := next(); !ok || len(b) < 4 {
+		return ""
+	}
+	count := binary.LittleEndian.Uint32(b)
+	b = b[4:]
+	var artist, title string
+	for ; count > 0; count-- {
+		c, ok := next()
+		if !ok {
+			break
+		}
+		kv := strings.SplitN(string(c), "=", 2)
+		if len(kv) != 2 {
+			continue
+		}
+		switch strings.ToUpper(kv[0]) {
+		case "ARTIST":
+			artist = kv[1]
+		case "TITLE":
+			title = kv[1]
+		}
+	}
+	if artist != "" && title != "" {
+		return artist + " - " + title
+	}
+	return title
+}
+
+// GetRecord returns the recording settings, and the recordings running and
+// recently finished.
+func (srv *Server) GetRecord(body io.Reader, form url.Values, ps httprouter.Params) (interface{}, error) {
+	ch := make(chan Recordings)
+	srv.ch <- cmdGetRecordings(ch)
+	active, recent := srv.records.get()
+	return struct {
+		Settings Recordings
+		Active   []RecordStatus
+		Recent   []RecordStatus
+	}{
+		Settings: <-ch,
+		Active:   active,
+		Recent:   recent,
+	}, nil
+}
+
+// SetRecord sets the recording settings.
+func (srv *Server) SetRecord(body io.Reader, form url.Values, ps httprouter.Params) (interface{}, error) {
+	var r Recordings
+	if err := json.NewDecoder(body).Decode(&r); err != nil {
+		return nil, err
+	}
+	if err := checkRecordings(&r); err != nil {
+		return nil, err
+	}
+	ch := make(chan error)
+	srv.ch <- cmdSetRecordings{
+		r:   r,
+		err: ch,
+	}
+	if err := <-ch; err != nil {
+		return nil, err
+	}
+	srv.audit(ps, "recordings", fmt.Sprintf("%d scheduled", len(r.Schedule)))
+	return nil, nil
+}
+
+// RecordStart starts recording the stream of the id parameter, or else the
+// one playing. It records for the duration parameter, like "1h", if set,
+// else until stopped.
+func (srv *Server) RecordStart(body io.Reader, form url.Values, ps httprouter.Params) (interface{}, error) {
+	var until time.Time
+	if d := form.Get("duration"); d != "" {
+		dur, err := time.ParseDuration(d)
+		if err != nil {
+			return nil, err
+		}
+		if dur <= 0 {
+			return nil, fmt.Errorf("duration must be positive")
+		}
+		until = time.Now().Add(dur)
+	}
+	src := srv.recordSource(SongID(form.Get("id")))
+	if src.err != nil {
+		return nil, src.err
+	}
+	if err := srv.startRecording(src, "", until); err != nil {
+		return nil, err
+	}
+	srv.audit(ps, "record start", string(src.id))
+	return nil, nil
+}
+
+// RecordStop stops recording the stream of the id parameter, or else all.
+func (srv *Server) RecordStop(body io.Reader, form url.Values, ps httprouter.Params) (interface{}, error) {
+	id := SongID(form.Get("id"))
+	if id == "" {
+		active, _ := srv.records.get()
+		for _, st := range active {
+			srv.records.stop(st.ID)
+		}
+	} else if !srv.records.stop(id) {
+		return nil, fmt.Errorf("not recording: %v", id)
+	}
+	srv.audit(ps, "record stop", string(id))
+	return nil, nil
+}
+
+type cmdGetRecordings chan Recordings
+
+type cmdSetRecordings struct {
+	r   Recordings
+	err chan error
+}
+
+// cmdRecordSource returns the stream of id to record, or of the song
+// playing if empty.
+type cmdRecordSource struct {
+	id SongID
+	ch chan recordSource
+}
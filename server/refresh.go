@@ -0,0 +1,98 @@
+package server
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"math/rand"
+	"net/url"
+	"os/exec"
+	"runtime"
+	"strings"
+	"time"
+
+	"github.com/julienschmidt/httprouter"
+)
+
+const (
+	// minRefreshInterval is the shortest automatic refresh interval.
+	minRefreshInterval = time.Minute
+	// refreshJitter is the fraction an automatic refresh interval is
+	// randomly varied by, so instances don't refresh in lockstep.
+	refreshJitter = 0.1
+)
+
+// jitter returns d varied randomly by refreshJitter.
+func jitter(d time.Duration) time.Duration {
+	return d + time.Duration((rand.Float64()*2-1)*refreshJitter*float64(d))
+}
+
+// metered reports whether the network connection is metered, as known by
+// NetworkManager.
+func metered() bool {
+	if runtime.GOOS != "linux" {
+		return false
+	}
+	out, err := exec.Command("busctl", "get-property",
+		"org.freedesktop.NetworkManager",
+		"/org/freedesktop/NetworkManager",
+		"org.freedesktop.NetworkManager",
+		"Metered",
+	).Output()
+	if err != nil {
+		return false
+	}
+	// NM_METERED_YES or NM_METERED_GUESS_YES.
+	switch strings.TrimSpace(string(out)) {
+	case "u 1", "u 3":
+		return true
+	}
+	return false
+}
+
+// ProtocolSettings sets the automatic refresh interval of a protocol
+// instance, 0 to disable, and whether automatic refreshes of remote
+// protocols pause while playing over a metered connection.
+func (srv *Server) ProtocolSettings(body io.Reader, form url.Values, ps httprouter.Params) (interface{}, error) {
+	var s struct {
+		ProtocolData
+		// Refresh is the refresh interval, like "10m" or "24h".
+		Refresh             *string
+		PauseMeteredRefresh *bool
+	}
+	if err := json.NewDecoder(body).Decode(&s); err != nil {
+		return nil, err
+	}
+	if s.Refresh != nil {
+		d, err := time.ParseDuration(*s.Refresh)
+		if err != nil {
+			return nil, err
+		}
+		if d != 0 && d < minRefreshInterval {
+			return nil, fmt.Errorf("refresh interval must be at least %v", minRefreshInterval)
+		}
+		ch := make(chan error)
+		srv.ch <- cmdSetRefresh{
+			protocol: s.Protocol,
+			key:      s.Key,
+			interval: d,
+			err:      ch,
+		}
+		if err := <-ch; err != nil {
+			return nil, err
+		}
+	}
+	if s.PauseMeteredRefresh != nil {
+		srv.ch <- cmdPauseMeteredRefresh(*s.PauseMeteredRefresh)
+	}
+	return nil, nil
+}
+
+type cmdSetRefresh struct {
+	protocol string
+	key      string
+	interval time.Duration
+	err      chan error
+}
+
+type cmdPauseMeteredRefresh bool
@@ -0,0 +1,246 @@
+package server
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/url"
+	"path/filepath"
+	"sort"
+
+	"github.com/julienschmidt/httprouter"
+	"github.com/mjibson/moggio/codec"
+)
+
+// Relocation is a song that vanished from its location, and the listed
+// songs that may be it at a new one, most likely first.
+type Relocation struct {
+	ID         SongID
+	Info       *codec.SongInfo
+	Candidates []listItem
+}
+
+// referenced returns the songs in the queue, playlists, and history. It
+// should only be called by the commands() function.
+func (srv *Server) referenced() map[SongID]bool {
+	refs := make(map[SongID]bool)
+	add := func(p []SongID) {
+		for _, id := range p {
+			refs[id] = true
+		}
+	}
+	add(srv.Queue)
+	add(srv.History)
+	for _, p := range srv.Playlists {
+		add(p)
+	}
+	for _, u := range srv.Users {
+		add(u.History)
+		for _, p := range u.Playlists {
+			add(p)
+		}
+	}
+	return refs
+}
+
+// removeVanished returns p without vanished songs, which can't be played.
+func (srv *Server) removeVanished(p Playlist) Playlist {
+	var r Playlist
+	for _, id := range p {
+		if srv.Vanished[id] != nil && !srv.hasSong(id) {
+			continue
+		}
+		r = append(r, id)
+	}
+	return r
+}
+
+// songName returns the file name of a song, if it has one.
+func songName(id SongID) string {
+	top, _ := id.ID().Pop()
+	return filepath.Base(string(top))
+}
+
+// relocations returns the vanished songs, and listed songs whose files the
+// health check found missing, with their candidate new locations: listed
+// songs with the same tags and duration or, if the tags of a song aren't
+// known, file name. It should only be called by the commands() function.
+func (srv *Server) relocations() []Relocation {
+	lost := make(map[SongID]*codec.SongInfo)
+	for id, info := range srv.Vanished {
+		if !srv.hasSong(id) {
+			lost[id] = info
+		}
+	}
+	for _, is := range srv.health.get().Issues {
+		if is.Problem == healthMissing && !srv.Hidden[is.ID] {
+			lost[is.ID] = is.Info
+		}
+	}
+	relocs := []Relocation{}
+	if len(lost) == 0 {
+		return relocs
+	}
+	byPrint := make(map[string][]listItem)
+	byName := make(map[string][]listItem)
+	for name, protos := range srv.Protocols {
+		for key, inst := range protos {
+			sl, _ := inst.List()
+			for id, info := range sl {
+				sid := SongID(codec.NewID(name, key, string(id)))
+				if _, ok := lost[sid]; ok || srv.Hidden[sid] {
+					continue
+				}
+				it := listItem{
+					ID:   sid,
+					Info: info,
+				}
+				if fp := fingerprint(info); fp != "" {
+					byPrint[fp] = append(byPrint[fp], it)
+				}
+				byName[songName(sid)] = append(byName[songName(sid)], it)
+			}
+		}
+	}
+	for id, info := range lost {
+		candidates := byPrint[fingerprint(info)]
+		if fingerprint(info) == "" {
+			candidates = byName[songName(id)]
+		}
+		if len(candidates) == 0 {
+			continue
+		}
+		candidates = append([]listItem(nil), candidates...)
+		// Prefer songs of the same protocol and name, then format.
+		rank := func(it listItem) int {
+			r := 0
+			if it.ID.Protocol() != id.Protocol() {
+				r += 4
+			}
+			if songName(it.ID) != songName(id) {
+				r += 2
+			}
+			if songFormat(it.ID) != songFormat(id) {
+				r++
+			}
+			return r
+		}
+		sort.Slice(candidates, func(i, j int) bool {
+			ri, rj := rank(candidates[i]), rank(candidates[j])
+			if ri != rj {
+				return ri < rj
+			}
+			return candidates[i].ID < candidates[j].ID
+		})
+		relocs = append(relocs, Relocation{
+			ID:         id,
+			Info:       info,
+			Candidates: candidates,
+		})
+	}
+	sort.Slice(relocs, func(i, j int) bool {
+		return relocs[i].ID < relocs[j].ID
+	})
+	return relocs
+}
+
+// relocate replaces the songs in the keys of m with their new locations in
+// the values, in the queue, playlists, history, and analyses. The old songs
+// are no longer listed. It should only be called by the commands()
+// function.
+func (srv *Server) relocate(m map[SongID]SongID) error {
+	for old, n := range m {
+		if old == n {
+			return fmt.Errorf("cannot relocate %v to itself", old)
+		}
+		if !srv.hasSong(old) && srv.Vanished[old] == nil {
+			return fmt.Errorf("unknown song: %v", old)
+		}
+		if !srv.hasSong(n) || srv.Hidden[n] {
+			return fmt.Errorf("unknown song: %v", n)
+		}
+		if _, ok := m[n]; ok {
+			return fmt.Errorf("cannot relocate to relocated song: %v", n)
+		}
+	}
+	srv.replaceSongs(m)
+	if srv.Hidden == nil {
+		srv.Hidden = make(map[SongID]bool)
+	}
+	for old := range m {
+		delete(srv.Vanished, old)
+		if srv.hasSong(old) {
+			srv.Hidden[old] = true
+		}
+	}
+	return nil
+}
+
+// Relocations returns the songs that vanished, or whose files are missing,
+// and the listed songs that may be them at new locations.
+func (srv *Server) Relocations(body io.Reader, form url.Values, ps httprouter.Params) (interface{}, error) {
+	ch := make(chan []Relocation)
+	srv.ch <- cmdRelocations(ch)
+	return <-ch, nil
+}
+
+// RelocationsApply relocates songs, the keys of Songs, to their new
+// locations, its values, keeping them in playlists and history instead of
+// removing them. If All is set, every song with a single candidate is
+// relocated to it.
+func (srv *Server) RelocationsApply(body io.Reader, form url.Values, ps httprouter.Params) (interface{}, error) {
+	var req struct {
+		Songs map[SongID]SongID
+		All   bool
+	}
+	if err := json.NewDecoder(body).Decode(&req); err != nil {
+		return nil, err
+	}
+	if !req.All && len(req.Songs) == 0 {
+		return nil, fmt.Errorf("missing songs")
+	}
+	ch := make(chan relocateResult)
+	srv.ch <- cmdRelocate{
+		m:    req.Songs,
+		all:  req.All,
+		done: ch,
+	}
+	res := <-ch
+	if res.err != nil {
+		return nil, res.err
+	}
+	var ids []SongID
+	for id := range res.m {
+		ids = append(ids, id)
+	}
+	srv.audit(ps, "relocate", fmt.Sprintf("%d songs", len(ids)))
+	srv.health.resolve(ids)
+	return res.m, nil
+}
+
+// RelocationsDismiss forgets vanished songs, removing them from
+// playlists.
+func (srv *Server) RelocationsDismiss(body io.Reader, form url.Values, ps httprouter.Params) (interface{}, error) {
+	var ids []SongID
+	if err := json.NewDecoder(body).Decode(&ids); err != nil {
+		return nil, err
+	}
+	srv.audit(ps, "relocate dismiss", fmt.Sprintf("%d songs", len(ids)))
+	srv.ch <- cmdDismissVanished(ids)
+	return nil, nil
+}
+
+type cmdRelocations chan []Relocation
+
+type relocateResult struct {
+	m   map[SongID]SongID
+	err error
+}
+
+type cmdRelocate struct {
+	m    map[SongID]SongID
+	all  bool
+	done chan relocateResult
+}
+
+type cmdDismissVanished []SongID
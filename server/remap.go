@@ -0,0 +1,160 @@
+package server
+
+import (
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/boltdb/bolt"
+	"github.com/mjibson/moggio/codec"
+	"github.com/mjibson/moggio/protocol"
+)
+
+// fingerprint identifies a song by its tags and duration, which don't
+// change when its file is moved or renamed. Songs without a title have none.
+func fingerprint(info *codec.SongInfo) string {
+	if info == nil || info.Title == "" {
+		return ""
+	}
+	return fmt.Sprintf("%s\x00%s\x00%s\x00%v\x00%d",
+		normalize(info.Artist),
+		normalize(info.Album),
+		normalize(info.Title),
+		info.Track,
+		info.Time/time.Second,
+	)
+}
+
+// remapSongs finds songs of an instance that were listed in prev, before a
+// refresh, but no longer are, and listed songs with the same fingerprint
+// that weren't. The old song IDs are replaced with the new ones in the
+// queue, playlists, history, and analyses, so they survive reorganizing
+// files. Referenced songs that can't be remapped are kept in Vanished. It
+// should only be called by the commands() function.
+func (srv *Server) remapSongs(name, key string, prev protocol.SongList) {
+	inst, err := srv.getInstance(name, key)
+	if err != nil {
+		return
+	}
+	songs, err := inst.List()
+	if err != nil {
+		return
+	}
+	for sid := range srv.Vanished {
+		if _, ok := songs[sid.ID()]; ok && sid.Protocol() == name && sid.Key() == key {
+			delete(srv.Vanished, sid)
+		}
+	}
+	// added are the new songs by fingerprint, or empty if ambiguous.
+	added := make(map[string]codec.ID)
+	for id, info := range songs {
+		if _, ok := prev[id]; ok {
+			continue
+		}
+		fp := fingerprint(info)
+		if fp == "" {
+			continue
+		}
+		if _, ok := added[fp]; ok {
+			added[fp] = ""
+		} else {
+			added[fp] = id
+		}
+	}
+	m := make(map[SongID]SongID)
+	var refs map[SongID]bool
+	for id, info := range prev {
+		if _, ok := songs[id]; ok {
+			continue
+		}
+		sid := SongID(codec.NewID(name, key, string(id)))
+		if nid := added[fingerprint(info)]; nid != "" {
+			m[sid] = SongID(codec.NewID(name, key, string(nid)))
+			continue
+		}
+		// Keep the info of vanished songs that are referenced, so they
+		// can be relocated.
+		if refs == nil {
+			refs = srv.referenced()
+		}
+		if refs[sid] {
+			if srv.Vanished == nil {
+				srv.Vanished = make(map[SongID]*codec.SongInfo)
+			}
+			srv.Vanished[sid] = info
+		}
+	}
+	if len(m) == 0 {
+		return
+	}
+	log.Printf("remapping %d moved songs of %s: %s", len(m), name, key)
+	srv.replaceSongs(m)
+}
+
+// replaceSongs replaces the songs in the keys of m with their values in the
+// queue, playlists, history, stats, and analyses. It should only be called by the
+// commands() function.
+func (srv *Server) replaceSongs(m map[SongID]SongID) {
+	remap := func(p []SongID) {
+		for i, id := range p {
+			if n, ok := m[id]; ok {
+				p[i] = n
+			}
+		}
+	}
+	remap(srv.Queue)
+	remap(srv.History)
+	for _, p := range srv.Playlists {
+		remap(p)
+	}
+	for _, u := range srv.Users {
+		remap(u.History)
+		for _, p := range u.Playlists {
+			remap(p)
+		}
+	}
+	if n, ok := m[srv.songID]; ok {
+		srv.songID = n
+	}
+	srv.mergeStats(m)
+	for old, n := range m {
+		if a, ok := srv.analysis[old]; ok {
+			delete(srv.analysis, old)
+			if _, ok := srv.analysis[n]; !ok {
+				srv.analysis[n] = a
+			}
+		}
+		if d, ok := srv.durations[old]; ok {
+			delete(srv.durations, old)
+			if _, ok := srv.durations[n]; !ok {
+				srv.durations[n] = d
+			}
+		}
+	}
+	err := srv.update(func(tx *bolt.Tx) error {
+		for _, name := range []string{dbAnalysis, dbDurations} {
+			b := tx.Bucket([]byte(name))
+			if b == nil {
+				continue
+			}
+			for old, n := range m {
+				v := b.Get([]byte(old))
+				if v == nil {
+					continue
+				}
+				if b.Get([]byte(n)) == nil {
+					if err := b.Put([]byte(n), append([]byte(nil), v...)); err != nil {
+						return err
+					}
+				}
+				if err := b.Delete([]byte(old)); err != nil {
+					return err
+				}
+			}
+		}
+		return nil
+	})
+	if err != nil {
+		log.Println("remap:", err)
+	}
+}
@@ -0,0 +1,541 @@
+package server
+
+import (
+	"bytes"
+	"context"
+	"encoding/binary"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"log"
+	"net/http"
+	"net/url"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/julienschmidt/httprouter"
+	"github.com/mjibson/moggio/codec"
+	"github.com/mjibson/moggio/dsp"
+	"github.com/mjibson/moggio/output"
+	"github.com/mjibson/moggio/protocol"
+)
+
+const (
+	// defaultRenderRate is the sample rate of renders if the output rate
+	// isn't set.
+	defaultRenderRate = 44100
+	// renderChannels is the number of channels of renders.
+	renderChannels = 2
+	// renderErrors is the number of errors of songs kept in a render's
+	// status.
+	renderErrors = 20
+)
+
+// RenderStatus is the progress of rendering a playlist to a file.
+type RenderStatus struct {
+	Running bool
+	// Name is the playlist rendered, or "" for the queue.
+	Name   string `json:",omitempty"`
+	Format string `json:",omitempty"`
+	// Path is the file written. Files not written to a path given are
+	// downloaded from /api/render/file.
+	Path     string    `json:",omitempty"`
+	Started  time.Time `json:",omitempty"`
+	Finished time.Time `json:",omitempty"`
+	// Song is the number of the song rendering, of Songs, and Title its
+	// title.
+	Song  int
+	Songs int
+	Title string `json:",omitempty"`
+	// Rendered is the length of the audio written.
+	Rendered time.Duration
+	// Error is why the render failed, and Errors the songs that did.
+	Error  string   `json:",omitempty"`
+	Errors []string `json:",omitempty"`
+}
+
+// renders holds the status of the render running or last run. Only one
+// runs at a time.
+type renders struct {
+	sync.Mutex
+	status RenderStatus
+	cancel context.CancelFunc
+	// temp is set if the file was written to a temporary path, removed by
+	// the next render.
+	temp bool
+}
+
+func (r *renders) start(st RenderStatus, cancel context.CancelFunc, temp bool) bool {
+	r.Lock()
+	defer r.Unlock()
+	if r.status.Running {
+		return false
+	}
+	if r.temp {
+		os.Remove(r.status.Path)
+	}
+	st.Running = true
+	st.Started = time.Now()
+	r.status = st
+	r.cancel = cancel
+	r.temp = temp
+	return true
+}
+
+func (r *renders) update(f func(st *RenderStatus)) {
+	r.Lock()
+	defer r.Unlock()
+	f(&r.status)
+}
+
+func (r *renders) get() RenderStatus {
+	r.Lock()
+	defer r.Unlock()
+	st := r.status
+	st.Errors = append([]string(nil), st.Errors...)
+	return st
+}
+
+// renderSong is a song of a render, with the gain of its ReplayGain.
+type renderSong struct {
+	id   SongID
+	inst protocol.Instance
+	info codec.SongInfo
+	gain float64
+}
+
+// renderPlan is what a render plays: its songs and DSP settings.
+type renderPlan struct {
+	songs []renderSong
+	conf  dspConfig
+	rate  int
+	err   error
+}
+
+// renderPlan returns the plan of rendering the playlist of user, or the
+// queue if playlist is "", at rate, or the output rate if 0. Songs refused
+// by the parental filter are skipped, as they would be played. It should
+// only be called by the commands() function.
+func (srv *Server) renderPlan(playlist, user string, rate int) renderPlan {
+	var plan renderPlan
+	list := srv.Queue
+	if playlist != "" {
+		p, ok := srv.playlists(user)[playlist]
+		if !ok {
+			plan.err = fmt.Errorf("unknown playlist: %s", playlist)
+			return plan
+		}
+		list = p
+	}
+	for _, id := range list {
+		info, err := srv.getSong(id)
+		if err != nil || srv.Parental.blocks(info) {
+			continue
+		}
+		inst, err := srv.getInstance(id.Protocol(), id.Key())
+		if err != nil {
+			continue
+		}
+		info = srv.songInfo(id, info)
+		plan.songs = append(plan.songs, renderSong{
+			id:   id,
+			inst: inst,
+			info: *info,
+			gain: dsp.DB(srv.gainDB(info)),
+		})
+	}
+	if len(plan.songs) == 0 {
+		plan.err = fmt.Errorf("no songs to render")
+	}
+	// Settings of the output device other than its plugins don't apply to
+	// files.
+	plan.conf = srv.dspConfig()
+	plan.conf.bitPerfect = false
+	if rate == 0 {
+		rate = srv.OutputRate
+	}
+	if rate == 0 {
+		rate = defaultRenderRate
+	}
+	plan.rate = rate
+	plan.conf.outputRate = rate
+	plan.conf.fixedRate = 0
+	plan.conf.backend = "file"
+	plan.conf.device = ""
+	return plan
+}
+
+// renderWriter encodes the samples of a render to its file.
+type renderWriter interface {
+	Write(samples []float32) error
+	Close() error
+}
+
+// lameWriter encodes samples to an MP3 file with the lame encoder, which
+// must be installed.
+type lameWriter struct {
+	cmd    *exec.Cmd
+	w      io.WriteCloser
+	stderr bytes.Buffer
+	dither dsp.Dither
+}
+
+func newLAMEWriter(path, title string, rate, channels int) (*lameWriter, error) {
+	mode := "j"
+	if channels == 1 {
+		mode = "m"
+	}
+	args := []string{"--quiet", "-r", "-s", strconv.FormatFloat(float64(rate)/1000, 'f', -1, 64),
+		"--bitwidth", "16", "--signed", "--little-endian", "-m", mode, "-V", "2"}
+	if title != "" {
+		args = append(args, "--tt", title)
+	}
+	lw := &lameWriter{
+		cmd: exec.Command("lame", append(args, "-", path)...),
+	}
+	lw.cmd.Stderr = &lw.stderr
+	w, err := lw.cmd.StdinPipe()
+	if err != nil {
+		return nil, err
+	}
+	if err := lw.cmd.Start(); err != nil {
+		return nil, fmt.Errorf("mp3 needs lame installed: %v", err)
+	}
+	lw.w = w
+	return lw, nil
+}
+
+func (lw *lameWriter) Write(samples []float32) error {
+	b := make([]byte, len(samples)*2)
+	for i, s := range samples {
+		binary.LittleEndian.PutUint16(b[i*2:], uint16(lw.dither.Int16(s)))
+	}
+	if _, err := lw.w.Write(b); err != nil {
+		return lw.error(err)
+	}
+	return nil
+}
+
+func (lw *lameWriter) Close() error {
+	lw.w.Close()
+	if err := lw.cmd.Wait(); err != nil {
+		return lw.error(err)
+	}
+	return nil
+}
+
+func (lw *lameWriter) error(err error) error {
+	if msg := strings.TrimSpace(lw.stderr.String()); msg != "" {
+		return fmt.Errorf("lame: %v: %s", err, msg)
+	}
+	return fmt.Errorf("lame: %v", err)
+}
+
+// remix returns samples of from channels with to channels: mono copied to
+// each, or others downmixed.
+func remix(samples []float32, from, to int) []float32 {
+	if from == to {
+		return samples
+	}
+	if from > 1 && to <= 2 {
+		return dsp.NewDownmix(from, to).Process(samples)
+	}
+	if from > 1 {
+		// Downmixed to mono, then copied.
+		samples = dsp.NewDownmix(from, 1).Process(samples)
+	}
+	r := make([]float32, len(samples)*to)
+	for i := range r {
+		r[i] = samples[i/to]
+	}
+	return r
+}
+
+// render plays the songs of plan through its DSP chain, with their
+// crossfades, into w as fast as they decode. Songs that fail are skipped.
+func (srv *Server) render(ctx context.Context, plan renderPlan, w renderWriter) error {
+	const expected = 4096
+	rate := plan.rate
+	xf := plan.conf.xfade()
+	// tail is the end of the previous song, mixed with the start of the
+	// next by xfade, as in audio.
+	var tail []float32
+	var xfade *dsp.Crossfade
+	var frames int64
+	push := func(samples []float32) error {
+		if xfade != nil {
+			samples = xfade.Process(samples)
+			if xfade.Done() {
+				xfade = nil
+			}
+		}
+		frames += int64(len(samples) / renderChannels)
+		return w.Write(samples)
+	}
+	skip := func(rs renderSong, err error) {
+		log.Printf("render %v: %v", rs.id, err)
+		srv.renders.update(func(st *RenderStatus) {
+			if len(st.Errors) < renderErrors {
+				st.Errors = append(st.Errors, fmt.Sprintf("%s: %v", rs.info.Title, err))
+			}
+		})
+	}
+	for i, rs := range plan.songs {
+		if err := ctx.Err(); err != nil {
+			return err
+		}
+		srv.renders.update(func(st *RenderStatus) {
+			st.Song = i + 1
+			st.Title = rs.info.Title
+		})
+		song, err := protocol.GetSong(ctx, rs.inst, rs.id.ID())
+		if err != nil {
+			skip(rs, err)
+			continue
+		}
+		sr, ch, err := song.Init()
+		if err != nil {
+			song.Close()
+			skip(rs, err)
+			continue
+		}
+		conf := plan.conf
+		conf.gain = rs.gain
+		chain := conf.chain(sr, ch)
+		out := conf.channels(ch)
+		if len(tail) > 0 {
+			// Songs that flow into the next, as in live albums, aren't
+			// faded.
+			if flows(tail, rate, renderChannels) {
+				err = push(tail)
+			} else {
+				xfade = dsp.NewCrossfade(tail, renderChannels)
+			}
+			tail = nil
+			if err != nil {
+				song.Close()
+				return err
+			}
+		}
+		var read int64
+		for empty := 0; empty < 100; {
+			next, err := song.Play(expected)
+			if len(next) == 0 {
+				empty++
+			}
+			if len(next) > 0 {
+				read += int64(len(next))
+				pos := time.Duration(read * int64(time.Second) / int64(sr*ch))
+				buf := make([]float32, len(next))
+				copy(buf, next)
+				buf = remix(chain.Process(buf), out, renderChannels)
+				if xf > 0 && rs.info.Time > xf*2 && pos >= rs.info.Time-xf {
+					tail = append(tail, buf...)
+				} else if len(buf) > 0 {
+					if err := push(buf); err != nil {
+						song.Close()
+						return err
+					}
+				}
+				srv.renders.update(func(st *RenderStatus) {
+					st.Rendered = time.Duration(frames * int64(time.Second) / int64(rate))
+				})
+			}
+			if err == io.EOF {
+				break
+			} else if err != nil {
+				skip(rs, err)
+				break
+			}
+			if ctx.Err() != nil {
+				break
+			}
+		}
+		song.Close()
+	}
+	if err := ctx.Err(); err != nil {
+		return err
+	}
+	if len(tail) > 0 {
+		if err := push(tail); err != nil {
+			return err
+		}
+	}
+	srv.renders.update(func(st *RenderStatus) {
+		st.Rendered = time.Duration(frames * int64(time.Second) / int64(rate))
+	})
+	return nil
+}
+
+// GetRender returns the status of the render running or last run.
+func (srv *Server) GetRender(body io.Reader, form url.Values, ps httprouter.Params) (interface{}, error) {
+	return srv.renders.get(), nil
+}
+
+// RenderStart renders a playlist, like a mixtape, to a file: the playlist
+// parameter's, or the queue, played through the DSP chain with its
+// crossfades, gain, and equalizer faster than real time. The format
+// parameter is "flac", the default, "wav", or "mp3", which needs lame;
+// rate is the sample rate, by default the output rate or 44100; bits is
+// 16, the default, or 24, for FLAC and WAV. The file is written to the
+// path parameter, if set, which must not exist, or else downloaded from
+// /api/render/file.
+func (srv *Server) RenderStart(body io.Reader, form url.Values, ps httprouter.Params) (interface{}, error) {
+	format := form.Get("format")
+	switch format {
+	case "":
+		format = "flac"
+	case "flac", "wav", "mp3":
+	default:
+		return nil, fmt.Errorf("unknown render format: %s", format)
+	}
+	bits := 16
+	if b := form.Get("bits"); b != "" {
+		var err error
+		if bits, err = strconv.Atoi(b); err != nil || bits != 16 && bits != 24 {
+			return nil, fmt.Errorf("bits must be 16 or 24")
+		}
+	}
+	rate := 0
+	if r := form.Get("rate"); r != "" {
+		var err error
+		if rate, err = strconv.Atoi(r); err != nil || rate < 8000 || rate > 192000 {
+			return nil, fmt.Errorf("bad sample rate: %s", r)
+		}
+	}
+	ch := make(chan renderPlan)
+	srv.ch <- cmdRenderPlan{
+		playlist: form.Get("playlist"),
+		user:     ps.ByName(paramUser),
+		rate:     rate,
+		done:     ch,
+	}
+	plan := <-ch
+	if plan.err != nil {
+		return nil, plan.err
+	}
+	rate = plan.rate
+	if format == "mp3" && rate > 48000 {
+		return nil, fmt.Errorf("mp3 sample rates are at most 48000")
+	}
+	path, temp := form.Get("path"), false
+	if path == "" {
+		f, err := ioutil.TempFile("", "moggio-render-*."+format)
+		if err != nil {
+			return nil, err
+		}
+		f.Close()
+		path, temp = f.Name(), true
+	} else {
+		var err error
+		if path, err = filepath.Abs(path); err != nil {
+			return nil, err
+		}
+		if !strings.EqualFold(filepath.Ext(path), "."+format) {
+			path += "." + format
+		}
+		// Claim the path, so a render never overwrites a file, which may
+		// be a song in the library.
+		f, err := os.OpenFile(path, os.O_WRONLY|os.O_CREATE|os.O_EXCL, 0644)
+		if err != nil {
+			return nil, err
+		}
+		f.Close()
+	}
+	ctx, cancel := context.WithCancel(srv.ctx)
+	st := RenderStatus{
+		Name:   form.Get("playlist"),
+		Format: format,
+		Path:   path,
+		Songs:  len(plan.songs),
+	}
+	if !srv.renders.start(st, cancel, temp) {
+		cancel()
+		os.Remove(path)
+		return nil, fmt.Errorf("already rendering")
+	}
+	var w renderWriter
+	var err error
+	if format == "mp3" {
+		w, err = newLAMEWriter(path, st.Name, rate, renderChannels)
+	} else {
+		w, err = output.CreateFile(path, output.Format{
+			SampleRate: rate,
+			Channels:   renderChannels,
+			Bits:       bits,
+		})
+	}
+	finish := func(err error) {
+		cancel()
+		srv.renders.update(func(st *RenderStatus) {
+			st.Running = false
+			st.Finished = time.Now()
+			st.Title = ""
+			if err != nil {
+				st.Error = err.Error()
+			}
+		})
+	}
+	if err != nil {
+		os.Remove(path)
+		finish(err)
+		return nil, err
+	}
+	srv.audit(ps, "render", fmt.Sprintf("%d songs to %s", len(plan.songs), path))
+	go func() {
+		err := srv.render(ctx, plan, w)
+		if cerr := w.Close(); err == nil {
+			err = cerr
+		}
+		if err != nil {
+			log.Printf("render: %v", err)
+		}
+		finish(err)
+	}()
+	return nil, nil
+}
+
+// RenderCancel stops the render running.
+func (srv *Server) RenderCancel(body io.Reader, form url.Values, ps httprouter.Params) (interface{}, error) {
+	srv.renders.Lock()
+	defer srv.renders.Unlock()
+	if !srv.renders.status.Running {
+		return nil, fmt.Errorf("not rendering")
+	}
+	srv.renders.cancel()
+	return nil, nil
+}
+
+// RenderFile serves the file of the last render, once finished.
+func (srv *Server) RenderFile(w http.ResponseWriter, r *http.Request, ps httprouter.Params) {
+	st := srv.renders.get()
+	switch {
+	case st.Path == "":
+		serveError(w, fmt.Errorf("nothing rendered"))
+		return
+	case st.Running:
+		serveError(w, fmt.Errorf("still rendering"))
+		return
+	case st.Error != "":
+		serveError(w, fmt.Errorf("render failed: %s", st.Error))
+		return
+	}
+	name := st.Name
+	if name == "" {
+		name = "moggio"
+	}
+	w.Header().Set("Content-Disposition", fmt.Sprintf("attachment; filename=%q", cleanFileName(name)+"."+st.Format))
+	http.ServeFile(w, r, st.Path)
+}
+
+type cmdRenderPlan struct {
+	playlist string
+	user     string
+	rate     int
+	done     chan renderPlan
+}
@@ -0,0 +1,456 @@
+package server
+
+import (
+	"encoding/xml"
+	"fmt"
+	"math"
+	"strconv"
+	"strings"
+	"sync"
+
+	"github.com/mjibson/moggio/codec"
+	"github.com/mjibson/moggio/protocol/remote"
+)
+
+const (
+	avTransportType       = "urn:schemas-upnp-org:service:AVTransport:1"
+	renderingControlType  = "urn:schemas-upnp-org:service:RenderingControl:1"
+	connectionManagerType = "urn:schemas-upnp-org:service:ConnectionManager:1"
+	mediaRendererType     = "urn:schemas-upnp-org:device:MediaRenderer:1"
+
+	// rendererKey is the key of the remote protocol instance of songs pushed
+	// to the renderer.
+	rendererKey = "upnp"
+)
+
+// rendererSinks are the formats the renderer accepts.
+var rendererSinks = []string{
+	"audio/mpeg", "audio/mp3", "audio/flac", "audio/x-flac", "audio/ogg",
+	"audio/vorbis", "audio/wav", "audio/x-wav", "audio/L16", "audio/aiff",
+	"audio/x-aiff",
+}
+
+// renderer is the state of the UPnP media renderer not kept by the server.
+type renderer struct {
+	sync.Mutex
+	// uri and meta are of the current transport URI, and song its ID.
+	uri, meta string
+	song      SongID
+	// muted is the volume before muting, or 0 if not muted.
+	muted float64
+}
+
+// didlLite is DIDL-Lite metadata, describing media in UPnP.
+type didlLite struct {
+	Items []didlItem `xml:"item"`
+}
+
+type didlItem struct {
+	Title    string `xml:"title"`
+	Creator  string `xml:"creator"`
+	Artist   string `xml:"artist"`
+	Album    string `xml:"album"`
+	Genre    string `xml:"genre"`
+	Track    int    `xml:"originalTrackNumber"`
+	AlbumArt string `xml:"albumArtURI"`
+	Res      []struct {
+		Duration string `xml:"duration,attr"`
+	} `xml:"res"`
+}
+
+// didlInfo returns the song info in DIDL-Lite metadata, or nil if there is
+// none.
+func didlInfo(meta string) *codec.SongInfo {
+	var d didlLite
+	if err := xml.Unmarshal([]byte(meta), &d); err != nil || len(d.Items) == 0 {
+		return nil
+	}
+	it := d.Items[0]
+	info := &codec.SongInfo{
+		Title:    it.Title,
+		Artist:   it.Artist,
+		Album:    it.Album,
+		Genre:    it.Genre,
+		Track:    float64(it.Track),
+		ImageURL: it.AlbumArt,
+	}
+	if info.Artist == "" {
+		info.Artist = it.Creator
+	}
+	for _, r := range it.Res {
+		if d, err := parseUPnPTime(r.Duration); err == nil {
+			info.Time = d
+			break
+		}
+	}
+	return info
+}
+
+// upnpRenderer returns the UPnP MediaRenderer device, which plays songs
+// pushed by controllers.
+func (srv *Server) upnpRenderer() (upnpDevice, []upnpService) {
+	d := upnpDevice{
+		uuid: upnpUUID("renderer"),
+		typ:  mediaRendererType,
+		path: "/upnp/renderer/device.xml",
+	}
+	transportVars := []upnpVar{
+		{name: "TransportState", typ: "string", allowed: []string{"STOPPED", "PLAYING", "PAUSED_PLAYBACK", "NO_MEDIA_PRESENT"}},
+		{name: "TransportStatus", typ: "string", allowed: []string{"OK", "ERROR_OCCURRED"}},
+		{name: "TransportPlaySpeed", typ: "string", allowed: []string{"1"}},
+		{name: "PlaybackStorageMedium", typ: "string", allowed: []string{"NONE", "NETWORK"}},
+		{name: "RecordStorageMedium", typ: "string", allowed: []string{"NOT_IMPLEMENTED"}},
+		{name: "PossiblePlaybackStorageMedia", typ: "string"},
+		{name: "PossibleRecordStorageMedia", typ: "string"},
+		{name: "CurrentPlayMode", typ: "string", allowed: []string{"NORMAL", "SHUFFLE", "REPEAT_ALL"}},
+		{name: "RecordMediumWriteStatus", typ: "string", allowed: []string{"NOT_IMPLEMENTED"}},
+		{name: "CurrentRecordQualityMode", typ: "string", allowed: []string{"NOT_IMPLEMENTED"}},
+		{name: "PossibleRecordQualityModes", typ: "string"},
+		{name: "NumberOfTracks", typ: "ui4"},
+		{name: "CurrentTrack", typ: "ui4"},
+		{name: "CurrentTrackDuration", typ: "string"},
+		{name: "CurrentMediaDuration", typ: "string"},
+		{name: "CurrentTrackMetaData", typ: "string"},
+		{name: "CurrentTrackURI", typ: "string"},
+		{name: "AVTransportURI", typ: "string"},
+		{name: "AVTransportURIMetaData", typ: "string"},
+		{name: "NextAVTransportURI", typ: "string"},
+		{name: "NextAVTransportURIMetaData", typ: "string"},
+		{name: "RelativeTimePosition", typ: "string"},
+		{name: "AbsoluteTimePosition", typ: "string"},
+		{name: "RelativeCounterPosition", typ: "i4"},
+		{name: "AbsoluteCounterPosition", typ: "i4"},
+		{name: "CurrentTransportActions", typ: "string"},
+		{name: "LastChange", typ: "string", events: true},
+		{name: "A_ARG_TYPE_SeekMode", typ: "string", allowed: []string{"REL_TIME", "ABS_TIME", "TRACK_NR"}},
+		{name: "A_ARG_TYPE_SeekTarget", typ: "string"},
+		{name: "A_ARG_TYPE_InstanceID", typ: "ui4"},
+	}
+	transport := upnpService{
+		name: "AVTransport",
+		typ:  avTransportType,
+		vars: transportVars,
+		actions: []upnpAction{
+			{name: "SetAVTransportURI", in: []string{"InstanceID=A_ARG_TYPE_InstanceID", "CurrentURI=AVTransportURI", "CurrentURIMetaData=AVTransportURIMetaData"}},
+			{name: "SetNextAVTransportURI", in: []string{"InstanceID=A_ARG_TYPE_InstanceID", "NextURI=NextAVTransportURI", "NextURIMetaData=NextAVTransportURIMetaData"}},
+			{name: "GetMediaInfo", in: []string{"InstanceID=A_ARG_TYPE_InstanceID"}, out: []string{"NrTracks=NumberOfTracks", "MediaDuration=CurrentMediaDuration", "CurrentURI=AVTransportURI", "CurrentURIMetaData=AVTransportURIMetaData", "NextURI=NextAVTransportURI", "NextURIMetaData=NextAVTransportURIMetaData", "PlayMedium=PlaybackStorageMedium", "RecordMedium=RecordStorageMedium", "WriteStatus=RecordMediumWriteStatus"}},
+			{name: "GetTransportInfo", in: []string{"InstanceID=A_ARG_TYPE_InstanceID"}, out: []string{"CurrentTransportState=TransportState", "CurrentTransportStatus=TransportStatus", "CurrentSpeed=TransportPlaySpeed"}},
+			{name: "GetPositionInfo", in: []string{"InstanceID=A_ARG_TYPE_InstanceID"}, out: []string{"Track=CurrentTrack", "TrackDuration=CurrentTrackDuration", "TrackMetaData=CurrentTrackMetaData", "TrackURI=CurrentTrackURI", "RelTime=RelativeTimePosition", "AbsTime=AbsoluteTimePosition", "RelCount=RelativeCounterPosition", "AbsCount=AbsoluteCounterPosition"}},
+			{name: "GetDeviceCapabilities", in: []string{"InstanceID=A_ARG_TYPE_InstanceID"}, out: []string{"PlayMedia=PossiblePlaybackStorageMedia", "RecMedia=PossibleRecordStorageMedia", "RecQualityModes=PossibleRecordQualityModes"}},
+			{name: "GetTransportSettings", in: []string{"InstanceID=A_ARG_TYPE_InstanceID"}, out: []string{"PlayMode=CurrentPlayMode", "RecQualityMode=CurrentRecordQualityMode"}},
+			{name: "GetCurrentTransportActions", in: []string{"InstanceID=A_ARG_TYPE_InstanceID"}, out: []string{"Actions=CurrentTransportActions"}},
+			{name: "Stop", in: []string{"InstanceID=A_ARG_TYPE_InstanceID"}},
+			{name: "Play", in: []string{"InstanceID=A_ARG_TYPE_InstanceID", "Speed=TransportPlaySpeed"}},
+			{name: "Pause", in: []string{"InstanceID=A_ARG_TYPE_InstanceID"}},
+			{name: "Seek", in: []string{"InstanceID=A_ARG_TYPE_InstanceID", "Unit=A_ARG_TYPE_SeekMode", "Target=A_ARG_TYPE_SeekTarget"}},
+			{name: "Next", in: []string{"InstanceID=A_ARG_TYPE_InstanceID"}},
+			{name: "Previous", in: []string{"InstanceID=A_ARG_TYPE_InstanceID"}},
+		},
+		handle: srv.avTransport,
+	}
+	rendering := upnpService{
+		name: "RenderingControl",
+		typ:  renderingControlType,
+		vars: []upnpVar{
+			{name: "PresetNameList", typ: "string"},
+			{name: "Mute", typ: "boolean"},
+			{name: "Volume", typ: "ui2"},
+			{name: "LastChange", typ: "string", events: true},
+			{name: "A_ARG_TYPE_Channel", typ: "string", allowed: []string{"Master"}},
+			{name: "A_ARG_TYPE_InstanceID", typ: "ui4"},
+			{name: "A_ARG_TYPE_PresetName", typ: "string", allowed: []string{"FactoryDefaults"}},
+		},
+		actions: []upnpAction{
+			{name: "ListPresets", in: []string{"InstanceID=A_ARG_TYPE_InstanceID"}, out: []string{"CurrentPresetNameList=PresetNameList"}},
+			{name: "SelectPreset", in: []string{"InstanceID=A_ARG_TYPE_InstanceID", "PresetName=A_ARG_TYPE_PresetName"}},
+			{name: "GetMute", in: []string{"InstanceID=A_ARG_TYPE_InstanceID", "Channel=A_ARG_TYPE_Channel"}, out: []string{"CurrentMute=Mute"}},
+			{name: "SetMute", in: []string{"InstanceID=A_ARG_TYPE_InstanceID", "Channel=A_ARG_TYPE_Channel", "DesiredMute=Mute"}},
+			{name: "GetVolume", in: []string{"InstanceID=A_ARG_TYPE_InstanceID", "Channel=A_ARG_TYPE_Channel"}, out: []string{"CurrentVolume=Volume"}},
+			{name: "SetVolume", in: []string{"InstanceID=A_ARG_TYPE_InstanceID", "Channel=A_ARG_TYPE_Channel", "DesiredVolume=Volume"}},
+		},
+		handle: srv.renderingControl,
+	}
+	return d, []upnpService{transport, rendering, srv.connectionManager(true)}
+}
+
+// connectionManager returns the ConnectionManager service, which describes
+// the formats a renderer (sink) accepts or a media server (source) serves.
+func (srv *Server) connectionManager(sink bool) upnpService {
+	return upnpService{
+		name: "ConnectionManager",
+		typ:  connectionManagerType,
+		vars: []upnpVar{
+			{name: "SourceProtocolInfo", typ: "string", events: true},
+			{name: "SinkProtocolInfo", typ: "string", events: true},
+			{name: "CurrentConnectionIDs", typ: "string", events: true},
+			{name: "A_ARG_TYPE_ConnectionStatus", typ: "string", allowed: []string{"OK", "ContentFormatMismatch", "InsufficientBandwidth", "UnreliableChannel", "Unknown"}},
+			{name: "A_ARG_TYPE_ConnectionManager", typ: "string"},
+			{name: "A_ARG_TYPE_Direction", typ: "string", allowed: []string{"Input", "Output"}},
+			{name: "A_ARG_TYPE_ProtocolInfo", typ: "string"},
+			{name: "A_ARG_TYPE_ConnectionID", typ: "i4"},
+			{name: "A_ARG_TYPE_AVTransportID", typ: "i4"},
+			{name: "A_ARG_TYPE_RcsID", typ: "i4"},
+		},
+		actions: []upnpAction{
+			{name: "GetProtocolInfo", out: []string{"Source=SourceProtocolInfo", "Sink=SinkProtocolInfo"}},
+			{name: "GetCurrentConnectionIDs", out: []string{"ConnectionIDs=CurrentConnectionIDs"}},
+			{name: "GetCurrentConnectionInfo", in: []string{"ConnectionID=A_ARG_TYPE_ConnectionID"}, out: []string{"RcsID=A_ARG_TYPE_RcsID", "AVTransportID=A_ARG_TYPE_AVTransportID", "ProtocolInfo=A_ARG_TYPE_ProtocolInfo", "PeerConnectionManager=A_ARG_TYPE_ConnectionManager", "PeerConnectionID=A_ARG_TYPE_ConnectionID", "Direction=A_ARG_TYPE_Direction", "Status=A_ARG_TYPE_ConnectionStatus"}},
+		},
+		handle: func(action string, args map[string]string) ([]string, error) {
+			var infos []string
+			for _, t := range rendererSinks {
+				infos = append(infos, "http-get:*:"+t+":*")
+			}
+			source, sinks, direction := strings.Join(infos, ","), "", "Output"
+			if sink {
+				source, sinks, direction = "", source, "Input"
+			}
+			switch action {
+			case "GetProtocolInfo":
+				return []string{"Source", source, "Sink", sinks}, nil
+			case "GetCurrentConnectionIDs":
+				return []string{"ConnectionIDs", "0"}, nil
+			case "GetCurrentConnectionInfo":
+				if args["ConnectionID"] != "0" {
+					return nil, upnpError{706, "Invalid connection reference"}
+				}
+				return []string{"RcsID", "0", "AVTransportID", "0", "ProtocolInfo", "", "PeerConnectionManager", "", "PeerConnectionID", "-1", "Direction", direction, "Status", "OK"}, nil
+			}
+			return nil, errInvalidAction
+		},
+	}
+}
+
+// rendererStatus returns the current status.
+func (srv *Server) rendererStatus() *Status {
+	ch := make(chan *waitData)
+	srv.ch <- cmdWaitData{
+		wt:   waitStatus,
+		done: ch,
+	}
+	return (<-ch).Data.(*Status)
+}
+
+func (srv *Server) avTransport(action string, args map[string]string) ([]string, error) {
+	if args["InstanceID"] != "0" {
+		return nil, upnpError{718, "Invalid InstanceID"}
+	}
+	r := &srv.renderer
+	switch action {
+	case "SetAVTransportURI", "SetNextAVTransportURI":
+		next := action == "SetNextAVTransportURI"
+		uri, meta := args["CurrentURI"], args["CurrentURIMetaData"]
+		if next {
+			uri, meta = args["NextURI"], args["NextURIMetaData"]
+		}
+		if uri == "" {
+			return nil, errInvalidArgs
+		}
+		ch := make(chan rendererResult)
+		srv.ch <- cmdRendererPush{
+			uri:  uri,
+			info: didlInfo(meta),
+			next: next,
+			done: ch,
+		}
+		res := <-ch
+		if res.err != nil {
+			return nil, upnpError{716, "Resource not found"}
+		}
+		if !next {
+			r.Lock()
+			r.uri, r.meta, r.song = uri, meta, res.id
+			r.Unlock()
+		}
+		return nil, nil
+	case "GetMediaInfo":
+		st := srv.rendererStatus()
+		uri, meta := r.current(st.Song)
+		return []string{
+			"NrTracks", "1",
+			"MediaDuration", upnpTime(st.Time),
+			"CurrentURI", uri,
+			"CurrentURIMetaData", meta,
+			"NextURI", "",
+			"NextURIMetaData", "",
+			"PlayMedium", "NETWORK",
+			"RecordMedium", "NOT_IMPLEMENTED",
+			"WriteStatus", "NOT_IMPLEMENTED",
+		}, nil
+	case "GetTransportInfo":
+		st := srv.rendererStatus()
+		return []string{
+			"CurrentTransportState", transportState(st),
+			"CurrentTransportStatus", "OK",
+			"CurrentSpeed", "1",
+		}, nil
+	case "GetPositionInfo":
+		st := srv.rendererStatus()
+		uri, meta := r.current(st.Song)
+		return []string{
+			"Track", "1",
+			"TrackDuration", upnpTime(st.Time),
+			"TrackMetaData", meta,
+			"TrackURI", uri,
+			"RelTime", upnpTime(st.Elapsed),
+			"AbsTime", upnpTime(st.Elapsed),
+			"RelCount", "2147483647",
+			"AbsCount", "2147483647",
+		}, nil
+	case "GetDeviceCapabilities":
+		return []string{"PlayMedia", "NETWORK", "RecMedia", "NOT_IMPLEMENTED", "RecQualityModes", "NOT_IMPLEMENTED"}, nil
+	case "GetTransportSettings":
+		st := srv.rendererStatus()
+		mode := "NORMAL"
+		if st.Random {
+			mode = "SHUFFLE"
+		} else if st.Repeat {
+			mode = "REPEAT_ALL"
+		}
+		return []string{"PlayMode", mode, "RecQualityMode", "NOT_IMPLEMENTED"}, nil
+	case "GetCurrentTransportActions":
+		return []string{"Actions", "Play,Stop,Pause,Seek,Next,Previous"}, nil
+	case "Stop":
+		srv.ch <- cmdStop
+		return nil, nil
+	case "Play":
+		switch srv.rendererStatus().State {
+		case statePause:
+			srv.ch <- cmdPause
+		case stateStop:
+			srv.ch <- cmdPlay
+		}
+		return nil, nil
+	case "Pause":
+		if srv.rendererStatus().State == statePlay {
+			srv.ch <- cmdPause
+		}
+		return nil, nil
+	case "Seek":
+		switch args["Unit"] {
+		case "REL_TIME", "ABS_TIME":
+			d, err := parseUPnPTime(args["Target"])
+			if err != nil {
+				return nil, errInvalidArgs
+			}
+			srv.ch <- cmdSeek(d)
+			return nil, nil
+		}
+		return nil, upnpError{710, "Seek mode not supported"}
+	case "Next":
+		srv.ch <- cmdNext
+		return nil, nil
+	case "Previous":
+		srv.ch <- cmdPrev
+		return nil, nil
+	}
+	return nil, errInvalidAction
+}
+
+// current returns the URI and metadata of song, if it was pushed.
+func (r *renderer) current(song SongID) (uri, meta string) {
+	r.Lock()
+	defer r.Unlock()
+	if song == "" || song != r.song {
+		return "", ""
+	}
+	return r.uri, r.meta
+}
+
+func transportState(st *Status) string {
+	switch {
+	case st.Song == "":
+		return "NO_MEDIA_PRESENT"
+	case st.State == statePlay:
+		return "PLAYING"
+	case st.State == statePause:
+		return "PAUSED_PLAYBACK"
+	}
+	return "STOPPED"
+}
+
+func (srv *Server) renderingControl(action string, args map[string]string) ([]string, error) {
+	if args["InstanceID"] != "0" {
+		return nil, upnpError{702, "Invalid InstanceID"}
+	}
+	r := &srv.renderer
+	switch action {
+	case "ListPresets":
+		return []string{"CurrentPresetNameList", "FactoryDefaults"}, nil
+	case "SelectPreset":
+		if args["PresetName"] != "FactoryDefaults" {
+			return nil, upnpError{701, "Invalid Name"}
+		}
+		srv.ch <- cmdVolume(1)
+		return nil, nil
+	case "GetMute":
+		r.Lock()
+		muted := r.muted > 0
+		r.Unlock()
+		if muted {
+			return []string{"CurrentMute", "1"}, nil
+		}
+		return []string{"CurrentMute", "0"}, nil
+	case "SetMute":
+		mute := args["DesiredMute"] == "1" || strings.EqualFold(args["DesiredMute"], "true")
+		vol := srv.rendererStatus().Volume
+		r.Lock()
+		defer r.Unlock()
+		if mute && r.muted == 0 && vol > 0 {
+			r.muted = vol
+			srv.ch <- cmdVolume(0)
+		} else if !mute && r.muted > 0 {
+			srv.ch <- cmdVolume(r.muted)
+			r.muted = 0
+		}
+		return nil, nil
+	case "GetVolume":
+		vol := srv.rendererStatus().Volume
+		return []string{"CurrentVolume", strconv.Itoa(int(math.Round(vol * 100)))}, nil
+	case "SetVolume":
+		v, err := strconv.Atoi(args["DesiredVolume"])
+		if err != nil || v < 0 || v > 100 {
+			return nil, errInvalidArgs
+		}
+		r.Lock()
+		r.muted = 0
+		r.Unlock()
+		srv.ch <- cmdVolume(float64(v) / 100)
+		return nil, nil
+	}
+	return nil, errInvalidAction
+}
+
+// rendererSong adds the song at uri to the renderer's remote instance and
+// returns its ID. It should only be called by the commands() function.
+func (srv *Server) rendererSong(uri string, info *codec.SongInfo) (SongID, error) {
+	prots := srv.Protocols["remote"]
+	if prots == nil {
+		return "", fmt.Errorf("unknown protocol: remote")
+	}
+	inst, _ := prots[rendererKey].(*remote.Remote)
+	if inst == nil {
+		inst = &remote.Remote{
+			Name: rendererKey,
+		}
+		prots[rendererKey] = inst
+	}
+	id, err := inst.Add(uri, info)
+	srv.changedInstance("remote", rendererKey)
+	if err != nil {
+		return "", err
+	}
+	return SongID(codec.NewID("remote", rendererKey, string(id))), nil
+}
+
+type rendererResult struct {
+	id  SongID
+	err error
+}
+
+type cmdRendererPush struct {
+	uri  string
+	info *codec.SongInfo
+	next bool
+	done chan rendererResult
+}
@@ -0,0 +1,131 @@
+package server
+
+import (
+	"fmt"
+	"io"
+	"net/url"
+	"sort"
+	"time"
+
+	"github.com/julienschmidt/httprouter"
+	"github.com/mjibson/moggio/codec"
+)
+
+const (
+	// defaultResumeMin is the length from which songs, like audiobooks and
+	// DJ sets, resume where they were stopped.
+	defaultResumeMin = time.Minute * 20
+	// resumeStart and resumeEnd are how far from a song's start and end
+	// its position is forgotten, so it plays from the start.
+	resumeStart = time.Second * 10
+	resumeEnd   = time.Second * 30
+)
+
+// resumes reports whether songs with info are resumed.
+func (srv *Server) resumes(info *codec.SongInfo) bool {
+	return srv.ResumeMin > 0 && info.Time >= srv.ResumeMin
+}
+
+// rememberPosition records the position of the current song if it's
+// resumed, and reports whether Positions changed. It should only be called
+// by the commands() function.
+func (srv *Server) rememberPosition() bool {
+	if srv.song == nil || !srv.resumes(&srv.info) {
+		return false
+	}
+	old, ok := srv.Positions[srv.songID]
+	if srv.elapsed < resumeStart || srv.info.Time-srv.elapsed < resumeEnd {
+		delete(srv.Positions, srv.songID)
+		return ok
+	}
+	if srv.Positions == nil {
+		srv.Positions = make(map[SongID]time.Duration)
+	}
+	srv.Positions[srv.songID] = srv.elapsed
+	return old != srv.elapsed
+}
+
+// resumePosition returns the position to resume the song id with info at,
+// or 0.
+func (srv *Server) resumePosition(id SongID, info *codec.SongInfo) time.Duration {
+	pos := srv.Positions[id]
+	if !srv.resumes(info) || pos >= info.Time {
+		return 0
+	}
+	return pos
+}
+
+// ResumePosition is the position a song resumes at.
+type ResumePosition struct {
+	ID       SongID
+	Info     *codec.SongInfo `json:",omitempty"`
+	Position time.Duration
+}
+
+type positionsData struct {
+	// ResumeMin is the length from which songs resume, or 0 if none do.
+	ResumeMin time.Duration
+	Positions []ResumePosition
+}
+
+// positions should only be called by the commands() function.
+func (srv *Server) positions() positionsData {
+	d := positionsData{
+		ResumeMin: srv.ResumeMin,
+		Positions: []ResumePosition{},
+	}
+	for id, pos := range srv.Positions {
+		info, _ := srv.getSong(id)
+		d.Positions = append(d.Positions, ResumePosition{
+			ID:       id,
+			Info:     info,
+			Position: pos,
+		})
+	}
+	sort.Slice(d.Positions, func(i, j int) bool {
+		return d.Positions[i].ID < d.Positions[j].ID
+	})
+	return d
+}
+
+// GetPositions returns the positions songs resume at.
+func (srv *Server) GetPositions(body io.Reader, form url.Values, ps httprouter.Params) (interface{}, error) {
+	ch := make(chan positionsData)
+	srv.ch <- cmdGetPositions(ch)
+	return <-ch, nil
+}
+
+// SetPosition sets the position the song id resumes at to pos.
+func (srv *Server) SetPosition(body io.Reader, form url.Values, ps httprouter.Params) (interface{}, error) {
+	id := SongID(form.Get("id"))
+	if id == "" {
+		return nil, fmt.Errorf("no id")
+	}
+	pos, err := time.ParseDuration(form.Get("pos"))
+	if err != nil {
+		return nil, err
+	}
+	if pos < 0 {
+		return nil, fmt.Errorf("negative position")
+	}
+	srv.ch <- cmdSetPosition{id, pos}
+	return nil, nil
+}
+
+// ClearPositions forgets the position of the song id, or of all songs if
+// id is empty, so they play from the start.
+func (srv *Server) ClearPositions(body io.Reader, form url.Values, ps httprouter.Params) (interface{}, error) {
+	srv.ch <- cmdClearPositions(form.Get("id"))
+	return nil, nil
+}
+
+type cmdGetPositions chan positionsData
+
+type cmdSetPosition struct {
+	id  SongID
+	pos time.Duration
+}
+
+type cmdClearPositions SongID
+
+type cmdResumeMin time.Duration
@@ -0,0 +1,131 @@
+package server
+
+import (
+	"net/http"
+	"strings"
+)
+
+// Role is a level of access to the API.
+type Role string
+
+const (
+	// RoleAdmin has full control, like the owner.
+	RoleAdmin Role = "admin"
+	// RoleUser controls playback and its own playlists, but not the
+	// sources, outputs, or users.
+	RoleUser Role = "user"
+	// RoleGuest can only view and enqueue songs, like guests in party mode.
+	RoleGuest Role = "guest"
+)
+
+func (role Role) valid() bool {
+	switch role {
+	case RoleAdmin, RoleUser, RoleGuest:
+		return true
+	}
+	return false
+}
+
+// userRoutes are the paths available to users, besides those available to
+// guests. All others, including routes not listed here when added, are only
+// available to admins. Routes ending in a slash match all paths below them.
+var userRoutes = []string{
+	"/api/data/",
+	"/api/queue/change",
+	"/api/playlist/change/",
+	"/api/cmd/play",
+	"/api/cmd/stop",
+	"/api/cmd/next",
+	"/api/cmd/prev",
+	"/api/cmd/pause",
+	"/api/cmd/play_idx",
+	"/api/cmd/play_track",
+	"/api/cmd/play_playlist",
+	"/api/cmd/insert_next",
+	"/api/cmd/append_album",
+	"/api/cmd/append_work",
+	"/api/cmd/random",
+	"/api/cmd/repeat",
+	"/api/cmd/radio",
+	"/api/cmd/consume",
+	"/api/cmd/seek",
+	"/api/cmd/loop",
+	"/api/cmd/volume",
+	"/api/cmd/preamp",
+	"/api/cmd/speed",
+	"/api/cmd/pitch",
+	"/api/cmd/trim_silence",
+	"/api/cmd/limiter",
+	"/api/cmd/stop_on_error",
+	"/api/cmd/discord",
+	"/api/cmd/crossfade",
+	"/api/cmd/preroll",
+	"/api/cmd/replaygain",
+	"/api/search",
+	"/api/eq",
+	"/api/stereo",
+	"/api/ha",
+	"/api/ha/state",
+	"/api/ha/browse",
+	"/api/ha/service/media_play",
+	"/api/ha/service/media_pause",
+	"/api/ha/service/media_play_pause",
+	"/api/ha/service/media_stop",
+	"/api/ha/service/media_next_track",
+	"/api/ha/service/media_previous_track",
+	"/api/ha/service/volume_set",
+	"/api/ha/service/media_seek",
+	"/api/ha/service/shuffle_set",
+	"/api/ha/service/repeat_set",
+	"/api/ha/service/play_media",
+	"/api/waveform/",
+	"/api/preview/",
+	"/api/duplicates",
+	"/api/library/health",
+	"/api/library/sync",
+	"/api/library/relocations",
+	"/api/classical/",
+	"/api/levels",
+	"/api/attributes/",
+	"/api/song/",
+	"/api/art/",
+	"/api/download/",
+	"/api/export",
+	"/api/recommendations",
+	"/api/stats/",
+	"/api/rating",
+	"/api/party/",
+	"/api/history",
+	"/api/cleanup",
+	"/api/positions",
+	"/api/positions/",
+	"/api/bookmarks",
+	"/api/bookmarks/",
+	"/api/instances",
+	"/api/handoff",
+}
+
+func userAllowed(r *http.Request) bool {
+	if guestAllowed(r) {
+		return true
+	}
+	for _, route := range userRoutes {
+		if r.URL.Path == route || strings.HasSuffix(route, "/") && strings.HasPrefix(r.URL.Path, route) {
+			return true
+		}
+	}
+	return false
+}
+
+// allowed reports whether role may make request r.
+func (role Role) allowed(r *http.Request) bool {
+	switch role {
+	case RoleAdmin:
+		return true
+	case RoleUser:
+		return userAllowed(r)
+	case RoleGuest:
+		return guestAllowed(r)
+	}
+	return false
+}
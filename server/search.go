@@ -0,0 +1,180 @@
+package server
+
+import (
+	"fmt"
+	"io"
+	"net/url"
+	"strconv"
+	"strings"
+
+	"github.com/julienschmidt/httprouter"
+	"github.com/mjibson/moggio/codec"
+)
+
+// Search returns songs whose title, artist, or album contain all words of
+// the q parameter. Words of the form bpm:128 or bpm:120-130 match songs by
+// tempo, key:Am by musical key, and year:1977, year:1970-1979, or
+// decade:80s by original release year, or release year if not reissued.
+// Without list options, at most 200 songs are returned.
+func (srv *Server) Search(body io.Reader, form url.Values, ps httprouter.Params) (interface{}, error) {
+	q, err := parseQuery(form.Get("q"))
+	if err != nil {
+		return nil, err
+	}
+	opts, err := parseListOptions(form)
+	if err != nil {
+		return nil, err
+	}
+	ch := make(chan []listItem)
+	srv.ch <- cmdSearch{
+		q:    q,
+		all:  opts != nil,
+		done: ch,
+	}
+	items := <-ch
+	if opts != nil {
+		return opts.page(items), nil
+	}
+	return items, nil
+}
+
+// search returns the songs matching q, at most 200 unless all is set. It
+// should only be called by the commands() function.
+func (srv *Server) search(q *query, all bool) []listItem {
+	const maxResults = 200
+	var items []listItem
+	for name, protos := range srv.Protocols {
+		for key, inst := range protos {
+			sl, _ := inst.List()
+			for id, info := range sl {
+				sid := SongID(codec.NewID(name, key, string(id)))
+				if srv.Hidden[sid] {
+					continue
+				}
+				info = srv.songInfo(sid, info)
+				if !q.match(info) || srv.Parental.hides(info) {
+					continue
+				}
+				items = append(items, listItem{
+					ID:   sid,
+					Info: info,
+				})
+				if len(items) >= maxResults && !all {
+					return items
+				}
+			}
+		}
+	}
+	return items
+}
+
+type query struct {
+	words []string
+	// minBPM and maxBPM, if set, bound the tempo.
+	minBPM, maxBPM float64
+	key            string
+	// minYear and maxYear, if set, bound the year.
+	minYear, maxYear int
+}
+
+func parseQuery(q string) (*query, error) {
+	var p query
+	for _, w := range strings.Fields(strings.ToLower(q)) {
+		switch {
+		case strings.HasPrefix(w, "bpm:"):
+			r := strings.SplitN(strings.TrimPrefix(w, "bpm:"), "-", 2)
+			lo, err := strconv.ParseFloat(r[0], 64)
+			if err != nil {
+				return nil, fmt.Errorf("bad bpm: %v", w)
+			}
+			hi := lo
+			if len(r) == 2 {
+				if hi, err = strconv.ParseFloat(r[1], 64); err != nil || hi < lo {
+					return nil, fmt.Errorf("bad bpm: %v", w)
+				}
+			} else {
+				lo, hi = lo-0.5, lo+0.5
+			}
+			p.minBPM, p.maxBPM = lo, hi
+		case strings.HasPrefix(w, "key:"):
+			p.key = strings.TrimPrefix(w, "key:")
+		case strings.HasPrefix(w, "year:"):
+			r := strings.SplitN(strings.TrimPrefix(w, "year:"), "-", 2)
+			lo, err := strconv.Atoi(r[0])
+			if err != nil || lo <= 0 {
+				return nil, fmt.Errorf("bad year: %v", w)
+			}
+			hi := lo
+			if len(r) == 2 {
+				if hi, err = strconv.Atoi(r[1]); err != nil || hi < lo {
+					return nil, fmt.Errorf("bad year: %v", w)
+				}
+			}
+			p.minYear, p.maxYear = lo, hi
+		case strings.HasPrefix(w, "decade:"):
+			lo, ok := parseDecade(strings.TrimPrefix(w, "decade:"))
+			if !ok {
+				return nil, fmt.Errorf("bad decade: %v", w)
+			}
+			p.minYear, p.maxYear = lo, lo+9
+		default:
+			p.words = append(p.words, w)
+		}
+	}
+	if len(p.words) == 0 && p.maxBPM == 0 && p.key == "" && p.maxYear == 0 {
+		return nil, fmt.Errorf("missing query")
+	}
+	return &p, nil
+}
+
+// parseDecade returns the first year of a decade like "1980s", "1980", or
+// "80s". Decades of two digits are of the 1900s from 30s to 90s, else of the
+// 2000s.
+func parseDecade(s string) (int, bool) {
+	s = strings.TrimSuffix(s, "s")
+	y, err := strconv.Atoi(s)
+	switch {
+	case err != nil || y%10 != 0:
+		return 0, false
+	case len(s) == 2 && y >= 30:
+		return 1900 + y, true
+	case len(s) == 2:
+		return 2000 + y, true
+	case len(s) == 4:
+		return y, true
+	}
+	return 0, false
+}
+
+// songYear returns the year of a song's first release.
+func songYear(info *codec.SongInfo) int {
+	if info.OriginalYear > 0 {
+		return info.OriginalYear
+	}
+	return info.Year
+}
+
+func (q *query) match(info *codec.SongInfo) bool {
+	if q.maxBPM > 0 && (info.BPM < q.minBPM || info.BPM > q.maxBPM) {
+		return false
+	}
+	if q.key != "" && strings.ToLower(info.Key) != q.key {
+		return false
+	}
+	if y := songYear(info); q.maxYear > 0 && (y < q.minYear || y > q.maxYear) {
+		return false
+	}
+	s := strings.ToLower(info.Title + " " + info.Artist + " " + info.Album)
+	for _, w := range q.words {
+		if !strings.Contains(s, w) {
+			return false
+		}
+	}
+	return true
+}
+
+type cmdSearch struct {
+	q    *query
+	all  bool
+	done chan<- []listItem
+}
@@ -5,11 +5,21 @@ import (
 	"time"
 )
 
+// songSeekAhead is how far past the decoded audio a seek must be to seek the
+// song instead of decoding up to the position.
+const songSeekAhead = time.Second * 10
+
 type Seek struct {
 	b   []float32
 	pos int
 	sr  time.Duration
 	f   func(int) ([]float32, error)
+	// base is the number of the sample b starts at, which is past the
+	// start after the song seeks. song seeks a song of channels channels,
+	// or is nil if it can't.
+	base     int
+	channels int
+	song     func(time.Duration) (time.Duration, error)
 }
 
 func NewSeek(canSeek bool, sr time.Duration, f func(int) ([]float32, error)) *Seek {
@@ -45,6 +55,13 @@ func (s *Seek) Read(n int) (b []float32, err error) {
 	return
 }
 
+// SeekSong makes s seek the song, which has ch channels, with f when seeking
+// far from the decoded audio.
+func (s *Seek) SeekSong(ch int, f func(time.Duration) (time.Duration, error)) {
+	s.channels = ch
+	s.song = f
+}
+
 var errSeekable = errors.New("cannot seek this file")
 
 // Seek sets the offset for the next Read to offset, relative to the origin
@@ -54,10 +71,22 @@ func (s *Seek) Seek(offset time.Duration) error {
 		return errSeekable
 	}
 	pos := int(offset / s.sr)
+	if s.song != nil && (pos < s.base || pos > s.base+len(s.b)+int(songSeekAhead/s.sr)) {
+		at, err := s.song(offset)
+		if err != nil {
+			return err
+		}
+		// Decoding continues from at up to the position.
+		base := int(at / s.sr)
+		base -= base % s.channels
+		s.b, s.base, s.pos = s.b[:0], base, 0
+	}
+	pos -= s.base
 	if pos < len(s.b) {
 		s.pos = pos
 		return nil
 	}
+	s.pos = len(s.b)
 	_, err := s.Read(pos - len(s.b))
 	if err != nil {
 		return err
@@ -67,5 +96,5 @@ func (s *Seek) Seek(offset time.Duration) error {
 }
 
 func (s *Seek) Pos() time.Duration {
-	return s.sr * time.Duration(s.pos)
+	return s.sr * time.Duration(s.base+s.pos)
 }
@@ -0,0 +1,120 @@
+package server
+
+import (
+	"bytes"
+	"fmt"
+	"net/http"
+	"os"
+	"strings"
+
+	"github.com/dhowden/tag"
+	"github.com/julienschmidt/httprouter"
+)
+
+// SongFile serves the file of a song, for clients that stream or cast it.
+// Only songs of protocols with local files can be served. Range requests
+// and conditional GETs are supported.
+func (srv *Server) SongFile(w http.ResponseWriter, r *http.Request, ps httprouter.Params) {
+	srv.serveFile(w, r, ps, false)
+}
+
+// artURL is the path ArtFile serves songs' artwork at, followed by their
+// escaped IDs.
+const artURL = "/api/art/"
+
+// ArtFile serves the artwork image of a song, like SongFile. Artwork
+// embedded in the song's tags is preferred to an image in its directory.
+// With the size parameter, a JPEG thumbnail at most size pixels wide and
+// high is served.
+func (srv *Server) ArtFile(w http.ResponseWriter, r *http.Request, ps httprouter.Params) {
+	if size := r.FormValue("size"); size != "" {
+		srv.serveThumbnail(w, r, ps, size)
+		return
+	}
+	if srv.serveEmbeddedArt(w, r, ps) {
+		return
+	}
+	srv.serveFile(w, r, ps, true)
+}
+
+// serveEmbeddedArt serves the picture in the tags of a song's file, read
+// when requested instead of held in memory, and reports whether it had one.
+func (srv *Server) serveEmbeddedArt(w http.ResponseWriter, r *http.Request, ps httprouter.Params) bool {
+	p, fi, ok := srv.embeddedArt(SongID(strings.TrimPrefix(ps.ByName("id"), "/")))
+	if !ok {
+		return false
+	}
+	w.Header().Set("Content-Type", p.MIMEType)
+	w.Header().Set("ETag", fileETag(fi))
+	w.Header().Set("Cache-Control", "no-cache")
+	http.ServeContent(w, r, "", fi.ModTime(), bytes.NewReader(p.Data))
+	return true
+}
+
+// embeddedArt returns the picture in the tags of the file of id, and the
+// file's info.
+func (srv *Server) embeddedArt(id SongID) (*tag.Picture, os.FileInfo, bool) {
+	ch := make(chan fileResult)
+	srv.ch <- cmdOpenFile{
+		id:   id,
+		done: ch,
+	}
+	res := <-ch
+	if res.err != nil {
+		return nil, nil, false
+	}
+	f := res.f
+	defer f.Close()
+	fi, err := f.Stat()
+	if err != nil {
+		return nil, nil, false
+	}
+	m, err := tag.ReadFrom(f)
+	if err != nil || m.Picture() == nil || m.Picture().MIMEType == "-->" {
+		return nil, nil, false
+	}
+	return m.Picture(), fi, true
+}
+
+func fileETag(fi os.FileInfo) string {
+	return fmt.Sprintf(`"%x-%x"`, fi.ModTime().UnixNano(), fi.Size())
+}
+
+func (srv *Server) serveFile(w http.ResponseWriter, r *http.Request, ps httprouter.Params, art bool) {
+	ch := make(chan fileResult)
+	srv.ch <- cmdOpenFile{
+		id:   SongID(strings.TrimPrefix(ps.ByName("id"), "/")),
+		art:  art,
+		done: ch,
+	}
+	res := <-ch
+	if os.IsNotExist(res.err) {
+		http.NotFound(w, r)
+		return
+	} else if res.err != nil {
+		serveError(w, res.err)
+		return
+	}
+	f := res.f
+	defer f.Close()
+	fi, err := f.Stat()
+	if err != nil {
+		serveError(w, err)
+		return
+	}
+	// ServeContent handles If-None-Match and If-Range with this.
+	w.Header().Set("ETag", fileETag(fi))
+	w.Header().Set("Cache-Control", "no-cache")
+	http.ServeContent(w, r, fi.Name(), fi.ModTime(), f)
+}
+
+type fileResult struct {
+	f   *os.File
+	err error
+}
+
+type cmdOpenFile struct {
+	id   SongID
+	art  bool
+	done chan fileResult
+}
@@ -3,9 +3,8 @@ package server
 
 import (
 	"bytes"
-	"compress/gzip"
+	"context"
 	crand "crypto/rand"
-	"encoding/gob"
 	"encoding/json"
 	"fmt"
 	"io"
@@ -20,10 +19,13 @@ import (
 	"runtime"
 	"strconv"
 	"strings"
+	"sync"
 	"time"
 
 	"github.com/boltdb/bolt"
 	"github.com/mjibson/moggio/codec"
+	"github.com/mjibson/moggio/dsp"
+	"github.com/mjibson/moggio/output"
 	"github.com/mjibson/moggio/protocol"
 	"github.com/pkg/browser"
 )
@@ -53,7 +55,11 @@ func ListenAndServe(stateFile, addr, central string, devMode bool) error {
 		if strings.HasPrefix(host, ":") {
 			host = "localhost" + host
 		}
-		err := browser.OpenURL("http://" + host + "/")
+		u := "http://" + host + "/"
+		if OwnerToken != "" {
+			u += "?auth=" + url.QueryEscape(OwnerToken)
+		}
+		err := browser.OpenURL(u)
 		if err != nil {
 			log.Println(err)
 		}
@@ -127,6 +133,41 @@ func (s SongID) Triple() (protocol, key string, id codec.ID) {
 type Server struct {
 	Queue     Playlist
 	Playlists map[string]Playlist
+	// Users are the profiles other than the owner's, by name.
+	Users map[string]*User
+	// Hidden are the songs merged into duplicates or removed from the
+	// library, which aren't listed. DuplicatePriority are the preferred
+	// formats, by file extension, of songs kept when merging duplicates.
+	Hidden            map[SongID]bool
+	DuplicatePriority []string
+	// Vanished are the songs in playlists that were no longer listed after
+	// a refresh, with their last info, until they are relocated or
+	// dismissed.
+	Vanished map[SongID]*codec.SongInfo
+	// Stats are the play counts and ratings of songs.
+	Stats map[SongID]*SongStats
+	// Import are the settings of importing songs from an incoming
+	// directory into the library.
+	Import Import
+	// Cache are the limits of the disk cache.
+	Cache CacheSettings
+	// TagRules normalize the tags of songs as they are listed.
+	TagRules []TagRule
+	// Parental refuses to queue, or hides, unsuitable songs.
+	Parental ParentalFilter
+	// Announce speaks announcements of songs over their start.
+	Announce Announcer
+	// SyncJobs mirror folders of cloud sources to local directories.
+	SyncJobs []SyncJob
+	// Record are the settings of recording internet radio streams.
+	Record Recordings
+	// Bluetooth is the selected Bluetooth speaker.
+	Bluetooth Bluetooth
+	// Input are the settings of keyboards and remotes attached to the
+	// server.
+	Input Input
+	// GPIO are the settings of buttons and relays wired to the server.
+	GPIO GPIO
 
 	Username string
 	Token    string
@@ -135,27 +176,168 @@ type Server struct {
 	Random      bool
 	Protocols   map[string]map[string]protocol.Instance
 	MinDuration time.Duration
+	Party       Party
+	// RefreshInterval is the automatic refresh interval of protocol
+	// instances by protocol and key. PauseMeteredRefresh pauses automatic
+	// refresh of remote protocols while playing over a metered connection.
+	RefreshInterval     map[string]map[string]time.Duration
+	PauseMeteredRefresh bool
+
+	// Radio continues playing similar songs from the library when the
+	// queue ends. History is the recently played songs.
+	Radio   bool
+	History []SongID
+	// Cleanup are the policies removing played songs from the queue and
+	// History.
+	Cleanup Cleanup
+	// VerifyPlayback checks songs played to their end against the checksums
+	// their formats record, reporting mismatches in the library health
+	// report.
+	VerifyPlayback bool
+	// StopOnError stops playback at songs that fail to open or decode,
+	// instead of skipping them.
+	StopOnError bool
+	// Songs at least ResumeMin long resume at their Positions, where they
+	// were last stopped or paused; 0 disables resuming.
+	ResumeMin time.Duration
+	Positions map[SongID]time.Duration
+	// Bookmarks are the owner's named positions in songs.
+	Bookmarks map[SongID][]Bookmark
+
+	// Volume is the linear output volume in [0, 1] of output devices
+	// without their own in Volumes, by output device name; "" is the
+	// default device. Preamp is a gain in dB applied to all songs, in
+	// addition to ReplayGain if enabled.
+	Volume     float64
+	Volumes    map[string]float64
+	Preamp     float64
+	ReplayGain string
+	EQ         dsp.EQ
+	// Plugins are the LADSPA plugin chains applied last, before output, by
+	// output device name; "" is the default device.
+	Plugins map[string][]dsp.Plugin
+	// Stereo are the crossfeed, mono, and balance settings by output device
+	// name; "" is the default device.
+	Stereo map[string]dsp.Stereo
+	// Hooks are run on playback events.
+	Hooks []Hook
+	// Webhooks are posted playback events, by ID.
+	Webhooks map[string]Webhook
+	// Speed is the playback speed factor. Pitch is the pitch shift in
+	// semitones.
+	Speed float64
+	Pitch float64
+	// TrimSilence skips silence at the start and end of songs. Crossfade is
+	// the overlap between songs that don't already flow into each other.
+	TrimSilence bool
+	Crossfade   time.Duration
+	// Limiter limits the peaks of the audio played, so gain from the
+	// preamp, ReplayGain, or EQ doesn't make it clip.
+	Limiter bool
+	// OutputRate, if not 0, is the sample rate songs are resampled to with
+	// the Resampler quality.
+	OutputRate int
+	Resampler  dsp.Quality
+	// BitPerfect sends songs to the device unprocessed at their native
+	// format, bypassing all of the above.
+	BitPerfect bool
+	// Backend is the output backend name, or empty for output.Default.
+	// Latency is the buffer latency requested of each backend; backends
+	// without an entry use their own default.
+	Backend string
+	Latency map[string]time.Duration
+	// Device is the output device name, or empty for the default.
+	Device string
+	// Preroll is the audio decoded ahead of playback, or 0 for
+	// defaultPreroll.
+	Preroll time.Duration
+	// DiscordPresence publishes the playing song to Discord Rich Presence,
+	// if DiscordClientID is set.
+	DiscordPresence bool
+	// CodecOptions are the values of codec playback options, by the name
+	// they're registered with.
+	CodecOptions map[string]map[string]float64
 
 	// Current song data.
 	PlaylistIndex int
 	songID        SongID
 	song          codec.Song
 	info          codec.SongInfo
+	sampleRate    int
 	elapsed       time.Duration
 
-	centralURL  string
-	inprogress  map[codec.ID]bool
+	centralURL string
+	inprogress map[codec.ID]bool
+	// ctx is canceled at shutdown, ending reads in progress.
+	ctx         context.Context
+	shutdown    context.CancelFunc
+	playing     songContext
+	hooks       chan hookRun
+	webhooks    chan webhookDelivery
+	discord     chan *discordActivity
+	relay       chan bool
+	connections map[codec.ID]Connection
+	nextRefresh map[codec.ID]time.Time
 	ch          chan interface{}
 	audioch     chan interface{}
 	state       State
 	db          *bolt.DB
+	dbMu        sync.RWMutex
+	saves       chan stateSnapshot
 	savePending bool
+	guests      guests
+	tokens      userTokens
+	mobile      mobileSync
+	librarySync librarySync
+	listener    string
+	vis         visualizer
+	analyses    analyses
+	analysis    map[SongID]Analysis
+	measures    analyses
+	durations   map[SongID]time.Duration
+	health      health
+	// loudness are the loudnesses found by scans, which set the songs'
+	// ReplayGain.
+	loudness     map[SongID]Loudness
+	loudnessScan loudnessScan
+	imports      imports
+	syncs        syncs
+	records      records
+	renders      renders
+	cache        *diskCache
+	tagRules     tagRules
+	renderer     renderer
+	buffered     bufferLevel
+	levels       levels
+	skipVotes    map[string]bool
+	loop         *Loop
+
+	// durationsPending is set while measured durations and scanned
+	// loudness wait to be sent to clients.
+	durationsPending bool
+
+	// probed is the queued song last probed before its turn to play, and
+	// unreachable the songs that failed, which are skipped.
+	probed      SongID
+	unreachable map[SongID]error
+
+	// preopened is the next song, opened ahead of its turn.
+	preopened *preopened
+	// announcement is the speech announcing the next song.
+	announcement *announcement
+
+	// changedInstances are the protocol instances changed since the last
+	// snapshot, and savedInstances when all were last encoded.
+	changedInstances map[codec.ID]bool
+	savedInstances   time.Time
 }
 
+// removeDeleted returns p without the songs that are no longer listed,
+// except vanished ones, which may yet be relocated.
 func (srv *Server) removeDeleted(p Playlist) Playlist {
 	var r Playlist
 	for _, id := range p {
-		if !srv.hasSong(id) {
+		if !srv.hasSong(id) && srv.Vanished[id] == nil || srv.Hidden[id] {
 			continue
 		}
 		r = append(r, id)
@@ -169,6 +351,9 @@ func (srv *Server) playlistInfo(p Playlist) PlaylistInfo {
 	r := make(PlaylistInfo, len(p))
 	for idx, id := range p {
 		info, _ := srv.getSong(id)
+		if info == nil {
+			info = srv.Vanished[id]
+		}
 		r[idx] = listItem{
 			ID:   id,
 			Info: info,
@@ -185,96 +370,106 @@ func New(stateFile, central string) (*Server, error) {
 		audioch:     make(chan interface{}),
 		Protocols:   protocol.Map(),
 		Playlists:   make(map[string]Playlist),
+		Users:       make(map[string]*User),
+		Hidden:      make(map[SongID]bool),
 		MinDuration: time.Second * 30,
+		ResumeMin:   defaultResumeMin,
 		centralURL:  central,
 		inprogress:  make(map[codec.ID]bool),
+		nextRefresh: make(map[codec.ID]time.Time),
+		saves:       make(chan stateSnapshot, 1),
 	}
-	db, err := bolt.Open(stateFile, 0600, nil)
-	if err != nil {
-		return nil, err
-	}
-	srv.db = db
-	if err := srv.restore(); err != nil {
+	srv.ctx, srv.shutdown = context.WithCancel(context.Background())
+	srv.hooks = make(chan hookRun, hookQueue)
+	srv.webhooks = make(chan webhookDelivery, hookQueue)
+	srv.discord = make(chan *discordActivity, 1)
+	srv.relay = make(chan bool, 1)
+	if err := srv.openDB(stateFile); err != nil {
+		if srv.db == nil {
+			return nil, err
+		}
 		log.Println(err)
 	}
-	log.Println("started from", stateFile)
-	go srv.commands()
-	go srv.audio()
-	return &srv, nil
-}
-
-const (
-	dbBucket = "bucket"
-	dbServer = "server"
-)
-
-func (srv *Server) restore() error {
-	decode := func(name string, dst interface{}) error {
-		var data []byte
-		err := srv.db.View(func(tx *bolt.Tx) error {
-			b := tx.Bucket([]byte(dbBucket))
-			if b == nil {
-				return fmt.Errorf("unknown bucket: %v", dbBucket)
-			}
-			data = b.Get([]byte(name))
-			return nil
-		})
-		if err != nil {
-			return err
-		}
-		gr, err := gzip.NewReader(bytes.NewReader(data))
-		if err != nil {
-			return err
-		}
-		defer gr.Close()
-		return gob.NewDecoder(gr).Decode(dst)
+	if srv.ReplayGain == "" {
+		srv.Volume = 1
+		srv.ReplayGain = replayGainOff
 	}
-	if err := decode(dbServer, srv); err != nil {
-		return err
+	if srv.Resampler == "" {
+		srv.Resampler = dsp.QualityMedium
 	}
-	return nil
-}
-
-func (srv *Server) save() error {
-	defer func() {
-		srv.savePending = false
-	}()
-	store := map[string]interface{}{
-		dbServer: srv,
+	if srv.Speed == 0 {
+		srv.Speed = 1
 	}
-	tostore := make(map[string][]byte)
-	for name, data := range store {
-		f := new(bytes.Buffer)
-		gz := gzip.NewWriter(f)
-		enc := gob.NewEncoder(gz)
-		if err := enc.Encode(data); err != nil {
-			return err
+	if n := output.Name(srv.Backend); n != srv.Backend {
+		if l, ok := srv.Latency[srv.Backend]; ok {
+			delete(srv.Latency, srv.Backend)
+			srv.Latency[n] = l
 		}
-		if err := gz.Flush(); err != nil {
-			return err
-		}
-		if err := gz.Close(); err != nil {
-			return err
-		}
-		tostore[name] = f.Bytes()
+		srv.Backend = n
 	}
-	err := srv.db.Update(func(tx *bolt.Tx) error {
-		b, err := tx.CreateBucketIfNotExists([]byte(dbBucket))
-		if err != nil {
-			return err
-		}
-		for name, data := range tostore {
-			if err := b.Put([]byte(name), data); err != nil {
-				return err
-			}
+	if b := output.Name(OutputBackend); b != "" && b != srv.Backend {
+		srv.Backend = b
+		srv.Device = ""
+	}
+	if srv.Backend == "" || checkBackend(srv.Backend) != nil {
+		srv.Backend = output.Default
+	}
+	// Plugins were saved by path before they were only loaded from the
+	// LADSPA directories.
+	for _, chain := range srv.Plugins {
+		for i, p := range chain {
+			chain[i].Path = filepath.Base(p.Path)
 		}
-		return nil
-	})
+	}
+	if srv.Party.SkipVotes == 0 {
+		srv.Party = defaultParty
+	}
+	srv.guests.set(srv.Party)
+	srv.tokens.set(srv.Users)
+	srv.applyCodecOptions()
+	srv.cache = newDiskCache(cacheDir(stateFile))
+	srv.cache.setSettings(srv.Cache)
+	if err := srv.moveWaveforms(); err != nil {
+		log.Println(err)
+	}
+	srv.applyTagRules()
+	analysis, err := srv.loadAnalyses()
 	if err != nil {
-		return err
+		log.Println(err)
+	}
+	srv.analysis = analysis
+	srv.analyses.wake = make(chan struct{}, 1)
+	durations, err := srv.loadDurations()
+	if err != nil {
+		log.Println(err)
+	}
+	srv.durations = durations
+	srv.measures.wake = make(chan struct{}, 1)
+	loudness, err := srv.loadLoudness()
+	if err != nil {
+		log.Println(err)
 	}
-	log.Println("save to db complete")
-	return nil
+	srv.loudness = loudness
+	srv.librarySync.open(&srv, "library")
+	srv.mobile.open(&srv, "mobile")
+	log.Println("started from", stateFile)
+	go srv.commands()
+	go srv.audio()
+	go srv.vis.run()
+	go srv.analyzeSongs()
+	go srv.measureSongs()
+	go srv.runHooks()
+	go srv.sendWebhooks()
+	go srv.runDiscord()
+	go srv.watchImports()
+	go srv.watchSyncs()
+	go srv.watchRecordings()
+	go srv.watchBluetooth()
+	go srv.watchInput()
+	go srv.watchGPIO()
+	go srv.saveState()
+	go srv.compactDB()
+	return &srv, nil
 }
 
 func (srv *Server) getInstance(name, key string) (protocol.Instance, error) {
@@ -334,6 +529,11 @@ type listItem struct {
 	Info *codec.SongInfo
 }
 
+// Loop is a section of a song played repeatedly, from A to B.
+type Loop struct {
+	A, B time.Duration
+}
+
 type Status struct {
 	// Playback state
 	State State
@@ -343,12 +543,61 @@ type Status struct {
 	// Elapsed time of current song.
 	Elapsed time.Duration
 	// Duration of current song.
-	Time       time.Duration
-	Random     bool
-	Repeat     bool
+	Time time.Duration
+	// Remaining is the wall clock time left in the current song at the
+	// current playback speed.
+	Remaining time.Duration
+	// Loop is the section of the current song repeated, if any.
+	Loop   *Loop `json:",omitempty"`
+	Random bool
+	Repeat bool
+	Radio  bool
+	// Consume is whether songs are removed from the queue once played.
+	Consume    bool
 	Username   string
 	Hostname   string
 	CentralURL string
+	// Party is whether party mode is enabled.
+	Party bool
+	// SkipVotes is the number of guest votes to skip the current song, and
+	// SkipThreshold the number needed.
+	SkipVotes     int
+	SkipThreshold int
+	Volume        float64
+	Preamp        float64
+	ReplayGain    string
+	Speed         float64
+	Pitch         float64
+	TrimSilence   bool
+	Crossfade     time.Duration
+	Limiter       bool
+	// Levels are the peak levels of the current song, and whether it
+	// clipped.
+	Levels *LevelReport `json:",omitempty"`
+	// SampleRate is the current song's sample rate and OutputRate the rate
+	// sent to the audio device. Resampler is the conversion quality used if
+	// they differ.
+	SampleRate int
+	OutputRate int
+	Resampler  dsp.Quality `json:",omitempty"`
+	BitPerfect bool
+	Backend    string
+	Latency    time.Duration
+	Device     string
+	// Underruns counts the times the output ran out of samples.
+	Underruns uint64
+	// Preroll is the audio decoded ahead of playback and Buffered the
+	// amount currently decoded.
+	Preroll  time.Duration
+	Buffered time.Duration
+	// DiscordPresence is whether Discord Rich Presence is on.
+	DiscordPresence bool
+	// VerifyPlayback is whether played songs are verified against their
+	// checksums.
+	VerifyPlayback bool
+	// StopOnError is whether playback stops at songs that fail to open or
+	// decode, instead of skipping them.
+	StopOnError bool
 }
 
 func (srv *Server) request(path string, body interface{}) (io.ReadCloser, error) {
@@ -389,7 +638,11 @@ func (srv *Server) getSong(id SongID) (*codec.SongInfo, error) {
 	if !ok {
 		return nil, fmt.Errorf("unknown instance: %s", key)
 	}
-	return inst.Info(cid)
+	info, err := inst.Info(cid)
+	if err != nil {
+		return nil, err
+	}
+	return srv.songInfo(id, info), nil
 }
 
 func (srv *Server) hasSong(id SongID) bool {
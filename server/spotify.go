@@ -0,0 +1,200 @@
+package server
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"strings"
+	"time"
+
+	"github.com/julienschmidt/httprouter"
+)
+
+// SpotifyClientID and SpotifyClientSecret are the Spotify Web API
+// credentials used to import playlists.
+var SpotifyClientID, SpotifyClientSecret string
+
+const (
+	spotifyTokenURL = "https://accounts.spotify.com/api/token"
+	spotifyAPI      = "https://api.spotify.com/v1/"
+)
+
+type importedTrack struct {
+	Artists []string
+	Title   string
+	Album   string
+	Time    time.Duration
+}
+
+type importReport struct {
+	Playlist  string
+	Matched   int
+	Unmatched []importedTrack
+}
+
+// SpotifyImport imports the Spotify playlist given by the playlist
+// parameter, a URL, URI, or ID, as a playlist of the matching songs in the
+// library, named by the name parameter or the Spotify playlist name. The
+// tracks not found in the library are reported.
+func (srv *Server) SpotifyImport(body io.Reader, form url.Values, ps httprouter.Params) (interface{}, error) {
+	if SpotifyClientID == "" {
+		return nil, fmt.Errorf("no Spotify API credentials")
+	}
+	id := spotifyPlaylistID(form.Get("playlist"))
+	if id == "" {
+		return nil, fmt.Errorf("missing playlist")
+	}
+	token, err := spotifyToken()
+	if err != nil {
+		return nil, err
+	}
+	var pl struct {
+		Name string
+	}
+	if err := spotifyGet(token, spotifyAPI+"playlists/"+id+"?fields=name", &pl); err != nil {
+		return nil, err
+	}
+	name := form.Get("name")
+	if name == "" {
+		name = pl.Name
+	}
+	var tracks []importedTrack
+	next := spotifyAPI + "playlists/" + id + "/tracks?limit=100&fields=next,items(track(name,duration_ms,album(name),artists(name)))"
+	for next != "" {
+		var page struct {
+			Next  string
+			Items []struct {
+				Track *struct {
+					Name       string
+					DurationMS int64 `json:"duration_ms"`
+					Album      struct {
+						Name string
+					}
+					Artists []struct {
+						Name string
+					}
+				}
+			}
+		}
+		if err := spotifyGet(token, next, &page); err != nil {
+			return nil, err
+		}
+		for _, it := range page.Items {
+			// Removed tracks are null.
+			if it.Track == nil {
+				continue
+			}
+			t := importedTrack{
+				Title: it.Track.Name,
+				Album: it.Track.Album.Name,
+				Time:  time.Duration(it.Track.DurationMS) * time.Millisecond,
+			}
+			for _, a := range it.Track.Artists {
+				t.Artists = append(t.Artists, a.Name)
+			}
+			tracks = append(tracks, t)
+		}
+		next = page.Next
+	}
+	srv.audit(ps, "import", name)
+	ch := make(chan importReport)
+	srv.ch <- cmdImportPlaylist{
+		user:   ps.ByName(paramUser),
+		name:   name,
+		tracks: tracks,
+		done:   ch,
+	}
+	return <-ch, nil
+}
+
+// spotifyPlaylistID returns the playlist ID of s, which may be a URL like
+// https://open.spotify.com/playlist/ID, a URI like spotify:playlist:ID, or an
+// ID.
+func spotifyPlaylistID(s string) string {
+	s = strings.TrimSpace(s)
+	if u, err := url.Parse(s); err == nil && u.Host != "" {
+		s = strings.TrimPrefix(u.Path, "/")
+		if i := strings.LastIndex(s, "playlist/"); i >= 0 {
+			s = s[i+len("playlist/"):]
+		}
+		return strings.Trim(s, "/")
+	}
+	return strings.TrimPrefix(s, "spotify:playlist:")
+}
+
+// spotifyToken gets an access token with the client credentials flow, which
+// allows reading public playlists.
+func spotifyToken() (string, error) {
+	req, err := http.NewRequest("POST", spotifyTokenURL, strings.NewReader(url.Values{
+		"grant_type": {"client_credentials"},
+	}.Encode()))
+	if err != nil {
+		return "", err
+	}
+	req.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+	req.SetBasicAuth(SpotifyClientID, SpotifyClientSecret)
+	resp, err := http.DefaultClient.Do(req)
+	if err != nil {
+		return "", err
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode != http.StatusOK {
+		return "", fmt.Errorf("spotify token: %s", resp.Status)
+	}
+	var t struct {
+		AccessToken string `json:"access_token"`
+	}
+	if err := json.NewDecoder(resp.Body).Decode(&t); err != nil {
+		return "", err
+	}
+	return t.AccessToken, nil
+}
+
+func spotifyGet(token, u string, dst interface{}) error {
+	req, err := http.NewRequest("GET", u, nil)
+	if err != nil {
+		return err
+	}
+	req.Header.Set("Authorization", "Bearer "+token)
+	resp, err := http.DefaultClient.Do(req)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode != http.StatusOK {
+		return fmt.Errorf("spotify: %s", resp.Status)
+	}
+	return json.NewDecoder(resp.Body).Decode(dst)
+}
+
+// importPlaylist saves the songs in the library matching tracks as a
+// playlist in playlists. It should only be called by the commands() function.
+func (srv *Server) importPlaylist(playlists map[string]Playlist, name string, tracks []importedTrack) importReport {
+	idx := srv.newTrackIndex()
+	r := importReport{
+		Playlist:  name,
+		Unmatched: []importedTrack{},
+	}
+	var p Playlist
+	for _, t := range tracks {
+		if id, ok := idx.match(t.Artists, t.Title, t.Time); ok {
+			p = append(p, id)
+		} else {
+			r.Unmatched = append(r.Unmatched, t)
+		}
+	}
+	r.Matched = len(p)
+	if len(p) > 0 {
+		playlists[name] = p
+	}
+	return r
+}
+
+type cmdImportPlaylist struct {
+	user   string
+	name   string
+	tracks []importedTrack
+	done   chan importReport
+}
@@ -0,0 +1,139 @@
+package server
+
+import (
+	"bufio"
+	"bytes"
+	"crypto/md5"
+	"fmt"
+	"log"
+	"net"
+	"net/http"
+	"os"
+	"strings"
+	"time"
+)
+
+const (
+	ssdpAddr = "239.255.255.250:1900"
+	// ssdpMaxAge is how long advertisements are valid, and ssdpInterval how
+	// often they are repeated.
+	ssdpMaxAge   = 1800
+	ssdpInterval = time.Minute * 10
+	ssdpServer   = "Linux/1.0 UPnP/1.0 moggio/1.0"
+)
+
+// upnpDevice is a UPnP device advertised over SSDP.
+type upnpDevice struct {
+	uuid string
+	// typ is the device type URN and services the service type URNs.
+	typ      string
+	services []string
+	// path is the path of the device description.
+	path string
+}
+
+// upnpUUID returns a UUID for the device of kind on this host, which is
+// the same each run so controllers remember it.
+func upnpUUID(kind string) string {
+	hostname, _ := os.Hostname()
+	h := md5.Sum([]byte("moggio\x00" + hostname + "\x00" + kind))
+	return fmt.Sprintf("%x-%x-%x-%x-%x", h[0:4], h[4:6], h[6:8], h[8:10], h[10:16])
+}
+
+// targets are the search targets d is found by.
+func (d upnpDevice) targets() []string {
+	return append([]string{"upnp:rootdevice", "uuid:" + d.uuid, d.typ}, d.services...)
+}
+
+func (d upnpDevice) usn(target string) string {
+	if strings.HasPrefix(target, "uuid:") {
+		return target
+	}
+	return "uuid:" + d.uuid + "::" + target
+}
+
+// serveSSDP advertises devices, described by the HTTP server on port, and
+// answers searches for them.
+func serveSSDP(port string, devices []upnpDevice) {
+	group, err := net.ResolveUDPAddr("udp4", ssdpAddr)
+	if err != nil {
+		log.Println("ssdp:", err)
+		return
+	}
+	conn, err := net.ListenMulticastUDP("udp4", nil, group)
+	if err != nil {
+		log.Println("ssdp:", err)
+		return
+	}
+	go func() {
+		for {
+			notifySSDP(conn, group, port, devices)
+			time.Sleep(ssdpInterval)
+		}
+	}()
+	buf := make([]byte, 2048)
+	for {
+		n, src, err := conn.ReadFromUDP(buf)
+		if err != nil {
+			log.Println("ssdp:", err)
+			return
+		}
+		req, err := http.ReadRequest(bufio.NewReader(bytes.NewReader(buf[:n])))
+		if err != nil || req.Method != "M-SEARCH" || req.Header.Get("Man") != `"ssdp:discover"` {
+			continue
+		}
+		st := req.Header.Get("St")
+		ip := localIP(src.String())
+		for _, d := range devices {
+			for _, t := range d.targets() {
+				if st != "ssdp:all" && st != t {
+					continue
+				}
+				msg := fmt.Sprintf("HTTP/1.1 200 OK\r\n"+
+					"CACHE-CONTROL: max-age=%d\r\n"+
+					"DATE: %s\r\n"+
+					"EXT:\r\n"+
+					"LOCATION: http://%s%s\r\n"+
+					"SERVER: %s\r\n"+
+					"ST: %s\r\n"+
+					"USN: %s\r\n\r\n",
+					ssdpMaxAge, time.Now().UTC().Format(http.TimeFormat),
+					net.JoinHostPort(ip, port), d.path, ssdpServer, t, d.usn(t))
+				conn.WriteToUDP([]byte(msg), src)
+			}
+		}
+	}
+}
+
+// notifySSDP announces devices to the multicast group.
+func notifySSDP(conn *net.UDPConn, group *net.UDPAddr, port string, devices []upnpDevice) {
+	ip := localIP(ssdpAddr)
+	for _, d := range devices {
+		for _, t := range d.targets() {
+			msg := fmt.Sprintf("NOTIFY * HTTP/1.1\r\n"+
+				"HOST: %s\r\n"+
+				"CACHE-CONTROL: max-age=%d\r\n"+
+				"LOCATION: http://%s%s\r\n"+
+				"NT: %s\r\n"+
+				"NTS: ssdp:alive\r\n"+
+				"SERVER: %s\r\n"+
+				"USN: %s\r\n\r\n",
+				ssdpAddr, ssdpMaxAge, net.JoinHostPort(ip, port), d.path, t, ssdpServer, d.usn(t))
+			if _, err := conn.WriteToUDP([]byte(msg), group); err != nil {
+				log.Println("ssdp notify:", err)
+				return
+			}
+		}
+	}
+}
+
+// localIP returns the address of the interface used to reach addr.
+func localIP(addr string) string {
+	c, err := net.Dial("udp4", addr)
+	if err != nil {
+		return "127.0.0.1"
+	}
+	defer c.Close()
+	host, _, _ := net.SplitHostPort(c.LocalAddr().String())
+	return host
+}
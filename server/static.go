@@ -2,7097 +2,179 @@ package server
 
 import (
 	"bytes"
-	"compress/gzip"
-	"encoding/base64"
+	"crypto/sha256"
+	"embed"
+	"encoding/hex"
+	"encoding/json"
 	"io/ioutil"
 	"net/http"
-	"os"
 	"path"
-	"sync"
-	"time"
+	"sort"
+	"strings"
+	"text/template"
 )
 
-type _escLocalFS struct{}
+// staticFiles are the web UI's assets. The JSX in static/src is compiled
+// into static/js/moggio.js and isn't served.
+//
+//go:embed static/index.html static/sw.js static/manifest.json static/css static/fonts static/img static/js
+var staticFiles embed.FS
 
-var _escLocal _escLocalFS
-
-type _escStaticFS struct{}
-
-var _escStatic _escStaticFS
-
-type _escDirectory struct {
-	fs   http.FileSystem
-	name string
-}
-
-type _escFile struct {
-	compressed string
-	size       int64
-	modtime    int64
-	local      string
-	isDir      bool
-
-	data []byte
-	once sync.Once
-	name string
+// FS returns the web UI's assets, named like /static/index.html. With
+// useLocal, they're read from server/static, so they can be edited without
+// rebuilding.
+func FS(useLocal bool) http.FileSystem {
+	if useLocal {
+		return http.Dir("server")
+	}
+	return http.FS(staticFiles)
 }
 
-func (_escLocalFS) Open(name string) (http.File, error) {
-	f, present := _escData[path.Clean(name)]
-	if !present {
-		return nil, os.ErrNotExist
-	}
-	return os.Open(f.local)
+// assets serves the web UI's assets. Embedded assets are versioned by their
+// content: URLs from url have a v parameter with their hash and are cached
+// forever, since a different build has different URLs. Other requests must
+// be revalidated with the hash as ETag.
+type assets struct {
+	fs http.FileSystem
+	// hashes are the hashes of the assets by name, and version the hash of
+	// all of them; nil and empty for local assets, which may change.
+	hashes  map[string]string
+	version string
 }
 
-func (_escStaticFS) prepare(name string) (*_escFile, error) {
-	f, present := _escData[path.Clean(name)]
-	if !present {
-		return nil, os.ErrNotExist
+// newAssets returns the assets of fs, hashing them unless useLocal is set.
+func newAssets(fs http.FileSystem, useLocal bool) (*assets, error) {
+	a := &assets{fs: fs}
+	if useLocal {
+		return a, nil
 	}
-	var err error
-	f.once.Do(func() {
-		f.name = path.Base(name)
-		if f.size == 0 {
-			return
+	a.hashes = make(map[string]string)
+	all := sha256.New()
+	var walk func(name string) error
+	walk = func(name string) error {
+		f, err := fs.Open(name)
+		if err != nil {
+			return err
 		}
-		var gr *gzip.Reader
-		b64 := base64.NewDecoder(base64.StdEncoding, bytes.NewBufferString(f.compressed))
-		gr, err = gzip.NewReader(b64)
+		defer f.Close()
+		fi, err := f.Stat()
 		if err != nil {
-			return
+			return err
 		}
-		f.data, err = ioutil.ReadAll(gr)
-	})
-	if err != nil {
-		return nil, err
+		if fi.IsDir() {
+			infos, err := f.Readdir(-1)
+			if err != nil {
+				return err
+			}
+			for _, fi := range infos {
+				if err := walk(path.Join(name, fi.Name())); err != nil {
+					return err
+				}
+			}
+			return nil
+		}
+		b, err := ioutil.ReadAll(f)
+		if err != nil {
+			return err
+		}
+		h := sha256.Sum256(b)
+		a.hashes[name] = hex.EncodeToString(h[:6])
+		all.Write([]byte(name))
+		all.Write(h[:])
+		return nil
 	}
-	return f, nil
-}
-
-func (fs _escStaticFS) Open(name string) (http.File, error) {
-	f, err := fs.prepare(name)
-	if err != nil {
+	if err := walk("/static"); err != nil {
 		return nil, err
 	}
-	return f.File()
-}
-
-func (dir _escDirectory) Open(name string) (http.File, error) {
-	return dir.fs.Open(dir.name + name)
+	a.version = hex.EncodeToString(all.Sum(nil)[:6])
+	return a, nil
 }
 
-func (f *_escFile) File() (http.File, error) {
-	type httpFile struct {
-		*bytes.Reader
-		*_escFile
+// url returns the URL of the asset name.
+func (a *assets) url(name string) string {
+	if h := a.hashes[name]; h != "" {
+		return name + "?v=" + h
 	}
-	return &httpFile{
-		Reader:   bytes.NewReader(f.data),
-		_escFile: f,
-	}, nil
-}
-
-func (f *_escFile) Close() error {
-	return nil
-}
-
-func (f *_escFile) Readdir(count int) ([]os.FileInfo, error) {
-	return nil, nil
-}
-
-func (f *_escFile) Stat() (os.FileInfo, error) {
-	return f, nil
-}
-
-func (f *_escFile) Name() string {
-	return f.name
-}
-
-func (f *_escFile) Size() int64 {
-	return f.size
-}
-
-func (f *_escFile) Mode() os.FileMode {
-	return 0
+	return name
 }
 
-func (f *_escFile) ModTime() time.Time {
-	return time.Unix(f.modtime, 0)
-}
-
-func (f *_escFile) IsDir() bool {
-	return f.isDir
-}
-
-func (f *_escFile) Sys() interface{} {
-	return f
-}
-
-// FS returns a http.Filesystem for the embedded assets. If useLocal is true,
-// the filesystem's contents are instead used.
-func FS(useLocal bool) http.FileSystem {
-	if useLocal {
-		return _escLocal
+// shell returns the URLs of the assets the service worker caches for
+// offline use.
+func (a *assets) shell() []string {
+	urls := []string{"/"}
+	for name := range a.hashes {
+		switch name {
+		case "/static/index.html", "/static/sw.js":
+			continue
+		}
+		urls = append(urls, a.url(name))
 	}
-	return _escStatic
+	sort.Strings(urls)
+	return urls
 }
 
-// Dir returns a http.Filesystem for the embedded assets on a given prefix dir.
-// If useLocal is true, the filesystem's contents are instead used.
-func Dir(useLocal bool, name string) http.FileSystem {
-	if useLocal {
-		return _escDirectory{fs: _escLocal, name: name}
+func (a *assets) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	h := a.hashes[r.URL.Path]
+	if h != "" && r.URL.Query().Get("v") == h {
+		w.Header().Set("Cache-Control", "public, max-age=31536000, immutable")
+	} else {
+		w.Header().Set("Cache-Control", "no-cache")
 	}
-	return _escDirectory{fs: _escStatic, name: name}
+	if h != "" {
+		// http.FileServer answers If-None-Match with the ETag.
+		w.Header().Set("Etag", `"`+h+`"`)
+	}
+	http.FileServer(a.fs).ServeHTTP(w, r)
 }
 
-// FSByte returns the named file from the embedded assets. If useLocal is
-// true, the filesystem's contents are instead used.
-func FSByte(useLocal bool, name string) ([]byte, error) {
-	if useLocal {
-		f, err := _escLocal.Open(name)
-		if err != nil {
-			return nil, err
-		}
-		return ioutil.ReadAll(f)
+// serviceWorker renders the service worker, which caches the assets of
+// this version. Local assets aren't cached so edits are seen.
+func (a *assets) serviceWorker() ([]byte, error) {
+	f, err := a.fs.Open("/static/sw.js")
+	if err != nil {
+		return nil, err
 	}
-	f, err := _escStatic.prepare(name)
+	defer f.Close()
+	b, err := ioutil.ReadAll(f)
 	if err != nil {
 		return nil, err
 	}
-	return f.data, nil
-}
-
-// FSMustByte is the same as FSByte, but panics if name is not present.
-func FSMustByte(useLocal bool, name string) []byte {
-	b, err := FSByte(useLocal, name)
+	tmpl, err := template.New("").Parse(string(b))
 	if err != nil {
-		panic(err)
+		return nil, err
 	}
-	return b
-}
-
-// FSString is the string version of FSByte.
-func FSString(useLocal bool, name string) (string, error) {
-	b, err := FSByte(useLocal, name)
-	return string(b), err
+	var shell []string
+	if a.version != "" {
+		shell = a.shell()
+	}
+	js, err := json.Marshal(shell)
+	if err != nil {
+		return nil, err
+	}
+	var buf bytes.Buffer
+	err = tmpl.Execute(&buf, struct {
+		Version string
+		Shell   string
+	}{
+		Version: a.version,
+		Shell:   string(js),
+	})
+	return buf.Bytes(), err
 }
 
-// FSMustString is the string version of FSMustByte.
-func FSMustString(useLocal bool, name string) string {
-	return string(FSMustByte(useLocal, name))
+// serveServiceWorker serves the service worker sw from the root, so its
+// scope is the whole UI.
+func serveServiceWorker(sw []byte) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/javascript")
+		w.Header().Set("Cache-Control", "no-cache")
+		w.Write(sw)
+	}
 }
 
-var _escData = map[string]*_escFile{
-
-	"/static/css/fixed-data-table.css": {
-		local:   "server/static/css/fixed-data-table.css",
-		size:    8114,
-		modtime: 1456790235,
-		compressed: `
-H4sIAAAJbogA/+xYWW/cNhB+96+YJihywNqsHTsxNkBRx3HaoGlaxO71lFAStUtYElWS2rVd9L93hpRW
-h3XZTd68AgyZHM493wz17OnTHXgKb8UlD98ww86ZH3NYz2fPZ3PAHdo8kdmVEsuVgcfBE9if7x3uwlsW
-cF/Ki114lwYzojqOY7BUGhTXXK15OCsYnK+EBi1zFXAIZMgB/41FwFPNQ8jTkCswKw6vz9542lyh/GIT
-Iom7IFLaJkbv352cfjg7hUggkVsGJaWBUCgeGKmuQEa4WokzivMZHKfAwlAYIVMWw1Kx1BA7pM2Y4akp
-FQ9YCn5TLPx6fH764fysIVOzhFcyycxnOzuFK++9Ne4tYvd9puRahFz/LMMcic8CJePYZ8p5c5blPhr2
-abv8KWHI758dAF9eelpci3S5wHeFDvFw6RXuyNzEIuULSGXK7cKaqyiWmwWsRBjylNYyqa1tC2C+lnFu
-LKW34f6FMJ5Bc92+F+aKOcL9w3miiar8DVN18DIiQX29KE8DR8yZ5j0ce2lLvjkmjKd5jA6tTMWfl8jr
-3k3c1f2b9OvY/LcvEL9zZUSA6eECYoxMFjAnbjY5incjs+KtwyUY/wy5XGEQWXCxVJRGXiBjqWAjQrPq
-8c6UY/ZlAXuH2aW14RlWlcyDFbrVZaS1hZQJLorMjK8gYRdc39z3ZUw1h0k57IpZc/kYg7fmuyOHFitK
-UefFljkLUEufPd4/RACp/sxnR0/qJr4sTOwW86NU4lqmpitQK+4i5bwEEPPI3ClajtHtw1We+9oBqpxw
-qxBVx+4cpK2Lt1FqivglY3/npEDXcp+yXbSDGj6MoqgtPcKOZMlrUZ8OlteewD50iYYV0as1LosfGF3F
-qVW5cD1Ic/+BazZl7EiBGR7hgHuQ5Nr2GGwn7hy2OqYh0zwPpYcsE+o7WiIDZkAY2Mj0ETYiHnB0CrD0
-Cn57B3yNVFZiSsw23LaoJTdWbiAV5RF8tmQzGUWamz8/4+GwufbXZ4iUTMo2WukLpSKbFbW3ULHlkjJW
-GDcCtPy7YJHpD0qwT88rW5W2hykWilwv4IUrxwBTD2Ut4NEj+jcUOosZlVEsg4vb9LGOyrOdaqAFjRzp
-hZsiDTv9cCNzXUJPIKZlRzzs0ZchPV2ZPgSDtQIo29UQg7oKBZuDG/hpGRXLJVDP5992cW400hqLrjba
-f7qm1RZzrMCSZ6FMwbX4r+J7P7v+r9k1alyfmgNsc2/SFDuIw4rHjGqhUYYtISvOQltE7XWmX9ukfW0F
-FnoUsl0yI5KIEPayS3gYPqdnQM5HubH2TBNTmVtAm7N3zAro3j3hcVw5M0KOiHwuu2keGOIbYR5N5NvV
-TF9EL6Mjx7/FWMmNPiGUwDuIs/o2oWxbv4WcbcnfBK+uHjCR17SBcWt/NUbcY8UXxApKtyG8GE9HCsq2
-iotmM7cFPHc50g80N2eK6SMgjkCGezqjlo2XRZWweKDmrBkx0+YEJ6ewjjsNja3WY2xWGJWYIsPDXqcc
-0DPGiCGX9KNNYeJj+KXx7NrCRX7S+RNMlKLY6wwCuzzGYaNYtmfPbkNhaHPKuf2Ocx4i0JSzz7vOBrhL
-kV0XM0VpSoKZMK4SnT5xuG6ZZ1RalHJH5dWn6xCmU5J+5JieXDWRszvryqmoMVoVA3PrUjJdXu3yFORK
-Uwbxjacs1d1YjR74KZW+FbgWWvgiFnRBtu/xRJFbDh3pP98/OnCwMMWLdRWqqi98e+Cid4/5Xxjzf8CI
-ZQXw9+zakrJvNs7lxY7iTaO/1x238jdCdqdrf/U5r4X+hCl9adu25btJg9cWm0RKX3S9bYdqoxPqNNiO
-RhX6A3XPBkHn/pr0dcqgDmfvMcjd5dCm+pTIXPNjxVkfYo/AnnfYvJjv9zSom4Krq5v7YFNctHFocbem
-GvD2D11jX9Tm05RxtXoa86RZL/TdHr4RSSaVwajeZ+0Xzlq88w7N6+WVeHRcv9vlvxuW2tlCSvgyvGp/
-Uxrn02tTbejenUZ25/v1IT19ZtkVVw36jaAwqa/QHYuQONd1fBG5hVe3ONMbKZzZb9OD/gsAAP//xjo9
-LbIfAAA=
-`,
-	},
-
-	"/static/css/material.min.css": {
-		local:   "server/static/css/material.min.css",
-		size:    136656,
-		modtime: 1456790235,
-		compressed: `
-H4sIAAAJbogA/+z9+3YbN/IoCv//ewpuz8qKlSGVvpJNac2scezEUT5LmUkcO1b2fF7N7qZIibdNNnXj
-0l7nQc55ufMkB0DfCkAVupuSMz6/4yiyyG7UBUChqlAACt9+881/db7pzMM0WU/DWS9ONtOLRW82TZNO
-r3OaP+68Eo87L5fz1XKRLNJNZ8q+/fprt/PTr51wEXd+fHv6hiP6x3Wy3kyXi861fch+xKPZNEoWm6Tz
-YhVGk6TnHFricbRc3a2nF5O041i233m9XF7Mkm7nZBEd5mCLq84kTVebo2+/vZimk+3oMFrOv70QBb/F
-WGZw3/7XP6JJuN4kaefZb29/6AXPjifpfLaLlrPl+mh9MQqfW13+cxgMDh6Ojnrz5X1vk8ySKGVs70Zh
-dHWxXm4X8dFfRm7sjaPjNLlNe5tJGC9vjhas9gyoXfnxNJnFjKHuZL0bLddxsj6yjldhHE8XF0fWA3sa
-TzerWXh3NJoto6vjScJb5che3R5nxXvpcsW/djbL2TTu/CWKouN5uL6YLo7sZN6xHsJtPF12o3BxHW66
-0/E6nCfd6fyiu7m+6F5P42S5Y92STiPWWuGMNdbRfBrHs4qzXY7NeuDMh+sk3K1Zq94nRwXcw+FovbzZ
-JOvt6mIdxkkBcehwBo5hM3Dustb+i2VVFc1KPhxOGOlkUVaZt9D/mDKxWqfhIn04vJ5utuFsdpcXK9sr
-mk1XR2vW6s+tjvg5gO2Uc9Pjn5eM5/GMNX6Gomrp49VyM+W9dhSOWEtu0+T4ZhqnE45BJXw4XkbbTTia
-JUch6+nrpEsXEJ92gsFwmy4LvsTnomErrhiaKYOqmNmkIWvinBUO9HA4XeSlduLvlMn2XV6dh8NoloTr
-8fT2KByzEdCtvo+S8XKdMElfpGyIHj3rPDsuWjnljKqgO/H1aLRMJw//mCfxNOys1tNFuvum+02O/Jsc
-Kfswnq43aW+WpNnz/Ot0kcAxwPql6kwgBeDhaHkLRwd4ow4cIBZhNxQNlybxThSLk2i5DkX7MbrJmjPy
-EP4xWSfjfxe1K9vh+bNOmKbr5/ztwbODZw/haLT+I52ms4QuLF5npQXa///fnv3lWV68Wz66DNmQi9bT
-FQPVcD17EAP6f22XadJdsa7JpRkM5eFw+JBOkjDeSV3V44/YwOfNuoJY+KhmmLrpercKL5LeiI3Vq950
-sWGj/Ci8Xk7jB1aEjc7bXi7blvUVaMiJ05243dVuuV5NwsXmyOVyxwb2kZu9glhFbXKkD4fzmCmPiLU6
-UzWLrvg62qZp8TkK13H+aZJEV6yXs2/xerliBBa9ebLYZo+mrIGYSrvg6j57kCbz7BNTLUyRiY8b1j5c
-vMXnm2kaTbLPrH02Hz+yf7vhrneTjK6mKXu26k3YoJvxgdfLxC5ds/qtmC5jkmUqJ0yC4/vd4tc6eBgt
-47uuMBtVIx6PWbf2xuF8Ors7evZjMrtOuGZ81n32gpuhZ90No8csyXo6zooK/Wl7TCOJrzeZVvCYSuTC
-2su1hGMx5SNIFeqM0+rNN6yFttGkFwo7czQPF9PVdiYk/ph8IxjfzaeLHkRWKvd5OF3Itubhmz8ytfJv
-SiFP7G4mMxOvO/G7kz4THmC72Fu7s5kzvciKFR/c4oNXfPCLD/3sw05qzF+WTActWUuaWzXTPT3WqRGn
-3ju0mEmRGtM+dP2HRzAE+mjJqTCte+h7oDsP+8k8axIVgD0tbKLD+pzZxArK7/M+FsNrn3pLaB8K0gKz
-5zPxgi3gBZwU7y2dQRcAup4KKOSQ9/E+PDLInfCklpvbXkZlvmR2hfcT8xfuNsyFSOSKdOx+MTQER47K
-ketwjnKR00EfCk4zaEsZZ74yzmxVfIT0QNU66e9Xc84eGPD9ugEv6qny4jFeJDRIKascyUUThLlb+5fx
-2LMCW20AULtd6W6sE64rrhO16SGsaxXim94xB2eaMq8x0kaaXotAatHCIdHdrlkyTtn49dnwLYzl1//3
-//F/fg2BZVvKXv9fX+f17+XgFoNnOm19BTyQXtEg3ngc2A9xKg2DAWsTpruYb7vpLmfdrT7s1SYviu9A
-cyyWa6YyoJJ3FNG1HwB20J+KGGRGNb1bLdkYWU3uer1cCfe8Lv0qq2KPt8w63KR7qRTAmGBe7XzjyOll
-4tqcQ6BLaSiXrrL7tFX264YoJtyZtTGwv1elHbrSztNWWlgKU6WF5WjOTaPq2XT17KetnlvnZwn71pwb
-Y/W4c85x67Ur3jxt5TT9rFaOm8rjWvtLV4SsfTDQgcTESK+6ePzE9d7TqDfjzVjJzXbEG0evZv7iiZXw
-/k5DUwaNteXTBkwXZc/RuuKzm0EDvi2KfnuWEf2SPW/BcpOmplim1Qbdymx4rqOkxybx42S9TmIxXMmm
-xwo/seypDaIOsnZ92IThBh3bppXwwp+4lR4nNo9upShcpWUcBnmBUtgpLqvRB2pQmXpaLedSJAW5adrV
-2qAL2jfCsdExaM3GY9sHRuD057XNVsXk1Kd/so5pIm0YW2ZN0qZxsrCyCBqy3psfbVerZB2FG8RrwuHN
-PhNHzqetGZlsEYZ/J4qKdTFYVjwgCkdsgsymyqB09oQofrndpNPxHSyfPyIAFssb9nV3M5mmieggPgXm
-j6h6Lm+yplPbtHxBAJZt3qIzshYIVyJGca9BVm90UCGQ3EeWogAOXK0ggET8eKdMmmuh1snFdhau1ZhD
-LRxfmtnOd8r4qQUbLWexGv2oB5qF0ZUENVSgimVXHsXfSHrh63K5+IS/+1pTraYYihb+01RCDqJ0MA9X
-l8tc04XAkC2j3izXcY+LaQEpJkgZw0mYbtdMmBkFhnpz9DXr0TBnmHpZLCI0L1TNwVjTsXaZMgmOs4bP
-lIeoC5OLuIzjeZ7r9jtqN2WlRUEs1GWAKSn0fKsKFiajJDEQ4WUROiawio5tAUJRHDsmQrwwRskAV1Fy
-KkrJeBgOQxMlB6VkhKsouYCSP3AHromSi1MywVWUPFgn3/UtEyWPqJMBDsqD1VjqROG9Ja8PW88dur6J
-Up9oPQNcRWlQUYpdZ+yMTZQGKCUjXEUpqChFfSdwAhOlAKVkhKsoDStKo4Ed2ZGJ0hClZISrKL2Qhm4Q
-Bkbhe0GNXQMgoOVAWr7jG9XEC3z0GgEBLQ/SsgeeZ6SFjyojIKAFRZCNGsvchoQMmgAzWqvp4qocVEM7
-6ZMqSZRERpQJqKIBzUaUeAkpfUVhpOVMcIASlL5gNIrJlitLI7RMgIAWlD4vGI9sIy1C+kyAgBYwHmOr
-7wxJSS9LI7RMgIAWNB+RZw1Ik1iWRiTDBChJhtVcAgkL0lQKgQmJA3vUN8sGbkOMgIAWGMGRYwf+yEgL
-H8FGQEALmJEwtj1/YKSF2xEjIKAFDEkQWIlHGseytE7LCAhoKabECs2NSNsSGhJSk4wJXwk3U6OsiQES
-UoPmhIl1TadR9sQECalBcfRtu29WH4RFMULm1Lbr1SwpSA0jZzCiR1lWVqdjBIN0oF1xE39M+oRVcaQN
-TZASNSCOic1mMXSXVeURbWUClegBgYySoRuT7iEoj/SaCVSiB2zMKOwHUR093MoYQSV6YAiEI28wot2C
-qjyiuUygirRYbSSTsDbNpRPYmyBxvJC2o1V5RFuaQCV6YJAPRvY4pAd5VV6nZwSV6AG70w/tET11BuV1
-ekZQiR6wPV5oe0GdvODWxwgq0YP2J2HzkXEdQcICGWFlijAaYXnWmLZ4AAChaIKVKXryVGM8rKOIj0Ij
-rEwRiGlo0f4DKI0MehIwoxUnyUoxRv2BG45IlQ0BEBk1wWoUgVlK4mQwJoMiCgzSjyZwnS6Q19iOvITs
-SxUI6U8TvE4ZyO3IHcYxKbcqEGI/TPA6ZWC0hv7Aj+ImlHHLZYTXKYORM0j8QUSqWxUI0bkmeEy+rNZS
-TVizlpINTJqfuD495VWBdMpGeJ0y0Bq+7cQh6ZyoQAhlE7xOGZg5z3cGIek2qECI7THB65SBwXNtZiUb
-SRhu9YzwOmVo+kZuEIxJPa1BYSPagAChDZTJIPLihrRxbWJEgNAGg7rv2+OGtPFRbUSA0AYi3me1SUh/
-SoNCaJsQZLSni3h6sSwFbOzbI3IClZdF5MoEBulAoxgkIW0Uq+KIPTRBStRsOOGNQtoUgvLYjNcAKtED
-Mjsch0FM9h0oj1ggE6hED5i9wTDoR6TBBeWR0WECleiBceFH/VFEKkFQHtG8JlBFWqw2kklYt+bSCQyb
-O/SGdKgJlEfomUAlemCsu5Y7HpJ6BpRH6JlAJXrAiDmB6w/JOCsor9Mzgkr0gOmyQ8cdkCvFoLxOzwgq
-0YMGK4iGCa24IQAy2zbByhTBkPfdfjyuqyJhoYywMkUwCN3YD+sp4qPQCCtTlMTUG9dTpOTUAJtRHM22
-5VTNsYf9MSmhoiQimyagiga0Q+7YGZPzhaIwYoVMcIAS9KFGcUJP5svSiO9kAgS0oP2xopCexpelEetj
-AgS0gO3peyOfNuVlacQ/MQECWkDkPSc0hHjL0ojXbQKUJMNqLoGExWkqhcDe2EkQJOZ64dbGCAhogUFs
-Dwd9ejNNWRqhZQIEtICdsf2+T/sJZWmElgkQ0AI2xoq9QUhORsvSOi0jIKAl2RdnZNP2pSqOWBcTJKQG
-hrPnBWEdNXw8GyEhNTDInOFgWEcNH2VGSEhtAKn1nTpquDwaITNq2fl8aFcsNxyOyX0boDwiJyZQlR60
-MfbYp62mDIKtFRmgNarSnD3x6XC1AoPN2A3gGl0grIEde2Ny7qPAIOPDBK7RBXbIG0fumAyDKTDISDGB
-a3Sl4TLq0/ZPgcGk2ACOSJXVVpIJK9VOmvuQ7nBE2yoFBqNrANfoAkVhOUEQk/pdgUHomsA1ugGkOxiM
-SBdRgcHoGsA1utCe2f5gSPqLCgxC1wSu0ZVsmxUbgn0qEDKCTfA6ZWjnrMhrRpmwdiZ4nTIYxJY1sppR
-xkexEV6nDMXaGtp0mE8Fwigb4DPK0V24ABWNYlJriJJo7Wigiga0edZ4QBuBojC2ammAA5SgnXOS0Zj0
-Z8vSiIUzAQJajjQ0ErqzytLomKABAS1oz+LYSkh9V5ZGRoEJENCCNqwf9el4ZVkasV4mQEkyrOYSSFms
-hlIIbZUVRpG5DQkrZQIEtOSBPAhJ/6MsjY5gGhDQgjbJCtyAVFdlaYyWARDQgnbI6lt9c38RFsgECGhJ
-tofpb1oPV8WREWaChNTAcLaDemr4eDZCQmqSjWEedQ01yroYICE1SRxHQc0wIy2KATKjlibhDMh9PyCX
-YkVJVOZpoIqGbFGcMTmUi8KoRaHhACXJosRjevtCWRqzKAZAQEuyKNEoIrupLI1ZFAMgoCVZlFE/JGeE
-ZWnMohgAAS3JooR9ejdYWRqzKAZASTKs5hJIWpRmUihZlGA4MMsGZVEMgICWNIQHw76ZFjWCDYCAlmRR
-+kPfLBuURTEAAlqSRfFij4wKlqUxWgZAQAtalHDAzxsaiREWxQgJqTkwGD4e045UVRyLhhsgITUwyOw4
-GY7I0ENVHLFfJkhITbYo45Cc+FfFUYtCQ2bULtZJUk5SvCgc0ycWs6KIljJBASrSNoSxT+8LKEtjuxAM
-gJAW3IQQJP2ohhixB8EECakBSQz9uE+7o1VxRO5NkJCaC4N+0SAgzVhVHIv3GSAhNbgtpz9iBslMjdiP
-Y4KUZcRqIY2EfWkskcDAeG5oeTX9hlsYIySkBld0gyBxSbVfFUfWc02QkBrcdpAMYpecqlfFEa/ABAmp
-wU0HIz9xavqN2HNggoTUpD1yw3E/qhFKanecCVSiB03NcGyF5LICKI+MAhOoRE+evvQHpLEB5dH5Cw0q
-0ZPMTRT45PorKI/RM4DCaJxkdYJR5Ho1kTjK9hhhNYrw0JI9DmgLpMAgJ5dM4DpduDs8SmL6eI8KhOwO
-N8HrlOFBJj+xaT9CBcJ25BngdcrASoVJ7NNn+lQgxDKa4HXKYPAMoyjqN6ozPoKM8Jh8Wa2lmrBhLSUb
-WLJBNHI9UturQNi+XQO8ThlunQ2GY7eRbBM7Z03wOmVg4Xw/GNHZIlQgZCeYCV6nDHeHu/2hXbPKbLJ5
-RnidMrR8UTQeD0lDq0EhI9qEAKENj5o447HfSH8SttCIAKENj3z0x2OLtFAaFCLhJgQIbSjiXhzbNavd
-RhtpRFDQnpf7JqI4jlxDO8+xHRNGoIoGNIfD8Yg+VFsURuygCQ5QgsfJrbEXGXpvTu2MMAICWvAgXz9J
-hoa9GHNqN4QRENByJcM+GBhMzZzaAWEEBLTgcUEvselj62VphJYJUJIMq7kEEhasqRQC0xVZUeiaZQO3
-WUZAQAsegByPPMewzj+ndjQYAQEtYJ6GyTB2DHtG5tQuBiMgoAVzQziDgUlDzamdC0ZAQEvKDSHS9BuJ
-UbkhTJCQGhzOyXjs1VAjxrMJElIDgyziVsJgXufkrgQjJKQGxTFJwjpqhDyaIDNqd8lstrwpO22cjFxS
-8vOySI+ZwCAdKVXdOKbtSlUco2aAlKhJqUrGQ3pVB5RH6dGgEj0pWQnzYcg9SqA8So8Glei5Ej2bjgWA
-8ig9GlSiJ2XbShKfdOhBeVRaaFBFWqw2kknlsmssncDejOM4oNPZgfIIPROoRA8M8vEospw6ecFHuRFU
-ogfszngYBk5d/XDLYwSV6AHbM/YHY9r2gPIIPROoRE/OTcSsSF2DktmJDLAyRWnIk0oalsbINdHRSrI7
-k02AAOh4aGYVJCvElG6/niIhoSbYjGI4H4k7gfLykW2RwpIVxagYoAAV2QgF9I6usjQqITQgpCVJZBKN
-SBe2Ko72GA0JqUnSmFgBGeupiqPUaEhITTI/sU+nHauKo7JBQ0JqkvRHIZ2XsyqOyggNKcuI1UIaScvT
-UCKh4RmPXHqcVcUxagZISE0a1aEhkWVVHKNmgITUoNEZBwa/uSqOUTNAQmrQ5Iz7tdQIi2OChNRkg5Mw
-Q2UmR9obA6hETxrg8YDeswHKo2OOBpXoyYPOq2tO0uIYQCV6smiOaumRskmDZvSW63BxkVS0hgFNKy+L
-0TGAQTqyyXGTGlKkzaEhJWqyVFoj0gyA8rgdIEElepJURhGdXRiUR6WEBpXoSYZnNPBINw+UR/UlDSrR
-k0ZBOHDIeRYoj0olDapIi9VGMknr01Q6ofkZBVEtPcL+mEAlegNpXlBPjxjlJlCJHjBBybhfTw+3QUZQ
-id4Qxpl9u5YeboWMoBI92QzFdu0AJO2QAVam6MiKmjZEEADX1DWWqEAgDcJhfaOStsgAK1OUjFE/rqdI
-WSMDbEZR5A5STZI/cMy5pQx2yQCrUYTGaZQM6aCcAoNpAAO4Tle+TyKiU42qQLjtqMk3CpEo0js0Z0yr
-sVoGeJ2yZLqCkN6BoAKhPjENr1OWxs/A8siZqAqEUTbAY/JltZZq0qa1kmxo2DzfplfjVSBsVcIAr1Me
-QBPghbY566PRzhnhdcrA2MWB59qNJAy3eEZ4nTK8z2Ls9q1G45m418IEr1OWDeAwoQ2gBoVaCBoBQltS
-Jv2ENoUaFGoraAQIbWlQuwYTpUFhtA0IENrwPozYMXhVGhQiaSYEGe3RenlT7gwcDH3fIyNAWVFkd4cJ
-ClCB+9H51Uh03p6iNOYtGgAhLbgDcBBF9A7AqjjSfiZISA1u1InCMCSXxKriyOg0QUJqcKefHQR0eKQq
-jqximiAhNTAYgrhvuLaiKo6sdZsgZRmxWkgjYc8aSySwZP3Yi+i17qo4ssPIBAmpwbyusWe5pEtYFUd2
-zpkgITWYyzVxPYe00lVxZK+9CRJSgzv0Emfg1EgJsTfPBFnun74DuzzYD0VIlER3eNBAFQ3ovof8x0SG
-8NtNcIAStLL+2JD3qyyNzdoNgICWtLnD2HTURq2aG+0EKLz+zeI/RkLE/W8mQEALqKdRzH+MtHDtZASU
-ZMJqLnvUnQoN5Q9uMPb5j5EWsbPYBAhowf2WNv8x0iL2WZoAAS2olhz+Y6RFaCUTIKAFlJJj8x8jLVwn
-GQFzDcjTlkDF1LcGcWBOgUhoJyOkQg36U1Eypg+NSxDISDMBqzThduhxHMTkbEQGQfZsmaBVqtC/skZJ
-ZE4haFBfRmiVKsy3b4UefQhJBkHGvAlapQo3XgdDi95OK4Mgo98ErcuS1VJ6qSz7bSQY5tj3+gl9/ZoM
-gnhFJmiVKlB3nu+HdNIPGQTRQyZolSpQfO7AG9Dr+jII4iWZoFWqUAX2XYc+LSKDIHqwBpoBoCfU6pJb
-crjawj1xlza+emfEn8HVFu6t1tN5uMY0sjkldw7XK281b8NiCR2Ha6zxzNmyw4jfWo4SNN3oloHtx3DW
-pkVbNWkgCaIi2o5S1j5NGiVf9c5apklzQIBG7K2nK/neHCG2x6PlOk7WvXUYT7ebI9/66nhSXDzOr+lO
-xumRdVzceG8dr5ZTfuF8L7lmdDfZndyr5WaaTpeLo3C0Wc62aXKcLlesbHFDdnWFt/g0C9PkOdPBX3X5
-PwfHNa9vpnE6ybhZXifr8Wx5czSZxnGygPU6nG564YI1Or+jeycQZTyV2DuH7qYTbUfTqDdK7qfJ+rnV
-tbqHTtc+6AoapgJZm5hK5E3UOexTRY4/S666Wi8Z8Kktfj3dTEdMqAr5OHSzEnlPLBc82DcOt7O0qz4f
-M3HtLbdpb8M3Ck4XoM966XTOerE33i4i0VoSM4eezA3Aya+ED9d7YbVIpCWjOfa2rNol0lEYXyS7crSs
-Eybq0+vkWKh6cfk9vy2f32N/zJTHxXTBWpqPRH5hPsDAyqTP/4jDNMy+//tgJxUPt+kSFIclj8JxKrZV
-sjG8YCXTdP28en1Q3q5f3m0/S26rh/ONeDBaVs9EAVi6F0/XSdYU6+XNcQGjPMZKQiTiLn/RECWC6pFa
-Ige83G7S6ThT1bxyXDEm6woBa96r4iFVNkcVzqYXCxIRlwMJG15cwsU6eL7RMIl3MhapIK5Ye7bNdGHW
-1z0uG8djRprJ6Xw6uzt69stytEyXz7rPfkxm10k6jUL2+cV6Gs6edTdMbnubZD0dZzA3mapnnmn2fTO9
-T45sh6HMtK7DP+b2QHzWrQW0J5nBOq6MUC6G2zRdLjpGkcwqZlUV8yWZrz71eotlryKKSjewnpC/9cUo
-fO74frf4PXQOWI1ue5tJGDOrYnWsDmvazsU6vCNocws0C1fygONsm8vnnOVVA+VFy0CbzHjIGzmzrXKD
-8y6oXM5jXZXkfeX2WcGMR2aHmYbqZf3Z59KyCuOY6SxGyubFiqE8XQjBHs2W0dX+EpVJUCmUN4UvYR0L
-h6Wy9dvVKllH4SZhPkbKPQqu/wRbqplnsjhjXs+ErxcdVd0FzWn1tHPoKMarVMJd1WEkymZmsbbEMbMJ
-vMWyfoq26w3rltw9yiobJ9FyLaxIVkY8lMa8aHHYZ6zmvH1nebE5a4BZAmXliKnh5X1vvIy2G2aKFnzn
-eyYullRswhtRd5HFELD9oFv8siEgwQnEwsIchREXqQMCCTeYrCVsBT4DakLYkwDBx14GksSyn15Xei/W
-p4vVNgXY/kjvVsnfNtvRfJr+e1fqcCarIZO2KOvsB4n8OpxuGK+qnlEaWdYzbByLX6sjMeQddK2Oy55z
-LdTjBeBrh7/lb3wdUu6FgqmyNyBtD8fgFdi5dtLRdzOmOXDP1hg7bt3fJYtYr8kqOVDI2QHnhj/l+kt6
-5fYfwQoqgtC8ZXJImDcTlrxm3abFicGLDAQjmiYDoiXODjWV1FpjHI52urdQ2QjhuBRTTWCuuPcKLJZ4
-BT6WxkuzEq0GoBD0Q3QgFcNAe+d4B4jFhSp8wQxbONPagTUam9Nye9mbMi9xs8P9Ot4+Yr7NPxjnztxD
-64p/idkzLAAZFI2eu3ae7IQITqXvrBOmohtzYI97Z1XPiK/VR6zW5YOPHzOREU5yOK2sFpSNosrzcHPV
-Y9MvZu2LR7wUa70L/pdZzufRdB3Nkq6YOHVFJO5Ao/9ZKz/O3+eg+dQ+x9Se0asnUZBKLEPXDEcjDdYG
-IeWetEHSXAfy0d5YCbpOqQR7edhNmmVAP96tpmjiI60Vs+pNFxOmf9JaZcUZ/n+JtuK86epKtDgEr9ps
-T2xac2SxzayH0Nb7T2k9hFwxt8smdXm72FbRdRYZus3aSZS859cGJre6bJEOOaMajmbFQKGYg8MIekZ/
-FOD/bg6vD2ghXqtwnRTh74K5PDKPPaPnG0aw5vrAgERVPN29APO5SKWqdZuA1i5bRkAe7QzqkQRq3iAk
-CnNzNANr0hh6vVBJRh/iQlt92ulTTodZ6TxSkMfFdZ5IyVXNUWPmBCjKYd38+BitQu1koQVnGfQTMgf9
-fH2ynpvwfbnNwZv3thIAlS2JIdgThet496TReMbedr7AAvL5G+JpFdTrK0E9z8pcksLcWsgSXeGkuPxd
-YUlsZBqlqAkk7in6dXrP3Zz8JXtStdbHj/OEmUvStYMDbZ2skjA9yv7AFyVfzDJ35Oh21ggRrx98umTO
-GZu15g4YZwm+DdM0jCZzviywidbL2ay+Fuk0LewheCCWexVzvp94UC4gILZ7irULallmk66TNJoo6zLF
-U6p04eAKISxQr5L1ZpUIj77oB7vPZ3dZpMD4Fu0HGFvXXFoEieGd1qjl914vI7gr6TLlMD/i80rmgk1j
-ecZ3oCHKREHuok0yG4vu7SWLuGpa3iF5R/HnWFl5fqDMTOBody19ckG3kTfk8+UAbaPqXbFCAeu42Y5A
-NZU1BV3H+t4BgWbFdz/wMSkQ4ZAA/TqZS2PDDhBtJolhptqG1leQbii052an6E191EEX2+QDlDSDJjow
-J08KG/fu6yWt10tuV+Ei3km2hHF4w3R3NXCFsrxmNTwG72WNvNgi08d81UyMIM5PNVwmSXTFaoSskVe2
-A7Wa+CoWPshBw0szxWK5rJxMy0zx/Q7bFZ+MMaey0PdiGlVNJ4uyHz+KhYWdOpulMXZQeL3tMuatgnML
-YRvs1uF9pSxeHIv1I+0hvtJxrH4HS5NqjXlj50tiRMjArTYUte+ufhUpsaXVTWXlTQsLZxw7qNBzHaB7
-GoWsOXp3ic96b8G6awSlGRdEJs+TUWzd8TSZxZskVWfFRelWnBBTkWrDSIUnW2CcJDNmRv+M7jRtLKAm
-RrAtBb96U0rVUKKrSHyViqGqOlInbBCOhjz03W5gd232m01jUEbkQmqnMb14VTMGi8iVFAwCGhGGo462
-69nzZ3yDxZEITH27ub746+2cObHhJul73X++Gnqj9zcX8fzdXeTMrkeX1vT0V+/mZPrdbDQ/uz5/Pdue
-31vTd+9+ef3m7cX05Mcz68N7/+rD+9vr0fy34cncvz65DP768vL2Pp5H/7+Tly8uktf2ZrQ47Z+/PmXv
-J1b844v+m7vhTfz7T5s38+Hd+d3w6sPdcDZ6/S49f+9b0V1w++by++uTi+XFycvvGD+32+h+dfmB0Q1/
-/MWKXi2v3zhnd+fvf7DC389nH5xhOno/3EZ3/nU0j64ZrUsF9u789QdI243daBvfn25H7k+LN/ff+z+/
-vbo+fXVyzSDTaP7L/M3vZ/5o8cskeWkzvKfTl9MXFyc/TtLRa//+58WZcw55YdjiO989zemfXr64OX05
-5PWX4P7560/L+Mdfbn6eBtcMwn2ziO6z+gd3p69e3LxxGd67E9FmSvvf5vVxwvfv3H/Nh94/fz25OXn1
-4uL01xfFu5to/u7+/PefnPPffriPXr+7jH/4aRK/nl0zut7b97Pt+9/s1Wj6HWvjd9bJpcfpBOev382j
-e/H54uTV7eXo9ezmt9esXV9+tzp/ZU0/OLer6OXJX0U9sjI3H37/ZVl+57+v/zU8ubIuTl/ecJ42nKc3
-r75nvOXfX37XP/mev/e8s7en1ul9ZL959WJ7+vaDe/r2wj37ldX98sr++e0H7+z+N1beuzu7P709vfyN
-w1inl1f3+XPv9FUGf8Lh70/uT9+eCFw/v33hn10WuE5uz+6/91CYV/+6Ob3//u6M8fbm8sNdBfO9dXb5
-m8Nochib9aGT078/vRS88OfOz28zPD+/+u3+7NWpm+H53snpXSTTrP+K38j9xR+95uPifDV6fdM/cc7n
-4ucynYfvbzdv3g9vPrw/W8U/XvVP36b38Y8/XYfOb/3RfLg9//Xk4k3ZNzfXoi9++I716UXRH8Ho/Q/3
-4V3WV9nnkwvx1363DX//hcvc9Yf5avbB/eXlyH23FePX+uk6eZmXs87YePllxn7fjeYzK2JyPXJ++l/n
-788sJmf2aP7LajSPGezF9J9CzjK6EZeRF8tKBl5+d8VonWX9zdoz6//bk1ff59+ZHph+d2ZqO9a+/tnb
-K+fnV7l8XEaifznM2asTv5Cbn1+9yOF5v53eVf15dZPLEMd1d/o2ui1wyTAvLCYTZZ+eXpYwt2evPthn
-l5lMnb09ueGfVVk7u7zK8VzYp/f/KmTz9uwyo3fy43IqjQ+mI5P3tzPWPqLPf56eZTIw/zA4n882o5f2
-NWvPS9Zf/s+X3w+YzNyNnHT2M9OlTKdOT14GZX+/cexJ5GzE93++HF6dvz+/F/3y6vaO91lBN3ZmV0xO
-hieX35e8hK/frc6dicV0R64zOG+8zIuyTPKW6ZuXhQx/J/TBm9+FzA1PFu/uRi8vLjOdcFXCaDJ+z/Tf
-K/7bXMb/menLv768+NvfnvHp3xdT9cVUfTFVX0zVF1P1xVR91qZK2egNzpHE23yn8KETbI7bn4WBIKv1
-kk0107ujilzjSIY0hdQ3P3a+2NkvdhbYWUlflvbSuzljeonpJvvn0h5FLvstdJHFfp1T8e43xnt0e/rq
-g1fqw8sLphNPChtnM9t6/3OpE0/Y7yl/55++unJO315Zp7+WdljoxrO30V1G94LZxN+YLjv1S/389rf7
-0/urUq9lZUs7ffvz23/dljr6kunGuxq6hb55PeN2Qugqps89oGuYXphtzpn+ETrx1fIyt5Tznx2hV9KR
-+93kgzOzkrfL25/dMyuaD9fnb1fbkePPTqYvrpkeDETfMn3yDIlA5SPZFFGEo7o2pLiHdlACi1+UxBcl
-8UVJfDZK4uPHWThKZsiqmrJ2Yli0lJbImi9lCMKtFzEydo3bp7A1C237ox4CL5d2spOU/WIJIzt6aFqx
-cMGKhYuvWNSsRD3FTk+6suSmu7arTyri1r2ndQPZZW3YkLaLPpYlqrGYU55xKE6Vphw1MmrydTViHd0p
-lxSZAM/C1YbvGso+YCe9wZjjC2poUguVp046ScJqETrfQeIW68tqwY7y9OPHTTJLorQ4xSrWhXTQ0TK+
-66RrpAGKAyHl/o4/ZQ6TNQjJJxemrF7S+YUyV5TIykZCk8cVkiTRgfJtgVJD78C5WnFEgy8lgyOfYtcD
-gupoPF3zDAPjHj97iCCWC1BbD2Scs9CMEr4vMarZBuprbJQMw2YVCXODvSnVmDLvlmLlisoIbI75SK3E
-BjlKGiKYqAUzVjMRKG1BMptNV5vpRtpbNbAs3dhqx6KVE/r4jiZFJwSoTtCrytUEI9ZjepoNn164iZIF
-x4P0ei1wnBTQiAkPBgePYuholIyXa0ymW/CVI9nBU+5fn+abczsnfHPu19pW16z507tZUuzgUnbLSbvH
-1O7LQZRD8GJPDbp54oaJe5bkIofMj31zhpMw3a6ZGWEUGOrN0ddM3MKcYepluZOrcaHNfLlMJ5z3cJGy
-dpmyyVv8FH1Xph959j8TPw6e7YNT70sJ6UhDWo7mav+JXiRio5Pnl1j0Fts5E4aovsQOaHiukXOkrL2W
-Fzs5kQPY+CF2QPIjfNoOlOwkojiKyLeS9gZqgew0Ij+K6HJgVz+OmJ9xCspDmRk3xebewkwJHOIfsIkM
-iPShv07mMoJicyPYktgpfsUQeOr8Mb11whTnJiHyyJSvTZB75pVBa/73b+TMH4E8JyBgcgMeTaazWIa3
-UIge85SYmIl+BF6F2CtUC8BYhEYYVj9LcCJ2+eS1r57I32SPAOy3K88iEo5Nzlc+GCtZswpZc8htvBmG
-eXIRMv2z5OlS4G7bjjhuXGSlFJloEV85S6+qYerl5pApvExARDqWrl4ss+21xZhvUVOGb8RrQPHjx0YU
-2dBVKZb67uuvj+VjAWziGK658zFB2oGrqAIRUjHRleR7NjE3gfNpFgAvJL3w2MrxQVPshHVIO6FyCEIn
-omxpxzKxPPxDnBXpbKJ1kjCci7jzvDooOugzUTvYtWw8uXF2zNkLU2ARWjWz3I4ZKjDKnqgBJX8DHjzW
-gj+G1DQIR5tlxIMZoxSrWvVyR8RVqn3MpVWiclHr25ElRmI2h+zFPGk73dRaGUW+tCnOnrKzHy/F6amv
-mtTuaJFOMiPz3D5ABrcJwNEBEH7MFMwAzoF5TJhg3bbE3IOaUWMGJtVwE7BdpoD3oey1rad3AKkdm+vc
-0NRgxfa0NrINrDWSOzh3tx7MI822uCux31BrLW1P0W2txam9LBTZDb46BkMN6Rc+meLtXARIEUpqEfIo
-CjxH4/uHAdDgIKPFUU84cXzTgxIlrw72lMdWGnD8V7II6bTVoigrTQ6NR3PRAIXChTlGUQ7L//m9//L7
-rxs03FG+skuzjrxhvsAV+2eTNmhWkkBZMeTNPgT+99PwqaExcaP33xO3ZwsC+7UnTaBVe7ZAA7gpfSvC
-YWvfuI1He30zNh6ye3O1T+e21gI/GLQAzRGWlbhS73I4wB0eVod3pZkXvn6qnOGVAt2uQKWmCkWWyYgo
-Op7uSFrs2aeDdK+HOk1VxENkryg3xFnt5LqiGQWwmbph7FUDiv9TBqV5KnZt8sRsLrfDJgpkaygldpWz
-p3qBZUOZ6HRmUxMR9lo9763G4LU1EUmYLHM10ckxeLurDxwgkrnnfHAfF6yl9vz/jo9kFL8vHtAXD+jz
-84CE3vziArVwgcAgf+JQA1zf0KLVs+XFEglla+kwDdx0WtvEGnjVaJaTfaUWSgZJUanG5kpUvBXqvCFE
-2ljB51NksBLe3Xp505GX7eTHWEkq/xC33b1Rkt4kyULJQpSX1bMQSTAwvNGBstBsWapqnprYmla+U/WL
-Gp3Xyuqqq3qH6aOn6KHcVdf7qNjqhj1UPVgqDQtet2r4oNXjo+MJh8TePGjdZaxTaES3r5+KUNTXsySS
-cD0LXTQqxCC/gKDq+eKBdDOBRBtbAoPE5SWwBtRtlbqdU7cR6toSFST9iZeoeO4/ZnYuLtC9pXvlOdIz
-COvjB5DFshNVc0RQUE9QhGD5/HMUSUxne8vRZqzdHw/bGWRfVvIyF9dJimtlkW3iqvQQ2Z7120KMacKk
-S1KUgtJVKU96CQrVwGqqS1XUlL1zqORJZ5CQPkTy2SoY5L3lJArDEQPYcqhAabmHECrNLlDBkTZpiOap
-giTg9sclnPK4BJmQ87M6LWGsLnlgIhu4bQWq/tTD/rhqjyvoU0uYNrBjqV5WBcW0eJrMu8r3jvJdugdT
-3N2J9v3jnMlHZBtV67N75P1VWKpbJfZ4aHlqxkiYDVfbfPeEF+OpfjZ8qD2QUlYquafR7dl4mvcWstHU
-KzzWs0s6SHZJ5xi+R7dzteRQey9uDZDMb+UOPQJteB2m4XqnTzjqEDO3d7mIYZs+1TRNzQINH+qlkIGp
-J5lV88sq5dSNZe0rbyiR7X/tYD7dk9KZLsbLnXL+QtMPpu3/lmnP6+OY3I562eZ4aatwdrmfil9Iuqrs
-88svZIcNv4JDPfJgzKI88PmPxkI2KjQmsscEG8pdQwTdBjkkc6ZAPeC+4jKYB/jqpTdLceVnwcsAUQ1V
-qVrtCJ0idfUKi//sQ4TSROoGvCfBLZSnHifkDiMmgfsT0rWiFgB7GjpsRGVHJR6zGinyR+NntHBHzUKq
-MFkniSR8QYBWtSzXVPx8Zx/xa0yGEEBt0D8GpSJ3rdglRcnHBzeNSfgj/Azp44UFds0egsOTbWcnL7Jj
-2+BhkbED2zovRWXggSV6NtizUYq7Mt5TBIDE9eDTGY/z5LO60rfMbw5HEUl3uXepEsXd4wVXw+EQEkQI
-YGmN8LsmpEy56swUhK60a682UThLnlvwoqvyEZktv0wFpT781HeGSkGjkoOG99SDMFF5y7zhttxPgbTJ
-3fVqrIqWpA4iKkVf22Rf23pfs0dAKBEJLJ+UK4VicrCj71OwrK8wIcmemynwo0Nckmns+aEv4nk99ibM
-4xTKVxURJAqlLhFheaxFsC7TO8oNorYDL70O5POO1ViOZtPVEZ93P8/cZ+ugTt+hogMkBpUBSbftwKBo
-Iu6cx2YSTkmYaDTRQNIBRAWkFBne0qJ0sXpGFs/Ql+UrOuW6m4ngdiHmjkmMYIBtL2I7shWDJguPZxjD
-5srxXcQoZku8ueQX04hRzH+QFQPVWLT1CtDVPHwZQoSYoBchHiB7Jyshbydvpc7bbjiP4vw0WJ9Bnm70
-h+qDNlpYdHc5oFRBqL0KvSqaH5Bl6PnZ8en1tPH9O86BiusPcR6cPyuTz3S1IsqtYIW8GEVRCxgbaWYJ
-S2jKVEIT7RYFMxXRugYq4v1jqehXQWK0aqPgQDRqs7mAslkVpNv8mwGSl8nK248rIfxP3M+5Wi8v1slm
-U3eusdQq1X3b4kK3eXjbq6jJOP9+OArVSlAcl3Ygnxx8BZWReFa/zljRLT5x+tQVl2AtHd7WCpjfjsfJ
-WkGRLTVxUQjX1RJTuuwI+9UVysHx/W7xezg4QB8edEkcOX/F3wPQhTij4faWc1lY0H/kl1ttOs+JNfF8
-v0qBQdygLD3pCYrMmDA/KUyTg/oSBRfdGsx8W4AZs1qirN9/uBee5OqTKi0lzFV8dzL9bnn+frYIf/wX
-+/6v6cmP56vz3+N/jdyfrDLN4tuTi7OXJ3paxmmRYrJZOsg8b/Js9Pp2Ff14Njt5fcZTOXIeLkUe5OnJ
-RbTgfJ0wPn7yxbvLMlf6ZDSfpR9+/4XB/WDFP/60+rB4Z53/5k9G73nqzTOP4ZlH82Ga47RGjK83b7/n
-6Tuv4t9PeN7l7dnilOOeRa/fTeLvz67j9z6r50+r0fyX2fl8xnN4F2kLRQ7w8/e3m/D37+7P3+b524vv
-vzKeXzGe37I2e33m5zTvxDNRj6usHq/PNh9+P+PpLzcj54er899Pyrze/3z9wzZ8b0/i179dsHpZ0ZxZ
-eUb/7fyHlKdc/OBeMPjzu5Fj8b6yijpx/Oc/vrvj/fPm8vye0z3//bsZw/GK5xyPGQ/he5/nql7xHOMg
-r3nwxnm34TmmI+c3Jg8315F7vmD8PPq6gi/i9UW8jOL18NBS8++r159Eaw8xrT1so7Xl6ppqKpwVu9uu
-vGsor7RILX6svEt7L6Vpz6IFPOVUOE+OJBz2sfmtjqPM0ehsjvGnOgxzXrPXjMPtImVExtMFe3jcoIiO
-TU0GmXX1cV2Blh3dtuNcXY7lTYeGdnaMveA8/KNAcpXcjdeswKYj99PO+iqLzJR3sTFP2y8eOn7xdOB/
-9cB+s8fg5jZW+uEfnxR5TQ0cRqTrY4RKjOVDRvEhXbaqwxOhF0KwCK+nF6G0q/gRmyra78cxZMWsWMv2
-e++k4wN4aAjJ3IYHmmrTSxZxlsNggLPT5v5y1lpsQr3TU0SIz5/DxebFZLl3l8Uby++35WW/2hS53NlU
-lM0u9ebtly630QTWXaSjnYezWS8/VwDe9djf9UWSv+ktF7O7LnidGV8F/kBGAF8JBMjp0KwsXKFrksSj
-mubntMCN5AXGbHm8LqLwuE14YkXauKnm0HKSuSbphgGWV0gER9f7X25ctEK8Dm+e5pDTo6X5kUqp2OFj
-gVtZuUjw4A/8XnOn6CdfrzTv+8n4rO7dzcJwx9Jyb8h/kMU88YnJbfL7857j8yM/x8aX+mpXtkC1Yg5G
-sr5Oem58bHpXt/IKk2k/WS5tlJD+Vl9WPZYtkbqKrynTIqDlYwMGLt4bO8Ii+sA6qEH7vzX1x+YQ2LMd
-vtdVwvv3b2RNsZmsmTGE+1oXyYVQeNwKwxIothrNqpcqtC1UhX2wpJltreRDFz3IBsK3IGHWp2DK76tM
-gVOhMqqO6op9Gm0IN6Ru0nWSRhN13yp8fLGexj2eO1Z+hWFRjw83qaT6PXfyZCu6p6gd67lSqwvSpe2Y
-+8pIw9pAPhp1PY6HXsABSyv7IO71ou16LY4waLgty1ISyNRloPOVxur1xtNbvsSIDJjcW9hX42FV7o22
-aUqnrCyNtLQ+nO2iDcozOepG/BZCpy5260fWyAtmHHX/KzerksdY+old4SVCJzEXbX5nS37NAvRCqs28
-pR3y5NbL9kt3DC2qtyHAqklOfhR3j7FVz0leVw/XMXopbOvgQX0uxfaSnJPuSkCLJU/NfsV3hEilTDWU
-Ji4PGrq90CCN/N/BT6eSGmTDMg3XqZLRIHtmKI571PsqBDCbrNldCo5K9b18mbmaaXBdUIxdF1mNz0Ok
-cEx+6qnHJ3bNq/p3q6rsrVB2oHl91RLLQxue8cYDD39HCYBjPQ44nhGFs+i52NbX64jnB0+qejB9mV8N
-wRy3ne4SY5pAcnfFNnJkz2GB4bjMEEOc/laNYCW38nb6fftS57ZK0l1cciNV83ASbnrQJ6rDV6c5xRnk
-5ZwpFH5l1C0ct3vXisCpCStSXtQvZT1VbDi3RRM8AScVZjB+bEuzvYpBUdutEGC9vR9ni9CG6fXAXqu6
-98aNWlCHUqLQy0OeXfxdEs755VtN8NzwWPKYqRm1OHH+lAIX3Rdu+HbaHMvuU9uCOo6Mb3usVgmfN+6M
-xlw6YilMOX9CFUU54grxqS9k+fPOLe/rgpgdmmwuv0lmYz0gkB1UQqb+cvHHn1Y/lh0fbcMxNzv8thdL
-1jt17rDRDrY3ws1cebPx3VcnC3xIWCnfjc0aB5zCfFTjIHGKquhThf5k3Ukypm6z1ItocTPEy4bC9aQD
-ubHEI7E0OnPDY0REa446LwIDQsNwYGZBxV2Psmu4nrQCOS9UVDUXf6Vqy9F4u4nCdGne7V23eAPXeZDt
-xR5ymq/JfZ1POHGiqg0XFIy5dvwD7IQg2E6bx6l6yTVrsI1Yy1D6ztTYCins2EXVTvlbhKFjhQvdh8Kr
-jjB/XB2e0JeERa6O6iIwuyMg9GVcNFdW/fL5HsurYMd2m6V204SxqKg2XyWWyMUUoKTVw+ab2tpRKUgt
-NUFbvq2n45nsNkVO2GSoxzf55cph2Iceiz7jFzOxg2M5EYXQYPs5omTkB9RDOVnMapI1BVqRo5KBrBA8
-v6BP48wuRdE2mj+BNAyPFOwTCWnmhLXnZA+rVRDRUYoMmUqn91s6rzT2ACDPfUsioMVn7saGQZYsKJ8r
-h8Syo6GXHQOLKYU5i7FSZVLT9aKF6EXrWHr/NAs0hP74e9Oao44D3m5KUB7Ps6jg09tTqI7KA2mQElEO
-DSPH64qlqE93tnGvsUuupjSF1C62fQwb6la//dnSNg3qwyjLKLifK02IW5YDV4D11ShwrbaoX1CqqaJ6
-JtcELM6DZ+cItSRECoDIx5vzZhp6WercvKB06hqUrGaOUs4PaWoDbhEzuIXIvoVPrNXQjbDGQ9G64lCv
-nFG2zyr3dLMJQrKOwo10xFw6tNDX8+btJc0giZcW2DdKbp4KR9q03sb1VtMQZUsJWgJgQLFWdBtCH+V5
-0IvdybI1xS+aedZ5Vp0oJc/KyolePCtATkQUUcLkdsW7iDiD6rHp56FlbzrhjLHK96R3mGDchOsYHKN4
-PCpwJDaczTo2hsDWZsKsNXVFVnPWmNwkos7/88bNLDS4rM/kWTRiiDjO3dtDlnqsvZNZJVFdvAB2+UZj
-7Ig54IeTlohBUxKJoj4QHqZWw3nSvE+Qg5zK/n6VR1kU7FYfP34srIZyCIDA20FAP/8s3zm/rNdYY2Yp
-gQnP1SvSGFtt+qbyr8qhIs2GFf8y589B00rwZF56tsJyjqD0i5T/GaumRktKil3ikTMOI4i61IvOeJrM
-4k2SatkYxBAw1lXJqC11lsjaQXdWpW7KVub9FxT9R6egzDoLZFULquy3RQ/hgcngM9htTeSacuOsTbWM
-U9ULUwrMI1wqMOmS+oXmxhbGCOHGBlbKLH6QUtcoZTSDoIrNBE9L2o5ihavIIqGqpawSSzUsU8pLQtak
-CQRci7o3TF0v8dY+1/uwzPVuuzXDzHOqceY5/6lk70RNyTTvzVWkirJFX9VnhW9HWsqUsz8bplQ6R+vl
-Ms+2uZnxREnwI/ASuh9F3FZcAtJjRjRKJssZ39uCmXrshpIHgBiJ+4nNY9U1ltXtjjorO8ppQD0MgjdH
-upxT5F/8FDGiIpOY5CcRJ7+r3QfqeivYqyAtrcLClfXEdJLeilI+LWH9lXxaOMimly6Xs3S6QjxkFKII
-wIt3vfV2seDCy81LdIXmdSJZXfPjUyig7h6SzGPQevQQn5w2pjLmx72YwoNZuqG0kfkOAA/ws1gHLpIg
-yF+K01FGVkRAozEr3A6YOVHsEPbMyJcsE+lkOx+Rg7pI6wgccfo6kiYOkRQCw/OU2gGdlTGDritTOBM1
-5UrmOtwVbZlN9TPjEkvPakDbbLBnooHp9SeTC5j/wSwtJMtCiYrt1HmWuANCyA1eJnaBT1NSaoM9nkwR
-q8Nr0bTtyCy6fMME9rCeH62qTXlpRS6zFYIA2PsN3RqoRBppHZX+oTvw9xrZn05n/LnjHJfkjdavR/ic
-oBPNlpuEpwpmmCtz+FX50R0c+l91EaEnX3Ajj+V3biCVCNtkx/t9hxY9/pl7Dqxi1+FsSw7BuhhMM88K
-oabrkk9C6a+g3MePYBRzh/fv1MvMkRET1T49QdBq9RT6GeYlwCMQ5V7xfTlqocaflhuzts8v1jzskyLw
-ZHqe4OzRwjJsISy0qSkawicb4lF13V/7QQedaUD4td8/7A++Ur1z/KnQf48U4T1roEiu9ZX6xPE1dpFH
-QLVXoOjDtrreICZFlXFFf/x0fTkYHA4Crc7403Z9qc5a97ce0owTbmdso69h2xLoDCP6qAiQ4QqtWwtm
-Uof10Nm4ME9yNVHp9we6NGZP6Xh3kwZQlFiLyreGLCqu6s0nr9Z+5kCVbGQbN1fhWRTcIK2PZGNPF2Y/
-mabR7CPjNDaTzOdm023oQJLOhGGM7NOKDcW7QQs+GhM1cNq23BO1zRP5XE2GEDCfbTRTY5CyZUkbrVro
-ZqrIKFg13DWRqL1RtK/w41wS39I8D+SR0RlR2g54IdJVqn15waZ1CJ1vt9c9yFaBa4TRx/k5aM83w/vx
-4xQuNMKb5bR8bcgWdWn/pxrb1IZ7V3pZUVX0z37H3AncT3KYETaLtnPLWGVkyVxbFILrl744CsPX0v1q
-G121nNlHUkeUCZmqhRlDuigmoj2bSFRYvCNrJMZT16y0YYODjbn5fly447Lc+qbXwMwBuSJPMmXY7AEC
-nLy5mwQhFwybOCBS1ETssFW2lvplOptc8/TAlobiLm3kJILDf6plWIvnF9vvHNQ+mVTxDJNmeQqoxJfy
-Oy2I7PjUTtXWAFgw2YAeOyuZb7wGu629wbDcbF32ub7JVb2/HSQmCeB5dnBqyxPNomCuXjtBIN2h4/cD
-bX9Mtc27QNAr9lWbewvPFpe9wA6DNuwKq2bFgAZo1HcWPgQ/fswu8+4qT/lO/CqroMezefBtf2juae0e
-ZxW5YZdABSv2iT8ud7GWgpsfMSDPFNTcHK7dPwpvqqp22CIjQdvxiB2c0M5G4E1Xe9uaBsADgX+ErJV6
-GeP/PgCXIyLymaNZZcjNJ8dKg1MMs2oxzilP3OSoyqxWhdd1AM4eZEcPymMFb5YhF7TDw8NnEg7VaVPG
-Z3UEAMAA36W3XqZ8o7/Nxv+h4/rO0LOd+aaT+awd/UKFx+HB8vUbEe7SJaJrsnfP3b4VJxdQ18gvpLz9
-n5LMoYScCUeLjObVQMHQ9OziNsAy0XHoj32paI8N7cXFLL8CsoNi6bYEcNoCuG0BPKViko9VyXYmz3il
-6qQ8m54sxJ9cPn3X5WJJ+F6FmHaYVp10MZJM88YJ0zJ8G1obZNQA+oxYRMXPUXpp7Hmu22/TS86f30vO
-599Le7OI9pKr9lIcB26rseT++b3kfv69tDeLaC+pGs+LwrFvtekl78/vJe/z76W9Waz1RnRed7Zz6H9F
-+wq26+O+Qv7iwTFBOwPC08hfPIj1YRreswjq+QtxuxIJ7XsE9fzFQ99c9/6AoJ6/EHcikdCBTVDPXzwE
-5roPPYJ6/uLB5OLZVkBQL97QvuQXIfkiJJWQ1GgUxEfjV4jx/SFDvl6xLGeih8Phg9P/qhsMv9pVUwRS
-Ch+PuBHnjkrAZgR8G2LiJLkIQXo1bO+NtRHPrordY7+DPsTOF4K44LXgeW+sjXj2VOx9X+pEi48T3rNt
-WN4TqeyJXISr3ipMowkyw8V3tIOcPJ5fHeFXZsHoioNyjQHBieIr5ccky+l2EUbteX6RTEkp24tmUxHD
-bxXb8R9fBZm8qR6ORfC+wxsdMpY/zreHV2He7PInsV6PMntc4uOLDnrixv8xnfP0hOEiRfaV655qdsZN
-/kplXMqzw6ipNFS/Wiym4I1S3bxlZJzW9s6QUPbZi4Z+O8lhjScvcurwbx3bdd1H+tx7IVPaWjQlXpX8
-NC4QNUFwr2bvke3ea9XwBnZrWj6Tmadq+v2w1VuJokd3mXE3+JqUz5K9MHt7PcJdyp4b7M1nwl5NK1ad
-U8dnj2S014hTgtGaZvxc+MuG0c1UsvqlNayO8+JrX+1TmYAQuYOnItd3jpRV40lYe4yJ2XKrZJ16+ox6
-WZsYUt9USz6iJM/ksGK+F57vRsfWQWA//4Q3Bb/aCWZ1XwRxu0mWTMUHxyW96maTvrZMLd6iJ8nL9pRS
-iZjZA+esygMRJR45FYKEqKs/IpPT5EOJPg6jp8soEWtnt/KbQ41tCY4EOtWVOOJjbUqM/9AFRk+X7oa3
-QzOBoM7FVWlrpcbgFfYMjeEaGyOgb/CoFTiw4xI+aiFwfxnF/IcWtGw9fZLMVmSi1XL7GPT3sY0fPIdm
-l/+D7wsBr1vbCiWTkvH0tOaQqq0tpd5BG0I75+bouXd0klhuHo0qKZZNGZBPiBJcoIeqc0JZ/h7dwJN3
-JdrqXYmytVayv9VJdZZuSH9UJ9X5ckqdQLfPNCRO6eeTm7qMXh4QRA+XxE+aagjXop5RiW7SZCXu82mi
-NfdMYFTX6VoKIzPJOlHAO785+UZpjDLg5ojwM2pmW6RJazaYirEk7gKTE2eqCcN5kSrR+Gdw35J6R5Sa
-OkhcJpU/pMpKCYiKl+LafOYMpDdJAm6+FIpJIM2xHRvBkMtksNsri3uY+EOiLFQDlQeu5DXOo2r6tfBK
-7z11PuTPJEcxyDeJZmzl9ddnQmWraCl+IdKgOErUGMmfkuhXTaL13yDRb9mUsGX/Ewl+GzHSyGCZZAbN
-5gteYXl8a/HJ2cmQkJXUpzuwOlNcdGI9gEUcGxoCGFl6DJqMZzashUl80szC+bTesqTt6PhNvcLXtRRu
-sgYlswHnY7TK0lcC9nJdL6JsO6DlxAOt8Hg7m2XcafehgVJZ83JvAWy2Bzeziv3uYDu/62h8FYEeJG+P
-ZjPUubQ81JRekbaOm3eMa00v5rlShjnlQGfVfMIMIateagX/SO9Wyd8WbN6arP+tZc4qSzcAro7zZrtP
-ecRUureiIXSWWRhAk6ne5FSRJXptEql1KtwjjwBPF9ch71eTRBT7x2JfHNxBLzWVkMqur4qVdHOVEaZz
-Y76yVRXYmH1MdIlFj7arQ5x/YnhDnBFM61ZlslltaarV2WtmZIr1Ruysgq5TFI+KB9g82WfQbvPhUzVx
-dCIp7hFVRgzab2wipjV+Vh/tzjtdX3GfUESixSQaR4PH3va/Aw+VO6MMglm/9rS9ZBpSENc0UE2Dd+uh
-qYEPeSqcQCCFTn5dtjg8pF8CuC/XlSHqZelvn7xeDSnsslhKH7G/Oi1K/0ltmCs+uQ3xcZ879uRtTMA7
-KD37r7+G52fLfStIDPTJBg9yhWWhTLSa1YpaXudcsyHXOJLuS20PkM2ZdYnWB8l6vVwr3aa3ozIY8hOt
-XGxceUzkTWPS96YqZNzUjjFEshGPFx54F/7dE0oD6j5XzvGhjQpFQz3QbS5OejtUTPQtXTQb64RCjnKj
-nKPJszVTWVX06wX4I610b8mMOfPNmPx08niF6R1+GrmYbg6HQy3nxnDQzf4/HKr3GjhCjZQ39KL9CKTd
-Qg5jSmcuPXlSNLBgcoDsRLjQrr6YPmWh6uxz4bejARypvY2nBlfb2SbpOJalblwpD8siQYjmQBIjPWYG
-1hfJTmsBJbxUnrnlJgWZLwvyO3S3g0mQpH2bdPqr4RDJ7MMe4luHi1xtSPq26gpczOzDmft/jwpl4W0x
-Uen1nHi1+9QLyzJFV6X46VZvc4qeStHDOfUKnLaFIe1mjcOBeeYMhR2ZZF8l2SfQVjTRiuRN4DehGag0
-g4KmCgl608u7W6frF3TdGrq2VllxA7+DohaU+WvXyvHrlEu+e+p7lbSjde2Q91A/lwukpUVTi2oNUNqC
-azfIxQ6jze+tf4pVHDEr5Te8i0louawhP0YelQliRAQLWy3ZpOskjSb6Ukn2GCla1az8IC7xzFMM7JRc
-LVEym+Hbnav3Pe437pCLKrC1HHBZBVzQUcpD5NmWNgz/HhdhQMSZK0QyzldpMdT8OVIWYs7bGkOt9ZjU
-ILDbYPGaXvt71VdylCxjJ+uz6ux2nqm24qF4kP/VYR0F1lFhnRzW0WFdBdZVYd0c1tVhPQXWU2G9HNbT
-YX0F1ldh/RzW12H7Cmxfhe3nsH0ddqDADlTYQQ470GEDBTZQYYMcNtBhhwrsUIUd5rBDRDYsVTgsTTqs
-Qjww2dKES5euUrwQ+bJVAbM1CbMLEbOdmqQ+Qm0DZ/xBGx0BmqSL2zKg+YkBps7ks2qwaXLSW02Wi0Ra
-W6q23yN1zspTzx8zWgnMDoq51VgmMLso5lYjncDsoZhb6QECs49ibqUlCMx9FHMrHUJgHqCYW2kYAnOA
-Ym6lfwjMQxRzO+1EDRULHyttlReFnhiKbXUbhR4fj2bVB7DYPBLYVR9oxMBTeLOa4zfWeRKp2mINGGC0
-YUUctSIOisfBK+I3V94SqdpiDRjwZSvgqhVxUTwuXpFBmx5xm1WkKQMDuUc8tSIeigc8hYV9/hi5xa9h
-1bxmVatliTDVPgrn433SjnO/GedNOVA576u90kcR9fFeGTyuV/rN6lbLElG3AQo3eIpeGTTjvCkHKueB
-2isBiijAe2X4uF4JmtWtliWibkMUbvgUvTJsxnlTDlTOmWugWkcLt04W3jG2/biegQzUlzOyRVWRMLeE
-wW/JflOT35gJjX3N7Nu42bUJw9+yQg1Nf3MmtAr1luPxJkm7yDPEKYIvpFzepXv2Vx7aPGgc+1E5aAXS
-hkHNfdPr7VBonZp6c2+udb2d9vXeh0HN29Pr7VJo3Zp6D/bpb7d9vfdhkDuHZLrjDt/1DCIxgfuISEwL
-Xx73wEUcJuWL4WmLQEwGQL54VCiGwO3guNsFYwjcLo67XTiGwO3huNsFZAjcPo67XUiGwN3HcbcLyhC4
-BzjudmEZAneA424XmCFwD3HcLUMz1OCxiNHTOjhDEaCGZ+vwDEWAGKOPDdBo5OBjycHhed0+UZCmCROC
-foNAjYbLISrUKubUKlTThAXFbSGCNRoml6iMyPn3iQI2TZgQ9BsEbTRcHlGhVoG0VkGaJiz4SJRGCyrp
-mHyiMv12Y6dV5KYJE3117BDBGw1Xn6hQq+hgq2BNExaU+OBArcwAxzQgKhO0GzutIjhNmAjUsUMEcTRc
-8PF/KoxjZMoYyNEgh5Tl+XShnCY8NA7m6FbMIjroTw7nmBkzB3R0WNJB+JQhnUZsNA7q6NgoJ+GThnUa
-sdEqsIM4UtIbfaqfuXV/anCnFZOI22cI8CCeV0399wptPSbE04pFzUs0BHkQN62m7pnT+KcGeloxiTiV
-ev09ErVXV/+9wnte+9rvx6Lmg+p190nEfl3d+/uNe7997fdjEnFZ9fr3SdT9uvrvFeLst6/9fixqHq5e
-9wGJeFBX92C/cT9oX/v9mMwcYiTIG3jW/uFc1z10y//aRnZlYC3IGyebK77vuHmUN4eg3zwqzkthdwjs
-7SK9FHaXwN4u1kth9wjs7aK9FHafwN4u3kth7xPY20V8KewDAnu7mC+FPSCwt4v6UtiHBPaWcV9yOFnU
-eGod+SVJkEO2deyXJEGN28dGf3WC0nMpSrKP9mwZB27GTkCpYiIirGN1qEra/cN+8d/gU4WHm/Ejs9Ig
-Vqyjdalqtgp9t4oVN2PCaRQr1nF5VIX2s+0tA8fN+KE9BSKKrKP1qWp69n7i2Sqg3IwfmZUGsWUdbZ+q
-ZqvYf6vocjMmfGQ3oB5f1nENyArtqTxbhZqb8eOT2pOIOutoA6qa/T21Z6uAczN++qT2HKrVHBJoh1Q1
-Wy1+tApHN2NCmRyS8WjEmlqkdd9TgbaMRDdkKSB1qK07MqTrQLoywz3VaMuYdUOWhqQmJePXCGbapfmU
-EeyGjLSKYWNOoPwKiWhI0vunRrNbsmtwXg1xbcxlrGsTxZf9U4PcLfk1+bqGiDfmbNa1yl7x/sdEvFsy
-qbnGhog35pDW1V/xlP/U8HdLfk2etCEWjvmvda2iONZ/ali8Jb8mx9sQIcc837pW2Wt95DEx8pZMan66
-IUqOece19X+kWXlMwLwlvya3Xm+VgKaivkJWjh5pV4L2rbIvv6ZZgN4qQ5qK+uqJ1pOG7ZtiXyb1SQPi
-glkGp0Z9hzhhjzQtCk/tYFqzbJxjIG1jcvjqHdThIw2MvY+LujfLypTk4b++/eYvnc1yu46S03C1YnR+
-++XN3+ZhmvDE0Yfz6eIw2mwO5+Gq8823//X/BAAA///0Vt/i0BUCAA==
-`,
-	},
-
-	"/static/css/moggio.css": {
-		local:   "server/static/css/moggio.css",
-		size:    3628,
-		modtime: 1456790235,
-		compressed: `
-H4sIAAAJbogA/7RW727bNhD/HD8F0WJIXESOkthooXwZlv1BsRYY0gH7GFASJRGmRIGkYjvDXmMPss97
-mj3J7khKli3JSIAWLQKLPP7ud8e73/H7TFYmyGjCyJ+zM/9RcrGLyPlnapjiVJCPiaz0+Z3f12YnWEQq
-qUoq2sUN43lhIrIMQ1jSKomIkAkVF8co88vjDbsePLC8EVThfqPExZU21PDkCsH11ajpYiOzbE4y5GEu
-zvHrfH43+2s2W5TePuB44MWRtUEchjYar+bPsHazrLd3Z1fvyG+KZUwplhL0SHCXvLuanaVc14KCS14J
-XrEghtjXgLHhqSkics1K+Ci8W/dl7bolWDBsawKjaKUxVCRSMbRjBoIIdE0TXuV7fjPk86Wpa6kMJodQ
-IcgfLP6VGxIrudFM6YUlB/HGaw5psQGVUprCItHKQG441Sy9G6B9oRlVHGxScl8oWTKHZUkqVqWQV8SQ
-teElZOETy3nMBTe7EWY/c0ib3Ho2pXwOpN4O6OSK7jRUDBtB+PiTO+yul1HTKBZoyAyc1HDRguf03NbE
-qUJ/kLE08mUFfjsocHeafML9Xnm75aBbHlZ132KymL8W72Fj+tMDxic78dDmm7NeTWX7M0t5Uw7J79cn
-uTuTb079/RT1H6RIh8Tb1UnaaHBC8oysg5LyCknXUnPDZQWtHGspGoN6AQYRQUqAZiToyIcQxAt1JDNu
-QznioQOsac4CEDPDKoOgJVU5rwJnfmPPglkGbcrUodOMb61ytI7CCS+d8L13TGKarHMlmyoFv0KqiLzN
-VvjPenqrGVufduRxOvHsB+ij7XlPGqXRSS05BKn2TgLwMJnGflBdSrvoAMLQWLAF6HWy1sTY1LSEViuf
-tUObdIFpVlKgrZ8Mtx/GTOMGnFf723Dea5qmVinDNuT+iPJJ6E+Vfu5v25tcQDJMQHUSRTTzd+qvPyJv
-/vv7nzd7V74KVsfOrpcHYCmbRPv3VWiJ4MkaExEV8smDjdzeopIbRWuS8ic0QdNMyE1ECp6mrGpn6X6d
-CcFrzTVO5IIbZqep7WjE8X3QxOD90dbaj9TQ35HHY8EojDoyvnsPuI9tMw71bBo1lunuQW5IL5Cx9Nnc
-nezxkSa7DsPvei+P1VHLgUpBiVAVwLhNOfi5AKmyMJdE5TG9uFmtLsn+Tzi364fL8/kLohvfhY3HAtwJ
-dAnvqNEUvIbuEim1fzq2/dWTbO0dIoObfge7JOKhofUvQKx+TNpfZNHVavcKdE+3Fx33lT4C4p6Sr4Sp
-5Ck2dSNEYLNnK1ZICrHa76lK8wXmqsiVnf35HHB4A24xUw6aJoY/sVONglzv93NmOERRl5E8kB7X/6EO
-9xugr9jDEWOLJYR6tv8Xq/lhEEf+AxSQEyRubI+N0xi4tpLT12+v1Vak4AGeA3rC9upmh0BguBH2aXKk
-np2OOzNewvyenGJeBZaHA3PZH5i9q/Vj6/8AAAD//4Cr1w0sDgAA
-`,
-	},
-
-	"/static/fonts/MaterialIcons-Regular.woff": {
-		local:   "server/static/fonts/MaterialIcons-Regular.woff",
-		size:    63988,
-		modtime: 1456790235,
-		compressed: `
-H4sIAAAJbogA/2yXU5AuTbOF37Ft27btPbZt27a+sW3btr3Htm3r7P/+dMS6yKdXZ3ZVVGRFusuLi8ur
-qMgDAO9PADgAAABU0gwAAgAD/p9HRFyc8J+BEQCYvQcA8bX7kVQNiouryAIAf0n/vcb5J1wXFGYvCVEx
-8X9M8F9M9E+kIM4AeQkFeeV/zORfTP9P7HuYt9kSyqrC/1gyAECSAABodsb5NrHIKzMwAwBPlP88/P9k
-AO0MNmtsa+jwj1n8q50JAABvfCoL8VuYGv7L9QL6z0P9v3ygzH2kFv/gP/bv/wCY/6sLygAMYmHr4vGP
-Sf77jgwAAA/TY9bEsjX0+Jfv7Z8A4P8TiJACwM7Q1vQf++cFMvrnLXI+e3t0sHd2+bcvW/886P9E+BsG
-YPHI7u6C0kFRf+XIXU9EBE0xcRiq17CdX8FbqvwrdhlUs7LQuYIoGSDI3hRACFwbQXIwSE4czXNQG7lS
-aaCrhaNta12zLeLecm38qcJwvfaQy5NE+HL++LPz+/vo/zsyzfc6ndOVe+w9KwMFJSsNGQTpq3f5dxNW
-6zAZNXr9Z1jGN3OxvEUKUklYsjR8/pPM8AmI+D9+ybD58PLW3KcN+hAtovNnJUmeMVdEXRPcVbUVtdGn
-FEnw3i20WmQpjnb4nh28xQJfMtFimhDsCB4ysuSQVEbkClHyBGlgUmip/OLo4i5RunMGLFosIiyaDOIM
-qgwaqRTaZBOpVMkoMrLE50RqqRyT8KP8o+SjxCORidVEAlLe+ZQzMVjig4iKYRcj+gKi/0JixklSkUaG
-xQtj16RYkSUi0A/wDkQKU94SBEmFqMcJG2mwxF9I8gglEuQTlMczjnSYM2TIZkzYO5J1pO3I25K6JkSO
-HBszukX1yARIBYj6KDYpg6ALvZT2lwSteJ9p2TIyRUqUYCp8Kv+zKs6ssF7CWGpbmlvSzSwVOYP0guTL
-9YvKFe2TLPuL1ZyjJ8pQEH3O3Bm4I3tbJv+37Mz5afMJ7QPkg6Qz5Se1V7aNSUgGcjE80uSDRSQdaZry
-BOuE64RMfAgzw6Y0nsr8hZH+xPaEe3Fyy9AvzS2hsmSoWF6qTFo/JbMkuriqmGzheWFmQXfhe4FloWpB
-vnhXyj9lJ+WHzNikxzTYdG5cL3kmad20ylTCNM0kb+pmHMoT4R3wjvJQ8MD0IPWQ8QD24NKh3KuiR1yX
-cpvSdWR+fDsukOpP98sWAHWGxshooNNfHJAG7AKkCSQGpA0SC94M6oN45wuoi6dKhm4xaj/wFOsklmiQ
-aZBTLFqr4yzxkHAUuY85MB8hCFTJJ1bAwkTjpHbQ2gvqbxa0NrAzECLE0MBv53PQ37vvdw5UBxGAs4H7
-Yrp77RfoAnVTdFN09yycaqeXd81YOg6ld+606OLsRHVdXzDVT2PRrXV5r566pqeMgIKGhYbBRmRlPpvI
-f8u3FGoKbhY6IZT4e5B/LKSj4Kkgq2D8dyB/Ucge3DNoB8pL4Kwp/zbYD+aDJB0aHgoeJhYeH4uX/m19
-73zgMWgx6HoAbEDHwfTuZY9vACJIHjQPoRf5S+pOfsDfFe6s8mqjzFpllUg375K757K69rGz1GXmyuo2
-c9VVdnWylqcT/+riwqvSWnq1rVPv9kN21d+dpMv1eqVI26NNootvnq50880OpYtv9/rYrs5t6qy1HGe1
-pnEz1g6is2fl+tqOo9N35/rTzr2zxbVrN+cG/2+P92vu9lDX6y1/8Wf5jeN23vWP0+v6dss23DbeNoYr
-w1lvIc+qr/HrzHabq/2FX/nn+o16p//W99SNgNupyBGRrohXCC86M7uJVgS5KIYRlCZuI3cxcTG7if74
-evwUEYewlohd+BbUuzJWPqzii5I4FQR1OJVxyv2k1CTWpOgxsGmYUwGzumb1Vv5WBW9Nd2l3zVfbn8LE
-KmGVhEqiyvtKy0p1VfRlpWUv1VCr6EqhFn1XJVe559wLlGWOSv11gNqIeOPQeuSV9BXwyix6o/UadGsg
-X3+u0a7JrrmAsb/RbfikSFd4WLh4eCxkDJwvNyvRU8nf9QKqgta/IwUlBWgF2iJZwghhvegfamcYBW0i
-m8K/F0LPZxsk1RImcWPe/13cu8Rh8SF7054lrAxtkZSNReGL8VF7LT1zbhV1jGnz8V/Mbxy6xe16b91S
-nWNtNfnPJfDl3bz7zHHxPfPF8Dl7059rLiVdai47S8wN8cm/+nSdQvAJ3L7bznn3BHnDXbA1qCvVdeuh
-9cC/cu9OLV4e6u2+fjSszvX4XbvWrX7viNqL3eL3fD7drHpb3Jp79S3x1Lwe7IT2rD7p7Xa1vsbuQHjl
-Xfj2vmr37F771ryO9Wh7716IPJ3PmyvkEfmKv3q2zsH4njxPnCmvhV82dczt8EN8Zp85t86V8Kt/+qyc
-hvP3vXQQlEJeorEz2visRRs6DyUOuhiO1o8Y0gythNQgVMFt4rhRX9mshdZXGRKE+2K50VyV1esP+iF8
-VtkL7tr5ej9fb7b57yb4jj3nbHE02CvpK+tL7Gb3Tn2iP9NddpXY6+6C+Mo/822+Cv9bmt7tp+suwce/
-M1iWqTS1FGiVwi38Xe7134flM+wzb4ucrcrm8tRSygXfhdmz6bPrM8eF/tD5Xf9d777ivsjg4SBFGEXI
-HJQ89GoozpDtfsd+yX7XoHyIPmQfkh9LN1+hxHB8hDmxmVixhIlYdcQa6jxiA4uR/3BFhDTSKkk30q74
-VtQbWWIUbBRsDC4mFzszvZOWidFR0PiSpISJm4meiaGJlInY0cu4SEKdRG6cD8ND9HhWwm9soiiYOFUR
-cWFhkbj4iJhsZCYWmwA1CTWPlepSWPlD+Wg5iGJZ4U5UJ7Ir30V0eV2h33/C3+l+RN9rfnGvOMv2OgLG
-fVt+VeHwOPxGJjJX5fPGrF5ZM0Yo9NjRi4as6c/rWlnrBnKLFy7YNYr2zhYxE3JJ7NKHjOlPrahFI7Wr
-lkrNauEDRisMLlYxYTo9sn3jVqzFmLgVWl0iSVYNQgTcga3nTXBmupkrxW1lly3sZxzb8am1SLnOR5h0
-6FUT8cC/rGZncGmpSypJZFP5HNiJtlraseCl+xRJdKkyXX1GThtwVIyamAPlqxvPbk0jBUzpA2yX1/A9
-s5WuG8+DYklGAZqXbBXgRfNjr00+MK3YLYzwNkKtgnxbvShlys5FQCvgytpnSzz/rNnxslUs/W6lZzMO
-PCOGDeu2XlV6l4h12BpFsh47uTcysmwX1xngVaPV+keIkFWyeab1nNf1qNL01YNGLTxJeOAQzU08hl5y
-TXLGY6u0wXL2829uwuXFFrI/yLcGqSNnrOds3NKt6xKZ2fA9cT4Ou5YzKxRll1foWSJJsNoGzaXWLeA4
-ufm3sGxDYR8VSHgq/7WDt5+SiL9FppusgbLIcIhZsx7kiduvQJVIVS1Gh+yknhTBuiGSBQ7zmI0/qF4R
-/olNdqkBedGxXtzLpSVHuaLco4OwqdQ173eqaMCpTm8UniOvGYBscs6EJafRg40gQQV3Ro+oIx8xCnHu
-n2IfxMx06Zm3CokZfKBh5LFggazQhWkKbmCbmD0yMTZf8P8yg/nPprF0SPNAqPlNrZVoOeDzYUaBZGtk
-sBbJfQO+LA9KGf3NDQKdilOBllS8RhOiy9Q3PZqn1RIOPtCyBUtG44DA7uLUjXVmRYvN41fwQyhX5oU2
-mkhsLZ22SjOpYg5pNAzMJGgyuJe3fBDchL98X1OgfP0ZvgztaCHW/Lh+bwhQMVZKtN4IiVFoxZv/1VXc
-UlI8ZEdhrcx8rWYbxY8VR4xLuUOypR01krkoxIPntwCtJpJnBGI7f2mzoRKO94SYrBXMRr9cLvsIZYh9
-FbRYypFuiO9R6lFQT5Ahx8Jb2XTumh9qIPF0sMzREU79k2E0P4NE7+HKvq9wvUGrwYzbB/JocB8Uuxcz
-MjpT7BqArKVkOBoUhUWnlcNOBhYCoWW970axcVNlcEilpbIl+Jep3h09B98GXRLWZllEJiGlOpSpAW7Y
-bE0fKKG5xHD+jyt2jJ+Z+cbN33epMq5FWBQZdu5n+OiRhB2h/pusmXrenhsgafN6Y8Ob4YboMQs56dbA
-ebf0UFUuvFQ5VZsZHtWY1Cs5tiQ9c4dMF61wtcPhqJJF8/MYdJpscj3SJUHh605a7cxl+BO3BL1wKJsx
-Huqc50WBPnjxsacFGy1r7jf4b+GmnQTbBlMrpSfxorzRSUs52YmwpOagl7VGOEI++HpF9p4dWkl/iuWL
-4au/1XK8WSNE/10fuqoMMAY3hJMFDQZsSzVZX3ERjpHHyGWBLJSzrcNZmtUopmPAY7CRsE1obe5m4IUD
-ZxF+MGsMCXCg1fz1dxCC/ZkNpntqAKVZ7miDgDV0zel4o3IUk/Nu7B+gAx0scC+GGYULkclKjMUr2ZHI
-7Lc7ItW24e4xaPNVaRzZr0/3QRsAf7O0yfB16RwxGx85WfxNBnoZ9F8sl3d9k/y7Ad9KaMm/AW8hfFs0
-4PxBeQWANUATqwnlGkBmW+O5tEVoYM52CydiOyXCx38iCmPMjkuyYbrwKa4nFohRJxUMD8dcC+DGPzDQ
-PjAG8A+GyVNo8HX9GNYOfDFivIzFH1QZXJYMrKDNFgabjv1Ax8NIKprnrlv+AVc+PdVsIdTj9ypYPLh7
-tYdgPKXAYxchx2Xbi212IUCY0zIH2NOd5SF5g24K7Aya59/fAmFK98DJq6VdsMK8ApGuga3Ctk7vl4lR
-3Fad26ypG88/MPXTD0ppTfdSv7kFl3vzg7sB8yNvYYzjjGJhS8Sqspb37oBhVycOXciE+Uph+CTlI+Wo
-VzV8Ho+6PxSC/sZQRc3vphN6rRvEhFwjNgNZJ5bjAYcwaWVz7x88ChPXBKf6/XmiMIITgQ5EJCyV5K55
-49/JiB0VHhmD6BhutEV1Gank+JTkuWIdVXeD+OGrZ61nUKtW5O4vZHTvt6TyQwZxl279DpyXaw04XA7E
-6nfAN8AAeOXg4YAUAHjTEiNbCLDfpZFUYR6/cGBoVnc1gry+Y06b3Ri580UhIGBb8bRL4MW5Bfdio+Dy
-o68H6gFL3BZA2FhEIQ0aIzSQhXCk4BCY/OBXwXEPtsFQR+Opxo2cvn+lQOiHzJjOhqOEhoP9tp4AggNQ
-G6BJYDdgOKxd+Iz4ovwG3YY/7Dl4gtEiIaHE4k8iI6we09T50uEezwhnVRkOHkD85DQ4AU6dZ9Js57Mm
-VWTajLkOlZj2AdjQFwarF/UxixvXBnDNJxAhHtqXIe1g3bp/yD38swiz1jwh0ZBmoUirxWlncrq/6uWe
-7uSeIuSexuVcZCi4W1H4qNfNJkDLoNvZZfNsnDcWiTKBqqn1hPpw+aHdlq7KbDFB3iRhRKGD5IAlpR03
-GsCa0hfxjUyHToKA2KPMJuk3jIp2JALvhz4AmUHzlE8QmP2Eo3h4aDGzkSK6dOAVhHhDRmzGoEYhZpz0
-YNziLEqUEpZGIUrhNZzJFQBs0EIQe0g2TExAzgqlyle5SPkakRx4y3KZtfP+BeA/67FRmHAORKSLb7KQ
-eMtMQ4TlMPelanGN1/rwKoQb4Su7e6Irw16n9jpcotTeDUl9rEMaY99jSn2uDfXY+7RByd4/zOh6sq11
-K++iPrQBH+Q1cXIt+zaBdYm1j/IACkifYhDoegvwuzliEhh48BDwAxCc3rVFsHRqJcIm/kFYoHw2uPAz
-RiDKAdsN2KAENGkW2DBrYSIVUahBVHRcRpd8GMsgH2iMGPRmNCNQHDdR8K6SQw0gJBPoDBidKEUIdGYS
-OQMLcchQyTjUaMyGCDQYGx5Q4DuadJ+rgWn0eeH3ZM3xeIsQSqhhjXFeg61qDmtHBVI9NjW+dI5yEyxA
-RWDSUOy3EJo5XrWWjW5/chadx+83WbvuGr2v3FiM3F518PGrNx5swPIaZSnW8yBuaLT4OF5kcXkJ8bW0
-rgYc1aBMi1uMatpFmo9+MrhT3CmhoE2P2bQCQNKS8MFN9s+f1rjInIg41OmIbUjn+rLgllE8IJAX3CFh
-b3Y5yjezvSrIc2REbFnqdlQis7CMPUq46V5dQkGSsKhjyAJEy3iE8BFakzoDxCY8Y1xIiIGfiyamScmZ
-e+W9Ktz1iSBSYSLOW1zCfvSAdMFiIGFI1a5Fm9lzk8QQWoxYk5GcwQCZQwQxwzaH2JNbn/gP1m//xoEW
-zBiHDTOfUQ+DrAEOG+Zh+nNMuI/7zG+gEilw3KWOiysflK5quVdaLYmyyu2MbLj9WRBw3KB7w9h607BZ
-BCGNVCgvqHAwmIB0MRgJO4w64sbRffx8mohYIX4ftPAJiT1GzXGSA63GPscodtXbMkFXoXdftmguaXvw
-awj1yCIcfZL+zBOZBJkRvf4bHHLZaN+IfCP+sJlEYvE1vLlS7Ss12Mlvtm7QQFYroJeDVgAS0wZiRuFW
-NJoPLz90VZNusVDvU69pAAmwZNZJVTFMRiiuvywjMrDl+Mzyh2R2BioVTADvWaWxCU31PFCl4TPdusHN
-2XrtawtQtFWLWz3ehhi4oK+b4GM/6KXB5PybiH0eGhC03cCPZjUjq01MfmQQ9R5t9YrlEADEgUH018O4
-3xlKm4JWlwQaKIC6fm8NM9hmD8o9laBM07plQ4wy4j4PQQ6aH43xeLfyx2QJpHaRWs1HikSBdy0u2cX3
-D7lOow060/jjYhAcEvLWHn7D0GCkOgHUnnxcEq3R3m/AwhkGFq5HaHugBQUYdJaEB3Ar0TaVgUMBC0Gk
-toXrBAIyHfDXAtYVlTCxhDlSIqECRFbyf4FzQ7hM7MCcgHHc04wmwRlGStSq/HouYlP0A26kn+A8ZosY
-8iS8uOu5/wzuql+J1uQ0CZACo2x2Z9+1ByyD66PfXgrGRkxqJWG43HJ0KYB1CP6iJWm4JeH9BM6ERoiZ
-zzGV7elLmEmUa9ii58v2fkUuVoLMOwqKhsoDwXCyDwm7VKyaBZaTwcyu0gp64cpzO3SizMHShmESHg7z
-EALG/BlF7Uu8IxAMCGijZiOXB6AePv7KkngQRo+AmsM3fsS5T3MesP0c0jG2zSb3xYAn0qv8NIn84jWI
-MOpUgONKH65pwR6H6rSSTBmNrtuvYQ/kRX5AO2NYc6KOyw1IZaFyKuaMkAriC6IOigEof3S0oDUUk0YK
-hwQU2PDGY+/S8yMQVEEJXxghYGeGYJl83xnlk6FpZhMC1bidQnDzIxSHrcc+0QyR+BdBnJ8U8ez1s4GF
-G4OmUIPeUL3/dI4YoQJv2XD6MzrBC404RnUG3wnAO9WymUPXWQoqE1ZyWGebFaDJcbgffHgYjNi8lUmt
-YiuDCU/Txvx1Jf0dhq0jWg9gAlKhlasOJQQzsPlRG9mBJ1SHWG0cl+cfLzImialhXMEOT6cfxehGIQ5E
-gSY2fAkNQdQUpqDyyjY8g5UiGg/OiNTJ3vJOH2zcqQCHLrhVQRs6/TU44xEgZnYA3zxHnP7Dzr4gRxPG
-6Id5yOD7mXolsxjB9WJSNgCJjvWiCWFlDc6aY9e/QyTBy6LvJSras6bz0TiKmLpJpIXSaIoIDdUKdpJM
-T07xJHwAU+YINwjzB08QBxE9B7F+aMW4K4Ku9Y5+yGqkjo6OM3ZQEinTmDa9cgaY5uaM0hdFfvnS1cO1
-ot56DdZICN1ifxmDF7wB7EbV4rAoVPBf575RI7r5G+0VbsvgBxPqfAr8gBQE73h/Xr/lEr2YGpZWMZ9V
-HZsNoXqFu4AHX7UN8AP9/VKiC/lE6LkBDg8YQNX5shUELGYzWsLKKC3EEE9OoDZucMqTIveWpuI6cHMh
-7JRZ3F2ixatdKdYTHkDckQsO58IBxvTBgBAlBdfOPfwZEeutnOurA5CzfAC4FFHDlbe2WPlwfBGFsgbQ
-9jA+mi4JRKXsd06+6AoGOA5eSwGP3goDDNjNwMeP4m73mtkuSA/rP5IgBNmSQd8BkevDLlISK/hqhIQl
-oXL/PYIiLW/mG2H8R2qgbkHXXd3M7aBTyxHZeOWuxY60a+BtPxZ0DVrG0F18h/CXCVEyZN4emye98MXC
-p4fX4FPXGQKC4pVg60aywKU+BJbP4x0+jJCQ0T/e+e95eAenygD5920NWC+ecWv4+zm3wwtC01VQ37nB
-waudxyUPplFQVVHYa3dR2FCPnDFkLO6m4NcQkkM4n8zKBstzbPh0kYBm2PECPFoR5A1oEd1NAQsXyHQB
-XpBFwL5btOwhS/vgpRJQLRTqSja8sORp1MspEWpQQrpIFuxvv3mEUKpe+GIeZ8+pJEQbbb9CgpNW6AJ8
-yHgLYGUZyAeoj4x0g7VwuQmGCIm9l1ARwG9gKuzsDwaSEgvtcsHRgQeqxzuNHYJbKUe0FfCG0oyg8ocU
-SPczSKkG7pQhphdy+Yw1A0zptCuOpUPjNxS6FREYSA3jSIMQI0g4Qia9Fd/fGWDSqpcH5X3pHIBASeQd
-k6CfCcTYCugrMFowfgoSgSuArppbwGkQmwY6PJRZ+dy5GNBy6AQmyJ2zEhyP0qVkz0bQ24Yu5cIYognW
-pNyDb40nBoxK/vmPLvS/rDiXgPH+pRAEdAPYAdyyffcQ7js8dhQPlnC9RSDckDlwxnK29HA4fG6XtyZ0
-69h8B6ShcPjof+NyAyDMh9coisvUYXhR3HhYhggJiUk5Tl64DufqV4tV+E3naT9TlJgoKmJZlKkhbC+i
-RRSqIZaSbAUFh3ROMjdFJl4mmZcigzSDNoM4g1oqXiaJl8FTiFmXWeSh0KT0iEyq8Mh0giZRM3E9kSux
-I9FzWNwIRVE8GQVNlExRzIa8MpJOFK4hpZzsIsKrMHBN3IbCDZEnUnfYzghhXvKKLDPCd9jvAOAYzymK
-Sx6LKBFpHBLtgDIWiTJsPSx14HqA6pjJKcKKQjeseQB3gOuY2y6oQ7pDukNMj2SPgn/AfA9/T+8Y2v6n
-W6Jb3kdQh4SftBaFAIkjIi5SPkI+ci5iLpIhggGKYf6Pj5iPrI+Ij7SPhI/8l+AWqR1SWyRBZF5EXqQ/
-knuEfoR+pD9KH5J7pDco8UbEbqQAZG67/JfUl1hzEnIioVQisWUiUUnxf8lJQMVBsKRd8UImjOspV+Ju
-FCKSZBXEmszJV0IvpN4JPRP8JpjraSpJ+yagWFLiCd/jEuM/R4AHxQcm5kxcSXhiAoo8QogkkfGCI0Yn
-Tq4gXKnR+IYjSidWrjCfRFzpWJKxJEcT3nfUd9Z32HdarsBsSX4JOWKKJOXxChNgrhifXJ/AbLlZQn1S
-80SGhMijqSOaI1ondq7wbOlZktOkukQYSbgMUasJE+bQpQQv5hQZkkUpkAwhs0TxBdFMKRhLMozEf12W
-W5IiQ8wsKXoclzlNR+YkQXrcpzGAljQtcWr8+AjaiawjipZYPWnkCDuRZDWR7Ii7MVlH3o6QIzFrnLkx
-u5qQZoLaiUVT1o7IerzGhE5TYiUJ7ojdiacjeEvqhtg9yXtc3IlhXfSG5DUJYjz4iOiB0TOE9w8+iW5x
-Fq9cLqE+iXxiXVLZ+OIRVmOGDdFcon386fjjEdgD8Tv5O7pnpE/WU3zahPu4+wTNkeoD34PvA8gD9juR
-Z85XRLeQn4yfsN8fP3E/OT8hPymvCbUHmgfrB5wH7QeeB+8HiAdyz+hu2R/JH9EfmZ/obpEf4Z8/P1lf
-Md3SOyRI6+I/cj9CD0e+D/IPDI05iQqgYkqoYsqwZCUmydnQ+agqhMuh0IVBFbUqHJU8aMJUVGgjSgUq
-CJUUy9EXss8Fg+W9yZnQZcTlktRRFxJ8ws9l4ApxSnGK80uCVojPnM+gbOnYwnAlYYpDFUhLktRhUSXi
-ikUVTEtJSxpWuGy5UUUHFWXlZRXm5eYVUEuiF1xWyGwZmcKyJcjllkuPSzBLLlbgz8RsSV6Rf4r1SvUK
-zBSbKriWdKzw2fK8UrQELctJ/0iTKVMlxy4oV6hgp8hhlcNSJzErkU/KaFLStMQ3KrpNCnFV0FJnaYke
-K3QsGVHHaQkfK4OpELUka4nTlakp0FSoqiC1ZFKXrijgtqRSF6wolix5WYG0JGyIpigeq4CpSqkqZlVY
-WcGoitiWWFVwV+Za5bcqblbgLclZYbRkbYiwKXlXkFsRtORxC+IVwyuGV5AsKV5gHhduLTlegD5juyZ6
-5XCL5ZTOKsyowLimegVzS+SU0StgVHBUtJVLL20mh3fK9Ij0SOsW1CrdKt4qEyisliNUXC5JLMFdGF+g
-XChfsFw4X8BcSE9KtyjpK+or7yrsKu0q7ioLKHBUaC/ZXkhcUFyYX+gv4V1gXKhf7C7ZL+le4IeWs1+4
-XyBEld0o+yv5K143h5BbJJZaZJpZUNEVHbsAavJBzuldEPFk3Eu65rfPEbWkqixS4Uu8//Kd67nYdyLg
-ib+WLLtw4glJLCi6CHwgdQrwBfgl+/0XWwqxEHw2dk5xNvCE6EXJ9x++XFy+xAKTCy1fFL5sXEFe8djC
-4dm9Cy6evICYgKyAiIC0fD7Fwtx80tnEE6oXdY6gfFFemX5+2SLLGcZ5xpO0C/E38zc0X3Su7FzB6cLj
-2ftT1ROwlgjFvBZ3hlyBa3OuXMnimcuGoPnfjOYE9nmzDWHt89DmmNZF+A0p8/n1J2Lu8NkSjEXPJ2Tu
-PPp8mPOSJ2Yvrp4gPam6wuizZRemnrCTsxYXqJ5Y+pLVRZyzmCfyzv/oy2jO/nRG7cioL2qfYXvR6Eqv
-zj+7gPTE7Mjd5rsvdJ1tP+F3JuxIc8x/lpfd/xohkp0zeMowSzJrMMswKzH/eSg26T6ichCaC7zMAKc3
-J2/PXE/0TARDrhGlkEoykZRBrhqmn0g8knwgBUs0TUxJXEaWa5RrFDpI9JEejygZtmPMH5NioDwvyuf2
-4sSGo63K7J2VMT9hqmPpkz4sli5mW0BZsDf9o1lsky1Dos3QReL/p5clX7s/P2AdqATIHuIrPx81CQ1b
-TJMQ5c1iL7s/qn8VZPf2QTitRrcWFAwMKygW1BfJp+CybNtk+2f522y8Or4ZsS5RMYyKgoYlRTtFPcVd
-1VfFWXVT5dcIqpWDJ4QPm5v1SfJMu0Ag/IFviQ/8GXOTpd7cdM66B/1Ja8vauC64h/4F/pav5rWCXzRv
-39fqw4fArcX+ttf4c/aiq94+NFNJXt1e9VZlF0YffQedKNqDnJhrEFURl4vJSeLoeJyB+FZUTBQu0oto
-EikFQxXLtWd3zy/v5fM44SbuP//hj/FeCbsngr/+Tr8j3wxPfTsEcAYQonCYzHvjAe4QzQLc94qbRapx
-nQR8v2efcTFe1Q8Ni/OB8ySF+rF6uNuIvnaEXPWX/YWBu3B/8Jp/7/fG9L0KN1G0c99sHb72JoJZwM0R
-6Am+NvZew7tZTVbjs0RaRBjgsniqTT2uHB4h3yOUSX7YnFxhcZoYTuLWotb0xWuwtu2fpuc7itrifjxk
-rjAX01/zVPi53ieCKOR530fW8I0Xx364bkYcxQ7h4z7NfJbeiu9VD3cx/SnsPLaqHMkc60ZaRr+xCRB3
-efi5f0I9xhafFr9zc3+eBsvkBVieMqLM3fger5ummxTnbnxeLqG2yXm590Oh1lFmZX1sDqojtSlyfWun
-nBl4Xw+L16ScyVXlPqwa/ZwGjmaS4uT8zB/mlIVLl+Q7WEtsraaXuJSlS394DGUG6xYLyzTLcjJeZh5j
-MYD10j8HozB6/fbjzlOxdM1kMhr8xC+jHRlKuz2cyFke7zOszfFzPc3Jf5VkjAampPU62C+r1h/WD815
-zOzzfD3cHq/mTp8yijI2nW/eOsxPnnPbTHPN+ZkEbH6qHq1Pf++t3qpo9Gw9U/QZrQaewLaCbGFq6d+E
-UIo9xZtEF/O5qjoyUZNZhXkUszJ8eI/ltxG3jbZNt/M+FD+Ev8MrhCqilXelcdJKpWnkH0sR5P2ZfXsN
-A/YP/0uHVRZ3HS2Y7h0dGBWQhZgWOhFJ/UkdSXVeGVlpWamCGcLg76zfMWtAW+NvcW+Iq8e5kri65QLG
-m+PpjiXIAclpyoHIKctOa0TVNG7Um/4g9DZzs36pi/6g9bKa7xbLOy53PnB2Ow07VTvJ1eD1cLw+iPa0
-2RnubvIs39tqREhD0PWzHclGg1CjVkev5q2ndlkOUM1S+1ZlzadsONutolHPo4On8yvxGMsUs/Y70NPC
-6WG3vj5O1CN7JyshK1sJranSaetxETwfX3ha0L0kWSmrFWgn8hyyxyni4Oaw2xy3x261fC3Vn8ylmaLh
-0Xxx62jWUeXP523hrfKRfmdAG42Ko4FQm62WqdrSXlcSU3Gty9QxrZjcwW3tMHnkWAZa5quSUBHQztHq
-KfBSf56/SL1oq+xZ9reR2nl4Sb21LmaLW8K0Xlm52twwvUyv6dBmsP0ucDOpKW1tfLnu3Ou841behni2
-+T7z8hZ5DYuEKw2L3izeFLfJapnpzNnKberxG8vHW+fpXcfqfozWDXY/vuLu6Dq9Odoue53efRQz9jn8
-GH2MaXsVv+Xrfn0tHfXle5Hdel35OX1lWP/9+HlFUIAwI7DgW3s0+Bm4ha+A70DwdeS8uHsakobnwDv3
-Iv0ZzyiqLFKUOIyb5e78OAsrOo37Oc49mnXK49o59Bi4F6Fgz7Hgv7+0hqvTFdD0ejibuNVT7+G/fj6s
-8/emfluKUJ7Ls+J2PVxiKPvhxvP6aFn0z5biNeZpupjmMP+xudnlbenoftd6JKCT1mJvzbbvcHabXB8x
-t+9leoF7xMnLScpuyv386H7/uoY5Cu2HmcCQ031bHcmPFqG3os+kt+vyuCf2ct7Iytrdej4ZadOx73GX
-8rP8KulsC97h/3nxnTPO5ZVPOa2aIqGf6/V5zim4DevLurspdN/1fd1JGauV6Ut0Pl5YDqgKrpVr1/J+
-Pqz68u7ZwXnYib7F2vU/D15DoOZv974usU5Z7f1xuAi318tz6DkqIiLI4N/wCu5tOBllIBB4vt+R//Bj
-fky6kOf/OKqT//TnPlxczNv5af5uSp9b8XX7ekwTqPvE9WW6nqrV5/80XU+r2dGxt6ZZxl7BXs38m7lg
-Zc+36nVaxa8v+x71eTGu/vvDfWJ9uGrd9/OI88wh4I/zbbvStuvLcdSVeOvmL+//O/nKtXv79VNDYPbl
-cFD6cJKZdWr33MH2sIJrB8HdG7zWUGR7Bm8lYaHN3OkpoNkZ3DdZeGgr8n7YaBD78/uc91m7GaPiDsXx
-x6VAFJqtEJhdeMD8r1abdGYo9rqkWP3msWMRcESajgXhj0TFoEJGWoxSc8WZpJga6VSVjiTZ4sO9TeIU
-HcyLxxY9sBdz/YZaWnIcxsfBFPFaK2wsg0W0WdDl9jPlB75SKCFw6B1R2qzOhATRnL5Sa2EWZZ7qT2Z8
-/rNkigBpe8mkPh++epDK+kWC3kFXw2SdAkfGA87NRXe0ctXxcuNG94VY9MMDesWjySSP8uPJzF5uEo29
-k08bGAynRFbUYSuTT2+Y65KZ/aaadpss3pU40KruqiJgn4Jy2VcF/PKqbA/kTAL1DJmWau2w1t1bJ0Rj
-jyZUpfw/OkptNU1NPTXNjubqfIP1RmA2apgaHfCnBVMwsGOHuupqpRmJIlH2FVeZl4EF58X2sqOiMhks
-Pq9JHv3yjuAU2WeeY8nZKWyc959ygbawjDy7P0elEyTaxjc7trY93hylbEo5IxzRJEL2KVOThsUlYBcG
-tz3kczT0rz2IcWUT20dBeRLrOmulQRXmf1L00dVIydpN+yssnCZDOsgkYVJTVcPaJu/+W8a3NF1vDHLg
-33hHmEmLmkmLnJkaPjn+iztChLapj3Rl6zpAUPIw+K5j/B6kpkJ4aH8PZkMTFZdAjJbtQ9OJRgL34rFt
-l7Z5TcZbb3gnUWB9J2iTqA7TR6oXyhz/5+K5EQR1oLxBdsTJByszSmXaF6zOiGnkUIuROmxbXNkTLXwS
-kz+BwThwrwC+DTkhqh8D+htcV6ByD+c94co4Sqht3WLkevNKkLbO4mK76e1N5ybVm87BY+TvQvdQr/nc
-y7X3y1pb1gx7lyYfeGhDqbh4VcvsXqmTsvuMg+VxTIzMR2D1agd6bcnPMwjEkcWPL1ZXoraikkpim6Wl
-p6Hyfu8V3fpyhyqXqmcXzKWa3eHFkX0u7/tJVt1MXq2bdF+2l+dSOZWyRAXXJblzSwu7r7bcdWLm12QJ
-TlOlC9ssnydaFXU0zb+rfyhcPoxCCi+6Ee9ggn2bBD+bZudUenygk09q4VK+YdCSfnbaRutrjgJ7AF4p
-v917kKtDMSMLsRZVsiXKVycQ+ozCoMmbgDN2UvgGjzdUpaH2FoHa38ZY0Amc5u0MVO19E7FhhCjZIT6A
-PXy1609cNrfk+yZvtPD6TEpKFoc6Oq1i3b43szQ0TBotVfla9c47L8ycl19wWW+4HVXKc1er6IpVaLCK
-Y3TGE98JyMXz1kdLifUxxEJGN1qHWEe2z+jUGN++pdJNBszirjZ13pmXvFpXrd1QqtM7x5D7tZGgJLLG
-kohbfzcF37aNnDrGRDyQCgG+RrfXxKTPuxyNsmafI0+bCP6VmKloViVdLl6U/HZM4bsFrcc8VG58eNXk
-o3yL2JBRMgaMccmkbmiDFPOxa6R66ZcCMox4aeFfGtVamqegPzo0/4VOKSvs/qqARJmFU71pN1w5FPjT
-KhjAQskQB4cH0W9q7uwJg1vS2gNYzkYXroFTcTR+75/4UCnkpr0RxGh2n4O+ENTzzZrTZPfQlJq24Z06
-fOO03h9z8Xheymk6ogob0ZyOMSj7aZy982nifTnu60PFsfDdVkZi8oaXi3U8R9+md5KlHDtwxuYyd3iN
-R9pxuCQo9DendFjwe5yKzEYoYFeitYPQ+o4LNz7yha4gwXbNoXmUmg8HJI9tn5XKO+gyj+c85rGw9Efz
-DAMjUoLWVQy1AWosWmsaf9iwWXGu+KKchzYORQvxSeXuMA44X/PP+TBeqO6rcUbIpJoEE3z1SIA60T0G
-KPHBj95YKAkCzR3aRgYIHVGZ8xPyn/JPg9M17khzOkcWb4/8E9+kaZW2T1TbBjiHD0GCZDvPS7cYqaOc
-7x2VXFia2ajI1S5jHNu8dJn54W+GKZtdpn99C+6JU9TSlSCvgOMnjcvxYnP81VoLTTwnoOVqeQNNcS8n
-Avm84UOGvkURXCx6COCAwab2D0vKq9FKo1UOfBImr13D95BpC6E71XasoyGsM47k/hxMdZ+lJu/CbGbb
-7RxeNtCpMaHnGGHU6XSmTyU8mjKPrYhtLk/qFxy/1LRsDipm2HjL1ejO1B3+DXlNwzUJuu3gDbfXh6z4
-LfnVfBrPUdeqX6Kxxyjk1sDKaxfbOFpfWDrSzK7ADyltnOwKLTJwwtDolYGOqWeACheq5wF9pRzZAUUI
-3C7eAAm/ETZvVrKn9B+FQwYXKYDFINKhWoL/jgOfAKfCLjprd+2qUI0vEOU8ptVUQj+1OWjXAKdK5GYD
-D1A6uWD00cjXIiG+EXOomf48NZdLZ0T5ne1DIH+NxHHksN9pd3w025KQLw+eV00eyZS5lVuxiA86jBcu
-MswVf75yhNOfW+XIVOCrFKgF4h/qm1SmlSeaNBFGlQ8sD4woyHqjnTJQqabZaygN2M4i5mRE21o2oGAf
-OR2eFB4JA858CKICegVd2HdVpr5axF7H0dlykAsHNLUftuPsOoXvC42C8L9IgXWKxakSilDzyA3cfgTh
-xbmi0RL0AhiRTgAr+B/o3QxREGRIuOzlnrxncric6tgm+pNViQ5kQ1NB/JLzdMkDo585PERrrWo+Az9l
-sGxN8lQKiALYn+N4PNSvjndpuyabRLtdaetpikX7ewzqSQlEp8HwjfI0YTVGMeLkxvo3jvA/VrG3mxT2
-rEzs2kLgEP6qGTw2Ts1YtqMgnQ3oXQ0QDCLYfYnBCwIKOI6e12eY3rphA6nYITBdAw78ImEFCYSae6BD
-HZc7GvDHLAO3LVj91zoejkxjXz2GVG29JrqRX5OkDvRnADycKV3zYTlvJwuuvq04KrvckhsgOQ/xQFYo
-Wve19eQOsVkBMuuNhb/DeKmf2GLGX0VWKdsxe39rxiHv1+VjUWzng/ZGmmDQszX674wRwRY7WAwAY/fU
-DBCJEfjvdpRvMyNTTmoTbI18OO52O62qfjogzn+EjTXouyzmo3Q8lcGbPE9Le3RQXrebcZha/t4pzd5n
-bJG+0xRs7dPyEtn5PdKa2VJBRCdC6PP9RDJyWtkBJYOUrWyx5zwLDMkuTPvwQ3Ra1Ph+gr/m1I2h+3Ff
-Xv7R0ObTw/GfBpkCP79n97g1hNFWYJiMjYoRiqxZiNDo99FTITxGsUxop4iCx/zTT1/P8Aurx5y1poZU
-MhyRzipeCTdiAMbthy4S+62NkF/7CfUEhz1WiJjcf31A4ZQN5Hr6hsgf2FRYgduWt3/bVj+GeAzckhUY
-1hD8tizR3mkm6uDaFOsQ/NfqhHZ3ODfT+YO1Cb1napafzxy+1RATarlrUxBpExkoaUtdCm99Hd4mC9c1
-urWip1aDkwFNH9xPqLNGjjVks/ES1+pn49sCPWVgwFD2pZ3vWJ3m1uDwoGZTsa/pBBn3CYg1OCefU2tO
-TgV2tWku0M9zTWoNWrdygmZXV7eFrq4UkAt137lr4WC3rZanravdVvQSpN1RAOmGkFno+bieeKAkV7Ol
-c5SakgxQ1Zqk5EBLSU6upts/ukZ+WAh1i+R1s/Hm2V1Y2vkx/5ran1yNipKO4nRNrG7HjCpUlXG8t6rF
-1Grb9aeq7fl+9bVvli5XptXJ7cG7qTSnoMkfK0xU1UHizFyNKqX0KM1U7X2dVwl9uBsADX3oUeINV/Jp
-cskU7uh48GzH9QHK3eDDK98e2Zo0OtPreJ+EDW38moyGPh5TNzsw9apEULOpRUf36CLv8/K+lrc71qez
-wGtsKFlIXDCi9/mmTrfkJ5FxUUVKHjkQ3UReaW77daSFQdc4tkkbX2i/06GC+Dq1G2hejNrYnJ9M2yCT
-UYWv9hzm6niYS1VKN5ZylLr3SrfsIt3ToNcx/VT5K7LAbaFpjkJfUmK9OnSS4tBrlilhcEpbB8RWTaBw
-SdEibTFl7bQmuKU+UdlSTyG2YJQ/1HGwbN1hxCWToJy2hH/QfrG+VfkOW13+B/rQ2YF4/y9ZQ0ZQgtEo
-iCsjAr19nnWaZCxDyqNx5fDQLSlll5X7Su86ESU9LaVIQ347UbfvuT8DJRyPlaACO0YByV01IYk/Srwr
-IWG9RxXerYglFO3NMYEhxO9W1WD49ECUAVOO6XDb89c1gZC4Sr3jEieVzuw3kt/Tb+p/7fOojjl+UoG6
-7xVm5jPy9RSXvwJpibPISpO2R39AhSjp0EyFfwSgRn47ZFScp39J4UoQeyBpMAwIwIuMAnVZwPa9iLOS
-j8LiM+QWOkvsIrxQBoi6NGumwG40p5N5KQOwYFLZJ45Hl0lfRUJh3EHc9UfdOAHENwBosUKQKxoDzTog
-cHoQ9Za8zTanW6V3VKw2m++omKrLtJWTVVp6Oi397sBNgF1Ds8rOwmByamp1+EXYwbNYiTJDDCdvPM54
-zF95397ycg+0tkRtzcbKZsmdB+jF945MuhbV52Oc1RqGQ/aJ3YRrut02PwEIucZtMD+cOy1iA0a6qOR7
-6/eWgKKVwhZcgnPgCFNAmiYATrww64p2hO+Hf3PtbRqCHIVEZgNkhRsdQrXhDiMC2B+YdXgsGCXG3XlL
-HjMruE92+AQvnsijvFUygMIDFl7MqiADCItzdkTCqVcRdi/BM0KspExuG6v/vsEDPMkTnAn2wjmKj+31
-/RRHNo0+J3MqMn/msjZcgAFovHo6Wv6SnEcbaUqE4B5QVezTDYEL0m9DJWobeYW7dmaxnwzl4MWJLiM8
-Y7fui5Y3MKvavk3LfXgwJ/mr3YfU/PYozMksjzQNxGjD5/JJnIrJesKOTQJsxF8k5XX3XQOB0JuXVBor
-uKgpuHmPMfD+0HYz1vQIhAP84KmKCMTaWNDbW4AvPfjsFRjivxYjb4hBGR/sxs4qmXSxHuHVtkkypMFY
-MaCbF783+8fJq/jGu5qlUAhbh4F4TG0w7xEKnEMToIEoCg8ooF6SuE8fVCSqUgS8xQxZKNoQ6dxUBSBd
-RbMdGvZIQXPgxSXgCT77BEjjd3czq8FMqTdMQON2Gmb7o6RmFwY425i5Ru2ZxZc2tkpo0mPD7UOweO/Q
-dzNVYQEooLE54P8eU/mxbO78fET8XF+fMvhv0GPhsJTpMu9fb+9v3TTqssE96H+wnHnyo25UZ2St0zfM
-QdvY63+OR18jn/YzXV9vLUBdI0v8UHUsezmCPz4cLZxJL76Y4kIAvNN5dFrcw78GowNm74YdCIPsdor+
-hp+xuc0Tk9vfL/jQK9fWxDttTl5WtY273xix22rpz6pOMgX1c4rUpOTy+hwdTQx1vlvZzT9sBQF3hBEM
-QTN1QecEQmpb6TdD7kk2TStm9eheH/NC4celGbcuLwOhWoJTKl0qQLSBbZH/LdlEbV/hbvXA+8X1mrXq
-YY6koqelP4LmygVPwRwa8IeIw7VJ8GcEIt/a8TN8iHSbSPqVC9N9N0NSKDF4nu3zRysn63iAc1/n27w+
-cbC7n/waLHnAOcz4KFoeaw/ewlDewZqx5NKNXSbu446kqivfaAdjxZ7b27nuamPSqDN+hm4OkFP9kie+
-Dis3jgUc2iPnF/dGIRa5RoBSqrKkJQ8HUm8fYGLAyfLbeWfKKndH3dSf/xos2nWO9AMRg9lI71ovAob5
-ewMaiun8UrKmYpz5TqVSVgmMzDiwd/Z2wki29Nu0dyxMHprUOdtDt75yNobkfHbVcrpgEF9OXFNwqI8/
-4ayjMCV0X9hdz7UYiUne03W73nbnb2Gxm5J7PEluIh1mu7cy7dM4sDI6S6OGtpgiwAn6RmjaTeT00zPo
-dq1NWtWvN9rDk4oEdwbtyvXaQqv2d/I8/YDTpuj0ctqQKXr7EQJBuCfwVpwfB+ZrtZRJEV48ernBNxCB
-BwJxfAMHU3abXcNtefrZLFuk/ivhr3I8/ymcGcnqsKrtNHE+P3kAefZ2W53SlcrYcInVTOnjjptKcva8
-fFg5nRobbpwkgMtPAtAbo03uchf6u4yCuN+MvdffYmXUL7VyVlCamYsO4/wHu8dQ33MVPRvIF/s7bmLy
-Z8XnjRWiKkpQvnFDEnHTvFJzcefN4y9P7OfdUGLLA4cHThYRpSfvcLMBmKIPn9Ky8lkmxn/0QXdCnRsj
-DwPWGV+DQV8O/GKp+ooaJeIDC5VRUZUOycRV1NXj6n0BemYo7VhqNegS/0HAZ1fb72u1J2lCLDhyq11m
-NtAEJkkEYN+yMbW0dFHjdIjbqQtdE7/viI92SusXgVUsn2rVOOlP5vei5Vg+H58w/xtpRNBxMisgR23s
-JSr38a1VPCyTDn6vWcDsDGCIWq2a4wP/i7kP+I00uxPnabxsrVBsvir+Gf9696oH+LgETeNcPpMHfEai
-q/ho461B8mux/9b/kM6CizjHwdLINOOIoVAiHeHijIuje3+mkw44DJNDsakA2JWe+O7i9r64uBxy9vdS
-EuE+4/A2+27XGnyxJiawwo4c/OwtnMDvXoDsBe5g+0W8C46X1M1k4U5pj1cybd4JLWV13+nt2r4eaGaU
-y2Yz2VytHmnSli3qnpycp46nmjLLwoxzTrW47CFt/mSoewLj5gay0SQ3KSrQVPEmeHvtBKZxdsRbcQk8
-fjscXzlvXUJYyU35x1NXGfUwH9vUdami69wKtfGC5nYCneDA5Y4AG00hmDVdGBsbd2tq6lFeNGn17Kx9
-fKypgeMHED7O5ODLQ75J9f7eOLmP0D9Va3u2IVoaeCNnFrMto7GxLYd2XOhm2CRVz3dvh/zeEriWyqyr
-pechBL0my7xrG+qp8kfoBaCmL6uqqu6vmi6/p7xbPdESUh7XhaKH2lvZ3ddnCYWwOISJXhTUQDMIBM9M
-GlE/tIuFej54UTQ5U7h77Nu9R6JUL04EqM39pZjCyZaZorp5VJ8dvy/mq7mDeX9xkJ3Scf9GegrRnsD4
-WM2auzVt+A8ySAHmoREy+bGJPNV6TW0IoBUq4LkiBO5w1nlg1GGK1dToMvKRQoYIi1a7MSIo4VAkmUMK
-1tyNjY2qmZEyMqVeQ5uoTZNuBC88aOSpRh3saCPV4ONNf9p8ygxocNRRyBvtH6oCQuNIZcKJYq/dUPiv
-i67lwC46Wlpd5O48rLwM8aQeXqtmd8SGcr3dT6TCx52IZLoadPMvMhBjMJJzAfpf/PheNdqAyvlxgBwf
-epmm8T4p1tI4ggkGtQ5ryO7e7dUa6Lo1hq16W0XxprV1mzFKs69ijW7MfqZBkCAEjAZGbE8LZrvlfRbo
-lxvSFxDngYcwbb0daj07WDxn0l5vNPMN8uCyPwUwqI4MNswtLU9Pd/culacOwcSGUNKkgsvRdtgHoiky
-sMWgnW3AXd/sxF3cQ0t1Yy6CIBCB03/MUKAamnlPBVfMXiFsBCZqdjqtflQUEJztydqtVsfQHVwxmh2F
-FlQQTIY1FpbzWHklNSmOx2LHNBxBIjHiSRpJVD/iOtkV96Mx7TgxMRwH/J3zFlFxxoi5bP6ymWNo9Pp6
-lKemX4uQftOlg1HHywbRdd8zdr98ZLWHSlXTm0cWYFoDNizqJU7cN5Ht7RQGsWu7B91lH5DulP5xIrN1
-e+qMsXzv4e0kTqgM5GtCvCCaEJRBOGzGCjRvGz7VpfipcxACOQyu8z78b8gEDkhq6Qy+kI2kzQcy9YTz
-Pk0w+MhKucTlqA+JGpcoYAo3csA4zPFV5h4gdYbqtVp9hkNeX9otTseVqjRDBROSKxWCps3K9/fkbr5i
-UmDqZAIVArDsqgIEOHKuaBDTzw+LzifThtCrGKlHzk6/fPtoOOqqOg2Vwn4fzfHLpwaU3abefNnRzuEA
-RL5DtRvraHBVARAeSlU9OcBQ3dap40Ry3v08PXpZrcPOHw8W6m4M1RuR+YLJzP0FUx5cbbrsUOcj2qaJ
-lM+wT/bj1HQd5Fi8smS7d8ctmDMGdukB/zl5oyawQBqDd6gIURQ/MRR8Q3i0Bp3nh4ugGKcf0+E7hkdY
-5XojLre7bqBDkbP7VNS+/lE1DQ+yGBL4q2sMKuSbBi7o3gAGY+1tpgWDr8HjGXa0rGgitqghwz1ZSP07
-6ThMKoeDBkrGgdwTeS34bDIFN5hQOSKuLqP1MKe1WrpP/SodrsXUJAhiFoyU+uXlLA57HV1FlfQmp/NK
-Uwa+mZkgyq/g9tNNsu/R4tY2fRhAMdeVkWtX8aWj3+ZozWbr7fp68+8avZxfgHaWauLnEfKQCn2MtDUr
-3gm8w+lr8jexvZ2nWOeH4Mu65RYvPZepo9vAwfSSqsOWNEyqo3SHqjaH7WZ7zb7Du5nnzEsUth3Cq5bX
-M/GaeZbzcuWxwaFaTfJhNUndQ744p/Bqz0qX3TEnJE6mZg0FuRDN8yjjpDIld3RMCvVjyGv4pDImfOhY
-gKVVaqdl7zpFjrkPz+aXOyOq71XI5TDToC/qtw9qGcsmEBqt2l3kG1rLnbClKHrWq/zAjEAIz5hWRGvv
-GWTVpkCVH/krJodVpWf715NR6X7U6ZIEYVj4I7btw70jJVK8UCFiMzI/jIc4TBSJT8r8ev091l5e8a/B
-ll2k5Sf8FPoAhTAgdx9YwVBwdY3sLbRL4aHSK/XxjjM5jOA08jmI1edhlOg3a4R2Z/LkWF1VXV11sI6Q
-gUFAgOFL2SXwWEK4e2ARVwBZKFDcKUKcVdAsmwTMZi/0sB8CJBevIlkAmuHHMxgDq/hNdxdExssgTtOj
-DscPoVXqnQQT5jP4cO2n3yho+yxZ1FNQiRLJa5RESCMvebgQNnnAPMCiS6ONR8pLX+xwkGYlXuMw69wH
-N6NTV3DsHnzleOVP0h0T2b4O9Bm8XWgT0w1p3z44HnW/Yi37BNkxXIMwDMMubXIDYtNBb8iNmA3e1Uu9
-bSTlPf8XAKLQWuqrhbQ0qdML1LZH4pTy5hBoH3yaYOl5c4hzVLVBgmTUSaY3t2dqeUZgFk4QLGML0LvH
-OTYM8iFificUxFjAnf4sY6eWOksY1zdw79F8z5E+ihdepbslkW1uN0T6fk7333nWfBrB/wEPQPC/g+jN
-jdS8+kO090Ps2n5AAeDN7B6+C3Zm9oQFHCLQ3qNw5fcyLvQbW6jgM/li9mtOgxSCpWzGG49UTUjtFvyL
-71VURQy7VPEYSmPHK9r6NbHjFP+1bURsbESEN/bePa/33r1Yb0Q4PJoJ6/7X8zU7Y88w0dCHpSn6yV2Z
-DO4o+ktL5s+jOr2oHtiERoI9qwMFXKYo5J/0C7f4HhT+CUNiGn+ThOV7Ochv/D+/MjN4KE/bI+zdCF45
-ltOiuBaOD9Bmcpkf1l/P4pqSmrhPUFc+GMK/b9A3DHohgkUBsq1LNufkLFmSo+bGo7H82CtZnIsRekIm
-LISL+Wh2hZC7AEiLsyXiV0/3XPhNCYbs5wENrcRQXbhZ4GWRIknmBd3hFW6O2YymindRBoW+giDiPypx
-DSEo+quR7i+/SnIr96ALaCXLccuXrVy5dOnyJYAzDFJua3l1ebm8ro7ZsGHdRq5M3jHlTKlf3dTckpX5
-y5bJlVfNPM2mHDsqvYDK2XRXjDZdqtyzOD9/cTj/qWxxVcEWNRoq3VJVuSUcfSrbkl+1WC2m0d5Do9mU
-FO4zBiXQR1NSkpk7x9hVimAfXgPTm+SCyIThewz5h2Z831CtGxQtyZeTa0TdWO6zo8kMvwBtASSEIvlI
-PpAPbDT99BPHx9Zz3393ai5EfvxAtJM9mmJIbzRdvswHo6Asjotw4V4S3WjCIQrt/vus/oWHQ88ghi1S
-8AzPsKthjhcA+eS5JnF52mTuKthT7iqfyHyGhrLFCr52CXuas33M+FxlPP7wAPvLNQreQHMKYMyDZsHC
-pzS4ep5d66uV+GtuDzoNUYMHIm149h6LP/UK566fe/uDcrlHxksKRw3jeArO+9bjSMG9z13af6RF/tI+
-xmrQOudz8nr0J5vg+1SMW1SUszJXfi6Dqdy5oZHbyyFq1Le8pEIuxKGtneJQ8ALaWKnovjJEYODVyviB
-R9jJV969YmqMjv4pmkEDQn/6Kfonk+ndSZMn4d00ga3UurzSbYsAkvAZaxnBD8aCZQHjNFBEfKjM7QXE
-Z4AQMxYN9EobXOw6xf2BbrZUEezxanGcD7+QejfWeJcyHsS1DB8/NoVaLRRlSYAVkwAVAoPP1wKhLHBD
-ELAeHOIGhbI5CcXJlAUbFcGXXYLmugg/ISDze+wmBXoJxbFlgGk8cBD/czt/i1Z/C9C0fyE1W654+kO2
-QoHawCBU/j9s9b8ZDRlaO3sjQf5jIDLg8Vdwbli/lC/DVCu4h3gw9K62OK00hr8p3gtuM7hZri3uPvwx
-we3Esae6tROhFTTbLnm2+U67JNFwn1m68fGP+X4c/Ht8/NIVcvFOe1MFzbS3tx+fAJc3A+HS/Bdc3vkS
-Lm+9C5cPQ+RoCu9AU5CDEXkSycCfxXYSyfBI8VmJZPRdeXBHFk34GikWYHmCcLLKNm8b/p4gQoRI82TK
-35OqFMo/vNyWZZvzN8pf94D3jMMneD/J5QaE9YcXf3ogc8Oh34Jo+RYECIWCdc7weAQLjksKv/PU8gNB
-YDE2HugCEt0K5Qdq0UAQXoyTB2plKJ8WODyGNKKMJvBq1m/OcjYv2bp18+atan7gIDY2AgK0BG/STAYc
-4HZW7NIh5FMHuv4jVERtVpbvwgdxP4ivEhBcP0Zd+C7j/TUUP5hDQagLc38of5wVUTL2zQ13T9Y0uELq
-WwFsR9Q019xthSgZ5cKJb1bkRrKtUuX9QuELF48MblQroLpFgRKdbI3C7RJfOeM5ZYhRAcS8YlSQ4YsK
-6ls9MICnNeQe/hwCD+CBIygUM02ttFUBnfK9AMj9b0YLBqClOlkTUt+Ev8/hVTXXagB8/dGmYetqttTV
-bVmcrS58RpYL0OaeVHlHeByi2B+F73EqZB0fQxbKlHeEDydixO8m+JEABCbum3Nl+afyTGkF3wOR/VBv
-Tr557ZNgb4+ivmziWZPp5Hy8r/wqtArv3glNY+OsZia/KosryJfzffgwJj+fy64qkKMyvoypyq/jKqvk
-KAz1YaqquPr8SjBsWeJfyNqmtplNyrVo5FkYde4/j6p0ih+6hMEf7hBi0iY2vypbGC2s02jlfHmn0cLw
-aHUwGt8VrWDfyzg1adNYeZO0EPXgyX/z0PuSNZ89yQS3xbtCvOgWH4/z2m5llZDSTmp1SxNiWOUhH4EL
-5WowCxBScbySVzHKljUwFeF84UaZVNkinDHQ/tjP47qM4i7jLIkHlXkAqdxvS7wrJEW24jcnjSwKl/o/
-TA1fI1110Lhbz5k4W06BTV4kzVxts3IZcuFLymC3m22La4uT4k9oYL4Ufsd0E50B6ZRq78exLpcWAhyQ
-ea304Vy6r3mboc3AYsvTFscxWvQRy+/n98uCf2kbiT/ZhPPiX+RfbOvKuZJ27WKy2qazfAI/DSXwCeAg
-y86yaC269HfjhL8M9NS3oPAQ/LYKYgtPg9KLvkQa9s3XXntTXcZt5DYUy4+fY5ZtePwT33Pjlq2UI45/
-+/pNBkRVvV1M4lXX1FTn5aobhCSefZvJ5HAISbw7hUsLC8NjQWYLy5dWqLEUV5SXV4R7ZRVLKwrVwe+1
-OWH2bg3zapbVuapIjtbyl7749X3xVciqHDB5i+HSvuIDvl2SA5f2lUfOtktyj5yVtz3HK1jfejI5U5EV
-prWQtzLB3gZXxx8YMbBfjaxbmyTVOmH7W+/HSb0e4Z1sbAMbAwz8xm/vww0wRgn55eYk9+Gbk8DCKveB
-lrazMfXaBDC7soTWhBg32J64BI47zijPV28qr+a2yndm1S9cmJW1UK3cx+VU5FUvl2vEZJlnqlRZlre8
-EH/At7A+a+fO+vqduNHWwi15m+SXPTIhleaqb5WJx9HpLG6AK49naxXCtu6xgbbbQdthW734s3K8rTcE
-fYdt9dYpXgaHiN/wblgjP/8RM+Hs8L2DuEHccOOEl7EK37z+M/cWc/HkyYsACxO9D15ui1+NQRSTyPKK
-xx/nFeF/fxsjv3njo5s3b8Q/oeampSfONskbUhnPIddV7hO5+DpFDQGdgcWvTXAGWf33tzXCF9MnD+vn
-q7nMXKstT16fyhxu2OniTsp5UMZ6RXB90/Um1NwEOLypqckNPwr9hr+MK5bhDPx83ZGTalQrw1/W7tlu
-M6t5h/BFEv6gWo00mbxG5v8+XI0cYuJehxP3tbKpLa9cxklwNSoGz/IFMn8RgvqcTDq1w9MAV+VnaD1Y
-oXlVi8HGcNvqaw5yh7nqnLVW+UnpmkOOfQsq5CcTXpUlL8uwcsnc3KO2N5fIlbda8isWcdlcXqFjPqfh
-cuuKd8jnS9fN37/w2DL5q57XZK5N+7Zzx+XcW7Nq51XJg/1BTqdo577wZWqDgj9DwyXO1UHiXN59Ffbi
-/y1+vuf7BiEplt2mwJ/d4S8RwIiIES7+vEDG34wVP1M4g5EMDo2F0FmLv/gFdoxbgHyt4FTwszGAh4Uo
-GX/XJTwWB3cwGsRf7mKGF84Wt8BxM37iv0X219qiheEAfQuhuoEWo+0zQsCO4kKFyRmgd7GexPqie3+s
-jx/yhfxxbsEe8vgrNoHh4z/cSiwQxNi4RxeNrzG8iu3UoS+HEMx5CS8d21bmlU0Ez7BpE7d5+Sb5nNPM
-8k1LuOXL5Vrtp2OZrCx27Kd8Bp/Bjf2UY/7LMnE+VViXOP1Yl281vomKR3Af68N2iDJ82QoU5/Itwwe4
-tbRwBr4t5+PdQhkrFi6MN8W+hePzHVySL9PxXyeGoh/eK1iolgcwpMWIyIWBEf4i2Q1zEmaKPSTMNcm3
-YVqvOJcycRJe99/yK75ZuHx13/r/D4XB6xvUfyrxtDi0LyPjm4IXia34m/hGm8r33INj9OWH8L753GXH
-uYMcCYVKKP579qlRkGFvki/u0bI7FPxNt1/r8Ocigla4BBXAcRE0+2+9FaBrnSQPloon5fVJgj+XBdt8
-XwWLwTGGuJhYnwo8kO4M+oGedggNH5/k2xVV5z3wbbz3vup/kIZrvr304vEBadzEGy7OoEPNfDOJ+V+8
-vQl8FGXyP5ws0z3NLEZNT0dXd2eCumpw1/Ug47GahNVVYVwFDeDBMYNESUI4k5CEkIMkhOQBcg0khEAm
-3MkAEsw0AYEET6TlUIFFV2HGa7lUVDLPrM3a/6qnZ0JA3HU/n9/7MqSnu6efo5+jnqp6qr6l54ny3y/p
-W5AI+nYqkA69xmENW0h/p56E0Z5rChcqh8tMu+TtzX3phTc0p8NkwswaNd4p9Xnmv1Us9BjdpfeA2ndq
-9jZBaqg6QDyCZpyiocoDl5oaGpVdfedTaOj6LqoJUrc+z1xUMVhQc01qFxBjmgaErwuqsQpVEytNPp9+
-76ePA+EwA2GH7FbhYaV+uNxz5/E5is/pLgYqczH4afkuVHy7ZNWFUxlrgMrXvndWonVHt6Iou6NpnHL6
-reffEs/RDjpUSlKO8MuXzsm1MoVUEiw8Ci8G8Q7esNp5OmCa5F66dCVpEV59sf15i3qd2kVv48VznRtf
-7rQST+66qbD+0ZNKJDWjVX/520/uIvkvdY7f+A96FSEKp/4rBljXW9VbR6tXKmTDh/QK9Yq1a9U9wKkC
-wVbooEiq+fqF6HYsHSYR55ipzB2wuXnlOsd2YlW3e6Rvv/3u99zmFkl9ij49OWPXM+qv1F/1ShMmtT9X
-U1ZXXkfqiKuqpkag/Wl/rqEeZAqsmyF4kzRt6vsPc7MnF04m0wnufhMPWV+/sll442ngQl+gV0swgOjN
-xELI1PQMhwAs9ePcQtcCF1ksQC1xZadOGOi76PW71Ou5h3ATELr9VbJKAelnpf1VovanXZzaqmoSe5G+
-nQQkpFCiqV41FabHGmAAfH06N86A9vEJnB+EyDWm/5oMltYuCS8ouuQk+HycDRKy7gVhGjoB7ens240P
-qYlPEmJH0VkZQz6giW9jX0TpFhGRPmaLnsr03WqCIQe38OD1vMBWOKVuMuRw0pBu4H7TRpEjQw4fHgXr
-5DevP+c9ooxif9HdisjTOGY/2OU1itEw7BOktSbxL5AXfM2110qzCwtnz64vXMZUlcsK6mdbRBNtMKwz
-iTfAIF5nAg6eeNNbFgP/TrykRYG/aNmb7SYtoiGC4v4KZJQUoWcYGcHqh2WZks4D3yy1k5TxZBYnmq5S
-WibyW7aSZjhPsvNihNsNzCZ8d/udWUa85MXNSejWJG6+ylsMF7HZWXC8yo3nSU52rvjx3A4ygQvV1jY5
-Gphn6HOxkxmOuIEFZ9I4+jig94zNWLi4OOQ8s7jeKna63TbomEJdMJdDLjXJuBgbo6AbzZEBxhX2o13Q
-bQrx+glzvyOqWSGcmhoP9+SL7kWhHIpTSgko/UAolRT0eYpTFDUZaFRAsgdUF9zuUYxRAQVVRWa7Ek1T
-/eIeBQjbSpN4FJdye49RPGH3Q/UVeFJNVg9JfoXtQNI2bzTUyQHjTDwRTAYibfMRu+oiBIYBjBtOPKqi
-z1IsuhWrhXCUga2A3NabQqnRd9SBCqk9lI1FJd7mtxEFU3sxdRodxFLLaqG3b2oY4LvhvbrpoO5+gQCQ
-yF1ANuO80vtvvPG+lSaOgucmpKINzIYOi36J09WCCtA0RXclVF1AqHHzGf6IA0uE1iE9gYEBGocsnS8y
-7OSqG2iiSiA+nu21Ab1PgzIHAQeMCtSbf5GdnHoqpLlyhfcjCczZoJljKjB9167VpKLzFXuuUj2lqxtg
-gDBVA7rGMlcFePfDisK22Zn5CYpk1Cq1mZJArPOY6KAYjykJ5nN4EwUaK+ALRKN/sy+QBUfxX8G4gLTB
-JKp2aN+NJvHf2WqhFBsb4H/xpqgCw2oozZQ2mejJbOll088a+6JpoBxMQJ0EM3+9ZIc8iDQRvXwD0ERI
-PeHj49U4OoiDsap3LPO9Uc1yNPP7EjdD4x6SxGk2aElxM/M2PoWGIx37emT6+wORNPJTev3H/YKFMPLO
-pzloLV9VUVtRVYFuljXlWsSvh6Hlee5wLaKfN6hp59oHEE37+vBqUja/tKKsoqx8Xll5eXnx/LJyYW5F
-aUUB/PrPp66H44lPB2oRBuUNTfseDhEDBj9QVgM5uspd8+tJg2DjSf7CvKqCqoLaYleRq6i2ZEnZkrLF
-JQ1lWsQ1pydDmauOaxG/WXdO0/41BnL63WQ3WUmaF66oEVZUNboaljQsWbK4prGmsbqRrCBN8xvLGnV7
-d+Gf/PlIv0Sv4/Nq5tQW1eS7SqE8UrOodmHNwpqq6pqqmqraatdC18K6hQ3oUxKTdSPRIq6OOKZpP1Ro
-8MZw0M5lR8LZXbOhHhUGLYLbV6Zp3+ztB+91ZsO/BB9PVsxbXgZlFi8tWFKwJG9JTlW2K3NBJskkORU5
-8OyxMZs0rcexStOOX3lG077a9Am0Q92LmuY7WyTMzS/PJ3kkryqvpmBh+SK0R4Jhxwx9fDg9T8lGlB7t
-NNWpptpx877XEAiVLk56yqs/4HfGw882Dv1slXivX+Fx3zoYF8oLGBWWGVO9K8wlF57w9y5JwT9t1gdt
-q6S2OenLPNrfsX3b52XeblfsRJYJx5QMKe1jrAoUqZ5yojPlKbZX68W9Wib8R9I7tnsU9/Z+lA8+KE2b
-dugvHBm/YcbOfOHIEK60OL94Ckj0uWGJflNYom+uWlHXUC9sfx49nO+i1bDGLA1rCbLCWoKi+UXlxfPQ
-pNRVtQS7DKZJSJsRKXsOe6jLg3MJZtx4jzqeH5OCOpEt2600k0elBqp2rOpQ5iVevxiWDjrYow7mp8yA
-n1bPXG+lQ3n9DbeMsaqZ/LDRo4YN6xr9rjXKR09FkqCrX/BO4EU3m5i3b3JPeFPrHJrqDuV7bUoXVXKu
-zRvWvYJu7BPHWJfwaE/Myr4Nkg809WbHiOCQI62tW8fTfvRXrRncW3WYPT0UiGTGDLFAWhRCfH7m0Mnv
-xR9hLqMTbyQB4gD8+H20S0LZU51IuN73tZ+V3hrOPTo/+WnyiMAIOF1EZGfYcveIKkn5h+2vPUjSyZS5
-M2aj7e4y1/KqZiK82zV6mLXIY8RdUSuZWZA9qwibe+2K5R7yMnl9/LbkKiHkRa9XAIQgGAqtF9q6kV+6
-o/Pl3RdnxewZ6mgCewHcwlCiCayXNFXcDItyIRCl+HigSX67MQALCbdzr9RuEqdtwbcNUzmzTtNosuGV
-y9xWU2WplwTGofGGD5dx3b0hZM/iuthSiKYaV+agO1sij2+U6o9nbsOnvOJR5tmnnqKpvNgJj8sGNZHA
-quqQ0eVU7PyP2cFSqbT4lGiZFvh77Ip4jikN1IQWft0bS9ete2POVIsNbohBfYblwN8KxmxbAwoqzfVC
-Li1CPHdxIcCewCDoQQ8+5FlpstzPDSOhwxRv8JpsBrvC4AEs8CIgoppCj6/f5O2bgLn9yabziWocJAya
-ofhdk/jj0/Y/uvYegQ4A6VqR+mYW5YMmgp4PtVIn+udvNYk59JRDEjs74WwbW9CZvXDIsZAp22ha0Mwz
-E0d9u8cVMiGJI047yaYJhLidCjIPYcMWfV+AbcK5+uSVKFWbLpeNw+mEJdYp0zj+4hzgTaHKwNgG7Aoy
-W7ibF3D7cEdPbPcxWBMV7cSMYgUI2n4pNhAwimU+d4zdpxjF3T6mSYsPAAtXfAIVxhIIX7toFy++ctZn
-k2Sj+MoJp5fXnSJ/xmoKmOZXznplHh/F41mvN3Qe5QYWiab6ItFSA6fySRRrQM6nCSBHqwkLON0mvStk
-MpcQ2hwL2RGqCedTgbOQVaZM/6+YHz7gE5P7WiA5ZPEg81wZGB8/0Cr6tpvEQ6+axD02NVHhdc/4Q3SQ
-kSGuMDyan6Y/0Sf9WUh/CtJ/Dul58VNfthrnoGZe/FzP6hRNC2UVQPGqBUEilN5NBCVkJQFtM5A5ddlb
-+lyAxLIDGKlAn1stMV6nk+8x4FHJki5cAFm+wDCmGfUN1VOhTdQ0Y4hlfJLeFUnvol+hcYVZ553QJoCZ
-OdzVj35CH5Tivvrqq4xWlf3KYFXs+Iud3iO1ZlCznioj46s4/RfMzS5BkrjWVj0FS4PGaqgOU18jfz96
-IbfW1sSj9DXCjJ/QST2sK4BTm9Q3i1ZkBJkHFczaixuMutySz68mEI4W0AJm/h7LLGrs7j4Xoabr6XML
-ms7h5AMGPCrZ0oULCi3Mmg4HXerPEDfd/k7WPQHi1Y3Goxe9V8JRepTovmKFoS21ROZ+70UIgBkZ0tEE
-9XWUcl4nCQkXGhIe5zZ2/gR/xSXZR44bPnxkp2K5CA3lvcVvvk0OCb3QLL+ELqGYgV4cvSaIyYzAIwdj
-VluleLefpwnZEj10PkE95HAEXTKiBTigsWlBOOd8NHQQd6C1K8MeQsdt5rYNwtouiZ5SC6D9sFS9zQpC
-60u4TQv0NrXxF/mYRPnQv6W1T+3jQrW3o+jbefFadUn/iDnZNr2uaHuiS102b3TfCzHrYMhPDg0BeXGw
-zxePpCv+oEPWrQgcvHhft+yAW90OpzF0ntXtcBjZNIKLGXDBi9O7ZScfPj8oO3RzBSBqmyErozjtoAzE
-Tj/PPCjLRjbp4GIwiINQ7kHcATE72SyWZUkfNEAFEcmgMDhHuqd46ENksEAH8eogILVhIAqLTz0Fqz6d
-JKkPAj0inw794J7FAlIkmXYpkYHNNHNzv6ABF+xM4CUy+UdUjXWnNzgIyCnPWA/Igm0QAxHV3X5OhZY0
-d4sC66YSHegZ6AX6Jp4BKgw5JaP/In/beTMMsJ0mP+7bAVU7M2XmjClW8Zz6o3HKqhnrQUAG1m418I0/
-GtfPXD3F8gvNhH7yVCFaIJt15Qa5S3YQ2s9HiDpoLFHsVYRjWg4YAUwJcn7QZonmqvAh3Hj6MTDYWyb2
-GtUXOuzBKOPOZ9nWbtb5G7WIyhE5mpZtBZmtMnMN3Pt8IZzd95kQTLpdktWjRjQ6U4A7oJY1K9dEwhmC
-FDA1r5n50iTpnjVDQp41BYrk9DlAxtBtOm0XG/H7dAPhWnqdlLNi+pLpZDqZUZSTIzTlcY2NK2pWgHTW
-XNaYL7y8UWpq4jJaJzamEPjkZ2TgI2tWtjV4CHwK1mQCj4kKGMappIb2XXTQINWl14iu+aek+ugV+S/Q
-bjVSdRPucjWi/Y2kqWh5fhXbI4xDADdcsnahXB4nAb9QdPfwBx+YIdCnDcA3RvE0bh8XhbxSpE9hE4h5
-YYLs5JNRP85WowSD0+flbTF4DzjvBN37ONHJvoEYt0m6/VgiwSFOwsYPYW3Ez/lMMcQP3CpmYxHOs3uc
-XvG7CAQukS7CwGEjV000iOeKutCOU/xXRFham5mDDjPNq2CQFgW8MOdDK+Gp0EqYFloJT4VWQmBBuj0B
-j8eHOgKkFp5AkR9W9vNJTJ3sUE/yutFxqgx9Lp4vov3p0D5IYTDoUXqy7rZJ4r9jx0wEGWCL7tSJbgZW
-2mEQf0xauSJn5sycXKjg8lWrsAk+h9n/KA8JsvNys63q28bspjy3hT7Ku5c3ua30baM7d3m2JSRmsOYK
-iRm7oDF2IfG4CKxilm45hHRyAXVxupE6QfpwEobQZroX6MOfYG3bC3N7L68azscx+oAyMSy6jD6cN4fo
-g0unD0FziD4Qt9IC2QA3n6AmeOkhoBCoAlIPIdAbrw4+n8goBB0EowpYQCQRM5FEnB8EJGLmemQDsaWs
-wUHMlAgWUBiThPaPDn2Lw1n/qoW86LbvHqVYlO5uxSoOJwZaaBQd9lEj7VZ7N9zHWyrcqla6dytWZVS3
-3aI/xSsj4dw+apTdyhYBXAiRY/aKOcB7xIXtCjuZXWFOrSmKIHgM8IshMCDcLkXtX18cIKh2DNk9fcfE
-NiGbRgGTka1GcS9MnvEcGUlGrn7ulcmCW40Cvt1No7gtbWt2kN0wvwLRSsCNq7cSEI/CrEmU4n3kACee
-WO1avpKsE3rxysSjZOryzNVzBfJovM1GSGyAkHd9X/juJMPgLMDsVxlGFUy8gBwLEp2j97tHHoibTMCC
-GP9/ADQD8TLAgLrwGw0dD0FjdZvsamKvjpDuoMXSC3e/8Nndr3z2Cvy/+7MXODcyF7sudgP6Gf/lPt4o
-CjLwCmp2k6H6B3VrkeeMxVVFC4uIIPpGORyjRsmObusO0rG5+lVhAQ8PFYKggnpNt0dOd3fDn+zejUaL
-F98QP1OYmF1YXNyLw2hF6ERLkkF8/W30JiqrLq+pqaqqqSmvLrM6ksLvJ779egBEibJ55fDEvBpUmllr
-yqvKLOIHr9sMfRNaMaXFDtMUcsTn8LFwOquoKOkGHQayuJ4taGw1i/fA0x8wr6Z58HQ5PF2FT3/wuh1Y
-ANQollVB9tWY/TzIXrHj833v420r1NIOPRdOj/lZMUOL+NlnyhAJLXnC0JTW+mJ4bVH5zGdg9i2uYl0V
-xACPYJHwKCOx0ZRR3nRgs0exRow+onhgLeh2i+4E2goFYYowxqUVs7CIpQl+Q1/oSyvbjBCLdvlCt3Wc
-ytDtuQn+JElvpGrdmQtbCR4v8BvCvRC6XV4DsiXtL+WaxOoEm9oh9W3bUDJ3gppYJO02ifMTbEek10xi
-cYLP8JrJoVbgZkTRLhhTqdLrJqikzaCjZl5Uye4E222QKqq7BV8T3x7bYLfX0xL901vie75uhWZKb5iU
-JOlGk2+IJO4+0f2mSXzd1w1raMGFsXKhF/yQZIj0lgnT0kNFkFYtTIJ0Z7vfNqmDaZX08f2c+Oan3WUL
-uN5OwTGC7pSVC4W3n+TyKwrnkNkEjVzJMrK0fmEj3q1YwPBQ+sKLhtP84/51a9Vv6Dccnaqeg6L83XtM
-Ptoh9X19fHur+KavO94AbQeVtBleNzFPQKzaO+yVKFR0L1QXVTbwnorJH4OZvWsS3/m8ex6s/tU8Wt2G
-rXCt84wL5ywpWlohVJ+GBJ9279Ob1cOG0iUNe+lN8Y1v74B5elxaZxJ9394Rb1hnir9NErvP3fG2SXwj
-eAcdBnf8uJnWfXbaHtNhA3xv7vvy+GJWcc/ZO2jcEWhkO6zVN5rE187eYTPshxczwMXBs3f4DdATvuAd
-auJiKImaDFjCOyy77+5QTOqPBuhNyAQ1aZALLYTBgyW+axrCStxnguaBIljDHIKheaPJfttF3Y69bsVy
-lSXSJQXrAIbBhH4yLnvM6J+aTp3CI5q56eZxFvYUSGdpiFCSKk0kLxVPzlVe9D5CHiAPOB+xv9iW+3Lx
-FiJQ02mW9DZIao2SXcE/uNBNy4z4KqlSFsmaP6vs02nv3E9uI7f97f7B05rLWua3YLILJVp1f4Uckls9
-u3Zrc+tW0kW6MraOb15Wu7x6BRHC66L1lzg7/Gc3iaCZuiWbLd5vi7crRI3lLuJVzxjpWIOuS9qlczdk
-kDLM5/fH+/yKnUOoxH7BJ4J3SlPWh02q1KfVp98aTp+mT3Mhcyt1mzqGcYtTZ8JgbJ6+Ic+jo6ni6guv
-+uKrUuP8xoplZBfZvnxzq/AgvR5EhU8S79G0N2Msmnbs+kJNe+O+v8O9NcPgbPOvBfUuepPU/Lftz+7J
-FD6Cx2HCTpQmpWQ/S54m+QvmLJoj0MG8pv1DkbSIhNh0Tft48AdwVnkLnEVcC2cjpwjBX92M7nE1Rd+q
-D3Ob2t07yeuksXLp/KXCPLc0650xO59YKTygXs+NTpfO0oe5/EX5C2eTZ8iY3BczhA/hPogDW+hvI+Ue
-OG7pQTdqF4zNLhjhh6QDJvVaNVU6aOo1lmYYf2h0GR3wvutTvOKVPhoH44GmqZn8yw8vf+mlh3NfAmaY
-Nuscch+1neIY6OzhxSofcSiwYNNBsGCvCDk/ogAeC0s/80pHGBNIiImsMkxJZ49X50hcPjky0OPv6cec
-1hhDDjwv8MC6FztqrC9bqIO/bIF24DD1vH0ggSGSQTRuk+PWbwLT7qJcJhaohQz2KB5ahel9CpmuBxXN
-7Pddod8RXosNsWw3zxZCG89WpewsI6sRZHTIKO7ShQWQeAt0lWMC8LqoQ6YJDJaRfTMTy4D0HhovvG9S
-dzmkD9BA5pCJ4iJQqeuc/V49ATvBOZEqHUaLmkp4Si24TGKm9EWTYuBgpSPAVwMb8HcT3RVzlDFINuhI
-5pjMrDzNvxXzg/2BjnxosvuknaaBMTtN4g67Da5BTkmWxHxdN7njI5OY/w+YnbcGpAkdNIkmdUzgaBTl
-pAvWiY89NqEjI6M1Q31KfZrcfJbApHqqNaO1dULH/v2cy1XZAJMLJGXmVEuTHdgT4h7mqT3Q0aO28gMp
-iHrJPWH+LuQrKx5l2/4DSQ81czTZMJAXT1yENCIe7XHIwTQH/8sc8XsNXYns6zV19dFDAem+x/Z/8sl+
-/Hvsvvsee+w+Kz1p+DPqgZMl8vbGXbtWCduMQ6aPfIjcS+7d/dDh6XD5zKrUXeRtAS1bnQgobvcyKPEo
-XR8dydy/UfBKRMErAQUvQ5gyqQmXyNkJId9gXWbrTXpBZruQ1HVJ0hCq4MVQp7j00FPO+Hinv1eNmxbv
-9SNeulmWvDA4Q1K2kzJPFEzbW2yc1GTyGj7WIUDMfeqDfDnCC/Wtj/mS+phD9WGJepMGpNUgSN1suuQ2
-9D+M35OG92AQxxwyOeguySb7fLLNqDu9/lzF+uZCd9FF0vBE564RibSAcB7nxUX11RRAtS+hHWH3tjC9
-uAjiOoi6ZR37FN/YdUkPuC7pAWqFWQoTVg5aJUcwQTaeT4i9cOIMJgAZYnYHv4XJSUuJOkBNRQcjl0ST
-1VMgvpziLjVTYND4qcz/LxXIQL9gok9STxF0heLUQ1kSQ7Hg6aEeqcgUCzMFqJZXRm9Ih8Oo73RrXn0g
-0dYy4y0vkZeUFqLO4tQ2pHRA5RET5uX3vf98OZLWb/NvA7YCd8WhptQCiTTNd9RNNO3U0bWaFmh/RNPO
-PrE9dPjumcdCh551nZp2+ikPPPjlyCuEbbwWYZn/hBZxZdUJLSJ61ha4nHatRYswfziHaBG/vlPQtHNf
-wF2uZ7amfXvdEwvnkVJSBqm/n/eDpv0ofqRpwW9maNoPZ27WNFrhgsOfW+DJFybA2bAzkPrG26Do3Jns
-INw1iKTTxwl5WRXJnn2cFhH12ZHw4ZYRWsTVrlQtwnTuQzgr311dt8i1qG5R3QIXqYPK3F31HSzVm+7R
-Iq6ZFqVp7454BzfFsd3QGlnWMUppa2nfpksESRBBPcLtBtM9ctun2/oBm6JJF3Cgbajd4iSoUV1dnau2
-rrau2lVdp0UIq+/WIq74oEyLMD6/Dxrp+3qoh/nez+F4beQpaOaXBC1CLL4bXq4hCM2V/1lpbXEViLGL
-ihbOIUI+KagoLC8sn1taUvIfOwHyu2LYXzUM+AQNIXdpEfyP50MHY6s/dPj1N+Vwb5QVKvJiAySJCiTC
-UVg7RdPU0inw0wtPQ6PProWzfg1wptogx/OT4CxyGxT1Xb+r4Xhu82BNO3/od3D3+X9Dwts+g7PvD/zS
-fsONnJepE5rygc5PO9HMbIe04CnEYzwxCQ7nj+2AEfhFNWQy8GFI2v4FjI/9sfDK8XfCvcB8TfvXXijs
-mz8/g+NowbxF0BCtNjSUEaEyo/ZCw+Z+D291w4gfhDeMcPKbN3u0iMhsVYu46kyJFtG/AgfN3+ZX1VQv
-wk/Nglp0K6qsno+fKuip/oty4D3aHodU06G4b278NTbzXa2Cek2pNB6y1D4cMh6aaCH0gW/8XTDSZkCX
-nBk/tW6uq2RJ+ZLy+ooG0kDqFy6pWlLlguEAJQ9PhG7/Vzb0w8u7tYjfDR0AFbtlZ4GwYCtPWuY3lTfC
-Cx59Uou42Qzc5JetZ9GYJ07T3puzGxEsdzrgjU81QwMcLC/WtFdzCq1RzGk2DRkAdjbIG2DbvnHSJ0D4
-jpnUOLULKH+qzSkDg+OVjptYivB/lgg9bXyyDgUR75VR5brKKhtXzVqeaYGB7cCYAjkzrTag8j5HKN/z
-5vjL5gXzJ5yVhzm6M/dKT5/MHDKGbdALASE9AbIJmn2hbPXX6Qrl1XXRq+hF0vU0RxqR5Nw5PIkWEk5P
-oLvt93mXvi1gRntpmurAgBEqGjug9PHf2sELNcZtHtnvRY2077/V+bIZtYZcvByGUKP2yTUeGjV3Jq6k
-M3XG8TIt26dfu2QdrtxPE4kXbS6dPmJTEzl4r//SI5dWBu3FHbpluF6pn/aTQ69buhFVB7rb/3+o5WVL
-OxTisr2GUGYOPYc+nQ/DiZVG0ZXjJ7kqzMRR0XOVWcwUmfUr5utkwwhWPexcIB0IwXIMDY0TJbc72yjb
-JZ/e0tmoUJxpzTbOxO0Ed7bdoYR6EZUTx8Psgr47G5BAek9AVW8c4ZCZgMkDfFyCGoe20QxX/QK++nkG
-hepDnjz1cjx52N4HuMLQbsxFJj/MyknmyVsv7BzZ2LJ+4/L2nzf+0WdEaBM2xJ2kIncC7QYsRNcZ/siR
-w0mnsc7xBBGy4sO2MF2XbOZ3hXeku4AZ8f+C2Ra1VT5HzefkyNA3vvcVX0qe6Vvy5HJBNk6umlI/c7lA
-o1Mlh1Gu2tLkWSNMbuUyc6cUTi4XKK9IrRncGk9Te5UsOPjyyYVTYQ14+JzUbVLnZEsOvsrRNHFNutDa
-xuWuLFxf3iZspNGIDT89PW9iuUOQ+aq2+vXLVwn0jXskmS+X87ZM9wgZGdzymfVTqiYLqSBlp6+Z2OSo
-EhzGtvL1hatyBTV6oyQbHeUT89KnI/bfquXr69uqdKh1lKYSpSEm9YVUqT1rR0XHqppFNVXVVZWllWWw
-jDD/QN0DkNRW1lTWCtXlNeU18ztm7ljY3kK2ZnonrCpbVFZVxkwhaxZ1rFrpJVuFlJbnFk6YWTa/rHxe
-+YLaBTWwjmyf7k1pScma7iRjSOmCsgWlwrwqSLdowqrnKlKyyPiVzo6ZNfMh7wpN2zdmC6wuli9hFVn1
-hKYp15bA0rJ/T73Qa7VIC91Aw81OXjUugAbdtg1aiA7KkLaNbW0du427k14FAzGAiC5mr/FWHzTg2LHw
-5o/QhySgeF7+/jxp7LaMjG1jubyJEoM/A4Hl0PpIqtJvpdUfrn6QK6cDJCd5RE3uII3yAdLo6CDc+duR
-9XcparIRARlsxMfAhDSEJiB+mqCmETKWDlWHcmoDnSBNm9YTSx5AvMLko/Saz+5iMKeoQVALWBaDqIsl
-u8SBDarc67/WC6djC3uthV1dEmAieoNpunteIQgPDiksDYLcANKYbNP5dHrSdpGTTAjXSWboXIgmhMAE
-MpHxEj4+hyyaTWzTw8mLNwlOo3jTVQ5eLL0VeGnxplgZDknwS6mbXRfB9c0R8HtMhNcoSiYnk4vEicCQ
-94IqihOHh3AVxReTmCKh7FcwXVulm03igCLm9wLJITdzEfw2ALMcIFBzjGi+Cm8iUN4AAW/GqngziT0J
-Rd5UhJVLgp/NSVC4OdYL92JZhfHeVewePDeAVdrkt0miy4Q2KlBjBIMWFxc5nGig4Sc++PijwyeimZn8
-hTUzTLzRBfEb/PESs7Zr1iNdWZn4KA7x2SSGSAk0lek69Kfn2sK3e5/G21f7gIr1qn+wj3Qhfj4D+O1N
-cKHUW2mCobdU/TaW+jCWyurYt9Q++isdDi46fCJeeZae9EmkrW0y95eYeP9hvr6QU827pfDpOEO838/H
-07FS2+TJhKPmQfqJ+gRdjc7fDqIe43T6eYxwYtVZGT3Y1ZuCN0n++HgYk36JTPY6yGE/IfFDiOxsIxdf
-c22Tebimx3Sre/UY3rsYty4cT+OkhHVR0wx9vxAH+RffRo0ByINAMzxZniWeaIyd0MPsfMXC7uB4KTOL
-9lf7lxBExyVyOjpteAjplgkZBd/nPOncXz4wUptqW7WS07RZ7wF3XH7zc3C2bKEWMe8sMPc5dSAalJ94
-XaCZMBvJXE866eCa8hvzLC8QSP59DAzDrmyHMckgrzKucHhITie3pKoOeGDBbWTPJd3Ar8rErUqoYG8l
-PR6vXs3mbuBRMiWn4uU9hiGq+TDz37cqelqxq1u1oEVFOlS8Wx7loWaMOQZpRkkOt8x7sqVwKfCAvIoP
-16CmDhH+WPQPNRUHiBth06lLzEcvgL6ImVYWOgtI0JiJKWPGbNHh/pjpttgEi7kUhiFlfh/oVW5BtQ8B
-lkGOdgPji8yvuIPt4uq7+rp3uRW39S001fCpSRyDphh9s7di/heAKin9UEo4evTDDxM49Tp6xQWwQGZ9
-7aq3OmzSZyaHLPXaUy2eyyLFWZ+SpQch3dEEbuVL0ucmGgU80HHUcDeW1Al/+idXUpePKrt7bDYfV141
-b2EZmUfKKsrLhdv/xJWUkPy6EsEHP+L5HDg/cTtXV7KU1NUJPt9xG1cFK1Uvuvs/T4Q15z/1zAh5sYQB
-6mVvOOYHMCouZhacaFLRibKPXzHS9Eu8Wj008fSo7mjl9G3dtEMWX2PWWJ7YdFiJnED5Cd1Fd3Hil2rH
-KEk8BePuI9ljFE/B4HA40oH8fQl08AjtgGLRXSbE7IB0z2i+N7r3TCymXYrk5d969dW3LF4jRtWz+mIU
-RTdwEG9SE2FQYww9i2xUpYEDVckqXhuEavLDn39+uMVpPLZv3zGr+Bd/jINHtanFYaQx53qoZIWUdrtu
-F3HZgmPpx3S5hFbiS1vrPAQI/ngyoWBStnBmkCQW1S6sW1BHBK9xFplVnlcqiL/6E60Edomkl2TMmYbG
-5e5NDR3AeqiF06Q9T3DiyLzC7NJMAuvXRuKpX70CH0mb5pg9lgBj9CbpdndsQrD9SROyR5ERsMSQbcu8
-azcIbRlczozCdJIqeHmystZd3yTseSJk8S4KNCrGyZOSypKKUmFjKlfbVNVMmoXezSjqCjKEz8uF5+uQ
-UGxAn0wUHXJyMi3xsl92wGMYMgiNW6zoLI0GwH01u2gAfJFijqntI3tlIX1/4AuTzXDfY4/eZ9U77q1X
-oSu8/CePHbjPApWhaawmzDRIjyiG7lTAUcjxXr8cihP4cyEFvSAd6MIKLEAWm4zMhbk3umDfVKH8oy6b
-0AmvFZJwYKWy+PT0wMW4JN0LPFTLZIx6xjzsHCBaQcv0/GztWqWZs3IhZxbx0M+val6+0uKVoYZQMLSm
-Tx/r/1Ehru8T6vuHVlQGM5kg0RgCywhpyxW77G6nv2tvQaNIuWfLgQNbFK/4dHcwMcAM+uq63cYnx2x/
-++3t2962phjbWzNSrOLd3SkZk1Ms9HfGlIyMlJTWye1WcVl3ipGFMRyz7Ulri1Ec3Q1leg2PwFp6fXeK
-7hYwujvF8QhKKzmXcQ5whQyu+gbkUxAB3IcGH4kOZlYdMvoQ96hZxoLaOdWz0eyDoXMgXiuQYKu4BwFY
-D/p8DOEmhBb3gHTY9eY75KhwbOj+e+997LF7LWTI7ifeLxTuQf54F4jYuDNFkxVoAhtiPolHT0MfvGYK
-XrFF+mmaRMUonnD7+ZDXpZ0XT4SAj8Sj7oH0pNd+jA8V2GsvHZC+NIVMpFNhVP/z/2CzxsfIcQWQ46A5
-WwrrqC9n+MWsaH8m+F0UjfLSx3tR9cdCy1eQisqKysczhxYOJfCpf3xlxQI0JBBK582ba1X3G0uqy2ot
-CzGOyYK9K/fV7yPwKdybubASPkSora52Wel+o2tedaklA6SKfGPyshF1Iwh8SpJnCzTf+NrsN0veJPCp
-fa1JCNVMr0c/GuuTlr1Wx34teXP2a/A4Pzu5hKWuG7EsWeieKWXuLWRl1u9buXcBRjtaKNTOq55rUffz
-c+fNK7USVt+Vj9ez+hcOzXwc3gexq0ur57ksdD9fV1NdayWsvmFnnXDDwPuPICPLRhQLOfPLcrjC8WXj
-ME5Zzbj68UJNzor53JvFu8uwdrtr3lwsrFhUs4Kr31rTiSHLyjoLtwplK3IWcSMWj6wZAdTY0EV2zHtt
-7uKUambHOS+lOCV57nPzRpPR5LnqZFdx+7x20i6Q9ur2xe2vuXZUd5HLrLKyQg+hS3QAjTPNAUn5ZFuy
-8sknaiSnolbihIntGp40/bIt4P+wfYi+H4dbPD1oKObxuD0t8Of2KOkeUesGutUsuZVs4EC7pbtMAaig
-uyWbF7WDtCJG1HzpWXjuAS4Nzp3p6NigHVR4221sbbCMouZu3Y8/Nku/k40Phe7RYdSC0SmBF8C4gSgp
-nj19FuPaXdhWGqW+LHnsy9LT7bPTLbNpsvG2b6U1LS1r1mS1TJ+elTV9ekvWGmvU6W5UDnbrrh4sCB2q
-bdREQ5eJNhtOhdUrwTuDvPRi+7MrniMCOXjnHV/cSQ5y7WRL/vopb44gfxgxYsqUifkpJJvMqspxCemT
-ufWz20o8ZAfZuXxz+5EjhF6RdERQP1V/I3344YcPPvggRycbVKtqofgHHy7qU5lWy5FwrEVtXPBBiewb
-NOjMH8gxbk/xtlHkcfL42FFPFMN6fXzxvg/IpyGjbTVafUNanbO+qBX1LTmLcmtn1wujj6HuJGPJ1BUz
-MJxB0bLS5fNXCC2nUHUCC/rU8gxY4xetqFu+ZJkQKtenl3ueDmMhD1YvWVfVKjj4+TmluYWzhXtHSw5j
-a9X6JcA6TG7jZhfmlubMF+it9BapvLVoXc5qYXIGVz+7NndRjvAs7S8NOkOGcrrxORm876HjxVC3JxaP
-7SZ7yd5t3XsWC/caf2YvUE2ORRSAgI95cvjQJya6rxYR4w+j19J5Mwb/FI8X2bwYUhiNDuGchRf2efuc
-6/fDz8iGXmKXcDGx691tpVe39KNXfymxX9vIEPoIJ38tnTbR19Q/SgNpHVe6LH/xbAL8cWVZhaDeNYyb
-XVqcT2bjJsPCMqFHdXG1sxuLmxDRakH1QoHe+S7X4lq8em6LcH7wA9L6QkKTR6tXc3OzFs9smYvhZAeF
-3IwO0aHSbtU80ph0BAbMSIzKuPtIEge8rnTGFKX722MwQDMuUKFHhxw53PfRboxdyPz6u0KxC12hkIRd
-ugs/OeKLJ7Ttf/fbps8p0h5CR9ARZA+nvmAgXpvNS0AKjAmc1CLM/a+BA78RLv/1tFU9CQKrbm3j9PlA
-8kOIN/IEXUQXcd9WSOp36neQ0HBnu6Z9+1SMFvHrgRWadu74o1pE1Ia3NE3dv0qg39PvuPLGwiVoZv4z
-69AX6pXSjNXpDWkwI1XznXdyO5/9mOyEf5X/eHYnSnRtNKbNsxFWy07vx15vx6SOdzvQ3HodzZNIASmq
-mFsxd37Z/DJN+2H5Dk3r+fMsTQvs74DLN6A+Z+uqCRxvq4QjTT8O9X3vj/DTpHqo7+0fMN2cq8JFUHjs
-xv2j2MgVWkS/5i5ognF/1iJExxB40H1L9ULU+MGbKd0YDMz3A7zfWhCZuacfgSKfnalFGLdC4d/f+Fst
-4lePPaxp3xj9uMl1uJ+gXlkqTTBqWrf5dmjcE02a9t7p97WIhGsmwuH5v0AZq3dpEbdf06ZFJJ4YZenA
-Wtw0TdO0f9m2aBG/1Sqh+u88AY9VwYt9/yFkcmVzi6adktq1CMExXtO+vHogbhvtflnWtNPDh2naB5Zo
-Tevyf22lm+ulalJTUVWpRZhuvULT/p31b6jp37+HOr/H4a5s548gE2gRt3wyQtM++Xo2lHLDy5r296vL
-LEVkbuXc+RjgAcpytGlacO9nkH/zKE37+s27Ie3nU0+hk3tdGuQIA0E7pzwITXWFCk+2PAvtWl43X4u4
-4prnoaWuSYDm/MN2TTsfsV2LGPDqaeiBEwLUKf/vOE6ughcpiYNf3ziK+44Z18LbfP05bi9umQBPXg/v
-+NXfVljEH6Eqp8Vm+PGME5LIV2oR0tBvrRNAellYuqgU8quEUfDPl6A3ri/eB8kboIdu/H4T9O7iBa5F
-WsQ1JwZBZYNR0A/XHIHK/fYxaGvL6TbiqqiZzxS1ZVUCBsyYu2juopJFRQtLF5QunLdQb4RzBzQYPbmN
-8H53RGoRET9gEL0ogruPVz31OOS2aZYWwW/sgMsRwDKdf+xNSd9XdsqEUFcZc2prO7ZhKQ7mz+WPvCne
-V15JfWVfhxjsDpqoF3qqWu8pZSL01N9ugVccDKMlsLUTW0Ud+J976j/20jczd8OL53RBj9dIUEL5EHhS
-hca4YsBGmBePfwqVvikfhlw2zOPo/dAr5x8U6irqKqor/ude+lcSVKDiBFxlXwtTpS4DWntynfWFC730
-wlXQS6/Pg166ZyskfyAPeinlPuilJf97L81lvVTGeinYDeP6yUTopUdg+gc2wzv/ylSMm+WbFBhtV2Y8
-Aocrb4TMa2EYXnn2E4v4Q5J6d4Yknk+C9my+H6p7YDk0gOMtaI+OJyDlb/MlON5R9rim+eRnoG55kPOB
-oetJIRCeYmj0L7+/Cwr9wa9pJwfs17Qzz7VC43zSlo2iNi4cVTAmgBxEDKj4EWZH5NdQePavoIhtIm5W
-V/4bjgOy+kF7fnYXgkL8dj8uSRXzsEE7oW3+aofDi0Ohj7Z7oVnnaESYkbaGh3pD3b6GfK++OhI61Q1F
-fDYeCNAX4nOQQIQx8u2ZtdD7Z162ws/zNxGc8S9By+QDZQmuOQ2Uq6Qa+vv3FM5uLIf+/ag/1O/58WjF
-UXwdsAxAphJHaxG/t+bCS3a3AoXylVugyoSiTUPaV9hFd8IE6A8Ub4DxALTn72/AHD6CYfLRMXhD0yp8
-w6UlAlLV0hgYLNuAVFxlg8Hyz0eWQFP/db6gc2Xui7HYdQD4QbRFam3j5i4pXlzkEjImc2lrnE3jiICi
-0EB/fA/qa6ykc3bH9A2CmqgO1LdOYBmWVDO61/5c1vcO3X/MStbWrmloEahDdXBZBdNLphFBTaanJBbO
-bVZGIUY/wULIFvJyfVuzsHsk6oQn0mWS10EOOQl3oRx/mpSRgZVbNg4SvViYMQsxA1oaVteuJcfIvqHk
-XjKtdHpBFupqmlvrN0OWeqXh5RAmOPQJifK3bu0XvA+RXW5qobfylaVMK5Nul1RDCxX58tKyknmlgmr4
-m9T8jjGnOLs0C9i3SQZ6Ew+ToGQB/HQr7zdQA19dWlNSBZcif9zQMt9duqJY+BvvVN+X7lk8lHGd+z44
-vlhwb5UGmsJGbWFf61RY9XVXZnPIf9vMI2auJKPBmtdoV+x2oGgOG/yhL15CyFY3Ab1m7YqCiucweAY6
-RTsYw2f2iV8yJYos+7w2BmiGQHNqqtMofh3vA75UDNicvPi1z4EF+7A8hhgoO0FIFwMqas+dNNWLGI4u
-fQ8sKuBz+90IqtPCToDvD6Jvls3nM9ow/rzPhvGH/b54fqAtfIVf9BB6CMNlD8YbMqrJ+u0eYJT8fng4
-Xv9V/3Ib8Ddg3OLcGK0Y+DYFw9ybca9Xj3OP4hbimcWh45wZveH5KJ+fJiuRwdQt/ei0PijyB2iyTA6o
-yRxNAcF+hnp9Or0+laSr10+n188m3OQtz68eSQQMvINOntb95I0t614XFs5tJx4a41FjNpOtNJlTf1Sv
-kHaQmR9yP8Hf2LAXNQtuDGoOI9psg1GliJ8GDfRqqX1ia2tKO7eeXic5MFD8KTXxc+k71XyTUfwuJWv6
-BDJGCOu9LWT79I6UFkEtvEvCzXTou0HqJMm718mrdzdLrRntW0AG0QujcXsj3QrTQyn9zOf/ToXw7/QK
-NYqlQfyFT000MZYVrF6p3hOuTDvC+LempLRmcBiaUXI+jvEPoB2x1LfovVJKe0ZGe8qFuA4yTWbRO6lL
-GukZlU5oYgAjAPZ6Jim+SOgp6SuTDGWClJCdlZV9IXidmXe3tLhR8ejOasm29N2j7Cud9FMCUveBLWO7
-Dx64A2XtBKnXqtOFjpaIi+vwghyig4omxrBSZJ52wWjAH5yIbulFJE4bQ7lEMxKHrBdq02FS03zsF5qo
-YigRvV4OKMkpe40OPdXPVo+icSWD8AzBZCaG4FRpqiFclZNQFXwkqKvbcT7oAJvQLvqJAZVsalrY6RrB
-28N1lG2hfMOorIkseaj1ElgAWocBf0HDX5cSyQKg9WMW/39MeOP994++cfLk0acefjjhqT9aWdCV5Tg0
-Qfjp2ct0cz1+CYdISgpHuwARQO6/De0pbCzQY+pxKWUL9PdETpVGSXudj0PzBO+ScHi0t3Pq2GPS4969
-RjVB3SnRXVB3DJCKfTGwp7X174nURm0wjjB4QNiAdb2R5NcVLikR6BAV/qjALamrqyeNAl3fG77Qj6rZ
-8VJ3vN3pM6JvjSXQzR9O4r4oQ2fn5F6HgmSYtaFtE4rnoY0TFlS2ro40lLhgOfPQOI9bPuKLJnjW4l3i
-G4In4o/dwbgj0oQ0jK2HMfZSJ1gRTMxChxqeHDvmySe3jWWa1rfHbH/Sqj7kkbp2NeVx66ZuyuvETYN0
-MqMhZ4UwoYMrchUvKkCpZ37ZXOHJJ7m5c+cXkyJSsKjIVSYcfJKwOLnXctBE90qIVNaxEaP3pU6YkJY2
-wYqFjYHCtkNh26CwsVAYzQRxUdSSmJYYqqnv5mpJ4Z1ftNNVlIAv2idnKxiiUcmWxZ6gGaEbXAHq4tPV
-QmknyPsxO01JLZJ4huS48hoKhRSjeI6JrG6g6WxPq4UmZBnRHccifgE3v2SCbLtR7GmodzWRFQJNNR5J
-WPzHPyYUJ1miAiDKnvZEo5pagVVJccjiVwEo82tT+kAsrgCKo8thoSxvKID+EdQCfg4pKCkvEMYjsPo5
-NVEXmMUzLbwY+MYkfsEuW8Llil8hAp5bpocCsWEQsE6KU4C6bLyKoE1ObjysBwv4jaRtuXuVe2Zr7kay
-kbSy8zY4FxaQV+gNKAG/Uwx80JhStIkE0bn/4xnIEYKI12/0lcI0F/la97u18+JRBdeyTrtdN4Emt06b
-C4JTz7+nA1fdehDS3w98e2DTPyDPPfcBC7dupBAU1b9JGSRj8fTlo18aSka/PD03oziDCDin15PW2rVL
-d099h+xet3ZOa+l6Inhl/kKHKUj/A7IYYN0VGwiwzhJ/2GmC9bQ/TFW8lQS3cucsZQESrOIPvQCbp2HS
-nkV66ld6/NE+nSNIZOAmpyC/Luj+HurSEXrFc4dNaqJHmrAxrcMinkpPJ0UIfrck3cOJn2NASUQ5yYTu
-XQLdW4Td+39ZxaDVF0krYBqn0CNSSj55CDiQb2i/7RxuZNaQRlJf4ioQWlK5powNWR24ezaGON1TPUKB
-vdNB1MEOhOUYL09UE8eRt+lu7qNDEvNLCRky6LPgp4F74jB2lBKN+C9mWoiQjCwEaAIv5nyrA5/q+C6d
-3zFXqESQu5L6AUd957pkkPTfXQ9nx/rBPRQm78xrRQY0Dpc+GFFJf/pA0/aX3QrP7bsOzm6YhmlFOHtm
-kpBsUO+kV06aBkQII1rHTVrLRb0XLATe9Ys7Hxn531J3bmzNSBp+IYeeT/5xPyL201ykdnF/e54+oD4A
-mfwWtR0xU7LgbCFw+DEzPocz/7NwZlknzICXW908uXVGjh7RiKnyShmSiOJEdlaxwzIVLUPPogVOwCvW
-XXXx9vD3JvGD4fC2bZKXF18d3tJiFJdtDgWo0PdWYKrW3+GDX+uHO3hx2fAWYBT/Pjwryyh+sJnpxOz6
-DFeyePGVamQdXxkOg5/66O3SORM1NUo9Ybu/EI6gBksybSMOJ6f2R8Aic6wDpn0scHHolkbk82bgaFlE
-smT0zWFBEhRppQmGZgEyLugncEpN5cdsmbjdAjwqw/QCfmbV8uWroNqrcjEWpaIocohwLVaKaYLaX5GV
-IqRfJ2IDtBAJWKwkfhrLaJh4KiJExgIm8WwEjTCJJyLgxc8m0UiT6Iulv4Jjkg7leSKWJhhVjB0ufh5B
-+5kCMaI/9O0rgu8o2opBu5VIhPH3wikqGBECOVt2KC0OGKb8QLvkyJKz7YrX7WxREBNdUXfZ7WobTQC2
-lybQNmNozyF4PeJurCRz/bSDcOpN6iEJMdxk5QNClIccOnBIMv1Rks//hluU3TQbPQfT+BbStGyRW5CD
-v0Hzj9OB09H018dJDznO8JCsUhhpbsrMVeutiA9iUX/3e4aZYBEPLiGLF7lqhHKGDsDQ9KuMrrLF85cQ
-QdyzfjWkIO6C5bmu5a6G5cRN1s9YPQV+OVhEisvnlglVDGcEUUgs5ca5NcVVzDGcITCQ7Ibc5XNz5xbk
-kmyB/k5dJDG4JZTvLMTA5Ei2vxaleKjZs8TTw9ToZhlNVvoxUtcX1kbXx5pjVHMPjAF6/xjpgY8yszrH
-cbNK5uSQTIK1J3Ug+9cuEtTbjeEYkJbTOsORjUhcIalK51FO0jQ62BN74bXpeCNpnNdQvBj4CR/wfTSZ
-eKMDaJAqvks1dFowmMSPYSqBnMaZxDOUh0tqNL0JnCQVcH+R+fonMtf/vhdicRC9ncVKGy8Os8UbxfU+
-o7ja5ufFQT5evM7nN4orbUaxBS5GukMgpP8LlWOoLTLuWMHTyXooN2KKBbma9jcpMXDASHssah/aRJ8y
-zlyRuwp9bVatgBlETxlX5SyfaVGHqwelDWm7yQb4R15L24Agzr0gXug7Sk2mJMOz2x9dNwQXfuNhcmDK
-zjE7tx9YfxhGYYFxCHl06rNjBBYBMaCgMSDB7iQ+8SwLnjn8+e1vWcUzMh+GDbGI38n88OfHDEfzHfEM
-A/iD7mE/QbtJTv6t518drtuC8OJ3F3ZZ+4brvOCrpbPp2AoYJwn3sswhM2nVbGCIKGbmcQhihPkyt1kY
-XPprE0aVwf062h8aHMjEibgwP1pcVQgv3hu1yEgKq4ovcKWLy+tJPVlchbzpIDoIeNOqxXCjvnwxmvLQ
-ZJ8fcdV8tN/H/egmWHQf8R/keyMJz0OXtrjziPym8Az74qk3HrbikGKxpU/C8GH+cDL6wyk8ilpPk1X7
-n10xOV5dRYhTVs0OxL4NAi2VHcxin/tJOPZgnIKxRZMlOgBGR6K+2jbnZPbGvw70SInkkbQxo/GdTkLH
-n+TGdD2yIREdeC6i7z+NmonAtFAurEJoPqBHqhKrQLhBI1cbBtk57o6V6BUmuxrHhvHLPYj+cRxhO2kU
-DPj36AqJXmlSuRKJXvULt1Z9HuVItydS8TAKojCq0R/6zAPv6B31B0K6ObUQmEnoxS6aKdn9vRYxhbKx
-dxQCOek9lwO8zgMfSbqYCz5S9J/Y4FHA30bIyGQeiXCMMvZliT+K6OWJj+Aa848I4IrfLwqzxR9E6Hzx
-kSJkjGGMrKPG94B6XKdep9An6HVAPZDtQ4zjFbzsWynLvkwHEDGaAtyfZ8309PTpM+BvtcezZo3HSuPe
-Q5gP8dTMnBDQB0Ll/AzQxwXzlwAsyp2E1lDzOI72/0yaX6nyUPlOdcAErryyvJzME9QjRgbssGgB5bfC
-c3RAB1e1oKqKVAv0iJEhYfzi8HDMtEkdfP66xnz6e4X+3t7YqIeuZBPE7+sX8OnhM5kjXzj0OnrJIDCl
-hSbGhIPO3AbssQXZSGo5bYkK9j8dGTjdcxqpVbOUYqLjDC8y+McLSM5YLgEC0R9WV5dDIt30FnoLV1VV
-S6rKhfYUrqqclJaXC+ot6i0crYTSn/3p0FTQYOVdL3MiZnCfX5nQhVOSjW9tf/Utq8zfO/Sxey10kAEN
-3TImAakKIEn3QSUGuUNrnE/WhXaG9cjT5Bh6tYmmGcZesKdTZKZbkyPZljo6bSO1RjMoIBgzrbGGMLCS
-3jZwayDcWtHnVs5MBAhIluyr+M2L13rIKwIwdwxUBOYfs2rMshvJC2vTNxcLM9lZWu8Z3puhS400gWdy
-5GpjOBeW3wY4i4KVifbHCe894huF6IsvfS25T3Q6CQgt0C2Dx3kbFG5dujd7O4rTE0iqGxju7I3c3IbC
-unymcS8DitpvDLkF5OdDWxpT1SE3uEscHM2GDhTPoqESGimF7ZWtMEFQvxmpf+HwOuljqkOvAY8YVwi/
-qTkm3u83YlAXSb8fr3+nGfSfQ5do64snIWhAlK0bCP01HZDRqrKgy2pia6sK17QBd5GSA7h/3CUFQDiC
-yy4attSDYWXzAyvL2SBD1OymsifRvZ/EBkL4yGjokpFBf60OgEKIE7JU4bw1g7Lgzr1mfxoLNZ5wUajx
-o0dbMxISuAw95jfQ5T9Ld33O8vo1OtKiSvDnzZ7CIcJ1UEgWWprhOOrQkHrKSBqgn0hxcRh6Mgw/yUUx
-BLpBPU4d/4udidEwPuMkkAmuVnhxoN1rFG/oMYpDnCiN/sUOy/TcHl4s7kHDedXlRynDrBjFaxU4uXUg
-L5Z6McYxL84faBQf64GbD9uB4ELGfighOnwiXo2BSyRxoAL53ACPRuOjxQPVAsxwCGT4F8xwLmTIKiuJ
-12LRt0LRN2HRj9nhSfFhqGEp1nA+1PCxgXzfwL7QNCzsRhwG54jDYBsYIzI8rKZR1NYlmWAcJQGzBYfw
-wEO1FbNySWQ/G5JMXsP4sDsSGuNeEbxCmtzmWDGGCH9MTPjjH/+ecNL6D7Jv94rDwng+79nnJz1NBMSz
-YlbOm4gnf2Wm8MgjdBDMlkHQF4HgQCmj9dFHHz3AkDzj1Mel1owDBw482toa9xUGAaBXSfpviAXKPUqf
-kfTf4uIQmdNNC4HCBBi4gW6deUj6DCMif2aif/ha6uzMbXruOW7W4qyamWQmySqbVSw8tyM3D7joF6fN
-HE+eE7LorhYj2TFz64trBbVYXSDdu++xY8f27T9m8am7jP9bGFcfsgN+ao7GUNNo0inuAB4rVZqClC7c
-OIineDLh6B+tNM0uxXoDRjHfCUMEo7/8xHAhhby4NGOlQEtoCbcyY+mLJIWgxh4oCALkMGTGdrJ5Tmum
-oJaoJVxm65zNpF2gGF6LRpsuyg4Erduku03q9UOlwWETDfMFEbKP7qtLFx37LvogNJ5DmZHpuFBi/AIF
-xi91efErYGtQli0IRKKTHWTk1g10NpgCBiqaRqtJUubKG28c15mZeeBR7j89qk5Qu6Surq7RLStHw1fW
-ytGjR3dlZnbBFxfCT/b2TQoUCdY3FqKzxx7OxIn8VpoDpMQoCh0hJXQmjmv+9LE3P6/b+Mmm+z4veXQE
-R++iD/48zvMlaKtmVPSnwprXoq+OCh0M/yNpJs6tI+iJlxkypYzF9kKfA/UQn0+KSkqKBNWoDqHwx9UV
-NZQ0gtTw/iWB3EOx8E6imZxbcYtHmYpjhcnNYuHN7BsLrzgcCw8XKZj5MMRMo+AFq8iiyioiEDry/KDz
-g2gbhjkhK+qaGuqFGmO3LHdbwowxHeZGHnaoNM7koH+VmmfNmsXRaw3s+683Szt2kPsXkz17xm7btu2J
-PcWE3P/cjvz8t54c/iRjrelgP+OuwzmclgKwMBC7jZB4RPrGR6zMCyt00o9htclMqLE4dDxzH1rAo1Ts
-MB7bN/Rea0sSZMWSmliIUP0sFCM0ELwd1pC/LOablzU1NzflzbI0FUuLjSiRMwG82CgGwyECA+EQgcFm
-k/idWmmYRmZUzawrXTi3sqxMGDOGe7Lt2R2T9wjbt3M1NQtcFbUlq8pXk7UCUwIlMesndQy9R72HjrGK
-QcajhmyjgFLKIDhHBoDdXkTvkNJM5/tR4Iz6q4izMijbjcvLbaePEOLRo5d4SK8oorcDw6hQUbIxZqvN
-8MJ2WO0dcIP//y6iJ1CuSD+Ng1UMzb8KEcAmHPAbGftQRpWXQmNeSG8OiWLJrCcxKkHIIy3kjsZESHR7
-RY4Raq2mytFiEpTVJSE4p5rIyhKLQDZMxWf6gmIwVvAPJlpo+GPoBwQpTmQLNQ89tDKzMZ1MItlls+bm
-MmT8NYvbyAayfZZ3cpugHnBLO5/hZk8vnkzSyJhmZ9tk4Zln0KaNmqGfM1fme8gm4q5pdi0XaJKaxM1D
-q60KYbyxqWpZbaNLQHBW9TMMoH71GGmrMa88vzR/rtCWzjHH/YUCQpb02c2fxsIQsV1/mqrodXwV67gU
-6/j0pOefzYO8D6/YvY/8g2zPYVW8R50Viv6lSGTMCqzis6EqPo9VnINVfH3TqzubhK3GITkjh5I/C72o
-pMyLCxazk1Csvcc5kMWcCDh7UUp9hOEs+qIDXsJcYsStVAsWSBguW3xVJ2d3GsWtjfMb5i0tE8RXoXvS
-1FajOGsYL25dMq126sIMoTNkr6/pVvHqIeAgHMYef/xAq5enMTeeU2MQ4NRuFP+s8AyIU5zlNziA5X9+
-OLD8aLNu+T9eQnSXaMI8tRmBdyOyTgQ9GUBM9l3Aly+RJTWZZzp59RBzJ7fQZAb+ZqWHmN+5pZu556c5
-eMUhMdsC4/lkFvQabtnZidnBM4j8xIvEJNtB8ojKwixjxKhHfQc42YfDFtVRg4CBDqxpioZqtSKhblot
-VzWJDRE09V3pnsXDDpFPScOChkX1wne/J1khH7+nEa3uw4gFpQQ/42dPmJpR1jS7PocI2YqO+wTMuxwK
-n7Qtwisb2cKw2zirJqsqC5Z4023Mu+L0bQyd7TQ1WUktqa2sFcSPiqphjXgoRBaX9ZLFmRfI4scR4eXi
-o6LwevFxBCwY+OZObwswKWIs/QOtlVavrnxlxuoZq1+onAH/FryweoawesYrC1avFkSHGjkBDSCMopsh
-l6LBhNeoGDA4hwWj6tBWaMk76HbJ09iwJd+T75nYkJ6fnl8wsTG9MX1LgadRUK+aIFHrs+Q2jDCw8Uzq
-0lfOx9GbuWBSvLSsM3PHuGWCOmzfOPUK2vQH7mIdoLi5VwE4TR2EsZM200EONc0oTgumoVJ8Ilbigl5w
-83cMasgdAIanBWHaZtObpJLGnJrZpcLMoodoHFfamO+aTYQkhuueyOtgs3HkkBr30cvcsrq65rnLhPM3
-U4NEk4EjMw7f/vxbFiAAMNtRJW0FMRgqxkIRMfZONSviD8GhQMjSUdXKkMkuzlqNO82L59noZENTPYa6
-H8kUyimS5QQCNWL3OLDEV6FEdD1m5dEP1Nul+Y1zlkCdM4gXXuAyZZhJto3YTxOumTQuL29GiGW3smdb
-JP0a5OAbSdan3Macl+e8UitsM04qTWmY5hYmIS41Hf6sFsF/d0bTvvukRosw3BuvaWffj9G0fx86LlTy
-0+flzaiaLqxXo7majDVZbcC33Ew+tns8nGpeAgwXn06mrZzXJjjpOCfxqilc3ejOlG7CcMNn0Y+lFQVr
-q5auaFgxb23O0pyGafMKcgpyqqavmKNXz7MdVgH3aaD4xyU1gkYMIeQjrnXGdtwy226cRJzLM1cLGRug
-msEXb9YijEs/0rTvp5m0CAEutW/jDhKsYlbWdKt6ZQgyOIIcSeJUNzVh826k2VJT3hrSBP/ImrymvKbp
-JA/+kelNeUKUb7eXfSJ9zJIEF8fjOqi0xdDnC2ROE6I+MNp4KoRYYmTqy9xZeHoRt+gzZurKCd0LBiGT
-WcAMyKVLwvjtcT4uAEMVgYBVG3nvvfxGm83my89/769wyTFQibCitzcCu0vHnLgEURiXBVi+4/yQLSfm
-YCxLzJXG9OIL02tMP4tCfMljNNFb3FMsi256CuZapc9JiC2eEPZdSbjs7KxsK1H7c2LSkSRi7wHOEl0T
-+nPubDRqCZp90RhJxuTv8ScpYjULZoxhnlG9zlTvLDK0mhwjuvvexrvIqmTiPgJIyyOpjStrymvIJUIF
-n0vyCsryhJGqDX3/gdNxq+Nj/HQwsDge2p8P7XDrSs3+QJk8aDEFy7ODe+ut0+St4YJX5u77RP0Nufc+
-wRcvoSKQm7RpBJk0SZeaZAfX00OTSM9AQZY5FQaM+hJRY1RJiJJ7MICc3Su6mSI9VnY6YnXXzRD82RJd
-271tW+dYbtnstWTZMjjfNpbpwpcQgS2oG3TU6gyQQjJXZmZyU6c+T6auE9g5rFsls8hsMqe6sE6IC1na
-n9It7b8yFpbMmTebzCK5dXOWCnEM8HMVjihSN7+uvEZ4ae9Lj3PlZfNLSInADGn6Wu3oX+jnh6uZHjsZ
-pHU0nwECJiWb0GU/FMgjFJQi/OVFjMI0qe9+wYUvhFMH5kEaGXYoiA5QEZYBWBXEE4xBodea1IQY+huT
-10CvM9GEGPEsNeEyE3DLWfC8IvegGoIoYgPjQx22bIcV90ONss3tsNAbyiWxcfnsJTlkhoBGW2TDs80O
-WOQ61Ru2SfR6k7gjEIMUnoFnlpKyyrKK+rzabJJJMkuzC/OEmoqayloiiEfV1BbJrjjsdgfbNbgQpDQa
-Q9A4WBganyz+K4lh0PV5e/GHbhXfMdQ84vluxrj+O+niRmJ6l5+2HaRGawWJYABCu504mQzQhfsSNPV/
-95u47PPAPxNnRt54gU7h87Y6W5+FVUy5fOpItk2O+nP6W5M6zCbR3+HtFi8tRNYSd41xt5Zt8Vh5lA67
-Zccoi6z2vziBnk9UQEKUAnTgnTbtnb9xhdmlM8l0AUiCw0GyOeaCQt4d+9oTjcJSYxh/1zLHWDS/GIOE
-0XHqOE61qveHM22R6a24xdOPdvgkGwteoUVc+8hjaKfciVHf7oZLbZ/lNB1/2XyfyB+XTIaFy6caIW6H
-zHlRF83PP5D69jNb0LFo6+T1zy16SlC30N+hB9MzE1OfnP8oejC9sW7H1ja0BW7xoV9MZMDnVlp8qEZ4
-XXryL28TkhJLSPvbh56EY4BwPjWhb6MoDM8tUv8KyXTmWN7fkhUfYAFXGG1yAv8Z30JgmQ5vP5hjjTBD
-YnEflln2mQN8fBZnQ+xdc8CY5fdnkQDTQzC0Qj3oQaTcg1rzHmZLDL01iWQ0zVoj+HQXcFJbAR9g4ReU
-Mv4SdwxxX9ywibTmNU8XbDrVIqUL4SOM4yuBbQTmEb2b4TEQSc78r9M54FE8bo/PE+32eDx++MtiR/FH
-HxD9/lLfTTUrrl4Wtb+BWkwwyC7+ka1s+m+0C8acqDnUwWt4sri8rrRuRU1LzUoidL02vdCqJk8HeWYN
-X//ami68YfG9J00nE0smz5m8dGLddCKMvX3mWOuIYNqbxm23rxqLXr1oNtQL/JsKbCesd+FYcmEPXmvo
-OTURjbECNAGo0iH9YaA4yX7pAgGRjeJRkBWkvllYMQ8oq+NAh7vj845IX0fnK1+80tnRj+330lgTTTbQ
-gSZ1joHewM5vRD2rzJAAB8FUficYLZGJu5cQNRvt51o5NdkuOW3xDr/N6/PL8fz/GLozHARPV/FF04ES
-yBgNIRmD+XWZgVKx3QzZa2SN7zLunbH1b+RhMj4PRBJBvWVk2FS7YSGKMN/+HiY2G6huehK170G2wY6D
-vRBIIP4lnyPqrbjdkcidN98lkXgadz+N+5j41bj7uKit9OqV1IwmzPQIvQpp0YPSbJJfVlQsqH/4jFtQ
-vaCawKcSPsAwfsbVFzWWNhEhTOP1kTtvAXwEddBgrnBJfk0eEcLRN6xNpLGmfolAb7ubqywn88g8IXXd
-lI0b163fuHHqulRLlKxE+k7TxCP96KKAlDK5dbOVbGpZ2V7XXrdlecv6uqlVU0mG8NS4cU9Z2oPXS2TZ
-U2PHT5k0fvz/K+5L4KOqzr4nkHvnMDeEZeYGqXYmqQsmFKXazIugCSgoOC6oIQVEmaRsSQiEJQECZCF7
-TjKTZRICBBgIWxhARHJBUQgqblOpKKAt1k6qYkOqtFVy0t70ne95zp3JQtEu3/f7fUbunLude+655zzn
-Wf9P6qFjllq9feayqfQhMuaT+Pb2Tz5uN1Omf+av486ScN7h7DbUMN5+A/zROwz2UPWkepKd1H9vFDQQ
-dJbq5YQdf/tDy0aF4hxGAYBDEDx7YjrHxL5NZndwp65JsL7MfiG2vWDy4+6xAhvJpsrqmIYn2U+eVH+i
-jslh5I5Fagy76RK7acIirld3MZs84Vn35cnvtte+0HaDO6OeVKNudGcQAIfF7zi947Udwys8X+15fa+7
-+Wxzh8c4pAtGgkteX/7p+H17hXOpr02tmUA8Yh4boQ74q/ojSv6kVskFerqBFpQV+v1/n7LN7+/4dh9w
-7XNHgqwRNtPvv/zsNEpWsyHbRb8uKkHx6wZ7HvX7268W+/2/G5ptmfOpPEuNexVO/mj5cUwEeuubwPjX
-r4PrUgqDm/QPAht9zVi/zhjzNFz4o4WfkVo93Vi+saTO7//um3f8ups9g6Hm+HfhkoRv/f7fnFtKyZ3j
-ZL8ubP8oEH9mmKA04zd+nZR9ESoYPjaC1pZXl1aDeJT8Hjx5fBq0+8FOv27QGajx8uyJGIV2GbEqr+mn
-wMv9Zbjf3zkcQ6HCv4FN5bjgZvk7cOIXFC78y80PkL3wLuFpd/t1Bsfj8Cquc7C77CWo/84O2P1YhscV
-Qw9cXaNgMB/IOt89/h4c6wJZ55sjVvPazC1QwfDN0M6w+aPgxMftfv/Xd46ATTnce+2zF+BYZgdcHPUU
-CHGb74QK5n8Cja5I9OuGrNhvyRD9ft/J9X5dxFdZcMGkAr/OclO52a8buuIkBpaNXgkCYO6zcIf9LPRo
-3u4qp6Ma/yqrObypqRUe+O3jTfD8yEFQwZHnoO8s4WSOapenHJj964JLJE00Orvq1RFswK0MBsGmivGf
-whA8r7h9w1mCktmWqaVYPi8r3RjKojYrPFupwpr1mDPC+IEbY9CBRIb4vPgfKr/KMJvL85HU3hWNcR6R
-VpA7vSxGr/6v6pK7or3d0SKLQ0Qx7WhChFbATEqt3lZMXAR8Rqu3A7kdVNBbE2VM66emgGinpqAaCe3J
-PI532/YmM0twi0Hg9xW94L5NPZjEUPEfjmJyEA0BDJ2E0FUB1aRyj5tCFqb1/Q49tbV0QYF0pGau+jZe
-UWHCX19v0BIQfG648ha7+SV4UAhz/UVOpHP2Lj1a0fL7JnRw9K2oSFqaPGdpIqzib4SyUQYvJyzou8U9
-IId3+lAKMOmNV9kYNkuucGw7xO6lwha6yVXTQH7Hohe4Qaz+cvJ9fv/7C5b7/V9EJvn9Z8unQWnLAigN
-mYLuPkYGo+n9cX7dras+hzE5HobFrV+uhmPs71DauZsYr8JkvjDGr7sl9xMoeTBGbMYVKOXWQikmjkwB
-ueMT1bokUSgtKgMOhWjSsktRqVhaVumwsAUP92J+gdiEqg2LmhrBiKENfVmgDfAhbd4kXI31gZh6VMl6
-mFnz9xqPaZcmGOpj5XzDnWyh/ICh61aPfD+3epmCiNM98ViYoLrAkKXGB+9jKQvgYnXPZ3Bjb/WIBB3f
-9QRwjavX7U8X2Gz1bXlZVur6hXQcnXaWfkZfaDjg3k3uZyPljIx9S1asbl7ctL0xW1iSssZOn9UiuU7Q
-Y1sP7iOvzOEZwNYdvM+vKxjggNKmcr9uwze/h9LLD8Cx8FrSNfpBbMQTe2RuC6FrStcUrSUH0oSNu2r2
-0WaKhhKaTpcULM/Fo1uqGx1bKenf3Ez2vPzc8czMXz+CNuyVO4VJ6orgS97b0NstPXTd3zJc8boRvcqL
-4oAxbCI73xUtZ+1wi5GZ/IcZjsh79wpOJ60pcZY4C2lJCUlLE7Jdq6tWgXy6qjh7A0nzCNqZkhJa6Cwh
-e5cKxvCJaStXzadJhLlE2pL1YtpOck0dJrM7Der/RsC2Fead5hhU2bpjoojqWw4TerFlor53Z4fe6Jio
-5Y9PgemEOmb8pOhvmtCCABXNAfGtJRT4rRgvt8nxBPDoDNeL7dOVcE1eBmzsVoN6CkRgrxIV2SIyVwQO
-qWv/KaMVuBSRLTjYDt5yXo5DcE41FR4oYq4cNUZhMSLItgiYFR3BopFT6PBwSML+ZONal6lTVl3N/Ryc
-smTj32FO+0L53feIG3at3LqULqUr12xYTu4Rjdf6YxtdRz+Q8GE3YQs1CQWW6mi5w+AG+TASk2DwlG3o
-/c4bhgBmmOCpU0t6arWJkZ2d16jNh04d8b1vwM7Dq4dwg01vImQvFSKBa+65CHjEEI1FijFwObnnTD8o
-9NvYVXnxYsG1pnoVzSToz2SmXqvVF0u9AlqzLfRAvid7N1FvOyxDS3zWNmoT0OJtoal1aY3LyP79Qv7W
-4u10B+HBY/9ZHmN0cwxinHTF90E16WQn4XsCG8dGG3i67iDcO6prf4paMzju7cTstQp6bXCfLeNd8DSX
-jPhTxgUwDGJboHAXzyT6pJjfmLNpbT05dCi9ec5zgtGaX5lfkU/hrzy/jMx5OT390CLBuGRtXs76/Gzy
-ZNJxHoAJZDLLbley9LQQ5TryvP1t0bhnx/yWZa9QYlzyCm3ZveNF8rbyvFhRg+jSBMa63a2nNeW15SgM
-hnethkGVyBKFba/mv5l81K7YameuJF/dbbON+UpYNrPeptiPJr+Z/+o2whLVRAFmInW5yKuvCvUuunlD
-NelvogsmJTPvoNuLt+Zj3y9rTKtL1aIGLTbaZvX5BJYCgjyOB/41M+mqqjV1BL7x7mxP/gFK+Ff1ijxZ
-NPoDqSnqla44KqgpbBPI1uoSdRcVgplmOIetIrJtFE9dwcFhLioh6OSBnmQwpTtRbwEEQOyDzNF8HTJH
-c2BBjVdXymksxqNXY64FC4la4fvHAvOye/qll/0mWlDnR8t3fv1Nv1y06NTmU/rkMm2X1SsKpjJ1hbIx
-BhYf+i1OshT0vVXgx4SqMD4vjbAacdUBqo30xgo1vhuGWTxcEy8aH4MOSpF5vBzs/VQJDZwI7zKBUIo4
-VS4jcETQ5ElUMLoz3VHwY8/MmkSpnZ2Efm1Wm+VAmGwwswASUQUZA5CM2lhc20C+GjYbZkTKrxp4dtok
-TJ16F3fB41rEEFQSRcNFmla712nWwvbz4IoAWIhJzxNvcRUm5sc0BcBE9qvtcCt3zdsZTLHd59b8wK19
-tJ/81jq0tSew8fLPvlzSz9fLBbQR97lvmiR0PcJ+LKuZatY9XyxOZ2HadYI6OAI+0M3qLegXhN65Pm6r
-UXydmbA1/k3zMzGqNhYjHzQY/5GlYnL3TrE3oYe2opp8mmonwI9ZFR87ibKdz279Hs7taXUHDBl0hkhP
-f/0ZNRmd3ZLRf279yyHwbyB7g82X1YfGf8oeYg99KqgmHwxw9enQnPz8nJy6/IaGurqGhvy6HBD5bmKq
-ev7a8MCvcXrXNKDXaW1aoo1Yj2is6rHypcVquWHHe1ALvmtZWtryZWmWtPFaSthPPaJnNzrJLk8zp32q
-5YE12ts8eh7gsG855kGEsTuk9UV06mu5dPrC6UuavDomDgkxotFUv7bjaIv75JGE9xZ9RBvplorNTuc6
-x1qazSmAmQuuXnWezO5GjyvYhmvegW/K+/cIbMDVj96nn9L3Z56cRhfQhWtWZqzcs3DrAjqNzpwJm/Ef
-PcoGjCKc3gaE5XpG5LKyskrh9q+mvkPfpYpnv0IPUE9W/RLykli/z+M+QBXqWUzt9PGpT9x+N2lnPvmG
-VU/Dqvdn7N/Lm/GrYDPIv+WoEEzrgvlHUXWN/GMCLCxBn+meJIosV40CuZxymhAfgJ0rV6/0eFTU0VpH
-TRUZc0XIzltVmEnJ9GcRX/AV+DdnuoVmFq7KyyY8LD6FIznNh2nH2FPypm11Ox27iF3Mn5208Bd0CV26
-cfU2cvq05l5y7JiwJ+PF7KPQNc31u7aSb28D0qyugNfZR/fkbltNZszQPA3mzhUy9ixoTKYT6WPP5D9E
-FLHuVeUFjgDWFzI5WBzIsQ0R5ZilhHKY5BSrbMeMJXzUa+mxT7YEYJUVDfO9BS8D3kexB1yvsVftyGgo
-CKyMQXs8KhgO8+mkmRWSNOsED+u9cVOivbwlbXbuRsHiQHBKQWc6jI1KCUhoKYH5mBKYjynafLR2a2jP
-CGvOCwq6BATaZ/dph3jL+bKUwG2wJuD3Tip6lhD6kqFX86J0MNeFEOVCp7fzguaRYZExpohnXt21YsmS
-5SuWICDX8zIGGfWNOjKnqc/LS5bjZXj5Cn7C4mOf4dqGnmyYkQXDTQayC2yXvHqVsG5tfi5NI0n1It1Z
-5968hezeI9TWVtXRjSQYIWhOErk28sX5c8x0dUF2bi6Zq97HDRdZQK2jDN+nfHodbUAJCa8DfYKf5vTX
-X389obk5AX6EgDLLx2UrS0sg8GWsIa9TZj8D3oin0YJFnpnaMBIp3quHDwEStJqA9rn4YMB8ENQBHdGt
-PnoR7f0TBTUhdKIad1EPjAJMkpUyu4f7Crlw1U3hewrUz0KOYtyn7SiDan5yFITqi9iGew3TMETzO8xW
-hdFoV9jP0YkkFm9CZv9aH4ngvGaZSQWRIAuu1XoiV/H2Ra68heXCdTic3Or+YM9kqec11x6QIZiM/wdM
-tyYfXB6PcA0+akNschAMqMD9OYOch1Vp6zKprd2mNnvsdbbbUwHb7Sn0g26X/f4T997u97/5q0t+3YTY
-WX7d5Pf2WujBTQeamsiXXwps8JMyohKo8CdMnUojz049kHos+xhC/jUeOHD2LO2cepYgnxrSFdY1QmZW
-Q09Wo4A1J4elshzx40ghmWnnefY1ePuLyjXlInzWv0DDKzdVbKKbMetb2SbykehY71hP19F1petL1xN1
-gGqQG5rqdtXsIe+JqxalLJ2XQ4qZQR4llm0q57dVbKrcRK6Klesr+G3l68vWk1mqUV6ze/3eDfvJ78QN
-i9cvXbOMWFmt/Ji4/dDBvUcaclbkLy/MII+pcfJ7Yk1G3fKGFfMaUvauOkSyW+TSTaW8YscmB7anbH05
-r7hifeV68kdo79Zlm5a6FpP7RNf+TXu37ibsTitUXLgnf1dO05Gcg0u3LyLqnUF2sU8yp1PIy/+PwY72
-5XHcLUST3PhXRa8SOwjQehALU+XZwN7vMVgVjWKkhvYhDv/d0GzxtsFICjmKPg5Hd7btPIp8K6z4QI1S
-YUloCc1SeqO8joovNDe/8EJz+kKzXU3Ua9lqU/X9PfJSNY88oKUJ8tzrnqC4tSdwI9FcaI0t+CCYb6Nl
-rNliVyOUMj0+CHNjhMh9G2DP+uGHhiOznYQo6OyUz3i4K5Q9IY8dO/aykF9X3ACfDgkh/DQU1+WTzWu3
-bBGAlamCw7RpBV1C6Loi4HGI6mRTeFy1xZjBE3UG4qrNPaFmxsOBYDP09dHCzYyH/8OAM4W1Kz50vbYr
-Rl9XfBfm+FNhYhs/4BivCF1h1xt9vd6+fTNZuALevrjyd/ZxDeF8MqKE9ORm84pJ6LBzFxdtU9r6aJnG
-cSd6ayz3W0DzjgnEIi9L7WElNR/jJp5iIAWYyhQtd0kQm3b7CvMPD+MUzR2fYWxurM0mYliGDWhSApfN
-qc1GKT7chvnAYmRoKbC3kdQLrXB3ym1e2O+MjIQ99O3vBGkAKANQEViB/s6Gyo+/K6zbkF20mpJEOxqF
-NcOwha4uyt6wjrz7uHCE7ZbjDeqjkTJf45qWw9IGax5wkpZ/Uxo6/pKiOd3xvC7c7por98Ti+fTBwCcY
-pj75EToPvbPn2R+5Hs835rj4ZN2cVvoeMDRzXpzH85+YFZE+NifxyXzyPIvR98f77a+4pZiD3hSpUK/x
-j3k8/UkKqixgNv9Vx0YZkPEwfqnLUux2NQ42WSJcdkOFcI/ihV70XghhvzlRdbrqBIwCxN94zfBbt0wd
-FU6Kf45yB9m5VaishFIlyToslFeWVpSWk2V5QpGz2FnoICV7BQccgvW9tLS81FKyVMD0H0UlZFm9UFZR
-Ul5eRrIWCOVltLSyjOxcI1C4ipbQkooSWkpWZgtlcKaijLgXCBVljjJHBdldL1SXVJXWlBLnEqEUGmBu
-ER2OCofFuU+odtQ4qpxkd55QWe6srKgk7sNCBbStrJLczqbL3MmwnFQw2h3fHc8oFWC3ltbQzz/66HPK
-vVRTlJZeP9VbtLR46JoaC7IrzxDCUjXo9f/IUMolVMolzwS5g5kujm71XhwtgPQvq3HU3qL56nMW2xWA
-gmrvz/l1x9mBYT0lBtbiFlioA67l0eiMfRF5BbRkUjXF6+U8crSADtnsPgxDw8fDmt8uA/XXq3GclQD6
-pKPj502dSUCO/jU0/qww9eT4l+Ag4QwDRnkAw4BW534qR7sVE/2YQAgP59K6lar3qzuf+aaDxVvpaDW+
-Q433UXY/2/nGnaOx2IEhUd5+XsddQ7vukNMXCwcz3IiYhGDy46a9/5mZvrKj5cAeAnJx8qIMhF8PgpSZ
-6ZmM1uRD5LaX4SOkaMD3/1chqj3MZIyH/RxYiO2wcm4w5Knje1E+TRjNEdIV7UVtGbD6BgQOTzYE0sdz
-yJ6BXkX2eDxpN/pHu+LS0tI8Am6u/yew89CsmVzF6mrhK1roTA3BV1P1BvIBcJ8nHvIy3qDmhKpXWpDu
-5LL9MpuAAU2w7bTL7H4D15BiamIYpG09cp/qipS7Uqma2xX9r3jhG4db/hu9FN+C3vgc72EgmwvPv403
-DC0PDOgZM4d0+HZc2+HT1pBpcnLKQQ1Q52BKcnJKSrKF3Xta5gnlagI+wMcPCU5HRXVpFbFfEEqriiuK
-y0hilpDnyq3JqSIFbwjlVSXOYkpixWJaUlJeTAqeEXKKcwvyCkiiWyiuLC4vLSb2SUJpcUWRs5QcXySU
-a47KhZSrHhcJJaXlRY5iokwSHMVV5VWVpNUt1G/YWNhQTGqfESqKnSVVlLSJVdTprKgitW8IDVUba+tr
-SWuWUFVWVeGoIsoFwVFVXl3iQHkTeCnogUsftH1wCbPWhsJgQeM9tMyzQnCU0eKyUpI+UygrLSkrrSAL
-NgsFRQUlG0pI0S+F8pKyshJKnhRLaBmS0qIjwgZnQVVBNVmwTigtLwGSR9JPCWUOWuWoJJ4meHca+IOW
-pTUJpZW0qtJBmk8KlQ5npaOcHF4n1FbXOl1OUv2SUOGsrHRS8rbopEiWSfU8wVVSW1xbRA5vFoCEA7Em
-zb8QKktpcWkZSVsB787dDg5jXPntBg7GNtHQ84r8hxv3MS8twj3YqM/a5oOFGrM7oKEHp7I5jabXLW/E
-5FOe5UfXHaMEpzAafCwK8Hm9mDBa0hpYZUBU1fhYjEg4x36J8WiXAvFovxT+/JKshrPwLOA9rDyoUVIl
-Ldzy66/hkJsyOHv3V8LBYAqkeHtfARuqb2kDgRHpRCZ/SqZivAaiBSYsgzEZJ/pCgUIEgCT0/TWM7YFE
-u5ezul1atiStUuOfYu2BrEktrBXpZKfP6+7k3Op52WqwZsmtFAlBK6WtSBFoayLFyZ9IaSJSAYE1g1Ci
-UVQtprSnB7qGdFnk1Iw/qqF3qaEHMhhhFkFtyOJxdDC3UMOgpkYgH994WU7doxLVwuDfgT13sVDMOoUm
-GbQVnb42idMQ47fwwXLlLw1G5lYPy0Z1W23DNrqD9IRgGRnNbFi9rYDQKQ8+2Jzu9Xptzc0PnheymFvm
-iT76ZY/4bNr7GB7hYqbhbmZCHaLJiqGBsYqxkGd0MW5gcQYoxxuMpbHIUE40GKvYJNizi3AuPoI9CKeT
-tMhU0djanQprq9HNHjIYW9lkvDY+STaWsim8aDC60Z+MPYxnoRI3fNH/rw9H/TqujYg4yW2q8TKLsyW1
-ZCbxfyJLifAGxBocVbCo27JUk94La7wNVQd8ecaQXDd6YmEQK/9c3kxvFjNlwQ98se7p7PcwB/caWjpl
-Y/dY9ohBHQRSWVRsG18OMZSFz7+u+FAt2YoqX4uCJVu+dg23UddU2TIRpoPxH0PhXmN3FbMA7eWe+jYe
-Y4I2SYtXCy8x/u9QNTpKDvoi8tATfqJnbKPSoI/NMVptlmOpMgMVH4/KifZWu8BcbTKNbVUEdRqzyPYZ
-bVqcWAePmDsdzFumQXjos1QL8iU2vNKuiLEK16PBPPW2cMFPy2I4kJ2PwBl2oMuAlrXDmbuS6XPcsoYx
-t2b6ctPRwzuRV5i7OH0m8Aq9nk1n0k/O3U/iT2m8QlIglUhwwrqBUVdQHxfC9QgDudvPo3R6RlISJsE7
-dOiEu5W20hNZhxYhYnySMn33o5RAH2OCvm3b9m7cBzLgXlRGwtmMPfMbk2kynZ+dkUGuqc8gHIeXYwH0
-AR1HO4IpYEdwBQLvgFcPPXScuYZ3vlCtUBzFilL9gvGveV0Ylb5QtM14fvr0Gce95oUiffDME+fyyZSW
-c/pzdWfeoeeJ8S95waxtcEeAJLlRWv8u726DsSsvEgYLf1mMwQOpIAsjqT7g0hSF7c+tIJYC1UiQ2+w8
-wl7RN63ZusIcK65Yw1OzGD8AkbxZXLF1dRMKkk1bMUVLM3dW8PPRyqLt6HH8HYcgAV6TDbIJxj+pg1Ct
-ZLcGqly9bYXZCvLhal7ld1FqTqDGUyL6zFtYjr47HvWwuaKGYgPHEQdKbUcbNkKsoOh/AQZKvOxt944R
-Xom4i0UKJdWFDr6al5YUkbvUyNupwBEQaBmmriCn1dlC1ZotOdspYfvF7XRLQxXiEd/+tl83+W6HX2cs
-v9eve9B7q183bLro100RniQcSOG/AwpC4RCjuTTZD4bWQB7mqMYD4yxGhmq/tkg5UukU1WiOVwmHe7ea
-C7S2BHQlsa2yOkmd9MBvYZXTqSHAUM0V1M4W+RgzHFMNwtEo+RiFw82/hK3afJw2eVkY7QM48A00+Gen
-fzaj6a8TPviu9uifjo7+bsOEh4VpbAMqkv5N1Ipe9B1EBwAOUI+QXfIcA7vik59DxTfqH5IwCrkT5U4Q
-tWOBHiNBVa4ZK7hBYdady2dZjI8l6mE/+oRIt9Z4jjWR1c41mKPZ+Bj7eaY874HV8yzPspwT+iMPbJtn
-Nt6i/tzLveJ/yn6eJXM1dMAeZEFYJ3NA7cedrbjiMgYVlzFcKZDaIlutPiv14n4LnBHCA5M7AViVeQav
-mir/ElnSaB5eiIYwTAozaeLEi7BA+xRKrUi5TPKFixcnwhJttcNRwQujD7qtVQEBiktCPOc1iMEJXdO0
-5JDqqW4TiPro8aWReoRp6WexDQC0qAlAM33AKcH0S8LEjzbKWfKUQFYHoLDG42gDhvpOshTR+IkV0w2Z
-Qo3Htxq+H8PU7+1L2rizvma2CM0xqO3sJD/bbQrFHx/s8qtcAdLBXKGoOWKpPjGQ/KZbM3bgCwSMCQxR
-UTWmit8ezBjB1YgKVyn+v0Rrim4LOJ+7gGNDUFgMiaTr8orWkUfVGKEMmGpaRJIr9OVlAUvUzTQrmhYK
-dIOjsLqI7FzJIllU0LYQlJ1Brh/YlQtkVfsUN1P317RGoK7SmqJqsnKnGqlGCQUFxfk0l6DhhTOACeJW
-urm+ejP5FYsRKtGxt5ocLdd3p6jrZWhbrNjjdTTcmNe1ErEcbxNzaN6G4jwyWxWAs0c1BskI2IIHin6/
-OmCY3//Vvk3oX1kIpZdHwbGwNChtm0gLHUXOEtKYfe07obraUUtdwPVpUZ/qSp+s2Z6hEl3oP676dTf9
-YqFfJ4SvwwCD38Cxb+uglNRKa0qrS5wkuzHqJ0IRRtNsALYwEEp6m9hA611V9eRVJoBcUOGgTtKjPcak
-vmgbhomL37WNo+vaYc7wL99uD6iSldDZ3JDe2aKZnzM7kfYZGQdSiezs1CP4IscqQB2TaFQDaqYf1jFp
-nhmYahB9M7woPfWPHqYTTk+7sBp2ZzUuOkHfQHUFLGMGdio0iPHcAzzBE/SwCceGawK0DZmrFqOa1zW6
-azRCjO9fvM1O52jY0HGfAKugYXjb9U/kP5dIH+uD3XxonWflThRhNq+ty6IrCWYmxIzEZvrSHs/hOvK8
-3viPvAYD1E23bNiUU0PY5Cx937RhzHDlCga2vrWydd4RgjaNXM5xDGRfAVk5+MdDdwk5LEa209GqSwFC
-/mfaZFOooC5md8oJrRUVwuGju09seY28p6gxLUlJaoydFYjrk1fOW5yyasfaphy0oW5Z5E7bk07UkMjm
-9M5r708TOMqbzFnb2bPVH7EfWa2aqwe7R73H5/v2Vg2/pgsnHKeh55GGnkcaCrd2meRXZ996q9WnwtWa
-o4jPyqCWV2dzhvXfcibq4ysVL5/sCS3pisdp7b/Ou8J/nXdFSii/pK8Xhf96LwoTaw7xeXewBLd3IKd4
-sOA0A50Avltt9gKXovqB1kLBJuKSzlKDoOawauX6WK6+Ow64IyggWHmzzFLZeRUBNXi9nW74Qym0HfHJ
-mzuD1UEhEuHWAyXUt2BMeAis/vBetq5orz4rsv/7Xd94eL9urEBz8L3xKmuCVRY6AfZURPicYwjCs/va
-3MyFJp4ExGYHaTaImu5TXaI7FNhv+I10c5x29WQo/rhDNTgkkxaxEHy1cdqrmToReT0XnsimGviLwS9f
-2vv8QsVTDTeoIYXXwHKhisjAnTgnezup323axX58HnYllqCjuMmPq+k7McdTtGzrn6bHq8V2IMOM3BmL
-ibBpQFd4QZ12gcvVy1GrpyL+uYa+F/zr9FYdrSwVTlfD8bOPUKfAox1/mi0fSEVyjqoR9JPgyhdnBYGj
-6wvXFmfT6fTZE/Qt2li1pWYTUWHiy8Bkztzl9/8JNjrj7dFQgo3OuOFeKG24d9HSpQuzkw6PuTytkx6g
-+6v3bfTrRnw2wO//470zgY7fMRhKa5PhmPoXKD31MNmzpzu8K1xgT1hllMEtdH1pTnEuGi48e3Yd3PLS
-gvafvR+JzrTFy/LJy88JG6saHJs0V4j/Vp+IK4MX4d96zB6nZDbGoOaGasYPhndr0oKaq+dcfVuU6Gvx
-JdFrIPtpAkOuJlX0a4fmdxJoBrDVJm8S+nbCxite1yREQRRZboRNETVQS5MGemm36VeswajiHH1/ASUn
-iB/LzqM2VGMAEYINPRkx3ikWauor58T+i4oC2s0OhSNx+qHF2bSoWnA3Neynh8gnj78bF/e4baI5kf7O
-+rlvEhWCpqVEuz3RvJ7mOHKrMP+ZO7epcDfFdKNNblJdRNd2Ru73YHqHEO7Hqv4E+KLDQPqP2TWoPMxi
-p1xjqdc02+eet87seWp3xlsZ059adiZD2DNB7utrg7ZOC0Ip3sCcdF16SLQ7CdoSGad9jTauxdCEYKA9
-sMZZWsQglKSZ2m1V9GHMwnLCW01bCU/Aq54K7fEvaNcUbD2kDJM6JHWZWvTdSEB2IilrgmVhp2ayd3Pv
-O6BnXPt8SnbblSxgDxS7W9Hnx/Yqjnuv5Upl1Bpkdbr1iZF9LsG0DFpiZLdH8bR62uCf4jHObe2ai/gz
-mOU41OhsxQTHIhwFdoZnSA7kPVYT0mQ47mwd7dEbLa0dHs9oWLv/+Zjx7tZEu2zHozyZMh5oVfSwy5Mq
-69HV2IZcvJriHY4aHMpSKfwYh+tgtOTKaHL4ia4fVC2cUuHoTboor2zzevXGoXnsZAQvFemg23Jlr2ic
-ovNiIs4inQ0Ne1cQQOTsuyEgaMDkFNOFxpJGV1WjszF/a3YVUYXm6SwhuZ6qk6P2rnptcS1VBxzMF0DY
-/W6V3/81bHSTf22CEmx0k+/PhNL9mUQtB9ajK4bnRwjpSmNvySWNa+qzi4kqpquj2I+T8z5Xx0QtTfIs
-LoCqhu992u//ffdeKNVHQqkjE0qvO/x+34gvyME6JjTj83Jfg6N5D0HpeBuUHrFA6W2gY8PHbWusqoNG
-k26r+mzgsT6eLAW1X7jaIkpJD+9lF3uYLS9GwUgT9R2jOzomguwkIXKf1wbf3e0Z3snTYXs8xlzgXI/K
-ntGYzVrx2N16NdoDy0lOQX6eJVafV5vfYDZu94oNtXX1FiVNb5ybluS9UK+vL6jLMbdGwF2wNAX5FP7d
-NL932OWBiMCYngxhGdjED5ubjyYzAV44XVBXh8YZItG9dDhwQy4TlbeHGRSDEhamSErY4Ft0EcaQyAG6
-EJ1eF6W7VzdF9wvdat0m3W7dYd0J3Vu68zoWckvI+JCEkOKQvSHHQj4IaQ/5c8jfB4QNiBzw8wEPD5g5
-wD5g0YClA6oG7BxwdMDpAX8YOG7g3oFHB14a+JfQh0MXhFaF7gp9I/SyMEC4WRgrTBWWCOuFUuHXwmXh
-azFMNIp3i1bxAbFQrBf3iGdEn/i1+A/9EP0o/WR9gj5Z/6H+O303MZOfkTlkPSkiNWQ7OUM+Jt+Qvw3S
-DRIHRQ6aMOgXg1YMWjuocNDuQS8OOjnIO6jbEGl42LDQsNqw0dBsOGG4ZPijNEiySKOk8dJj0vNSubRN
-ekf637AhYbeE3R12f9jksPlhy8Nyw6rDNoW5w/aGvRCmhL0W9kHYpbAvAG85kMbCOgeTwUMH3zd4yuAF
-gx2Ddw4+NvitwR8Pvhw+KHxYeEy4Nfyh8KfDZ4WnhZeFbwk/GN4a/lH45fC/DZGGmIeMHfLgkIQhC4es
-GUKHbB1yeMgnQ64MYUNDhw4bah46duikoY8NTR+aN3Tj0H1D3x16buhfhw0YZhp2x7Bxwx4dNmfY6mGl
-w+qHuYedHnZp2NfDRwz/yfDRw63DnxueObxg+K7hx4d/Yww1DjbeZIw03mOcYkwxbjA6jG7jEeObxs+M
-fwae4RaTzTTbtM5Ubzpl+pXpc1OnTORIeaz8oDxDzpcdsls+Ir8pX5Qvy10RYsSoiMkRiREZEdsjDka8
-E3E+4tOIv42QRowY8eSIRSOyRhSP2DXivRG+m0Jvst209aYXbuoeecfIe0bGjXx05DMjk0amj1wzsmhk
-9cimkc2YpkeU/lm00HIRWyQUZLgA9AkKNXFjLBLHywYOiMNtA+tjkfr6PeDiZJGud9zoEcsCGMxST/6P
-7wnKkGw2r40N4tHhEqYZYIP0Ni8WgdkbJHqhyKCoDtJ7bYKUBoJgGjN5EpVWjyihAtdCd1Rtr9uKOTSz
-l+Wn0VSCkpyZ2nxtVp8gUa81FhU6wodvPj3ZQjOLV+WvwfRPjbvrPPQAQWkPmjBj7vTpM44FlKRP9ipJ
-36bnicTjyWFq9zi1AlvOMVUkJdZG7W12qv0m0Rb4TWrL/D68vFN6CV1FV63uTQyMzkR9l1YpCW3z3O/m
-VAsUJbWQFQr7dh5uOELb6SdxdAydl7Ng5RIiaT7IRxoO79xHWKFaKCxZuSBnHiXwCDvUKmE8HQ8Ulnbv
-yNSSs2bu2I3JWuGjpXrFgJ7LhR2fRKGTU21iwJPPpXnnCBLm5+q1yE0dZ5H6Zvziljgp+ncZaV+PE6QM
-T/R9e9Lgu6UqQPMkDghzo04IuBFJfU13OBAsElLqoO8mZyEk1eTTd0ZSdiUyUpC+L+qwZyBVljqpWdFX
-OuDH6RQqKzEpMfHrBrx7xq8j1gl+v9rq8fv/ET+jrLKEWux6tJmaS4qFsrJSWoZpG7ufifP7/3bgdb9u
-4KvAfA98qMMibdbTalpVVl0G25LKksrSStwWo2sJLUaja0lREbUU5ghQLqRFZJ0eo19PDYDnTirx+6+6
-J/h14sk1ULn7DWdZZZkT/qq4z0sV2lSdNdXUUt0gQLmGVhPpcql+fi5d566mUwQpqJOApWzKU09NmfLU
-mXPnzpw5Z6axHFYwVhgTFz/mxk0kEjSQN68QmleEzSsPNI9nVa0sosXOMmjab96CptX+GEoCgx5a/Llf
-Z4h86PuaV7CuQayspGXwItSSpy+DEvxHzXkibCstUmVZJXT+GuyDrg0gqwxMmwq9MfVev05wHYe+j92F
-b19ZRiTtAbjl/zkroclQM++asirspmJ4jcpKhwM+Il4BlcMN5hrMySl+MQIqG9qIOQ23+P1/vzoPurv0
-CSLB59vxNjz27h/7/cywDI5+8DmUnnmH4rnOc7+Fnbvu8Pv/+sVHsHvzGMsOpyhVUt4Eaq7vfTepRA+X
-XiuHCs3AoKiGLNhtW26WaBt3GWsTzp3BL6J9FYtd7NUffQwURY0HRhazrdlRby/51JRuU1ccUoB/A1yx
-L7LiMroMIRodG8p6IBpfW/w2kS6qkqgSVa+pVyi75SId3QGTxKXg5NfgmFZaaLfJ52OnqNCfyLBTCtKW
-nb3ITX0m6inqswI5uKLAGajIDjNX+u+BIvu+ixSc68G5DzM8PkqNv0ahkyJZfCc8NkET09VmTQan6ilf
-G0uhAhfiJTvqv+2iphTJhYZSdl7Q6mYxSWqMTe/DiCvJypGRYtDDMMYLhKzHIT3oZS6hPVoNZdHqfeji
-xO5joWiPtkgZGW88QxEolvdFqlhDaxzVDvJS8t69gsQplxpDZ81ixWox9ra7NzxRevTde1+MoTH03vmP
-Pl5SUlZMi2hRZbGzhLQmCs4SmJ84SyudzsYt2xrcdTWOKtTOvq/fX3egCrMZHSjen0+m6WlBVUFNqTt/
-2/rGtX3vIq2tgtNZWYVhJ2VVJU4ilaupQn+X6oBHKjpf4ycFWsxOcmKcWqEtSd0mffDF+IjoMgVGRBCb
-C30LLdyPXlJTtIXbpqBgckVNUUBg1hatQDQB3q2t8ani91ePF3SbWCu0UoqNTWqLbWlra4ltE6Wk3j1R
-Sm/mzhgSk3ocMyQY2xL+9UQ1waKxralJEytXrMB1VKqtpZsKasms14K5webNuzRBkCZc0rM8ljfhEgwp
-VwusupK9y6TopQJFTYCR4W/hXz2M1nLLD0sRpNjkNhF9gRempy+04A4Ct78158R0i8T9gtuOxorT5zw7
-HTHvLLizML35BYtEfejdgkB29A9CJ72Y0joxq/PRTjXaTfhwB8og2ZVgPwXn2pXrfGuvBD4DCIfin5/c
-stv6msDLLPSRLXtGvylIPMgbvWsVmyjRFjW+EzHGemB/KHBLcZE0icW1QjEpjaZdcF/Moh67By+TFrXE
-Ji0S1+bkrLWol/RrG3K3mNlYcUvDxi0Wdkm/JadhLbQtnA2+3jVG6usaM/jur2CVzxAaltUuoYvp4oIl
-OcvIruWC+8DGF2kLfSnvyCoPkV6dJVRvdm6ljRQEtaLNRLoKosK5yVNGUTpqChToqKv0wykfnrtK6dVz
-HwoSF9FenK8t/PNfhEU/iISNDBta6S3SObiBXh1Fb1wPfmF978VoMefqvRR9bl1fi3lKgAjBOOAThKd8
-sNvYKa+aqscR6sIR2hMyGYCa6xQlpVOLDatgLg2/SEInw76eShYp/4vJH95TR44lzdXfkz95cv49ZG7S
-MbHung8nf5FP5rYc039R9+GHdV+QY8CCL1+dlpuC0fLv08/owY2ebbuIdGyusHLp2pSS+dff96Lz4Ja9
-O8mxuRrElND3SStKVxZm5RFPmtBYs925PZjidFXJqsJsPFq/vabJ0cSfmVO4YT1dS3p8paRNG+s3bdqY
-t96c+Qu9xDtmh75p1daVmCTCnKmX+qZ1AtaLASXp0zknr7NO9vDyHjHovW6WOOn3aep2KQjXry0B25A4
-aa4zge8hab6o+KiT+CiWogABkgJccNCfpBcokg8cDWtIoui/qsI4f422NDceI+oSsXFuS/prsObz1vRx
-SEGBheXgAwK+y/of5CmzFFE6bbvQ0QECyEkcab1IFDcUaqR1F2yvP0Afp4kLM2baCmfNoNO4LcjMmrnr
-k4W+MfflBKDdN7hOuuGFVo5labKKSGIEKQA/q4gsIuo7VQZ5Qc9VuYr42dT3x5l7vpBN8XZBk9VoO4vW
-9/9G7ApGGMPq3xfDu4ddT9FLnIRb7V0ueHk1RhN1vMjFWOEpgVFvtoMsE6ewOPw2Wja4nlRwkpoQi7CE
-VDX8Th3IBmSwAXt+xwZSZiAgRUma0lFzA+NKRykoWPVkXJFwxuKg5KOQm3j6fUkLt0VKmlxal8sNPdpI
-LSouLiqqKqmurnJWW3gGEqmoBA45i+FQVTUeQbk2v0eutWjDs0fUBTYDGRBLoCa4zQm3leBtwXPBay2S
-VqWzqKi4pMjCU6FIwacEnwoXBSoIVmiRgvI00ik0QQQl7fyNnFJpZGpe0iPfJ0cGRKhH6LwWQeL5OJub
-X3wRM3imp883Sz0hEz74SLBjVQKGeQmBUwNhWXrJHkg2igclDXgsGIq8CqfnKX2vHMpgDipPvffbtigQ
-DjnB1XjH75Fm+40JC1+hJJQaOXoFYjUWVxdXl9bQWm2Cmg+LqIVuj/0kyqLpC/qG25zsz9xI2BpsDwrK
-vHt6GtoTWKa9rV1tD8DJtStQlPoOMQ6VKE2IfHt8yvLRGlxsVyq8GXcLCygQebolScs3BsPRla8NR0nL
-PIZ7ddoQkjTfOZiEQac6M0xQ7j1nlnoj4nm6BgnzNGBiBp5OQ+pU40XMnSv0agau6G+INi5F0rHTFozR
-zD2d9PL7h9sXtI99PxKIXLAhwYZZJBwaGBnyL8eQdKEjQN8EydPBcqhntKAR7h3MBcsAZgC1qHP1PJtk
-31ySPJMkm8vThMI3iVHjEaD3PJIdmwJcPMce7jezOfuj2Ulc19lJXJqdRIp/NX72zj9MPnO56sDvD/zP
-l8VTnhI4mcLAl75U6l+hzIL01pDj6klFuiGQdwkolmYv5vTF4mauLFjo8MAOkQ9Ed9phStMWZGkrRV/w
-HYvWW0mK/gcxeyocgtQPT+hjZhYqHdxLQuKURv2AroJZi6+BozaY3BKpS75GEALjzSL1DaTmuG6S3a6o
-cbCxd5sUEC9ZnKIHIRPZliBwYA8KQe+4rUPiCBVzVZyWLRDN8S4QDDWdllk6sO7lOvSlr3lr8f7F+58q
-RI/6vOc2pxJ1njoPhuoo/FNHAReOmZGkt99RY1i0kJpKZxxIJRJVsjrVKzQrktrdqDliqahTwnTkPOEP
-V+uNHg0c6qCO0bBSD+rowO3oDnWQReoTRPTDyh0u/wEzgajgUh8s6D7o07j4cSdBLXSt6XrejYebSkXo
-8Ve+a03jCppGEpmpVf/aqF2zzNKsUctnWRLZKdi/E/cP7YXeX9q39/vB72kjmg3S/5sYnDCQcTZrgJ5m
-iYcupBxIxsTDyQdTjlqkp1Vx6dMWkLYV/ZuquPdpMx5Z8rTFxq548ci+p5HnmdeHWT4CzPJ7Zxevt6xU
-G3bqN53d/55Z2rV91fLlq1bDv227dm3fvssiRXX25GNefEMlGgfQxX7G6DpJw7hj5M//jIAn9OgUg6Bd
-FknDmY/uxSYCdkPjz/4JnOeHtcDWtubm1xNYFItsThd6BEqqtrNoL4u2UdauYXtLka9EzqGsGSONJbT7
-onoCiOg/AQsEAaKldbl569ZtzN28eePGzZtz69eBzBZ1OWHL7onH6ZeCFAg/+GxcZWlbWzAA4Syw9VOB
-yUmmCxoz9pD0xcKhRa9ktfYJQJAoTiKrFYc7V6FafT6fNtZvUnojVqXrAkmlH4wklQrqc2o0qtX/uhxx
-E22oqa0nf2QjgAf8WK/OV2HdZ/PZfBqv8BgtjXGOVtTo75lI0cAM3ECNg8qk/1SNw7s9RHz+yUPLBb/u
-pkE+vy7S/R6UHrFB6Zd2v27EoQtQUi9iabHf//uBp/Hsl1DaOh7veAFKcwVyaNfzb7MQiiuOtrj2Er85
-YmVNJYby4IyjmXR1Xm42yVuXt1nIbVxdn0kX0SVLYKPBt84Rf9D+LgWs7Rs99CD55Am0tj8eF/fuE5+Y
-e23p2deiPB5BoksbV+7aQH6mfKmXdrm27qR7CSykeinIZpqtwDgFJRdNtsEsAniYLt3a59bGnluDx1vg
-OJKvYDV9ZRqcMhapk5kiRen/AKbggiEAAAB4nGNgYGBkAIIL/7bcANEXu+wuQOmLAF30CC8AeJxjYGRg
-YOABYhkgZgJCRgZVIFZjdgCyWcBiDAAMpADGAAAAeJxjYGRgYOBikGMwYWDMSSzJY+BgYAGKMPz/zwCS
-YSzOrEqFii1ggAMAvR4GgQAAeJyVfA2clFXV+D33zjwzuzM78+yHOzO77MyyrIhISESKiERERmRESIRk
-pGREvmRkREZmZGRERkVEZEZEZmRkRL5EZmRkZERmVL5mRGZmRGRGZESm/3PPOff5mpnV/4/fMs9zv++5
-5/uc+yhQSrWqqhqjYOnly69SWZXCEvXMM8rWwNK3vPlyLFP8hnUp+s0quGSxqqiJam5mb9v4rrvLW3un
-DuZPv+gMfWb/6P3jR0546tzF556YesuLJ09fN3vKxcsvvvu1Cy7xL5lix9AfVjlVxL8+1aNqKo9/XfhU
-UReoa9VudRxGwyLYBPdppcfqS/UavVM/ZLQZZS4yy8wGs8s8YJ5MdafOSc1LLU+tT92e2p96LK3T1fTE
-9Jz00vQN6c3pXen70o+mT3m+N9Kb7M3xFnsrvXXeVm+Xt9875D3uPZ0pZKqZsZnJmRmZuZmFmcX6UlxN
-F/6V8P+K8nF1Vfzfri1HJUX8fzj+FVU/lpaovh/fa8rXb1DDmvYt4VMH7bRHlWnPPo1RsL96oep9zj0r
-+NuHTzi7vnyIfnaGQqQm2u/1+Pxc5/PVCCzvU3l92RC9krOFvV6HozTr1Y6l/JTHug6au6hHqW5sb1cw
-QBhin3rpyZZU8KlAI9r5fH0jti7gvx6EZoXGaqOTsSMWaL4Berdj19QudRr17ZUWPq6uB0f25Xzz2KdG
-Z+zrz+FvFf914coq2M6X8Ws4Ux/1LXIvfbPqbNiyj+YMW38L2zWbPTKzWo/jFGn0yE7xze6x5iCpP4bw
-41ZVHNfCqJdqOqhfHvvV9GexzbOsTC/ANs92QruwpNnKe7BVO+6tOESLir4JnxuthMf34XVBfZW4Adf2
-0s5qqkefQZjQFCv0R/BpCDzQn8H/G81PVK3XUd9o7wjkYT6OxZDGlURXpap6NNaF62onKhsg6Bf1mbG6
-cM39qlevxboCYSivqg3/SsRJBtU3Y3U52Yel3qr+FO2oIistEJx5L38iSNYIkzqxV5m4qa8G9UYsq+LM
-PuGYPX3HbXdS60Yz3UEwCGtse5xJf1TKo3DuQWp/jNqEs7fTfny4FOVKP+FGD3MErO/WI7E0hEwbl0If
-loZjuH19AkvrqEFvInmVOE/9aSnlVbvS9aol2Jfgq/5ErCxPM5yJZTxTnigOKUj9b6yM2uvNWNZP599H
-M/hqABZgWRJ/z0IJOTyEnerTn8SS6EoqeoPKkFzpI+5aVB/Hd56P4fpJfO8jeDBveD2+h3Q2CJcoD1uf
-huvBefXHVToYfzQ9t2FNWZ+OEtvyh6r6mDJ2fD2JftvUeLUY+c2d6hHIwhiYDVfDetgJB+G47tDj9Wy9
-FCXvLXqPflAfN3kz0kw1C8xys85sM3vNIZTAfmp0alpqQerq1JrU5tQdqXtTh1KPp1W6Iz2YnpCelp6V
-XpBerL6NK2TstFAdpLPspHV2BDyrHVdq+fQerCnhs08QtljTSe17CO8Zhy03vbthO+ZdDmJ25O+RZEm2
-K9JJ5RECJHXUPTh/H2FTJ7aoBHhfptouC0V1TOi7SLvIERewfU6ntdVItm7FOjtLN8naPvxN8G31D+JZ
-4SjDBdO6iGuX1F7ChvqVdHIZzlCsm6EtHFGfj7/1+80HVHgX/sbPwlJyN/EUK1+/32T+vOD8D3CdjepL
-1P/P0bXIymrUrwyvJ2j3U7mj5X7B193EBaKrCmgQ3kwt6mfMqr9gTXQ2iyUlKjlaV1NizIDFTUbLwJua
-1HhwRZOaNLyxSU0KFjWpMXB5kxoNS6jGnq1Pmk4XnXEHvv09sZ8qSQq7pycSNeHId2JNHKaOxv6W6MMY
-ap//mqjpEqrrU49Tu7DGzt2Op7OQnvuljHHc7uI7dIbR2e2ai/qLJBE6RAvppTFr6giWRkdnne14orRG
-EvS7xLvjGL4By0iKkKTLk+S6AjGkn3iywBj+iiU+wbaTRvT1F7AkRk3wBuLR/QIXlBVwGfLcsKSiP488
-l+dCSa4+RXy2h+TaLfjcT5KvCG/B5z6av1NvQf7Ls1ykblL71SkYhItgOdwMe+ExndWj9IX6Cn293oKc
-9pA+abrMOLRzlpjVZou5Cy2dJ1KtqcHUlNT81DLks1tSu1P3p44gly2nx6VnpBeml6fXpregvbM/fTh9
-3PO8Hm+sNxUtniu8Fd4a7yZvu7fHu8877B3zTmWyme7MAFo9kzIXZuZkLs0szizLrMyshhW0iwpJ6R6y
-bXpJJrGUL9JeS8KlrL3zAXzrJH7sONCIOl6XI4q30rAb/kTchzXxQmDtVaVv5AT0IuKsFdFLyxHb0Nox
-lhO30em9B9t1iqZq5VybcPPEKuBttM6KYCJraQU6GcbJPhq7Q28juukjy8cX/MwHuCf6LCzD/s1GG6Ae
-dgf+0DswZxMX7iNJXyYtjvdUJT7fq28VCVkh6mfuXxWdYxCuIx7M2iTD12rP9mRQy4PhxO16iOOHdpMt
-wd3AWdSuKlpuUqqOkb5VsW94TFm9mUDvpMvgb04oydlan6GTZMg4/Z1n8tX7grq8aKG9cso+rCI9mXfj
-+IEdH7VhPRbr7OkNl1OK8Dd4X6Rfj5SzFVcyY0meMHxtKXIqsYqK8M5gLfWYfqXYDSXlrFpfNK8iDFKN
-gyvTA8FUfZq4ZnTnBVrXINRifaJWxVLiziEs22WHNf08fI/vWXit/rLUMM1FR3s7waCOusw44qV9Yr3Y
-mexeSmoT87LYii3NrCRcq6MpuIrGCVfLkCvBW+vKWcqOEI2A9z2MuDX+6a8q5/3okln5TN4vY/YSTQ4j
-/4pt95ZAFuTIk8DafRH+ByVDdF7bpqa4tCSeGeZXNbiapAjrBQxLhAz0Y2mcSiwdLImMwKMj/cG7ULq4
-1WmUBjnVqq/HsgT/gwGSTG5Mlh7vjfRtpz356q3UjuWk29+bSYK5mZnvvI2kU0g1VbgGS9xoKdImro2U
-WKhW4D2RkizpL2NonDKWiJyHd1BJKdBgfVgZ6eVZ3QYtg2ywIguzIizHvfM4fDpXybuztM4iycjcoISS
-zhN8sBb+lVQnJ6a/JDK0k06zKjIU4abH4LOD60YqJzjpr4htgxigz5b2FheWIhwIY/VtJGUtjx6NT8x5
-3kRPuAf9FnpCzQrejU+805FqllqO67xbPQoejIKZcCWshdtgPxxFqTxST9eL9Cq9Se/Q+1AuHzdZMwwl
-83Qzzyw2K8wNZoPZanaYPeZec9A8pC9AXOlR7GurBTYG+1naHI7CP4kf9dVJQabOCukrNTjRpBXzxRL5
-GAbhHyJhkq2qRLnUBldFZ6cS3glsw9DsxLn8IVZkMep4kxbtdJpWIh0X/08j2Y5nA082qWfvXDf8rWn/
-Ljqzs4kemHf1kC+jLxjd8o+xVF/FtjXB4QH27ZD0q+pB4Zb18ETrB/7epJa50z7C5+TcTh94oklfyz2r
-egpJgiY4Ac8jyoqu2lJ+Qd8u5WxpBfIX5iF38skDXRCtAM8ZHsfS+hUso1JnReUIr3rVD4m/1Yhn9pMF
-jPxNv4h4FMu5HOnVeT0Zy7poDYHdoLcTj7I6SgB5/Q3hUb7jZPAB5Ao0BmFqVf2I3t2qq+rt5MOwbS0f
-KeqvIS/wxUPrw/X0ZikauZL6LPGQPMnvqrY8pIdgZP2uO4jerQYxjp6sBv51ZWj39+Ivy89zkI+tUbeh
-1n0UWpHGL4RFcB1shNthHxyC40jnw/Q4PU3PRQ18mb5Wr9EbUM8pxTSbqM8vSqnOe3mDsh7vXuJI/XQS
-LK0qohdq+NCztDCwBnEj2aKX7A4aQU/F+uGBtLZynOErPgz48JD9Da6xUFdvT61D1te81qgzAk9maC06
-7dp6MpM9Tyec69HPx+cRdMoV0XTJZ6bHJcpLbJ/pb1I5awYMa6t99ppJiLXh3sU/rafFSpnCfP3iWClR
-IVqfz0PMHSZRmy5en7a+vRGiAVWFwj6I2BzfzVgpYdvH6qR3IP6OEO3P7mYnSiM349X4zPhZMi/A59OE
-KkeSNUgQhNWIraz/zFKb1QNQgMkodzbAHjiiO/QklDlrUeI8oE+ZqpmGkmat2W7uM4+nCqkxqZmpJakb
-UltTe1IPpZ5Md6THpmemr0hfl96U3pk+kH7MU2j7jfdmeou8ld567zZvr/eQ90TGywzLjM/MQGtvWWZ1
-ZmNmW+bOzIHM4cwTWZ3tyo7MTszOzF6aXZpdmV2T3Zjdmr09uzu7F7KijZdFH6wpjuvUc+pcYJ3jWULm
-/6NfKejXp55GPhjtx/w76qkviD5hMeC/Q7Yu0jxBawCaMWxdEj7MGOLiQ1YX8RIt2UIkKUSehj7Cf7RA
-QDcdM2qlWl33n4mWjIUdBAHx3ZKNMghKvIrRMV1Uhv0a7NU8kWgXHbGgAvsUPk2WYymwViN1Kmq7/ot0
-l8bjhR6l/yRahf421uqrBKMnRdNoNJbzGrUk2vTL6YVxqKI6lWgTnY3jCyW4WZ7cjmrylhNL2sqNp4YY
-R3AT1+MPvR69m3BpuGjMNVUfkdtNNn28RdQz+wLyopRIJhbEEqgojpZiezNZYqgM6xxJ0G5al7UjNtLZ
-hztljZL55wB8LlE7XOxZlu8vkT2H3kOOBpEUhs9I9CnEij7R1Kv6uyRdukg3YToN7FT1jcCOrvcyfCox
-ZmsojeB0qfPr+6lnBGIhVdcEfwpwJtVFd9EpdkgRNok0dPMVZQ959W+VT5y+syi+w3qEZCH4ElOx0cI7
-IzVddEa9DEkwidF6pWUNUokaK21YIt0lOwhhKBEvdbbKK+eLiWnn5nyhfYcL4uWBz9KKwn1WRAMaKeW+
-insmbk60dzzqpMrFViuQhXSivCrSkv0IvFILK9GuYZTKxc6E9eMKfJ6sa9YVw9jBG8Sf62wQa8OCWlhX
-qhXATaS/hivvtGeqx5P3OEFD0EoRuUpU/4UtEuHjFXCU+jIsi8/kwcdQvkfnScNHEyUpuDFRYuAjiRIN
-X5DYnpuvAhsSbVr1t8X/XAxinp9MtGmB9YmSrP4W2eERvgKfSLTJwMcTJR5qFpnoXmGd8sIWcAZp0nJu
-8C98C7HtHair9JBlVIbNQUTRh614WozHn6MnpAi9i6zqAnH1QTVVzUN7fJXaoG5Vu1EHf1AdUSchC13Q
-D2NgIurgbRSFDO0hy71HEETuo5hwnjTtLrI6fDVM302lPYTT1qaz6+rWS8WnwqUcvf0xlvWKVSpjwpfI
-p9EV8cZ8kUrypIuQJIRbKcLq2lTUT1RGxuFI8R6xZkQXVZ+nd2fD+fB8fGduSdYM3EKaHlso/0PPvM7n
-47Pjqt+TNgh9MwX1ayunTlNjUB+8St2otqm96pA6gdbKMITaZLgILoWlsBJugPVwM9wKO/Re5ctpRXmE
-pQarYdvslR8SZ7Ur7HBeE4JomazJQb1PYvjxemfn7SUOnRyfaegFtLcOwvJQo8YxzQvx2SerLmkjvIDm
-zpPXgWFZJo7+FYrtu3iW+OHhNjwjLnX+9B+QxRj6v8fTGYTjufc+1o7gq/JekvcvU1Q9jOhso6i5xHPg
-JOG7pYuS/hE+25gVYoD+PuI27UffQ/Ylnp05D5+4/nXIpYoIhVFqvrpObVX70L7M43nNhMVwPZ7TLrgf
-jsAJrXRed5sXkUVZFC2zT2y/vGgJhTpP4tcQwi72x5y3Qf4GtOFuKgSDPpHCoRUvGRjqndRmeNAm1H4C
-HIdiYpwu6sGyVziq/gnRS/NxKlDA+aOjMDZyZNvWWz4RrS+oiO9QLcedlwP/P2tF8oZztzWbG9rFFnd1
-gaUOuUSNyzmo6R9TTTvJ4jimst0b9uHybr0f8aIi/tMt9MzntF3sPatl3Yu4QaPC7UjTVZLAPWo6csQN
-6k51GDQMwnTyPmyCc4n/DIZ+FMV+epcTE0Y5ziHcaN7SadQvfJZ2omnBeYRT8XZOHwlip8ir24ccDTka
-TGrQhuNFVeUioi8keZdsE4m+wGSiiniLSH4aTCHpEq+3spC8SXA+WVnx2k7i5j5cQPwoXmcxug0mkByO
-1sxQ65HrPg7dyG8vg9WwDQ7A49rX4/VcvVyvR3v8Pn3MtJpRZrq5zFxrNpk7zP3mWCqbGkhNTs1LLUut
-Rav8ztTB1NG0Tvekx6Ndfll6RfrG9Nb0brTMH06f8LLeMG+cN92b713pXevd6N3sbffu8g54h7yj3km0
-0Tsy1czozITMFLTU52gQGHKeKcOMvQwsuQpiFUoeks6iBAnbl0jn4swijr1bjBYfnE4Tjbi2nO9ZUNE4
-Pec2/ZTsvULIBVSDDBvdIXYkj8a+k1Be8IypWJsO4mg8Uzj2gaBNOFsiTwemqnbFEUn2lxYiEVuJI+su
-spncXH0irfOET5SZpctBi3CmeIT1v7ExOI+Bs9ccP+yMtegV2eMsT1yd7iSaq2/h6jmPLqxn7kQeYJTQ
-KlbLnmj2l1uf/X9jtWH2KEli3R2rlZiyyw+FaUFUt5Gn3Iv17aTT7HV2GPyHoBzOy7lozKVNrK5dueiS
-zUxri9Vx/KhbfBnnk7bRRvBlDCxL3kZBTaqrc9kCVd0SG7MUbaffGtSFJ1wQ6PyU+F0byfakDd9FPNuN
-SfE0gdopstUGlS8SL7QjfyF96nO93hWMFq6CtcpcbB7W2rpIN8xTG1fDNGS9B8VYeRA9V+eRvI5CiPRh
-+IboXN1xzVBdE4wTXVNF+4nxrd13usprHSsvChZ360ysvNNpUPAMWXNRvMX161Ks1OLSCNUFL6ZoRIiJ
-HP1oj7WVTEfdGiulTHac7Smy6KJ0WoZvUxnhCM2FVoM+KO34jNhG3R1rx9BcQVEP1qA6Rfs7LTaH5X8+
-fJ0iIfG8i6dj7Sj/AHaQpOFToHPR95E1Z/G+X/SGX5KlxtkFiJ/wLXp368rArti7B/8be0/rN5J2zGuu
-qeHwzVh9Sv+MYq1sH3fCv+lN8BheRG9yAnAnacL9tJs76Jkp7QDZLkQx+n7UcoimYSfpx1Zb+gKVWaz4
-uYvEqAmS82R1pmlqCdo1O9RBtGq6YQLMQXtmNdwEOygG84RO6bIeo6fo2foyvUxfp9fqjXqLvk3foffo
-fSh5f0USo48yrirK5eXknKdddLHvkPyo0PzRPJ5IvrZ6D1ETZxFwbk1dRAN5jk90VGrGedBW9clSqhFH
-kaxmFc2yPL/JGAFng7uIzzLV5wJftaPJ86wMaNCfabuTuEdRekYj8Faj5X4xGocOihz2iT1hcwftGX+X
-vClVsbclgqK+SFSZgDZqcq2iFUQyX/U54lHxVZDJCsOorCIQYAuji8p4xS77Y6VqqTuLc8iP4VZjOfLb
-JEPP6alF9W6xwCV6qc4l/C8Ec72EIogcaxpUEwmPCc4wXfAbZ9QTBHv71VbyYSAe6ZcSzlpt6irB3qJ6
-IdnnBV1R1vfXq6fjbxlHsPb6PLVK3ar2q2No+Y2GGXAFrELLbzcchGPa01U9QV+I+uMivVxx/nuX6EPh
-OVdFGtKaEMrvGLJl9H7K20k3a9bSQe9qoolmrVgWvYM0meZtKnC3eLa7iA6dZ9sXn6WvLyTtseb8MwH+
-ddNZX0A46uxKtn9ZJ7M7XiY5wg1mh+/TicfnrSiOJh2kfgMqvOHTy3wYue73JJs4jIP6xImsxL6frILo
-Wgdk7slUMyDykqU73yX4WV0fznApqV8QhAZklCLho41U9xINhStnnfEBwuWa2PTWa3Ut+eHYz1qGPcSN
-3bp/jpjWjjNZ3jtGzVSL1Er9f9iHpJdynuk2sclIw9dXkI1dJPx30QG+sYYQ0A8KLMLedHvBTCV9rV2F
-0SbSFfWvyafG7QeIZjvVK9QmymAdDQtgLeyCh3VWj9Pz9Sp9qz6gj5tuM8ksNNebW8w+cyTVmhqdmpm6
-Eq2jbal9qUfRNupPT0lfml6Z3pS+I31/+hhaRYPeVG+Bt9xb523z9qI1dCKTzwxkJmZmZa7IrMysy2zJ
-7Mzck3kgcyRzMpvNlrOjsudkp2fnZBdmr8yuyF6fXZe9KXsrHMEdhzF657dmee2y4hwFkdcK/qxKz6EH
-ez+t7//oc2pfdbqJfpm0DzNh628r9Ip2YM/ud4pzNcoqJ9kuLs+jS7wVsVxS/VuKLnN7Z1O77ErWQjjz
-x65/quoKuMyQ98rUS8jrl5dbGs3bvYjslcYjSnSOLLYfSR5Fj3IRGUcrTAVy8w9+2KBdgxsWqEt0NFlf
-ZFb9KOVPFSI8wMVxIqX6MeKhA8rFk3yy89gqqTrq0Iclz4nPxWXPxFrDPtVet/rkzY7r5AzJByFrdrzH
-ya57GozTTvpWJ0lOq7dNI3wbAgKISf7QmIS44w+NO7gSv+lKaB36kER9ytInzMYk7676KPlHGf/FjxbA
-zeqYaxvW8xhWQs+I1Cez1+wZv0d8FtXAiolactdQreP6ydo/SN8yrahAOw1OQz8Sqe2NrMHK/Q71EdIv
-k+t2Em+d6HKs1UQzWay9coBOLIqXedEBrA/2xoYji1dbvym2X7YyQt7+csqUditlbGeK6YYZkbpwXMI4
-c4Hk6A8GMA7jLA9LDkOZRnTZFWRFw0tj8+VV5BYb/ETqJCMkqsPChSofW2eYB75farhXFKOmq7ysMcyx
-YGvqothoUU3qFbGaSO6wnhmriWgq8FqR5uHdF/L4wo9VLrKyvJJ7derFEuVkzVy8o/r3omuXVXh/LK9X
-SqnDVl5/OVHKcneFaOA1sRk40/BllHEcO0X4KWkU0Yz5a+X+SmDT6mGkU7M3jfJe4Qdigwa0rR+ikg7h
-FfZG42moVfdL9N/eYfkNxbkGnaUBe+m9JFzQ138kq7MkLfr0u0kLl53p6/DNjVbQ76I6oU29mLRyhvp7
-VTrY4xTSfFhb75M4pPX53itt7L3gP1EkjfRK/U5qb/dQ1e8l/d1KA1s2Uc1Xy5G63kfZNl3K5c/HcqsV
-+3skY1L/mbI1fMnNcWdmdWJaqT5COOB8pm3u3g+VXKhyLuNdRb1ZL6X7QVw+gK2Wqy3qgHoSyjAR5sMK
-2IAW8QF4DJ7W3Xqsno5a1VLUq9brrXqn3qsP6of14/op02rKZqQZb6aYGXo17iQvNievgm/bVVTOWfzK
-DzTxL5PG4DxBQ8p3+Bn5k2uB3t2qQKRJh+K4O3vBCojxHyS4NV5FbAW4Xr9pS7ZGapLJG/PYCiWhtYZz
-FYcYoQL3qUJsj5yBJbJTH6PaMMrUSxTB0riMtlxbU/jMoDqXP+U0SY5B/Zzs8RBWYcbUKuEjHYqjFiXi
-G75ma64WrNBR3f20i3Akie6g9t9K63Y385lCFpEF3RvwIJtNcXmiTGPZV4Iyd7OypN5InqmwnafPJbu6
-XaRXpzmH3sP8paP0zjdiepHe/hpEwskKhJeTLeNuEr6caNyt+EuBTW4he5vUsd/4L/RWJJwqo33hRda1
-DSnGrfxlRMt88reQ9V5EDvC4WOplhMY+qMJsuB7ugEd1h56qr9I36/36STNgZpmV5lZz0DyVGpmanVqZ
-uiV1IHUiPSw9Lb0kfWN6R/qX6ZNejzfZW+hd52329niHvaczwzKTMvMzKzIbMjsyBzJHsqlsf3Zydn52
-efbj2e3ZfdmHs6daOlpGtUxpmdtyZcuqlo0t21v2tjzQcrTl6daO1pGtk1pntV7WenXr6taNrdtad7fe
-2/pg65HWk7lsrjs3mBufm5KbmZuXW5RbmluRW5Vbm9uQ25zbpn6jzlKcLec8qGxpupuyjW5tsb0fta8r
-iuPsaSWeSPVroqC4fmszY9kOd7rtIMkIe2ZlfapBj0AmKud54ngPfwPl38+5R4XpSP8jkCBhDxeN5OyO
-viiOqespV97pvv0kc6Pfkoh5ZNXDFGMIYRlq2yFcQg3gUKJ1PeQroa2uT0pGaLO95pW7XWXHWx1ZN0eC
-+LbjYMN1fwB5b7NdJm7IqYfomyZDrTrc6wcj4yZXkbx59xTJiub7q0Z0z+MN2nK2THhj1HmUfolto9mP
-DvqN4PBb7PVsJ8IZKxz5cp5IscBForucY/YHPkArajR/8ktEv2raMgErY+XEAK253uqLf7fiSZKGSXyv
-uw2u/9OgXRT6NrZoddrD8l2A5hBiqXpCPFPxeRMeCZOjqATfcBlOeh/bA/yVEPnmh/4X2cPxsTg3OuA8
-+r8N2vQIbMTeMkXKGQv5Tz9ZQU7ycTTkd0SvQ1Kw4fyBZuPU1HBTIH0rfj7Jc/Hr1suxZ5FERkn2TrSF
-i6vZmaxnLootCWwCqxs2ySS31GRSYu1Gv+PTRtYht/89racRJPiEZ9F+nC3uMjbcd0zmBHykR/ICcnS6
-9KyfEf9tdG+9TifXTzesFS3dQIPaID9D/5O0pnit4Ci8irIBA/+XcpkI9vwulTxgzk11WgvHyGdhSbfi
-fNreQHsiv76eQ60rFA1I5A6YtFilDb6UBL+i+3KWh/D3ayLWp55LYzbGr/fX7SGEjFdX5yIsVb1GsR+p
-/j7NA1gaXUnIZS8mzs17i+mtOFouGC2acT4btcay4sw+jpRZK/eVVMr2Zejd2E4aZtRTavnIg5ShF+aE
-kH1l8ljq6Cnw0cEv6Ps9zBOZm1lKuCHStiCjVk0GS3uFV4UWwitRT3Un6/z8r6bc3jbFfge6KWTasCxB
-z/pDWOZg0EnztMNBygHuEovCrnQAusmnnRd/GHEQ3U/R3Jqsh3NsLyKrOUJP8ArSmJmOOOPRYAljlIsz
-/w1L5FYr8WBf/516RSjAtIpl7e4GXYTv9iYfe+B9mEUZqKFf+JWUvRiuYya+s8eKv8zxCL5HTke9CjXo
-CvH003H+J0iflowd/T7liY8JbRHTQtmOrL3/kp7pmyImS8+2T1WvImubMoD1DZT9aCH2anxiXLxdYmY1
-o+kmroXG1yS2VlRfpdiaPaPZ1NfmvbxKImj29u0ytQ4xb796DDTq7pNgHiyDNbAFdqEd/DCckNt50/U8
-vUSv1DeiPr9d36UP6EP6KOr12uRNt+k3o8w4M0z5oiUVA+q28OkXnCyZ02jnfDOnKnQc5sW9mmDaJvpo
-wqNl2qWWreqox99ylor4t5xfIfxyQ1XPowyWssiiHrHpCCa45ra6Ncs310yNbNvYl+YcBzG9Dfq5L6G9
-RuWFMlz8x9lSX1fuy3zO00fRBONu70RHQ9iYTqRRB68QTnPINxXCiXI24LeUN1FWLp5ufdm/DspKirMC
-ffg/orQO4Uh0H9T0UDZ3/Ebl7yJlslZTJU4Qgwg8JFQqWfgKTAfRpNw6J+v8sNxQDTOVu6iN25n9HtsI
-ygLPu4w1eJBsWwsr9tN+KHi38Z0q/IZizm7elB6g+j7lbkzPpkieQMmUyMNF9hUcwmfpCRcT7aBsgYfx
-qZ+kRtl5rkw30glqnManyHMbzMX9Dcday08nqen6PMKHdsV5/OHtyomJcrldqXZQfqo9xz9Qfmob+aCu
-VpvVPUh/WRgJ02AhrISNsAP2wyNwCm3o0XqaXqCX6RuQ8nbqe/Vhfdx4pseMNdPMXLPYXGPWQkXiUFGd
-t9l9tPKztI1+L6pHcgKateWsoYuV7ySkcpnBsa9gwO+pBUd22QJNxGHM80h3Sn6Hz3nIR1Cp658XHYV5
-wMVU52aPebrNyEg/xsiik/KmXzzrrMMxPvOYffAI4VB0vSyvauo1VMOzsYbiMsTYcy3eJcU+R4S4GU10
-wmNxjj3Z9mYwUl4IelbMqEg5+51JipnhkXKWMhxZnJeYV7iEOZNu73D7YeIbran58iUO15rhO4baJqBv
-BiIj5IWyBjWP4KJZzGlr8PtIW3fCg/q1xH/4PNwXbM6gMl8ypzmWsIZyU7rEb8h60iVUFq7U8sOzIn0H
-SM/vVnOJ/0ROxJxOJb7kXNs1X6LsTdqIBQl/oTYd4qWxlPFhkvm2jZX5g1g7mm6DWOl4r3pUnYQ89MME
-uBDmwxK4BmXkBtgM22CHOZs4qbvB2vBrQvBHVSROx3doWS7w9wWIMs1YWmk4RuKLOeqPRB9sbdRUaOtx
-7POYapPR+wJdy408TrXFRo5+g+Zg8MWTMHOjV/h+rwrvDT9KcOfZHQRtWZviLEz+3sogPIZQbVP89Qur
-Gw3q9xOfzyuXc1uDPyCc+eYk30gbRVEMK1NY8v2C+HqQxwGvIZ8j6eJ6CWW54TrM80Xn6VYLkJP6qk2/
-GXlzwX7X2IwXW6SmmMbjGdNM6cx/NMqIspko+U1lZwmYcyV/rywnpumLy/abyxY3AWe1tDGI2l3p/wEJ
-hWbwAAAAeJxjYGFiYJzAwMrAwOjDmMbAwOAOpb8ySDK0MDAwMbAyM8AAowADGjjAYPCSiwnMZIKqgcsp
-AKE2AG3DBkwAAHicvZDNK0RRGMbPNcwnYxgf47j3njMzTBIiGsZINjaKkqwoxoKylY0s/Ak2dqwsqaF8
-TAg1mlhgphRl4T333Dtz7kb5C64b/wALnnrft6ee+j29CCEH+h4ZSfZG0pPtpC9fLmXsu4xGUAVqRwk0
-h9bAAU0QhhbogB5IwBTMwAVcwQ08whtbYltsm2XZLcuzV6Zps9qNdsfdPMTjHPi7HtS79LgRNxaN5yIt
-DpdMIQm/GBSTYkWciAcTmU7Ta1k2s9tmpQDZrGaIQht0QS8kYRrScAlZyEGBjbJNm7Vrs+7ZCwMtpl1o
-Oe7iXo75Dtf4h16vdxudRsLIFv3FaMksvQuf6BPjYkqsiozIm2Wm27KsgrVv7dEYraEBWk0rqY96qYe6
-yTE5IofkgKTJGIkQmTSQWuInLuJQr9WUOq9G1YgaVs6VMyWjnCon8rV8KS/IHXI73sDreAVP4CGcxIM4
-gQdwP45///a/JDnRr4FSmaO8wulye7w/ivtQZZW/OlBTG6yrb2gMNeFmWVEJDUeiLa2/L/wH+gRjJ6dZ
-AAB4nGNgZGBgYGRotuLS64jnt/nKwM3EAAIXu+wuIdNMDGBxDggFAP1gCBAAAHicY2BkYGBiAAI9MAli
-MzKgAiYABdAAOXicrVQ7bhRBEC07suUAYdEkSBYfWUg7BCDYxJJxp0iENAhIiEj6AKSdcYJJiZE4ACfh
-PlS9elXTa1K2NDvT3fV99aqPReRY/uNvUSlS9b+qNDz7XHWVCh3XW2Tg61fuVdqbdtPTkTp+1mXFqa0e
-q2abxPZE39/03bl2u4Z3p2Wnv5dY2e+zeup4FuwU2txgL7xI2kYGFuU6K3qUpwKN0FluyZrSidEdyqIr
-i76J+TihV8/xSHUK8zesTuQUGl+R3yrfGTty+FdW1NioMeQhOtSIT8fXQOTAsCqmUe+G+Qf4Wabu96zW
-cvdKvH+OxtBO/4bvQa2etsEPycx93YhCQeTAuiJHe++Rva0CxdDxbKIPld2uQHLGI7gSFdvz86ALcz+c
-B/b9TF4wt5X52skF/BfZMW7JDOaonuFAhoZJZU/bxFe3OVecG74eTHMg8lHfl8nGCtwc78j1LTXNesee
-jZzPzdc2rQVxGlcLOrVle5WzaVpfiO8V101eI8IKPMz6DSrrmOJOrv+R9/JDZbsHZkRkwsgZ0YjKNScp
-0N8pa8tB17zmMfFFcnJN7lPXeeQsjtumTVWaHCUmC/vyJHl9OEsN+DpP71G3MGtnVlUcTm/5Xxh9vh18
-UgZti7ybmNsmJGpyxdHonGTD7mxC1St1zJ5Tc+jOXSLVyecmn6BVyJ5XycA67TdOdEQvEvdxP5ib+TYu
-madP49YL29/LU/BCrf8CJ+GzUAAAAAAAUAADQQAAeJyVkL9Kw1AUxr/0n7h0c+twZmlCGmiHbqXQksEl
-iOBmbC9pIOSWJK30BXwBX8FZEF/AR3B081X8cntbUUGwkN7f+fudcwB08QQH+5+PW8sO/c+WGzjBm+Um
-zh3Pcgtd585ym/xouYMz54OZTuuU1o2pqtlBDw+WG+z/armJS7xbbqHnXFtuk+8td6j7gik01tihQIoE
-K1QQBJx6gCFpzqimP4NCn3aIHAt4pAl9Gd/oWFcaS/FV7Lbl/5KZmOr1rkiTVSWBPxjKXOskU30J84Un
-kyyTqI6VEqlSFVu1ZMUFYrZTZqTYiIQU1ZQuGYwrVaRxJuFC57RrxQQbpsUsQKSSTRYTZqagMm/BDGX2
-8riZYMzvL5F9RoARXF7BPd4DM51XM10kSgLPl7F8H4aOYOQO3XrPf25xZRJLptYJQrV6Up/KwqAqylTn
-MvB8P5Dfnb/6uj/PcdAxMu7hOJ/Q+HbqAAB4nGNgZgCD/80MRkCKkQENAAAoVQG5AAABAAD//xhdHm30
-+QAA
-`,
-	},
-
-	"/static/fonts/Roboto-Bold.woff": {
-		local:   "server/static/fonts/Roboto-Bold.woff",
-		size:    18568,
-		modtime: 1456790235,
-		compressed: `
-H4sIAAAJbogA/1yXY3An3xfmv8HEtm17YpsT27btiW3btm3bdjKx7c3vv7Vv9lZ/uu956jm3Tnd1dZ/r
-KicqCgAC/AzxUADSf1efh5/4f8r/P8SERUQBAKDEnyn/D9K/GEDvxP7IKf5oiwDArzcAAFZ2/u1gS0xR
-WRAAAJ/98Wj9EJpIlq0gp0jPBABA0PzEyj/o5S3UFRha69v9aIE/+UUAAAj7fgChhqGLEwEAAPWfT/A/
-QGVJVk3sTK1/tB8vEO8PnYrXqhWm+o4/MXTtjwfmf0AAkE2t3E1+tE4AgO1nPf3AI71MGTMja7efZYx+
-PP9xAgMOuDAz1v+ZC47+xGz/8dJQ/m72IwIAQv/dJv4PJNBkYGVm1k4/uUI/9QCL/dTnkjAhAGpla/jj
-EzH7qaPrh275XvY5a323n1rEKH7yCP4DBA742Ebf2vhHE/+Js398WEh4NJN2to5OP9rJj4b8n+/bGmBk
-52D8X+7HTxz+Q/4Wmw+WGxBsXx1Q3x8MsN73bKjl2uBprKdZIP56oXnjsov4b9laEBZsT3B7J6Tjdocq
-+5rb8tuq25WIvtK0yXoAPBAUsRtQYjxIbIE2WvAOfcIpbrNqz6Wv1Uaz9f87eJQ1x9dKFMqqGzt2NGeG
-+lxtrsJwJCiVJLIO6ZQKlUi952o9Q1TeisxDhmmT8pQ/Za0TxWwImQ2OWlw/nuh6vJHaNNmuZkV1hXNI
-P/gbpQzgnIok5id5gCYIZBVsTNEGjmjsljlGMnlkmchnIU2fg+4TFAfL/mXaa7TGc1xm8lbzi7Yyj6bt
-v/U/Bv2OEDgE7qmbXjvAHiEaxlRl2iHgM7iB5RivAhQppfos17zJ9JzDFylzGiuRGSvCHQ3miA0pCxYp
-6aAqySsrybAqaSKzSiiyKE80alE3KlQ0alg2qpw36mA3ym80CXE1SXU485M7KZQ4aaA7yac4qas6Kbc4
-aUb302A6onr2ay08eR4rOsgT//nkUU2StuEK9KV/urewrlqcWC/tQV1E0CzaY9O1KVJpvaMOLAUvr2Go
-IYWQoqKhrwP16HlTk/CKyyOC2iDJELWCtFpKFGB97Sf5Bd3xRcyUGB+Jb11VvqZ5dx3udZW2w1vGi5Yi
-uYp9QR96GfP7D2Wp3K9f6+q2SUgv5ausyoZFRC26A5dyyDwxG9fHT+8sW3Th4Rep1qmueCNagmVp36Qt
-hYDP/J6T/u8XXa8QVoAl1VuiunpiiiMbVyApeJIjUlpGJUisaF/2b6igHNqceTVOY+L82i15ec8ygz84
-koIipTBLbaawnsXrDOn3Rg4lfQ53qr2Sar8q9GFxDjVDdhlw/AvsYKITPIFl+ynZYdH3kLSCqe1Qq+Wv
-OTf9Muwi2lsIKXtOqh1WiY8aVcetW5wBm2ozet5RR5lw/H7zs/4XteBP/byr8F/0K5LMjRJiTnJwx0hU
-FRYPzdVklIGOtQqC8uVoAft/kKgGzFJDbZGk/cvscDYg2SFLdlkKSd6TwgXsKSCT9FlwQpDtkGT7zCH+
-iHjmRthB0g2gtkLZ/NogCOo3SgX74GWqH8BbhX7X4wtUcEz/XxJqVRZVg0rTtxnLuT07EPkeSmZwxKDW
-3wm7X9V/qc05qKhP43xJFlTfYgqVnsamV6U2zGwvM5tq9SNnXLRvR7Pdjw9u1CCTef6GCEIpi2fG0Xfe
-G6tRDsLqrjpi57sZwx/c9jST36m2dj0+i4bxGp1awT7DJVtRNqBYeaIaDQRy42mOT1jfdTa4qkz5INeP
-uExdTcg9bdpeV1k3Eb2Cb/BO9EaM3286zjCtg2uWMgwRvZfmYlQaXzRu3TX2nyNr06DNvA9ftPH/GzHT
-3qAx6tkgJbSk2CfRNb3aUPz1NtQT+eSJLZKJj/Ft5YFicX+RLkgatOpslrcY/4l+7chlMOU288kmF9oS
-WcnvQdJBY3TZpdMO6kp8dc/uEBKWoiDWoxn43bXzVYVE+o4T8+19iOGJwUPkEfL5hJ9Isdbu9nFNpNpo
-PEekM+zekyvefbbJKpm5pVCqK25X+BL+xGnw3duio4hTr8r4Acw3dN009LVTn9H9ybDjgYEGuYY5RDAj
-NESa0v/wTXYOdFwNCM+/aXcbHmK23PCRt+7Iki5YxxD4+dRwpF1lnsz89ndUaUwA5rCHGCJFiyGM7DVl
-SRhjyMJjvFfjDxCZNylzUhvSaK9M8bdKFrAy5gpYIx1gn4QMdkyHjzyi7lGzpLZgplMUl0zIZIEsEqGh
-DQ4fYLjFpMMjJukzG95lUqmPTEA5IJ0CJdtnkuAmM2yRjMeMNy4+TCUVMZHK5lDIJQe6JzHqHqSJHfWh
-XrM13ogxXhYPakLayGFlk0qqUjDNUFqjg33naM5aOyxttLJl4yCklY0YIb1tm2RF0jpmo3N18xsRyo49
-KkNxG7YU3BYf1GyivlUe91LsC0et3GdKKzQalQieFVYgS44lVOjlZJL0slRtUOBVHDRNV6pUXfYgrVLt
-9ziqUp3zKHp/KK/njyJKFjeKUlgebRKlXJYYBKOsk3BYnmjQqXu8q0wZgLtdI+b1JN0nCKHQ7ItEhanS
-G4YKzoxnMm0VBzo3u/SyTGl0MbdUPYoqF1GhNV7S8rmPyWy6/wJhbeXgBgDcgGTChoAEBRCEdMFcjzt/
-WeG3CgF59u0JC8xS2PiyjAnxo8lhXw6ciqfTpNMXR1Nt2kDw6q76NECh6LZAYnS+RMQNIoILY+3x8U1j
-GiIQLuG36UUi5nuCp8rD5roBGeqZqA82hvgn+fs7o1UwgoAUcAeadI/GDg/29gIG/9z4+Z/7AgIMeGLL
-IPY73t87mCkYGD6+J4ZvYvwJo7zRkGMn2PFJRgyG2v0FgCDSALU1cD8/0Z+avSdAIm1UAUFzjwXNW9nt
-sJZDY4OVlxhNP28QV/OUnpUnWXnVRkYmkReX+tpciNYhPwfmbQu5Y5BXMaAE4u5pWPbqNltQRMMJu/bK
-UuLfy+Ta2tWFkwvbBeYTPHjIQD9mHsEmChT8Bo9Er+zf584a8ToVHjDrF7LL6XDtVeILbDjpjoXva6P4
-nHhlTfLLMVsJ7DETCw5neYfhnC5flU/1NreZ/awIV5rtdrej/V8RI3Rev78bYQC7GJl+UlbuOe/9KVCo
-lC49M4vlijUCBWTwbn8a0KrWGz1HBzidzuarxGrEuC1WQ+UNh2XcJg/IZNLYDJeR/11ApEn0ygNG9UrE
-4YCeCWKeiXJ2vVH2LVBEeEUYNexoNeaCZuZw2/B5MPghwYhz9CEOSodAyW4BxQwhJpOGQPD6iJYnvR6H
-iFa7lg972HMFL2h8+hh8B+jcwbq35GS3KSeQJPkcTCNi2SSOKmdW3CLNXHcwa6ejDUamxDOIT+KXYceQ
-78VPIxGBIIrgB6j9+nErkCUl073YgX9HNQMziGyRoYiv7Vav4ly/wVbsyoX/64yoAKeAQsAXQBagB8QI
-qAQMARYBXj86ypceAAU446fzIQRAg3ICUECjAAi/agEoYBgAVKBiACJYLwDu//aQbkDaf1jTsRlRkiet
-XIEWFtBlC2e8qQYiB8LWFNwwy3l6mWpOaWzb7Y8PXhCkQCb+jrwQ7+kyaCWAFREbz0eAbM5g6V/39ABT
-gs+qshR+//Jgn2+Rzk0RQu2iL4d9lRaA98j55/kn6CjN/aHLrvVI7aQMmMB8HDWimWQgX3InFFqyC/2k
-YeIlk4nHGFXNGYp9kxF1GvTtFZ3/eiK9UmpzeV5tUSmdcdZeIYF9bl6a0t8/A5O2u+5uNYttlPFGRW+0
-ajZRTZpYviu7gsewdeu8yHNmMYq3rSYjkZsF5RqLBtQpwWYFZ+aJObkZdmaV2USmlMVf/6uI0tlS2CQK
-Yy915Zcox98hg003kZi8lcQDots4DmbhaE0H6bvt03TJA+YKdpwMSgfDhpZIRxq8DH4fSZDQZDzkG1lW
-Ujqg9qDQg58HCAQA/ul1gb+/AfBuQJWd4F7IYsgUvA2u5G2a9VZjelqHkgx6vbAZ29Ye4IbSNcVYMMUJ
-s5B5v5AsSsmciOhAkZCASZwE9xGQFcr8ETgLA5q+rquNCpm9vHa+u54bnEuo27PSsngbwKFgALFU1Gyx
-/ODZuyBfs3hWs5UdUSSBF6TJb+r7gFUgt5UZBWPgYT8WZfuLh+iskNW1Th8FbxxyL1zy0L5/z4pR6SoV
-c1SYiFEU6oyYVQrGntoONEHbJeBzx+hE8uGDNqpWclCoXd5UlG4WJMgXXeI17oXoAH/KIvjbWr9Z+LPS
-+h5/Pg221dpRoNJBvYRxwKMBuA7wnyxVL9dzV0Lf2nsRmC8sVkbJesmmSQH/9tLR1UIUr3N9ErKq6cnx
-8cnR8f6B+YISd+0Mlqt1Z5XfvBZCp2LzsuAEzOWD5fUpLY5KMUR6xOWDUkgYZKsPImxhOSYg4vLDwkG2
-sxV3xcyrNDYL5sbcuOIGOsfYt0oAd9oBUtB+/SosPP0jeEhTI4f9/YHeZ8qBoV44mIteVtzt6YDwi1yi
-EabFkFpy6bogMtCKXN/gOi0jaNZVJ4NzbaN72T88Wuhdr3Ze2H+M7hlWgYe0TUSJA2jVMiGpkQbDmURI
-NYfI5SUPc3IpbvU1FVHUHVkO+IedBzVa/uS08d03TIKk8fGWOQeZ4Izyapwza8mA751pq5nD2+R6v5jC
-fxqj4wqnG5ICArOLakbL2lH/wIqW3S4hY8DtpnczJVZd9I7XkajQ9+mCZdjGWTzUc8It8nsFIzOWomqL
-qQTQtotX0fgMwS2+mKZgGEgsHR0WJi4eDhI0cIymo2CjlkFDwSRgap4E5McbGiZU+f8b1KCM53gPcXaH
-7fb0HdhWfHD4lsX1dPKt5Wxxue17PK7OZ6VveSfI3K6zHYETQn/c+NLubdlRfbm/h/zFgav5fLFgvPpx
-gTtUnEA+6ZtDnZoNAvVA11VBIx8dQK8UOB4L2qRfnJ6ePJ0nhbqAojbYoxZhBRkJH49sVQY54+gCwxWq
-v7i7+U95kKIIKwyZ1ke2qTXa4AVnvR9pFb2p/NwpVaBKRCuRgbSIhMneblSonnRWi5qUY00AflFnH3Qv
-ZRWVFI6265lGq9N0pl/kGtecrd3+4GGx+CXmMkrk+lKj02gmLjJRQkbsd9NC0OGhoPtRZpUmmskk7eZd
-cLWNycQb7XnzpJXS3eMmbQa66eTa3ZUXR9Pl5rIzMzP2x5oG3gIWiVnfiZI6B1gSAZhFL8ZSjwmtLLFv
-NNyOir1kGDlxkCmm9uRF70emHgb3lBbiL6WHw45ZU5hcUXY6JTSujBKBqmis+EImODs9/NKVDIV4ZFiP
-CNboOULOYhWqM8ya1CJ+KQvJ8FyliE7UKA2dzZ5Xy42TEuev9FeQz9ql4ZqeAlI3T3G4LqxE1vHVLpks
-C9qF85Z+dxBX2Mvm8oVo6BzaLeYOkE/Kb9i3iKlt1uf2+OiqT034KzZBrBULaQOrYb/kS6A8/wee9MCv
-utLSE4xauK4C9sdoxa1cygf+Oqa/cOT2WtXFsyz1yit4kXp/HoHIbql3QDMye+2hIcSbTkIZRxH05kaQ
-kH4bz3/+atqh2WzsfOrkUjSa/wKrHgB7XIErcT59tXtjlcHvKscjO7jLeUmgbiB/duZdE3o3giERxk9B
-0pNki21VcuT3C3rIuh35ux4BVTRlu5z9bNYsPF89p/MgvqU8cKMYKT3sxs1qoozrEQz3ksbHFbiYlMxY
-dQKOGA2ZvMYybvA3gophC9fq3shzJlCPTUW6nIhUkhFtqDClkP1CUG6xb7HX0D/1TwgeR4/Pd/fjvKk6
-vvQQTrvLnT/Nmyqe6ELGwCuF+U0xDt01uC1Z0IX+xZrXm/MJElH1c+Wwqa8RH1wR9QnPG9u/fAnJXLlT
-BK0wqOlfgRTtMLpBPb3I/Upy+rh+E4V3mWuMQ0UO1gUrp3DVoTaCX/w4EilNlizm+0mKBdX2raL7ivVx
-H8BIGP4yleFX6+PpVqTYl8fiRLyfsAjFSV9hI7eowUKFVK8vd/LaSH2vOvNtPplnh4Z0gwsO6S2jp2lz
-+cf5JtodbJbrRdCA5C8dzgQt5OQlUBgV14YZH5uw/kFmdImbobJ2C0CgJSNHkkQJfIGuozJU2aDo8fjo
-H1kq7fIIoEEJK/6obAexGf58ojAjFlBh0FSDQOHTKMHP4vEyObwt+riLF8WwwYfrWQHvou4BB0/E+YDs
-EjEGXMNZcEsDbY/4L7XbANprq6Lzxa3rK9DAKkC8srwAuOw+BQzakbscGIr+PLLSWBFu6KAcVIqIRLRE
-YuUD05Zk/cAR3sCVFzjEFNChtGgAVGwRFnUwd0FPHM/SyyZCnw18sF61CwnxjUAU2NKC8xR45Ef6GsR0
-XQIj6VBZWJUE5bTEYoHyNE1ZKPr7v4eJqxQxepmepouznoLjOfjRVOQX1at2NG+xKVyO95KyHVx8nyPa
-dkwErHpMKaNZleC28q8kqpncR1PAW4oAdj+cIHvjycxtnX5/98QLQGK6+7QVQ2K7W549C7esKNRdGrLC
-A31XZ1oXY5F9ZSyX/XA1MOTl3pIQjHXeux/Z0pwR2WVl8iYhLRi1OGUHATXoXjVmEne9YcHMw/1upsFX
-ZKvwK4F8Hrvnk/8619khpu8Arzq+PWbyapuey3ESPMDny6q32ULZGpm3w/v+zwghscdH7ysrKv/VVOsG
-9AeB4kQ+HSkxwnMibmjF4FDZJBWxa7YSfFaLnfmQDE5ALkU1zv7suFOQDmerMXSX1c6plIdh4yeulRXR
-XM/cgZppj1MrSQ4CdcpNnhUeFrN4P6Eqq5hazhQIE0QtDA6fy+4IKRjsFU6ZGB9cJq9A1SFn2UnQzSum
-UsN54ZiWBEJ3vDrAgkk9oBKJ2B5AzKgKVGev0tAW+eXxmT+tAPued9L3KuIKTVeV6mxdHKmfTdz+PJHF
-4mE3IHkIfTdB7MGUNK8JTS+H97SWeOEPrZvBRF1EVHIBCJbjOmJIJYSqV5ZyggEf3r8zCAJwh6BjKDkN
-QGe6tVGCRv9J5WoBFTl18kCH0pOWhQqVTUpNhoByyieKqnK6ELD72parke5uPyHruuVtfn91uWckNAQ7
-jpxShdtJkV0dXghJQdvyQsXOf471ZL8gWT9gOnfRbXdfa/37GygPz28zdCoazsL8Mcgymv0l3ESjt4wC
-oUpLbCLq4vhElWRMRzkZtYc85gi5RcCjmHEKAXJJrxyLgltbM7dPpS7U1jDrAcrIELQeqKmce5s5dgc3
-p9ZW6ePBjSwm5QC/NiujoEIS9eA18X1I1YmTGZFOAwxrUc1VI3lMXdipPiMlQBZcrbXTeMeyaLSvjaNN
-8YSPJeuL1dhsetaXDuVb6pLY0SMaZ7tkpbYWD5fr4UQ+lVR1grYy7eVrcKZah4vTXrcqPagN3Per7vBg
-2/Du7TASU8LnjMmN/9mmrY0/1/LUsI2qQBihQDjaIjr83006LNsM+lo0wAWhl1LvCQZhLoqDcFWbBQSm
-fMx5sBbOeSThFlLCxWwKIBULhOLtYNE4SCPpgOkRz2s7NKepWNNZdziOUmi74E2LtT8oMwWugrNVsXGC
-WPhK8dDZ/tJ3Qfpwm63vxHF5EBIzGxrCjASdbK3Bl6enbE4pbezykrqJbECYi/JnprlIgeik998MDW9H
-tmjK3vuFB06Hg66kMC7GZG+N1AUnQWGvl3naZnQWY8dtZhiiHwpHJtdQ6JjVIk28Tr36OGFbySktjj26
-2KymFvCxfnplQvcvyAcEEOnw1Pv+LSG7ORHbP0cO2YFxjuXVRsEsUb6jlx1PX65ACGS93Kf1myr8Bw7A
-7CySiAGRntqiMILEHEbfalha9eGR7BEm7Rdw3si0OOkWRb7iVBlbemwdXUpdqdps3VUKiK1KvY7y2uIc
-qhJJOFR9cpaGH8KihOJaB3ua3mwdm3K1vMyRHk8zRGj2CJgRazJ8pR3Nxmh5KaPXxxG2LVdbeU0t0jWm
-9OPTHglDr7adHfZ6l5drl/C+rlcHdNQezWMXqjHZlvNV2PCf/vzUGmKuImngY7MQZGWnURl2sfOwzRTk
-dHgwXvCIwbdq6jILfAFKx3HDV0dAjb6IByCaWDHl7qsKFaMqEWW2qqBXfUKYmkmgrOPO0erLYB4tp0SB
-ZRfs1S2KisAYxWgRhcjR8gA1YuN8cj1jcLRiNOL8MEoCgvS1eYDTpsF+ZHXH7uf5sXaJKMzqy7/gXr+0
-kjt0eKvYkySNlmOUrDi8EtOY+XvPTlg19HOn9R325m1VobTGHvvekWP0zO9nxckt1f29rUbnqas85X0d
-TK4s0BTgz2Ljht9fnA2/bh+Hb5/kNpy7W8Eda3cxtG+xtcVKhgNrqrXugatHIGAGP+Hp5uec9ipftbl2
-n7tH/Pzw+E/LVle3odMtuz6O77E5XXIPuPcpkfPWNjk0H5HIOaSkVFB84x/yPm8BHomBeWlqp/LvpDg+
-gIp5g2QlC7n+NtRkPTC/j7BQpkkEsInF3RuIrws9kBSOZlY086r65ZQGABT1SCfxBAj0czRNBC2JHGzL
-LkbT4l/39w/aPbQrOvXihokzA5/tnV+NIT1B33gsVwwWzqr5c1h7DqEPZo0OyOjoCRRWLoswhSdhQMTv
-UT5RX7fwn9CMLbvUHtfzj3WK09TNDSPB1tFVjYZ3r9cQacLQYCZZ8eFHXZ8dQIKqJiHZ4a3taSAitsFJ
-z47V7b+PtZqVQVjgPH89E5MtaI38Zf2LbTjtrFc3DDXCM3ra+m1ZSI7ZspkfklJg7gtJ9mvB4LqtpucT
-Tb3To8+LvtM+hHc4LDXNGQ/7qjDmW439c78pztU3lRc7NLUAfw3HXQihsMuIk/3IS8uOsfzN4nQ00L+I
-PrQyJTJMdosPJAanLxBX03xuD1XKGnwsxBN1w8bjwSqh4WEVLxE+iWTro3mqCNsPODwaOLx5d3/k+EPU
-Vn2kZFGzwMU4YDjCqK9eFBK7SmA6xmnHJxAleTG226htOrjQT9ajcNWnRDPzoKT2lidvT4pE5JGkXpG9
-8eC8kbg9SiyiyPsJ0qpR9mnLq9YdANGlthzEcBXqR1gewxrPo5ARscgQZhHr9ZLLi4VN01ays2iHL1HI
-mlYayAdhWj/LhePIEq0dGd3xTiJr9yewci930cikCGXe36flGqGrO5hmIDeAQt8J4pPS7itQGdtupvkS
-8wGo7Fl/R1+Toav1G35V733HteTfTme244HL8EJOn4AAqeSknuUljEV9JFby/HRVCTq7udTmbnO7MXgi
-hYYVH39EHdWYUc7cE2n4VHV8s98NGgAP1d75RMrhNHpkT/4LYojMgabItch3TRWEOTiyCQOg4atRge3d
-tDDJY6QKITKtvzSmivYlSalyPddyTjCkYqBmR/g9PaQfMBxDUfAHBnsCXKNidyKqInlJa7iae6eNBSIE
-OJBJxdmYU52+NCFcRZjavC2Z+Df0iEH2JyVkoaX4MNt6yJz5C7jbGm/dYT4yEke2QD7/UYo9V+t9zK7Z
-plBxstNAeUzqLnt2QQbZg2dyslU65ax0w3B+R2o2fAhBBFSI4fGv6JOZBLsLaYwgsmZyHXa8+1vnNxkM
-2pd4WYgDsONTVI59CU8t4W3Ptzatle24aMoA2TAUpi0NkWz6eRLyxofluzCVr1BgYLoiGnU/GIRueSJM
-Pp+TwxG304X4YatpbEkxP7vPGiVTJBs/huS2OFwXuX9lvlWFluYDwtx/ClQFWfZA7cPsQBWbGSWuRPnL
-uuO32W+APo1pq/sQcKIJLc3k98ZRg9RaPYbISMDp5uWsPGvHXiMjVve7nk/Edvaj3l2rW1o/VrrOdxtB
-+KBfYQPZyl1j1Sk0kr9Xlr0aCdPPiPNmZbCCKOxljoC1YiuSGWqXMfR11bUiPc0Yy7RfrnHeiJsWfOdK
-9M4a5R/wBDzLDDJu7vttv7+8H2L1GyAQTqSlUPDGQAVJCorElBoXjqHpgWXmRCc0wAI0mULfo/Y0NM5o
-3zC2Jk7UnZdbR0JO0w4bU2YfoN9knko2vh8/6J28KYwGgb2VSVuBQ3T2os98Hce81vxrGABwKZO59mOd
-QV52ZIwdxMPMpLIpYAGVxmM5FHfTIKLXf0gwPtalK4X4im7Ybcdbw2LEYz+jDTrcv6oSnC86Pkli6kDA
-Y8ADTSZ91wbN8MA/l/zGazpbNVCG4fqFoVgmI6RCe1RTG2RRbvDvYiwSWxNWRAxYUPNXT1rVTCFOqRDT
-aOuZZYakhCMdC+2RT8oufE2+O0wu8R3NbxsZiclpdfzsMnQ8O6O2tcOXGAxWQTOXST31pjhWRnxZSK4n
-0kBEjxpt5kokM3RdcNrZXzeCYtohq+AJchCz7Z2rquZl/07nMt5PazVBnE7PDjemmoxmHArJZYyG+BzL
-TZmyUCM1F1ZT8ZlKNb6JUr9oDBjAGN2CDdbFsZOnRzogdvFfFk0m9Jbfk68bFxth8eS2zF1XOxUX9u2n
-nZBmgfHvXZOHVUpaoz7gzRx1sozLVewDSFVtflUZ0TYPNivpp+nwNPflOUSQX+I+MUHxu6qv1vKW91un
-usDQRn+v3TojbrG2l7O76BjXpgqn9rGRKeZ/3GAMLwg9fHR50mB6QxzE8BWqUbxzm9N7qytEpPn1GNHD
-5WDyA+UNcZoDeWZ7sqJJzMA9wKkZ6WvhckE6eR2XBTDdcb+TQ7tV+7mMPJ+aeER8WpvstBsmOyF816u2
-iBEVBWoRHXMogfL79Obe+aVrlGoZWuY6ABENH0IlwrIDITMEiM7XmJGYSCIaSUjgvwsZxLIBBMmw9bNq
-nWX6HmdT1JyUwfZHMUvDzwtL8o8DoCxzJu/RBdwCtTzaE1DWLMdl8Hnqx7p7rpFeodszlJarQHiEgQPW
-iumvEaU0ihJHu8AV3OeI1vGsx/d8Z7SX+fZn/OfT5jfrPSAk7FLQn4SIj4fZ50N8Sla/PoN3rqVcDwgZ
-bSJb8ZGtBnFBsC2y7JHRV4QNE+nXzd6HIpzPv257KqUO5colu1M4oc3DFsQjJqZRy0qPK0fB5XGmwFJD
-5d2MZmQi+WJufx/KsCRpNfYAQ6hhkdECASCp/IIXnoFB/nXE5mDQQBWUNBaSyGl4ySjlxR8yk6iP+BqC
-ZUM9i00ijlE8JenBlWBrSNzxeKCfj5oyBGkmFEuPnKhtU8Do+HOPiKOxxQ8VGuc4xLHE0WZGddHuRmaj
-eTbk0YA9m3H0bhBdhHXkHcaZJ4ZkMF+Jx9xYmRi0WYTNWUGG7+njyzmBp4818c/kbSz7ar+ZuWTIpmWd
-HH3sq5dc6aG+dwUPUMN9b8NrjhTFvN9EaeMbfKrwpK+cNxG+P/hg9bRn3TppRAXYYiumg/jLw6y8cQJ8
-IfyByssUKEJmBqlBO1NPHAqk6ZXpwmNkzVDrPHlDoeh0sSMWHHFnmHdF9xBOorgFJePOdTnNsc+kpGY5
-9e7DKh7uYvtqyEnp3QosEBjnkN7uN+s4a335or+kv+WhVA/n6Ax1bGEgotS9btEzaiRnJVlDgs3PBcii
-ZvJR5cYGcgKs754RHt2qko4Dm98QxTzwMF6z9rQQGtIKTd6BQD/tP9ooW07wT5zPL3ICeYlAOOFwuE8E
-nyfjwMEMcMaLydWOVoizQug2kJofZQhwZNdKQW4C9P4VqVEpOS2jHxLpFTrFrTwowOvEcYNWDV3xcQLl
-M0zPW24mOvgQMRJBsDLBYOnyddswiM6X+Ay44nyhVrWBHBcWT36lD67WnbkpvEJGZkUsfem0UcK7/gPy
-hwFzxiiwQQjsV1vCQbuatPdsNYWTOSrKyw9BCAiHfO+nJlMicT/bDx8l+heeWvLGbehivS4OC8Dfc7Y4
-FggLNPrHA2UCiVSEl42X6Fae2NQU2/18GmTeQ9ejZGF73GL3sqwTgaW9vuycytctAQZq8HCdxEZMpxdc
-4OR1VYG88oxDIzOKb3PKjBKrD3IYTwcBnPZchJ7dis2Lurm2dbjdqoSnKOvy4PTs772FSnotyoR6uymj
-5JBfKqP2YAX3969b1+VCFHbPV6HNb0pO8/uhiPnc/ex3OXCdW62lqlYj1hWhKTNVjnh5XlCDPWVWzmqm
-EtnGvn+KoFi7WvVqLgiTcH9ZMw8mOMTIw5vAcvRoj0tN7PvQKuWlYGpOjlcplzBjR4aLwt241RNJE007
-iyxdrhvXd8Cda7Z8SnY13ioGVGO8nX92Tvh5zh+76uanNbCUBIzfBlESAdnbOk4+RtcqzWEJ9/P3tSZh
-x/VVn7dG37gACCGAkS+uHxCTMn1JjKn4rNGNts2yYzQ3wtBcYYlHW0VW4iAE3gaUNbHgxKvhsx5+kI5N
-DjNPlI5ohdOFM+fqdvjF9NiZqkI5CC8Id1Gt6qvyw8mS6uMXmOkvQDg/SHQyMQT8CQZ/uWzJVB1Bjq8p
-py9I16DRjVaLCm1Hw0dXZnRfFKerzWKroylAoT2uuV/bSgufgRlLHI2RJz7USlvIfBIxR1fueZLQthvK
-SFS2xN7DWfqZ7L6ZvOCwnMbq4+FTRSZh30SjvWtLpvfOqe+dPOo3N/roL5s/hJHAsZ4nfgJLMFrEqnYr
-KsUp5MrcOBKoMegkyjc5hF5oFoYIJgthrVlXQs4QWzUB8VuYq5HLDTPH3kSAVgHwI6Y/qDqZ/kgoy/7I
-DlssZBJ5f3LHBQPMSUePpszJFgoFet31DMDJro/bYR7yM6tDs2RqJELAHXuG+0V0pX0x0dO1NkMXuEfw
-3tsvU22tOk6KIi8wm2J1bNJYOZJ0khfI6TbrjzZTntiEL9eMw7uA1zYq7gk6SEoioLhTUIJTEDnLeIHI
-aHWO5OdedulAKHPBu3YZni8akE0ahmQs7PJNv447mhHpWBPO7E+K6Xgc17cjL87XzEO14xayVKohA8bQ
-qfiRTX+HTVFUZkaYjyuqsFrgaFbhHkzDq9H498bfCy6xe9Jiyb1aP8ZgGBE8sFVJ+gpZft3kHYzAT0yR
-22NL6ZT4blq90n+CVRDdqnW5H2yjXtvsfcFdEq8Yzr4yn4iWk4E7kQFlSalbwNSK9r0lw5+hwItxD7l8
-L91DHmbDm7pLccyIVbt+mu3xD3/x6gi15LQL08Njs9Azum+rDj4E+jAHIx2FbJonNu2cqqDA5U0FA5Uw
-DQyM3twYusEo0gLDX0m59UyytcbeeU9XzL5CcL9c9XiiloXBwFap5ll6fQ5m95FJ0sOuOj2QaYg95GSl
-M1n2iiOphieZHvOEiXjiqXjXmyjS4eE1jhEmHGJPk3O7Ho/gMtrVBYKtHj90tXgyCj4+uDzRJJa5EFoc
-VnAuQSFjykThbamslBINeUbWryQt6HpOoCNYMMfccmNzgdUvxa6yV13+TkqxRton5PaMQSjx3L+uf5Ze
-AUct29tHuoYKv1s00trPD5pylWpJpZ1eblo1EtuBejUz48162j1J8WUuO4tf433vSP59cuq+I90L4Phd
-oYgeKVeNvrl3TSrGp6nYI3QwiOW8L8Tp2/TBv3P5ue5kBYDQKXIdEyyiBBUUQ938wMgrkT6qJDZ1pnFA
-KXbHIO9guHWuOb1hKfevoCV+VhwkAa+LrxzswiDO/jopOIuyGHQ6FYhwOl+mlDnVHXrSPSM79a0MDSsU
-YfSApJ5+8ONbgAA7UTIZYp08+StlybKZRavP4jUGfeEOQ54nvmP3dWB/vTMAd8nBP9vuoU4lOh9CbJrJ
-KRm9kWGNL3yygMxd/is2e/yUfnrTTG3yQeufqP1Ecm7SaEkdp7bo9byxGu57/HVOtt6MSCloLo7xjk5q
-C+XMnIaOr8T28EZci/1FZ6d2rLCEQiKxraI5osbJ1sjxNE++61z9zeAKe86Ft8dfqxbXhIXLy7BRql9A
-o5tWZg1sZM+VUadH6tj0YsvD50Hq2GAIMyS3tLfbMWMP0FbCMzg8gnINnxzShL6GyO/e766raVdESRIA
-AvHGpMi/QumlFz8FSCEPmjvVQn15OcGK2UE89PfU1Y7SJvkTZvWjrJO1Xh+BRZW7XOiFFTYwoqrGRckN
-zIfL8BlUPdC04ZVISwFOocFC2GoM33xjV15TMaSK87LAxD9936dE2Ta3x0YqBFTjMds9EGCUlRNCgf0p
-DbBrol/tLJhYlIcWlgAlJzgk0Sb+ZkpMFSRkt3CQ2cIrDSYuDUGNhdRLPHfd3k2aLaTXPTWVudTg+zcJ
-O17O9S8Fuki/VTQYXj+aLrJXImzJb+dcF0ZW7/lcRu5YkJ6MKJ9G2uVet+Jglr3AKn6Vc96f9bYmHwkp
-FRCn6Ev/AdGqpQISBaoIWilWNExIcM/trUf7N0iAdo9A6lDktevXWf/0/v5nu8BjFpZc4QpN93z7c6XG
-V2pD7fwtBF3O5/Hx2UnRl390CPi1VQn8rxvvDL0/uALQlUzVejDW8R0QX3U0JprgAFQUNvlDjR3W4QF4
-SlPml839Q2QEfufW09KUzFljPBVOZ1KzD7+mw43GFmtbVZuxdxLdsKEDdmwvuEU5J+EIjZR1QI4bkzRx
-sw0c70r56EY+Mia2Q016xwoXnjrm+crpq6jcP5WEU1Iw0mKJlWpHNSE4ggf6x+SAJLVahy5czCFR+/P0
-hVDSFGHvOOzvW1LieLMm5nBrhp1ywqMEdM6Refj+AdORfoSWcJbTUUUMMAenqbKYiBl8zqZXYkH8eCBc
-MNj+PpqguoeM2Y2eIP1B1nt4DEz7I4uToilt8J6mvnbrMj9c/uwaL7i92BIVp/MPFFqbZId/GrCwCIjq
-pWTeZadcRid1Xn9hB+vx8OscRmrEWcFDrZQA50uzbkX+HnT6lZ4k0eoF7FHeTWoB4vHVvOovD8fZmOPF
-S289PVQ+0jUu57OtGeRkCcHQZKa8Hii19pdb56Y2ujzzRWI9vCvXwP5wRb8Tye6IIfzdxBUlliymjXUQ
-RdZHEwyR9yR1a+9UjeGrZMIXRhib5SSjpFB1xMtFZlS11B4wKNxiPavzIySq274m15xraDO59AbjgyKW
-GQ66jebu9D/RaKjS2D9uSuNCQuC/nRZP5ELgM3itl+NfLWN86+zX4osMR2h12w0+0wos0elIE0ZycH9f
-Xbz8XXh9zadDhCA4IzLBgK5+JE5XyC/qnAHnNZGXR+LaXjemMtAZ4PVVbA07tANjriNKqEiSIm/vfvCq
-eGuT9UTluDumeZ/8Fr4U/chLiuwjmCy8t10oWPWrjwZYDDxdEC+4ryqciXusKW5jd4Rsi95n113HMcOd
-ekY2BLrMCzGsyKXPI9LrDjITGgbc2OsYy1Hp54woiI/BnqK8RvDlkVdR1yhHC7CKB2BaqHngitFOJqsM
-YkNps7J+tZJ0URSTtnVcUjGSciR15GiSzg4++mnwSINKLsFaoK40QS1VcKvaKhJXDLI3uuTqLK6o+VpI
-q18g4U6oB3q7uWZMsCZO/cPoblETflXR6TCn63pOTZ6vMPN2fjv5WDmpj6n/PTUABf8lCmJ8OUFMHtnP
-mPNcr2q7ZD5eFL12cHv2VSkAvsP1eqk+ebZVLlAQDSLWndVBb3ojxjWjy7VlrbZRnEb7HqXF2iz5bVJF
-9VtitGynmglhNK4nqT7R4AZJu3KST/6Th0EsiSQl37j/dJI8kbYG6Y11OGl61Fyxxud8+fG8cUB3Y0ni
-etZE0rJIStKkgpBWs5CsZVJawmIJikS+pqcYB7liSEG/DEuHa6pNdrYh1DYhs05KmY1HeaKVNQyXBJKe
-u6w8VnI4lyQzFmepojyVvCbQe9f4xoDcq6tVedvSDg0kFXDdxN5TgRpWGqZiTElXwzT40jniYe4RRpw6
-5qCHeqRTKhstQTQnH8fECForeuCHYcq4sUZDcNwpFoPD2Wlzf9XE0/GMExrgYfLY67pT/Py7pgR+uVqA
-mE14YZwcnndY5YWq+e1F0WP3Rt5jF3ne/agY+v3uSWv1TiVH1vGeLCZjI2/nwpXRyZSwS9B1jlrTVW9w
-mXn14FDi7SqN/iQZuaQW/Pjm9dB7LGWOEdRAL/CMfB/d5lUw6wHagAR6/4J2BIxj5a/fZE9ZUmjExJFk
-f8A+OjuitUDkjnZKlE70feXzepbgjAVyGE3aKVONwDB/RAFDniGMtIfH6YY0Jp/jxpXAaxSTa89v26v1
-cCeho2nCtqqO+ALCGqdMbqfDwcHTMNaP4M5mIzae5ZaByFSo+1qbqatej8iNXiXzgEY0A/1HZQBP5bAP
-gPs54KCoQAGG4o+80kBg39JTEeRzELpfdHb9THL6rlui69+PyOuPXKTFv5y1fzQD54vd3HSDUjyti/cb
-iFml3XakvUsR40fvhRm2Ixh+TlIwyFvkYZAWpedfq7PCJXdlKo2/uyNpiWEscH+npzdOKkUvRMIQ1pnN
-WJmEE1JQJZfw7uNYkNCVW7onK0QerU3jNQBTV64mqNYUb1tG2R6wnf9rutgiMiV87cYxzKIdcVt74Ck7
-+DAvOA+JobKyYBS2Mdsykh0o/OccOzPkMCSFVXaBatkxCIycfzmaLLpuOAYfvqJCwCCzzq/CfOYpQEBi
-RqnbHKrasLvwciFeYIXi71BIN8ttvTCKeL4Sg6ETGnxoMuXAzGS8kSqDbdt9H20acVi4XBViZIc5Bpx4
-RQnlFsDHsltEY41xYe42x8mRUSN6TO0jO5KRwdqnNiwcjbv2F/NBiGsr4lJtrNAoYFFs/T6UbmQvpnYj
-IZ7LL1ZyORY+MaYPuFKIrfVvM1GReeDd8TnQZuE8DFHrHbipyPiAc1Ref82vtwBkqrpsSXF9pfmGYnvq
-195unAHLlQvtiukUrmO6nVRGL44nMksdscq1tfk2H8Gv9hWo9GNXbGoixPrUPneEfP0wLDTkiT2nubC+
-03JvB/a5feplWgLNlj+BqQ2+IOs3EJLu79gGnhcD3aVSq6ferBgHcYyZqZzoD9ndJ2pKTb/LpcnBhQnF
-KEjG98V1yvSFGwrDG9qhOfTFgp4knNbn2J/eo3+Zs1YscrR4/5vPctzhrfWaq6bPIFuSyV5UxNviKZd0
-O8kd+zUAZdFV73bysGZIZEkm9ijMjzFq0Q8igubOm4j6N6vr7atXBn8PxcbsMVmw0K0selFyPDlYIqHo
-1zyWeclDnZPKm3w7MU8SDMf3AI/7D4YvVgYoKn2cD9O4uLPMfrV+xi/r46cIgEtlXcXc1Az0EkfWR8qd
-u0eEtamwuXdOtZG4OftlMBFZJFfnk28V68b5HWf1ezDjm8Vq79PGv3mHpwvIMg3lczBH4PxmvwhgUSxn
-9pX3LwumMnhWNKN3CYMbsNIMDqajl8R1bH0TvqJ0ftdB3ebu6FB4z2ff72GT4PTEcQaQg1Z4zIlQkInW
-LJQCl5C5FRznZ+pMMdePiVlNHZ9ru5G78WlA+wLxvNRTKmW2CWeFlhI7Wdk4B3e9MRXfz0FDCt3Yg57D
-VYcfTKKlhKtv2+K7qfK1S1TYeXuMZhDrbq3nSbbWYyD8i3cNFXCHd5r9I9IV0jwjTz23LpJa+EEkbVPw
-YIVIxuhlDzGSsepYoKv+O+688lY4aT+LWh65Cbe53FR8LRwIxN6Xx0/kpytnqdjQrggYxh9OJM7pbLha
-eHZYb5kaznSxdjzsxub9vtiKfMzB36h8wObotH3vJhaDMXhqgZaOe5K0JEBbptj8qu4T6b284wcYF9aD
-7OspnpLWx1+uGLC75eI1x/IA9GaRhCvRz2BycVStPnRekLkHbWcQt9Xr1ZlCS56AZ18p8CNKcBFdreI0
-QPBbEMoTWPOhOLOMTdcFGdTyGs4IiYwzQgxRo3uEqkIaGAfcuXUkjlWc9JX/FIsHTKCUZWCEJljo1fYs
-CD0n/AVGxkVsuLFRWON8WTSV51bX9vXWODljWwOtOrx9hXZVfaxCFWNYx1yeydRu5S4wXnL1cGJ3uL0g
-P1c9UjpPCWoa3/fq/+LPGTPbhQf6s5sM7O1e0Cx/rCZfod1ZJgKS6f2WTgqCBY2WS21ZkrSK32JIditQ
-UThDk3Ylhm1b1FEEOMV/h/P9iADDEr9awErFirQ+eQ+/pPM+psONURdeTMfq41g2rsPozGtWq78+Jebg
-mJ9I8FU0Hku4npVyUaFOIlKm9wzLcNObwUBhRJ37WypmFRj2sAlX6c/qOuuY2hrgIxJhh1Osv87mgaXY
-MpfwcMgmnWfamj1NuVVKQv3cB4x4A+U031xoAak6RzCfIG9gGsG0Khjulol3RiQv8oUFe8U+zLvygl0S
-IxmFCs0vHCdka/JIBh3Ozy8RLiQ2Y8F0DUSXuXaUMDSiPnD29YzW+OE2/U66Zbm/N6lfaZkkHVmEOeWo
-YuXSDPJb9+3FESd+GFuVS7bN5+9VdQN1zIf69bSz8dAfTztgSz1SBmx+n5DbUHpQgd9Lemhgc72qN2Yd
-2E2szKC2x8C6AS/DFPs6IKKuKghlHwz9Kzs3V8qHE2KpwwOutjYeN1Rd9zeM9N06eL8N38yGRL8H5Y//
-Zb4K5amu+cNb83ytgvY7E0yc5Myrr8I4qQZUDFo6670SVRarlHtQd7KfJo/ID8iEF0ioRi1UC9tpeCJC
-3HdVrPn1TQQutM2it8xNfGtxfZzXemypbnau6msfJfogbzjbLN9exqnLrFA7ZORc5WxyeMllpLpYH9Ps
-eHITvYgqmAScbWHJuXXMgF+mu7YDCG4li2zyNrP3EkCd9zyTFiuuJ9+VqPc31kXW/9GrKpd6jelmXBMR
-9g++hOLabuhcDHD1sXTRkeFHEIQeLLOMVJlfLLP84w8qIS2M425yxaoPUku3SaNw+oWOQAdxkh2++2v0
-7OqVUtMB8To3bCHJljJCYp8m20DfMhZXhpWo6CJ8b3IU22p16q6oB52F+/fjbsv3R3eVUEZ0F2YOhcjO
-oRyX8scqb69nXysbc0fTWKfanfzUR2v/dgGneDEesZe9Rkuv8dR6OfbOWpB5VUezOFOXs9mhmeB316Ps
-V2GoRYjFb5pqNGcIrpWKrUCUaFz5shWCbf/p1ooAOjwqAeOTf1orM560BuFubytLywp5XqRcq7nqllXm
-BnMp5/5wDHXsE/LeyEGTatk1Bx9DTM7xlmN29Yt5KKY7us2H/yx9vTls3absQzaqJ3WzvXW31kxUF4zd
-DckfsLklyvhzZiFpXefeqYt1s56pPVp00n6bS+9EtlKzmkRhGMacPixbFqD71sKRmAEW1EU+PGOFU7Rd
-lXUpH9TxIXfSNzskItMlQWb0Xzi1U5jwg5n7dRTXxqCi6ad7obe2o/Sd3ul+u2sQTTxlr2HSOng0Wi11
-WcmdbQ6GFmdxsVrOlzVsXXzsx0YxwvwjRhj9R4wFVlYfCmfFpHsw1RK3btHtItNpESfSTKN1YbVHJERr
-uW1stDemzKsedyT1KTuEDSHrHCkLq1Xs577kq5fyDxs8J7/F9vkqts7+RtXM+9fRycumFzeOIpR+Ukg0
-Oi4gL6LHPQLPiAeAmoLeE3bKvB7P30NLPeLU+L3XFKf5j/uf/u9Z/5NfGH+q0XuFFt5f5uEA+GNz7y89
-rgDunXeK33q4ABC3gXE/GfLqGzA/dUpKRxxYgLvSvG3td6aYb+73mSoKXH5UMDDBpH5GlYNHmw7GQoSn
-frPD47QNGLC35PUZFzP9ArA8DXhIdAsOQY7qZTnmSmS301H0lFzFCr90G6UA7CI7qQmtPAsWtZIhAOdc
-JBIO+8NKPa+/P6n+ufSEK6Wz23kfz8M1qRE4wCqhRfL1abvQ/5Ni7YOlZSV2bmuHbrDKf2LWk2t5I2q4
-BWTtboDLSaG0xmnlumDrofISeXovK/WpZ6DRl2Lhm1U/rrwi6aTyQRj11pqVNsSyooWuo1ed/5gw0d25
-rkHZVKOTc2V/CbkcWaajYBlUyW7TBjV/j+mvFWyTIlL2ykykp9NRup5Bpkf7QqFmha+34fSQ7Xd9DOMk
-zqax7IhLGh/Kx9PweSOnb4v49s0m42ub0Ig418YD824pDhmBHHpn098/Hq7a20qWTTEL2F2PaMMFh3Um
-96LOyvr6e6n6qO9PIIFjd3IK/nC9IPN+YixfTP9uqPyySQ0e9zziC6iY5MJV9cAYVoNFUK1Gn5hcdZQk
-tBBtO/XqMyPDFPqmlipq9I+s72hziqRgMUY56vnx+OOTyA2crVCVmTQ327e4J3SAZvkNBjzCizr3kUri
-pHqKwNKzXvP+YnLwmHavd1w31p2CzJ8/VBQonWswVHumfNxmlbOZ/WnyCADTchkeUy0UkKRUyyV8Nna/
-HCPW7+kRaxX7G5I4AI6QRjl3nudqusn/nHTJJqVeVsfpngwNS9zvA5O+xNU8HXtGKM/aMnWBm8yd5xFU
-KnwU06k8vN7dVO4ZpXGec6XYwBnhqxI/7ZC3iJA+8KY5+6YlpHcsGjs97j7Shslg9kdoToYQ/KCjXfwG
-UHG2d1o0Baa0azGq2Iqw3wSGJv8iJdlX665dhC3kHHf5r6vu8VIHHUCo7RGI7Lz7hSNDnQO1H8Qwrcod
-9jz264zXMKaNg99j0eYq/tLSI3QJLepU9QVrU2+GkcczOnPXgyPxfuuP6b+mpCvipxLdpozmjwDXhu7D
-l6jkANKgPSToCW63whYYTxC+HEIeohWn3mn654PjlIM/dg+c3tLdYjzYExB75ap+Y6/L3EfkjMX0Uod1
-vrytG6OSayk16NAIHoQ0V+Pdsf4PBy6Oy76u9dcxaZfJPe63Vjn4fu/NL+Cn5J/A3XoqXZmYoJT6Napp
-zs4OGUOY2jNZrEYvlkYmV7NrgXBpbMZ6H7tlisG3xzAhZYXdjWhOegA2TKo56hmdnz2NIBnw6tOegAd0
-ADwSfnqIo/QoKkdjCCWJpAaxD7kOVh444a8PV4xAeTLILsbU7beXPSFz2Cyj/AQ7Rrq9HpgAxHkMUjyS
-m8MeFKGi4Txjcl/VLYgvKE7ZDaP6eBx5l3szXL9KUMU29MQXpvXbEuOk7YSM+nEbEhoV6jBlv3WH/Cdt
-ujgTOThjo79QHs+/U0av2nPQnYjdBVJsRcLs+egZyVILcZy7IB2VAs5bTJ6Z2FqG8rg9GoIcBajPP6PD
-ATovMiY5KLXhPmcQEot4ReZONfVWU7N0c4omc76nHDGXGy3XLAY8i7BZmFvUGdsQfWzcllKHxhMD+CEG
-4mPjlNRSs0hfnqBdnQ5QI76YpzQ8yVhfIy3JEjFSbJJdEscd/MJTTSl0EH9zgSeVeHC+AsBVoemvSVy7
-VaUWKrW3bTpCv/rxHPmeGWKQWW/UY9zDC0Y+1BM4Wr7xrTbFv7o5n82SfAbOc9rs/CePssEs2Wseh2ac
-8QKxdmrGOX/2BEV5gDuC4ARMj/akB2ozbMgmrdxfrLkfEcAPdL1dQfqPBwmnwNeYs+FzVKyX63erQxEP
-SAHPKrdQF8L0lIg6msyYbjDr0zmZUW77tIWofhiFgFmBek0IC5oeOxWVmzaWzKjavap1lEzxXsJC4Fj4
-wlB008uodch5k0sBlPmAya/oQ+/Z+zFPfDuSjMpv3/on+um1lC/K3MjgppnjiyislUNjjndvbkO5uyfa
-PtiPIjNEtQMRDs8J3/G1iJFybaHUnASceZrAww++6Z2Dlm9KXzkCTD+cuL/ZniDpt6S2/MT0biDpLdUf
-2LOpS43D+kRkZOdjsVdbM3Ou3na7KU6nZHxW3c6fb20vfss0bI7eZ14dhHP+iG4jX9qAV924I+ig7u+N
-91fRsdSS6pQ0YOEUYBYmkHL19QozlvzJu2xZCVdf7NAwZ2sOXcfxbuvVlplH2dhiPjpleJ9W19cYo2Eg
-hV0PRog0AbGt17zTImwnqBGxzhHRHEEDQjDA4yzK53cP5xWWhgUBFMhNloE2/Az+1f+GUpm95GZ9BJdT
-a+HQVoer3TzR5fks7PNWmiOxdPPmfZYvS3bYCbix/9GCeYeYXJYOeXau7OiAfSjjXjUZ4/FClzr6XtpJ
-ARK1N9nYsB98aiqZoolm0ipjJVQllEEbe75Sm7WyBGvNYsPeBmPp+sLWP5n9sAC+16jEVf6PnoILcDdy
-MEH13W7aJvmClbAurCzYOTKrhZNOOVM1bDs41mbiM/MQR2h+7sLJ6vruabhpJz44RPk0Mbvy7PsTZ4bR
-pQ8lNqomR5BH1Bi2LN70i9Z5VXndwjdJa/aFsm4K+201jvKaDx3QKEd61umdI3axdk1UJgmfFX8TWVtW
-9Ix25hB6Oc3AE+f86aUPggbjULa9JrKOJrnn/wPTByz4Zl2EN1jboG7l00nWBnzF7MqAbm/TFR56WstO
-dRmo5tpP9MEzs0YqPajXieuo5TlfWYgx4YImscKnk6wNeIQ6TYk1Nu1oUNG6asocynOcM/nFspTKpLWz
-UUA9VrUJRxxriUckq5POUGJ/Nl20JgpmD4CywO3Ybl4bKbEtDnRUWl+feMPCmAyQJtHLplukXOJCrlUB
-6mC5Ur1tq59Uo0o9G9aaohqv9hsjab9BqySpvhOphDFbsEV0G6kZ1SEgqNXYJGVaaAy1uiO3mu88tPLa
-yBu2xhhqE6a1s62Ocu9wEUBcitSj2av2Dmudet8WXObZO+zALXg/6yis60DWe/bK+4YzaE94kk2nVTDZ
-s284ifpo5ClrwxmKOrhvoNVMNI6z5DjOpHHE6/OmMkeuLaL+ma1m1ykAdZSoDTHrxAw2TTSG+nki9zPf
-7mnmtZES2+IsR6W19Yk3LIw1oI43rWyrLbVNroagEheqmh8DLpOf4tO2rtHrxBTW7pqJMo2m1oz9P7nC
-ayNv2BorqK5R7xZP7+VT3lJVerXsQcF7Gmkib8hQThfKEZzzDxC6kgsAAAB4AUXGh2HFMBRCUX3gInn/
-BTNK3H1egTHGb7+/bcsYkgyzPzvINi8LyOoOWlNWH3vDcWIOBgmtqxsmYLmGVs0MTILtxnLonFmULnba
-VpxOSUlsx0C9k3tj510/fLSDzz+RAgT9AAEAAAACAACabFjbXw889QAbCAAAAAAAxPARLgAAAADQ206H
-+jD91QmHCHMAAQAJAAIAAAAAAAB4AWNgZGBgz/nHw8DA2fXL4J8pZztQBBXcBQB9UwXdAHgBbZJT1J09
-EIX3mUze36ht2/Zn27ZR27Zt2+1Nzevatm2fTmplrWfFw61GIgmfhu3dR6gGetI4tOa9whRE6RuI1a3h
-avNEK9ovd4dQWc1FMY5BE9sTlFNFkUOB6E1v7Y/lfaywRHARzP9GQrqQJLT4dB9CDuhFRdCE86IhT8RQ
-Louu6jVq/FYFnjoBv+li6K+bI0TXQ3/uKyTIvgNi9BL0p2BM4q6orPPLeTD6/0Zyt1gYLO+rmBlB5j/f
-hjM/lndO6Kib4TfLjnK6AAryIxCfhDs1EZ9xgMz/qD6orsbDRotQnz3gxP2Qy9XhwP2FQLjROZTgcXDm
-7kijUsim/PZ57IJcWfe27srbHkjnwfLW/BsEB7otcwk0po340/zhv/CPvojikm8BZiiZPWghapOFVTKX
-1bWRQQ7oy9ORyLuQqIfDn68hPzH66t8QpbYi2nJBiBqFYcoOb05Hb7NnwkRVDL3ZC+H0Go2E2rQEyXwX
-I9RteFI6RlJ5JMj5ELUUneVvsN6OZKsQgqwuiJGaO/82CMSt0UYPQDSfwyD9N4ZYu+DA0xHEY9GKB8p6
-ONpRHQz+bQfifmuEQPUcPWg5uqiT6KT/xVSrGLJt55FmO4amtjdwUK3QVjnDTTWBA13AEOqNLMqAnxqH
-kvQaDUQD4dweE8Rffr6OEvQKQ4RGQm2hgdGh0ZX2t98xevglog+jjW+hYPtmow+Z1wgrv+jiR0QT32I0
-Yfr+S6p/1MC3UB37UlMPmWcJ07/0/0dM77+FoaDeA1+vv+gAeAE9wQOQ3VAAAMBv24ydPNS2bdu220Ft
-27Zt27Zt2+2Nblen01XJPFq3TZ9Pv9FQ0nDa8MwYMhY19jDONh4wXjP+NYVNbUzTTG/NHcwbLQnLAMta
-azbrLOsq6y7rLZvBxtiG27Pbe9g/Oko5RjpOOvXOds6VzqPO364irhauza6P7nLu6e5vnqqeA57XXs3b
-0LvW+8On+Jr6hvrm+g75XvpNfsI/2f81UDawJOgPNg3eDbGhRqGVoVdha7hbeE/EFekcuRD1RntED8Ts
-sbyxEbGL8Ui8YnxMfHH8QSKdqJJYl4wmeydvJ/+kpqROpjum76RfEBZiHXGE1JGFyEmUh2pCraKuUd9o
-ia5Lz6FX0Ifoy/QTpgSzgnnG1mJXcgHuBl+Sny8EhC7CGNEvLhGvSl6putRQai2NltZLZ6Wb0lM5JueX
-a8s95OHybCWlZFVmK+/UhNpBfavhjLm16do67YB2XXup/QE+kALZQFnQBHQHo8BCsAOcBnfBF+iCLMwL
-q8I2sB+cCJfA3fAsvA+/IiuKIw3lRmVQPdQFDUIz0Xp0DN1Er9Af7MU8zolL40a4Jx6N5+L1+OB/DduI
-oAAAAAABAAAA3gCPABYATgAFAAEAAAAAAA4AAAIAAggABgABeAFVjTN7AwAYhN/aXYq5a20lU2w7U2zz
-7+fi5PmMuwOOSLPHzv4JO5zDot/lWNO83+OW60W/v9Ef8MTDoj/UPr7oL4nSIEybnGKgMCsaFLZ2d6tt
-nCI9+lQ1t7T/4IU32bvsW9U422nma4vhecVQYSDrYOBVNp7ZC1k6iryuRU1taZR1bVDVrkhLikXFK15c
-WLDhJ6L8PNefAO6yIwcAAHgBY2BmAIP/WQwpDFgAACofAdEAeAHbwKO9gUGbYRMjF5M2w3ZGQw15MT4G
-Du3tTC5WOgpCIBYzXIxlYnmMlTKIxVqW4G0mC2KxqcpLCHKDWOxOZlpyYB0cVvpKEvwgFqeZDpTFFe1t
-pSUJZDFs4uZiB9qp4FqbKeGyyYCXsXjTBn4gEcDPWLKpAUQU8DOWbuIXB4rlizOWAgBKeCOQAAABAAD/
-/8E9FJmISAAA
-`,
-	},
-
-	"/static/fonts/Roboto-Light.woff": {
-		local:   "server/static/fonts/Roboto-Light.woff",
-		size:    18520,
-		modtime: 1456790235,
-		compressed: `
-H4sIAAAJbogA/1yWc3Bl3xflX5x03LHtpGPbxott27aNjm3btu2kY9vpmNPf38xfc6o+99Red62qfU7d
-qrvd5MXEACCAf0tCHYD83+6X9q/+n/L/L3EFeSUAAMQfAIAYBADgVlgkntfElVSEAAAokX+vtf8RnkSe
-oyivRM/0T8v7V6v+Qz9PuP63kY2B/T/t5V++GAAA49gPItI0cnUmBABg/vMp/gdYLlWaqb2ZzT/t4Z+P
-9x9dTs8ElWYGTv+ysCz/PHD/AxqAYmbtYfpPEwAA2A4AAMNNsVsMF3NjG3cAQIj0n8fwH6cQgOd9cxMD
-439a+L+a7T9emCtBzf+J/7S+fzXBP0h/lEOamts4/5fdBQBAZf/15+qArjZjbWf0zyfK8K+P7n/06DAI
-b9oYuP/rRez2X47wP8AQwLRtDWxM/t0O0r/631lAMJF16KTs7Zyc/2l1/zSU/3zfNgBje0eTf1nxoX/1
-73+0hcTbMLuDJKeApZFqoIvJEzY2ZmWs/LEp7LJ2i1svbrbZMHXq5jbdaJnAxzlqvrjunZg4u+feifB0
-OMjfl4dAiJ/4wRf9vf4qrcbwJJv7stvZvmdD4D3ZmXZ3ze790o26D5a2JyCv9/CwVdQT9l3T6n3bHX3l
-A44bz/drTPkbMvsTkyDtRPtg63rrOtrJsdurm1c32W3Co3jx6k03OfN8ZAvGtkfruGfHyr731HcLsbM3
-xe92sOe25q7klaR7uEHEylt5u+b1+SnvUe4zrgIcPmMjM+FLAvd4D4U0xtlQezYm2ogHJW2CxZQpam2K
-KFP7njIvXq0ufJj2yNT2iC2lMZIVFShDVXsg/pH2aL1re72ne33gi78riG8Iq7U24vrUEPeJu0s4yjHq
-8UtBDr55CSgPFsMzYcRhrIXC1z7UbJQEKuYowzV0SV3ysyVB5OixJZJqy48ok+PvpQO6Og3sEXG7yK9U
-miNqr5zmFaGEbonyj1jLCxa/wi5xq02am8TnSajDoPu2FW4i+Qml+yYeklnLa04PXd34owbVlXHPNUIc
-k8QnqxTESfHDwvVnxjMFa/H5edtRFdt0735fQX/rSorVHLLZdcq6UQ5yH5XWsvUaKbRRY2i98aqG7Ay/
-jGfnm71FNjka2oPKJ50eg94BiiCOWuICoPxfSMMyoRTqs4qmit8Rrq0n0EzDXGMJYYcGHiL4yQ6BwZKJ
-3zo0FcZ6xvnapKljyYpWf/E+P5ENfGlXJ+HvqyxPDj08BFMmWarnRoTaJX4l0By5HWp4yOWkYhi3P6jL
-pDSkCzIiB9KqVf+/QxTL/+mRUa5JxCm+lnzE9ZDATxE/BKaPKNbsm3pEadvmmjX75BouWy3XJj42CLJJ
-Qen0H2hyz2BfKOvKn9IO5pyoPEFt50VOGl4odEOE0Z9akpdPaHkkWCRTMuvYAfdlM3YCG/Z5n/J0qx1j
-vop1qws9m2Prxi0dXupt+g0YsdoCtG6J7KlfEH8Zsfzheu9Q+SNp1XiAzWreqAJXubaK0s0/xr9+UrV4
-Xf/5ZPVII0Hg/OV0L+rhGv7Oy3wavqJ70TrmCaibXjGkf8xY/EWGJL/EdeXxZjnf80XgxQaMjN9s4h60
-ferqNp4N4BUtKF9IOiUAAZxuC9FaTO1du3Kbta5D0Rq6PJXzrX6V6abvBHW1mQg+g3hJPceYUIzYNd+s
-zV6K3290TDKM0Q480WwYUVxCAnBsDC4argAti5ZVCFXkHohJ8Z/Il1IAL9JyXTv5U74EQPp4LcwfNcDl
-0PDrmOqrkGHsjNfCwjbpW2/LluufjEOqbk3kdzIgsUj12vqlRaYM5B+I92KXNWkA16qmxKv3vddQzgXJ
-dNDDxmXroNf3OoEen7BZBWOVaS/O7vrNe5kN7vEbAcGpb3XlKgUj3EQPXlV1rfxvn+JnL4WLj56bh76V
-Rw0bYYSDXx3xcNvahuAQRTBhdfYRP+IusOJmSU0ThNhCKqaNQIzUSX8ZlbdlaWn0o1X9XFUgrSkOZqo5
-yNwX8x0SG3FgP5LJDxWLWV6MEmufIqXFLRjY89xzDLptA75Eo4CMRBEmpk1Bl4QjKYn+WBShXkzPf5NB
-1k+RINEqSiQROoyEuxchfyWOAkUuURBJoyiYwmBED+eIGiKjRSOZV8qMnRymVA3HrBwyjxzClBpSlxrG
-nBgq9lYaUqfAZfsR1inq7+WOlBoWPIvegmIM5YXZr4twfk4U+3M/Ddopi1mEk2Y/qzBLugyZpLw4AUjJ
-OrLLFJVn/1u3EjqQA4j8qhfLHomEIgpdXNKclPDWKBrebki6RhyCmyBVI0EiX5RIX4AaDvdDNNpjQEko
-6laD2DsJASQlilBK/DN5wKwZs18zQTJhrEAkWkiyXI98wFsy+KUF+g5v7y+YrwQuL9pBnZzI3CLjBFCk
-TXoo0REu/9JZ2bXIUcY47/KWQ5qTj5XYX5FEAiUuPqKB5EKPtBJ9C+AOIhsxDCIkiCSsB+l20gVhTdAm
-DOLVvyciOEdp68cyLiyALo9zNXgmkUGbQV8SS71pC82n98e38QeqXisMZtdLVMIQMpQI9h4//wyWERLR
-MkG7fjRygRdUGhA+zx2EdZgdZEABALlH3x0usbb2J+0PEySanTgI1yl6XSBY8NyG3jXpGVPG2BjbWlLZ
-JxcnLKPht/ZSXIhvCH6oA+6Ux6ajm1B9hEsBSVHjZdFiRK6dKwIO4F/PPpNg0bZqgJD5x8KWrZwOeKvh
-8aGqK8x/v50W7pZpfWsv8orqjcwsYm9ujbX5MO1DAU6su1YKpxDvEkAp9P3TiNz1XY6QqKYzTt21leTx
-y9Ta2vWlsyvbJdYTIlTY4ABWPuEm6g/EDV7JPrnA565aiXpVXkibF/KrmUidPySXOAgynYvfN8a/c3+r
-aFFcjdtJ4oybWnK6AB1Hcrv9VD812t1n97Oj3Gi3O9yP9iGiRn95s383wQF2MbP8pa09ct8HUn+gUbn2
-zi5VKNUKFpIjuis0olevN3mNDXI5ny9Ui9eK81j+CQcajci6Tx2Qy6azGa2gHF9Cp0v2AQFj+qUSCCDP
-hHHPxLm7Pqj7lqiifKKMmvZ0mvMhs/N47QS8mAIwkCS5BtAHZcPg5HeAEoYw0ykjEEQDZKvTPs9DZOtd
-q4c9nPnCF3R+A0z+AwyeUL07CvK71FMY0gJOplHxHFIn1XNrHtEW7nu4tbOxRmMzklnkJ4mriBOY95Kn
-0ahgMCWoA7QBg4RVmNLSmT6c4MAxreBMYjuUHyQ39n+uE9y+IVftK/4bqgDUAHOAJkD/3ySVB5AD2AKi
-AWP/dn3Af1ME6pc+ABU0E4D0zQmA/0oF/AQjAsCCcwFQwWMASBB1AFRITAAaZB8ACVQL8BMKHADxf+c4
-dxAdBdEOTUZUOfswDQhpPtzfcR7iEr8e0SnKO2M6/D1zDa043VzT63HR7y4n/o45fILUhyFdSuEdFDPd
-3rGFN+E79/oOgzALNdWC3uIg/IJsk+Jswzrz75w7m8wiVmLq/jaQ4jqc3EDcpfqz6eydVcEFwhKvJ8uV
-hIpN7fHLV/kcASpOZcQoNZ26sY+903/aRukzPiiVl3T3l6uJ/ABBQnCogqRUBORFTXCzhGIxx8uMGcvr
-bC17tsUnnLcKAkjpgIAQCYyEbGlsSuGtGZesnNO5tp7eMnx0DQ52Kjw5aEbAt7PNbC6urGYo7jTQRI6M
-A3xtMGBpCrmc0zBcUPIAyVSBTSP4jKxLvASs3ZIQ0dMOfG5boUZhvPu15nc8c1QiL0nAjM1KChAyhjco
-z0yRniRBO7/BiwSVH0TdJnzxvwsEAYD+m0FBv78BiO4gTd5QAqjiqE+Z6d3cXGocCMrnQOU5JcShPyis
-2DhP6LBsImCue6jKl/DSl900T1mVP606MtSJ51G9ouuLNZVQbV/NTBH/iE7voKxtrW3cH09X7nRpzPUR
-AhRVDsBBNW/BCW7DkAvitvUZevYe5rnz422avS/GW3tCdoPRebP+qHrwI1hsJrL7s/W1OsgD+wRuUFnv
-5ShueF5mzlx0HhLkXyYo6rbP3LpVr8KhhcEZCY0FSEHJCqAVl5eR/IBqb0pckd7X+sSS0J3a2CdR1djZ
-VsQz1Ez6YZX019w4zxBwlVJfojGcnz1zAktvr40UVlRHtQWLYWzemNmaMWraceGTybmPzgVCFA3LFTFV
-Q3I/z1dgWKDMSYwUkpHXs9rvbKyWzHHiUmCS9WjjmtaISSyXAHCwIZCn4nT+Aq8mkBT1nA5EPjFKaqek
-nqXiBtXr5Iasa3TWf1nywM6+vQTE83tNq5yxs6eMcPrmkrd7chV1sAb0iZIWVaXKctgemoDbEHpuhT9t
-lA11oO/6myo5Z5C04qGLqriF8pmDYzA3PDG4um0KxF/jtpMOQqytaJ09T7NBPir6hChOswU/ZCiL8UUV
-JqojkEug38woLmdtWxb4PXPmGl/qnSu2+lzJJHY03iBUpPHTorFJEaV63uVPfp4ZdAquAoNmFiSbNKVE
-K9/zSKjMC4e+FYtSfE7b/eaQBRhKitAdLE6EcTlEfuoFuYJn6bJtC8qvC8ov+yezDViQbB7aiiyIkR6A
-xT4YC1+XY8iWVRWq00OmthI/ztQJdaBVJmcXbsmER8RESMqUZSaZs0NBZlTDMK5IVHMSKkAbM+Uxa6Gc
-zT75C3hldxiPFARuQOhdS7r4ZjSbfAXCRwF74cdPEOG+zjsK9ScDwczHwl9lNX17TCc1epnNHhDlarkb
-aEcZaWiMNNZXaj/4uPbe/443v6sFQjJJ+S7KrGYVaarCGBKCnPcz2JOvZ0m2pIqyRrIVmH+npbijKR6H
-ewqu8Up70EjdwOWTWBRCGT7eLxJ51rtqRP0gw7BdZ7YylikIcaNhBF4zU3+eFeux1I/9rj/bkcVxbGh9
-Iljovtht+pUdtp8l62eX/60Y6nzD5i7R+svitziOd0dMneP59Vaj1vH1NqPa6igj31E23wFeUCS97Faz
-k610R3r12QUm/252kkM6GMHST7FVUNAzsl5YDBY9AUrlIBFM+pWyzKHQhtBtCyrjDftWBCsq+qbiaMb5
-0HJlnEBzknDJgNqVIdooje2Qic66hj30wkPmO+vRyyBztUjQVDDuRZ9shOXioJpGdaPmaCUZPhmXc/J8
-dbxE+J/6khVaaqYImuu87frLXbwb7AWiN7FjvN3mivchjCGz5DUbj9fXxwsKvRl6uFW8VpqLcmfdkNFk
-yaG2F21Sm0mN5rqxyk7j835u/OqbPaNu+3Uo6TXsG80iqZ/VOKnyG4RD8n/4yo5vV67C5EAF4sm6IFqb
-AdjUjHL9NmZtHozk63Y4nhaKrEZFsGXnjgLxFCqjWOuoDIG6DwwQc7r3Wh9rjzBiRr+HEY2c/OfN54f1
-s9jvHeXGtJSUMh82+CiN74/gNp+oNYM6Wq7NuV9qnZ+i7IokF5JXrtYLZswhCkkC+XAWzXBOG13ElM4a
-P9PW4PrUMbRWzWVk+jjYqObMhzgO3qmIwO6VFF1LgmXefEltnXWwqa+sdq5tUINMt7r9Swi1olyvinwW
-CJ8ukXgSmkpS5hAHjvrRT+N/eNfBBpUbwTh6LnSX0oX30HBJCsc4N4kaLSD/jAqVghoEahFNg7HGxSN8
-/Hl8d9IZD3roXzNvYrG732VcWCYva3mQJXlKYatTL6ND9Wdf5nljeLM2ZWg6Ig+0HzSLZVCHX7rEuuRA
-wUvUjE5dJ3Hg6WFW/CHaKl4Q5Z+X/wD9OugwB7Tl4LUuyAOWqdOyk4MsavZIj0jG/SSX0WKuR0JaGsGr
-wycAHbjuZ0OmMonrQbgohn4guWbAROqS/9QQVofFV9A4Dou9Fx6Fz96XlZQxSu/p0SsUns7As2xqWKn5
-ZHuUgxaSLtnTa+dnmX9XCQ3ZNqsnfu+Cy/rNB9QCAwQVHrrdIy4L6L21tIrWUhNtAi+yIVuPPea5u6VY
-4+Yg85PmYEWCAl08GSXWiHhbxAwN53CuXKLJdUBtLFxCAStSxN+bPb6v+O1OV/d4/fUmG8fV7iclMRtI
-1xTZiXyidnkcRYKuSwodhJ95PgG7w8xnT05nesG5jYyoIPYBx0jme/DpHPDF4n8doUGxV2D6yX87Po4G
-ZxfVhy7nSUa3HICGsaDz/pgLpsfySOP1ovRHknlFgrsSnZVKPmu+p4F7KA+NrFVwTq0oosR0ZzGai8o5
-ooc7pNCwTwyXgod0YXQxoSlczocmanMn9cxIbPDo/y2sQTIj/35fOo5TbPBnGWdVx71pdFN2NNUsF8vp
-TuE8UViRmBBTQPwcq5gBgTxCxTju1bFIIYStguEHEI23EGZtyBf6EdnG2YkB61HYPUlJ/kqYM3v7jfpD
-6JwTdUhNfEpdbAyxvACjMOnAqvZvBQIEpOOq4jHTGppqE6nZ2HnsReoxnn00X6LP+Ux0fePfnOxMT7LJ
-u7UJVq84NA6JW+5E7pPqHTarpfqlydGw67g4zt9el3Lr9VkeBNbq9e6s+uaQhE3595cQvrcIZcTBjk79
-nZdUbZ3Rk2UmDkiGDvGn+Y/HCRS94S4D1kcVsZeUA3kkFvzfl/YuI5ml7aEHUhGnnf0LxCwNnFS/Ysll
-vcAC8ZaUIBSphQhVqlCxVUd8RHZipSTviuH1SggkapN48e5Ngs+sC/ox6kn0ujEV5Ark6FWpezmC9WRk
-IyKwSYTSrZLWsK0QnZ2SyxeXU14arPJ9NjsSe7fbb+dvQhlD8WBXbZHU3LIf2L7QcrGJIJwpA26uJXd8
-u6yXyxHZcyKs4DBLFvjczWMFCdUN/CUKCrDogl81KTFuFnpeFkP5nxs8Q9p/8voPr05dW/D8STCnugnv
-1YVTmYhYw0YoqumEbUKMTysLMxcaZ0+VgrY4oECT3DnHwcf7Oz47KCf7JeYZAJmfsiqWG/3ibbO93Z1h
-JFz8d2Fw5kbq27t3d8bY2OtQoHFOt7/ZU1XzXDzO9npTaaypFc9/V1RcHNZlKSXfO1pdjaXCAKRVUmBs
-wrpu90iTBxmR5iswa67r2TC9xIUaEGCQZJajfAFKhLtPpBZdAUx85I80EbbGOT/WAfiIA3wM2r2ZEqqu
-sfpqauSa67gWB85lYCufK2PFqcKylGuHOM70yrnijJK1nSGV53hDCurHgkfp9gJIici1K08CKrjDj0b5
-5eVzmxTfjn9b3R0+bOopXNtl8yX+8nV0Hsw4YPQym9lud5qp2hrAKURQjns3Xqi3Uos78UZX++wrE97R
-+6XL9h7Eu1C70fIbHeUvu+ykTicCA9yv16gPOU6DAMpTmlDRUQbDwTTbcdzbkuynX0f+CWzR0WBDY0ux
-RxJiyXOV3pCyEkMMJvTpTXU1DnuMaY9IBrIN4oIsMYM/pbklRtg+zUVmxC1I5OS7n2QcpV4DyrgWCLub
-Fu8clkku0FG9/dmf8X57eR0X7JQCsTpSf5lIGS2Co369DszT2X65uOl9xZGRMaR7brgTwRj2vVD4I0ST
-xY1he+Z4vEJ78qXktEuv+KM/EEqv6zBpmQtsPIrOecz1UIH/SuO0wEukbk40CyxVeZgNnqJEToe929tP
-LALRpfThhjF5FkchZ4zrgrMh54/3JkzKMM9TDQolT/IjLqMNVSAK1BeEfc1/IblHfyeJCW2PDGX0dNrl
-e5UyFzmrLpYIo8qXKl45ZSjUMFICRmDGUCawOHULrqMR9EnH02llrOllJIBhdiR9SK8rWEAXLNzGnlJJ
-B1FMCi/pSmZXqFBF6asDINmOo3iSa61p2+CzmqdqzadFEKbb50igbN3PY+qwNGLJOGD1tupPHvw5C02H
-h0eWypBRcMJSTH+9dRl9j6BgzGC1Ph6qeS3Iy5y7SfyUVnLrY5CyzOsuHQ/quEU/DAdOZ3Q2t1MVc88c
-N20o84lWT3N19j0oz7o1hcmoXKSerLCY1F8MI2m7Esee23pEuTbDqDovIzJXzrwlLWEufmmEp1DZ/EYm
-JoaIjgG1Uk8jFzFInMc0qSXBRP4LIkk+QlTVXYFV5+b8DQNyRnA0zfpm5pM1b2vN3anm+HypkkNu0dl0
-9rvpOSrPpWy+edtwsI59prg7XhX/9q49wPGbWhDMFQ3v7/Xym0Qc9vQ2Xn0xnZh0pGnx09fl+CsdGezB
-tnM2dub7i1UTXHWHCAoIosXE6t0KAuNTrgJfRTTo/PZOT6IiGQtlIThs87O0HmNPKCg0PxC2CozeXQtv
-tJIousE51mFJqU/bNmmBzvMukQku4HKKg8Rx5HH7norDFTd2ms43QEtydV6KKoZYCI+BuH9KjgASickD
-3SDAfgzJQYRUTRh9vMFG3UDUrzr2xB0cwxjFRnBJ7p3mI9sP6R3ioaBH+zt+XXsNHnkCCXqe/HlxHtkx
-C9UB8+r9UfLJK3GVp5eKTrRwhWCtVzUjgKtq+7Xf96xr5PXsDvgKVOHL/DhD895sNlsc0LgIjfdgvjdl
-+MwW+5wdVZ6tEFBKCA5W1FzST+D69TwfJZYcA6+qoRLwAydWVoZB0ecnreoEiXUeFREKy96B/lkqVGbu
-rFijdMt35AeYk6WUldyn6/Nf+d66PKXnHBuxZT6+O6zInf035JiEX9KihvUPVwkk/pwn8gozXkHppkjH
-ArAHqRo3Q9/DDiNCuY5SVF4+NAzKDIo8oLBZmfoSMv5/Bs2HQZRNBj3PSgsXCCh38X8kfXUVO9gm/ODP
-fG13dqgsMQGCV8QEbxIro4AQyq1pZTXMWGIK4rF7WrXzeS32P4v5JLmqzlUKSZpsTg0tIhm4fidc1FwP
-9XIkTlF+4dwMieu8l9kjuOjQFAfuJebcrlw8FiijOaoXaAWAV78Vqz7T1HZ4miamD/vteXcNLgd1X8PN
-4vth/AJ6RARvF7SyRvIpXmWr3BO/odhtuzT/Kdufl7Ab+BXRVC7ClzGuiJpMdp6RTjk8pN5O6/AuIoiL
-Lq9jSc272a715sUo5IL0BYNFY6LWE+93PQtMPbbG3OKSwo6723ZTai14mUUgwTl3e66M2SjKXOj1akk9
-XI4IF49tUrzmPTT6A+QNrbhUJtM6n39cCtwY+sMDJBh2v9eOr1+Ua3XdM5C7o1hbK7JAwOtuZZVbNe/y
-7/7+udV0suY85te3/aHhusTHrXVg7NZc1L4e9jBHYJ41Ve/sEqyDz8Tw00d5PDngndPtZ7II9iHPx/kG
-99qG2tQ7wCpr+HRgh12+dsfevTuT/ZnTbj0yh4/WRgFWndMzL4WN7O+f94ConDK65k6uGVRj8bHIPW9X
-ti+XGCteXz/OHueRR8TiPSLGNtzh1C1UJKkAyIqvVOsgRce0h3UgaI+CNyVFTEWEV1Kzq223V88UJeuk
-Ss1aEBuPN43hO3Rb7so4N308M5+8deknExEN0zyvJwbUwYRWY7d263v7tfu8gxewaF7Bjwlk1gdIm9RG
-3lmSMk/Oc/C2oSqiu30eTi8iKSFarnCBvQIojbxJ6yMcMRURvdFwvavPwr1/At8zBRaNaKASfUBjkivK
-mt618AcrdFLfZiVmgJVKotmpHUa2/oDASKqYqueqaJnKwC4cgIgEP2dU04pdwgzeOZFcmPl5tuQdDIQK
-vpd4ysWY4fN9eGTv3efUkwzRAkJWdH6wNNht0Op8acc5l3U8ZD9YFx/+7hF0GNu4gkX/q70FfiVSC2jE
-kb2nCnY0VZaZFLEL3ITEVFYdKyPd0jeRC3hdNV/rHhEj9GLzfGBeaMeXhJ1rAxuNu0oo2YutQWSZgTKb
-/U5rsTqKBEsbJj+wyLr3d1yAU52QROS8edQ/bcA+AEYlCZ7xnh0ZtmUkcsUgWJiQpBGKmx2rs/IT0KbH
-8HRK8Tufd+g40gkkVb92IuXkfprtpY8i9v76wJulSpoh37GLijehqXBwhDW6Uj27oE/IaF5OYL8YJgLs
-pXEPnNFDQkI06oKfZ2cMrFbmYKcgPTq2FauFaG1r86eVgVfgyVINuoxZi17Hk4PqpXge59H0El6ttFP6
-aN45sfhO39E/SW/Y662axvx9Wuu6qWxPed1fnOla7gQIIlIPnyU91zMoPFRpxvScoSHJQdsE2+PB3Blz
-7Z9g7c8Ew0uwHuXbS39IsQ0jV+csREQWe5DgxaaSO6cNUmfOeDRJW6a1oKb3+H1fF2U5ed3LR5g/pSuf
-xeWqSpMxN9z4dF1YJXH5fh2yDT4d+jHfT16ahKVwih12s5sajeQM7JU6qkVNpptIAJBS7tNaS9bZy8ME
-KtU4H4TS2zdFfyV7N5LxE4ku3g8jy07m++szH62iv8mAsaCyii1K5whP8yTj3CmVw0rTFxjCL2WDh67K
-skBrKVzT01xL9lqQa4tY7CjQ4HccnrcVZB627v8ZNJtFi6+sEjcAgH7C8+S1aBos14g8ah6GAgvoJHk0
-Ww+Q8XNOp2hXr2RWr9KhBgeC9zHwTpmRWUDnFL9BxE7LfTsmgiuUFY/KlNtQfWoxnjXvZ1JvzEc50WwV
-DV2i8Z0bxH5acoAuV2rBnpFL/mUqDjyltNArSWKHmW730SjTcd47xyN6EoUymCoQFf1rUpkLTuidF43X
-3qwIVJ5/quj3FAClkN9GnmvAgxXjSN5sv/dz5YGs1iUOKRiVLsZXbB0+jUBtCG4asKjhqlHIOsiItTuo
-xRIVTCrwmagHAod2KkskyAsufabO1u4EnA0DLiRbLs4Mu7KUqfEvOmILJfC9fOkBBizPTtN7jwlBC47Q
-fVMwwiQsRxS8rFxfE6TnJyhYTAa+EiVovIRUw+Lj001NfAO1wl6AQLqF1FNEm1+nhD8pux9M0Ap0C5JM
-zyk1pqK+2tyB1ALVzck5UEXnHLZ6lcbY80kzrvqZUmqUc0LFeSscWUxyB96+MEgAHyucrxVzX9YGzvrf
-nhSKYRuQoZVzdSDNCQg6HUXTn60qFU9uoaB7NG1pBzEOtqgSdHPEC8js6PANTosMzW4njRvX0aIO3QqP
-9diFFyxf/SrwSHm/WY1ZAEhlVe7Kw7uSgwo588Ow42X9QRo3YdDsCgHHWEgV7XKHYpEIb4kMgRrEg+a7
-SuaOLmDUY23d4dsGIHBB5sGemuazZrpOotKPHGJFxZsUmqcXdP1oYNjJ+0awiObeL7FielA2fLLe9gSl
-ouk0UeRRiDe9TCamHhUqWOGjSTPNYmtXtv70KUF86ML+w1MG/JUpQb50MRnkuWZVn6hnvp0N9jND1bHi
-BLhvoSIS+LEoDjsEHMyTfGVKsz7MKwQz+NwkrRgZjvtzpjArzc9nbC3fue734W54U4k1+Wwp5iAfVLVQ
-EeQeM5Ok4lT4LffqTG2g2vn4GDGIahb0PUa8TN18fxHyRjhURwssEcxHJjBTA9qJAza5HLyqTI1XTrVy
-P07/9BhOjVh2X9NOj4qlPgzGFmvaV2/Q6yRGjj33Rk/EB858/oB+W6jnFh/9Bb4e/jbH8CijUMcVC6Si
-vZtj/g32IGOIRWgCRBpvszbTBaOY6LPKy1qxmE664fDdl9vCnD5FOdvhJXiVzXHfMYoW/zqchkJwzuJ3
-3E3ZLjuPKovxm/1wvTls7tVt0HXva8uSK+8aateOGKERTK60DxaVN0GlFJ/0wPrIre8Kdbf/Ncm9aEWB
-JwvDctygAKmFVRQK3xVJNN53Kv99tmhl1CBmOfLhfnn9ckVj+3DmZDOMgKsVvf+070uvjxzTjXT6i+cD
-F03vLQi4HaX4hXdUG2K5DJhn5hmIsYFEozZMjewgUr0D59MwVRLAklMQqy/Q6BlSku0IFdyyrdqwjs3b
-TEngFxElDUzJ2yV38pvC8vNYGH8fHk5lK6G1+GPa8WC/kgDgEW8k0X9aRHOw0qNNyvlamHJ8qW3d6L5j
-pOJLvul8ghWYAwfcrGb0w5UX8I+a5GUtGkh5IKh2bKU2beepGa6lLcNQvYdryAlJR2DaGsk2/RX6ESWX
-gptyyctpr38SeQzPc8GCyYFrIEmcCiWz8Qsb8tU4LoMzr406Fa2c5FXVfLbSsCQCWK+yVVbVymSnUE5i
-k2Qm+NW1x6bZsKT+Eh3ZOgxvh4ka1OdnpEqtTCYLpJdU+s2NESe7T/K7zKQDSK1uO97BKMZ9v0ovnm03
-Z30PNekWz7gat2PAtNL59oYsM0aqPN3ecvrGPgvpZtHs+t2xhgP0jjgeGFQ6zW2Co7aCXgGNd9IEoIjO
-+7j3gMsVSnEvOYi2FzhtkXN5IMjHEI2tW9VNKN8746dj4878aYU1yb9jFrusSUkWLXkkeBrGqHl7eXiH
-PTbpoLZ63QQDVMgXuoDyzakvvb21W53FN6Rck732FYeYasb80K5PXCCGQD2zn0qNOgt+O4WPHGWv1UCx
-H5cqh5cquvkbILDupwc7qukzafWsbJomzvoFtwDk+knuRV7hFKlGwmftvfQTnlwKBGcouIPSGKirwx1b
-S8UWHRMXFxMRhXKO0azes80y99xlDz5twsLa2j4arLL1IiAuaolW0qTUf14nQRRlrd5VF8Z9FyIEvAiY
-bFOHrCEdB9nt4EQYpWzyDUkKz/k1hLxYHKBJWcMGUh1/3o4OCNQBLP0QgHigulw+RTmqT5kF0kHNyZWu
-oyJoFJATK8bAMk7o2QwFLreo2aZZDGqvQxfHKHhUbIVBtVqrMcpXDnla5BNjzrCTa8A1a2Yr4ieUzt3u
-2oPTnfuxITyucCo8dVEVpILJpnNaOlUJl93gMuzu78JZdFSSf51/8nSZZNGA6GWxm00Yq5Hl+6Zvz/Fl
-5DWe4uirPgO36JL9gNhc2ygBiMl5kHkJRyZoQr5ChYF7P9B+nhNgsSiXC0UzXfMG20ul8wcLMQOhYdV2
-2ah/qPjKwa8rq64Ri8GmbTmdRr7x2Bp6xfQmuGG9RvfyfUOdlR9U0mX2nnd/dseY2cc00x9L7nRB66qx
-4Kzpuvs+fbxySs+sFkbO3ENLSCEzlNb/gMOQJcqPhC6Lpk0uKouu/Z6sR0owIrqfUC9bkK5HUgKN4kLf
-70fqcfkwNV2BvmrLzN2yv9Tk9PZ5bIvBonG9Nzevvmg4Pq/oXlApl2i7Nf+jv/qXDwx/cpskRHu/uxzu
-JqLfkQdDhiRm1RMxWealF9fp5Xb7dqtykKs5tQ5bdw+br5dNk3sh4XVQ3G3kRvtLWCoGXljtch+ByG88
-ChO3McMjMAoxzsDoOx9x62dM6pzuCzB6YkOvNucMiKzN+MsEbrRDsWMTmeqIxcyaqpTOHSizOcaKrHFg
-3xvlIjTW2hGhUaOf/5Uj7722AbWYZkbn48UyaGFouMwhaz98Tqw9rPSrpHzgUY+VMzRZ4DfmTM0hmcjK
-Dw5ZjfFi7JKEfrtf8KSW+c5Wwp03fxF1nKwxc9sZYFnVV59C3ffZw2wnpyIqE8rNPAsP5/Gc/iLSnrNb
-mKfG8mq5jV8ymV2EeQiPwpv5NCR9xO7MkwZhvUX4BqC7TMvVNWSwvByB4nsEauqMD/5w40na7yPHiJna
-xlLnRCbwhb0BwAbDlW1Ua9m1wNuU1LtM4Xu8NZFrvNn4T65Uhq0CBgc8XvYBxqbKNUVZ/MKyYfhc4aiD
-+soV4M1Zdfqs4IqMjM+PJ4qVcGdYdHBgCxuDierWMh1F3/XPhDOeAcHosfVOKz4uP/32pVJmvgQ+ouRA
-AjEBDbkLBSGe112q04wq5cIhLCrCxpgSkAFzBKfZ1lTdvSy0Xjgaqs6RfMqxEqy5C8KsoEXoti6Ub7nQ
-8YJi/ofZ9BuMoJvQ8ZIBABsGSCIitHzqlEzOhXHlMzU9vTODCmfqzAykhbqXy9P8Iod5qoVKwcF97/QF
-TYBIUZjlh7io/K3fAb8u09fBI/+g3xwJarOAJ4FsDn124sy0eGLR88mBxanwKkENzWm0MsaoIUohpbal
-cJa2XEZE0vJ5PTBCTFSLLrD3iWw+j36lnpJqRUhYa6S+tGwPtVxOYbbQoQmuUFxMpiCJzQZRQ+FQ7aZo
-R8Dm2aDJFx3JGYqwkxLNMony5Ruf7A3eT7sLg95RlqKkP8gr/pKIF0TQiQqvdoUtkDAcozuI5o7vfb1M
-N5JLz351yQJNPUjVl9oQJnk7Q300Xj3VRNHZUFF8ciMGncdhtOb9eT42OzkHj4+WJ3nhYpPPFexsIf74
-Oqz1avyqeHHn81Ouc9St6AIPJICSVzBlVXWBGN1JAxIxJcec3/DgQNLi6qRkeVW2sjVmGRWzy0Pbqo7K
-STBmnnD1dZmVjJyU0dOiTypiaWM6ATK0w4sPje/zQaN46/X55madCO8mvMC2PYOd/g2B1TrTutfrQbyc
-UtbT9+DPFZf1CsYR7HM4TGDZNvbWzdOJ6MyXZ2l7vJiDFM2x3nzkKwPKCK2d7BRtvVyo7qGn8J7uV5Iq
-Cqzn0hkng8/fqRVmT3ZpyfOE0KhUn99N5ZrHEk2XIgdyspeJTADhnKYfy+jrvUm8/g0p6mTUpu0p6mr5
-tOEgbuSVESYItEF/2IVSmcVn8OvUnfWYORpMZVP4Cceo78jdWBmRQMYNjySsLJ4ODZQykZoNf4rioWlp
-GOxe6MOxnBOjzSFVd0EHgxCL9UiIQEQ0jW2fcY4cfCiOJtjR47lUvSpq/YiYwuG9gf3M7kRHfZNekjVu
-1u4t6W1rgcMSnIMScM805UVmeaoYKFddRaowaGGQBdQ2KYEUVUiJtdyOBdXu3dEZo3l8hRSI9lAJ4EkR
-K+XFUmV12ypuqQvpoAI99DUdIlM891tv6YeTof7QQOjPHoTx2uFStSV1e08MFeeUQeUQfbbUqmlm2O2s
-ZCvoY0d+3MdJv5vRbfWKdaRDYjJJXVu+wcTlMymHkRNHRJ20U42iLptQvwmp8oomKVnCxVRUOOpMcZwZ
-JhqWuQ6Ra5yHav2m5WrhGywQ7pryX12ZaxCvvtV9pxff2m4puQjNwuLuOLYT9mq5Om/xOg+m24r8ynbk
-dpuo3rRgl95dacKq5qrR3rFZjLXiv9ne8lHbDuzm5fvjchGWrdV5cNked3zEm8V9s3e5hggiyONfF3zu
-QxidAoNYFAzV0xQM5w26pWRfpvBwAl/izEPAe2r3g3mPz5oxRUAeiApNI/3789F6ypmtJNL3qNcdbaIm
-IDah3ZoK9J1gzYG/ySZ/CRAT/1YwrbhjbQHQlP8OlYbH2M98KZic+6uNeMZhuX2gLf+BxGBN2ajDBW24
-Is0qSGaJFFGoKWYEik5ga8+Bu4AiFKlzKP3Bri+RDCfET53vkg9xGDlv12rsE7XCjbOY8JBEgCZLgDaN
-v0yaIe4um5SXIhGYcTsWD0OyRQ2v99vb57dulZIvE2157Dva8o8z1Wg/2Wma7E1p6mo817B4SWuc4h+k
-YHT5HJo0BBptWUM/PhuYt6/0vMkOFo+Z8TCe7Hi2DQubfPVmHQY7HsVeOrVm8XKt112b7ibg9bJO97B/
-56w5Lq5tk5TObkbxYbLQF6hu31bnLnkZPXB2PQV5FK4/2uQyGe/tCv4lsfY4y+Tr9fG9C3MaIX220Rrk
-M+4X3qnn5S5tqlYbdpNpBUl//KMpKIv6Jer0s6ufuJYHWbK93qjL4Ck9NrhgiFHToauuquFOwRtOnG6f
-1ciD02g6Y++aF8k9robmPVPNSDWEVJ08dMkraDa2YdnXOnN3pc+dy/yuODBLAAeGJ0N+NyOyfGmfIfo9
-HP8OxjHhWfB2zmsOhQ93UCGSeiXquCEFkxQMH6bpA0jZSZozM/2jwgDuhMOMA4fKVuQoIRpBvzkWaA0C
-TGJV7ONq5+rH9feISZjzq3y4CgsDPl6B0SNOZifx0Ra+NNJRmorzRU7t/Noa26HT2frziGkZ5Ai8MIIq
-Fi6Vkwr7Yd+bnNnLu2S145apur3O872VrhVqImcvdYIQOeYrINL8jCER8j6Tp1sqL70xm5Xk6u0E8gjo
-q0Mg6Pu+t+w0T6N9+8sTTmVlnr+OFC4Ep+bWT85Niwx1zzCrpV+avoG4FPVVFM0vZoeeyf74yKjKOIKD
-g72B7YXTvMSl4ItsguwMDqyRjHJR81BLQ2Fc5ET2kCL9fW8e29vyXuWw+Jd99mKufjX+uWV2SkNb8ifK
-aLBsexh/TGNpHA6Lt1BhjY9Wy0KJnBaHEtdap9do6B03unwB68xaXRIL2QUhpOr7NwiXekxG6ke/Zei8
-ckOQu3+oZJV+IsZYRdQepccmRwIegprWz7iSAupBAX9l6jD5F8sCagUo7D7Let9BsWiSx8cp1AUbhh0x
-5SooYL8ZpGCyQ2rzkEI7Ot0Seeik+J6y0ODjv8dVPq5Z/QYqRJuhTHi2Xq3bCqVz6MUwpHpyV6qUhjuq
-XAETpu6vQGxTz3QExUEUJHNGLaM/0EMTrhQrGdRRWplGEAtEVImRWqvexqJXQNTq6PZ6rzBnI+4OBsAe
-2cVJa8XJXvr5asnqnTBemCtBC6IeFVrH4kIsrPKpHEH8zNVO3E9RigoX+17nvfokeU1T+az5JsWRiWN0
-n6X9nOqdq4WCC25MQD+DLNPdLtsWwDsdFrjqj4K4CujSTdzMXcO7p5spItmDAZlTID0WaKVnQ1dN23yk
-KsKXJVVHRqXKSub+dWiQRk5Y5/wJM6PNYH5bMFyKLmXkKXezclXN7gVjtoBO++E5/pto2vPBfKVAjV6f
-dR9wGHCL2tVPkMGwUTpTz/tYqxky6mjNL5p2OaqI8bjAs6K2STD9eyRO39YeHFvBO5CFJEaxeKWMEA0N
-9bXBxdnmjrpLzAkcNL/ytD/BpP3E9+HTt0KRDiy5XaBKe17Uf7TGl0XhVyq5Hf9uo9kH+4xDkTrkcNEw
-gfgua01i2Vld7PjWO4KOXhch8xkbdV/let7b4I7FQs0J9xmsoyKUvMRb6DL0PoQ7fjE95EjkXr2Knqwe
-gw0485tCHQE9omgTjzO4UCGmTYfjehcSBSYwUcuXiRCbMVC4IMhEQAZOLbZSpz18UsdKL7S3zadqObKr
-0+VytdpoIyn/osERo9XH6+9buVILHM56sVdu7Q4y14EqPE6rrQ4/2WiCZq2dwiNZOWdiGMv1n6dqiKlI
-aPY5Tj52vB9yl2xJUJ9EcObawVhcGDKP/OFAoaqy/vfnMMscyV5AZcCeu64PQz/hPH1fVU2AB6/67jd1
-nb9TIhsqLHkZ+4F/QM4NpjvJbFs7BSInR0QgxXR6D9dQQj7O7dbeNeq5iyy/u/GUemCUXPU2e4KqoC4E
-eDaIBHgtcgRKRFwtNrNX+QhuQKn2i1kebkppB7y72dt9ZvrYxQzdJeNTp8dOctdpnyWJPZul+EA/GLHo
-/ni4sv83eguSYPthk3OsiTSPFjR23KyW1bLnhqkRDWSxqzkR4EXQCnkzvAB7UGWxNFkaXb3/t1wlbcTt
-MrVlowlRRLKswcIO38bK7Moj7nK3mYTS3/vts6sdmQqCG0YsJJmBC4X8Y4FhVg3qYaXalSHqm4LCSRbg
-Xh1UWTuBbpha19YFr6mSqUHCiwOiEkxGpG5yZdlqZYoH6hvKHv/8Q2YZeUxEpNuTGl1EKRej1fc+2Xt2
-VGr4WeECZCzW+xEmkc/yKEHtTed5IQoOS6GZnNsDDrPRE0eerjOcdcnIqOSjewyyAJSVgnj8bBC5dCOi
-oYXsqp472VataMBugHMdvN8PgCeaGiXrQ47gQ07zR07zLY37rdfNXvJDDh14vdcS6MjNeNHzRwXnQG5v
-9k5jDClgzqslEL6WupsicBul/wYEjreIqihqDiGw/0e3yGyZ+eM53uu+/pE1yeQSiXmbIrnHVCLUouAi
-80GB7ihJRtBjg+mrQfYKb64NvZas/B55zjoF0/hwfduMg/RoersGbB2Y3iZdDl5LXSXCrGvpO4GxGT4i
-HpeNlO522xVF7yb3/lRVChadgqPFYVQ/IA/2IHlFLI0EW5bnirXhRo/S+LOXZgheM34vfRX9PUpX0x42
-cRr2EVitreBBU9jiPE8fQah63CjzgSiKpKN+BxFkNds9bIwq6dDcsutag0izF6d7OdyjyiJcADFWXkOg
-pJM5/16EPAoNmaB0aHUb6ikQkrHihuKG1SnAw5l2IvkHAUbXdraGCtVFN9CLsBuWqiUU6dPMYLmoDFEq
-2ZW80LbMq6EJpZhcurKYFUoB8nxDcH2KfeG3cdIvOgihi9jnH/Qs/HcuLMuEKqiH64QBklAbI8rae8WG
-pmS8iMHV3idN59nJsOn2wZTHUI8zlE+EjCWGJkw0CZKRe8vj3rqiGOYLp3Th9HThcuGiAUcqE+JmcHKZ
-SgwEe3itKT3sxmsyypfMINwNfHzvadE3u57f3SrFrJOf7Lqc0R20BsXNHtzfHJRbevRo1cS9ckZHzevS
-sJHVOgpZ2nrvRP5zkVK/6dPyO8sQm8Ycr4YqluvRjFTAt6+F6Lu9i0qNiisolFJNFsp+e2miT6WZV9bw
-kaOvKUSF7MR6ukqun9jnHV/wvdqHjFChleSM8d0nEuKVjt60o1+KCXZCHOOpzb0Oq2oihoc9FSTXikFY
-iijfV2e0o32yToVEqqAON5rGdJJXdnhyBnMj3Q4e+yu8nfzyjTcKyEijpo3DhL538+6yWh5N1oJ0+2o7
-adXqRFWOidbdsh5YwCd4ymE52/IDNxhLVXOdqc5lKlaq9rSAPSal51On8baXELr2Fys0nbFMdayUzqU9
-swKA6kj7rsrlhRvgkgqivGiTyu44SzdWx9vgoAq9qvEFJjfOnRMHR15MSJR9pBP9+4oEgOoqMBL+9Nz0
-qim1lmNuM6FsjIrFq/VH7MqJ9PPUATDoZSvUdkDUel0i+mOqb1uJ4I6U6l87HTTelLTTvzmEwbMLFyHA
-iTuMUmHjTrJt/9zOdGWL22WJ+yZSyG7lPEr3D32q4ORgvg/L/zn6HM8h+Wt9g/H597oem4zBLI4iQ6xE
-jt98YC0TlOkNUWKn3XwLZMHobaFc2AcDz9vuTx4wrhYO9vX8ZOp6EerRNnKfAg7+lP931Yeh+J9zsK7t
-oAnFtBauYAWLc5o3RgtDYC1zA9LE4GxdJHSFXl2snpkTJI0ggWfh76COH3obMCzpYFKrj9hYJIaYXaOH
-UcRepGwjMVv2BiQOfpbiOeksjxtZYaVQVzYKPqPyT7Un3njvpN/M3NmIa+yGiwG6OJCz3wJGHkTF8DsK
-TPzvJ2tuCXqdpqLcx92J3FVr8aoeBztUX1cxM1QYcXCcDC+vZORW1bsFCkrqA/MWzMt42VV7XCkhddU9
-Cl8sYXIBDpfPJTGTybXPm7XJiGliN8/0vFiEBG2L9MrYEVhSiMEZw8ljvKuljsbkdncP9WXJuAXtTdZ6
-jeSFNWbj6kQyL+Feh9hT5ytN7dW2HK0dyyrLYGiVmELgYImH6j1OI0x9A1Rluyo/QFWOCN0eU6Vg24qP
-gPErNoEeoPqC3iIFFklhL3kPRcmQhVDNN9xlduvBFx5n3YnvCLgsPJUmsCyF052ZM7sE25bEstbbNotq
-XQ95KyNO1b46P+tb3F2bZc20vtodX8RjJIrbChq3hX9koH5jv37gjlIqgWeju6MNEKqgBXYg/P0WvgrY
-Q2uG6tCisDXmwj2oWeSS0fVU8Pkcu18A2xJbXdHp+pm4iv+xaLVrZBrjNifbptArFCJ3W0njPIDX1CRa
-E/MhezK+uqBl0B5++AJ7ZnEV7elSSk3k/blOXJpbcbhCaVYrhl2NadH0M6/BMqBWK+im8GGBNnj+frkA
-skdzSbFlCUiipmL4JIqRbXbXj4KywpcZY/bV7ToiuPC72teYhiiKfAYzk/PgQOu0xYPqfivk/EZw5AjE
-WdA4w0ovPvd2SP7tmeLscnZBibAqQwVDzEcAfCm4o054iXzZaRZnGL3BBq/5sJJCf7J7UJpViove9vDK
-etSfKITE9LqvCFrtiIZmUk0MI3L5kETQ5PqMJ1m2mvtIbEvbdil9skq9DKWGNXX9ueegdlrHvtth8IYi
-u+vkp1x1Dwe5wUjjGvsFe4fYhduXQ9Z6192hxVmi0sBbeZgshxtUTX2N+1MXViI6f2/KT8dDC9XS9ijH
-khkTCF6K76/3wKO/ft+f2NlcjUTOd8gCdcAXtNzlD7paJCTrIzbYNph+78kTvB8fLgZcmbEPyBEz3UJ9
-1sUVuWnGo2bil2OzL43Vt1MXg4g+0mlNsSgIhX5Nzf2woP0jDrjtM10wkuRtiML2H01S+T/mepIMGa1O
-Ia/bZ2Jwlok8Hm6vBje6VUpd/7baNGdajDhj8nUJO3p6Oma3bjdR9Cxn7ekMkOxXt4Obmo8Z6rM5FDRH
-fGkujUq+PLPzeNScV07cRB/R6cVWT0KrthMgDG8OusedLz19slvLepo4Vy53GRRasu4sZcljsXc58C5s
-IGvw3ld70oZt+D/DOEkAuuBxagQ2/squtUu655b451jB4EgxgSgqZYJONod8ldWsSHjY/eSVcnLN5PkW
-btffY46Acfm2Xo4ybp7HvkpvdBJ/2h6+tqZtEoSk84/dluThWcs2aXN8xSg2bboUZQphgzkwk8GZmiu0
-JOtXgDs50+kv2O2ZDOqWd6gN2c4UucFba2iEmujIZSC2etwVWg8KEPLTDlvABpNG8rr5+Ua60ngshHNZ
-DeHpS1VqRtUFAMjsFIs/77p5jhDsi8jzujHl3E8w+5xxc8aOlRDxKt+YNf3lLS2kGD/faIBy4JEay+k0
-OU+mgkE5nsc7GDDUhrAaQ78NEUi4teNoINq5IYuyTyfU2Gx/21+ayVZ0sDz5Vf4CvM/7uqpwlcGaS+Cl
-wLsHMjrc37gwWPRzxVrqj0gZi0aMfQEg4JJr8v7kc8hNCK6RJ01fXyBmzicuvPeJPYK6k07cMC9yz2ug
-0uhghYIW7UQoTbBKMs85s6TGEERTjGdMCPQlbo3rJaNG0TZqHSxWjzYibjFus/VJOwSYUkx+gdwqMqj4
-R3qX54EOJpmLmBblEGEfR21HctHaHlD/Cv9ZGNTVNt4QkZcmqlxtDsIHhTq9j9NyNAyQ5f2cNjDNbI/Q
-OC3MSodTJLM0xffC1GCRSJYw2PUnbeCyeysNa1C04zOStJT2qcnCTj1ndX1wOjO7h/lEDOEj4Bi0Mh4p
-J5hDL0dcD7wKxZEn3oEVZZTQaj9Z0R1DHA4zUf9uW7Siufhm64rjIQSp/VTE9uir0+2iR2OonV8rYxUG
-gPfLzJO0/fbSMdMf7uB/3Id+PEd34fyoVKIs/fLTBLErauyhV+vDmar6Ff36xQ2FiL/D6yTYFyz53abd
-1j4oWLkrfvnZ1h8vUk4apNqfuiye4NMkjj6GJCvYX1sDcXDnX+FNVxWonTSrUh969qjHqK85SYqYrHah
-5YHorm1e97uoGCw02lj0PRYMai5nmXO2kQVW6KjDZz2gXeuZzROYbOADKIsHV31hyizeF9ITDFpSz32b
-tKYeiwPzhV4H3WUlxQXNP5mk7CkmDsUqc6cFvx+g4XeH+I3oK0XKv++HNe5HFis/3+2RNICJeccUawfc
-f4kWOsTQjjxQC749o68NuQ36/PoWeDk9vSDy7pvzt/0Rnu7nVZ04YKih+37VDOqHGmodLoaO2btPYr29
-HklgUGjLSKz6BY0jl8HjUcYiJfBXXCKdkhgu3ROX+wkdwVGSslVOUvJMDbUeHW6TXhQAvcrxhAFq8f0o
-KgCKvZYW9Qn9DoexhStD/gmNX7wEJ5zv2Y4wKE5deV5U0caq/erzU0lkDUrqdP45DCHZr5hUiCvsw+8g
-lyrBthC8ix+tlvpdE2b75q3u0oqsRuiY5Esczw0Ehx1xGOSjfSd1Mm2h5CDZYOrfIi0JH5mKPovwkNkP
-hwxkZDYCNzq+Il83uW58JO6sUV+W9+M1Osu5g8frlBVDRt3fsYNiSn0EiFvd1PTrybCdhVwqV1ngW1J3
-4FkQ2GI3WNFwZTr/rGkMQGuwosKW8Eq7slxLN4gfPIn70L43sUa9sLqHD9bLxVf/gkR4HgboDyo+Eb+h
-iO77YHSifHuWp9GHq/PbL/F/LMCdehJxG841o26FNCGOwnXEFqAU8S7VlDQOEKpFl5GrMdenqBbcnyIV
-j87qqDN9UguIDaoPCmHKcn1heAqdUk67lP8LEkyzGaslvDGvCl+CWyA2V55lNKG/YENeRz+dJtKGuUI2
-2y9mHeCguyLWcI/h8QbD72n69BVokHkSvZvOcUZei66HjeX9Rc2W54LxW1OvWdZrdULTCWoKW6tJ0cGF
-xBuq0l/lyxm2dczqPnMent7/PwC8B0P4jkzX9UL2lnTc+Z6p6HakfUgZsl1FA2kPtavpRmFQjJWLrnK7
-lPtespa9rBM4Wod5dZmOXcPWL64HyRP3CAAcrWW4hUlj9hqDbqnoFGkfygy6taJD0knYCVUr6FaKvkDa
-w2xX0x3CoA/OivCJzuTLWC2wTL6r8LPTA8u7kbgfIm+oKXhDYScpzsAwOV9XaUzUWkG7TtcL2XyDzlN0
-irQP6wy6QNEXSHuoXU2XhkEnHFH0gqt21jvut8sbann0i88wvxPoXtoNwL6SScDCfBT/taG76B4AS1sf
-KMWPOvAUR/1LGd1FZwEyGlsnSHuoawCX8Q0kOr+sZ1yNlyu/FaqAo/2RKDse9/85OKcT5ArcAqigcyrR
-C9kSg85VdIq0D5sNOl/Rz0gnYaOh7jxFXyDtYbar6ZIw6ChmBbtIsPJpcpx34lroM/r1ZLquF7JlsaZe
-E0fRKdI+bDVkZyn6AmkPtavphmFQJCrHvgasZZaspTeAcgNRLaQvSJ0AbxRl0294MvsfiA9RHQB4AU3H
-hwECQAhD0fMnAdx/QUcRu4+ac85l53YdnwMo6UJyEM4X7PLUSqpIZeqTZjfGVlZ2g5ksbbltCxVJh2dO
-W5EUg+zu9qSSyWLGcjWgJytLC9VbFqu+8oeVL/sOhDwE3QAAAAEAAAACAAAaIzs5Xw889QAZCAAAAAAA
-xPARLgAAAADQ206O+iD91QkaCHMAAAAJAAIAAAAAAAB4AWNgZGBgz/nHw8DAaf5L4Z8rpxRQBBXcBQBt
-AQUdAHgBbdIDkCRLGATgrKq/e55xtm3btm0MYgdn27Zt27Zths+2QotcsyO+tqoyzTQ4EbWor1FuYLFu
-gJmyES2lJ/pabxCQ9/CrDhiop2OaXoqsxoWMMg711SRU0PVQVL3BbFMQmXj/INpDQdSNqtMmGkMNyUsu
-tQMj1UxUkNQ8F8B8yYlhZg1aOBqht4TyPa9wyCqGsVZFHJLpNIrHgzDb2oxDOivuige1rb94vjQO2e95
-bQd5MVw+RGwHWymwX46jjVxECasa1sgnZHYUQEV5gdJyBWnkMtqpd1hrcqMItzAt0ND0g9J14ZG6cPIf
-l0pmdJI+8Es2tNMbUFl6cd+JZeoq5qu7oYvMu4j9LY7UWCAuLJOhaBfx3AD49W4e/w2XnoPUvLbIPEYu
-+zdUNs9RxFxHFm5b63Wooc5hD7e/W0Mwm/vTpD0Gyhx0tC4jwH8voI5hpTyE2/TGTNsFn5mC5WY9hkpH
-DLO9PO+F19jMqiJa6OdoQLV1FQyTfljD7zRXv7CWmXbj+RFmCSaaVczzGvraf8Fpl8IAsw1tHHXxvxRB
-x4j5O4GDsgsL7XPMfiNzmYY6fFdbGYGV6hI2OQbD73Cik+mFVXoo+puh8FqlsNIugOHKj25USXVFXu1E
-dx2EGrop9xdgju4KP3U1C1CJ/1Ke89RcZmG9NEARaY/K+hlGUAOqTeX5zwjvldUeWcP7kJTwfoR3Iy52
-42+6orOGhtCH6F4kwk7EFdEJ5p4k9iC8A3GpS6FPOR8rub1LZ6LzT4TZxxWePbKEAQLZ19kAAAB4AT3B
-A7AbUQAAwPzwrNhOTg+1bdu27UFt27Zt27Zt2xx112AwNPx/mmFHRp6Mzcb8xtPGhybJVNDUxTTVtN50
-1PTU9MdczTzAfNFS0TLB8sna2DrG5rD1sY2wTbEdtb0h/EQPMk42Ic9QEaodtZZ6Qlegx9DL6VtMiCnM
-jGVOsim2E3uRQ9ws7ghv5bPxI/iLglnIIzQQugvThQPCbeGH2Eu8JunSMOmVvbB9j8PqyO8Y5jjteOas
-41zq/O5q5DroNrnbufd6rJ7snhGei163t7x3lHeh974v6avv2+ZP+of5XwSowJrA/eDg4K8QGUqFLoVe
-hEG4bXhnRI+UisyKHIu8iurRGtG50ZXRw9G70e+xBrETcSI+MH41kT/xO9k1eSCVLzUntT1dMP1IFuVy
-8mh5qrxA3ivfkL8pFkVUiigtlAHKXGWDckQtodZTD2sxrYI2V+f0av/W0w/pN/UPgAJRkAtUAvVAVzAC
-zAfbwTnwFPyBbghhMVgXdoHD4Xy4DZ6FT+Af5EIAFUV1UGc0HM1Hq9EhdBN9wDQOYoiL4Zq4Ax6MZ+GN
-+AR+gL9nEjPJmQpmqpGp/V/u/4owAAAAAAEAAADeAI8AFgBZAAUAAQAAAAAADgAAAgACcgAGAAF4AU2O
-M3+DARjE/7U7d3ynLjXiLbY9xTa/fi7O7zHvgBuKXHB2eccZL7DLz3lWtc0vTvqXJ/kV77zu8mv1k7v8
-mTQdVUMqspnMIEybJi1VSerK5vQoMyGrasJU0yEDDH755FvyIzHJHKiHav5PP/Jx8lEmGWHnS7JEwidl
-RrKqpnVVQyY0Ne3RVq/OgCl12RdhArjwECUl/7FlsAKh4yLEeAFjYGYAg/9ZDCkMWAAAKh8B0QB4AdvA
-o72BQZthEyM3kzbjJh5GILmdx8pAU06IgUObYTtjbqSrkSyQuZ2pLj3AAsxiLkvwNgOzWJpT/KzALFZ3
-K4iW7WwTy2OslEEsdrhejkAnE1VxEIvTUl9FSgDE4oKr44aZx7CJl5cd6BwF19pMCZdNGwQZizcFCDKW
-bGoAEQWCjKWb+GWBYvmyjKUArAArlwAAAQAA//9ILLyrWEgAAA==
-`,
-	},
-
-	"/static/fonts/Roboto-Medium.woff": {
-		local:   "server/static/fonts/Roboto-Medium.woff",
-		size:    18576,
-		modtime: 1456790235,
-		compressed: `
-H4sIAAAJbogA/1yXU5Rkzx/kq23btm3b9rRtm9Oetm1b07Zt27aNnd9/92nz1KfqRGR8T2bdp7juCuLi
-ACDAvyUZB0D679df9J/+n/P/LwlFBRUAACgAAADbBQDgEIVhboIkVNSEAQAIg3/bv/4RnkSeo6ygwsD8
-z+v8pzX+YZAPU99sbGvoAABAov6bLwYAQDj3g4i0jd1cCAEAqP9y6v8BmkojbeZgbgsAQP+X4/tHp/eb
-YaW5ofO/WRjVfxnY/wEJQDG38TT75/07l/0AADBi/lHlq7MwsfUAAIRF/2WM/3EKAfZxbGFqaPLPK/un
-2f/j1bsS0uKf+c/79x8ABP8ghb4BT7Gwdfk3K/JPAsv+u59bFLHkoo298b+c2D8N1PWPbrW/vL62hh7/
-7iLx3z0I/wMEHvjJztDW9J9H+0/n/8vhIjXTLTvYO7v882b/eSj/5X5sASYOTqb/zR7+05X/mMSpf2X3
-AEpKBEkykUAN/Qlr0naEN9pwY9amabbdYI812pBfbh5uxiu1734Qz87Wc1l6g35RWzpKJLEsIz/zehvJ
-TWOT0slxn2hKtR6JrOnaZb7yfuNa0S8nOYo0G9Yiq8oObsqsez7Ud+tr0rCa+C4kpeg9CTUo82fvOAna
-oODc8KLpRJuens7Xi9E4GuqCP7GguH7X28Q7H97U3RrYYtmq9N3pWvFdFZj3Hbhy+LmPLTX34PEOez/o
-TNxT3m4lUHcQKK190JNwj31x2XmJvbzunNsYeTuoOwO1wS4zxK4pc5uVX9fqsNC4Ku5qyn1eAeJWhLhQ
-xCshCZ9RktYIbWBVP2ATQmETo7hQzS5hcUnJGejAeErYXvh4FyQ4J/GtUngs5184eRfWP/f+yiN4SvRb
-hqWbFaabN6qela6et6pWaoZWDWeD2KB9O43Md5RwsavA2qW0CqqnQrVXBnWrK2wqvYo2Zco2EOMeO08G
-P4uDQjFklq2nzjZy5V6ycgHn/X0Jdt0De/MwDHFL5ANKMEDBmPhi1meTBuJsiD95cg2sCRlpwFQVrFaH
-vSzIfpjHgHFPSAA9qwMZI//3rK9Vkt4Rp9x7fw07YGSIe5HaJY3tFJJmEfY2nl5oPdwk3azX4I0dxt/v
-6NtJ1zD1wq1D+WXV0WuWf57kmdtdHDHaGPVYNX1aiTUuSpgZV4ovIf73szQcqZscN6jfb31DbNkwzB1h
-bHBde7Fwm+h5QjxmQ+bb5hwDvxjnmYHIW+RJiWYAej+xJuxiZ9dkJMWeLnaRCLlsKIUBP21ASB0kelAt
-bGNulW95lUOeISIWnXFTmzpzbeBSHXhDn8botQaoXkAqEILnCeIrmF+IJSNegeZ9w/8OKdCtHptUsXV+
-hFtAqToZ+H+P4HCTWhPOmhAnaze09OpSJdtmSrQlbQuy4hrzYpZL8pepJso99/T2wEo8tpWpkIvE+jxh
-P5ZPphEXd3r6aMKGwuiVkXyPuF1p4oal15A6eHwWehQCxMFCFFbnITwmhT42o/muwHoG5Nj9laGO0d/q
-vu3mlsY+dAYNabgv3Qdmn6Slwuq17sZfHYvc5et7OJ36UGyM/iRPtrP8FTyOL0SLfefXX0Hq5k1f16zA
-s0ZdDWbMqVViCWvGaF0FjKSsjaWbs7ImeaxC6tZcXRcL3g9qBRb96ZbNuUur3c9mncvrl7+L7GOJWK6Q
-q7bE77aPmlehSWUuJuqybW7mX5prb5lJ+yhDLjU+Bs2GDn6j0TX/bVpNLVhbae5XZ6p9zYUnNsmO6gC9
-eEA+rKzGFAAriJadhDpg6+OkkfI5z29/gf/otlh7gzJnaDpbzQBxHlVPE6ASjiFetAhKXkPHJlobesZ+
-hnDty2lpL5euQmKer9V68GpYzdD+Skhdbromkal3Wl9+qOb7c/kXdcff3vdbW6ZHgGnwF/qnPtjYN2nm
-ag+4Zymf52NcToLqOoCLymMOuW7vmLrit4sl5pZS+PV5D/kF0FkFAD7v1bP1PSfbdoNtvNmaJbqhPtBm
-o7ktXd7Ob+XA+amWWsT+PdEUsVAScwS1Vs4Y/Cq9WH5SzRy9PomeVgNBvZLCWkuBgb2coYNLUAD+IZCo
-8whFxvHakM1SXFk9okL5UT2Df3xWQ+udKq9HGJwpLZzIiVq5n7GJKQtJj6A8QGmcSd5zmMYsEFpWNV6K
-mknLtKSsn1UtvQabRdU8BXtSDeWEr0Vm0ajcilaDR72Sz3rpt6vVjJerSaLVpVoEiLSjJpAEp1SGyrhT
-hnNw1gkzkkarUK/cNaKzhun1XRq1gupJeRd2p1qiu7q1Idt6ohlqvvLPViV0gbxa8g7eB7VZnlFoWZ/k
-UJT6pCYbVimozJpjoJPmuFuiqmkDtKxStOW4pgW61jFlpCukZkFcMbRmCS0Nar20mHrloHZTXdLIhZpZ
-kFEzpVllw6UJhmtWqVS9BlpWZX0hNa7yV08MU7OzNc96ayVwM4lxG4aJZyWgEuk+3R61Hq/4RhyDn94D
-SO7PEJCwEKKIPrj7SSeYDUGrCJB3356o0CylnT/rmIggugLO1cCZZAZtBkNJDPWmHSS//qrfX2hU/RYo
-zM7XyPhBJAhR7D0BgWksY0SiJYI2gyikAm+INCW4PA8gYwOz9PHXkN/Jv3+7olcygYAU8gabdY/GDQ/2
-9gIGFW8Dfl/4A4KM+OLKIfc7Pj46WDgZGT9/JoZvY38TRfuiowQBgxz4xOFGol2Ag4MRmgADBgoQIAAe
-QL4TIFF2GoCQuafC5q2cdjjrobHBqivMJtuNZp7mKQMbb/KK6o3MLGIfHq21ubBfh4JcWHctFM4hPiWA
-Usj752H567scYTFtF5y6a2up49fJtbXrSxc39kusZwSIsIF+rHzCTVRohA0+qV75wJfOWsl6dT5w21fy
-q+kI3VWSSxx42Y6FnxuThNwENR2KqzF7KZwxMysuVyWn4dwuf/UvrTaPmf3sSHfa7XaPo32wyBF6H46f
-RljALmZWgIyNZ+5Hfyo0GpVbz8xihUqtUCE5gofiX/Tq9Ubv0QFul/P5aolaCV6r1XAl42E5j8kDcrl0
-duNllONLyHSpXiXAqEGpJDzQC2HsC3Huri/qvhWqGL8Yk7YDnfZcyMwcXhsBH6YgFDhJriHkQdkQKPkd
-oIQxzGzSGAjBEMn6tNfrEMlm1/pxD2eu8BVdwBBT4ACDN1T/joL8LvUUirSAi3lEIofUWf3chlesmece
-du1s9K+JOckM0rPk1Z8TqI+S55HIYBAViAO0fsP4FajS0ulenODAUZ3gTGJ7FGiSG4fV63j3H/AVh4r/
-Sg6AGjAJiAfk/OMS4ARoAPj+61H6AHmAARAoYPDfPuq3AQAVOBOA+MMFgPtOBSCDEAFgQLkBqKDRAESw
-OgAqOCYADbwXgAisA0CGAAWA/d+e5wGkqyiWQcyEKu8QtgWZ4sNJSvChRaQWNbNLemBXySXYweGWotFh
-NOLIKaoj5X3Y9h2wKnPbEhJ69+v81YfgOSFj5+0NBWISUumHOqDHwYGx13S8b0wSJqtNXcOEFCvx7oHA
-eJ7Yv/sboDG9H3pwoqkOlZfzaj3bnaeYeGd9V5qkwNk6yUxfOamJDfatBUZrl15Rirt5SQZk3k3HDdyc
-xLmIilWaVFZnA6NJ7UhNBjHzFAWu//Mxm7M80atyvPuX5hF0cT/g0MPGGgta58DRJyt+5f2+psbYZUex
-w5YRUdG1c6mZVwcLmwMOKyN/OFh5b9ecwVuYTbxoOBwInCxZJE5ozYCoBO0WCetlsCD012nHtYiEb70g
-2cl5T5TIMKV8zCNme3O6TijWYoxL4DZUdVTSK3ByNo3YPcwQxJ//CuW/9gz8r6MC//wAEDyAqjohDFBG
-kAV4O9wptGqoU44CI2ltFsDQm/uAhGG6RE5LNIIs+0KMZO3zW+IECRke6ekFKfNxgHmByRnigm89LJOC
-sbMEe5ffN7YS4+URu58VbrOfizqy/637zDUQKHAgyGRSCsg8IDxQYcioFJAn8KD6GhbFm2Bit8XbwhiC
-oIheqBsXdyPvRT3cjTwIe4CtoGQQfkP4720qmXGpyqzP17EzfxF4VXpQKoy6bSyfQjO6yiUDNFhIrDgD
-MapRalWnpYGQ8kMirihg/yFgIsiOXwkPQ+JSF+MjbWTgWSfnQR+TQ76Agf0GCguQUgHI7QS2PqLLMewu
-lK6YacF1SBMXq8DPSrcWP4wnc4R3EYqGlaoQm8aX9fIxw2NFOR/iDKehwABee7Ymtch0ull6JQ/HdruL
-ZdcF7SZg2KAQAUcRuABJrpQ8F5JZChj1dTj6Al3uJjH56i2q02w/MXmBZ5c+MwfJywDZ5hf3wvNHwqME
-H1zOWDyAiADgDoY7hjI8JGQ3vznLhc/21HKApQzRnSSBnXgtrwCECcenURd87+h2kNicNVPIDZbSCXzV
-VGAmFcgFPGJ1TnJskUfkrbY/IivPPfSBhkksi4U9kW5o/Ac3MOgr3fYzFl+O3edthF1nY1NI2YuJuXFj
-/priXeOR8auHzLb58DPtm+Q9HFaIoFrEdaVQQVLguSXHpy0Gts9VUVeQsayqPa1MC2SJUL9GuQscr9ca
-vLYQTtdzQByn+b5vZE4cF8dH1ODfV7DecJmPj1P4dAlkg839L9mGs1WxTL2a+3tiPNOrwcGiwaT1cksq
-eGfNtDV1njn0Wqaw0D9MaRHByzYyUrKyjsGhXAkqYCOj82Y9uqtPY9fGCsPPNxxOoIGmQcoMBNr+z1aO
-fa+ObRb3HYXepMc/TW/ap084SP5o8lrcVf4te77csNlbzhsrJQul/fi52cz+qAGgIBPXMg9FLIig8XGg
-VIoYx2PK7zpR/FI3rGj1xqgC6vsjqtRL5W7meB0Sp+NrCYI4UEkosuefkVnfbmsBWwPGK4cOCjS/5apY
-huUFRtX4Uo0fnZifHQZVeZaEUkHwOun4mYb6JXISlHnincC6PqmnP3CGGamgtMDtcnsMNprfnJ9t7D/c
-gthtSxuHh7ecj6/Xha+vV7e7hQZSrs45LGQc+L+Z9X/xOW80e9KZ2Ww1Cj5Md9w027zJr9hNyUSa2W93
-1r092uocS8l1uNpttWul0ld/2xDFWAMBuxTjskiGKAIh56FXpViT8mC2WJ1mvxN7FYEEaYiRR7AXjIuR
-Z7H3p48OijEJxdGcFaxKhVcOyr9LO16aKHezpPWvURFvDsobhkIuayTpxTyHq9iwaqRwd9lIUB8XK3nu
-+RjtF4qVU8F3LRvJzQ+qJSUsxKWMpSBaScnIqHGuuC6W3rBTOdX8uN/sgSiJfv3ItonbuWvLcCNivHvO
-QzpRs9t7Dc7O1cO1s9ttg03hdNp+hqtNufl4mvjVImw0En7cpyTuZ9d8r7XYZCvaadZe9qkEX+lCr4sW
-uVZv1BMAAuWFqaB3uWkuXbEnqgsUBQ/fReZhmYEiAwyoy0MElh7B+E5Nq/tGV0os3MHX1l6jhlkjwWG0
-u+YK5AY9zg9H/lrTbx6EufmoOj8TJDqE8VjwWW17zEHOJ2yeS9fEBY85YxemnBoLKv7hbyHxrYnnW0HV
-/t40EBejHF4vUi0kEotNTJjvjxKHuea8u+Fi07jS6qJsPl4cUflyGMg0IVxbspj2eNRPshEyPP38hHbG
-iRNmzg+PZoVuQRuWkYcM5TQtIsYrHxXLpwzMqHAojclxfXZY1xHOIOUMBo00mYcWRrYSoS/NxybKDckb
-Lz/sbyv+ODTtH7reaFByvz/z5ulILE2njPlkD36aR035ozYIggiIFyQQz50SFQzilYkpl69cs6rDqqJS
-DwK5EU8cm/ww4c5w0q/bJr2a2860ICMsE4QvgG2F4IZMdf0GisKxeZIW0ptPShp/TFAv1BIg6aJ+B8rf
-OzTo0HPOwNtK9ExWWsC9cMD4RIM0AHBnMt8v2zR6bw6pRQXV1SAiTlJ9wPhGAD6J9EIDelOxNUTWigwv
-gDu9xGV90oHLsiOEM5utlhqVMtUh10tWbLesfHSWWegzf0O19JDnTT41tz26RcJxyeOPPMWeWFeAGGDq
-uelHlVZyQBbkpdOqhpd0sYSYOtRq+3uVCnQtkOTbLL1fmIIonzHf7J3V0+HDUHkVkqxZSO9bS1nvclPJ
-RfW8vH6T7G0TxF5DDRmTH8cgDctN4pPs9h3Scv2ZV6xWToqZHyNz4nqsPPhQiAPBmMHjHhBvQjvtLfrK
-A+JwJpWOfKzoAEHGCbBIBYsV7gXukPzCzhSSlnLYbaAwgatBoaHPfl1Ce76MARiJjAdBWlDgEBfq3e8I
-5lp5FMP09fqSBekte7ZDqtAHZqCoW0NLu1qjWPUoT7giNETAyVQW6gx4myBMpuvx7pUe7XyyWQJpRXLY
-9YVexm4gZLaT+i8vZVXqm/Bpy5kBAp9dUiaT+scZpZfVy98T+hGlVcNr5ZOVIHFAXAsONYOHxy+QxuN2
-SnKziFxcvUvgzo6kc5DSaIhaqKNfcmRGLk4RCiXm0PXnbH3XEGwsniOYWBqGYdOUs7xdj59O3kAe7qXu
-EPQTBSJLTaOz+d2fjfK5sDmcVr2NvaO5OB/DFkDc1CovszQ9XkYGvu7vh7s40seMbAH1PMm//CFDU6wK
-95RwfAK45/gEY637eKkHeanXFFB6fbW/SdhB/0bTvZEeCqoHqa/W+fvXX/jS4evvawfeR2t6Z/j8zqhB
-7Ka5Cv0YYvJME2/Llj8LD5XlYiZ/JmtBuiJIRkERxRGyoSPsaqmyVEIkkbNOJeHDILMl7cAsShXhw4DL
-vyzAxcMaH2NNL7NCpgyLPlbM65RzCm7znEiZuX4WrruIlA6CibhO6vuZjj8BegmkQMBOFPAXPtguf7X2
-ebBuYMrm/Toj9/ye2Jusz53MbRo+NuCdKjGWSuQPUwwPAWrgmh6HZHoo6yNZ7jY2corqHAY9Mk5ovEy0
-eXbIjqEa19GbZI8W/3BCFawG1wTjBljpJKo8/RH+SYv0125AyPokoHo75n4DMS3kf+GZjMj+2Eoqnz85
-Cbk4IY8f2dkmRVA0JnUVB5N0a7pca18qHREQ88tc2+wEISyZb5sTbNBG8DALhxNUloNuRQMIs5d4D6mu
-7DF/xtCIvopUfO7r3PVZAoBC29jywaoU0YYn2q2W0Dj6sbQGY9C2cFkW+CepBdL+mJhmp3+1M+s6J89p
-3oxhENOw5ISoxrh4k9XjX6c/OQVyvHSupKT2EDMSxDrPPkgNFr7HZIpK93zkJMniL1Bc3A3V4v+Yr3OA
-vXGQD8Vw8zJfOKRoOg+mZFBBjOS+np0crIUlWtthOb6+P83zkZhPTWIzuTK7PWUn8XkoysMnJkQ1YBTE
-x2zJjvBLLg9tbJIgPNxt8VrFR3NN9v5qGJtm9G5tiUXQPFGPG07FWGst7JKQpsOU38MGgzXjk2xGrbAE
-tSJS3iRBNSK347y7eLWGsSeUXU54mmu2t9+x4Fpif7j4pGr9YUV9HEjYKU0MOhRSSRABhzwJeaAskqns
-/qHeZHPeVqekCTpbrPCjdQsWZUwBWPRpqhO7FK1YK17wp+opFGjYweAJgXiRJFa1GBYyqthIbKBjNfqB
-F7bk/zFqRpEySwqcpERroeEj7gxy9qcVX6OYezXqrUOFQ8aHWhHM4yMDxLJFlnUl9Q0AV+uh+3YCjsMh
-Y6UF0N7rBIhuA63nPA388G2Q/VQX9usPoOmgohJQPzusk2drH1F431IlTPqkGnaRDMo0nkvrMqbNOFfz
-Yqsq03ZzpqDFbq0OsRVw7mzYaTyIHSgLvITQhWbsFithdfPwW8F3d+oJo9+FGOiCNwQtmrCre0FX8Npf
-LH24G5TFZ2oGIu+CFk78tfnKmb7/Lm3AWRLu4F5lNV1bFL9PfcBe7njeFEfm2mty5D8hO31XpxzF+zLc
-pxx7W0IVCRun0fYsFVGRnNF1LYiPKA9du6wuxUBZv86FEuDEH94e0MTmllUX3f3NiuGLrB09y1ufcM6P
-0Gv21F7UZB/cRpbpyiCFe+icV462cUAXGviFTaJ5IFa1hCm23GDBKutJkRE1HBFjMhfOunnDxARu2Q3d
-6Wnj7zuSpT7Bz3gOIx97zlytY7tQRcTCCu6UkEVJqmGiu/tB+eyUOoNDvtzDmxWH1vUOCrotupDDLyFy
-pExUpjay6Me0lHhViGEwBXPfE7ve6OnjBzJPWzO/+rS0RuBWWG9hR6T1uovQsCdY/OqZbOXrfSdAiYQ1
-900hENdn0rcZ4stTCFFCE9AHSciWkpUH5m9R8jy+FBA6jrT0J20ioeXXxPcnMYpF37gNLR9MqS6G86hz
-1iuMPLF/x3LB1I9Q9hg9NJZrb30rB2QdsOBmr3EgY3gILRZNChTLTCKeccQiu8ZVkFYADw16hS2d6kao
-R/gdTH0rHlPX+MKfO21P+CA2rcdXpnD2ckgKsqQOZhrFO5ZgCfiJdPGcHzE/CaS2vzcJtT/T6WyW7ypj
-ns0OsxRL+8/y3+fgetLnx7A/Yty8Rr2jfgEGkE2EL6ftkaGhDb1f4GxOBehqob/LfE4lk/1M+AHy0uVT
-g5C0zaLX4Glbeo9djPdTD1uHa8iGWMw+zxDSsAxs9PqNlgzbzYZHFYouXxzKDVBLvAIvyNI78nq8769f
-An6Zbj83a9ZAjCefK0kPQAYl+ALwf1vf7PxQRrBgQPgWlZ/B35gXJecgaNdBg0Tm9pOiOxQKKnA44ifM
-2e3f76m7VSm53BP6I+kYEv9uDOnL2An8VB8EYQRwitlyil11AudqdHYD4F7egVWl1RYrfQS7LkuV68Er
-174oNh1tRySkdSC9dW0dNHhzWTsJuRgSlK6/ree+XWdvCTQCtV/VvqwX+dY3v752uXeR+d2eBqpvHVYg
-qmqfAwgTx0eNqUmYbmtwEXFelMWBLRiAakclewVGtJxkA3pH/9RX4taBRpdBxuCfZmnarI4PhYYaWU6u
-hsduJHbcnxw+hwFXhBVEKMk+7qiNPlx5hzC85JyLdvclx8BPbUYp00eLVM96x/qLr/fgR7UpSLcXnqJA
-lxY58KvYLrIUR6AtJRRmiGSDb/I+TmIjTGLu4D6exvXL6K7RY/j1oM44ysVl1Sg4EgjWAQGqT5RsH3F2
-7b4pIbT1taX9oSErUrcw/IoJRALea3BFNLy78EFmwmFUwfjBj0OTQaKoXu2oqNqeL3aKb0zh8lWToTVy
-tr+tfJ6zfjHUgAqbN316TMbXMnoeZ7nC/mp4Nu0X7cVqELeEWzaxdgAvFp3QSgytgtRyPCdPtQZaj0nQ
-7dn1culueIlzGS9ydyfaz1D1WqKb+pOEDUXdJZXRp2ORNsg9q9zZ4JJ0AayLkccrkTjG9kjJtqB6qSFX
-O/5ZcecLSkyAOi7uEq5dnFyeXVZM5+8+4V8ggFMHz2LISITDPMfS/pu9AK7M0xL0rDHXJSaPRadB6fKr
-AOQdSYFBi+LZHS2uNRVVBr07ZmN0VEDwqq+8VISO41yNwlhKKuUWBYVq0AygtNbY+qtNll1GVHeMRVqy
-HhM07cyXKrTGkm9QMZr6Xu0KFcurdvnHSg/MSyJIfo4ixqiicRMw+Ad1rnJKEM2UDEelKA3tsM/ce6wL
-YRZiNBicaIH68N3nYljkdfS4+qPeJxp3qwmGrXP+jNqq2RqCy0/NK5CRqmPliMnz9275BrTPgLEtSmPy
-l4jn436tOzj67zCsbsNk3IBgDbW6Xtj0MJdhWjt9MZ3AZJ1FUhrt8/6lH9H6w5jIT4ilrL8WY6hO+BId
-fvFZh+NNYT/0oxNa4VrTxbJJxJ64RoVZjwc5NBNeg2jliFhNefWSJP0i8K73+4tZi9qNUNgMzuNr6adT
-0+FYXAzsCTBPmVxbtFsdsfVJuJvte4j8jaY4pmemTsUjZ2j+YOVr70lxDTs7XQo5Xq1eibVYMI9JxbeQ
-7tuC+o8lxZiBYkNCT/DtIIqSntI14VJhQyvjrb6YJs0FTuwHeIfNMCJUzPRZgRmJAr9K4zhdx6rSDxl+
-TbhqOX372K6lo670BDqcNTKtqTDpsFSX8WUocOWAgpiKkKGXd5PvR5ZEW3QzF3BBElRFHmv/Ms2u44h2
-4v0pZlmn6K3O1ow/cjklLWPmPhK6mfCZ7uc6TDTUL1jVqSSYptPltb7vRCubKPY7bJG4GP17VBNNMJ0V
-4vQBN2GT5L/9dpE2oTIsN3G5YTi4a6kzjPvK/jHfOeYs4XCFQMjdFqsjYlu9WKFZqIH1SMzhn97kkvCI
-PC+YJdSVDL1fiwEkxH+0ovQuy7eYeFZV/naUia260U24RLx3KwmtdunS6wge5JHk83EJ1qbXbgRVIXkV
-eUAPJgGMD4khk5jqG2wFYw7kmMuXYUzguqmUv26sQsXTJA1C2oqESDMgeCvNCh/S9v058YSaruR714WB
-d+qMtAg6u7mfUkUQ0hHk661oPev/rMM7+fxIJzM+1pOFnBikLPzDJTtK9PajKZylMMrnVJnGKeMnbriT
-Z2gUiYE3WJRwU57DR8sOApoMNLjT+jKnJZcy/uGxRGxpKVKpSMyMzDouBYDjYUodnMpI/bnlTYqiTkA/
-RX3L8ZGqP7s4ZbWMoYbO3TfQgNPghXf+irHfr01PsgQatE6iFigrLhlfaImgRiCSzp0c1rqzWMQPSXQQ
-pqUERqFNhXfeGtrrt1oWgQA4ArVYy/U9zHJoumiBTiLxdNF60p+T0nWVaX6ghvKnzbn09RQh/uxBoxkz
-/FqlZO98hbqwJ38D1pilBaYbfGCNW145QOskS88QP77Zh85kjze0aFJ99NlQojuRtViDNVT0JAfe6MqG
-9CLJ/Jkdad0xHnv7FAggjOuvy/SshfpcGb0e3H7kiV9fYmd6HDSsA2vweU6hyxDzX+GsOBt+l+vbgaHy
-ySYtX/4zUoI2JjkxGOdKjHanimO036rfHVxxLajWjcyBAvG9rIaFCGkavcoaAFDSi71zzScNPayixyOq
-4vXLmlOU6Qx0xXbTEDMQdIUUxoImbWQQDUdzCPXWuv3EOCBOBtoCuwdNH6IZhQ/Ip3jSlaEERxU58kux
-2Svr+VfiQtClQ8mkybS6DZmbHYQQ6lpBKLw63KtsYA3IS/LpZzX7NKc0ZyjNOMXQeKUGEDKXJ+QUZAvM
-kirtw7ipDJiCtSehaK9VUNsJT0FG7LccoPIlaD4eL7K0sh5uPo0FYJJ1a9DBHFmOJ6fCbdn3bjt1wzLc
-sTbbDGPZtmJviPcm2rGeWb0vjHJrprhBQsdUZ8itAcKUKxBj5GQMpsqfKNlbB+piXUpPkr8jpvdKNUiu
-HZJze2/x6JybsHxsdz1pqoX+tCT6czvTstyK3zm8Xc4MJTovs9k0+m2aREUXH6532sC1dufI1iT2NMmf
-+Mx77D1nSw+Lv0ZO64FZ0Im4RHEboxQ/G6jKaNzC7J1dI4I1zk8Y6okyo/MEMkEsW4MYd0Jg9rp6H/1p
-GcyCWetRdB+8WUkcytAxsrvJKi8zcDfr6PMOzRMlFeUVSfZ/5yWxH7kmPaEHTLLIBIITYhJT5tLFK9lR
-oVDmsuntaiet57+IrQcLTpv7GfmwV7zaKB3tlU+Y5v9qZjLst5zcLsVaJVzf4eti+fsqbrD82/glAqZB
-o3vBxHRaNesWeO3BIRmD0Yxc2q/hbJbWxfIdSdzTXNoTcFSHVWAYijt8h26OSXMLhOCo1C1DjMypT3L1
-ESOhFzOI9fEuHhMzaPwyJL6+dqZkgsU3BkOsFIr7qvy45JBg8whynVpxIl5w/EuoMRr8bzKZPSbmqBOB
-unZZoPcmkcDRz+e3/4/DEsIrp/vELVZ09w6nRQKQ7jMORoSNghE/nY6oHQqzwfM619uXBOQFxiEOxXFH
-F6aPnHGo4imt9ZRho3ntnVbXSs+NQTbxA2+XKX49NjHBaSjxDe+Ewdy8FGOmVvPlhVzm9JX1qrAKlelL
-NE7/pS/ICxp27Jvvsr3+mejwm3xRJaYkFwEQGtXJAQoyFse+1jWcKvCBVqTS9nbgb45NaNl4/FCh8j54
-rsFe+nGzbzyVX3PU8RH+YkXzm0jeyhnKX/skutn4qPjjsK+/eT+oXlJbtnH+OvYH7nnHfN2ZqE/fH9/Q
-zmSGoEgrPVlqkbnX8OOtGq5VYYHR/VWKLsWJkRXiIMcDAUUBBPewAbwsaD86vvi7dMHGK5UtqPkGYdN7
-WmqkXAfrV1MqMfF1Yib5Arykh74+aK2VftGiTlOs1by/3qZFnRWTYRvwmhfUibaPV55jf6qo6SbZY8Oy
-hEitVGl4ZuMQ36Euo6FioMz7mMFEDEeeURbIV6q7zms3m5w9fxWxZYh9wAYzWlDBpDi42wZs89SoaRFy
-N+mNAVdAYo7alSwzSB69W5WVyO2xkHicprVa+iaC/2GjgDx2MpIOtdk25szPpLK7jQvBpBhUhXvNo1O3
-T0Z9MmscUghpfv1iWQ+0i6KltwuqUNZwQOWWvs4rmDPpApc+eRHDk/Jd4RWsAwDQLHohUhu60VONqsz+
-/jFgDjALXI3ZTqSJ/OVcrhEzMqFSfUaZK9tDQfBGvU0s6liqY30ckwcNj2COHxtC52nXsRVYNpdhA94i
-WPC97mjecxPnfPWXr7X1pG56YKpMk7u5yq8+jd9t+XjKXKH9fB9F5OPJYi9i8CTmJIUffWFiBRIvOceK
-OqK700+14jfogvgtjR03NDqqVCJIJ0b0kPlSHv9650JZYknryO4RHaXpm4xUNw0YAR63gzpVPmhbtY6v
-tBKkqDAKF2NFYEcE+yYP/gub7fawjCoPFDmZsWV9cAMrQp10xz2q7wdVT80rEBbwbJFOpV08xNBD7rQO
-J5E7kLy2Pnvs5nf1NUXxgxJdwYbT42B+NEUMM0LYI4p1uyUOWSamrR2CRFc7716xdDH2YszMD4R+GFT7
-0MoKkvW3lzC+0mfhT3iQub8XM529aeFw5fBM7zCyE9uGGrkuAVu2uCgDay5+puh+Ce4eb8lBrwvuZBBm
-/jPhtg1cUpPV7VH3eKrHX5J8C9/MPTSJ/cvvyd2bCJaW3Pcuj+FXsqkMOmwBGApk1WKDwUhRVW7wtVw7
-fm6orRmuqiqxzth5AtApE12H8PXuCliNJASDS1jFpeZz4E018CPtbAamTy25kZTH/Y6Ma2vrEZiur5J2
-6vAzFHRwkMDlRYlOQW/54L0G+nLki7elsRpBHLRWFv3fJNRpKyAXqvBSimk5Z3W9edBCnANaQgwajz7m
-1aNPmQZsWo6hpRUpCccwvYw7/ExiVDx9AlGvHQ+/PyDk+ajxl5sGdvkpzUQ+KEL+RgzvpN6DP+MEI+Ow
-VeuWf6SA6We91mmJV4K7Yndu2Rq1mH582F7tsBWSAYB7Gj48shYhhfMTYfX0Iv/AjzxG1LMyiZQUZWul
-UVScy3zy0l3PMqL0d6Ly75lF0033+mCCrLt+elS3VKpebaaYcZqUI3l2zzTVC2X8YKAnBULciGgLxslw
-fcvKnmCP2w6pVSyLJNdC3XHebAYbqEMcWC+jYEJwFQ2QxkOXIHLuRzn738V0wUXYm8q3uWATuLJ1qBKe
-SmNmoIAT/sJY0I89LhOlO0djlbgfVsQ9HapGZr4RMHGjqqCzkJ5m3grrhSbULSJY0ox5f6vFuOcw5AKC
-TinM+gTpPEUEDT1Kwy0wPgG5/2rr6Mkh2eFbdX5wXsTfoeGKvGklHnTRYP0OmUOHIdwsbiyPz6K0J6U4
-n5KM6Qk8fO1BH2NiOqCDvAyfI0OVfS6sOZy56SZTOH0DQA+uzBKu8K0gWOBqBaPUpRciVIdYJxUgeuiA
-dwnh1ZabDfRSBHzoE/2yIbsBhip2n8TFhYFbTiO4uwRHliz9jaCMvXyajWRKwhIP/jTMIbM/RnKAIZz4
-jGUEXQsBjiivCL1IqbD4A5RkTm2n2Q5L0WoHdaWQrR3bQ/cZ/qQRVjUsw/bUB1zeEE+ytAv7CGxJa6MB
-fhsgP0Ddw7sXW6S0+ktJstxWRkpeKp+NEB09yMoSzCsN7IXioolCAfojm83d0m3O3+RJcPHmXOF+Zuey
-I70zbzryxu986SO1kuGckdptgcvzhaBSDekhY3urIYR3DvcgeTDp2EbambNYKyL8kBVilU/sMMpMxAPA
-INAwIY4wlTDXa9lct+iibSQNDYOi2dU5gKsQi22bpMLrIOiyzEuYy/209k1wnoK2dbb+9DJh6orBaYfH
-/ZL4uZBO0oIbQIgzhWgP6VanyMVE+cu4twtxGky4jkdEFVpm15sXVK0iTDo6hIqpxMHagCNiXzKmj9YG
-yR5XZ5H6p5D8j9x6Dm9dwVJVsVxChgtS1PTPUro53rLpCIL9VBTBAhjjJDnNbJiRDiIhKPaodpdQPGiF
-iFDxIK91+n2opUq1QjoCyL6sunzUBq1ND7L8eFyzWOEwnTar3SJwLTWRsnb2cvVFUZPd1EsYaPi3MvGg
-UCiuabSGxkRFSh1Dw569A0V60hQneHcP2SJ9GM7nFpixUrdmmPjayW9LE9vDD8HN8LC84gCJD3zPDP0h
-lolpX7+7/ZOTZTxk/6Xjx/bqUo+ROnjnnorbj5s7ymfxkEpK+u9SAhqflmZ9dq6VwHx8yHdAeGLxAiJM
-0NEo58UEEybVmvhOZ10L1z1NgjFpIc/NtdC4ZhJaoXso6pjheIt4RoLyW02+Ns0QkCabLTKbulSTyoFe
-hj6MbgG9rYgPYvLmWZKq2kctmx/DbEWHP9zNgnEZfPFezTTXjV5rHs7WxWyqAv2n35fBzt5CGN9bgsbV
-VsJ6pL8gqmrBfDiNhuMHRL1YXrPuO3FpTmG5I76kSG0qSpPVRnKRnHQhc1xK0jBfKmtkCpqzViMla1R7
-W/micx6brNHX0093nd16P1dQQwrtr8bjwXI2GYxqTKbdL7Fiz8KYLc6UZNfH4/oqJDAVM/r0lufJNrmJ
-VFpfBu+heYRlfc42tz1lXqobZbfp4jCMv38ZGjzDAYgKs0sE4AeoCQOUY4a1NvBBvYwcrUERh4jFSEbm
-HY6yqPJsQxkO4BvqZPMyqF7IXTKBIpvzw8umdSc4P8RgPqXS859OMoAPAi1HdC+YkMRfqKLsebAN+UlV
-IiCn+qBdqESLw4m65ZUn/oILaUsCaT3Y+gBY53L4iX1uWZg92cjyBDnaO6XkGuZzRMZSh+tqnZZRFKKK
-xxQHeAFTTgapolM/otV8ftnUA+zOKXGMUvdlWmDpFnMFNG/VLU66j+Yaqw/UGmunzN8GM0MBBm5K8up2
-kssTS7w7YuQaKu9xibwYNOoyyo1eZc2dwGzVne085eBm2ipSm3+oW5iImP74Rgxduu8H4jmPJu7kJhDk
-VmXzGO5dkujjTqwul1Exb/lFAUemHst5CSBMcS4tPKmNsJNA1HxWDXs7z6VCeMNyN15cvqTOlXV2X208
-SztHvVNeGMwctgU163+eGR0vdme3u+CwPw8oHUMnk7tfWinMbfzleTZvYPhWqJPC6EOARZzBJAuzUAqN
-IkPw0BXVKgjnP+IfwMKcjFP8JiqUkl8o4ZKbbSu53FQ9z9hsPMsrkbOmokDMw5k6/5E9IUOmFqKmTTBe
-OGesGWrwTb08dahlhsjGhdAxXYrMWrpidrttMJQFQxkxyiK6qOBJSkj1zVu4OA0hxDv+yKijdo3gWyhc
-nFEvVezUfxNTL0/bPSlWfBVnaMKxVy+hyWevsYjEqN+mHFZjJad8AvEqzhK8/BuWxenlsxWqa+3tfsPn
-olmLzzd3rHa4F/p0OLfONhqATbxwjhEbk7dsQW3legjJ5XFO+vYJs2n6VKrxEr3l67ow7pd9cmxyMnnN
-abBshtplTPIWf0kqYLcWs9/8S5Cv5zPBhpxk7PjhRFGhtw7RnxyTE4Km34hLsg8qiNe1LD/JOV+2KiRY
-ccirnUYhvne9QUBASWcftjivOEzIy18vxE8plhk7A/gJ7XHp8enR9rHrieQp8QnmsR32rQ4/2hW6Yl/e
-f1z+xmmatKXPuBx2uIs0JJU1Pv+QiVW1ylFAZGOMi6X5Gpu5W2lC1/t4+oMywUe5XEbVLLPm1WRBPjE0
-NmOX0mERFp/d9kxWebXn4DEC6UGxS3vLMtmRI41hErlZiNjHBUEQhiBfHveNSpCrn4oIiDT8sI2te01N
-bWg4/OBcktN1mk3GItAprhVEVAx/i8fVSojbh/ajR23GVjFa7FIzkgDvHBq+ic9Mlundk4Xs01Qm5hlj
-c0PNGMyf/kLVzPipXtUssdC4Db9+Ao23R6EsrurwNXtpNSJuoQt0sSiBhyDd5DwFAzosJCRUa7JZFDyf
-tM01ujqz9lUKXB/JlcwngJh/M32lrHnZX07xoPkCvSdxjteY6HtbAvLniqSoaxQ/fyHr87KlUMLewmpu
-xH++ZOwSvHxqW9gGI8UE9d2Bzm4Xi4e2zLophL9m/FVnrBWeERXS+/bDhd7FILCdubXS3KdFlTKTn6xK
-msRgSZ3+RZ4ehcCrk5wVdmrs0+JptIqNIFbS8yR7Ep2uuN+VY7mqrT7mkE2hfKtkqg49IJ+BMF+TNgQA
-+duMFq8EvsT1feXK1xm8/DIItcqwTjAAlWwP9qmbk12zE92VZKObxHp290bR6Qw/v5DxQIYZa+oTWY4y
-HwQC9OkWm1ZGohe48ZydVxiPqRy6StamNsD8zQjyQEgHspXll1/ODfCb2NqgQtbN6N8fyXJKhD512o7l
-gtP68qYFHIJtdvO79UkgmFlvDzn+hFD28oR5TtfPMJUdHKaiDU3BuFCMA9t5wzLINyfYFUG9b8cqd/JA
-pT+0Qj4SIA1hBBbh3Hl+kpg0DEKUQY4KB0QqpZf3QttgBw1zoZhXSpdYvMZIB3wrMSa5jhZbcRMTcqpS
-7KirjmZWQRnR8PYZ3R4DWcneGDNrjq/ZXvYruVs6uGs74nwjvxMpYGdcVfWSuIoNbIyktZP7wqcGMTOs
-7fqYLHB2rDEDlUILpF5CBGkVTyBZOOn7qW5V/yYbg5s53TSCj6IJ1kURe9QbcWPm5+KaQKtpSFXMWdwA
-YwRhDynRYA8zzk3gQc1To1jt/mFyf481jrMgv45ve6re/7H0ZsidfYYCgzKjz+IXYkzJuuImjmaNq6Hp
-8Auu6dSuroji4yHV15+kILlVgX6BVX1hzB2y6mXh2SSfifuxNRnyO1xfasDAwzxB33OfZc9H8bVLuzzx
-o2++MfW67CjDgd7V6d5xUqpcq95gs1kE15e0X6H/omvnARJCtwyeDgL0Keosh5y+fWF3YK8vn75Ybc0k
-BjX2yYoqKtYhF9IwxxVRdrMuEhosTbOtD09luoejKBemwM2km9cqb5F3zbgF7yowpvCDwM1u+LqXdjas
-i+q1j8qw1Y1XWax9S/ic8mLbFiDI1rbRYjAeGmmViWO4EooKi2iVEs5xH/OUy9ieaVxDn/q76HGO7Ps8
-l5W2kQm8cErz3Q2NXMRcjZ3O1mCMW+zQi1z+jJ2pZOpUCei2CmEzWpn8wH9JmMIZdrgzl319xm3MsHGb
-CazkUfXWvADizCR5CKH46T7wa0tOmiihP+PQ2fdYAHka9SHzIjdnvXGMlhwfdLzgAn72SHoiN2uEog3e
-i2S/PnPyH3OUzyD9v9X9ZsjzgQBd5iSLg9arfPgzWVsgmNiJwIYoM5HreT1VGhUYT17mjZmx6u/GlVZQ
-9Wmb0iqZ3Tr2wRw2XVALHa4k0dFN2C0LJ7OU17La2ENZspM9coYJkc4O0hP5fBCPpzlaUQTZ7Y//iurV
-5x0LWV+ohrzeOv9ff7PcKKHsF/RsgVZLEfHIF+XZCUUChzUVgC2HnoBt8C9XuC2ciE44bEXENH/aqVSQ
-pFzQVbUOaFX08VPxhyr1PupbyanBnzi1WTeabGThT4SETGGUPYkVUZCa03c2VP/bfaAMxJD2uOyEB1gs
-t9CignwPep0T270MB/0sOCBFiNUaWt28XXQTf7wP0sjAmOy4ntNpR2mxBvVHd4bgAGLqFchiIhMhyUhF
-HdVY1BpB+3wvGkkigTgWC1KrKOxa4pHX37g6IdjxB7IUCAwfqagMfKAwg0KmmMQXlaq+VBiXaZqg9pTY
-UYvOwQvDCvI4/m/BesmwsSY6KGvKCo/VBGZHtJZ/wNUfx1nI1hkTUa2EOu/VKrKD+z2urHnpyLZbHM1q
-bWUUaoXz79OHtpppfzCT2W1jctwyRWgi7u6sBuftXtndtRwv1BAUCvPlagpKvMEZ6Pml5Fu6edUk/VPR
-MT5s8TW8SJLR+ttpDEtvpfsVT8mNndNwMiGVuKS6LZDuIV6Gtw2OVBizadTA0trD5/MXsTY0uzhti5Tp
-Io4/7yiYE8KGFCIic48ZdBmVnBI6EGZ838J9RATI4kbPOMzvx1faWDlaxY1DrWZlmtVq85fL1F2dlkZ6
-QWXMTHGqf/VV7i/RUi11O6E9LYO3dCEMHyt+HyMXiwvVv9HWqePi0mW8Hi4Xk+yId6EsS16/fq3B8yBb
-qzFIXu38XbuOUfFy56SMwKvl422o7guoj06ocNViqn2KEZFCKqUhdbFVrlzZbMNrz7XfqP9hIbenG7+2
-NovAK6taCvVyEBp+wmn11O8Kx7souxhZ1EzV+QGVrjoPRIOnhS/qDhZL5m92IIpqmHANOHk/X30ZWq5f
-MVxicR1fLbfPnrt06Zw+4uYU0VOEMB11rNDGzjwxpHarryreepig7MoqoK6TmvcAs/3DUMguUxFyzeG2
-sllXgKMAihGq+QUXRG7Xgc7azr7heb1nP/LhdvO0jbKS/hOd43idGVaT0r709yS6bKdhLXl+6cy5EgN+
-YgKxAp52trC/ata/6Wr/V4ed+YOeiDcrOx0PqzU8eY33w7Z+Kn5Pm/xAkL1aV+AHu/W/F+J0fUQWN2ID
-TGnTivwSbFERtQhaq0o198KG5E3e2DybmLp3chbhRqTrd3uR8OWv6SwH+07NGI0r4urDLnCLeiVKMxtD
-H8NJSRNG/ERG6F0oKVhaOg5kYWPmo+XANwEFLG/L44b6ppJfNi3O46XVBNdbK3ZMEZmLduMfMBLRORRa
-oyzM7Qtm9z5rSbknNZ2JO9Ybg1xaB9xqXNm8FAwTaIZVWuYX41Q6M1dGDcugKZGW+2J6ychA2SZKY98R
-vMBIyS2SkPyZYM/n411ETKMylM/Y4ww6gYu/Uylkneypj+iYqlkVXxNPV2kYMxoLftiU+PfMdgjbplqX
-q0eaqGyTt0vkvHTPy6EakQlT1YFx95aMoFRhIv9I2UkXchaz50YAXLsZfh2KeTpeDva1hRM/xcCZt25b
-0hmpmD7QYSiy1v7WgHUIsVF2qRuuDlTROhI0ezmWCUOdn/8dlUOXAt+muomo1rRSvATS7nKvqzZS5NkZ
-RejnOBd2CEpHGq7l54kmrcsDeEHgaTcVC7WO3ANdaFsoJ8gtAdJTYpTUVcqrSf8U0JO4tZoqSnueoNKW
-UMw6432QuYvKumcOND+EpiOZ9dt/Z0/9RcFQNBW25QoKib4w4MK0VZpAfv6UzLoQGZkMHStk+tK09Fbj
-i0ObHL/vTNRsrt/1MnyQ+IFeWYmXbuBaTADqzLpDn6lmFyE2hZwMHKneBRBdczdSlL2dmApP6FD+3YUu
-ptP1eECH0hxnTnOrjFrnOo+ez7GRP+pGk3KIgMtes9LzTMjlwgW9HjPvTnxQodhueYdrqUkkWYXi8wbj
-tHeI1ozsrVT9I2qDwKQIlmAYjbtXNsUG5Qy/Wh/aji2S/aWWfFC+Q0b8dsfM2xGSKrdeXtGycVUlPF9T
-E9dY9ored+M0/LCoQYbgXYFeLwYka+p9ZvNSY4mOGCgEhYX6lLOrvt2lOBo7Y87xznuxLqKxElC8xofO
-Yb/zWdlpDmMm8BXC68nsLXx9yeW55hySuz0FI/Yxsl/UE+9rn8of3no94+uvm3zoas+x3oWjJqHZ8nWb
-j5IZi8fPay88ePrHZybttXBWNKgXYdvQIwuXeN7R1SCM6XHXzxOXOsIgLkDFhQLlOcTCeG2YTYimfUiu
-oCfOw8W2eU4sJxBXOHGhUj1HlChyyBvIegU8X5G82nNf+HJWFQgveN6zwH2UCHlcERmL+dVbZ4jwZwVI
-bN3petBacSWq/O3r/QQDE3uljsPLu3cjgzqx+eTINlfVxH6xziyaXA7QJ3oHkZ/5iJGWT6QyOD5xAx/8
-OvS+9hUM6+7GHGAfhYDUKbZO6VFKM3XuQsbRHiF2pj7LUH59WxH/h4oG5n35S+NqUPWsRWkC9gn4YvkW
-p6nCXJcnco1/WGs/TJjOAvzCP5hdn81kpWSP9izCyRYdFms+bmpIkAm+el40kEB0wo/bnw2+r4p85q7O
-BnsGuqSkYHSsK7jSooy84aqKrPLk5cD+wTE8ANxa8AtVLT2P5yp9cIcWo2KNpa3UwbUV0tacVofDn9Jf
-nDkfDdjhNz/J5xGLixtD0NO9W7TZSpF7yIUFZNtyjcHQvRaWmI0LyRDnZ3gMKVLKw6kr1znQpZcI0i0U
-3OwFTeDqE1oZBsBgfYeqE29cEEK8bhwIN8gRnLEemBxLL5S5dXShSd2YimBgG1hcVLu6B6Y9ciUsTuXq
-2Nfn+3bUMQi7SafxfAcC/Dv1rUMzKBNaQBAxeCywY1qq7uZJY7Lm5uIo4Vgek5WVwf20F0oT8V0+jZEw
-X+HaGyuKg8SQ3w1yQxDEi75Pc+JLb5J82ERE9VGpy1xnNJaVLIBkVJN1I9js70f77EfB5ssdpgYM9OmM
-EQ1LQ3owMqqE4br5TLCtScEfWdHIOWS9nyyyqOQEQQBeR6x7Ukow7cbaxXnDqswpprnKrZOP7Lo0QTsU
-gAEicaa5inCcI+eNzptwOUk+CO54wsyObvGPxJOOro3heCbU2f4jAJ9b7Tcy48c48F8Dv0OZN8CE1Lqc
-cCiHPHLJPUaZVszenj6ujs7R0X4zJ6ImH/uXDJi7VLP4hEqSMLlW5Vqdl+oOtKfKRMCvl40255MaQtWp
-ZK7K/rg+Ap8KEZAxbVbIsMttyy7M6H1EPU5XQ6yIiC9qv5PhEJW11ZU92r14xQuCHSlyXiNBhmlqUNg9
-QIGYMTiMaJ93g/yaf75xTCyG8dLSybZ9zyMbNf5NKpvoMAWXrFqqfBv2vbSuF4gsmfJeoHL6dNTPnFym
-4nF5G15Ki0KagN1jXqXG3ey+kXbxdsWu4M3r6APbx4u9NMzSkMsLfK+VC6ohoyK7dv+Sp19liwbiEcsT
-fr4BXOE5HUifjeF8Ie6uLmYe6jeVyTrn0+RbIix7/nWcegiHHapKQw9dVngGuow9BMGlmFQR68vPp8sU
-hhLsDkivJuNvcCvPkHyk2u4VSaVA6l8zFcNPnBVhefiAlJDWlYMHJKseieiZsD6Bg4dePEMWgbmcH/3d
-h1qxk4nv/g3KaLT8XTUX/n73XEDrnGdd2M0F4AsQy8eIltJLQfi6//Cd59gDVlSAwa+PpAd0fFQWYWM/
-/WPvg+/7FfvKG4rqxUmepu9ZqzWIs20dqv+g9xcQfeM9VYZ2eMPmXGThuNPtGxsX60J5Qen548Ao/WMw
-EAp1yiXlgTz8WPjhG6wJAm4wus0CuGOSeC4wbK+K/eNqrJxRwNGlpwCQmlu7N/3dpSovaHw0d4k4AX+x
-1oQIFJ1YP8LEqmhduY5AWv6Y12vAaNrm3SMWnFKQ2tOf5JcQi3vXKXN4pYPz7fukvLet49Hnxnt3BCN2
-Czzf+9S6FoRRhILD4N8TzY5GIQwsYZtJjCP91XPeCoHZEUgJ22uud99oZrvR/L9PeGVdFnfV1jA1udWi
-xQB2y19z8h9fDIbp4wn/mclsvg6EE+V8e1geGTZr/BKNSIqaYXUTbxedU5JHVlKelUIcIYLCkwI6MikZ
-rWFjYkaYMlGKlDsydEotpRu0T6rXQqMsTUGtrPRSk3ol529Xal/8aHXXP5TyF0PWJy0HaSfVgzwXuSsc
-3iL6ouY1BDc3Rk1CXdqXcHNc+Ftg75BXMHOe+NnBeoItTfQHCyHS6vtoXLWN3DNNrOzJPObx1/wJxFYL
-DEhi7KWnS4vY618evGaND+oXcL/a5iSymonWoCoJrLoyaTi8frMMVONyq4wR12zyZgYqCMF4Q0tPUoWz
-Jw+RL/BKBCoYynib8ez+amMwqSyeqxyCsHg5mDrH30J/h/SFHas5m4vOi41HtgVZY+PlBu4SFN1aiLzk
-lmY/6mJkefzl6d0x/X8A6wcU+Hzt/f+xFXB6UK8WUmvzv2Chx7SFOUI3QxKLPToCvYAU6nV1OSWrm3p5
-wpsr6IzfAKRj2jvTpvPcboy07m1/u64RWe2+6dowjV4tBmmdJC1lI48R1sToyOBgXpNUG/0Ge2yoCNjG
-HvsOdsE6ojI+21zJDnoXUMUUVHqUPlOrgG+qZFvjh/7rVDoBq31f2306c/fhuU1ecX+7PxewP+47ElW5
-xqBeLbrx/mgpq3mMYMP70z2YVyPVRt/BLliENcPWVrKJ6Td3tDkU/an1Qda9dv70dASt0W2OEkXmp9jV
-1a3Fmy5ur7mv7ZzoL2O519R0AsnoNVe0Xh59Dr3AGaJ1GZ9GrzFzWHwGRpgzMFroHdNVyEom0cxF8a6O
-tPfgHu4B/ptPq1eL0UT2L1hljxFs+B6MCeZVTbVcn7HHxgmbVscj3uGKC2g74T4hr4aSDc2Kea6DKxxv
-13MD68E3F9BR/Hq1GGLWE7MdBUaw4fUMDeYVp1qu1tgFG1ArLLqJmTTgq0+2Vy9jri6aokI2nDjZJPZA
-IgYSyip+7fgfh68A2QB4AU3HB2EDUQwD0F8t+/gTLJQoO89T55y/zv916RwAjCcg5cf6ALq0KTuh4807
-bTcEiXZ1A8a62NJYJhjYMaKRPKJJWgSlmdE6zrpwragMAIolulhgXlysfPgHyh/SDYr3BOwAAAEAAAAC
-AAAhF4+xXw889QAbCAAAAAAAxPARLgAAAADQ206U+iT91QlcCHMAAAAJAAIAAAAAAAB4AWNgZGBgz/nH
-w8DAmf1L5Z8jZwxQBBXcBQB3AwWTAHgBbZID0JxLEAD72529+59tv9i2bdu2bdu2bdsoxLadFGL7Moc4
-W9W11kzb/lQnUryXYUwMBpnR1JCLVBadd+ep5v6ipFeWomYnnc1BYts+/CEtyO5t5U8zmVwmPb1sXIyu
-r6PMVooohZTkShelhZJDqa1UMP/Q1btGQvmOTNKWIRKXzvYy6f2xKeeK8b37kuEuDTVdWoZLT6WG9ptS
-1y1iuMnKNGlCAveFjhdnuN/TuYVKT13/V6iuLC917jB55ZSuS0UHl4Dv/Z+T2H1LHDmPXw5Q2CShuy3N
-j1p/aeuRwo7HmuEkkeKUlS60l8T6/u5KPoqZoySQPhTVe9uYL+hgvMAKSaD1F/T3PdS1zWgjAykU2teP
-Qma/1v+Rz8whSprSyj7gZ3eX/8THP/YKX2pd1CwitTEs1PoPV4QW5m96SgeqywoquglU0Hf+5j2ntzym
-rO1BBV92StkhDLAXKSUN6BTqn9K4f04nyUsl85D0SiozjsayjqH2Bjk0VgPNtzTQ8c52lr7jIhXcfsr4
-4lHYV4paGvOs/h6Bh5KdAq675m4nw+UavX1byC2zqCVDyCa9KSSj6Gp+Z5B/OdX9aSlnr2oeltLaHqet
-i8MEX2K6eJup5q0nmbeXpPZH6ts/yGyjSGp20ds0pb6pQ3GNcQJ9Swp1oKJ0Y6LM5ldZHxrr/Ob9Oh/y
-UL1ypQOPQj58CvUj6MY7qBuB7UE/tN6lbHrjxYeoE+8SckLz/kkShx14F/N7YHkwHlqvUua9yf+HaO7f
-JZh7vnwFAI3JBngBPcEDlOwwAADAtW21TZZV8G3btm3btm3btm3btm3c080oFIr66WcqDivzK/epqqs+
-q23qnOoW6tnqA+pH6h8aoMmmGaXZoTVp+2gP6FK6cbrd+pz6xfr1+t36WwaVARqGGTMZOxnvmKiph2mH
-6Z25rHmUean5hgVYClvmWG5YM1n7Wu/astmW2M7Zrfa89jH26w6jo4CjiaO3Y4HjuOORU+Mc5Hzkyuaa
-7Da4K7hPecyeIp7xnmuej9463qXez77qvu2+L/5G/rX+z4F4oGNgV+B3MHOwW3Bs8FzIHSof2hj2hruG
-b4S/RyZFDjNNmEfMO9bC7mYvck6uMrcMBEEOMBJsBVehGeaAg+A4uAIegneiRaMro89jjWKb4yB+N1E1
-sSrJJHsnp6XCqW2pt3ySb8F34vvw8/mD/EP+Lf9TkIXyQmthuDBLWCcisZC4VPwlxaWu0gtZSptJXiTv
-lC/Iz+WfyIUSKAMqjuqhrmgsWoJ2o0voFfqDPVjCBXEN3AEPxzPxBnwC38ffiJFwJAspTRqT9mQYmUHW
-kaPkNvlIjRTSjLQErUU70IF0Jt1AD9Eb9H0GVYbgf9/IikUAAAAAAQAAAN4AjwAWAE4ABQABAAAAAAAO
-AAACAAIWAAYAAXgBVY6DUkVhFIW/7B6g0Rlmu4bZ9igd26/fSheztfBjA3280EVH9wAdjMEf7mRU7Bd3
-tejdLbiHGSb+cK/0uz88KhRxQ8y7qlAZnGPyiUtJKMfEFgp4I+NBLCOXFxNhsMQcC4pFxarYNtIQZ6Xt
-zdm2Nx0KRcIW84oaBXO8kag+5JpiMRm23ABXmklEjqma54xjdtnnglv12d8dvgBSKSOpAAAAeAFjYGYA
-g/9ZDCkMWAAAKh8B0QB4AdvAo72BQZthEyMvkzbjJj5GILmdz8pAVVqAgUObYTujhZ6KlCCQuZ3Jz8FI
-WRTEYvaw1lMUAbFY4GKsBhryYnwgFltyoL2uNIjF7mAC1csR7WWlKQlicTan+Fkpg1hc5Uk+ZoogFvfE
-8hiIGE9ZgreZLIjFq6MiI8IDcsImfl52oBMVXGszJVw2bRBmLN4UIMxYsqkBRBQIM5Zu4pcHiuXLM5YC
-ADd2LNwAAQAA//8TIutAkEgAAA==
-`,
-	},
-
-	"/static/fonts/Roboto-Regular.woff": {
-		local:   "server/static/fonts/Roboto-Regular.woff",
-		size:    18520,
-		modtime: 1456790235,
-		compressed: `
-H4sIAAAJbogA/1yWc3Blz7fFb5yJbTuZ2LZt287Etu1k4olt27Zt29bL9/fn67qfvr1XrX1q16muU8tV
-TlQUAAT4WeLqAKT//n2jfur/Kf9/iQmLiAIAQEk/R/4fpMHoQe/E5OWUfrQFAABsHACAhZt6OpAQU1IR
-BAAg/v14tH8ISyLLVpRTomP80T5+atUf9P8V1xYZ2Rj8AQAglX/6CwEAEPa9QEJNIxcnAgDg138+6f8g
-EQA/NP1jZgMAQIn/+Lh+6DB70C43M3D86YUO+vHA/A9IALKZtbvpjxYHALA6AACGZJAYtG/mxjZuAIAg
-6I/H+IcTUOCXPXMTg5+zoPlPzfofL6LlcOY/4o/238z4P5BAtYF7m9s4/dfbAwAAi/zM5+KvqZBjbWf0
-4xOB/Jmj6z84PwhLbAzcfmYRHfnpI/gPEDjgV1sDG5Mf7eSnTvvxISDYUZf+sXN0+nmL/82M/J/v2wZg
-/MfB5KdX7O9P3fnDutO/2As3INjeWqBeeQzwnvdsqKWakCmspxkg/jqhOZPSi4Rv2RoQZmxPCHsnpOM2
-h0r76tuy28rb5cjekrSJOgA8EBSxG1DSHMgfYmmModvvo7lsR4u16Sabdc71n+3nxzpeb1N//TyhXZS6
-/+By3OymaoOEExdSoTz4fl/ta3Y4StRIlXq4iJQcmbzbXxdclnKs1QEwZYfEbI+rJTfOBLZLM0wevz/Y
-pNlB6HDjr5zel5pdzcAUM5rcsVqeaySZbXT13lRmW3PXwTpePlF47nifNXGu17F33UpOzmhsai9bY1Qv
-PtNWXITND3S2VMyqZHJV2/Tft+qTkCKpvsGqvge3u8O7vkfEv+fQu0PdvqHxvSH3TQyfMCbkah0VQ2+b
-y8KLMkbJx+xXH2LI/iVtjJSY1kdtyhTaOmHEUK7Rr7lUm0YjjCxEbW9tBhEj7qlr48JVwhpLI1gJOygk
-68VcB1TQZ3WrmVPkBXaIZKa4rLs8xsfc/NRQJ+AmIYfCh5Cr40kpTCI58o8kK8FI0utElYs/YmcKsFa4
-mrOWnAg/ml+/JqBpQreK5LBGebkd/LQu4FNzj6K4tyvHVfpBESOq+Rgven0q6tUEtFn2mO/BkJ159gh8
-aM3tbJPlAl/QSpOXTFOQatM7u0hW9qlUjBu7D6Z4R3Z9T7ys09N50TVIeZsge0ruNea6dv6ysZfsLYGi
-h7iDjWdS2xG5jUdKUK3usiXTMXG8dht5ecDGNXR9F/pBqkGScVCXf9DUf5Doku46v5rNKHksNo6B+Xvf
-uIN6VkfKJI06FbVOUfX0wlKD2MokyzrePDxePTzRrTOVQMskw3q5nYskapomhM6GT2/VvqsWlxqtei5c
-Uo5idcTw17EO1yg6ox7aiKAGCaLmfaKqz7x39zj+XapxosuciJVOYftDnMqY6jiWxu/zAcRgdMh9EUbF
-9La1S+b32MyDXL+p4sXP4Vedr7hukoyGRJU6zaURTQ3yXWOFdpE8UlFDUtmOl0pKoFMyHpHvjDs64y7q
-uOA68bkbtun7HOMk6qU4nKV4mrP8/zm5DTBvBVfukkrd0od7l9bBuExoLjsma8Fd/J1Yx8ATKwwhABc1
-lZJHX969PnxARAne3wrzdMI2dlwJFe/4Hcf6NXxAwqulXKrJW/ETDZ+ApViYF5s4msFqH6SVcopGyqcW
-59r5pd61dHzqraegj0uxK59NtJAi1WG0lbOBcP1kJDl1ZnWySIKE92QY4JXWmZmy9eyBVpcbVUv0A8cr
-pYsTbzXajY5+VohuSoB1Ojf5bN3lEucKHheo/nCC5jbud7VLCMmwTQ4sqBY/KGKKvucvhJregmZXeUsx
-68rBdYsMg/FGeIIXuB1oHz71KGahUmqwGp3eZBAX+oPrX1fkPKDzXBFZQDXu3Mq10PDN80+bX3gbrj5w
-PVN38oMeCRNIH75WZJNy27U+F1vdnFnt+epsHK7L34gIN+u37U9s0K+8NS9cqkLPfOywX5ujcr1tq0iC
-xzSBn4wB1SseGRh12VkHqPDxQeWCmiqheotCfj3fMksgUzqeAJFbZ6dLz2n36iYbXwWb9izp/DUMgZ8P
-CUfa1UXJ6+CLkUhGmAAMsSkJMiVjMq3mhAHepjoBjwZ1p3qvpUe96lu9AnhNWiWMcSjMLsEwxpjEFkP6
-9Id+Y+qMqCDZ69JVVyMX7KmAlAe9OnCI+IR8OAbZL2JMkRFzSgbRu6hk4H0MuRHQ8L9h21QcRRLhp4qm
-IQt/KVFNJZJpS5nEsCaS0e4LTrsbP5tj0PVDVRLqNDW/jn2/zIZMX3NoEmpV2l6naewGr3BS8LaiNjnI
-eNgpEdFJjojUxFvGZARt7WPD3rnzBoxK74JSmd1IZXTLdTKm53YjoYpo/yIyzoIZoUfp1IgqaaoaxSlv
-MBgHteB+tya9SY2gnLaobXUSPVO1qk11ll2pfBWrjXGzWNaIY9kuuOQPRK9VxIOfkxgFomz8/lNd5Al2
-pWKcEXSRELc5p88H8UfAEL2iVf8igEVWPFIps3gvxlbsxCV+PXmTVqXVlVm78tLyF9+rUO9lQLf4K1kp
-wA1IJnwQSFAAQUgP3PW4A8wav0UIyLN3V1hghsLWl3lUiB9NDvuy/1Q8nTqdriiGasMWkldvxaceCkWv
-+RdGx0tk/AAihDDWLh/fFKYRAuEifqt+FGKeJ8RfBdh/bkAsg2xAffIA8F26zjDx2Z6hgRSGEjfWvysa
-p2g1ASBBM+t6VySnjJqjI6yrQSWfnBzQDIbf2guxrT7+eCH2OBNuGw6ugrXhznnEBfMXBfPhOemicImA
-n5m9x0GibNUAwbOP+U2b2W2wVoOjAxWXGI0/94araVLf2pOsrHI9I5PIi0tjdTZU+4CfA/O2mdwx2KsI
-UAx59zQke3WbLSii6YRdc2UlcfQysbp6deHkwnqB+QQPEdrfh5lLsIECBb/OI9EjG/DcUS1eq8oDbvNC
-djkVobNCfIENJ90+/31tnJCToKJFfjlqJ4E9amrJ4azgMJTT6av6qdHqNr2XFelKvdXmdrgHFjlM68X2
-3QAD2MHI9JOyds9570uFQqV06Z5eKFOqFsgng3eTr0erXGvwHOnndDqbqxSrFuO2XAlTMBqScZvYJ5NJ
-YzVaQj66gEyT6FEAjOgXi8MBPRPEPhPl7Hij7FmiiPCKMGj+odGcDZ6exW3F58Hg/wVOnGMAuV8yCEp2
-CyiiDzWdMAKCN0C0OunxOEC03rF62MWezX9B4zPA4NtH5w7RuyUnu009+UWSx8E4LJZN4qh6Zs0t0sR1
-B7N6OlJvbEY8jfgkfhl+/Ou96Gk4MghECWIftc8gfvlXcfFUD3ZQwIhWUAaRHTIU8fWflat412/w5T9l
-wv8FOipAFsAPEAFwAyz8pCNZgCYgFKD/k8+YgbkBHYCBHwfKlz4ABTjjJ9MQAqBBOQEooNEABLAaAAo4
-BgAVqAiACN4DgPvvUW5AOjFgcMgCKCFmJVqCKKWTyvFpzLQDVO2DuVvuLVpRIDuyl9Tfos2zeMWhPao3
-8cfX4/BFU8Qs9HDH2A6vz89uak6K8RlszC1IfT7W/ttz7P965MSjw5C8hc8+40sT1MMGVz2hZ9FjbbeF
-GvDcqu19BWiAWFljebgYkGzGvuGukbPmwt7+mvtpxEG4IQGA8ZM09VgMzzr4CxjohQLVVPFsIu8UobDb
-/XunxqSIol/sorN9v17+lLDmIvDlxsP8Mgp0wYtCi6Qvl+zQpL6LpPqccv0+ojX7Rl/zRUcoXaf1ZYHk
-rC5jvEYvq6PiCTa2KFh4ra3lCU9gnoNi0SLOl3/WVuWQ+ZKgHjJdN1VwWZapk0OuN2kc+aI8VHjc2Uv0
-jPusig8doWzgaw3QYZDN0nilKRd7QjvF7x4K/4naQADgn/wK/P0NgHcDqvCC4EcZfv7OWHXAeYT68+Ay
-tqpQlq5uxDaoG6hD5HIR1kkQFoFaoY6Ene5muwBJFhHeaRiuYBRV5DUv/VGEfRjyPUHt1lx8tO0Tbt2+
-mcVmp/s5kQH2KwQIspGEC5IgEK+jF54RxS5w1tzS8Jl1JTfTxqRr8b5SjmMl1wafKwZ6M7Y//fmQnF+u
-Z6ajmsjwnxxVQtt8KRd81/r16WfoXqXu7S4XF+vF8+NXvkH+iblRg77wSFiQeVJQXrBmTOptuPEdNKXI
-1ra+P0IQLtzo1wuuxPHf8GJehj2LGCA3fjg8AEaT8ld4nRt8B+EVx4D7LDKdaCa1+9IlsjfTQ2wXVAID
-6T8DYmNUpKCBgxd+Xw4ytMft0/vb1aTZaOxYWQHsAb/h6yW7pYptKTqgEJzZoEpGP0gPSPB2hGJ9OX9X
-wqmq383Q4O1iHN4Z+izk4taBwERDuaQjqTaFFsY8b6ShNoB+ZcAtViErChGhXuFSBtKJjkylfzbGSXg9
-fkhZIoIHUt8b4tVkWI4BfCy0In/JVaIOdXNofa2hVrXDms1g/2t+au+V1lRTkUN7aO/V9DUUdXNgL95A
-tTD9Wz7l5VKBuSPzpxTCip6gX/K3BpgHKtdIPu2rDh6Rt5agvFvzh+1I/vqdLXqXlee3ROqhyEg+jXvQ
-UiJzAy867KiQ1gjBU6pYhIMEVlMeIQBySJUVJfpOtATawvL6sUHr7QxueCYRY0kMJ9/sYhH7YuvQ2kpf
-azAq2wUSO0n/WCOqF09jqqnWunzB5w36UtmwgoaVlZKBg5SSkICevicdwyo6HuQDRMRjj+4cuELi4Rj0
-uyfoxTh3esM5x8fB+jhdRwzOTO4vpxWhZUPx+Su8aqrub400nm6AjLejqmD5b63t1rPvV9zxwEgpV+No
-mXEczkbzgyupI6PhqO2ssd9/wZAK1L5IJlNlA+CD+KlJBI3+Fj2p/NtELnu3LCRXXVMj48k+dJlmH6RQ
-jZ+sohSYYirvxdOVHc7Ao3DOTO2HlcwvNpNMSJYyVsxMIFJfVZDU7hc9GjgIeukBrClYSVsnBD8zUlIy
-pDgASMnEbvXytH31O+KtRxK6HL/6LrmOp37rbhQrvW91qj730V0K31VlFQTonM6Uuw4/eYfT4ubIoi9P
-0W0lzvv0TmW5ty2Hwp+u3j42NNiiqh1dbyUv+3xdZbnfYfeHPl08DNXk8vjY0ciawwLDVHsRfNFm+UHW
-CABTe8XgBM0m1lj2wueRC0hTGPb9ladwfjCwJh9YMOASysJoYipDnzyj6T2jncGYwEA0lSeaVeIVEcNS
-mVXE4ukcwUzuGwiqjQwPhiNVyp3RqGBNq6sKQckJcJK2BPWVknSoGyvZTWyGt/U2VOsN79oXzWW1cTFN
-3sbdOH3QykEK4NViybKO2GqlNR1LYr3t171khaSRbJbLruv4jIF/prmoL1rHNj7qmExu/0pbabtUyxrO
-3xTQuC+iRu7Cbejw7N+a1DvlgkPoIBUNDFzHCbS0L6CKFAo3YR1CpEevjFeNOzyR4q+JW+i1OlDQB+VZ
-8d6/IML3SqQcJDISMKOH0HSqZe2gv+92NFLDm80v5CEfpp3w8tt+gbIR4FmTwZdDGl3mGaW+k7n9FyV7
-nYsnrWZ0yqW0sRX+wbC0miBzVCAcZUPpT4Ysm/ZxDrz+RtwyXUTYBAZfvNekl9A8EhODqTRTTUF7RiUp
-hqjDyJlgyAiDELTbKyg3CteFUBjTLi6mdrCviyeNKxGnI/f81kKwpjyJEp5+nI42WoEMin0mXMXKD/tw
-zq1wCX6wV4pZCGEKlfP4Gyf2La55uOpSzgfXL2/E9RPzsLpfkkGBG1QXAfjBxGXnSEhU9nPviJZgzZxF
-0chMukekxC+KmpRYQaB0pz6WJA/6fcaWH0H2Kk/4BYe7p2+rIEwuC9KTkp52vcXiNrV+HiIMIjShHffo
-+FKb4QMnP58sZoD3BQFSH5TTAcqBRbrB4EIoYOaZvQ4BgbrYxxpKG4gnqCxzVmHqpIUhKVOukN4OtWdj
-8Y4MWdfDQjCxF1aryXrln7sjv35ZJh1GCzkzCOnkIuocOY1qUo0vE54ys1IVvJ8B0aKgXFkwb0MnmWVk
-9oOpnEf9vDoD7gMzhWhBrXRVJ72+FQxaAkxRkFvi6tCjoNnBEYnIQ8f7Tqjn3maOb9vvJOoRPVb446ND
-WpQbDIegTH4Jil27BSgWsuURypwc3nb71A2vP99vbH7/MADbCbyA1nTa8AQiHmxrvwEUwSgl0GIXI3+d
-AobgRQrSRemeZkkJPNbgSDUH1yxT4gQoArIZXa0dlhlO7cl2GtrsIm1EGLSlhN5/J1nLsBNslEBogWu6
-TkhlTdALwws7pqC55gy5ebgezF3He+GRy8kEu0uvEMwVaTF8emHYU1sbjn1jMlJTvG9O32YHTHPoCDAl
-XgQfQqWRlU8YvEkEOZZnAacS9PlwXi06geyHjP+UTZj+wZDG4MKCMvde5cc74eO1+guEOzOO4kBfvOoH
-8lRk2iaLLwPJ/Nukv9HlZgmZUIpQYFGmG+W0qM+76HgFS56/k8LJ0X5jsDpoeE3mnxM3seituDDBVjv8
-dcvuCRywEeXa3fl5y1+oW+H9FP69wcNsf5vwWu9Iry8OjNAur/EIqndS8giQre95i3ggykdVADm5QIl2
-QIh0QIFfjYRI+iAT9fJLLmeW5J8VzYrzvtIuJcal4zj9GykLnocPNepZso6xuxxB9qJNw1+TfcbvLSnC
-DqNqwd+gIWzxhzHHAsBIkZfoyYk/QDE3SEk4FZlAG6RTsMGaUo9444pUbrSVsfaLqZEN2KSUsgdfyc5a
-CvJgw5gof17nLMjXqUPPTnnqFN+32/sDfp93/47SUc1GN261CbEr2u6CEFkrzvdqkzwGG38y02a7xsFX
-kaanxm8On+wSwjesiHWasu+KR38/VtsToMID+QcVhXRCBQ64oq7EU5KWhBTzXxF/Mtc/TcggNkNb1YDv
-gXmx7q/7OVRFf4/8KzUI2/CNFA4m3+AG38SXky/gu+HH9wO2hbFZvErhdtLhl1+dazgtjm4yAr8IyvwJ
-lgo2Ugl1y4+ncvUbpMDVwEKpwMRGxqhL1OdrP402mvf8/yid3bzUoDSg8fvdJtF1aY/n59o+roDPoIPP
-NHMFR4+myuD3uBnktxkLlVYJUQWt/LV+XX0ZOdNZHMDTlzfUTiukCscyaRVGAo5sYO9GiOXYT6Rcx5PG
-YTQwMHlVGd74FTjMx6775LgbMhxcfjStJeODU2++uxkcOTjAMAiL7c3+mO/5zJfp0KDQvzcbardVscLp
-/bkmNn43GCeSOHR4tKXTZ/1SMya5i3htBnsCMuVU3JZq6mFo2okyIS2njgEEzMdoJaxstHCpa4vmL8Lf
-ISaNFQUEHlchsSC5UEDGW8qZjpbOtslpvhTfYI/uYPsldHGwidVk903UXWBbppDE4e3Mmz3fNBz1zvHb
-N1OAl4iPJHGLRGmNwufZTTBWNrSLo8520r6kIsj6DGg4zIYATdoXaE7B96mZHhGGNoU+jIPRw8zmLNlz
-jsvmIZsuOFwcPJDNr5d07MgVOADuJpxe5wKUEbRtBOMzec0ZT4qQEkCaCzjwJ1fWzjO18t8HHdMML8rR
-/9CJvAte7zNB4Idg8aeCgcVhR9Zp1ELc7OCEpgxaRxULXrqQM69ZVGgCAwiwZiJchWVlNfw5njCPH7tE
-yg9WSiAJ4hT91UkVcWtIgkO6+nu0j7FnpvVOzgAK95wgLKRq+lZH4YEkItq5hGk6WB4E2WAWQPeg9++N
-7qxDUTH7rdp48e7cfORcOjZ0/2X56BkjI5s1H9TX+yTfan3IiVs3maY6p61lOM/faK445qr78uH18z1C
-zFVHirdUMgeoX/wuwevnXpCYwxL34FUPkeElGcJnAyX/9rnFixLuZhe5uzESwwMv/OVyptXKs/UviJNu
-JqlRtrVTNtPQWfeeiYKmtnxDsd1kvy8jwdqQpw6SMVJKGgGX2DDLGmk1LRjiZcWaUaGYJj1uwigVhWk/
-yGvq/aiXWGLUPSulkvndBE/qmrNYKs9dZQhpVVoyzCElk2PnsBNnteqB68Vh8gh9Hj+x8drTc1uECe8r
-MKTz+YBtu8pbF08NhMV8w+GO82HZ7uBdh6fd19eeC0l12Wa35XazE8ScUNMz/eDjMayCU3eacxbNF/9S
-OHckitfNLfaBeDy1Uga7RMw39LuvBQOcIFjKZcafvMExNntzFzgte0Qa54Ho6GlcxRJVzkdB5lUBmKkw
-4M+sQpIn1PJ68HmOU0cIHq8g0wgwVJtTL6EbG2QNNv/EbrwngbCgnKtvaXi2n6e4VObesfVcfyyYwgQy
-ZqZgWEMRWS/GZRS78e+8YmaM8CrMyrfRk82TqWl4ctiMBKku8mKF+gvYg9+KIYamqLSj5NviT8gmFhln
-oqkTHPbw1y4f8Wy4p0vTWDw2MPOdpQvEGwVryOA2jS6KyOeMQQPVmZj8iUPs4O2Ztf/Cf6WaRwMbKRIl
-YtWoG5W26KfLZLjteDIlbXwHIzi4gN1tPPNA1eHAb2n8eRmjNBnPpnc6bSc3B5bUdcAjtSWPsdteF/96
-Nz6dPnhHhQA40ubkaT8FAMzv6LhNM+9dFEVaz/aHxGaNc7O1Fn6JWjZaWMbatM+G5JcMmTakbXDaX25s
-dvu2EK66xjuaKdHFO3LVGHskhH789uQVNIXxYye9ZCetDlTKyw6f7hBOgy1PHaKIAJdpMJXnZiabw8CV
-fyTfcDzYo6MKgSrHebxV7lhUqJWoQ4/3ULkcnn16nLnTM9aZNYqPbPZ8r2sSsVLxRMfJGAuyFlPiRpl2
-nTC2eQUOOFXmvJI/DQsi/IaYu93zEg+X77QS3gDqDhuBzPT4JzKA7VQc1C6vpISW1ls76xcRkzAYkW9U
-I0/KMeEc5soZ330J5S4fpgAM9QEOJppCmtg+LJfvOSxqJ0X0XrO7MvKQRXH7Yb2AGP2xCx5B1p0wVuqb
-QIfNKnDJVtaRyP2y8rgQk0U2OQ+ZZKp82VMHPrQw6cEsED6fr5wKdLiKamQBvEkmjjeMy2Bi8nJlz+kF
-QtpuhJndc3AM0pY2Vj974+sf9dqONLNQxzg6lS2ZhzkD5nECAdtwWzHvzvRGYY+kApDZMlTurwZleUXp
-iOFrdThvrttwV1IjHP06yPkNf5Wd1lqgj6h8K7b3kVYQzZ0V3qw6s1Fr3EMNZWNxAfqpTyIdOliDF5GL
-wq2YTEaNmEg64cNGPn55oOuQzi0ylQJ9gHtHqJEjabFof5QLGlTBrpw7ofXQuY/Mfcfn5vnrtpjaOmwc
-l+l3lYqFAEahcoDmdxHYDpWe9BzyjHiFMjVPV/iZkkrsoe2Gx/PinQoTMxbhzIs5ueJkIiMCR0/1FbfA
-ZNcDu//TeFTFiPSz+e+2wKsPJVMihvKwRW3R4R2ZW9wzWcWbGvL+wcaYyZj6KNbihrNdFTB3RrA5uIhE
-hMqHltLiANId5VtcZOqBlShlxEY8vphubjI4d0XhXxrkXWAYRcq8cQ/K59EIv2QaXptSEHnBl4UlnKSS
-PuyTecnLEIX9oo2IOKvmilAkujO5665JfSv7fscvb3mPWL3W3hGHVkEuS0rSQbo9wzp4LdsuHCi+qSx4
-TNs6XChFgaLw+7xZLSA74L9y/Bv7nXRrvQpTTZn9xW1yawyUiaa+RCIbkeqXzTIJ/GwjexatevTb3Meu
-8q6uyTxJNyQQn4jv7nnbmO2s/SvL9W5UdGNB4HMO36zat91C5KojGlgfQ+mUcjTJFwhBqPSuZkl+n9Xz
-D6qKqGSYApMPN+rtzku5wr6KRQN64WGp7Nxikt7AMsWYalJW+MDrgWEorZy7VXEHTbvbww7qLlT6gJtn
-tPaVM+E9/LzSF1rifg5RUFzw7TNDq9rgtg5gT6MKFw2jQlwARzd+BWY0Mlk2w+A3OMsEo3kfNV0VprQh
-8OYmollbRb4Fa9kRF69oRGx+9Zfgm8q9QrVUTO1ISrfnuaYDdveRm17ddnu8I+2Wwk60rzaP0azDAHMx
-9KMWMoaucCm4Fs1aytCGFXnMBWOg9F+2J2dFDOy+TdcKMlyY3XlKQlsOWikgzKpD3tC+gu61C7KhPV3f
-bbEjLjC+SZ4uq826yWDzhcHcg15XsCCsfLkt3fZKwHFTd4fL9acEOiM6yhTOMttvmvMs+3e+LDfTpwBn
-Jltp005LUAK0O/Zm/yYuzadx8OhxOjXMcR5zwlLXqAX0eZL5fT0SJUQCdUEOFelti4BU1NELufcKlkAs
-UMl9KxS96LaRf/ODftHpdqgQDBGDYcpbEm7iXb1i2+tvT+0JnURHSO7WPZC+pBvlYTRrz7rxNxwAw9v2
-kx03MSMwJ+rtWXA3zfxiBhs/UNt5yzt9HqD51cbyGLsI3G3dUpRnvqu8tVlTwN10R6x3o6mAEX9WWWk4
-RBnYWmz2iz02nRujFVU5F35XPkxNwT/QNgYmTmtOUZg3zgrWiLWQACsRH+dM6y2xKi3qgwveukKYLDpP
-NTflXQM+Qq/7lkh1x40W8hylNwRmIAQ6+28uyXFgcp4rUXXocBCkNN9FMyGgCz8hAsVULWdKNV3y6xQp
-Yw8JQNpsVapAYRaX9hcdEB1/qV688MMqmzGPxjtLmwTSFfT9HxAxEDEY2wv2wOu98qhiH8AeomQpBPOc
-4lmBmFBpInsjzxEk8s0f5n3WW2ribNeb4pf7CPuYd3ZF9d6tbWj+zClvPWw3+5tdSyVh/ZCQXbiE+zzd
-udnIsms5QwxhgCVRg4eDWFiJG8VHp8W8g73urOLSlqQDuOeoIz8EAz9l0/nGvmmvylrpu7EC0xOnSxS1
-YNrc87e0VjnXxJZS5BQLc1W2VY99VZswvvGcwngMxCrpOx91xGwikzmvXSNZN/bgau3vT5cC2JVJGKHc
-3iA8GfLV4dhxRzxn9d/HNS5ae1oBfMuEGUqhzHm6VQS4SmhLYQNRgiohWl5OdGVc2IurxHiEiC9MYjb8
-BKpF0FZA/H7yqcstEMpI/Y5LqlFoquhowIm6t2RtNIgIPVAuAwnaSW9Ob1CYysanfvgxA3/BPCte1zN+
-vSbwokFv2PEi+KDohUKWgKVFFLrgZlNjXyiobA0JhteAto53k/UPoeG5u9fi3t882C9rrx+YlN5xxWOp
-T59h9Q+vqXdMWHv0zbtDw32oR32lAGKuNPIxGtTGdzieP2nQEd92ewqiclcLkeVkORx0okh1O9k01eI6
-E/PaTPAn1r0LjnfC+9dcaS4cD3D32otiDSy64VzlnvGA/65vUWD2oAcY/DWE+mXSkAaD8gRkmZwZbxKX
-sTi/h5EQ2hMoY8fbsnVF2SaCzEt/IDZseHFAkTg7ROhCzLRsuMBmgj58Hik1lD/4LKV65DNTeOwfXF57
-i9jaZFgEEyN3NwK7F8fbpix9hqoHDGo3V+vBP0yFw0kVEia+1gD9gHaAt+4nJdz/NszP6tu0GDesnrEj
-JWH84j9mb0WNDxiz2fA6sYz1nktKNOomkCPb1PQ+UYv1xns9lWKryOGr1DurMhgJIag7qc5cvy6k49Qz
-5XGIkVjglzz0NQHwQebiwEHBGlFDKXJQD1vIK8gQVBfqInVwhK7GOK589FnwORJF29AkdauSCavTEIgz
-1MnCeP25OGlJMPxsUcL/0q5jXd9qfN/KbGR/wkdhgyfnDZHgoLiNv/rjjvjmvKki6klV+Ok6tgsY23ca
-DLxfudqN6mR3F7iCQtgDuuiKawEZFqQN3VFmox/YYVwt3DrNje4lYc1XiDYXgwAvEyfIu8eebcAD7XYJ
-5R55M7xqxMdVvf0cFHJAYnQYqby1kmHvIx7GF/lGq1+Ml/MhiVluyryYa5tG3d1YL/dKtQWA0oHsODoA
-LiflB+niLHKl6Ii0wbhHFlHbn2NvsS36jPDVjCzYUpVaDdnhRfZelQNFiqEQMgDvttPZc3spNNz7CW+w
-r1dcBnJfLc8xWNAPp0JkB2oPoDCB4nS7bGO3+iVA98l/02uj+Bio2UFh3WU/oR0mfWXOwi2LlFtrb8KR
-oKU2G27CkHp8jY4otYmlGaYhlSRVgO2kFKwU/moXsg9azsQOy0XPX38dnx140sGmt7kq7LbU5fMlo1Gv
-LVea3RJR6cEpu442kD9XfbFWPLeoWKEXTwncdvv+MWF6MwF53pcCBNGZAIgXjeQS02IjCWUD+6zyeH32
-pOS8sk557zWyq0zJOAgjTNq0Xd0lY9dMs4Q7g5GSeKv0ZK0lwm8ECNNOxfvQyWLxHzzAyo96ejZuIE9B
-q9WsqkZ4uy4mmZnFqpKdp0dAqmiREkhhYfYVu5bqCw6iIoYjZRSMP48m3zs/GHfGjAqYzqTW70P4Tfup
-Bc6UAc7qN8DzUhArYDX5IX6910D2EQIOsEGwswxNJCygaH9By4SdyLZZpaIkrbfGMhOSpC0k0DJ4T8Wn
-/qp6dszpcAcQnTfuGAMZeiF0mvxzknGRqPsbYd74NYgRBTGsDpwewAXlCMdtwa3+eF+PMEG5Ov+rr6BE
-wOC0ICEht+Tcew5/syX4a4IE2rgEgx0VlpiOCw0kIBpQUgxja6464DhLzYrEWMN7BDQy3/L8tyxso86C
-5QIMXDbfdvHzqZj8a5E894EkSewUzwtYe4+rPRRcG95wNjYYM/SASkJlnGnFnyXkUl7J4qy1up20mwsM
-NlFKdMrr93D5Q7tQdVKYTDGN+/WjgHF8Pi0vm4XhEqQYN++rvtBgrOG8pU5eJ6Og6+C48dKT5DoiG/lV
-tkHNTaYGCJ1aZV1LOaqHRLb9UKlqUltmdJWlSDgwzKdFUrx46V2gvJvILRx0dZkBS/vRzRWcPINVwLZ+
-tIrhFlCJnCmysLwO8bDGiu9R6qsegdHRZ7LrSP9cllTK1UoniSt6kw1Z5CjpntKD8a+I6hn8ls9Bi8PI
-dWpgosABSFktyCiViTJfVqYP0fLAKWrlfo5ET04KdyvD3GMPSxnqAeEsiQBWjIiXs5fRqITNt6j6HC+d
-OgYztdgrTzTbvqHu0cW0mO7C9fmUuPWD2pfeR5YY8vLqW/ZAGCQ81UejJNapF9fpXKjW0az39LD916wZ
-D8jy27Q/+8OJJvvc5vxqHnuhCTLQzc9X9TTtkMOqeWuOw9wxg3vMCyV9+Q9vktZj38rJOtrinjsglyiz
-qZPMbYAvLMNNHmBH6xXS/buyMiH9iwrTajD0JQunEpVRoNzvqQN6g0bbQJCBIia1F+A9hjXENcZ+ywcq
-1XxMW5ndfKIgFfSeN3kc3fylAPBoGKaV/PFT1dJcGo/K24Z0hcilEsdXy8twP6YR1/Xtlu7rm+ZCx1CY
-HHoksIvLL+/RDiZMUsWtoIdVijrH51RP6bO6m5vZxeqYy/mvZNZJT5Mn3drryctAxeEHY3edQ5ANKctf
-QPTyt7AN5ojRBMtEQf/MrCwXgDlT3n6lU6HAL1fVOWAh1xuJoq3eGMDvWEBHekWZz7Y6jlbmYblacxkt
-jSYqSt2xbzO0qPy+Bv4OGHRz5E0AEoA7fMe1JIJZ0FSAEYqMtxhxcGWb4tejaeubDgeyZDRkxxKmNLqt
-kbd6SHaFz0tgWl5PXhGb5PVESuVY+1Ih+ZuTCcyPAEuQpGiZHQlkITzFg63IP6fPf6J28ImYVpsk/X4S
-PrM3SmkKhrgAFlc1K2SHUSDO23V1vTzWrHqnl+nhn9qTFdV2/1CWF2jEH4VSzyFwoawrKIsW50uV65fJ
-+WExmC65W0heHDQQAW04H4EtiMsvj83PLmZxH3ClmZdZIjfTnc+bzX2evJjF1WZkGqONgNEW+PV1LaMY
-NLTh9vHCxByCstnzLp+9DzGMbg6LGVy5AgwJLGHWK1t6m6+AiBF1lekQDmDpNzR0epHsMElNCjwtlQRp
-WrOWQFMV5D99KdxDcbd5wbVafwE6ZWgcrXAckSt5J0n4IHtBCauGw//NCgD1BgQ9U5JqwlCc+AJALCOY
-fjftZ8eACBoaGMQgdNjyDsuPSGqTsAUSmBf8DRRym/beK6FtQwkROVuhTtmZyDP+cogBJifVTNxLTEjQ
-GDIuUCFqiNgw7p1/6vb95Nx69rlIzuIKljI2+WjGz/7yHrQ7xN+Q+/sC9TrdWv3+9P6E9NrKkq+P/wrb
-6G5QSGXIoJzBYjVid6fg0pw2x5UboStRmCqAJ9BguBUpVi8qWR0/Z+Uw9zstKlNXirFSWTaXGdtcF1Hc
-bAAZ2PCv1HLa9DkcXgdSu57AcjX+xnMB6l3HvU6sLV+mGGG1dFS3nsPjbsT2tPeLCreVL68mJS+KYQ9w
-JSpxDjbAjAB5hIG8HV/u4XNf2yYtp53m3pCoj/oPyAR1XcyqmSQKagrJ7W78Bpyji2vZvUdsZei9ro6h
-HebXGb+AntNvC+doBZCbFCmaZo89CRAgITyNyLmBZbcvhOsJfDfu2H1mqkqNu4C0JnrzKWuWevmsQ9jB
-9uwqt17PHO443coxLIQCNY9ZyQX1sBBbqAF1jZwsBfM4rj8Vv12Debk5HnanrdnWwWsrkc7g/EbO1qCl
-B0cHlk3N5eNk+ymgiHI0de++0hzfpaDGjFFBV+n56q8r6gn5PjAC+67Uq/NvTqq7GrTpSkIEER4gEDRc
-UGGZkuhd23L8n4g/3XXRMYthooSRkz7Ek3JxIoVLp7bMx+E15E4V8VU2L+MrcHZeeYTRfHUaaNOTRwtJ
-VkvKkZi7VqSd71vEHD/APO5OGjVvV4ze0T5zzGK2eFg9td+4eSz9h7c0tn3nyT4/BPD0rAX3TvWqrvFZ
-jD6lFWt/AeZrX1XP6L6gcuUhNClabHkW6VZtDv896oU4pOwbwkKJ11JkZHzXnVpT1ipLWVNyh0jUnqhf
-poqEfoCA7LsrMBFrom2veB3eHV24uxMPVac3l50kImcsU1PgZKR0/zOcGUDWyxoZYefg3R8YuIU+OqTW
-bLvdKGyGdoQqMqma1Pl+ekXOSD3eqag4n6+vMpHpTuG45JwvEwfEy6bw6z/i8BOQcskzhWMe4dG9pQW3
-QblBzMHh3zCJpduXbU4ERduiIrugZf7xXqit3DvvuNCoMrxb4AXnrvw0+84HzQwR9ZUF/zdkDJcudIRD
-vgrjLioz4scVQLd1ZM7u+A7jgNKYXetNRmtmVBOhWbPcAjYvgFBvkB5OJSIoqFNmxUygn7tbNjenaHlH
-g8oHaXaj55eLzxCRpiyJXDqYk6++3pSPa1julcBOdKaNN6CLemJg0g2OlYRGa3LlT1Omz6hHFYc1UJib
-rDbHwkxBORW5Swnrjiwt/kJFJBPyD82tnp+MlfLQItr150YXc56Sc+aP8DMALxes1HpY794RmqY0XfJT
-dWH6NunonvpHc3Cl6HAop3iUOHseOD97kqRcjGk1Nd4SobRy5Zfu+3RP6XCS0wkbPOpex4c+eS/UK0zV
-6BRjs422hB2jyuItsHW699tjDyU/5aa6Zr6e7U3AIoc2XM6VSYX2Oj6DBqeHNtssFzqz5X/fNHkP1diL
-I39H4kuS94JEIUxRMeuoStWsymYJLAJzYizyS0yeBTaUnQ0+L3Qu5KBRK56rNY9BBgA+TyWrvsXJW4pY
-/FkiKk+lOtT/juKsk6lZaINwqsOWFbKkZZPcY/C2+ms6efbIhaFQiJ6uEqTJbQzjAuQCyTt3zkeJqYiX
-SHjlppPkec9N5Yrq96dM9GBLwCOr+of3DWxq9FQKdOa3hc+fZBpeykOSk7nn0h4oOON1VWoJ4LyazefF
-hlmtV9sd9L+B8sXg3ZgW/ZsImCzDpbUiRqa1muMgMsjxBLJmcRZOR4AcbhYkHG4QrlptzxuFjC6XtzSY
-Go73KqIsIj1h9GpSvbqMIMpamzEGLzZkr1ByhD5lc8i4prJI2qu0Rb1bhnaewfimxtPpNW+jG2uzX+vW
-3Gzcm4iGwEvOOVe04dBWw/cGXSfOIeSTXdrc89G7pyQZQZfDYyjUwFXknEjqw+GGcDPGfnNO5k450GsM
-EXceqh1LZzU7Xp9g2myvtLG57r4h3Mlu8hrstz8PDhsZek66WW00DIiM/tqymxyfJiMHd50sQ22DaRTH
-kNf4ZSFPAYO8Faqu/v3XD24pnzUZFGFCVCk4XxBFHAG4QqgkE4tVxhknP3adcNe8lFjK3L+IKLaSQJCP
-yn1b0fZVEJREkGmlkoTtmCpnog+yieW+UkUJaA8uPo3lN5RQTjalAndIMUu/ZJRmLZqQCeMQws9GSVVk
-ibtbS5LkAUiAkXiwLFCisBIhuzWDfWpx8NTG0fqzCtN+dIZFKHVrZtIki5n0d/VwcJAaqx5U3QjZppZV
-K6DNbWhtWmeDTNF/ow711W5NGO+Pv7CJVPx326ZjEfE+lF4v/WxXrVDOcijNUPOG8RVz4ugmhek1Hsqk
-8Z3cXfaM89dEhertecZjBMsCBQMc0vHO0ihuBhDBXzIR6MpWHrY7Dh5qc6u24KUYYy/ZiCkImPsgRu7A
-mGh0UMFM7PfO8dJmxQesYFaLln6R2POKQizZO/jMZtODMJVy0QJ0ZTLXs6TMudZ6dVbD/ZCHeDAw/8sM
-5suPoRNZPwN6v5viFy6bZfi12kXztShNO7ctTrb22KItobvktPuHBEHSzDIdkOG2cMdZ8zoP8rTooyvh
-SipeJF1ED9QEwpISBBuPvfoU+zKWLb9ncTHzbU8KPBwZcLOyAsqZO7MtaCs0xVfwMs1qN/bq97tk7i9/
-6rjDCdEpZ2Xr8LJu/JghT5x2hU4GGr2rDZ952YNaH2V4rrFhbNH8uIam6PTONeO+s1UjsSgPIzAC85j7
-W49SFENCtfC6xbm5Yg3JwZNA/SXqwucZ9r+wrwQFVDQAE5y7QPEwEAEdxUHIJQ7Unnm2lrIEjqVShS8v
-mWSkqi66v+kEy4pOi4WWaioqEr3zKiqsv+eeTxZyRdrJstg6eYhdjdqXrCIc0S4oPPaTXXDZnhBD2azU
-uy0ELwk10ogV3yCWp/A5HJdK3ui6M+N6y53rBzLYKzxkseY5LKIUVfwOAedQsEn0jpq9Lv50lcZp7+PP
-I/hWJIK29cfLSsbX6OmB6f8i9/YrYmf7WtI7kkCIyZ4ZKWYLXySO82NKGOLynb3q5AeJFFwflgrmJ6Vc
-z5Qi1ZwL/aqMZtexl6EU5GRltBfiF5JtQQLUAEEpHUeVVQGdHZgidLSgyghuJSK+opZO6iNINgOrPF9C
-X6LZ3wK8K4EbLw139uhL8ypuKGMonAF4wKi5ZWsIw5fP/5y2YTr7lR9OkcZVzpCkvCIra6OsBMBAyOKX
-5RlqWi9UIw7MZu5Gd8DX8OjHVdOw5AYIIz7bysguxEWg81QQa0p3VaGgnnWbSfWeRa9ThPKQhNTgpjCS
-MdSqY3gzFsn3bU4I9RBXFQVgWDx2+8R9L/vEL7roE9yxcXUwwvFs28JD0m9YN5pHM0cBf5tyIVl55VAE
-tRCVrdX0dxOXBiizcwOwBinABZhA6FoyWo8raZdlvv98hsDHxkGLbeNt5L/39G62w/XtzeTJxVyfNLzu
-xzaP95NJ43y9zfwyggB5LrcKtugTCAuG5QVwrviJArdvIfNrEnZCs66ovnLoSj1jKyC4EeBaD7MBev6Z
-wOsxCUPocY7nrELC9wTyeYJUF0530AjfmDGz6H+k2QPmpucQv74EvyZc/AWAOd0gsHZhF6eF583yXpn8
-cmHTaKTzX90RBvjtRKpVRc5Wy4BY9086cg6P9cOOxJe+bb1jeNI97JZX2fQEipVa0wnKe2G1Ovvv30jI
-ul5UlD1nDOi4LSZq0khfviDIba0x59ssG+G2WByekvaFrGLS/s76ngfXX3e30+0/JpdgRnucL+8zhXQD
-nXjcY1kjSy+q7nS2GGszjP0zZKCj/lTr+GdZ6WfapLufI82oXFmMmgf221HO9Cs0D0znOqLQJwYavRtp
-CBqfXfd+uJoLDmJvP/Y5lynenH6VenU5YS04JmeW+QOM4AQ6NYhuIHcHLPr1zb2zWb5RvkdpOt9WYaYe
-9w6lnESHSs0pj7xbYtByArKQwFhAPFRebyEcJzuCMy9GadRgwYKKQxxurp72JJq6LscujAfamfQdAKqp
-8dGEQssDgBYWsBFXGuB0bhWtM0+1MRpvhG5pyIRh+OM1P+ZKbQZ9SEqymw48iXUhIlnhScj421Mi/PBc
-8SUqnHaG+lVrhQRsSSKcFeWEWmKLyfem7lBMY/MietGOZjMz8vcZbG+4BZe5RR3CzMqt+/dE8ePoJquX
-WoiokxlqA1OzGh5hc+hXrfIIplikuTfcZPWI3eiYOcMFAEGpdOOFnyDRCw+7BP96ZknT0kgZVFcF2gv2
-LN4/JrmIUVrLDxO32rSc2pYBu9zSbJbAWMPZjMr9geW1sAEFjHq9jlSsLnF6ni/78db1gJnHa9C++Y+m
-KqFhfZ1T0Z8C6wDOuCrh5crixiJEWynyRtuh+Yl4Pb3Riqmd1ir0aEXf+rttDGtlqXbDvLVbiBexHb8z
-RYjFx3plLViEqBKZ0cM5EPJjp6+a5VchHfihJZsGCgtl0eJYj+PoE0etC52v+fcMs2y7DPo/7lPlGcke
-Bth5Z7AnKezmi3tV3nTPGPQRXnDAHbqv/NmEXbLvqaCTO0kB7kc9wH7gh9brB+7OprsPJNpaLjsxKbt/
-8PbcpNB4z8Wl7DroPOdH+vIqm29N+X4Tnk3KvE5tJW1tPDgonUHns6TrIXPMQz1OtR1a8zLJGkEkltJK
-KrCukkZHOh/Zo+kOIv9TvS2Ql15LWwC7C8ADl/8QzD5oeLM8nYLv/tobryWeS8X5uhwR9vSKfJ6r9rku
-KfS0mzybaEkRQX2DE7ndltZlVDxhtI9GDDV+xz9h2dnsk7l+xElmaSrn+NZ/vrJ7IEvmk27A92RhJK//
-p3Q37Wb9vKwYPMDjHg+tykDHGVlJkXouwL5QeFtbpeuNWty8TAVhtoO5y1u7i15RrvlpfiAmGQW9V4JK
-YpwX134eoS1F3X/9l1rhQLamFKHsDDtowJdse0LXidzx47DVzoxV26PdaDUAPp1741lhGJGd83oSdd1l
-Kq1VFoogdkSrVCTm0PHapPrNYCtILqUKj7PbN39y1N0xVOVjq0Vj2IgrNfPljL7e6tKgWl2PulCMV99/
-GVVH/o/K979Mm+hD25xW/W23uyooz6fxqRez7tw3Q/1F/3TT3OLa1NvTePVuIuGXWKNIrKhSrY1jesux
-/Lqjyd+QXOb8/UwxK9LoyIP9HenUaCFURNOACyKmPGsr52rwGWUS9aw9DBvU1v3Dk6qTiCuq3lwf7iEz
-1IxFZ+CNBazXnj/Q1qrRVRM2tNiRXxrP98NwJ+zEl4mYzymxJ4sWQzWRpIEK10e4aNd9Fp2jW0lL7lwW
-QP7E1VNnkecSGoijjVUc3eCFXtxw+BFt3jcz7GKpO9NSgH4sRvEGnuKl6fXO9DvfroCeF2kw/2g1hCRj
-r49MYOBNtOFWsDUoYFDsk6LilYXKGLITMGfmwkJLm+GwsRwnj66pTajfKMr5ZM8Y4J7irU/ImunC14O/
-gy+6NCPBujbShym/sPZRXoBT6F2C29eezItiVkylmRkk3NpaFPIk5B6f5Z3eoNoZDbA1Au5GmjAHBbma
-tuIrvb3qSIhtJk5c+YZyQFEIunkoLOgY0d7mSeMycFBzu/YYu+3oEueXfQzreFru/OFKdGIDPOIgwSIy
-B3aF0waRrdflf6+vWajIjGbP7zFpzLg7RG6GXV9E72nj0NZfkh1IOyxyCb1UUYGW7ne0dpXByUU76Dcq
-NP2xe7bGlZmkuudmv783aTXLaORk+LqLjXHb5FrFlz1Pej/Oi6RJz5nqEsJLth4d36xzvs2zMfzMBfNf
-YUNfb4rbGO2fI0vIIUPNweGx6n0xEGKSF8r73jkyS4XbbLPfvxwkZIJ+WWO8xRZIAeImUKh0LXaJKBpo
-E8d9FEkYibKp0TF0XmdmE3Wiw2KX7/Xd1ev9d5ZpLItw4AxmtfTJovsxWN+ygo35V9k0AqJG5grY4yaU
-MwKhpxZW4FM0W34xaU4wVPFVxJZAZpHJz+tNZq9iJ7OnCfNze2KMM1MErmuuxCBDDWuwOeXoIZvb5Prh
-tHNvrQFvrikr/XAbKV1qOxAs/Z6Mm+JFznMf8dTfnfij3c6at0cuMe2Va/qkI0yEC3o0yxz06CvPfSmj
-UQ9Pm63CZR4zrXRpKBCJ6Jw1/ipq6snuaj4v3nYB6buoiPXRQrcB9jhcKhjDHCLDrrccXQu4swnnWMJs
-0QqagKaSNN81bgVSJnEy6X02InRLKYNGjJ6p4Fg2x/ejp48cFDkx/npEefdGf3XD4KksU8LCDCAgxIDQ
-mNr7yJOeIAD2AqGv3T1MMn4+pxQMP6PPUNPfcTh+DMsFC2kZZzcr9Seui3zv1PPrM19Wav4dmlRotBnX
-rDqT0fqFY20a0ZDYK+iJEk3VvSbTCdUDTP1bDw3Evgnm4c3SzsWbxRLoJ9y4w8IHdr/AiPSXrSmxilAp
-UDLwrDe1XS3RMobb5/bnkZ5At9HgSlc5j+io3U2dWNG8+iygDt0PrgQYBd1TQ6lxl1NlBrV8Zst6Cd3L
-tAzub13wGVz4LdgHrHh+/F1I7xyOOQeBFO3nxCHFAcWE4Q8V6+UsfTs3qjgI9NYnB94tPECroxmsNSbS
-1BeFdl9qRHtX5YNA8Z8BWoYaUkLhzxHeJsRubYQxEAwKJBmXrpthTIKH1A2o7ROnBAedqSd3V6JkNXb3
-BOXlMaR+d8LZd2DUjYkVXwdLPwXZOdRfMr3MtPinqhVusGCkbhCP1PMZ7JKg8I6IUZ59XsUiC0ROW+r6
-WReK+zfUZPQv/rZoyrzGvpBPfHy8fbxgGfLFfmJVPE4WvFMGkku6IIL6Kusnk4DzYVOMnASUzijObc+T
-hMXXfBEPkd441Oc0cvA3r0P899/a64vUWWtWvvB+vlaselLgReEtJgczIkwRUdv67O8/prQEK8H3QTmg
-Q4Py6N9YI3fUByHm3wM7OplqkH8nB6HQ3Rc96CfUuBdQsfAmlD9yVKu5uheOr8azrL+/K63MGHpbeTxp
-fZl95A4mokpP9bz8uVJS5t6e2OCzrZoYfBZKgF5RqZt2VF764Bv4/G+K1oCQbbZRcmmkF8b8UqQT3yFg
-nEacVRVM76ssL3Mk05ORJ7zwsrYRsKbcHidct5MvVgPHnuDFnKUKcr/UxBTWKEqVtng2Luxp9P/ibXKj
-APOHkKQ+MM14I1ZT5Jr1cHOZnuSijezT4wUsAdxiER69nHhneLJgZ/7dfwF14UTfPfryik9jffKJHedE
-Tqp9hS8rnRkSCkmlPL2bUlHwBVYWczJDpPSjIShuMrjNqBjdqAf2tgdeS7pZo3S+8AX05t9/B/QaiRdl
-EAv2MG0zQ3+k2qXvHnvNT0i5fBdhPkbOsib5IUVxbpgslMiQqImmaY0G0EWXXHVsts/XSAMrOKmbmDyi
-Q9TCiF55WwKHbf+hpsOZ+5ruKnLVEC6VxFm3RlMIiWyr88CbqWhP0JbBudcwUBk7fwNCavsb6y97AU2i
-HCpnt350h5nwdMBndv0V7dNiDx+88xjs1nTvSeLGgxh3mqP8xIOENGrN9/0VTYs4XKdLiNsgAKvA+GKV
-an79/PQvB8VnajNxlsGlOQXi3ZvZlZyv0xMz03uq/dN4ud0oe6sTRzavaLpjXc2pVcvn7uEq/gbeu3nw
-clP0Ft6TwiSnvvh3Nm98bNs/1Wnu3d/fBvBlHoZUFv1ddkI5NUCRvIyzi9+LQzpCUrDCBoCu+62rnav/
-Fu4Pp/CoeGjFeYXelPFXGhJgLMxuoef0aZ7TOaOmazx6V6/PMRWyE65GL7S0NGhrw2L20yfNmhMSJsTC
-6MCcimrAbd6ydCExp7L8rgtdtkDeSVgVVwqFXGY69NUV7TtzUfsXR4hn+9cBEhpzC6unXUPziDKBQ7Ho
-4aY2W1qTqxrDpgb/XKQm3woX27VdlsQmT6m5nKwyOn+bHXtqtmAyapiO2LG0r7hmE3qRQ7G9F41M6Jab
-RgzxUCYI0PRzKlaDePyRnQ6KOTUSt9KDD66a0WJp/hf60vqod5JfFlu5mS8ZW+OdNuK96/hiqzKVYMYJ
-fg4D0Z1Yldn5j/gRNF1vXmmTprhYaHgBoWRTyFPzO4RXUq+Gimlz3BS/b/WVukWKSW5OW9D7w4DGot/i
-JcnuNWdx3PiwkwApulo3cbayj1Px/wCwB0/4+wN6jK81idYUnwxY5Vf+BFs5WirYsTnbiBRH6SER9ajS
-E9WXulnSE06yDVfcoK/UpnDFWNvF6XLF9n9L0xO19ooVslMtSHcqPgfqOrBK+tWIDuAf68kA7kBBR2p9
-QA/0tSbRmuKdAav8yo2ofJTirAfQw2wPxss+1WTdoXK6Cq666kuvm9xOkvqyJLlHVDPDs1Efk9ujUqvZ
-mufWji0bZ3mPGm6KlVsq1s+sXlZugD5qNlrdo0q3Udij+ByXmxlreWbsKRWydOPqfLrK9XudjI5Nl3t9
-THW/VmEdqZ0B3d/XmkRrig8HbC7HJ9jK/lLBDsjZRqQ4Sg+ykYQeYjsyljvCZ0Vc7i3yLJuRG/9+SDpz
-7oKO1EZonChJuvhak2hN8e5CZe42Kjei8lGqZMmhe9rkgzg5n2ZwWXZIlj5Eub0JyaEbRWs6ugHV3Xfh
-qe1hqw9Mq/8HIogwkwAAeAFNx4eBAzEQQlHdB0ba/hu8Uoyz30TWWn+d/+vyWoAme5AcwPmALp+pZIZM
-Gl7pdGNsJdUN4aTU8rYtNCQTxs3ZViTFIHvv7ZNJTorrWJ4N6MFKqWBeUtR85AeVD/sGhcwE3wABAAAA
-AgAAMBtRrl8PPPUAGwgAAAAAAMTwES4AAAAA0NtOmvob/dUJMAhzAAAACQACAAAAAAAAeAFjYGRgYM/5
-x8PAwOn5S/qfF6cBUAQV3AUAcFsFRQB4AW2SAwwmTRBE38707vfbtm3zbNu2bdu2bdu2bYdn21dnJnmZ
-djrT5VtRCAAguCyEe5F+ri3N7ChVrQ5lwl0Ut4sUDgpT1M2isVvGF74C71lz0gQdeN/9zufuXdr5VLyi
-+opihMgt8ojfRQ/RSKQW5USh4ARNgvF8bS+TzArRzX6giV9KytiPlAj/0uzLDA9/p2oYl+HWSpSSX5Ea
-4SSGu++ZaiX4PTTF0zA8uqLcJFGPKuFzt97S2n2YzSWdLeHn8CdahW/yXuxt/g+Nv20NL9oKsrgP6eCT
-8ZXep3024vlemKuufA7116aV/UQua0he+5/cbin/KZbPitEqOEnr4OD1ufaC3pP0inlaWgnVtyPnrb5W
-5HWT9X6ktzdPW3Ga+X18HHm+9ef52i/nDb3ZVBMvOM1Yva+G5Wkou6Xlo7gNIWc4Se82PgwO09EOkEP7
-FYmSk9N3pKOfTxErQ90oheIjqOau0sCSkN8dJ4GI4xpQ05rRwx8koXuDjrppJcVr+IFiHkV0z8zR76SL
-/qasdkkUq3H9lCUi+83/sxkMsQW0jOaTxsZRx9qTVLNyWi86B5foGetPsVgi8vtFDHejqe6XUDOMx4Ao
-Lu2C7uQTPwVd+cxtopjbyX9uvuwJtHBFKCWya4d/tcsf0kBe66AbleFDa6k67Xdvf+Vv6VC6CnML6eGJ
-SB8mbTyI+/76PuljnN6DYss9XTyKNPEgtzShuz8R6cCkgQcJLl1fElyii961Yta9+z+Kbv8gN2/PGzcA
-HJjX+AAAAAAAAGEAYQBhAGEAYQCTALgBOAGqAjoCzQLkAw4DOANrA5ADrwPFA+YD/QRKBHgExwU8BX8F
-3wY+BmsG3wdGB1sHcAePB7YH1QgzCNYJFQl0CcgKDQpNCoMK6wstC0gLewvQC/QMQgx+DNMNHg2DDd8O
-Sg50DrYO5g87D5APwA/4EBwQMxBYEH8QmhC6ETIRkBHjEkESqBL6E3QTuRPxFD0UlBSvFRoVZRWzFhcW
-eBa1Fx8XcRe4F+gYNhh9GMIY+hk7GVIZkhnZGgwaaBraGz0bnBu7HGAcjx01HaMdrx3MHoQemh7WHxkf
-aR/kIAQgTSB5IJgg0yEFIU8hWyF1IY8hqSIKIm0iqyMmI3oj6iSoJRclaCXZJjgmUybWJ3AnnifXKBso
-JSgvKFModyiZKKUosSjpKQwpKClFKVgpbCnoKgMqayq9KugrNyumK+gr6CvwLFYsbSyELJsssizLLOQs
-8C0HLR4tNS1OLWUtfC2TLawtwy3aLfEuCC4fLjguTy5mLn0uli6tLsQu2y7xLwcvIC85L0UvXC9zL4kv
-oi+4L84v5S/+MBQwKzBCMFgwbjCHMJ4wtTDLMOQw+zETAAAAAQAAAN4AjwAWAFQABQABAAAAAAAOAAAC
-AAIUAAYAAXgBXY4zewMAGITf2t3r7mUwJVtse4pt/v1cjOcz74Arcpxxcn7DCfewzk95VLXKz/b653v5
-Bd88r/NLXnGt80cSFInRk+8xlsWoUGNCmwJDUlTkRzQ06fKJkV/+JQaJPFbUW2bmgy8/R3/qjCV9LPxJ
-Zkv51aQvK2laUdVjSE3TNg31KnQZUZH9EcCLHSch4vI/KxZzShUhNQAAAHgBY2BmAIP/WQwpDFgAACof
-AdEAeAHbwKO9gUGbYRMjH5M24yZ+RiC5nd/KQFVagIFDm2E7o4e1nqIIkLmdKcLDQgPMYg5yNlEVA7FY
-4nxttCVBLFYzHSVJfhCLrTDWzUgaxGKfWB5jpQxicdSlB1hKgVicIa5mauIgFldZgreZLIjF3ZziB1HH
-c3NTV7INiMUrIsjLyQZi8dkYqcsIghyzSYCLHehYBdfaTAmXTQZCjMWbNogAiQARxpJNDSCiQISxdBO/
-DFAsX4axFADpGjJvAAAAAQAA///YkPLVWEgAAA==
-`,
-	},
-
-	"/static/index.html": {
-		local:   "server/static/index.html",
-		size:    611,
-		modtime: 1456790235,
-		compressed: `
-H4sIAAAJbogA/5ySP08jMRDF6+RT+NykuV3rupOw0wA1FAiJcmJP4gm2N7KHDVGU7453N1IEEn9ENftm
-5z2Pf7L+c3N3/fB0fys8x7Cc66GIAGljJCa5nM+0R3C1znREBmE95IJs5Auvm//y8iNBRCN7wv2uyyyF
-7RJjqoN7cuyNw54sNqP4KygRE4SmWAho/k0xTBxwGbvNhjqtJjX0A6Vn4TOujVSFgckqW4pa0yu6xgFD
-w7AK2NamFBmDkYUPAYtHZPlVQgTGXNdoI6VfuMdFP/VpdQanV507jEGOekHOyAhU0WpV9dgvNtOOh89Z
-D1lMwY+YC3VJGLE4HtuzOp0WV4NDXSxntyjZXvbbVjzI1rfbMhz0/fQ7FD82TQQ+jGs1XbgCGJ/UWwAA
-AP//AxuagmMCAAA=
-`,
-	},
-
-	"/static/js/fetch.js": {
-		local:   "server/static/js/fetch.js",
-		size:    8779,
-		modtime: 1456790235,
-		compressed: `
-H4sIAAAJbogA/+Ra63PbuBH/7r8CmbYh2bNpJ9d+iHy+jpO4tTu52ONH52Yc50pRUMSEIlkAjKJL0r+9
-u3iQCxJSnLvptJ36g/jAYnfx2wcWS8fztspVUVdxwj7uMBa1kjOpRJGr6HAHXhRzFkteztM5V/nCEDEm
-uGpFBbefkcbxYFUtlllZ/MxfZkseV/DjJiAbtW54PWf4mj04OmIRyqneRI6GmaEjfUlVfaWH4+RQj37u
-+Ozfvs72fj7Ye/Jq7ze//d3D6PffpK9e//T3T/+82y9SxaUyknu2aiHqFav4il2DCidC1CKOzqr3oOqM
-5YtMZLnighUVW/BsBnfzgpczrUaUEOFm1U69F/WKi2eZ5HGyGYi/ZWXL4/f4G4BCv9+AhRk7MtcNaFiF
-NMlIh1O9FhmbNUnHWS0KmS6zBlh/1BNQlGBoY3iFo52WdiYAI1VW5ajxqc+MWcRkOq/FSZYven9ClHaN
-aoTcritA72Fk/rTfZU3Dqxlll3Qkn90t3BhYGC/BgYnyPcfz6Vueq/QNV+er6kLUDRdqjY7aQxReBVVq
-rJKde4tPd0QfZyWNsYUtbUStajS+5QGIhwBzEl08jMNqh7pI0Ns6y5aFVNayaHejaGfjBzjcr9AS3951
-oUNnwQgSEA/Ex7Rp5aKXGl7wbTTjJVc8uhuu2Qk340TieNV3m/EEs25ijCAYr/NgCLP3g0qyP9mb24M7
-NmFVW5ZbdTguy01qWL5bFWCfPhnsN0hYZPKe7JGU+HkckLbZWKkcozlwze3LAA8KOmW3tP19dgZZBQQz
-yCoFpN9sWnIG8QfevEqDStngpIrlWVlOs/wdNfUwk22Je7eILwa+k2OR0Fmgiwob6+NdIK8r2S75LJ7W
-szVN//ic4s+N5LNeijUh6LgsJE8FR8Xjwb51XApAZs3wN0ro9uRxRQREYFeYFyW/1NDi7zoW+n7gRCjS
-atEjIrisy/eweqMXVRtZpHVV1pmX0WjitLOtPFibbEvVpcshJ45r3cxK42KJNanPKGAKJH5a1tNjeSxE
-tn7azudcxFN4Q13HsMSMCgD8uUMqTnaIdngJsKH4bQB5i1bX/IP6Ner0879CDx0tbdPUAqPdyEUeExb1
-0iKsi3RMPXzIItTWezMsIHVuEGtiLlQep7nShSYr46HaZhBjUGDG3Le0WUYGm/rAwnGyq+8gcJfPM5Wh
-zva202+E91MIjtjLYCRcjJQuRi0wKTWKnfRTURUKeVEPpTHeU+LbM6AGSrztRkkRONWMgjWgzwdtPORD
-Ch6qMFoGMSfZs5AX7v58brTdJAhn3kOQgx6FOex/mUA3e4vQB0N8A8hE0XCmT+5OATaTtpVdCZj/qbMT
-qhuRCrNzO+oAUwNQMDuZwMUMpb2q2wNwYuLZ31H5q7I+7waJJr7zeNYKsuj3EZN6B1NCKI9NMoR8gGFe
-t3hSqpVOR50bGKeGYgWRikai7qFslzVufTPfJV8yTtZn5s07SF8rTXVqStWCV3Fwl0iCUpRxuf9yFxhs
-MP8JD0CgfpEH+HYPWT3A738gP///ps1fHzODyIDTWXc7uacDWbcZb/Tzkb9rBvMe5C/mElygyyUzntcz
-HpSqad9KKEiCPDdx/OvV+cu0yYTkCeVGyMnp6vT6+oItuVrUM8lWixqsmmdNofBIluliSC505E553z+Y
-2aLQzYNjXPT85MXJ9Um0y6K/nFzj5fTk+Dlezy+uz85fXuHtxfmVHrq4uY7uwo2wHzTL2HCmRW7b5Jkp
-wMxYquqbpiGdtW6FdrJMi2rGP0Bw2KkJ+57tPUrAGRyvieU1KgAv+T9abBC2otxldYPvug6RfQRF3B0c
-xj9+7i0Gk2AQfokVc8FnvFIF1I79RO8tMInAL1XUT3J9NVPYuz6dm+yaUT29WQxt9Fg03RRLgKLQSHQq
-+CBRTD8Cme1jWCLBYZsT5qSBA11oxJ54TIHIHhmMBrRXJJjTnCw/8wR7sDpH49YBx+t6BYbD8z+IYHBB
-hmB5bS/ptWH9HB978kYWN2HoZX/0Ooxqd7CyAW6dTZ+iIdEvIexkUxYqjh5GgQbBdK1oY1Mf6/1X9oCF
-PDBD46DjeERyJRLZRp8eTeWimGPQC96UWc7j/Vff7L+B6GKDSa79Z2a9rYtKM946D9ft+pcGmpvLs2f1
-soEzd2Ub57ssMGL6N4Fzdn9KA9Yj+K0zxx8WgsKPrwfu3zcsm6zQwQFzbEvtkktQQ/KOdmCgV5Vdop46
-ttXCa3L4djFjA4aTDjIkfcfXI+MY+p3t1gA2PiEKc/ADV6+h7aOJlA5Nm7VoKww/xoR2j2EDjLL8uq4O
-cgILWCv9+MOLU6Ualz8T4vW6GeblQcwHeS2kVymhNVeFWjzzMiZ2AA6HxQLpjxi731y+8PZcFBuRQX3i
-Jy5m/uyyUTChDVXTsF8ev6+LGUCXt6JQa7bKRAW1nmSgBfig74Cwn/LKy1vTNXt2fnnlKbj/+sc9i9ce
-yJ3sL83HqS1unWxcwEiHOPK4e7XXoDYZ4YsMt3frtBupTLVoI62xewLbPnr8+FvcbR8f/AF22n7QW72l
-/449OjjADcM+f8/++OTJcJWhNudLrla1eOd2ADbPipJ3DU+6vFHRadTvt3Pvk5JWY2Kvu6MRrBTpmvCZ
-Utl8NvESGyWA8mDi++0G/cyxgnVu7HwYgKUeaxF2j6gPbaP1h2XnHnqr64ub0enVGP8+DdavtIgvAVKc
-yQymSLA9c113YcwnOySDdBigtA4HKCXCPTjmIwJzEEfdaRiqooX+Oz9Rak/B2NSY2NC837dKNxm2AnvS
-1NqSIynm0RZq3XlRAdDgGFidgUP4dMPPD1gUpfjBIh7tG8mgGCYeg5xGJbE+QQ7e0UL54/hY09dlvn7m
-NGNtBUvK2pKWxKa29svSLgG5Co+kGU1Qv3Mf9Fx2OYKkdICeQ99+x749OBiytWdfnzW+DJXpg9J8qLYb
-xieswaOQKQzWA1toS552cuzdoRuxBoQRe0dGbHo46sx42DEcVgcseN4hVUHwWGTY2H9uoJzTpi7X80J/
-6dQdfOzGH+78KwAA//8H/9KvSyIAAA==
-`,
-	},
-
-	"/static/js/material.min.js": {
-		local:   "server/static/js/material.min.js",
-		size:    61655,
-		modtime: 1456790235,
-		compressed: `
-H4sIAAAJbogA/+x9/XPbOJLo7/tXKNw6lzimFDmze3clD+Mny0qiG/njLDkzeX4uFS3BFnckUkdSSXyx
-//fX+CLxSVKyszNzdXW3GRkEGg2g0ehudDde//DDXxo/NFZBhpIwWLbmKA3vo9YyzFCj1ThlxY0TUtzo
-x6t1HKEoSxsh/DUee43/GDeCaN74MDkdYUD/5zNK0jCOGp8P2vB/pGgZzlCUokZvHcwWqPWm3SHFs3j9
-kIT3i6zxpnPw98b7OL5fIq8xjGZt1iz6rbHIsnXaff36PswWm9v2LF69vicVX5tQhnav//LqbhPNMsCh
-6X5zNtBvmiXhLHMOeXkDNZGXud/CuyYi/2ZttEQrGNa0PVsGaToK0wy6irIgjFL42k/TPi5H6bR9ejKa
-/sd4ejm8uBgNpoN37wb9iet++xwkjdSfx7MNhtOeJQjQG1CoTSddB5HjHqYC+GA+N0BmYPvnZ5Pe8Gxw
-6XrVbTRsDjEyYRUyYU1kMArBeo2ieX8RLufN0PWQVJC6TwhDGHwG8BgcilDSdGaw7L85Xr4Yqfstba8T
-hGudoLtgs8yaHFXUXiTorp2uYRGbzl8d9/rgxot8YV3+a4OShzH8OcviBFfZD93DrJ2gFGWT4HacwQin
-TddjRRdBhJZ5ISodaq8/GX6EOR/1xmPXi+rXfXKfnnKayjBNeakXut/yMkyBeICReYCBHxIqswwwwmuk
-DDBzvVAfYaoPMZTQHo6nFHPXC2pWfIJ9Ebaz4PY4SIzbQm5o2RJBFRWWo6Nvh/L65q2wrEJiWQMJ3LVI
-9Ut1GwT1tgHwHVhc3weSuEdZLwPedLvJUNPBBOK47dkiSHpZs+Pu7QHtqfvFA5ICuoOu00X8xY+8Ol1G
-7rfIvPMCM2Euywkz+C6EWb4IAmG6T5TZftus75Ngjk7iVTcfK2HsTx77xBa64nMqfBe+9pbLS3SPZzVB
-865wqDx5CSu/ojXn/WC5vA1mv2kd8YpKD/P4S1SCwdNh6gv9qefWHawDnYLOYfrTor1E0X22OEz3913Y
-tIvr9IZO5VmwQpjQ3ARlmyRyNtEc3YURmjuv/OxhjeK7RgZUhhv4sH74v4e06qsDibHRvZxpJDsPsqDF
-ZmsO+4g2bkSb5RL6zY6uHeemm3Hi8hy3gJrCUFIKN/RxF6xx6+CVD9QXAqpfz+/w2ZI3CZsZbgJDFEbi
-CyMxFacun60AZisoZivAswVjD4TJ8uifjOzcQ7RM0TfKQbJDS79sELGPgCUcxjCfqR8XMJ5490nBhKQd
-BVTWdNrOPmyVKWA4/SnhGE4Bw6iZXE9hQ4onTdQMvYjMw6umE9/+A8AU6IR7eyHIZmkWRDP8NyMw180W
-SfylEaEvjUGS4I08jD4Hy3DeCJJ7glZjncSfQ1jIRhY32KI2QAxosDO47XCekWEZYOlf3+A5idyU4PP4
-uGyvN+miiaBImLrYFwSNw0Ub5mMAYmBTJPi4OFVQMXV7e60DIKNlTgwIil7h3lCxZFDE+8WcIZ9tPJne
-HBqzyZy/nZIJxdOW+Es8qa8SfVKuouB2ifAMwDLD3DSSnAM0Zlz4bUAvjWyBGvchsNQGwaVNzjKCSCJg
-B0wxLdkycKb8Iw4jsjfo7PZ9jA0D0Y9hIZMNYbwg7vSv1zd+4s1oN333kI92A2Md+NCIsaGUj3rwdnO4
-gVELn643NxhW0v4SzmE3A72G1wLGN36fIrLSDs3P5Mgk/0lhtCtYlzBjpav5spXPTzG8Vx34fzwJ8xCO
-2my2oNVXIjkHmAJ6SRI8tMOU/BcKHh+byHd4nYK8URuk/NURrQ4Em8X4QzvFKgYZI7TtXqObYm6AZ8Ds
-YBGTzUn4NiWsEtgZMDwvE3cL1mLYjoGJwYc1CJQzfLwnKGIA3nb29oJmUewKLGqJj3fKmo08Kp/0sq+A
-7Ctg7DAFwBlZ2eMj/0VXJ/K/qRTSJbIZ/ws3EP708gXG1fDvXjoGoozuSUWxwOMbENdkP0kl9tujeHRD
-L6ep7vXNE+YF5t2N1ay8NWzoqNji2v6bwK7K+RCv1lgEaSNYAhXOHxq3CLZcsSu7DWdf4BmHtDPh9IuE
-3VjRHa9X1p+DpS9pagUyhHbnX6KLJF6jJHtorg1cFzPUgpHMqHjTWG3SrBHFGfT8GcGI1vtOg9FHA+OC
-kSQwCyaMmuq6gb4QPD4uKHeIBKqM6cHJVC0g0UN8SgjcgrQQj9qkWUgZCE4lVJybiJ2bSDg3hZbTQlqY
-CYz7cEakgBn07h3QIaSwJXP1rlSuEAQI2BtpARb4oTwHWMVm/YTQjyf0UMqGU5UNR1XcL7Jyv1y2K/if
-gIbMCMVVmhczJ+6eWfsuXALpNUUNgglahX6MxbwnN99+U5fsRyTyNsIzHx+lsrN4jvC57MoiJRJFShDO
-gE+yE50IHRqIbaWLfIqIfBEBBHJ+YjHwiQj3CxAuvBn+Z+3j2c3tTsDv7sL7IagmSRQspw6TGkVVIFSF
-/0gT9wOziJ/Y5fq4kOSXBul9/vTUxHYSBc+LzS3Qol4ulsDvAnlf/KP4wvrx1QKtRqpVSYs60mB9czHU
-ts2Bb/8ktBJqQak2U76hDA6UCArb+Q76EETzJQZV/UVXfpdxMBd0X9C2c+nTCWGXWSwB8/CzA7KkI8nm
-YgP4pnaGP1M89vZUiYTtxKNm3iH/wbpUtF3CQ/4BnMWyXqD2d5saQUhKqbAIYjk+sE5gqO0o/gIyBf8p
-1qGbqIm3L/7sYnY8CVeo6T7lTX3+oxCtQv/a+YJufwszYJyr+L8dbLPrHEY/hYx/gLjO1ilBMLFp1ovC
-VYA7fZfAsXG4vx9xM1F4Hd0cllX26cfrYN+5NH2Hzjm1YM60tLbuGz47N4+Pao2KXsqQNH+sgZ/xG7bE
-vQ4vmsH8cQG74DGeu+0fzseNf33dzqCTJmsaBZ/D+wALJJsUJb17rPU9PpYuQPHZ1K/LVN9O+bqIhxU9
-vjilYJbonwbZor0KvjaX+wf/6mW5kSClJBZvsqZAiaBE+yCIeGkrg3/LJmy2REHCIPwOa0JVW3Hs2SJM
-i/MYeeRvLCTALuIzyO9TjjdZFkd+7MUCyyCyfIAbw2aWPhR2MP+bZN/sMrbRSsL1eola6O4OWwM81W5K
-692SXqdTVpnp3MDHWH1ai351ZBRul5uEMd+pNGq0tycNnNTEfENsDUIPVqtFliM3YhXmoPiYWxrKpHoo
-qtvBwZOpoV4k1cLLKALHd0YifL3EeJGEKxjM25LFHFUZq7XLDAtUwWhOqtB1HXACLe/F1KRev6pZ3ACI
-Qb+NQQm+JF+OC/Ly6TeB3tq3sHkIHDNaugywioEFbtZOWT8MVr5eIs4ghhYt6V7dDkO0FW5aDzWhAAf8
-jMoBPYliQfOboLaCSCupTl1HZk5OYQfgTOaWfWAGANirVFlKduaD/QWa/XYbf/VB9DZzwsnw7NN0Mjwd
-nF9Nuu1OB7ZvYmONw7OLK8YSZwzwdBpG6w1wxOPzX6cAYgQbQq0B/7TgEFkCK3S8d+f9q/H0w2B0wblm
-UfEOtkzaWqDlGvPMybD/sw1kFs5+K2Buw7KH78/OL6Hux8HZZGyu22qF91GcwJ9UGbVw+wIbG7+f9qET
-Xp1Vas0QVq6MR4I3HE/J/AxOuk6Ytsh8YDUXyk+G497xiH3g3JZ86X8Y9H9mHwhOrPzq4v1l74R9yFVx
-eXnjqL8Ions01SlsswYdHvHFxyeO3PAdRs7QzsCgjdysGKsGGm+wepATtIo/o62An+KdfbU2wMccRxuo
-PA3aAUgm/IQtR5PxFVI4ie/vl4jc06kwb+Xxud/YrjWLbGx/wy4bKDKAxhbzfd0Wt7XSu4qdNia5L0ZS
-R9suLiNLt7vD2vG2FYiXfdRb8lWqGC/fW1sPmO/QnUacNzYMOUfc+kVqYxMGzcMEidCrsd85UEOZVM8i
-J9r6PqjTN4OpF+lTVY+Wa46ZQtRKZPYQbdVvrfFymIYyqV610Kyj4su0qfgetJ19nTjxoU/lkOeLzoKU
-QEFmVSBVO44GUhQouOG7yjPrrql5falgRelDd8gqkW2zcsHXe74WsxVjMok9kpjf51LLDpqL1vZZqtOu
-0NjEvAhiRGDT9ScmNNDdU8gQFs1J730LFYrB3tXJ0KY0lqMnux+WEHcJFFGnG2Kec86ESz5rTNTUJk1s
-QaRK3oCKmKX1sajIqxOx0Vyb4Vh3GWXubXAAIyNxdHToEGtCIeK9AQgZdk0YWBI0gMBTsZOqLE+Uaz6u
-rDzMJhpxVaRMXU5s6jLXYQ0K8yz/pKrMJepDhco8BKSoHOlPvWltpXlaQ2kOAXQrI7BzvVn1qPynqa8S
-Mn88DVae0a301On301On31NPnW6rp06/g546/V311OmfVU8tRbzso97yz6Kn2hG3fpHavJieagJqKJPq
-vZSeaoCpF+lT9Ww9VYeolcjs4YX0VBNMQ5lUr/7lzouoqjtoWIbgiv9Vsmxz8z9Rz3qpS8Et9CzNFPA/
-SM86j/5X06qpaeVTtYuuJd3slClXU5tyVWg7BvVKUBF0DWu+s4Z1iqKNP/fmFt3qsnc2Hk6G52fTk6vL
-HvkxHgAzOxl32z96ps/vLnHo0vlZt/3vXn90Ph7kutnB3ztPUkc/o4cZdoeEjqhmc/CjNxj3exeD7pt/
-88YXvf6g++Mb7+pi2ru8PP+l++O/eyfnv5yxv/6mQJMUPUW/gpnYTKeCRiVdZdKv+Q3mcDI4FT9gJ3xa
-qkWsqtVaVt3tOyuUmuJnvnDEXz4Ox8Nj3AI+fA7T8Ja16J0NT2EJz96TLwH1AIru8W3yZHJ+Oh0N3k2K
-8bZat3GWxavWEt1leZ3L4fsPpkok8tnxJucXGpgsXjMY+KsGAH9mra/OeiOYITym4vsmCpYwI0RJnW8l
-55QfNcRFsvqkUc/1nDesg0RwfwRBByXZMbqDlWsiuWp5Syr+CwdR0czgeqLAzMkQuECFhZ+Ot9LAnxvh
-6VlCtwxIhx6Sx5gpY2R3AfJIZT94aOe4j49lVYgjO157UtcLfRx8d5jisJ5iYNCEjer4YUjuB0IcXYrB
-Yj9VzhZDL7RHk5LaC+JW8i5O+rhQPC5dY9vf0AN291VbA5e7jYOE1pag5O73JcI1j5jT5WvgR7BgxREI
-7OdnigGT1ikGrNyMgie3pwNVW2vDP5SjDCMxyjDCQYXl86p053qkTRbcDnGog++0DhzPAkaeYm3c5DZp
-e41DUTfw6F5A4zBMDvMxxYPz4iopN640H5hOJO6kVAE8qStCxxKTSXDkslgQ66HMFdP7tP36CAeQyzww
-OeepvuMsmu6gi4rH2o5d07Y79M0Py237zdvt2OdOgy0a7tBrfrBv22vRUM96YbCXiTcfcymSTWL0vhLP
-Jw2IYSicJNwRXC3HB9Ex5lAgRAFgKLoEjk7cxLWq8plvaXj4nJl9fNyBMUokfNRUZIp2mj0sUZtIZzgS
-Bv+3ldH/7jvrr44qhLAGINLpMxDf3WFLdrzet3z6gHK4dmNpjd1kGwaWQW1ojeBb2YiolOun7AdMAozx
-Wai+8JSXIfgd5uP5K8yOCarvYhdl84aVBZs6G7fAtWIn7yCO7TvdKM6a19xUfeNikRr6EYKp1QmrQxCF
-0obzpKA2SEF90Jtx1glcNVej21xTPoJKWjKV7Jrj0Tq4aRMrDI6zKgFXKNukWwPETgHIzFR16XO7Rfou
-S4J7fdFV4WHwJZH6mVtE8oKsm5DgdhK/XbmarmHq07edo+w6FVaya1xfEsjaKO+nWGZTT/k0pfsHuMt9
-qcvOVj0Rk87jY0kNaor+puPBPHBw1B6x0fFgZPybqARYWafzqoQdh0RRNLXbrB0sONha5V+I8tJ0n8gI
-y5AntivLXiE6VThHOKWRdasYBJAci0WQiqowv7N3j4DOgOHiQPzgPqDmDs7Ul3EKQsQU312VXBdzkyFF
-z1OaHpRdGUsWPldh06AoLB9gSNK9Oc7p8xxBRrlipQcOrNDad5wdDltZxLHDTrAI1mkAm8EHVCP/5e7S
-aSGMVHTo7KO8O/Zr5w7rDZH3wweq/K31Ls2/vPzUdJWHEg6iOVfkLRSuXXpbzLtt0Vqp0tx8XtGjSn66
-lSFLgigNcWXQc5mMUzKY6hsHGjc8ycEOaoGVB0ZSoO0i4HBlynyUWnSN9oIIY57BZGes/iWcZ4tDszRI
-vvlpmcBIe/NFoTLXAW1AlAoSCCFqW2FYJTcaP9Soym83vGcY7cosQ9ieufSfwVFUA+rWCkeLmN5yYV38
-kwro7uvsh3DfSUGykmryYmq7Y4pATvInaBk8+Esq1hcHA0nWUhrRrHk1beFdUzAJrwZnTyXGB4xWI9bq
-HrlE+CRZi+mgLZyJyTZCfDU+IXGOE7aW/CjGeYkYp6T2gjNBCuFDAz0/N/1R5mK2xMaKSCKad3MAVjtu
-rEr7hDupBbKQAx2VXchUs68iR8/uWy+DrZcVKV8y2HroOuPkSicsT2kkHAStOaZfx3qPYTT04PQ9jI9G
-8FPgkc+gYWHv4Ex0JTRa4jnGybSKMlVBFa+hWiDVoAq7fhu9u251JJAplT0waWkmAdaxXmS9hp/bruHx
-lbjhAn5FitWb98XON+9AZvcJSlN/4S1siREWdkfmkwFoSqfDs96EZeiliK4ZVOzTPEfQ0yqMoENHhoUz
-l/Lu67jMlqyUjkhua+S43AaKLAAi5b84rh0lS7la/3hzd4cM8di3pNzcK6P4zVft80Gn0yJVNLxYP8ZS
-qe6LXjqTlG6OMIcN9r8Dp0ZQkzj3QIgVfXpSn/n88R7f1OmxmPVt+6OrwTv7sU5nbAH5FrNSmtPhS24h
-CgeWvYwqCgDPdftZ2PgNJ28Dz1nnn1S+M9uZ71wG8zD2ZzizZs1wipmVC33/EHkSk9E7GZ4X7jAYf8cj
-hdPjyRlzfcGl0ynP2kC/whAGl9P+8LKfe8jQaiBUoKQ1C5MZdn6hlYdnZ+bKYRSJlX+XABGGykuFhshr
-ag7oyHOKmlwr0uOHPt/AZjdVPKf4rgun2MvEFHs8DTLO3FzDjzhfZxxfJ7uDRDhHlcsl4NssGpjdRmg9
-kCdxl9I20J2q1Zl50YiV2feMWJnpESub0oiV2XeIWJk9L2JFXMOt41VmtYM3xG5+99ANO9rWL3qbstAc
-cbS/b2BOKdplH6WWtlgV06qWRG2YQBrKpHqWSBVzz9a4DQNEvUifrDoLW3O8FJ5WIrMES5SKsddaY+UQ
-DWVSvZq5FAREtg9PKY4VwZeMHoN56jdqfyh34Sfnw1YtjsXscqxBiRc/80hXW3D+LtptXih4QhOdXio7
-hC5nbZWeQU7sQC0xL+Lw9xLxRVV5LvSnjSxwy15DKh9H/S5YwE9aLzhCocCXToagPbhkX/WUh9sILKhO
-mIq8r/nuKgWiRalIG70WCDVIRdz4O0WpqAvxT0wIMLMpr5dUG9M0V6alqWrruobamg4HU1/L87pKL+IQ
-KzSDiMpAZTrueBnOUeKvvbXNsra26rSa7pUSYKAHSorXeDQ8wXzMXFeoeNzr//z+8vzq7GT6bjT4Va6H
-E0rfJ3hxW3dL9FWqPTr/RQUrVF/GXxToVxcXJfU3a5LID9Ybwx1Pph97oysaBYJBpVnrc7DclAWPyJMW
-RyToypoE4COGNsaWFCoIrLfJIFDe2BiNn98i85ysSod8QUjjE+Ilr73bQCGoVyqyd6bVOSUrcU7xvlHQ
-Xd6HRw0VKRSwX7DB8NXBr11yL4V/sZJP3Vq3De2HJ9VDVPZoyfD+Xms6nzjVotBFhQnlcCUk0pLLVmHk
-vlbqrYKvhlqHHXyrtbXuIdLrTgqIBEBgMtxOXeySEd5U3ACINyRnSpYq9GL/nbHiFd5wEqyDVnklARpU
-VUh4u7TCppaGMqneVmmFDQ31IqkWPZalTWd6pAvxYB2Z6HwkHXdlDIJ1pBdJtbYIhMe08kLxYkM9FNzM
-Zv75IWNP+StWz44SU8/GLUaqBo7tOtKsZKQVienoICuFaeVcV7vMlTiFc1RcjJi5TX1UiNCgCtVGoFpf
-lBdthx/jX/XxI0JKBX4UqBZaL2Wk5uKHWWmWBP5aWrksW1eGytNeNJlC7VEXOrbJns2yUpmnobp5hR70
-AipI+e60AFQD+KyzWMLwv4uOs7bpOFSbMCg5Kfugajn9Ukc9Lipk6OuW+VVmwqBOB+Nx733uwkF62x0a
-9WpzvVcaZsZ3xBJEX/G6RY2gsUJpGtwj/nQieTAwaKQR7OfbIGlTh/tXBjQrQUeNgL0FagWdg/2Mcisk
-bZRbzD7H4bzBzKIMV7mQ1p/AoOVymLcNmp/FWXgXzoh3DIjI1zf0I35ii/YTzucomjZfdQx6KMPU73t9
-sybK3HvOz6ajwdn7yYfuG5wlQaw8E7XT8Rmw0uMeV/MYeMdj1CAXT6d4IR2PJWRQvtFR068flZatFp1S
-R0YFKxTL4IGPqsSpVn6ALIC5aC3IPDmeA/sNOa5pqbjoJ5MJIcc+faHXVxfM+4upRXkAstypa1vPA+65
-J24HHRlOUtU8Sd1wHzX7Z9m03QUgn2FDYnF9RqEuURBtDGdURmthR+K+4pmX06XCpyj1k2d0tb15Af2k
-+QuCsA1xNH6DPsna+BJmi0aQNXCdTOAJ+P0zSjSMDxQ98Imr0ZMADdgCA4jmDCSSF3Rv7xUSKKQaPHv5
-F+rSYeDHTmlIhsphjqxsgb/K2m1KDEnlOvmgcXAJXZ4jaa38vLwrl7/5t793PG2kTRO/U2q5njgdchvK
-86TpohirOx27iNqpyPZB5mP4nuc/8dzpfMM4pUp4lgi4aW+VLsK7TEOXbxI7xzJbEkxbtuT+emv+Z2Ut
-juMdxzHG2sgS763w3dz9znRCmaQFtV9TlTJXYhNPNbL33U9i5YAXSKncQ0A9XVUiVmZINigemSaiemG7
-9vmzLZldJu1bZdL86Nel0vyTKpdudnYaG6+JF5S/8TZmOeb0ZDQdX9C7vVHvE9H+r84m3b89yS1EYUZr
-w4QQ2td0ipk8lm2EavTacNofDQVjN69PXbRa2K1/bW5obCBXfN+7mF70Jv0PSt37YN0i1lS5epHOqECb
-ZDMSKwlZjfJaNKmRPDtU4x7hYRtemnumUUabbfK075Zt9p2Ws49eyIJiX9gad5nqIlRdS1KcKm8ljaRg
-eyZXhh09Z7xbNabBOkIanuvUC73oxsPvFy5/Cri73ZK729mTzVDUK3PN6Ki7XnC9vFGSwTzpNjDtdlcq
-iMov+e3bw1Iu1cdhsVsc+CTtGVV7lI4JILVAqREkWZ2+uMuwrSMMRiuR6lQbrotAmYND9JMaAWfh1OSt
-a3q6FtOJH7OuGkkt5+eN9Ryj/NB0jPEv6ik22P0UA/l+tvAH3qC27/PAHoGRp5JPCdg8i/zkEnR0+UuW
-BFhSmny4Oj1Wviw2q1vTS2y8gvwO2+/jfcxx+SNmph9sc68seqYNvp+f7+B7+vkOqu/CqXGV+/kOvoOf
-7+B5fr7PzEw/qO3p+wdL025H3PpFb/NnScNfinjZR6nli2WmNwE1lEn1XiozvQGmXqTs7GenpVfAyX/K
-X+/unp2MXoWn/C19/wM9llbz3p6c6VV+sDUVMSIFvODraNKja5Zb6F08bHe/Hf29nXFJtT99sv/vnOn/
-WWn7lXvgP/X7aNs7C4i+wHXy9mvvads8/rfPwG+60t86A784nh0z8G//oHcNx4Lvk39/YNVFiZpjUkXZ
-B1UTXe2siU6C29RfeSube/LKpnZOesdiuH8GcECJDECFvOidDUb6N9h6aMlvefnnwgDhcc8I4VMRfItt
-BXUfRcN1zeojRURXHosWsnZo6nUXbVaeRrwSeNpr3QHZjysTcjxvbd1Tq3qA/HqI4Fsi+VjTneR0wvMB
-YDLYEZZAWYXRkWZSKbAUU6rgy1amHZBv1xl1mig3Jmn9yqTpysuZIKxfBrdEY5DWtDB/dQ6RAcPc0EVx
-Qzc1FBtx+5gQucDTWwsVthAaMqz82eiosrU044xO863QLLl6WtnYJG5pYJJ4Nh3GGEfVjHEVfL2Mv6Sq
-dfLsfHra+3V6ef7LuJyBoq/ZXYiWc3/kjcxcVADVbR14/Pe0N5lcDo+vJpjrBF8TQAI4xcjGcEe948GI
-8THeJ76ausVcVTACCh+ZHZCo/peTT8ySlWQPOxq+hmcfe6Mh/RBGn4NlWJYxQR5LHP2MHvRwB7qD85gJ
-4mLdTtdLmGvn/4Gsxsnz4EfiqsFSXgL5vPXF1dvb04Mh1P5f1LI2+p6WNRn4Jd7XtSyJo5cwsH3Eyxpm
-D1LhCaYaqeQdzbYqd1rDBvYHMH7ZMbZ+0dvQt7KEIUpeEjbzQJeKvK6a/rI+6e0ydjNlCcMwF+u1OW3Y
-lvYz+86de5Ri+sM69JIRMM5jH71t2nhD09jz0Vi/mEglKR3+BuVHW1GUexDtQPLAt3ekd9zSSOyJacik
-WKpdavCsZek0QTOUSfXKLJ21TJwGYHqRMi1aQJC2hDgJIndJKu+TQdOLpFpVlke+g8j5voPNkUgLogr9
-zJczKQxXnhkpE7QiP+lSjsvHxCWudZCkaBhlEq+QHMqqYXoHHdcL07PgTIKt9WWX7lzRQHIlLq1kKZEX
-/Z9sjqEvVGI5QKlOZQOz+aZWJIdpwFVAapttdrPXWJsSBcexzIgrSfOv/JIV57RBYDCpVJlXLqvWn1nD
-i1AyaJffAGyv6gvn2OH29igTuDwMxUNbmgwEXDwrL3CCTRYzUUcLo2R5+nVZssxWNrIqgVzdMWmCxTfV
-Yjbc3WIGcl4Wrv2hN7QZzYZWXw1gXSzKQbB80dTvTHuj0PmDhY5X+PXln6hfn+DLl38pnjdUPmTx2pHx
-oo7lNEoc+0eUqmUlLwiR91n2M5ql8PUbL/Tpmy4ZyzgLRZHfOvhBIQKarfkX2ghnorZV+cCg7PLq0M6J
-qHkSajw+PrDO23A/OFJwLJ6Z6agRFOTLCuYvjCb4O3fJN7QNhWziVgABe5Cm23mb7kdmRMhbOWWYjEgF
-CyqkdVqFCwERUWR2uGUD2jTn/idv9eB/W3YyaB10SMc7vDxQ8uoAfWFIIuT93Tuyv6cg9GMcI9kNliEq
-c5RvL47o1icACyKwMoURCj4bAiS2U0KMndRNTiv3Z3ij8xCZ3tO0vb+JuKAnVDa0Vw6yLLglz+XkL4KK
-VWUf/Lyq53Qc7dKaMFlJytA4cElAMFkNW2u6VFprEVPL1RfzjCtB1Xt1UAtcFm/gKM+fk3geMILbEo9K
-AydOBAHHTmcLRsRrtRRIqdQxtEod9GBVZQ523nLr893OQsYoeIg3sEu8O0uUA4iyvwxPJh+6ThPnOCFM
-q9s46Lz5G34bxcO3LuP+5floNL0Y/joYjbsHnY53Oji7mg77OARz769fB38/eXPoeP0Pg4+XJDAFCxqw
-iJ+TOGKxA/wbkzX4Rx4yICJX7w1tuc0pNMCxpJPe2Unv8qTb8caD3ungpHvg/dIDGO96o1H3jUcH0v1R
-biwJVspF35JMn5T96MMA5F3l8wIFJGz65LL3i/ptngQkrxGGDAPS4cIq8pZF9l+5dYtnAaZzvmKLS55Q
-T2lG4S3caC1jBLZjfe1bvs58hjsunb0pWx7DJLZaKQpW+J6AVS0W0Fj7C56Lu2C5LGDTVTbDniUxrvoO
-aPlkqi9lq3UXfkXzFl/Q8+N3V0B7k3NlruLbu006w2m86BbJA5jFySQRS+Ta0j7hODRZmG2ordXJe5ge
-X4F8f2YGwimE18W7sLQBkR7UVmR/ljajLzTyduQiVa/Irug/9MZTviXgNGzxrYDLoccxLSXXbKSMrtzw
-7H2+Mvg7XTPgmvmq9Htj/H7EdPyhd3L+C3WfDlL8tnsrXQTA/qh39fkpMIsJ/Ryv1sGMXmGNT4GYcF+D
-wRn5mK6AfHA3CAe9DTnO0/MLVkF6VF5Xu0ofp7c+Qo85Ze/y/SDHRKTCJVaXGEqtOFo+kPoy5mJ9cQi0
-vszjGJ8Zk6nMoxdl2YkmNKBzXNucUDzmwaWtVzuZJcSh7aRpiTvaLd5touIVGTboPjj0dqdhMlri75fq
-rc1v6Ut0yt+nqddW6DQ3rdRvKixL1zIXP/kdK9hac8FlXr25TZCvOR9lvtgvMiVP8ub4TXzOU98cJBVf
-5ROJpJg+0HJC2Bw254u90M05Dv8bmfYfaV5UOUXzMPhPbFnHqecykJfSra98pC2lKuq1ZluCwMLoydhy
-fYf9WfMmqWCrDFpxjO4AwlUmmOJCve6Nq4gzK+zt5QZW4geAW7JciWyJX5kf+cSOAdYa1As1QdkmiQ4N
-DgSVxEHJWHpPz0olW20Y8VVBvUPyOucWHX0nviBu7GfsammANm8q6R0I89NZ21kktE6NnlPftVuRsvSM
-l/LGr3FdV+gi7CDVdzl7xvo5u3wrEDoGpaQoAjiSOVWdhDTIVAt9BamWSHUsLQLnqXUgswwZVYAZAqXL
-a/kgtXjRZ5tK5Js/aOLH3KVTfdduhivjRwZT8pQd3YFeBBsyfBsdRjy4PPDT6+jmMCjGv7cX1KI9Jn8q
-LNAPXG8HYJSKXeWs3REYMz/k0LhACOCerKavdXCPcJYax5OloTVKUlxprt3JUfMyLFpyt4y/fPIdvgW2
-fApTg+NID1DKsZW5NZDNN8cKNMvjgL8wwM+UGgyQacPsopW9XkpsTG1uYsrT9CtdbnNmSrYQ90juhxpI
-doWZG02A/Ulw8w/yQVvvyeASKUV/HdQOWsyyY5BDpHXeeQaoLQGTuzyvpNirZG0mcwSMbMkVAJkaXkAt
-ZLTKFQn615aQ3K6KH6Gix0e1mAzMivRW6lsV3jWBaajnhKoyLANBcaueWE82dejkaazWdF1Z4ODJRp4l
-Q2HbSux+q0hZ4sX1hAOtHgwAwWdmktO/K9dJlZlRRNTx6BMr3iEICUmlkN7HaTiTNsl98WFyOtLSd/DL
-BEBNPNMTpjdtI/spRjX3qHK0aovuLn1KivLeXp1OZd06rmCTJt1WomkTANmTqBLEVvaFwrS7y929ZKw7
-ktiQJCzG8lFyFyZpRohD9WQ1teIMg1JxRRrow8oRF1cBZSGaIJlWHXn1FkJQmvyprARVrbTRnqX3UF9v
-eeLCjtFAxV82IXYqUm5ytySXjDwzqdHOBcPKR6RUsw9Cr9NUZUHxYNoyUoNfVFASmleQ0LwyTl68C1LE
-JImC59LZqtTUlCJejT3fXI7kog6SxWWT69VuINw48Rddyw6OWb2DY6bnpy0IS7xyBkTFjTjDmFs3oqp3
-cLmFShLYM6ml9qXdhEsKCXsLqHzu19vOfe0G4r0dz9hdNvn9epPfrzP5pG9AVZz9Psb9GbO/v93se3Op
-94VaIO8m+dtayyRpxept56hyNxQ2uu5iK5OeZ+n0J0M5dfCSPgieX0eVZCMgud7O7ii+zif1b5fKN663
-aSrDqyMk2GKSa+pGxkQauWVo4Euwaocgu97Kl9WRLSKOXW/kdw5HPw247XfEQouz5uB6dOMNvBWNKn7a
-6pRSXgMwegBNgls/s7on3dnck2hjg0c0vXjm/km3O/snnQRZMCGxNbferc0N+tbmrXPSm/TwAc0dVHAC
-b+xPgBWi8WAERGP6hl1M8HKJ9abw72nunlPUnU5pXeo5QKqyW35aXvrUt4w5bXAZfxGvBTxYFPcbvTlq
-ZEcCD0JFsqs6l30MNRCMa97t8Qbilu6mR8r1QeaFNCM5Q8bFG4jeYKTiDUbop/gOQ9GMs7njatoynmAC
-7TbGnr9hTgt9Vkj7wl7h8rVI5dgP8YM/jX8GhuyRVx3HrWecJoWWKYUe2LwzmVz4a+O2053Gcbte5F87
-0jg8vnX1EsVNTENe3iI3PO0Nfirdj9r/iMOo6TQcygoCu9xBQobcQ0bsAbl79Z0CnUDNfyLiz4PQXQ8d
-NYM8fReq7WDCZx+6qUhDU2zUZuAhYoTEVvZtWkWb5RLWCtOOKGsEOA0wYw98XkLPUckL05xMEbu5Oqu0
-vsDexH4vSYIHkS+BUAbTGMDRVdKcnGzZbTx/aGSJ4+KQjecAuovjjAHCgR6wdKDrNlN3t+dvC17P3JDs
-mY3JJATsDJf3WZOsWojzHiuLhsputCKgkVyuoPmKQ0O+4hAnFzZwHzzimFayW9p4RWdyfH7yCbsuEHAU
-K3yh1Y7gH7wh21lMXpvqBylqstmYmoeLQWADnmy/eCKQpREmXgyCxTNkErtj9K1N8sgFA4PwIRzzTAB5
-2FkAuSS8z3/wHszSx/BsOBn2sKWuhyWJFMgbNTvtTqdz4DXof12cNoPVGv5fqHSA4yh40flFrz/EOTM6
-7b9hx9MzqYyXMPAgMjzYhJ3amWmfkWDIltvW6sUI3z4Ox0MiZQmekvIw5kXwouaRQ93xaFcD+faP+KJz
-hz1jDRq6wqPMZAK1hJpRrYW48NNQIJ9HwAjO/USN4iFbYvI2bGia+m9+AOpZtNP/SrImq/QDj/fh0H7g
-P9z9N17FGH21ByESp2Tc5ma5sU5pWb1h2UK6nvCsWe4kxbMRYHKBKS3ef3Mt5f6rAyKPfRMDKARwTWu7
-Dk8lSWrAOpK74j4sDF4/nEDybYc7W7GjV6xywJL3eRHweTiPNglmkpPSAEQMlb7hw56q3dsT//wE0iNZ
-cvKaH4gfX3i8YSSXL/Igw/ztyWUB9Cj/hZ/NJYEu6XXnhhd6cdFhXvWTqeqnQwmfZSsgwWAKOjEUZ/Ga
-aZMwSZTX/foJZI6oeCSKltKX6MiLKoxDmm/sSSvMAMifbFeLtpgnee9v1maPyr29N6/wBM8RnOnLvb3q
-FMtWei6RuTlJS+4CHVdGcTfhqo5cImep3CHwtCyhGn8VR56X7a8qpRyVd/k+mvL4T5HzsaKv+a+H/JeR
-Awg8VQuOF0+FbR6RNL65qL+yWALBHNGlg2CJDZT0DTvjLOeHtL45WTeMbQsApqC6LZqrqRbUphKLFncS
-t2solPXk6WxbF+BEUkRPeU+XIrnbOpP3xJOnckBFpeZEjXKiztQ2lD8W7TLCJ7DGwN2B5T4p0yCvmMAx
-RN1pljjXPNuBX8lp7TXYnw/kT9c5zI6aoWr8lmRQ4PG2zyB8ul29uSBh8saarIEPZBOahTj0+o2EsCBB
-0S8uVQoFEK2/d/7Fa+B/XWgV7IdlchB5KZy4FoHKsfLTkrqrtF69TKx1tPMZUrgYd3eTqwQfbEZV6vmp
-3T0ItN9qNd52jp53JnfNZ/2B+1QWtPpg080umWKgKWaKIYnnyjiAbkDG+svr139tpPEmmaFT0DkB5NXl
-yOfBjPhB/fY/cOKV9V/+fwAAAP//EAmardfwAAA=
-`,
-	},
-
-	"/static/js/moggio.js": {
-		local:   "server/static/js/moggio.js",
-		size:    1060037,
-		modtime: 1456790355,
-		compressed: `
-H4sIAAAJbogA/+z9e3sTSbIgDv/vT1HwvjuSQJYNdE/PyG1YMGbaewDz2Ka7fVgeuyyV7RpklU6V5MvQ
-/u6/uOQl8lIlydA9ffbQu2ewKiMzIyMjIyMjIyPap7PxYJoX4yRrT7vjbtn5bL5U7aI763zOT9v3xh+K
-j/zXlP66TMsk3ZzeTLLiNCmz/5rlZba5eV9Xvf+Xv6iPG1hn9pe/pJ0ym87KcZJCo/fWO/g9199y9Q1b
-Pd0cZ1fJdlkWZfv+VjoeF9PkNB8Pk4tiOBtlSev+w+Lh/db9zsb0vCyuktPeoBhmm/ff7L58/3r76O3u
-wdGr3fdvX97vnt5ie6NNxH3zc3Y9Kcpp1f98e7uBY/iw/rE3SEej9qiniroa+3bGAxxvEuCjjx+yjxsK
-1ao9fjbuZ53b7qhra2Zdpt2tgsIudSFhkS9Eq1MYM0IXm+sbxY9lb5SNz6bnG8XDh52qXSLhDRq3nfbn
-R/0PBmXVRJep1FV9dz6vrD14sJI8SLaKyU2Zn51Pk/agkzxef/R9N3mVDrKTovjUTXbGgx5CPR+NEoKq
-ANEqKy+zIX7HooPzvEqqYlYOsgQpnsDPUT7IxlU2TGbjYVYm0/MsebH/crWa3sBEqcLktIDSJB9jMTb0
-emdr++3+NkwqAPHnpCxgloeA/2BalDcJ0GkqupuWWdZLngPvDIc5jjYdJWdlOp5icwA7SafZeKoRH6Tj
-5MTtNnn3/GD77cG+02eVXmS2Tz3M/z0pi8t8mFVvmN1eAI8BIfZnEyToVlHiGNZWVlozGFo1LfPBtLWx
-soKzdpZNf87Gw6J8V2an+XU2fIs9bOopb7d6a1GQVkc1EPYFtT+vJAnNYYLYqen/DNM2uk0OyhkM5zQ5
-4YpJxTWBBlWVpOP8IkVqVT2qvAb/e55WW/v7z01JPzGL3S9qd6jrJFFd3rsXRb7dMv3osSTJbXflrlhP
-YV4rWAcXAdYHpiTA2hYtjLXp54sxfjJsQPrJywa0ZeHCiE+yspoAz+aXX4nYeZRHDmxRnNz5clxiu1JY
-J7fA9SyttKQEbg+XwMbKbffz/Zqlc7//5G+3H7uPvwnCry4IaZiDUVrh6CpoF9ilSLJL6KBKCq45LAaz
-C+wxhR7gwziZTYaAAwgfIFs6ILwGxcWkGCMUbtizs/MEt92TdPCpIlK/G2UpUAf2eRjgeTqF4gnwUvam
-ABH7prjMkotZNcWRYL0Mh0LDLYnSBfwEUqSj/F+ZQhQWCCJ4gfUvsH5K2gP8mk2oxzKjLms7gEZLrglj
-uuglO1Oc4/wCOSnFcRQ0rhR5xGsKpiofw0zZzqEm8CCQL8f2CoUj/FW347zcfWOag5U2+JSVBIYKxOA8
-A7LV7kBHA6D6NNuiWdtMrG6Hy9Su4iEsoHH2rixAlkzzrGpP0xKWVzcBTCYVgRZlQppIDs2sb8A/P3Kh
-VkmSHHQSAEQYQH1Q5hNgHgAmqA/5xw3xuZeNgU3K9GSEG1r8+2+/JafpqMqceoNifJqfzXTNKciyDRRm
-7dZlOpplLeRgC96RVa/KfOpU2z35JzB4zxn7jRm5qPkpu5G/Oxsoq7R8sxTdAukHLeOaIcJNC2y06sKU
-wG44eKdJieja4k5IfNFQjwBxomWTHR6z025TKy4KGxp1AQFDgv/faaPiYUZ0RIt9C7h6C7msnQN4Crzc
-lTX1iO6Z4kT/AfJHAiIka+iozx/AmFinbymdnpZPqiRMihJDY9JikjNLb6PAea3WtatMOUVGiRogLiOj
-x7wqQbq9K0Y3IPFGbv0mSGwOW0PwrJou0lwjqEEvsrqDlSq382L8RkkRFLhacrKgvEIcrNjCM9xlBrsG
-CZ8EpU9PNrKN20AloBluNkmuUHQjPAlz2OQT2GlpxyOdAP4x+EXwbxO+GuLBGvfWjX4FHLgA2tkeUX8P
-1obFxVvYSLUq4TMi7oDdWMdIU6yAAL2jvHpZpmdn+fgMCMqyRJSmzrTsvASY8Ww0kiAKDRRR/JcsVPOw
-qWgZFiF1Ny2lfQC91WyG33oncLylUXYitd5P/DrvJzU1hvkw7Eh+9OrdIvnkntGOELmbfOBpAbnYT1r+
-5ly1ujwJimMTrTrkpC9U2RQZDHnXbs64u/AvnCyAsgyrmzBbNtau8jPQdAj2PIvxaahDAGBlGrM9p3Ck
-At0J2J9hjAbTOymGN8kI1s+I9vtp+gn2cthe0jHrPLot+GQ0D1C9Tkj9ADUMRS4vN6VGJwntUUJ5DkjX
-BqIVtC09WKNO9ApIWMbyBFIJTUjxCZbpX/6SyIL3E/psa2pm8KptupK0xzRpO5zfTVpGb2l1Q0ZVzOb1
-oTBYqofZxG///cS0frsSEsEu7nCkw2w0TX8lRSVWchgrcYQFKQgewDV8p+H1BqMc/vnVB7jxAA4t+vwv
-F05K+vdldprORtO2GuQtndsSubACTTK6sICrtc4J3Azin04InubrMHUzTwa9tl0erGPB5Tmwxyp1O85E
-klGtXI5zWnNLlh1lOw5P1ewI9za5jhxJk45Q006AVuPWE0WtidvrtjmXdWM0vAk6rmFF20WEB/dIm6xQ
-ZwDGK0EaothF5Fk4a0lOIho2gZOMBDdtJsNmZrT9AheuPUADRpaC6qBJoBRZnw61S0rKrshIUMOotII1
-ge2PzpYg1wdWjaWNSB9qoTArx4sJe9l3g5xHlbBW1GBhRMysyElVwu/hJjSzqhlgIwQ5RJAbA3KzscCC
-2IwsiLU1lEJ6ntMbmmvgQxA/JZsAkA1MU8lpSdaFIqt6so33UPu4UWM+xh02vSxyUFVBq12lSYDZ7s1f
-XY3ttkO1KNx57ELyaInTcbNxNwnv9NnqxtlGwghZvJAkkepoW7JH1+GEToQ9nO0zvnk2LLD3kznLC5Xj
-32uFvZ9E96xALId6g0Nqf/v2dHtnXj/yyUPJo4jSvLGiTteBgTMOTBZOR32633/Svd98RgWQRwjTyO73
-+9+hhfTJIhZScQqdlMUgq76ZTb/4/siZ1EXteNnFZHrzSk+GY2VwisiqoCbo/QTayNKLBIRlhdVgYCSY
-jG7YS16CGKbdGo83vBLTwQAGOk3wTiE/zQfY1DjFcwpRZpRO8XqiR6jG7DGOseK1tm0im2tb8XBW6qPb
-yewETXITOLkp04S6s5ikwLPJZzQKYKUDssrdJmyd48bYWMC22bOcths9MBAyPbchJOf47JZRQNsT452w
-aS3rnfXgJAsM9qmF+gufSXCXaXnt6AVxay0vW/oPXdbz7l14p79VBsfkKp+eJyludrhTHicXoDYVQ3vd
-woMQck2fm5Rl0gyha3DQooyEHYH1gLOdmRE7RxygHWm3y/qkEYV6REZu8hic48OFu0+JHrls6U6tBL5V
-EhfmvsqcwU6n6eB829WkwrJ2C1ZI8jBKwa8zxGG2VGfh0FaC67t5S0gZEb6tIbmGFFFCW8uiq+je3GWk
-zPi4Kfay8WXv7e7L7aPttz/TqbEFJcOZEsny9AhqToHbP1u+n0+nKL75hsncA82bZDzRpwnyVktf4pKJ
-a6iFubrSjbBHcggbYJJOJjBNrI5TM2Q3xpqwd2V4LVUVsJUxGr1WoAnVrhFnL4ou2jsJITTH/NEyyOmz
-YZ3qdaO0fQcNpwTxuV2J3nE76KCmyH5IZOY2O/2RYrZWp6PUQ4faoPv90L2vge73H//wA2h53327B//9
-FbrrbDDDPrfHl3lZjC/IOIyK3NqDf1YjUKpgJeWjPrFUnYIHKMEJGGXyZnLvXlv5hl3l42FxxRIFCYhX
-fMMWmtu4pGfu28NPPTbjqzsVoRfu5xcTmH+gPhDjKqMZV650cCADLkShQwKW7OHZNGN+xmMZyK9pdj0F
-kmJLvxTlJ9Qif8pGk0odzQd5OZiN6BJ4grb48SBHwyTUBZYGYcWcQRtOWmGrJ8WMZskzH8HfN2SdR5O7
-6omltrIs3SRjvNyC8sFoNszY2JSyK8DxHvoacKVji8mN0Fojk0bKK20eai769s+uLeBmq36iJol/+5Mk
-KjjLuxKNkun+XltNnC8L8QZcFwn9RjT8c55d4SIOmlTVKmCBbEwV8mpnzIj2k3sWem0teUUEv2KLAF3S
-IJuuJhfEGIPzdHxmFsTpDPeS3kqNGIvQlI6yIIi+/yaIfndB9AqdoF6m0/QA/Rx6xt8mOGCuPUiUYDrJ
-p1d5lTXLpiNY8bCAcIqVzwTKibMxuWgYAwFvsIGvyCP2FYFCkkpRfxFFu00LRf4ipp1PsNiBEgymHQ4U
-KsZBogcKzu7VWDty8BbKVbrYAvsfEJIf4OdH6I5L6Rf7dBivDobbIJc0xMAlLco73/cgBqF9Bl4XgIzn
-+MnfNATJKxeAPjnlW9pv6hcQzu9gIe6hWCvf5Nf5OFK3Cdxp95fzLBv9BCJ65A8qKNb19gdlgZqSB28+
-M/NpaJc2L2anp1mZDfdwN2igoYRram+rGM0uxntZlf8rYzSbWg2hm9qGvpsag+Km2kyO+dwi4eIt/ZIP
-p+fzGxJg1v1lBExALIDeP57Di1Omux5ce1DXumQIMhgWfKY8kQWMLNHQyxitSFyM4X/zdOwtBPNZw1Xn
-pEps/9cs9TxuZImGJj/TEYhd2O/eFexx+uuhWy8OY0iI1MHzAVKPlkTPfFEQvODO89GwpJtGhtIfFFBJ
-6++g2KdzNEDFZEbPheIxbL95d3B4tPvi/2xvHaCWcsufX+zuvdzeO/ppe+cfPx2QrDVKHrYKGw8qZMbb
-knQ68pRFJasipiOPuPMsxT2RFTxcHpXdSWFzTG9ARdP3ykNsmB3pcnLTOi1Ir+urGsfHx/jPw9Xl/3uI
-FX9jqiS8TpN/lMVsgieu35TMoZ69wt+44k88DO+/uu+JrRj/r+67qfgFY7xjj5o4akRMhsojjlf4+4yx
-aexfZ4wv0P/GjNAbo1f433WMr0CXrJ1Hr/DfNEZez/jXamxlMrP1lSMKntdQSLBAYb8uFEMAiS0kKESU
-hEEdTh3djOrLYkXZstiSReTgupfo2Ipa3Q2Kr/OizP8Fp1H83TMY1skIgaaWdgI3gRZ3JVGg6+yrc9TG
-GRuU3RaXbnIyU0ASJW7HnKJtkW2k59HVXbcOrppm8/C6E6Hu0K8a3bJEidGDm6olilzojBr55S1HEImD
-QE3QySCmv8UwqpE/dWjddYLWIvqfUSmkbyaaN4d5NYENGp++9JOWW4ltEBOtqvTZuuFcyb+DCiMYPaiN
-tMHTwTHZOSVPMj0aRd/TfNrV9dKQo/EwQHbkdDLJ4ATgXNVTD32rSPXGs4sTUHbyao+1sGHoLsC4nbOB
-KkAODd9xzLwprMFLw2/nZHU65n6OcUKOL9Lrn9RP/RBE3Yb6DktcKxxYOJo36XV+MbtIJv8NRmUgFxlY
-dJpaVVJcjdFip61KM3b1gJFcpOP0DH6IRcEtgd4940sJcj8HrTO54tc1STXKlYmP9wiQ2adlcQFjAN2T
-TVPFaKgbwtWMKut5eqn8icm9BNqCFceeJWqbYqS1mzxjlACdP2mCJDtQEYRhFw0VNzyaiTIxYEWu0kte
-QMMVdnZM41aE7iZXxueYfJZYuRcDYflwkX7CutVM7Z+nvPWjQi4lpSKTYQClknNLuPde5lXOrSq3q8Gs
-LDO6zE8HGW15poJuDubkMs+ucI6qC3wJQLdJyt6Xj9NRl1E/BUojkiHrGldoZeGdVdZTq8SDNlbDsZSZ
-bkQPHq255BQIwhK/a9Mbt4d4+Zx94PDTqUPv5MdEM3zb5fiOy+CiTi2L46AR3V8lBBykdk/bH1rn+XCY
-jVvdpJXOpkXrY6fr1DlcsI6/lt4SAkhbEgRSLXIHgMVb6KbxhTKVupmNR1lVJcfwg2nyj2w6RcM5soSW
-EGoCydVTtzbMyUID/EWuWAGKdRRuRBEkoem0GyDVzmEBXXeOxbsV5GrYG8+xR/3uUDfGCAMQIUjzU6LF
-VLR7TA1MUhSvdHEBJTUjYRTkeND8GfHKLvDpNxMXVoLao2k6cSp5VO54zGrUw+oJoOOkAnk2GpLIvgBM
-k2xM7yah8XO2eKXVzXigGznNpoNzXE4ww3Ret3OX4IVzUaIs4yt1M5ksiQjcOOlSQ/7uYNDyCdE4rYom
-6fhGmsa39vf5wVemlCY7UH6VgYIEezQ7IVKF1B9Ueep4IkDYqzF/CoNdDYUpnyj4yMAastsRlbA6fff9
-M9bweWObjXQ39jcir101U54PY9LRe4PS/INFwN9Rt7wrCXlTc1vlb4sT6+X2u73trecH2y9BH59Veqe0
-aNHjQ0DV7N9kCHMexym3UyCA2mYHGb3bxZ0ZDq0xBLGRQKTjn+0P4iOtp64AS8syvYnJ+WUnRSEaTIo/
-+vmT8jPLwdNQf3db5m+vs9OFpmUHF6A9RZPGpnSpItbuQcFnp0XaNhh7GnC83clS6OKOMR/XveJqkVa3
-nKeYdlJJy7GnZLpJQ/vrFM3xdPDE97ChnodC2xszb2MeixZjPphuj4fz578RyVADUBs+yGC71/OKNnsN
-mVGIkFprlHsr6so9o7SNM3ROwc3nJBukuH7VW300mHB7rBlm1RR9l2FX4N4qnC1ySsKNurISeYzXqT49
-tvgz47BFN9hfSBkeIcU/yMNnK8V4D7cYKPla3QyLGZKhvreXBPB1+iRHvdUhKMXKP/gcD5Xjiv3MAqFD
-/ZPL+kuo8pV6z9DPf8nut8cLibyF+h9l6WW2ZP+vsc4X9k/nIxg5Pl09yTLz/m4opYCSquMsG5JCeZKp
-U2zAGfJ2E+SB7no+kr8o75tUd4Z48uFxdKO0QcbV6zOvRJ8AJbvC91rKTQ62NeUHR1eNwk3OK6GHXvpt
-lBNJRnvzuTrDutIMY8qXKTuPf5abnPeRdpJ1dvczI9hhYbWPT1HcEciSyAjQoDdR16/0jIR+bJiyS+XI
-o46xKmhGT+lOq+anHYX5KKlhPga0kI/CK/dWGjef+uvoNjdozpsqJEjPiPiuh3wAwFuJcqVUTrNQbsjs
-P2mS6BmooJJ1wxSPdxjxfdhYKaqIvZFuR0C6yaO/rrN9SocNcJ4TwirF8+A040m10yZiLA2si8Vo9IbP
-49bjOCg0Lql0i221C4creqLAEk1Co6eZcTRDhy+/0H2ix8OB+Tgo9hUg+r74vdwGmKkblChyXBbip+rU
-oijKY1iy8IkiKnuU837leq8gOwduK239dos+6sdvfNZkECr4tbbkUEx6pJ58hBaW4mtPVhofrNEbushb
-VvNMjclsjE/05FKbjiy1dPAZ+9pXvVSk9oEMb9LpOaxBmIM2d2mnSoFAu+sLNKgKuNKPIBL1W29+kMez
-82vyFEp++02BPd2shftRfrxIr3mif22i7mEjdQ+/gLqHDnUt/ovS5t9D7MMFiX1YQ+xDQewy46hqQl+W
-1I4UR2SYU67FhcTEAbC7nnbbjFV0y2QdNnSYOjEUHiZ11QdxTPD5pbYVu/JObcGbUp7Z2XXq6Tngisb2
-DLPlIm35YOARzoULHztZ8pzHm/ZwEGbu+k6JeQHbtttUt66h6HMOv9EQ1Yf1vKjlOY7RbQi3Cb0xyO/e
-MGMHPm9naYB0+4xoFv7gAibylIGX9Iw4rgvoMrOM1O4cW2g1esYev9Hx9ehaGBlsYJxdT3UgshpNxID8
-CRWRELd/tx5icAW9Y9du2gJTs5VvxGAPo7CHdkBOu2Y9+FoC7AZOozWAh/4476I8BRjZm621tWSfDfjq
-WiKwMqreA2TNJhw04ZnAVAMdZwJYtGRToar7+rshclcIok5sjcESfU+n65r1y4XLLWC2LjuPw/BDzVGR
-cHOk6EbjMdIUinOfWBIkcmGr+octtXwgqnhOJurlUDtwee6Kh3Uc9oEaOeLzaatrCgf61qWfDK7brcns
-ZJQP1k6d9tZUrY6tNiRrO6Mt0MMqFkh5lDAU/bBl2isjaEJtbQaQLo7E2T9J/rXDnx7ZT8XpKbAWGwTs
-V2k7cFRcC0IDNU5CATKvRLHfrvVUj9Xc92FU7dtOIJbsTuvuyv4OzH7VxZXx4Ech5mvppHiiEKXvRVQI
-1DSH3Qtcog0d1jVkcLJan4vqM/sgobe/85/bSV/HKHHqH9r6UjVZDXtYTR4nD1z/arHMmGV3NV+Y9tw1
-BljVMKCLHnqOybb81h8abH17DteeTouLfX6jx5VshBYKn18UU9m8r57e4x0QsHUR0d3iV4N2QMqYGUpo
-62Q2AvGfWx+NTQ8j3Y/bgmctkk4ewZ4egvzoTLAVdnFihfVXnfobwvAoKcmacz5uOwXdkEgPox2vRgZu
-XhxHeDaKpse4wfrXu6dZH2JfcNaniEDlV6nZF0y53A/QQhyyidgSeIfcj4IBoayMEAJYXXD1TQienx0U
-LeREvd1wRfJhvXi0+sk8Av3qhuiyqNObG18kHTaIJF+omQbsViZnljvQVSMI10zPTyFk141EEJkIhczD
-QPT722E/xtJNU+ajM2/SRI9VDMf6OR2W6dl/jIuThdSZ8DmYJZKrRpw7nKzuLX+RWsjAuQjBLtRsgnZu
-NAeQF/MqaRC3Xno9t54CwXrsSdZ78/zXo5+fv36/rZtRHoL95N49bse/v9GAI9BsdtVU1/aIQA6Wn4Du
-P3lkCxUvRTx6z9zQugTTVYMrrn5ij/bRu6+uMVFg8X+gvlrbI2izK5qrnA2UT0cmsppVqx3h7UgI65th
-ZHfgrPKspgDOBf2gyAToMwjdUVvn+svq6apWoKdbBJs1X4SLq7zuOpMUDTT11UeLqurunjxfvUb4QK9e
-St0PTyT1Mqrkt6/67IhnQXzm2nZUsE6gci4/5zzj3snsTucyeSrDMjnj9YcxX4bGhIE3s8G8iln1dOPY
-RW50dmIMiW3FGLKWRbBCLYu48sfue/JrKFumxWT/PB1q05dQ6MVXK22UPiOsOLp+DWe0hvlly9n23an3
-5tw0J5c5hUjo4+N5nAH3kHAbZ3GLsau480nDHvxqVXYLE7fvxy4J4FAZNgubk38nYlXM4GDwFUkpW6yn
-pnukqKGmuhWKIaUqMG7qRy2G8XWOMVMkgsrO149YCHvaBhiOJqorReQCBlnQ1RsG5A0pcUI5NRPeOXPK
-gUWwjZxP5yGdGP3SfhEHfLmrub+xL7FD0i5nf5uVZz9JDlIfzWiCM1q3/nxAJR3n0k/vN+5dn9mFxI2q
-kb2LWyrn8Wx9lAe7uobsEbRn3xCoFW/cToxgLysEU3uG2svlRx/SVWrdr03bBQq+2Hbhcr61lkR2r8Lf
-t6TjoJJd5osD47j7CUjx3YEX7nkC2nwNYZUvnQ9Mn0No5fnmQ9NnDR3zujeT6JWIOvpZiwFVfkcWImgs
-aMN9reFxjgs9V3Oo1QZwpmu1ATQGvE6Js15QdgiOsDNfXwI0dQAKjX/ELeosw5Y1oFErgtiR2u/W9Xgk
-f3ftjKjeGCn9sji1HoDokpjz+5AHxk8RD3jaLRUjRGJqDPW+hRPSaED0W5zMpqaDQVFi+CJubVSo6IOF
-/8KJvAqPfGVKhniWJZxoRsuqQXFxgjbBXzRBbZE9zbrfkRQC/Jn8/kYdw2NF6qQtG/uNw2MiGuqgyaXR
-OO+oIHEEIHy8cfC63bG6iMUVBOuq/RVcTJsLLxOSMvDFlEwXnnf7Ylsd0RIQfT9MHHomq4lDLIeRIyXW
-zuHTUpUbe4ZPUDUWx0bgbv8UE7/vhsjvBhCHLsShhHBDtMcDVyZy06dzlJlZracFK+8oLZUo2IfVC2Su
-dobQD27VkovrodrFyGwweEuq/pZ8YyFULC2+xTaw6muzuxH+r43TRe8hOK8b/fljEnSykTx8yO+s3JQ0
-MuSOQO0DwX7kG0o5Eqeg03HiePpoyowU1jtT5WZRBJ8UE7rlVc0/HysMmL8tyZvg2gNNcf6DLnHgFwyH
-Fph0lhiksIRgr3Pnz828EcJRiyGwnFPqCc89+m9loKqE605d3yrAfT1XmQEGjfsOOA2YMFmwQP6uQ88f
-8oI4+rR3+g4NKVy6Mz4tVFwkPY44raQblK4YaHaYtUv37pdtxKsHioDfRgAQacg3SMg2/LKa6o14RAEi
-DfmWOtmGX1ZTvRGPKEDUrUxfjzKMDsGlFKLJKJ+KgrbL0fNm2Gu2Ryr/ErPr17d6orHTarvdQtgrpxU6
-Kf1kKtlRxYYVYRivv9ph1TGLX79mWDiFSw/rlanUPKwI/3n91Q6rjvf8+nJYQqKEgsORYlHpUePRIRm+
-BiSCf5OTR02Li60k9q2MSe26/tXNBU8gHDj+4Ra1I3LZNqa2CuO9SfMvWnDZIGjE82VaYvnX4DNnpuJ9
-uVy2zDzVtOdzndVvViLL05+An9Q3IeikXmRrC+3IdYKT+pDnHicOKkplgyOP/mL1IN8GZGIzth13addF
-47ffIk4nAqCbtA6LGQelwZNHlqunaPrteElRY1TdlrSfD2zIxQ8fmahOKEYQCOV2OjhX6GnwrohbS9+6
-SUS3pZIkkvKKKe7OoaQFVexRUNqjI3HNTMx5dIQEqQM5OgJqcMdYKOIh/Bi7uE7WnlKuBQziHwXg8Gdr
-T20Qf02D3mRWnTOqWq2SlPV8mRz91hAHm1FnENQK1acP6x/rB2/pGPRgsy4KBc+x5QkR6OinLhBeSG8E
-DZgjdWMLCsptQpuE1B/CO1e25fvk3ou+3NEPYYQwr+wbmEBuW3D5/grtVU2Ku//MpQmVwxCVwwZU9qXF
-d6nHekkwl6LFHq0/H9DMmYQshDVEDkLCaJ+SYHeP+aA7wsgnz9zx0nimBcZij7X+bx88PVPKKOJVgFyw
-2kJXxU1/nT6re2PKHk4N/GiMuURxw3PmsyX92pofConj0A8xL8eNE7JBxWS/0vHATguOoWVb4qpCef16
-r21r3tn+GV7aSsm1wJoFfmu71yYeH/7b+Vg8hIqylqRBwGAOgRofHGf20kn54jRRObBESPOq3Sa4Cd88
-6x8nZN26faqul+A9VqRBGUW6Bn3xJl3q02JZ170j8KoIw09tiPJeOvwn6HxCPaDCqm3VNCYcWZm9k2JF
-D7GC/nqD2NnKHEfqa+iB1hJz8aEsMIo6CxZz5BfZKjf8zY7h5u93ICox9hpsFgPMSMXVKMi3ChrOX7rJ
-sBi3pu5zHFa07JmKxfxmkw1G25WFwl2D79PNsG2pjrMjzTQdyea1V+26e6rMNd3sZzKD58r8nfy4CM5o
-C3fTSylqNY4Yk1qIKjUoP9Rt9ATb2HomI60U8FbL1lK5hpSrISVd+uDFSF7Mqnoq+uSKYrAEhYLzs0em
-KEbLESm9TPOR3W/1oERVTIMcnQ6XOnpxiHW+yIAWn4yP8fHQS558/HwwyCZTOxB9AUxjiVEpivBqlB4b
-lmT2HSW+12/o151ge1RpqBKdI9nh0+hQ6nqKwcou3H8dRdifjkAwe4/C570HZ2uv1Zlt3WeB+aPvtGbr
-Ew8yzJ7ybfOch40HXERJfRhR3x/GHjLp7sTTns3EqL8RJPyjcPTFf+CiEHkNGQzzbZYNaZBxP7/FcdGL
-sX6LPkPXm6ny2feNaDWPQX5WAXU3Y509dSTIHAuA2Jbr+5EOiWZqVje9pyTmkBGQ8eECoIaxaoBjjwd/
-lXEC1rsxYqxGtJzgAWJtG+ZEZcet2qg8BPJx25hjLH4O8GEIfNiVbp9iLu7FvEFBExoXsYjbJn5dl4JP
-kusKUrZKrjD1V0peMVVhoj7T8TMdJ9V5mY8/rU6L1dN8KsK/C91HSgwp7YxICWbbtz86qznkjYaFLV9R
-B8ehn51DZ9uZoBU1RgyAT25OlGOa/fyU5w/oj2wyxhytU0wKgZ5BJ+gqgzHFfH85aMxafT3LgzrsY3gn
-SpxWZukn5DHcAJLTjHJUV4bx4LStz706gVmDo0z0sOWDYbZ7u9krvb6rw+qbg4J2FKm6kUNI39Xba89r
-/ci3GqfHhdwd446O9aKo31Am3iSp9ddPwqdidmX1I8/6XN/tfow5XRc8L0hN5KML/2s/ibryHervh90V
-u0o5f4mK7I4ZKy/SGwoXfVXmU6dA32rkY7HG7RmO/N6QL4JV2Q+Y3TqPuo/LIqHsah+xu2uk76+ZW7FK
-XxQgp4/11dIxoXrs3fgdUxz5M8w/meIp8ORG1z52d9bjSDxdisKtU4dkbJ5jt+KebmVnrAQFFCknLBkZ
-3+Q7htoVRs/XyRCwE2PWQ/mcXcmhYLR3fyQaVKcLPU+ht5ZOaanCGEpzknv747hNkAUFRIMWKs5H3wXK
-heiGlRy3p7BJz2XDGnnEXZNE0L+0lXhGyuLo+oEWapuIIx9eHNe1HL36VHeYujl5g1lzdezcZdZdLydr
-DygGs3bK1Hff5vNnuV1subYifaHopLN0bAQMGjcKeO3BoRZPik6+PefKGgDk4xJ8bUVvFXPxTE8ZlPri
-6tQWGguRu8NAu+pMKb66r/Vwse2p8Nd1lcUkalC8JHOT1QnvzUpNRDbSiY9vHVczO+FbnvnNmXfhCRNO
-uHST8Wc6PsnWu2WxCa6ZW2u20zkVB8Z0IUN7JrJDdd8a4wB/8mMT8k6c9xaZgZAf3gkucBnAMJsEVB+b
-5tSWjdITfDIk69MnmPXYtAuyBFMuvITCKZcuRItNufX8+YOmXHT4Vaacz/3/rinnJHTOMo5NqBi0mFDh
-tCKnUvqyRCcxfGck9kbt5Pk7TqEXLtJPfeAezxixDxE6fky8UIJuM+1IFXPJ4V1BzO8oXHrAKa2Wv9t6
-i1BvyHbCPDcvb958J7AlNthQQ/iyDdZrT22w0c0roIdDh4h+okORej5mTiBS3/9sQU4+dT3PNA1itvwv
-EljW4U8QgjqX7CuxUdLK1KnnxQDR+qohuT+viK77Dgbhm6t+hCgiJveReawqXwjRpyAs7K9d/8uhfFVB
-CzWvKDhjNpTvcZDq10kkrOmvUl6QwSk9qdq66aeJ++nXjh+2co57zlI3+C9u2n4U2sOO51E5xxSnL5hd
-g5wYdWCbw//iL5F4gt2nmb6fQDcCax5nBq4Czjte9yDveguEgFHLQWS0smbceGGUWMcdgae3Znbr/MAS
-4KmHmxzD99cN8XEzuabQv8/g//rJtV/0NB5AGcDj350m6ifL2Eyu5Ri1gSQSPr0t1ACzGP0gP+669Evl
-Eq30LVHTmrQBPAHQxrh01qTn3REZrGcewqbskBcdqRuByh2nW/Z7jPIwFoxqEc88ISMsLu7gIxRbfCUv
-s44XXMXLreGlp9Ipl/PoVVxoq/DjjNvMPHLhxyHaIS93YzMfbq23K7c6U30k6FeyWOrYSE3OH3uRX+d4
-FPvAjtU2yjAcaGZlxgeTNwj0ESdtUop0s4ihE2jMz6LkpC5DaB1dbD6giTDmpjYJAG14sbltVvNwvFM0
-12KGJygdZWIfY1DgMcZYCJij3as476G4TMAAOCa3VmnMx+M7t25J0wI1BdSraWZCbuj9q2/D+y6E07RM
-xxU6L73cfaMv/X89bEfQ7CbrbpBx7WcotcUvDnriRzWKsHkYnSU2ZXcLW6JbjE2TeF48J76ivcC6lSTr
-Ol4RebU7Sf9rlvm2GZjLEh9Ap2qqLQlaDpBaeca2aMrgyK/+5n/5vTNfLVwUw9ko62XXeEUYusgBBFrg
-/Xs0+6pBX7HA8JKTG5Wmk1x5OdBxfooNoMNKOsKktbfdz/d7a/UhRHolUvJ+/6/dAEzgoEL9aeBHj0Jo
-3jmh7HFYBh2Zqt+FxdKrFyAiqAi3BAD4AQE4GsD9/uMn+GuPW3/8vfnRJHgB8K8GUIbuhgJq3C5/hfbj
-v+Fn1zJ1v/+E+h5cw1/UsUyhA9+oD+elPHyk9s3TF/jwd/wg7xju97+jb3HJcL///frtx+5f+x+0NNWJ
-CLrMW13FW53PKxTX4kGyVUxuStrs24NO8nj90ffd5FU6AGSLT91kB/YAhHqOSR0RqjJ3jTqVO4XFqIpZ
-OcC4FMMMnRxH+SAb4z3WzGRofrH/cpWWry6EUziUmiTFD5LXO1vbb/e3Qe0ZZTrQRVkUGBIDg10UmGX1
-lC/BVXfTMst6yfOxTIJ6BoSZYnMAO0lxs9SI0zW3223y7vnB9tuDfafPCsSd7VMP83/DErrEbLdviJDJ
-vFVDdfDhzuA8G3yqKBX9SgsdHTC0xWDaUkoGsVli0lW0W4rz8I1RmLoeQ6BQV26NGqDaNubU5hG0tB40
-uHbhB9e6YYd/XSCnSMP/s8jHWypDrQMtCjRsnMPdanEYg7hRPozaZr4oiPpZXFDTq2+AFb5AgwuDMc3V
-pLwTw8Lwu4uqf26EJgtOJrgA+nxRvEWwpgU00Pqsj6a8MU+jgWrIpujC1KQ8dIFq8hKSVrVAKmQFt1B+
-cQW7QD5oBemGZqrp2o+ANKdZPhnFc9XWADvxnOZxTySYk62CmaYYTOnF848PX5JJ0DziMjI1fFVkhKk8
-i4p3ReKrv7S7Ye6jrn2BbMDUIdSz6GIXB8WeOhx5mKqYVZXEyXtwFClRb4+kzfeuOfeUWQTV4AG0/Byn
-2hjYvzyDzwmNknODHOQXNDUoSuBPOE2opw2ctZMJ0k0era+v/yFJfjiPjE4y4z7Di7EIp5PR8D6P+NWC
-cqf2ebSOH5h/ObaOjKXbgHA3QKeBp5viay3G4LYzj7/DAsPeImNcDTuJx2KjLC1dvgqhg8HchUG/c/jT
-KZSWMvl9gVXhhtGuN6bVWSMXmgU81XC3Q4PYbWD943OoOVoFaX+i5e1IgkE4o76kV1Gg1ife6Yz9VjEK
-n24nmVCO354Uom5YK2edvx9fNIk5Vdwo6PQrffWS5y5mrEkZJh7SZaFaYZ4tRIpAPjiqttNOWJ2/OECu
-giCB3ZINN+K25hr3Mk+WbCxAQQlvHrGxUhq5pI1Bh34JpX1PKyuIp1B0quGEzFbSbkbkWFu35TwELrWw
-4dQlAaVkLVHtPK1eUBhaVny4psJ0czN4172aPLLvIAK1acO9TnLpy/f3d4ton8uwgXTgKD1vaB2+3LCT
-HW8QzFC8Y5DRfPkE4U+Bf0/BWqi4ZvHDNshIy/awISrImerImxV54BHX+d7deVTDlUhEw9sXY3VE0dcS
-e14UWgRxzjICMBKEFsHFoUYABzFoDag62/iwTghaA6zOOD6wE4HWsQWL43o7lEmCHRpCZeMF+B6F8w4W
-xrOosdkFamFWB4olo69wwtfAWjNwnvZFZVs7okPL10yVdxUQM/ajXt1kta+MnT6mltMDRg9d/TCt8JMY
-LGvP14bzyjWVx0SHMEobNyZhrHAcmKyYFNeyKvaP/fDZ2ZkdDdkJM/CsobCtWu036cqLW9KlnWYjagaf
-Y5U26jMUfx/YmK3RN2rgFavnfv+7x3PNuT98M+f+weZcdNFczoy7c3Exoxe6u/w+yzFTeoXawjnP9Dv3
-qiJStwlct+veVHi2XafM1Ki1AC9q0V3QHvty+9Xz968Pjt7t7b7bVwdXj3p0eElH+RkKXwyxTGLuHJhi
-hIxBbnAYXQu/ui634rN0vqbPibn1DznhTqZgrHiHO/+YyVgN1trCoP7uafuDGn3SggVIOYiSFq2N1kel
-6ogd21auhKOzQ7WYGc4lYBxC0vJupjyGs0GufVBVboJcx8sXskvzAZNDunNQd34fRSotx3EPn2IdS4fy
-Y/8hFodZZ69z9vIVNtDxTbxPULW5HZiN7DIz77QYD7qzxU/HsA/S+6t4V+R07vEF/tn+4M+3JEIThZB1
-Yviafd8MnvHTmEQQtB74DSbn6HxwcIuvMCPCh3+BOcHAV1/eqZqxufPCRoGaefFt53XTgihzlPEvxXmR
-yyUfA50Vjs7qlA/ByWwASltEmnYohwInPdANAVoqRD06v5TKs6GXvCPTDr5Z5EATWLUYj250qgN62agb
-cZIv6PHBRp+W6UXCo3Gj8MdBUKjGS67qK2nZVVOqJFe0VKU68OPhGyj14JtedzqT5ud18O9/YvxCOQb5
-OIH0msDsjCrWw5iaLl+MotdA9uZFhfz3DeteScQGpk4Dznb/NW1ptQc3vUk4lzKqjEerXjnA356PljBl
-mKMeq1Nql4Vu5MEYzrC61/gRGFcDZ4xyvYwaoMWG3TKDsN8WaAGQ44Cupr75skBtUkX2SMnQ1ekTGbCU
-9rFoM1ustUTaUfoMH+c7+rxs6OzErGWHSGsCZ1ipldiQuVKbkfHiqAljHJASs20/8UNe9ze+79Kf7Lsw
-a2SQP52IZX5MtK+BgdefsdrVIlgbQ42wITbGV0B1FhyaScRDuRO3xL0L68t59TPM6lCbJlTDnRjpg3d9
-VBAYJ9V3zC0aNUw5uN/Oo/Zyzoe13chHg/Rd/W4Ma4gOjOok4DOvK9hjb+5UC76Daly8afvYhhl/DIll
-yWH+muMkylzidtmUTy4coONXKQ2hsayQpSmf5xEapCBceiCYt27BMRiY204tc5BXa53PcWy/atiXljYQ
-yqHrP7s1ZsM4A93Jo7ee+PUi56pMJ4+idBcUnDP5oq6Hkk+Muag8bnVkb3P7i/S4dJ9P/D590UMctuL/
-JT2O9dub+OKpzxJmYdp1+bicJxmyrnRXIQVLgvIdkfyilWr3o05uJT86uyHjsrgxGKmqjcCerel+/9Hf
-7+5BPM8j2LMD337s/u2bpfffYOmlF87LmXv1GwJgJuaUHkwj6q+ga9psCNO0PEOPlM/uZfYjvsyGwhnF
-9jI32fnDhwhM5xYm1KaFwutr2w7aj2DYDIaVUH1QqMCApwXlDDhPq90reseeldObHoZJa3OVLraAmljC
-SH74xC/fuZR+beD6gf+nb24IboO2GCEcqEtk8mI2VR3lcPiB79RFpQfE1TnkgCQHDAJg9QiwBj+D2z1t
-5x2MVrveIdmWj2fZBkeZW2iYhEDekZXVSOluHIqJoOHwYlZgE3W81muaIbRV/L/TbUDU3l07TnszsoB7
-uBtbIxC86j2lC/UHOn/LEN51dw0N1wIkNnYuJne/HzAtfIWLAt/awy6ZIEvrUp3ETKVz/HebjabGtEjf
-2Y5dlBfpVAvwNKlyGGyWvEknyqylW8KsNGOLM1Z28RsublIN7KjGeBo3Z6nChcxp5YJu+0tbVRe5JCHA
-f81v8qt7pA1MBA1pSpAAaIdVS4gmse2G03AsNuz0I3wy1mNRObrJP21QGbtDwr75T71RBsdifZ/ZFIrm
-noztQu+qcCUC7QY3gxFKKcwn5KG4KoyCyY+bfqTaRvCHTjAZrsO7mhuaAjd0Mn2NRkeYgSi3gQaIurxt
-KQdNmkjEW1kMhg12n9J67gpMuj7WrBC476GTYGgPN8Ph1BmQdBBh41Un40rHQgYvY6ttem2r8+gKNHSR
-Xj3cJP+a89hWue2sPgIxJY8u63d2xplrZqB9gUxr9Be6SNWcxM39R5iSXl1ueynpiWlWbCrl31SYHW08
-XJEJnaXjn/1qJ8LKEclZK36uaP6iMzojm0UlkLR6ejGYnKIgaJcvqFREpHjULqwe5u7Q/atDpFdbRYYS
-78SFIdmDlUWygrBH+xVsUYiMiK7s27RrrNmqInuXcpArO7CPUYuo7NB4Afv0t4UbYVde7Wfeh7ZzQFd4
-oTdXA4orDi12KtqXU44f7tLPlnhRY5wd3dLW/a6wlx08q29FOR3OX/qhtmdtjcqhw92J4FPXI2zf/OWX
-cLQ5QVRRLiLfiV8GQlqx3Rk2bpyuoe+liIEncYmH23U9RuQvCyE9RuQvDUGmfmHiF+nNBcoXXppzkSRd
-QnnJ0n01z/2tocwlvpCL6vtO3Cn6Kuza8Xq2KeGZvT0XS7k3el6W0W1TJNG+ctOKLB3c7MpNAiIinDnb
-u+B38/22yW2KsyV+0dnoKzlQiSOL8vVXN94wie4xY3EfIr5Ej7+TDE9K/7nKXhowpVfn+eDcJFrTHhuK
-HNmwl7xH+1vWO+vRVH7KsgnscbohFRAao01zLEs0oJyWxZjGVFCuT3xC4A7r9zo0HDWdGiyFMGr8RHgy
-c8bF4OhRa0ziyt3kQ8vWb31cVnU0lyMU79k0JN5INSqAtgbrfrwq6jeV6O7xLHpptfCmEtUnzTlB6ojx
-29FFtM1fynRCpqzmIC0N1gwvxIqaPItnswjWJ5DOvNuK+juKdo0Kqr8Ywbz24Jn+JvcJ+VlsMlqH/U0X
-ehvwstchjepKOwjmw6xHh8rVxLtJgQOjuj9R/9i7E3th8sW3JDTDcX95axe83/9hXgCYr3vLYu5TGp3o
-/77I1Urb2u6Btpie5Nt9y1e+byEW/3ohUhYw9Zr5054O5CrJcZkoDUKSTqG/k9k0Y1c4OgOwMtQjpCL6
-DVtR76TcUFXWbPjpjdFTvOTV7BVmxF+TWoMWVxuKig8YF9nYKDpqTHhy1C40joJwJxdzHwnrCIeKC+Wv
-0HzI1GxVbBYms0XoMLzV5K4eGzGNp9R2AZpW3jwrnheVB0PNJ3v9IGqWGsZN9Pj4WP9pxIT+IA9k5Dwc
-fKfjmHSsp1JziFB2aadEaWTSgZ2bFGcutzN1wuAa+nOnnzz7//+zug7HMd8RO07UMz7CHxsy8LiOnN2D
-R3SkT/LHDvF1a55PeyFcxo1HspmAnVNOTcLCA3MTO7W1ngzkUSk10UfejDpmRzgGOVVNQV/mCwmOhkZ4
-HrvWiWMfcd3ZTDlRDzOAgh01QydnUrENcir4WpmtNj0JqAvREqP/gi8D6BeeX7BRIhnXhNZ1axezaorD
-yHLC+ZgnkvO7MBcd95L9fAwbxFWmcnDlhgh8+LgBzS2fmqYqENb5aY7k10vcCEox7uFSbxPCBwkfO81+
-57CWy2JUqWw0Irsq51YlV3R+oIsWfbVd/Zqk17kndlTcbNs/P1v5HYQNoeie4hYUPiodxLNAvjRLn3pB
-YuQS2X5/fMaL+emXiho3ncZizP41icrn4f/HiOomrJhP1BezwaeMaQT4mZtZ+yYkH8NPtWAM4TWB/E05
-+n5G72bR+dT7vLJTEJm9NwVM+cV2eHqgoCxUhVzsbpsL356K5ndOzZOSlJ+cCJ4yRTnowyD584vZhUTG
-7b7p5doXdAviqrnbhgdx0Z0dDU6nKWrr0DMclHLcXgplNdI2wmQfNbiUNePTUXa9SvWev9sxywn3oPPp
-dNJfW7u6uupdPekV5dnawd7aoKqerGKdk+J6rZe8SCtOzQi7efopI4406XJ1a9k1nNvUFKNZC3RnpY3j
-xoNKLxzO6MgygtqDQVEOKbFZAUrrSGPdMrhB5/9AfGm7h9GohygVVvhXVhZJe1wQipgz3tsMVN1F6PmL
-VAR47tSxiZ9AwSYEu26ik6xBjbnxU73XTiAKYXtL/Z0jpRTigClNJ2hiuH3fFDPVr0SImEnrMvjiB0iw
-7g5ZXJ7M3wW3r+EMn+OxAo4YKkWkTw/2BNDJKn3KXBSXSnNLhsWA/Np4sy5m0wp0GN0aLPVLlSkzSdVC
-gUnE7+KUY3Z4I/NVGnrufKL8wShkEGbbodv+SpyHppwOT71n1KjrxiYpRp6fjcy9Ik8KpvRT6Dp4osi9
-VBluyQJLB37dmO4bZC+dFRUGxCQXuC60jwUuAUQKE+z5WVOFHRhGRs41OPtDGGiEBJlDAX7lTNyAJmWT
-7BkmBrqCv9iojGXmPCc256HWtejFIeqCo2J8RgMCUqtx+2fKwLnC57Gv95LMXq1FOjUPv2mVq1Qp/Op7
-kUdnNu6bMgr1svFl7+3uy+2j7bc/c0oKKBnOVKxTEXQLqYVOMdtlWZTtlrVB1Hll6QPFGFR/7UDXiiZ7
-U4G/FrTeUfsbK7cd9o7EBdI19pQjNa5Wp6OMe8IUpwsx/PEPtx+7j9a/WdL+PJa0O/gu/8HmtNRR1jlB
-VLNZ7QsuDm39+QY2AvvvZ2WrOfwSXX/HI/BSBwtGZrHjhcmhudwZg/v4eieNu55EFXubPVMSQB3zUefP
-p4Aq7uvGgsIWLs6mJmL04w6tGyOdSW2/qRmpx1q/50FXzM1ip12M3ZhqL17qmmWA0tSHOp3cF56KIwlU
-/aPx11ct/sSKA0vM31l70Ld/X0GFePTtndNX1xYOlOmAhTKZrvUR1JtOEEhTbX9IhzDcMQ2PI4jgvoHH
-SYrQgf4qqXP6ySthtEBHgbTkMwsfcLAdrE0GbHEeLeEXMPDQBrpYRskJ04wsquu83H1D7xXfwMHzoExh
-C/Ne8UQA9OsVTiLigvO3f+eTHRXBK72Y+IG74FNzvK475UQIZ+ALNDPZzFfy7VJHT1+BmfNuRS0VChcj
-HcJ4LYid5k5BpVRER9rVyTvjhONaSjWxq+IWk7lHV6QlpvHgJaqrjoG8p6e9ZL8wRkIgP9rEhI1JRXYp
-3GuRaZWNTsPALgulh/CH5vrPkZAgUVBmZ7j1CTL2fGoDm8wu+EINHwPxQKfFJFaJscQoQos/t/FD3URI
-mBIRuyCeDRFhWwJ+y5GSdhYya3KOmZtVrKKljK/aiMwNU+/DMj07o7hCrB6N0gnewRV3ti1TP8pqvHQ/
-SxiTVVoFtUeQJ5GOyKR11CCUkKLZNgIvoun/YsJJ0fmFA36PMhEICrUQ2uVFL64P1fZ4OP/GRJle8PJU
-X1GrhXOSYdfKnhs7unzZFedXSGDhaahKl143/tuzsirKl5gVFb6yFlgTFv3LUiRc0Tc3Q8KVcs8X844O
-b/fYAfLC23RhOb9ENgVaBWHrQ+BBOkHjs9m5q3h/c3Nx6sOHV/kXN1y1Q8YorAINY+J/SRIMf9TqfV1E
-Y2lrp1L81o26mMJS6BqbOyUfrkuWcacA+cEMlRntbmKGOhtzxqaPJHfwQ/bdf2VWRY5L7/ji6009yKGh
-n4IhB7Oqh9vMwWvJktRXr5Qh460fJu+p0Uc2XG/E7tBN1eTK/tKMjTLAiu/6K4N6vIbNMhaKbKF6nNIS
-0YPa98TQlG5m2roNk0NW89NBBoF6mj2aw2Ehrz2HJiO5KT838YJZ1fSH64iMvOs6IOOXME+6lIl1HKVS
-Xm8mqzKB9a3hblj1zltK5mghlZKHTuprVYcpoWvS+aAdja6i24/GWdFe9mtrzv0k987dalUadasMn4vC
-zjm9yjIVo5GfkIKiauMnopUIzoxYjCJN3G3HJbWV02JQ0V1Oj2XFyuOo8zipBnX+45hA+IvkW8yh28tK
-HIa7MTrFMl7ZwbFKu2dHton7/cdfKzkmcdP9/pPH1gMb7TqPv9l1/uBbIHasX9Qc8uUmjab7mvqgIf5V
-VVNr8xvy448sFc6czUI7e0f7O/94C8CuUE6e4UPvfvLw0Qamyn3O1wIwOji0fj+5lhf/OGP6vj8FjPh4
-VU2No+oYIdF0r7dB7Hlr+/Xro5939nde7LzeOTg8Oth9vb33/O3WNqDy/QYKRrLc4UnkdHSD3YloO+6z
-5za5uHTE02qUWLjRsKsrhtJEpQrfCtsvJiOxFnBK02i1WOoInUWVOL0R0MqtuQZUkXEusul5QUf77Dob
-oO9OSuczig6cnqXoiSv9dZIzOEio/QFDj8LI1NJR9yk9Zn2KgPv5mXyrfuvUgP+21M+62pp8twYjVfGV
-iGmdXQ8Yb9+vVdlKdUTlc/dlgJkaqLYNtZhB2xrDrumSqc3qjUa4pyoJcHtrTt+k9kAferjIaR5rV5jV
-KyI46a0mxM/RWRfsT7w6V80otMUxCP5u4JaLdGJYAr1xQBkwfmgk39FvrOK7RfT9wrxI2iHqAV8DsfmZ
-Ft9ZNs7KlJjPtqRvRk/JqU53NjhPx2d/fi6jsfGljmpOLUrjEhXlRiCrejVcy4paSdTD0skbl2DRAva3
-HLa+Lcmqsl2MsyVhrCp5oBc+meDYPz11J6ywX9QNKlvzJJBu7gr1SDYt5lNmAcyF7sy4UjjHyaSYaouf
-4BjdlM84Zv05I1lqHXLur0udKGqLWA+JQ1eJGwLKKLiVmo/oSnYx8Vd0OD8Db7F6feHhQC9fr4qNQaNs
-OqoG3lzWt57ER6uT8qlTlfnLDro3mVXnthsbjcbmUYMZ2gn9Huwsk9vcQPVJuz8BM0vRYppNJtrUa+bb
-LHUz1nAEcoSCwb3Axc7cuNFlKaqBO3dOGOPE4NGXjHDrBeURInoJlrTYS9z9ad9ypPeKBEdhYKbHgKmZ
-0bfKFpakfkMgPcRGa2F98xcSo1ZD6tcXdY3FSCtHfe83QjirqO/+xHIrNfvib3o2PucYxsOqeRkrdNb7
-/b+Hb2MD7fh+/9H6nINaGFoUj19Pvh2//uDj1x7gWXvackGbzjVf91T0dc9pkeZw1GoN68UUa6hryqnJ
-OBAVIeQBO6F7RMPFF3beg8294uBxrfXek956a/4axXrxFapX3fd1a3PZxYtr8btva/EPX4tX34L4LhHE
-98vD3P55QtbOF21unOcFgtcumtnuK4WrFSapaJRcXb5xZxdtVogq84oD2dDzJuSwsEqe2Azb2quwmBrX
-Ml6bcII6uUmG2WU2Qu6Ecxq/uO7S4sdETWEHujV+b5yJbnJ0fqZ3XnX+4Zia9a4Bd1Xdrx9S6r9bFNxl
-ggQblwj2Kte2GQcpN5my1+sSzkRfEIyrLiua74KyfIqzxSlkE0LHyRTJJL0MrYib8mpKL77k/RrdKGfD
-s8x9haDv17D4oqimiXpGaXjNPueyN3Tanq12YW3lCkYRD3vWiP8v8g1vMNV3ecD7Cj6zv2rKM27SygU+
-QZxle54nUKTBIWXjrmvXyeE918/o/9Vkeey8UNME9PmHp7776i4kkzCcY6MLiWlUpoabmw4ONqjFs8Eh
-sJsMTmDCsQb/V/KYzEGPzF24sWVKJ40hhWLVqDX4nTT5YTh572z+IHm7bbK3xX1AhKmP/rVElPtLc0xp
-0Z2s1NmItlWT8apGb7QDJzsee51QUJyqNZcp3CCg617M0GCAfrTqx25A1srpQdbWcKwneJNcFwG11mNB
-BD39ad7IbEQjnw9X5LSKaPL75+kQhKwX01yWtQPCiFaC7fTLptM2d7c5FcV2iwRJJi2bRHwRNjRk7BC+
-Rgwlq/O5Zr2JawLy/alZZ2WOaNKicq5L3N3klNEg3PGS97OI6Em/OZSnrOtoCU4LokS0I7+Grfn573Rb
-Nu+dbcl+q2lne0xhqcKGqMBviT/WNPU6Sy99jrAFflP80W9qcZ9AU6UxS2PgHIi7Jvq+uunTYhI0oq/L
-NI9CSqmvoZPgElGdQSNRcfhV0x3hSPjniPTspBaQ44/kGHCEuBeJFUf2zFW/UCOhU8xTmRajQX8KfFJx
-XuUsvszRTFgGzqyRas5ctuoEOWAmLuJi+qKM8G07rN09tLqzsbjetVCOxsAD/cgIL8fbET8tmMCPQKm8
-G0jorr9TuB6XjuiT/YuCxbCQFe6GS01uwyXTGVrwL8BCid0ADfq+BB4M/wWIKKEdIELfl0CE4ZdDpC50
-/B4phXey7/2Jw8X/+WPBx01OJgKRTriDposyMzib+Jd0ycRPDd2jOim8B8XkD7YX/VuNAL4qLgBUzqFn
-kW993GSUF3ljKPp1Z0kZCn+xonyHAPK4hcLs3ClovLLIe+Hi7cicmPGWjYwHq0VR09kUGWuCVMsW8qgH
-pOojnDv3vH+bF+b8S+OZe/lh54Y4f/T9tzvnP/7O+cUMX70sfOcstjTgPREmi26Y9S9mY3Xniykw+OJW
-JVMILqOV8q8flAUX0YD+oMwnGPhRJaagi2j7uZeBAFXRbTZrvuOtODksyvJBMT7Nz2a6Jvn4kULfIn/x
-FhLXgndk1asynzrV1M23M/YbM3JRE93mZKsb8orbUnSrGAPxZzidRLhp8Y7FCkeF0m9WdYAWVdwJiS8a
-svfysskOj9lpt6kVF4UNjbqAwCt6kFf4qkhk2yVRjBcJW8hlbfSqx/ugrqypR3TPFCf6D1gaEpAcB0yk
-GdxHdbSZdIx3vehpgA63xKscd1Jh0mKSM0vvgBZ6lpW8DvYzP9utV7pwDIt8DP+bw6J2YcxnDfdm5+3R
-i/evXm3vHe3t/rIP0E9UwfNfvYK/4t34Wt0SZvfic/aB4EHTvbl60VCRvnNCoKiEQUOj4iwf0EqMBFih
-ZYEh0GZTpeehLqYVJhHaaUW9hsu1PqhVLVDc4vqxwtcXH7DHmd81ddzMKIjQFvrUu+lRhhwWac8kjnRK
-dcBLWfZM90ttcgnHPNdnBp91KXpQHZbmqkLPddtHiTyQQQ1qBQUXs2r8f1sc9/y/ZrAxrCfBzZrpqKV7
-YuPUiWBhygbs8a77hjnoGgWn+0mCa7pBYfUiO8tF5tMQYHs89Isv0uuf+XoWAGjWAOJNOj3vDbJ81Han
-JVkLcOkkD5NHG8Fo9zQPmDei3oLqcieno6LQD80jqKwljztdf8k55CpFR+ZvD0ByDoPJL35r5s2CT0Ez
-H+4HOdewpexxE/Jn7yRXrzQl6qoQdbb3EzjAZkOzABvLvdboSkvu/6E6zPW6yQdeNHw1Ude8UuBpn3Wj
-RkTB6cijrIzSxlZmeOkIEu2F4QcY2GOKgxJhE2kDM0V8flHsCNzRrmP51XijNqNWgjIQdIK21/aPdatI
-uuUzCCiFUHpQqGG7DXXr2okV4CpcTR6JZxBuYw8f2pIIGVdXXc99v7q5+/L6XIwSdj395S/RSXzqJhn+
-0xNHuyia0UlTZhJZEc0LoK0sCnrfOs3LarrnZG0MC9mXoWalYKyizcQF3XDKp+nIyB6AloWlnXQHEwkD
-CoG7kvJx2wEGEV4ngbs+W9g3M/X7TxQTxXalZTiDFxwB5CD9Rak+Iz/WcutcljSJNV3suhYLl+skQg83
-43uJaVTUe/iw9AZO74H2QTPO2FUsSU+n6hQL4npqQ32jIgj6cIYxVVQ0Kw4nLhtCZy3g+FlenbO7z5QM
-Zxg+fFywLmhja49N4x5xQqXAx/p2Zen145G8Zh35E+MtmbJpKf1s0Y4CIT19GPfqB5oXKeI9/Qy33J8R
-XQ0STjC2IYmnVY9Yz3SbHsHaakzcHsU4zIayubCLhzXq1YPksW4Lj2fO+PRLbZ0Q0B3pPqYD7GDWeB8B
-uZiaCVZmk1E6yF7NSmDDyiNefMqipOomAaVv54xKIgnr4pcMLTIt9EdG8wgGE4ZzaXbNy8UefpRjZV5x
-liOoRm/73EWmgumjsq4rLkgQoOvbzAC8KkoiSUxQ2AX1QTT5MbYU3Yg/6qUrjwDmDqNt3lB2D+FeyUE6
-Ja7G0l6gQd0ZbYr2a5pIalJH5MxVcM6ABEugbmXFR+dNX42OinFg24uZU00FMqr6Z6r7/cePgogi5uAH
-H/6Ols2/frNs/sGWzX26/F4uvMg34+Y34+b/Y8bNdyWM9BqFVglzfgDL0zVHhuUmBm+DfZMAXNvk99HL
-cLkKl7D3yWqBye9PZPGTeLqmuFIfsCpliwsJ3TZjCW1d0ipUoUY/5ALdGj3rMA106v2pNIjnSRVpGYRO
-rQXw1h2ZMIMFVrCJVVkc85+K+m+Ol6aZB41WxyWNlHNtcMmz4Is4MrQDR/IaeixltTOg7JBFqui0QM3U
-RMTzC+KGPGCnn/3O4gU19ambFzduty9umqAPChf6oGiG3iuu/ArwqXY8e8GE1ZTUmja3PMaKfm8yjPpK
-tvvVqSlmfUa2SbV2dsaa/O11bQucZy6VssOzmIajrznihoDo9DRXtC1psY6cwAOGq0fQhWvXymUfuYWW
-Vdy45kx9vZXNAWuHLqueScKRYPW2iRrGqDNS1PHRl1j/ljH65U1GPWVKwzZ+9I291liW+5ayGkMZD9Uw
-bDv3D6rQjbGAeXsTgn60oLlrob1dbDaenxSX2VIT4tRo182HS/EoRdHo51GV4Z7CPmmJ+DSsJu+jvBBI
-HL10c1Hyuvvzw02u7xHVt2vXE9X01ExJi1DE+BZbdMoOo+2v63i2Mb+fbjawmVqu675lR4WLcreI+TZW
-FTNK3NjGWFNX++jPDkdxYoVNtbHa3ELEZqOUSNwQhWXZtBhMb03LEgu/iquZoXc9Ib7hk9X97N16rM+5
-7NDbaeOdhzHoLcgpcb4P5tE3LGuangmadnocYnJ1MeaIbYlKmarbCVVxEGI59OTUT/1YDkh+1ZjP0Fnx
-BZpN2u6qdszHugWh4OhP3mhdrjFArtStuQViKAk08a2W+oO50VFNcYdbep0sOJ24LIOhkT+FXIFSznld
-xcTDpbmN8qRE3YJaTdqmi9WA1PbyCrFlOf1UYWhQVPuAKuVtIHbxFMg52eqPAeoupEsJT+Ljf3oTWZef
-A0uwhVzdDHrcqO2vGbKMXLXKyHrBXtBEkoXFgZr0JSc8juGtazmX0+JwoybyqjcBylFseeYLCbsYWz31
-lonLSiEqtcy0+oXMJBsKu421ugCgHqZVYtzp8hgqJIa3cy/FUcvPY/268bfgKM9JaXqRXgeXRe7GvhrV
-33UXQmazFxWcY/W3rmzdzUkizT1Wylu01M2cJsucvaxxF3P2Hduku/3UbXkCvnmfd/X6GB4qOdZm2Ndq
-Emx0zbYCz0HAO8Zt1LfhHkt8nF2157Or3/eTyFES/ytUOi0Xi26wofa9mZfPSgW/9aNMeFt/bmYV8Pl0
-ezycoyzGQBdVGfkCVwfbZaIm2biYnSn/1hRJy7dO2QiUMgCkIKzkJlHNSpXaHj0ibJNXOnwOwpl6Zm2g
-qZ4a13FviMboSnulIiSrKrZF7JPuiyn2TZlhZl31XocjW82wBxM5mtPA48v15VRjknYcPkcssTLi28N2
-hJg48+p/jBkPam0Hfue+SPbKpWCnIN9zqtcRwqvnSPIGM0TdUKVQtiQL9OAFzx5xCU0XYbrFH2uU3c3w
-8KvEgCuda88vB0Xj+eWgkAvNyOvIEcZF15sWjoJsikcZ+6qTywB7PcM6WlfLpLTP9PCyeDaygZ5kgxSh
-fFJn7XBUkoUsuP7x19GZvAgV+J+Wn87H+UJzGbHp6JlmaE+X2e8bZgEjmWvawzyckeW65HkBBUBLOGKM
-rg5ZLhujePiftJQsWH6dFNNpcSEySJIrGs6pmsOeowyXvm+pVvalAS0Jl1ZsSwik3e181eX31V2Mf3FU
-iZFexE3n92/KTPInV2bEzVcrEpOPUg2TqhAqDSU9pJ6e20TrajD0KCcdXaU3JgjaCT7kUa98sB60o/KR
-GldPlUI5napWVNW1Rhm/54a9sHZH+60wjsa+4Bcr2JwkSs9BWy5qwxqF5kWuttp4dOoq8Lo1M3fPjRkH
-zR63kJlMHscLyeW1DLFnL3jvwhYnNzzjlFB0isXuNFOK1tRk3+dWKLQeeYSxt92pDg3obKRd3afRJk9M
-GgITXQ8TFwB7WVGuWlIqs8d5+dQEBOC2depc3ZyDgA7y57XS09kSgm2E+zYrIQPaoUOzrNyN4sDJkHxN
-QvVv2uPuJCYLrBwxv3Xnka+2WtRmyT7Vi6iXXkXtQq/aWPBmQplPuPKPnvCMXMW4y0rX7ETUGYXH0+Ca
-aq4aE1W1F9IHatAMtvLYCw8DHTXAz3VElbf/i/qienXIHTV0K7rff/yd45CK/qc/fPM//YP9Tyn+x3Lu
-p03hyO+SKU7erh3gK5MFg3WZt0C/yHhdS0brEg00Buy6tSvEVqHsKwH6r0bZ9T9K1k0WHIGu8iWDMG3E
-xnGqC3/7jXvwx6Nru0PCxzVlfjKbZljO87Lij6q7IvwNNJi/idBtmC50DtqeymyiKA7ccHAqRozLI4au
-t3pMzlNLQdZgcgYiaGlQSXOUwVhDBZmjvhIb2oCdiZw9ltLkiatSdLnTKZyRInwcBp0Ls0B5Gajw5JCP
-9S586+EmSSNeK0dRS9Yi8/AgQmYRbDTIplvXiYqw56OlneYcWriNmtg9PmqrHtVOzYII4LlpU+GVZZKV
-KInd1FVcCQPNRdPtYjAdZuYVb22YlWE74MWhwyfZYd+6yzgd/nNWTUUOFYKqgqVMZc56hl2XFPvomlZv
-tWILIjdrIOB/7iS2COpy4olqVqJFkzl6ObgschFmV1mLV5xouZrxXDFj24mLizoZM6+ew+Zob3HIDcqu
-xMqY+9RKeR6nvGEOJlhiEtwtOxuWKjrrhzcRdt0qe3Mkp978KV1iOt1uaqY0hnvjPuA0GsRPNvVrRZLb
-wxJSLxR7+9l0SqaSzejO66LajWLYmS/gHITnize3fdW82d7+yQz1T2CoYBi9cMP7p5Mg77m3Pmtb+PDP
-j2G6PMm/9YJWh6EOpK3p42pxsWsR7ooyxqEfLL0GKVwjgJtlr3grP1dkSfWGbqAk8I+J17ajiUVZT/Nc
-k4Tq6OnyFEzzNZqrUJxAEg4J6IzEU/m6olzzcD/4glCRYfRjHxE2nJp+5JsPKbbSfl3BImkNBQX0eXmB
-LIR/+3ZW/t3Pyj9l6SQ8FiMYBWF9MxufZd+eaX57pvk/4Znm2gOZh/vBLeZydn6f0G+9d4JoGWXp+NbN
-mK3eqWGATqgHmLXTbnLCG5BJuf1jcmL2ClyBjS8yEaCdT7MLUoh0s82vI7GO+0yKGoB++F9gfzfiFXqs
-GJstweilJ4Bs76Qrmx/QWjBsWe8csMlPb9o1z7EQWe/hVXYxmd7Yqxl9GxChPRXE7wKokfAloRzw5maD
-w/ykmDSg8KC5c6gsukYe9vsNrfTWzC2uCLLR1J2YD+sfnetxvMff9oF61L972Ytdo99OHKdolCfdoYp3
-At34zhtVPv5EYczXOwH6ilTZqP6VFqq1ESLbNYcYNBMaFWOE8t+tMe52hA8fflT875LlZHYCgvv9RBLD
-XvDE7hABooExWLtt5o6KI7HUcmY9vbLs0xcwJdT+cq50sLUsWYOxXv11PoRGOJiOrTYgvTX4RCrxfQiT
-RPECN+yjrfUN/XoqZGbDqnnEGSWGueaMOIOiTqYnmzttpL3lszx8qhYu8jzmwqeG6c4SXbsROjsReuU6
-/hCTyvXesVUDAfJBNPlROGGtrSHb2Eo/Iu6oAgiXL8tXdpNomypdrNFxnQNdThO8Rh1WV6nvTxbiCOhn
-oXiSxER1USNh4TSTibbmc4Zmpa/DGR5jLsgZLmug1ulzBaYQIcuUZgwMxig5ImCGkh/n1FdxwXFiNODq
-I8ElOhOLaOpHId7cyXdQ9QbrNuI46ke5zMDHuCxxEHabli3fxviQ7sY98jSPyUJ7g/KamT8qWyE+LNLX
-7dA2cTYoxl5TQxIjU/lj0LZLNA/1DQf0dh4NWXhcwbm0wMBd5JeYUvsRCeIPaGGJEV358dHGxYUt94WK
-FhYGIiIqXG8DVG7r/QpUafcz2jv+voi9Q5wT4Mg2yKpvRpAvNoLsXFzM6PS+q7Iof4tS9c388fuYP4hv
-ztjTV7hVtI+uu8nR9WP8nyd6po/Ss5Rcw5ikR7pCX+/5DKDBVZLbzQTbmiiS009oFh+u5JdkgIYeeNqN
-0sO2dQ48YjKfbNj+Fato7oMvanqh1u7VWE/vSzOLbZ06XKOhiMq1N0UvGnmDiG35nZ6d3VPVnmpFw9qw
-kJroAnn91hGGa8a5gbRQqwaxwt9PyHuHibPhk1w7C1jaM3saZzlvZQhU8Kfy97O44EgNpQkCfvGg9Gef
-OLGRqW9cpYeWrbYeglo/t39uS5tFLR+fZyA7qnY1OyFBCstpBpNDf2uckAkACVtAr8xbpll8v+QVasaI
-IrqPsHjRA/tfhWhczKppkuWU6Aq2FQqhijn0DeZdcsVtoROkjwuNSWNvRYplZd4l2gJBB10phT4jx2lC
-94lh6KhgiWNleJ+XZTfRslflKEykGOdvnLGIxZogrkH66IhwODpCzcYAbJi8HHp7pMimrrebW2Yc5BbN
-uQHSf/fUhaFPuhzGeqaSIXludrLE+uUhf7/BEqb985KumiVsLwajEoVUB1l5kaPm4dWxJaqj/e2tve2D
-o//YPgRQwrgNkubl7tuDo+2ft/eODg7fbR8d/LSzf2QhOUkpX+ErhW2fdg7oa3peDCsOQotO5ficEEUU
-/8InQWopVqgKHbtEP+65FmjM81RODUw713+xAm1zYZgCudLdtrtJy1OO+slzkDoXkyl7keNDgdM8Gw0x
-RXyCD8d4F9IRZXHZwFdcOS0jWIJuejiDt3VkQfUIKFMVY6LNCSZCqSEI43jcS7Y518oKZR8ARRG0y3+h
-TntxAbSlQLfHSn0Gteiy93b35fbR9tufWbBAyXDGouU4uUA9GEaajoCq1BgUg2Z6ccFvsqagwSKmlE/v
-AbnhM4EQWXyJBZhNQbXdz3HohAi9G6gwJd4NXTePKxDd9JIrvSzyIZyO+GUBtpdO6dUqIGyx4gl31+au
-3v2FGnrkUpnnXz10ABUXlLEZZlOrks908f4jz93TW0rYZ0txSDC3CEL5AAmKnzF0zfvbB7xikClgXzrH
-UGrk8Q+Td0FUs9ymGiBHfhyHuOvwhtNuvuXwoPWFB+pX7bge4dWwsrcDnC5kb4uPxx3eXL3eiJgf7MLW
-nhseTxM94LBl1UZ8k6AaM9TtWA/IhRjSnoK97oZZNnlFbL4HB623wLUyzB6dTOnmxey4Hi1A9fTYZSO4
-qQmqUNpl9+qG4SPPal5uv9vb3oJD2kt801ZmmPgE1gev5GmO77/p9QwlzPH5QL/6MGa2r8q8d2bfWpue
-2vRdWx7UDNSCeo7c8OdZQaSTyegGNeKQi4zVAUrrry+yaWR29qgiamtIf3+LMfIcTs1jzuOJb9zwhD0D
-dHL9/vRBWPNkZh6/ZeItHb9pgpVwPJmhwH4+vkH81OsoUNd1g+okn+PLiipj6qLMNxNyTNslNYPDT1UF
-eqBE2+epwc3W6SVvYZjUISZSoFDoQ5WnwaJBweRpsxA1fU5Ud1XuuG8Fw8B2VvJGzhPo11RZrG8TGELy
-ijdT3DiQIXHcTGi/sr7y8ftF9ge9djai5K3YCG0mY0Uh3qznPKLKhHLQRbSEx2mtdqFZ0CoYSlfGYeG5
-psVDIHUdv92TZx38eI+i6YK2xVF1sV9UQKC9EeyKps836QTDsBnu55G1/DUQkyPeqJrWiD7VRtbK/uwM
-XziCWuJrHhQeLpCSn4nonPkUuqUpvu0YTkpeF8UnSu/GuXlvQHrMKlQo+pi3NzlmBbNzjFxxNktLYFQ8
-piJrTpdmR6UoqH9s7mDW5SZmswrYFI1f47PbxAwmof9RmXXpq2olqPrg1ow74UNEY6061nZmlNuB8yUb
-j044+8kxNHQ8l7uNQSr3p8oMrmswdmU4MXPy2QQCgN8fTCW6YlLVPG6MMUrQeyNPDrNRNs0a2NIV4f6G
-ZYXwGWibY0X6MU5hmV0Ul+J96e/Kj7tK2PkMeVoWF5YXebCELvRWy4tDoMYkGzJnRdmRG2rgLY1PRFwQ
-yZAOCo1GtnKnJ5xbiarLUoNicuPwlLEHI+2Bqb225EUI6o0IhbLUIQZGMvVY7jythLUOq3mXPYjIB/hM
-fhJuZfosb1vcfxtELjbaKGhfguL6Oygkmu1B/9BNWjVEwygtBC2AsxJPYaObJOUyn5G34aBW5rjfpKNe
-8jr/hLDDIuMzLrQzhKV2TDtXdQykLPHSYXSzvLpQI5ndjdu08yfUHXBCv0B/UMgd1bRUy0qESRXnpDLP
-LjM6CXjs2ap4BGypVAeFpecsICw10+yLw9361hlBALVqURsVUL2LdCKO+LiIw9feBtxZtrf1b6I9qtCF
-ZXgaFB4Lam7oFGLmN2ZasyD6/Q694gLtW3ErC74VznGEgWuAax0dXot0JESXhSUFIkgrzdgtTKGGc1xx
-2Ime6okU+U2HkFChY15ukdFP37nhpRuC2Ds3vnRb0WIaxSwZ+6oPufNwEQbXLFvdEWsJC6iI6RGXCtba
-2NYwHbyxE99R66Dv8zrRgE4nzVXk5NK3rmnFNybAlD3H0ygl3xrrAxWJZjlFyTAf6vt+PTcA/R/h9Gg+
-4adccmoUfO3scHmyqQHdOQIk/CliOEHA2MtQj0JcR9NV/fIf1eCAaw2qybPoJun200nonPAVWnG/kI01
-8EGIrH1h9jIm9yNlnmp1OjoDl4PT/f7j9SDlFn4gbfF+/zsKhiDN6vDt++593S7U/+GH24/dx+uLuEF8
-u47/dh3/ta7js+tpNh4KUQQMlJ+NaVbMiJlWAXs8YvYwhrgYiygPl00LRSzia/kMpqnmmwM98cUrlKt0
-E7XdJIykFuRcyuKfb4WN6y7BbfzZr4m/uTV9Hbcmks+LejUtenWbV3jH4AHRt0Uud7/mLWpwNtR0AJ5C
-8wQgnZFhQKnF+Sif3uDF2hAOBSNcT8kUDnbqNuCBogdf/MFMAomGPToagaAoUxX0NcXJrPTYyNSQjWFJ
-DzKnG7rCK5nGeDhP+PJSXOaRHAAc8KaB1hNfdVa9YFzYlhlPVdcjtWf3lm6CsW2FWY9WA6HFYc6gt5vk
-qoRTLxvLQ3KaY7PuAU+kGJ4KWxkX41Wxk9mOdCAxIyc4+N0YtMUbWBtKTOtL3hV6Q44D5wveHqxrpf7j
-3evgHA9wyc723/jd0TRXqLT5jIzncONRqharwJY3jHpcO92ENQylvF4h+2Nwslk+Gqo75LwkStqL6Aip
-Kg4iTJmfHTbBhc1I2NbqKWdFEpnnjqfFPtm7jrvUPrAPEgJJucJZ23BwKVJ0PERrnHH+QhkLPGcbUCYF
-utq6yKHCpwwn5ITQwvvPnPNIo4AWuA1ndAWeg4gijNp4qUNTybh0eH1ojEb5Rc4jT3S4u3FBkZSRDkwe
-S1GBnUacBPr5dDqp+mtrZpX2Lop/AeJpryjP1rLx6vv9tWExqNa2t9483yeF5egl6PDbgPdROlWvoP9/
-/8cvejE7i9yfa9+W4B2euS9/bt0wossEVlCWlpVO5I13SkCd/5NepoyBOqx2ubXsepDBt+NaHf84yVDP
-g/2BmjxGRU4zAFm+8MJ3dMOtnZbFv7Ixa4RELboXQQnGt+wrjh1DP02oMlCZp8n2GAPFVrxv5bSO0sGA
-DGspRuYz9+IsvpBHuD3AGcous3GejWlrNbt/RfECWSE3lRA+lTW4lYFU3i7SGx31kMfUS14V5UkOuye6
-UyAr4ZY1SGl3xkV0Bctlmo+SK9Wccio/YX+65+92Gv0MOFkzE2JBZwNxOS4vuxQx8aKr3lOgm7R2cdkM
-gMuRO6gnIL0Kq6yyUDd7+5AvzWExw9VyAt9vWFwZLxRYfp/8zd+5c+61ah5i+r4/kdv9uFNDxOim4h2w
-eKep0mOlSaT4xDiTcX+mZrsbbO1wUmcBo6ylxuYpxCiaP+vtpUrU7CqHgNd40ysdBKQR1Vwgi57n3DLV
-eH+IBro+Du6tAKjor+ksIRxWFaDzNDceicvU1icRYyLUx51GVOCAMv99XOhxEuEDBqiipnmWYL1klxwv
-NX+gCMgvJqOMZJjZJ3SDl1lJPptoXBqykpFXdRbbB7e6rwNjBsepZX+w5hmMONQU3v0LW+tkUeT5JFrJ
-XtJ7l2l5YztPXu6+AfE1NDet5jJFLVYGs67P0MwrisOu6geGfcsIOJu4Gfj4GmNY5DoI63j3QZ6HEV+S
-ZC8NXVRLH7Dqx0797ZC58mSLJrtq1TBV0EnMkyjHnO8VXxkeh/ME26TOZH+VqeD1dOrB4atdDh/3c2vj
-DM1PKcyNugUqxibareIYBFdZ90GB8eUV+oplTtRa0xCdH8a2Bzjn5k5zepM7QGQZx+LkMi9m1ejGXFvh
-3oD8c1WUn2iSzbkT+cesiBgl7jWyeN2MNnE4mkXueZzB29cet+a29G1BfOGCiNA2siRIU6lwNNYH1+Zq
-QMYwrLbTpF3o9vDMCuIYTgBDk0FA8+wJZk4RTXZB98CFhso9AY7QvGT2zAX9bOkIXqWXFB7s5CapPuV8
-JDUus3wPdLGsrKdVFd596hASyS/n5PCXkDY51QMjjkIH5iwb2svgmjvPevYPnvizS5R+q+N5Rd1ra4FT
-dyroIJgC0u8c5t/q/cxxp5XR0+PiOQYX/Z68hJNHcRF/xhh0RPcDwU0AC5L7/e8eOfcCaPR/9C3W0+9v
-DxxPs7OsfDHD3XM/+/bO8dvFyv/EME8i6JKwTOOXpZ4vraAWk07R7Ia4mYRkqc0eQYYu7V4CK1jdK+kQ
-9ZVyWbrApnKMC3WVkj5E+SMwwgm5J+HWZ6vg2zNKDaAbRqs1EaMYZ+TMjK2BdMHDUcmlaBoweJRoYWZ/
-516yz01lfNjiUkxtUt0oZLMhNUdGGnxRk19m5I1UqHxFBcwTMgY6OLM+vq4SOwwoUwLAmcYpu9QjbO9V
-gUSi1zwDayA9QQs7PduhTh/gA0eRu03hQ06H5MHIOUHadB8A3MM/1ZsjplGH21FmFSQS+omwiRx/cRWc
-GuzK9GD6PEkHn7gJRTJVQzufnc5KmL9KOS9a53aGKil5pXnTQB+xtcT0mKPrStAb7lzq9RcxCZpK0gvY
-A4ElhsmoOGuPO+bGAiaMThls03PonY+xNc6gByox2zu9PaAx8pgPPO85jgfuBiCj4R8UOgnEG1qA2ufR
-CUK27ny7gF5+Zt4l5w6Oh/bhY5fSZo2hMdhCh7gQ1WZ4nqloMaKBrLSRyTqyeVJVv6R5lZ3Lad4WwyS/
-zUwup1dFqc3MDaW9k1ylk5aYAiyV+slF/e+1tfdFhDf1Mw6rWP2V4u1or00wXqsRM6PHJ56JUSFXn6B8
-n4NnhTklFw+j5VOtvjMHTCaTuWR1fO3Bs1je/Dqe/0CfPgbvvL0zstDr41lP6ptuGnOE2+pHHgGOjj8Y
-vn3/sQwRuklrn85R4/8rLAPASrQqjZBE0a3EtjqOqu0QlqfhqJaTnWecXQUpeS13SOFj81+rZDKz6vyg
-QIlQtUUrXcbAC6/XME6JQvhK5d28jJBNq61m/pqqtJXxQE8cbMVs8Pe+n8MhJFoAOP9cz/3zp1/XX4QD
-nI234t00Ov9q+1dvjR1WcKZpgKd+nlInk32w3fR0/EgTSElsFrrQS+N4cF7ijoxqlIplPsL7LI09o9rD
-zLd0BcvX1YVQUGRjDs/TIZKa6TXLCsH4oEc6+40zOo4FGKakukiv3Upy1LFKSDzT09PNRLMTWipMYz9u
-JoadPJo917ksK0EzNalISGM97SojUwtT7OIxFb1OXaPQAkRR/LjHfCUHYRBftZR7aoewWjsCA21UwpI1
-QnUAH7IqaOed0Xe0P5l9UyKIoQhU836wKmc2ZbjPIM972KQalN+kM9dOk4KCQY6vOslnejQu6OZZz3LV
-FpEkQdrzUITbPOpGgvl5Ledm55UN1oW0lH1GsvR2w11UXvwp/V2Fiuf/bJrMMEGm6p7ln3lDxPm18VjF
-zGbMRMbCiLn1zkmdBd39JBukCGZis3HYAj6e1jIdhl5V6HqbocNIIViMsFYw19FVim5DHJVIvEDHgBn5
-K5BjCr9p06Ol1UipvPHgz+GToBxPoI5MhcZ+QgEOZBAZdJU/e6lOl3jeRacYPBRwsmOUQkTIXnyrCTcX
-j1w+pCCfo8qAKEAopctzVpV8HNm7OMBsNzIbXNLxRH7Qanr9Ja3SniBafZo8Wsc39hb9YOPU++YFSfWR
-sW/grc04sxSm2dZ2gAxNpUO9CcgGoZR5AjfYQgXWuACmALUCiP2p8iRfmfEm7GkG9SFAnQp1HOu16qxz
-tggrS1QdrU2IyZDYybPIEbkfgnuq8P7dD9TzjtSmj9cLn6qntZ3EDtZcrqKf3bMEjGljrigVsM6+Rrzy
-DgQURVmp0JHokl6bGysKMhIMgJjPuTWs3ZRU1Z7S/u/VHfUSbzri8lTBvW4WqJZX/ezagQFF9Fgrsxny
-tc9EjWJ7rtRui+xaSE5LCz2j52IuUX2sJTEBSmW04UR9Hkx6Q1xfn7PrBhUAysGNzquu+FmeU15EdeEY
-mCsAWqU3/jEp9d/19A7WRR2KAeDXQPFpFEXv2tGz79SHP41A0s0hcsv9/qO/Be+J8Krw8berwt/9qhBf
-0PF94H17H3g/NoXIKS+eb/3H/rvnW9v95G/IjAfPX/STv+Nfe9sH7/fe9pNHT/DX89cH8CeBbO9v9ZPH
-P+CfquaTx/jj3fN/bB+9fwc/n5ifL3d/gSaefEf13r6EP7/HP3/afYPV/op/v95+BU0/ofaoNnWyt/OP
-n/AzocKtfLdOf2+/3j6Ayt9R5a3dN2+eI2JU6d323s4udPLo7wQKBX+l7v4ThkRf/nN7bxeqEvDb92/e
-PX95tA5lfxW//w7V179fudWhfR8/+cazvzvPvi4G6SiLci2q9egYT9QgfJXvcI8uRbimYua82jt4LeQo
-/W57GcT4Upmk8wql63pJ2NEJTdpQzWe/gfuvD/bus3k8mj+LUTL8892SoaHPRsVJOvrGU1/KU2FW729O
-E9+cJv4HOk0oOUJvYHgx0LoMXB7wGl3fRdPhDuPl03U3XdnjyuN7cHy0gpc7+IUOHpWGPiW3BXymNKHV
-l1SzCxQ3Sp/FLvFpWAJikKO+mAbRgosRfHHtdxDEqaLcBWaTiYFh0Yi+5tf59KaPPxP7HqWf7LbHHf74
-X7OsvMEPfP2uvrI9QXzu4ndxKw/a9OkWOUKQBQoHK5672GdIoZxpvJgPwaWd0XTalfczOZ+6X0OhY8OO
-3+WHPch8rsQDY26Mh2cvlnf8srbBx715F3XN3xIg1knwbcPghJ7DGuwxxQ2sBQUUnzymSD0Y+Zy2yp74
-Zu57kGT0SaJ1qS5HKACDqdQ23XfcMeRTyoBZtX36U6J631lA0DF+OV9ZmKoOhlbBC9oAN/0v8Rq0Jpwa
-9svc6/yQVcIL/YAp6q+dQ/6JsXbs4rmOMx95hqMQ5sdENCx8wwPAB8BbNdfyNfwWO8Ibvqo7uhsAOXZO
-MuMkydWjhk/JpsnoZwYay+umk8Dp31D1A377aB1vgqEBTPNgmMWbRqMWgRhOnTziEdGjDSwzttEa+toc
-eM3QGLrdkzsi+5HJ6I7/mTziBgnSujZNL04WcU8ygL5FcsodXOwaDVvTzK6e0tfhLjA1yPnVEcenqCCu
-rjbjJj+QoPxoaCM+Yranj3MNZhxLNjbrGN9w+bsvlKI61ZC5Znw4jwMk8qYFHKpzTy3bFhnS7GdKJeeu
-IFt4z0+OV9upT0pRGNLZdiDpvSSytdNz1hzq17wU+Uyal3JruSUeY33NzAEIzcFoNjQXZqDc6eas26OG
-7nQT9UiJTlnYHHKrbQ0zyOmrcf1RNwdaE8ZIABWWLs3V5/jDi7M4k3WEZVNPmLi0Ntdmlik90eDeZ9yJ
-JykBGIfS26zhlVpee5o88p8o2cL/BdoN2rqDfFhNfaEEQfZjqPDK4E4MZ9PIs+YuLqf1TCmsbmu3D6tn
-3IVRWdPmYwO+6qM/gHNwIhMVyaHSrbFJ4OQmEScAOLqcFXA8Pb/oJe/FZzyaqGBy5hLJnEt0g5fa58M5
-ZuggP42Ma4cddanzmTfCTLaFHTi/tB91k/Vu0/7h+6xROjtqsqezSkb2R9czUUBvNu2mHntIpouwgFVO
-G1hAjV4abyhgCnptC2p2Kdx4ZPYsE+jDZXz25LlyoZm02N9xJgUr1M6Qvnu51H5V3syF+UDLjB6Rak8u
-p51VR0bU60uyE7E1sRXFaX9TbbUxkdTTByanzkYIp/K21t9nLsZO3sqoU0q99aP4zng4arEXuD5SAR91
-XmRn+bgJYBsY0iuuZQwr5EXjnOPKbTHiLCyJriSxlPw1M9wVtRR5anSa26gjncTrTT6cFDmdtmTy2XBA
-D/3hiCy+ghL6PjS+mT1IHn+MkCEqGB0lq5uE8xcfR0RiRrzq5knlQMOL99b1iaK69laqq0yKi39noT1s
-0kA3fJq5CkFtFsvwjF9/qRuHFSEl1RtYNryQYn3fXNzfT57pgr7JtZWNTiNg9NkAXQHjF1cRMFXQTz7f
-dvR1yvffruN+96uTvSylVJrh9UjAMeaJXYl1KBdU9/N9+nG///g7uvX/67cp+2OmDO3hxRj6+AXU4nez
-MtvLcMBv8ut8fJfpXBvlJ2tzW3YmfbEq9/uPHlPA2B++scZXZw0aJgYvmaogeniYAgWZE739cp5loyS7
-5Dej0D4K98qe6a+wnELKwXd81sjvNlRQ/OS0pKwTKeVlqOdE6uUnCqxffrt2/Xbt+j/w2pWcWNEf8pXG
-0XmQ7hTpsKtjPBeP8n9lvEqdCm6ZroEAWTV9Ps4v6M3xK1yf74rRzSk+VnEaaAQ17+eD5dsYWFHLk5yj
-hBmZwQYcROEk06EsSY6wALdSBOaDW0LX8Sspm84xKOOYs5PZjlRgnFzHapRtT6/swb/q44OVaforyTj6
-8xBfVVNjJJkpQin9duMLBgRo04B1+YM1hUqXPiu3y99sMacT2R+UxWj06yJAhwz0TEM9s2CYWB8XQnqW
-snG06d43QNx9xJ06877zMpERaBhEUcx7xq2I53/Nh5pJ3Q/BNaVIA+zQxssEbE+FLtSm9/uZu6p6yGrK
-1HSACXP79eWvtOuXOQ7X4Ha4EG6HHm6HvxtuHh/UYufDbQZfviqGPPGN07VRAxjQTgLOHYYEVstxS4ue
-TS0rBJCWHpvOz7nX48GK8m7HVTs1dipVKl23SbT512ZKrA+3L/m9gyvo21xH3k2YZeqs2od+U71Jfp3p
-GRA1D92ah3U1D2VNf5IjU99mRDp11Q6j1Q652qFjQ7rndofhtZw6kch0vpnLpU0oROLEAv5f3whbOPRb
-OKxr4VC2kPH3kv59mZ2ms9G0bd8tctprvCkbysE7mNPdpXVrUdgEN5riFbS7UtpeGDxGKQCKXSsp1LQO
-6hMYuzQgmwxkH36Eu43N7G56qN2YGlWVtrvfLPA0RIPWGZR1uXjltcCeWSOAnPnrOvPWibDWr9J5I77h
-Rox6gWSqt+nFQOmlhiPo7/ef/IBPNlzhc7//HX1tnA8A+hue5f/27Sz/u5t5WPqcpGWvVDa6hY7WL3ff
-vCng85viMjsogUlJrRdngwiAPmGoVE8CGL/oUuIut3hPWwJNeZMpKFJ3nrGp7pjityOLzflmUFU/px40
-f9NND6690mtdsuxxbgpcUY2AHYC8+gncr4duxTiMQRelNB4/zUrumS8K4v3b/a293devn794vX20fwCs
-pV5D2Ifn9PalItZBq0KfjRWUQgZbePV8a/vozfO9f+y8RRtIWlZ4odFmqrRblea51VNYoasXKaYWbHW6
-yaN1NU7RwNFjaEI2+AC97gzQ/s5/Qgn182Rd8df24Yvd53svj3gYR8/f7L5/ewAA361rwxB6oDDnZ0Oz
-Aows5nwk4jPTSSpySI5hXk1G6Q2n8m0ZeL64vkDegjPjh7kM+JHgJ3oS+mrHwAxkUAGfR/ftpPX47rCX
-V3sq2QjvQEPWBd6ZGfJrMFhe7U7S/5o5LeIpkUuLMscw/34DgPjuaftD6xJtK3A8bnWT1jnA/qvAnACt
-jx1Ve8wkkFVRfHPpZA5m1WLjnKIo2SpGRRnD8AzdKDU+/9rh20+/TXyms5Kwdyk55u1jcAPf79SWtMMb
-Wh1FuNIKKP3gvdW5fwRqDWa4FrklthkavyFQwviLN3vkDazMj/S+W/8teEJ/EnPGZw4a3MDy22i0lw2y
-/JKsY5XMSl8HI88X4+x6qm13ZuiIBq8dEUrJQPbcsBmk1UUqxF/oKpUTA05dT5loUUqy0zP+2fv/2Hv3
-xiaOLFH8f3+KxjPzkwSybJ5J7BDWgNlwl9cCebCEC22rbfcgqzVqCeMh/u6/86w6VV0tyw7JZO7C3jux
-qqtOvU+d97GBPHQIvGj+d7BwvtgungajiLIiLjme5gx/43BMeRAmQ46u0P7xnkZfEkc3shJo4I0NtQTw
-qIJwuxb7Wx48UO6zRSEGY8hnvZPffMN2BDyfKSHDIGAVFtDo74TDJ17ObL5/gxrRlW00ZHd0axP4zt+v
-LfcdM46+QPLOF+EbFRWV9fcO/zkLfBqP/WLr/ygrAbUvtdXZ3qOImgG8/WoPU045fk17uT/NDzAYaDRM
-MztpL6W2H97XcBW01Ph14FLQY0eptG8DFdPVFe5M5rtA/q67N28dK3c2iWXrL6zkpw7V7UosbqbrR430
-h2sS1uV1pJr8Z0s9njHVkyU5tX4tuHRt8/eAsFY88fDr2TMO6y87VazbmGo4hTg4U4gwRW7tbhK6vV6x
-pNaWFxvacfub5m5LEJ/ouBzODjf5qvk05nqLUlXd2V0LKT/lwx03myRtuw523xidbqQRenrIhwXyVsuN
-Weu2Dfo8w93wI+4FuMoNc/DPh9bGkm8rl5ntMd88hcQR5YkmSmzZAEULB1PkIqV2pvT5/u68XAMW8f2s
-mqz5amsjnHgnHKgVHwiFvMNWll09ssPyg3sA/DJiIEnAbZtO5kE/++b73dF86j/jL/sVeMb71fHYV5AC
-W4c40LCWK7L1iK/TOseGyRsY1Qz/21OMsBkiSF+DpAObfqH9l1m+K+/fBvuK47/UyvGSBSLJfXhOCdck
-hxLgqsZQ3IHT+9SjP9roxcdoENhGKNLHrqUGU49hgzbls2i4Bv8geVF6AOlOKAz7OdsMC14FuqjjkHFH
-HWtT7aabqVQdaf74488UjIgjTrJpQ5H50Qqra/qzbV+FTTH1vxI+tmFque+Xw9bV1m9usbnno6bYBWeb
-kLd0gzOPH/pZo4gMDIdw9ShKkf6x4+IJpVCMe0CCaEZOVBu9Mlgp0Tim+YelcOLdBr0bHMAfxkeLzqd8
-jhZtrNR7QkcZL+hgWoyKHIuk3EUAw2VoEx3IJP2szpAx6AyjMIjxYPyZ4ZN098S6VUmRNTYnUW84eVUb
-8ScPMbwAQUAj+yEBvREcyDK9Z999H6zM9HOPJP8yyGwziKoRD/ZV22Bf/fbBXvrcg+W6bSMWSEsMm8Op
-+XO8LF8MNB2B7MdcT1AQsKTppekFAg43lMRNDwdg1iUcbRAYLJzHSpbyFwxKaQpBiZ0DfagplejldTuJ
-BkdMxkRIx32bXUU+y0BBP886iE8ok2+KSJucJs4DaBLYqQ5GITbb0XmLBdSn/rCdapkZ9VaIht964Ldv
-u56a+YV83QfVFGoEgwwCLaKly4ykwprMocR0A/h9oLWv9rJnAJHeNS/OU43d7klGyCtbRyXZbpVPh9rw
-Wi97VB20t+OTZgaFeQ2C6s7y5z3mSc3JQkp9GHj+ZJWDao93Kjx4pwDdISMgjiin5GvjWZVNDk/CSXGo
-aYQyWGkjc5ajbWqJJUR7vW53Ocm4c24lcv+hFpcZgH2GXe1vQ/m3MdmQPhnEWlxtncVxetji7wHvw6MK
-KjTPuZPABHpdCSVqBIRhryx2cdNyS/+tVUMb/nUj4OaCJt/ZjpKNzffG+L0sxeHUBol1p41U8I275hlY
-ibo32xpxe+m911Ldd7oUFE46yDGmsW3ZEhOOtEZJJVs5URNShmyMnv2Pebk3en+iAOHavTtTNSFpTR06
-M3ywjtHzwV5wqGuyaf72X/01SotD2sMb08ne5P/4Mq+EMkfx1C/dXr53KCGPaQ7sgYxOfrCZA0vTe4yt
-f27FnxmPao3gQXQl8tYpn2JfOS1rvPc/9+OSVwuIuFe9Zj8/J/pp0m7n6+fnRD+JblrpzzY9iUX1/Ghg
-nleK0bwPVDOr2lmL7QwmxMQTk7u7p+KHmvB9wpTgHflsUpxfgDpdm0+G8HF8EOz3RbQMnpo6t3omXEwj
-m7AL6opbrbwCOtCgUDbEYQtuIsVYsoD+r8AcUtZOms+02K9JLtwL4mbfxQDg76r9fViWn9/Rc/pulJ8U
-U/wx5Xz5Lqk8ZuecaGJ59LKFrTqhfFMO3lFOTzhgqPxDXvKtxKu3O62Oa0zYiomoDjHqHjehaeUow1Sz
-NZ6QKbN2YGnRZiRBMG0HMjOk8GwxTxFwd7Puq2TdV87aCibpdotzDxeCgtZICCfGF6OTPh9tsmcukGqR
-GNUejjTDXZE8YHIv4owAizSPwZvj/lxvCHm19pnMhIOxllIpAHWyMbiZgH9BzWVDVGvH9+k0eLg986EK
-Ekt7JK63axCaMDce9L18gtnbDO9vrSUpWxn0RxtE6XbrCslIxF5T1qA64leOL7VL38QeD76bwAvYdQIv
-YPF5UPqi0+KsNxfeILEoE9t3MeDUZvzf1AH7c2FZkXO1LCh8+V2kRTytQmb+KaCLxFwmHKuTaNthSuHC
-1wCO3D2OGcRnTn6bt8HVgGcBja0GL7fvBtj/UYExLfBoc6aE/X2DztA6LOAsG0T0sBTvl9st1jfmzGkU
-VCbqQ8a2eQYNX3NczvYO3Vys2ecebADPi+IAu/Is6G3t6lbwxQ05LQaxlXenRf7eIX7bIQUbXqrDdhgc
-mrgFyEIYor63bWPrZbNTsfL8N6wzx2k23XoKpD4s92eBOOKslWk40y9cg9Ol1vQPPwg/PPutx4CCUl/k
-FHgQGjH7N0y8XnrGPh73MoO+WG/nO+ALzeMXPYa/8anSqV320//NL5doR+1b8IDJBftW+fdF+XKmh9gi
-IX5hWKNqQWLJshATj9ZuBHDXgnNYvaxJkVUMre8ApnTzN95qea3zQBvltBvUO4VTSTirYks1a7jP8niz
-s4Ek3u54ZPZGhX1rgjSxhnB6lPi/ap8TEVhJS7lJwkoOK6N4khVFLfYalOO9IXb3suHlzexobN6/yfR8
-J1bHAe3nADjDhuCgmMVSmL2GtK5lbM3pRHrBZi9pZgFVsDReFOSVtVn5QXafkliRCMAKgpDFHRXEu2ZA
-YrHvZqYJg2x1p71D594iHyo1RHF60nqQQC26vK6k4cwiaEKs7hK9tcRh24offrPHMKIW7eSlZdWYrSAG
-b8Or2aoGxW5iRa8/dZEzjWqGLaKKVia1mISp2CTKD7DVPLyNcvVNUQi+2KAd0b2zZG+6zjhQnIdgkte1
-+M4k9Perm9fQVQbfWviT/n4uYVBuuh+Lg2Jcu+UqWksI+EBeODz+1c3rN+jXR/iLICcdedLWTKubNzfQ
-V+ebL746v7uvzg+wCtsHAP7t/Z1nz3fuAaD77KEjC4u27tQAU5sDtNFJtjdCAgPO5ZDbZwSAcN/TF0Be
-zZhcGWSvqrnqFTDUOkfZEEHHeG1YQCE8srC+bhQZ7y+nlYc7V5e7KPzhiHq46TWqE+cMKJ/PUEM2yyVr
-/BSlsjSqZ98/W9vNcefcaFQGxoPX5YCe8wPa6xrOIi7wfjU9zqfDTa3AmC61TIOyEOLjskPy2cMd+Xmq
-AHARcM+A0qmyYYV2PDWZmJA31LL9YOLTr5qdfYUBBXeL2UzW1vSLgnARjDrEV2t6UNIuPMmfYKczUxFF
-4ZTX7giJnz6cUIJ10jYH1gSx8KqivKTH+cnSc/o2NaVbOKXjCtBhPKMn1cwnS3RjKCmwxajK+QSi3Ixj
-ycJrjAkBMZ+zVHXOIQAMJnLij2c+gnHXlM2Ps+pzfnwYBZ4b+BuDQcp0yxoWEkOqzRgQObFxVMdy5i5a
-dvkyjnfz8mV7DS6j/UA1PhidQDnfCVjYSb7LUXCC0yp7QsB0Br6ChCbFFK/A38CQSF/lV/5wNptsrq8f
-Hx8PEHO+r+FmFcAbHKz/vV6v5xPEn4PD2dFIm3Aq5yklwaW8wTnnTTCZ/+FQYL7K42lOoUz9qaIQPWgr
-WNQEi+9rvouXCtYmP0ZVDAbP0HNWTqfFATBBcHvLoh5kFCKCtlXNxXLR0gG0g2kx0RzF44Oagv/m9XuU
-j/89/5BzGJn/GAHHxEoIxPnAGB3BxYBWON4S9TTZScE7lo/5qO7Bm4HTWiXeDultjuU2oN+rspMtcYAm
-1YSYOBQTq44WVR6iiuBKJeCut/uANferj/AXHSr473Gx+76cwR97h9PqSFpu780wLujDHX9rqykfuVkp
-5wP3UCG/hW0fvZWDwSCewYDwoErvVY2d8pxg1d4CHTvHEtitaVUOpdvp3mGJhwp2VtpBi1s3+Ov94gM8
-czqbySGQBX38Ix/Cf1iPoQtyVMEgiyDcja6REFlk6+bWLUxYRGQV0Wt2YVn+vsKKvQqxwvssn3Kc3fnB
-wUn2f17AO7w7hXNkdNSdmdpMHgGzXq4Ny4NyxkBCTACL8hS3BEirDl1+OFf0CrGRDx7p8qjmS0YVGcg3
-fdYlssZXkA1ioR8Z+vrPg1fwFBcjfEWgAbciAOvfDL7eyLr7VdXDOIb1rFq/Nrg2uHrTtb26Mbi6scLs
-1DynRIn5h/IAcdFgrph0SyrwaG9n6907m49fPNwZdH8ZXvllAP/T6/0KZfD/HvDp+/U/Aa89y6f5ENDX
-rw/3imOUMI96cQsaJfx3cEUGNPh10IvqbE8mo+KnYve/yhk1hyL+eoe/v5zCGzye/bKuzQaXpx82DZB1
-IF+LvS5Mr6czqWqaxuN8D2mInwHOT3xu4a9HeG7DRnhQ+Dhis192u+Uz/PFr+ex1Xr0Zxj3QiXU101Xk
-VmCtbf5zvYyq8IHHGg/ubj+B+R1f2WpU4nuAlR7TX2EFPgvhSwZXHjhAQFej6gDOONv8Qj/4Pu0WQK/I
-3/KGoAUGoks4oQwN8UTnJ7q02cdbNzqDLLs7n2WPX9x/wgcVhVeAorhH95YTvgFyji06PDRMJFOUhCEB
-GiLbMkfQ+GTifYJXoEAFpBnS3+f1jAdFg6GxMDQyqsKgseUeHmFEt/ByEXBo36Ho2tmtG2u75QzWAUZ+
-/Zr8jeidPzAkOQ9MwdTAkcCcn/4E07UcNOMu9Ii6tE6jiE8N4iC6NS5MUYl7RUWvr77J7jAn9gDpia6W
-oh0O/30zWeMm1QByyqn0YPgJzK0UEfYJ3K4+dfZvZy79OJCT8yiTlTzDSz0D47BLp2oVkedqVnpKyaUI
-mvEFBSLjfUHB8p063QmOtArjFnej8RIn73DWeJIIfTOQYMmkkJb1SnYDFg5aijxV30q3I9eS630tXG9+
-V12b68k216M2/Aa7RjeSjW6EjWjvuGGcH3zI6aCBg2OGrzgAbkV5jnyXdPWA7n0i9FJCjzHl6WER4Z11
-6nwfHrUO22PVJUqCOwbvdjIkwWC4SuN07hEp0ZHwYSJygr7dmyGPmsqZ7NPBbXl30yialoypFV0yPLVn
-Xxu3dpFszQPzNVaiWnzozZlwG+1b+30UOKd6y6vaCo2rmgZkNu0+kdL02ugNAfTzcB8TsIdkAu9JgYEH
-ixmRVbSx0yCtuzyXwKTmmO+r3syAqkChzdtbb6/ifwdf+crPcLHquJvccTJAYOSI33n7OdA8mtNC/2hN
-6wERaVPzuKkuvog4SsCz/C2jZJQUun4qse4JW5M9SP73ykgow+FY85AP5BNCVIY80Jk8/K8Hb9/I0x+j
-Wr6aNW7cBzJ4NEcECmA/BkDYj4Bg73beYgCAQaeHR8YbXcRnhoHt2xBffJflaUC0Dxt9TcJQM8Grpdff
-bDUOGAM07V0Tb32/ImESU2wsGS+umFCDwsgIV/3DNq4+J9GBPdn5OBnBXeWVvez/J8v+w1lcwuL8inz5
-j+HR6Jb7fAop7RxUYBhIWpZW+1AWcSJQS4QDh0O4VmWjbthu1MeFPMmNQSfeMzMPNwN0MGA9EUArx4lW
-fVH6aExFilTZqJVR/tQZTMhM9J6thm9fMPPG17OXInywvmtZnZ+EYLGCEthXS7U8L5CUYi8H/PYeUw8i
-rVPk77M6n+8VQD8d81z4oo6LYsjV9UV48f5kUoT8i+sCeVfoBh4abofG5GhVwQAJDInMjvElQgBUDZqu
-rXF8C7aK/8utjavf3PzKHp5bN4JFvHUjXrV28Q0K7IVXbKxZ6iIIK/J5j7+8DmYSUnLW7ku1JcdOfNHn
-HTk9ZjYUH7FeZ4yaKi05ZmHRPuug+bk1o+aCs4bNtVLjfgA9PJvXh/Lzp4ePHmV3d7LnO4+f/ggI9sed
-56+yF0+fPhmQZW7yMDJwN0QmnaxPGxUsda51NkuucJ4x6aRo4fOuNRM51iuRCs5aaxUqnTGDuaCx6Xw8
-RlJD+KtBavSE1d8hWu/Y5igWeUEnzbalY8IF9pxwyZkHhaude/RKlySHz4tPk8h80icRGiP7E1NiAJgl
-rRIj6HKW/kdyxOOyLujJW6eHzVzw2qIl+HXm5a5T6GjhvEk20voQL9gyNJVGD48PzJQbODh0IoNsFiL8
-fdbwqdJ5JwDAc3h4SHxDsoZn1TCbiCDzHBOzMKO5iVk0baJ09PSFeQNJjmRfQSo4k3qgWn66LPcxYLjg
-DDBdFWMxyJzCsjhZFP7NYAxGYikUMIOmL1fmugN6HpDZh7I4RgkMacV4KaQuMpD1ovmpdFe7lTGZTqXk
-rIVy4maFhNMMljs/EwbWEWo8pQNP4XLNbX9944sa+XdXI+9BLQzImYz1uOrVJ6uqLTg8mQC9+ixHzSWK
-itbXuoPe+sGW0zvfQ4hoGIiYmmuTUoClGH2SMwqv7bVe37mBdDsmyMgeBiPpSE7tb7PVKEzJqptUPs2P
-sk/cxal0tWJwj36ieflnWfvkr3yUnQcXCV2U0Q3n3TfJDt720X0V1WXFNLoMrnwwqyiJ4D1YFqFRMK1J
-4kLokNwluLrMJTAh9DlH0peL8dsvxnivGKVj0gZ2Ft+TOrcOMPQYs5dKlgSlUGC8KZi0/M/IbUA4QBEy
-qsMQQcOyVGs1u/CSdBhZ4tom27oU58mvgL3lM9PW9xZXOqr+eUaN6iwI9RkV9mCVpi/Lo6Kaz1KPSWoa
-f7YMY9evfXnUfv+7O4LnJfmiycLqg8Fcwylam6SKyYU6WT//GLwuUp66ff6xwUF1j0rNgdtHKF6/rokN
-oUL0ikAJU1C24ne+ta+Yf9SKUiJprpMPDQ7HvTLXv5zKP+BUArtAlplorHrhjGkhmGZ6tPD76iZsEO7w
-jS87/PvvMJnQLhs2/d6LF29/3H7+QgIpxJGwc4rhKCTwZtb5y1dD/D+K+RbX9ZX2ruH/JStJaG2odWPy
-MVkDjUzmNda41axB1szw6erN9Lc1MjqjGl81a5As249zerCbd6/dhHPl/2dj8HWvQ8EaIiytZP0YNiEi
-4NnmGj94TKrrOjjM66fHY5fPjGpFKFPrvsaPb5wa0ifnksRcEsMdw/F0V/E/WB9/rfY2sx/GcINI5iQp
-u8g6mrNQo3Kyw7Q+wxiYbdc/k8QM1XYI+kti0j/g+n5svas17Ovhc+YFn6OJANkkrSPfy+Q1hhVhz/QV
-G4DZxWHsugCN/qBSq9fuw5uYe4w+u9MZf+DXnH84hrUx4j5GkiLesxX+qbt6YYiZkmzsSHsFSOS9WtmN
-SmBt0TxpigbhNZqZQykcarkGeEfIrLSunM3Uie6ZuyMkGshnHB9iwD3Dn6jWRVEBHfJ8Wv6T7tIK5XIb
-Ik1U7qNlknBWUae6ww/HAvvtfAKDLvIj0fTZqTl7qhJlEmidU0i6eODC2E4KYZFtqTO8kmWW3HAotyQb
-VxgucIOaIRHDDbtYVG7CK6T4QwG2LKscUgwSisPde28MxvBqQxVcCTyVqAM/lkBcFkfyjvzKzmn+NNCO
-6QIWbH8L68W1YNneFyeceJxWLC0qf8ijU9DAp/bZjJvjC2E4kL1qPJQgYTixVlAomp/C1hUq//UDfY95
-QPy0xTaTMJLO8bUcu8Fg8CbLADmWFEcEzYeJuVZYtbv1Ygya18xl/0cg2pExsYsMQaon+AjWBXaHi+4O
-1RPh0+3L89HfaLFXIUTgyran05xCBplEa2aIGCWMl9TF443awq2WvJy4Nra3AaA2WEgj9ImQi0UirlmE
-STJ13rR2Fc0h0H997s1BjaieGWqXDNHnN2t0ys2P8knXIsPe4O9VOe52sk6bFOyje/i+pHf+/R++IawQ
-rHFxr5qmBcGyvg/HH6r34jRyAMht7P1G8300qc7hDhV7gJlhuYz3QTmCS1oglqjJQJbQ6Sif1Ihoy4Nx
-Rfexnu/WGL5pzAlQHRJ/4AXGfbxNaL56nCOWNq6ZOTploNJERuK0S5iMrEZJjUiEyYqW0MyQbfz2K3xs
-MBKHk0YX8AwMYB8o0s8jwC/FGK5bB+7hfNLpu+ViN0MewDPuHUnZjZ5YyuC9kGNVyNVAVK639pDMxvAI
-zjJ8PqqhuF9otDZOPgpzZMFWliOsCeArcmuFveD3zzmuSBgXHtzwBzsuCtByxqi3zjX5EH48Y1JjkZl9
-30xAx07by9XS4Aa0Kl0HTjkBXbtTWkUKRVT4BYWOFFxKaHOco1lNdgiUPbDIB1idispxcEJt08unHKnw
-IzasJnIttQgAlHQjwmGEsqQ7fswwJZFePuDRo/5UaQ5HyvhatEIl2jUybQHIAp1EPcUgARFMkwbNtpKl
-x2JlqYtGY+stPR7bKDGiBUIyd0gpWRDtT1/Xux8tYL8xCX79omW+HRf8+qspwaersRa3m0UYCzYQP4u1
-tZNGZ1ljEj76gcsZ/nZUoNLMPZ2SM7yPJbU+t12sBUjk7XuKLrixxX99S635B2UYl5cem77GwjcWMpcE
-nt9uXP56UXkUAMCoxyTnoO8Lvw3yyWR00nW7gv2rHz7/RxsO3r598RhQWy5Q3W+uJovX2KGutucD4MmL
-aAJ2rF1PRYU715VeGIrNcuigpWkQ/ewoka++UCK/OyUSOJsnldDeSiN/X+zY6kiQBirc5uGQD1BRTkMb
-x5vv7RWTGftHDst6L58Oka2YzGf1FrKF+HaPq4ysM4r9fZhULaxrSQ/IZFoCp1wCrwij35+PsnJYVkfK
-5RI+wFCU03LIsRC1X3ggJ0Aaz2omBYjdZQ9XNR8VwiAn92r2L0BfOrgtSE1ghLI1Sh9PHULjHM7iXpVH
-7Euwzl2KkrLSmpqZguZGi73VXp0yOafadJVGWJwkOtUSrYYWNXwyp0ApzYaUFHZRj7hnTTxigmZvoeVK
-a/ttwbcBjPgg0nlL2r8U4ZoKpvmS6/T3xzTAj/4It62aAsm5X34shk9YuryU6H7nY7E3xwHvjD+U02os
-J8Ak3kzV8FlCxcgjbKKlmt8TaEuUccSQXbEDd1QcVZgoWgSQHNuKJoVH7HXnJ9Lfo6PGUY3/+7j6J/7n
-aeeNraxiTU6XcrDzcdLt/N8uRasXYMK1/9pB/69Oz+UhLeqZZkZKTRvNC36oMVIIxpBUT7hEkpvegA/Y
-ZlOOyvosHIYR9juqqmQqqQQSyY2VCSso9KSSWZih5PHS6q/LNyLU9w5jQVU8WzrPhpDF1jQuUStR+rnT
-Vs2GC59LfVVo2LpX174YWSh10gxEWZ/uNECQZkLiBHjSXOycSQSIoxE6nqSzWjd6I5I3pKv9eJnXWFKi
-qTGVq6HSLz2frGRJh6HiazEpZ5zzXLYHGwzQimp71t3ohVZUsl8skepe7cUbR+d5gJvWjQCbwGPuMvHb
-BLciOevsCD2vyP3DM+Yk+Z2PdfNJOYQiw2AngCx4OAMytg5a/Q3vYbBO6hIVrxWvrj19FMr4CG9JeC/i
-SUamACHgNMmbnLsm9E5d7dVNDhYkW7+6eX0Df7tVhYJv8EFbKiCQkWfC0uwV9ZdX7je/cm4nkk+ZLC76
-Z/iL0KNo6cDWT2cSG42lUicwGzyzB4i54PscxdhMkIpHJQGTqENZDSTweLYvq8nK0KxLV+hv5Gnl0E6P
-qGzHuJJ6pMpkIjAwp0hFd1xgF49RdwL47D0b2h2rb/xxwdJLVsayx6wTv/nHFIZNoYM0iwguB2AVWmzo
-dDiXkI0SyTxYQo3CQ6oaGCScEIyrQhlKfAieYbm/T/ElDDzGrY133QiNdP2dMqUvqwYcNoymn+31s2E/
-Qzc8r0KUizIoxh8GT57e33m78+RHzlrku+5Yd1rZiNaMu5He249Vri2yRFmBH91C6s51bK4udea95KZj
-MT0B2FpyUNzbbTusx6T+gz0rPiKfRrKqvb35dFoMt0hPpqGqjrTisPgAu+WJNcLWHJoG2S/Y1WhSeCT9
-fT0sRhNk447zKToq1INOOvIgxfZgUc7reNfebIWVNJfjxlb7RB+69f+xrEaaShfGzkvmtL3rf6vXD/oJ
-jjfkeuvX2u+VK244p70wiyMNY0AZFuqX1bNqQtFb2S9BTFT3UOvHt9GTozUGTuTMDCv+MLmtbvEAcO0j
-S01H8b6VM97pkQ3lqv7GuHVfweNy44ubwB/wjtSkDnihOPv34owA4Pd5/aCgeFVbK4gcFrMUfM6DZjbe
-RiTQtvE6wk9oLKTt/7//b4VDoLDkRb3v2Tkak53BS+NTVwBpN5Fd15QYA27/H3VRaDivYXU0QN3YAJ+r
-4wOK5/UXKFyD/x+OZA1Gss8jQZHnmcPtdpCZAyRP8TqnYu+p5534WHKVG7MFQ/D06iEg1AnfCl1riyv1
-TDx5+nJnky8APYP41FFgp71qKqbq++T8PV47QJ0RPInUI/aHQcXq7B3n+XpH0cHekQz3XT97N6ryIf6X
-UMU7tph4VxcjgPlOO78LfZBJCIZCQR9xwMP/nEZWEI6fon6ReH0x3wdCNuMsIkj5AioeHAyy1T24l+9X
-B4GmRnLsYQBhSvwQ+r9p4eQQTfXtKoYcmYPyUpzpsXFz6bmR5i2jH4oq3PSy64ONwQYwGFn3HjAgMPO7
-83IET+Ov2eOHL0NuLb6h3WgN+joBTzxcOoNbR52HTBouzqVuJ9YKYtASd0Jj0zoTiEEYRTeiDI0fxviM
-RYNUtUpZu3lAXT+VoD8XFuiSqR5QGXxhLD5ISR348ZPKGGN4ewbHCMi/wncMN0zCnWn9cIhi4CFAXrt2
-zO12dJc6QaATO25c4RCLQUF8jAkWZbftGG9BETuzlQ7S1xi0D+OcAMeo5y1TUucdNX+nB3KcPdz55sog
-MaNlEA8dhnrAI4IlguPaaRiDGLBprjM+uWcwnPjkL+UU9eXJ/41P/hMKfXW2F8XlU7UOe+kNxVRk1YIc
-NTSHRAsxFmbknY/oZ8yRSqDDiM4wOI+iwHPDQOJ+6ZKU4jVS86snmvXE38fsjut2zHH7tZ7z7pHvt43F
-FmU3tl8HONaXGAWEqrHFQUs1RoBYjV+rTq/FCIpnp0qBG18ch37/M48C7ns+HKo5+GJHuoYIdpEs5V51
-tAvsa80RLCcja+PI+11zotFqzEaJQh/+vcZYtRj8dB0HITFZ15BnW/uA/53mJxGxMxgMnPTXxHBd7PJq
-ZuitBrP1y300q4SahjpoGBX6+pjGVuWLmoKOoOoDDuN+RAL4hLGDioV9ne8wnWxkKVGWzHaWKNV3VfG3
-tX1wHQemD2XpmFuNyk+VgvQ1ZjJmJe6YD1eA0+4AIuiQwiOYoxfYRpJ+1zp9p83yu4v9xffq97/Y74uT
-xyWyF+e91ksr4twGwTsyne/NWFAGLwGGoy4pjgqcdDJzpojTEmKwnLIhdcLYcTOw67v39NFTchpxU+l+
-2oWGm6TOwRhxQ/7zVA35SDV4Qo7y6GtC7TFXSmG+lzV9/zEfUQDVS5e42mtp98ZaMGLyBownUoj9JL7b
-aKHOMbaRmdy3huEStIJYQTKnxsVwQlpsHK0Dro23ZXyIxhabWfYJiq9uItCrfaxyjf6+dsq1ns5nVE1q
-4f9qLfzf0whl8jNM5EqAKqWcToAkUeM1tiJav/BQ3au/pmSIxDkJpS1hOCfQhdqWunjq6JJLbB1dspU0
-QQUi2ncDGLm3mTkLA9JB7Rbegl+EkIQy0UCspA9W2nsJfsduUFDTKMDQiqscz4OwfDCj12JOJpMxCA7+
-02LL4AauBHysBrrxxQfxD8F0T/cDMn2bnWng+cJgFGLXmWdONA4tBpjzr8Ms5C6KGLL6BFj8I650QleT
-1R+zQxT6jqoaDUBnFCKVY/+h8e3JOD8SCyfEongmlebJa8ELpN45LI7qYvQBtaPP8PEux8YvhRBljrZN
-BzwfdFGBNSunJBnS+Kt8HlfEpNv4e2C3tCH5TIHAmlXT4SAjHrMunAk5jlrj7iIk9q3GC5UTmI57zjsm
-Fo+/gJj7jEYX+CMdEakmsfhHJaWzoNEgSNaYdT7mV691Brxb3U+uG0Xh8RS5Pp+EnL1wBtnzoq5GH8R3
-xk4JF79Cy7CcVHjT2XxCxpYc/hzhTQvWkuBiTBEMMdp1a/wIGmeEDGHgQMTCCjy16FAwRoCUwlqKmrT0
-QggKUVETOYmdRQt2errvaK4v7pS/PyYCyueg+L4YTTCrAldRJaIGUqk3s+AN/E0kWPxwm3qu2JJqj/OP
-5RGQJN5RZVR8KEY1xwjOMW4LrN1PeBE5o9xeOcX8f+hTghQe5l1g6cYeEn2OeHi8/fPbxzvPMR/izrOX
-38NIrt/yvf5UZMekQzuuprBfrEQrhgdFxjGTRuX7gjSALwibdTsfOxRgEIvusvSErSGp6wdBJo/7sJvO
-Iw+xFeb6Z5e7fFT3Rc8+RaUGZ/GeDWI5DivLAfWvM4pj90AVJ7YIcyRsLBN8yoaxIEd7H7jlKeuXUhbo
-v11ptwpNMEWAwlptFcD8+mtWWaIKZ86FlEjOoQE2j/PnUOP+Rnu0GRdwuDM3pk3zdxQirxrDQYG7g32u
-+0RvQgQTq09GnvpkUDNu6xQPTBLePsW18/QevxRjVPSSVU9GxqxkL6yuKD0BpDty6akSshIiz7V+jIsA
-oG3oufibt1u1OuNQLY+LrIVkR3YHSF+Msi8m1eHqPNyn1cdXme43nkHeD0rbNCsOTrLLSNdeJksMeJ1H
-JSbpo2Desz6+9WXNoPBeAF3BmpjRiAIikrcJ1W449RYfimlgcpWPT8hrhaGhccc7eIEkt/3suHoHI8Bx
-vqOBvqulc7YPIK8WYWh4+PBejjgFTmJD8SIBm8Q+lOiCS03wLAwaFTE6eFQRdVw2mCgwqrJFUA32qQ4i
-iza+dinXC8B1z61jRSKGY1xQLOCwFBsCG/JyWorHN40pl+2TQFPZAbw6f2Os87d60OlnrtPEMYjW5amT
-EJ+1MHHNtpXheq1L4z831sYih0GiBS3S1nJVz5493JJFo188eHs9/ZZeMpiTKjQZSyxO7KhSsfGm4m5i
-k4tM5SFs5eLpBDXSU2rOCTOq8juguMeL8JefMSHji0xbtOhEeiv+Os7Z2Iv9YHNPHKh3vXW1p5aH+ZDB
-zSrkOoqcg/0Xk9lhComo2+IjJEqwJpBbcw4lB9P4gBIbcnw9yHEeGPwJqZnU7SAIyc2gL10ie5rbQMXZ
-t42nMeso5TQsioksB02DzLEKdNun1GmY0Q3lFbMZvoAlu1xydTK/StBTwgnOx95E4Rj2tXPWrhBVKs9H
-/MCUNa9WapFVfs4YOGwYLSVVeSHf4tUMPnYVQnNN3aAaj6of7jjENBZ0ibksOrS2KAxSG0lMCkQTcEDI
-FdZtjs/3BuW09KVIKcVt09VE51iKUc+cpT7eiIv5BUhvxQtOXKF5H2HXgfktMQlfta/oZnSAKR8PjyTg
-fTHeozzQU4XPBILcENxTlXdRe7zGB6QylJdiLXu3N6p24Yq822TxZLE/09cb95z8youhr16i3dvdEzJ/
-kzYwT3zZKQjIIaZromD7ctE+IL3h1gaHmXOscrQWgPoZARwAWQZo4KSYGduOnjs60e5tWvEtnY17PAnO
-nkExq7OHZqBcjiveW0kztvawJCVvaJHtel3dvHEdGeClwip8CSv62ZlijhD6HOMc1LMwVOVKW8zdBW18
-DM9p8nMcxDMNJIjieVaV6kwYdbJG6uwumNmfLXDnjS/uv3/A7VCWlBJUJ21PEsKhlgQ/Rv6TquFsTmP7
-o0jEFH1lCdI6Jo6R3K0a9oCTbT57+PPOo7cvXu48Q/31BoulHj18svP2+52H//k95ha/IaXPtv/TlH69
-sWFkU5j+OyPDqqyLTNa1NTicB7jtGEhvkg97zrSr4hWGC46Glu8pqdRM/JAxP8+opFzNKPyd17zVw2qP
-ThSGwUDo3cNqgm7KIyBZhsUsL0ekr6vmB4fkDTKujzmfLTmB0G1VcfEKs/kofvYZbnJm2ohdNjNB4g6d
-5YawebRyQNtJQC455CvC6XNHu9APGrHtAx0/BqLycnY4P6ipT7o/rGSshgwF4zCjWOsGWQ+xgRuentpl
-ThyR3SK08KpVMafLon9ra5IgpHv96hVgJR486F79Cv94uNP95ooEJO/Q3BoAXFuqfQtbPeX8sS8on4k0
-flz9k3b5WfmxkGT8HWoMfV0f3CRTvku9rAvYY2MN/ud6Dz+y/f0u5a+4JJA0XbwDosMASBuDbwYwcPEU
-v7axcV2m/qJizxmkFKs7nHVaxB247H6Fwjs5ACKMLgRaO/kALQ/HvGO86j5+A2sZdH9R24J0Uj4mZqyY
-wlnwoUYEYw2L0SzHQ/zStbO5ryM9kTjRIWnlRlxPyvHPvAJGeoalGJ4IBWnzmrU8/6yqI1jW7CMmrhgX
-vvkrbr4K307stwlu1s8x6GHJgsisi45LWKVuQKXiVwmoJBSm62EiqgleJHLUuG+y3xWlRIYjjRXnThHH
-V2xGeb857iZcNkQKE/RnATi5lXSTjRKsAI826+KfUK/uDTC0Go0DbwygKdJl1UBNk4JrPKNcdeLjhJut
-KT9YGKRm8eS8BJvNbJ9JX2qHNzNgaGdw219gDpAhpy/OuoQMFOfBqhZH5YyD7LFtOYJDLdcRyQQxbtyU
-jFVrPh74otPrxSmrT1gGTmlGGBIsKEZs//v8aMJNGAONC2RL6bAgI7+3V4zEjiN4oel0kt8XJuzDe4Nn
-3J0M4a7wPNNxgyXwp3SNTyTeuemJMMe+JTJi+/nU7CwKHOrJfEx7pSvCoLJsOM0PgEnq6bVhS/NiquEn
-9LC7R4OzkBuloUJirx1K/E2y1sP5+P2J7higT1gmeXCe3ZO87e9Vk6gGHR4WPNUjBIeLfgRkBzwn5fgk
-hPM43yNFajbMZ/mmWdgZJ4d24JQrdbphgo2wKBmNuwHZ1YFZZTrhuCC6uHphgOwoUUEic6N9Iqc9ajAf
-c35GYLY7FPGfhoDoi5Zlmv/zxJ1ePKm7xey4gMfFXwCUMcPRxND+GEqyw+6GHB0qU4xQX7JDreqSEgiw
-0kWS7wJwHiIO/P7Tn56sP0eSoQ/v7QHnG/jh2fqjnQcvZfMVvkRTpLiTGIqLvCrDHvBIINSHTww0LXz6
-w0sXFZI2RbJpsnMdOcHo60l3ceCQ2QmhJn6RCRn3Bal2Hcbp3Ykuws+sL3J0Dinb8VjrMdEjL2osmF4J
-DP8V3XVRlOM/MSCg15mJIebzSbqEcNf8eobu8YgmhZobRON7lVGqPkFk7GOKyLxUuwnS4Zd7Wf6xrMXC
-wpM+FtpDmFZFHqcI93/WaUH+R6k245wjuB+pmevhUuMSuGM0Bc5nd0Ryrt0T75dEORzHf/dpvBAdwit1
-kl2/T1p1XAKsOBjgUVVwOLv34+rY0XahBxW64yoO25GMqTjbcHgSpU+xBlxTGF8XWKpxD8dIuU7x2opm
-hpD4B0CwB0IveixJKUuvaLa2rEufe0zg4P/c0Isk9zfr0kDu04+1q9c2egEcpsAacJ6sby+Cg4BCOJIR
-sTmejcFVtpFLwoF+BM5P5fjr9nld3dhYcl4Cp3U817OsfTwKB0E9DW9Z+w54BoR7uLZw5bK1Wy0rF8O5
-uhCOrJyMlMjf9Wo0NI54cIzpLhJxYPgeND1iVCS8qrPMlkuUj+oqOyproqS4bx8Td0WMH6PzLd2ow5+u
-lhhzHx8fD46vk4/fy+frcH/XSNC+dn2NPWbW/8LEyxoBdYSM9rWbAx4Rl56cohBLOFxEkPgu9XmY6CeW
-dRmf9pmk6wsV19fJUcWfpcErjmeFf/6PUloOX6gwmdZWaVGK+Aykes7PFhL1xzDfKO8rXWPeDZ4MvURI
-EQTA0IkaCBICVGAeJUPveOoc2gpi4ZzzIt2Px+kPaMu/X+VVMqdIi5jFNa3XGv+unFkUdC545cPNdTrm
-0JMAhhvmxsP/Nloawk3+avmGch0/XP3qnD2ahufrUf493PlmcUMnSLON9dZ/uBEM12tATK9XWxraXpMN
-hbU13g4h79rN1i8z73h5nQ5CzxSo/Vr9M7rk98X6rH5lf62vB+QMlE6C2pNXHIgI6jGj2Be2j5Ql69kj
-ILH2TlbYAK7Dp5CM+mQ0rBGgPu1BtdlfOv44t7Rcaxz79Qx2qAXIq2WhvFoI5ucEmJ9TYH62YHhViNLz
-FBls24MHjFJCwYb2igSW7875SQ6I8EK9Gv/8/unzh//z9MnL7Udvt39++CIYVv1qy0/VD4Z2EypcNlI8
-/Ej7CnXDcr+P6XWc2H3EKsHaDVuWjcZgWv0cuIx24fOvvwLonp+3ewuseWX0CeONXw2y6Dt2B2WTzO3o
-KYYFuG1Fllv+eKc+RCEwLGyUcCZgG8FnCLvx4dSckwfA4q9RjFKYIL0X8KCLE4KLLD+UhYKO0Cyg/jnY
-dyj9FgiNO4Czss3sqt0QGAA1eBVcAyhtNlhx5lGfGB9sAnTGAYQX4Ocr/slYYBP6Nb/hO4CNgk+y1A3f
-1d1yLKQ2E9EYXwr5IeKRrggvooiNRRLEOuxj1lQ1r5bXOLxAJAUQhNpHsgjumQtaIyRGZvCGikMC2gfT
-Vr2ncN1enqarL+M+Uj0wG/0tECBGkRfXL7M+nrHxonTPPlX4nab8c7PpDO+8pu+42WwG8tsWq+Fw7Kpe
-TQ1pdfMaaVrjrlc3b2yg+mipmI5fFK6fXaWU1IuGiRwTKqUgLmeoEAo+qd3xYhWtab2golNIoaMVRjDm
-t+kCeSaTc14+0WRalZzKNMn+T+lJL1oSeMJM8grRFpgsGvPpVBYADXsH4+qYs6ZqyG3AT5Sd4nE+Oxwc
-5R+7G/3s6i3gI7uu6ZpbRg5vZBbV1bnigW15hCNqbB+TupuIMq2hsf34xC7Gg+wxToGX66dqitF5KXEt
-hvxH6djunIRPQv/fEoEsWZuW03qmy0qCSzJm5VDzsPDJBe8Gx7LXlkvuz61mX41u1+rm9a8Qpy44SoBe
-byF6XSrC4BdU+ptQaX1IeZl2UMqfxJyyvs/YI1QcXVFAsHuSUa4o0QZNSbFMbkuV1eghAuJLKMk6KIYM
-24vlY3JDo8jbIk/guITiySDDADTGWgiRx9Oy7P59m2DDH3fRbcrEcyLYodcqRs+mseXTIoLqViZ2gQjZ
-TrtQ6Na53aeuvW89jQhZFV/sHR6m8yKg+X0Iie2mE4QCIlt8byaLcFOV77rKZ0brwSXYjpIQYXc94+ha
-321WuOsD8xAI8fqn0VATKVg4AAxrQ5p/oFi3OzXvh1cWUhSouwMZyO73gQOZH5JPWxS6mA2Axh7qWNNx
-hO3QgyDC5LsW9cgTfV2+6emevNaSNzRv7MoXNeIHu7kbhic4DalQAvaQqV/bzaVi4X2hMj87anRKNuBF
-npGOrRr//GplSQOmuyzbFJ4BszCFRGPzu6MV0+GKg9bJKg7Ay+fbT148ePr8cVv8327HZa5Tavfu9r3/
-erB9b+ftjw9fPLz78NHDl6/aWyOZhNlFfyxrkRe7vtOrhgE5YooLb11zFfBa33vx4qWOr+72bPyvee7N
-I4XUuBP+HozzD+VBDjs7mCtPh4zhD0/+68nTn550thysshZK7Xa2zn/9sr7O0aXnObsgrLNo1ZZze0Bm
-wJ/nk0mRT0WmL8Aw2ig8Ouiee8hKC1mFrJoO0eKKW8Ph/c9nP6w5MwS4SkBfklslV+hipEGkKFEBUW+u
-r8PftZh8khqiPqyO30LhYO+gvFMOb9+6+vW1G72BtscIwNfuu/1gRZ/S+ZmPVsajhsm27sX1+6ndSKTk
-MKJXQgR9KGD7lMvrH+2PExu1haq+dmcWQxR03Cm6PqRw9R/RwH3ysY9/n8jfG73OVgQkcYgJ3GE5HBZj
-V/00KeT6I6bTNpleY2wr0fg+y+io4oCs6m/rMFzP/HFGsWFPgm+nEuu1100yIekr/2fjQprHe3XzKnIi
-SRwHbMrX+PguFZWuPcU3mu1zNMj1BwgaWMv8JZpJPodnj3CmGve31oJRonX/zfPECnuuyVtRu8iJ2gQF
-7OWUnnQXI9kTmiQfiGOObrBb7FfCsFLmvWoueY8ZfyGZTgalarUzTMYWcEf0nulY8tE0VsrWcRTPUtGT
-lvUo91nUG57lPixmsn46qq0Ppim9fl/WRLqIG7JzoHlpgrB6Kz34RSZQQooccmPrx/SkmhViMuXSDpRI
-leVDimnkfZiYlN3MrsaeO5iUMTCBQQk7Wmhg7yfJvhEeVjNeUEGeMBPMXOffzzr3WFqPWyHLMKC+NKgI
-Rf3raFIyeJXuV+LXSwIRTSKsbYWtOCYxHjdAZzMMA47GTjDukSRIzDjsrJj/oqkANYgArWG4Me5biIND
-M8quxrVMiokFVJyKPx3B8urVjf5q4qStbl67RnhkqTA5iSP9lkyQ7gEa5cC1VriuvvJ9H6GqmsJ+8dPu
-PlufelsRa/rg8Ci6lwDlsqmUciqXFMyU19L7Z27B43TqhkgBWDVkW0jn2Qx6iJ2fOdve7gxzy884Z0ZN
-VZO5X+BjxLNJTkzOx0xhIagWsGFbpngggbp2KYlNuhxllsSn2e971Xi/PJhrS+LWWL9H8gQOkOuq92zT
-42k5C5oJ0xrM/cTN3LQERtL+5gVuPvpm/2jhZhUCrfsS8+yZLqXkEZDPvebiG0CepbYgezznAO4iKOEQ
-tlwyfl8DpgT/j2mI9cvZ3+tDePWytZ82vr6hcudn+ezwh1k5it5QV0yYxJyoYvIC6O+9w+4UkA0OHypy
-ROh/zIupED6ARPjusEPpLvKUgn9mFVoTD8WpAQoQ5OhkbYxOCkM2jlSJxN5hORo+x35odPjHwJRpBERT
-ZPkWAtU3QJiyCo98P+NUkgaGP/pwGShV5JUrpYmk42qGzfAurEidcFSDsr4vKT0xFrQth4fnAbLOPR+g
-J1g8SinCXAzngR3YLmiGdNPcrnjoza2xJDN0ss0r6jh02SY2Tx1eyraHQ344uBolCSk6U1J7FAMHiXds
-yks3H5M9KR+OnucVNHsO1k0FfuQz86Ti1eEe1VJ2uIWm3WJrzMvIR2FFU/VSoW4Jf7OFek6CihjQlryX
-8Wi50z6Q8F7P6EvQYoDL6Re1FwfqRoz+GAfc9evOHcj697PXcmcs2DeSPpwearEKXsW3gEQqq7L4tBJ3
-ZMJjOTThjINSnXJYdak5B00+46QDuGbWj9CuW5zbNyU/lz92uslnDnuaGq4uA7e+6NhlsHESMgpSJDew
-IWlxv8/oYRqgrYj0YDaOQShBR2mnFRy+w/Lnlv0qa8V/mC/UKXyg/5ryaYBjayc/tnRG9zHjU46T+ZoH
-DG/oZtZBhE5fO32hOpkuRsp4m3dWbCBxtSjGwRq/BnmIgzgKBBV1aoWgiElDNPiKOHej7eDLw53Arpcz
-D2EPAyrUfZOzTeqpMWs+VJRGZHnGigxDmbs58kmr+ZT1gpQ1Zlv8MRW6/L9xyekcOLSITXRHGse6pb6i
-fD6F8iH9pE3D10xaio4DUEH4vCXekqm+a82nxHXdROyE0N/0bIrgx/y1TZihn4ktdwuxunkTVZI3l4ox
-lyDjkSp6SX6LIWGjxTaG2XZ2VH6EM4gLSeLDMTHyHD+hGmLsRjxaPzx/NAiN9sPgso9PHpVjZBieI1sy
-sJfnk1pjUkf1ZvY6I0Q4HTwRoQCcsTd9rQWc1nAETUtAAt6u7bK8pmyVhvIC+K+QFl2NV+sutRcjvKy6
-nZy66/SJA4GD3WHk0EFduxbR3kKJB3Xa915DqHXoBuOQ7e1aS9Nvc+B6aeC3P9EwzFROv6P/ZEfFpW/X
-8+98M9Mh/8FDYArVLJDw+5Izm7ZxU5E6ream33ZGalMgsp7z7g9XGgE3VIeJkT92KXwjbTIjFt6+IWvJ
-IuzEzflGcAPUos/IYQkREl9rZ/8uWAV9vPB8G7SiRd1ZFb0NsT4T11ImrlNb0DgRXcRNViN88vHGeCf5
-fsE5GUlSxZb+2eG02CdFLjcf4dEWb2RG2mRc6hdmzNYxZq7fA4Rorlh04bm2NE7M9aU7+7WOGbcJUwdx
-tFZ5cFywcxTPTea1D22WE32AjaqxQBBBBnJdlJBBZmrvmZltcP1aZ5ya6hktP9d0OemZm/DMyM6wcSix
-iictGdMwcaaZsyk935QXN1xuxk5+ymhaBnpQ3Q1FbFwQ5zJPDUprLhJZeeTkHjBFQPCAYWK1m0vFiPkM
-olX0mzsIrOxYWbHGH7TaP4IH8R+1UyPSmt+D168cFfQkFyjnWd/svs7X/rm99j9v//pG/tpY+wZ+XO79
-+vryoNv75fUvb3755cqvn07/71/frB9seWAPx9j/ErDuvLn8+s6bOwgwBQE2u0Sj9hfwlB4acL/g/935
-Ff/nl3X6jzamc2Nr3ukOLvf+uu7kZzzN4TNkPDg3e5gsWSrId6TD8L8mrYMW4T1pgAv0pzQRVHBQOsM3
-XhkqCnei4bGZS0OY2AmblFAEGw6uycOrvE5YnJkxDBDLmUoNHr3Tff1/1+/85c0VoyBjrZgROaBJzOXO
-oh46NXq0d1LwB5fvNGB/alT75RdU1qUkBkp9Npb9tSzkGyIUuA3LEIAwCBJyI2hZ/itZ56+Ydans9JwD
-ipvLpvmbO49t19sH4ZhEK2L7lHySZybHmWFt0JVXyBKP0Mp6W8o2bahOLexatkQTa6N9p8v/zLu33klg
-1f9TlTia48pRQXUUMAxHgNk3TN+UzhyzdMZBT31azV/WL/91vY+dYgqQ3UXEicZgkzChlPxZzck8lRUy
-jHp/3AitaED20Q238S283D4FSRoDDPyBSExjh4EzAYW4nR6oyu6soy+F/ML71JiK0CCGtfXmfWSDg2H3
-mNX8TpzQMbJ77c3ylNMlOyAEpvjKuSokuj5ML2Hb8rmlC5lhi2Fl+VBUml5PucuOtyV8HbUeyJetNE6N
-a9sdCoGLvIThdQWqDoHQOpU17C2Y26b7Hwij6M2IsB/wkDs5QDdpuPVjn2PdefAM6LWrgIiLRvCaKsJd
-uSrvhUN67kqraKeVyjeW7BH3wuePA7/wVDiMDKbGRc/Rl6iikhiCfITYZj7X3ARZXRxQcGWBbaAiC80W
-pa7ZuOJuDA6jZ71xtmyxPVpWeBcJt1Ci7naBHlV8dkxO4AgPNt/ZgEhZ7pl1RTqMJ2KNL4+ek46gyZLe
-VgxnIPGmNf0vyTr3KVUN6VT3itQ7Lqn5164K5r6TfnftWKTJRj9bu+plNVYWGpw5NWXVp7f9cfYq6SaY
-S7dFNth5LF7gq3+rVz3SppnSGcHyjlnZfuaxgb70RuYlh20rtTiSAo6WvRmEuTHIQAeih/h28x6+9seI
-UjwnFkBbN6dNbbO/YGDneMoebGLO8WIvGF9jnWTrgqU67aVOeoqY5qd58bNsY/CK/4zE/eQHiaaO5uUs
-+xAZA7JUuTgUIjaJnu3Dvn2oRO7CTQhvWFiNt4lEpM2nyUhOY21giP8tkyC7YMWZeJz+QdL0umBk8Prq
-m8WClSTKdci2klT46UlZObGZVFN8nKDtdJvtjCib8W8YrWxIkuJiSDwPiStbqpSkfXapqfl5RVICch9D
-tAhg/nspIbl7xINmvSxQemR3hE8Oa7nON8NOzcNCFTibBAKrBzzFcv+kK7qcT0y6PqCc8ptZZxdDcxSz
-uuNfbxyeAdQgNBapDZBTuYOMiwEgF32J1ouEGK4dyzACscHq5tc3+6v/qOG/txbb2ywV2TMh52jKLXwv
-YswRSjBIup4U71PDTm/gPkoDVZR6NQBLw3sJXYEcj0+n/aijfsS8PXRxpSSiOZrEaLgotInPR7Wx9KKf
-VqGEv7sTtiXB//BL6NQPluQQ25IJPAFSsen9gPytWBN9i6ckAIRn5zsdGxL/nFUpW8WaChMrrdIvZdtP
-m+jjrFnnsto2TwcJDCviWMMlHaid1NP9LjXrXaizaaO39XXVB5zR4bRHTjJP7z9d8TqEqA1uGdycpO7K
-nDQW/xHU1c2b38hlgetx4xt7cYIjjWZspOVayq9ZtFU74718Ai8vp6nBlIXkV6FyUEPoLzYWfS4N20S1
-rEWq0LKq2lppVy6nVMspw1PtzxmdLuVt+MVC74uF3v8OC70LPYbn1RIc5+QWmq4tH7XuUraBfNpZcSS2
-R8Gj224aQzW6ahaDFCAnG+AAFHcl+QGUqvlcP/MWc5gqZwelFvjHowKes75ozaeLrWmei2kaVSHUNVZP
-+8iKZqb8Qmxbo4KntC0SCxUdjJ5pnZpfhvliUx9sO2dASJX1V1BDF0aq6E9TRxYMKshfwTdaQ/pGf5lv
-sqzwTf5K2weJ1WFgGYTeWuPhPTTqS9kG0de6IZ6aVcYCrFMHRoGLrXRMf2KD6HUjjnNXkyP3AMjIO/xm
-GhicPAMfoJyTn2SebvTCiEusPLRWqHGJaH6kUfyVNSbWZpIJr8wu5axikr/TT85cP3dDk6RaGZbOtzz0
-YOTu+Pe04hWoSTfhNpGG/n4gbegb29p4yE1tujdY+7tObCVUW54FZ/gmOi58nMRSpXFc7tHXOhSL0zvN
-G5c9nbC8XTPmOFn72OeNV2As/USu+ZCC/LCsBisOVbQY5B7S7GfKLe9X6DZsAAYhtKlIv6yxpD7176Wo
-OAJB6sAFHyZzDLgNo6p6P5+wJUa2KFqf0LCwPCOObQKNMaYyzIicdp0lr6OhlwA5H5dA1WX5EcW5xzyO
-1khXRk4OvtyNnzedhdS/7dTWSABEFZLazVlilJyoliweTkLVkWwnphBkNE0mPUuBZDsKF92A5iPxeweZ
-YGGC/G79r5+ww9N3S8BVeCpVpzUgBNjHQ+d6qlQqZld1wT+xtAUQ634Pkm8O/ns55YyUEnnaGXpTooSc
-QjnXe4AJAWUCRmMwSy6aRKzV/EXB6Z6aoblnLfrXNrSGDbg/lEuMDC8CBtTCTMp2EO7lXH4QTQttGsgS
-g8CFTQ9En+fGv+3AIxBQAZ91Cue/W7j0jHKolj0n6IydHVQSMr2grv0s7bCYMviXDGtEXaeGpURJYljE
-vnv5RzgstmOUgS11nN3GU34rxKoWwTmyN2z20t1bT9NougRj3c6YnlHeMicYRjCfVRi4h/ODu2RttMz0
-fOLKCWrmXNtL3lmNxcSZSjjiLAZL2nOZJczrTJnplgCMzpOcRFSzmrhUFjTiYbGHGQXckJdZA9g/JNAK
-zqGwT06WOmC6ruM5rc2kLDCchZgvLLkKvFmHJfDC073Dk+glf2nXSagMfaNd9j1O4REBwogaJyw9wEj8
-DM4saF9+3DeuIVr2xHpOEHqXhiJPUXCSWj3YzPC4zOuiDnx6akMdLKaszVi7fF6BlwpjjtGGCwl22/1l
-FbRhJB6tSho8pm17BsAnegQ3XYkHIjp4d/NuNxhQ35mpZ3V/wunqVAYBOB8HKP35tu1dMgka2SoecduA
-yBqzfrpRemhpL35xfI4u6pakzpUMfJ12RWG4FokxJ9WqwvZqdc/+emeGABr8hBXhasECz0hVdsmtZeDu
-5gudr5vdB4Tg2ejYjMnWXLidjBi8bnp2GG+Tm0BY5sw2xH0ZW23IlkpcVPRM9Epc3Tz8e8uQZy4pe2QP
-3lHZRjwWYxmnlm1mcxfOlfJmCYHPRlpA2BbsVFlOw+ssB4T20i802WzFIxIrnq14LJ+akDrrpDhwshE7
-h8TxFEmKP6V3mluzqTZpwUGlMF+NI8SBYn65/FeJESOHhVlPMk0kN8ptQH5rzM6jgXMVUNaOgnOCBXP4
-p3KXyGiwIaZyA0qKqxrXoJ+4BL7Mi7FcgYiztEDFWsvgNfzEpIXrPrpE1oLD7UDoQ+ksGv7GvLRTGB1x
-rArkiscRJd4JTrg1Ognw0yDlrdk4cXYW3kX27GlEnpFLziM6CcvOJOmGaa9DCj8PmhKqxpnHkwvEghBx
-6oYi+nd9HXxdR1G5Nz2FBszD64iDOAO0X2ANH9r+xGrNt1G1aBW0Lw5LIwxqOO8mjFRPsaWL6SchLGsS
-U0vLk5TJYzOXgF2wohSRAiisyHtiGVrKjk5xXEOry4fGEl9GP+3osE+eleZMt9lpr12SmKAq/9jVoZxO
-Y7x61X7ADGfDapmVC4b+GZZO4S29dkp5X2TZQopclbcsBPcMtVu5KiQTB5gjUCPz6ZPG4bUdoQylAWcx
-o2xWDM9Lyvf2iol062SZCqIpwSQnOP2HwQFHpWRjQwDv8F18J61DwZiBAb/8v5fyFLOinv+XSVJKfelU
-2gGvjWI821zlevPaBzfUpngL3UhauD9qqonM9IAyWN8va7ZNv1zwubslqMuwYKq1/43nPiDPNht0vv6m
-jUfT1sudvmsiNIsZm5R0/Qlu8f6SFXDVBtNoPgO0RjA8jBj5KjD9wpsAH7yLGP9zZGhwi0OHZHlZ2hyS
-9XPTIXmxIUe7fZT9IqwnGoag3dStpYKN/hlCb0mgzJ8orBNTvRp7LotDZaYqhaFkmGH+YTKEY8r1SK9O
-+vbiwwv807hbRWXNML8cQIPisRNIlUG7UJD0RJE8ylspYng1jmpFERpVt1+YAAvIRWrfrrg16K7jHSi0
-LLXhn/iVuGqA5VSCHrBWklr1ITyjw23MiDirpq6+MCr75QivmterB5pOtQt1HQ3Il+DpvlbD0H1rnIMj
-uBSXUr0OMMnnGV0Jpd6iwsZOfIqOZxLw1egDvLVpLomwWIqHaewkqGC0l5RubG+vmg5FTEw0RKhz8JH1
-eUhRyDi2yyDv8fgFf14g5BoRM2VUjc6ReEdjIHzK8Df2n/Q5DB19QopmStDDixF40DY/d8NIE17lXNuZ
-idK5jmd7Gqqdg+/oq3aITictN7arKLRJ5zw3ITfMWsSrtcySQOet69H49rkWw/r9ptYERV3e62dlRRfB
-qRV+Kkejx0A1zuwL3fiYCOanrsbO1MjNUa9Nt2dlkC3B/2IdWyP+X2PE98th64D1Wzfw0n47t+i5BSaj
-8Bag/LGL2OiZmrpGmDzRk6/juwxqmN7aWtpA44m3hjc+fnBiYso4fdWx1c4y+2jMvsP2vQjewM7CnfX2
-LsIq/tHq9TNTkmsai3bb7wA7Ks3Tgg5WN7+62f/NgRlvLRXg9XeM6KKqKMRYTiSooZgonscK+UsS6emj
-eTD3NnUUO6Z6IXXgwpgw27sA9gJhYeg8mogwqfgrRNUhHPH/YvsbMg51xVtBgkdn9FPW2zT2bifHAXZ6
-PRvExQMlC5+yXuOZdkJoSk3TtADrDPLuJ99204A57QeDQ+kXTGhh2BdegN8r4ksskQidX+H8R3FapGSp
-sBHF7B5D5hYLfHBSo1jCVUgGM6ZFjoeIpecfJrdaygNMjDmcmqNeeDPskCN3T1d23uFym/MMltfxXMON
-vZW06HyDlSaLxxp4vAv7sNwgmUgPR8ll5xumtkmMc5sSzgKZflTAuSTE6dIEIj7JremmZ/od3mQoOJN4
-/IqDwjgGjJYuEiJoQeOFLyAimrboLbeWjAFuYqve/LotQYWNvB2+YfaLd3MSkVLouKSiR/PYRQ4hxiwH
-xYehe/9lTZSIBjEvTaQhDvAZFD5A2Y9on1DYu1eQ8QsGJnDmsy59iHOJYO2DxPeTgEODMLOP70Jc/2BX
-A6+TyPiayuChms7gralp8ZQ4F++dzeweR6emsaAIkc8EATbd+bXpKVyqQos8m0pOGyxnttU3NWlwaCSB
-18mUhuXlFc0Bq491YircmIRs23efPn8pWRSTXU/9ofC9L/Lfoa66HPyk1d2nt6BHjmWfhSkmE+DD0PNN
-iCRIDEZtRIVKe3lSLTYuEdkHzH++ZwUSWquvCCgIsdDwFSnQmydkH6mIkj75wZoNsoJLl6+OwUQGGV5r
-KSpc2xTPmJWABhWDtfBrwFEg3pilCJSRHF3Ahllwmr0o3hfFbGcwaFQSqCCsnUBjBmoW8W12vednH5gI
-7JGvdDcyUfArlRLNxhYCTcgS6offIz//bvNckYPa4lM1CWOuLjhYzzGrUfvp+pcfKxKyL3OszpDG/2uO
-FA3KH6kbf6oj1aAL/BlT4sCiOKAPrmEqE8Wq8JuY26USaSiTuicKu3Sc0fUUDfFIDDK1bRxSyrvMsmuE
-y94A27lboLwUnU2YAV4Q3+/ZDy++xyChULfT7pPruRfXjXfOlaAEQw/0+c6zR9v3dgCufFsImqLDI9EB
-4DmJhoV9VH2wsTnbpvH0Gc6imnRaiL9oOdU19dZFk0c0t8fTboN1lsfU61GtUHARC/aYEzBhlMujknhj
-aw2vtSVJGwwVoy5JMpLaC8MfclvJzGOkWXSKUkIoQ50nZVQq6u1nPDuknl3yb+Bl8SY3v+AFhcWLFmJA
-x25RBT1C7p5LkhFetJcVxrzZMPhhF4ir91sLu4QjsmKxlU4oxD5xP1prgJFc9O+TxcZzZ4z1NDXq0wWc
-S3ozBVu1nrbVzVuY3ejWUoGVzziVo+P8RFUvLhAn5rNyrisHzDDso6LHsiZJ13SvrnlZvawmn+N46i62
-rv1iwWgwDocdLhrS84vj+hfH9f9HHdfflmOgGku6On6G9XyXTlIfPVWKKf2tM9Ksc+4Daaa9PSIa+UYf
-JeNu+qC/wLoZ3L9pUVNcI3LeLUoyngKagfRp6NPpxkdJKTLyXI3HQlumo/drmrmkuHxNumaAwXDtNnzK
-jApnk048Gfb4xfGHeJOPcD/Tw8c2acgV+nOsdmooL+d9NYvrBv32LY3h7Vs0RXAVtuimC1on7yPvKEVJ
-0vdg9XK2b4Jj7dOti7BNPSdIRcIZ7/AKsc/VxuDq9cHGAPN01tWHkTqbYGxt0vtTiLCsLpAXUUW+ZJhm
-t7rqeFxMV0kUtcqmhKvasRAxPgJQIvCPytm4xU9TNLadhljsLbX96z2ddBSTIGzaXRxKIKxslX1xLxol
-ztMUcQ00DB6dCGAXp7sXEAGZv2XdsO9+Ax6PJnDQj5sErtesX2pxLrfKJ/pnpbChTqfV5irsnYyvWHEU
-DLmZrTBupqnhKLAOPsYXjTv17/AYf0Gq/0ZItV1HHSmpXXiU711kjTg0mXwJardFMHM0+rfWpPy7ELHn
-7Bufj7L3mH4N9uVbqYaUPLbnS1632XKfYbR9OXuKtnX4vZ4Dz8cGvdZHEvmF8YloI0bFh2Jk8pTH7pIe
-198PHUYCVO5lcq40sKpfjL5t1RB5U18JlK0eHOdB1KFH51vTmyDG+0GqMkSLWsXpNh6O90Zzdu30Jsf5
-bvWhEBxCtBzbxmGia0RNxwUlN0K8t/PiK5PXFOvlo/KfJBeA2prs+gAu9XwXCOaj9d18txjJ/5Z1PS/q
-9VtXv1lZuR/kQzNHHZeI1dFeG89aa3xQ2MLYBFTD0Hf4QR+N5EdRPAXfYJeteh9vXTAmEYcQ2SrDcmDs
-vUqzfNFOMBcdaAT7PsLbV1fdDwEKZdfwQVoqltu/6YP0hTv8wh1+4Q7/FxAy7SzWhcLULUUXrRg7kEfF
-/oyyhe1gkjOTBE1PGydBA6JkRup1CvZ+GoB4jBqVshi2Qbh0iQsHR8Us/6+CvEm4IB/Ngt97s+koKKAs
-p1AS2LZ/i2Z+31lzQ7R60fgQfCTQdOLb/LusGBVHLjYJptaq2VaDVWUI7ScOnJLP4ugjfba5RpNCwCxk
-QLTKX1YFgZPnQpctrxEUJ4gQsu0d172ntnnvCKP21DTkAYWjJ0PGPm7z/AiZ9pNqzp6zobsYjczbOzJF
-KeHD6sPq+FlVz1YlQNj6BH7U65v4n4f3V/Vdvv0JK52ufydQyPGe1Dzs12q7MyFdKpOPTJfIDOQRfwxH
-gfrP258+ZTyCzWz16rXrq9npaeY6j3zrVGXK+4O07ITexxHGHArCnHt/fwTjnbveUS1e4sFFh0cw8AvW
-3uTLzQsmJLKYly4v5cAGZxDHWOWPlWhgj0vJMbhiIL0wSQZbRBjpjIoZV51iWKbq2Gt89VE3Nfaw6XOK
-eN+MocekiyQ+7Nm6oVmu1JARBHBa8BWgnEst2LDXyJrkVN2UgNoOAvAjPR0ehTlSmX4CjsI6OOleYjHE
-w0nAt+WfNLOJQPSSBnthoju/SmigZH7qNTRF1ukv4aULeBFzBSbcc63lrUOLJHrEdIerqGedlrtzH7Cp
-YmYL3eYEZy/2UJauW2RlrUkNP+/kHXI/1woQHlhqCfqS4kVTNSjsBszorfFcqIQFg0dNXk33vJ25vA5a
-FOrybEv4+NYCMLYYJRPQbq8XG777oJYEKppN7Etkbe5bt8Z02IKp4lEtc5C86etnPEjnEwmzdyPz3cbh
-2HdnfY4PKTOobgJfGO9ebFwItIrZdOuGTDhRahkMb6w4FSNG0VPMfr6YnYwoN3rLmeC6NdUS9tO2jE+B
-9YaQebe4PmRJOTlehzbp+J9OIISDHVjnDBG6LOObgZwGtY8FSdE1a8qUDBQ8DLPKVoHFerqPf3ZfN2RR
-8Xje9CJQE/FRMOCIm8Fv/2CHgNQncxzSFdxJjbIKuDVICK4ay9ARJxySnJniTotpEx+kpBTrHKkJbv2/
-HKX/i1ji30ws8a/VrwSBZ767gFoF0fRucVCOKQa/cOSsZ+nUQXhhUo23a1zQzh2fAE26Fap+fk99zJMo
-7NVZCpkwWM9ipjOo+/upZKKAmgmdTFDjT66UCcb6Z9HKhIP6bWqZeDMuqJf56qKBVr48ZF8esn/xQ3b2
-06R+COdS+4sBLz9TLhBZFHJMDQJc4Htrh+W80c56Bpz32RnZWaTa74f8tYck3jcpqv7MKF+H2cD2MU73
-2B49JRJfKNiki+WmXvAhR+WBpJA/PgyEtykqrR6iZqo59VQQGH4O8eOQfAOC3GGL0T+i+ouGmPh3QPVf
-VPBfVPBfVPD/C0iExSr484b/+x04Z6f4jg0Oa5eIJ469gxpxFzXWReQ+KEyekMsUWkStDk34AsrdLkpU
-iYlHYSWmU4rgh4gip2jtCHpaFD4lw4C16ewRidApdu0/5lSXQuxw9NWC1Ox1gTwvABkWk9nhGkcygLHu
-l2QIr0RRVYcJgazrJveHzHdZc2zpPmV+keAeQjlQh2wBUORDDmTHMT8qCjJPEWwue+1+7kK8RzYGbkVd
-FmYKFyZrIb1hhiZyD5wO0ReLxntCzc1wbIQIuKf7xZTC++bkD6BHvNBVmHKSKhRC/J8XPw+o1c+PH62N
-yvfQ38l4ln/MSiIvYRvxPiqkD2U9J9onO6yOdVVwKKO8BCp1Tj4FOeVzG5XsvTYIwiq5IIuvNYSQGB84
-s4LtyeT0Ox+rKLBNGFUH5diYIDzC36xSb6kOv8L6UNDegGIqmfoUBMpU/5avkvx+I2GQ+H8l/tN0Pu6q
-/7pH19+bDIcMirUEoo35Vr6vfwevW7XHoTd2q+GJRlriAEv49/ca1gPJAB57fFdFu+fkAUTHck9yDDUd
-zmWbEkwORC5LIh19Z9SnNjzWZLI4MBb3txlHn9Bll3eva6NXfTssPzAdBTvhD9DqdytBKN5geOvBx2/X
-AYQpaQlURSYjmEnAZSOC68CeLuibLKEPNS6F5CCSBcwFbQzt0lnWKiVeW2jUsYyALSFY+73NOpyQ7Uy7
-Dqn5G9xSfJBFeaQx8r+cO8YvuCmCutyDTbAoMqzJjIeEh0kX1ek34/1RJNhWPZ4RHf4bKPJ+q/BwMY8I
-G4afUiFibb3dqhoRK/k5pIbLSAuNgLBNJ9YIX/jVUhGhvvCWX3jLL7zlF97y35O3bDjQ2lB1wbff2SCc
-hrrz4O2T7cc7ULHz9u3U4O23bzuhDDygN70cXFWw3iItoFdVIglAiAs17AhzUDFZ5pnjc1Jn0nAZIu37
-MOnTH0irSc/Lk2yuwevYDBEXWc5Lu7GbrdW0djMmrtjXfWTJNxM2cPQBLvNVNfhqNWtrxLxuGVp7bOww
-ZjWtgVukrsQU3K9f68F9syCdTCNU9hKDkZjav+NoMHz5D+OjZRbHVF1uRHRiW4eQbNMyhjT8vfACutFE
-FpO1BEp1DbdndIScZehzd6q6PROUb6HdrW/Tftwt3LNNO6li+4bxVXSZ5FU217Jn6dpdpdqMIedUuM+W
-qLHUfNGChdaWAi3GVm7aPtC+xFon7PysaUzK9qUYUy9pVUpGpXrOvZmoGvUtF1bYtxODv6UC/DbNTANz
-UF76HeZEJR7eoaJNP9vPaYt76B4pnkDL5rv4U8Avfss06/p3mqYYbY1IlvftvRcvvDX/f8IEJuuiQh5S
-0hWqhfsYzl4HcSe5Dm6lo8gUrpzS97lf7p2mf71sMwm0w5MAhj3GNAn+XFbh34tNl0G32t3KG+n5aiAw
-dwPb2/7K8ia6YZfmrb5Avws4db8TLQx7eEZWN2/dOpcZ61dLBWY09BzsF4bCSUd3TrD3iylqiZqTJpDl
-40U1O58lsdOiwIWL4hYuDCpoQGjguHo9XVthfZ/Xh9pNCEFDTNbrto5rxwEgz2waVvOxtveBcTyz46ia
-tn5B7PJZjcNarm2UosY0DT65zfZ53WqMz03H7p5K6IP2C6u6w1YvgBB91DaBa5ip7ssXc3kJneOZsc7N
-HoeVovU5X4z1xxRpNqhGRcsYX1FSmPmEUEdyZNFHtyaaUC5aEy22mtWXJsSnC7GKjzOKPxhfm+Ce93ce
-bP/w6OXbR0/vbb98+PQJdOBxw53wakm65VRHcajHM/t7ce/500eP3t7d+X77x4dPn0fdtmCHTSH7VoyL
-Zm0EUuI8oe8mFAlHraJFfVDJXascBxXVx4d/A6lVPz0eO6GdbdlD0Q339dqWvyGZk4cRfmxNABekpTsN
-J0cnyyn1RC1EmZHENWsM75v+jeX/zaGasfi/fVh3m3WtmZ/N5j2a0hymbI0XDnVFyUSfAj2TqiYr+laz
-mjQFsmVnXM+nmtQUc35pdg1RecEhwHTopAvNhiWrVDjbHvv4hULifjYi9NPIyc7SY/h7K7typfRErqsY
-NELRseU9/Jq+dpV0b3XlzZd4nZh4bEzZJBBE6tfkEDSkrz3N6e2EoxdWc9/MAeAopb3wOOVDJuPrlxWO
-W364wzX2ZbxgjqnbWmlbeDlQixZd2UKu6pfaB/m22RGtPs4M6LWv+cbk6EbH/wVJuqdOvT6k3PsduUGq
-hZMeW/sJE0ZHo95zbBEs16KltTXDVeZQsnaLqMFDdcxkueNze/mfuLWSI2PrLJ940eWpdEBTB4Zv5/a0
-CMbjsA39twXBEnKVCrhmL0jXF0Bw2PEN55eUKpP44/nxJt2zaBXlhphUF8kxy/fGkKl5y4j/EX1besDy
-jqbTP/vX08SGYLsmzWebbUsNhEKG20x6OWEv85mEbTFxWU2WM5QrOadEyYKEauPEg5ZOOJD3RTHhaO3I
-Q6Eq3IRnxvr1yXhP7TKeShZpcRVCFyGp7QJxaKCNNRWV+3/KWg17RFLIQ0DqC27hqBj9h9VcIXuchxmk
-A7IFBfQrzYzK+E991HHxPuTlCJUl/Wx1fTUjY/bjsi54CFEmPx5CI751YiRpSqZlOM7fqzkmVkaFgwL2
-jBJGuH/bXr6j6dY1lAwLQjLKFlFr821KSnOO5pSMgkzllGqvZV/JeI2FSnhYya4OJvyhmK6hWVpWeEZS
-gtFE0f/r8mgyOuHorplPFkZpXskITAbjkmeTKZ71aUskP7MJhAxrMw0TYWsS9Nvur19/1byfHGYjYGlc
-455p+UnOtcuKnZ261Lwk6S6G97yt1O3sNVpw0deR5wH1YrsiGEdMoGuzRm5JbRx9MCAimttB0qx5p1qC
-JMWLRukEdheWNeDkVAxLOvmyniC56lVdQq1nVn3rp4vKW96pTs+uAmK+kPHt6teeg+ZzVHjzhrCRi+LO
-kpLuJc9gYG5wFtUM4FwOnjy9v/N258mPPCTYRJQEEnFhDHtQepOLlttkyBhz8lR7vuEA7+VYHRXFnSBp
-MdxhMRJ6X0xmikZ9NmOT2E/SsdqMAJ40CqaigxlgksqaZDoaukWmsedTwP6tjrK+9jOzuELnoIOHTc6C
-wADPYUoXtGmdE+V+gJeQZBv0gHAzzR5h5bzC09IEv3/5+JFLvLH97OEg3k4ceiyXAZL3UsQYY5AFc2Qi
-IYu7eXzbW6wFaU3x0I7yE3GL5/oaASVO+Izp557PyQ9X1e76gTNePYtviNWkpGt0rW6DWNC4RphTovFZ
-km3ZbDiJWuaq8j+XBMdPYVTk0+3R6LngMT/y4EMwYDE8bZ3bVlzTkL8OtwQVvJHum63GEF1IGkoyOBzW
-LvNhxYFh5KK5FC9MLzljVEnw2K2LIgtQes+lH5IYMpkn6c1KuDJhmuK9ix8KqdXzpscL5Wta36RXOoNn
-a66qbRws6QDz8ohyP/igOa96Z6z3c86/49Zck/h9xjWXFD+NdQ/KE2uvxzA6qI3D1di/5vFjRNI9azGe
-FVPgHzDXL9vkKr3r8yZm+QGGOZoFixSQ+Lo2Hqp7Bz418pBNJOGqsDDwMHAGKOx84AI0EZVYonmxB8rj
-Q7XSLjrzD4vmulMVs970O8qa7vgZEkkN0LGBhVPRYaJWZx4ln8d0t65GSPA7co+vyDA+UATfg+C1cAll
-KY6eySCamuP7IkrQq0XtmUPxH4uyVKOO//CqO9ErxlGSOQCcXoStOSflrDKIt5njJ9CeV5aiYVnyHSzd
-TFz217PqTZCLzVMIDC8G5dPBB44pgYgEJhHAlDmonM8uxOlKfDj8suBZZkW3WaoxnnfRmnMWTxES2KSr
-yxwcDaIolwBI/ny/GJlAFSxUxJhReDVzisfo4YjHC8/e3To+aBc6Wd+THUFwsjhI2zInS/WZHNyt5URu
-xUsdkkyW4b2Tdf6CtCfB3gwOb8u6+jfbZWTCBWCPrNJzXc4ejPJzzFHDeeCheF+patyaKi5YOhvNzyxf
-GOTvMy7heSgt8k2mdyerK6Wo/KoPq6ImYh6mRqIjmapNZOgoc3nAugFyTOIC1wRXt1H/d9hFHlqwj3GK
-cWl2xl7KHH8qAxxrShfvZGOtzt7Ps7BFYj1QQl5W85rnta/udk7Q4p9Sspgt91kuphCVkUN7eDT0xoQ3
-VcbZPjlWphPR+AXyzZ9UM+AyXnqOkDxI4LTvva/d8mLewpJlcifVfGr92dj+OBiR7pMwXlnNtsx9PLMn
-zPopbiSLfqJG3O4oK3gwz6fD8C4L9VLLucfFm2MHygWirJlnm8Eaw8J9Xx0XH9A2iaRYJLO0iDtUlnc5
-GGTJaHpfE4aiTDbm/spxyFV6oCF7iazlTV1FDh6q+ceJ8b7MgSsu05T4jI9KWjro2QNV5p1NcXC9p8Wo
-hN2WjXL7xEqP5j04qO7mGLbNG/BRQYPRk3lqbtPvsquWmSesHq1arwVTVJOQ+wvkzB53uD9VIqIiAjdA
-PNfscDN062ATu8qpPfTK6fhRMiowey1ZfEjiRicgG6j8Ed0wnM6iJd3tsiIfb+gvRv4/jLnvYUJ2eSnj
-jjZJUmN7jl4Km3vbutMY64Bwb8LQukFa3hZYLohJeo/bkKKBRsE77W+XVt+URYjzjCcoPFjN14eXllba
-buuOpjFuTXbMOdhprwBPwVWTq1zCZZjBTauPKRgwkHEoHmOhtX9YeZOpdIsBwfHk3MmTabVLd7Walgfl
-OJ+pj7dJcH9YVe75iueiJ4kzwzdiQYefu6w+SXCiKgqVGgOmdeUHCkLPwV+GqfljJhNQGeL1OkoIHex6
-z3CYqmEwUPFBKMclZvgdoG/qboWAHY1APg1zejr03erULDQ2cFE8T6bVAOOpvoFxT06jX2Gs+FnyjXwY
-7lbf+42zU/0QCfKjclzUElBYxBHslP6hGmGO390THzIvwV7royn2FPOJ4QbYwZ4XmnuUnEbyWRQj9WFO
-rvYN2MOinuHBc+pc5h3oeRtWx9aLv0o3IJ94C3k08pQMCo3oBOMgcL7vYboomtJIAWryCstTdKZ2+cnD
-Gp5ADxpZbNSgZBiWe1qz7wpFGUR//0H2qHxf4D6yxiYcyMsqMQxDPyw3nlmVOgN38QQm5o0LmRwFqx7F
-dYtOPjpuaXyqkGIy+WSzl6gLrfHMMKoBxDIG6nB00udIBwhVcwq4rOZ6NQbsyO0h80iO81JUXNEdm6Mk
-3fTSJB0UbRi84zAJoxC2qjyfCDZklsiChRgmusIRR6/mfUR3sPEpd6lWFzEP5cChdQz+gcJ6C+GshxHw
-N5DFh3wY4IZcWrFvxPNiD84ix6JgbasmTkYWv8in6IFdYyEGusQ7ZVtjMsoS1S37aBfiqUig3hhZLUhD
-H8wOJuWWoZEAnDJQ97wEEUccJ7wWQPFuHIktoXvWndxtq0muceVLzvrlSeUidHIwEVp/lOIMssc5RtNA
-wyOXhkOiTXBmDaj0HZHwx0zeCa/BuLTDUjwnywvWRIasjhk6A1GXhgfNCfr5qAmmBsLAC/ftqSRbMH8u
-6adoYuPKJFlydVkk6pW2vi5qMt0oWFjaPgpv9+Uqt4/CmV25uu2jQDyrZjyziv/aapy059aYKrw2HkB2
-26zsYL8coTa7xeQnvHTZJWdP6FflYjaFltk4DaWFOj3S42ovFx2nn+nnGaf7u0n0BitsD0bLlJKMFe71
-LNC5ocexR8Z8qxyicJIRjj3P5XLrzGhTyjzfS+KoBYYGDeODQT0qURQVn7hszSyCnkID3agcsV7X0mj2
-gIcj6C9iAvjsF8oumJc5YAPlXET2BQNE2G7RhOr1IHpb/LRkqBWXSERA28yB6DT9m0nNqmBKuuvhMTPG
-lgvnlZ1ztP2otUbLoBMTfeGXaFP+G39VRdGmw2CiOIrqqbmKwQUNSOwDZ+Yf1RBvN7cowefT9ut3FvcD
-XP10Vjc0ZxGjM5XFpDeg51kbMSBj07Yki7Ef8jJesFCrgUe3HAAdnnutlDFosrYevUhqqjRiyeMkophC
-au8Vhi9KW0DtFpkE7g7oVUEXl51AjLWGNZy7wcFAPwcKAA3PBMWhMC2lc51bKwUMIaWTCOQvTrF0SSA7
-U4i+mk2QIHU0LfLhCcJR9yQHo2kfFF2h8EIA7nzBO5tEGI7gMkII+RKqxFKSfjLfNLjC0aWmYbsNRQS9
-DW2FNzoYLQm+2hoG16b5UmXulIU4Rf9rjbfcGgYw/UWMaLtLy8jYmvvhBEb5cMiSkUdw7IoxBh1r/9YN
-ViSUrIRbGJ+47Ha7bBNw/t2qmtWwthNjOKscv0arDQAvUPrLp0ChIZUXSH3cpK2rMDuFGwdT2w/c0onp
-BH+e09AmKSqFqR1VH4rWTUl9XnZfErvSIv2FVVAwoV+7s+9LmBmMnC1XAlwcD8oCDb+lQIcWkskOaHLi
-rZ7wxVc/dnpC6M9YKTlNsT4NHa7UAvaS/3rtASbMn9riD9iD0xaiwAO2gQnMoBt0oh0LWi7phyVV9LES
-kV7RK7GBrrRa9ytv7ksU88NepMSmNqQZFxmgKsQStsQLh8oUV8twx2HiscSQxy4D2DI2M2JSJSZHMgcU
-hHCcmtB0YfHImcJLjptDG7SPGr9/tjHzWi836v9mqjMxaAms0DZm6uQcpknTaX7SOmKxqlswzoYZW/yp
-faQhujjrIHtNtbWV8tpyZ7vCsW/3TMo8M3DNB2cG7FPELdLcL28OpYrJisRoKfnjqUWMzoXHLgoZKCHG
-jL2T7Ln03klQkR10VGSFjGboIGQOR9OygFded+Co/AiE0Wb2OvCzfiNfXSjETTfr1rwoAdhGiAQPwCPh
-M6MzuOpnRGiwPUexlH57lKWr/bBY4y5qCA6NseT7f8ixLYhODfu3X5r9147GFXo3hBxEG3pe7BXoeIYB
-U9oiEtk6cVgieFZ5FI1Oewt6lRhHy4dAEpKKqb8QcCOmbTKAi1oRCqCYXNlw59pes5ZIK6yLchFnfPic
-nvPDdjdEhICtdrgrjCVURawWvA2z3KhCI/bKlPzmGtFArGsPxmVhxohCmDlv+bfi8dHp9TheSODcv7p5
-8xpGDJEbDT+v408SQ8KPG/jD4TgouEUFjQChonCC31+b/BI3v8EfAcpY3by1gYWehocSGkFL3Az4TCM6
-KyYG1KPBLjQ0X938iipF9upQTNNIR82Ar19HX0PhAlT4JqwQ2a+sbn69EVYIWUv4TomYIl8PKIbR6v6t
-bl77qjUbYSpuCUZz2WgPz2q/iKYFwVLY1hvLxH8JQr18feNCoV7OHQrxPLFhbJq3KNqHI/bXbSVtGKdt
-S7cMai0OzmFaxXE6En04gMaHD0Meumvn47Y9IZtd9/76qLIrBiWL33/4G6iBzg9j9I4d+9hxhHcC/2Ab
-MIK6sDETXGEqaAR5bIdYmgXetz2w11rxjaZ/1YJ+ONwwRhrDMZKaHRaMRfZdVG1wBHcnPwiCTwdO0wZh
-iDuvDfKGw/bOjnF4NQIaBhBTTH6oMZrkCTg00eizzBoNjdlTSyoMYj3/VlT/UYFKzbb6iPK2VuR1khdE
-2m61TduhSX0BJQo4j5XkY/xOlvWP+agcxtVCv2/qnRRCHAZWQ9njT12uSTNcHX4Oolj3XUtd6hXvTzkw
-5zG6GwzIe7cJ6MYdCeARXW5RQeTLTmVCJ9hq3dbTw06FAfwwY2N7B2EWyPP0EBvTJYC73GJnwE01XTye
-fpx3ILP5o10y5zNcwaLaWysuPMOiqAGGaRXRUtqbhtKh2P441Qg0Y5uqhkZC0rX0o6ShlAclSnHaR/WD
-zy0H53dwMNgMMjh8Wjz5vjqruFfktPHQrYn2xiVViMRui1c3SgDCNhHrq8tlAkEz6FVtgz/WN/F/Hw5N
-+x+g4DTMD2FBzPL6vYLAv+v1TfxPAOIlFJxGOSbcguB2QtNqWJmms0ogB0lGgrwh8W9avrSTfGLZ/OHV
-N2FqVeYrmlpEGwzgDd3JyQxSd9bfDSqzF4TjK99eiJK5kTpUspMEl215NiQQrp7JMTTnKQEFQ/K9hVYC
-evur6HNwHeB73NxzCV9tLB+PcBGdevOC6QX+8KiBirxSMtjYvksEb8fleEiWwIA1Pvazk+w0iusATO5P
-VCeyuZJnu+kz753g0D6srfvQSz44WHxkP27K2AboC//z0/39uiBjdpdFR/+QwyuRGR4V+zMWkpwEEF4t
-DeFlNVkhSUrqZLcsB5/p38Qp4Um7dYGTJh8GF+JDtDFmpm9thB9t5QsyLtr8XMyLa7Swr0YfLHtMpkyL
-m5nsaUFnvnESqFn5iwTIdG0vHCTTL+dFA2UqhIsGy9T2L4t6dlZrW4euuzb+XJFK3WTkDsMtPgtYomow
-tLOCW7r7AE0PErP35fFqRyeZioKuzQOa5Nptha2oVTsiCBvbejGMBVc8BBJlAo/Gn44hGk7BpZFNzP03
-x1ONgLa2Do7QdB51AwW+FhMvkSTTbzWU3GwKJ3GDgVa5eh4Z48KDCpVuno9uQiwOxV//JnKqiT41vXsL
-WuVETuFaQ9n1pYWo/2ppaRqFwVealjsZUHAdX/CvLkgrtgswV4y+kAQjtEISjDUIVihKYKOJSwpUpGUo
-qGkE4WqCT3YPnWwjT4yKSfyv++BiuA7Q+eWka1v30rRVNAZDUxEBj8v79QWXd1Es7TODaafegeAh4IxL
-I7HwUX6N0zDVbPgTWPDQF+70Jcmr/D4A3Vzun4gDHLmyOfGYk7+kHSQih9crt7OrLlATW2tmt4W2ZuNX
-e5+aplRUEbvcpP9dUXM2P03Hfnqmc+RsoLgj/c0aI9uhuuslQ6kWFNT1xSh3hmAmEMHCYTtxG1miQRfT
-7Vl3o8ehx9Y7kcRKzdxOA5jqDAr1JcpDwJ7IHtrxVmMEIJvmNyyYhna9vo4Gss4nk1Oxj+1xQL6MPc6g
-ForO1Y/7EAApDI1vmz3k4H3OM5PcislFz/mU743KvfdqqndX3bExnNh8NsOtgP+SLxYPZlRX2W4h1r4P
-qimww0OpirKno3w8z0cKAn6Vkzlr+QbZiyr7O2b5OpgXdZ11JtWkw5YQdAjn020/ydvhDcBKdg1sQK3g
-ToRQAP00bsPTZz70q8tE5ehT8jCEZamzd8IdOnM9XN93PpZ2RNZTlLKGmWUYvioy9Yuvg7k7JFpx330I
-5/sSauvuzoOnz3fkAuHeob4EB2jDNQcHzAT+vWTRjon9C59kzjDWnQ9wax5FI8R/LVW6Hd99px+ceYnO
-4M06YrNaBTmbAb4gqN1ONW6FF9uHZDEe9fapnJiFr2fT2DJQ6CdsMds3CM+nwXSxg82oYS8xIqNnbSKD
-s5tiwAmKRqS0kdweHuxZO5So9Rk2yUD9bZsUxOvWXcJzb3bFh2HRHQhwQOqlY4ipu5vddsF49EgQJm/G
-SzlHl893nj3avreT7lXBxeVqdAjvR/yJU5lj6Mq/mOfFLU9gpzwxZspnLMzTZzxCpQLIhcMAPq/ppzoF
-FXvVsPjh+cOuIqifKHBHh8NdJneBXB41vkU561AcH/duYR51PCOzLAeuBl4J5ze6lj0A2mq/+sgBRSbT
-Yo27ByCX0psO1Qf1ZFTCSf1Lp/f66htSOHfMvGcVh5G24Y+kKJ5r51uL8b/riIIzmSDH1nQJckKecKGt
-CdKy3/w/QcueSbq2058hx/bHkKBhnwup0Gr8rJqwXVrxIVBU08+BGKsBEgf2mZK3DhM6aiL4KOhLVnxE
-l5QC4yPBzebmBIqiEf1U7P5XOSP3uWAl0/TNGbRNEK3HkDQNaeMfQNX8jhSJLiM9TbpbF6RGkrD+11Mi
-S2xLkgz5TTsTkSAX25kLkR8yAL04+J0xwCfjMYqZADudIBZnkvnGD4uvMjLu5yVXoiFKlaVGuXAwQujE
-xIglKD4PJXFuYunCz3mI7c540cPKF3zUv9644KO+UA/UkiLt7Ld80RORiDM+4FhPmpggp0wG544glw0r
-pvguN2KT34yCk8uz1FRhfTrHZTWByi9C/rfR9CnK/MzLsJimOf8RjlZm8RFuhGlPHuGUpBxP7tULntwo
-Y3bgd6zGk32bqr4nCecvuc9B1DdTsdeWW14MDCjkYi55WDBopOu6wzn+3RB9ZPosSBGOPfhgQHEG/lk+
-hQ305q5RmibOyMSmZC5H05UrPUopP8XIU5T31BmjUnomUzzweeahRrocw7zRQ2a/2+TzSoPQknYoD20H
-r6Sv3rNNNY+9ayamMMHcT9zMTcv3xYn9zQvshKJuRc3+0cLNKrGzZNf/Z7qUYrMnn3vNxTeAbPZ+00aS
-7Vu4i6CEQ9jSoZsamIwfSKVu7wIGPCsmuQucWD6hj6shPLgNW5lE8ogjqtlMgdGJaP1RgMgp+gHqVyj8
-BoYURQiASUZsdTfIfqgLQPSUQOIy+/SMUDRrYixIPmEOvoBR34ppGJaSiAnB22SRyGFVD4uz88eYNP0N
-I4NubMfpfkfZSQJZTTI9f+RCL6Quu9Sw0sA9DMQ/2kQVYdtExnyPvhckzE86z9oU4t4PL5FKWvF/SweN
-58E8ENGyss0jCpb87K9knVV+OLJU1uUQAuVd7v0ZEy2H4/QogYh0RGfxtdtqbyJv/DlbAQmQbtF8ihuL
-GliHNQy/vr725fH98vh+eXwv+vj+AWLRlhcYIxFV4w/FmGJdU5J+CfKJngMuir1AZXNX8ypGpnPtb6Kt
-2HVRuBc9irZF8CQeurnqXyZYH9WIpb/uw1uO66gcBF070TI0Hlbbf/SsaiYtee/gsG8mvDpSUa8Tj2dq
-TC0PaXr4rrtIiHM7WC5FMq3DsMKVtv5bRORctyEmx38sz6GBpOXjtDdWRk7jc45s6cSy4S63JJgNKwH6
-NH6/Vm6eXI6G2LhlSc4WL8dnsiFkbhlBSszaMoilJLKJuxENbJFsdqF01sdsS8wDp9sy7oZEJLzhiSQX
-Z9xiX2U5uWXLwhNx1brWTRGM5Y0Sdy7klJR2i+NOcYxo4Fea8fMtzNeJDrK17OobxyUsvw5WZJreu2rS
-tnVBBKpw12xc+M+0YWITkxzk52Nyzlri34MBss/MAi7HVnM8ToNsj2qdWwK80Lvj6+sXJPKt7exiC2ZP
-pRyWB4dro/+fvbfva+PIEoX/Hn+KtnczkhwhjDOZiYWdXAw4YccYX4OT3YewViM10EFSa9WSMZPhuz/n
-rapOVVdLAjvJ3Hvt3+4EdVWdej913k/2Phs6+gQujWTsINKFGyMuF4o242A2CGA6J1ECewEiRYuOiuVF
-MaPo6QYMek+lszlJCpwLogn7NjcZ945dEszkpG3j61nfwrcURy5ujoXRbEbpGMXUknCnzYlXyINyUCzo
-VnvD/aD8oh/SAWLTWAnz8VTK179tO++g02JAMW4fGvz8MHGj9aXZKWX76s/h1yh5AFipLNCHEWjdrmkW
-HaB8qmilbz3whPt89iv/92bJREInMeDw0HRQnFklpo3U46w6e2e07CbaKyc9uoaBTikovHFeR3LDhbqe
-JuW8f4FAUskgsc7R48kLkyPhm1ULsj23SXhgIp7QwCgNN7ALWTroVL1Spy4uC1e3eYRl5lO3/IuWd309
-6XQ6erGUM5w197Y7OHSWBF5cz9qUvI5JFlxmg5o+C4LyKb2GsYW8MQa9dspe2mVRHkhgLBmiB6tr/7rn
-/Dndwqirec939KwJDWMXxPhFhHb+iPdWivMR4D0Xci/MTEvLtv7wfjJLL4GYJc9rgJZNx/k/KAzSw5hU
-ad/UUH+dDovT9VEKJ2q6/nJve/fV4e6d259lhAjXMH9Gf1aum9fvl5JBir0u3p30fZEPJJ/VOEOvkfcZ
-3xz2LCyT1xeAbmEHI+PAHEmzde2jbYRkX3/zV+pqXY7IPMWDw34pwMWjJfDWuQQYxNPZnKcd6C/7cHDW
-bGyNB1Mc1eNOg5Pvr1G6pFiVv3Qe2Tpk5q8r7Ren+TBLDtMzePscqKDW9gVsWtZgs+xqsbcMtlagG3N5
-/91J9VXjCLZhNfgkl/ErxI90cODoYOMpvosXsGPfCxb1ACtGTMGfcAfgbxfpH779KSpu83yVDRF8mpkA
-yEz1Uvr6qTNEQmLgTzC7P8nKqN7js/Z78ZILGXFUCXi9Txzrn0wo9E3+E4i4Uv7E4HNuttwUscmfLCOK
-vW1swn+euiw4lgMtSYIHS0HX+pmrcVwC9finP2FPbrT4qyldYYMW9fSnmKAQqwZyQqj5p1lxjCXA3CL9
-jzDsb+zuxltEzDOKnLUciJWcdasYU3lHApVIVBujTvz5oPvN3xD2Sm5E8FS9LNJBwgWl0bMgpYxvs9dX
-aT4bidfrFDPSeHUm+IkGhKB3sv6Qs/4AkhnDbTNiOfmZSFaK6hT5ntoeu25M/BhRP10egVnQB9I9LAA5
-dtnW8IHU0yt5/kRXhIKK+TOd46dbzZQGPsiG+SinqHuNPwuvMuDAfF/zL4oP8hIrdZPHj+x0OeymfN94
-9OgRTfue7aFDc/8R2Z7Sk+HDMrQNcdVKJFE6RQs6/SVxGb7ZPUbw12wqNrEmmo8dtgXV8cdEGHZvTGqc
-6+Q7h0aSbk0Lw1uH4qV8SGz0dOYLlfKhyJScOMQM2dRH8bxfWJRS5l6Gk2fu0fguKMOibqz+l+IIJZIG
-FH0jaO9RMf9gWY850CbbKzURXgtxQ6PhhudCfEfChwtChCYVQJIbAtYJRqBzUJh29CLUtsNhw2RaLS98
-N09JMKJTVgWxsmBErXCyRH1K2gZ/LDfVDu7D0qwKFFcRynDZYEYhaP0rsn4BgOMT1LgAzdo0H1vmA79m
-ceA399Q8nH/kZvTiHYjjpBc+Jc2BqIcuqvePloMqRLLJSF923jfuzk6LAjvhluVFfjazXjvVK42dcAPk
-Go5PGroTrqrzqPAX3CNZnOgUo9NSkqHKhpD4YZilQOXT2HFEx49OZFANDq0Nn/B/fGEaVjhpwC2lIj7A
-G+0kqIhXFj/5V5/uL1/+MtvD4CJmCG3Anq1IZX5h6JrCFaFPm96Vv5+Xr9JXTSpBqtU7NrTOSKq6qQY1
-vE6WVky+BWQYL3nqYre5t8KerPr9la/HBAXvxer7uyLeQuh2Wh/Xw4rX7u9MzLlLR1rO+iuH6KZy07xb
-Rtkq0frkHJa6fzEfX5b39AOZkTZx/b+bx//98/HPJycPW+vuATUBktabPx+b8p9PWuvnzjvv+2wmhi8I
-yoEus/MRw+YSIIayPqFH7dmHXjOceBNgjJBULt5nU1QKZ8Z32+JvYzORZ6VbgWVIXoZxvHHSWr5QwBWV
-F2o22AOQAtkH4HvUqgnFbc4iKX1dN6oXorBJ46DKw05fFsWErArm5xc2ejPmYDZ5kAtJoY1h1ubwNJET
-7EU+YzrLDYtJe/p5dYEsZ7PpNoEAuz1gNpR4JMBXSI84ykhyC9hZAJUSII7VF92ajq7/fPzPn0/WMXdL
-o1V5IRcsVOUKsbcwWWM1SCCbjVJEAUDKkaNtOgAO8ALY2/ecIGaYnc3uhRsV3yVE31/ib0HOUrkjKPJL
-RN/+/pk4AFG8gIAraCHOHywhb9muYMpPSAN4UTt481XCG9jvImizjRQbrPfWxJe68Wbl4m6av1Q6ugoJ
-jVo/7i1ShH0z44LDTkzE9jfZ+e6HSZUcb8HrWIXSVQtsv4ajmbB+OhzJRHw5GhzBvOF1gIU+8InRZ1ef
-pCp0XRbvQtXQ/bjPfmchDxJ2GOFRIp0Gtbr+8VRFitKaZaPJAT2x4ckxu/ddeMqZNwsObBcbbkYIOHt3
-AQZ6eSGeJ8RG2BS1BSWcxYmRvQv2ryBdLeaQMcuNjPJdvoyjnu1i7kTkHD4xBYPhhYk81fw8yziIEo9R
-ALwIfPRH2fQ8Q7q9LYCj+ITrYvyYtE9UfmtTSQXmHBL8ySMUAqzkMvkHCAHoiL+eAtL58D2q9VHiqrIM
-JMkpym2zmY6OjykCoX47CcgatTJcA1Ex0P/qhXCWH4CsAXffHaw8AYztoz3Am5als7t0UH3SAiKwVKIq
-B56Oi+njnFcz23IL7L0TBsU+n5+dZVM6PFU+Cbkip0KucDsUOh8qKjvInSAxmoazd3iwFNQzJdb1YTQa
-3hVQaiLTTr0g4QNnqxg0uKDKaVEgMR99AY+zsThj2aDcsqv07j/DUxGpgqt7UuFq3xuplcQojU/Kf5KD
-8TCICmRo/PcqIiwWI0FptDoelAYeKsQZVMIaVQg5O3P+o8p52wNuZRfRE+3dqPiZX5WJu9OQ6vDBikPx
-sbnZy2WkH13zkPRbSIuxWe+qdJg7cN5jrumsSsvNKNsTO9PIUDT41fZuYXj5w5MeXH9KToBr+6KYjtKZ
-Y7E8kooLUX8VEFXhi6MPqG4ZyBx0ZwHyasiDQtoytTuLoUojWGjXng3B4OloLBAv+eBs48oaRc6hR6NE
-V+NYQT/Z/FdAK3KoiE5b6WYSxbXgEvpXj+D+UuTjpmMx6kipJys5qlZIqVsphkw8RdoHoxP0+T+rUowQ
-0bV7wa2WbYW6sdwAtoLvbQ17KPcXqz1zTVaVbZnJEs3rTbKqP3UiLfctQBTcqI5W4GYeFgqn7j9foogN
-XzD+LMIIHsqKDw23POZGJ8mClKErTYhLayckxYCP+S+rE6gMenFv0UXRgtr7fg0B7x0TO5bo8XYqb9V1
-DUvnDd6e9Es+6ZcVju4S1dh0zi8XcHSXAUdH77E70IhXwrS0spekW4lvs1Pm+Ju8/IBIS+92NFVZm4Eu
-ISrMdno3TYIDBfIku1ez6XX1WtkoBI6qRX2pE9x9SVK7pGExbNLHJFVJM3ZJLfd/449MeNkqvQPI25dz
-fQKSglCQt2RnzOieldqxA8/CsCgu5xMptFpS9/Jxbluqc7+iHjXWYND0mCudVPplTMIAay5dSEiT5J3X
-Kxtoisu7FMFTvAz3V5e3BvknwT87EjsTaLhAyRiujm2/yv0PSI5PKMpR+tPgTCrigg5jjI6oPmpGhhme
-6MAmqyIqN1w3e45QA9YcirqDoaLkIeiO+flYd45EVvdiESO8WBjs+Rbpudy/3xRtqnFQ0+9EUORGvEId
-vgLalOjJSrEXTGaaYnI9zc8vZsnjRxtfrcH/fN1OXgD2Ao7/so3uumS9ugWrQtVKmBE5d1urWQryISQE
-x1dDH/B+NkYHblwullU+P9xZK2fXw8wUwjGFUnEBRkBiNJmcoQLGeH6jUpRDRqMNHqWZcN3NplnWSbbI
-74dchtNhcj5NxxQtBOpOUgoNJwNHH+JTv9vk9dbR7qujQ6/PEqPH2D7NNP8XnMP3+SAr92klk635rHhR
-9OflPqYZpRp4ViixUynuiWvo287ueRFD/DNs/YofHSNIBLrafn5gfBf9rkR0aCNk7+SDfT9zpZfJyKWC
-7KQGjrv3trOmcQrbOdin31qRfxOPEOKPyzIGbgLdxxuP8UCu5I9cfyA/n8cVzuPzDNB+tjeezGcU6ur1
-cH5+t3NJzcmVNh17Roawt37ZA7H+4w7hjKVkHRxro0ptq0hWk6BlLO+JtH4hxuVEenHalDAXAkCoq2Wg
-HF6PYYlhSVQFGq8PprZaBY7bgBoIroK93/CAHpz51emTrbD7aufd33f/a/tgZ/cQiZon7WTjq3by+G/t
-5KvHJ5tkqJCetsUJo53slv12cggPdMaDO9p6c2QAQPvHj58YXyBKsxOZOwbojK2+yw/Eb1MjbKsMo++Z
-4RvXkX3Gdex+gLhpaQ9JQ7dlZ3j5wDgsAF3JxYPfOeQJxho9vcwxq/kZ+lumCQaSR78QjGjSw7TJtDU9
-DqIoTk18RSkWyaxAIHwPh9eoyUDMAzV7p+7q9dijaW+XIeYaYs7O22mJcLhfZONd9G6OcNpRO3NkRnXr
-fcGW4YZw0X1vzfhTXr6GycyKZuueROvYG8MknnzZJoMJCuTdx4SuaFLRd1suASc55jfiqkE6S8nmfZhn
-A4QjkcPH5Jegm2aA9HhdMFb4KWK7fjHFxe0k/5FO0jHFBxlkBSDQyUXeR2AlHmn0xFKZNJPmz/OvHj16
-1KJ86ezSCYAGsGMCb3jNSwrLG0EHOzji1ReWI9c279fcHJUPSpbX//1t8k3l29NnycZGC+DK4vM7eDDJ
-pimVPcalwaApZWJ31W0qr70NDXAG55PirEHNhI6f7FEHsAMc24IEe3h0S+3+tK5zN5izQDeMpGg0lmfG
-4YF+InUgxC2viRWgU13NfgoVqyt0MDpQ1YvJVLWmel7lZosM9nhR7lE+vBsxU3+9tb37fOvNO8FwXz3e
-DL7/sPUmeSam4x20zN++SKfbSOh4bQ3KmhWTl+juSNk3oaH/7nW8Yr4wuxx6FbXxcODzKZ++Emg0MkWy
-QWswNmHJB5I2xvSAa60QiVH3Ti5SQD7A2eTwSJOrFeYHVdrg0/np6TAbdPkVaf5ajJ9rKIhsb6xzfz+d
-oF9RTe1tLjWNmPyzdunoIjju59j3sYDzlgEXRd+Hsc1zX6n29wwlz2VZW8Hivdoar9FRigpP7sko+17n
-H7GA2wGgpWvoN/j4ZXw+nE9rZ776Gu8UV+O77wFUeDupLQWWA7AidFC7B0BnTT/mGG9XQN1mH6jJ77cT
-1N2/7l68JY//T7MZBtZtdoPb/H7bwf39C+0HMdBIpqPlDkbFIz/nq6zxPuOIe5xJFv2+7fNcCPuHRA9Z
-VtKjAXtHlP3fTTUXO93L12k7SQ3xZQFbcjQtSyBDyI30FKMJwYkZwTg6lo3OS8OUDGwMfhNWHymNMukZ
-qD0TfByJMxQkCjCK+toEugojA0yugeLNhkAVrAEl1k6yWb/TooYJJvA8p4gbmGcHpZ5kggo0CjybWZVI
-MfPf5m6aPMldF1rdI050cac/mw6hORJr+nM6nEW+jrJZ+ne0TRLSBLMOSHsg5KQNDBSX6X06JNahSLaG
-s++nFP/Akv1qdeHf/eiIAGJ1RC1L6pgdptTpw3Rm6Wo4dAmHZ7AR4GEQwsjQsbRSA7RiTH5l9fCNd3ap
-3Fi1Mu12U8neGlK82LCpofDil1c56TmqJYgqymw5Mu3K7ZIBOVqpE74xm6tBxad8FZhQb0WIgglXAcpV
-NxkV2H3cQaod4xvYiMinQHmvcb4j9EIfonhpfMlCJt5O9KjNzwBNEoeKUDQ/BtgBYJzPx9+tuuFSLvut
-j593HsQA7Ca8hnEBy3Tm7Xw7WXw7dV3iCOowtrmE+pIArtkm7h/aeZIOe21qVxuX2K5wOtPLLEmykEsF
-nkUt8R+4sHAyly3r7e8dPWXmDANyE3zKGibGvhRXAwY5Tfb2d5mp7PiHvqklVFYVGNkl6+q/6IrJZqtB
-7X6YoIIHuzfbjcKHFKYKv4EnHXckDxoq09Mx8Ln49jkAJAiG4hG6OZA8I/uQo4oQZjXMKTyJN5/YAbsf
-HrBlkyAaorugjiUUFlVCmkcvhfCZIuyAs1HmGATSpMDuo1BkSOFQYLmCeTmzigGn9wyQlwqD4O7N90WB
-EYCJH0uO4LhSfjiSPXu4h2Q/7/MUnrptisJCQ6UYvxRWAO9TDyv1HDc8KSjdmwv12xvAe5sPeybdIzus
-5ERFIMXyHgop6KVQR3xbuXLbSNCw0Rjd+2ZCxKTJZJiyNLwivaJH2ki5oNUFLh1FpEmH+JRTnpX+LHxB
-l93r7wQxVF5QFDhhflK1RlXiha0TcSVQYKoOI3/ELVIaaVPTF7okDVxsiSCKFSo5FPBjByvxltsSltDe
-KIpVh0fDW6hX0aJTswswMriCIsfkRgqLWQmwOV+LMCkQsyjM47gsPV8KQ8n1bOudg30mSMi4Q0FhAxuU
-yrr0d1Z5xgqdMVzvzsKhEJC9nQT+D9a753/3BxI5GMkrJtMksQGfu45/XEyzrXqdREADy8EMa+GT6r0S
-+jcbj1W+7O3gN32U3TG0BM2mfDD7vUMHR05iXB5qTpyFkTxbjYikA+kMSe9Xj5HWcd6JDHFKUD26pXTm
-jT+yOzzUsUVZSIremEW+b6sFV9mL6IQ162XdpqU4t5pLrS80KjRnBfJ8rC4EQByUjF5PDhhjYBifU7hY
-4jqpIZl4AGXH7lVkKxFXqcV4tnAX3LZFUUudwg9V26+R1FI749nWuS1dZSiwMyrcJJ7/2gOK//SVQY/S
-SuWOPAxNlYjInrZ7+iaiFLsWQbhJ+ies7ZPZ6s77t54oZjlCeszq0OyNydaGHntjSyz42T4EFECTWFAS
-YJjhWrxnjh8ctBHaw2VMbFuqAFCsMLbhDPfQLPgs7ZuUpfwm8qr6eCnx80HxW4QvruzBCi+xM2NTLe9X
-nHG8Qbiam/4G7gZa8E7a789HRPwcXRWvUSS3k5cTXg5JEad0LPRBczOf5tW842NlOkUMwn8H6o6ZJJ71
-dKMOlE8RcYdaEXGRTstPz+osFAasfB5WIfcxpNxDc/sfEjnKi1rRMzMlb8naNjGo5wWQnnnJ0SovMwcI
-6VuyOclGneSH4gpFh22XXiO1dCsOsuvEh6dsZdBRQxqLPpwcdnAkuG3CFSBxnbqWlUE7MMxtlFpmRyxj
-bhJB57PkIeaAfsippPmiy9FyYGCieHsTwh7wI5+Vdix2GCaYI64Ax3eULNQODiagcpN034mIpTDqluNR
-gfmQOeycE5dDUfEm630MKZfPRyYaHpPK3+WDZ199/fXGo69iXZhQfMJzQDvmR8RRV4te0xKxyrjQK+sg
-OfGsORVuSuvyF6IzeG77FwF7QN821bvElYh19fScVVNclyFbmaJGpM3aNN8k/dBqVjG4jV8Tq9hTHK0g
-K2IxzDEqjUx6MGD5NJZysHs7f6xchuyRoUkF9t4Z54xVp9n20Rb5t+ScMXYOw2mWDq6NQN6BgpOczvzd
-ZJErUkanyH/lo1E2yDEpzjVagXGAQ45O6MCgph6NPc4RHcxLBIbnrzSmICYm6ukQjixyLGQS4jZUZu1t
-KCq3gYYKN2vVTTbWxghZFi8QD8C4X+DFI4WCoDIlXW5LkjZknQOJg6NM3dOFoGI53oxYgQUBIdJpG4bH
-2OelEyQYprjg5hGCdZuTJV/UImsVIfjv+nRagkmGTwkfVn42Dal724eTr8VVRi+PkKLDa0O3wzCawOG3
-6FjP6XfqBgoLDHtVFm2Gg14RTkTCV5jAoPWNu8u0mF7mP5Hu3FtGPGOpHKjlQmL/fXdW3Hfg0ahlJZ0C
-oZtViPdaLmSaDTNAjLEZby5ia/S99a8r3+TojbsTkUSGFV0Pg6bwrpYzK2vrY1ToJD2bkXLRIMO2pPcp
-xBKMxMRKAIuj7SSvHaASI4Bj2G28+bAqhPXUWTZ2S3F8cic6bKtE2uE92k0aLSYan6EmM3ii+T+oQx17
-KkkHi7KKk9maKCiTreQsuxKBbD7MMdhSN0IorCU9epR7+MT3HvWg4RQXjkPe7JZ99tNE1eiy1sw0waCz
-gahcBxmTXTLmGioiQVu3btIgLWXyZTLooZDwdQHPzQUZMNpsbZRILte3GZGUBkT4SvS9p/mYY0wj7qjT
-5FrGUEMxBnE8+hcvRLvsvbaIHWlSJMzvbTx61EM541V67c3sFZBVnt0l7RSd2o9YUKu+9eaZl7rn0wyx
-JRqLdmh9e9ujwZfbsMGvapaiLQulgSwf+7qiBiq0H9IB9xdrxyNkQcwurgI6EE2sfCUXsl0LjDK/Y2ec
-boTAQwBxDUaM3tiV5ymNmmnjsfLeW9hwtI8hDVOuKB4EpamSwtPbMm/yaUmM/wtl2j41VpVmh24Mn1qa
-7YhcJ7X2razt8yjv/V0EFBXxkwJ2B7LNPuf8HmvMyvIDxjwOrYwjL6k8twwF/U7QqsdQYPdpgIukylEB
-pNrNOtFj2VF367ZySF+0ZgieTy1K26YsA4CLk55nD2tdAQoWU94TmUF3ff3q6qpz9VWnmJ6vH71ZR0+l
-9Z921uC+rtHM1r5ao0GW6L/01cbGo6/X/43pijVC75a0cR5L3NWEvHbwjbA4SJnPRwQxD5VKFBoyt9mW
-kPlttiLnZ2Zvt6N74geWqDhCbMXYkE49qt4LzVp7/BDybmKxODzIJHyWBVUIk6zfGAg1AlzsaDLM8Iln
-XS/q6A0PaPPEEN/nvX/Wrt2whthubrLQnlE0DiI4TpHuxNiFyAXTsvRnc1JcnGbZmOUIlJsGsAwzLSwc
-y/q4KnMYg15cIQXQGsdfYnsgYCxjTJFUEVvwEMhhQg/A3E1OOyLdi/O/QdX2LLhTgKKzhOWqrObH1yob
-5TMS1/WURqJnfTFmMB0aNQYHZYqEkqxcEL8XU9wSQYWQT4GHD3ZStibSlbMs47zrcQ+0RNKvO4TQVX9T
-DnOh0T/u1Uw+7bu5cDhLX87kbm9nol/PhzfkSWhQG24YCj59ZUppWpUZPDVq2X+Yn3bkSWVMREQb0Y7e
-Z+U16uPmGq3RLTC4/5gYm94FWmv+Vx1B3SjqR1IdDf6zxsoLaI3ffAz03xMhUWNetfFrZL1r/ZP/oLvx
-6C/tmJMlFDxp1/lQduFJai9yj4QKG+2Fjo/djW++btc4NkIZ9c1ejN3HX32NXsArJSz77Jb+cW7A24eH
-JpZw1MHXrO/hoYoJLaoEdPGbAAakcIAls71iZgZjmI9RKwPDfzD58MBh/bx8CwWYBv4VtTOuTMWHF8MM
-mGBUF7Tdh++nxXzivvaL4XwEhAb5r5uPZ17DM2515X95TYfxfeZ/PbwATH3pf3slSZHUV6ACfspw9d23
-YT7GTLOjif/ph6Aa3K9+PrtWH6YDjN3pfk4u0nHpPlzlmHzI/f7HHhpHqt9FMTK/mDQ//PH7tWnGRnEq
-bHeCh2F4EPYPO1tcZjspEPdnZ2U2C0tqGvyUDygTLHxk14S4Ulli+cGlHhTTNU7TBk+PiTCYnXdFixhr
-jAIUvlro88a1Z2i5ziz+fCqZw9SbZ5q6ZtKXSU3U45+9tiwNyq2h1nAbqWXqAuHxmI5ifSmOk0EB1VuN
-QRqGNkXdKRJ5W7Pmo1ZnVrydQN/YZ7Nlol7PT3nwzQ3P6PiQ8z+pGQmNiIJHID0wXjqJm/Kxm+rpNaEO
-dBvMpqP5jAZfCvbgzTCVFf1lvqCHeIOXoNFOGqMS/3e/+Af+56Bxwo4onHdQxY9JUOrXRqEGsa2Icd6n
-YzhyKVKGwLZgvJ8J6aNL4CW+Sc4LJmRTStSfc76XjGq1rbNIjuorshEpXdhizg/Iqj2KTAEvaNG5p4PZ
-hFil1YFB7Kb9C5ttDzOOT3i37FJE6pgYryQwC4AeV08AAaV0BdW6BYcowjx3N8pRd78ozfYqjGo8x+FF
-meF+Dgpc7w7VY1gUvpWQLKwmAjKawWF+mUl91kziwYf7PZ2hTlJ3ArwBq4y8ro1fAG7SKbC2l+hK1DY4
-fiq070A2/CozfsAyWLKqZiMDZlYGOTwwwMqoPjrJIdCbwgufzs/Lzi//M8/gVUL1OdACl9lsfePxV998
-La5EWfKXNQ4d1ugPi/5lQ4+X5jsC2gkzQUz44MMJIsy6doWIKgHqFjMcIDCStDlzZ+QHARawRiMY1fZ8
-mhfzcnjdpknKy35VTC/pjOlWCMtYSwAA6tXdJbvc5jHd/TBBbMLhROmBA7LpfIqPtvGbc1/2Rum5em90
-0WshouKlbyQ8cqxsuxgW8tLcE7+5U3l9pH/6pTB7W30+xJWofq4F+ryYzfBl0qD5W00HXFjTDRfWdvYy
-O5v5XeGXmo6wqKYbLKrt5A2/5LoX+lTTDZXV9ENltR0dFRO/G/hQ0wmU1HRxhHLzSgdIuRjY+HelMX78
-kRMFh59Dikcg5P/wAMSIHq75Ih3lw2s3HqIb8K4oklPuRog8u5UvRInU37HuosI426RG4SJhrZQrtKmD
-kRcY5eIzH/Ip+RCSQVoK5rbRiPzTpULkqIKPjygkMVeAkoT7QLcKXaL9tpVi0+0Ak7ECji6p5EcJkala
-RiqYthfXk4tsDBtR02m13LQcZaOCRoNU5wEsod+wUmzaXaXTMWe3UrXlo10KuQh6UBWAjswqTTWPeq4O
-XVUUGoreWvz4Yliksy2KMVMgD9nolyV9a6wSKsgZtgDNg8fJ5l2VuDUk6BOIGNgTEEs6HbTQEs91L7qQ
-ShChXRZcM/HWsWDQBsWGAzQ0Znwy7mvDKiexqwdIecxpER+QkZ6seycbv++8OtjZfbf76kc7OSDliadB
-sXcD8YgEuHHhd9hAyzICYmBB6V0n+SzF0zsQXc5pOviROIlsYPfndTrDqMsJJbX6rntFPMQ/R8U//lm0
-jrfW/r+T9U1hUhlVSXx47gZJV5SvpzkZj2ajHJh7ygwt3bnD/xOQYYemgup08+fy4b9zH+akqtGVNpJz
-UGgzXUqyFFP8gzmAA32O7akd2wMrppJBd2FqJq7/5z9XxnWMJSrYbSRDVryJZ0252mFIvjMX2EgryWfP
-iBwbb8dy8sl7QzEl18kXQCDv5APKvD7KANt/UX7XMA2JRZe/K4iOp06lmKaGzN/pHHvL/Txyov6fWm8R
-k1g2/q7rj38vEDdQsaRAXLopNZcu3BcTODm+PXzBwv2RFv4GcdVjKlt5i7xGv8EmHfob4aOtn+N4q5M0
-ki8NgCMgrh58AWTpF+UDGzYruncE20aAXor1KKdb3R7W6sWwQ7/k4Q33TF/X1f5DzzmPYYUtp5Nl092u
-NVrJt17Y5hqkyje14ke14IXpzLJyxs186DEcUg9+8dpyJzxJv5eadt6yKPcdLc9zxCyJXgZZSl4IJKPx
-hepOmBClhY1e1G7yYQZsG6JdtFYfpZMJwqVsYoFoCftFOhm9CMbIr3EVNjYUYAnsXJ/MGvbT6eV88qJg
-CUDZ/PWKWdHG40ePJh8a7eRCuL1HHJEH2z7gOlRjU8ofbT5Q8N/anM5ymSiFw/mYvQYLseqm1A6s6oTx
-A+sxGpGdSplkaWm87h9Kks2SnD0sxfLD0f7LtawEwoViz5DRgfUtcSp04xMQ3BSjdOWVkbKqa7ZcluhK
-Kc0oQ2kpV7LSbNZAZWFySent85ePE7+xydRAHyvJHy15rG30wqTKNxbVSE+G42CgxxbKiXYEuQWp6S6K
-wx5ubG3VacUkkLMt2jHdryauUiv35bMKn6FZiS+TRldlEfMbRliqmiEinM1G1a3Sxd13gDH2jzF6age3
-c1aKUBwnhls9ggObT4bm+rHTFJkPJGw1zVXFFIbNaFM5i41G0sxGk9m1mP632mI8r53oAvrBWByTmDZ2
-6NHsgVmVGxrH0iuBZx9g0TrFzv2YzF8jp1/eE5os/fhDLsBvcaz19amc7sqNW+UURiHae8JEMgXBJPbQ
-uyqKiq7ylYuvXgWOGoUB96NOwHETS9adGaGbL2DrLJDORdAPj83C8jMloMYz1G1c8/uBzPxVYVUidO5n
-pByAF8YpK3xgzpwHXgikwtC0L5+pSi4NhFVtHOrzWjNQs46RVmFGfy+rQzWdSWQ/dOubCqoC8mOZzNMR
-Fij9bHGKBBQGtm2OyndyNRqtltiSaBFa98lXS81FqhIwpBHaNRIuKPtrOy7C6j5+TEVVIVX38Vd/ayuB
-VPfxX75pPzBDh59/+xsKdr/+LNj9owS7YjL0v+cZshs1Uls2Bd4epmUQDF4VWGEj/A2ko19PlMFcZESX
-+fg96zb8uvbzA6WW3TLaPXrYJ2U2HxRrbLfIBwXxw0U2nCSXWTZBwwR0Sztz+KOEpyKfsVchKx7REoaf
-cfIL4jC/GBnaxgIX0t5z6Tb0undurDVsmfTUkvQItaGAwPhGoYe39QnlmNcz8nikfA2k5mV2lJ2Ne972
-KKPsljXU/V992pU3gDpnlDjiDfq7UokalRoUF0k+Nt+EwutNAkbTwN71paRUzmtSAq959mHmCgC78TY3
-/cHbxC/tgFPaHf8PVkA+qa8cE8kZ631xafanR9t1DVe4x043VBYjnMx8bhy8vVUhWSDW20EmCCiG/4sh
-09G5vWeA94hdNM3dyhr7TJ6eosNMw7aBbV6m6lqHX1TOpsryBx+qVQ0UTl9kfrZi4KSKDC9CQPOCokXz
-0MxwkLhxkmcVxphjKpvKOzYIqdlXMVdPxFa8evfwBqeKBuB4iLCFzOIN0L5lTrEY/YNQ2QS745G0Kqwr
-ql3zTVepbq1VZBHTyhEbK8u8LN5zhqtuyzjdE5GWdo/4mzNPbeznJQdgGZC9BOM/GTO8CvY20HY0pF2L
-0qKbrlfp0WV5rUcO0ROqC8PsXRgZLeycs1sN4T9ffulxEKbecX7CpJHpRCfkMoRXdUrJI+ud68/MFd1U
-j/wbOJfCNZrzJQd74dHD4xzL5rNo7WpXLhiS99hQBrfSep137AjgtZc0U3XDoDE2jWMSqfIU5A7QJfgT
-Hs+jwsfqrSgdq2vcgn71iQSgUomo1CQGfCNy0pEIQHrGCMq/fjZO/h1oxwtkE5RVey396Fu5r56DyPg+
-xDIQmbI/MmsRUV0cDDjoT5dUsgrtvq/04pdZWjov6fehVUr5lHJQagnr0vlAMgUYtgvLTculuYtmt8uk
-Ec2L0adz8zHh6wXA8pj1VPG3DlRPndQXw2W/rC2lhGu1pYuTZqwQ935BWPtDCtyem0Wiaiq4vYvtsrcL
-7Es+Kq0CBE42usHYk8WPU6Vkb6e+zEjcFhXjba1EFz3c3T7aO3jVlYA+QIXT6J0DpWJnmOnCOJYOUTVh
-1qNIzGr83EEBqJPgHe6+hM5sutVmpMreq9dvjyg4KxXOTCyTBiLThov0zmmzslIN5Dmdahfu/xZWMWis
-2+P5b8vsMZoBbsZpNiyu8Mmv6SzEGM0Gr1+DFL8mG1JzUZ4ulROpEyZDcjO2mzBKx/N0aBx49U5Eo+RW
-fJKXuSP31e0Ljl+NI/JdPY7vGYdt7jLREk75JKgKzbAbg+QXjK95mo+Z2RexJzJMHC0Dw22SgY+EKEF4
-ZAwNVc8Lir+CKRToJXnOroU08F30F82mneQwx4RZJAx1kajIBJwXfXgtfpHWrFz1xOGpqD8M+cMsHLuH
-IjQhqKhVir4QGHFB5C0CvEM1ufpzXCtRBo4zbIluuRx5ZMxeNdxNCYiYxcHEOb5nrg6PMrzY0/n4nviz
-k4KQvQs+YA6waUZkVJNzZ2FPQdt0NkuJ8yEfhj6mvJoysMwIQYxhPIZYNnnfxCObvJRbneQnsSgnUpX9
-i4shxw3TwuhSUryhKbpzXckpQuov5uSTEplHSecCBy2OFAzOxlci3paSc/A0kTXmxFOidMKQfvT8y8LI
-IbLh+wCYXQik8WAhnU0/xsQ7h7HMT8me/0wo2/UpHiwTEu9vj77B/dRkS+eUeUn52QSgdP72xrTb7cRc
-DH3dg0pyeeh6h+6sfOTYb1W59QfV5CDSV5FL+T2WGEb2Jzl/8F4pFLO3+41NsD8Tb04eS/iEuZTw1SfM
-tKyUdvjMMTZrFGNBpe16nFcZejGpHzmPlNRrXre+Jy7zbOHIMMLhbUdWXRXDddY963ousETGYdafysc4
-IrslWCFcGHUZuCmHAI25ph02Xxs+hNUt+J2G7mXxJXNMdy7gGDgrClRIA1FEeBWNRK8ylkXCi0GPDQl5
-SkyNYECNcnJHS5NTIGFN5JBilF0UVxyCaMkZ3JRKi++Yvx7VtaBld7Y+y1cECW5ng7t0hDc6nU6FRMz9
-EBvG0dV5OGv26vbk2N7uk6Q/TIFCprgM4iFoXxX7BMwAdj6kSsAcn59j8KgZSaTlAYeHihUU8OSZ+JFs
-hEMRVHX6RzxaNROoknjU8NNQeE8chSer3aRIkkMKSNKC94Ms5Qh/wNYDmbJe0n8sCdKjF60X6IahLikm
-UAksTzIjHfN6O/OrcXal2QPahfOohE1wQJyzQJLSiaWMiJsMKBQoGKy6l9tpSfL/1ER45D3CF3dQYMqo
-dJjDjNgdolMlSQ3X02gkX+LLvllThcZX6vGRGZs56ps1i08B1UvWfekklUKcCvEny8t+qugIKitMMUYw
-Pw6QGlMTtpPJD7tXxhTMTBqoS+honpcXqK6ylCwG9UBw3Jq7Jwn0fxwGYaJCxELT5xv+W77cZiO4Que9
-sZyoZUJFNAmVlX3LTlb2p/kECE15KBiaTuvvlF5Uo0E9Ne6ZgEgClo3vrNmMN4q2adT2Tn71vQ6pEX/3
-0TKQ0KHpRZ7LRVd5BEehVGlZmJnwIoDaeKNrdOowA48Mm5xNKXMI5uYpZ2Vl5z2srjf+NoSPQp1kdYiJ
-DnjMBeDYfOz8XK8TWuacPcdNE38J4wchpKZWXdk7UFQ157S+0BOS1G3lDzQ+ypsTQQmYw48t1NJAnAJ7
-YOI48VVmP+IANZNWjtuFef1iC1Pl+8PIh2aQJGdBhZncgDjhi2/Ce7NOCko57Ve2FXuSugC2uporkNZm
-SwQmFK8m4dC7Q+aEwVI7Io8iubVlDKWKLNUok72dauBgRXaHwW9+e9KVevTySVAaNnh2bUjoZt7JOm2K
-O0L+XChRfCIWkppCQjviDyly5QbYlbw1VylRrRxU3shFiAgmNlmIqXQcSCpWYQTuSSxsHBmHVXvSqeUO
-3PLu7f5RvAFlcv+mbZ5gEXon6XCEgQtwgTr+s+uiT6QGRoAFRHRA80cdooldLa85yTH0nTRgiOBBesg9
-a26IT9phNxyijkxti9LPZs65BfA/5+fXIoTywqtTTF8206FM6KXg7wE7y8GTC3ByeolMStLcsL0GVjqZ
-sEFCweDQQQTDsTJOm2ajNOcIYmnJwRhoiTHe8uyiKDM9LJjEVSpB7g2mVGhSIUS1JhJ9FMHT9okIKR07
-mxIHz+yJhmtg5RY6P8T/cSijBbxBlAQ5vliqu9cRkptINzaXKA0szE8RwW+kXqUEFkHYOeFHeEXkiAms
-fwHu1aMi4qyrR2H+nnxrZWzL0U8dcv/9sE+gJHJB2Jc3Je3TLRugOkvhugPRRvv32VAtKuQi3Ag6QYZb
-legoOeIOAwyNAzHOJNvv4Y2hJsSsBXSacQkLDvaTJ18YbDHL0SBcklUmkmBzPkFTQbzUVgTeoZ21fD/i
-nRokLGwZ1PBxo0RmF1tpyz7TxbcInfkQGgdjWXKaluG1VVB3GmUHGFkR1/Oo5yUJ9A00ZMYpPwYacama
-NNchFsL6EQpNTdJFw93T8A0cDP1D4anwsBF/SPE/Kcg8Gnpl6LydzxrsB5ECfzGbDTPE7Ok1qnHJaMEA
-UyqBMgN8P3DgW53kgFArL9soA+w0ALoj65x32hzsvuVizggGx+GHB2uMAUhFs8E3xD8XmCG6yi8QiVpP
-S9bQjzWMR53cgikWYz++SNrVR33zEn0oVgnVoRRFSpJ1aBiUJAKe2v7MkhT4XOG1OS0+0CGZpoO84NUv
-WSvEJjGU0gPjD3HInrQ/LUo2GXSxYq/wOYD30pLDNkasXBYANh/P8mHSw0eAQtvDncJulilwrXaWhV6B
-itbMABW8ofqWJtSIaDMDKbdbx99LyI09rijjtunFVQxatpssJTjytseBEHnAV4DcFj2xEcfgpf3DeAVW
-qRYLQoyh2RFBMsFGCXtsXls2vhbQRO4wIKjDl8nEdIL7KslKcMqZDcXuRJ1WrwdIl+LErQkCaGL01F7V
-yKXX4lpIQkKblH8xEnDenaFR0+eIt/9HRbyNR3uNx3qti/QaSCjoK54Nffuh07bmD/GDswOOmp743bV8
-F7qoxYY2eg065+jzcZVbrRNYMF6AsVD/FXhZ6jSp4d1aPLmoruI2k3PNa+e2Ooi93c3VVyTWrLIesZe1
-dkGWbqSFYZ58e6yCpn5GIyPtDgBCvU8VCVkKPLdHXzrvRpOtZMjD/7K4OY8MohKGORaIWY3q7hkF+J+f
-V8DbcbcTwblxsw8KfpulX+hFGD5gq8Watua08Pmvdw9B7dm9djf+9qRdNW7tbnzDVtyh+Wr38eMn7bh9
-avfxVxvVGNR/+2zm/TuYeQ9RsEYb+wYGSRGPg9BvtYbfaL3lt2QXCya1IoCNiTDRqX5RvYa32suXXzpd
-afWGRPp1QQa/+eyL+gcdNKB6ty/y4WCajT8uyOAOuS/7Zuz8zTPc38eID9QjIyxjox6a8UfrWYv4Et5a
-OH/kHhga2vtld/F73aMYKcQeQ/+v4DD0MFJMmtBvot4nKHSaSdHMZF2BM90L01fpiBKuVfKa/iTGATeM
-ZWfsnwp9V1JYGQh2RAmtDbePN+MY9DcJDSvhi57Owp4k+QrA6ixwUuW61OXWrOmm0XYDanNHVq7xHHXQ
-k2HB/r/pdJpel4I7SKZmw/71SJCVjl0gQIZk1C0MbpoNEWB/OC+BCmEj0h4Pi7M9SKQNipXS02anlP+F
-snAypB7qJMYDmgw1avVUImhO16lHl7NkhGTyElh8OGRQVt3GqgDK7S9xq8jMiMKt44iIvXPL1vEGTljV
-rSP9VHVtSXlM63JiQqzcC6yCgrhKpHSwgZXQz7MvF93Z9URvv2G+bUCC4bWYGOEo0FqXw/x05c53llUk
-fp11IOpmdoNb7HP1xsFHeaI2SjsD3AV5A1hggQFoMkqtILoWysHIoPgL6qvO8vP5VDmYU5xuOKWAItmI
-mbMO+dfbyIxjXs90rJ8ys/7tjek7eSmOoNGeOzEQLC0AECNaMQJgoPAnMX8qa32eZT1k4dTDLaNqK9ja
-DZeLN42Qdl/CZpHIhNch2dthPRQacmBmdEaDaGBuR5QwkqUK5kh57pUA2kzI7qLEZmdZNFq+kHPxlIxg
-Bp1ghIMKWKrg+7Sy96o5A9ajVbuyyqY8M7WOcy/IE391csn616izf/Dj7rvd/9w7PNp79b3TurhOVoLy
-ZpfgvApSiquJGyJNgOLOCP0Uqczvgq0cRyQa8IkPhhsQR+uBQBmnrfgx/tWJN1LNYTb8I9xsdZO345Ql
-mEDaoGaEJvcFXXRjpMiUm4s0aCCdQstrihJZWk/3K3jG52Nz47EYM0PAsRO9xSknIwuACZpvtY3SJhnM
-eUwFMor8wCVPZ6fF4PpbFsDyMzLDwcPFC+CNs5JtTtNzCd3/FOW737aTp5NvKX/E05T/y2DGxXjt8Mfv
-nfA1gEe54JKn5fvzb2X7YFGm6BuB8TtmkuJfFg9fvNKo1Iw1UQCQXgw6siit7H1R9lykRrWBdHz0d3NS
-nHzA83PX+95qqeNUxRvhF3jyOG5ttMGx6fik2lSVqegMC4H418NeBB64GnYVL4Vfgh6DYg744K1KVPaB
-19I4qfCLCl3F3l6swhWaCtfLiAEBs3mhGYbDw6wANYamvVlBM+9xfORxSVLvmVPQBdNwWMvi4l8YF/9i
-cbGbs8HJv/jhBYJqx7+caNTFTwKTbNWa8VWzg7nkwVxGHobLhQ/Dpd06kwNcoXU/NkKZLULxe8APvjl6
-t7/15u9vX3fVhfHpalWQVPF32yv2z4Oc2A7vOp/bdgycbK0qUkK4hHOdbK48L+8B/DTzCi9l8AaZu+ke
-wd9jnke7/3n0bvvgFTLZepo+AXureZpxuuafaKyKnNBDhdu/NZximCJDX5nHDlMTUV6i9BQ+d+p6Xh5K
-LcpM3CIUhUgLuhuPNtqLxQDdjb9+FQtJUWH+u4//8lUkUsWTz+KmP07cpHLrLRYyrT/8pRzm6MaTz67y
-UnKs1MmfVhLw2C2lPvfT8pJNk9vYxwh++l7qrB3/sytEUl5+WB9zNaW98S9s2yISVcvNekyVVfdjNl0M
-B9inPMXWZF4ynBXE+yrOUbJSJSbr0hlyvBfFFZuHl2XRz+lZR0LXeY84S0AKemlYLH49XLya/beHR+/e
-Hu6+2zo6erP3/O3Rbjd59GGjrYtevzl4De/Yf2HJYyz5Yevw3eEeEvsvXuxuHx1iwV9MwfODg5e7W6/e
-/bj18i0B+8aUvHq7v/tmb9uVbDwyRa8PDveO9hB/hXUeP0r+6VUFLPfm5cHWzu5Otau/PAojZ+HGkBmj
-F9PuclxcDbMBOpafFpIAAsMh8/WcUaI2swsJs/kMkChkxp7GVtOFl9ZRpPNye17OitHWDE7r6XymYpKJ
-F1b+PiP6PTU1OGkfscXG2mQqYcmR/Mpn5k6ahMheKOfkfTY9hfMy6qBpkTG+w/zfaw9x6/E2rMngbJcl
-mwSx3Ug+UpYjSTaejyj7nDbcNRUCMHx022r6r92iyPrZuNzeMaUpQ2/FOLOJ07B99HL1bVAbmA+KAjpo
-3X9NZrV2KGSHiMiJcrTJ6s0kGCoadFxN0aV/nMSjYMNPu2USGyUYP/NHwa45YLGlAazrLxVJFl1o47nY
-gj18iLLGKeIFc4Id0mBI/lBfK0txzPyUj/IhBY6pzoOdIoupXn8Ota7263rh8BaPZV+SLe6zSWBXnQE+
-8YKdbeo4k53RGBHibjI0Y3iZl044zDaoQRuD5jiirUrmF5XaGeOaQTGybjJ8xTk6AP2Zork72nieojG0
-JY0IWeZ0FNW6c3Mlc6uA1hI3tSDPqoPoqGLF9spTE2xmrH21VhWMd2DqoPiVqkCCna4DE1YTQJadrDaq
-IE3HbKlhdd5V6r2Q9ZdYipaWXaGLex7pHfKQeJxMcGO3P58m4OB9Pae8PJTMSthdGNzbDKOlAhDWHMZm
-p9NpdZP/KuY/N9CGd3rNcVjl8ProV8t/Gj83vih/boiiCN0qUmEcJAv/LyQ86yBoSsHKtAVl8yfhmAeM
-qxsRFBGJXs9y24DA63Pq1GsHtQ7QVSHNBKGQ1B6/AI080064jMW07MosoNltTz51l41Qcqz61semusvM
-Im3wbBGup+y7yTM7QOBgX5rvTcsP6i7IqoefYa8vB+5EwbM94o2rYIj66QUS6VQ3Y57Ph+Sm6ljWVUbt
-AfYHXgPG69dbYQ9Wre3ayuDcgjpeuGY/FMLUIGJ9Ll735DvFh4etFGjN4tdtdYB/V9rrYGI+CG91qh1U
-j8CytV8AXocvvdG3BivJq/1MoeRq57ov1NACVWz3OrpJjjd0fbSjlGinyjVFr6p0a35/sl4NQxbtFLb5
-EJDy7tkZNCs/ts+Q1avr8jkaGqZj8rj4FH16rF1dp6+QRcn7n6xTj/ms69Skz//UncdZ4LpRHAC7NyzS
-QTb41Ctfx1+7B++TET0LrqWnVY01ql4qRRup+t1kOx0jP2PYD9atnRbAyDsuTceXw1RldyIdVpvOapNx
-cZ8/ZrVXQ0b1a12HS+qWOmT5iEIrMcBJxiDYVgYZyrus9q2ns9JkPs1S3w/7qrmXWuFaaVODUxa2WYoJ
-nj5LNuo2jD3kRK4kQphTBtOm6DQMWX2b+uT5mEecGHHqfMZWU2i+c5qPU07YfosLdetlvP0i3m0JW549
-+I3kL87O0vmQHQ23MSaiSaUqCgPVUWI0Juj2P5+IWEkuiuzAvKRw8peZlSigUJEgYVo6n9OgVEzHjXzQ
-OOEKLC5cUPWsKE7TqaluRSvSw9YQvcHOL1hpILwYsleEMdsoRQN4aLGAiQHI5T1HL71xNk2HVvBfSiTE
-7vr6LyXWp0CIl9n1GoemWVIJvdDJZM9ZqtkFFPu0vR1Hdr17tbW/200aJOekIIuwHL4MeJvVDFcXGfmt
-poHwEV0nE5PVOBAfsZ4i+ZVDBzmnKJ/L68KO+116Yn8r0otI+p3ECvhnVgfAUcQESpSTZMDgoCLm9DEa
-F4KF9mG8VFVhXs2oa1iwJcMPhH6soAikmZyyMAfEOlWiThFgUo10KoebrGRYDYFdiI0bYBU2mnxoW3Pa
-lcXT8Xi3O8zDrRyalaJ8Quxy8Tz07Xo2OZcGRUOFqpPgkWNbImMblH2QdAVnaZ/8YVpLN0XxeHeYRFWM
-SsMlPQK5ptO9o/BCCM9W7zNZdJpRILgSE9hcG92wPakY0qJUC73KrvhcXXVGP8lN9DoiukBrq5AiM1Za
-KTnRihx+tSsa0mKfYhhiIxw7o8ERScfXswsWfqUmFGHvqXlPvsWb/FRssL7tLTkhAaFTPxFUiqDc3lqm
-SWwaDIJb+lRYOe9f4KpKOB+2kQZQ04x1xaUkBZLXHwMOYCgY68NvvNM7yU9i/qyiDQvSshFacgmRgMeM
-9Vh+EKsFc/dJthX30Kk+jcUDIR3SEKBylaJOSirjpd1rguCWZ8iQRgUZV3LW2VSQohRRcOhPOVxN+9xy
-uBPha+PjtsW/8QRi3PWKE9E0FA35bJiSPucqA1SYSnAluRYdMYqTJ5ZHTIYFiDFNsCUOUJpImiTrL2+u
-VqSVfbFJ3Ou1tM0oEjlaNixdjThhWl2PLaeirSg4mi1HTCquzBfq204X6Fa6yfHJ7UmrPkFzJIOZM79W
-iqSq1Zc3PbmukVbG7L9XVRJF7cM5UG3YZsyizlXABgblEVjBTLTgVWn8q5ZXtlgC5kbSJbn4dcKSBDnb
-2dnG18GoYHHkbqP11q1Oso+Rwww0lau60UDUwGniHcOH2vifCkQX1+Jb+5DtLprw2AEemeX9+TCdtpMe
-nvZeC9f7WnKCULpuJm7pKigrbwLE0I4Odg66yR6FyxH+A+7Z+TQ9tTHarQGDotHokpKPI72KMlFjAY0Y
-6n2RUzYAUZSxP6TTW9mqqKv6TlM6O4r7e1FM3RPtpQHmhLY4Iq0MxhJpTxrUkJE8Nk1PnO4Vs7KLS5gL
-f3BfQ3JHagFAST+sejcK3hsHlYSFSKh44B18NRZSAEsMY148E6jAdGklLBoY8dekGXOg+Ntm9eRXG7pr
-kBv5ZTcq1ay1WjQ1b2GruCwZ1sajR59tDP94G8OPdGn1ZA7ar9UVWJfU/5kXM8dmGFQgOUT85gurGodV
-yd7rtzQpfT1bRqa79ihIIOfPHhOmee8iYsnlMWFYSatmc+wsErSNCU/8+c/JfYFW08iTtdlGefkqfcVW
-lK3aplE9igUhNphPUe5WB6FGbGdhuOiz9Fix0+QtM5+zDwaw8tixSaqVWBcKtk2V/FbOJeMwm+2Nx9n0
-h6P9l7rKZXatf06zs64x8iMMbA5ANlCS9GfGbMaUvh2j/eJYnVH33igKCWfqhh6qf7kmrJKrIiun5Ofh
-WGqBhBUZlHstXJBfq86NN7GGEubNc1p4MT0YR6wkqDaGMGVzR2Xf6JgTK4fbBPYXuN6SDcaceE4kb7bn
-UgnJoF8j6vclqyGx7Q9X6/ODiXQj4GokdOGq1/dxOzuR0JRAnItNwHRa0RckVbhqMwtPYkgiqEw+o9Qs
-JvoVFdOpKBIlENrUZlAgYMPi/JyJLBRbYaw65TMHr0R5lhERVR4V7I+wskJGEKTZI3/rGO8ZBURDro9P
-C39RdpIdIAPJDAlQCXz4ruFiVI2sG4cGzYuVcLI7XrSbBZ7Zgywdeo7ZnuS2KvBWzZ8FeZy3JbacyE9N
-SMi9nYUGkCY2Gczz7Tgr++kEdo3CXHvBxEy1fe2A7FhEJvG4EIM27Sh6Nx8E4UL0iawI7pMvk8Yzp05a
-+D4i6AjnE1mIdKU1GMv+uZKHNy70r1qN76LL0TY2yCZqsD1J6GuKZOIwH9SuWYxTCN9ua8ejsM0irGBw
-cRQZBHZpAXZ2YbXqqIkIq9po+IxqraGYxki+tdVY2+uEs42TJJ6u+u4EAb4xsUnFTcju1dcxR3jJ4eVV
-lPfPxTJb/JaMfSGBDqjPGGeVTTH800eP9RZEE/6LECo8oRhzF8+4fGhSQGsJhlNNjCnI9SCL3XIdOwXr
-3AkH4MUts2XsfTv5I68u3rmRp3CpXrrAzK5y6/z2+lT5JTJdfT4ikflWQSGUCJujyoerq9Y01sdCe5tg
-bTjsi1Zf9fjtNar306xfjEwE594xfz4xYW/Xn7Q1oKbJJIS7PCIXoXyWFPPZRByIhPwB6uaQzlezteZk
-3vSPTqunTluOHtuJ6rfWsNKzVa8cAK1h9LefQ3+QAufDZJj38xkroq3ADy9daAO4pjWghXHaMdDsBSVR
-noSSxmAuKS7Kpiy2eHhwkGrk/ktNZ9ijuciAJ/IgJLJLuNDKwIfQld4/fUY42xRmn0SBJMzHPzHtQO+Y
-ixTUqO19OD2z+T12tKVjpH1r/ZG53Cam/MZD37/JUzF2nvUBgNrjVT24fLNjR/O3fzeCZ2KH8Mjv9FJw
-jv8Y4oo+C/8vvAfWjujj8XX0aC7Hkb8FXgytu6qta6T/zeC220DpXsQU3wbOc/3/xHhPT8LHexVcpKt+
-apS0DOv8thijPnRBldm+TegCJRDuPqF4qouFvN3Hf6EgB0agC7+jWoSNz1qEP0qLQFLc1YMUZO/zoch0
-k3J+ujhYQSycry/pjwb8NboGliJQKK8X02JkYwKp9tEqRreQjSaza6Ov9tt5RaY+vhUE4KdpGvTjFd0q
-2CZWPHi9++rd0db3JAV6t/ufr6HB+n83nx7/d/Lz+rcnX7bWJXTo7uHbl0fv9l7t7P4nyY4woScJeVnY
-vkaRERvO3naXw9UzMdAzmNekcDGpRG1wPXMIXh1g3ANpbJwWVXObJ8M4EaTw1znqs5FuxnQvnH5/1r9A
-cJJ5nLPDX1KQhjmsL8cfpIAD5ES+xrazKDoV8RVp5LFz7GKKHteUqHWSoklWOZ9O8Yyb8QHeGUs4MZPC
-fMpGF7NkmCENjwbdSqMfRCg1tI30fRhZm6pMkGKQkjBB7E0wB+8wt1PoLLDvlVwCa7iuTFAF2fVeyYJL
-FCtPhyXg4YrxUJobJrBhhw7BwVmzkTRaLRefwwSm/TUwlUDUZQWGFEIBozBgOEacE8VJoyiStkrpxYpk
-41UGxq0pPwS2tTkhAMDWsCzYZ52tTSh8LJwUeF8xOlfPpDh7mPRczK6e0lcYlFUff3L14JFu3KGslyE5
-IhigGRA2Pz7PSBpW4k/WRCJT5HA1/uTdHS0W54m2Mv668GjsHG09gugVsycBdhKT52TaxaExu0An6E6y
-jxeZbBx7V3DgiivawaRnLB96dGHT92k+pNCF58PiNHCLbggzzEjExZJgLcg4n5ElBF3CKfnIqKZUs8Mj
-PhIek/gcerSnzqS6w6kBfa+KJdm1W07dZnCe+8LL8/z6lc33Y81FgOH9HhZ5YpYQGF6FNDEjbXKFLweG
-0jVZutpM50HVxsMGc8YxUy53ZuLWWh/jquNgH+falWnxodkXc3tBOg1p5y+0B7rlmb3I2lXRnKkdr+xe
-WWdNA9Oylbq4jqZpuFeezc+CQi9eYX09GKWFI6PW0lzWlRsMBzWPle2SK9iCdTynI7gNz9mMg9Tbc2An
-lo8rI/EFHPfD4gobbZp5MfuKMVyx0MpOH3Uco3fc6xfEukSaLFwYVA0GTnHVyst8woTIRTF0sTdLMapF
-xMvvDEXUhWIHK58JM2LeAw43JLmWuTZSFbKObRu0AUNcs1cA0yIGoNjrAh3NYcEJSNtkhuTsmFMJr402
-oh21KrxzXuRZ2in13W2Wv3h64UmyEKkT7poCG8jp3BZ5p1BtjGp7ogJ20hK8npcXkn30f+assBZgbJzr
-cRsVwtOH5axZEwxBNHPEgqUUKRemsft9iubuSPvgouczH5gJhDzIy346JW+7DDe7aceFqd0m01xiSpcF
-8rBAC05bWrK4dEHsonUkVrkfuLBCk/uBC80J5/NjxkxnGQac9i9RFM4j73gNG/++gQ9ZhJZHddQDLNIn
-CT4+SBoKQismiXAnmzE1h/Q+p8fIUT+0gCYoEr5E5Enjnij/lCMcYpxgoaJsVOwFUef4lyIfNxsNFaPF
-57dgsDsFng3yDDFRkGiMTzmp5LcUGtiMyjlcx+KrqvHaF/LLL38JA3e4asST2TbHv5z4EidXhhfSxatS
-Ukj8V1OtWdndls3mZRq6PX6WfKngaJleBM7mvWjvoSgp1lC1/LjI1Uly371fixCWf2cazIN0E37yxL4Y
-bzRH1lkr+v35BJknhsGXq+Odft87drVRtPy5u0YhPnDrGWJLlv7w40NviYIi5419o0I6jajZAMFlGIkI
-Hhkvymg9QfDls2RDjefukkH85yHMZnx7dhjzUuZlGyWcSKxuIxZ+95XoCcwe1cpKrRmV9a1V9BEaikyK
-CaXKGhD2BMwlD3Nuk9SP4SWgjK0DFK4ZeEw9fCQvtYAke/asut+WuXLLNmDbf2pNzo/AilwbEuNMg4hx
-Jbfs357qTzFjc1qxp8oZrsx015wJeVgsO40x6Yk9ZlrKssxfqOhTteAro1m4RgtHbNdG+HrXLOotQs9/
-KVoxQ6SEwjGUI+UoX51PKRsEe4DBQ4b1c0w2WjI8G3vekUGSEoWWSIQkyDwNszBvZr0yrhhKTgM/443Q
-LjVZN6X7/XCb0AeF0rMIEe5i4q8m14imQbECDjNUI5H6Y8QckTEulXcoCYOIPu4q71CQrOjjX1zecYft
-4bVrr7zyodAgJi34iOHIapnz1wHy8VXFPJpANS5mo2HjzgeHL4/x1j8LsmU0niL0b/k+CZtQWim4SVyL
-h+CyZIk4aXvmY2A58/D0wClbx31GRkLiu8JTya5acoIlcgKhEjLYNUFlFJjKOUkOMwmgHhyrZit6hlZe
-VIt7OQnflcnEEuchzAnyeIPW8SMhx22vXhYEWn7JXCUdtG1VNout03DSRt9Cpbkw42Rcu9R9/OjrdkWH
-BF8p86SvKeo+fvwoFsK9qgJ9/Dnf5O+g7WSNv0pLejAl7UaNApNSgvraPc4SqtLoCWhyNSaBAZpP0S0n
-SqDn50DttbmmC21OucIxC/50lI+BgiFXdIkXgkoU1b6HihYk8eFTTn7W6TXjibREqx/UtSE0zsiOGfDF
-g9t5e07S/mXKqddxa2wc6pFGZOxseoHOow9NW4FJgZOmTPXppWcxHU0Nvl3Ta2ncrQ1qvEdxEqCGnd+V
-0f7k47PhPBv3Xb4GHii/lqzIeZj03mTlpMDTqReF8SHyllPzBPcOMb5H5tWSvC+0PuyogcCNw28hWXlL
-yQZjVhjmGAPGqXin2pEhfrRQLHwv4YPU/DU2fnanuCFeWqpVOozUOUoniyvsIoX3MkvfLwFUSeobGxBF
-wlhch9P8uZzWtRW34MZfwzEvF1fbL07hfh+mZ4A4Xd5qv/q9k+gjEN8Km6zYS/S78eirz5j3t8e80dN4
-F29VP9N0YE3iZ6EWM40w23SkjSo1rWxi6X0gG3nQfsNIBWvwwUldRN1TyfmK3003C18YcoJXub1x6P4c
-O16xNWN5gZmuqDP0u3qmxtMJS6UblyddnD5HNCvcta51cTqH54kVNRy8SW5qMd5Xld1FTiTeAeB1yoxw
-LMSqN2acATU/mNsc5TUV3rMdQnLCPL6MkQ7VqmOUyr/TGIlGpdVlU5BswLn/8VEgHz0ayIk52bErYgw6
-3P501d++oAOdF9PhCONYGMkUKtZYHE9ZZqeSAaQt1jNGr8HhQXFOa0OcFMPr0fpiWERhhvn3HJhhTsHP
-jzk+vECYHKChO95XVc2+uRR0BoWiJEGT5UgGczTCRz0Pv70qQe2oeG8MfwicTf7BsAyTRYZO0BWFe6LZ
-IPwztlkKxwv81ZgRIjrAUuLdeSkAyQJKzdcuhTSNSG+MEEafBLhpfaBXeFw9/672OlXpD+0k5eG/CfLy
-k3hpSDnpcCFsethQolQ/HEnvj16SSEf638PBmGQRGMJSDmDyiv62a60WwhnUPLyhBwXOwAglu3jSoLPS
-4EazsdIKDaV+9QnijhwGvhkuHJD3WQmfItevciVphvGvezvOy9XOVJute7tJvnO1F10ri5oKHHCwJOSW
-jcQ8xqoQj4ZI/Vqhx7iKA34TH9H9+hEBna8GtGormIcbhfGOkUh5qb17KLLiv5GdWEty9HpS7iuR4VsZ
-wVU+3qxOhtamw7I2f52pxBtTeHYTcraW1KdpIkD4CJsRXRHu9Bt6ptcKPofaeYEpqHiCVxfXSa+4GmfT
-HSv/IzpqBIcaXah0Em/0etGq1UHRr/Tc8YBp7wOorTU4PGz42DHW6Xl2hUcIP7GQ5Ceabq0PAEO48iuF
-e4KHEB6BIrIxS879XG0NYbn4IuMBVEG5Q6pj9esyK8xl8SI1mDMVTF9GZIt5GPFj4FKzcCuas3fuXony
-GlWOac6WJph+aZSOgYceOKRcrnIPKJcjJhym7r4LiLK9HRoGCuWMVyuTf9QEOo40wOFydVt/iPQDNIhQ
-pxwioRhmA4NEHSHRcaRUWy1lFFuy8JCHSN1J/uekQUDoU8Mr5o3liesCf+Of0Xm0M8mQeLnrTIiWci/A
-0nlQZ/486FPDK9bjdJ/DWfAsqULIXHTsQ5k5am8nLyeocs7KJi1Lm+G2zQ7Q+I3QNaAkjx9R6g5sthkt
-38BygudrzIJ6i2SqUbrUMtMBz9XdePSXdoytgoInbZ8F6m78lbxDYpxUd+MJlQW8+l8+8+q/A6/ubeki
-4eh+jmYGFfaVP1sW1hwDmMXri7QkFtPWav56Oj89havMXBnmipzACTW/b5SkldlTVLSnVwlq0NNhycgU
-52bI1H46xz1nQSRi/oToeCepCzlqNZR7RDc9H86Fj23zBxaR+Z9QIuV/wceAVca740Fd0SHaydUVcvLX
-oHSMsWj3s/E8+D659j/MfbA7xRxWtTrMnWl6XvkQDpi/WXZef/2QzyofFVetviJpWflYnf8OnA7vwy7u
-hfflBdBNpfeFxIzel79n1ztAZIXfXqO3Svjxrd/hyyL1p094qAKNvu4XwUwNVRT5GM6fvgZ9v07LYMff
-ABLyoR32p8Vw6H8yoYkjR/NwfjoKNgmTBVeX7KiY9y+2MfT3sPo9PBL0sTJ7+lrd058uMgPz3k2rTnIn
-181dxq7/E4FVcEe3+qnm2fK608JfQU/wqPyFHpWvfx/vQ3oIqq/H1oQSKUhJO/mR43IljzuPgCqHkgdS
-hPgUQJjMccijcapKNMRGJJ996GcTUlkgYTrMcWddCleBQm+eyWlXnCJdS+krJtdG0S0VE9KVkAaIXZuu
-rq46KQ22U0zP1+WtK9flcVuDAUuDt+MhrI95FEilk05I1INqsSFgb5RUncMTN+BUfZSllMIMlcXZ7ArY
-HFKKoSiPzB31epnhwax1hYKyyz7YOkz2Dh8kz7cO9w7bCOSnvaMfDt4eJT9tvXmz9epob/cwOXiTbB+8
-2tk72jt4Bb9eJFuv/iv5+94rILWynEL7ipcbDhNVMTmTBKRS10MwcahEmdeHqY3P56heO0cp0lh4B8pc
-QM5w6AP1EKgEuJ8S6aoyr4WP8kuSB1X8NfmJvq3Lozm9byewilk6MhHhKB4tCYCG0l0n2TF23pgsWEyc
-+n0ScJvJIyigromFPkPzoxnmznDPrjeBMHM0f5dMrkYTN5hPjQyQaYRkgve9zpLKk6UxMU7AXOhblg5D
-72ZicGpqpGiWoeBxU3QPOB6d807S6OOjSlGBG1Zi2AjgGGxxk6AZAhmMb5s/TFkoSzMyOI6MbezUemz6
-25OEBy7IFk9CicZ40m039rbt3JNwsUQCCEtvS1Ss23iFZgRu28aa9PhfHahpRG+GHaRvripdca1b96YM
-UCte9WYSsxlgLE/K5yboypqNYoym+bGl+8i5DbJb9VKd072KReOy2yIk9Ofroq+LLMrt78v9pRfmTrba
-tZbaja3ZDJE1P45Du9dL9pnjvjS87FkNwxRxyhrrrDOfIFkTOStIGUzNY01vSADQ6liAh8xQO0BBz0XK
-7yz5W6G/We3l8Z6l6G2+E1qiiHe/F1byOltwgc2FEgMFv/+bBWSs6f4Whm0x67Sq1dlfPwfe+COFLFYL
-ttjcgau9Yd32dcx+wavgGz5Q0dsZ5uSpa0ilVlzjZJR7Y5Keq0Z+mekICL1deOO2bOHAb1Utv1UIDTlq
-e2KyDiuENl5IfPtkamlpTfPleTq+DFLUWSj/M8/mmaV1da6QVM0DNjSfIqMhIlpShAt/cpXmMzGro3aV
-uhkZl/JRX3dmFv+bejZJf23uPN2HzAw7m6JEveTwe/QeGk14MimKIcajImZrgsQ7znoWBr74zjyUDPPQ
-181K6iM7auZ04LTm79NZ5gbOU3GD3BoP3vDIdEDuzBFa+Chmmu6KgDH4TR3DTlDFut6/FgNEvIZyl0y2
-ldMMjRQxqxqa2pup4NYo9TP1z0DkCj6L3S7SLahaLwo21pO5OIWZB+rPf/ZAh5Nwz0t1ARa106rgZQuV
-lXts58YjbYddGQ0CkTSsy8/L1/bUNJUymkuRQIGXDbFYRw6htwg2M6Q5wWtJb09yyf1A9pG9bnJshAAn
-nqGszaw4LM7JT3s2TZH9RIkuXEkgRDlp40WeTdNp/+I6OQdiY5zkA+tYbloqopUiERfD9/rG+SNyt86+
-aRS6mBCarth08fCpgugyA2iib/e/dmQu2dFVQQKqJdWcfgXX9a5uETRK1nI1glEiuaZscclCFl6a+0iu
-+U4ABESCyhihu1gFp+jCcpaTxhvGe4YOEg4FG+tgRiaUUXA4lHxyfUrBjCJ9g5l2vTZ4ocnglfmOi0zc
-//1kjy4xaM8zDek55qFpYlX77E3bxbAmRNjqJg9vGFSSvJHj2cEkW2NtAyUzI+EaVGhLnlAOMugHyTHA
-jF1TaP7CZ55IZ6QRkBaFVaIHiJfIx75mmlan2bPMjunpYMLkStutPKXeQ8Mn6ZOsoifzU3gLkS8aiTs4
-+Sk6IzkDUPIrIhCbvDLGApYdTqJbWlrKAMb+DDQ0EvfyvRZjEjT2Qvu8HqVcJN5j8CYoI4Mpt+ceMtO7
-zj217SNk9rsVWa0UD1apjytyo1N8Sy6KK/fwnmMsZrUryfNrA0zsMiTYkeGMkajknJKIgi7VWUdPfe4v
-8BHgq2Q8BErnIkBVRqNsgCEQMEgFxuo7HSrRIO5q35es2eaVEFAcvZJj20ruIDJ8d2aPjklWuYXEnV3A
-xGzF9mQapM11lWRwiYFpoFK1bvUds312VL32yr37yG75MPz6igeLA3SvYuUliTfY/BihQN1jFBjyyOqg
-FUjdbD5SOrHaQCxrHVuCm/o9JH9ut4W7gaX+8k0MW3SjpFy87vKjZe4nYaGjwlCe++lE8tKmJqMu32vm
-mI0RUN1Ay+eS83aloUrte1Z4QIgJPxkcuE3vahmHV1dbySEcpmXKrAZSTeUgdj2yZGXSM/iwh/r3nmbD
-jkM4J8f54KTXoQx3g2w0KYR3WZTGg01ZEfEqqq9GJBn2l7ySMH4WZzdJPtkrxqQl77UCQN85oaRtckQS
-MxFMwgEgVtShz8ncimq8LCHtymjaFubHe0/ft8P75z9J8qoniWZtDTMU5xFrXfy/KF1dpt5S9ZIiJZel
-g+S8YJmuTm1fnVLQN9ULInqsPlTPz/QUThBwYiGJYC0MF5807Ky5pApKCQS71XSG5xWNrawsriKX/8NO
-rE3e4o4snlQ6nQN7YN05hYdjxXOqmffaTViy/LyqMsY6IMBBL1j3yGKbOOipWx5rC6SHQGD/BfCKC6X+
-uy89UgA1EHQGTRzbsk1YGJIe85JaRsFqx7VWyVoj2DiqJh/TXfbGLenWcGhWtYzmZ7IxpSrbh/Hr1BpW
-FmT5I1a7LlvMbRgmCmZrGA/M7046GIwLcS2J9Il3FO6Nj7LvjzOO+GOUnz1TPolnSiUeyh/hf2IlxKUz
-ADcnJi6slNJN/4xTYFGgMOA/UsFFFU2eJsMguChbuovPmJC0osuwntpoLeQy5ANxNwUmLR9l2vHBpCO2
-nmvSeZAp2q8WRnCrusr59X1/Ia03jW1J/bYs2ppge+zXIGtBMFRfh5iZ4fsqE5aglu1wnguCauH/Gqtt
-Z6ztY5rdMUmT8DUsQ/k+hRuwcZadUMOGLnuY9ITU3LXqCRZCWFFGBcU8vDEz/KhLxsOuXLLMW1CrSSgD
-EbVRpMSWmMpEt18uyKaitC7welUkd5Knm5eSBuutRmVGlYWMsORw3w4BjfYytdrwAlB6PRHPChi8eRgb
-rRAJYkbC0lnGefhGxdRGSJPhInqWRcX9zYcalFODuG+7eh3dgMSZoKqtEjwTqPOaMYAVDYRWGn10EKD7
-apcNU1NZ/Garm2w53a4s0hW6vtaukh+cx9v55FAMJ5i0gRtykbJFxXWGDnOZEmTDtYmF2VHDbsUSPMLb
-Wkow/CxDVEtdZeWM03Z1JKYmye/toXv3bjKfnseOWkQRarp8905xAVgeaS5oR0NZ6CniCSJvE4UnosTu
-bnBAnYqeGgq+aVd10d2NJ1Q/omzuPt74arUoPH/7bA/xx9tDmCNwl/AQtzMosKF6dMQdT6PViUnZTWQX
-X4GvwBmdiC8t1ICVzFDgj31ho2+1gMT7aDI3eb8EBiVikHzCFyrejuMyzN92gmr5nYLfnuep6UbNk0KG
-WY3+/XAZ1KP2UwpvFZKFQ+RvemHNnlZ9dBR+YZSi6Fces+HO/IXRtHKgzfcrHjsoJyE5beLyhkO0uTVc
-249+qHSP3yZrG04EVz3xNgQdL1OoXGUjPgmhgHufj/3cDVm43e2k9wWwd1Zm5+YVeZvCkbaUtcMC5uNY
-tVPpzfzI8zcfuYqBlYRiARavp69qJp2o0zXjKQ2UyWo5WdXLkU5xFa395IrLWT9ks7CrLmpgJaJOM6t3
-FbPkd2o1yAGDaLUDFCnUB/Jpkk4IUAKJ2eJoWJpbC3o9tkM60VyYnk475K78jHIqs0X0JLxI8yErtY1S
-nG8XbS2ujFwd/8KortynYNsr92jx3FeZeXze4axbLd8Uxz4Xr6ULZUpmeAhA0fJwK05QYq5ZoRxPMDQk
-M5KYgadSsiyUNfRgPtCI/4xwpgrHQ98iNJDFMU+aamzkOqec6/omOcLMY5KOnOeIIabLeR+P6NkcI7La
-pa4atdk3L75b/hzb/oa4jeDLcteLcv82mrswArobg1j9+NR3N9lHRhKj+FGYPzndqbYtNxfBUmkG+zV4
-PTnrqb4Q+gQGjM2nmQuf6NvAUvcHI2d4haRBIkQZtzCpNOjUVNwU8qemuCJlpnoWwS5utBByuFIWcCsU
-nMUBoPwsDvnYglKpEeREhJU9xB0Fd0ekHQYowf+Vi45G9UQZOp+eYK/qFCZLJrEESjtCcbTvxWbQ2oyO
-9579RN5Km3G87OuoKMWcMQ8yxlfAHQEJdXatMbWIMMSTUDA5NSDVShBttKMU0r0ae6578TR5FT3Jm+p4
-C2QUPwFqX4SXK7tYVTv/fri5xhaiqhb6DVBy5bz4mDkcwmoIeuUJ1ePllUFEqNhV4O0oQ7UY0HvhZVVk
-r3obOtrgTd/JN3IlnFWgIZUokC1eMaOb06aaTrTN1ycS2uwmJjtQXhy+md6BRNU12RVDbl5ZmfCHbnJ8
-4gsP97XYwT3heK4GPpHmJPC1VkW/3iwAHkFegTWU7aHW2Oj2Hbgp1ULf8aI5hl2wiIbVr0riYza+eWr1
-X9hJS2Iii9Go1ocxOHGdyPrFfIK8BY3cmjMatTWcnHk6rJwuO4Bc8kqkw6v0ugxCQcP80umAPDxIACdB
-kduAQNYA+NoZWZxKj4D8Zv3aBJqL7e1CZUY8WF9oqxlCcvH76uz0KuaWdQKlj1ANhCDvKnJR6k+LuJWA
-oBj32WOS5PXD/BKNdQGk+AShrlQ1RIxvwixwXP6ofqCyHC3rAbM9RBieWhYOEzN0ErFvcA0HF90iYCSU
-vd5I2iLSyy1KqgOLOitQyNopUZDM8vravTEUT42YMKLQMFfO9xAgigXzZ/rnq8dZVdQdFJnNqYTpDNXS
-zq0BYMWPXE9fiNfK5+BUSUwxNxEmsKM7do4F+SxPh/k/RKc5VfctdsE+heXoR99AMRldcAetCaqzkIkO
-WAtZ89Ls704OHAh6ezFZixVqRLaLoYokOV6pwvA4SeyqeS0DsXC8n6p4WIY1XnU4Oh5hskD4TLhLj0nP
-4+MStd2v71UzXqvgvdkVEglnZxklP/IFz4EPuNM4EIVapUX5X0VKVsF19aNvKXuIBSsbk4vSRlbOrOHO
-3FlxAVaD2joo6wI8F4/MUBSXwMBNtJbGRokx0rXMmSvFsQiv/lZojVCx9jT1Fc5kdxvKWzKo7TVit1Dj
-9RjaZGjEEIgBxXygE0pgzCqvyLxb5vk2vIbd+CWdSD0yCA5D7fqyG8Riq4mGAqy2WqOoWOeuCO5TrNRC
-IdGJWTeNKOswmmxf7GJGpDxmF0J7pg+TohTrB506y9oGGomBsYBALbr30kWsGWo1uP72r6B85BVY+aXQ
-yyNWpct0lf5iLVAU2Tx5mFba3s06tq7mtNRVX6Q2qmvjr9EdJMDVxVpB9Buuml2Jmotwu2sTrEPMhrim
-pb8aNZWqyU0D3BhZk5XFLtXztIL5jlmQW9jwLDeu+eazcc0fb1xDNlSfKLvKHS1uYKm9pBs8GhdEgBxA
-62MH4NJQhqYp8oWzKzS740yLA+Cw9nasdDwxohdKpjnNiLbta5sbJ6vheHTiomoiWXp+qxXBBX23NqqW
-LTNpX7xqjgy6pSfmx/AH3gjQwcb7gIEuMLVdXG++wDVXkiXavWTwfJE9VqGB3uGSEVI6syGifE5gtZG2
-KoEnbp8gx+kX8nJ3DETQhRf5nhfeaHRWC4n/duJxgstbmfiqd2nG4VpJfq1mgnFZP8VUEM5dRoXtcExq
-SBQU9lOMCePw3mVMNABaqHs2igfGXcfj4SySN+/d8lLWwKkJguNzStZFyTJL7ypFm5VWiNEq9eGjjvpv
-Wu+VW1Mn28tL+m+z0knL9ZIPljSCnlR1+PUyQ3Rp232nB2qo0a43+u+SDfjyaLM6XoYVDP872evKsC30
-ugqup48UIbtVeVYZHqInWQVdCB9icmZCpIgtOYwMG/33orLfu3UqZuRapbVHfhtl0uufmmBApkUbptbq
-AR3yvsBYOizKQfoOH6dxkZR9oEFJJfHQRFkasFC2Zz1o2wgC6JUcOIKzM3wg3qPkmwP6UMRiszEPyHhO
-wmVdYnDiMkNZx4zUORhgCKmzZJSNhHhR+l+x4/bumZlN/9SF57nt5Vrtat3hsa7BDCpm0o2AXXY7K+Yk
-5NDFzlt1lyLw6bK8FwZ5cgGzDwE7TrJB0+MoTqdZehnKGdbXE7eiqPgkwgqVGVeYRXSK8X2GnJhfxY0R
-HRYQp+X1uG+cw9wxrIz+OD9p6+2A3/qVr9p+uFWSGSwA7kGWHXC3RKIwOntQ678UWG56TizNlmfwYKOp
-0a7fJP5vDg3HcUliwU63XKBNCTpkfLVjJhkDyh+DXrA52wqkJekSZiooQXCJwsFXsAHB5KXk4yLp7202
-k4CwNbRYkxuaC8VPOkUGUvjcboytG+/DSGFsNn8LTGM1eNDHg3Q6WKdAO3AqMLkdzXJ2MS3m5xfW2LGB
-KVyHQ2E17E1cvDaV+GkGySxBRG5aVXyj5lZFMrbwE06RrZVLuOWEwSUJxhmmXlKWCybntaw0SfOn6LDF
-8Qbxm9zp3HLORuoMR0/02rVQry6KhooqYQFSFwUFLydfN35xgnootp6Sl90qe4X4bGuGlqB7sGCa8vr8
-NPwf8TREUTs8AiFOl6chImj2a0SkXSu8IdHCyBCqCfuCk2GY00C+7W446XRXO823vQHh6Z8Sbr3VzVmC
-qFZCdA6J63nbPPuIPVKkEFFk9cChjzVJSSrqfkRdlMkUTR5MJUJpKjir55yL0c1mkr0b04FOKbZeQuG1
-O8keh78eNzByNZtHpJf0Kpc2lYO1lSdTSuhkliMSVgFdm5KrqIXP7mWWTTBuZf9SMCJCk9m/5xh5MIkM
-479VkSQj6lyCcsMi0ouOY5IBsXM2ZoYH3IGp0nFMlWXLyhA/H1H4EzcI8mjjXo3SFv5Q06rDtNiR/9w5
-L7DfAPnF8PWt0HUttr6zBehiXGzM7uMLZmRkVa4v0IEv6aSlSCxtrm6XiJnl8HMVd7WEa77FTV6ICew1
-94wp68hiAlVx47A+HEAIzEenmGDgzIu1rO46XOxzUmtP2ZzqUXBuL9Kycra0tOn+/fqj5Mb/PX5Jhwnc
-lmGOYUe9kJhncwrgSep14ps5/DCT+q+tmWbV6NOE36b7I3LHrv2LRM1Ghtd1f/J3I0jrqr85IFzs4HXj
-n9v3KqGHu+GHSB37WlQq25JFrdwbU9/e1UFI3i52/Z9OIp9jfEpn9QjfYXNYqkpGuV02UVqq5aJtua2b
-ejXX4XLN15PPmq8/VPPl8lB+Is1XNOxqPLg+lP3uofVr0y4GypFKFe5UBYaoRHXoqEKn2Du0WUAoql8p
-gfTRW1ZoPmdbVjHt5lBhtgLCe0B2J+UDOh5ukGKQfZaPB6WKNIb5xSRAuEl5o58GU3FrRpOkYGvyPk6C
-BdDUczTUYMzGqd54JoB+oh5OtYzx6G9eEO70HF2Gev6z2mOiUHkHqSjN2+aR4vxwNsHKwwTJbBsJeU4S
-JLQD5BQGRXIKi4vENBuR2Za8SUTNGkuyh8k+mhh7Dj0NDJGNT3lZ34NATpOrKZv80447mTGbtUmg+DSf
-ltrF0wSQs7nJ/L12t4gfQMI2ijAYcErd+eQqnQ5KOQd3JGzZ3ssJ0Eg5dTEtrpJxdpVQGstmw/RN1soD
-Zm/EWBtJqUbLZxudAdozM0igTCv3tCOMSBJJh9gxGVQN5egCbFaugiyHuQ0XJjIPTi0MUFpPMcrcY2GY
-IvVV6NPNGGCmMxcDgzparKjFutssFvPYNsNUAgw4FcD8OdmZBFwaqG1aS5BJR7MzhEfBd1qd5GX6jxzT
-zcOp7pMCA107kGiPqTKS5CdhZYdFMTHSOwTnbhCH5+BECJyr1uRAcFcNuN8OgsJHEU+NqZ4S58qMnN+M
-rgpN00wyVyn9AFdm/RRfPzWOUUqhVgRlO0QtaZDqLphJb+Bu1yENKJoEBBVYt8GdEZs93/q9Evu62aok
-XvCyg9uO99PppfMJWYIwPM9P1tnZA2dOnHt8S8JPxXwmfjvseTU13jk2ARccikuOOUCzZ8FB+Cp2kkN8
-d9Iy6YWDDJe8R9IE6ZuhIYXAmFmEwD1//j28D5aOShnHqqeE4nLWYVaLTvHlys/HGGZ2x83zVgdgUdjT
-6iO8GoyqphlarvDiGqweor4VkN/d0d/dEWA+qIn/oE+kwoOcIzWkIlgUhVGVDAJBEOFxWaj1Muz9gof4
-47BE3SGJHsrodZfs4lropG1oFqE1L/hgJPgdF7dXQI2tzZpOd20OGNUtpfVvc/b+NvkP4Vs9Kwy9cSfc
-6Hpi7Chg6W8E3a5dVy7hQfG7jaDuEUZEbLjC1t9+JeOHR3q0xz0pRxS9MZsFDAPylujpgJQevadnGO8P
-/qBkLNAN2iSk0jwdcSKLh+x+iw9tyggNX+pzEg0R3Sr9WJIVRcqDayOH5sQnD4CMoK5IaD1Fse6sjKcb
-G3Jzih9IPjnE5kk+S+nLYWaE5yJeG+p8PR+UbKex9XqPciNnmCGfnEDg1xWTKQClDzw5erjNMNCgWHnY
-1aIrBlctkwDEbD8JiMGXIGt9IxAhmGWaQImK5yplzbfQH5okCWdTGvrLdSC0kSJwkAgy7L1KShXn7R1n
-7gpE8rbofnYXlrbvVQmFeNuwzG8Zu+bdJeWLpFhuih8vxQpEFvD5r3eJslgRfm08WkX49VnO9ZFyLqNY
-2h2/z6fFeGQeZFjZX8phTj59+bBLynX6/sDJwB6IxAiG9BaO/cE+HLD79/F9aEqOhqt8PAC+FjniBi7i
-WQ6opcGJzbisMyjgKMgbHnzqMMe/y2Y2rXvKIvyQDB2QJoJFucpo68WUOC0xNZ3VfRHiyWbC0EhisVn2
-AREKQvqpwHe+k/yQDSdlkqJ9W9LPp304nVPP3pxTOLJwgk4IxUVJS4R6KvTzFVA3mAgesAxlPcdIEIjE
-KVk+98S0hASgugaW/z2mgRr3h/MBE9ajNCd5S4+M07lRz43kWmkKIptn4kPYPem6P9uugMECEpGN4t/h
-RqkGdM1drHw2obL7DnsHGy/bF2baRa85U6TSaivgP+bZFd7obghSmpVwENBMkhQbe2MebDe572qvrycv
-aNGvJOFazoYj8JqO6HD0L9Lxub0cZ3OUdHTqHFoi64qIsv0rIaaNz4jpt0dML8QwbRvTBJQ5W5pwsJ9b
-B3d9jaHfBttDtHvzpOCqwMnaSySA/HqcKrzDRUbEDtTyEaCRbcQm49kWhY8rpn7DeB3t2kLbewG4B/ax
-TyMsOSeSIiQZvySEtPoMis0hJP/6mN0xHjJ2MskV0mlesgjHtBGaifDYGWpEjfTbSmbJCtiEj8LNIheY
-K7KwKDMjFRI7vCHSnqi7pJx20NMpnVyEcVoA5s2RXNVjxo7xo7RPzMaakwEXuQOYHQlDObMmUwOmEeDI
-+tDpNJsMU66jV8BkOO+7E9OW0OcICo8Fdm47BfSNCgH6KCBHWTUNbpjUAq+Mz7LWHdUmVmWeBW9V5x3d
-tmcEYdN+LFE3i4cEvc3xi5wZ9hbnOmfSg1SzxkF8IJt1A3BBOto0Cusm+z0smdkEeQphDyZz37vcqNzF
-jpS+is83DiTiKYsMeYPMRxq8p2bWFfsnV9R5zwabzvqqUuU4fo2arXh+H46DkwnLgVJJCnkyP+WJWOcv
-jn1MATvgVHHSI4ZRObVkKisLJt9XXaqddJbWLFV1c2vWSVcxK2VFVSU7rHg/jUVtcMSCWi+NC7BrY0z/
-bEUgOrwfHmTvqNoqFqypboGy5ABNEKlHNkHkP5/qIcnH0PrQDfOY/uQQGaYX+bbEDFEtHBwS9KZ65i3G
-ml5PGmpGdTY2saPk6TNpRj+XDdDChLrBYN06cenqw6Z4O0dpPuQVTr5NNsh/heAk3cSSbwwhikHszhAw
-HnfbQQ4iFEbP4M09zHt2Tz2hSPjhT7gBR0UtUmrFKK66ykx1VV7g7sYGMZn6/YZvxGHWPLndx4+/Iurt
-8Wfq7ben3n442n8JD6fxTN+W4HEeX3maz67yMmPWsoZyUzB8wkoVWLuKODekrSsiNSzdt//28Ojd28Pd
-d1tHR2/2nr892oXGqhslJ61W3fRBvH5z8Hr3zdF/LYdgajKAH7YO3z0/OHi5u/Xq3Y9bL9/WD6FS0wE4
-3EN184sXu9uwdYva64qu+au3+7tv9rZX6N+r6QC8PjjcO9r7cTeEBDijHla8kQN68OPum5cHWzu7O+EC
-LQRb10z2/CItD3/8nn05Y4ejYxlMZ+ARuP08S6y0wi/ZZLM0gA91WGYeNJX07v5XjOnwIkuRPV1awegp
-Gxez2aS7vn51ddW5+qpTTM/Xj96sQ88bG+tnXPXfnqdl3j8kESj8dAk9Nzob4lPoJPK06LEbbE0Rt8mC
-cWsGl/V0PoM7/CY73/0wUVHhUP3dQZsQHuT6fzcHQAj9Ew3eWmvH6do/3p3g//48eNf5ee3k4b8jtUSm
-sa9d6naTIsJl3DWOLqqWFK0b/UM2UZ745sv2RTots0pBWf49u/Y/spGg+oIs1Qv4eUgyiG4MT/yzenFV
-4yPAuSUwEyi7iTU3Vf3RobNDtxbufFbgU4kRPXSr9XUqegEnkiQgk2J4Dcgb3sj1MTKTw/wfHPNuy9Ta
-zz/k40rbhR2/HqbXtRX62XD4Gh+c8bkeGH4+nKT98DNsyyFuS92qEJOfDboR1Fq76MRF7+3UA4WZHhWk
-UqCqpCCG64g3VdJEoo4UTZLGGZ6RdEpKEHydOtDKnvpNA41VJcX0klSleHMsIGhYkPQPc3QxM1sm2QfS
-H+3tftMBEmMM3GVO7LQdHpRYt4NSckfh8JAVKQuTz0sSx+O4yKjEjCtxO2q75GxuASgKNmaeVxikX3tQ
-QPd2SH5AUSPnJBWNZMWANaCpA80MHXTcXnDoQMGF38UuUGR/ZS8LdIKuvXLxV8M2hRPn3WXh3CKfdoFC
-wgw8laIPs/1sPF9wQKHS1B/kCie0KKaD0utrWpTlAZBiuTfgAfGOrIEWCWfvqZi5rX/bQ3RVkqFHOe1z
-vEpskh3luOB1Qx5gQJPaCwyknTeCvMR1GdwO7w2KqzGGMe0ufLul7jQ9Pw/XHnAlRmXQn1AaVj8pLN0S
-5L2ozq4BvKjSPmVwWVznVfGj+KfULiVWY6nRAlBTuBzPC7aVr6t0kaUDEta71bgglceCJvlgcNvX6gJA
-en1Ms7Pw98vUx+AXs9EQjqX/aTbZBZr7vf6Y9/1nNY/hdLEUSE+zoa47DPpELXb9zNFw7la3EZ5oDX2U
-jvOzbFEPI9jTfPyD7IBuid9/ygezC//zh+AnM/4LOsgGebqk+PtpMZ94cOXQqi8+MjGOabdanBG8J7d7
-fseE7V234+UXBV6g8YLCWT6ajzRIMc7wPqH49qIY0j1SnwsM0e59mWaMmdynaTrIi8p6onHFwXh4favJ
-T/2TawJR1U4Ono4FuAif4zu+ftA0fP1KePJPC+8sUtSOyof64ZbwTg2HARFXZukI6YLbIRvWJtzybJUX
-aTBeIGjvuELYNDpmKabVWwpkAoTtNhKp3qim/eDnTtGvxXdQvJAGJuFct8qbm9LMO7YkRdIfgK6hbGD+
-N36V1Jd8FjQLnl+M8pR6PZHcvn77tHSBG1wxZqyb59WoGNRchpANfFWM18olrKDwM9vpBEg7ZHxY70Wc
-0xTFV2xdxSRxRjKv/eIUJV2H6Rmwqfh8G0CX2fVpgX1d5CYtcQDc491cHwFzls+yEQ63TX8d4mXjPykm
-FY5Hdbqfw21DCtAMspMcZlkivH4JrNEoJV5/UPTLdQyNCW8xP67STf1a2+5vd2nNUBfyVlhpb0eUfdno
-TXZmZladEhKvV5iW+HQ+M+3JohQuVj8/yzMri8T//+mHraOfvqcyJ3XBRTFNcW1KWBxciQ5W61wBJ3N1
-TsvE7196nq2PzDBoyf7N/lwbFKM12FI710VcpMxt4VI45qpUJw1X4gCevO+n6eQC5wdPN+pyz+VkmVbB
-4dnbJS234vM4rKFwIAAWuf7T7CJ9n8spmo8ZzTJ5XR3oPdKekQDNcrVkRt91drJaetLg32t9/tBoh0xe
-g/5uBLRhA2bcqBCHJLVawxfyfUMNxEic/HEEl62BH/r2QyMmFuE68rMRuZpSgX6pcpGAUCmtaSOUfVAR
-UBzXDbs5vf+fvTf/b+O49kR/51/R8owvABkERcl2YtByLiVSCW+s5YmyfTOyhmgATbIjAI2gAVK0xPe3
-vzpb1anqaiykZGfeSJ/ERHfXvpw6dZbvMTeWAqQeGK0UO5WOMrJnhG9ATnttvNgbTuVtSTEk+T3IIubo
-kuSWcUWcB6P5zQ6CZdGyhcV6Wm5Lxf69qSGvGyHv3oCfwFI3qtxPAx/O4KFRPeAa+IAimYZ/sjXMD7Mh
-G/6BBm9hlWwJ7GJF7RMVMlqdj5bxd7/7rl0nwe/u7t5D1c6Dz+6yf5C+58d88jYjlaIAxW5spYPmbjDh
-gsipNDf+t80wZFnQ/5L5eVFgA2FpGII3LyYN0kHB6m1ggw2brN/lY3Nq6Bd0u9ZvcON4LwBYXL8oF/1x
-Lm9wO9iFeGJoplnlZKMOQ9lEY4zbBSIjew44S8oOi1Gh6OQh2Y+AUZ5OgtycTkB7ucHRHni+kzTRZSFH
-ldisneToNLkqFtaSGybZBiJTGduYypTZNyfTFUgaf23MvVyuOVDHhdkkCTqrRaAwbtvRVsuDIOXJ+FmS
-6bmom6jbwIVU2mYb/h//4X0sJo/JhnHFBPlDlwqcC4Si4QLI3MubLDtLevx1pg2mbMUcbdTJVuD6wjPw
-2M3z7zk/vLxuO0PVLSQFW4YxMl12iiKbTPKvPWG2MJUb2rPW9tpw8lZhvIhtblaBPlRGdif0Uh00VFeT
-fbrArABuZwQbBOdiV0zW2QYm2P4dODCyci7FdNhtFROoht+o5fVNx5V7s6araaprPCfxxv2FXXcjbEHS
-wy721EUCbiR8jxjRBcUwmRihrDwvFqMhGrMvyO8HWY5iMQesLIH0w+MZzLCDQsgWc5JxwEOyWg95BcFQ
-B02k8PtTOeq71iyKxQ2WwcMxISQGCudp6/LDwrC/PSZ/Lanf+EGYkgqD8JqGHFiYStpgsUe/ivQw/lW0
-KrqVldAm9O96K/xsMQJU1sY/aK8j7i4gPvAUQ3UYb5Wmw1yoR0Pra+xFpcNSetKpHq/kGSNnoNvbLEP+
-NUXRKN1FsUA8SYKSCOgRauMV1M8wsJzpNRKs3pBQVHGwe53kOagTwRyoHZYEetosR22jah5owmSMexYd
-XkcGbvMPq0L+RCvn/4TVwGPwf8B64IP9k64IydMNrhwdWCFbMsyBWbGcAZjlsSyYazr/QDOriB3nEKPg
-u9fWdthC65HtMWTlnqDfJH3GSSpmSK5d9FZD3n8OSKDifDgiWYzPdcuqhrWVARKk1lghtVbaldQRk+yP
-M3bCfMBdclHWD6Lmpag0/S82ro8rJGLpyKpzuDK2VcZ0yejqA32d8eX0H3eEHao0MzMCCe0NqqVvtYvz
-ud1Vn3h91nGCPHgOtvWjzVkdA7diumTM9uoFYCEvtIE7biAS6e7+6d5aoHK7X39UKdnXn6Vk60vJCjOz
-5Ew0S6dserdMGvaIDLIwy+E4ByFxRC4WSfW7I8etLY2z62qWjYsLD86D7rn8nq4weF+ojhpfGebm+REB
-WuH3rl3XOrRLW3iXR2mZaQ7vBhIBvI0B0OZiArgTLcObPGWMqDG9Q2B90y7Ga2j4l+kwv4rSfAQOfZZI
-M3NFGGhw1QaYYpPCXMSAJrt0p+k8HZFvMgI0lVIeLkvwoZCTElY+h1EGpcdialrQTnrgOtdDcDdZ7VAf
-6SJg+NG1zvn7mFse4vhzw28eKmWiYkg1InPcCWeXsXFf0soqqWHUHxosQ19i4VEmKgRUAO1Tt32qdXND
-vXXF74LV1Vb921IMKHnRjGxHV+Kg1WYIkICYFdjBZfeYMdwIW/YSWW1EjZpk6QxivqNzpykS4cHQxNPZ
-l6NCkcoiayKL+oUI2ZDWuX5OsgxivKATt6zGX8y14KcJ7oTuEo+3SJ/coRyBd1kyEkQtKgG+qgdtdYFt
-etbGyGx39/69m6BdrHNOf/PZUenTH8lk8kEWH49Nl94qOJObKLE2g4PFLNl4Or96Iseil8P7ZDNsGLmO
-l4hv3GIh5U4BXofEzuDPjTQvGcBICOIQ4MoVk20M/Zxi0CbEI7SW8gSMNM7SSYlBRs+QZ6AiHNbqsLDV
-Ed4Q2MunM5hFKI4QxMya6C8gOv9FMbpAHHyAiwCfXcDYh8EIyhUof+UG7i1oCM6fE5QHB7omM32qGLNC
-hf2r2qo82CRTW28OeNFok9VLmtR3HEwcyAJ96c0S5+FpObHnspUmoB044iz3JAVC7JIncX28gHYvM3Oo
-D+my1vNXRK/j5w892m05NIivLEqq9aYnjgQ2MY/w0uZgMUcHifmf2b09/33YGIlFTt79dGw9w9/W+V/Q
-CULBwL6CDGOwyiDyeSm5AJjzfYB7lr2DIAwU/Ll0Ltvea3WZXcIB+D2MvxWEtET31DsaNwhP2FJS8Zks
-/oe6ZFYIyEUWK6BkgKvC2oJigktcyx39L+AprElQ6JNcHxN42Wq3Bh41iFI+1evev0fn4bc3PQ8/X1HX
-Pw89P2t22HV2ddOsMKxiZ1z8Zhg9Mj78f/9ZAPddzE5Pd7Ly2+3hLD2dk4FSmZnD0isvPDEd9B8BWdDq
-a/NAlA7lUZY46xV5wTrQZNgjDJzs9UD2xoBUsHy5STA6vIAcMarjFm8muCRTGVyrjaThR9u2yZTTo58C
-8Q5swKWJISxoiEtgAu7xe3ManaEtowu8ZL86hAEp45gWyUOX67VN/cZBkeqk/qiR01I+ceJGWz6eHrb7
-rogWQzeYpWBvDe4mYG052dceGIsZ8BTv8ozABWcEPAOLXMoZmB0q04LziFqDS9SlzpSxJG4MhJe5ZGRk
-q2PFcsiXDoHG0TvOrOVtii5ZqiuONLG8GvfBVrIsCH3rEr5KUX3ERJ6Up9lsxjcbNX9vM/SRgyHyQR/8
-SacLBaRqQxYv8tS8eG1evYGYr+Y7/o5SVBXqFqUgFepKi1tBUv3ps+XbH0QwFQRF7aVgk1Dnx3jJSKZQ
-LODxH8N+MP3hmDFgBOWj6k8L6FwO4colFg5BhwpNSfYTsYI1mxXhSG0FaKyalujfasUABOyLsgOHCZ3x
-9hYsz56toMfQpWYDTyxaKsKEtg3zZ6apRzHUQZZFBgRZQlhc+bzMRqdtvBikIDsn7FmkBOnETDPIuihE
-HEkb2uLzaiYayhlv2bgEIB/DecvNCFxOcCaV0YFhYfe5wS+o5yrwMtCiJ6CcLJ/Y3Q2Z/s6IYdAJQbfH
-dx1pKpTFRFvTafls8kbST4upiM/oK5IMSdJOgubs+foFSoVnFqk63uvvcBhioWGnnBICT7nLon440t12
-kt7/w4eBm3HD7qvcqtvnhlKs6rj5/4N/k85jU241ALYENwin5o6wzhiY/39t/v/Nv9FY2Cbdeky8ktzY
-iFPRS3N/T0t/ZKSa+vG4uYkhD4/8MAQUy2YDwlezKyS7gDeK7dLU0vxAgw4g6IQKqCIxAFMaM+mrqa9l
-g3hYWmxOOsZNtpfV6qemF4u0dkkYVpe+QVuP89+sJia2KBbluRtyf5YODp/s//Tjq5MXz5//eHJ89L8A
-pmb33l7l2+FL86FC+d1Zu784I5CGHrA/jx1EdI/1CjSqKYnEYD6SlLLAdKDBCwLD8eHP5xnCgKGMfXTV
-orMN8TDwSJO7Anwmgxg4oM2HoAVQHNhvAj4j8ToAgcihNnoyfj1rMEpY42iWY4NEpAzT7SrsuSnrJYVb
-Q2XSLKbEOLVC/MMn1j4gaGKif5MrEnFWi5LUYGgC34mXxewH4cjkv+EQ0zt3Zms4Mb0Rg3a0OaPbl8+y
-S9maQVpYR/LVW28m6es33ldz8yPeznzi1n74ECwv2S13bK5wZVc+AKJSuHxl89jEss0fVgjSnrscSOo9
-uy98nFNogB7CrvcEpKWyNbrVV5Cuwi10q68wXfV47cZeQtrqKdSNvIsLl1Q/N1DirNa4/PnzLeoPukWh
-gk3w4ZKzUdEHpfbJycvD/cevTg4Of35l9srxyV9/fP5o/8eTvz1//veTk2UaGD98ZjzAHn4V2wUynDrP
-R0NzcEZsLOSTn97KxiMZ5Jufo4pB7N4HZRNIeaxk/OKnJmHM88tJ1EJEf/byMbR6JAt/iaVmPIcQ6zia
-xMtPCPFhFvM2TEUAjvWDGybx85Ml6ZEAwcXyB0m8/H4IlNhsBSm83GjaEcmD772UL7LZaczLzbz2063r
-EmdzgC5oMshH0bXgPnp5joEMzV6iIQUwK9WMQYrNAbMBbZnNRwKTIvdB0gJzhXvOT2lfY+3RuWQEwKY0
-z4shIDYf/OgHGNhT6Q3RRhoZTc8fOf3ILMG64tU305wNIwUua7ndYGEXkqUdCLPZniTL+qFyeR1iAy22
-A3/olnVnbJgVAChs4KtGG/whIZH55XZDh961tMUb8y1CbsX9YwzQEx4h7phXFmYJjCzC7/yaXdTRJCVI
-gS8ZZAaBXuzq2mJrcUtgugHBZ1/1rqVY8OKFc1rxNyd8ZKBpwwH6AYeF3yCz+J84HnEkjB0eVx0/YDEw
-iF6+PWk5TS8eK1119HTU+3a4xLr+YztYFF3vqR2utK7/6L6zY4/t6RiepYM7O8ljq0iAuK/FtJOAGZG+
-SEgkeAKtmwH2OxbCeAnMD4/JqEcGQJj+/cmQaFY4mbQGl6RbUcyjK4CHWK8oSEtcr6V0Xf3QRrZ+guhF
-9Ne9eVUco4a9GyXUHT+Rnw2uoRDHbDFdmdklhSIWZMRl278/pyar3saT4FIvkWDYZeORRP8jJAf3E+Zp
-uh7v01FfxMbtb3K5/a/j/07KKTh8tFnHQziIOYnGzV17jtYcIA6CZXJyQqm7fF7hzQLMLif/lFhTM9Od
-fGwFKsPsYl6AsojY0eQcqvZhENlCoQMF7VNkGAxJnPUXZ2cYgeacceYxM4QlwerAU5PeAx5JBw+GrURi
-sSxne+PBdNbKykcjWhc0ZD822HV2nZxN2rSanexWGVAiqQGT1I0yV5T0qaLOTx1RdpxKN+RrKIHHA3bj
-3CPQA7Il3vD0vR2kNMkFlmMKq4hH82KKsyLxbrLRaYtMgsQ2+DJrUByhx+ezApzWrJiHAhzxWh1j1EIK
-EWv9PgWOUYoD3w1oH8imUAgCDpsdpzpOL3KMDganzWz/DLctqJefnzYbVL1ZND8k27u+InS95fvQX75a
-PwoE1NzsO7h/PIe2A+6B8zZNDrKLVwXh3oAPWh8hVGAgslExxYkyd3ZD5TKz2rqhC5kYNJz2DauyM4MC
-t2UMY65joaIcioZtzEDMcBC/5rRmfMvzfMxgVMk+hPo1VA//tr2Xzm4AbcbqPnqMTPUzz0zdZ8smGYYF
-QoJAhCR+FmmcSg2AzfKZefi32ZWNZktnjEpfTkf5vPareR4zeBePS2rHhUsnFiGo8nSWZb+Rhv/NXqCG
-zyloRJ58X5kEaz2Rh3EZ7oRJX+dvYgsvq3hSPp8gCsIYzBcOj7/Bqd3BftjqwXqQliQqKkHee5HmI8Jc
-WmPdXaYzsLTbFrDm2AKsiQnB0Uix9g6rhs34NO51dh90HjRisjNMu2nUPy0w6e7e+/NSKJ52bbgGX5LS
-3b1/v+0LQMyrB+2qBMW8/rodij/My+/aUQFHd/fBvbaWLpgXu+1a2UF392vXjopkwHz9ph0KRczLP4cv
-ncjDfHUtC2UF3d1v/tT2hQLd3W9d8/D6b964Aav6eIX3ePPeDVt4TTcfsSx9ye7ep2ly1+nu/QdRkeh3
-n43QfyfpJ7sX2HW53D1sXVlKxZfML5+v29bE+CVepUqJxkU+NuJdA4QOh8zaBEdjIIF5MW2Ja7QltnbD
-dsiD/Ml/mkanI/4NxwfSVXyWIEr2zhR6lXCtqttI0lpLnC7rh8NapoabBfbC/c8BSX/nvaBdbT69M0ag
-PPjbol+rOoBAt9UcLynY+FVtNkngC9VVJ2VPVqTqYRopQQJnPs2g40EXg4+bC2zzEis+tsaYvvla8NWz
-YluMxyktrF7djPYYNgftOUG+wSsi2U5eFdPtEXDy4lCCQQJLKxBCB8txUc7Z5N13FSgJpGKLPP9Bb46q
-+mIwWIiRGAZZRdWkuVMgUinRPLMJDTko835u+NorNqVLEjVT4nsmfi/6Tk9OmFgBW7yK3Sk4nyDIPZdH
-PWczZ8bU4CZgW8FLhXTq2Ooy0uyOG65fgO3k8B7YiGG2PVwY1nwAQjMJHF6ABR9qJ4CZlhGD6XxbHbE+
-Ws7avXtJ4WErNT8pZpeAi4vmfjIZXGHTht81q6oY5Cl5x8rMwl6WCeWKcVrzeQtBM/3t1rPjbRoEBJ8C
-sZdv2a+ntAF1L5lOQXmFeHOg7UXEOcQuOLBoDGqkKtD0mNkism6kuQOXIYq5zhaRYxRkcc1fuEjlX7Qx
-Gvu/FnARhQ3hfKJgqo4OSjGeABtLCvQJz15MzromYutUVHSMx+F17rlhBi8MIYCa+ZbCjk+8Bq9Ma8ay
-977aVv++gp7gMfUBr4+GH4B/H+zrmtSJS8b/1OuL8HVtlW7PqSrNLxtWeHlLkrp/Ou1XNe2ty/LVBwo8
-jc36sF5m9+0De3vhb9fsD0uafVHf7A+69A82szcUXOqyIdCF6fcd8/8fPvgL7kOsMHxJ88Td+lD53AkH
-yT5+5bf1A2pRODj9h5qFUFvYh1fp1LbE/E93U1omOArLWvY9TrWeLfM/8rwncnP7bibm7mzOGIgr/mHZ
-BMQKC/7VzebKpbHeEkz+d6yiNXeO+/jhEBxId37M0otM7ZyvlmWWCr+q2Tl6kW+w4T8k6ZROR+AroIVE
-W7wy3etKf5a9jjRsK4Ag4s5q1sLcFwz15zH4K1A3w3K/WMymhTlWaUXzCBwjvSaWF5m5ERzJVz+Kwyaa
-yr2/FgbuaTHJzVYyH44xdoOg356mozLbY/WtaYDhuKxX/WNEp5ihoA11JBB3iA/1Uf42s1i2ibi/oj8Q
-8E1Qwtz5EbN/7GVGR7s51VIob1RcZgwsYY66SdITMPdeq52ACBKtJefqPSMZEBiBZW2gLG4XRcViPAMX
-TH+M3gHiNY0D+dTMPRk4IIRIMX00WgBGed/8QQBr80qQjBoEiWRfgyMjgpznDIENL13I0sPJEL66F+bi
-HEl2TAEddEL0J44k/WlKgVN02gW+c4lVlKoGx6wyY7ZwCaZX+GV6ZV8tsPqFrfGgWJjpku4N+yOvhwez
-FHDDIVCTfkW9hbeqm/QBY67wp3k28z6+y+fyzfzUn5A08LcR/NYfgY/hb8WFX6SMJ3zzBvIAYyCY98VU
-Xgm4uwN2Ny+PALTJvETwJnn59+wKtA7m9dvsCjQo6sOLGcY7gS9T+Kk+/TSl9wtb5dPCLFouagy/dWH4
-8WmB/caPAGfhfXyOTcNvhWsdfaIxoW9qUPAjNgQ/uaa8SEtcTVP4Ky+JMABcO/6wryXcu90MNgC8vyte
-ofchjeBcftuPYJXwGASgUAP6zg/wyUtASwm/qrWEn3hs8JseG+cMLV+9qf/lPMMaL+Fvg5S9HHDfXAom
-YJxiCBAQrVOz1OclMu8JH/HWo4pos7OSVkpba6U8V5Tz6MCsABDAnyBRVe8b5qAj/UjzaTo/78wMF16M
-m60WR2C+72EjnWVzS9AN8T1gMthEjfs+u3+j8hoiCrSTHn/A4ANpcg43Yw4Th9dBDiWI5uM931+xRyWR
-XGYEYhnSmKzwbSXlAVfbrg6C9XvkJK8rKd6gNCF+9Hz1FWk6qofb6/ry3vDBR4oRlgtuVoKGzV0it1CS
-CIKNUNdaulXZO14HDk/Ey3iXwsXBCjnqsYfM7pF2NRvjK7N22knDbEMiycnYusaDjNOJCumEhIijeOtP
-ky9mJG5KSdsNophmvBz0BaCqrFgO4T5QKsvrvK691h21+f66XSPSapMu24qZSb2CRv98m04Hb7OJE/lW
-5S0KEMOG+I0EiA1hJaoFcUoKEkRFxWqzkt7qR6c5rH6DAKGk6nnF8A1OnVg74+deBk5vdX+12aq1W7s9
-/VJhI/l4IscZ0D2O5FnM2A3zis22hhYIslSYrdkEoWo9wT8PeR+s8tPJtaRJXgGItSEmK8uBuTDjdjjh
-UJN27DmVhsnYYDSCeVovU8c1xFZfO4JW52H7Xu0ziHUqvc3LamcDjcadO7JyNukz2JRs0lvbEHMUbdll
-F3TzFwGEIckh4aIleOgqkCC0Q+GTSlyC1TJ5koNM9F1y8efOvd2kiRYqhSEcAE5Pjr4tQyTP834+R5DX
-GcKNSjgjtKSigpB+AwffKyaWZepJQyDMbTHO7O0CIO8J6Ui3j0pikB7CIyqvxtN5MYZ4VDPOmSPQPmj/
-EQONijG1ofcXwhQUgJPGwXfuIq5CClYtyE2g6DSdU3tNXwHhvYlu23QUyJjBMjlDPKIWqWygKHegOHEh
-5PX7jPAFCOhHJjzJ38zN6gKE09R0KovOAdYX9VT2+KS1SaqYz0kAas6NgRmEkhs2AEkjhjNWJYkIOx0V
-YPQGrFT+/Jhly+3kn4tyDsG8UMmFGho7ZLF+zotYs1Aw3ob5BU+ouZg+SbugHYRhh6byptWyIPRCg3Zr
-ZCDexqWLqwZBl6jdEI4OIU76o+JsJ50NzvOLrNy5f2/33s6973bwinSChZ0Ms5GNvsZl7o/KwjBmeBPo
-7fTkpkA/4QbQE0gsvitzr8kCDKbm6LAtYdHukmjbpbTRzyJ0WPCY9PGPMa4p0LUTQCfNrHPWQQxt6Euv
-VQPNxDGShQul4y2RRxYCmB6R+Fk2wcwSO6oPHNCU+tRvWzteifYhZrbNHHHRpA7fMS8tt2cSr2KlXb5h
-NgVF92SQO1Q1X2/XYYIctv5A5XwdfhQLps2R2yCXb/fUTsBRUTfU2Twl3yejwPTJ69VVkPN1/kajQ93R
-Zk/mzFGjGLD+TVdkCyOAuJSv3ac3LVVeKwTV162KQV3h9c3Hw0ev5EDl2OT7XctPutmBX4f1Kf/ijWsH
-qbgl4WteZt5bZdXl41VXeocU9t+ni6o5N+2n3weQLTJbAAAfSPoHhGDq+bHTAUzyCQUHp8tZQcPNZXuH
-CP/O3JxB5Q4V5mJm/oHDevD8KUpsjp38ZbOh3QoGeZ0dRpVZI2P6F1+FIhfCyGW3XoceQu3KEaNmVoas
-vOlQxVbhJ53yzTqwSVN+OXp28PyXk7/tPzv48fAjrw4Uk4bRSlZlAkH6WguKha9/xHrCjlVmQ4f5dP9W
-rqZP3VgY0EpbrZ5io6YuPWaw+/mkesZg1FnAvUt6nKaH/DQ9FYu5vXYRE0gM6qa0OeSv7/15597XO2L5
-Mzk7MWzl70yolyyTfPJ7LJSNWhtdJzJFNzlPtrzpM4fyOH0LxkzmWIblh2uAwg0H4k6UdkiU4nRwjo5O
-g0xTBMUtRjsCYlwgDHvrZsK5quZSKz5Q/S3hZ/0N8PEmzVXhz0bQMs0/+wmrk6amTE1YDTMejM51INCq
-BFJI1oukIC8C0dXHGLcNkPYVIKSSXlXI7e/cqSipv3WvrEyO6kKOmUUGzB7D3pxlJZgCsqQD5HcD2IyS
-BEPvMAhmygIGbRGB5sZgyEiQf866DY3TZvnZGWEEQCBpMx5aCPHs+avDbnKsOPXSl3NsIHdRPHvArRNQ
-M2rxlFmDs3WISFXxjltjD+HfmE2vzPidmy0TGNB2+IvKWd5ASN8ZUxtUMU0u2e7pesMNt43d9sVBQkEE
-WwJSIO2yG9gzd+oStglz1BdckEF2tZCadFiG0l1V8qlvmNZKZiJp1TdMC8zAPKtN7n9WOfZHI6vjq8ml
-k4Bb1CqPAT27q5Cs/Qrh9bftGpNw8+1PKzynKmo1cCZC16XQ0ru7+x1mq5hod+/f/w59GXY/g2n/Tr4M
-6F/lnLVu4sfwckNQF/IQGhkWLJvEMY2Cjy6CEy5gMNX2XfBC4NqaZFIOg1KgodKygurTaWeC2DCKd3OZ
-nGejqWhHLMgGamhmCZpKoTEZ6BfZnH8g+B/J0Rwj2UKIPFgwi5xU0lDSNCWo2xz0DuaMJXe9xWieEyoN
-3negNIz9gJIqOHaLGcPnoE/BBKLLgYOtr0QPu/Iw0I6T/d8c4l8kX5D2CCPQf+FAfzmkE7gXjHJQ1bo+
-0QIdpKIYsS4YB8+f2uHtgVG6KprsRkhzADnGiAQR0y78xQZsyAzRHGJfwE2qTJ7hC2oKALaUYcgGm3Wf
-Z4PTWvsayeBsD0Rd6hacQ4ax9CtsSZtgvFP8ypqFd3OtSxi4TRHshEphrUAOP0kRIcIW4Tsa23kIrhiQ
-y7tc2FaAPoMzvZ6QukDSmKsX+s+J+51t9ZhDjsGwZkPCq3BGSikybFeNWaZL4vBknWoLjhw4a+3Gpo61
-CUfetdBvuXTlSOGx+rcNy0xLxghjS9RA3HHCrhN3S06KKSK76qUEI79szU5n2YUliC/MQ14sSoiKZcF5
-hvHyYsv/3fzZ2ltAh+e0O/+VW6XXesn6eTwt3LsweqfaU+FgrLGvyJQ0sqVwrvRo0a0k1mn6onccvgh2
-HUUOcDEDyFKX8Uwv0yv26hq8RUVuSfHoAIY8x6AAFIMMqGIxsyEEoH/gv2U6bLZnp5NjjAA4wn/Lp7h+
-bBZyzYITl6LowZcxCLa27Pa4kM0B2+75wfMuoG5AuD0giSACI6vHYduC84MloF5QrKfma5VpixQHTTJn
-3uAtWEan+aigfkUKV2H3wEd9GZmqzgRvTLzpeNkh5ItuqSNElSDT164BZksr2id0TxccgCzoTysJoB+D
-wompoWrbVoBe1SNsOqKffYIpOR2GmStHZ+yc8DJUmGl61F1+3SG/LuhvPfPSVM1o6zK9EQgYN3OvGmT5
-ReZKUcIe23ivuPhBZ7MpQl3tiR4eOwehdgS6aVNFZGOq9SEcVFM3GSZfi6vkF59wTC/5VFOHEJiKoR9l
-Pm+U8SPM9mytY0x1QI+jfm22wtojWKk8duSZTnJMRHeMIYEguJAEbGaQoTWXJlFselsuvnW9uanbcQQO
-Jf/uNEOysHrLLlux9XNuB6kCoQP/tbjsrvIYI0Cll2i0U+EE2OLIWRSKRe4ADPHMyWZTUmmMxKXRB9CO
-d6JXVR3fILXfkHeonrs8cNWDN6xJhr/Cf9YlFBmW+op3Lj+1T81WzquX3Qu2uQRhIbjmWFlJHXpJeCft
-3t/dbS+9bXbv30eZyZJrZPf+19+gwOP+Z2znP1wKgpuxRsbhY5crGYH64OEmPJmlZzXYxfLJRcycpYCO
-BLK+uDwkkkAqY3gmPz2/dBVE4qmohncq3/eWxCPxMlZTOMGIHrNZxgeIBOiBZfk2y9DXTTngo3GmIfCG
-oIxJMjIgxNO7MkoQG5jBDNOLIkdnPHHKs2bNdrIHKgjAE0Iqe2Rq/jvVrMH/75xa9H+GNLNBT+0PQ7+5
-ba412GQvjMBf7toiBCZb/qoCmB2y2Xecf021oc2gSe2gAiKvGIg4bPzDsDt7QUoH5e2/AG8TPdcanr9Z
-bWK7uso8pyEu/NgkHdGdrinLWkA0k4FjyNpJ7oAOTyu1oarByw3dqqYLh4Mcg+TMqkkctidvac+bozmL
-vyAu3OLsPMI2cUALCD43zQZgWzwEuUcPwmGUHUnfc5Q3E4HhUM+WiDUQQ69F9s7WmtpK26AEw1TwuR7G
-x4DFaBtoqQsGW7emsN7qtcfQXah43vK2g5eyfplD0/xPwQqXj3JflBa2dWXxRa7lWGHswJj4xkZO9JcL
-hzWvrmMXWaO5rC24i6pkudoTteDbYSOwlEgTOMBGuEG8ZfiRqeuYjQ9uSFufptM6umqWiSn8ZVYuRnOG
-UJSlJ81FuXSGMik47meYtuzUkGeTailp5q7ECbP5uIQoh1nVkvV72LRdauv2tFX5iia7/j90Y7Gnvipq
-rZ50Ckel3cNSCu23tx07zD36bIpVSxXixHMt65PpsVdlnERzOj0ceuWr0eHEb7Ornyb5v1C7fMd+jt4G
-Bdd2AxjdDaJiMVMll3TbLrmeNzxOEnrS7HQ6rW5yOBmQ3yjcAC8LR44tAhFyqqbAtgLkbPS+LHsdItnw
-rbQSiAVWu8d3Rl1geY4oPVQU2jmp8qCi03xW8j3fHidwM+00XPjnMVt1JF0nT0cqCkNre+35PphdYy9z
-XE51Xv0juPo9dupySTTnVq6h6mMcPRdGPp2WH/lAVk2XAxnH9yanMkluX0nALICrAKErgKeSb6MpBI1m
-epXF1BOx7QwvWVN3xTenxRVF/MLFdJoO5pI2OVukM+hEepbCRZXiCysBwMfmFfQ8B7xChPLCYFVyiO5A
-pBubHhk7Hk2LHcx4ng8+BlOhKRk5d0dZjeDwUCyGOkb8Zq3mL2oJdpzNCJpQz2L4JmVyWWUoZNdgYkaW
-sPcHi/H4ar3Dw5Pzu82M7vY42zZ27Np7G33ka7b35utd1iS1g4BDq20KVt9AC9HUxHkrT7Q1G7KSOLhW
-4Xm9FY0S9X5LhR8JWG6g9xi2RO0RiiiBYPde21dJ0XBXrA3ZrCUm3d1dBzds5SLd3W/QPikm9uje/xpz
-iIjDPMeQge8/+CxQ+0MFaksjTi8zuomb7FRgtD9hGDFAWY/EZgs+RoNyGSK7JCCX+erl+jE/zR5fDUZZ
-JI/9Fg229WNBtrDLgm7ZNEtLABPJdUrBdF5JJND+fxbZItZ+9fUGyK5rRCTnu8HTHGbET2dfq3TPTytp
-np9uJER9evTfR8+OT/5++A+TDrM331OcH2KUr1ta9Gn2cp4xdqbZG4NZbnYYuOMXQ4RL7bltcgR3A8O1
-ZT1niXVsjjIs5Ioqo/40PTTsV4hqKmUKLCoFrmBMWVA49lUYWDki2ZIa2C/ogUN+ODh8cvTs8OT5s8eH
-Ammybo2mnn7BF5pYZWDjoOIiAQpvn4BH51QGQY05hvocwQo6Ya1wC+JTE2QUlbY/3X/2j5VtR0cQc7jM
-SMhBQAclN9wV+fznw5cvj8wV8NH+8eoBgULLfJyP0Jdat6edZO8G2RRZclOBuYOTyzz1g8oifpfs4QGm
-GfxastkZpqQLIJvVSefJTB5iKhMQLIwu2ltReXK2lRiwHkF/yY7OlGWRpRD87nxWXEaH8eTp4cu/Hh5Q
-x8n4mPcnQQqjmW/JwWndwUlYcJlTZdLQnJvTJpsxnoL6RviymCtjjCvBk8PjD7BfCLrXZbJnbMF4MGxu
-hbC91qYQt1evjeaSYEDoL340t0QEDchJS9ZevXQosh5BcOCWYgJByA8MwGEjLPsV5Nw1CJSMsKyYKU16
-pK/s8bqxILp4d3h6pc/FSlOAAtxN6FpC8bh8V4K7rAvnNfL9ML/44W/ZaFQkvxSz0fD7HXixJ+mu6ce1
-gmqK710GitaDSKGbBxTVW3YA9hfMpuxoGMZjnKVIXRnPgXvf6iTHhtGO0kFgVlEoWcwyRm0YmwvvueGO
-YBlIzRSamlHRSBIENBcJjaO0zETFukUozRIOxeKnWVAxD20KWpZEWrsEj8pPWLGkBf4M4uHACBK2OtOA
-BC0mCSYSpTxEH80Y0nqLBjjApf8eC7zmVxI12+5tOa/c8dLRVCpsXVG5etcMM867M3ZwhxAVJVOIjW6U
-iZYcKzO53PTcDr7EKZc6WpHOsoigrrdUwJrdPbBHEDLIpp+M1UJILrVxJdZqydTFXty8LaIp/HjN4RI/
-UosCs5UScLvQ3H1exu1e12sj5Hy8dkN3wOn7oW6nrE14H8C7XRRvQRsi0e88kxsxuEnIg8pB24tmgLgT
-tKXh5d1DIT0KG3oE9G52A64gBmVygolm3jEXs0VJRvhJD1yW0fDTW94kIMT2E4I/NZitzXpnmblSoFkP
-BEbMeoKZzqj+A0R+R7EgcxYTbmJJycH1wBy1/C6bUynRaCkrJglin1BIIrgv1E4R8xHt6DQQk0pNr5+N
-VwHj44mvqUCyLBeLJ04GvIDqu+4kNl8PZNUv764+TNUbcIF7DoZRgFPc1u9Pi+JR+lsXWQrz40lRNFvu
-+7X8vL7ZYPut3WCwN6jjsdp4G9XxU5kh/Z6a7T8rxv62gCWKU6C/8Xo0px0xBQ5gjI4H8OhmpjdK7Z4V
-KOA2N8IsIx5znA4lTAFub7PUzwGv6nQu9nWVbdVOyoKKy+d0u4Ddg7bfJQQozE5PkS/3Vk6c+5JptpZw
-DxM3Bh1rsnw3zqK1k/eQ5PqO5dKWrBVfTCLzOSmgydximwM5VodeKW2vTi1c+8yc+gQVcQ6ym5JT3o0j
-h9sIK4EDSRSwgT0LR6cbqUwAwo2hlsQsTGS2DFvah5v1lC6OENo0nadSEZUnQaF42kWHhmaYIIab4mXc
-Nhli+7Jlp08QI2eoysIhQtc5SZcMF1ja9zPwoKEhwaGClylGt7Ba/pSVJVCchdSzF7yJ2hR0FHnhsnJ7
-pMVQ2n6SKLVQq9k+yPYvJhBtxswW8H6wFphRhjLj3eCx131paktU8pMhq82WDRNK2kkIchszd9WBu0D6
-icHFoAmMk1i1gqgE8qqfxIN8eIM5jJ5abChf4hFAO3/tAUbEYQTuZQ4UDOiJnTEL2foLKjIJ00HsRHic
-SxxcJsmoGUaHedPwizz1yLNP3Lyl/ZK6w+e78mp7R2c+mfx7Ng9M4Lz2NL3TExSf5mIEO7VEh3hbXAc+
-keLnB0087Wt1mLaiJJJ8/F+p7QAE0HADMBa9WN9kwMyNx9y6irFcdPjy4uYA6BKB/Kc89OQYQ6QJ1ZJT
-ln0w5wf+8Ch/sXCXgCRmTpo2igVgUkxN/RTQHyFkLlSNQJo+RUJhai+2K7TDFw7gWvTKn9TNyBYoBIaG
-n0XBWR6cztriHRuNevaU9bMoc4HSaI9AAXaTtHlEDWnYKWZyxVh/6/Qk1B6wZD2iS2aIISzxwNxYU8E7
-dRimbl4Z3NK2ZQebsuNMNA2rSSw1HqVgN6UCOJuyqK/+LqKRsEe0xEqo20G4COs3k8DumhakI53X7ZEW
-I2LJJlNpuXDHdC1J67TIkFrro6vcyIpFGPNKxKYs+f446khYQTCOrjo9DTF5QHxGavmgtZkcy85QA5Lh
-IiMirtYYsL1Ulia8bZ8TRvQqPe7IHPfsuEJ6O4g9vvZAHjVyvfX3jJi9mXMTpoLOGTrT0EkFO0Mh3qoo
-Kv8w9OvuXbps3r0buVE2Wz1iNSz7tQkBu/3auYlX63KyWb9iNuHzGmWEnXPMH9PNT8uVRSoJJwQsjJZN
-CHxfNiHobRWbkE/NyH2cWfL2dR+ED+iES9dXlFSmyF0Rj36RyRUyOrlDAJYurjaZ1WHmgiXoO48pluMy
-LuV5et6I8I3G3KdA2OILkel4w+sWLA0q0DaYLlApI4RvcCXiOpdMg75m7g8vQEM+rL9masd7T5Zsnbbl
-plF3V6JyHl2BeDpFkyscfauaKUlo3yj5aiJAGTOmHTnrMUdnxSyfn49FjVlMTTFzDPk0NBfLHIsCVvEy
-L89xg5KikWY7tuU2IlGeu56ZBSqconcmnuO8jJI3B55Kc0uHnpHQU7jCY+oSVEKa/ohih21mMI9VNrLO
-ZH8EeFxnqA4GfnmGTOMoBZs9jNIl7v9apSB2JrpWKMzXgWDTslKuPVV1XZuUq3TjmS/INgx1AVt8XrrL
-UK4UPzVqCV8nlBxk5ZQ1k/0MWkBFc6UiYLAqkMUchRhczhesk/gCtbBoBEQdzBm2nrRZvuqDRw8LVGAt
-h8eHL38+PDg5fnH4GMwSjtnkCwJujtIrsNhQ7OZj17m2TiJmjup7R30GWG73xH6wTpEUL56+amwz/00I
-HE744GwcYCHDNVY41girmOwcxfMzUuvrPObVi42OqBTi7a8k1F3ZyLocZIEYeD+Dcg6yU+fjrWu0Lys1
-u09x456OzsFpWxosQc9rpXQXHYezvr+WCpfmk0SVD1u2fhrxdQb7DxnnjYZ4zdG9wcDGxrR+OF1sHKbA
-gKMUKmGYmPCVCJgWguBhyoOiCr7IMmuXLubFmAnO7Aysg+1JUlHwxOcwSKanUXe3Jpk/JmF3HrJA9ykY
-wwzJ+PiJhwSzogQ3v8GHYEJ9eInlTQre7PmERql74+NlE/xuC97WuHK1i+Rr2VK3pa25ziu12xfBCrcq
-+/i48Wcbvc2cCfhmxckg2dhbRLs9haPo5ZvTy3Yy4tFpiU01HV7Qi2eMeMBp9YTyq9BXSXIp8ArDBpNU
-USwRFfsBcEDWHrIslBGUy0x4RSWoukijAUsJdzqIrczzycnB4c8nJ5zjpj5PCfYSWBnq2WvpyhuEXG/I
-qCpQ58aXZjK/LMkwC1yaWPMGw75nNW596PupdaJblMS0ISfqXJjEx6oji7bsqJrqGJgPH8Q1C5jDxor1
-j3aur2W+3/gb6Jk4RwXuUQI3cR1ZWE+Rh3vOV4AmMpKMOWMd50pt7RmxHIqDp/xly/bCT86OUl38Tk4V
-dNU6yEu4VF5q00czm87y0XKci8kII8q/A9jdfE6xiYpLusDaQGdYLVouLQVUWnu52XUuC04PjMlWd40J
-XPC8wSBBFTL883k2npJQQV3KApc7WnTOrs+/jXSSQ4rOKZZ9M9EsTtCQWhXGuMJQzyidkrMftk8Gucbp
-zg3Cmr1vORc9Pcf2vmQtZ0lXBaIGuHetnGJcqr/7xCoZgYvwsFZya5Sw+WKgy1VlKRSTUFbijyGj/Fhj
-WrGIXnhFoSSY7KxvPuORYdlkUGSNKE9JNDgkeFJmGQnTu0ymVChTMLHdFveWqqErygXwjt5fmPsAjCkt
-dNxAZVZxy1t5lYPynWfeHfcoihC74m+6BPkgg5IxV3h2qdWDi+bXRmXRyBQ36Oh2i4ToBuq9aNqTIzrO
-KdYcH/Wz7GwBluIci7CxFS6G+ibSbN6063e060snL3+GeeZHXIGt2w/BrTu/upEwCFuCq2colTk6A5aT
-JDZy+D26ogWOJJEM162uo2pF3AbL+ExoPfk+UTnol0AIvmCyBPJbhrlFi32itUjt9Z6lPdWWgKZUFDcD
-6BHGqLAWBYbBTE85JmKZBdbMuCKESOPvgEY7TxVLqavCog5VXt13/MEdKhVwL70d7f5cAanoAypySAjI
-THZSZvGqVmu++JeMcTgpVDITKXElgeakViCJ1+G0X1xky2oVzh2aaRYNZNaAY2swbnuu+ZGBXT4Q1QxU
-efWCCLnjd1MzLMeGDE7twigmTkLZdYnAaPK0gFOd+AB0WJU8LCDA2AeZkwEg490HV0BV0K7he95N0edD
-MZy2qCYvzC+sofwXrY7Lfb+T8DAOM2eX27T+7NZxxWaiQImKxyQe66HPu6/BJO95Be7TGjpg2ezDpJ7F
-0RkBbjobHpi19CifcDZaPQjcySHHTyiquiTza1YAH0zW3Qr0SLvORjO0b2aG6rW9V+UpBMU7kRHzPwf9
-1x/9PvIC10ii0owwVuIdGkPuvaR6mk59QM66VNZxnv5db63KoPBBaQD3/Cy136PwoeGY+I1e524mpENl
-o51XEsy53WhCwBArYgGOxf2rGmKjyro5iy1LJbImkjBm03qMJ/OScKNeK33Lq6XlhwzyuIufJpmQF2R3
-mAP9khwJQFXyZekJArAEZDnxHDhDzHNnDmLK8GQD8M812X8/cZd6bKbHhqwzfJ9+8ITXUQvsSeEoOWuP
-nJIouKa0EVcEKXT2DlSVFN7YFSYF9cWBYDQS1bvYRMFOaot8GFGqp/B6lovBjay3083GI4zp5u/hJZJf
-lbByXNI/FWJrvRFfoymPySl1k1ZsBe2pUMUlFO1GgmE7rfvDYWIoSh/8KrVEjM3dnLyNr3/mLsj3ODZF
-DAskt5bTnFZHUYxKP5ieCD3rjzfYPcgtRjSQ0WEJdJFh3uSrpHFiCIPCA68OvDcJW/pvVWy3qUhZXVJ9
-2bS+p8Z4aD91yJPSV82W6jpuwmsLS0WXeECH5pZUGdK9Wwp37rh6IsKYFTKY1AoatAzFkSAQzLS1w+Hk
-Vwoyz0p8T8PeIYcwQOjWpZHBTWiBcCVS9z0bXd7QSvIyqhoLqOKU3cBKCY8aG0vUaWqOJuZemM+9uVFL
-7iNMiq1h81n5SJIxLR4VG8n1JGO6+S0msGp0ojTUE3eRK/1lIT62YrM9Ky5hbyF0ATu6ephsIWiQmLeZ
-XtP9Cp0W6K1QUjB4XMzZwK6aFVoBWUuw+Bly3ij2FVRCgoBcu4dQ2Qgnzi0GH5AriteQIyhcKHKD7gM5
-+yWfnz8rDhYUTi/7e3ZVNk0tiN5KFOSmSwzaaqg7dA7+0DEAL/EEoB411CdIqD+x0GlpS1vd5DF5NxI0
-wqSYbAtuQlWGdNsW8Qa1tBv4UBpdT8t3WblBmoSKHN+GhTetfW1KI/0amAshf6fUa6uGy9OevZpZMVWw
-G6pYhN2EAAj9fewVp8TdbifvofoxnRkaDBI+NrLjpOg8QTaFl4VfWODd2GyBECxQs5uXwqrSTkExHPfA
-Kw27A3JY3BIgoNbXAfPMvysLJjLaLcvPue/Qfvyp1X/WSt+k83DMkHUslYqTzi9yQCxxLKwBHfvVm/lB
-W8d85uN9hOTIwaLCwtZwqFQ60acoah8RokgWoks+8pnL9CTeh3NaTykjjPoWgQASlV+grbCHkFbP3vs0
-SXw7PHLGWZqah0rN1JisHRDJXhEUmW2SRKCBdH2awqXpYHOnIRqgbUvfk8xB2n5t2lTbUWBkKyX2WLqD
-B6ZZrfXS9Tmd4BXiCXi7ZZifTcypXl2H/79chuHVbvkKHASpZc5XLb7li86bLxB4oRqF5A58a9O28FHG
-xLFkDknm8rywagTgTs4KZu/6Gcl6a2aGczy1LfDTV2fl1TknUOA2aqQBTNrK8jBB0za3zVmcWQQWJKJf
-KRCKcJluhvmrChYJ4yN45QA7bQV7SzMEjVueWMCXwWjKhbRCciC18S3XzfEgbtTisp70SSStK+1b8fPO
-3SQrzW19vm0yI8ZNf1QM3m6XA8OpDLcv4HyeFNt41JHBX1IpCQIDOJ+1S+QHzEBarsis4PLh6zft/3ly
-cu/hLvzZfWhXtVjzwsfv4RP+AsteyNaZLsrzpk38Gr45612ITFSShm0+u5IFC9PfgusbaCz0UttDjR36
-iucXAKsLNzhXFNEy3EIWGeIL2INfOCfpRaU680bwAaiYUQYOAmAX1VEichkXWHhu/2EEI/fBPxpuY4WV
-KOAJ4XhoYLqieXf3MoXWhghWsoWFoPhi1YZygOH7MQE1fVl68lRvydr3gVWUdzzewRmn9fDph0FuswJf
-n1aGpEpQw6GgocQQqsjEwpJHr11PV4YaSMA222Z3OpQpBEVdplewjjA7wG2W6WmGaGJon0sIOqa02wy1
-40zcBvYN4SX4kk9XkYLwmaS+VI+uxM+6HuVclilKPZdl0BQUVtOez2Z5GS0VsSQwmyAFZDJ3vefz7F7W
-yiEMOGFm1rc1wWHl86c7kJcenKU6BH2r1JTT/Z2uq6599To6xL9eki684KoqWn5or7HM6bLiXqv8NsyX
-zeB9haOtyjRsVfZGYNbpcFo7CIGuiQY1MbrD6s5bEDN3QMysmuZ2otwlA1NQhAJHQ9npLCNTqYOM8EqK
-GfvqZBOzoGewLhWYkLn1VpFlcHR9YTi6kwWmrvhuInavdsE1NhQfBrTWo7ONL8sOmvOadTvkvgFEE/gz
-flkyQPAInRhJbOogQd0lXDU78morQuEYKZYu5XYlEhfdgPY02to2fmFGFD7SnPh3I3jvbMF5p4O2hISY
-dC4oOwcFDcqaXRSUGqIA4DiDq+TUXCAWMwLFw1hVFowLZFWi+QWpiDiCjYthJhjrjzUFCeEDyU4vhA5U
-gQQECMtNhXkilApCVLD6Z3MIlQmEhUGqNSnB5EgQDHBCwZAfOyKIYsQxLFiVbbHn2sgkFRQkBtkn5zEy
-y8wkDrIQUMswQgxCIIFkrGlSABdsluK/4O9LVRBPsxSiLuhhafXlPeaUXJbN6Plu6DF+TCHw2WILYUgB
-0cOi3fkYpxqtjPLXgxdwujbtK7I9u8xLi1wA+i/UhvOzWfE63Hj5lPJHiMSN/EhM3xhSu4Kj3WH32j3F
-7FLij83RYqmdE/iSDffB8fpldloeTV6iL67H4xkiA1Z3A3E+tQMCLCuYdOdz8eE1AyNjVGHvbAqHWZIm
-U1j99thlRWbp4MsgLL9kCMqbgBBeSF5ZwDFDWvTUOVxBIYCfAjhVaGguYZohlke8gYZvXIB6H7p0agjQ
-U8sxjoqzfEBagADGCNtbx9hbF3nRd/mWEjQR5gwCQtxs4Tmyr1yul3GgyycRIwKFMZWdUEwcm1Wo3BA+
-HZqF+9en57KOKiVYC6fKF1icPtB6x3qS4xCaqZPEdBrtVSnEcUaQuABkPVeuwtOol7cFRgDheDoiV7Jj
-Ly+AWOgCHKqCE7EI4UoeU8QZ0g9NLciTB+2gaYcpbNEfgRM0PrjzwlIW05LQzU83dn3afcwFMa3Vhfw+
-tJsPDwuNvXJSzNHybzghfJiGkyKN3fgw1ZMihXyqCUHeyudlNLS1PrpMSudFGEnujLnbNsGyb5Zv2lKY
-/ByeAngYFPgilEAEq9zFt0PeLwhn4Xeowpc5GXuMRaASz/KLDC+OEfeiJSsUDeMeR2AZmqR0Rkc6Vtc7
-kO2AFXGr1s2Fhj04daGYwgWKCB8OZUEtR20PDuOj9PJ6oqe8ZD10q4TNJfMAFboUBy0wGe4DuAeEHkT5
-spXtQ4BsRn+lYiQd8qZlmQG7PTEMnDmAz4BUW/5McTMbG1fdhskhwBE+VgyJibrqmrP62HIPLBgiEVYo
-25OrFt29Ugz9hdFgZuHh/1/H/21Pe5AxdZPz+dzQlJ2d035nnO3gDX2bbjNcypKD/nrLjfkvOZI4Fo+Q
-tE0NL95HlxsHx2Qb6oxX1TngLLb0sBIgjZmFwgcbcNB+pcvQQ+cQyR1wu9nsWxD2gSstuhyoBVkqNxbE
-+VggeE/mign018iBVTFCregIAbIFEZP3vmLIc10U3/TDGv4Sfd2U2dq7zSInxwtyJMSZtfsKcxKsH8Gw
-XYFbkFPHQ9XOKlBZBPpdAjMLq2FvaFN0O2FBxzonwOcP3orKzTcbFCKSlw5QsZ8OzQOA54AQG/ZaDlyo
-+FgjWhJe2HUpcgoT605kydDrPANJuEoazJAe8dDK8HYmILXDpwxY7uwD7H8nL/FvU6dteb7Y1dXS9SKq
-pBb0H667pk8bOVjLkfc4vCxEHdtu3CNlDe3tbJ2IGZGKoZhjF2DSsssYu9Gs2pe5bFpE6LuciVeQFxxF
-YkrLfMZ8H0mnicFldHktuXds1WWMOE1yarOQjyzeLqH9agALa/FIvCpwyexPZXHA2MbC8oYb43gMfcSM
-JfmbrYpX2IYES+3+NJmnZ2RFMET7CE+9x3y9k72T2zawG2/brjDCSctB1YcGS2RO5JgfhHTFz5MCccTM
-eOEjR/RMXVHj3CxPsGYoksusYQYayA/qgUi9SEZXhPfkrFzllyvHwSejKEqvwlkGU09aT62VXGvKliKd
-dLT/wz5Y/JPtsHPZua6pz+2XgOLU1VybYd028Oq5OamNt2bmyZ8aOhYPhfeNseRIUpfF/CHC6JPFZfW3
-tM/hhpvj5gzrnToKyPTnGOVgdD30jhlGLec9xqFxPQO9aBnNVic5yIe4OyBqUByxtdn6S2DWCBd1tJg2
-lO18lpaCOmyuqiWDmpJAXLs9QGqvGOuChEDcdBySJkELyCoeCDXysQpAiNDll5mZiwxhzqEwYONR+Qc+
-FwtDKs0lpszmgn1ZRsMSJUrdR4MsMDQRDzW3ElANH53T166UNyt3qE4csD7XQWd/JP3Iuelb5UjlQ7hi
-436DJQ7x0lyj45oi76wUhVGtfk5ZMJhP5nrdfBfwx0eHpHo5TfNRUjA+oa0uaWblN9vleT7eKc/NNX5e
-FK3YGPFCCwaBpFnESZhmdUW6jy+QsVDXbzy51ST7/Aea2FASLZxZFtMVVs8GAV39EI7d3d1v29U4nt3d
-+1+3o5E6u7sP7rXDgJzdXQjrWg262d39Zrcdi6tpPrgMLnimef1de3lMy+7ut3+uTYKfXQk6qmV390+Y
-z0Wl7N6/j29c/Mnu/Qdft220SfP0zTqxa7/+HLv2D41d67Ddl8Wv3Tz+6bphTVeGIxXbFIfbRDgupQ2l
-YUNF0N0IYMR8QxVlW+J3Oiqmiwte6oQuznomqiBhPdo+6aMXgoIMbgkLwpO+K2nAiouVbXNgvAK0dADv
-hTz9UaZC99eFQfHyitbcFJANwYl1dCWCejL4u6sUjH6teGVyPrIquEx65SwMzKCbVhP7sLptLvC/DeBB
-TZwtDBd0NRmcz4oJhvtvY9NKRps1NWYXZtYILw2Mh+CwQh7mDNXWnQQHEbYJr3XCmqaNhYERRX1BeAw8
-Ltm7bLCYe8jYqEspEmkg6WCpZiynAH537mjIL+co5tb8FjdhqMtpW2+7fiasIjj3FWbDIug0RjS9y7wb
-Wic0MaiaHpUW6mWDUqxPy2KKrmy8thRKjbUnw2CDWTsJFr+Ye3CsVSY2w/z0NJsRgRAtrlkfdy2bia5x
-zllBfF+UdmimAnCgIxq5XuPSj/G8bbbIyCmsjN9aEjUGsSquMiiUFgsczBkbPOYV232SunxwqgFW19Es
-P0MNGL0RaqI6Ny9c0NGEmDbRmnl5ONYTyhT4Os3haWirb22iWytl/TnVmlOs+fYThtDV6YtsoAJPSaH6
-HqrXbok15Q2rJ+9iQK/adM59mlMGSWxgYnOLsdEX6HYKsodSi/VOefSwhX2A+3JxKwB2yGE62Tkm/RJR
-tuVl1aNYrej8ul2Pdbx1MyeAm16LvSbc0WNfHX0gvRB8GENeOY8yEa7KfsKR2NPesbkoGxZkdjXI7A3Z
-iQ7s9fJ6a6kqXptQ6dbbcVtXi7xM6+vZ0j2BFpcuiAi7EfJRLmbouQ6+kKM+7+2kuJwgfUBKTYF75hyC
-4JLs7u7epYgjaH0LYP8KI19oGww8QvADtwUWMmQIkJc2MBBWxX7kcNQMswyQ8FIUpTGr4p0WjZKnC2Qb
-FPeJIFb1kZ1S2Cei9R4zbQkzezf1onS+R27JciJSWigjGhCKYr9UDXt6IYlfSVGZALBpGxzjN6Spaq1q
-suqvsLr19UQtdKsB/CQL9MAZS+6/OLJmnfCbJL5mzSBWCtzskcXOBxrUMKNTG+XECOCfJZe4tjG2AfrN
-cwWwjsaAJtdga1Br6s2m/9kYVd1yOlPcazQO1WanVvt4mTmkBMPiEUgcbI4UiHPOYmmJ9Eas/oa0ES2N
-7QDhmDzkgQf58POnELGlm7wWYuVeWjlZAxTSBNZr2jrkz9pk3dqeYQ4G2lWmjbZ0+84VbgdjjJL1BRnt
-YkxH4PX8sJCwR6YZeYHAfSor52i57wE4VAOXkK4TmWtAToOLqin/bek32DfQsW3WryPNRmpEo8OBT9Mz
-8OJnPcW8mCYYJT9aF1vWhnXh64ZCdiA1vsA0DDPRNzj+nTGmm2XmITOIZcCZocKLPkh7d05ZdkB2Ajt5
-WZph3Hlw/8G3Lb+NzoLstToSP9Y4bLFGjxYobAIlu/vF3pqdZMzKKc0lY3Jq3fa1uDAuLKy1MUp0mU4e
-GDfZp3+3MRiJuDihgB35C98AH/3uRzB8/5VepMe4AXyS1Qk9ixIcldf33kRe7r5R7yKmnlZ0abmaiPrb
-whdWZag3l6BeyzKwIvDTiYi/fbKlraL9L6FDC5WgfFnqlhenbAc1vabXb0IfkBoJq/ORWlvKuoH8cZV8
-8Zt15IufRYkfU5T4aFZcmmE5nFzks2IytsLFnbv/ROe0JLvIR100j14qdDQH6dHBcwz4hodbVfAYpBCZ
-In4jE6RqHnyvJYv7fVN3Cqga6WWKwOCWuKUjCFDGojwJjSVMiDVzB+bacJdnGYb8JOFNn8bAhva0TiwE
-lWi40VmpbPFnfIIRMyauJrgSMIaqOKM4NUnoPlM/+GK3yVsDQ56bE4hjjHUdjxmMZmcIbP8MRU4vonlR
-ccNHMrA8ANbwNJ29XUwfXR0drFfyy7rsQew6EOo6pJUkU/2zE+EuODZwnB//mqPXk/76SkY0oUj6pEki
-rHDgQRMOMNj2Gd6RvWhRYdMFwMsArPIgRWkkRp4+OhAdp2/mKpAMEryM+K+jgyezYqzmTJ2vEjXw6MC7
-EOAy7mDlRwc60d5KlVf9UgES7QhwuLu6u6TSUduou/vtLpLZbz+qGufrz7T3hrS3QnQjxHUd1Qwn5D3x
-kDgzz8Y9UmPFQvw5i97RcxzKQqdkvXcNZwHMMNqLm11qLjSgPu8Anz6Di+KF+CIIQc3mgxYANKdwc+8m
-+/ZDeWUY7TFtwtKGgCd/xiHebP0wxmaHIvoQGQTy/RS1EwPADp0A38z6AGXHjILYief7tnwfk8Rs9aBA
-t5HMl5ccmhDCcdLtLB+nZy5i4DgfDkeZDS+NlwdYf7Yx9RR589ZMXdhDNDEhLAkMhcZyFMNp/8LgE3eT
-UQp3dBeH6+liNM8fU+A05XPADa05kVwra4wC4oRSLauPg0d2R9a/Mvepsf3sVltmLkYDBAgcaY0I4gXG
-LUVtdQ6Dq3andeoWnALOztZIvrqi2sVUU1Nt+tVVxZdDTT3xxHuBXYjnhbfaGCTSqg1uLeHlpHoZ+dPn
-y8hHPhBXnYewBl8sZhk5ZZKfVM2xaC7e4AJwCMHh/ZNRf9E3B8OUosQpcFWxQXU9dfAX4G37RTvJOmcd
-K/PmdDzO2L8ZAr2x45TtdeCZ27YKbhx18psHep2SGJ+s/5GUa6AWs3RKC93x1PwOm8420egYHPTBqlLl
-7OVHklCtHHXYxndZlMI7aGWeBhDCu0IzdNjb5nspTYKzvl5ZGgh+7rpGwPtQjHXXl/Z8P8wvSJ4E8o6H
-751lSMe+vf7htCi+3zEpf9iTAq7pB0qEsMZnBUgxcT/ieTAgb3teV0kGCwtumnziBj7YYsXN+KfO/uAd
-+VaTMd1iJtA9OCSoEcLJRNZte5KdpWA1TWYzpACCkkS/D0G2k+fQOMquPa1LGzPpAnAToOrqgiywOIyM
-7+nweoEWCqqutJtckEjPVHezjS4qPOij2iXPhZTD7bddZH0fujq5o/d4001028Xqb7kQXcQeVLPwULhK
-nC5mxYkT6Zy9jHnkp3v/66/xLPnzZ8O5P/zG5fGAyy3oau5LoXQqlswTaznwqWpW/OKnVsansSzaNlXn
-Y9PUSBYxWo2k/pniexSxmsIkXn5l2xrJqi1fdS5r+BrJ44xidY5nSAG1Y3aYL0jh5X5hDtJIFnjtpwvN
-amOZKqa3y0pA2901SiEbX13SSyezrGZ3H708lu+usfMsrXyAjK38hL6BNJcLGOtX9MVPrT5I2nUNR0vl
-S+FvnZB5q0u3tgmqJaLoMQSB/XG40VR7H5y2h4txiFCmkWYUPBgbgkmstxNK8uGDdSywoDPayZzihoVo
-Jc6DYOJFluAjrUGgPiy7RmrMtpuGPvYkjgSgffU6DX1DciU0tH3rduUfeqjDVtumfYiWt5YwKq/7alYm
-rdvat6vr3PhSQaRhHujVeeaJYICRMI1EWNoOcVvbEQ9k+ULdl6fXA740Njw//PKN8G9hkopjM4WIhDJX
-5RGQGpWu8k2xpUnC5iQvziGcUte9D7r3UttUNompJAlDjpZlMOpsMNjShURZJWXVGNZTTRCOp3tbN65J
-YW1AmW2cYu/e1NRrTXvUwITNInnGGhPGKd9UJt8woqaFxVU2fEMrsWlftLrRBdcfpRPDGM2yUZYysjtd
-n2g5AOoZ8NZ/fSyMQ3XZ3+Kf6NBIZwURPcx9CIgIkC4QZirLV9Oqc2X6q2zORHIpLqTMs1OuCTLuZM9N
-8R7FHrKn6X9PJH/AKplbSwRORENPIwEzjMlTkkAZOrbrrtD/OcoAc/J9DU/ljBGuI7cCL2kcO47cBjTo
-R0EuwBHIkhggiWZeAN81G1sBsgduI5hLVrZp69QSSspuLTMQLsI/EUwHONWeSuPUO54LGn3MHYSUgnvA
-sIlZxXhM5WM7IVdxWDAnwJuMuH7UpNH4dU5bUEn2xDeYY52C6ifSlWyoj3LVJx4wywGHjcHF/XxG6Fv3
-vDEqXxXTH8G8Jqi3Okx0qa0Y3VU7I/Z3GuI6QGZybumMC+nwv6mrJcZbXEzbTEPOAKbQvCVTLIyTao78
-KJgT3DEmZ9d4TzLrAhQe5o/oquHyBPqPTmQ5W77vlbMl/YBfjlHpQndQU7j6fq0NT7lMwdn5izTlJU8f
-d4rt4M36zGZks8Nok6atMYCoyh7C+VQydk8nenTQ1m2qYO2Ei8WDSYmsF0WivvqqdvNRzSqWEYEGieM/
-LxC6ajsAqgrfN1VgVK4Ud7fzyuHX8ZKkhzq+kt4+sWsNsJD2AeVpZmNKvGFmImOV4ad61AXqBAnMbFuA
-OjH6hHK7coPW9vt+C9dvwUVAwSrFOiJ1lDkWmLLw9VMRGDJBTodos56ls5FCIZB7AdpOF+h2ZpiG/uLs
-zMH+3NzeDQZGLAEDy3pn9dZNnhWhPz2LMdhTmjZhpvB3fEdvOR265GhuhYLF7KyYz7OJintl68k54FXc
-iT1hZHBbVjoY5KDCRSXiXMLuiJHjRA60IGgNgkILtG4lTEeJkc4qKFAdD6JFXgYe6u5DBBtYqd6iHutk
-oeMsc8CXz5AyFhANtF81rhyCP7OoOTCwUhRJSmcSIp3NjOC2jyYlUDJaRAOglATARlirzpZdINYP0e2Y
-PffVUTdvE6kUAKMJPIW7Z3tnqeId4Kc6FOcYLBwMk0g67BA8bR5ypCv4JdFuUD6DmHAyT+WkqAJbmp43
-oRSKl3SLLU+dgK11dMp9Lw9QoL2cWLrD4iOAsRwjfgpbox5bk3kdohR8PECdD1ZCEr/bB8RyxSHQKS4Q
-kFqSg8Wc2Pmx2R4ZCB1KIlUd8rIEZzrMgLtxrgI4UE5cZJCwnWD0GO+ODdAMbWugfmuqdgdXXYjepQTn
-se9R1BNFDMPiwPPEehdNki/BLbBqDszOBAF6BgHn4IW5XExBTs7mdTJvpGUamlsR0P6K5iko0EPw0IEd
-yX1mGkRb/IuiX4L7paBn05XQGh9lbjTqTWxuVqLi+HPjFfdvNDeEExjADalJ8kCbIvDAf9AETVmqW6pR
-tu+88a0L7YnjLjiJ3H9vZCKD4Wr49xkJJtLhYOjXm4+HNxD1i8Or5N9hSCyQHA5MBFAIS3M+pP+3YBQ1
-IzOhIYpaSzm+CP4kjnDpcPUwdH4I3+cCJiosGskXwPN5kgpyF7m5WdrHQkgEfMSSvKvQBJJZXbA+r4VF
-jIz0UizET4SE+PtLpzBuGVkxKgabAgyRyONQhHbuSs9A9w5iPVkfhX3PMc5rpGaBAXdJ+XvR0tUEw+ow
-tNtWTZKm8oACjFSQIo+56nYUxaN/FfjuYjmM7mGS6eJ6NdPYs9yyuUqenaEgCi8i2zTWAd5qvBDfKc3b
-mb6IRmVsugtX27tetWJYp/xDrwpbuNkiTtKgEjTDAoPVYwuok1Y0dXXW3wxlaKMrH8d8vWVTXaQedayX
-yurr4zyvqFab8Q62lwiXCCKNbuDJ5YyNoOGlJ3ZCFDgSLz4MddwdX2Doi7IqvZDGsDBREiuRopcf4Txi
-s9n2FoEFMo3vP9H5aTg359BvFo5zrngJGiVzArO7d01BtFT9Q40PSxqmiFj6JWmwSryPOlcZsDLimDpm
-H/tj2fNE0EultmxrHJPbap02CwZ9OYQSBVSJEqvy/GgubgXz5+WE1iXySW39rqnm8MhtiMdX2+ymQtkL
-d+yGtcf6HAUlDPdHODXNmq3R2lux/X1FEwT5n1u/89M8Gw3L3/+krqSKKWhWKL98KSD1JBkWKIYx90yY
-M/THI83GDPtN+LlonMZ8E+qLpahRAb5uArBF6F3Jal1WROUnJR5kgFnB0t968RxjLCwVzYGUGxW8ZIiq
-JXkld5LuRmBfYN3oUD3FiOgYR9T2FUADPKkhiwdZNWCjbMblguSG02SCZkWR4NCH+BQ2igUZpc7AF6+Y
-aEEpNswKU68ajO8sRVkNP5AXVrKdp5MheGNS+AicfHTV9MSvbnb872WFv/e/V+c3jKoDLoNgVJhaDCmG
-J5GRQyEMWeaiop7A9HlK5xmOyr9nBJ7KyXAi8AhH/GXt2Dse5vUwA0i3cf6bac40nZ93AL5fXrGCH+H2
-ztMLZB6tCqLjFUaSI5QZmIGYpW5YveRowBYwaAH5kFiEAUuzlOBoy4LOwFCJbH8y9ELXMEXPfN6Jg8e8
-v27bZjLP6kUe0myI1m4LO6GQZ0LsGH+FPslHqJJm9QcuZ6ZzZomS5REZpidvsysLDo36Xiqhp2UpvSVL
-ldPxR6te5s+SJ3ChPRmn5VtmvtR6CrTBxDaWbzPFrPubFlYEk3in/Tlz4MSV0DYCP+nNDwRmWSB6k4Nc
-isDh4CGSz7GM/sKM8HY+KTuW9+Grcj2bjPdmUrs3KoZ/nrLH8QQUfFtJtR7WV+CJ3hwve0e/XrfecNgF
-y9wiWnChAmsRr8Mr5bXK8sbp9r3XugmWIVZl/A5Lnaw6KDgPe6cjkiXQzQu43C2j2zfbDL7JwGb7ge85
-bjsF99Ubxlv8GNYIS+wRLJUTXH4pNr6ObElgGysG1KWGoYmXoFFjvIHTH+K23R01lX6Drzdfo5VlQiPy
-OLpaViyWOjmFXjZe8Wvc3yyl8QUjolrS1YDAL/ZeWzZ7V+uP4tXry9EdQruuiemjEk5WAsv4De4m1ewi
-VhXthKEaZFVpaIkn5wZBeKXEFbqGpXLXWsnrRl1ueftq46hKq7bZ6tZ4UavVx3V2nEpet+3UCTSRoye6
-2m633hJb+mY9rlln5uBJvviy/IIumXO9uirF+bFON1hF7aD1bgi9dbV+x1qVsWcSVZWqe9TQvzVVZXC1
-lKodnct6iiL6JcdcLy0v0lQ/fYRyM4ZOVvLtScW+YE20QC/DulxMHHdhfS2pIGIobLhde0tUfEVyUGS0
-PghmHKMECwL0nsDbcnFonEclMLeDmMXcqFKAjPOlERslwuZmJ5C2lIzE/LxlpOc/ivOwuvSbsR0RA4DE
-ju8axA/yr8VrSJmRxbpPESXrFtiSheAMCcCdj4NKEw0E5aLf6PoiSv+TmBiPuJfkRg9rYvpFO/mCOSz8
-idgk8qJu4fnz0PUDd9LgC+K4VKllESjhOQbMxgtioGA3Ykfn2HUJt+diyqubopQiWTkCKV/QeBU8I5cv
-n2gHFyeoUG5NkTUHi9K+DkEAJW9Lr1EUdYCBmdN4+dJtTxID4pKJRY9Bywtx3ZvbMKSDdHF2PgfE+kZp
-fWz98voZCGpAbFM63R/6+gLpQnFJ9m6QTZkupSYDQsGOze5Pz7xAhbc7qB00t4zaaxmmNwFCdwWkspt8
-WZI5a+/LskfDgxO8B/d84QWdnSugXVMgAz96KWCRgyiXjJvsCu0ENfrrxJ7lJJoI0tb7ir6WtR2AYkqv
-1cs40PnKcWp5KJq4tjiqRpBPQnHIi7bfRbUNlTZWoDazd77CV+rJ3lVVt7QvKIWKTovOnJUwm79kHrK2
-oSiZhCaggPSI2JSP85HsnJJlAa4MD/MVteYQdCM5aoydTTAEv50T/iB4SfgFaOPoIhnnExJ0CmYgZp4k
-LmSEyo1euuyhasZjmf+qNsV142Tp7UOJIR8/b/yRw2a/wAZmiGQCQNOIzDc/ZwlYf5QO3oKDCzqZsaU9
-4Rd4Bd0OUDYKKWu22JM0B2G281WVFQnbuAIcywuqwwQn/CgD7L2OosiCB5k5C97/nj2kqzYfc5+0c5Wt
-5liOa4aKRCfQmGYYVBFiJOC79cCXiPgBdKCh2URM+i5pQzGXdQfCVKuVgpiClB1VA4OqzYBqnrzSXVSp
-gkut6i6+iYnkAQxzgpAYvaDNvbZ7pUxqAJ3FfVDaU5LGw2llriJtq3o6X8crMuZGFnMTq6iBGJGIWnB0
-+iyDxZ7OrtRyUKXoO0B0mth5Jojjr7Te4bLzWftAzLKmakflqk5+oN31LwvK16BOPX6Hg324yqsz57N5
-G0tnoqAcHeSK9ydDwH82dT3Fu+PQ00ap3sVuRN6FY0slr9k6y8evbsjX+RrKipZOSWWLQUPhtjO/LOQu
-AV47fE5xMHDy8XABkiAjXQ1eCqAkqBkRhQF9sBHAYVtUC6hwgnRDuzWSqDOL2hhYAjg1W2EYe/vrdzLR
-lcRRIX8lFY9Ktdxo9kj1ivLix7+DsuShoM+D6iSWq1MWMyv7RdR75dYV3oSWiq+Ca1KO3sDmz/eqPZ1R
-Njmbn5vXX33lSw1AwvZQpXydv7m99XZlkF+baohZrg4FflMSZ1o2BGSKER4wB7+Q1ckL0VwntGy5SSKl
-Lt1KLkr828KxgX42vzT3xctzgECyxlNfBmbYEDKhm0CghO7OzmnfMAoUGUHW8Xb/apta1FKsRbzD7vvK
-Xpsn96CWgmzd0Jy7Km+jY8YF5iH5AyMgqAMOLQ7r0TWs3C1q/M429SQbIcN6jpHdNDexxVi0xzbozKTB
-lKJ8m0+nYEBOhjzjNEfawglH+Wk2QFSVagUcHw0dqaks37THjxZmT+9HVyLTa7NlkARtLuk8sMCFZDUx
-tJDnORWbjs7MdWN+PmZrj+Nieg5mOWQ0OBjlWBRemPLyHHWmF4a5FJjCW3MSUUwG4KFepMFJVJcYwdwi
-iT0eJflPbjbgJ1rSHBxgiiZvRY+xSrvodaUFLvVPk4hiEdIHH7Zg9a9nSCnYG4EibhCasVuQO0kxJbkg
-JgCTM7K+WsC09rP5ZYbRDkkoyAvWDFm5wACYBBBIplELBnJJRBwVDArxOuGYOILsNz7qFl/JrX3iXSkv
-6l30qyVM2T//NiwWAjNVZ6/Kr+Lyqfdk9W5e9Z1tV9JVl438W2bRbmZ731JMlGOS9b41VLMfMXiXCinq
-DPxNGRG2liwNsbRsQoGMQEyITrFqsZQoOHQl6SpsDIah+GTBLegyzfHkEosctGvD4KAdPYNV01xN55c7
-ROiUIZ7k42C1eSa4sruOV7of1JZqy9FQZhYWudYw1jp2kjNd/PSyierTROEzo238XeP1swiyrHjAWdes
-QGlf47sGzDpDKLiYhWpxBR6D/aLA0Fvkh5Y81UG5JB4uBDYBqCEQzNxej191WoNx1v0OHeJXWUmTaJN9
-ctgRBykeiA8ckCmH3MMXTGR6naAiZLHCMd2qpVZt/5Ov0HKLy3tV0fpHb4z1BM/6yXiyN4Av4fhxw8wc
-/mOcdyJIytSPTQFBk7rg4LQA3TCHwbtMJ4rgUZTfEBdXrAFNObP5YDFnZq+0NrgcM9Ib1gokVGUc9/z0
-zqSxOgp7eoNbe2YZfe+rtWaWifC++rVUdPasoo9QtkC7FsbbXsuc6F/sN1AjMnEJGX8+TKodC27udxBx
-YbAb8s6/fMczJgahN6nawtLU//gP6h5fSfFSuFspCJO8vvdmb9npouwXpPS/2JyJNloPdYk5wl9Kll2T
-lq/MummR27JYrT/katxlmduybHv7kXDDILh/URwLJyEgfsIOUWdRuKy61ZyhxGdLDa233oNb5FMwfiiV
-iYSCvDZUAQw0gIKMAF7b3dVMp5hJsrdHppWl3vFLweaUvJgCU2ex+42zvxAWl4JYk8OFs14F2pSW4ulu
-Gh2a/OuCVDTsSgkSwro2s/C5S9vBU7UuPNmS26Cte1Gl/lGTk+iZFV7nKsL64LwKFnPlnKqcUdXWbXKH
-w3tT5H6mv9f5r1c1IP4d0NKjiOtaKGquSbEWi6j23ZJjLuI64Q6eRexoW3awLTvWlh1qqvYFIwcHTnBN
-T0kVNKxVO6oW2/QGDqA11xNbZKefT4ZMHO2Cabu10dbLoNX2igvJY1UgTkGlMy/MRYhMBrRR6ZAAkzAd
-DIoZnJ2jq4+vSaqbnLgmKcp30JBwvsDDM+L76G+plxX/7Whx9ZrJzdzHW77Aplr75t7j4VUicOOOdLId
-q7t1E+1bdKx8jj6se/kd4AaO2kuuB+QDCgqgowOSvpr6yGRH7JjuJI8WgLNJVg18VxC3RLhLdBSnBK1D
-b87QwXNPs1PemBzUr6hq9pXOvtGSnJDr1s7+q6asztnInwi3vp9u5ua/0tE/4Sn4hKvItaEmClYwCQdt
-1dN64qsD0gvpWxLlTV236ivTwSRtmrUif8mAh6VvuUVAibfsyKzVnzjV+oUsAjFGxfNZ1e57g0tkbG0r
-GM7mzf2cyFSMnKjTxbzYHhfgd45h1U0+wj9irXFjJqidCC5qbpFCJZTrX6SpvmzL3BrVJUf1AsCOn5ra
-n/AAabmmMt407eqnfYhNl4KBOWwCQ1bAXghDR1lVt1iy6jIkHDTptvJSA2A6a8x63AD6fh25iUXP2+ri
-CVj6uqWjvQMiVv/RJeEf8ICy4I5nHWZFIBr29C7yvjhteYWUbC2hiKE8v+LKop16dQCXoF7moJX5bmRC
-oqRzzT3YXA5AUx2NYEC9c6umIx8PN8tabe9Pkl5eYh+BtPWccSXF+HUbA3TisPgB9NCsOdFjRQdSWezE
-v6JIyYnbPe9vbo54HlThODRol9AqiH2KBsYBCbe2xoIM3En+odF6PWn2rCL6Bp9Vw+zMTHIwbQXsBcPe
-ZDMxZ+arfOcjgYJ9vGm59aRsNiVi2LwB4fox/S0fXVmYnVIQPKzbDYmWCgiI5mC0enB0mIvWaW8pCnx2
-ajogqBvgbRGIVmx510q67UYDJDME+etV5gM6hIQ3nc/TwbmpVkOzZ6fKdLvucDbr5QVKhoQN1XcbxixW
-+MUPPQRjs72bGtz4/XVLRJvwRubltHxt/vPGi79TU28wUQfZnKIIayQTN6b1M/CMvVxgKXC+dcZxmEXH
-0T+nTsslg6c6PiR0GNv/SP/+CjK4BA+1YVYiIiuH2/FxDjgeMsZOkyAhiPQNwdNzlrDljEks7hhWsCgu
-YDJAFhWSYQU7dbd6JiY1PB7hH9TfJtTlWoX9CJlCELr7b7QDo7e1NfJiCDXe1HWYIrUTpEqqQgdiKZM1
-sk/8fDBitfz0X7O5gtkBGmPRhkLTIImj52Z5O8k7hvO7NJPNM1omEEGQQ9zgyiNjID4rJO6NMJzJY1oi
-SGvRIQKkTaOsZB8uB9nj7R9ZH/7xda3xglwjPeSgmkXjb4rI8hH9bOV6wMMJsOeLPnCUtXddUsdSEGEb
-/q0zzlLQAT8l6T8smGVBauDkXOp6S60NUdQa/gs+gCtmSY3gDadbwiQ3ar/BiX291arEMfSanHDMHexb
-d2nPVwZf9rJsEHrZjzrX3d39tr00omJ3975OQb6C5uV37WhoxO7ug3vtMAJid/frP4cvXXxD89UVpkMY
-dne/cdlcnELz2qUPwxB2d7/9uu0FHDRvXAeivl2Q5M+1SfCzq1CFAuzu/ulBO4z4Z15iYh2vr3v/HpYf
-hr1eGnKve//rb9oqvJ55jkbK/u5zdNM/Nrop3zdrQpp+ksiPK+MwklE4eicogF6e8L9n2bQEid7greVk
-+B7plI08sQ7eBwDFFvNiDDDgeIedgnf9MBkWl5OAG0KL5vI8nybneTZLZ4PzKygNjkYoxZ24F3kaWM2A
-OLiGyJWVcMcibfADmYUmqsgGee71GHWMetzV4+6zCq/Ml8K0Hm4iGbozlrGxYqPw7F02WKCcpxwUU3My
-MFCYPsj3gaeB0UM/5wEEXBvjxhuZnWY2xdtMJa4JvJ1QID+rEjGpQnkL+P6K5OH9aVGYc8v8t3HdTkwR
-D38gNu2uLYrvZ3fVuQ/hvH94L+Vf26DdmOo6wtorbAOn1L60QwTOmCDacYGtFEqhp4Om4hyonj+Yif0B
-NmSLCbk5kyHjZQ0OlE9WP+CF/Xv/5Q9uWaix81EWrBOJ36Jb4C7c3KCPd7Yy2lPNhi0mYkc2gEdDUpRz
-oA8NULmUPWDRGBn0qbGAEIgtnM10fEQY7xCcyGL+c35lirflNRjuFrNFVrHLoUD+H1WeqMx7gtLaapG2
-YqI/aIspwZ/njyPCu/YFIdLr6+Xcnmjab8fixRiSFbzFg3vr8Baf2YiPwUYoJjrKS6w+uynmsT659QcY
-TP+UvjwvRBELRzgYlyvIWLLvnOF4Woxl02um2XT51nMH8F1TQx3yyRDdXJLz4jIZ21L9AKKsJe5b1z92
-+h9B4MnwnPcjs69z2AcX5eqh766mNZtO1Qk7r/0e98PuZ177j9wk6MTFk4y+oiXH7NkG8JhaFlxfO5NP
-Gqd/i8AipzE23r7GhLIeEFgXTuOUhpwEiQy3+7dXT39M5umZ2DOqqMG+RBXSvDL/R2lZEwF/eoZl67U6
-lRjDdnFSJWZIn1DVTVMIMScb8zJ8oNV4SmM9uhI68qLZoqmvg+HC+Dwc9QARYFwILBkxCrchi+ax0xBw
-fKyO3UpwIRmVReVWQnI7k7nnBh2lZj5xAsOth27KmzAgqWG4UxQmpf3+DB7MH3oeGn66LOEV/aK3plfw
-yvzhZ7OkRxm+wl/0FrTN8A7+0pvFMAfmHv/im7556tMvc7mAB/OHnoc5PA5zfirwiXPlZ/CUn9HTqBi8
-/deimGN++0DfiuEVvDV/6Bl6x33rL+bzYgLP+APfGRpwkUJ36Qe/Qxk6vpxahKBBjvXBH3ousLPwh59H
-+DiSp7NZsZjSK/yJ782Kg5GEP/YZkFP4Hfyk90N4Q24vwwxKNv/lp3maj0p8g7/o7Sm01/yXnvJ0VMCQ
-0Q9+d4EvLugJi+QSsXaqNxub39mYf/ezIT722QGHQPQzSC8/+f2ZGzT3IN8MF0/vzQ96Z6g4XBwb9IPf
-zcb4Zka1n++ap/Nd+n0fft+n3w/g9wP6/TX8/pp+fwO/v6Hf38Lvb+m3Yfzhyfyxz1g3/aB3+My/52MY
-G/iDz7AqaU3mpzNUZTToB70bwzCb/9LTZLqAwcG//KbEZ5qlt31oivkvPWVXZxmMGP3Ad6O0j7ONf+lN
-Zr5BNvpB76BRo5x/T97i0+QtPoPvMQiYzR9+nuLjlJ9mb/FxxqmzyQKezR/7bFb4mN/BT36P6xb+yDMO
-Iv7FN5MU1pf5Lz0VpIuCV/wT39M1vOthseLG4X1TTOeyb+SnvKfVVbiVVSzmNNz0A99BRsqBBxA8wV96
-Y05cWor8i97SG/tUnDEBlJ/4/l/mxb/w1wyqmFEdM6h9RjXPFn2gPPAHn6EIymv4B8gDf+hZRkaNS5kN
-uIP8i9+OaLToB70bmxsWvIK/9AaZH3iFP+jdNMXCzB96ns+KCSxV+sHvrpCG4196swD6bP7LT+MxQrk0
-+Be/xc7wvMzBr9o84196wyR4bmnwHFbvnFYuXBT5NJGf9B4oAbyEv/TmHB7P+Tdt4rndxRDqFF7kvA/n
-+ZzaAX/pDSzP+Yx/m9sIPpq/+AYWPa34BQzmgkayYU7NRpf+wDPwd3AO4V98c4kHyiWeKFukZvr5r3g6
-zOhApB90Bozy6YsU+yE/mYKfEvk+pfWRjcxXPAz5F76FyTqTHZ7RDs/sczr76ywd5qTz8V/wPi9pn5dv
-eTdgO6bSBvMDbiX0Cn7R22J0dYarkH/Zt9wG+UkrPoXjRbXDf0FpaP3O7OqdF7h8zB96vsBFeXFmVwev
-DHrmZYx/G3DLqTCFrdobkkmzgUjiBuogxy937z/4NiaduP9ZOvH7XbweIVdXe8faX8yLJ8VgUaL20r/5
-+N+8e9ajWXFphtnXfUYuW9F0XkkIShvJie9jd7vlN8Etxrd5mjNAokprX9uExPJWQpV4dxnhi+01sTCj
-+KPhSLNJNkM9pMlvi8aLRDF5bBaRoTQgNcUzeXJQmEtIVnn9FAo7KC4nlZdPi4us8vKnqX6FpT02TCUe
-39G6Il9tlXXfoOa6bz9NvS+Gjrg78ZHDWkmT72nUfjAMECh6Q5OboYA3s+8EjSrFKyqhMASTMnfhEk7Q
-YY/x2zqVS9wjbwYpRrKKl4zTocxUumyWYHPycX3GHx/99OrV82d0io1hrZoj6bW/D9pLdsAbBgGMK4AS
-QbQtOUYIvjSHJRC5xGIQ7wkWLLyhcRnxckMLawq2IkODZVgfV0A8ytn+Y+pjPlg4NnwfguWajC3f4Lp5
-R6WW2sCk5051ByCwkYezi9kYAUo1B98sM4+mZSNe1KoFotRqieB96RFHk0sSwCol6363215Oobq7u8pQ
-AklRd/f+g1oLCUdazKH3NZ5yDz7LHP/go8/O6Urh487d5GxU9E0b/W3RRaCLmqPz8fGxpHtu/ktGJ/6J
-E00ih5ppoXz0c6kPkbR1dUWTxA7tQyCyh+McEaZrj2ydyj+wZVQloTM6Qmv7ykFen94rFx1CI+3B937K
-xWieoyYzltx+9PKASVEkNVoatTY2+SgH6TR7Je60k/mTYsa9C0xA6hNKWQ5R3MvpTI4kXYkzcmxFpn7y
-4KvkMnTp+WmFC3p+uplBCtrbCsEXKhtZJx0/JVVBZ9erYlk+SUM5ZtlZDqjRYuhF27pcVkBNFmBOdhIz
-6Inp3ODt6MrcAOcDdGW0RhhAF9oYixAwS8wpycRojlzE0LryA04sbvvnz4Awnbz6x4vDYzjIJUoZM0tJ
-Y7IY97MZP1/zIB6/+sePh8QpPj9tvmcpA+izrmWcD388fAolo5geijfJdx1/9XxqkejzCaxKOJGTwpED
-4AIwGIYg8pHehRimR+aOb/iSowOPfjDCB9dQARzgMAVa/UChOfDaR1BRis1AkBCP7aBTXRQHZi6eFew5
-DfQU4H0fPuwxbBYT51M2nk4wRAj7bHS2HNi/4UZSCDiK4WGPs/nRxKw1VCAEuGM3d6XxWY6EvSza/LUB
-1sAY9gAjTU6oI5K6h0jESxva68QcReKVihfGrePpLx+6IEy99PTkBCW+En6hNr8dmhUdt647AucO8JW9
-91RNN+l0Otc9bdLSeIFxnJOLvMzniNBZBhCdtrvbqs5tMzHmC2CKQsG6QNwk4AaST6ByCk6xJJj/5sO2
-9qC1rEZtY83dTW2QqD25ar+/sg+Qtxq5uD1T4VB6uZtEDsw0BUypORgT6AF+EhngNlrKLabm3jfAAD1s
-qQ9XvNVbRMGD3bTfd3hzESE/NCwpEk9zm1mx1fe1hX7J0Q9dCQQgBi9Tc1fURGCcTtIzciVQg0PayeSI
-IyhfJleGXQa+fQpeqf18lJuhNmfR2SIFZjljAMEJExlV0vy8KCHk5jDjKLUTc6tAh2M45YohxVUyczFc
-TEeEX9qxmlNVjvU6gxlV8U5i4w9L9aZ0iMaZrhka/FvvMvkaBqVrgJVKD7/2KAYN9bWqUMYCHAIQDCXB
-A7dtnxvQ0TShQ7uDzEH2LgXZRZvyP3z/fpzOzvLJS7icdEFpMIAavkp+bWTjXxvX1ySdsAUuSvj8X8f/
-jWMWI+qbdrtF2nN76E4Xc2GqmvmwXeGQ2lZC4IUWuKFZAADHHf4ZrkJmUST7L47wTKZY0gMU/wh6LpDw
-XjE5HsyK0ajHSUC2wxDAFCy2b0OC33T7hv0lGESpuAEjGnLATdAjwcc2smHOwRT3QJ+5dWzrr425mCJE
-e1SzEySwq9n5jivG20rH8CxDawSI380yOzowc4e5MUibfNHCIUMeOdYrfurA9n4l0XCr7KHAh7kMaDJ2
-wETWgoTZ70REhdtuVpeRaYDtX4Da88KtQcSHc7A96gtNmFmiW7Fpa6vqMaQ6G4kQi458RDIwOxfCz6Ix
-iJi5mWMGZiY3Az4Csmc+Ykjst1k2BXvX83yeYaQVs1ChOCKOGF7O3OkHiCIOBXaI0S7o2HoMZb2Ceh7i
-HJAlh5N5khWGfp7pJzBqUI9kD6BenHupQRmuH1EXrl6wtlu9Qd21ekYts3omFa56wYpO9YY0e+rFpe0C
-s+PPXx12rXq7UbrxVSGiebjaiPTIHpMLjvJntvbYDOs13bQAIWKAskuwH4RSKMK++A5jCYZaDth6EONn
-0WUGzZsBvryfm1bPrqA4WhGXmY1ONHZYpNAMxLY0pDw9pSUBeRjF/fLysnP5oFPMznZevdx5efh4+914
-tPM/nr3aRoR1XAY/7/94dHDyav+vJy8P/3r432YV7Pzv1+n2b/vb/+sN/+2e/Nr5dfvX4Zu7/3NnD/Wa
-AP1MZ2uJkeaxpAvxKzOr6TE41rKAF775EiX8EAhf9xSll5IOhC0yJfoWXnf8zKTHqzSgDQPSusVNKBic
-DtyOsSHmJD1iR3nzCAF3GlSZd+7VZm9ZN+igxa/N1zfK0vw6aj8G/gIvXRwwXgclOO0aNg1lisiRpVO6
-HZ8K8YMjC938oTwuwXmoAEdGK7fkU8yJ3KE8MBfTbIUysqWhsCBgaMCmZHHWDC3ZTg79krtw0qCaBlBp
-lWbGPGZzku6aXH7dJhP2HJYxZJvwX1Txu4xi26u5Jlx/stJKF9bDMH/IPQmgmTnxvQFJJyi0JdtI4bcA
-mLiW27KSWe39GxfLiodOILzzhQ6VrG5L1G4YWEPkBwt06CEsUffKghk4VxwB4hD4anIDOIY+on4mTOHg
-qeyna/aY1S3t+DE+GpUEjb1YLlGootW0HUWlU8p8HPkavMHMwbFVHKonIAcys4aCfBijMaFRYWgHcw1c
-zMDgku4MjH4HLCGYMSaZWTrIfwv2J18J3Tb0HKEDY+8QV8A0wIziK1EqwBo0z4R1jgUPQ6yHWlC9D/jl
-GNQgs5cS6WEF6F7MjR9owwIOJRoUqh5M0UOfZafjwV4Eob0COL7IyqFsRBMrsrUoBMCUkfuFaRwwG2MK
-Cxmb13YDvDHEvdEwJLrx/Y65uKid8VXS+KERhwOg2pH2Pp8ajjE9I9Sr/clQ8XylH8vqq0huln5T7hrA
-Qskn3SGmO7Jy5TDg5SqQ+IYKIdeI9BqgHNO5mU/DcGSeU/7S5dzPkLNhPTSwJ8zDAq3QpRzNUYgKdNAs
-NKGNihSaSk5TyAdnxcRwKl56dGazMBKlueYz2/LPEsBbAflyx5S5fVFum6TbnJsXrOwEBbHx++wLRqsz
-lB/GGs86YLoSG3F25UpZHv9Mq8frV75GUQES1/jeW8+sVfciyP6dtOIRhfidqDKc83gKbVin+WSR+cpr
-1+if4eBLHrLim0uwqm6RXXOqanyMuuIJRTuq3Fin1VpwEBKftoxN23XAFx/YxoRBHaU7MLBwNUXlhh/u
-w+txGDtTD1j9eev5AlpBSU1ERr/QqOKVDUNoZZobJ9ZVqla6kl255BlCMI2qsqi2NawgnBo10KouGKmx
-h1hI/2B5f2XWNwiauAnVBno2FHyRJvV2MgWoGoDwNkwzIduY1cCsrzl6kE1Glcti3EmOUwhhOirmgOxt
-RTdXQjzJekQJBIiBelUcY11Pg+ZbT8m5Pl6U5+hYhgjPwLUG8+igsoJDgCqsTQ0XlW9bsN45whJeGwQI
-3pHsIPk9iW/goB33kRbKzOdrhVmJHb+bIQef5u+AxjYcbqTiqUEINyJ/8IbD8fHYbkgCVuRLPluLZw9s
-cn84pIDbOQLnm3sfWNjaE5oFeOagPZsUM1Ipnuazcm5T2iDFGF/4e27nD+3ke9OeHzBe6PdS9Q+g5TW1
-fZEuDGMwY19JAXz8ItneTswh7coTRRToeTogZuoAttDlGcobxnCJgc23U16Zq+e7DqT6H2Y+QUy8DR4I
-2/kEzMAFSNIN86+T6lZZ50SUDXvk5PeiYL3zEIPCXRT5kGJJQ+d+MzelHQreYutRSqHlaiu3FPI6zWvw
-uUMasVhcKF7JPARfJWGewFbMP4hEAmv6/KoA13dHoT0t/WtP3C46GmCJA+1P14Pv1FgNXL5fHffHlIN/
-ukFx+vCP5Vs2DkssR7yywjMa6/LaHKmMzVgn86MxnBGyuOhqw5l1UC6vwLb6EEUXTjSxwn/qsAv7qZrR
-+WeRT5qNRiXKVCWfI+Y3irdcg5G+TqzljWD9w4CwXiN0+GQvFoNtXuTIYrAikIORWS1clZ2CMj2FCwco
-yoGcjWaArm8oJkSyY0TGobuxE7QhMQYs+ekk9vQqnY0f1AFX8HYgGfDOw48Z63DNKIcfMb7hhjPDC2fz
-67qOt+CYnhyZUFtpR4xfK5x4kN1u1eWZl66oYMLVDbZ/hWGjKHS/yAoHcLWdOGkiifvcosK2KfMjOFcn
-Ev2aZUlWqqcxmtG6E2IogMlzyZM6mOUIWoR+OsigcWgTxDcEn7dx/htZcOgbPqKtPjKNPQd+CJS1CO+K
-zK4E0Rucw+E2lA6YPo2zMdixpoyAT2UxAR+DhSkoPFT1zYz1S6Mr5rgJXQkQE0yLTNni/d3qxBuGeDos
-eYDTaTF3eNMpqSLnxZT1Jd9992UiR5hu27BAwRv1B7uWJmeGNEyC0KY1bQDup/RgMLmzQ8KMGaTIMaxm
-foVRHaUlhSe5MWVQYS+8PaL2q60kou6uhvtcR5bANzT3Au+acNsOXjEJ3nNSBiVhsO3yGSHbmLoru+aO
-kzu2lNvIJVZe0dE209R0TNYHdfdwd3RjZ+2wSHcxYfXqbz+FfbAFtEKhgB5ewPHRjx8+oD6tLvlrW+ob
-dVvxB0T/Wi3jx/SOpbqFCMa3ia2XwsQ4ubjAoZObJhuSm86G0BYrbPJWUZDh8aKcF+N9EYlGWxoxEWWL
-XilIx0Zw46g6E4hjTA1VJlCzdeEGsjvFD8Emr3X4o4qMTRY0J6wsfhVtrW7+XVQ1uwejorw76+xo1WrT
-BCnwo+xdTVT8baRGai3Bmi1FiZZCad+6+0XvMKEB1Raaa+pPEzCcxT1bohGgpOyhgQCcZ/BW2tbrqOxx
-KlStxm9CPRXynZ7on5tf9HeShyWkLKx7Y2q2Pj3zhZ7XwchSaCoeWjrWmdMhNsIN9fJBja8uf+VtNqhS
-se6bDY3tv/+koxmpb53hjewKM94vs1HOa5g4FpIpA53s4Tq29vg93SJv8IO+Sfsist6Pch6tqRKQZizV
-CPx7nFE08rc4o9oRArr83MKgYmreXBPrG+iWRiWeU6RxuvQtryWR6EnBLc7KsyzMJpjlGMpdtWHB6we5
-+eGNS3Jal5ekFjP0Y3P6cp1dyedHJTjrMvw2A5QtcnORG0alho4d15LD6ltPeihNWqsGx03oGqpvbQ1e
-J/4GolUp3zWrzmXBkuaVST0RrHTIq801cWVtK5Pa2jBDzFXoztquQmqGnYGPnvCK5LY6ncE8KkMhNa2V
-cqqTpqTz4rtdmn1Jgni0u3KuaIXsO9QsQLcu8gFhvaZthoGV0tDmYTSUHP6SSEtu4fMZTVi09+bktAvo
-zsPI6o2UE+s987rVcv4/9v69q40j2xvH/+dVtPmdGUmJLAO5Wg7JIQZPeMa3B/D4nMXxD1qogY4ltY5a
-MmYmvPdv7VvVrurqlsCOk3mWvdZMUHfdumrXrl378tkigtnZkxp//Wswq6GSWitQRc2FgRn1mi3vVIrO
-gun1Xuyzot0q3btTSqvc1pGJ8G/9/ox7M6dPtNouky+9KlFLiAzkl6h5xU4Evd22q7TaiWq35m2OVOmi
-9vz0Bx5SxhIKUDWaKaF6Ru5mAPJ0XaJvEPl4aimqdP5mNhNHsutgKmDr4Xal1qzG/AiOUYlqG0KczoCx
-HDCbfb0bHGqkJ7XuZJ7LmJSTedAJ0urjcHdGI+WjFbedLwvRlq73d5+Yb1cv6hqsdY60cMkHdUlFKl6Q
-3Zi7ZBcnZpXUIcsThxCKhEvjXvXDNFLDvMAQ4ehrm+0kcF+lF5hXpFqJHN7zYsJxB/Rbb0BFB/qxl9HS
-azIe3Rt/qn/SstTj6epObgEbFcc96D/EFB8a3qD/8GG3Frugv7mBOUkaUp/EwAr6m1sb1dwoEfQBU/Cb
-rg8z0N/81kGDKCgB83wrnp6kKcS/v7XxMJ4/pBKvb1487Nro/P7WV6vkEPnq689IWp8OTsQs7LgWRwuX
-E1KLQlKlAJnDfyfQC5CnZoSvzB1pGgHQihT4N8LgwsjuZmAtBDVVAFKHqKEqJoeLAQR8cRwhBxAmLw44
-+DCj1NNkHEPgcVjP/b3vMVQIfN2gMZCluXg+lxo/QJc/imHOvCiz0Tmc4ZgC1vyPHeCSyxTuF1dA5wsM
-RgA72xzRhgsIACovkTwlJIqMlpAXO8ewk7y00UrcZbomacJ8QHVKKG/I6VRnu0dmdIodopWeTIUos1ya
-CiNzoFehsJ6oGb8NENYTwZZ1MFhPXhw8C0Cw6kmpGyPlJUhYNinmPuWggDmnMFoL/nzKO3N0jevK4Wgw
-VRBpm/xSXJkJmXWludNfS3OjMpN2Dui/6O2YzqiwYSJAIcUMOBRIeaN8MudQnati9lbYAXg1S3PMd2bZ
-tLBkBeV/BUkP10vG2YMFZoUee6nAE4W1pezulUWuk/iMVDr9GQl/iBPb9plIz9D+UyB9iA7FXweGWYPA
-BEx73tLC2K2bov3XRchT80eLI0KbEbeAiizeVsDxzEGOJ3+Mo5nj/OuPCMaFx+I3n6G3/uCzUsuYK6Bv
-/VrCljQcLh9RdOrviLkll6gGHK1qkY+Kw7U60tUqqFWrAkiVHm6JLqrf6CQHewBoR/fiUG/M3BiuxpA5
-Fa9V7DFyWlHHD9sdB8YfZPUKshzAQPefU/Doy4MXL/cOjv77ZO/g4MXBIV+WarSHZLlt1cKJ2Cs6WB75
-pIlpOcxIPRRoiZ+sra60+lCXIqEFsMlSiOSWZU7DM4bHlLjXlQ6eY5wCw3AYT+9yFB+qeRFi977TqmQQ
-XAD/VeNTKCOhdYRI28DPi0coUfkTylXN30at0YeF8bKGh4QxsbVZhvMhRCdwjhwcjpkkarYXr4HZMyRV
-uB3yBAENILYq0rVt44sb+jZMe0Z/cdfSUG0+1qrZSR3mgNJBI3inA2BQsQrf5MFFQDpg81DgIT4E7Ole
-ze4JLYOYe9diYkQsaL1er0PB3VyopuFjaOkNlvEjwG81kk4noiZ3zoRFVc2vhULWzulVk8a8wGac+dxJ
-nPkkHb4DvImJhjwCqdvCwwDBS1sDeAR7Gb0OIMbA2tfwdBxkl+m73AzSjAwT0RcufoETCVvtMZFaRekZ
-j4QxI8OgIR1SRB65msQ8HXhzg6QfbGizXo0aYReQ049WIHW7polVKEvGF38Iq/CYhBtQA7uo7P6qY9Tn
-3f8hu/8DiFVvgOXUag83krvlZDNcJ+UDAOgWXHsR5SMtkxbNwhccHjYjuC4Ee/AgEK5twsbFhMCqPwLl
-irWdXXqeVeCufNiFpqPKiSoBqVI7t6TSeIilYlUcXknrxF2stEStUoUBfdTtj1ZVczNCGZhZfPOcedJh
-MG2XypK24qRpUZtnBltZdV4wraZEKIIsOrgWrEl++pEZpvR1pDpeMmVKFx6ZtDPfArrivEUvZRGrKU3p
-mTKZVjxlpqP0LDi/4EaDiW1Lue0KTiFxCx1WuWRi0ZZvUY+wr2HN1HLcqQUusfgbBcv+GGkBLdiGozNP
-AAL/ona0dwV/K8zka/MZFP7pkiouKxlZPD9M+YPWblnvvJTcY+P+sEo1wK8Se7LZGHxqIlid2Swz9NQ7
-l7tXbDkpyTCx3B9v7C0NrKiuJpAXZu3iL4k1QWv8o11kaYF/0ut6fq0m5yV9hMwgf7VaFx5kuDgWACE3
-y7PxyPznB/vlo2xyMb80z7780ome/PI4f9Mj0/Xz2qWtFLXW35uGFefl4C+ojNuZIpcaiPV9VtmI9WM2
-E8fuaBF5RxLKVSS6VvWhMi3rA7UVPlLlgkOkFXmqSlf4Zyv6nBPlrbKHW6sUC9urI7zW0jKtJapi3wD9
-0YzKEa1d/+H3y83LdeZfz8xbNeF6WrP+1tdbMQPtt58NtJ9Q6YwJCD+baFc10VLCxiVGWs7qGDHTPi3S
-4QcYaVFl+EXMSEtdfmIzrXSaOiutNdl+ZEPtvjfvtzHV7rsUm85Yu//kYOfZ3u9qrmW7Js3Rp7ZsAp2Z
-U35k/rOaKZJm6c9ijPzu8xHwCY+A8cVn/r8y/x9fLGP+44tPy/nHF7dn+2Yk7PBzF7YPPX4Knu/m+lYM
-n1MoK27/7G+fhNWPL/5wPn/XhtBubVrK4L8rHhnjiz/LefH9Z+eVP/oQAWz2j5gx9UOdRp7mk7fZENXq
-r+bg3OafQcHbf48D6A7OMC5ENizLb26fyG0V3xkuCNiJSw5LhPR3NSiIpPz5GithJAQm/bRb97wwFE9j
-3z/H782GzEPBJQrcGcmpATaITUWoUPjy0tbyWK9quC3sL5qmdWIOQBh1XZpWgI26Kp1l/DIrM046D9rK
-LwiOBiDJwbUrGwIQOZqi4I9hdp4uRvPH9hWcpvIU6dV6Be2fuyYwfRY1goc++BhBdo4RWOPaHPH3wPoB
-dLpwQs8SigNCZ7MgsjXhltHxLMOgOjTzobHObO2LC1NdOeTgcc5L7MZnnl7jeNxY4HtgdJUBIZuTlAci
-zmB3pjiOsLlX8YrtWpeGkhyQGOVHO7OusV2y0h/ZINg1S0PCx0eGObnQVyH/J5k9DZnyxMGsh8vZwSy9
-YB0xVJSNp+Ik5BWmVe5YhquQjv0EDeaQ2nrwehezdX0DR9bW5sbWNw/Mh91HAr0vU3OJoWWhcKV3563E
-K9yygYD1/OWroyUZgEOe21sxM/AF6AxxkgE3NatJEawnL9HZc3v6zSMtrtmIP2qdF6kfqcuvIGYTASC7
-fk1suu8PwQW5eo8pGJnU/k4+bHL5fjyC+LhTN6hTcM2+wri5FmMlkBsPLrqL5J1yYLcCLvGEUywY/Uwd
-jVqdQ5cBUXp6x48rK3zBhm3kvB3doFR5F0zWO54lHCnynp6eZNXpmR1srFv+lGrHrpr85TqXJ7HuuUFv
-2orJY+ItEj1PVxl6+MhL0YxrszRD88qXBTwshxVDk8D9mj/NRkWzk7iJVI/W43woeaYjnb82/O0VhTHW
-9C/hivL1utNHS0YpdbkgGYxqxhifGTqp1dAAaIdhD+BP5BX05+Mq8MHysQeZvwNiuYvzGVdIbOcuArjF
-zbfco0jfwn24jB9dfet92OBQB1wnNSenSBTKuQ/+NreWBaMHO9+fbE5ePhB70kteG7JW0L8WeHh+PWXI
-P9A5I2afwjrsJr+Ww2IsWpLerafYzmzSwpG1ugmGhHu+fpacvO2qs4S8C3wtaA+rAwTzYbnNH5vvF/w6
-nHKpFuJ+C2uV98q8R3oSz1gMeZ5AwXNFQA9pmaILxFWaz40IYsaAAdsiKaFfJdBSepFiTioEhJCGwH16
-DHdkEOkxSR/5aoNDCCR0y4Yq6Q9KL4gfdQ2pCaQNwXa+MG8XA0zFcM6XZ0o5+iAvy0VWPtj89uH3WEnf
-QXow/nZMside2VFsf0JWALU9JhZvMNizc0kC15qlw7xoIYACJcILCH41lkDl/neRza4P4D6+bevw+BKY
-WHMnbtsyyitAwwfoNmJlXfS/20Mg7kt/FD5llhsC7Sf3GeMBls7q9+az6+QUivUEUfO0qzakJCUri3E2
-z8cAXQqOuBlEmsyA/jBhMmggMWcctWmYT4ENI4G41qgfM2F8yTP8mzMt2X5QXEaPVj4QSbFgXU9cY3BB
-uELRJp+cjRZDlms4hgKoHRgPGJC/oY63T13Wkh6pW11rVi4ycilod+DqAduJ0+mmGHbWTczx0ypdnBrU
-otz26iNxoQ7NbMLVdQcSHTpe6MQxQ+eQx21uAUCZbC5mBWRDUMsdtucOCLoVo5voNrCv/3P44nmP+G9+
-fs0oFwgZ9mXSeoOAONvrSOPrb1odS4y+N0uXhvAU4VjwT+fSkvxgXwYOLszrAAuWNwcWPM4V/heyNVdi
-2+0LH7gqce0gAaOXrkfSPoZZiPQXZpjA1lBFUJEvbEcKou/uHsJ26D5qh38j6id4Z7ngZBF0z53cp1+4
-OELI1gHeZZTF5lDhRrnJJDVUKbHunFkW/vk+wzyujvpQNzcsT5kZiohWXPPNx50h7qdpnl5N3k6KqwlP
-itm282ICnoB/KXutbnXKGz+cu9OfT7xyzmFBqT7HvB6RkLuoUBLwZeIUwGh0a8BmBcbRMDARx6wXKDE3
-SrGQiw7BHIGgNbjPpXV7gDM+hSPKfAj7kOK/VY9E/8tDpDV96wgEF8ASadbow/ffwrsoUN32H24u9xyq
-qF77m5tfrYZY8QFWgiafJdGE9je/iyJPVD2UHn42N/zB5oYXUwLEq7E3/Ln1+FicYUr8koJdIoVIbbxE
-h06FtM05UFdTgTp9NfRZkuB/WqI4AgplutNVbbIvvBHdRm9INQPF4YuXR/svnq9snH1TVVLUqUgYLSFp
-Xy8mF5BUruz0zaAx5EoSV4OEpL/ZzZULOrtVFvC7Z+v2bvwypDCzvjlLX7F46muLtf6f9H5mjX6gZn7U
-0Xta5GiJiUJNQaGmQEQOL4m3vkAvs4dTQ+pK1qD6WnY0EfXc4mz6iAfHEkShrzc+eyt9Os5PN6aPaGn+
-f9pS/JHtvwyKACuw5FCiQngoWTmIxGXayeX++csM4wFpIDuT4V2suSdT3YifMtZ7ZYaLytNHH0lbCsqk
-Onty4n8pa/EqSkhlX/4HJWRGA7eydDubbtQK7MNA2Io09VgM3HvE6gD/eY6Rn/b8JNzxf6059HyC7mfY
-b19NxkzdAROqWj3K3+aSWiDg/w6E9ZgJwv+GzXcqmQ8hvhTdkNrqRor5uNeTL+3wzZ/rdMg5q7K5ctnD
-TkAnDPPBsCIYynpHHXvt9VMZLYo5QFW99U6oVQ+iwuGD/tjvSUpz7hm6DT6GVfDnif9VlKgu+lmK7jz8
-acuebpSIaHUIhr1XWLBGhIAvICX2DtsK0G+wTfgmdmxd8yEjDuxyxTDdTx09+5vpzAFd2i4lq7nwJt7O
-3STvJkisTpYGVmib8JS8PS6A+jNKP6cALSM07vXFjirwHPVurHMbcVINLOMp3EaBps1r7Rh1fLbicf5G
-Z/n3EoGojvgDGrvRkwRJAXSvYZw5twcha++8/Kuo8XMvbXsg7soPrcuLlXUlQw1GsPOMIL9L+ZrAyMSy
-ak8YY5lk70HDZnEkcnSwhezvCOMKGflnRYkIttKcNUdh5ifU3Uhutsqi+gsRX+BV5j2YMj58toMVWD5p
-jgYcp6lOoNcfj8u1LS1v1LQccgl9nXSMqdH7SfydNEQISZ10PWk42NDSUeVlzq/HsURozzGRSE34plhF
-ORt8JqQ9q3h8Sg+7mi9VovKHyCfbSebrfJMTlXU0ksVzY8rneCu/pSuVsD3tSsV6yxw0hADLUFJMu/qM
-wJ1K3y0DryoU/S1KYLRrHS9vJ9i/v1JaO5D3h+Dzdq2GLWgx8t2cfY4dsPT8VrUVh56oehttBdUMtBWH
-e0/3Hv9efk4wt+iO3eeNqmeoH0p1XZZjY++WX9A/kV/REgcZV/Bd1bGIhSj6tFt6zjTphZbcDW7ng/Ox
-PC6W3hccAHyNZ9tqbdZUrmh2VvO0+T1mYunV7VaTdc+O1UpvKKTcUxMRinUuJXwOcTFn+fwa0kikhv1c
-V9hW5cApLi5Gkk+j4pHQvGB3WbJIjiXMsAQIXcQ2iQMO0rO3CJJhznBuBgF3+GAiV7dVx2Gn8qfk+E1i
-+GIs/fC/tY9NA6dwYlLVXreagsM6tSg2d1tLHR1PNvwmboj7g01t2q6GetLNz3rST60nbTKS7b3PzhZQ
-QuH7BxGekRJWDcjAJoZTmt00M51msxfn53BH89qoLSZ6SlNAoXTsnIFu3XDfsJFIGY27ih6HyWlePi5G
-o3Rasg0rfZfmI1REcLiknZaEEGvoBnJmK0FbsXoHyIeojiG/vc1N5TtlhodJxMbJlZmli54LfzDNn10W
-swfnsDkJshR7LHAKSgyOkLVlwZ3kakMWMqaerw5Rn9im1snlkf6muQW3BtMhvcA/6TlxROY5rjJeRG0N
-UG3qxtxb+v1IXQ3/ltExoxwauC5+pvvsB/TFhvRzvnjv7+kAi7N0fnaZYNRqzpeo/b1WyecXfPfOy30b
-P8s0b3YGppZmMk/SQbGY29zV7vijaYNer9LZEIxlcB7C310WetFFkkJsyREuwQzPxeLi0pyZLVAfXKXX
-tgl7kdkjdDFK9V0SwpxhaZmVXLHrCX1QPrdedrZ17HFUmNNZ/PSSbMKowCmqEAE0k5Ehx1k6Abgm3keW
-iuAuhEAOOAzTyfwKEthjQPFVATNXOnZBGjvDIVxieDNgfCeBEQ5pWNEdeFbtEQUQQF2odSO7sKCA9ezD
-R4Fu7oCPdFuAr2T4nJwtdfGnqL1Q+iIs14N5F40LYlw9SHYXIK8BCboB0fICi0XLL+0umF2glIHpF7Ob
-49XXjYdHAKt5OE9n80rnQ+mJhmsL9qCTo4JnFlgWzZRfyMzg3mT4ssghZZX566jA562u3wvdpXAq4LVl
-rq4hbw6oqCEeW1BX+zKYz0dra0EgDJbu60p0xzQt9l2zaxi4UtUgN9LTT3UE9Qy3ynKiusonw+IKZD7H
-vw2Xijxud6z+9p5r4bff1OoiDTymEErD2TZqDRw8EM0nVTPu8SOvoJv+oKhwTyYuy251SfvUKxZp0uPH
-XJaTN1b3l5kgfLgzb290ZLfsK0RDdySJeqV6MHmsVB9O6B1rF6BXfwSTxhByU4BfsOGd/gm6xh67pg4H
-G/JhSoEkpe3Ctg+Ojeo0VApcf4G60TeayCMrEH1hN4HbnEhOlklFh/lTsmGuSXp5enOz50Hx2O5oJgbt
-zbPxVJbQq3IGGhvFJG1BZrUwaJaQSsd2dClgO22vTdztUCk1p8ysm1RfsuBgh5eXR9Ji4zqofv0+Ym/1
-UrhXhunUVtMMKWCUOJrIIGkVXBORJbA8VLin4Ztqfe1Bk81BdKwVNES6ENodYnnzUtbVHpKVk88vCmuG
-rL5W0qupBAtdIwI+sgv5Mw8TBuS3YLd37TGhav+EE8YnhzozvCI0m3iQLD9CfjN/wuEJg1fHSYDuy9JP
-aPwOpJSulHMnyyxchRpJJDzmLYV14TPsOcMJabmbHlIPRJ1YlXyro+cOFBBcFH8/khkTmnu05umwvMLJ
-j7qfunZxdK7VSneezmjpoIJW8VzEKWySdlwBot+WlVpBzIFHqli9QDRjQUg3CLTtNQfDvB9pFDXHHU1q
-+xORzsE335xAYgrsipQoroGDwrBzuTDA8Sh72hkctHkG8u724Vq48aU0UUbvm3T2caYu7eMvMemnZhrN
-B50aYR8APDlYi0V/Qt2F+JXcfsn+XpeuKNDYtCjLfDDKGNzH3JMuzJ6B3DFoiyPyNhcL+Rp1vpkrxqLs
-Wpvo/h60AVl0c0QM5VCzOWfQNXMHFzLIGEZtFotZZF4+9t6uCozB/kbBLyIX+kKeJ98tETTd3h/JIQ/9
-Hsd1Eu3Om+A0kf31LJ1f9sb5xN/QXW614x8+y3lK8pNV17pd3K/2YqqqPlj020s2N2E5y8pllehT7tmy
-GxBoAemSyZLFviejfBqhpbKr7l0gVPJ1FsLGRnwJ61Wk9B73YAR74XWKx4loZCYnYG+KYclcQ0F/jeEF
-4LZmoFmq1UlZ4HVhJbwiK9eEAduDQXdqPsq24+m9l0oEikkSK1XN9tR4+UnhTnktvVKyhp3RCFsu22Ko
-s+P0Z9uTlod0H207ZuwXoHVr2+/r2bmIjSkwWOgTYNUmlo7OejDAFBs6tyKBmWkQT2OKzZ4h1lclJNWn
-BOwt20cLuK1FN8eX99otzSOwBPGOzlpHByFoTWyyzZ6NAYJ29e7MkNZg46Bx9/2v+MnTxphtH96lfdjv
-1bhwLIOC5cM8orJ+RKU/opBZNyfMPXTKIkEUi+me+5ubGERUr1Tub21tdus1xub1V2iL2Ppsi/h0tgha
-CbYoYcGm1HYRU8VHSSHXkNY42cZrTuB73ZAGWberk4tFHK3169ujbTWkSvZrN+VUVnYSzElBdoh3eTEi
-afCsWIA51/S8GE+VGxMFrwLoY8m6dYcE0Oc1N5L3a4gdQqgQ9POCHowkT/5M5uvNhXX4q9kt4CLmegcF
-0zibXdAmgWbU0AwZcpo8mg0AV+LRILXv73o7y/mg5S5t3NUstUkDgGytfh2C2OHrEJ0KCB434EUhEjjS
-tqHsYnKWj3Lyyib9PQZuioeutGdHwrlZ32XlPAegBchlAYyApoVGr5AUeCfBeUNOTvbz7XY6w6iA+l1E
-B28ZkPeanx4hcInyWgFtsnPrsC4dEDtF8zoxE7iAPY53oBwtVhd0DsJ70haNrmnSB9eGCsyW7iGvZ/+g
-aL8qRXyQWc/zSIY6Nzgx/LKS9MGOUPkWaLAXmzNX0gQa6QouQkxNSHI0RIwW7+IlKvUiG5QvAH+vC8GA
-rh6pAkRu2Cq7jlIJGctLm7NLVYrm/ZeXuK32DYG+B39T56FkJ+wxyot02eRMGeSIl5eOpNh+NOGjBz4S
-ptI0i6QMNilqDVzvzk2DixlCQpQ57kZSwtnN7aW08ZdN8rTAN5nvAeJ2yXNw6+JwImt9wBsuOzKHU0lu
-iL/hm0M4SmcH6MsGs+veG9pwP7hNUVbJSJ7VzkkdTeGUW1JVhEUf1dWdct4cR3CRJaVqzquFOPWQqaSB
-b7dDotJCuxpEj/z8UGtijtJnXk4TpLvnYCUFur/KWkPijBQgDxAYp+U0nZwq9820hBMAfYKGwPchydtg
-MXfNlYhp7ELsy3y+ID55hRZc4nWE4jEHyGDTxAzvGWZ4RuIwF5COQiORiyL4W1mAEDRewhSlJXm+insS
-v1ez6Hnt8GuJuWj9AF8HcY81OEJ036I5M1PP4FT7uwiu8aOOl9Tr9qVt/gG0/yPFSnYi+1MisOb+QWV4
-pUvVKJ/asLEUP5yY/8dBgN0cfniVV9xasa1T2QnmEMzyd1lsL8ggvN2go3/sKMHfLsY+Q5+/CnOVFuTO
-h/A/5vdhhcmGJaV/VdKNQm0n32uODopD9ARgaCp2/mKfV0G2NsQDbgcCzPBc8Kt0Y6bsg0K8u915a5jt
-kAQjM2OLVDCacPPZmIWza5QIdHOI+aB97cFR2GmAIqeP//0uZKAik8by4VazhGGGQ6VxCvmcBu7wu7Yv
-ap0EF5NalitLtEwg73Eb+7tPZsVYvWiHA10t3NiTVuydtBnZosGrrukq0d/c+qYbvSz0N79CF7sm0b6/
-tUHOdV99xqT4E9xyza0m/YixyZ9RsD9dbPOtsK1XQu+YM0EsCZWWYn6w9B8PdJ38ICNbEerawlinEzST
-hYFdyB88VzmUfACVibcs3BfArDTICE5J8CUxaKlcSDwZHM0vH+/uHO1YALmPF7vlQLDRAHYH2Gs1gA8B
-vobWoMatgK/vFqmVfRT86wiytQu+qxIEkKrNGdxNeEw2GaVkUW0PQY0CVvhhpxrhdRTssdvEeEndIMrr
-aO+/jnYO9nb+bfCsV4W6qUwtAN7YPe4ivGy57VjAlYuzsOUqoSx3AM75EOicxIflxovZqkA5ChxH+FiA
-y2c/s/Cmy0fkUwA5DqfwQwD1fAKo4AAZNnNdLIi/VMKSionHvbtw50DvADiILcfU+D5uALF+O17ktw+D
-YOPw7rSUUSRBO0I2uP+wnWx6a6sOJhDlEFeUnLbnlLYIQhsp+W8dbmKsD40iqPaA/Hm88cYt8Fpsleg2
-6m8UF44dnVk7aZWWdPVbR9gFEPeGQ7wGcfadRHQi/+EmRR9+WrmBnFpHADoSXGunc3cbOU3ai4kFcWbl
-0JUcIxya13N1jyojYHk8TcxVY5TBis4kPIMH1wKEsmv0c3ENoSQjiaNTnZs8CbH5cWHadWjzXmAdTbvT
-5PxxQbR330V8Uriks4cq8WrITVqnNQVPnZMSJv4q8bIW44L+3lqp9040BYGfYcC9flfzfBUIfrNOOxS9
-gSjlsrFZjMI7HsH1gnvY/t7D0N1ZYgYcgITZcXj5nWMAqLcVIEoDV51jQEWbyRPmBTJX8xvUpAH4MLD7
-jxWauypK9mf4+D9zaCv8/y3B1xUd3zZOVUTs/7dBZZchyC6HEPz6szvKp1LU0UHzM8QbmhU5nBtayi7q
-XU9WVzVBaWVh8QurF3dwAoEL9RPRDPluH/qVbflg73Dv6OTnnaPHv+ztnrx6uWvm7JDdz9yNve83DPLA
-2agoY1fSpqnrgaM/PXLz5AA10BkABvXk6avDXz5gUB7XOh8tykvsNhvKs0E+GbZ1qY7t++hg5/nhzmMA
-mz15fbDz8uXeAXR9HB1TNz5/b7R6rmlC1FrzBOJZOcvcV3pF0EOdJcG15rlW9ZQPhamkX5AiwjyktQNX
-OPf29SydTiF3R3WRLZePzZZ2rLQKTo/aIVh15WnxfCTj5YU0Qurqy21fm1kfg88665oQRsgBKaKRm830
-kr+EnOrhPEJ92ik5186zU2oNY5dnOTrXgKPSgAjNXLcxdW3qOfJA2g57RQL+KRJJPrruWVvqwKNVNfsw
-CHCh7iZpNxl0kzNzXdfiQzoy5+fwurrFbrcnWRi+9UYmvIs1dXeTE+Nqls/nGGYMP9JrFPTeFfkQXMVn
-KWqHz+igtvJL/GMcAcpktNVcRN2GtfsD22PVTFJGlGoblh/VCCzxebH2vwZpIhQBNL/vbz5EC5/Pq/tb
-G3T2f/PZcvcnEAj2J78uwcv4OTNUlmGOBExp/HK0uAhNYvEyco6TbF5bufLa1hvlEJSJvhzgnMLuYLpq
-pIQ1ItIHqnZfoBXANyPGC0kbe+Aa8jRL39WPPlrE1r8z1gjUBr2BugCYO+55HtjeokWk/rNiYGjjMD03
-PNnM1Nnb2o9oKvmHm0d/Nwfm2vO34sscL/kh7tC63s+UmSVaid6FNZ5AHqFoeXgTlqaE8pHC5kWl7G6d
-xT1WolL7fEaJymL18F2lBudjjVWAV2F5m4ghUoHehTUsGGKkBr0LayjjWqSOvI3VWkIBXpEPcAHA3fkU
-naZChlZ979W07D5Sy74LahAS8i+o9onRRFDijim7Y956kYqxYtIOrWYtk6u8dvXI07b+nImVsLXBf7P+
-fKi8tvX+8bclzD1Wwt512ZvCSHwv04ushvBqCq17LhfpYl78DSjFFOVrEpmQ4ZLlQSc1mJqVJRmq9ebF
-K2woLbM2O73WWhQ86HBN9CpLiMX+hH++UXD1X87WIDZAJSDTfVRdn37FOGoco78/eriae2O4B8x6VLC6
-79ru4uE9XxPkBnuJo4YTEn4JJcpIqsXoHaiOwTfkMjeLMzu7vMYb2pSoDH0axPKDd63YKInmflkMeJyh
-pNOukYBwZlZp0N/97RhHWLkt5AptxyCCeTqE5GIuaScqeHk2SkmbiOEYAvXYFsQjAMkmNTxvDmoQsMs6
-d54/zvfI5F/Z5/3qI6LEqMTYjz+mGhUJuV99RCWbBLl+41uqX+GR/eojKhkX+fs1z3mDVSf5OXpYOa9c
-mmVkR/mZfUx8Rt3klTRl99NKLXtHcKTZINroNk2DBp/5J7VZx1gdB+CAo0mWDUuKSDGF4KoHc1jH4g1P
-Qr/oq5x9PdBXh5qj4AN0UKLt0KuOnhg47zio2q4X2W/x+f6sZnIstCjvYKsfiLtERS3QYKh3IL7ymxzl
-RfWOBEh5O77Qr8YX8hzT9Pd9+ZLfccawfiBM8ltO3dIPBEd+a70V+xUxsUtqotblfDwyr+tWjd7zWdi6
-zNJhY2F4L4UHxfC6qTC+79TuMSVE8FpVxIp29CIZY9yrNBYTXKIj2wPVUEhI/sN2a1KUZ7N8Om9FmxAt
-uBzDVfFQbfDY6zUWAxrbDa9+7aVaxTreYeVH2Ta4qL5oSa0vidaXMIiYDkTgFHyRts81YtJstEbdN1RW
-TAUeLLuC19BU2KS+ZbZjV0+HN3ErjztERphB+or2UjQEgUURfW7vcpaddwBVT9yUoPv2g+Of/vpmBkM8
-AZXs/wwedHqGcuZt00/H9yPQBPPSlK3XNcDbdetbEL4hEJV2CPtQUe+SjQnms8//RTXwqjbpGrVe/+FW
-N6q36z9EzXBUK9d/+F23QeHW39zARuPqNPMWbdGN6AhxXZh5iw03qrhMoW8+0EJ+m1gXVur0N7/aag6B
-8bQ65uHX3TpFjHnpdcHaFvP4226g8THPvutW9Czm6ffdqjLFPH7YrWpM+ptfb3RrNRvm7Vb4FlUm5oX6
-rhqtmin0dVgIt4N58W2zR4Kv+OhvfuN6c9oN8/ibbp3ywrz8rilrblT/0N/8Dr83emHvb36PU1VVP5g3
-m9067YJ5SW1W9AfmDX5W3eW+v7XxdczZ4tvPzhaf1rYCRPuBsB+1QU8xTTb0t2M+9rrMo7rbaqk76upq
-ji45s7AgWydhbz0vrvzS/jtfGzWDdXgyKtI5OOR56icE+TofFcUMXpmJ3dzY6CQP4D++7sasO3n4FYNf
-u8nb7BozZVBb5tGxeQKJxdr2b3Okb3TI5e2R4CdFzmqof2IOzmdZCs58GDDUT47fdOHqNQLnv3yejYVg
-MN9bThAFHOULHtrQBgZtHs7Ts7em+sYbEPNP6HzOhtrOz6iTFY0ZIohVhALbhBM67LqwWAVSDN/86Bva
-lVbG9KLjhZdX+wqmoWdR4jYerfk9ZxOAweXCiUslccOfWEzrPG+i1YOcNRfZ/KmZeX9J6lLzLv0M1+50
-lk/me+/NLbo0l13V5FgVl+b1MzNE76ehrZW6ZcS2xXgM7uTbtTsb/CTtuA6puD8oag3iqgsjDc5h+trc
-bm8MXo/yKUCtFSWsC2doOa0yQbJM6EIO1XpeNk0VoXFUzM3eRPUDDDABoOOkPS47pqLa29iGLdVRDdhv
-I0SKxgay940NkMa5sQUqoqs/c70aPoWBMpgJctkQDCPiiQHNpWrwQI1ilRZ51HXNicRSupVYTCTcnHMx
-3khMh43vb6ImXLIjMzyzXazru8KjYBdlaXCyGA+yWc/fKfuTP+dOseP6fXfKiyuwxP3o/KOWbZP9lTYI
-vLvz2stnjQobM8a7E5rtt7o1l8s4aXjz1psXT/L32bC9hXghybisIIEANJ+qwtP/LJ0uIZAPWFr+Ht2q
-fCOcOW6ELs3aJ1nr1+Z8goAdtdB2dW+9uMEJRW1/kk3nb5NKpdoF94f0pyBOnDojTf/JmBXcrz8im6Io
-jBIsYS5Nb8KPjrUid3/3ZOfo6GD/51dHeyfPd57tgXhMR/QwqNaCy0zLvodfYYl0dlFiif9z+OJ5j0Jg
-8vNrYmnwsvPI31xU8U/Fvk4ACW82fD3LvQikfNhNzieUT3wujXcT/KgQe82+5+S86EBrbnvnixFHC+YO
-chj3OqQJMuN7MMvOAWHAUg+4nILrRc0XWxZSIcDjleX2+8nmG9cOdUjrQH8f50NYUfXDbIDjN2GR3nRR
-Xratt6ohjr7MlzzL0UfAzh0/hhns4/9X+Bwvnd6reNemVZDVgJeBtK9vAZi8GP2KTRfb5sr2HycnG9sb
-8J/NbQH3s7mM4eUP8Ar/goTGUI0+zhY+hndvLC2jZ7h8ln44e6d/MWwzP4GLHH0BAV2fkPD3PLsCTZCV
-v1tmtu3qJImuEYkIaAUITzvJuiL/dQYvyzAZ+TgD1UOZELmD/7iZKEM0lwk23IMA2qt0MtftzYvkwkgq
-UzI+cgMIoJ5c5IDSjlUhKSQlinCg2Obu/jZJGWXCNai8ynGTWNgFcHcQtMjC+aURYAUiSY08+Cmyn1Jw
-LOR2mI3zCdlIU7pMmC9ev6Lj+KqYvV0XNYXLXbnKXdcjcviXuXviv250zHY+qXkhzjD+U+QCZfiUtlf4
-VIE7VN5ZOuwnG/bxjYrzFsRwXxPTViVm7xgls4cpQDmozuPeK0zVsRrUbXiRrfam59hodbiS/0ANm08U
-u+dUSglvoxFu1ji9yPYn8wLiH4NN5pgM1Yip3b2d9pHmdLXvtcVX+TA9SuJCjBom8gjeeVGm3gX+Vh5v
-vlEjii2dOh7b3FjtyXi8oVurWRAVN/2SNNePOWQ5xtUIjxFQVIw8QMnw1DvuFDB/9gwrc/IRBav4Dakj
-dsfU80QlN8WcyRvge8iYeq8CZSD/bFOq9HYSNuB3cVPX4bxYvTspazvjB6t25QK6V+tOlXdduocrdkvo
-rat/JaO9bidMqMfVdt40dd1My0G33PYUgp3mPtyfeu17J9K/ioRT+RLvubfdbqrbJSD9QVrmZ1XCv8W3
-8R7xR+eLao0fQnAoNhivV4L9hszK0HQ32eysRT/vZiVeHeW+KA+VuUKWbIGZ3n1Y2wrfIYZnu+NgIz2+
-42MwemeALhYgWkK5JCK4/QOgySAezpXsdNAJwGPYnOckhgCKxERt0s0p4HtKtm06RW5chxrAAFl+0+f/
-VGWj1m8EqSHAlXzkdZOXrOzcXnFywupig2oY4iP/y5wpJXK57ilLi9+VaSl6Hf8dJJjgsJY58pfVmqxw
-YKT6MauqkEbNhooeojxpfnPus0lY3YiTxh8nsqw0C0Q2/izYvm4xG6SBGeAbHqaeoGLa9tive3esiqmL
-cvLldvWqFxm/vRbUfALMjRpWp6Epe5FYeTYa5vU2jbllo7r62nHsar9h86j8Y6bWt3gp6Crd0WdIAXrU
-fpwJnuDLINlTQ0nXg8etADQLxvijw8O6UYRYdwD9KyzRvAEY2Bc1FsvDbIFxxKB11/sPH8bcXqytvr/5
-daNrCvvIfIs+MoGJvb/19QY6gXz32Qnk0zuByBKSx8fKGBhrkHOT0ORnGGaOSGYLxAQamQsS6EEmyWZv
-a1wm7ZTtcsl+Mk6HAEPWYWeS50cnj3cO9k6OfjnYO/zlxVMQAUwlC7V78uLl3sEOoh4c/fdLi00RvUn2
-wU8aEBQZ1gq1sPtmZQ6OTp7tHPz91ctoiWcv/rF3svdf+4dH+8//1ocj/V2GLw728BV4aZrHlK0LXwAa
-5cljM3azBNykulpgEZbJZP8AVDeMj/HX0rmRngaLOTXXGmajrFqWnoZlqYVDIMgyaBWptNTFLMKXlKx+
-vb7bHmSGe8KeHGav8/klAfBL1Rm9bCET0Qmja/TYNmsJi7ycyBnJwJA4ac3Kn0CtNBoCfY/zcpSlQ44a
-sjW50WRopmcKOkDwHSyT9ByyrUtKmOwnXWVUXNB5QWYt0xsqDW1hq7qbF9P7o+xdNnJaPmqHXAooJQ1B
-vWaQakWSx2qZAl1KrAY3xyfmPz94FhnR3SY56GudMVGVCWw4xzlfFF1fX3olet4hD2xerOLu+Y2/UnUm
-HJuFFYwgcCSQ7vx3/ChsdcinHfaTI2vT30eaRScsVd+hUr+iR8F3WsbCr6rqRXPIhzv0NJNoEohwnWNs
-E28/aFtwP7vBlaTP71J9h3ZX5hvPK9ouGY6wslzNjjR20czxMMwFGoTUQ4hW62QhnOffeTXNZWN/V8En
-8gc71a9ePid7Rl5aARDfdTSRENkgegx2p6A63df6o/RlQiAYltGsqOXm71iVBZmx7o2hAEdInmG9rwfi
-aENp2jfcU6WYV09F+a4e4YXLacutBhB1EepTqSp8pCb/mq8QV56Aq7g2fAmy2psd/qodOm+koE+vpWXd
-2rlctVvn7hV067W0rFu69K7aJ1lMg/5cE4FwvsZXGcwKdEXAnqmRFWeY7Ad0WZyODFG/wRxdyklk3vr8
-Wm8Es0/cALV75vIV+nE7Jpy5Tzf9Eketacrjc2tUHj7IsWlAAgpsogM1gPtJ6n7hCdfRic9NexV+2ehO
-1UVI4scAZbuUdVrC+Ht2/W/OO6WLM/hwq6cqVdKqYFqQqv3CCebYfTUh1HqnICv1BPsuuUu4tdcvaEnv
-BV1W9lkBGfsWmds4/Edw0i3h/ZbnQ8Jl38mNMxOwCwSMfwyJ15T1V+K1306KKwjYxrxarj1MXQjAZORC
-Oc8RcDY5RPtyenExyzABYeB8ivsaE5s7szPkFkINA4ip4pytPzIlv+232bVDVnYUa+ZAFe5RU+BJ8iOm
-3NLvGo5C3WJwFvqvmg5DXVIhHZDtt+l8+wDe740O3dh+X77v9/fHMX6PBFbh/JGJugvX95q5I9ufk74x
-xT9WY/bL2JFcP58o1w97AexaZw8GHXS73HwWJZx0HhymFZaVDEdAWOrEZVLFTSrjNCs4ncJ7yq6YzyDT
-4rxYEwahPD2I6UB6DzMQxH6wGiczjnwGaSIGqCdai3LwQNafza+fZuk5HSOsrDH8wZsTuVFJrfDYAcTz
-+EkT3z6d4GyMsHoynOzmFC1koyPsyuC9PMhgIw423aTMUG+ehiXslVyaSlmdkFD+RMiJqmYvfn7rOYud
-35wgQJUyZ7b5+GH2/sW5ua520AC04V007ZdKGAn9G8yy9G1163OojNQyx2CcaRjGvVF7OLPvm+swuFpW
-Dv6aCCIVmwU9Ra6f/djD7loSE736sYdc1mkh+v5Pfn/k/IP0r9WU1/IZDfiQqGr+/qMCPH79Wf98O/2z
-zePbhPDMuPkxHD564yPxkSxDDvCRKur17dGeV8otBgjFB//Y2z15efDipWiqDRPu4+7s4p48px9IzExJ
-r9PZhAROSFKASPgydSpjtE0fSkk4bjgZx1ok0a/pEifWUi8ltnpNw7XQ+tQAhiASd+Evp9KxYl1xFTjD
-6OHFDJy9+zrRTzZZjDN+TB+Nj80+jkJrIf+TRJnFzNPaWY2xZJdwMpoN/7EVMVKS+R/3WXp9Ur4A2/zd
-8xoFWY1au8Xkf1pz9FiFPfCXErNgYHgsp1hG8oukBtMpjVqUdOtahzRJnjPI5M4o1aNrwlmRDLXSaMsN
-CBcf/1VSIIXzZSj0ncuAgPIaA41JYnrOJiwwyiD1QFK7/Jy9a8cDwzQoW8TiDKbP3J1kjMToKDOY2eDP
-mLifSSUrDnzgPsgUKwkoPuyzbT2CGALccEYnp0zLbDEsnsyKf2YTlxRcmY4RnK3vwnVYD2eFi7ckeMfb
-8bIKRXajyr4OG/KRkhLis2cjVQ1/nhspqv1eOfnv71E2uPM0HyV2PqSzNT/B4M9pmXl55dFd2tT2KLfs
-WXrA5E6S0x8vLUBa0BT4LXPOuWR4PUnH+ZkNKTTbAePMe+auQ1J0eYm2nxHchgsGrXZOW/b8oPX+4gb1
-81WG95tQguGuQQXihPoJA+zVZuN3WJcud70sShd66NLd3CYZ7yZ+kv6fF/lofh/IwJEQCq2DbFTAzWCi
-d+4a70ryq0okYAWfvcWr/FtQQVm0eopsPxeArgN0oGOmISKykTOmkLbYMGFKMec4hplflxqR+UHBByb+
-1yJ/oR1tV7L84ZI+lg9m65nEX9IhPTBDQbsc8gHoXG49s2JxQVdY8oawPZ/Z853/uhtqDkOgvyMHLtj9
-56P0gtAIKUf0zMa4G7ol7vIuw+gBwHcFoL2EEm5TlmjASkfSSAC5HAkVeKZNysXxAudGiPonOfBfXRZm
-sokOe25QuZWd2Hgq+RhN1+nbZ+nUdItJ20VzJJk+wdhoB2Tmdex23BDMlcUUiTRzMC6cil2xeGBczLE4
-wVFh5LncfNZLeqpufUTCNwpTnvc1UFWKWYL07jBUlJa5JK3MSqSu6WI2LcrM9GPmBypLW/PI4kyKyX0n
-KyRtylFv6iPldPlaTvxB2mHUOIBWNHxbfTt0OFtMcCRw/+t0aakyfEIGY2BMtqGLiZmfku25+hiok4HU
-tFIiInIVbHV9FWVcJgrlouAVXMqdwCSSjkXYD5n7TbjOPTscdaTyl77OWmZaUZ0BaWGJYYOoQhuDZHVD
-WcyUXH43M6NZOuP4FdR/kIIDdR2ugmXWThYwHRiqMGLTUJQkvImkKRq0vf5GDrdOuBq003TeJZEBA3WT
-w1BNtukbH5Gkjbn2cnTPwNsOpqsC5xW47DFSoRUeCsvZ7IkoMl1vDbmuOij4DENUw2GOW2GRl5dI1phP
-N03M1gdIVOYOurZzURYAD3A9dO/VZeGWbLFGAIp3TkKf945EuNrTkIi9azO3OZMKzJS1OeNk0a0X8RmI
-F2MqCoACWfMT7/2LGJCIUi6zHMWKntsnfErQIMKkaFSQ3tGJaSbKJvc1Ij0mGuyrEkRE1CU/xR/xepRP
-0haz6qyDbGyWGTmhO/nhc80dmzZeihjC9LVyexP5UaYNNbeESejdkrjDy7Q0N1gtNGKtTvLXv1qGkiT3
-/JtobS193cJhHcurN3Yq3KN63fVjm8SWTrtxgcnkMA1olkhMoggOsN9gXjBdyHk2A9cac/4rLamZJrNB
-OVdJNvSmzKpDuc+nAu0Sxkkm95OtR2t+VlwpvA2ZRfnj/Uy6ybaXK1Q57wZt/OhasMPBsAPTgp+FlSp0
-HtVqIv2CgRLSNXycv9GfeZwbOtx6oy8K8U/Byo/Uch0gfnNmkYgFdFqc/0ETiRI4F3jpBFwvEfJL3reV
-so/idB1rzgs5qNAgBjfIFmw1k6tufSnRNmJ6O7+et2K2AsEf/6joksSGpl+TNOu/kUnu0IkUYbacfD5k
-to61ntsC9ayaEm5Rrh1m7bjme+6cwyXmM06aFIc4dx6JsJvPeVdTOyD4wd5EKR83rrof7vUueskPT4oi
-efCjCxUxv3t8obNiMZ+aIJkYfgFHwzA5VQfuKQeh45VlbDYVl9TntPALyPxECgd9syJuk89tbUDkpqS0
-qh8e09PsIj27Ti7BFkNXH1RG5Kw1zUv7wWuJzFl4ZxNvbHobW2VITLszGbKXpeweu9jFaLgnYzeEqbYJ
-LL554k7jON26BpRrmnpoqVk9s4StntGVsPpYbrz4Qka4Ji5ad7m37Z+LIduKoHxHgTlHwbarrpqY2Vde
-yCjs8KrSsB588DbwmXTt1C1cRBSylLa6NPRCPk/dTItpXhGF1N0sc9KapCFeTawigSZzi++LUpmjADs6
-VAWg4mgAvID76BoxCF26oSKof8wFbJYbqWbCDYoKIfMIaImcpqQaaPeelrYcpzejOsxHdHMHvemIbeHn
-JFwjz8L4x569F/jinxwAMsZaBq4PUjU2mMWasdEER+XBm0apkCcQlizny7PN+/BZGvwsDX46abBRDsoq
-h0lVHlJHRRY9JwK5h1XOopH9KWLNElArTjx/kxyxzeGUCp0SRgiycqf2RGvrf57notH1OHheYnhnhIdT
-k1Z7i7WOsnKOKYuR2Z1DPkVUvRWLeQm71kiqlAQB/M7JDQxcskhbDnpwTHZASS3zOavNIbQB0yjMr4pk
-mJ8bUoehiHIcOuIWCmdNpaam6UXWA5Wc6XwyBytRKpMtKgbXII9CYPyuMCZnjb0kUPeWkwEKOkwnVe38
-mnhtuNm6d4/nCW4GvPECTYXImRTmKFXBs0+qqo68ii5QREStCapSzGYH7SKId9fFAvRYixJY5dgI+fl0
-lCWGiZaIuGJnzoK3gJoJc8jn44znH0+10giKV6SDRcItL/Mp8qFyvjg/7yVP8vdmxbqJaT4tMy8M5XXG
-kSqspLxG02ACi8/ltD9/6UkTca8FW2RlyPOGXJdiEe9vbikkcG3z7m9+tbFK2uuHnz0j/gSeERyOXszI
-RYKnNlpC0usCU7yiTC7mXMAhKT5B1xK00aFuXSThUu595kydpiUb9bRx2W5M1J+yLmuA80tsEWwT5kN3
-9/5BHquiELVGjwFwLAKXptsnchq2H4zSycXCcDi0yuF2LxdToDPSzzc5iNwuMd2TWXpRU1xe+UDSZk6O
-zGifSsbcSMVKmcYW0A95hVaw3Ic4t9h6QTacSNWghHVmAf+tOUAJFbMngYeM90q6s/n1/bIu7f6qnjRe
-wFp2NkoJzmhvNitmO3DpGC7G7EICh02tPK/lsQm5hteWdRHaj6z8P0FJ+l/2WoGMvWWkW0O6fAlBTjTO
-jBCLp+kp3ASwqy+T1mmvFfXHg+wcN77PA7lTzLIWuKDjrcIwWsPScrz0ZCgRiDEdnYLxyLOCHfIIsd2A
-zyfK06DywD3O+xcNQKXZsbB732bZFCRu8yW6pUE2v8rgVvcFu3yUzoUDz8tf0vLv2fWrUrwXRGkPBUbF
-xUU2BDJ+kuajxczGVODb56+e7R3sP8YryN7B0X+fHOz9be+/TIkH////GX75Hw+caPi3bE48SQSGFmE7
-I7MARgCpwqn7GgcRESB/EoeoXXJ5p2agFbnL+U4jQgTSsbrGLwZmPQQD1YzayldGwpG/gYheegWJnNDJ
-yW8hcIC24xGtBN4hlPlpOxhBT720Xahny9oX31ClCNPxBb/95r2a8DPVzE11uSyKPBBKq/QCFj7F0ul9
-vev6bqtoHx7hEiUAj4HuQFLHrLOQh+Uvekr4hhPb2PYMFjjNS4iURzcD2uW45Umoo8OVDj7at1KZPJvT
-CXGAHro8UIQAnbcXs+KKRNlLM3NvYa7ojJ5lmIwRYlFA5LKbHY9aGAoMiXPAmyrmjVOhASNhNj1EP/Yv
-ePDJuhnzunN1q46+1m9Ln8/WeyuRo5w9wLFLEGgoxsV34UF9DzAaqd0q+aH5Q1yHFHHI9+zxfBsW5nR3
-rjV3pmR1KkWz4kqfVrU8ikka9lhtIyp/P2yGncnwWTHJ4TAtZsRdifRaeL3E9dJLDyub8/ErenRelMUk
-/19zVXZrI46B8rV0I7df3ES06MxWGoIANi9G0BRvZ3zU2MUfYBJLvrhZPPYvyLmwkRZkl+PutmlFaK+f
-qxinZdTzOIwg+F3oRwYI9DNBLMFGKroXP/Mo/dZEa9x8wmkmCtSu8RKU3vqDTw26mRgxAcWAsrB5WBP0
-rJNr1u2o4vAyhe0vghvwmDGNDX7i/Qz9gJhomhl8sPBGzi2N7I/cbABuiNILruMFnC3FYj5dzP9wIqhb
-Ff6CBlqw0hMHKDadVaKipza4glhH3ZA1AhvjEKm3ffUjPNfVm4nYJdhDl3tzQ7XFyYIhIa3jIv+n8xgM
-RcJjnhAMDCSabS8pCGJiZ43DVSn+jvoIVdU8yJp9I7WOudgbzWndpL6Y4bTLVYKBq91X/5S015uk/HUj
-3bvSXybrvXXBdVKrFrSCFCsR96DrYWXWD9Caqmaa+9G112qJZeZVicFoVUFLcvgU5+eMRwbc2ExiPkeb
-5RS0FHLkA49mlSJPaVcspGxbRUNqMRPuS4cPbKdWGfqVsk4RD37kMHO705wOPJhqzk2ozlhnqvCNR7XP
-wRaz9M4H8ZTqEAnCx8TG6DzVSQWEI+75SvwX/sbFXeqPSAAqot9riGB/bniH1a2kPKeot4XFD3oherL6
-+bvFKSgPQ2Gw5kb6l/IvZXKYZcnlfD4t+w8enA964+zBDCbzPte/jwcHOuEXGMpMKJ6Qmamlz4lgE3Vr
-ZmDNi0JwR8reBCF9cDXYnZPJIcsRlTu3EwaSj+iOJNdll+QhaEkFyQYStQrEYPGli6LTRIkwIxSk8GTC
-JsimAKeYc/hbJsoCWpKRYOAYPMRhImHx6GmxUdN+bU+VmnMImlhREkEh4O8Q3gmV4uIHYaDmJZmfoJzl
-mzGzExSIh0HavQCOB6aUhS5QCpi4oYWMXp5hMSaLYynvIyLGRWd6a+rQ+0yxKQhtqW2IVEWrbROoYh2c
-hlqR3RsH9qXjXLW6zNORUVHrKbiH8J5SvBT9Lbp/W+p1HrKuXd8IrPpDaGIYufnMmRds4g8t2VajJJuD
-GpqULufZ1D25ugRFdvteGx6rBnqT7P283en0hsUkQK1etkrQUo8isqpw11X6cMWjROIIRf/FpOOWi6Uo
-3KgoPxEPaPmEfu6UxJ5muMfeFfvn8sSfuTAISNrxwoMBVVzaC0QbiPkJcUGrtw4MQpEmMIarZkZuqhtI
-sd8dswdmEktttf9w2WfPmi9isrqH7VBzvmKCBtDUysFT9mIxW1NWeJcJBkGQ5x/d+9BP1tOK1zVQxgYp
-uznJwBcNTs/pejdZZ5M0/omsTB6wHSZ/Z2ba57Voo5AxlG0/f5L7gq5EV0jPtIqWHrQrh62kfWDswxUc
-MxAcGKZXVtpwEyhPVhUVZAEiHUTeXEG8oRE4S44yGRYYnkG+75A6g9AJuCmMFlNC7/QSr2Ro4IZ0MPkQ
-qmEpMoALvAkA7uXWBQeyeiA6n2nK1MfLKSqWMBYdTHhTGEXLSMGjjONvaLDcgA7I8EzDvgc/6hvE6uAu
-jGfp4uISAi3xEHWWdNvaIDNDyiHGzYG6kA3QnN8shrwH0VmidQbZfJ5ZknbJOFaWzuwwNcy5cnrF9Q8d
-X4USWxpjzkhxfQgyxQU//Ut5StOCS/8IhflFOSc3R6nfNXcNuj2g0KnDThPGLu9ZOu+1/JQfestDbnEy
-tCM8oley3o51LBvDg3OXb7WPQE5007Ty3HQU0yPGsx2r1eZNKg+6SbCf7e61oodE3mTvNV+WPlziAg0c
-Q2+VpwNarcgNAt/1RB7PJxGDSSfIjfMCLKusayEKPaeSFDc2yAyp084GYdrs2ZJMs2bxR8WcubNuEO3Q
-NBD7uDqMY2+o3jWa/mHovLvkNBnqvJidO9xn6CqTtGBs7Pt1hCiNcJtpdRNvqF07qkrsc3AQiiUyG6IT
-q8TJWJOVVoimA2AQFDYornoUlV0avgCPToWsTsFUd8ry5mkYwSpnlD0SQOtFD0lzRi5D1AFFGhoOZTpZ
-qvuqqqqeFDPvy9qO8rmOU06xI7N29xJtDz/zFVGVJABmveakeJoHKqdHEf1XFoPRziqI2aEprVdnreq7
-qCBlYCYgLDvV5v77G5hn9QfJMzs40U3EKCM8nV03DWrcsJFjV6uineKR7Zsrd0xR8lyZo4OiyQ+RL0se
-/NjS5kT8yErj9tOlaa8YEqi+PYm6ZHCdtAI1WKvX+nhaC42qQI7Pvb+UPp6C5d9/KRWmgoZRkIOudWs8
-hYTNlbYFOiQ9P3CJfKegLjtJIDAIXIIMvOU8IR0sqFrDrj/zVaUJgNWNIFkYO4CBdqQYXZ/no5Hb9Xmp
-sLVgbVOc+dTTiT1XhJoMsMBAqITqIKgRnFgDH9/IVP/y/oauvpmASyF6z5q/BoFtmd4MPFMxgnClE6cq
-RxmbtJUl8z0nLSLHNdNIkSCO0/GSge8Zmx0wuzlqVdF1Cc5JnpUOyr+Gdc7zs4U5oVSX6QS9oVxInusZ
-I/YkCJmivVtzFy1LgsGA9UoIBUh0DO1pN++Jul1Zf+UvbsnNccA7k+Fr4urPaFaQr7Q9Vo42J9/qqBZv
-L7KNSQd9Gnr/nqrA4BmStDRyWh94dPooKd/mUwveQeOWuF/NGoUh6Wh0fSyQ8sV7bc0iQdkpvWy6b1Xu
-Wqvcs3AqvRHU+9p7ednumV3o1XMiaDd0qvekvRXPbCWEgad9Mb3GYALxk2cWJ7FbGO11VXgUCOFUQL7p
-RcoQA8h84mOWKOpmt/+mHS5aDAkmk21+XoA/kt0fpAyg/YR3Ft5KoBfD40YC1+6wgbQihe7ylW2jRZ8k
-SPMl10+0cLObBJ3tPUmA4O6uvgiAcXeoNgrUJAQvbyNYrKfl42JiVhPsuiQVwnjYnxz1ImeSY4pbIA4F
-l31kXk6VsKY8l9lRFG233HCrVLPOyKfD7KxYTEfZ0I5rTUEf4mjSmZHLuFOCahmYkYPO2xp95BMf4/i2
-o16IIN099goayo9FHqyxTVC58PkdVL2WvLcTJdMFryqqmEDdw2Q+UTDedS3Ie48peQmAK16iWAZLdPTp
-y/J10BFer3TArX8B5vHfFdGqubMee/njy50p7MjMQua3wpF50EBwBECt/Iytn3RcEGE4JUTLbXojyL0q
-M2fumWqHkGFyqiN8TyWMocfZkfWF78YDOaz4S28TjFnDwdpveon4Yt7Z1w/hEVjb4McDMiIHxQ3kDHeE
-qjkw2Q4VK4FS2ftpxnxDhEsr97DODi70ALvlctHOMopzGOVvAaVjwJd9dB2jfdz7IGJB/sheT5YK9Oo6
-3AqMIfajfAE/QbvwgYpOynhgaIPMcqqkDSc7oHtKmHEnQURXR5ZUxLFGrymH7NqpUgr+Vnev5sjqIMUS
-xV11FGaOBemVuADoJi8vCcN0XJy9paGfLYyIM06U5I47xgEHEfgS6nUxdIUDYDlSblIkgCSVzSyoC+NB
-45zLyJz9yH5cmL6SJfVM4lDoRKe7mLYQbpGFsJKIOjQThrZJFZz2RkWj207qD2ae1OoAb9Tm43j5fixc
-npbVGvCeRCPnLUeIhdDLAaAoneDHEourftvA8aQyID+G3K7YrcKoQ3V5HMFIyajhIDz1MibQ109881wD
-jhH8q8N5lH93h12Uf5FOcdzehOYgSjJ0Gbi94hJwCDkwDHu98XXh3BSZNtBSzH4tRvbySK7XCmpV1I3N
-i0E8hOe6MkkJSfF9R2/u302lB8HCZKWdKroW+7uq334fKJ2boQMNOym/uV9e5uMH5aURwOdF0QmuBN7e
-DYlNbWKlTelHguprztAKFkJtqH4tw4ZmfgcGp7AIPF6n6zpW50pzwQgSAc7m0gBCy8ZuEUlYHx7ohU/1
-NyFGMAyS6m9+83W3Ln6ov/mtexkPbDJFvq8tgq8xhNEPM+pvbeJTF1DU39r6foWAxm82Pgc0/qEBjePp
-/PqxS6/28QL6xDYAPgTVGuqtDWpbJUhtzbvHPlqT+/8su8hLOGoheKp00VNOJQ1g+qFHRJl4mPYguoMe
-DQ6LU8jZYN6Y7QsRFAkGS14WI6AGI9dfglh3OinKs1k+nZ9SwkSoZ+fS9Hcgg1KhVpFZ35/AMQTUTTBz
-Of72y2ge7L2wN2QVRVgPXxRW5vtY/djIodmTGkzpmoIqp7cdzm4+tJmZfdGDlpz89lTwVkgecOl1hkor
-gD8D9T76KQq+JIrg5Alcyk4Q4wJ62qNzBd1ozG2OGptkzr8U0W8JlPGi4DwSRsxYjNFF6QpfiLfFOEsn
-JXadMtSR/eLX5nx+NaFUEqKSIsTLGSeysZ/tItXaOflHiFYHslni1QG040K3C2qV7ZzIBnuiWg5nMm72
-om2SzZ77lNoOa+s83YQNcYsl1xPwkVf9bxkww6Flo7xo4DcLBQiRJaC8W04RcILfb5JmNsm5Py13dVax
-X8umuaPZNVOuQmgXHIqZckI3VIivNF9zPMTa1sAgQfwoI2WO7wlia5Cnh41ctKnzH1nmgpynTjDUN7x2
-zSx2nOUfcp8GStsUTUTw7Yqb4yf2YlZ+Q0MuL7zh5GopTwO33lpyYLChOq7v5f5wWnizM24z+j5wG3qI
-/iNlASwOSk5Qrf1Bn9ZA60NBKsU0t03fqD/utmNpwJPxJwiP+Oq6PtAW1rpv4C7u3Vv6FTWHIJ/LmoL7
-3q+uPbXNSPqNZzwWDYfarz5qull4Ld/2XhG9PWhxzFwgvo/J8lXZffNzRvBPJaaDohhRj7AIHCV49HFe
-8HUnsa97spytxiSMi5HAciDHhfC7i0U6AzMeA/ZgOIQ9nRy8CMJ+6KQCw2ywuLhgEzLOAmA4QqzpZDgC
-ZkUo5AiNlOZDV94lTRvMiquSwtHxJWNVfcFnuwWFL8H3/pr3cekjEdqRYk2qLlxI3t1gqeSJsApyw8ve
-Z2cLAuSrC3oFpbGOd8W54vLCuGwn+PyB+X8spQR2+KObaMQKQaM0L5wEXrPZ7RJS0iTceFufN96n2nhA
-03vjHFyMn+XvwShfc0XGki9HC0OWvywG/hXWf+cDqZi7C77+v4tske1Pfga9Xxu3Els//cpGHvxfKIlP
-Syn4qFqOubVru90Jzrjw08T0ZpnEoZn+dAxM4pwxBiQhO211s49O/V5P2R99ig/Ya4fdXM2CIXLQYgI3
-aqu1FbcVaOdUXGTBcgwTwXaXL+J71IznKQwHL6mcbQNFXRrV44JyfZanPb++xCnY+mQ6h90+AmloQqHT
-NmUHkCgG8CwZBjazvwtpBQFwxn9eN4gJquhwwAmp67zsCTjTPctbiLseccuKyYi1QU1JN3yG44g/3d+V
-52o8WqtLhGZIJCRHcqFgcvw9RiFGHeKcDbtlKSsNCd6moQv2Z39zA/PQffPVZz77KfnsU9x82SyQcdjj
-4D54LzSzX2kgwn3llego9/D0B494tdn8apESUvsl3FaG4kajKqkXUW3oL7h/YzBjQQmvtmjQwjr43Cv5
-aioJs8Oy/Ob22fUgryTMIHPJEGxMvVM1Xk0GQB3Z8PBsVoxGL8H4TxpOv3ZNuXV12X+SY+iExRIOXYyR
-1wJ7rrj0f0GRvz520e6LZ2rIN8lLahRCztIcc0cVM1EB8zWU/JZzwHPm5ur/sb/WJCttjjV71J+bL6Hu
-VHiq1W3t21h/I5QXxRAARVM8J9OzSwZbn4O7IB2+i8kkgwM+NbvZfBO1ZHgxyPHpiJQ8UJPxRQCDFLxs
-8dQeFqIZHV3bICvyN2UIAusoWgyQSc2gGh2ONgEZ+H1Qml+b93iCV3thuKR+Q77u4jER49owI1csoHyo
-gM8PsNQTc6CTxqA9EVWbxeCiJfP7g1m2qJv4/kkxM7Wp0wDEozLUJ/mspO7N57VtF1q9RTXF+fsVX4oo
-rROixYIb06W5+6Szs0uMNzWnXEKCE9zTIeWOIwo5yx/zm5/NcfH3LINExW3vHK2ezJScTAtB297R+0jK
-aBljW7djS8i4gXMcozKEQc4bhufUml3WFUkESMTNIDZWlz0yNkr/pR2gQEZDbDwd+GZtFNvtmSMMfpoB
-HhUgkDR8AUgbuur8qthhqzE+nq15orovfO2bS2l74BpTITu+XNlMYviJPh/VrepZQRgW8KS8MiReXAn8
-yNMCfZFcJjdLe11KNS5RaK0SkQ6INykrGDukYkimuWobucbGbF6B/xvBjAiiGeIlCLWwOwaQNcEPcUpu
-XxfQtdFwgY4AJAkjUElQiJmPLmIxkw57TTKLAbAJsJ4zcNxH9YGbUcRlHGbEJckU8P/b3Nz4xqZilx25
-HawLUA+Hz0sZIVY9/47wMLe6LUq0qVpX3N0rdOPn4QbCNR9p/hPvxXo3JD8kI8/JoUJW0QYsAESVEuOc
-2S+D9NVidMyqaNY78TeBSPx6KB8u/dfQv9wE2IfU7ssSZYd/gF8OI0C1zwZuO5ahCFIvd7RpZ2EfZ4O2
-XzN2f1YSJ3R3kk3A/WrokgqfhDc28lkzb17vP9998frkl53nu0/3+lGBtGf2hzlf4KD9iTd9oloos/kv
-dfdBv1shoeULaj7Ef+B8gkx3e/J5zhpNT5o64CIIVc5/u0bzstpmoCZraFPa0VqLo1kKHgC+rqKEMAWS
-cIgFDRaDAbCs30O/IPWZzUEGV/SBp6MNFYptQkA4M1e4t+udGtUA1bfBQRA8it79YJeezwF/cCQTEigl
-pYUdC6bDwfJkWXberRxFhS5lhbkJYopgaqzyj+vOrZrE9evwd1hPYQTR6c8wxdkQv1r7gXpCTTBH8sBT
-PVijIb0j3qQDqipes054uNGU5BMRfYRwMA9vL6mMix9HaFF0VfjUy/CkPrSjeFc3Qq5pRbkW0OtZOjWf
-8Jlgf0+CfYxz/CemWCKC7A8iWcY6OHSnrZqjWXY+y8pLL88KC9xmGqontO5V6tL3RzcpHX7dpEUttbq2
-dTVA77Nq1y+i4MSVaThmwtUKHOVnWmAxX9t0Z4LYebxxNOlKozKP70oO0Wu4O9EawEiwrPPPhp4G7uz6
-zGxKghh3tU9ZkcAxQqc9TS6sLOoNSAnPP9vVC1BXf7pNi3vOAH4y2KYJmWWYzqMdaWcVfa6sla/Ltdq+
-vrkJdOuUeX1zT+jWZu7Qqjzz7LuKsVw0df3Nb9xLUsn1N7/d7Iaat/7md+I6q5Vm/a3N77vN2rD+1tbX
-qJD++qN6y35WUFcV1DX6aXGvXvtgB9nV8iwIxElmM/nj/gUkkswBDGO+6Nk7THAE8aejETuSBml8SozY
-LWZy9TeSfKkzD2C0OSQoSdEwpxIcIPg5Ij2kDn2twEz+Sn9gvQQmZojEioKc6mZI0xTgr81WwOixxRmc
-ZIykbr5yalZzCCreOUavotrTUCKFr5lFhwBEdmUViLd/wej6HFV902EIdLYqpjxVFtJNRYAhaLY3DgyO
-xAF28arOvlUPbp3ZWaPUEchJ62SmCagl+sdhjuGLXqFdembLGDrmEMeDIEeKSyXuDgXD1J+Yb0fsTrPO
-EgCNqTWhFKC/l+WCVclCgujayiAKlIyKFDhhLFHPnXbDxXh8XfVhVGLLJnNwtto1Rj3960aOPTVx8uhf
-1ZgmG4Bjbtg3cjzeppuWoYxWtAO8tFOcFH6j33z9ajj8SRW248AUDLm8v5bxULzTa7MrXJoOnfCsC4Qr
-Exr/IinlopMqH8HP60O+PiTYC+jn2NGvBx3W2gny+VDOMN796APLIV+sLwReBcgDAfKZQuoIAsZegtGN
-4r4gZaF28u/Z7IZBa5fZyLRWqtCwSFBY+FlqYT3ixnKKVt8g1qMN3ZLZKL25J0DNP24B8jGYdAAd2luK
-ZwKcdZsl+LBZjE+fKYZTFEBYkHRvYZiQfw154wRZ6blPsz01n7ROWAHkJ0V7M0AbJ+bhNMuUgpnIig4X
-PF4vM3P/LjFLVmFmY7iYWtcWTCko2PjmqH5X5OaQnabjMT0pkvHi7NKBV8tgHoPOmk+AVhD6VgtVWqn8
-5TYW/jJp9QH3SYAKPCTSDrzutvyot5nEJ7xGxIwX5Ip/754/y8dhh0zqy0p5Cy+4Q2F/vLxar6pWj+2j
-r83d3B7oNmGhYivIYAWUDVwEr4sFHGzwHwrxdZaJwuEZEXHDspDQ0I9nnrxT2K9CjOAR31OwtqBkv8fP
-zZ8+GjT3/bGYRSUot9UgVBFEhGDTpwDvdAE8g84hL/ZYkoLWswJedCrob+zlMMR/6CxAMiC049NMCKKB
-CPgBoxQLusvwJ/P1QbNTI2+E8NFE/MQK5XlcbsCSXU/M8uKbG+PHWeoKVl2HPDd26k4Ev8+rWT6vyi2r
-jgafRwcT8lF/Q9O/ZrlMRg7imGv3JvxcVqPsvTcXUMx4SvWqC42Pg+NtLUoIKEIE8ETW4dnjghakyN3N
-9OnOcAdrnGDW3MjsTCgQf8mSyq58/fpD8458cAVCRg2cPbG0dOAv2YdhFFRFBPeJPmxBa2eCSDnkDu/N
-OWOiDDLe7ahhCLEKmriLPVzYeM66O05BAVy30holv9DABlFQA/Er545iVBsU8aa6gTh1k0KejwWsz6Jp
-ODIEWcq8KYuukJXIWXYie8k+HMXUllf7PiDkcKkuIfIwQFl18k0rFxPMhgH3WGqMEQGzeTYbAzDjFVR+
-m08wbpBXkces6N6Bx/8hOwAcMDAxjOSMcRu5624ewRR6+2eF7bPK6mtqAZhjcB25yuHiTeiMGdhxLPoT
-JbPhBGMWHoKewimqm6KPA2CyFFI0QwztnAhextGFSFlSR9C6Z+lsdN1LdrNzSWTObcFUKJGb17BXy/9j
-kP/LQf/9XP3wr0lk8SXuEGBFQtVHRfG2RBAoT6cm1G6BmVgNialU/GZwUuTre95bbTIPsyM4YtY8ozY5
-Q+32b1DJP7HlPiwobRlqxDefHbM/kWO2DVys9bzeffHM4s94+mz1wnpd3ypIxiVYFlVzrbN2bTG/narD
-tnvulawEgFY0+l4Br+7PFNamww0iDURKfXhyaFvbzH1TVf3aT4qdzc4j5eGxVw59cw3lv48Utu/u6Jnu
-0x2rARQSRu1a93IdZYuL2lcL77/WU9CvTlqlsJByX//wS4WQHRFCCSp4JN8PY2uqZZlQ+rWE5tcJKKQf
-B/z0qsBK9x0t+C/tyvYDKvCL8YL2fVuyLdJwfthVt+ZczUT6D9F+GonW6Tbtqv7mlgNPEnvu1lfdRrZh
-SrhWve3S3/zK1Q3YQH/zm40VwZdgR5knrhO3bfqb330TtR7j2fft57Pvk51908X8MBstOQCFRGzJOMO1
-rxWOEUYXlM8x65Wuo98IFz0vzhaRovaxir7ZOUP/kZg5OnzrB6Pm5f5k1zSI4qyLUXEZye2o2kMu1pM/
-BAsh4VAPhQoRmU1mDmZVzNOktFPHfCEBLyaEqbU1er+SGRsCW9BnPCfD+ABwgEiJhFI/WlLxAnrJZnEA
-TgaCGSwuDCm8N4dQm7N9Y5JCgvgxzc4LCVmdQVSLG5U1k3dwu+xHx4yd07UfSUUdZfrDJcrWDM4+e5xO
-00E+ykEdHaDtBb6w8Ajw/0Wv0cZCPZhySuABSub95y9fHbWgGL50GUEgqVZLgaJH6h7t/dfRzsHeTkvS
-afcwGZdZ3iHPMbU0W2StioeYIS77TfsuO2XEBGkJOsPbEzlle4TZ9pH3rPXDVerrH87qxv0fwBL214Kb
-m78WvbpFaKuWO5wEpb4V/dl+Td0/aGvoZhXxAv3PGXrvZ2p/7FNeSkdmKt8nZUssMN8WelqNinLO4Anc
-EqT8sn4ZZkTnixFh1ZqzFNqBizOcq5xaBxUslKye3SaHxZgaBAIh6xi6dCSYEgwd+/JJV7lUwC0bPp4V
-WjAkF7hc/T5LE9NZXsxidOM5Ey5mT1aiF1KJ50VQvLaTnlqwoIVDj5YaG/HJzvl7BsMmbVEwOKVj8Phv
-WDBIgXArem5oK07TpaZpT2fuN9QN3/mT5gwgoYXDnlvVsSn3v2CX6J2GaGCcQ87uEfSfKxnEhJpMgOul
-5lTo8mGD2ZHRgclnbCrE//5/YtE+xFa9TRbTaA+kQoRyUkl4VWn6M5VtpUP43U2yyVA93JsMFWaI92F2
-a2DrehvY2gqQuOX304KN6FVEpZERbDJIK0MCMrllyXzYKeqFXJRvX/yUPgtr9YKPs4XwI4Mi5lNl9cVF
-1Gb+tHKE69McXNRA7FjzPmp/73suys8wupR3a7VlVsQjZbYtRRI6+Iy0jmj/HGToWuJGBDY9aQ6md38P
-NZWc0c+1M0Ax04ivNt+A2rA4rh5Fj1qmhZ8WrFbd/NsVuE9NAZvG2W+3zi5TUPFls1Y3uU8zgiYyDifr
-+Ia1odeEWZ3lDTgtYWAloJVUa/KYtlWSyb6CjOXmWNnfaySzipgMx+qL83PwVeR94Lld815zTRh5RTbe
-Bu+1jehRW3p77bCZiciQKTs1bBc4CIm1lNQgVHZEaJkHfqFpfQnv0NtP6hf02YkYGaEG3lvgwGFbSMkz
-IClBzGx9YdlJ2cBOugk37/EVmDizCvyqh7/dcWhmU700v9wJx04OWAJ2qU0goHYqVVdt3tyKe8UYjt9e
-pcwe9vgsnV/2jKTTNgNg7u9T9UfkRprzUHliNkdmbQOGQ1sP3HXTaZm1QZYO3tXsbPziSNHKDoYJvx8t
-Tt/V9r9cvqG6B8tgDzriWc1H35cqrFaneifub36NHvPezbe/tbHV9e64/a3NrW7sGmtekIv8dx/VRf6z
-zuTWOhMvOOIuUC63UzDfDjr6cO/lzsHO0YsDcLmDpIjew5One8//dvSLeWcfSTy2wh59n48XY/CHNBIU
-TL9gbthoeDBgQsi6LPS0KMscRXGKnjeXJ3Mc7jo1wbOd/zo5OtjbO9nde4ndb25suB4dVh8EAu/vAgTI
-ef4edB+LklMmWmDgABylDDFRJovxIJvdmBmDud0x5JiDmRni4S4YecdJsRQVSE0i3dGgdficxjfxgTsO
-sXobO/LUSG4NvqRh9OYFF/7qW09x9JiIBjO0WPZmiXIxnY5AB2RmBN0Hygy+EYLxOfOg4YJ1WV7zYcJ+
-sjypvfpJIjIU7HA7DAhRQPjhFXBM3dgrA80mQ2nbjiKW8Twvf4Ztms6u2zkeZuG05sMe9LMzlymHY8rN
-tRGP6HNQ4Bxauo7Ntje9nOoHR46ZEirzttIEdwHdZpCtMFvLO6ydIjTHV6Ffc5ZNUKlFt1k3WxvhTMGB
-7+ZSZsqcp5sdvMDbkmt4ra2bwHOA+RDinDikCPBBMcv+v4uUM2KC6Gn4xbBhNqXy/m7srWHB5vwYpogb
-2zi9p66h08i4TnVLp01bPS93bEsvztuu2a43Gm8VaOL16x5SpFe/Y3Oc0jI5qtcVu2pG1A3FWw2rHWCk
-H0Ll80jbp8/TfFibFTofcv3U8dZelFu6bqhfxJMC3xiwFDFtN9AwxIdivSgV/wSUWS4Gppm2ueQAcaYl
-nYVmFi1hdsA7DDzKI7MxMfKoHCScAxWEBoq8sv6Edoo8iiFyhebce0D9ySeoBQOaAbWlKXONybCQyLvM
-2/SVZTmdy3QrAkWYF6ZS3WXNlnBF1Jqo56Lviazhc5gjNz9uasLZiA+lZl2h1V1FxOGucQ19GCa8Y4N6
-WwFXsy/83hg7PjbEv5Td5C9lB6w1Z1mOOQkm2F+FLbNvuPom2e6up7UQP/7WQyWc+bvPTBPXWm1Ger1e
-R4xXYGAap+ijZScFzUlTXHPKTW+x9BnhyMEz9ZI9zCMI2+gv5Skbs0KebN7cdXJX/FqaVMww7fYfsOEI
-UbpQDluUnMJQ93MISYZtzlv7GRJZk4/H2TCH8EwrAvUAAAPkZ0hvMc9HIDxfildoOinA+9AXmCDpBvog
-sogXZwmeVqNyWBjJMxT6JeYyhz/QezC3OgYEY/K6iCWhggn0zytX3vBqpXIfmA94qy/Qdlb9ThSnjx9t
-kywFwwqcSeNC0Q0zvKsC0t4Is31VUiZ1w4fN+9IIr4BesaQZ9kbFnIa2BLTGlxDmq9wV66ZkmUfXUOws
-A3zxNFmHxAfZbB0E+IFZWD4MK1cU4cLmkI3LO6azQNBxjLvuQ7r2UqDOYViyiemmmW8jXttjbNFtqDaO
-rktjcbG/43zyVPDprPYJizLFcA2tgAK6UfV0Xnf+vlZL9hj0AUc+DecZTqhckxEPjxAJk9Mftum49jcX
-TMD63oun61YUuixmcC+xckmAVIakv+0+q4ngeUZyZuD2OX6wvwHqPiGvKORo7kQYJwGcZtA+a9xXOGFE
-vNQhgqBRq257RcfzgUeNHGBB7+psiROWPXLt4RC7BvVNGT4VaN7xT5pq/DPotuHoDQfo5XIJXmouJIzb
-k7JRVhpk8ytI1S9coZ3lyMcXU9iFw+IKUv8foVRYsgXlC0lEK/qmrkqbC0WS7H1eQoxVtCPDy0T0RBAA
-gZ6RnAGnGCl02nXaGkxqMC8whiBgQD8JO6Hzw1BMOndIRvTQttOrqVlMw4pwVsWruUQFZ4NEQFIwXn/y
-rnibkcu9aQ2Mdn5/9oYH6f2RnpLXlxlONkrMGCaNqf/dldTJvdFWnqbNjcBe0W3EuKawHbrKvDRL1S7J
-BgoT0zWfiUkfu27YXds3bedSK/kdJiHO6zb9xz686x6ltqE8joqltOrQSeR7nE5wKoSjUlKlydBe4kFJ
-ivdIJbFhF5W953fcsZi00vYr+MZAfKOJcyr9u3606gNUQmEndpE69fNBHKqbNMyLJw8MCycEQ1F0sfI2
-sjdd8icmYfcnbuXBd2zeMG9Yp1iGrm5Q7hTtvKTKTYG5zPNxJtIjPeZzVS8PRjbbgfyk7+xmtJFLwyPv
-XHW2sOTBF3ww48llttAjei3No6qPPkgZ62bZ3Nnf2vfc1oc5GTJdAZ2gp9g9u6n1a9Uk8nrT6dkAu3Of
-hnvUy5SKZmwovM0QJNzmtrSpDcEv0JSOH/bgQXpuxAzz8F0OmEZG9KL578VP7ruTd0LL9uWXRmL31eoW
-/mMpRe9mcz540ekKEuCDBGxYDuHFqvz43BYJ1pl3QrMtBo1BDOw9hIAuyJ8zAkMuqq5LdZAL/Qvth9Rf
-92Udh4lqjRST9IIPZtiGBG1F/pIwuhmAOZTm69n7S/CCKqA+0BhYukFrTSjoZ3xzQEeH2ZAU4XZnyB03
-A7TxPMP0PQQdiKlTXI0yHy9GcBPk5A32DPb0jQ34+X6qEoQ2BDCfUmK1xWDBRv/wprBTMWuw6ZxdRJwd
-ox6YNGrvCBzjw+bgYbsTA4KMfIIhosF18muRY5hbKoMVbEQAZmyCg2SY9QOuhdrLqcDdOyVmbfqjHVcM
-UJYRBjItk3OzboCuNnTpn0M4x3CWZYIjwItqgry5psFH8ybxd32J7yIzaa/HoubUeWBd3gDxo7BWVbnL
-gjoUXlJrYpGOtmFzWVmFqLVS1C5LPlQDS8Mmw6m0IuXtPqZ2vuvQ9bWbyNC74Q19i0iNvYQVKz+ClUT7
-RORytRtabb+t2jWFH7kzCJXcWPzH5P5mRdlNVi3DEIf6oAjwM6voquqOYqbP4fJjiDLK12Wg8j4bgFyA
-3rciw1BrHJl5xW6yqBE1K3g6LgyP3IOZPsXsEfj7aWa6PtUJfKiR5y+O9swZIwgTLOKjmVAkf9Y716ll
-GEydmgMVGXCH9Qz6z4brMIL1UXY+Xyei0J5oNRQ5gqGSnpx9d031Gs6A3eiy3G9QfNmVppjQrYZrP4Ae
-FZtwGTwW053ZRSKw/KoFb8ZwH5o2uZmy2g7cOm/TEg9MNxYDjUXqwqXH9Va7iCe1KzNGtx78nK6MRkt1
-Wvdar3YKW+04SVA1APISl3S7MSL32Na0clgNkxHXlIfSTV13Mp6m7nQv3qzwdHQZgQ27rXcDPmTZoWR2
-LTd6cti7Ku5PL1OMVye43AeI9S25BwQktrolUVJzrV0CvgDIRhPkAgLyMi8WlFSFD5hGPGY/IxiOlsD7
-I+nEluyYKkWnK1LzUvI9uipewoxpyFxB5Jeruj4R5GXjWrda3aTSSmyBo7VdTWjH1o7TYw3PBwkJTIbY
-PyeoqDJ6uJchJ1LK4l7yRJzEs/fpeDoyfQI+B95behu9/5gVV/c3epun9iiAGAiQiLFJM3K84kCnkl8S
-2Yqpe9pVLZySkstr8jNdWnoQtleuQph3J8GAfPbeU6JTIAzIVYhgmhZ4XSm8eLQndby6X8vFP7zDUMMQ
-VTtwggWtJOkHv7GIlcf6yrel0anTu4zdFuyhGui6PNXw95+DXj+xA+ezdBr10ORJPg0LnyZjc0uim1Jq
-/p4iGDpnnp8uBmaKzJ47g4fgBArxaNBOzi0khLdiLcXMEwJNRcI4mhq8uOTWoTXIjV4MET6YkdugsQUE
-vHDftj9CrEmFT0HHZtAuuA2aQ+bFA+GmGRbYZm07yCQoLufURHuH3/aTiuLCzBAktcquEvNXO5YakEr4
-ag38yp2X+wr3iA4VsHhDZvZTDIe9ylpDzrXK2ZlLRAEG1Oir9FriFAwZlRxBkJUZ2TLQAousZ3+PkjzP
-5j1Q/xPOqapkfqhkC1wUAJ4pcMZwXSCy3Iv5A+d0xbwVsq35s0egx/s8wzIPZhJsDIEXWhpviO9/9e25
-NoyMc8c2UMKNjKoMR8XoxCt9pcU7vVmF2RryUBmgH96VH35mhauzwqf5efYYkic0MULS71KtsVK7wgy/
-5ZQQ6KKtPAcTDP4hsymddPxJdKNGZ26Ifp/xg8WEH3kRvQwWJ6DtE8yHQjyVj9MyaRcWbL7s0DgIhQtU
-D2YOjGCSQcIBAVxH375sfggc+gE2SPgTMk82KfszdjgX8uwnu5iehsQ8UWVi7Bxdxly35A6Ps0q64xxS
-vwM3XmBfADpKYcaOW3NVZEB2fq4zw6p+Ka7MxW7WRfQ3NUAGzEeCYhyAibkA5hk0B/xuLNDH1FEOkdR0
-NFU+9tVk3PS5C/taPjYYO87qHHLWyNDhuAGKRfs2Bg2AVh2JyM6D+ra5xddH4tcLh3HZAqkGfqaQMJ6n
-MAe9LXmhkW+ZIyQiXekcUMdwfFcpyeyyKASzS22eowcNhImnZcbnoD3F7F7hiMUGQqH8ZUnz9GK0fD1X
-tN05nvjtxmcZ8RMxRnCSWUzJ3X0xrg3kSSEd0VdbfkwOP/TRnvwGBfLpl73Hfz989exk5+jo4OT5zjNz
-F25B3ob7eKLeP+PirSC+M7jsjrHthLpgiafGXOGVIWlOOoEEVrPcCFsZp7Liiz3IOWYZZOhHBbWhpALq
-38MycN/JsyGFPMgLetbjTBpi3mz9aK2FLbBExiawV506wP/eXofytnfzZP1HwhSN3YNr5pGijvwikFOZ
-tMw3NvcVkjBZKfiRN+mlik64CpxNLjPpjA0aQJrOYJRODjIzhJqJ7iZBii2MXwXfIfMVisJELQ6RhLK0
-MserTambuSTaQfjor38F61uZGVGwHb7sJpsbCsBiHO6H5XTiCm9X+16G2+h/qw3TlL3a33z4PbLYzc/B
-lX8o34Vjspbd3gaI8SMCFT6mY/zFVSXxfuW1D7G4SraksDT6LKaUcLemmi3y0QAdPyx5v3+/r6tp3vop
-/0MOUMn97xW4G4gjhJ2dGVKMzaZ7GYFx/L+YdK4OyhHf3hH8Ed2zGfXAK61eSNO3w0/Thm8mlf3JY5VD
-3kdJaygrLZKEP8/Ff6GGvGqLuXaWRy8T+IsZhen/l6NnT/2i+o0tjSojmt+m4dWXk5aWZisLI6tje6Jn
-C3AFJ5pse5Ca+7t4xO7//OpoD9/TIMAygblFJOsL4sk+3XtmGOoJ+oMd/fdLaGvzEXPDx97jh3RlB/Wb
-00zOnCcECDahU4Vc4XriiyQPyp+vlROFjGhZ86dnQkGnBKpl27Uv4g3fEnUcxnFysrv3j5MTjLT3tbHB
-oPDIY2GIBsROho70o4O6QSXoK85OR5Bj4MU3nF/eJz/jMgNHC7pSIzhYPhla2jpAHxWEgQsfg3QHwDGY
-DcX0dvzGaVue5ACgQtdrFZVNHdowa9QsmJtqi7QG7D+hnMXRP/Uih8xoYRCK3AkkBrzSlwvmvkLndBey
-XJpj3FzXLwJnaBzcbn5+bkob2iEXMKqx2eWqW2EIiY4f4bI2goSr6BiSSNQGN1SJ2JDW/JgKaTMSVSGe
-OdGAJX9s7Aiqh5f8BL48fRmNhoWM3B7sRiAvQhYRUOsAPpT8Ouo1FfOZ2knWLbmvoyE4j7hcUWQqiI4k
-ZtZCGrTt8NxyqX1CfhsNB4eqr0IsdAvmjuAkPU7Qr957UWAujRowk2PLT9/ARUoM3t71xhbpoH8TwglB
-SzLPpMen1QYJ1ox2VgBsYGGETVTvU1AYxvWWjoP3QGCHhmDRCC6Q7QPJqR4AHMdksUCxGroz7RQwSLFr
-UHAZhf1JmktT4leIALHRUJTsoWU2H+/hAqwziGiIFQ29mJb1gAzZL8DGX3Ia/U4l5sNR4W/mz9dYCv4S
-OD74G7B84BtuqE1pfFkguAqWJm+70yqVmXV2eKvWTVysT39zBSRizHfSs6djmMXAFPP98c5QdwG2KKly
-nA9t8gdKVoElgCu4IdG/u3tlw797HHPEPUD8eqeakglJv58cGQ5NYU9AL4sJASYQcTmvS6BJiLfwvKjh
-n6VN6MU+9/2pY+PpSLZH+OdNEU9ZI/xZfQVim2sqmB+3sgNUqK60pD/bPydyw4uoEDrnj5C82IJb1RU4
-MazSxehGzNeKKeowEkl2plVolYhQkHiaEDZ3duF+y0nWeeRrNv2GjqTswtZi4AunKbvC4K0cmVTPfTt+
-jeF0uHe8XmMPNdfikB/HAy16G5LOfeuj7jYfcQjZpnXnDg7p6NLtaWZ66Gw9GiHIcDaPQg0YCj2ymQIt
-koubhWCvl3aBkd7sfi9GQ5StGrY8FbmH+C2yK8kMrAgPC72ReFH87jI6m9j9o7U6or2JyFw0MRWXZ5r7
-C0i4DfkhV0aGiVZxjFSvj1sWr3fgqYza0QBKhCijMmWYaaqJXWJuPuEM3twQg+CJD2dNHdsg0eJP6Pjn
-a0LyeOTLTQH3vcVssy/FAcNn8w0lmOwvbpz9aae+ihKZPupsg1u53P/a0mFwtoXqD9j0rmzvBMQeck0X
-+o/oaXp5+dwwJ/ub5jqw61vf8H+D5d+xvH4dT791zqWUl1Y2HkJQSKosnWJUtNKlpyuFNEsciGepyuY3
-FKeRQRY2r+BOvcCdgQM3M0VxjA2ylOKsRtob5mjf9eWmODvNJPjZcfKqqGUtCBKuaj+wOl3RSaqCRwWc
-WWQrWfa7C0CxA56guKw5R4s/ryZ2Bgjs/owcoBgRxMo6gTXCD/Gu7dJKOZ7yoYaM7fXlCXqgMzGzrGjr
-mqPbyw9g33BCgMp90iWHDWUjTm6ugLXShYRwoKgGdGjuLnBtIOs2SDkVCaDhAKLYc9dMQAvThXmoAJgq
-h6xsWgoTzaZZiZv9sHiSwiwSw1EKgMlwl0ph9aH1wBxPRx7yjTBI64usxXRX8E2I16Igc+wz7dTfceJC
-ZbBSCjmUFmclYRlvKMipNy2m6HlvDRq4HIiFDG56izF6IbDQCXdIaciBd5WADNVLfsa4UFy7c0YYKaY9
-zbWZCvzAxgOXCI8/RS40vtCG4HEOouRU3IDDm1ft0gRO7rWLnMSVnRUXZg6+Fm9k/NVIF2uSeJ5SJSwm
-Q1Zzh0N51Dw8mU1pQe8r3OglX8atNoQCo8oM7INw8kw4wJ2cyr0t5euLb1wj1s3tSHvCgHci8pbYtuSo
-Pj/MDbWTlbNiBY0R94Sj71WGbI0bR+DemLJ/+9z90DUcOgJqypXxGREn0Jt8WHAwF0wbW2R9SsPhODnF
-DAvZJBJCZdqIPjgEkosIOyVCckPlcPlwbEqhSGPdDo0+PX9IdUORYXh9ajvNGlvBK1V7J3l5VEyfZu+y
-kcoGrs6YExzC/ji9yOyMiBlfHSDVj9NU/DOE8+A5yaT1b06hH4feBjQrzz4q2TVQmaIOITXJ/FW75UDk
-fwlfOyRAatVGj9OU0Ejjm4fGJO5kv882EuJe8YNmZnlTxPS2zzoVjsvopsr9E09LckHM/0mx3qGO2gix
-GCqNlecluQE63/h3maauUjFx9lIcEyNPdfiTNUeR08XkmlJ4SGYMaAAykGq7lRk3YJTNimsnBIgIzFvP
-2+GkV2/Tc7VEXXliEdcNLfCWMXcmwDOXTiHPEJSFdeAOfhjm74wct71uC63/mFT//XD/fnJY97mJtONc
-yqDF3ldhU9DKAZsHnPXMYiZIWyjn9Hq9yDj8f77RAnt4YMbxI3+a/A0/92kBQIJxo+8qk5f1tpLxMV0p
-s4eDmXbLwh52YCjU0UfDbLC4uEA/1sUMHpfJFxRyFDd89msMot1qEAMKZJeQTYUdW8hsyLpIGn4Mv5og
-N6ihbFKK0y1fZtMp4xmUZ7NiNALX4lyuSWDB0JQrd15q6+wS4fDxBz1pZt1wSujWyDHbTTsx8XjcHJWy
-oXN0KweDhgvnKPjwGZKoRTVcJAV93rPCsIhiplze1Bnpd+I0IPppO+bnd5SiJtmHEgC/4RFYc512QcuB
-yF7YMREnGvJBl/73hycvJMp3LghVI7vtkLmK6SbSoNMrZO+t1SzSGFi28dtXWVz3l62Gn+vX/UlFQspa
-KsQrdJimnHUwHnNjRDlSgvbIpxv2h8rHaReSr8beHMkVX32pPAqOscQO6APylEd9qHroLbozkbz5z8Bp
-PhuCwqxsq4H5uVkU//coV9NrBVQlqTgYmYH+L/zXGlJJndH2ZympDkPsWP6cNHUhO6SuD9tWYP7pqDxM
-t5xvc1qACDEbOjFSuLnZAeBjD7cvuG2j7xKHYpktx/77+K/WS+O41nL9Jtm203Erc3WYeseboghjOcgu
-8hJG70W+CBexOxWi7oCdIPZRKZwczSnUzpiIx7lt1zAYoINGBvMxeUJo7XVygU3Q4Pb+jGcitvO9Uatb
-z4drHD3dnLPDulXFjDZHkh2y6kv1229JTVnPuUpyQ1lbbqv6wYyhd0RR5m5kHNeTauG114roMqMf8zt9
-ijMC1znA9lAUyQ6RVv8BpPrMEmlb61Znnt+UJxfLDNVttrhodaxaRGOdJh/PLVwVjG5OpOgUQ1Ij29Mi
-CKxw/Kor7cc+cxvuwugDIoGxFp1SAgfo6sMHsNuujTxDb1gYz/PsqvG8rhzMy27LcJl0ytV/GNIuFiUE
-BczxBgh54848ZxU3XCPXulTSLatX5ZHyeZ05R7qO5LXliK3RqFQTDUJza24+wJAgoykBNp8Rwc+vRbpG
-IZB0vCUJyqvzInYO9eIptCN4j41Xyfa2ur8T54jNfBsh13HoEuLtIqIhvHyWOVFMwfOBUz6IKnLAzLNH
-Iqmh3xR+vyL/Bfspo6Okakfok7kVJQNGwNlJBt+fzq67NmaOW5W2cm9Etq/dnH1uFbuDqfAtM6Gyarve
-6ViLY11paikfirBqe3xEtSmKymncPF7KomgDKd2MldeTs8tZMQFSH2D+vGs7N0hqBGiSDBdyxENb9kbY
-RWlIxvI6B2ESbrCFmh4zl/iwa501WPslraVnIGjh1ZHYm9AfliNMAnBEuLjuKcYv6h5ujH/K/DQq2LrL
-JnGmb8vBvFo0yAj/cBP+5xM5/aPp4wiZlQmsPcvKmGOnvrOGeaN8hZDVA+zHAfYw47KReIFlozOUuvmH
-2hmEgqBsz19IvmUV31xgEDawJPLOxjuVu1qXjqkQyz6HJHkUtgQNIvTVPI4buOqR7eTkyuHdW+X0DjTZ
-6vzufaRLc/2Z/bgq2avVqC4rQVFAgUDstszy/2Pv3fvbuJEF0f/9Kdre3wzJhKItx3nRdnIdW5nRPfHj
-WvLkZDNes0W2pB6TbA6btKwT6372Rb2AAhrdTT3sJOfIu2cidqMLBaBQqCrUQ6mlodJ4cfFbT/6AL2el
-7KtWWf3rfzGYmgNvlyuKjMO1GihfRyfXcyFOvcpY9Io0lI5XxbwDlsVVlk4obI28iNO5XVJx6vNyiaiT
-R51oCC5H+7QAylc2mILzn8o0dFRF9Dp8ZYk2wVjNzXhqHn5cjKG4LZhD2CXn3+sUyn2RJ+ZcR7niPz2w
-myTmoH+jezwg2cTLLRIMWmcPxqSP6M2ynudooMcQRKz+ntLhdlJgrMICaGi+Csc8LhZ55pL9emMzrzui
-x0XUr02J2XMs8S1+D+s0mnpLhTrpPFi+RzO8ch74XsPBGz7o+b02D9XHPXUVRN+8pE1JzJ60LBWx8fm1
-0yPWvYBU9OOKQIAPmUHZRz28RUMPP+ty58xUvn+yZ5rjZBAWo0cr33EntHVFtNoLBj4Efkd/T0sV0mgl
-h0ovfnBEXsqlDJNTN/zgEpISugnWIfjhQwW3ASzhXn4AMReaSIIIEW6BkXQ+gPv2E8ox3q1+1fNKgVuH
-yNrZqALwIVxcn5N/lI4xeNhxJ9i+SyrItbJw/uxpbVgZ4trnmBBwF/d5FgKEUnLr+TIjzyMKAYBIjmw6
-dQlxZoXNlGs4ouOVEXhmhlfZVrnAyws0FEAelSUGamOw+XLLoshJGDoeDKWr6ccqib1sHPUjTgaVh5qS
-HKyzuq1YtYs8rN1YZv/cDIy2VVWzoh5G9XFGZ5OriRotpp5rxW338oQSDVp0e5Fc0OMGo7SXZT1iI+ar
-VmpTLEecuJPVWrz3GIHoTtCWrHloN6k2/SKSV9P1V1E71LtQsqbwqDO2cOyy4k2/JFaxGsp6NdL9Vcrm
-doiPwOEU7ysjFjc1EX0aZMRYjhHk9kzyhBZPrItA83NqVD0Ysoqy0JrE/8rJK1VrRF6ZIOblhzmxOkd5
-5KXfSHKM2uPNaO8jkF6ltKqtHfExKI/TkqnJ24gAwX2/hQhdLA56A1OEH/hp1ji2WP/pS5Q4oU6s5ri/
-zMmfAk5bmT6krBz1pVt/KW/hEauSsJHnkCtxepQNrFLJEXNB9RHqtFe7URqmMTpxArDh6rCMkDxsBIpk
-q/qKsPVPBfNaBxQMNCUmUHGRQV9wMEUKAF0vBUPddAYjS1Yww/l8cx+SCnHL/ag3zLpaGvtVnM3SWoev
-KFZk+ghummL+I355IW0rrtXO3GGtPvBsjbuH6JiPyYg81ZzIUMra+YMahNK/s1iHTtZ1pSw8hLR8gFJ9
-HbbPiig+5DndZ9JJqFrkphhWisl0PXxq0j6Ed3y22Tnu9zinHt18sMdevIRJyCVbxJZ20uanUTfGkLgr
-FcrTwORq0yfBOGz6QrygIVDVf2oAdhPEdd2mnfBnu6BLunuYDcooP+XC6CFov1OZKU8KAIh6EYTGJ+qW
-hXMIcwrICTak6CMO+MKMC9wWME1AUxv0zneKXfhOsMZOcZ4rQbN26g7u4leC7lZLgftkV4J/RleQmsX7
-c7qDnPt0DDXrDXw63LF6c1y1d4axUXKSNJj15Fx0OnOow9gAt43R4/abnF/3L2qC4z42u26MWAEk3KT2
-YAxCnihbOSpbVMKyvo5GqHtYjaN6/3hxrZvXEZGR7w9zqZMRlM1I/p8yyxixJuvuWe2JKGSi64DZEKDK
-6fgyCCUKoblAbmWD3WjLOYOPCkt1X4pW5ZlNz6wLwvFqtRjevv2vEm5djUYyo7xxhrNvpVvQKzZkE6uD
-ivWfoZhdpPsBEQa+jn6iglh9UnOh/DWLjCe/lJcLaceqOfbi16sxBzq+OeqgPnPZUm2Oo12hPNK8GjGA
-0dEtYlolO0B8CCg+WtsDUFlNEHG02FyNn8rGUr9VrMOg5s3Y1EX5VDUJ1HlYFmtQ/i2H+jmgAAe0KQAu
-lZ14OcGAtw24P/sR6ijiSaU5SlDEQirf/jFx4jMfWM2aJ1gdxi58b6C+8iPVdcIr5BARZxkULhy7G1KV
-SyFL09uEEq5NKknvlC3flymucB4uMgubz4GXo8gjdmRIHr/EMCF87C4TPF5Mn/z1r2peA9X2YZiUxv82
-uFUivd9nesdo4UBPU+zNz98EyXKIdxTTiQ9Juyhh2NN8U/ejPCBKTCBmGAkm2J8YXDIqEwNJ8VHmngw4
-S0XJKtmEEg+XrC1ZSJINE5x8jrPQsmie4omjqXtTj6lgHdXtT3iLe9mru8jFXf1mgtU7gMSJdBDyjoKo
-n8KoNyCRVC7ZXPDeIHmGDsZie+r0a7ib+j5yvRa9/7LXPco8Ej2Ca449OvSqGXZay7dK6VabJ4dNJy2n
-qD5EcYbt9/GirpFjVFLKtB+g50zmUTXnVvJyOrOaTR1VqbxHRhz/GmyOWf9UjkU797Fp/kwl19tx0jDG
-IbVaj+QKQT4Ez9J0KRu9pv9IBbrKbbr2HFPpYCQ9jBNoYSduB3ZFVmh3FB/juhuk7AbL3qLq+QmUdKJI
-myxMZZmjKrp1pXuHKstGXeHcNTnWS5qQspLVEJcLUqkYgpGai1KOOCVo1ZrjDbWPo/QQE0ghIINMCGd1
-CykV+LAj803NOuIhKuYnlbVPFAV+BTlu6M+KWEaPfQeSRvcM+SLiUsSvfPebRCFYRSKi/9ZURGaOWJdd
-zGVKkejvYLkJykjIgdJZJhBGPC3yla2zPE9n6FZNteINdYgbLMLCzMAE6d/rfPx2elpnalZrnOg+kz3K
-LsyqOnCXovBqFi+bC17GSdK7uYzxb41PTZo0fxpreUyFvyra1CN1NSw1tTq5bonJgpuzKCu9CD7Z5Zrc
-d5Rdi1PIPHKZhzbJjfPhg7cqDM9D7tc7r13WGgFRkUu9T2w2YUjm7W1Eh/2D6CdBrlMWh318HJDPP7da
-GKawwGExUvxcdxw6dhGgJzFWPFaWAWEH3LwiLgNNcTQTslCX+w9T3E6L4i0m7y6wyBW0yctjzJck8RIW
-1Op4WayPjlE4K8mHqKTiYOQSe5ydAkhOmVQWfOuZsdEfssEFAOFG4l1e5pyyF/FKj4wEMUiewzl2kkO9
-q5OMbvfm/+euu8rAmQhkZxCYEZzUzppBOMhY6BgPBfDInWZWsMLMcwPtSaVrEOOBFp3ZRK9nwo087yyS
-qi2nDg0NfqVUWT61HcP+SPWRZUxF2dE5qVQVdy8/qa/YSorVuRRXPBCfZl4u9HGTWl2cZ534zwEUKQlB
-AWsEFzfJuwx4YdIuBg9kc4LJuCj5QClZuUJAOqX7wNco4vs3ZDf1nyzW5THNseINvRpvuohCJHqnjM8Q
-M4gmmPR7xXraMuug3feYwoFRRsFrRh9OPv8XZd5L16tiZs6GMXpZH5zi9BxQOChmvOXCfqMHK2C13418
-ONab3qhm6xWpIe9XS2x/UExOvxsxqqge42GbHR7CtRtm7FWQRoNcyjuMsIgQOAomzxerfAaFfgg/0IPy
-+TrDauo4Ez6Qg6UZ9jH5WoLgeUAyKFS4pttaVMExj6LwDr3G51sutVhjvQFj7o22rdvYj0N+C3ySLbk+
-Jrdb6gfknlUGxu2iaw4xiGwJpXfmGUQPsI/McTZdkCTzt8dmO4ynaT7TUIBnkRsqb0hg09nhKuG0JNl8
-YsUm3DFuHmv2yR1l0ZH7C30ayUSp6WqBdHGboGcc6FTVwMFg0E/+UvYgSSXMs5X95cKOHG5sUUJ11Sm5
-SEn8gvv9tc1zaSOhJkk3GxyZPvwN1+trSOtyjUQ/WatskCvyOeDdRbtzjQEeuD/LPl7E0u8j7w4Xgzge
-gID6XT95sPgO82g/SOm/a06yP9/a+8ffnMcN1UZ8UL47+s67XUahfKAf7S8hqwmkprTnHm0KIiImFc9x
-izQjc2pAtk/nmeUnEEyqgoeWxmKBHFT2XCnvkRRskZpq46ZkbH/iTAU1Wfmar6cp6e0f51raRbNEVuXS
-VSqkB+VJbovi+bX4LLVo43VVv62NTQlqI2o7YaxKn6P/jSr14Zy5A0pDJ8Om62AzqKEVHiBaH3wN3hz+
-dHbHey8vNTYvNshW5HTj9VCESxGRxqJlafQKPtW1BjUE+sCA6BjaNuou2D18CzBHTRhxlDTwrut4K7l7
-p68Q+dz87vlxav+cG3rGOAyEq5DZHKIa+OVuxyIb8ma4Ib1LqV+K9T9ByVotTzngXHy6/MtdzGErSbbp
-iAni9WgOVHI0jpJxmwRO0JzCRPnUlXMxAEUH72mxdkbQ1K6ld+sMAyyW4ss9DwFh97jmzksC0/1ycDWi
-jKfmKXjLSSxQFR9y2YLQnnwGzloDMQamc0pMDkoYy/NwJ8MHfRjSuCzKcoslBLAlLd+WIDe4OWORzM40
-mokSs0iV2SbvMVC3UTA0xJEvizl+5EIqx6yVnpIvoHU9DoDBHZNTuiUJhVSLglsvnsTUxhyhAjD85zwo
-ZOI2nNvV8TOngUC9G8ML3HGfaxud78YJCmXAjRk5Wy/hNHFJXDz3b6H+6mVT/XagChNYNNylwYdtkEZC
-zTq0CyvbLmWjDKPlqlYw0VfgMARAB2j5RNIV8prDc5J6UY2jabBKZwUa3Ju64rtAc4aywBeZbp5PCqT5
-NY0LE/pPIRYOLSsVYCxrHpgxHObk1RqOdyCh1n1zRhwBCYNloALp5PhUzwolRHRzA4lCLX/hieLxV5Fa
-MhJV8o9ugM2vCK9AJt2A71+M5x8Es9qB9Zvkk/k/O3AgZJGF+RntNfAeE0XmPt/pgOYAa1VDx8xCYzxz
-4APay/gykqOf9os9OnV7yBoriDXKwpvwJV3AUmcJVPWOKyEirCCtV/k0X+VZKdelN5LALXMY/O5zE37D
-D0p6UGaqBWlD/Id+qAucDGMPsTHXERjKH1iBGRGBWqwDw5LghHhKZ6G6Lurr2/hOH9lwbU6qupxJuI2i
-2l0n8rRzA3MZ1pSHhuZQFbpH0ZdAeX2pBdp5w1uq0+tx3WhdcHj47bf9plLCw20jvUWLBQ+3v3BvWFg2
-D+99Ez50FX/NW9dbUNF3uP2lAxcW7DUvv668hJq85oXrL6i5O9z+ykHE6rrmyVf9SAXd4fbXX/RjRXLN
-i2/6YS1c8xBHIX51sD63hnfv3O0HdXDNM/y6pVzt8O5d/LS+EK1p8XXfKzlrniBor7Ls8O49BNRQMtY0
-+bKvysOa3waOkIj5+fXXWEn87iaVxK+Lhq+uoGj4errK0U6ITUDAJ4EJzfj5GEvE1hYV95d3R8nEkVLf
-kWZ+UWuLCtEOHAqxotDRdrbScLC5Nq9djRAbPwxaYJdMhIgJnKL+ZZU73iFLi8R7mHVZL0tIys4hH+Rc
-ZtQIiMnE5O1rrpyLacSqHygZggWsGRrrDw/zMQhRU7foqLJJvVomg/ydAWITfxPMJ9g7WYh5UFTH2wyE
-M1OZgaPIx/fhVH9TOtpJDaL0jD36RtD1CLUbrDovXh8Q6V2/kKMA8xTs9A8I8HdNA5Cq47ogsB2Bk5D9
-ANBqT2RAqOmJwMR62qFMwWW8J5Vwn27aVKJ9LB9cV42IzMWeawLnNPfzxIffMRZscMKZt+HiGCtufd68
-z21Z44KMJU/QIJ5yDUrG058WG0XFuZLZ2CeIi5DWF5i2cOWz5/s7wwTqs1E6X+DLRhbPkuN8YpRpStlE
-cptaYLpaIs1T+hjav/rqOUkzLlYMFnnYwGkGu4Z1v9x/8/TRy/949YK+IdwR76FefMKCxc9kK9nmLjBf
-KCZf0j0fouSHMBQ6BT/iP25QvuizCEUZrs0JuCBWFv5WgSDpnC7lrpSMhA4s5skenVderesQnfORUnCx
-sQFlFe8yRVcWtz8BaT19/g+jSv7n7t7+7rO/RUhLgWolITfuc9IR2rGDVG7AiuYfm3Z2I8tNJxgY1tsX
-/iW2iy39H3fFX+7gmoMu+5HWGxrXL3bJ950w5Vjxd0ydXPVBo/BP9lU/5Hqywa7edwDUCiuwf9w13t/5
-z/03j58/AwF880VWPzY5HqLL/IIUJgg/mJ/KTNoIYiXwR+adlS2cnK6rY6mnzPeWq5XeBwxK3AyCFLgK
-opjC1BFKNiEy0I2nWbpkhNCYo6s7TtFH+/zj1EBxJNURiteRPtn1G11Z0KMCNBCP0wU6OJjtsmSlAHbc
-DFotpplVBSyaSKBRtcsrlxtRiLAij7ZvvWBVjnOa2Sv5dJqvxHPF3j6g+DejGvmsvFgsCZxF1SZMkwRp
-I/GNtgQwssXJ+jylfZeTSMJSyYHB4eA58JopnpQSjmrHCNS0KmDTuTDUp/n7fD6ksdvRA4y/sSUZ9Ixb
-XCkNDFa36HIGdZHAEd2NcXduEyMIPMi8VB1pXyrDEnBKS0KczykV7L1jUaukQaLwfuuN+4xzCOAMzdKF
-uFEpV2LURM7QLIBeStAXp7bwnZLVt9qDmGdPKqNxz15+W41SULwPOfj7VcRZlpyJY7rwQFmPBKy74tmk
-O25sTfZgJZEEc2r2HlpMtG+us1iWrJe5d3noXwgU0oU3czCTQGprhqhvtKxLLlBMUAcavgvcO7U/sXz2
-K7R7rX0UwQtxPsVEroAEO71TEDVEaI/a0sSIx7jqdSnhsDxhhdTFTj7HAd4P2rup2rDmo/wjv1Dv0bIS
-dBkpmme/99bZW2sLXko+8pLh0ulGap1ZBbMPPGA5+W/XXf9YLzoFj2Mk+hVW85IKWdERZBV6ux/QHyv1
-ZCxmEXWMQUSnuRKd9ixTcXIafxXd2MR497VcEdSMUbKT+wCNPG5eMCHeclks98HFd66qcNJKngbuN5ge
-0u3E+BZ1k44J5UF0j0+do+QoR5GkAcJNdOc9r5P950+eD9GEZiRONScJ7FfSOF3yl2wOPvD28woz8Lqp
-5FTVbzdgC8LG9Fh+OH1mGnqQiFP0EYfAqbn6lxnwHqRNzU5CwV536c+ERxOuA3/tVSwZuF9hNompJgFF
-Q1tbfvTCTfWuOmuqn3B+QtHPjrfqw50EomvzRJ3V7edXtrpEVredbSyyo9Km4/396tnGR/w56wzzVw08
-IHq6hxi1nfCX5g5M5h5Gm+Px350i20hydwZXM5kWCED2zstimrIWb0TP44IdjBbp6tj5V5mFuD2GawKB
-djAtIAv9PBn56zH6Q5CyTVKT8JXUCh1bPMp+c4Wkfe5zCw8DYJctEm9A7DccSbjO+pEpvaT4q1HTDrg3
-PZxtMufISRb42ephK3kR4koAJMpiI8p9mc+NLIrWO6tlEWEAsen+RxgsoSBBPhwN6YB83eAxC+gY98V5
-gDXmVuAFBC0YLc/LglVe4Nku57pGLxT0vbnb4FiXWJqqfBnQm1QcUMtSPfd9F1qLSrDWYVtPGkEvcNva
-RxYpyeUost/03aT13cR6PExP91PDdAaz9L0DoIX1OgjRxjyyXV+6j/BXb4yReDo6yj3q4rClkepuRNWK
-1nMjE1F9DEl76AepXX60m4h7jVKeLZTF/JbLACvleiIjzCG/I1sFBuFyvwn7f0TY+hqdJRjCySOIBh4F
-/yKedYn73MkQZ44HsCpgJS20SqWY5iCBPFXZUtIOD2L7t46bbSSVhxlsbnZDbtnOASK76oJyfbuIarPQ
-TacRvYkdVqxKI84CILlAGSnbUuBxJpq81MnnSpcwtSIYxOXNeZ05KYgmqR5azSfuRupf+PEGJyWnOIhO
-8VPM5peGhsokTNMvV2P+/NRk+iNgjzUwvuHyv7vA3WgcgGNZP1VPUjGASqQ3ZpcHDEeDFvnLnhY6BS4x
-CkZZc8AgqTOV7+VBcIfoUWXkZNj0c82vOfo2X9mcSw6WoWzMKBTGkk8KDtQkqkeuAgWbOAuXjawmOcPB
-s/4XxZIA28PCmxVn+vPOrAexESfe3XRogetXjVrurvp+GxN4vMzYwB3Q6CWokUyJFXKyXiPONvjUurHQ
-tLWRDEGuIRplpbNT57uLVKfOfROZxl6DtW4W39nnnDX8X31H3Th6lQQyHH1lxHybvQGx1I9yD8svAkO/
-gN2x/cq2cay+ZUkNt3JnqwatbVHVka8q1qkox5bErLy9w6wqcMqGY6c7Y7nUglTdyJUCxZjcs0rK5gdS
-hyHmSXoAnuOnl+L96wjvtuZfEGxAZLCZZXD961h9rlwpIKqckn/K9mwAcE793N6lqiWvEyfDksfBJQEK
-lcJUnQdD9I6gordf5V3Jee5JLnxHEtyPhHcj0XuRGuW/9S4Ex6BYboTR1u4jleLYFy1BN/rD7SEWA8+5
-i9CKEBcMPeEnQupVkb56npFO8ZteDC8NMPL9psVUoimsj/mfs/rwCntDDjEWGEER9UYebt/d7jc6W5sW
-Lv4g7kU93P7qi7oQBfTM/+LaM/+Te+arBar1xH+bnT7Nwajt+63bx9pZ/WfKSzN29SicVo7V9CjlKXg/
-mOXgoqAxH3CcCnIDJ48Y5EgTm5fJebBzIGueTvP/yvjqBZGcYL6dI+fMs2MOPHHtMISBjmhFCUpMhh5Z
-tcg4d6A2bxo/vMBOETqJeU7AziHLc+B0j5WXn3uo3cLYf6speiqGlt3pbv2Gd7+4hzvw3iY7sGvdoLp8
-RVGzLe9db8vzbctnhtjeuVoutRuSikT5u5ECswb0SoJPbFSV39YFW0lkC+SbEr+nn5eQvHT5GD3K5DyB
-Rhhim48tfn4LtC5irlWo+mRm922WLbCQ+/gtzN4JgRVXSil7OccxY1qcG5QK72i/qHTx2xmhQNJ8tH+3
-G4Np3MX4ZqDXh+LuSXW5xuNsQVI/AuJIhnGWv+MrXIOL6D1WRjGtieug6yRmS8MqOnMbeDs3Q885D1OK
-GhNFWP8tNn1eISD9QmSAukn3W3OYamxwrMV51aoJ6VX6VgYKbdj5DKNLbqgiPRmmCcNEAm4w+5WFaB1J
-dO02HobhVmBeoSAgVNAsIZXsszugQCHT0rmyUSJYWKYUF9EMG6TMfLyeErGRLWddSsYHxCt3Fb8xUx6t
-gZTgohnwh5LVjz+zM0Cbsxsh8X5S+cabi8y8XELisGPYTWUOt7gnmdRKooyih5xtmALxIa3UWMpDlQwH
-ZGdKWZpNM6jdZEfzyDAA3vhqILxnDQMH3mWHUc8s/A/Y39ZJB3/LViIcAFq64pbmDohnCtWkxvmhmXfa
-Rp5vuY7CPbOO//u8aeF7q8VCJiXKAWBAk9O4OEO6spT7Lgk2YjBW1Sml1cB3AoYcad6i/VgspcpnJgn0
-xQWaa86L0XUl6ZVc0XmZXcZNt6R5lFShUL03fOuV+5HFiNDZr+YZXvRRJn7/A+KkdrvWfF3ZtOZBHUUA
-qfcE+7BGLu/4s4A6+EAQQavCui5DGypAxJora8nBYbApRRDMXf7O6fEeNVw0MUT0ICB1v7OPmjEmCbNV
-F71aYrTxaXPYPBeaiG6ESRSi3VH6NknznJ3EkepqwH3bjRS7dQvuLRicKGRA9NYk0OPjE06GGrP/AEjX
-GXsUptXTp8v+QTX4aNuBksiqOeB9lPLSOxq7QSmtcBPYQk1w1FRQROTq5BoWZxrY0LDpJdBADcEO617o
-b7w5H8YfQ/tgQobhg749g8z0DZsluAZTRvDJOXJG+JLzcHv7q1hCgmoigS+vVFm6tmGcU1nCVB21KtJG
-mo8OwEFwZRh8AwktQSg20hVlAkIZqziZZ0EEymeyUio2JoB1+zZmKkK5mqGhXRSg6Zga9m/6jHItO+Qa
-QQtI6iCGIJ5pYF2cuP6BXt7OwSESo+pvmRe3ZDAvQaCEftJ3aT7FnvCuX6JFsCwqWXOSyZpniUOVUjkc
-ARBJJ09PNeOiFD9sZUZm/Bs1TVjpCD0APhPTN/HPrnuSJA8m+TszWY8Ncb99+BuaTCmJGz45+063Na0f
-r82qzhw2ZtAPb43x4a3kttf6wW0DWj2B44/+Bsmc/lJd1SHNVtzDckDdaPS6MZi6MCcXxtkQMtdzz/8r
-04DpjzN8YteWnTyMWpFBjmny9RiQBW8piw/P8CL9VNojVR5g4vYM79Q/w6uGZTFNDDGkNhWDy6Xnyp50
-SmpzOC1OYqFrbk/bQ49IP4xRq7iX0n/5ZW2VlhE1G+ki0Lj9qmUOqRgLJvhBFNQKEJBAXr9502ZdZSXV
-+gWx7F/wObNaGS3VrEAg/4OnkN9yksVbchZWVhD1tDyaTPzykpiQ8JBi+UNGs0l9SQfJuzmxDCQoLyk3
-J9AnXpyY/q0ehh+JE0yl1IVGACf8jPF1HZtDVAEbF8sJW3sPW6tUwmqag8rVpCzNtO4XMZ29DwD71Pnl
-Ewy78Qw0NXUJvE3jFsGNUwE/h+2WeseAWUrU8XHfkwwQyU/tpx0MksSZ/vCOjkhD+yhBewVtUmQlpn3D
-Hi0RdXmX922qQWefl9otkj0iVnD4n4YTjIjTj7iKcs8MZanzZ4ZOZRawAki13VbFYmuavcu0ssgpCsG4
-c1xMHaXEEsY1L1KPr9uIUdjt20UyceJ9ZDsqh5FwR6KL/ZXuSbDUbbgr2azFy6/cUM67DxkIGpRgP7K7
-X9tO5AtNj+Ahhd0fZjvWIfhR9+SS49mut+W5tiUUz4SsYjRHo7GLJQdlBQvL44HDNaxgcsWdzzn8cUUT
-AUgnr6NvN2tuJEbcAr2F3QfAnkYMwqjcL9YHRrQTXbjbQwntV/M/r6lojN3tkbbu7p/AWSEA+I2u+Nt0
-r4/zdA4VuF3Z/epa2f09lV3I8Ygvz5FET2u38D0JvZyXOHn0/EVSnparbAb1RvOjOXu8UmZQ7RNF7AxA
-0YKTBnDMlVOPzfKVQ3SboiQH2WG6nlKHYnVMzXydluBRaATygS/fY0O+mJNz8weS26l+FGieRg3DwiqC
-HhX7gFBVqMABkXxwrk6oa6z5tszeZXPWB9LxOIcU2mbU0+LoSBIrwxAhYXHpql1Sb0+pk6Gkjtao/d0w
-LchOzmKsTJcz/0HdDzNlP1h0+lziipsySvNTQ1/zIyr8cpJ1DNkb5r9c5hO+iKKyXGQYg1QRQUcOZzBR
-ZBOL85t5wX/3oxpTVGEKBAV6B6JC7Fubxo5PCGhXWnT81LIVramvgPc1AF3Y8pw5wm2cNdz8mQWIQBXI
-N9W7MBTDfH2OWCwaxq/mo9fCdXVqXR0No0asnipMEEq/8gU+tk9Dr+6quPlKLjGhgPsyXSSS6x7SO0vN
-LsZvkPzvbFkgzR3DTSdGwcikx6RQRRtVwpCXh/PqO3epAn8FGrp9GdKPTzhELQS9j28uQS7ohUm9TH40
-oDyfOXott5EPY3EoqigJrrjHNKrhwDd1X2GgWYCHA+rt6popqIvzkgQUCvbAjGd6SnJAki6PMA94GY20
-kuqv7V+dyR88XQPDpRfTFB0bzWAY6eTzpPMGimkQ7vINd8OfOrnGQ8DpVOqGgGZQeZ1WKU3xWut9SRCe
-VshrFi5czSKYEXFTLYSpy+29fGZmy8gC6JUgBRHVKTGxnfYTSZhOr7Cqd23+NLvl6jdc/XaLbzZ9Y+VO
-jKat5q3KWZ3gCdO2sdxZlTK/vvYA/VQCpTn10F8fTAb11ygbe5phcvAfhaS89t4raf+vIp+zp4vfWr3Q
-dzQuYmnFWAOm5tERJ/9FlXKWLY+o5omk8eMibF4Z4c+SETbcYwCjAcSWpfTdCfo6Ze/yYl1OTymyt09r
-R5X8ITymulc171GgN9l/pKLLYsiXXQ9OZQ/iFsEb9T6IPX0arz4Vb5LHVqOQg01EhkEIzNv8eGmvmYdX
-170SFBR3tNfXq2BwT3GdHtYN3VUjNvKxzdFIizaycjLpH1hMigWmTpl0Rw6jUZIemMY9mCBFCTe4oKiR
-7ib6Q0R/RK1xb9p3uPs7pUtKi508GiUncCNC8MDLiDGbQBztj9OcypeTtzI4aAzcErIn2G9nZoDmaAUv
-BEXs+wGJg5v0xOxK8Es+Lk50UR9uybXlR/Ib1rvcLzDhNQdZGJrA8jNg+Z2fclLv9+BeBw7OZJdHBlhS
-PRcXA0zFhPCmh52jR+NpMc9+NmcYdtTtjUDJMiOFArqnTsMLVjbHze6pes8yqOdh9/Ro7IVS4NE9tkHB
-Hifp3/CLxhMAmPER3ijBAUYZwmFmgHZ4/WcKtDQc1pGiYke9hh6RQri3Ltm4qOiu3BXVooBfDuM7REsX
-T7FCpaZ5EciwWqXQgXA7zowOM26zuRDJAzD5NORiohwigcVezLOTF/ad8DV+6XM1wQjKfAijkq9pS1u1
-DejuP0h181vYDBr0MORl/J3iZ77CxslxYgzIkGGVNn9lgK9VdcPKh3/9a+TLdsxCODIl3NLNjUWi57Hi
-eo5e7WvhwcGMGAFsLcFanoSfVRKT+oJC6Hjg0R2xJZU33PObIv6bVnkUq6mx++CIlOIMRz5i4Q0xHTGr
-k0IKynEsCaERWcKIzmttJdPJCwJiBDK42GCYXbwXBzdqEBnG2QQuX3pxGLIGaBm3M0UCSz4P1GL5iOzo
-fJ/MRVmwHJ+ZX5JEQFZEYAhpMlB6tPmNPWpVmgcSbkVX+lfvWnVIyYc99eX9Niu0v0I27iTub+VLicO7
-d7AsjJYGh3e/oDixb65t0b+76nC6yH4qxujnw/a/pjIu0U84rOPGOU04zQBvCAMcJh34D7u6cjDsEO66
-8C95DrLFY/eS4/qpiRVhG8g7xOESyu+318rv70TBlwx9rCXzagggUaZE81mqtA88cmwL76t01xDa9/Wd
-a+r6xNTVwhNdUeywFpRUnNMVpH5cpkc1zeWV176OQYYfR9tZqj6PVccRz3TK8XaQW5yvICgj13RqtNdJ
-ZiQ8zp0L93DvqIgef8CiESfwAoA2CYeRlzhExzm2Jjvv0xlkxF+X6VE29FxOSd5yWHf85emIgyS5pz6C
-qLBp1uycupCPh9oL06j/YC5YMLVxIB0qg+Rwe8uQyHiZYwN0sOUP1eMhoTugj/t2IALfBTVn8/XMAz4G
-EdZQpYYszwSsma7nh91fO8+yk7LT77w4LlZF2XndG+TlSwYc6dMbQp5Oi6NbEiaJ35RU4YV8F2D1nmAj
-PUJ8IFhIU4MKtdT9V91gY67AyWAwqDq0PiJ7Bl5ZT21wkCUqMJqYXQwFflX5Z/BtdRSDIVnDh5QW/wO4
-jn6Afj+Q9P2BcqV8oOX5wNNpcHnd+6BGBf5BBA6oHMD5NDf4DYsQdNWwe9/fUFXOsHzzu2x56m0Tko7Q
-/GnFWpekBlgTyNLpCi/25LYedxsZDJCWeS7IUVjvOgFpdtOPkHuTdpSdGdoeP+Xzt417I7o14jvDdPLq
-5a5HXcfiek7fwc9hxdAJ/6F7CcsBnkm6DCE4TpJZLP4B5jxMkgmar3z6+r5qSvn9pOlNugdUfrj63cOk
-Q9h30LHXwoB/NxUUFc9jxtjzcfMiknZQKghAdXYwpBOrpLvZMrsMJgwsJnCTJoOBqzU4oNDzygPjzY/3
-rqcn4Mz+LX/ZzRfbe4a6qZ3beH5CBDw0Hj17/uyXp89f7UGd/gcP0nkxP50V6/K77+T844gwoBZ03UL1
-nWhqp/Kqy8ea1PINv4BMO6pp9QgUvQA3thj6XizzWQ4RQ7qnDjbpoKEPGEBzY/Yup+YwTc3Nnfs2tCd2
-0vwFtaH2xIWa27O9GtsT7TS3Z3ru4QVrOrdz82h+6s1/Xybv+aFtQj9VM2iUSdhZdYEpykv4ZBguFjSc
-U7Ukf8HdLLjvn/PvsNlc9QFJP2Lv97EEE7V5NTcLEzQqj1PXYA9+qAZo9QhucB4fpzm6AujJYzbLNzPu
-C3gN7brq/E2a2Vw/mbKQJkYb77WOy8XfHz64rXj/huZ3ih2GUb+cC1lJg43q7q/S0mYPhj7U4VbJDe2x
-Pvuve0u+SG4Zrub1/7l5NLrls71bI+vhyoc9pYa51fMqvHdv4Yf+vMDXg1u9G5ojCg/0EHWJlPzrMH4v
-S9tyOqll87w3pbALVEqePBZ6wBov/PfgIJ9PuqgbkisaAgi/UKJE3ceQW5/qnUv4aQADrRwBOUd5Rsan
-EzwLSPr808ET2n5gq0Y8R+A4y7/c2dvzqdwWfq8ifQV0DheStmfIDY0+HE4U7hu57LQP4U7Z7WV2ZHDo
-o2sIymPCqh0sXDfxBkSttDg8xDL+KNiCeTk3JIi1BYwQW4K2Q/nQjIh4jOp0OnfgOqAUw/gl4qmj89Ux
-ND2X9oE3nTfadm731u6cwqg23bYWMXrlEMG33v7t3tKqX81O7tvlpRZ6sYOt3ot4Gckm1wkKNuDmsQ0T
-HptevHc9TE+RHoB29RK/KXHz9uJdVY5fTDDBf3/SjYkXU4iPYUT4X0VBV7bbzrH9Pw65BoRpWzrSPR+t
-plx/rYY67d1ojkUHzH8euKXgOoLm6eef+zOcsalSEYObn36Stx1NqoCJVmRwFiNHOTasuqV+jM0V0wuu
-iMyRgrXhTaJNJJlLQPdXR9VXSaia/GLE2kyNnknyk3HMiPhvJQyVOuqKlrgi+ioK93u9UrblgWYY3rPB
-PC6t/4HoQ5v4vFPWDeqTHrWBTmeJBvnclVHNRudfjFP76MTZtW97eqjEVPrq1/x1THlSjurCcG9cmlDh
-Y/KX5FqBD5P/d+/5M7ZF54en4QTfv9FEnhc6ZbF/946mJZQJzysRQooCAxvaeeMzzYRWK+mpLkiSEVPE
-7yaTXUZZslakP+Ih9xGlMc650CKOcYSRm8X4fm4LLdpAVkOn3jZpbVN5LS6xVarFfCRuHVrXumlFfbky
-nh1jx5Hu6kXosTUvRz4zTFkLymO1bJshWbG2fWTOfnUsukZ+aLWwXRV/9cz8VykW5iWA/p8j5MNoP5mg
-FtrNu6U8+LRS2n/7EzFyBF7OlsZ2ww0PxHBZq9zUtcDYDM1Gb461jET/wtDbs4iVY3zBU9Oemb+XSUOx
-HCVFlCc5JJbuhpfP9rYnLbNELgWH6hHf4+lH4GJ1CCZ+fqocfGVOqaFcYgbNbtredWPeCEM1j+2WP8+/
-nKQjdHDo0ixUbl+qihHeyhv9uM1GU9uzri0s3WiW4blRDbL3kLN8tXsoTyImRkf/NeJg9XDBBr++fd2L
-CmZeOeLamvJuIJxcIFg2C+UM3fhvJzv/XueGCDFrj1HdibRGdM0A/iN4eZZOKVEbaEaYXRfWEj1R6OJi
-4OU8jjBOHFDAYkMqBqw2IRgeCV/Fy1bziUKJui+zo533C/nYjPg51G/KDt7mqzLppqsE8lyvEqjJOU0e
-zSfLwjDXe4M7PduRS8AW3qTgPQqRPKWyoCGl3Okg+RnzNszR6YhqT0iRmrIgP6nbB9P0tgDjyGDnDMQ6
-hzdu7jDgMTKx93ld0b+SsuuVdENE9SuoBp5apdGAshVhHUesrKtvkMrBDSz5DjFTtZd+o3D9Y3dFMRKo
-P2W9M/Zh5IytXe8n9i5dTxrw2I53V7shwTgQROodfUUbn/1mX9zSL6QjrpzD7Xvf9CsOm8PtL+/1mz0x
-h9tffRMPj0Cv3u1rr95P5dW7Xv0EebXnGRVwr3XufQEn6kQyoyuPWfXCc9X9YVmcmHnegYQ1O2b3rbJl
-xFE30spV79gkSttt4uh4WKnChKJTflHuF6aZgfvrayR9DgiKfg/pvVcFcKE+AuLifKqZ8iXTBfpgc2EE
-XOUUjeAyWKzL467duxbMUP3dVye8ATy0PYQn/9D9Sbte5fBbOLzLSDXamJkhhm6tmUHayQxHPlbGhjoK
-GCg0nRbhwR5UJyZsEcxP7LWbJE8RoLkCNlHJUFuzfjQhUkmdvjc7jRPr1wHBHrpsSsCICLWZBoYlwE8j
-vuwXceJsiKEIWrZEqek9bJ593W/ansPtu3eQS9+95tKfiEvHalHeOGfeNNiej9Pp9CAdv0Wa8Bmr90oY
-+adl+hbK7twwgL1smkUCNCIN/AiRgPJjwSFBE/leTa7/lXpxzsOJqX9nDskGKP6jT/nGpUJbn2PYZbBL
-KO2WdDmvoxFx6RUYOLiGRA6D70ku6okZyXp5QIkSOL2dRMurApouecHezk87j/d3nz9783Jnb//5y0fw
-d5i/wIbs2kk+S9yC5HOMSGC4klXapugexlYRkkLaH7sOgJd9QKKLVa9m+PGeWYoF+R8yAYxc/zrJwrQo
-a/Ax6wF5kOwDL+fRegGFlkCtwYR7Ruc6mK6Xtw+L8brscW0sySORz9PJOyiTjcsFyaKgkhamj5f8EseG
-MySUMxSKGs3Seb5YTzmYrotFjVZcCymfnlLKVAorxxJWuOI24QEANFB6bkl3/mGYy5u9Vy9emCXda1pO
-lzocysagYWeCnGNNxc7rtueIC38TOEogGiTFj5BB5dRD25lQ9g53/7BeDshLbtTl07m2pTlIpanzKXW2
-rKBPd0hXSM/OkMuWI4juhPNFXybt0xaZsaQYjyF51gCzjpTZSIAxXZac74KQoHveCl2rSJIAWZnxTSas
-+jFLJG5DvOBDyVAkyr8oKY45JA4yx1RS+2N1W0xKpF9RwcZRMubTppQaC8iu6liW4VWG4A2/evTklzf/
-36udVzu7z/4W0viuJTyaOlvnaFTMzddmIianI8J+Q2plGQ0qTsKIEIInswVZ6g/htIP9bVjz4dRI9OD8
-ns/fFWZ7Q2FOVcLIw8lORv36NqJkjoH88NSITl21cHh8v9p/89PunhE9dl6G89Y+8kWoClWG3ohl5XOt
-e3SrJLbzPhuv4ZwDC56ISONiYfN6j9QxPLK2j4ERLTFX85JD4VKMEiPH5VkGVmWJjus7AyHE983o3gAT
-GUHjk2MQztjjuSymmGAamC7AyyCKDdN/qDRALx8923tEZ+nPLx+9eLHzEoKDfjUji848HHXR0xdeVHg4
-PIwS/o3XKipVGBuEtSVbyNa5jiyWQqQZWWak+1hxVO0yINbx0tCCoUIGUjn9BvT8pXCmQFoR0fXHNUTq
-Db3GycQcmWAVug1ZpKBAhlnUpWEcCdbKwwOQEp2BfXE9z7GkPYrYKMcaajIqBQVCvMtT2l5Yvx0/xUxY
-NCRIjpZnJ6B3JAcge6ckVBtcdiHwD5EA8EeQG4VrLWLQoNkDE66DluaQeCgFlWKankImQ04mDGZjqPzJ
-QZW2+ifmRJrkBniJCM0gmdoBMHvJjhhLAFMjz98OjBmxZtqgsczcLvaawOYCo/Ec5UejRS23MJ25S3du
-eAhkbp9nGUYxGyKgaMakW2acSoxOtT38/KV8OOrRLtJQjZ5RNnxS2baGaXEuee4zNC/nqw4DhEKZXMkY
-VoCvB0qXHxllaerWkMZjlyUdTx+Hk1eba5SwzpSvsOy5X59+NBi5CYYx7Bd7qFs9TZdv1wvInir3ETF2
-bN57uhRmQ0d1iTzf7Xchg6zT3RWAnk099zR/n88rYh6kq/ZICp+mByVe/fBPXcNA5EK8lnhA1urvzhLo
-H8gb/HxIWsHEuwvI7ZFNQJUZiLiy//zJ8yHsHhCBMUsSglJYcOXE7+zhZsZTfR0zRzF2MT4bk+DCNEPA
-DElagcKIJKzUnLzIDgYaw5f+qtZjF6MBhx2mwvfXswVUSBaRkY6U+j1KppCq3NZA7eNGwbSRLHrg7mY5
-FIOnpdwBAbNOsFhlFcRPLJhk56LRjOVTOpdf7camhAXymh3DtY6txFrZAx7ocIo07MiucpfbIG545t4Y
-h9UmX/0Yt1yfdl67rS4Gud5eF2ttbXa+aWb47Zf9qzPk9WtMKsPtL7/q1xpMhttfb/cDw8hw+9sv0Sz4
-xXUeqz+ErbA+/S23O4zYxsxTz5zGN33/II+PMGFQtIk2fP09my6oOhawWtuxKzdUSsIHqb7iF1DuJ6WR
-0s1ZRKUD4Xh5B1fdwMckGVFEpsUDfWuJzEU0Pi1due67KvuWjxjnB0em8oYNCDzUnl/A1M14JV1fqJR6
-9X+ADo3EiFJFXxwTUENkwdveWETT+L0MCiiJwivhJ35rWy3JUO/uE5SjzX+kZBKQNASmx8olxXjThxZB
-70wvR3A8fy+ovJQ67DQFfACRdI+6ClejMLhuVH/JF+PUaRXOTJ9noa+x7EveKm2smonIF4IY+J11GwE6
-D7xz5vSP7q8BCq+P5pOf0+X8x2JJKVQpkaG7LasgHNBw9dYr8YwvVRGo2xvw7WfX7ZJ+pSPf8kYTGJFh
-XtKBXmLWXsO/kXuWsrE5728omOtd0EgK6/nGxOBZyoAF2NJEZYRw2qZV1jpsFiIUtSCRdcyrkCXpRcGr
-UipOX5oXaLKCFJ7vNVFssPtjuzvIC8qkX7c+kFZU1zVW6xOdv77GMr7LbkCdLe07m71zucjalk2lxlUd
-oQ+PBvTXv2o8Bm+oPNnN0PsdnI9yEKl5xSgvUz6bmZ2KqixaCjGLNgLAQnPEC/sOBAfMT7Bk2nFm9C8s
-95OvTpE+ACRqzVgkzwCc5XN0EKI6O6ZPB6pcw83QdA2WZDFDGyllF7KAy0UUahAlJuMmRizYorq/Hh87
-cONjvKTiVNqGmx8dZZjNh/No86lCg+PSaFSUNSN/LAdq5crXg+MZeOoaWSyHmxRkc7bYEiYpzzhYz1aG
-K3MmboKWVwZkWkp1fKkZZ2QJNFukSuDAETs4kyyDmhcz4qx8NFu1yHI34Z2fjr0r6ut5vQPJQzm2x7g0
-E6dXHA5omYi3IFfj3hVhC9UFrCDpqX2hoAcjaGWaqicf68q2DPlCt3Xbt6J4lYdapDrRj2Dox0PMiOew
-NbF/lErT8xXA3IBnb8qMK/yWL4aJCHYPn2VAlenytJXvhnKB4rKVxavrpLvyVOCW9MpOmj5Hpb+4IjLc
-vvdtX2s2Rm+9FykD+PW9a0+WT6adHjarpVIYO9Qy8bmfl5Y02N+8nFV1dWy9UquYAZ+8npfVMtTWJnfY
-bS5piaSscyiZk+On7CgdYy1cJ1RSrc9YLeR4OVjr6W5H5VXLvPSorBl6c+RrK8e2jCCm8D/UsrgcEXxI
-O4drHIIVDcwvcbCGF4HEFSx6BSoLaurOsfZw1NjpA1IfjbZ0y+5hkuXsXC/iDsgcUGz2cIS1JOk4mPSN
-IvRWSo6Ytkacz8DEToXhARY0psJcVCqXSh5jVBNs4BEZCDyfAC6OayZlkh/iB6ueA2ZY8VGxMtuZnQ8q
-EFHeWpeZlsHkfobAsBy3QMJn8yqIIbbSLL4tzECYo4KuYpgG+iWJSEqgcEwDNMnDAgKLzxYrKh88zaGa
-dDH3hMEb9B1dL+yxpAmcDCwjwJVEzPueLj2lXjFWByb5lLG84URDMycHWMgGtEt/6fVZrPBM5wyNoVC1
-mfIkpSo4WILHTBPIyNAzT3U5SPAYo3vpExLT0cQFsh2DAtPPBKVwmL4DI2ufGpSnxfyotJ9gD/QV1/fk
-j5HEV3kFdQA7MX8NVFo0Otqjioun3sjjDx8qH9Cm81sTmwBbmN5QThD8CBs94IMbbPQ6GePQD2ig02W4
-/RWZsL+8lgc+lTwAdjMzxPeBO2uL7Vq+2pX6iZWr0EoBszek/4W9WkcgAPQ40kSJyFEQvv1Ing5iTQ2S
-URC+107lG4Af+8wl2FdlJGvmp2kzODRgS+AGuK6L/cl2Ra0Ld3zDBDZ33CdXk4tfJNu/c/xdzO3aa+F9
-Tc4RGFtXrmeRj/0G3rf19wjJQzzGfEj1zf0M/3RFGsnvTy8Ehfqz1PuytpmDw9Xmw++kCL26F4tbYjMx
-vajbErksAQr6+/7Tn9ii7t9nOT8VaNz1ztjNjFLJ9w7/7o3QOBWGJUsHZB7oBN33hskvRkhCVyjMDZpS
-GgQfZAdNCUPV7SY99sjeY4ufyVU5GClOlfk3tyawgGgrjPmJ+BavPPf/FkpTTjnib6yvPbTRic0i3UjN
-ZfSHFjoSRNFcXUNrMg/9xCpxAkeuquwNUAVweGeVTwKTmtocYdbJyBZGjZb/3i/oXZewEHOZQvMswQub
-6ak+Lhvm1zp8VGxHZ1e3i1zBYjIxm864FsfuE9S3xjw+ANYFe/IRXMem6PNM3NpQ+FFW9ur2o/Mb+x13
-pULif9jedCmif6+tKREIV7AZP9p2qsiFv91IgjNlGPzuey0cgQ2jT1H6vIgt148wD+WP4faXzsMpkC+M
-LuecmxokhuH211/3A8FgePcOdlp/5A/v3v267x3u5sk3MdPyhWt/37sWms+nStYv8qa65XnCHc8bShl1
-pPtdgxMvWglLxQKNvKGOamKDoh63AEnF/qjIRbY6/vkigTgcxpu4Tx2LU+38f1R4TWvAjI7CaGEYYUBg
-9GCrBm3UQ+3GIGwaztEUjxB7/N8vPOEs2QHiplLuGwQnXIcaXIca/DFCDW60y+cu5sA0rkYdmIf4x432
-wIP6TurDD+q/udIghI3jCBpSQ11tlexr+Xpz+ZpuSpuzRGHDn/LD7PEpFR0NxVv7zpOLOYy1zvdDv45F
-J5zTwP00XTQYt81b7ysadswczm/OL3VvYimGdifpck5561UrfujnvmIfNsKoxsUZS5gFb9Dy5C/MwCZL
-QLZpugo9pcnz4STrLLPAze1ncxbgV5zEb9mHy+jOSvBDNwCSVgSSuBJ4c22maw2FfVUfBxTAJG5/SZf8
-SuloQXfZZcGJHik21QW79uicQljpAVr7CnKrpSbgvXeSng4EEiQOPFhD2avkf21/fe/OwB0WjOCgZb6r
-HjzmcN4NWuGB9GOxZCALz72ojwJDtrSFQC9nMdQ7SBZYsnazufAvJZZ5HZqDFp0o2JeXdTTwowUPXDF8
-sn6GjIgKdQKMLvgJm9UTJWNEEzzqDTjYwxaqZd/hAyCJBXjF2JmywGzVZFg97HMg5e3t1MTtlE2jVUbK
-ymZ4WOETINwGK2MzPd6siyS4gCewBGvD5qaY5pPM7KF36KVsRztInoOKdAIl004yNYdlPhUnUwL2MxK7
-dSsBEJgGFVLIMO3KgY5uQLRLT4Almb0BWDhYK/RFkX16ApLdEmUpaMbpNym2yUx0aRjBBLqaGn4yxkOA
-llyQ25iMmc+5iJabbiJcTjePbJMCc4JKFAVaWFEFpT/RO8n5+HRUHukOHuzrkhySZkbfK9HRB7ucJH+B
-ODGs6y2RJeah9/1Y+w6x/30yL7aKhRCtT7ixZ/wICFqZkGvyNNcx9IcNDP3VfFbD0mPg+VnYw311ARPK
-BVKc2YUSrJmjk8RfQl6k9VSCu9IErAAQpxgmxzFC4sJZAirdhGF2O/Z4ETWLk0qAbnIANzts/KDICwhF
-JKMT0SojSbBw1zFNinLCWp/nuA3i7Vni8wbUA7WWs0aLCTvcydkVBNl973xULPaPie4IXZpJoE/EcyLf
-V7y8g52t8wNFDhdoIut/0cMlkezBFnPf7VTIvBOgxnv2F7fFRiWl1QVPR3NsLKZGLJffbqd1oBmYOTLV
-TH4bohsZyhtnkmWIbFEBTShgeTn/p2F78B4iXQZUuNI/UJrH1+NNWnOcnPfUl6sqw3Vf2KRMwHJP2P/R
-CEF4FFNpFBDCjPA+Ad5Nh4XbrgLHGfIoJAmAMZtHl9R8JYxKfD/70GaWYxeiIjhpzfSTmxdyPJlDHs12
-QnufyQlvpamfgSuUBaYagV6NHAzmAWcPyecRAXIAUpiA8KQthhw9gcV1ERuchzPWibqxqrku3qQaVcYc
-RchclQ1ob0tJYO3O5N78Gr3tUMBCKjBe6xPEOtMCj7LkjGc7nFe4KhOSPWSPYW4g2p5kqCUwsdXaIT/o
-w/w9UJQhkEle4mFgRAuUGQv8bJqtsimUVhBYh+k709hsMNwqaP3Ero2mO8WhQtbu6WnfRsMx2HpcRGKN
-5r0h2tlEekczWMC1ZC83hhZfs9arZa1/uo22MXW5GLKCYp8lgJJlSJbvUajFwHiwsfIWzdHT++28OJnj
-WhCgcbZcQQqrU8mphYGnn31m9LnPPiM9GgL//as1K9+gY0h6as+HMclJeUldgjwMHRLssgDDUZZBeocU
-6i2s+CKJMZF92SlZflHREHhR5MjN1jwnetUo4TygFAfv2co80p77Ljqc7p/ylbZIsxHb4xJCwqBWxpIT
-Xkrkw6k55mBXYjtt4tqPbl/VSmw6svlCcgaTdSAHym5VW5s3qoqqrNVz42GwdRtHDRMSg2PJjwvul5fE
-nDBbgFxflnJqnKSnJYe1QIaxpSY0EHownJabO7q3scyZWUAU1wclfWNo00Zu+6TJR+e8SI7WKVhiMyYA
-73vRQQyQbAKuJFZFNZJWWRAwznMGt+Ve3yj/i/aOAyLxCvepvcrJksLgrlKEXj3xVsL76SwnMeEZGOT0
-jNbS+kt1rDSpJxr8R6Z9fdJ9NOJH6KK7/uoN73VNxgj5VE/ZZTfOXga5CJJyfWD2RLBzKCEfnDVo6HNB
-Xt4YEneXR93xhwvnFDLLlkdkJoREi0d8FnG66vRdmqNEQGkLIKEiQFtQSckcbL5s1wVt+RDTXwxYcN15
-vyhKq7UTOiBpou0KMM35Dln5lQAyn2pTmJ9WfqU9wU/8tAqA0YTrFG2yZ/aYfTXsF93zR94uwkwvu1UA
-PTLaPGwTudTmsfpdvUjob7XXLN79m1PQGsHPm6yr3Upor/601IaCfLLnoTFH2nO4NNIWAminLWz28WkL
-u7ksbV1c5apSVV7uF4ufIHV7Bc0mTQutxVohcil9WHWCBC6euRjMy6t8a5FCcr45pTbhGxCUIRQwM9EJ
-ejagciziBInpkklmkOyKPYfjYW2Q8j+NUK4VP76lEdnC8Cl0DbdGfSPbUx3rtGSEODOXG5QCR7GrpKBw
-zpWo+tc01z1lCXsKDFPSxDmjrY1YPoS+6MzqkzGKLkjgA7mzspYk/shtDiq92JAoiPuTq2ZlYXL/2tML
-SU80fQ/5rrj725mLXZUKoXq/tcgG7v7b888fT82iPJpPXipdXyg88zO+YKfedrsacfyTckKc02fZyWY8
-T89KE9/7BAxPG2P+4ExPoxphfJ5Z6Zr5XTO/kPn9MXkYN+RON7LvzrMTLw/IRkNzH93fGMGmJEnqMvQc
-kTVxd72qi9Vw+wsXO9MYiQPOUsPtL90L599lHrvkS+IqNdz++ttY3IxzaxrevReNo/n2OiL993f+qw+d
-+eiVxS7qIvgiW8byHMNjr52XxLeaFVleftxwnCtzDIRGmI/OmsdLrndJ+KSLcMHqggJ67hP2spi4Cgj4
-6gDKXaHizfYe62Ot3BMh8xElowCYl3dok7vgl3Vp8SBXZ4gZe7ppAEMKhaUEGugRyKC8kFMKGoELAwHp
-3NTEzFUTM3shRHsukuLZzt7+zpM3r148MRt4b+OAoWDxf5L6lMFzqeRJB2J9RR/MLVYPFxYvDtlzcXvk
-mJf4BWHZl0wI6+A0qV7IiC8pGPMdrDKf5dN0eV/CDQyfBPkuoYlyEVYnFPNBIyv72v9hufa93ErMy2VR
-s9VIDpNHnTKGGBy6pbstLxS4H/rJDyS5sjuaKgRmLzwBLL1eigGV5Vg2+avhcifiRhfONqRJH2fdO/2G
-5bcxyVic6geqUsf02Y1fg8YXVeqcSkVpiXEL535TYh0HoR06tK2lypT/aW0lrLpYMn939cMRvK7UF+bp
-wqyfF6nK07g5xcmkOrJmBm07jnCXh+fgmZVQriB+pWbwbcErODVXG4nVXlu3bY4bA4q8udchP+GiOGgb
-znAQtxStEWJ7iy+o89Kkks4UOavmgTTZPoV39pNU+e3vlGUGAe1Tdgpm18RoTzY7QgXeSMC52g3qtDRM
-jZku5L0mGZR9k2VtQxKRrkiNYd6D2oz6uxHJ1obSgAfDv2hI/CMVHXLDcsw128ELzDjwuazQj5nFfnLQ
-T8b9hFMVhyISYBGKBYNNoGn/3EcQN8nZwrF6Bzjm+qkMSjh00S3Yhuo6RSCeqHi8bbSIZblSFpDTYg0O
-59QTpaWq//yuOc3MAk3avpcgy/kawoyhXgT+JmvMuqTk5Kc0SMeDBqVRBru9oOIHjvA5DPCxnY3ueNvM
-2d0ep7ugPB3bgzeubbJl3usH972gDiNABKeoTq9hMxyaQxMvbh3RRpnT/UvIxNhFrRDGcu/O+wVVEsbz
-XyP0z45NPooQtsaOPPjI7/4FCt8WTu6dwcirzTFUV38k/u/mEf0Rx7EiPTcPiW1kNtO+9R/HagmOsKDy
-LmTYn6sUrcd5tkyX4+NT6/DfNYIDQUvJf2RrK4FgZbI/287ZXNPrkWTndAWzS6cTo3/23bMbIlXOlOiX
-L21TzEBqKFUuqnHegEtWJDsg5xj50gzESvabKfJq81PAlp4WcCa3gQyMn9gWJYO5YeHgeCkOVeXK/K+A
-O6DaAxzyn3HAFsvLK/L1MgcdEFRuiKLqPEeZTHmOzEd1ucMlkKJYOIPlWCVoC2br1/y1s52aMdeCPYYD
-ao62XBubNj/V8j+oCgKJ6AAD2SiSwJPjYS7N5LirVw4r4z7EywcBrdLyOPgYeakbnPIvdEEllQkkKaGh
-QSTS2Vkx6lO181Ho0jrLuerJMLHqlYl/eTGuemZaUv0Xkeq/DKm62resiSb/cnQbduwL+5K3X5mkLbRf
-//W6r5835fG27ax+dKZ1G1IgIiqTzm9rU0I3CQZaMMIthVsEqSEgY+ZFyErB6sDLhSQq6qlWmnW9K6st
-d/NBNujf8N2krfLak60rfvlUboMrihTo2E+FZMERdIXlPwygWO9WAR7r7Y0mIxIyYetS+o9ujUL54YNn
-YdJmhza7QpBuzLeQV3WVQHEKSUxk3srRTrm3vBxdFkBjh3WZt7xIgtjgkxqjmxs2+89U7Xn3FYBzGPro
-o3/HVGn4F1eY/q10JGsOiG8Xa3kdcHFc2LueOQ5lhU7ka3wReX5DJSaCxCfeKQduxYa6SSaQS+Q+2tAp
-pJXqz6ZT5cdegBrwmU0yIjtc/NIqEWUFXVZnFj4Vdi8D+dO/8LEo1sn+tNn+YUQhSPQOLgGYZhyMXHjz
-YANtnXANdWweO69rgvCGcOKSGJkTZXrekU2qIJjEOCDa/NccdvMMikbfJ1DvjG5wyM588D8dviM1NDBI
-unKRy/fBhitgSp9Vsdiawp0mMw4CJbPSN9MMWfHZaaZvOUk/UR7J/SRbjQf36To1xyhMzoIuVgB4CBcm
-tjMljxqcwDt3QsWvXaqVQe884rYXjLppRLW/5r3h5jHQNvTZSts2Bvq+1FsCEqYF0uXCeK9hoC8vJnte
-OlAQP4NX5IcGAB/8fVvGiWEKpFxFZFdPEZspkgNWb7DnQkVzzcsf+BFvXOFybTquN4mq9AVzHOcIgdw0
-PCwo0kN943TjWMwopaVZQxkRyghqZp0dWmSJ7QUABjeDBwMkJgCAZuDzws4bBF7Y8DJOe8WHC90o6nKU
-hk0rZd6rQ3hRjbB9BSK3IQPABEOp/6mTNszdWY7zxEkfKBEDejxYEtHDB6qjgfOaRvKKbkAouNKVk8yK
-f9V5k/bq6CSvaV20k6GHCeTpIihmulMGtmht30uHe8WgWvebyJ0V3xg0X1rFfE+i6PfOY8bEszxWsViM
-kjSNPwSLq1PpV668Lj1/FZCbTZ7d0PoiL5yyKro8X5eO7qtADhhgXdRf+5jU3Y6d94YwvHMi8hEnoMIC
-qGtOxLfZFMyTKhQGAOWClrnRfrJzzUYLVjIfkdvwCiz/birIMoSKpHOtNO9c8Z4yGdXuyRHXCl1xOAbE
-U5xaNY7AgXRLQbkZ2AsMyFNXO8Lz1qw4T9b2K1UoxF5tCWYY/O7fCPyehv7PGgl/GHsYK3oRcvQ+HwND
-/N9WZ6ryHI5UV5EMrd7jCn1ThttffdWP+J8Mt7/+oi5l2rm9qr65c13o5uM7UO39429Pnj99wVvycTE/
-zKluh5nZf5XTHKTKfAV+m0MUVGpcrBQM3/FHvbDOP09f7e2/ebW38+bR/v7L3R9e7e+Yb1TDgdv21aYM
-I4Y3M6gXNl5ryOf2eJovXqSr42Gka87a9L7h3Wn9u0nDqwaQkwaQZg2nzW+fL9JxvmoCYYTNH9NZPm1p
-s4d+D7UtGgZw2ADZkO4kNwSLXICuf9uavprnq7K+GRREyJY784bZpiZP89YmRodfruobFYeHRtFveN82
-9+wl/hjk/daBceO2VoXZhk3vmZc9wlD3l2CIqG+8bHjVsODLhiGXC7DuPkXLQUOrVbF4XEyLBgSgSStx
-G8ZTvG0gW3r/JC2P0Ubd1vCnfJ6N4SBubrYhWj/nkyY2Ayrgo/n4uGkSVu3b5l22LFG2qG2QZyc/FA3L
-+X674d3dhnf1r04bQJ42gIzO6Q32rzI8/pFIxJCGLMLSO/AnBGccdyIssgO/tnjXdqrssQM/tg7xVyfk
-jPSyNH93allbp/KsE+VtHe93p8LYOvT3lhF9OxWWJi9nuf+SmZm8LuFnp4EPdSJPOzFW1NE/Ow1sphN5
-2omxhY7+2amwhA78vTWGH50IM6DX/jJWNnqHnmxN5FEnutGl2ZQedKLbXBrFuuRNLk1O4GenusE78GMr
-xV+dYF92+K/OjdpikDEJx2Y81mLV8NtvUV7evpaXP4G8nEH69J13poMX0/URJIuqEYmxDRQogDItQSCB
-/0485wmqWdb0CHwoYt+ot0H64MV6RbhVvP4jDeTbvdO5GfoqHyNw/zP/nRXej7LVIwxvi+Y3Dt/a4IFy
-32wGRCL6XfW9fPk2O30eBEngI3lvtjpYDXb+vTZU4DXTbyz6K45j2z9doEXDX4mB91rq9UETaQ+nT4nT
-KCfR4jg1JP8yO8rRSGdm1zuokuRgfXBg1OwhDaX7WzHfYwBgnDjric3I8KLVehlp+JheSHtsfsaKhNhK
-UNv5VaxXehQwph+m62W/7uVjMk0/zebr2jY/FuN1Wfv2P7LTJ8XJvPb908Jsj/YWrxa17y3lPpaCx0ny
-2rNUpQFRkoNJ5c3uE+/dNC29bePezARnFVHCzPNv2QovaKmEo80Jkhq2l/97DbeteCjOV6m9/VOXNKV0
-5zhpJh5+5D5oc0odAGudl5iW3nQ5XhYl3JdBKpNiKVmnqbYJAFqdFIlhVXOzZyGvh/Rjy1cjh4PPxUWM
-cqjaL2hAZehiac4aW78Ovr5RDXP2r4wME7Bz2oUvXI7vjsUKRZcOMF/E6K9/tTcNFW41MDvMEUC6SA/y
-aQ76PQEPnLNl25UkG0GTgd+rUFkGcpf/foddv87QDErxBoD3ST6fFCcDPTIdfl0qEoo07XoXgs6FAkWD
-ZwaBoaIK97TvtXvOmmnYkp5L20PYqCFI+9BrVQWoHkdnYWLeYwBsGZuEJcYyP0yqraR2HjSomQsKumY6
-GxKsgfewaxklcCtpAn8rriGPD+C0R3doy1Om2eEqfP2TeaZGqm5gXxRTtYXAOA0+lyfmQESXTfAw4IRx
-4XaRyH9KD4nni+cx/L1/sAZ7B/Y7+g0oQaOrQFlnrh30y7C5TlFqQoHOlvqjmvx8W7wu3W37ZJkeYRak
-9AbH78gwu5TGC83ZJY8AEofA+xEdhDbNag/i2LFg5Zd9BkHgDs35y9maCsj5arYy4WKWyixoavBZl0BP
-mLy7g0nKkPKM4Cjx3ifsjXGC+ZMmebkAnAbMRRxvtkHfAfsnTl73Gm6CQkmlG/IRL9+05ECHsUkeUpk0
-cCg18Mlb1xEFeUwS09dHjMcYPLxc9nb/WPrwIbmphZmu97pf6cSOJDzdwob3XbqjMpQEfSpVvljCPK1U
-xPu8H5vq3Sfy2NsOifPH9DsewBWX6Z3Pic79aJt0CXkWHvpdMbxQTB6kY8OO1pDMe/+keAHC2hMmJ3OA
-+JCDapz+y5BDUGox1AE4mwKmihiJ1Cb7BbfgvFjOuOobjYxeom8Gn+voRMn0b8/gvfUCNDe3M9B7YQiv
-tnhrdYExjaoC9KhHrWTb0a8xaf47RnGCGAWtzE3yw0PwzUT/HN77UtkCKqYh1JQkCtaZDgsppASe6YzW
-j7z/w77M5smmkxJLMGRmc0LyQsAXB2u/kwJ96QL4gScs2TaUepBYGkBw2dgr6pmkY3fkOlR/B5WzmIPb
-grxKCkWfgiXlGk5GvtYwCnK/gMCEXSCdKjhEtyDvTVGm85L+k9KL4kgLOgjGCLLm/xvZcuQ/D5GJHEfJ
-M39xcT7kMzmmPjtDDVs2Dwuydksw/cpXQIO/qWn/+/pggCWLeTFKdFumTDf6sfK6iIj+FXUARxh/GuU0
-PVp8w0FOcogI6WroXvjv/pIyBWe8q1Aoxa0L7B2Pp4HV0yCBZ1RDGnITzgxc2ZNdH+demLTEfz0INxBe
-6sMtXEd7oVcOvwDM/bqWqAuFs6hbx7UjeXtm/zow7OXt/cbpAQ3UzU5cWatH0n/fjBYj45b2CcoQeHKj
-3xxuAXL51pKRSEWc+EeEIM4anLCkNINUQOPSZrOjnaR5ejOZWFXYTYbWNMkX7BzzqpT3YXvHrxbxbj3v
-bVUXukUW1bP8+HgJWZdB0NvdodmeF3MwSc8nqWGcMu2e6JS7tMtdibqhiTawVjmWWeKM0mYbmmXsqToy
-cBYcFu+NvpHBq6Sks9KBJ9B9zO+OAQSuY3BXXZcOFp0o6Bb8Njs1muFqeTpATu2+sbFH2NY0m8DkYeIF
-DFJy0Mw7qN/OQQzUtwFgP+EDkA9RXC9DT8fFFH2/qY0HrU/TYDjxKjcHsYVETBgdGmHGKXk1S94ZoWHm
-uJkiA9NKMxWxpae1kSa0zalJuEqdRTw83K05PLCnDrfv3OvHTKbmxbf9GpPocPtLdGoJ7J7D7W/QL6Zi
-2Rze3cZOIqbL4d0vsHQgGSrNL/SA8eyRw7v37qHV/u611f5TWO2phmTKtfvNQN9vUnib5x1uA4XjLwUI
-iF9Gj4DqJT9nRqubQ8XXDAwME5CfMS64dEHNODsAbPcJhSApBThFiTcvczbUybY2rSAlPmfpKE+NzDhD
-2x3AAVGS0hBTSjkSyNcrtgpg6FQKcjkYLyx6f3sF/QObOCywuBElzZdM9ifF8i1lyCtnWPspKccp5hZn
-AftvPz3/4dFPb54+f/Vs/82L57vmf58++k+zOZ+mq+PBojjp3u0nX34h5vbYxLMVnW1C3qv6lAsIfpzl
-0y7+RbNsGn1Wg5HKsRHhI1WkiJXgjvziOpvX77VNUc38mJdrCPXVKp9GL9fc28tdydkj5PE0XxwURvxp
-ul/zG13sas77AlWRps9cg8q35uxuRdhrU4GAgmbT565B5dsnRgZv+tS+r3y5X6zHx02fugaVb1/tNn3I
-bytf/XycZdOmD10DfXlK1HmcLh/Dvg4vT7239rNNU6+13ZVulJrtKm5ID0Dbu/j9KCqLqAs13o5CM3s3
-Sq2dCEnZmXKoNHdhPB7T962IYLtmTJSadgl8NJR2rFzrFtwWp5dCCj7fAJvFaTMa68tcqT+Gr9uRWK8a
-cZgUawP38SXJ5omG0oqTat2Mm+F9l0EKP2/HxjRrRQMd1i6FCULYCBnTcgN8jEZ+aYwQxoY4mbbtWL3P
-L0PRTyyIzXAyTVtR+ilL32WXxIlhbIQUtm3F6vm7S68egdgIJ2jaihI7Vl4KJ4axEVLYtgUruN2+BELw
-+Qa4FItGNNASfgk8yEzejgi2a8QE7fSXwGSXvm/FBNs1YvKWjWIXx0XMau3YcMs2fF4YjfIyy/QfFsQm
-GGHTNpReXYZ8yaS4ETKvmgl4WqSXObx+ws9b8YBmjWhgldxL4LFD37cigu0aMbl9O3lWQMAjllqnQlgY
-h+nuKKE0HShtwDrl4s8VMaB6duywUcxJvYMT8jb/wFNAKg5A+o0RvsbHSvHGm0t7K3GJuXF3LO3zY9s2
-zhFi9bS41Nn51MHYDCto247V80sxwacWxGY4PW9hhZZMLo3TZge6bduO1aX4j9yebYhRCw9apOXqMqT0
-gr5vxQXbNWKC+YwvgclL+r4VE2zXiEk5XhYQ+Hhxp2oG0IoLNWxGZn0wu5TwvscA2pHBho3IrMBy9Rjy
-wV1mevY1lFa0VOt23C6nDu5bEJth1aYQIkqX5NX7DsZmSLXyasTqslrFvgKyGV7tesUJWCkvgdPP9H0r
-OtiuFhPrxi/mRXIP2i/EV8+L8BZ/koT/KV/EA450ANcIMsBUmqBVUNpoi5pu48dEYEswc0WgmefSBM7R
-WJP1ilt4liHVQtmdpCWaa6qwQDlVTXDfRJqY514rtG1EWpnnuh2aGyLtzHPVjC0AlWb4XLWjM7zaDp6r
-ZkzQlWbWVx/bgfoamw5y8DZNWCKuNEFJm9uw9llpcyjhLaYN64WVNqhvchurrwVt3rpAGGrFSlSlFT53
-zV4tIh2izsRtSO2otgFthpsoqddrMtPBN9KOeFu1HTzX7Z6vKwQhUqfX7F2FvqwkqNvJOMN2dpws2VTG
-iRITt2GZo9IGZRluI8JA2IakDGnEh3SlET7nRt65qRqpU1m3lA0ZtnQbUp0t1WZq/jWzr7TTe4M5cGUY
-yNmRsdrktuhdDVeyjVy2J0y2oc2vAOr1QMefQc0KfGqTmVXuc8/jjbsHV8YpJXKcZIfperoK3JD7SfZ+
-nC0gRSQsQ+m592HBPXAdIGgUWZWX5OrGPn4SymAzZ05yzNw+oSxy7EzByURjBSPFuZauASlFnwQrUBCA
-aiweA2fJowVURMExSFJITtpT4/o7KWa7T9AXxPzHVgEsvGTN9Cl52GL+T1kw5VSBk9NnzR7SjSJgL4gH
-5+wfOFsPK5fmgwB0HcD75yz76KWwtNm3NC43a7JsQQssTY/rOiL/7JQ94yTfMLp3QlDeGC0Q4FcjK67K
-I+ZHc8wyH1t7Z+2YpfO1LVGhP88GEKMu3gDkwmJIKxuYfgGZJ0TD3V4fqDpdQBqsZS55KsNMleRB7K3G
-Q/bTdL6/5G9a6fa+9zrs/r6W/25cu7//N3d/l409CQXpNv6uEHl931LkzUnkqIB/QdSUuIf7zjpUFoeD
-ehpd8uOepSSbNTZBSamxBQmKjU1IxGhswsIDt0HbJ8TC0WBL/Rip43i1Wgxv3z45ORmcfDEolke391/e
-Pl7Npl/ezsH/bAB//y+iqq079vNw6iqBWedyFrcCqUYP3Kh/LN67GCbQ2SCAuWQmCtKD9XYz7+DkKfgE
-XWYzI7F4w0Wf+jnUMTO81AdVDhJUOYAfHxcnGZiTD4rVsTkW8jmFODgvcAQGLuT25SB5Ps+SE0xMnGG9
-kGQ/PeBjV5yqu+DbiDVutbs4EW/oYOMHVgKDveMHVlSI2hE2IvgZcGQUPJbF+uiYdnjj/J/be7qJAjxH
-rHNRgg7H2CiepQkN5052vtAFVINDUjz0SBF1daZDKGqDXpWoMtCr0qdDFa1BFQGEDhWc0qcJRQIDQzqr
-giq73P2ohLBx1IY2GDQ2rMSVNDRD5aO92fM2VutUv/Zmm5K0cw48Fy2huaS1BShnGzRatY3I2kpaW5Gp
-pLVZ+yQ6Q0lLs2LDibaulOeaZ60NtzdsnW+nC7c380bfPDbn63muwbHRYKMu2Cv0XPBJUd8IvPMdPWc0
-2OK0eSoft21qssBshKTvuBxF9Ox8emAlD3PYvdX8KqaFYfJqTuqexJph0cdk9BejoHRiiWWs1uV6DbuT
-xMnWydXzh6U21dB4X0Q+h7xOGFGXO4FveXMcOyLnZ9bIZFHqirVXpvAcSYZ9DTAIuFJu9ObFN42RWHVe
-8MPtb+71467f5lVToFbMy928+6Zf78I+3P72Tr/GRd282+7XOJKbd3f7MV9x8+KLfo0vuHl3rx/z9h7e
-3a7JlOzHkbXlTb53HVH2CUJV4nQbBJVhnGk+3oKQzNpYlnMmYeOVoyzoh2apEtdzraY5Fiy30kVe3rbh
-XD72uxYkh2jJ2yfpKg3NiEGAljC/jvcRJngizvm9PR/I4OK1S9yJw/mTvNeOMdJNocxBYOQJbBz0nzVd
-ZCaYX8boitKISEmMPxGbK7d7irk1E/4PZcs6PJXSiHzUULx+SxqgOsOT+cgoA9l8Uia/hawkSA9UQ3bd
-4MgJkO9XjUJBehfH9MPTqxEUWvoDUOn6CCz0mFW+W4Nvv47wetGDqk7g4MMochAgI/zymhF+SkbIhb4g
-i9ofmRU+ef50CwWxrS+2yFh3W+xuYzeEjM14wieDwYWccoIMEswD1/wpSg1wPFTl4oC33IhYrz8Ro9I9
-hbgDXrUEcKOFZQXftTKtr66Z1idkWlaruBS3OmfIa4Rv+Xich3dZFmVBxHgTJScHJ41rHlVd/EuIT25l
-r4Y1KXhxQcoi3Y+seDMvUia/kAkFCi9yoq+vOdEn5EQX5kKqiJPPfXR1JwnupgJQfju/NhSHimezxer0
-R9kyXnvvlbQXa8a+5GaMxLXTuyuV3Cz3i3I+mMmhrXpKDGYYoMohMJwak9EH2sjYi0j2ruFW97GIZolL
-DH6fwMAgNVWGyZ88EEN/BgeQSo68VcpnjA+yLjTkORzJv7Z0D8i1CW4/1UDyWba3SmeLFpWceKNtDXlE
-n4C/ybw4ITcQCiwkx5AX5CYCPrvST17uL9elfaRPjb3AEQJTUDnPJ6yxi+yaEmQalmjWApJMT7Hsm9n8
-i2KO5mcqabyy5XHps0k2zcidRXx4VLbsUnLh2DK1lN4GgMPqLAzhc9jSMpusuQbyIVAkVpUzW/ooXR6k
-R8gvIPUO55ikhF+UZochU/KuUV6+gJormIh7hCdgZkhtls9dTmBmP4S/K5/LHkTAKthhC9DjJGJUgVR5
-jCWvIKM3Jh7E5DyUxGfBfU986Pn8XfE2S0b8emSThoaL08UKvesDrLoJ5W2tHx0iBH5luK+SL9h9IXn0
-YtesIeUHosylWCqVD13Y2XD1umdBSuSY1OzNp6ecl7hQneFSQ2922983u6ZcgcuWcomDSiL5oUMfOG46
-LQs7BOTedXmX/6wCTExCuaB0gtNTcfjxH9wP2/HYHgbgbTs9hoe6T/RXAx6s2S9+Mla3JPYlALQOqeAE
-ByEGwBScFKMq7N+0TyEL/POTuZQ96cqnPeU0VJhlmUsGReeAZLPvGsQsvF8FgPJusg0dTBiIawojlzax
-5HWUK73+W/dF2D2FQfBMhjzZ/3RQeX2T812TjbWx6bDSJOpkCEhhyvfge5kYXN+8fFJFtPbc2+fclt4k
-XQDOj4Lf2Q37vfJ/3DNnyWIjCGa6SejpBpYBszSrgq4P0V3Zd6GMJCmjjVQdg0vnqW8Qw+3k6C/j7nV3
-obtnjT+nT3lZdWV1ks+zS68huo4GnqeRiTnPsANoG7q5RgdOUtMPKEx5y+AN/HxE4w/des3+bI94CJvW
-MtDIp6pRqbOM0qRMi2LRR7VjfkTgzAezhAuZK3mB3ZwwMLt0af0gZWhSQMPUFeJl6QGgUfp88I6bTEAq
-q8LFduCsxEJEHXFrAegCs/SY9Jn8UB24MSGpDkOCYr1z2RH8LIHuLFSGx5VTBGafCD8pQEqDQqZuzHpQ
-DQI7sgt/PCOlXI1gGd9SqseR0ez4xBuBwxqudY7X5GZh8pVgVTok3Bc1+2fzY3XTgzV2MgV+u3ERwrWK
-iw7B+0BkcAUVzkAHDJiuHqavzTm14+/ZdAGithXsDwooPLwArwjS1tB1ECumW9k0FBRdHAYun371vQhs
-zrwLi9RgOAa+KouGD/rhdKOlcL3A+YFZseKSPWXMC9bByfGxi83dKYScjk8qdTRhd0Gr4Fny0PUSea0p
-yTTFt4getdMrwt3/dtanwbiXesCuj2CO6Bv9EPtRu2hgWNQLUtz2C5lK/X51vMyyR8sjrC2DL5ZgOzNw
-aqH467YJuAbrmW8527ygtm8uGd6983W/ahMZ3t3+Bo1u31wb3T6h0c25CF3K/r9xAsmI3StA4ULGLwcj
-tICZ4wZCnsQmdW38jyz9Jaz/3MfVmP4FWNzu7/Dtx9a7mXdpD/3Q9K991pAFfXvNgj4hC6LE8X9khwlY
-2ds/P9mqciBY7y+2t+98CQDwn3WlwGj5zHeicEO9dp9o4U5uqv5MjhMOa8AostwtzhLuizY3iW/vXDOp
-T8ikPHfpTyQqXSxftv7KYB3/wLwI25oR54c5VlRf1XTjNalhp9WJupBA54EJmeXb7HSox0hZDcdsgrMX
-h6vl9D+gpTwoj/PDlfcknfq/Z9kq9R4ss4XRR91v6EVfRBokvEkZxqeTr1h/yo7S8alWraGkDq5c3UWm
-+WokbUaw6XC/ZCXkgjC0nyYS0cqMGu65bPVdak2JH4pDgQfPqFqjijqFPsxDuGWUdhaylzuAivrkyCIW
-UzMIvNfiupqnGZSZgg0KeMyLlcCyN2CcXWB+mszSf7kSvgMqLmm7hJJjMuxBYCUltR6yIUhsbacShF2J
-c830HYW+Ir5jr4ENtLaV4CZ1CwHRh7dfLTZfisXxaYmFh98yucMfbgX23UrNshSzBXANFgJEuUdsdWOo
-41V2HKxpelqs7RJQlWbDHtk6CPe3ZsEnhrWW5XpG533KkcNmPK/26iAJj4QaKeuloaHSfGxWD+JzsMTK
-LF0sAB7Y4gwYmIidNdxapXNCciCgnqwzyuIBpzL1DFVqJbMHVibzL1BXdDbAnX4jYUB1qA5c+EferRdV
-iqFmvMCNdIIT2UQl2ABpBK9t85Qso1mOt+SOiMBa6vY2LSau8dxG8cJ2Bcx52UWg+hj74ZPO47WQW5Uq
-/hxauIdyv+acbhZyg6D9JnW8MUxMCzPm2Vf9WlHFvCWheftaaP6EQrPzqfw0EjN89Y88O4GFfJoBsMAh
-MHhZkbKvSAYOxn0hAdjBCKXfcmzWdv6fSq7FB78oyXeam+/+M3zwy9XKxueQfSmtRMOZ+XN28DYHux7l
-vzC7a+fbzz3JZZgk28nd5At5KCDv4OOuVNns2UtDSWXBDJp+uhv3DkJ10XmVg0x/cCa97u48+DZECzay
-EXqzSRU18//Ch9vJPcC3mGMOj/Wid6PSJ6XfSL43/zfUj+6ZR9vmkRNF6GWp9RXP2r2Bc6T3BZzzEsbY
-ZWkAEl65qvIyneVyLA+/F9GgkCec0k5/2qNxOqdLMyW3XmACMENXy9NbTisC+WaRHmX/2YJ/BxtF4iup
-c3xroyrZK4I2RvJ5yCYGUhgeEyD8ZERQiyjA+WUTVH5pROWXKCq/tKGyXyyuBabYifLnkJYcvv0YR2+W
-k3QmmDYhKTzZzPNN5KHr2qufUh5yiRN+BwviFck2wRhqZRtM0rqVBVKN+7oSqQBvtN8/MZf98DFXy648
-bxVXNhB5NpRornlxQM1/Dl7s8O3H6LCZF+uESpsqrDU8d6Pqutc894p4Li/OJ7havpIgMI3uhfRGBhCy
-13dGPqgTYp3lD1rVWPTglShDVsdayTD9sXnWRYDO7cRJ/69/FXZFqU5Qr6AnrncwfdpYtJSToiTE03wE
-qVkFuUkxTgTsoDiZZ8snxRid3e5bA/vzJ8+HRuk8gkzHxRri3U6TkdcW7bhlYY6TfJaVTtlDt9Kdb5wx
-1nRXmTrzTJziQUAD9QoeGf5sIP+MI4r6cPPnJ7rFDRuttkrz6Ua6HTWFXv9nWV0bjy3eIH/8hDGMaL+y
-pZtPKpuXr95/oMH98tvr3Fmf8nRyWdEudUBdPv1CgMiFjh4Ho+LdlE1XaZs9x+bPotbammL5GbywhhTI
-l8up/4E5jbDcwxNsM8IbN7Iq8l20BMB1iWINIVBakneZzZHcURC87kmPGajXYIYLLFqIXZulyB/kL3WD
-/GWDQf7SNEi4tmsb4y/NY9wEB0IBrKIXQaC5/7op/t+isJEV8Qc6EUpzWq6O4Qy/ha2ewk1RgomRgWth
-oPo8WaYnVO+JQMHfcPLPuXbjvIDDja/TqVIKSh/Tk/S0TD6/vZVs373TTzDD8fQ0gY32Lp1mVPlikb83
-h/iAID1KjopiQkUO3uczmz/f7J83T3Z+2n/05qfdZztJd7sHHXz5F3j3jm04SQkzWCwJ0v9/7w6D7uNk
-Owh7j1/u7DxLund7fHX+zdeDCKSBTNxT9G74n+Rj6NhenTTgeNafKQWKw7of47rNAoLOrLtBFpRvN0oi
-17VT2+V0nNdiwqXFBMxZlOK01soANleqf/K7FKrqvB8pgCOVY/5gCpz9oHjPHkDmGfiEmX1+skwX5K2V
-GeYKKRRwz1NuhnG2NPrF3KFASTBmKTiVoY50kBmOhSUNOPITxs6gTC+UvmECsDDxMOhRULkGaxoBLeWU
-T96cJCfHNHvmA+EagBtErBK83sDshMJwgyWAo4DDVc4DXLlx4xLxPCfZ3OA3hsODHWz0UGiMGE0HJwI4
-HKFHVjCLGN+Vr8psekg1dcr1YmEOB3OoKJoBWOl6VcBJMFaLBhz9tFgnW373tBAw8Rp1CaMEYEGo59pO
-Ea3f1OA9OeX0GubtIPnF9EKVI1wiEiIBykqBTlyZC9VEpzZ/rIDsMgManBmNNl8AjYBW3Cd0FwVshRwg
-4xqU9rhAMiphcVIIED7EON0Vr1xpVg6Xckn0A/Vhsllm0CvR7yvZukFhwTCn5ilTuXMKQ4I4KSw43k0P
-FsvsO+uXX/l3In128/m/0BMOnMu89e7Vfx38+9z+sfEnH+wfNZ98vhX++1D5g/7Vdfqh8uRdzas6HD7E
-Ufn8fBDM59iap3wbHssoPt8Iwoc4Du/8obRAkH+fh3DOAwE//uAGc5fG4qalFcKHBhw+bA4h+r4dAlHA
-uygE9+6DDK8W0ueI9uc86s+9ZdHvPiieCsAMTMNGZl3D254S4zZNaGgfknCp3BP5X3e4ALDqFvnuwxaR
-FhPYdx9sR0JyugV9UzvIjfH6Q0PYeKnqySaf58DYQS+J/BtPixJfbM7K6v4hK3twm1k3/HgFlW4gYcAQ
-fm0lL0g4FCkAw6zM2cIZrIyGNz/KSpY8bpPUYcSsYj7Opzmy9gEh+TIrjeyFgfL24wyFkLlSSgqUSNZz
-qrYE+aegdtaAUHmSwcn4jsLt6aqTT2FziiwBE8HSKG19SaKCeaSm62V5G4uuQtVHRIi+PFqnIF1mmXXI
-xjGcpMsJnrOCGCfpQgWXsSB52eD143RdQrY2c4D/e52hx7kk+TIjgPRTszUVkLQ1FGFfJa92QdwyggNL
-aykh5k+f0dPemglG33+qFHhSoFZHnzIKuyKpgQzj+h7ZandP8smrxcQgPbL1GyU9yBIKauLKzLMTQgGr
-EbOet5V0f6TahGsmjd6QJAj4xGiORsJaT41ofAjTkFnZbvQSJupnRHZEE0PAQXdnjYMaluJ6M4LEYUpu
-Y5NAegJyipnHXj1CP6HLPIxtzOXzyqo4DEuxxlmwUosSuCBAg4qGPnqxy9T/KCHVi0gDwiQMgiO3PUci
-XuN7MjqVuAgQwGEmnxeeEUdUzKrABvY/TccghFPYQvCpbe+L8Anln9CiOauC0BAATDMMIDFSJCB+mBp6
-n4S5KR6ZfXP6wJsFlg6/O9PCMD8U0ZGnpSRRMVWFUv3ZgQEz+mQ0kIQqqsezZI9kYS17O9kX+YOQOsIg
-HSDU1tCLM3/PNV91XdfMzOt6QWqmlbfLQsdfmCmHW6IJ9isJ+gx5z9hJLHlSUNG5zxJMC8dqGauuZkwL
-Wc1ZCmoAieIFhN64Lq22R9oCgXOzZZaK9jek2oGmE9MnahyQNWM90/qN6W0G2vN6ATwY5okrQ+7Dh6wW
-m6eH6yloeaDyTLIx6GBm980MR4FHS5ffTrTwGU5gSrBu6ewSLn/MMnM4q0WoS+RTJaJS8sqAw0n1bVfd
-ZN7Ediwc7ZpuoTiDn1UmfAtVgV/XZkcLWw+m2fxodWw+ulPJRvMmL3fnCkGd0orSDYUthtQgKHOaHpRY
-gpN/yhaobD238fAVLorqXTQotxLx+VP22ip64RIxMjdvRkccSay0QwV5iVNZKxQYg5F4y/QwW51KTQ2U
-JpCwYF/BF6tikWDeTYLGOqSwTwzvMnCmYJtM0lmxhjMej1PLD2+rwxS+QiWboGH2SqrOyHjg7Y4URub5
-r2Tl4Z37NJsdGM4GVVfHxQJ3ChyVQX3X52wjpTZ70pJTY4ILbvSD7x+eGW37qBwMBokkgSnDysqMSLdY
-0C7vMaj6f5CfqBRJx40/J3PYDKUBl/3J0h7lmeKwNiqxS32PvPxcoDkomqEmfRp6P0n7yUE/GfcT88j8
-PBSSunjxrZuc+cujwW7P1uBSTwei2JgJNcf/Y4qzU3Kzb4WSo3LpF2B2lhvDMY38gG7tKAmqnly1ZIdw
-DaZe+S6QXPfRnOYeLsVxYmWYt8eXIszGZZHDm4nHnBSYIJfIxiFU9GB9hILvrMCUuIeHII+t+nBQnYD5
-0khouD8dJIUaph0G8oO8ZiwwmUcrzjRs3lAyMxKgYD9w5jiCRKrI1hYw63zVgXtUtMkpoCzfY5zq1JyN
-02L8FoMPIcaydKDAgEeiY0k1xtEYmZ3IRZZG2p8bWgu79I+m0+4dW5N6iQ4zRLp0XVBHvvejHekkhnYQ
-duXUKrJjj/vYL+gJ0QyHdpuRnbVEJeWQgi7L4+KEOJpZMrj5W4LEX0DJWjRMIkwf4MGpM9GSlGXGPhqo
-Rj6CdrKkrZonGuAYb5lgFD72rvJocKYyJkaCM8ja0U3yCeQBRJz7QIUTzAt4ktLVXWkk0TlnSbbWZx8g
-bFVvILVoC2rV1dngGHcAvKhJ3qZ09HmUpdgh7qxdqKWsk+c1iDuRV0ESvdw0dWDvm98PYgBZaDHvP//c
-rRMAYBkBN0jls1/z1/ejhHsFzMWG0DK85z/s7bz8x86TNzsvXz5/ie4Vlr8ATZ8sc+Ex8jlo1lNZATya
-NEDDGhR3r+M4Qbc+79HgAjYkE6fYiM994tKjmVEz1fpY8hG4v8HXkZ6/V4Rffe0uPXuqilni1QqObQb0
-DKxD42HTMCqcbB8tQYLQEglYSC+nicObJaCl+4k3p5kPaZmBKQQowV4QQMID4RDA+SyHKElUWUHGUrPW
-mVGKlgE4VspgyZflihCQ7AFwwIBwREla14sN+KR/qOTJ58n2+Rlmhc0EEjXabsxmw9SdhsxHdcyC+J9Z
-rpFlQnCCQEpAdLdYFQSQJojyJYAZDe7v9bYqm3uBQUMnBKzL6jsniTeS+JKS6U9I7LVEwO/JzEBXc3Rb
-JnouWi56TsAUbt7IUi8uTJ5DlrTnipMkSbCpiJBzvGYEHrbI4pJhu2D45zgh6LLbqtQ1jEO3rgi8H+GY
-0bCuSIq9UjmWIlWZZTMN+cdIvRhLHNrO+c1GlgxO3V4/PvvxXmkvGQEfEaAaJN/6A6VJ3oVTAhFoOyBk
-ojJ3HPiQLng22OPAh3Y1Z4NlGk3HwuaHwkbmKXIpx8guT57FpPFo/BzSf/wTZt9w3jmMD/JGkylGFxRh
-NxCcjwKSyojBBLi0T3bD5Le66s6e2Wjjus5h7eFKdeFvr+vTfQLXpyCetdb9qZruAUmvEs49TO70Ky/2
-i4U8N1qvmfljeo71AkotBuDjF1zGUPZQaww5HI3el4P399u/NFhVPzyVvOExSg/AkVsf0upGFcw29t27
-d03AGxOwK1a/i3Lw5d33cJ4tVPA5g/pPeKLM1ub8YFcwjLAyjFNHKrE5l84Zc7RQMYXASQsudfAOlK9y
-Dk6T9F2Ro/kxRfs/FF+gLHvm4IabtNXx2kx2Ol5CsSK6fIO6SWMoTTAHmVjsMEzgI5lkRJLlIvJRA61B
-ksNx6rjPqJl4DoLRngm1T1dGdKQWUtPNiD7WRV06HPLEjUBm9NekCwa33v2RplPA3hVlKBfpklKbwRyZ
-STOzMnJAyCEOY8NQhMRhuzpd9l7lsw84fQ8+g4uUucOCXcJxHan+kXNQDlDl4fSTefaevY8vqogABInE
-I+WjE3RGFmxHahMmtUYqQ93D1zx0T6RvgIDGQzEaPr3w733gG2Z15N1fGGED9BvoFxPJD5Kfqfz/MHkG
-HoUoPSfvIZO/oc3uaY8UI7hh5O8Iknir4oZ5z9nZ6F6r+94QP695mpDvOlmBCKh1Mb1BigUPYbekzx8S
-GKNk4X9lhDhiLL5kxlTXFtcTKwGouZHGRqRW38pMcaPBYl0eD6Bq2GlAHvf1hPIrO6fVbmJwu5sBimCH
-sQ8HqOMYkQ6ccZbFGi+U8JrM8AL4iG3CDPpXhv1aFtH2fkZns9eKRvkaXeYrR6FPylcq910XJvgUxyZU
-OvziLnHD258l/yqNZrwCgoLCNUNUfGuO0qfPnxgC+OrLL+9uw5l5m6YQg4eRM2+ZUc+cX4jlv9wllKEx
-pwi7XFB2TND7IHvpoTlvlm/XC8lzeoL3vHBXAOYs1ugPzKTtKicJd/kLMMbL08WqMNO4OGbna4N9MT/q
-i1NzWpqJPjB/UuDQyqHg+gdAVOlxRd4cOLFAN6g4Os9qw+SY03EjSjc0UAcMjbo7sf4LWB3VzOC28K0D
-0fI8Y88dsvFMnDLoGXUAQjc1Gig2kPSWj1bdvNdL/gKLRFwFgHcPTLtUPT5zuz1NPsD7Bw+S7a96NXud
-hqDE3evE/Z9gi45NKzCwBhGrtGWBSt4cny7MEfwiXRnBDawBt7e6g97tIxeI8hhAoB+AWWdqjRRNRy/F
-uWXvU9iqIsAlyXe2524H5LyjJYxna1xMi2WHXfUfJLfcq8fw5lbgXyaRafRfT07jV36kmO2T3va4qAh+
-QY8GnAi564+779THN32XXzmQd1ze5VXxCgwtj82scG3aGrIXjCzd371z4Vzw1xrd+el+D8YEVcOiG+CW
-O5hu8cEkH/oqnjy1uUNmpdox/2drVm7dbt8xj/f2sNIZFARN5qZR6+ax6J93F9VB2ZoV/7WFtntcEQXk
-afFf+/Z5M4gyDmFW+gCQMo2aNp/kyd4MJPRyfXSUlRRb0FUh6+A3U0ILI1PObh9MiyMo43H39p27t83O
-MLOc/9dyC5wd8vfZ5Havj360Bo9RQg9ZOTYCqTlcV3SST4uTbAmLkIxMw9Bz9aKcxc1DhMUE7MfyGkss
-/aRjJq/Ta2EWthMb82npb2jOTeQiGyXHvQ70vArWUpvoocp1psU8+9nQMSTLrLeDom+7TdOp+cz/Ze/d
-t9u4kX7R//UULc03IWlT1MVOJpHsZMuK/EXf+LYseTLfVrTEFtmSOibZHDZpWUm013mI84TnSQ7qBhTQ
-aIqSHc8+Z8trzUTsBqpxKRQKhapf6TcCkovPgCCuLtAZJ5FKfgErp95nV6/P/OL4SIhf0vHcL8EPLZHd
-n/Zf/Ph279XJm7ev35iiSKH5e+8iH/SNBksuo9cqdvXAQvDAyV4cdwgNjp3UzfhQ1CJSEYuSKWVqgOfn
-TCITp3wwANNRHxzgQYqi33G4pvXgXTNd+v+Mx9rQbvQNG74n/8wBYglUI9ipdfT/fpUhoIRddej7mLAS
-c5Ujd+Ol9SkSHGaTcwhRlFgE6Ivvwe63NaW2oK8qNhjtGdQAppWPgqzbPq81sV6b6pBQgjP/QmanW9+W
-M39Yx0v8tpF2Z/Z+/L/ZoMOXpWFjW+jcrvuKCWE79hSo3CydWeI066WwmMyU/NIA4pI8M4XYE6GGmROU
-A4GmRXllIQ4DPocuCEY8EMKTuo9HU5e1aJBV6BJbbpg/sto6OEfUNZyANjWmQ7OxzeYxcGfuqhcdWUBd
-ujimUAIUKHiFhi7s8uUwn7i3JlvJV19xHzX9uVVk1u0HpDWwEUUaqQ19eDfLpkGwwsIxGlz0Jhla7U4Q
-lAuXxgkGA32ctnli8hJhu6Zo0QZSUhUmVi+KDrKM0R3e0iZKbXR7rV8WQ6P5Fy2FDmUdld7V7bgeX97C
-AuUJ6q2Nx9+2awTx1sY3COFEUndr8xGi4YuE3dp8HDFfba7fQ71+iTNCgdGp5SvT5drzcV4eGvZ9Vcnm
-5J7zrU+d8UtmyaaUTpPzHEIXIZ5sBHSlHQl5FKQjzP1s34fb3A/mBXz3GnzOswk27fVsGtSIFM9HIy6+
-D38FxWuTVXftZ7phU7uWZDfcndTYNi2BtmuD26OWXTf++CNZDkrYBe+CZ8iHGKq6muCCWFdz6mfyRz8Z
-O3uORmuBD6p67muhleCmrjOkINat61BHqATEqwVUQ+YQG8I3BSYxvBu3YTw3V1FfS74ia1/g1V0Zvbjg
-VYNkTzlqTRlBuYmS8B6A9UtIQtw/8Wro+aQYvoRjdq1EnBZyO6bEIT/UADZvKMYGTCDZbJKXEJM7Naf/
-mPsQad4w2it4/boKCvdKx1pEIJJ29N5obu/NPPxXUV7M2obu/8wgSqmcAkDTyk+o1vWL8+TZrN+/SAGy
-aTbJfmBTBlbCNGh51t9KVl7OOit60q3ksk0rSSsDnBOKuMJAWGofE1/ZoiT5GLiLhxWb4D9z1Ti3FOUG
-w+pkwJACZYP7j9G2KQSkJj8dvnyxy5GkcHzPOucdEtao3cAaQSGMjwAeklSR8tnVYXqO9olWS4Zvf0r6
-sF6ZaHiifcYNODejpOPOc8NGAzNt4f7z4BrKRfcMfw8ww4VM8QrHqmkqedaSplxB4v2LUbnXsGX4dHnZ
-lBal1hTBNhLiDLexncAYvDDto8fWpxk4/yA9Syc5HWqAhc3yAXqQCYuqY5Ks4I1QaLTUh/81w1h3HBCY
-RGgH0W3QfQrC4mHXXCW8dcIYRupMs1FmBPj1IR3EKzhtwHk0gI+HWaQQWCyfxmmyg5I0CA2hYQ+4wKG2
-YaB/N8AboCFWxzzD/HP7e9+GY9OBbx9CvrBlMxSjGQQzuoGQkx5+FAMt3ECYt+axz69rMjdSwr9Hx97/
-8YciKitByjdACc8yN1Zmurgr/IRTlCwh4uC1FTp7vutmZRHi4j29Ip9QdvBFNxZ+ZQ9iZg5xs9i1wFs9
-s7sIPIEjexaAOAklb0eB2OiinJJvCkZV93NnGXCGXzRAR2SxkrWN2PsGbMNMwy4/hH54PcpeT14Wk+wQ
-vF7KJjq/kFLxAIeaHsCOHKErxbeldKfDgBzXunvganRJth70dzaEMELAVG0kqRt4SkdJYAVJafqeTkrZ
-qMwx0sorCjmXK1m26vprwEpM/OFEabdNHlRurT7Ao6VsWhBOr9EA6mUaVg+twLExsnINldmI0AvUrCPz
-7DhQ1arrI9T7zBItQdlo1mpc3EWsPUfxinTBKmCyi5tj6TeofS0EMHxvZf7TVLLnZlt8k55nu4LEEtXK
-AhMzpOrMzmAR9vIJoatgnCj4vZcg/GgmCbMGPRGgLWChtOZmRG2IWJkJzUEbphcyY98a/HCXse4SC0HD
-4fqgx2BgqI89iF5ksxGG/DvWsaPGG/iTi+lw8L35j5EA3+P2+OS06F/BLReYoE4xgqD4AN4akwRthUAl
-S8t8cEXoGr1JUZarAmd6CSLtorhEzzYM/IAIKYFjBP80+hM1Q/CBTPsfTEfNfAL34CA1yhCxB90UDSMT
-Ho9p4jSfDggbb5iOTF20eCUHGNvyK3j59fmMljCyMI2Paa8znUoYf/QOzDQILXrwX8b/8yShwzpAUTzK
-MYACtBOj7gITQ2/QAqu4tCI1K6zcNN9zziyswRjRgCvxaczAxy+x4raYZit0pS7yKtckSFbGFSEFeQv+
-8C/yyXwN0K8DEOBQpoGkKt9oJA+hepsSGNh+/2zY8x1xYQQt41Pi0RLBBpGfjb+WRj7ltEUy53eSZ2wi
-h4sEj1VhuYH5aepb1wHGwLS9LHNmWqaEjrIAt7GKcBuuh6U5ipzzQmrzSmr7BN26akGKuxz9pOBhBoFZ
-4NlkRE1yWBih+DGhuKKL9AM6cxKSj0ct0gYSBSNcddzeknGoGACAmansNNyAUdiNY9qOmmcu5HvE4oBL
-6N01TzV9Jjq5ks3BY2SK6JN7gCThGE5iX65R4a4aW3V8Gd3WaE0ifGtj81G7zo59k3vl5vo9zPC/Vy/A
-8z/qb+RqGNMKrEk6+wApSMA00QbMJvrTmnMIksd0Ym/0IZ8Uo+puHithd/UbjivguxApISoEJPHCHkAM
-aSUDj3sl5W+jQ/w4Gw6vxNAIooIvmFlSgCM6OWqS6Rqz0EAVsieb5Rnrt1loo3dlBqf1H+zGG9w/Nfr5
-h4bcIyp7GDsMYTuMFtY1PYRvgQDqujaM+JH2L/qlfPCk+cvlw5ZyMtr7iLBQFIHYlVpdOaFymCJrZ4Su
-RL7yxZnqd0wfYPfZg0hxpxT8IKVxuMCZSb6MkMqwMenRtaJAdbpJBZwOIJ14ifGPT4XAEH42g3FBaSpx
-CF49cMTXD442js02/wLcgcRf7zpQNJVtgPlFsCBHaGgjlgFvYw4TrXQSFChc/Qy+BC7DUqR7YXa/QXbQ
-m+TjaVfDXqHDgAS3JwWoVegQlvYugNiTEqt8b6dRMOCkPR04K4+K2i+AyOJWIG5lDV432mCvKp8jw5r9
-1nxu2VHsZbTgvI8mIY915ELK6ZO62YxkEAyDG3ppXad6Sn9iliMvvj/Mn3KJwBE8goZmKeGchvdVMbHK
-DNr22umzq+FSKzSAI++g4S0vWwpGk4o2hL6R+CBV/UaAKuXotFw4C68ESvsVrjyrRF+S+LWlzRry5K9d
-fTYyCGpYFwIwQuPFEM45oZKYZWeUZOaOh/Ro83jbpfyCJz8aXryQCusMCEDYqk37fnXVKVk85vhFo8hM
-d8EarhQrbRepNIsa4zl2EFOVQjJqSW9QoYbtO1dij/pPB5vQ7GVdaOIcSeFe7xzEbUVG2PUaw5fwGJkV
-0qhRi7vY6piFuGfWoV/T941BEVljQAyuLLAmz68/hx4v0TEcn4fFgsgD/Pg8fTkYwFtoy1G1Z2tjY71d
-p9Rsba5LTkmluhgden0xrfo+WP0LKNDWKIJetv8gpKjbp/PaPTgQxypfF1UvnOmrfDcCK05ZvsKbFVND
-FeuEr5UdTGzfjLZoo4PpQifplQA+lU/R0IRFKO0FTRhqZV34f4ibVTdLg+Icrh5gDbJ/eglO1abnZgwg
-DSoavsywduc1s9apGr9bcXYn4Fvzka4505v1cZ6Pup3ACE+99KrSI1t3Y338MUADdpooJ7QSj0yqiv6F
-/dws3xLvB1NSiAIFIMIWTXLRRyokntbWzEemfL10mTU+OIthVvbScQaqx/Niwmmumi2GIkToyDJn1Doi
-dHlRDDIXv0qqIFHpO3Bmo+xO8tPZNCP8Zs59wWhNCFmVWRWU700kX8psBDCmcNyYYHwXtQJTc/QQWJiT
-c+L922luThNmocLQk6ceQxEbaoa3CFG7uU+ht+TqZ97gom5tERHw5y/Zob+4TMsxpqHLAWmoM74Yr/3z
-4ODkeT4wyvvJ3ocU5uJk98JI0ZODiyybOhpMAiw5Y4hMn3R6RWf2fm1zff1vaxsba5vfrEFCE9OkbPVj
-Wa6aNbBKnYGbR3FQpFs30JhMX82nExxuuH/iKyCzpmwOFOy2pWEqljM7SzAWcK06gDpws2QkFcj+CRgK
-e7OyxAC9U8APBwxGwxVmlM8LPEDIJE3ATPvu7Qu4BkQwfLOrAkh8JznIsuQvmxsbX3+3viEbal7uQey0
-ERLEwBx8ra6s5fnTpMFX73ihrR43RFdhYsF1Er2/dl98VYzMos6MhDOfNT/TV03ie0dHFfG+te6uckMJ
-EXqgwoKCS+VKwSN4c1xppNEb8TvbGBOeku+IhGy4yObqqFCZhoNWxDcsIc27YTNUJKjIw6Qx/tiI6xMR
-CWEvzrTQ3/ruEe7mC8F53O/mn7abI8rAcx7mJR+WAWAn9vT7ptl0PBeUOkBrUw7ZQ1sKfC8hQdwHuzbI
-Acj4QOktym2wsoPEGAFWKSSuOjsDjxXr3Q7UxoYHjTZY4xFA3jlm55/kfdza7XeNcj82soVyV5CVgBMe
-KvhqoAHV2rzn/NdBMqCUDShIVhGEAT9oKqeG+XpFGmyH3rjC8JiB8J51YA98yykLnlbHeru++HN0QIrU
-YZP7nKrokxqrCSbNeRVfgQCNVWRf//ovwpw99TjFXribV9vAJLWVBatbEyAe1JyWRHGCMn80bfjoQsgG
-96b3P0vWvOac5jXho6oMwFvBzN4yDocqd85MR3/LmopeTQiDKrHw4bZ69LwPxv8SDGTPCLuUIscdFWrP
-mXsHuztv9k5evH7993dvODlJ46vGlvm/dDjexgvOxvf4+3zKP5/gz4H8XMGf/5oV8uCXBj75y8fNv203
-LCggf+nt3n/u/RNuHY6++v7JSuMYoQDcvoBdmDTRJu/tpV5Dj/D9seeZhzXLBKKBOIsP5UZCkxJ6O0wB
-urzipmCOhVgHxo3VNMBoRHLxg+DOyB6kOBI32Nzq56E5tZhN4qaKaig+tgG9eqjaMiZ167P+Y06qbywE
-CnAv1f+ERXlmTqgcInMXOxDdqVMs3GsMe4t4TanXnvPUPif3eRlefoZvvVov0UOjWh6f38rdSs5+1eAm
-CWxaKEaYWVC0wSnlUCNbvb11OaWoP7FTR4N3rVPDH+5G59o5frye8DNv1euih8Jq+G3hMvVJJQXUxDer
-n7hjDC2MV8FsUJn7joLlItpUdDmAV7t7KG5CH++cwPOsvwNmibfZWbk/eovz4PsPgetuD6jC7J5nUx4N
-8H5TgwMGEzjG5NbLptnq+E468liZGNNkPFNu+biKMYKWAqbNgkYAJq7gUSPPHmqZQuzjLD/Igbg20Zg7
-Td2lLGwpObi7RRrXtibEiRmPTvKy+MDJhdAYSo7GLt0drTH0oNe0qhnxrEexcjWiOYD7NgzEQOf1HUe+
-odyNbKjxzXPnAT5fs/UCEeIqzFuH18cY/9fKphNn/kpIW1hCkwmFFVh9FiDqZFaHrybhJkXoxAjIR+96
-01Yl2WFnEGdiIxdBMijVl1/2AkYYmdF5IDbR5vw+uyq3kr+WLRc22aX6NnufMFcDDZNkr2QnVtnFzCrk
-D/DJAMjGRmcpvOxbsLu37CxdG951CpQXY0OYFTCrIRKAjBejLMewJH9DMCODAasokJp2ZO84Mta1L6aw
-KdF3a986vdFvbTxab8f28q2Nr124OG3aWxvfbMTuCO1WvLVJxG4IHN+4h4X5t+mRA3BMGu0yXEKtwjid
-pGZ7Q/D3XYe4oEMpqwVuq4lVs9QJ0V2CY0jkv4K7cjEpZucXUiwd+B47/loUbBV8Kjgr8tKruOzdCLJD
-mfiKmdUKE3JB0DAA2RDoZzSglN4TRwJQQrnhzaBDjLjRTuiKgS/rfpbZ1YEEklFTAGI5ceDTcIzEhca0
-890o/xcaHpepcPReY/su+uJdFT3bKOsvEvAfe4rsjXq4nYAGDmn3LciHzZuE3G3IaS/uRvevZbeTIC3c
-xghOGLKC4ke3+YJSEywvwFssJVIIk6noOY9EwXyh+06Earaa00hcsQMQFhpXNw9ffcVklkM1ByaH7pIE
-xcRGQvHSeE7DVLqGUwZduBq9Ggvap1yD45VzANYCAuYKvwv01IA6yJl81BvM+m4Pp4ahdxztVN4BZllw
-h3gKs76jajpNZyfoVHyB2BmXSu7o4iBdovqgD+0SrAawXSYxaWWptucv0TbT0p6a9KRm3/W7c4u9NyYx
-zQb5t0U2zDsjrdyjMd5ib4Tc6WxhqdrNg01Ln+dHdP4cz6ZrwFJmlaaYjwfoBYtBPoEOY3YH2N/7FvtC
-MVEruxjoBR4bVEFyp4KCPikGFmKK4mSMNpZjZEx7ycZ1Z4D7y4mxEb8M1OfEz7jMEa4o9OArnRW80INb
-t/dGuQTgsyXOXwZAwqUSQUWBOMXmxP2BsMXOJul5TrE5AJ7QWdIpZdA3Dj8hYaGYOkYyx8QjQO1IKfPj
-PcrGF1kF6EqpsPqjSmKwHCgOGbxYVnQyghWbjcAha/HJyULlFxO8H5a4KiiNSZXhvhk38HGaT7K+0wW8
-fAnMOA6ZG33IEJI7mU3zQT7lTOMc/k2riViaHWJSmw1dZU/AKHCghS6l4miPSJ0q0S5052MKYamYXglq
-Qn7/PuUD1ZjddAeOyS4cRkPLBVRUh92/jp0Y3fGU8xK7rdOPyDalrPnC/G29Yl3FIKA7dSn9eqd+5tjJ
-RMKzY9e+1daqZbpQOPb9Mv20ZXqeTXcw7aE2NleDqwjoEiEgSxcJlOqa6G4GbuwlBeAYwb1Kub0w/1vH
-TILZoGizAWrA1Oizx8nD68gSwAwtEgALI4+yHirTdu4gq42uJCo3JQMB/Zxxamz4MMRsihHqKgNMe0oW
-shQG8HjD02wlaw9+cJv2gzXke7dJsepX05c//nBvoAFqG1MZ0EIatmR0hwubqFbQQoGL9yvok1fQHtzt
-7nJCgdveq/H4dyUhQRe9Tc3YjjjcjbGUViwgO47uCipMMAS8hoAq5X00xMj60IHLAmkXHOVKvBfgEyse
-dSOJSsemKvpY04mPP4ponebsnpF7dt5XLW5jO5Goi7iaksMsNHEPzuQdWamH6ekqmEMIw1pAf0bFaNV+
-mTzNRMPkgGn1PXIqM8erIcZbK0XWVnKtNRRX3diZueUw8crdu5xMRykuJ7ywf4V/S5UEb/FvdMhWcyme
-3VW54jFNU33TxVwJGWWc2eWQIFe8w09tqp6GVEP4oArhxJIN6LivYSmjsT9/7sYT8nuqfmFmRDOZOLWr
-aAZBfk/Sc7D9m7LcLM6nQwd0+S460n71leuQebDxyN0IqhZuPKoJeOLzjm2gTZ/iMSZsSrYp6KhIJ6AJ
-rGp2kQ0HxQ6ow4AFjOeARXH5UMwgiFKyf7hPr9mvtsVnEj497BDBl5KxSr2DCIXRast9wg5uZykYwe+f
-Jo82YTvxxtQNocrh4HWFn6/HjRIhV6qt5D5a5wtuJX/Pru7inRFOXyXE3HurTegiu2zmoT5sQT08PkA4
-4de4hrqcExvbVmaZDYLoA2QFyLnOsPjNaF4pBkNko9V3B2tGiynXfs5O13be7K+Zjp0WhtuxIX8xv07A
-0lcuuXBwlqHmlbh/7ZU9cOAijypy6ToYAzOlE3ie0CNIagk/zQmmuMQf+Pjd2D40f+KjtzBz9in9whc/
-Fpcj+xx/0ONsAE/Nf7Ipf//nHAu+PqCfL7PRDH6zKRB/4oud8biMvqB0mvCKU3IaBZlpFb+9kfVvBgGK
-vBsZFhlN0URLDmzipA3Ay5ytD90CBtl52rtS8s7swWr6oM7rkVh8DV+jCIOYaZRfbbTZIEwi5JkeZyNM
-5PSe5ywZpFdwzDWC/9S68f1JfDDlnmWHheOEb81YPAOfOZh9HK3vzBOjTeDfG5sw0IPMcAX+fAQ8A/KT
-fn4DY32RM19s/I1nxQw+PQDiOwN+C3TfgEaBPzfXobA519hZ2vybz5CPNi0jPnqEdc8zZrdHj/m3ZahH
-X2PL+vQD2vWT2Vzo198qHPzo24B/H31XZd3H6xW2fQxf2Ye0mVzkG5+HN3C8nm802uZPaPPzTfwTmvv8
-Ef4JFJ4/xj+h8vOv8U9o4vNvmAY07vnf8Dk06/m38CeO1/Pv8M8N/Mg6/k0fxC9u4hc3NonMY/joq9nQ
-ju8Gtj5YGpubUOxlNk29RfD5lTeUdDfobYYrqyob5h30FTOVe9B+Ai5MQO8tcSMhkeqUiNMrq58m/RkB
-ahEBPz8cJ5S4LCbv4UTcn6RnU7nKoUyoHafHmS3gefHRUSi5l6AdMaBr0g1ET1fMuELF6SXqUNAcuZGD
-7KZaWHVb7SQ/H0Em1XxKWhZrsRiB7kT9UTBqx6DZBM+cGxnURy8NTzBWoIlsnWulx7EvalRrhBFpJ+Ni
-cHUG1oMU7gHNuRwtefDrEux2o05kotEqjqFYhgacS8qGdpFTquVcvd8p3nBMykT5Q+wKoweM+L7utMB0
-GfqoVD089QBZN+GoSUwnYR6NAaWR4FikoabHo4KxcSv6LTusdWIqJSucyQ8iZpOtJHLqtCqrd7tZO3h9
-kF/h7HslZuOGWlQ/Y3Q9Rn2G+5SCNlan6GGWjhi4g4BHiI7bMnE9wlYIGKa4I9rFj9slH0Zno5x8B/QZ
-wkKw690rZG74DDK4z79BhF6jJi5PCSAbj1fR7LY2N75Grf0+Ku8Lau0vIV1Onk0OwN/zjlagiko3ZKK4
-HPn+Li3LwuhvsFvYwHFusdriRDHjCOPLR6iMHb5d+/H1y9UXoKmtPlrFhpdrfwHWXpUOKPhz+bxht8MC
-PEBEKwddyWzH6QA4kVRX0afM4950MrDPccs2D4fmv/Yh6WLmaQl/wGPa1GsP9QAzoQfYzDxIZLyiuUqG
-6ZhznYolCkjpwYMblnFRim82DxX4+WaDs7ayc5A6nIDeAdK37AAl9nUbXLUZSutXpwaXbPRKdi8mYCYw
-Az/E26EIKReuqfvynxmgPMHGtiMxm4BndpGTfpD3gdVtkg3GcrkamV4YniJV5ykF6PFLrQQ9DYp21Mvt
-iDwOBzp0q51TVHqgXSzMI+abCi8dUfFj5TIhpX9IlpfVl474+bHZZDjXwvVSVSvzG1PRz/gbkaGfL2s9
-ssomcp92+QtK18N0cp6F11OLilUzy6QFTJEKeVqgfE2Zof1DAsiItIfOZAxgsIQQIVZv7wG4vDMlYwSI
-Odh+JpMy3C+5Pl8nh67VNecRKhG3InOffWMvPww0rXLSU1dVhOq/zRZQzDKAQBlnZs6o2Qiwj66VhIvU
-xGwqh3v/PDwBbz5g0UcttniGGxKBtELGS9yYfi3XiOSJg+/vAPKqW7jUZgfeDyohKKD83CWaSbb42fx1
-fchl7IK+jwv9Mgt6fwp5wovJ89Ht13NyPihOTXMProanxUAMRvuHe293Dl+/PTn475fPXgP8GTv6c7Gn
-nlc/XD3Qi07OTSGX4+c77/55UqXV+B//Q8o1EJ3jWXYGp1omDqfuTjQwTGpJBESYMcqlSseScOxyPpNA
-7BmAAaGrFAMnkp+VA1VkXxZbPcVLNViSLtECw57YQacl4CahObza5/ou+QGGzNgiKoeCTw0AVGwpcv2I
-UVPJFFw6BetxLVLSCJfTTCp7EtHBKFbkn+6Hru8EoNdzrwwwAjkZN8NZN2+8okdBATzC+SVi3HPMSTs8
-7BbdoCAZi6druXJzr//3VTGRZZv3Mbb/RgHnAPGWPhf08OcAAwaTSG8qWUPRVQcuMEcZzHw6ufpC2MB4
-mWoPT4YOnLtAznctfmVXYRf3jDIAiTYtUCtENPRTSGaJjgB0EERQT7hgbSt0dMp8Iyio6KYAoLCIJUzu
-QFmZC0qqzciCOYPa1e9ySy2kMjQAvw6fhmOhLdlsPGgopzwyozHqM/vqPi+AT21mHrC1HvzjPx0J69OA
-fktXwFUM8tiXGNU0eWIG+Hv218VSDmXXAqcK816aiaN4FLxAYf9C8eNnfHhU1hqQSWOQNRhGG58M8vGb
-dHqhn/Wzs1L/zgamUOlVO9c/BoYXw9/p5D8naR9g8vWbcfAlsMmeF6PwUUgQKKWDGEFYsfp3OS3G+jfs
-l/zbIkRQtimetKONdtJ4Qo+SIYQ3jAfZ0xWosPJ9A96t0cvvG8ekSqAR1auNT7gw/y1lJ1LwkSv4ZIpp
-BZ5MJ1Jn8r35P3qoCFBjP5z7Lf1wLs2Cv6TYUMOPox3ngek2VvkBeYnq4F/HmASgAV7oUubJMB1zEfzr
-mHgDbT5Hm9WmS2tNCcjYL3XtT9cNYojs3Cwt+zFz5B70y2zKtdzPY2YSozzYwqRCcFH5QQWnk3jzeFSD
-AaVeF+MpNtHUdHzQ5jc5sqJ+jsOQyhs79TI8Qsp/gV+uPj0zG1v1KeSe8J/iY3w2UcXUb28lM4OEi1k/
-5vWsH7klrZ+eB795HYaPvLWtX46r33YrPHwaIV5Z595LWur6Ea92/YgXPD/S94nWVMBgzijczVZ6lp/P
-Ji5pzVTDojtAetlDXr0+3NsiBWiQ/gbIZ7T3lvWbryUruaZ5h6zFHRX4agR7R49yvx1Ke2a3+pd1XWoj
-Kjr0pgcMVjUyxMCxUYB8MgS4ahNuhGiauSX2N+Yic7KtErAoLdawy4wTbgSgvphadlv1jVTQcGopPUVa
-7hbSNtED5W48Mfz8Pln7vsF3k9qjrb4SgPLYTz1MGkZeVR413G1nojSOIykEQYJu4DoYoLjrggbtoSNa
-9Qe1dajHotmZg0n0ZOLY5nNBUd+IK725UFD4/Rnnk884iGFRTHbFF+D12VmZTedaYTETOCRfYLAcBhaU
-aNlBlp7RK7BrwB2uBF+GMjCeAoFkYsWSGilYkW8vzKeDeDoF3C5ZNtSi0aJE8PRrl9QIfSmv9R6D3R6B
-5RRi7ggVGWIbMIqIz20cjYQ6+mU6eJ/MxsItcOzC7OpAulEKEb6ePs3wmHNhDhDTai6LhQfvh0VH74C+
-Xj+AocjsQM+5VsVxIyyg5ZoebmfvDcOeYXw5ybJh2d4kP/UznNAezOOsvVlSUw/ZeNExgxWqy1Eu2Wum
-4o8mW7kqoxdfSE0g3WZClTQcmmWhoJcC42CaTsBMsK4f7o369OimmfGs634uCqLhPkE7UGfq4N44SwR7
-sjiyVP7JU+6PLCgg+P1Tr5MeK7gH1IAt/P+2ekpVt4TuqmudLXQtiEPXS6ovMkjcDGEyzWV6kGNcPifx
-aO20KgvdnYOx7zeqW21UiNzx1rSQV/D+aFckbK0r9Y+vd/EG6+Twv9/smfn8bl7kNoiEH9kCdq3MbiCk
-vWRGQ5dyyRnGF/mXCvyIlxnVSpYH1/Fv4WZAkAw8BMn+jxjMDWpbVcufM1JN2y2V/jd4FoHeWhLQLtnS
-PPHijXIFhUuqiHFRg3FFMgK7CuE+XLNA5/RWrdL7YPEvs0oVZugOguEVc2BiPzGLH32GPMt907Q9/YO7
-lZjNGZwP77m5LlzbpRb30Xarki/u7/PJ0DE9B/9JM9yV9RgZk2aw/LgfZj+db6RXPpNvMD9yonZtul40
-fI+os2J5HoKmbs304syE3qcY007UwGj9BGipTGr9AoAnpIajDBf8FPaOxkZ0uuLoKz0lDdU0jGGz1wuB
-KEh+4A3dr7ElT+2XQ+9K97laD4HI2FuHy9oTKkqM+7j1LyMx3o1OgVbWp0CBN0WZc16EagR7HITFrvYS
-KSRjJlFJ7ig7q9k5ySlGR57rnBpk05tJy9rmT8rZD/qj18wun+7AIRkbgSFfnIdmlJ2TkxAkBvzYQ+iH
-C5vpN0Hq6QR8kJoWD8MmNp6VmDEE/JXAjcZMCnUPzlqRc83P2CEPg5bKVy7h5ZoeAHzR86D7sYvO5t2r
-bkL+jaEEq5mjpvuCk2fuGSoHNNCBViAHgo9bMhHj9Dz7J+nWXnh9ICo6RB3iauT4cOXR+O+FaRwW4yV7
-rlBChdpmWuY6UvnqVeQt0buulUQ1Y6h0lPs4/y8gcSh1WNW1293wn8wguzpY4lU62+bRzur/PG4hsDxP
-wU9CCXKU9MzHB1BH4NvblA70Ywquz86J53vXgGbjNO29h0ulUX+3GBSTRovKPElW3JvVHrxaYQLmJIp5
-n1yutLJNd+6WLGb6obS6kjWKLysKyKZObnnol0WrH268zYbeN5THRqXIPwJJAoMDc0t3WNbmTJOsRtVI
-LF+CuC7Ta8+Pl9HuBaa+MvrtpLH6HxuNViQfbmWZ2Q+pVXUf8vwlV5XlvkX2b1httqav+dvHVt0fljq7
-9LBcXbt5KVby+d28Km0Hbrs8a8m8LH7DeJCcfMMskdVh8dvq1L6ZS2NY1pAoAwrInmVipsWMVP7bxCg/
-5+dZCc4s7LQ7lFedXjGkeN6/8MLvQ0LFES55lgWof6AvIqR05OBA+OrnkwmulxHhUJEcVk5YdgD5YBrU
-uEkk2O9Y/d/x2JbZfFFYLBRpde9u9ydIEEK4nUI8lI/cu3RL32KX4AIomJWROUDuSJqLSiEvbQXm3ZpX
-3y/g1aUAgXmVgxJW1KXmCHAeICwzXDi9snkwFsyXcTMG81pyWCTphyJH3OGrnmEqBg/IRr2rNkbNotsa
-X/QBa/QGpjU0y3ACwnmcN/w/oxffJMxMZlRm6hW48M6v2jFMMy2AGdr1ZTsv84/5CAqQKn9Sy1pb87jO
-nAmUL+YuouIw+hhGsqL5JYNUdYAzmLwfYVZ6tgFhkU6y3zH/h+iaGNwKhJjtGX0aGk5lA3GqULDNW0+c
-cvbMa+eiDhFl+ZnFaRJkJ78xvvjNy31+a7sM9lx0bfZTCeEYssezC+HVTvhhCTdLFOANQgtR2eYX7mAS
-AbVeFv+O2RV6mb/YvMpL6Lx9HV5h8zpFDxbmbkwyS1jbZIDHa1uKOkaIKkl1EM0FE9zAumRQdMlpeRVN
-54eIYKSZIOF0IWUx+IC2grzsRI/uO8kou3QNZTsH2xUaBMhluAIEbkeE7RTT8AZcUMf+eDPWjjVb+ePz
-5y1i1UjiySXzq31AuQv8u/aI+OxgXjgvW8eS53FvCTZoJLz4fDGrPOWLenh+a2zzT0ljIw2AaARusGTx
-iS0cm4HW/1dbU/LDqnw4CAMjFEswM1EhuzlLXo60DK+eyk5NSpdrC19wwPgy6JTGSZ8FOojHNsbX0Fiv
-9bxyF+qcnQbz/R3cjszSnk0wYTFL30veRsiBu+TY345cVcuieBrdhdm1vSL8mpnK1yLfB6dqMuC5YUPL
-YE/iiK1nOrt8yGpGZ/bGJHPEBN/PbHWzIa90EdiXlJpbEgupVdnR7mWU/iYut/WItrxRFCjcqVlcxYTy
-t6Yf0pxMdHAc6s3KaTHUfbTYzhhKLbmLmaDgGhJ+CW9yO28PO8lrkpqFaSvaTzHZUF9QDVEXrKE1Zf9F
-AudI+hA8jKODGjB4mkbmF2Sg7ncwh4FTXlBxvqLRbFXg6qJiiNlWpbl278iJxLL0ooxJpZ4XeONBHgqV
-u9K7ZrLxctkAKJfKtABFQWsgOWFzkcEK/Wtp0x2oXi6J8IjkqFGi+4uklpCILEmTYTfAGi2iWmMh5aNa
-7QbFo77ibDT3i3aKUMTTnOy6BeqLdxblQQaKJRLicDlCHmfu4yQUgAikykfwzV8ByRBB5iFRP8MrWi3C
-TPglQuZUBlg4dEkQb2ClXxYJ+fmTHFBwDXDXCncNRl6YM+HZGebQHJwXk3x6MSyXWChAKCasjsEV4A2T
-EdMMHl6JAACEoVBg0LKTWW0lQE85Eb4RaxNxGcP0bQi/7RpTbQAuskvAKfZ6e4IDvW/2m4/ikBW+G6bn
-mbuHvgPrO4J5icmYXlmX8qeCluCXq8+5ZosLF8imYWuXMlZnM9Br7XSCrsAbE+bsxsgojEmi8ks2qQBE
-MqETqBGmMtn9Pol7CODy6XTuNCSmAp97OfPq3kfIgwL7jtvj6ko0pa+tAEBKwjKt/hqzG9WqxrdwgfbP
-7FsbG9+059gdtjY2XYqtwK6wtfG1y8sVmg22Nr55HE/EdUMikc37vNz/PnMXz9W8i+13pSrYbLH6mE2m
-LM3oXufK9AaW0TkcNkm9lLx0YBmQFryhBiQlYoOd8WieAX7bNGki6jOl17RIki2U1Cnng0e8UMSVJjr5
-iOrmNnMCqrGnEwhvx6BE+GkaZwTzBGcIkoT0ppzaWK7dnQlrCNLuPLPBjzAcols7qUFYwNMLfwip0iQb
-gicwZFoeYbw9pcm0oEAg7wk72tHruIs/bU2zy8JIRmKONo9VO0lNG9pJr5302wncKNwx7SpU4OGHvd9a
-SZxkowQwIGD3JpNi0my4FtqcooYrM3hph0/mqxGKPXE2ou54J2cgsL1go+hrT1Wz3Hn0ZT6iFFQOP7zo
-4WGpv23PAIBPN5SC/eyDmSzn/uVlLpVQKtijwl4CZ7ple5ENxpghhASeOtxGzwJoZJ2cg5w/CmfzeNsv
-5O36Nw3Avp2gf+QFAYBteX2isbW3GGt/LdfgqljbQ3l7gvYdyfcfPjzeTq5bQafopI7t6ZxN4DL4sHiD
-IE0biG+BaUfAh6uHac5wkVoWapSQuzXBekuO2ywv1OT6sPXvnrh/8x6g5UvsMCWi4xyINP+z3DANwZ/S
-8rk5vBqJu70EAmQRH0avGsITJM5jx8dIlUNUzWuI8BM6XNLwfjq4TK9KC4tKNvIRLNpMQb6lRj3l+Sc4
-ggnDZAZIR/1i2EF8GNjULs8R6ugv5uGq+Z/fmlXTmjNqzYKNbjYghLnRwg2DsiVf+/cOJQYJjQTrTm3R
-whISbJrJyGuhGolmtYAB4BQiOPoDCl4F+XyemQOI2ToZHkrSUAMu/uicMzd0YaFNu+2kOyjSPvwXZUe3
-Tc5kFFht74mfFQBsXBJcl72errtFxu/CZe3BzBzRPiYEuUU3+FnnvJOswA3V+xU/5ae9GWGMVP/WRh6O
-L8CeqUexE79fObT3Kllk6CtOv8n/EMHhbt8fddY76+ZkkjR3ycj2bIZRZ38kL/cPwxsZf702gzFoSweU
-6/ANiB7Wwixd/+qrZLnZMMIIP/UCMdAA81U554YZr/2jJGoL0i7w8QUbx8NwuiRgKS9tb0xZV099zp6V
-l1XhuFV/LjoJ7YhiDTR8uTM1rGRUxcyNYxvCyqFT21Leb6BvnD6y9Y4D24xn3tLthvH15Zp5ELIy0rq8
-yLKBRsC1VlrJwGKkl+TPsy7Yog91sXpXmBKATr572In0aBHhQ9ihHWqRGSLDso1WmGlCka05Lgfcu5iT
-9aOF4I3uNYFP1QReFZWERks6JZi6qeSASzia8Z+Y6thUqZGSP19kU4Cdxp2DTmZcEa+idXCFJ+0wAI9K
-ehfOy8v8VKF6ieH9lTWuOwvrD/ZzbM+RcluyoPm9vjcMbpMZrc2LahL7fX1Rhxggt1eIElbjiJQH+Ssf
-3Ue4fxHeh2uUfcjG6lLj1eevDNGWnbYH2l+5dpmdrqbjcbnGCtcq6E9rhCRkjqVrpmGrmPp1VbYiwL78
-Cz0CDlpNzb60Ws6Gw3RC/kuI+yNiExsKLFgKsg/6F3p4TWa0wt/TfBh9tgoZJgceutMwzb0Hw2LkozQx
-23s4TmVp+tn3QZqMHuhhMWXppHfhozENasCZ6HfQ5tnEK36ZZe81lpOSHOGM4r2fJ0PggRIf8DNYsfuv
-3rw7RATJyNgfYQWYLoQHjFQHbNSdt3s7DcYFjC/4sKFq8d9HDX+xxV+7+ZGKSnuK5z6HzzQS35+8P1ro
-3comKe2PbZTBFmpYubqNJY/m8abbkFyvt4xShhx6HzH7BTj01yIf7YIbJafjuj0m9m4xPMXsGAJnR26Z
-KKrEGQhvdIsRspdsb7+aDW1yhj7g0IgeNWIVrI6rH+C/k/QqOJx3Oh0bEdtTjZ7r56162LQtW3vQBiRZ
-U1DHwcpbm+DQduQpJtJwaPQAK4JE5aRpmv0CIStMUXthYVEsEk5vLGW+TzbkG3CkaqIgyMlsav77xJGD
-3w8fKvgM+bD+zlGeW6Mx+sFJIQ2FoTvjBsJor+7vh5B9yqitYAt66PdRDPliznchsFI7vs7V6KvNZ6HQ
-1vtrvz9hvb/Prl7mYCW7i1f7bbBjd+VCn26IjFKXMewdBn1RJo1/QSoduq7PJzYDjg00q4TJQCN2X794
-/faA0mtSV5q/n5qK5HoCqK19+vNaYKMxeOcKQ2hMNarfgSrqfV7i+3+gB9JTcwakYkdcD5aXvTE07DxN
-AE/QMA54MMCuamYIxFkxGYJNlEx2HMnLzrFo0UR3NBgMeycJlYNxkBBYah3qb1tJ8rt5vLEFRDfaUGQT
-/968plKvZ1MsxqXg/6UU/H8IlS1+vOa/Mf9eey6wY6xvJE0h54Q7wZQAv1+LIOR0WXd1EDO09Tn6tT2I
-L/u55aEN5KLUcHxgJHprK9lhsWg2pBInxvAfKyboh+05jN3me+RXhgIb02khWX2Zumx+h3B/kLxNJaQt
-IBPELNNYVGYEjzBZ2VMZPCVeJ5h8IHYPZrt9C3+QG1HvHt2HAH8ZIfz6zLN/mTGCuwmGlGbogDSxt9SQ
-uzd5kcGdKXzmFIz4SXlVTs0xEwtRGu4ljqmFe9ZBUZTie5ae5oN8ivbc/tUoHXIGOJDwmJ6N1bS0VJlZ
-wYWqzAYfsrKTvOE4G7uSOHI3gS9Qh9ZMTTNoRnxhPvGpOEQAFwM58MNwDrj4WZyRdCpEzKCZM34nQROu
-aRl6bqSEKmJm5lTmGptISQWRTMOqIHCPgIjWxZla9ACegq2j0aSkhoCQgMoopmEb5Bitia3hBFlmthsf
-043NRoemq/m7/YxsL2EXqTyxAmRDNzQ7yVsKpqANT3cJBr8Ad9wUvWkmU/CRzOGSwPQT6U0y8liAwcCY
-jBk5PGvp/PrMk8wjSIP4WstnFime1PJLieySp3eSYCpTogYsJFTP7RisJzZe6YT3YdJfQPIM0zFtcbU6
-nj/5Zp6sd6OEOvklnMZHFy2cJtFGmXVB1EC4cpe4HcOf096FqISYq+RyFGagw7EgUYNpKJU2iX4v/A4v
-cwmZrDTHz7KDCpr6Zo6yjHKe9CXPiZkAd3iy2uWqNJzagWIm9mLEweX8nFtymqGwnaSQzhGdHZbI541z
-ELmQBnJSTc+mzGPQWAxrtpPTddfjZuo/5OCmCRQx46btWyfZD/XM7GNeYqYpl/uIwkXw1rzfdsWBGphV
-OaLaDZg4v9mS5EaboWzy2ojtwtkedur62sdMweAQjYluaJC4Qza+g39bnj2fZGOXm4bG92U6brbib+WV
-dxOvFFzi9khgo3Q6MLBx3htPL46DbtrBYBtY25JsCxVlXtAWtAjEnajTwMdWo7bWgRFfW/tEgK6/HEkP
-lOaMNM50wsSPRoziLI2lOtzgNn+CSrXZ5YE/ux2zABDV+PHfjpAS9PfJRL+EoM+GRf5bRpl0X9Oh/i7W
-vZdEp2Qh6xCqSEG1S4FWfA+cLiEuwup5Imbrw4u9VSjrrSZNU6VXTantFJ6e2Vwyu4DEiUQYTkM8iNkP
-KoRKD5dTeg+UOqLH1cWDBy/ucyuiGnm1cb3XrJewg2rd3PvK/7sWEywSxAFFbqyDe9izjkIVjAZ6c6cc
-TDoNHMGbI5i5xHsOwMeNz4uCco4ak1FD4OhYSqAnm5vykrx7YIlaQhRrJaRIhxKHvsATkmcFSFlOsy7/
-KAPkOEfUzyH6ixUNOZmmEkFrXelRG5lN8Ngn8w4e1Yaf1F0Uhh+clpR1KUX/JNDLzNEp780GKcYeGxVx
-Rj1VAxIKH6sd2BHD2dXDaUn5l2p+JM41jhTNCow5jmzXL9NVOfoc/3vklYCznNaUpt05PQaKH81+nbxE
-86ZcWtsvsB3NftsaznjeUjdOqEBnH1P01bQ8FLGrLfRhMqjZhPD0NC4YbeNuYe3y1t7WxuNvF0r88Oje
-OfwLiFQ2loPZdSl2NR4B6vpEN3H1RfNkUS9xVYt9xAXrUb1gN1d+Myzf1L67zE7f59M3uikxbveJoyqz
-iDfj43tvxi/Luq+Kyyj3Bvzmc6l64SVbpKSKhjEZgwBRFavc1hkVl80WAaaaLvcyCqA9Y33UbJJArfsj
-gDdw0RxShWIUGsTioJGgk/xMwcg6wfxz08Kz4mPyJNn4mj3xsX+C5GwGVhgEDNEZ2Rry0dkkDTY0VKwD
-Ll4OOhFZYMmPCJ14XRlBM9D+sgACnVMzOE31sBW7J/Gp2IWkZ2HLCH1cP/cekV9g/fxrVkwz6x3/DzhM
-Pi8mzygiptYumZW9dJwpNG1Xx19ecwrq5baHxSBVCzeEj7UYYEp+7ZDxZYwsbgqZpVXJyv7gmiv9Q6rS
-x32dUTxWzGjTa0FoDXS/uePSxA957leNFYx8qO+u1HkIReMbzdxv2rUyb0i3Ntdp7dw7FH6BtVNm033J
-6CYXiJzJvExeHuyMx39SkN3PP+0f7h282dndOzH/PUQI1KPkl8kvo1+mv5wdrxHM36vXr/6xf7D/7IUr
-9aS5vLr6B2Sr+2NU0Ir6Y5hN0z/4b5ydliP1y9r3xwpY9SCTWGvJY2ft8IXkrWpTtDVddqaYl3jKyWkB
-/UFYB8hh5gCMV/m2PjVTFUBNljD4UuNzL9UCZXl1E6Nv5Kh9UI/W7ihMygev6IpsbS35OR99C/kTyy26
-EUZsZCjBN5L5qEQU1CWFBUSzXsW5oxcdCMh7NwLwpxfgiv2cG0atWbTVyXxiTR0+LI5q8Y6iZazlLGCL
-6uEAIbL37RY4s44I1wnlMqHGSD5jm/NNsQueyQdZ2ifYJwhK9thjkgHmTL8TUh65y5QI2SqlEUkN8Wol
-HMRBWaBRIR2XKLpsVCcsuvclIOGib//pDG41QEaugRN7/iEr1zbX1x+vbWys4Tdh8E6MxndC/vwMqiJB
-XpQT1AzgNnXXjBR8nhAhkXPwmjsfCvZ2gskEGN4PraagzO0tHummivspJpOGeDvWFAE+tXFni/Mf9vdl
-CmgKRhwYCbmFvSzJV6APiVERWyEjxBTIez29hPR1fNtmhC+jgTlyksQtG4k1hdRZblDb4yILJka1yhl8
-rXTE8CbyspiUmWYLHPm8mIAfwCrsF8i0yFB2CsyGkp+fe8RI4mHeUmz6EC7PztIPBd2Xxj7gKh++/vE1
-LJQU3Qz6BeWYMTwCNmhq/w/aWdTPgaddRoNXAhpABiqaIwsPRiZv14qfM2J/az8Es18xeZ8iarWN84W7
-P+jSND0vIXk42AXTCc0DnGTciIDBM59AZzCrOo4ccvsF1BmV7HU3wVU9KhJLGe/yhE4+WjUzBSk46O40
-bEMneWMm4wpPUgUnPHejjYDUQgompoTuXZH7PcVIX1W7RX2yGz1qER01A8He2oGV0yTm9yAa4dHROgeD
-PkEZH2y4qqqeR9PYt1nPiLmJSEKPha48aD6zXwLqLgnBK5fVsKPJ/TJ7vvf8OTgLkA22mOLCM9za/2A0
-IQDIMANM172/ZZNi9TLvTy/A+EYD0/F5zBMhRBt0W9oy9HfpPtmcElPoyQeGHwGooqQrorfLsDSUyq/k
-xQQYGqAxDAaanrUQm+P3Gay4DCNfyUOH0IhFStI/kpX0oXi+TjevUq5jBEfKvuA4eRutSkbCDu1DtLSk
-YsvRC/BD4J8jbwelud42xFWtpZrac3Zn6+fNGByRU4MW2wtapu6DOb7M6UAd0mqP0nc/CnzOg3hEa1aV
-9Rt9bJfzgM469tlOBIlLMYahUeMCAG1np0YNnIKFAMPf1YfNDtBO0mFhPgNJzuh+rZxR/hLQ1Mvkyenk
-+yR3CILQ9oFpzMSwxSi7JC9yI2LLjtI2SF/DS7YU4GUZ+u4uJxbryRI7sai5qWpfznslTM4KSAXmF51a
-FlXg0SLYXDglW0upiAs2k0tbtuEC8wwkWFnu6+fKO1X/ZonXXsBm0g6ZfGvz8SaKyvu8b19CVF6kcMDe
-g8iLef4vfFXDsSrgQ23UJVy/U5IuRp0953CWQjtIg3ZIhjrORoI4JnTaAL0KfHEvrMM1qywa8RivWCky
-hM8yS+QTuUN3zae/Puv4CPxI23cIBPsBtg0o+1QrySYlYNS3SuqBAr+yHXQGe+YWNbYItBr32JooCUzI
-ebexhzAcUgBWBOTpTqOkFtrzGx2lnnWqsRY72m8HfsdclTXaNzrdPYuW+uMPpEiRF8vcfPzVqmQSV+Cn
-4vumu/BMujDM6QiJHdiJdOBZ0IF40yD6pK53i7TNG/yoPFNz6hyMHi8U+3HvYPSnSCPY39+hheHTk8/c
-nGzFT0LRhQsP3nc5ESPEfsqTNtqXJkMM8hU0KraNocojuOIW/NjaSox8K8hAQ2YoaJXp9XRSXJlHBbi7
-okUBAZHRsVsT7CTPIEms9dRGISbJYRlCmnA2rZcTsAtCbpr5wXkbY8xdgh495o8BZs41M106byTITmuO
-+2Rz4C/XOQypkYq+V+MWla0+pJcYGOtHcG7yjHquaap2tnWjnNhWJYz4o4wZkE3flZXHGgwLalFkv8DR
-KDrbthhQ8Yspug7n01ELoOz9FwGOvcsM4z4T1PdfSP0oEqfES7viAUIP/VPddEkjVKcqiSb8KrAFhDVQ
-1v/uWRYKgN0uX6bTHsSRawIn+KpCgx5ve0SgGsd5i3e5ewmV5778MS/NkryKlrlDMhOptqy65r+tsKP0
-1XGlVzocWirdOc+mb2anZjuQBAbN1qdT6GgE+mA5+LRovD7DZzp9NwHbHr3ryqBVOWFel6ulbztoi1FY
-bNAcI36Gz9x10DhF1EIdxtgn9Z35XQuW0Rw6n9DeiHCdl9Fm8fbd1Ka67y77cvjG8aSGIjjVLcrDqd2o
-Ammka9C4W8qS6CKNpEBwWaSieZNuuRijXD7vq9W+3iQ4YzUW7aw9ufn/rqNNqO95vAmL9XzhJtw9X5b7
-52Wl0f8aT/5aJmvfwzmE1FdK+kQa6ymop38tUWU2/5nZeGvU+jXqtiIIqkDzr2XLOyGgFtxJdiWtE99v
-SVL3dFpHbQqZMfrF6JfG1No1SdVlBVkuCJVu+SEz41tDb4KGAkge9UtjQhlYzDllQoq7+UCP7B75tJO8
-MRptmZF7e29eAzl7zDSD9AAjwGY33TW9x7wBcHyh/F4F22rJ+7GOXPqec1chppC0j1BFG7EZDKWdUQ8b
-70Z0N24V5mhNu69DlSOuc1z7kQWLBmphpUSQDc39u64VyteVCx+rIyu1ay6MD5sR4taCumPGLXzxb0gE
-8nih6O+FbQ+P720PC9sepsUOQ23VANTdDvQH0jQnCN61ih4OdJqi2B14WnrDvfNmX4UPUSy0GWdYPvyA
-jOcWRQeuUjm3KSAEwXkeiF1mZl7Z+SlBfaIkyYL+K9jB55Ni+BJyTIeHe2rhH8Jbf8AQDszJvIqOgx0I
-7KM8fD4ojtz1AgULAkZWw4PCTArBvyOMx9QG9dLEkh+QaHYKZraJsONw4Quw2aaTRK8YYKwXJhyCQTpI
-z8y8tMD4eNegoQj0jagwTQdC6x+WXf45+8o2XQKMeKgAoSdgD87MkvWrgUS1jblbU1qUKeyuQ8OE1V2+
-WBfCPjKSELjYw1Ui15Crymo/6yl/YpMVxXVRXZv8cBVcEYgBa9rPWgP6j6GNGyza+ahvRF9Z7UT1W5Ev
-tWyytteG8v6eDUw4p0SXEoNXYjxxiaIjCHJPdiiJJa10Iib2vGFm/tsnHAPKKNKfuSRsILMJcQHdd97n
-YzCeprhxEAAXUZNwio675QjM8aLbTo04DwxTxLIOMQO/aiPzrR2qh3aeZub5uJnxeJJ851IGSWwG0vwL
-tR/zzrlhYvGhtnXqw3PoJseEmArjKwHeOb0iQCEUwSJ+7VURQeLQJVKbKIFCKIgUTss08rMEf5lxMZ4N
-0qmVvOJTSNBgtG6JDVoepgEiHq4z4uEgAncIyFh5fkxyVEAOK+BYEZWFufhzImM9vs/M/2/TUBhUxYzM
-Lvtr1d59LB6JbUs/n6TnNcXllVdeTFA/GQkyQJzssFpQwjrRn2fTfbw6LibPg+z63qvPnl0fCh3svdl5
-u3P4+q3kfw1a2bEFiOjBu2e6SmNL3YqTiylegMaCvjE31oj0PXKchlDhHMDKULjYqcfzDwDRTME5RWVC
-g2T1nC+u//fsioJ1Ji/MQpmNBZj8aWPL/N867ryNDv7YoB9b+GOzgQ4qteTeZueYV2vt6Gln63jtnMv2
-8/7PZugwq+XLdFy6bJZO6Ys3rzl01m2WUPP6cYTFj7208IwRychmjKFBx90+eFhiRH/qso7i6CIUQJlV
-MDYQy0YK7qi/CZ4MN3YOTgesuXQEvgawMWgipIRcswcl5SKjBpWUVzU/8yqT9+3UAkBFY6F8Fdrwvj1S
-mkFq2oa26avu0sp14auvXH/wdiWwOJlNa+/j2Mi/fJrIKZsbAeAD7/x5aXq0Wtvukn9/6Ii4e1CbwJVG
-RaRZNl1SyT3Nm860ICiP5qNvvPz/xAjeTOKEsw0kp0w/nLF7hlkYTeEJLNu8coAx8wyVdz1SFDDCAWd3
-C0ijl+FQOYcruYZrgK8uPrZp5XC861ddu/79ZEnw7HmoICE2XFKbTnU53C7jmUVvZcYZ4wkBmuj2Tler
-s7y8gNEEjwy8Wh5KzVWHx4paj/uJV9F2Xhqlm+QcsSzAQleXNgpG/h8OHgdas+qg0LALNuDw5oUR41R7
-ayhRgP/hogBjZfVQ2uvqByH0BfwBW7wFq5h4QmBZ+gdoUAfFcwj1AvnNh3LoNaRIB/41p0+/qidAuC4+
-8iBLMpUI3Pw3HyRe6l5Fr4oclOy62GeGemOgCsCZoy0JVZFOOAiiWewS9hXkO0VPhTHhXbr8ouznVXDm
-T65HWbfkGObNp+02IISMqv1FkYHZvE5RtQqMClWNBwQRLCuhAEvIzgb8cOMLvywQ2VIS9nLJ2Smm3h29
-Q+BYctc8dBRVkWV8vKbn7NegE0mB/sp8kZ5COm+wKJt1jWnK0SUE5DR51NvheKqA0ETW23dP+RrF3rtU
-7rhiryR7jn21GCSJQL/z+Nn87v4YimFXz4Yc4CDYvqESaWGZNiwvRtJDLLe05LD8S/MX4eH0GWKVoe+F
-oNsUDFU0eJeoa7MXSYS5zsG8JDELbslS7FXyg1IFH1Y3X+lRso6WaM1i8M+xGT7gwyyz/YadQUTioo6L
-bZz+Qg1CwKKZ+3dh6dNZ0PSXfun+eOcIASayK4f5xbcTVSbUnTr50GnOnDb6wx4+NdQ+CY6nttSRA9lX
-XhTuNqnpRnpZRto9eugr1FtuElrqpiM6G0YNChKvJm4oMSOqlawPvSGVwt4wP3w6T7yorrvLCzuD3hP8
-vHukJU50zfhdQEOFdsXBudGnI+9I5CbUuQ+50i0vsa48h6GxRRjk0KfCXDjNxvLbp4sTabnEzMnE6N+h
-0w6bMeyzyws44TaXm0BXtaEDg9ZstTp9M73+zaiwGtTooI6jL3+i3Pa5OG4e18FyUAVbYaMWZsA7MWGE
-EfVoVJ8GDIn1K0wJ/+KMGXSxNnbqjl5Qn3ZNHZ5K/Q413uHVMx5XzXbitAw6i11l04RQYlzy0GSfglyq
-N60NMMZPcsR8GyScSpa2oCGar04zFzUtNz45RcxViZXZv2YZ+Dau0UJAvN8zUBnNfqe35FLCZGzqbJyQ
-2G1o5IjuOwu4a1DYkEUcoAlTssfDYr5Kjt63Pxwn09kYDDmm1AWa0FLGuFRxf3dY1BgshV+pW9jASVgi
-9JMQeYAvjzaOt6MroCIPPp9ESKJnD2rO+nErpBRWrhEo6y2vXKvarVtJlDvKlKhUqZMrtZKlTrbMky5B
-l6vX9nw1TxLH18D5dstxyl3vg+wAwJZmM3VB/Q3Xk0ZkJDm9xp46qaUjpA45U5DkNmYT81Loi7+2Q3t2
-xUFEGVlA7iH2xK1y53uXSzWNbnl7+Zkz5noW3A6nVqhqAFYx5DANodAKRL48nx+VYUcXzIZcA6NKvuiG
-Xms4mLtuF1m1/4fqAE5lVTmJ/V46M8shk1YbsQVENwuak29APk9K7gF5zeFiuOxI+S4iowIt2nERSeE0
-U3Uk8MxakyyO/WrSjS1eDGP3v4Ip0FrdxSoNsrPpm3SU2ZeuNl1NZY4Ej2mXFYxizBdGEslhDciCQM+d
-4dspMHRPlJEFdRW+UpqxhaacAiRG2uvNhnD3SPegkHvkCuzh5yRB4TNAUDxL0EZEI3qWY3zeIAO4gkq4
-R719jHOK3GSROrSGqNnY2XOwYdYmdaM1Sv6LYcd2MP5cM5M6/zss+6Bhyh7vbDSe9Z2bt74drJi69e4+
-2mgYgTPv2zUXvlXCnwoU618Abm18/bhdd79nXv6tXbnE29rc+C6GOHuzM9znRfq+d4Zb/KqZZ2apDoMW
-oUYE9iqAO9CvPMiCfJgDOjUZp/lCF4CvCZqqAIQYe4XLRr1eMaK+yxFuSNd7NC+BLDQkGHcAI+qzD9mg
-GKOczVxgOr7rTQzRHputzWmnhAhic5IT9xhD6Rz+xhkHseNUTJ8WHqXeZ+YoJIDWMLwUepcivA/Cl9mB
-R4L0RXfJ666tvbFjUIFbHLEdIbts7AC2E7LiG2Xyd6vqQZLRp0fH7f84OVl/ugn/2XhaydoJL5/AK/wL
-LINQrTOelRdNl3oT3h0roxR/DXR2azR36iE5JEECmz3MWOf05K4c+ystx40WPt3qCr9pjvG07SF4z5+7
-7DaN0OgWtFNQaZ4kG+CwBaB+5S8/Hz/4jzWCEqJSrUU6ADuQtIkHwbmc47EfrjJH+b9m4DPE19lXtPK9
-PjARcTBv4/1nShhYZqUSKOeHTD4Cx/UtoMAPbuoxKqqvz5qN56kRD326NoUENOicB+cKQ61FPmxhyCHa
-p/fPR9AUW8/duwIBPLFJOma/Act2an17pZks0aLX9VlGJtMcBH6mMdH9tHeta38t187bicLgS37n/Ra4
-5kjIP3x4vH1tlVu4SCjMHgqj3eQv2Zfapw1tKKurq8nP2aAHzqtwu5qdzkhS0NnNvNalKTIX00ZeIkLU
-BJztU/JcGH3IRjlahORiA7KiCeAxbQRTzAfiCJpPosImihxOFydZIykI1YT7sPREYUyFTBt2l13xmuBp
-oFT9uKrBX7mFeuHvDFub63+LbfoLwc5XWmM/21kb5KeknkCkK3/aPgNckm/hO18vhBEegmGsLRGkG6nd
-ELext2fdltE3gUI6JDsg5qwLklARqC4cZ80mQBdlXU4uRm7aTVQhu7LlWVslhISMJ0aUfqSwaUeH0xAC
-te7/6sLEDyE+pLQGyyknS5yuusYFzYJPATTaJO/3wR4xAWrIVCmeXgj7N/mAikNnSUHbkeckfai2J+kH
-I2YYtY/hOVwHgBqdkfYOvkkOroanxQA+scRxvNBlez3rfYHsA847/Iek8b8gdbO4KtnsIeiuqbJ3WFet
-PWjF3jAH1ysrPMKT0HN7tDkbUY3kApXeCTuYoMO+f5RBJ3ubRss7xTjXEoHO8Wo+kyh5zBQH6W+MZpBP
-8SeWS8e5GZb8Q4reE+oks7fXPBvZpFttrEFCFo+wZ6CrnY225be07anUsG8KwiEvGLPchcVIPqR8lA+N
-QuqNHmIrnQG6lBxsh8UAYSfPUzBq46wjVNuvGK0Qr2zHXgW2ul5j9GvQaUUGkFeTtQfmK3T+hSNyNjXF
-E9f2n0yj6F7acG5+Dm5MmkQJni/AlbYhuJf9/tqmPgN12Q2+rqucnU+QvUE2Wf0nzGYD/vAQwwDsKC2h
-RTHAu23TlpARD9gBhYodyiri4DinaCCBfpyrEG+hhOWLmreg30OwiQOPBJHgtUIO22XyO14t//HM4eRU
-5qZmTCxBpaK6rzSxK21un/POyD5MCQUAxMAP8sdDHoMt+i9uV20lZp4SI8s0QJSw+n1kiB5bBw/5IqsL
-y8uWzDaXcE9sqaNjeWnfmQXmXuun9Cn/7r2dDCB5vS1FKqjpPkUiX4rHeIsu6QfetXyWHaFDuK1ufnZo
-ZWtDQ5YxpjK724EUAaRIHDm4qMLErecwA5O7MN9IeT/h04BTLM/tQ6gECV/M7NAwimuG/NG3DercgpNQ
-Hiomgt/CP+mG+d+m+d8j87/H5n9f35KXLF8seywEuRsqPNQKIgf5O5rTK3WYVwfo8BOet4SRjcrKf+a2
-PWqjA71cYmXsfJ2NNLCSe07bALaDbixf8CsZMk2i7QRWG+8TW4w9WhotAwI1BtlI54MzGs7GloyCJkRa
-oXsiqSDb3i0lEti8DQGY4RiRR7clAmwSI/T4LoSA32LEvr4rMWDeGMFvPoUgrgZNlM9lKJpALG0Q6/ki
-yDDq6obIoWwUOAjh6SqH0KZjzc/WT4g/4bU2HY8HV9HmwgEfxVjoFGODCV0ttWJgofy6HXRm3Ta56tbk
-rRCQnosvEpK1teukZqW4tRIQCqYOHspS2U5OjZr7ftsnsXkLErhY4mQe3ZIMLpcKKTME6Www3Qru85dx
-Imkqfr2Jr34VvvpVz5Dirl+r3PXrsX+HrH6EvQqYze+W8Jscd6v3Wwwgp7U32jE5BzNuUcIsdEthtsNz
-RBWzG1v9PvoqvofqkwcfPKx/rz6m1J03DiVq+KMF+farLbbRonlX5eWTxaCOGSreVwaBZtk/jaDHrFld
-Ld7WPmErbvm6naLy+3WylUTOoNsxMryBVx4pGQP1lByKk8B9t/oULaRCiHmM7+OqHzyyhsXgnZM7WOK4
-cpdkKnjcudNHlcrnSjgeNBgons6qeLb7ZM7M/62siWdUxZy97NPZk2X5PZP+qUyK1y3ZHU+7YAgTcHra
-qusZ8/DCnWMtsIocecGYOo9R0fRCX1AaPcar0VVRXkrZmww4TAUfBOfqxbjd10k038e1lRo70J97CKJ5
-vsUZyC6OI3carzvFnI187U2rCboU2gTPRva1aXITxx0AZYNDkVdIpt2Uq2im5ALNEkXuVpSqQh3xl1NF
-sfBdaCvmgDoFd44WWx2JivoTDkfdgFgdOCgZGxWlQsUGxh+amsExNG52MVpbW+K8HSUj3mNUSJtAU3FJ
-5eQzB7kf4EIN80ZieO2ooMsyvdyIINp8PqhDtwUxiAg9ryDlrTCLhsusH8t6KSuHFspHETM7LSYcwTjj
-1lAxod17eqFlkWibziNqAY1TCVJPigZfPCsmtxdQO4PBi5iVr/qWZkCh4vubZyhR7Jy1ogN7gzQ7tnvk
-7Tfl6EzhTdDOIE9LRtoo+SpGkoSOs8LwZDIpcEwZrQsvNerUmbMz056FpP92HY2031c7RK3eZDuAN5R2
-jgSABM9L4EMGy2dek82afJl+jM538KrpBWuG47j3EVOeYwodnI/YR+F51rdTFqvM14LwAs1lKlxv2dnL
-qBQ1qHKRqD+KFx6MCv71bXKi7jBd0G6FO+wVr6y5fNQbzPoU0gw4LIRi4rxbmASpGHDDhiG5XYZywtJd
-8iIJHHQqfYK8ydKNhdJTBgT5BS+L/gFcfto9O3i5w2AswdsJym/FHzZFHkcURL8RSGfcSqLlxsW4qQKq
-6qhy424mKwU9umoUF0rjE/GbOvF9pWbTfFCuMC4UXNkGqWN+LfKRed8RYOz/Mr93oYXFRBn1yaOb3a1J
-fMONQEn4+ymHatMvs0pyvE2zLos2a4zL1AClhnSdTfEpJBaDPYXvw9QXcWPhr7mnS5JpZZiO0SnxhWqi
-4ojI66ajotG3SV9yxiwXPfv7dfDYIcuitkWalCJrnYBB1Tk6blmN6+HD3DPdCTGrnnm1VUyk1lrxYsZW
-PY6Gt0BtV8Qjr56HihL9P0+XC1hWSsRL8ABIk7NBCj6GOMQpORm6XZ7mgAQR+74RdxiJSonb7PAYNu/N
-JmX+wShZLTxZ9MD/kGAwaecDxzrzx2A3LSuGhSqzlMgt02K8OgBPPf3Gcgw03jysYZjqWzXspWYYLom7
-x+/Xih9UvEOkqlRXHK6npwwCGmys78sUQqNu4Gc/oZsaXWmtHfvqB4jZI/2XzwfUjVrAm2s+BCZQHYJV
-D7X0d+xwUVYQ3WfXWzt++M2/0yDaqJKA0225F3ooff7/exAdErTDaHQnnZ5RRafpIP8ta0qd1rFQehFp
-5bW+gZCbOiHrm80ie/YkIzwvcC5F4hYcpLrH0ieQGPx7AJ67kmwqAUEPSXM0cg5t5xgGZD7yQuh3ub6Q
-kRVEW9IfuOFVhK76qWwjZQZZVGGmLLHqzSxUN1vobEB+CI4TpTol9uqi3gxJwMjx0OyO0tI1/O9FWoq2
-t+X6qZ5GhLmwRiDQ4d+v/k85lcSeIusHjMmCvtroG+R8QBXVmA4JuwgxOAZ7S9rjXrn6WOcLDkfWfj1y
-36F7G4ibX4P1Af+0iQQPp4oVTFflVUdNhD7Jd2omKI5YXUlo5P+rQ+atoPHqZD3t6sLxvCMZxxDVZ2pg
-KZ4L/iambl1sdAoSDFdSbC/asW5x9kKdZir5O6DioJ+3uJpDZLGNoqHWsTcffYjWAxE5LF6ac9RW6O1C
-j2PbFfxDZ1g5Kdttb/5uFxHMvLH5lKKS+XREms1JRzoGSgeGylWhmweQ8porwJI4ourH5gzNhLboOW4e
-P2AztrQTVIV9hWCM6XDRyaA1/b7gF9quQW3dHrNfrPxId5Mrx2LcP3KND1+7VmzPZeMIw+5STiKJWWBg
-DrGPgUsvdYCRNdGjcwC2Gq0kR1m1Vugbft3hW49Jgq8DDzChJCsdNrFOdRsgw9Af72R2CA0LXZbZOxhN
-bXb2nIMYeDSPCjg+mPYMfd6vbGpqAVTe1ewLvhZN/i5wexjwCEuUFSvc2GtWPO3dyOfTMhucrVRNjmx+
-Ounkpc3krnR8+rMiET0fDPya4Sj0eeTsZ6l8m2RE7YfVp6JCOnyD+1Bdc9w4GLaDcdCch7FLtrliqII4
-uXzSm0FszqAoxpWGRjj+ABIKzwCPTO+Hwt8khNWnxEppL/34PPlFeT64HfpDbKIuWFGHR6JRVK75SzYm
-ss/vjSTZJ2J3EcpctkLbLlHZrnb8sQZQ50tcid3SnKIkyHQ2MqXcasMsZm4MsRQiMtFmR7kc1HBFt7DI
-9qW4VkcpBh0PN7W+0TNP/R0F2wu9Og2VO91bC8ysX8q1kq/fRR+i0uf4+qSDoRD7Z6TTzRVJajsgH+ps
-2rvYp6T0B5BgwhuKcAC0nwxMjGc9oD+btGlxDdyLVNgnrHVHRI9W1JqmRzvnYBqoYsN9glENNjs3MFzZ
-SN3VjXaycjjJ6Rzk3YYa3WKSpf2r5ByATdHA5I87FFteCT6C7SkBgDCjz/jJuu1gNfX1kPsz6AHDoCry
-ZjVszeErNwFblQ24+iW6tKofNRcDrwvU6rZGbI1Ld5gksBiORLi7NgDyJbWikYJTLvECbGDObtnEngjt
-bjDvQMip+QLZDvs9RZeSoRCBb4xyANrAZQ4Rr6DU+3oATIVdVJ4cCd7MORtWJEbksFgvDYKFr86GOLk1
-d6pMk9eOZ9zDFphd27zoBOpJvA+KXgd63awkNNHLLlyrLby4wCXIcXqVNbjoslPcWj2+3fmwRgwNpzG/
-CZjgZjjG8KLgjYP1i7PJzmBQzynmZTNkETMeaQ6v78gVfFlgyYjMrF4TCNmj9ePoZAYTyXfGMIWO+Gry
-qbNZq5Yhbqc58nVlm+52kuewX+EBGbcsyhwiNwNwqVsC2BNhMF5gbGIX4vfVBtdlDbaiSs2VR462skgZ
-yrYhmJ2JEWl9ugvpU2IXgAFETM1E8qJ4vFXZrhVr3W4rV2wQtudp5QmHmxwFz3F3D575BmR7rPbvE7zD
-SaVl5mt1p5dgLivnBlTLgCU8zSSoFbI5tAgrhR+Gh2YJRI5LOGzytlmvuNQMMfvwwhnfugBH5Nt1eHgP
-QevqyLsAVmzl7W0Anoov5iLlaVkxWBEC/CQ/PycIKXNUy9JyKm6ZRHV/amkRjkqfQj3RCQRKZ3jaLFhu
-IKiKmsZwYXU6nTeyLMtrt0TLxD0OI8ngcvF9Vl38889RbnVikhFK9w6wc+47OAAcA/QFTj0WiN838HnC
-Au5bDydGMqPJAi9kmyswznBH+++f8bN8cj/lf8KUvzBnGD3jOM7//ikHYveT/bknexcvc+xcm0b/CTPt
-5hbOUc70Q+/NHFxC/qLT7MwasS4A+Xpktp9U7K+5IEbcT/MdpvkA/W7sNLMbToudeKxvzdbmN+tt64lj
-fm2if8/jz+rfM/+SGc6kbI6r+IgpyBh7dF/QQwy18i6Noecztuj99U9F8d7eU089jV7C+0VcMSMjFyBs
-FFGAb/OrLnEiONZ1O2SvEDWO8AARJT4rHVwF2TOElPoYyMYOLKyp57woLFcWMLBw0CosjK1Q0QF7GArA
-C5vx9woCZ7ENO7PpYjlw2jK4iGXf3DGeZFBQnTD4CWi5EZ1xzginZ2Ae7nL9LoHNcNYVvoOxbUHTtFvp
-ElaNewiZ4l2MMTOOWT+mpIP7sMPM2WhB2+Z4bXsQwqQhiA1Hcdzp4DK9Ku24g02hcuNatS5N2bpU02rf
-LmDHXtsD7EPv7FCxbMRsFWIRLNU1hZV3MNupAAKyHK8xxD2PIB3e9fKAQ06OBB3yODnNR/3dSKgUyAON
-/sN7kwTA6uF2DXQ5QYDhlVWUxqAS/O+3Ut8DVG4Bms5MrZqsJ0X35Kn3i+9j/bMvtuAnxlXRwf3qeZPi
-Sn+vnEfTUwTJiZ0nGbSq5kgH/3r+MVO1NIgQRb7SNe153X/MrUmeavMZ/ENrVMZOzcpdm24isKcv0tNs
-0PZ6XTU415+g3aerZr5hZr8dRA3Bm5s/fl27tkTzKB2+BOUB7+oed5OmmSu9GSTp+STLOChUGI7Xn+I4
-flIx/XFMsRVw/iwY8SkGQJakPMF00g9mlmlhJQ1bl/xAr7bQ1rEjn4KU8y0KODCvnJsQPPaNN2SBtH2u
-tiGcQo9JENqiwh0hV9baJSBVA+byoQlSyEsFucyMULbkgjTmT8FOeTXqVecBH99yMiKWrROEjT80355j
-ETKcyV/1jUBe91u1jAl5s0jWMRVDsGekXF4O+ZgFG5zZCAHk30WhYuUF5D4aHcP6banI/+jgbmF/c5G9
-ZTFAzElP41VbO/+bZOXUaklOPbEJtcSzG3Oq58WsDOpLx0GDok3dm2ps/s9mKNQ822dWyIeTXQz6h0yX
-lxh/JkCdnn6MCkjqfBjISM8qLOC+xSxgqMYNgdeBpJ3aJtYzWCD8kfaRtMUua7PA6c6SbIWOyZP1Visi
-IpfcUSM4XHz9J0eSlBQTcZtjwu3jSBZKVH8TQTcn2OY2pS73oCGtNxs6bSGua1gE/oGY5eCt82yqcOl/
-ZKTMAj1quAgJ9jCDsebucbX60+SGDzRd09oJe5JV1JTlKmVKiKHyddcWgYukFRm0FYzFdd8MAfmxBTF1
-CPTJfFS9AfR+4oQcjTgmwX2FHnVAQ6JJ06wfs7br0YxQigxRMAr/vl5LC2p6u6QIiBsAlNnWkUILpUu+
-ZaQQHeRfsjjwynmvpLy1CkWrhG+l1t8zzPCiSsITm0c3BWRjiqhwB9+Ntndo20iUgz0GLpF+yMcsHBhr
-/ZBUPEMM8zMrHXdnGTwEDLQbXTdsdBeq5aOOOjKj5UNrmsMUs+x6B1/xG3YMpaDtYwEsqqD2uiD7jXtp
-Q1i06UVLPP286cs02ZTlO3rRAPdLBIv1WqS2aSrBZaWj9Du3GPx7t/Qrt23BfxxCNAyQx1Sh1F1mRjhK
-j0HChhNjHle1Wx+GdmWXXBV5VrJk582+WB5XkoemDehZOUreZmeD2ceO15wOXHqbuSZjj3LHxoBpoYjg
-mVK/shwk4VLkjj0Yjv78HciORT8+Fv1PHou+jEWq2AjE4JcYBiV1VRhS7KlysMC7YlckhZPDW4w3iTPp
-DaStOrYiQf/9lbbZHtCdYuXYh7uuCxZ0QapeNBg5BsU+WuMnZPWSaFM9vyH9XbvBeEJA+Z6r5juI1hPI
-3ZOZjch93x0It5IV4ogV11U+QG4he510fJhUV+zEnGmx6hYaDPgcVWHeti8GPF1t27VXZLqO06NHleMi
-6+aHlHyVS3WAP8ypeoXfrpiz9Yo+da7ETSHF5EjRO2Zdnl9VzwrX3GQ7qLakniiHIcTFYQesxAiDgyBX
-l3J+yyjOrKL96tmXo4K/f29tfr3Rlo3X/Nhsx/Zr8+Jx5A5joewZt9Q86nQCjmA2O3cQwYyP5D2oUVEV
-RL34LPoF2TfkOiL5EVxV8KBzKx1DbGNWxUCNokbzKJXqscR3H6H28XlVD3TAYSDyiPrhBXmgg9aOriIh
-5fHbIHcwi6gmMDtIMjqX+s2KEjN3U0LhXzAPfsXg5YqswBv0p4iWoxt+g5LTjGg5QDloDOg+n1v50a38
-HJv+mrwJR/nPUoqqY9ePjl3/08fOKkvE8p9TV7r7sHmMieKxWd1J7frD6a7snBEX6HQyCUxfYViE9njV
-BmZGwwsVhLCwVTVM+RUwoJ5naitWElzc6HSnND8gOXhV8R60b+IGcXjTjEBGKbKD+kBHM0DVaN54BKXl
-blIITc15juK2fQMd2mkq+QBXfos9lrBKCO1RFl+nHS6KmFamBUKdUkb8T/PMHLVd0WcESgXjHdjWUWvb
-CJWSUAyb54/mKiveNmEeft0O9ADz7Ju21SCMVrMR0XEWShYS6DhRG6AgxZijxMmJpMEyB4vfeQwHs4zV
-Y7hqgDGl9W+jTzCvHejQjjlWJlhktWd6umK02PXOo876Ck887EGx8+xcc46rc+s9sU6Q3bALu5qL7viu
-RmD6UDX0m2qNA+bWSgV8YRXEOXg54Fqe93y0HP6EdvFMnooUB7P5U9/hc5tg5+zrFLG+QCzAXcFlOukj
-7Jz50CliO3hfYI9CR5NdCv12kI+SKyROSn4pclhzpdBjTenqXBRXRp37EZfxEm48DUS+YhKjAkNED5Tg
-v5WarWLz3RGvYEsXBb4C1hOASVitWqmzTkKVgfrr0Oy4ouRWIfnk6bSpy7GDd3hcAWJZi4kRY2NIMQVt
-ofZhfD6quhHehFGL3D9SZEl/1gsvqfCR2rThFpcI6a2Bxcz77KrUZVsd08m9tHehvuhMcrGrCCN8PBXW
-qJW2fBCYxM3QBRyDeMYGQzR2fRo5vgangCpQQcWWIjuynlk5bpgJQuzvsKOqbGSAoLWRXVcCI9j+CaXq
-cSto1kwZN1uR+IG6eAE9uh/ASWeRYbVUfSXgphuTuRwWU38kyxkVsVN53Wrq9IvZtHSX/ywD8CqRdzKz
-S3pLxCziQF64CelNP8pILyJawNgx/ejB7djmsP6NbnCw/uky3a1dCiWjO03dPPEc8NolD6VxJ7qY/Lmt
-N99Z6WFIN8eD2bmRuadCQX43qXzL6wTnlaaOsNBjaRVpPXYvH00nBaB12luCtQdZaXaj6Wo/L9HZc1Ss
-YmbREhSH1X4KwaGu3ycn72svZiwpjsOqoSTNh2x6sIhEAVfIjuhohjgKkmAG24rnuZriMmKSUS+jM9pC
-xDt4XlvZO/g6KS/yofStz88vptNxubW2dm5k/ezUaPDDNSPh8/fZGqlYv5Z/ycqvVygpLmtyRyt9C+8h
-i3W7aumolr6FKe7zaL2eNmQe/q0daDzmGebLneey/M1C+ex44vcFl4pdAySDKudUI/UJOVfkNvpUiwdC
-bVrYxfQ3OrjWukrjgQUcLUwB3Cs04Cg8p/rmy4bk+RWN5itEoBNlvGTn7xWnZbFdHPUwfsFKGr8BrY9f
-iH7Ib1KIR11xqtiKFgE25BKDdju/ll01kCgWaDCD8asg80p3kp8v8t6F+wnJQktJmgc+/A6wgTzD2cHF
-GriXahxBd6QlVtZd4pesRiVx4+QThe1ln/NiFIX1KNnDz72TViODCKRmRRkPHD+oSpAyW6tjaw88RKZO
-x1D3dZCBh5RURrx0qg6UiiWbgTfpyHM4JrNDzaH/KMKG9tnxsefqpzvBRgj/ZqNVby5wClqM9wI5FOHC
-unX8b+DDkWsM7/3EiKXFlPl8vBcBTv1k7nN4J5oNXeR3mNAreZJsthOxVfKccCdxsRmy6NQ1Sjb1p7yQ
-7wW5PPC9c/HJugsIauuXpJT5r89eeJ/QlTg7nVcLuxDCb0RIbcUexpvK0GFxNBSvxoB5fiviXCjTuqW4
-QlZSACYRNsMwy4CspRUwAYvrAf/CHFORHkathbeAnkGE/KjCf9ePc/c0EL98SMBoRtml9QnP2zjFLYah
-qTl7mHXbxGLuNQ0WXt3F0VbKAGbFlEY8BbZyAKjE2DSA3rSl3RU4nKidew7oI0tdIhsFcXHYLYJPDkmd
-Vx2SJ/K8vTDEl0tLCsN4TuN7foDEXEd+tPJH4Swk34jXwYCpFfBP8kMF/wf6nmwlqxvbEel1A+4Pdv/W
-qD8hvwofzrGqcxHDmXGT/xwQIUpYrb2KhUP5G/C3Nv3vccSUzk2GZSLrir+BrysJzeZU0i2KrbFFmQKE
-IX/3Bj0Hzmo1PQXBEqKacMo6rBFui/7EGDVATJVbUUNJ5Z6ODZ20dgj4RNDnRIyrKGkMju3E4Gvo22if
-jX/ZTgtlR5XB2g5y1vn0wKB6MzkSmkKxXlOLWXTqpyEapRP0wpsSXJrYZLOyj6Q5kIXEdjayWuCqb/9s
-x0PP5EZo2R2snBtrhT7aaonXLYVo/prlxfk0jOXScOhIJfDmV5SzSdsNLffY276u+eQfO3sHp+2FskHc
-JkC43gVjmH8EU6A2S8PFQ1GSc4wbSDy2aL3Fus3oh9p/5ujYM/sC6dm4n5IsbOoW/JidNdHPJZRHQ07K
-ERp/yR2XXGMqET3qXcTaa0T7y/BT8s9eleJ329I0WyVcl9G9I0LEU1+06V6GOma3p3cxwC83AoF7uqoS
-d1Knrx25cix4kJp+fONZEf7xdoL95CXeQmbiGYd2yjfxfl3OLN+b3VS1j7mCru1vwh0Mw8D8hvqfqw4q
-3v/HjP2j+dF72LmKhmjdmiu9lYDA+R3mUnP7LAaFkDLZ8Zv6osc0uS3LMtZJdCjNLv+B0R5PkwWCFoNG
-uMrxCMYjKXAcLhQKcsDhmxtaFRlKFdo4fzRVMP2nMZEkao81AQAAtDSxb+pDg5PledGZt+KxT5Mdelg9
-GaCzj4X94NHV5fGcUCVztF5NJBJ4eTCx7brotW/ukkDoM7hc2AQ9NgME6nfyQxdwCOasAspPV0juDE0B
-+dO9pM0B7wbgD11LzFhYT364AoUQLQKSKmwYq+44tpYifADDYEn8a1tpYirvRYmGuuC8QA87vYt0sjNt
-rrc60+IdHEIhFYtZXg+lAB4ZmhvBecQDfaeSbc6yZa/BKE2azZdmDtQrxWhlWzfCZV6rzJW0Gr+7hsZL
-Gfc2D3NbjWhbDxFFvpqDNYMggVTDC621QdFPywuzMwC9YtKHU4Q9qZJvwdhQzkY9sgq6Hts7YzwLO8Vq
-Sr7oHIFmjQECLoFvK9Fo7jFN+wrmVVzG2p46TUqH+iTqvl5bIpAWziRhm1kWs0kva2OA2nZVBd9QuSRD
-a+TcXJJEN4klcbcfgW+iTyWWjSk+nysOU3p7p1hMPUSR7TIukdBeAzXakY8uCi1oiBxBbRDC1Ar6Wauv
-qcnVU37t8at1kkTBGCx/5tcPdud3DKqvRMM7eXsQQZcAdgd9hMcRqRG9ZQ9Dws0R6jAfZsVsqiBB1vmq
-3FajxQFJJYzIN031MjQViZ+8i06SjrWJbaFundGogGwQwK8w7kDesq4/tOHAOjyHxUZWrfEVBATL0MWY
-SgDsp6qht+0VOgyv+N8Xsxv6pQzLcy0ZAq8XsqqQRcWUBMnj3E2u+fDqz6Q5vK7jpr1QvrrwfNpQ/otr
-g/yUNIEGXx3UeyBW6gVFGzFArGHmIK9smDrC0eLJByLW0+RthonuQKEZQZbT5IAyaWhPkpIhZR9EAjiI
-FIIsmP0E0rhMKMF5lk76xeUI/vZMmRhE/6qYMkibmZWrYmb6p26fuqa1I8MRQh0C8/sFpNGkLNMXFG6S
-m00txQj8EXmtDQa4Cw5h9MEnZ3I1dUmliRSYelNzRl6OB2mogDCcEZ2RBMCW6DZNpXS1+BCYj1qAf3wM
-Kzu4P5sWvhsNi9louhVOdqcKygyJMsNiMM/AlDfwA7tNRLnN6Z0LQbIBC38KYwasjbOg6iuPD3jViLgy
-1C2aeSYeDTsMOXc8CeQEQADK666om63qJlybzuQmQGD96evolgfkIamPd8ScQ6b+4yGucO2eypROWMNu
-Uuafo3mU5xhIVDSocPuPef8lsXrdoNrVhifDKqfrsh6QczvpAZJ8dcjMqZyuqrIptjlRH//QilhAHDbC
-MDOziw0G4xvsLpYqqqT6vdH/MXnOZBbFpYd/w8w2ohmO8Yfj0Kjmjyb+Ckr4SZsUd/dO40haN0gdtKTF
-ylirNUuaYIGC9IhIljvJn4VQW/6/L39Ax2onZ/nA7A0gPqyN3z7BnUfJFpBYNs2XK7Z9ayEWOUr8yVKs
-0o85ZFzPNFj4PAm0qHBbW0ueI3ELjT9OrwZFysBO6JbViZ6MOG/j009vnAw4yZfmhAP0l33RckNWwEB0
-IIiSIRNK4rmDMWfisN4nyvSKN4YW6hH9JRzxCIiZvEIpfxY9pul/C/Md/NOCOZxjgEGjr9x2fOeyjiNb
-pRo2qXbCb94xAtyf2h0j+TO3jD97z1gIJMsLJouJ8YaOkOLzg7cp8MNIOZYrsdL0qhGLKbtx23F1ZMr8
-4vI0VhKiI+Ol4U18oxLv7TnzZfsFvx+r39RPeIrO1vIxePA3/QC+Dg+/bftxe5tff4dzuRCQ06fu/97B
-GKfhLAUEiStCp60egF/isZnOyRjjLNFZ5ohJINnqpOqNW7cNnwEHxGIGsK8fOB3VMB3NjBy4wngGOTna
-/KrdinRV2WE81IJPSeP3IEzh95nT9/1ZqfuiyOc2Uo2MG5JCNhWrgO/9Or1QPjESDxd6v9bkoI7pc9Yg
-x7pF3eHSGg/gH4bnSGvY/iJY9ZnVUiZgW8dInaIHOaM7yT5ZM7QhpmqF0Z9hvEtynLPAxjBSLoWRy38R
-gb7kjJN2lfAIqG+s3UkxMJ1Fc/oQGD9UC2qOZuC4OjxGnSkEMxgeRyoFFWtK0O4Ipj9wIW5MCXCfsAss
-RF5j5eHw4UqD3LNpxQdid3klvp1fR59KX2I9WVwpE0rxo2DImNFdPmDLO9i1sGKECW5r38KGuZPmIvoB
-7hsLwfD8771vDP7UfYNASjl+HsB5QELCNyA34mxaDCF8xFLGAIGu3rS7KDPqQHO0z3Fdku/PLrjLWsld
-Li66y3tR/X+mqAam/t9CXFdy1P//VEQvhCKytoaNe2fjaTu/lslG59vOI3kFUapba2su4vbXslNMzuV1
-s9dKNtfXv1vdXN/4Ovkv0//hVbJTXrw3o1u2kx+LHq6Y3UExoxyr+0Ykl9P83Mg/w0ZvM2gJyJavkr1+
-btZQWW2ToIydTbLMjHQ/B7+L0xlYibFZuAJf7h8m4AcygnStS01vYS2hOepZWmYDyDRihNtsTM9WvX9c
-cK+cpg5WflIUktu7nXQv81G/uOzKDnBq1kUJLrhGMnZ5WLsS3mVemA0A2AFtE0BHzCz0oYOUXTqEj/jC
-taCc+92TLtTMJSsWuQ1QSTU6T5F058Sjeno1zew+NTQS8wxCHppm1DBG+fy3fDyG+w1BeGHy6PH/BiJT
-q3GqbfAvkHfsaqBewqFD3lbDo6VxhGeXGHY0J48JRMUj5oZ0k9PTjDOMNe9hDFmRYDctqZLHAlcGuJF6
-cuCp6gM6mXJgLcbe1pVTEbjTgg5NqqB0uyPvqKTvBOuX9N9J581yTh482Nt9uXOAl7HJ1w8eJCNaCe7y
-3ktIw8Dol1lyYWhxwCJRg2veftYbpBBeAGmn9LgQ1f0SO+n1GN22zH/bqtzfs6vS77C4++lSzyDC3ZWy
-E45OlrogT3JAjhzQZSxepZD2SMWzCCsgA8wmk8KIiGzVzvpqeZmOx+Duxay664c9NluMgOeYLE3K9CxT
-pHmzVmuHdSH4Jjj3nWaD4lI+cKKpB/5NEAim0D9OWhW/JjT049bqF2wpp9bkpOlCtnCTOrmcQMq0Pnnb
-wQvbqT2ULnM68ODBq6IPAvzBg7Zk0DH1BNinXPWQfawyVwz6SVeU8lYX4OYwEdVl1kBERaLiCbu030fh
-hHrj+aA4NcqYRXnUN3qiCoJK0rAW94YeSbn7I4eJoCC4nvhapdOTnJpZ9SHQLjLi9HMiLzwrMolO+0oY
-yPAfnBpYOkKvTjr/2Ht7sP/6lSnbwB2yITNjgQeUA4xZswrVJzs7y3t4DmkiqBCTz0bnppullcJEjqU/
-pe9azUc6cT7q7JNsjGgcZi/ElFYUL00amWMNImaD+oSrISPTMP8t2/UM+/CHjeVDD+FdUFD0RFnMXjNF
-H4q8n6y3dGAXDbkEXAkBUzoZzYzY+yF5lGxV6HKw0sZWGCJWvWlQBcikbxvr2/Xt9QpS3qyhzCneb/EB
-qRH/zqPa73AYX68YDAge5VbfrNaOf/9x9ftm+5wNZ4MU8X8/rTGLkQpa5jmoRc45+nOco0SxX4hrrAQh
-eFeV08HVal5dd5CM2yjDIP1l1fAO2ktHmHiQFgwLtYKSj2a04SICuepRm9soJoN+VgJ8i9wy/T//1/+d
-ZDkwBVEz58HR1EjWNqVzPM2nk9QcCd3qTd15yRz/exesN6rHpPIUVmeM3L7dsErFpw9XXcvdYUrj3Obk
-XdfxlZvdxayQqP+oT8mhVWk6Jx3uaFMvUvtS+q3e4lQbglOcxKy++4FLY6/a0v0RIk1dtXzuGSVVvvGR
-3soyP0dDdSg7GdNG3qu2gar0HGWo3bxejwZ1CAxam2AUhFrPZi4E4+yQF8AbBmPRg3lWwfzOd5rjpje2
-+U/lKA2/q+DvMYdpKBmAB0CfTSHpOjsnBxgMAy4RdCceXjmIBE1DEXQyStgTVh/9UcnyRpxHBq9uva3K
-PRT/Zfilzv5L/n8rfuq35SJU8HQK1HxkFkI+ZbMUI/UKa50aKc5qq2Irq/16vvVqvbkCrdDBAIpqddwp
-nuqhIkC1QKt2pzYMjbAnOJkOe8sOPdxFIMpoVWBNb8VTRTeQQRadM8/5ZoGV4+ZI6Rk04UbZkBmPzd9P
-2WDMV1FO3rtcVDov6eVFhpqV3hmIiMvzyeKKLKk2l++EfxITUJ232QAKboldxSi/5rjXGRa/5YNBCpaV
-tf/1awGWzWJydraWld+s9ifp2bRzMR0O/lJmvdVpQUuJGRG6C2o4+NqaqS3NSOavD5Jvk//aP0xOZ+dg
-jNh5+3L1m8ed5C+b69895nF/ufPPk523b3f++2T/1Y97/zTD/zKdXnTGxWVzs518/aiVrCaIpQCFz7Pp
-C5FQVmg3qB2NlpTig+WL/L3HxFVtw5N4lnazokv47urW7TxpkNc5Hg8kNs8IEfXzydOwg97077o5ly2w
-jBmEPLMQZS2dgCV8Wowy3Oa7oD90gzO7efM+TbocHgdpyJDl8D61TCapiAW0z5izVC66C/LN6sCMH+S0
-hQVagr2RuW2cTgDh25UB9qKsuVfmtIbWALR+oQcQ6DXg08atCM6ybWHZ6oaqtl6lBlSLO3mQS2SOEzyK
-FYIIIIeaoeJ5ACllgUgeaQSQNDtBG76sMU846MT3EuINhHzLMYJRV4k2x4/IWLA9EqjRlo0q2ral6t5i
-ufItsztlUTSSEqFfUTcWFdROTqC60qQP0zH2khfSnea8N3+ueSSXwwkm2HM7vE4JsIOKOhJvz60K8pJ0
-mI1UrOvwhyu6zPoCugwqbHTQ/rvVH8ilU/QZs0ngH9tLXiPk7VN/gh0xM7Xuh57m6+puV/qCRyxTGEbt
-ZcmkbyEAWXY2hd1jkp9f4My6mEas/pZCsPu5Pb8ayq95rfaZUDFRCTgFWCzULqWy2IlTBQNEecT7mQiB
-/5e9d01v48gSBX+Lq0ihbjUACwRFuf0iTeuTZbusblt2WfZ136E5JAgkyZSATBQSEKWS+X2zh9nArGWW
-MiuZ84w4ERkJgJLsnju3/VWJyMx4nog4cd4HSwyyGjbJX/Y/+2yftRzR6KtFvNFm+aziE+FYRlnaECPo
-gioeTy5vdu8oM7N+y1XOaFB2dXmQW65xg0TE2rr8bTRLEyTjOLH0lnh3kP2rG8hbHcitj6QPuQQwz76A
-dXlIdJVUF/JAdtBXjl6yGlrmRuFCKDGoUuH1pkPD1iRCy31ol1hWS3FsenENJW+3SsuS3X63WiJSzvIH
-H/BB/OADoLOK6UTi/OFBmyoGF1qfNIaZOtDVQiAUJVIBaHpAYr8FkgzTyfSMkTkHWpA7HF6zhXopvtgB
-JtjvHxoahdDGLnCx1bhg3YKaIcAAuFUYwLSushcluocBdqCOF0HHP2ErrvdF3OWu79NcW5wSmFedDQlI
-ion06XKxQi16Xi+H2SOMOs/U8hkS2gAE7vqiIBf10yG/bVxgcxgbZvBv3mByFLahQJib5M6e4CK3tn2Y
-oidsdUANmyq7AWG9u44hxeOpbwCWhiRwPEtMF5BhAoa65itf5FoI4BC8whgYGNf51MJ4qtGs+f0toexv
-as294soz+dAKC6ZKuY8WMSmeE4MSLzLfWqpkX0fD4WSskOmmn2A9663gihcS714LV4rxVesReX47wDmR
-F0Ofi2Mcb1Ry9SzQ+v0QbG60XzU5Uhi4KAvc2Enetmk/QEXZDOSiSXuBk0VsO59tl/z9k4x/LkUoyY11
-C24kEbxKBBBsniAKNS5aYlmhLzjP03yEV0WZW6k06elYlMQC1Y1HHk3IeIkxcgyvcPn6v1Y4ucJvs8A+
-wF2o6NB1Ty8wW5GJdEhWVB2iUZdKhnVAj8yyHlPsZ0dHR2f9xAJLenRN4CePsuauSaYZuKR9SLGGswFR
-K08Yv16uRotJFNIjvlE5HOop5/G2fLVR7Lom4arzghtYcenAf3exEbzSQsJ7pkbYJ1YhgPST8mX1Apkr
-icDc46jfTpmEojBGeAThSNvDkCu4jRg83KK9BteEqxxkD6x0pE75F0qD0YSBgU9dj+EGv+DmpN2HOtsD
-pruO+fHkMKR4uZITktLjgVW+ye1qYkPd9COZmU+RYwhKBOFsVpVkP0G6SHh5BhM5g+ZzwFUsDHdZxwnK
-8+kqiL7Bk26KfQ1EjLaIfMvefmx8BcPwmPwh+1HUHIhQjluUE4QfMa9FcVGMszPo+IDgdAZkF5C6PJlr
-tL1pTGa0XC7qDde/Kse47DtNqZzAhC5zTg3qSXErer7FlLC9X281LQ4BtGlShlWYjV4Vs9XM3XI9QIjy
-e/ecsByaq6xYotpX+dar7WVagaZiV5WBA0qJ8JiaJjMb/8XIFESRyIUMT+FYdDlIcL8hDryrajg9powY
-G9fhQ/pwkMKYsULs9sJR0fxmLBs17LDTCn+RLRopqjMPIyrUphCLmKDtBIZvQfJnDuxWQJOoEU7QVfoi
-XF8MtO7aOzKrjUunM7fvt4NNFu+icK8E4GsXDbYeDRjJrY9GUb7l0Wg9Gf/rHIzP/xc4GJ+vPxgt5+I/
-/Vg8u1pdXEzzyC7Hu/HMqkkONc0NCW+56vE3lK1o939QAu2aGzrpieI1L4fXxYtiDpQ/q13xaY+r/D//
-x/9JlU6lkpwxeWoxCyXLiXx5y+0dqEKhesADUZPc6WRbzcggWwBDsAWvhMWIKsUf1ax3X7ZR33JGVAhl
-VPzJjcarSNwbLOpOV/iWp3ZsBbXBumvpcOVHqFTNPvig/OCDjMco4lMVqoa0OzIAF1K8qMnKXcgcjIZs
-EgKJfJZbNPozEZ86u44z4lDOHAPBydtrzKG8rLLravGCPYOI2JUdwmOOEXGZYKdKhys9L2TlXrdht6xG
-8dgtqEePKKvvpwDv9jQ1JoE0YSOQKQBQW/i7jGi4Z2qFzLRlt9ZVoWBm4wXiJjyKQK6jpHhC70uHslQo
-sVh+2RRHvKOC0huWIX/Ra+WnWlBswydNwrVyHY9Wsa5IEUzkBp746GAtclbspxwWAcI7q6Dmb8AC/JDn
-G8HE8eNQu7EqIDQSZF1h/JXUK3R+z+OAvCMglc5x741SFr37h1Hhz7nweaqwz7kRqVloxIyIdmWERvZy
-0x9kXYJRdwsTPZdKcXR5uSCxada5XFSrOeyvToac4Siw1eNvZoOd5+jWWS3WWBat2YAR0eSjuLy3Cz/W
-arKeoWUzVUGmF52aRLhxNrrIKB9GF27iso2No/6GoKu3OOPD7EfUPrARLOVa4xSXS/HP2lErW10osXdN
-28mT96VrmtAE1SM8Qb96xqGtMVGDW0/R/8aV4VA0/ETKlUBZwOSb+YqKjZcumPhN30uXAPL5NmAZwB0z
-K6YjCtx7JnM4G2TofAU7mJsjT0kMq4hKOAYEPOlFLu2SCh69dMviH6tcJVRQ4HYwCWfniDY/N7Lo9Y4q
-bHdSBtaLuoQ40XyBgoR4H3BTrZuBHbnQJ2DzPhCBRbgZqPJ73wz37iW3wH4IoGejC3Q9HHvzDQxtOkXl
-KotzxaZTwl3SqRWvvdPhsmIvrHZ3orv0LAdT1WpqWs1kXyB8NlLHUOjaJBpSkjQ1Bo+vzYiwSPGmLMDF
-/eFUThR81Pj/wO3OgczXOU/Flsuh6DdNQSs1c2CVCoZg9iTKfApEmiUQ8UKpsuW12NjVB6Rhub6qaqM7
-QxVYDbdIffHau7lzi0b9gRRxS+VJxWSnacNVFMHnaLFkc78/RhND2mDK80bqSqOkXXf/vFDthruCmmpX
-X+Yh93JAPfQ3al6PsTQPJ1Rr86FYb3+pN1K+NEJNlYswkqIFZQyER48ZAEKci9gw4Sm3JshV9GpS3+pW
-rvLRRPQqGFL3bEiMwQcfEJkOrY+v8vELbquNJ6Dz5jTu2DlS7tgw/cBm7RagQaQZBcYv8XlhEuxwLTuh
-GbSxAZckwShXyEZHu2YwOH6hTGGA2ob0xduMwkOgvhKWY/FaldEC0a+J/aIYF0C8XaymWWWcCX0sBcEc
-a1ZQNfRu4WwzNPr8Faq1VChAQ3qq1yWbIm0GdhO3SsGIIYoglQA9HJF9OB/AOYVQ1G3MILv9LuZ5tW9i
-mjAV+lO3VgSQ/Xinwc261GGsAWTMaK7Zc4IHEpvOHmMgUqZyjCeLaq6G2Kl9uWFLwgI1VsTvQZxf9rR9
-Reg7n/qCzbhwOO+yI9dsuQCEPy+KWYYRbfAEoWr6dShCKe0wyT143Nw7bUosGUtETtw0vGJD43hWYi1y
-DNiC5NPZxRRIxLw8c70qBycf7HiKcr4CIq6+IrQ7kGQs+Bfu1SeWiyJX19USist1WEyMhjkQKfvKCE0r
-YPauCdRxf42YGRtTQTMVNqLmmDATne6//Iul7+QljOB02IzfH8TS3NtT0Kgj8RTOCNkCec+TaC8H7Pxd
-AWDfDVka1Ms+ArBhNXGez50cXrmJhncXg17P9j0UXpj0dtdXxTTPes8ZmKFsmWsew3Ldu+e4lePn8JAQ
-Ivugt3dlpDZ3QqqlUFIRmuNT+WAPfyNwpiNUusuGWRy3hwGR9IAxksB5fWJunq/EioeWRuiA5oaWFt16
-hKIJWZSw0IBtTJIU+igShMt+IPZqUuUsFRU9MIcoUQkpQ6dXi6hbI2FtxAWT4kKiK7hhJi0i9iPk/iNL
-B2HEkxXmO8VYDxjfJTkBikfgp3OFblWcrZUbO88xVTYGkpkMiKe+0sAq1VxxjhjAA/A40OH0sgL28mqW
-MK1hbluIN3wgnM1vE4tX1M+k63bxpbrtfVlVGEmop3XMuXb+9a6ZhIhJ67lP8ozDii3OAsWc08Rt7+rh
-hF3KQrCGgzawvmrVzXnUyftmS9TJBEUReJom9F5w2zUEY0JP9NUQJsS+Au5ICHq3QIRLc0IhqfakAoIm
-b4P/CQhi3ZZBRjq2RnfeMquHrXhVahQrGT9KalYtYLVs60aXwo2m20BWEiVmams0gSsTmjp3okuLdZwl
-Gp5GOEJVecCOSxjFqYCD5OhgpkjIpNWTVj4IBvPt7kyGHHQDLeFh7Xn86dAQWu3xv234KD16YzYGaHhB
-qX6X17gRhDcRMUFyuCTMrkUQ0YZRW08cWmR9t949/H2dQpqfP4T2BCW2EJaGHYTvi9KfNzeY5yRGy/CW
-93OA50SmXNO69zl/fuL6CDId35hhPSd9hG8+PLlUezs9888a/89faG6J8RrnTYG8xMjIwDjmil9r9MNC
-U7LAJHuO6XjZjpeoAs/AcKPCU6DzFe8XM4QNu2VpSLfkLvf5w1uo98jQMFKFRZgjCrYSGQj+b8U8W1aX
-bJs+AwAXqAtFtVfN4jene+V57+5Grgx0skSsUoog/LJybTJw/lnMN5x8KBGlDjdGdcqL4OpRY8PsF6xB
-oTfmS+u+LdR0TatOImcVcSP+om/d2k+hKhU1wMiBBKoFVUUDbiyjCf2A3ZLJNDLIskDuCKukk5iUxC0d
-WQdEiOEWRtQiCHcaflWnKrljjAQ2nSoyYsSYTGYXiJllQ3dktwbaNB6TxJE1MWeKXuHYEHmNklyMiTqd
-At8jyyQUY40pMlWosEuHF76wT5fKbu0VM66Ah6rnVUniI2aPeatV57GXB05DxlQnEbfqBbfAyGys046Q
-nXlS3bylKUeQzwoHZQy6bmRJCOsc36dq+rR/crtr/m8cX8e6l6JihxUjjNrErYqgjU/fAcnNby6MsDdy
-av1RBc5U8hvcZQvr4toIb8S7sV1svr3gvDUggVya9obc0iPynXxaQ5cEuY05AIvqf3lkTg4XeDRESnjV
-zge+iz8ZnSvfRTyYqnQIkGIMeB8zcjnxEEW3k53MetFlR+tWkq8hLu73w9oqxsXwl5otwGZ45uO9d1Fc
-rjASnsgG6xliBTcjDJ6IvlzuXpFTbYJ3lHVOPFRdIftHUZ8LZpEp/eoQu6+z86LEcE51PlqMr7wVS+5m
-H2/Nd7Jo8Rd3IFky/ujWgKy6ZjRzVVy272CRumDhz6lkSPzNCuSwSD57Ma2qRY9K3pOSe86nIWQsZXtC
-5ZM+NCtSLB4QtngPSUDCRzI2eJm4O6DC7bCMuIYQjpnqfoLnNRgmQiwGd+DLQWZWcwPSYVeUYvIqIp83
-Ud8GfuIdQ1JJ7xcTYwFBM40kUrgVJoJYHtLPAy9Zx8d7MgoYpeUNU/lz3HBNi9xWUUpb+95p/yCzzdPy
-rmM97QlBDDh55YO0BJMl6awpbUFtZhDoHaASx3givkXh0LRGkv06Y0NGbLDRc7AVek2Buwtx0ieBd/10
-9LQ5qmhJ7sE6pYbDIUcm4TVShNeIb0ugZm6NSdudkYBK34zt9vcDs+BVXSyNRE4cS8Ysex7nosByLlUq
-Jt1Rr/bdffV84zIshRRHtElDX8J2nF7aB+WnQM7nzHyx2I9TNS+WjKAHrJA+Q4ZHKEWOh6pinw8+kMC5
-KSSumOQogSb2B/aGG4QoX240i31Sbey6Rty914/QXM78zjK/zCUEs3XWIYiiqDJfFmM0q7wEsozCkmaP
-MooJa8lZCSf84+vlFazZ2WJUXlJYV6ddyZ7laiCN5aXgRAJ1k47GGUnD23o4pxJkIz0tzjGm4Z6PjEdh
-qagTkRzTb3sbknoFNQmYgLpe5nMrFMW3XgGp25nfsmLGsDuZvHFqHN7J2CaVhj9QeF94n4Cmc8iRfozz
-Ytrjvne5TbzgaGyc4lnr62TW81YT5azic3rvnqim8MDaqWfcspxVLqNTCuhvLBVqJPRO642u8HhvDF8V
-e6HWzn0cQwi9yscrMmiysWJGlKsYThPmNHDHeATHdjGzJpkuL4LLhuDY7h2Gn7T/ZbUiW3O/JygCHwdM
-PMeP34TxZxH1wtZ/bIJO1oEsvReWsCGWXXvGXMp1l4g0WvetdHuKh9gH4zMjHcah8gLGr9EFNhW0H0XK
-FI+TfsocU4cNTYTstJqfuSWgyVLoMPEdFuq2x0EsyYMYA0ufMe/rZUSyrKQeQVV4fwhbZEp2tUQCx2HK
-u7ViljPdchT2+wzmJaT1y1Ex9UZv50W45EGE4cAQ3scWh7uOPFK5LqIF9y2KXoivBNDc8FqtUyrt5gXv
-EM5sgYEMf4aV5TzkXRrMDGV15xxHNp8QZ+Rm0+2H8tlsG0fg8/gUNCzP7XGReVElczI4pSP2iZI5oFh6
-qY77fRuwxrVPjYVib7RHI1MIAmawtV7bYJaqliN+EBVwVyNkmGZ0/RfqNWsTpOS7FwVCbuDS6oyvRhjw
-Gs0j62zyuhzN0AOVtqdOcJidZiPnhEu4aD4djfOrakoXPWlA+VLEO3KG97mzKnCdc5hsPwRjexdaAtEm
-MHIcAtCjdQu6v92Cki2eEk4hV+D6iJTmZi8lHH1aAqamPd6wmePixHZGj3CiToHW9CJ2HSIqyA+CwjGx
-KMyjm9PnDVVEn3clCd6TPcQG6O27nTe53+pbbmY6tlY877h9wF4mtiftYTXx+YkE70FwtB2XXsEn4Ae8
-M8t1Z1HXJBZA6yFcmLysVws2PxuxtY0LP82taXbsyhjLUroBzjNVLD3WfNSIxGK3aDFoDxY8CEMg+ViY
-+xbRCZLTrhTPse7Kn3+acNf6mRWs0GndeOyxYIIGu11U+eC7PEeNmHjSGj0vDo74fT6r0KwYo+m/mgO8
-g6QZgKwwo7AiF40KRDCdSc3GZQRozMSA50S4jaLGmlzii4zGV1hCyg7pOTjEkwkS6FCk2wUmsMfdwLnj
-H3Jtud2twSkOAncNubDQdJ06GGizqHHDF8fyfCKDbWk2OnNh1eBYBfMxomwT5Q4+B2sCFAPpR8IQyD5D
-mD+JM8DDRZ0Dlp+IGJ6ybuEhkQNSLFN5tuoVx2sXxRj211zH61GxvHVoDkdjLX8uZjlcTyZlUHQtG/Ai
-nxLiJOk+gssFpjTycAH6BIA6WU15g1LKzRVggoulZC9SQy7KPgak7PgF7hZubTzNRwsPggsJ3iXXWU/A
-Akwm3047gYDXjgFx04AdTzjMCd3NqHFHnSWggOWiuLzEFFYoNcUg+/BlLMzihPGb0pmcB4lkAgC+YfaU
-eIPp6wFNB5HNcjm16IS1nKvS3eyzFWrRyHR6PCo9lYCq9uyyws5mkka1pHFkGLH5DKF9hqOhe/+Qm0O7
-UDgxr6tVd5Kx3LpCCwNKzUrKWLpriFAQU9p8RPqefHKZs/yAWzp7I18O2KDmBo2/fVuNdpYLoHp9Q5Ni
-Sald0dhTYJDerwOhvQM1UsCWDAKWAD8veaNmNrK2zQnlGWP2KdEO5Ic508Qbj5ZhDPtAcaINSt2hwgtp
-CAKMqD5Oh2V13XNoJjVE4y9+sZ4Fk4FLI31jEiVH2jcaIqcWCqwkMXQ0RA3pxTPEQAVtU+xbQJQsodam
-F45sOKL1JENw6G7X1XEd+lnYFOsyI4eZ7PB845+jCPD33013XwToTms4kFnZLiEOxW5a4tAUSK9WZrLn
-pQFwmxW9xZqGRks6OLNC7rDd9UvkJ+xnY5A67fKBh19bENd2b8h2TArIi+g3QWJiB6NEYohiy0qi8Fg0
-y34lIYr0HCffDtAyCqpq+IAzl2/OjfBpcLWSoeRZMZthVINlfkYhV4muG2ivLMPVLhmLcVMhSkSJCnqr
-iNRXQa+3EJLA41bc5kZgsZusj2I3z9LCe7jyZnOP8bZBUvxZLOvphMPhc225TPxECGOxz/mcovoBH1G4
-vu3ukQOO9fqtWva2w8R2hjFAbn+MbnWQGlFKDtOhiVPmp9tgKQfnJn5lGnk6fUrI100cAa+DT2P7TSsQ
-YHDpoWkasR0w12OhndviBq8XcSdL2ClNCCEhJCQDHR9XEcI5mQZaLqMob0JmYoZmRVpxXE1yTYJLorwL
-Agz83NHoXpxIgKQ5KtglG2g4+IX1pRcT78Vonji/lH8utrrw1KZ8Z8OxtL8OZj65pGwYkS23t46MvCK5
-fBYkPtFwqC2a2IbszEfpXMsJbcLtkqpFvNExZ6vXfvkwzk7/MSBjMLcC9WqmPmjqTGUTeoadXFTkO+id
-b9CfNIHqDEsTnUMSWYtKZOQlSt4QZT15VISqh0iiTUIk+nyygbcU2VCxu9uPqhcnzH9xTRF5H779zSvJ
-MC3PIjtdJCsTw1M9BUhIEm2MfnoR3SSEwmQfb9xkiHJ2d6kKXCT7IdqJmcQ2ON288xwxI3bls7iyDhWp
-msSEBVesn7EKPTZtFzv/yCBBwrNvPX9PsNYimsKqDY4hDq2/PdQswDwfm9P9MgC4YQhd3AtX1TW3Vl0s
-JRYDsd/FMhDtTUf/fK0B7Yt/jjwCrUoJz+45cYY5Cxm4bdb2bFLTSWFKwwro8cnXsMk+kylVmHPb5hg6
-f025ZUjcBoWHw+GZSFSATp8ViGPVhe5qVH9drmZfrpBNuftG89ceEKxvXETNJ1SKQxb0ulrKJ/Ypq9IX
-wKS25GPOsVt+uOgOsm5R/6jaMX7hGgnTgfn/uqnOsWaYOpfb+q6ClcmlxROCrTfuYSf/pzxGGp0LJxpw
-1zKLJ6SubU6pEXnLKEEzDjZn3ljum3Mehwn1TFHy9DPPRpGIrN0PkjNYKDS2mnUdFxSyih1WKd7n0HaM
-F3jXNN21qkaUHUpogWpOg7Dm3GwQS5/6nPCGJPf0QoYieN3DDRG8lxFQ7004HvvygQcBVSg4WxeHBzzG
-V5yvnkAiz+sG6tFOY8gBjt0JTFkWRS7+YCzFj1QDmARBdmORqx3/ttrQH3yu5DNCCxIMuzXUh9EBBxE6
-bNgPn5U50nv+e5i/yATfbnhkCXqg7qMNwUFQPAS94Bk94a7QuI1R0NCNyGOS/oYTF1wl+CbG3Lwa6tPv
-8r81l0Yu7un0398RpNuC6T8XIibCQbRBw82pcVrWKIna8l0FNjGNtIzO0nRjGqYNGkhnkp75tC1orZ5Q
-83DRVqbqtnmwlNK2cY+flMTuLZDrh+KcJqsI8jO74vTxh4bd//sITmfWJR0UP0suzaAhLmBBbuAh6YLU
-Kxp8K8ePrBk7/zhOrqMpskyQ/Oz2ebJaxG9Rrix2HjH6UvEiUi4sdBGx8avp5x9yPrTldzke1AafjmOb
-K86dk9RBoUoBfJ4weHC703yQBkzgDxYyygdV9tYYJRDJWWevU3Br7TBr+LisA2Sr/8t2qfXEYyWASRZl
-gN0yoPJIrIrdrgkYcb50nOmSanX8/RO5hosVAd/0TgpAEFADg3YIcmcb7iDLcQUUpWrM4XqjhuKLKoAH
-l6DgkqGQ5utXwOtMYnMxTpQQ3sgFxzLxQhsu60IE5NzSUZQVuedubMcD8ad6bachDaZGwYnOuUkyUK3R
-QhXDK2C1IIUq2q3u/Zqf7/0bLC2TbXs/5eJUufe3aXU+mp4ymq/3+O8eW8z1zdyADaGV5S+pmb6w02wK
-A3FdA4OPrbxpGPv+EdHBmqfVWIy845F9kcrR3EyuwjYfLxrpVNwwbpJneFzNX4d3OwtHjJk0fgKOZZnj
-QYfjGhNN86KRciLH2DHVdmHS4caVALxa0ddTmq49vJ0VcjVPtosXEICTl0oOUxDtN50qMDERAWkjgRlh
-qabfMKnU3J/9VH+hv3AQOs4spWCzw8yFSWXQGSLdIq133HqtKcKt61QVZIOOnJjiyQQRIrMgdMzaa2fT
-nlUbB3x1Ph2NX7Ts1WpW3Ib+jDdUYj+ZJXRpx1yx5DYJEAYGj9xiwwwyltFsuXMSYtQm5x8EsU2sAZ/s
-NhAFl983KB6ktD/Ni0ii9zSWQt7Xay46CSgRWmnXMdoP0797IymfMT2gOp5cuNTQo6m9GtEu0RnckxUV
-J4AW0SdUyieiZ9qRMKo5yQ0tUz1uTzXPspa0e7MxjI+t4VXEAxXN/emCRfCnww00mzNw70mwo93xtCox
-IIuLEpSQEFCZt5EPVJrc3wdFNNFWJegoB0Xvk60Lz6snd0Ec7wz1/TWHrsZYAphFUC3aaHc6szflPLH5
-HRd4fr4oZuicNF8tSBVEqKNQy1XSSVVZZ4nMK/BBHZ/nanwFx2XgwiKSTxSWhR0DmHVmgmNnlYTWVqWs
-cpQ4UKG5qDUxYQo1hHzE/PQcjP0rg9pb7DiVSnJJDN0pobBOcigwkQJyeOn0REX9PaU5TNzmQX6iJs3D
-n1tuGHuDkwAgusTvxsxgfKnlLlTaLUQlLXcXOfThaPkOQiGps6L9/ffsbs8wDbfKOrgTRufzkfjYvbqo
-qzK05zwr6q//sRpxykjy6vmHhfxokJ0D3J+h0SL8pL86P+yIggOOoScJ/EAILMcGh9nZfc6+c1+iYxMm
-g++o8ii04lCbepazkOz429FiVpWvszO4x6ZnhFuqejR1bmuYymOYj2ejmkh/YQlerIbzq/nDYnJ0xfUP
-sHrfS/Q4Ev65j25JUCf7q/1sTwLl469zLxqUCHkWeOjgmI/zusbDfJ6PR+h4eEbRGqH+qhRT8LOwYxfO
-8byx72RcrtNfStLdowsEq+MnLqiGadK4RJ32KYHAaHgq5f1WP4/KYS6B86icBE7BdTs7Pn48Bf7l5ORM
-eEx3GMb4/umIkk2q9kesb80V4UshbMNy58mNXANugrPua/rDg3uC6sMVzLbGpGW+XE3J92wufpLwcoI3
-MmPgcw7BxvuQl42VahzJUJqm1GrdY8EEP+WXX7+an3QPfMf8SltB/Ee3Lkc95xyyZk/0nlbL/IANwvdG
-ewWtaBd/dfvJHnlevkcJUgW0PqoBar1LikUUv0RJGjbTcIetgMnBcTokJeEgO+t81EHjMNu4L0Vh69FT
-gAfRg8L9s2FMqdFceGvSz/PD5ESe0rpEEzl7Onp6Fg+OUUBZlbuL/GKav4J5Dm0lwbPoeo1nLBwvvHVl
-cZ/d48N7b+S21L1zfnN+aBt9VCoWCU+w3J2Sc4LjPJGeqxibGDEBQO4xMO5TKNY9fSR8AcSDPN5rAdNX
-sEE9kMJvEjQwguFj2nS8s8ONDeDQoc51x+igqaPm3qetZFs3VoUwPwlhJRk2MtzMStRCu8VERkHkzpqa
-tgO83XEX0FDQmMGvZxtkFXY33j4QKj/i6D9HFgHhnlTo0fcToxm96yqF0jYJyYAbJ+uK7gURsrw/t+9j
-RLUT7VKBhUbyWlr9b52YMu99USbCycBrl8Z4VvumiXHwTV4sWHi5cMeEIPKY9dUjq62G5uX1eVOJLRcG
-FaBUMfQLtbAhV0klSI/MZc3FMZIqbuFa/gvaO3ftncft8ac1rWHs3EDxzWwdvG68Pe+nrHX8/RIGPmJx
-JZweJlEwIxqh8tdjYAMybni1wIOEJLuLIkqFOGk9WelScW3Q1yJ1/mQ0R3aMVvPrZ9lHw/1MgwPuw8OD
-4YdwUZ1DJQzG7Gj37OzffgAk7MgqNUihdJ7kMIL8wmKENncxQYDlUY84QT6J0nrWBRqvXOcsRStzZiV5
-Go5OI4dhOlweN3KDTO3hLuMfcEpUqH2un84bn8JoZ/Q5IL01QA29srYGMP7vgGQiB20K3JD9yOgZtwvC
-lJQXNQbiJVpwIVyzWFs6NyTfnARzLXMSv5hltSeCRnjMw2HvSX+bnDc/htgJN9JkksWpWJ0F6IYlE/Cw
-N2Xfgpbfnfe9vchPJhCxIPXEIhrasIn/DInHUTd4YnSXTIIM5fCYz9vIXQWfX+XIydW4JRKqcb6jqXNJ
-Vhius1xMMoFxIdlTcVmywx9SDP6+s9KbtZtKLoP8H72RLiOgSf8z4mjSQ0zmgIzHHSxskiu1cvi0GtjA
-5Gv0NZX79xwoE7fWQWBnvAe9/52RH4ldoKwgwI8WVLGdPQAyunMXeRCXbN2CrYF1DBNS2s9yHJ8rY/hg
-WYXDcK3EhuVchIeA7Wn1WKdwLn/jdVu7cP4IzaqXefO0Olndtue1mvei0+rfJJhxJutFTtM8WxjzCnON
-UACd6yrBQos8hBj0mCuPzFoRVvg2lFp5mYvG7yaif2AS1Oez+fL1wx2llzv03LFim7LKcmcFhrq83ViM
-CkPEWmukdCm1iU+yn0puFEXQp1cSP184F/vKh9QnkYkVAur+JvI9kgjGiYZ8oRQMWdswyr764Xs1SXmo
-8xcLlTQEVMR0t+ft44ZlNckxLAUzEq0LJ53KCspCBfZraL32kTdaI3gp3GR4mqKKizyRZ4DdmtGG7Dt9
-byO90+NeFLRhnAxQYZW0wAl8iQgqSprHctylwqzrAnYoIc+vlbpHIvduLKHEa5siWxQ1AV8U+weZ2UOD
-zBOyFAFeDk1RM7+Lv74iLWxRs6QAf5HnvWjPMRnTcdc1iHau2iD+VuvZrMsN4i9sEP9yg/iLGuyemDC+
-pRGQnB53ixpZc3x50gLNrRdTG4I/XWWn2Cq7b/yekUzAIPsc/CD2AFFRNtBPi+q6RgEFxTFS67o+uSdr
-EOAlkapFjdJAFHYBgzCHkdCW6TjIdWhdEapO1u+PuomIokCx3zfBxNhMdsnzLu8GfiRq5C1KWBSV6iKe
-EbU0Rzy4QFH7MPsVs0ONFhQ0hPaXMparSzJ4qKaT7OWn4hQE8Njfz3p/2f/4AarVkIyDIs9GF3Bmsk/x
-w2cPPuvrrKWlveEeMal+18MGl29PyuWnfKYDNtYDxUWW2rRP3JnLGifMyp8tgJ40jD9GGSWWVhpFD/43
-/HItjtRS7g64S/H4enBp1vk302okWp4Y7XiVGyNMEj88zFCgpA4/zA0x1URRQ33WDLpuMbhEPr3o652G
-VdeOlQpRe260FeVFP8ruxXgncY2ISEehI4Kg9V3ywhzR3Ylros/stY1ocMNBd+Km9aNjgJCsaTrVET5d
-tQYviYan3h5rLwYnLNf2f9EX23XiU1mZhOLAHo5Xy1DHQVQWBWe4aNFIOTvlSbGAb1NJPYIMEO0JIE9K
-kRNeVwuMcoG7hqJGeVUp7xtsNlarBdk63Rzu+nT6oVuEWzuvH3cz/GVZkNxig8+JOp38tCozBOuiHgNf
-MHxOuOiDsnpclRfTYrz8gHKsawZttbQ5Oz3zy0QBbKQb5zEOVCDGzvWuOgs1wFIe2PcaqHN91ylvuEVV
-LYen2ZHryLcS0gDixerA8u9IW5PtsKSrMjHvGCmzDIfNANSSQMlXrRPbMUSL5nOr+sQSYv60e8nBHhGC
-znJwmP1AzkeaiGy1rKErvC7NkuywgyCJx5abRtDuIWmNWPwQEd5hMrL+G/3i9vyR2/5x5MpG9JcIzweZ
-XeOzNAx6ofiZGw81H4iHwXAxF2UyXo+vaWx/2lzJvCVdgBG2UVRzUy3a6hnqqiVoC/8Ojn+gsaYHKulN
-KNDagAsdJhc6TbiIjpxxhK0eTX9l49pJljtyzhMTgGIWDrdcY4hE8ufxeDVzBtKJRPJt5mtrQmCntOml
-KNKpPzamduZcRQgpKpJYdMRJlCrfxV7VjB+zgp1JYeSI18fTFYrXfIRTrGNgMkPzCyhr2Vms2ghsSi+x
-dRVDYEdROFN1fYQv92w4bvopqf372Qfcw66Ui9NrPaJQbXVxDvQMZ7zqZ9eY1abCqNRByCHvys++6gIL
-xcXoxI+MB/207GB0OlFfiOV6/eElu+9by2MW4bIB9rc/f/9dRugUxVEUPa0ej+YSXIIMDPA5/56sT7iT
-7r90D+AfDOwgHoXdz+nNdOlefEEvLv2LDr34x6pyrzrdDr76y6sHn7hSZ11+9fH9w+6OHA8cxKq0w1AD
-+Z572Y9DsoZzof0jbbCMnhXDy2qPhEoEBbKcmVdT51VKinQygvqa+gk8lmejuT1peaoEHPkGMoB6x/Ql
-DPjFSmzKaY4D5yvu4jVHeUJZHOFu1A1oSBPuceJGwEFP0Qex9/AA+UMRl+BAh8+roux1fwceAxjGfter
-ANDYmTpGuDIvK2FWg8CqFPcxXQ64sctuCzZkONtYwguO0qM/3A3S7WaikedPhxGf44Y5xJ+u4YdSfChD
-7AVDHeiyoMGYbVfRLjH/VMRZFMpSB1uLc8e0FDRb0+1CiZkdxBtAdn2SnekFe8bepMZl2kUCs+HX5DLj
-y+4MsYIg5EOmb6+L2pGDLnBhEHwjsMjS3gdOKmA3sTHPNSZXAGQm3QGI/OVYmzFeklLX0fmRA1p25HpM
-4NdAFSmE1EOxAVHiGu25bDq5OD0BsmiiSdIbBMbRk1fGpg5PFsrcpwVi3JrNUvpi+2d8zZf5bF5hhG0S
-IBaTWnFCMaEU/XTa7+vuwF6eTEIjTuCOghyolNTh3j1fHw5jNzg5XAezevOPe1gHw/wHM/7S5bccWLp9
-hZ4MX//05W69fI2MAIwfI7ZA2SkaHpBBDoV9zf3O4rgXxEhocaCiloIdKUz7aErWaSSs9E0JRSKVnmkd
-vSNyXCdsDf47yPY+/2vv+Lf6t2cn9x72//rF3iWje49xcy50lCol5y5zTe2GpSxkfqVAhqt6Wc1YG3sW
-j/BsIMHpUAHbhYM2YpuViYjLxG4zuAwGOh2SnplrZYFoZoCqW9LaUmADDlnCrWA2YGgfvyEDStlMlhzr
-np3g1XRyrzfs/+97zvIWzhbqjmCxUO+MkjnWClvEjxlSqDOy0xsDsYBxQ1fqmLej2m4c55SosWl4pfvF
-ops4k19yE//2W5ff4S99t9B3C/eq1Fele7V6cP/Bp3iR8w/7+jN9/VnXLVt4fe799tvv3d9/W/z+W/k7
-t8R/Ptu7jEo/Bui03rdqFwWDh0MkEzYXr9sx3ikJqLfxotqVDUMS5Bo2O5qvAUD/rboq4eKri8tuHWVR
-VtThT6JvA/iTcjIly5DzYknYxB5HsshZvKRA8PCqnsPdpZGIgGQhczKUb7glQwIqdybC5gBNKCiRDOXp
-lwdwZ0wnbtOzMC1/RZm/ELkhIr4eLSZiTrAszgvVOPpTbaHLTIDihkFmWg/Mux32QOmaLVN7FGHei1mh
-/+aN/InX8h02kU3fRmnA6NMWP0l+szJP2CBmL4uRR2tqR0HhVxx7KDTOsVxiPR2I0gtAfcvx7Q+FEGoU
-tfhtm/IOaTYLU9mTkI77/b91lfgycJgXEmbTI3Sh0yLyV7FCbSXj09ceGN5duUlkdk5P5/eOuh3+RNHC
-lQQTGA6ik6nE2MDuWodZ4Vd1cQGQMMQi93XviJtn039JuSVlXZ+CPwYGNXi3F5kG1wHAMQ5WVb6Wgs3L
-la2m3I+h0733W9nrnZ4uj3odh1LgR6ffPzoisWu3e6DkJJbr96GGQigIp2gAsF1ndiPFPUJHbf0obNs6
-OfytpKnotoOmfyuDhTXx10gZV8Ed89+/r52REsNSsC3fUHNJ3urS+aHh1MUFUO3DmBOCqkIMymLFg/Mb
-Gz1wvIxT0ta4hNkDDkyPlo+jzKf+n2KkmgxQ8jwfNjHUUJvrG94JEStSm7///uam/wbgihyJjCrr3sAL
-GZM/CXguYBUGALijbhf+PD9ina4TNdOxHQCoBQAdOGvl8shIzQjoUDOOkNyFw33DyxSdCriDhXY+nR/6
-YS3hgvGEN7JueCuhKjm/dsxxrwECxDioh0KEctodZAELeAPUBVl9m42UDx0A+IfuFw5t7vweDKPZvFNw
-uRoMMo/YhirDYoD/vchMN8WP7HnlwsMVUyKLaFyaOuVlXhYk58ZbDy4ALhehfRcS8ChrB42iOp6Hn7/T
-uvXICFubQka7uYG6YexHba2h8x5lHfL96dhcQRRdjl6zXEAdDlpk91Ryjd5ebTzx1jW+Qvp6eKoNeMsP
-ZTSlSDDsH374cSel3HAssRkmHGANrBoGc7WhQCKHCqJ2uTGhdldo+fPDD8zvoBEoNCvG9hkmqaj5kvfB
-DzkYg00SvfJjMnqAX0NPDkBVFPqN4IEBvbjut/kUe/IsfOXizvpVSnl4KR3e5NMVsIMsoWqN1uWhrBqv
-MznFpawngNVZkEc/s0V+mhs0P7PiVevuEZMJE3VBHNiTpg88WQksd3qsBhCoCKDfijpOPcY8blhJ2DvM
-BGE8RhThXGSMjRoaZkoIPA5x2wyBFzk/CrYxsfNOJVRpw+W13zyvlCs9hmcD1ro7G4Dunfbj5marJSVd
-ZEOBtqYCC5Z5RZYoOHf8u8jJOg5/1lfFxZJ+VAv+iy6c9GlV8sc2yxUOS0i2IqJJoBBpdu22XDnvphes
-mpO/UycCe1aVNNYMb3AaHavJeeREL/t3PDPV7wemYn3kEPIl7fTj+yeH8dVj9oFHiJFhja4PJqmu69st
-EKcJQqgjSUDrQIP942GfmmIA8GBJLOjTUPj6FdnE28hQbKDp0TZxsIwygw3v6SInGmzRYzS2idHk8tW/
-Ivc74mqg2VfsIEAGPRoChi4JwGQ5pjvKjf+tSEgk84HJ6ofj/rdnPzwVPgkozLGJNBmNnhSl9u2yorpH
-zaJekeuKPlOBeBsESCTeDoZH33+F/G2BDgp0B11hGbw8lyLrnHjvN2XyWbiMVafVCHHVjlh4YYBO2Mlk
-YVNCtTHKtV4tdyU5x2yEPqDktzwDGn+KjhdfvySf92p1eZWxTn26I6YHsDsKobsawyxUizEqq/L1rEI/
-OHMVc1k3MqIRWH7OHasn54DzSzz/+ypfUH5SFqqjZ3wm6RFDgRwgSJYFlDRiySC0QhHNhL1WANaLCaYz
-BzhBE+oHJ1l5TH5HIEEUhDDef6yQwQESBJ3F0WMMBSuY0/jSZJXM0UKQc36onNHNXwSQvb6hjYwFwkjW
-ynW1ExibifQyMrMEBMgfhqPZRDeW9NP14AY0dGwRUFOBHVx7OzeeOEfksHMzeHNzMnjwyf2DY9cGDrNY
-5ANer0H+Ch1B6v6bHc5ESE+ofaXPQ/+CzB2wzDfFq3zyFVD+PxMRfpRJi73uBX7ZRaZgl8wPUf6BNb6v
-Li+LypYc7s3o3fB5rYV+ykdkyuLKLPCF/3oxXb0KP+Mb9x1WhLipsPrugt5rqVNbwIC5L1P7rijRJ4cb
-G+ITfHBU5CU0Nu9Ri7AmRT4FZKxXwh1ZEJqFxI0gF+AefLtDhER9kB3zNIYUz6T8ueo9AzIip4wN4xc1
-3jn5EniJJYzoZAD1LvOleDDR24NwJ9y5o70GzQyJ14aLF1fszp0bbIhZt2Z9WvMSIyvqEsNLuRMJt9XY
-7/BnGZ81qZEWqIkLDv4yfAK46Zggc0It3cGDcKEl70hPxxcnjnXB1zf4L25haY1CkDmvD6nEn/ETx+ty
-q+Js+O94eIyGGAz3Ol88hvPe6w9J3JCL607vPPzKLYf9u2gu2GH7vDHP+sxA7g6/EBAwUA7twOz2EEv3
-XmdadAbZG7SKwfI3g2Qp3IxQalkdZLIDua8D+XtDEf7CyUinPRpBsutJ8bIzyDhdE6/RmmJvnOvoQdaZ
-Taa7gOKqS7j7rl7v7k4KoO5Gr3c/zPDLuJpWi12UDu7uXi7y17sf37/f2TyzDtyRWKzz/aouxh1gWzrZ
-F1lHjtnaIa6m0URwr/Cv/o77l+AC+w0BdLOzI9ht+GixJPn7kZzx7oheIBX4KPpF+MrVm56vZrYaPlPZ
-4AfVQWTcMXivc/Dgkw8HnRhndg4+2h90CHlBiX/9TH4LIuscfPIxvkEsAp8/hgePxvDFZ4Tv9/8L32+F
-7//b6en9I7lP/UgnUxrm4MvVclmVR1hoyL8HP8OO/gYPN791j9Le42q6miFrHsJsyO8PW+8YfK/QjarS
-v9I8o2Eoo/vPvUhcO3Ig5bhyyQ7cBZvuIiQOX+Z8Fz2i33wZwV30Fet/KHBv4y4RZEPIkS8i6OMEjxuc
-uRtuYO1lxoIviuhFjSCaP2AKm8IiDfEjZionud/PxRIDoWPBUT0+oOtkEPZNj+wKixYs/BXB+WsxWcKr
-+wMZHV1TtqMaKDk2Czdvgap9Mnn1CA3V5Q7AAdFthLJGgnBX8Msdqreao8O/ZMMzLfEQCRc5CVKxFDBR
-iosScNqvxXT6Uz7OYQViiJdiTZjqB7+ZHrBJt5INmFN14id+4Ta0yuyFXi/pZebb0ZAIy5hEwNB/IZVy
-3B1NJoAUl8MnXw1/efIV0Qg32iNu1uSecJcs9aYDo5HKfTsUQQmy8ZjMq3tCHxkNDX/84dnPve7eaF7s
-0bLusaVFV2/QQYMqalYczyZ7OMBuPxgzzOfWQ95yVBYstLUOrCwSs+Pb5YhnkN7QSrpwKnb6jody8kpa
-ZFKlffanUPEh/P+oS+YvUsG59W6oTDukO3D9AvqTfmU/SHMIYM5+SnyMghq558ntIeGX43AzYNgUaB1w
-3MoeM21xDPcOXvRQywkNeky837lz0oRPs76ciTagnNiW0puzdf+k4MhY1UGJKNXtthKfc0Z3R5mpGGKh
-N4SO75oq8OImsVWCSjIsbPRm7T7AgnTDpSexdn2d5EaxtIYbRvyNoShe0B3cDa6E9mmzm5/sFTdVNJnE
-0rvwm0wn6WGSw5N2a6IPy6zYFgWNWP7ZgqQFULpPfnb3GDMwcsH+bK63BF4qHamAEXS/+uH7p9VE2gTy
-Ci4mUroeSmm0aeYEpnD1lvmC2s12s3LICvvv8oulucPe2Lv1+ia+zr4qJt+jcV1jYNIFnAMUWS2/I3oE
-jgGcLASHHg4LomCIKZAcRmC7brl/GxfuL+Vs3SAX5AB/q3FiFzyKg8iZyd/inOENaxc1ASmf9PrBptWt
-47Ylrhf5LUaL57HeRGHjvyKYdNX5lF3lxeXVMlrob/nlbjaRlf65msPTpw+oCm93EVT03nALBxn/venb
-kYpiqlGJJfhcjtUOQdBIf6BU2M8zWiq5i5CLqDP+pifXg9ZJMxoiDE+f3Hk5ZKxfoI+A4aDNPIZMSBr4
-1qwpkw9DTb9j5Q5wHcwE0G7sGL0adZ+JEdlrCNfkJQlUhkTqwsjkkVhK80hsKT9/NSQlBHDffFtRnsLU
-yAKhxHJIN84PF726D+z2bgwBMvMn4heaY1gINhSi1+3DBKEcrpLgYzqeHiDY3Jev2wGinlkCjgWZKusI
-b9EOLiUPLpIX0fr7K1ZPD3d4rDM+cRSEhUHnGdkQdLRJqUiXt29Sgw8LhTDp2+KT1iW6MdOfxDd6X3c7
-+ubj1dO33NdQdIy9fsCT+IOrjBL/vfHMAjNMDno5YrvmTfRGC9J3wC8LQC6sTrkxN9IyEDs26bUGC+Fy
-aTimcfJqcwsh6YSnmbL7URvouvM4R2YKJQDBeOrlQuJZsylLs/WNYrNUAaHRfqZUam+w/wPqQM/zDEDU
-N6P7Nh+lxLMtFNmakUnHT8ZosfGmZN5fkqrnMGQjwWPAKzXV6+Koun1AuOVjpIRMAf1200ZTzl40JoBd
-D7JldMN5rCxpRYUECq+2NaRbDAq3B9Dvgg5MRGPdAnYJKWcEI5FCJiBEX25kwi1Qou19uz3oLj1EEn7/
-LCRiWDekVNvEFEELguiMLaKpW1YeN2qtbky2kjh5HQDJ6OcgezPHyNyYRq97v3tzI3LZZMV6PipjAXNZ
-XQGptehgqJ24vf0H81fUJA2yf9umueGbdfJkkfdlb8LFdty4YBpYLThqLH1y7SUbTJ5M4otHi0V13blh
-KXUgrN5hgTVjCaABbo/EiKGdjS5z3Sh+Fz3B17/89J3ejfgYSRIdEIvZZQxDgvwu1cIlWowthtO2BWMY
-rm9tN6m1Mv0YzQIrFT5EpUL/ttuz2ToB1+0H6kt+W6QNZd5qo2VzuCd2F0gdv9WeM5KP97LrEoLOh5kI
-zpBfRYHEht3I6pE/904N1ETUf8frwNw63QzMNmTS2N20pJ35Txw0dr95zFiq76UdSFv+EWN+Q5teR/EV
-/A+NzH9Hq3N9hRl24nfKX7gx+1c6ZsBmj/UM/G0NAYi46dLw755+U2R1KWQ0ktmBdmKo9slGqMNfGjIW
-ip/C40rrwCkBrHLBhhqVd3DN3lf+0/Eunl9P0Sp8po7eXe+65hIaZItRUecTUXxoVgSHE+hq6TiVaef/
-/r86b4N6JpO4I7StQ/mI9gNFwm7QmBqjYMmbXmfQpPE1aV/WEe6j3zGIhpbwMCJ/klgrCdvllYAW7W7b
-S6yh84StbSOG+SNO/i8dq8TZdPOY5aYJrLlNnGLRkFPXLFmzwHQSL+B2HtzQcEkKrKWIQ5PUf0FN/oJT
-4F+ddTQUCdZgIIv8Aq9M0lLril8R4f+tyIA+uq/vAQkkXypCMIfefK0fs/CtfcO4og0Uw6Kuxgetsg56
-fjJWlKVv8Q6/mFbX/wF341UxmeRllz+svcxZ5TtQ0xft3wODaIt/R6MP2VHuA8PUI3xRIjGP1XOlu3/p
-uoN3ZxxcEVQj4Ddu5D5/iyE/uO/HjAHn/wZAPsg+DLp+HBDuaA/ZSc2TdbbbzpNKr59iTBW/wzQ//ig5
-4pkZcHIEoWxh/eQ8r9/rv8NQ95Mrsp8Yv7NeaZ9Bk5bbemU3LKB0/ifNVCxu1kw0Jv/e2zyp6/c1ze2P
-Ewse1865ST9uOylp3M0qwQjskM15YIvyHZvubWeO8h3R8JstUlqsIzfZk2y2jVxDFd7+Sv9jDee8RVz7
-NS1g8oLd2IDzJrV+zpCSKQFeLrFuRYLnj7NubTNKJeq6uWyRyE8oeyOyd5qAY5bUm+z/hnoMTDSpnGiI
-mj2utXuV0CrW5jXjFP1zZ/AqbVEoeqzKahdr7/r/HxNNmvi6ITb3p7xQu64D2m8325prkkuo37LWUpMR
-cmSn2SivNppC0HsTTTIARPvMB4M/y2LzwbYWm+1mk1sZdLrTjstFgMhriYdjMhrS0RmXKv01geWK0pTi
-k0rPx8WJ7Hp1tFRGHP4HLd07Yq7QhM8hFKQHYFwGK+z4n60uEy3c2crewJX4ljT1i+FqDidkkn9VzZz5
-2xpZAdmM4kTj/5OLtaKZwfiqmE6gGTYU1acBOZrwO/o5uMBIvEV5ye/0aTDHJKILqS0PA4orVR/1vARI
-40e8oth7VQnDFPdVSUFO0g2KiZppCj7j5hRGSz3E5VTnaReTj93G0Ukdw3xTKG0A002clVv80rM32Ai3
-y84oOkbNRXDXfnQD5+99ZDJrl0+Wq3JMypsb7ye3PLzp9xAygzcK2IN9Bi38VRjCTwHcwf6NsyHgXU2o
-EbYN4W1Ch89r/8KpVbQp3dqyj4Pau7taanc6Os+nXavPojFJbfYxuoXoO+jm9JTqI+rlRm8r9iasapof
-l3wZrSNfinK+QjErfzw9reH0jia9daPUKgp6/QH9cFy0ur9WX0Mw3AAJLnODMbN49bVFgswaGpXFXNvg
-FC7ZuSUq2AoFQEd4bYgJuT791+Fee7gVTOmjfM7rKuc4fFoU8/k0380vLjCU+6G7juwFaBrZ3QWSQDbq
-VrK9c9kpjUPSPHCqVNK5ZPpLDyH3u2PZSIVBYlcjteLJDiIjPnyfjh/v5qGxzvfikfhje08G/0aYCMki
-LTEMjnfuqIB/QM6Z9Bv2UHkJX+jYo31/F0/wlKhAfBDFRE1PyAit+CcTnvAT7cR3mO43Y3EvCBLCHUQD
-NTzBSAJkOFfAsB3n+h1MjL7RHkHqt8kDERpxjJR2YexvGP8jG9UwLjTMElUgnpfV/4fu5XJRXGL8Wu9i
-Hjr1e7pbSUu0ODZQkkcfVHGEgtTzaoLJcVbkge9ISQpZnWOcZS5FXCN5gR9k3XnF6yUSCWAMaOjdR2NM
-loxh6XCM4um997zGzB9U4DEnudpF1JUsphDCQR2Q4/hQHcdf9/Bln6d452KIQT575uhgptA691Suvhny
-Nsq+OEK5KKK8+Mvn2Yf374e6KcDBswJKQNFq+jL3zcfa8UZ5SuWJ6JlRc9QXEr59u1YwEQoI5GcCR4PH
-IvuWqU5GTtTmAZITBM+fyUzJBygm7xhCN/gPXR9mWRVm+i6g5i+ImJ9UY9pOCdNewPqT6hrDLPiRUrNk
-o+Dq0SnXjEB3YQvrF1o8I6jh3ulSmKGdnGbDzdGH9HE14cYpX+iHDw4ougAG9MPLYIY8RneOTut0O5zD
-+UTzQi78CRWe5hdLU3aRv0wU/YyKkp7fl0VD1KCsxNA7iIYeOUwgpwR/Ear5EPtDhoZr9vRM6kFE5f42
-JA2W20DQyI0KLSyK0XQXrQzqlI1RkGT4TsjgpYpFtGqa1kxcoUg0GoIqIArH5U0/sOom/JuQSxaz2OAk
-zUVCuQ3gWYaSJJTmZ3vZfv6ZUiQoYzCxyJfw9eP7jl6p469/1a90uABz7CviwKKd+6hLrbeBnXAPLCSa
-DbLOAdrktJIKW8gj/vXd5RF/nhsnteJ9KukP13xaLb/BpBVRieA1l5TTFRW0b9e7b9ILkShEQ5G3ChHO
-jGLKyBuZzEbnWSr1N3Q4DguRD7JxsBVZvClB3vO+wI9CLIWFlISyBYWQigrKWzss51C7nT+t1Ho0n29z
-QqFYoC7YQYo8KXFm0UomhCHdZC0ldbaBdHpNeb54m6VlX41eFpccmNi/pGLwfLKVdEqF9ovlr8963vMB
-Q6UcSbYWuHuJlupiHv/6YA9vjeFlsbxaYdri2d4iB5Jqb/a8OAciSPYOvJzmGPxkD4PaoVYOmgmpHiBy
-lGwZIvmE/gsBVfTc+ie8hOE8Hy5Hl6eId4f1ClPx9vZFxI0IzX9FhpOH8d852psTkHvnFhGL38GZGiN1
-JW6lPFuwvWT3ujtTOH0HMIqr5Wx6ulpMuZkbGwPBmqJvoy1yeiFZguQ9cM0+KNfZr/n5s2r8Il/2ute4
-EHj/iSMNhpyg0ERXFXlndPeu6z12H72uh1U5w1Swl0GIpTzpK/MGLuES0IOa4JhAFUhhEFVLOb16YhZ1
-qDoUIfmOJ0OkkFU+eyd63ZsMv3LVAgc9vP0rYA2n1WWvAwRpTbENqTZcL1y91VePZzmeYtSdozR7k5wi
-5d+SOdacIQOOUc8eiwHqS++3mVmzGNBwQ/ZcuR6l1MTtD7J//Dqqu64qXrY33uZtJUjL1A7idPjdI7yr
-kBKSNGDINx5m3aPPUWXFA8g+tdSEDf6Yc5X8OFIXOOJc+EY7Iv6IXHWoEUxvt9eV5XBDksx0AcUhHx+K
-4NPqjdgYjBNECCRgs0CHafcR9hRpkrMpp9Zl9SIv9zTcVHcLwTza6QA213lbD1S3v2S+UnQbG703BWzK
-jlRYb7+bqkGA8tXudBgPZlPECjIsxL0U+IsCji86ay16O3O1bvNtooR1Nqeg5ssqw0iq1O5wOGSzulQE
-FA79g1s5oO8ZXlZsTdcP09CON4yLEpHNZ1al3JsBmzDrvy9m/e0wbjFU9Kd3o2EkFe2sDyaj0vhoiOjw
-2hzmnQ7PGW5otHUESBhPcKTFs9AEkiH2tYqq21cHxrJ6AivroxGVo5ff68vQlfHFZv8WUeJSnKEXgSsQ
-yddLR7ucnuLF2lFezuO23kt+QL6rgmuYnzDy0DDp+NJPbK3HGM9pNDWOB+H3X2qM/T4LXPJXpdtQ69eL
-5tZZSROdLSbpVzExhvUH8XzROInJciMc2RUbT3Zik1JGl7gvj/nnSSc0vWfayG0FF+piVSZubEKDi+KS
-wsM6CkQJdaJCiEZpEicJlHvomnRBCzoPF8KsHFF48hIzG/zy05PHStb2uHdDBRqwfgud2aXVYAgoOviX
-K/lIsSUSDafasXRjE0Lti9HYJrw6yV1K0CHIkPZaIoZss686sKBF2ekbEklvcWE66sT5UKasTkZoa4Rd
-ccUNOjh1eTIbC/hGyx9gEQlatiXO6Oiwd3Htye/gbXCIkdErdcPoxLXfScQ24/SddxL45Y6D5vpbB8aW
-Uiv6IXtcIPBuQcvMkiSWTgTzDHd+uK26F6YCkIockjp470qc7ANvyL8J2TRGNsQlCdzr9QOdpmCit7d/
-E4LnltZwy2q+O8No6mv10C3GTQwtVfSZJwHjrpjqLEbXQFZFjgm2nBJrXFI0gdsRe+kxnZ5Kp+vds7be
-B0I2djZcNbfY4wZjyosNbW89VIeSzGjnHk3JG955O2HPyY5pe6yB9JjVQJtAnVivOTDgu83qd0R8pI+p
-5qxgb5Pcut9XCDfoPZp6+76/qKplvtjC/A9hjgMhcsTgrZ1AYczhbjwJyWGI3nBASVagddmHGR7Ivq+b
-IdPlSxiVqpZjQ+E6LkkeLr7U3+kxKjOSiIqulIZYjMtxEEVfjJ+x1IkqJwkA20gRueSWgsRY3LeqW+R9
-It6b2Xhg49n6yErpWFlG9bN16LyG8EpH25SDxKzxswq4xt9/zyb0i7zt7gZ+cPpaBq16RJYK9Hw1d3VM
-hqiW55QYhmfsUzyZr6ejOYb9RrXJx4cN4crESzwp6AlqowhKqZhM2DNa37rwI+FrdtlVgZM011qObodd
-1mDhs84m7nM5YmnK/ahhzKL20ZcfNxtwOks3BPnKC5W/SMtK4nUiDjsVEYhofk6gdJR96nwV5xXRQyxy
-4YyK/wHz01ROe1kvFdpJPluNFTb0QRaNJC2zwe2LM3oIdYiOrxGqZe2kN9U8LwkTpGVsQHKXdYFv4QQK
-BgkFP89CeEUxv1qWSCZKjH8gT1zygwToMW/IVNjJHhtBJ1wwKAr4/agsZoQDvgFa1UXT0tE2qdYBWg4E
-FCWC2W0TOOuC1b98/WTS6yJAdwGg3X64tPcTJ8IdvCgMlB5CWOFUaSajqVU8mdFaw+rv55/qePEL5nQh
-zxXZHrDIf+1uFtAt2YWesLnOpZhdtpzsxKkeOizEsUIvqvSJdgJynGGziIvMoydyGynVBZAJv4p/YPej
-+xzRAodATTIKATRaeMTjRlHQjKE033eDDDNM5Asnpy+837f6FozEYH2jMX7kU16wO7lp0XPihXGJVw6c
-R4LoC3Gf4advdtpryTy2GV7spx+Mjxt13PEdnfStnCEcUO2E/EdsMuLjNuKLO5rVjLZPURO/zlknr0dz
-yt4w0jOPLeWvsxFmTqLM0OPRtM8Jj7QpsbSirOG+Hlo64g15fVWMr0yeUu6UgzkOqQlGTIBsaa/eNY9e
-QgPtjlGdqJtdij/MuvsknX8ga+v7x3QsgFkoMZZU9itBu5Zz1UowXucb2/0UpZ8DwYPkvWZfATogBI5U
-23ldTYFMki/nFTBVMwoGwy+mLE2Vx5vwOETRSe4AntgQnEQOKg9bIpIUzWAkocBsTbPh+ddmYyYkHY6E
-pT0OX7OqIbnlcInY9giXieLBjIsFXFWnFwVmmuhqI+PlAog/syKtkBa4fnT/r10NiFzWF9UCYU+/UQP8
-H71dKNDnEvHSuLX+xC0sUt6PpsUldoc6n5zsLf0cz5dl2+gW+XTElpvUUDXfOLj/YQbnemBS/HYgSI0e
-v9mh7H+spXQ7u8FR5tdnaCgGb9kPkz+oS7j3CA+GSjZg2410oTeKDqIJe2r21iTH+3VgJJUW4ouETN0e
-AbgYGyJurLa93kzJnfaOqgXiRurqxjPRcpq3mItT6qTWJJOtwygtsycj07XC39EyZYnzsV6RFY3G7G6j
-kjAxh+R65N/rBSZhy34zrheQtGjWHMnu4nDpUU9HIWpxJGkIcAIbQ5IbEb1/OmO3Qmmtf/v5OkS5fqfu
-s7fj7QECTA5aqc2hKAYnaADFBUjx6P4nKv2+4cVj8KB668mQPWtqKu93vPWLYn6KfRXVqn4fw6Zrc+0S
-kAb4vc4Cb+h3HzpZBf9JEMe+3gO0AelOqtnWO55Kv/f5XK0uLqZ5AzsYAn+nIfAkIaLohloluT4kH7o3
-k/caUAKdPdQNspD3AK0UeRKpVqyhKGpgtBIaYfrQCDf91gbiYTjGjgdCz3sHj+R12D69vWXbddx4bZsl
-81JJb3OLhj27Jw3TCxi2fojGTa9v237d6CA1dP6wfduB8pEb11d7Bz/6j64ffedUsLfoS6XoQXtqTqs/
-bjF4ujqTg6OrG8mk/g4eCJFbL1ZinVYP1Ar5WyhdLV5/J5YBXp2c9Zyag862c+pxSmU+8i7JAo+XydGk
-Et619yZS88J8W8VgqAXq9tX3gR311eIZHfPvo6u+2C3j8/5gsyd/YOyM7z6id96uGd99/M6+/R+9T6e8
-jVbdf0Lypa3TKm1tBa7OGn+X0HkbtTh/5x2/OXhM0sD79uFj3EZpBJAhsfL6LDDHLi/NSVpwvjYDTD1K
-JO4xmStgv87mcIM6c35CCh1nfHXX2Ny0JBOIbEnYZdFGY1ERelVeFItZr/MDMMDXC+COYc9CDUryKrUf
-dvphpJam+YvCpWHNIoyGzyEUWrW1JxC6bSIgh9oZ5qR0Y4uvEPb/s0QH4uOwzrHe0XaheWTCjLJpJNlZ
-b3SyVdO4i4mGg79rB+opppZYRrJJKFa1o/E5JKRayCdIQee26p1etlIVT7cMUvWH4RmcizuZB4B3drxe
-OI176MS6o/oVp9VuHs+mGnF7hJU6P40YSw7W/5OeqvYJvb+DNglX591Ohkfg7UM/GWQmQvyaA8Mk1i0p
-qsVtfAw/fp900f+XyJ73kE3SoStlBrbCV56v2AJhaeFbYyzVKrM9yMtRMcUgFoiaSIItXiju+Rlscfbt
-6V6SdM9LtV0yQUn+Mxlk8eicT9MG5TLmCvZRUChzsDkZbpSUkIbjw2EZDunr7R1kqE8wDDURKJoZBwsH
-lpM6K1d3AqcNfdqV7KLmOWyt9aQYneMw6SMSPz58gkbRNma7lJIs8BB76VW38DvpSnOn4bDkF0A7uVHT
-6chnqxEiCUZ7egr/dr0aOQEDiXsXjN/5hBf17siH5lZz7XXezNaGlXAnmR9rB4FYF2OqOMxK4LoZ+KE0
-TC/4ja7UVl4qjWsEF1ADRpnfQZiZtzMdddDePR9Zy1F835R0BRe3wRK8uZs5vOVYDmwsdvhSjlEIEK2e
-1DYFXCVjWe7PKAU4TNolSpGfqms1IzfeCJ3fSmdKrq/tQrN0Aw3V1Yiej6DEPvG/7dlNmZPoWRXnerdt
-33L9T0/nozKf+q29fr1/dBN642eZGJ43k0hhruNEjZNUsEQxjoGugJipnY/BXUw/9TVwiq8taJ5ggCgq
-1w9Ma9icvsGg+eJRJrWJxVs1unMXkyHcTcWy1/2t7Ap2CaJdpsG+XDgPjXq+waR3OXHkYD0/vn+ywYI5
-LL5/knC0EcTooXdbc5Q/mM97RH5o2ROKEUMDRCe/9eoxDdje6N+HsFQkZoJabgI9BuuJM66vWdGwXFub
-60d5eooRjnd3y6rcLVezfFGMCSh6qIy9+XvugdyEVOtwZztlSmeJQWoaU6ej1a6/0DO8bdKRDbzS+9hz
-T/NrJ5xWEOsVyk+1Q2Dh4flThve1CqA89dtf0/G7nYatz8KWJ+GPPgd/wCn4Y9rmxKp/XOsXsB+vItfK
-tWuaOLyO3jHndaehbzTBUrZm2Dq3sPHHbpVO0Bz3LCFG3/2BkQ4lBcfeFXDHTCnICuUIMNuVIQLECVKZ
-uIR0SBrYY3FtW5r1kE2hpwgIvX7Sp3gNKxhn5rHBF7ZEqv20SOwkICxD2YrtJMz9nigX8X34jvKHB5wf
-idVC1s+5BkiZeWhTKH3UwAS5BK935sfQMAYfbCbpTPOKcvqcYk4ircheInaM37SzkFvkniLyDkbWEm58
-Tfab7icf/bUbZLphUKWS3Eikd4QAbD6NCiw8JYZIFcXjjWzRfgvDmEhOKcD+4dFqeeWtVOUtcUOb5YPs
-VFuNVoTVrLRwPfcYu3gmR0RCfA4D0WnQF8YC1g55i9GS9D4OsUBX8zq5/yDzIU+tT0uoE3gH31OexQaE
-DDzobXAyFOdwcHgxNfDMGqTHNbqDbTEsQTaKY+djL9IQ6Pa63RioynsdxNvJ7GOap50zu/29npjM+kv9
-nbuRDLDvvYv2495ik8WbbPtEkFvZV4nWb3si6U+bLO3m9zzbNFGYQCJbZYW4lebjk200Hxik9KqYZRiM
-e0Wxt2APYqxVjMh9jtnS8gVjOH3foh9xqQuFjGFp9agoC/KOkphPRHawjPDvnOfQ1VNxOGaG9xkkcLeU
-v8yfwmX9M6wVIQRUfjSbxrd4gdrmjWg8k/90jEExWK7xaNnj/IrclLu/gop2kFTKdfuPlv5ooNRLT1vG
-sLVugva7VKPwtDI/25TqUn3HGjap4U0YgU16NkDD7Q1w1kZg3PDSzoFrXF8V07wHb+w4LOi01mEDwLgJ
-9B01k/Xu3TNA/DyLmk0tYPw97v7YN3hCBmf9w6D8zU7zV3Ih8b82IHDVaNacGah9K4aOoM4HFJZeDtKw
-lMUxBHiGJLvOmVyuFpcaBvDRYjF63XPRs2WEmIG+7zd/4/MX8NnA0CRZOcr2D+HP51lcB97euxcDHgdy
-XGBvSOi7KseFWeUbAy2GIlF8OHiMBIBzo/DfRPHqiC24s6MjGBWF+E/tf7O//akZZPf9uYIdDQjt5afZ
-tHiR15gGZlIAYFHcUpHOrvYHzw4JIKsd0V1wQcGg4N9D/45K0dwXGP/tZgcbMJkQFlpHVjJuUIKgM5Xi
-W8Q9cej2hHP0FtTb9Z/kjTu7+j4vXwoK1jcA4pdyAPWVBHbJKNcORpDGUG6vMw5UjvHcRi+rYgIY5hKw
-elbU9Sqv48rN9D5lVc1xnmZTUx/43gxnMtGY3I1vgHfz5suLi8Y7JkVa2+HPj6ZTLVE3iuSzYuleeqgC
-FyZn162cmrLx6mnuCg6O3o0rFjU0uczq1Rxvw3zS5QV1HYyvJ/G2yDSb8F43M8s2vpoUi6AsPG8cB9dq
-GYUWWs1GdaDjNaO4f4iDQPrj5gT/GRw/+ORf0aTt/w0AAP//JDSWSsUsEAA=
-`,
-	},
-
-	"/": {
-		isDir: true,
-		local: "server",
-	},
-
-	"/static": {
-		isDir: true,
-		local: "server/static",
-	},
-
-	"/static/css": {
-		isDir: true,
-		local: "server/static/css",
-	},
-
-	"/static/fonts": {
-		isDir: true,
-		local: "server/static/fonts",
-	},
-
-	"/static/js": {
-		isDir: true,
-		local: "server/static/js",
-	},
+// publicAsset reports whether the request is for an asset of the web UI,
+// which needs no token. Browsers fetch the manifest and its icons without
+// cookies.
+func publicAsset(r *http.Request) bool {
+	return r.Method == "GET" && (strings.HasPrefix(r.URL.Path, "/static/") || r.URL.Path == "/sw.js")
 }
@@ -0,0 +1,69 @@
+// The service worker caches the web UI's assets so it loads offline. The
+// server fills in the version of its assets and their URLs; both are empty
+// when it serves local assets, which then aren't cached.
+var version = '{{.Version}}';
+var shell = {{.Shell}};
+var cacheName = 'moggio-' + version;
+
+self.addEventListener('install', function(event) {
+	if (!version) {
+		return;
+	}
+	event.waitUntil(
+		caches.open(cacheName).then(function(cache) {
+			return cache.addAll(shell);
+		}).then(function() {
+			return self.skipWaiting();
+		})
+	);
+});
+
+self.addEventListener('activate', function(event) {
+	// Remove the caches of other versions.
+	event.waitUntil(
+		caches.keys().then(function(names) {
+			return Promise.all(names.filter(function(name) {
+				return name.indexOf('moggio-') === 0 && name !== cacheName;
+			}).map(function(name) {
+				return caches.delete(name);
+			}));
+		}).then(function() {
+			return self.clients.claim();
+		})
+	);
+});
+
+self.addEventListener('fetch', function(event) {
+	var req = event.request;
+	var url = new URL(req.url);
+	if (!version || req.method !== 'GET' || url.origin !== location.origin) {
+		return;
+	}
+	if (req.mode === 'navigate') {
+		// The page, from the network when it's reachable so the server's
+		// auth applies, else the cached shell.
+		event.respondWith(
+			fetch(req).catch(function() {
+				return caches.match('/');
+			})
+		);
+		return;
+	}
+	if (url.pathname.indexOf('/static/') !== 0) {
+		return;
+	}
+	// Assets, which are versioned.
+	event.respondWith(
+		caches.match(req).then(function(res) {
+			return res || fetch(req).then(function(res) {
+				if (res.ok) {
+					var copy = res.clone();
+					caches.open(cacheName).then(function(cache) {
+						cache.put(req, copy);
+					});
+				}
+				return res;
+			});
+		})
+	);
+});
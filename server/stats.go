@@ -0,0 +1,105 @@
+package server
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/url"
+	"strings"
+	"time"
+
+	"github.com/julienschmidt/httprouter"
+)
+
+// SongStats are the play count and rating of a song.
+type SongStats struct {
+	Plays      int
+	LastPlayed time.Time
+	// Rating is from 1 to 5 stars, or 0 if unrated.
+	Rating int
+}
+
+// songStats returns the stats of id, creating them if needed. It should
+// only be called by the commands() function.
+func (srv *Server) songStats(id SongID) *SongStats {
+	if srv.Stats == nil {
+		srv.Stats = make(map[SongID]*SongStats)
+	}
+	st := srv.Stats[id]
+	if st == nil {
+		st = new(SongStats)
+		srv.Stats[id] = st
+	}
+	return st
+}
+
+// played counts a play of id. It should only be called by the commands()
+// function.
+func (srv *Server) played(id SongID) {
+	st := srv.songStats(id)
+	st.Plays++
+	st.LastPlayed = time.Now()
+}
+
+// mergeStats moves the stats of the songs in the keys of m to their values,
+// adding plays. It should only be called by the commands() function.
+func (srv *Server) mergeStats(m map[SongID]SongID) {
+	for old, n := range m {
+		st := srv.Stats[old]
+		if st == nil {
+			continue
+		}
+		delete(srv.Stats, old)
+		nst := srv.songStats(n)
+		nst.Plays += st.Plays
+		if st.LastPlayed.After(nst.LastPlayed) {
+			nst.LastPlayed = st.LastPlayed
+		}
+		if nst.Rating == 0 {
+			nst.Rating = st.Rating
+		}
+	}
+}
+
+// GetStats returns the play count and rating of a song.
+func (srv *Server) GetStats(body io.Reader, form url.Values, ps httprouter.Params) (interface{}, error) {
+	ch := make(chan SongStats)
+	srv.ch <- cmdGetStats{
+		id:   SongID(strings.TrimPrefix(ps.ByName("id"), "/")),
+		done: ch,
+	}
+	return <-ch, nil
+}
+
+// SetRating sets the rating of the song ID, from 1 to 5 stars, or 0 to
+// clear it.
+func (srv *Server) SetRating(body io.Reader, form url.Values, ps httprouter.Params) (interface{}, error) {
+	var req struct {
+		ID     SongID
+		Rating int
+	}
+	if err := json.NewDecoder(body).Decode(&req); err != nil {
+		return nil, err
+	}
+	if req.Rating < 0 || req.Rating > 5 {
+		return nil, fmt.Errorf("rating must be from 0 to 5")
+	}
+	ch := make(chan error)
+	srv.ch <- cmdSetRating{
+		id:     req.ID,
+		rating: req.Rating,
+		err:    ch,
+	}
+	return nil, <-ch
+}
+
+type cmdGetStats struct {
+	id   SongID
+	done chan SongStats
+}
+
+type cmdSetRating struct {
+	id     SongID
+	rating int
+	err    chan error
+}
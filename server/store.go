@@ -0,0 +1,508 @@
+package server
+
+import (
+	"bytes"
+	"compress/gzip"
+	"encoding/binary"
+	"encoding/gob"
+	"fmt"
+	"io"
+	"log"
+	"os"
+	"reflect"
+	"time"
+
+	"github.com/boltdb/bolt"
+	"github.com/mjibson/moggio/codec"
+	"github.com/mjibson/moggio/protocol"
+)
+
+const (
+	dbMeta      = "meta"
+	dbState     = "state"
+	dbProtocols = "protocols"
+	metaVersion = "version"
+
+	// stateVersion is the schema version of the state database. Version 1
+	// stored the entire server as one gob, in dbBucket. Version 2 stores
+	// each exported Server field in dbState and each protocol instance in
+	// dbProtocols, so only what changed is written.
+	stateVersion = 2
+
+	// Legacy version 1 bucket and key.
+	dbBucket = "bucket"
+	dbServer = "server"
+
+	// compactInterval is how often the database is checked for compaction.
+	compactInterval = time.Hour * 24
+	// compactMinSize is the smallest database file that is compacted.
+	compactMinSize = 1 << 20
+	// fullSaveInterval is how often all protocol instances are saved, not
+	// only those changed, in case one changed on its own, like an OAuth
+	// token refreshed.
+	fullSaveInterval = time.Hour
+)
+
+// view and update run fn in a read-only or read-write transaction of the
+// database, which may be replaced during compaction.
+func (srv *Server) view(fn func(*bolt.Tx) error) error {
+	srv.dbMu.RLock()
+	defer srv.dbMu.RUnlock()
+	return srv.db.View(fn)
+}
+
+func (srv *Server) update(fn func(*bolt.Tx) error) error {
+	srv.dbMu.RLock()
+	defer srv.dbMu.RUnlock()
+	return srv.db.Update(fn)
+}
+
+// openDB opens the state database at path. A state file predating the
+// database, a gob of the server, is moved aside and migrated.
+func (srv *Server) openDB(path string) error {
+	db, err := bolt.Open(path, 0600, &bolt.Options{Timeout: time.Second})
+	if err != nil {
+		if lerr := srv.readLegacyFile(path); lerr != nil {
+			return err
+		}
+		legacy := path + ".gob"
+		log.Printf("migrating state file %s, saving original as %s", path, legacy)
+		if err := os.Rename(path, legacy); err != nil {
+			return err
+		}
+		if db, err = bolt.Open(path, 0600, &bolt.Options{Timeout: time.Second}); err != nil {
+			return err
+		}
+		srv.db = db
+		return srv.writeState(srv.snapshot())
+	}
+	srv.db = db
+	return srv.restore()
+}
+
+// readLegacyFile reads a state file that predates the database.
+func (srv *Server) readLegacyFile(name string) error {
+	f, err := os.Open(name)
+	if err != nil {
+		return err
+	}
+	defer f.Close()
+	var r io.Reader = f
+	if gr, err := gzip.NewReader(f); err == nil {
+		defer gr.Close()
+		r = gr
+	} else if _, err := f.Seek(0, io.SeekStart); err != nil {
+		return err
+	}
+	// Decode separately so a file that isn't state doesn't change srv.
+	var old Server
+	if err := gob.NewDecoder(r).Decode(&old); err != nil {
+		return err
+	}
+	v, ov := reflect.ValueOf(srv).Elem(), reflect.ValueOf(&old).Elem()
+	for _, i := range persisted() {
+		v.Field(i).Set(ov.Field(i))
+	}
+	for name, m := range old.Protocols {
+		srv.Protocols[name] = m
+	}
+	return nil
+}
+
+// persisted returns the indexes of the Server fields stored in dbState.
+func persisted() []int {
+	var fields []int
+	t := reflect.TypeOf((*Server)(nil)).Elem()
+	for i := 0; i < t.NumField(); i++ {
+		f := t.Field(i)
+		if f.PkgPath == "" && f.Name != "Protocols" {
+			fields = append(fields, i)
+		}
+	}
+	return fields
+}
+
+func (srv *Server) restore() error {
+	var version uint64
+	var legacy []byte
+	err := srv.db.View(func(tx *bolt.Tx) error {
+		if b := tx.Bucket([]byte(dbMeta)); b != nil {
+			if v := b.Get([]byte(metaVersion)); len(v) == 8 {
+				version = binary.BigEndian.Uint64(v)
+			}
+		}
+		if b := tx.Bucket([]byte(dbBucket)); b != nil {
+			legacy = append([]byte(nil), b.Get([]byte(dbServer))...)
+		}
+		return nil
+	})
+	if err != nil {
+		return err
+	}
+	switch {
+	case version > stateVersion:
+		return fmt.Errorf("state database version %d is newer than supported version %d", version, stateVersion)
+	case version == 0 && len(legacy) > 0:
+		log.Println("migrating state database to version", stateVersion)
+		gr, err := gzip.NewReader(bytes.NewReader(legacy))
+		if err != nil {
+			return err
+		}
+		defer gr.Close()
+		if err := gob.NewDecoder(gr).Decode(srv); err != nil {
+			return err
+		}
+		if err := srv.writeState(srv.snapshot()); err != nil {
+			return err
+		}
+		return srv.db.Update(func(tx *bolt.Tx) error {
+			return tx.DeleteBucket([]byte(dbBucket))
+		})
+	case version == 0:
+		// New database.
+		return nil
+	}
+	st := stateSnapshot{
+		fields:    make(map[string][]byte),
+		protocols: make(map[string][]byte),
+	}
+	err = srv.db.View(func(tx *bolt.Tx) error {
+		for name, m := range map[string]map[string][]byte{
+			dbState:     st.fields,
+			dbProtocols: st.protocols,
+		} {
+			if b := tx.Bucket([]byte(name)); b != nil {
+				b.ForEach(func(k, v []byte) error {
+					m[string(k)] = append([]byte(nil), v...)
+					return nil
+				})
+			}
+		}
+		return nil
+	})
+	if err != nil {
+		return err
+	}
+	return srv.apply(st)
+}
+
+// apply sets the fields and adds the protocol instances of s. Fields not
+// in s are unchanged.
+func (srv *Server) apply(s stateSnapshot) error {
+	v := reflect.ValueOf(srv).Elem()
+	for _, i := range persisted() {
+		name := v.Type().Field(i).Name
+		data, ok := s.fields[name]
+		if !ok {
+			continue
+		}
+		f := v.Field(i)
+		// Decoding into a map adds to it, so start with an empty one.
+		if f.Kind() == reflect.Map {
+			f.Set(reflect.MakeMap(f.Type()))
+		} else {
+			f.Set(reflect.Zero(f.Type()))
+		}
+		if err := gob.NewDecoder(bytes.NewReader(data)).DecodeValue(f); err != nil {
+			return fmt.Errorf("restore %s: %v", name, err)
+		}
+	}
+	for id, data := range s.protocols {
+		name, key := codec.ID(id).Pop()
+		inst, err := decodeInstance(name, data)
+		if err != nil {
+			log.Printf("restore %s: %v", id, err)
+			continue
+		}
+		if srv.Protocols[name] == nil {
+			srv.Protocols[name] = make(map[string]protocol.Instance)
+		}
+		srv.Protocols[name][string(key)] = inst
+		if _, ok := inst.(protocol.FileInstance); ok {
+			if songs, err := inst.List(); err == nil {
+				songs.Compact()
+			}
+		}
+	}
+	return nil
+}
+
+func encodeInstance(inst protocol.Instance) ([]byte, error) {
+	var buf bytes.Buffer
+	gw := gzip.NewWriter(&buf)
+	if err := gob.NewEncoder(gw).Encode(inst); err != nil {
+		return nil, err
+	}
+	if err := gw.Close(); err != nil {
+		return nil, err
+	}
+	return buf.Bytes(), nil
+}
+
+func decodeInstance(name string, data []byte) (protocol.Instance, error) {
+	proto, err := protocol.ByName(name)
+	if err != nil {
+		return nil, err
+	}
+	gr, err := gzip.NewReader(bytes.NewReader(data))
+	if err != nil {
+		return nil, err
+	}
+	defer gr.Close()
+	return proto.Decode(gr)
+}
+
+// stateSnapshot is the encoded state to save, keyed by field name and by
+// protocol and instance key.
+type stateSnapshot struct {
+	fields    map[string][]byte
+	protocols map[string][]byte
+	// instances, if not nil, are all the instances, of which only those
+	// changed are in protocols. Others saved are deleted.
+	instances map[string]bool
+	err       error
+}
+
+// changedInstance marks the instance of protocol name and key to be saved.
+// It should only be called by the commands() function.
+func (srv *Server) changedInstance(name, key string) {
+	if srv.changedInstances == nil {
+		srv.changedInstances = make(map[codec.ID]bool)
+	}
+	srv.changedInstances[codec.NewID(name, key)] = true
+}
+
+// snapshot encodes the state. It should only be called by the commands()
+// function.
+func (srv *Server) snapshot() stateSnapshot {
+	return srv.snapshotOf(true)
+}
+
+// snapshotOf encodes the state, with all protocol instances or only those
+// changed since the last snapshot of them. Encoding all of a large library
+// is slow. It should only be called by the commands() function.
+func (srv *Server) snapshotOf(all bool) stateSnapshot {
+	s := stateSnapshot{
+		fields:    make(map[string][]byte),
+		protocols: make(map[string][]byte),
+	}
+	if !all && time.Since(srv.savedInstances) < fullSaveInterval {
+		s.instances = make(map[string]bool)
+	}
+	v := reflect.ValueOf(srv).Elem()
+	for _, i := range persisted() {
+		var buf bytes.Buffer
+		if err := gob.NewEncoder(&buf).EncodeValue(v.Field(i)); err != nil {
+			s.err = fmt.Errorf("save %s: %v", v.Type().Field(i).Name, err)
+			return s
+		}
+		s.fields[v.Type().Field(i).Name] = buf.Bytes()
+	}
+	for name, m := range srv.Protocols {
+		for key, inst := range m {
+			id := codec.NewID(name, key)
+			if s.instances != nil {
+				s.instances[string(id)] = true
+				if !srv.changedInstances[id] {
+					continue
+				}
+			}
+			b, err := encodeInstance(inst)
+			if err != nil {
+				s.err = err
+				return s
+			}
+			s.protocols[string(id)] = b
+		}
+	}
+	if s.instances == nil {
+		srv.savedInstances = time.Now()
+	}
+	srv.changedInstances = nil
+	return s
+}
+
+// merge adds the instances of an earlier snapshot, replaced by s before it
+// was written, that s lacks.
+func (s *stateSnapshot) merge(old stateSnapshot) {
+	if s.instances == nil || old.err != nil {
+		return
+	}
+	for id, b := range old.protocols {
+		if _, ok := s.protocols[id]; !ok && s.instances[id] {
+			s.protocols[id] = b
+		}
+	}
+}
+
+// save queues the state to be written by saveState. Only the latest
+// pending snapshot is kept.
+func (srv *Server) save() error {
+	defer func() {
+		srv.savePending = false
+	}()
+	s := srv.snapshotOf(false)
+	if s.err != nil {
+		return s.err
+	}
+	for {
+		select {
+		case srv.saves <- s:
+			return nil
+		default:
+			select {
+			case old := <-srv.saves:
+				s.merge(old)
+			default:
+			}
+		}
+	}
+}
+
+// saveState writes queued snapshots in the background, so saving doesn't
+// block commands.
+func (srv *Server) saveState() {
+	for s := range srv.saves {
+		if err := srv.writeState(s); err != nil {
+			srv.ch <- cmdError(err)
+			continue
+		}
+		log.Println("save to db complete")
+	}
+}
+
+// writeState writes the parts of s that differ from the database.
+func (srv *Server) writeState(s stateSnapshot) error {
+	if s.err != nil {
+		return s.err
+	}
+	put := func(b *bolt.Bucket, k string, v []byte) error {
+		if bytes.Equal(b.Get([]byte(k)), v) {
+			return nil
+		}
+		return b.Put([]byte(k), v)
+	}
+	return srv.update(func(tx *bolt.Tx) error {
+		meta, err := tx.CreateBucketIfNotExists([]byte(dbMeta))
+		if err != nil {
+			return err
+		}
+		version := make([]byte, 8)
+		binary.BigEndian.PutUint64(version, stateVersion)
+		if err := put(meta, metaVersion, version); err != nil {
+			return err
+		}
+		state, err := tx.CreateBucketIfNotExists([]byte(dbState))
+		if err != nil {
+			return err
+		}
+		for k, v := range s.fields {
+			if err := put(state, k, v); err != nil {
+				return err
+			}
+		}
+		protos, err := tx.CreateBucketIfNotExists([]byte(dbProtocols))
+		if err != nil {
+			return err
+		}
+		var removed [][]byte
+		protos.ForEach(func(k, v []byte) error {
+			if s.instances != nil {
+				if !s.instances[string(k)] {
+					removed = append(removed, append([]byte(nil), k...))
+				}
+			} else if _, ok := s.protocols[string(k)]; !ok {
+				removed = append(removed, append([]byte(nil), k...))
+			}
+			return nil
+		})
+		for _, k := range removed {
+			if err := protos.Delete(k); err != nil {
+				return err
+			}
+		}
+		for k, v := range s.protocols {
+			if err := put(protos, k, v); err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+}
+
+// compactDB periodically compacts the database if much of it is free
+// space, which bolt otherwise never returns to the file system.
+func (srv *Server) compactDB() {
+	for range time.Tick(compactInterval) {
+		if err := srv.compact(); err != nil {
+			log.Println("compact:", err)
+		}
+	}
+}
+
+func (srv *Server) compact() error {
+	srv.dbMu.Lock()
+	defer srv.dbMu.Unlock()
+	path := srv.db.Path()
+	fi, err := os.Stat(path)
+	if err != nil {
+		return err
+	}
+	free := int64(srv.db.Stats().FreePageN) * int64(srv.db.Info().PageSize)
+	if fi.Size() < compactMinSize || free < fi.Size()/2 {
+		return nil
+	}
+	tmp := path + ".compact"
+	os.Remove(tmp)
+	dst, err := bolt.Open(tmp, 0600, nil)
+	if err != nil {
+		return err
+	}
+	err = srv.db.View(func(tx *bolt.Tx) error {
+		return dst.Update(func(dtx *bolt.Tx) error {
+			return tx.ForEach(func(name []byte, b *bolt.Bucket) error {
+				nb, err := dtx.CreateBucket(name)
+				if err != nil {
+					return err
+				}
+				return copyBucket(nb, b)
+			})
+		})
+	})
+	if cerr := dst.Close(); err == nil {
+		err = cerr
+	}
+	if err != nil {
+		os.Remove(tmp)
+		return err
+	}
+	if err := srv.db.Close(); err != nil {
+		return err
+	}
+	rerr := os.Rename(tmp, path)
+	db, err := bolt.Open(path, 0600, &bolt.Options{Timeout: time.Second})
+	if err != nil {
+		// Without a database, nothing can be saved.
+		log.Fatal(err)
+	}
+	srv.db = db
+	if rerr != nil {
+		os.Remove(tmp)
+		return rerr
+	}
+	log.Printf("compacted %s from %d bytes", path, fi.Size())
+	return nil
+}
+
+func copyBucket(dst, src *bolt.Bucket) error {
+	return src.ForEach(func(k, v []byte) error {
+		if v == nil {
+			nb, err := dst.CreateBucket(k)
+			if err != nil {
+				return err
+			}
+			return copyBucket(nb, src.Bucket(k))
+		}
+		return dst.Put(k, v)
+	})
+}
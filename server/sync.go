@@ -0,0 +1,455 @@
+package server
+
+import (
+	"crypto/md5"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"log"
+	"net/url"
+	"os"
+	"path"
+	"path/filepath"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/julienschmidt/httprouter"
+	"github.com/mjibson/moggio/protocol"
+)
+
+// SyncJob mirrors a folder of a cloud source, like Drive or Dropbox, to a
+// local directory, whose copies are added to the library. The cloud stays
+// the source of truth: local copies are replaced when their files change.
+type SyncJob struct {
+	Name string
+	// Protocol and Key are the instance of the cloud source.
+	Protocol string
+	Key      string
+	// Folder is the folder mirrored, a slash-separated path from the
+	// source's root, or "" for all of it.
+	Folder string
+	// Local is the directory the folder is mirrored to.
+	Local string
+	// Interval is how often the job runs. If 0, it only runs when asked.
+	Interval time.Duration
+	// Delete is what happens to the local copies of files removed from the
+	// folder: SyncKeep, SyncDelete, or SyncTrash.
+	Delete string
+}
+
+// Deletion policies of sync jobs.
+const (
+	// SyncKeep keeps local copies, which are no longer synced.
+	SyncKeep = "keep"
+	// SyncDelete deletes them.
+	SyncDelete = "delete"
+	// SyncTrash moves them into the trash directory of the job's local
+	// directory.
+	SyncTrash = "trash"
+)
+
+const (
+	// syncCheck is how often sync jobs are checked for being due.
+	syncCheck = time.Minute
+	// minSyncInterval bounds the interval of sync jobs.
+	minSyncInterval = time.Minute * 15
+	// syncManifest is the file, in the local directory of a sync job,
+	// recording the versions of the files copied.
+	syncManifest = ".moggio-sync.json"
+	// syncTrash is the directory, in the local directory of a sync job,
+	// of the files moved to the trash. It's hidden, so not in the library.
+	syncTrash = ".moggio-trash"
+	// syncErrors is the number of errors of files kept in a job's status.
+	syncErrors = 20
+)
+
+// SyncStatus is the result of the last run of a sync job.
+type SyncStatus struct {
+	Running  bool
+	Started  time.Time `json:",omitempty"`
+	Finished time.Time `json:",omitempty"`
+	// Copied, Unchanged, and Deleted count the files of the run.
+	Copied    int
+	Unchanged int
+	Deleted   int
+	// Error is why the run failed, and Errors the files that did.
+	Error  string   `json:",omitempty"`
+	Errors []string `json:",omitempty"`
+}
+
+// syncs holds the status of sync jobs, by name.
+type syncs struct {
+	sync.Mutex
+	status map[string]SyncStatus
+}
+
+// start marks the job name running, unless it already is.
+func (s *syncs) start(name string) bool {
+	s.Lock()
+	defer s.Unlock()
+	if s.status == nil {
+		s.status = make(map[string]SyncStatus)
+	}
+	if s.status[name].Running {
+		return false
+	}
+	s.status[name] = SyncStatus{
+		Running: true,
+		Started: time.Now(),
+	}
+	return true
+}
+
+func (s *syncs) finish(name string, st SyncStatus) {
+	s.Lock()
+	defer s.Unlock()
+	st.Started = s.status[name].Started
+	st.Finished = time.Now()
+	s.status[name] = st
+}
+
+// due reports whether the job name last started at least interval ago.
+func (s *syncs) due(name string, interval time.Duration) bool {
+	s.Lock()
+	defer s.Unlock()
+	st := s.status[name]
+	return !st.Running && time.Since(st.Started) >= interval
+}
+
+func (s *syncs) get() map[string]SyncStatus {
+	s.Lock()
+	defer s.Unlock()
+	m := make(map[string]SyncStatus)
+	for k, v := range s.status {
+		m[k] = v
+	}
+	return m
+}
+
+// syncJob is a sync job and its cloud source, nil if it was removed.
+type syncJob struct {
+	SyncJob
+	inst protocol.Mirrorer
+}
+
+// syncEntry is a file copied by a sync job.
+type syncEntry struct {
+	Version string
+	Size    int64
+	MD5     string `json:",omitempty"`
+}
+
+// watchSyncs runs sync jobs when they're due.
+func (srv *Server) watchSyncs() {
+	for range time.Tick(syncCheck) {
+		for _, j := range srv.getSyncJobs() {
+			if j.Interval > 0 && srv.syncs.due(j.Name, j.Interval) {
+				go srv.runSync(j)
+			}
+		}
+	}
+}
+
+func (srv *Server) getSyncJobs() []syncJob {
+	ch := make(chan []syncJob)
+	srv.ch <- cmdGetSyncJobs(ch)
+	return <-ch
+}
+
+// runSync copies the files of j's folder that changed since its last run,
+// removes those no longer there as its policy says, and refreshes the
+// library if any were.
+func (srv *Server) runSync(j syncJob) {
+	if !srv.syncs.start(j.Name) {
+		return
+	}
+	var st SyncStatus
+	defer func() {
+		srv.syncs.finish(j.Name, st)
+	}()
+	fail := func(err error) {
+		log.Printf("sync %s: %v", j.Name, err)
+		st.Error = err.Error()
+	}
+	if j.inst == nil {
+		fail(fmt.Errorf("no %s source %s", j.Protocol, j.Key))
+		return
+	}
+	files, err := j.inst.MirrorFiles(srv.ctx, j.Folder)
+	if err != nil {
+		fail(err)
+		return
+	}
+	prev, err := readSyncManifest(j.Local)
+	if err != nil {
+		fail(err)
+		return
+	}
+	fileErr := func(name string, err error) {
+		log.Printf("sync %s: %s: %v", j.Name, name, err)
+		if len(st.Errors) < syncErrors {
+			st.Errors = append(st.Errors, fmt.Sprintf("%s: %v", name, err))
+		}
+	}
+	next := make(map[string]syncEntry)
+	listed := make(map[string]bool)
+	for _, f := range files {
+		rel, ok := syncPath(f.Path)
+		if !ok {
+			continue
+		}
+		listed[rel] = true
+		local := filepath.Join(j.Local, filepath.FromSlash(rel))
+		if e, ok := prev[rel]; ok && e.Version == f.Version {
+			if fi, err := os.Stat(local); err == nil && fi.Size() == e.Size {
+				next[rel] = e
+				st.Unchanged++
+				continue
+			}
+		}
+		size, sum, err := syncFile(f, local)
+		if err != nil {
+			if e, ok := prev[rel]; ok {
+				next[rel] = e
+			}
+			fileErr(rel, err)
+			continue
+		}
+		next[rel] = syncEntry{
+			Version: f.Version,
+			Size:    size,
+			MD5:     sum,
+		}
+		st.Copied++
+	}
+	if len(files) == 0 && len(prev) > 0 && j.Delete != SyncKeep {
+		// More likely a misconfigured source than everything deleted.
+		fail(fmt.Errorf("folder is empty; not removing %d local files", len(prev)))
+		return
+	}
+	var removed []string
+	for rel := range prev {
+		if !listed[rel] {
+			removed = append(removed, rel)
+		}
+	}
+	sort.Strings(removed)
+	for _, rel := range removed {
+		if err := removeSynced(j.SyncJob, rel); err != nil {
+			next[rel] = prev[rel]
+			fileErr(rel, err)
+			continue
+		}
+		if j.Delete != SyncKeep {
+			st.Deleted++
+		}
+	}
+	if st.Deleted > 0 {
+		removeEmptyDirs(j.Local)
+	}
+	if err := writeSyncManifest(j.Local, next); err != nil {
+		fail(err)
+	}
+	if st.Copied > 0 || st.Deleted > 0 {
+		srv.ch <- cmdSynced(j.Local)
+	}
+}
+
+// syncPath returns the local path, slash-separated, of p, a path of a
+// mirrored folder, which can't be outside the local directory or the
+// files sync jobs keep there.
+func syncPath(p string) (string, bool) {
+	p = strings.TrimPrefix(path.Clean("/"+p), "/")
+	if p == "" || p == syncManifest || p == syncTrash || strings.HasPrefix(p, syncTrash+"/") {
+		return "", false
+	}
+	return p, true
+}
+
+// syncFile copies f to local through a temporary file, replacing local only
+// if its size and checksum are as listed. It returns the size and hex MD5
+// checksum of the copy.
+func syncFile(f protocol.RemoteFile, local string) (int64, string, error) {
+	dir := filepath.Dir(local)
+	if err := os.MkdirAll(dir, 0755); err != nil {
+		return 0, "", err
+	}
+	r, _, err := f.Open()
+	if err != nil {
+		return 0, "", err
+	}
+	defer r.Close()
+	tmp, err := ioutil.TempFile(dir, ".sync-")
+	if err != nil {
+		return 0, "", err
+	}
+	h := md5.New()
+	n, err := io.Copy(io.MultiWriter(tmp, h), r)
+	if cerr := tmp.Close(); err == nil {
+		err = cerr
+	}
+	sum := hex.EncodeToString(h.Sum(nil))
+	switch {
+	case err != nil:
+	case f.Size > 0 && n != f.Size:
+		err = fmt.Errorf("copied %d bytes of %d", n, f.Size)
+	case f.MD5 != "" && !strings.EqualFold(sum, f.MD5):
+		err = fmt.Errorf("checksum %s, expected %s", sum, f.MD5)
+	default:
+		err = os.Rename(tmp.Name(), local)
+	}
+	if err != nil {
+		os.Remove(tmp.Name())
+		return 0, "", err
+	}
+	return n, sum, nil
+}
+
+// removeSynced removes the local copy rel of a file removed from j's folder
+// as j's policy says.
+func removeSynced(j SyncJob, rel string) error {
+	local := filepath.Join(j.Local, filepath.FromSlash(rel))
+	switch j.Delete {
+	case SyncDelete:
+		if err := os.Remove(local); err != nil && !os.IsNotExist(err) {
+			return err
+		}
+	case SyncTrash:
+		if _, err := os.Stat(local); os.IsNotExist(err) {
+			return nil
+		}
+		to := filepath.Join(j.Local, syncTrash, filepath.FromSlash(rel))
+		if err := os.MkdirAll(filepath.Dir(to), 0755); err != nil {
+			return err
+		}
+		os.Remove(to)
+		return moveFile(local, to)
+	}
+	return nil
+}
+
+func readSyncManifest(dir string) (map[string]syncEntry, error) {
+	m := make(map[string]syncEntry)
+	b, err := ioutil.ReadFile(filepath.Join(dir, syncManifest))
+	if os.IsNotExist(err) {
+		return m, nil
+	} else if err != nil {
+		return nil, err
+	}
+	return m, json.Unmarshal(b, &m)
+}
+
+// writeSyncManifest writes m through a temporary file, so a crash doesn't
+// lose the record of the files copied.
+func writeSyncManifest(dir string, m map[string]syncEntry) error {
+	b, err := json.MarshalIndent(m, "", "\t")
+	if err != nil {
+		return err
+	}
+	name := filepath.Join(dir, syncManifest)
+	if err := ioutil.WriteFile(name+".tmp", b, 0644); err != nil {
+		return err
+	}
+	return os.Rename(name+".tmp", name)
+}
+
+// checkSyncJobs validates jobs, making their local directories absolute.
+func checkSyncJobs(jobs []SyncJob) error {
+	names := make(map[string]bool)
+	for i := range jobs {
+		j := &jobs[i]
+		if j.Name == "" {
+			return fmt.Errorf("sync job %d has no name", i+1)
+		}
+		if names[j.Name] {
+			return fmt.Errorf("duplicate sync job: %s", j.Name)
+		}
+		names[j.Name] = true
+		if j.Interval != 0 && j.Interval < minSyncInterval {
+			return fmt.Errorf("%s: interval must be at least %v", j.Name, minSyncInterval)
+		}
+		switch j.Delete {
+		case "":
+			j.Delete = SyncKeep
+		case SyncKeep, SyncDelete, SyncTrash:
+		default:
+			return fmt.Errorf("%s: unknown delete policy: %s", j.Name, j.Delete)
+		}
+		abs, err := filepath.Abs(j.Local)
+		if err != nil {
+			return err
+		}
+		fi, err := os.Stat(abs)
+		if err != nil {
+			return err
+		}
+		if !fi.IsDir() {
+			return fmt.Errorf("not a directory: %s", abs)
+		}
+		j.Local = abs
+	}
+	return nil
+}
+
+// GetSync returns the sync jobs and the status of their last runs.
+func (srv *Server) GetSync(body io.Reader, form url.Values, ps httprouter.Params) (interface{}, error) {
+	jobs := []SyncJob{}
+	for _, j := range srv.getSyncJobs() {
+		jobs = append(jobs, j.SyncJob)
+	}
+	return struct {
+		Jobs   []SyncJob
+		Status map[string]SyncStatus
+	}{
+		Jobs:   jobs,
+		Status: srv.syncs.get(),
+	}, nil
+}
+
+// SetSync replaces the sync jobs.
+func (srv *Server) SetSync(body io.Reader, form url.Values, ps httprouter.Params) (interface{}, error) {
+	var jobs []SyncJob
+	if err := json.NewDecoder(body).Decode(&jobs); err != nil {
+		return nil, err
+	}
+	if err := checkSyncJobs(jobs); err != nil {
+		return nil, err
+	}
+	ch := make(chan error)
+	srv.ch <- cmdSetSyncJobs{
+		jobs: jobs,
+		err:  ch,
+	}
+	if err := <-ch; err != nil {
+		return nil, err
+	}
+	srv.audit(ps, "sync jobs", fmt.Sprintf("%d jobs", len(jobs)))
+	return nil, nil
+}
+
+// SyncRun starts the sync job named by the name parameter now.
+func (srv *Server) SyncRun(body io.Reader, form url.Values, ps httprouter.Params) (interface{}, error) {
+	name := form.Get("name")
+	for _, j := range srv.getSyncJobs() {
+		if j.Name == name {
+			srv.audit(ps, "sync run", name)
+			go srv.runSync(j)
+			return nil, nil
+		}
+	}
+	return nil, fmt.Errorf("unknown sync job: %s", name)
+}
+
+type cmdGetSyncJobs chan []syncJob
+
+type cmdSetSyncJobs struct {
+	jobs []SyncJob
+	err  chan error
+}
+
+// cmdSynced adds the copies of a sync job's local directory to the library.
+type cmdSynced string
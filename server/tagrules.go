@@ -0,0 +1,307 @@
+package server
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/url"
+	"regexp"
+	"strings"
+
+	"github.com/julienschmidt/httprouter"
+	"github.com/mjibson/moggio/codec"
+)
+
+// TagRule normalizes a tag of songs as they are listed, without changing
+// their files.
+type TagRule struct {
+	Name    string
+	Enabled bool
+	// Kind is one of the tagRuleKinds.
+	Kind string
+	// Field is the tag changed, one of tagRuleFields.
+	Field string
+	// Values are the values replaced by Value by a canonical rule,
+	// compared ignoring case, spaces, and punctuation.
+	Values []string `json:",omitempty"`
+	// Pattern is the regular expression replaced by Value by a regexp
+	// rule. Value may refer to its groups, like $1.
+	Pattern string `json:",omitempty"`
+	Value   string `json:",omitempty"`
+}
+
+// Kinds of tag rules.
+const (
+	// ruleCanonical replaces spellings of a value with one, like "hip
+	// hop" with "Hip-Hop".
+	ruleCanonical = "canonical"
+	// ruleFeaturing moves featured artists, like "feat. X", to the
+	// Featured tag.
+	ruleFeaturing = "featuring"
+	// ruleArticle moves a trailing article to the front, like "Beatles,
+	// The" to "The Beatles".
+	ruleArticle = "article"
+	// ruleRegexp replaces a regular expression.
+	ruleRegexp = "regexp"
+)
+
+var tagRuleKinds = []string{ruleCanonical, ruleFeaturing, ruleArticle, ruleRegexp}
+
+var tagRuleFields = []string{"Artist", "AlbumArtist", "Album", "Title", "Genre"}
+
+// defaultTagRules are the rules offered before any are set, disabled so
+// the library isn't changed until they are enabled.
+var defaultTagRules = []TagRule{
+	{
+		Name:   "Hip-Hop",
+		Kind:   ruleCanonical,
+		Field:  "Genre",
+		Values: []string{"HipHop", "Hip-Hop/Rap", "Rap & Hip-Hop"},
+		Value:  "Hip-Hop",
+	},
+	{
+		Name:   "R&B",
+		Kind:   ruleCanonical,
+		Field:  "Genre",
+		Values: []string{"RnB", "Rhythm and Blues"},
+		Value:  "R&B",
+	},
+	{
+		Name:  "Featured artists",
+		Kind:  ruleFeaturing,
+		Field: "Artist",
+	},
+	{
+		Name:  "Featured artists in titles",
+		Kind:  ruleFeaturing,
+		Field: "Title",
+	},
+	{
+		Name:  "Leading articles",
+		Kind:  ruleArticle,
+		Field: "Artist",
+	},
+}
+
+var (
+	featuringRE = regexp.MustCompile(`(?i)\s*[(\[]?\s*\b(?:feat\.?|ft\.|featuring)\s+([^)\]]+)[)\]]?`)
+	articleRE   = regexp.MustCompile(`(?i)^(.+?),\s*(the|a|an)$`)
+)
+
+// tagRule is a compiled TagRule.
+type tagRule struct {
+	TagRule
+	values map[string]bool
+	re     *regexp.Regexp
+}
+
+// tagRules are the enabled tag rules, in order.
+type tagRules []*tagRule
+
+// compileTagRules returns the enabled rules of rules.
+func compileTagRules(rules []TagRule) (tagRules, error) {
+	var rs tagRules
+	for _, r := range rules {
+		tr := &tagRule{TagRule: r}
+		switch r.Kind {
+		case ruleCanonical:
+			tr.values = map[string]bool{normalize(r.Value): true}
+			for _, v := range r.Values {
+				tr.values[normalize(v)] = true
+			}
+		case ruleRegexp:
+			re, err := regexp.Compile(r.Pattern)
+			if err != nil {
+				return nil, fmt.Errorf("tag rule %s: %v", r.Name, err)
+			}
+			tr.re = re
+		}
+		if r.Enabled {
+			rs = append(rs, tr)
+		}
+	}
+	return rs, nil
+}
+
+// tagField returns the tag named field of i.
+func tagField(i *codec.SongInfo, field string) *string {
+	switch field {
+	case "Artist":
+		return &i.Artist
+	case "AlbumArtist":
+		return &i.AlbumArtist
+	case "Album":
+		return &i.Album
+	case "Title":
+		return &i.Title
+	case "Genre":
+		return &i.Genre
+	}
+	return nil
+}
+
+// applyTo applies r to i, returning whether it changed.
+func (r *tagRule) applyTo(i *codec.SongInfo) bool {
+	f := tagField(i, r.Field)
+	if f == nil || *f == "" {
+		return false
+	}
+	v := *f
+	switch r.Kind {
+	case ruleCanonical:
+		if r.values[normalize(v)] {
+			v = r.Value
+		}
+	case ruleFeaturing:
+		m := featuringRE.FindStringSubmatchIndex(v)
+		if m == nil || m[0] == 0 {
+			break
+		}
+		feat := strings.TrimSpace(v[m[2]:m[3]])
+		v = strings.Join(strings.Fields(v[:m[0]]+" "+v[m[1]:]), " ")
+		if i.Featured == "" {
+			i.Featured = feat
+		} else if !strings.Contains(i.Featured, feat) {
+			i.Featured += ", " + feat
+		}
+	case ruleArticle:
+		m := articleRE.FindStringSubmatch(v)
+		if m == nil {
+			break
+		}
+		v = m[1]
+		if !strings.HasPrefix(strings.ToLower(v), strings.ToLower(m[2])+" ") {
+			v = m[2] + " " + v
+		}
+	case ruleRegexp:
+		v = strings.TrimSpace(r.re.ReplaceAllString(v, r.Value))
+	}
+	if v == *f {
+		return false
+	}
+	*f = v
+	return true
+}
+
+// apply returns info with the rules applied, or info itself if none
+// changed it.
+func (rs tagRules) apply(info *codec.SongInfo) *codec.SongInfo {
+	if len(rs) == 0 {
+		return info
+	}
+	i := *info
+	if !rs.applyTo(&i) {
+		return info
+	}
+	return &i
+}
+
+func (rs tagRules) applyTo(i *codec.SongInfo) bool {
+	changed := false
+	for _, r := range rs {
+		if r.applyTo(i) {
+			changed = true
+		}
+	}
+	return changed
+}
+
+func checkTagRules(rules []TagRule) error {
+	names := make(map[string]bool)
+	for _, r := range rules {
+		if r.Name == "" {
+			return fmt.Errorf("tag rule name required")
+		}
+		if names[r.Name] {
+			return fmt.Errorf("duplicate tag rule: %s", r.Name)
+		}
+		names[r.Name] = true
+		if !hasString(tagRuleKinds, r.Kind) {
+			return fmt.Errorf("tag rule %s: unknown kind: %s", r.Name, r.Kind)
+		}
+		if !hasString(tagRuleFields, r.Field) {
+			return fmt.Errorf("tag rule %s: unknown field: %s", r.Name, r.Field)
+		}
+		switch r.Kind {
+		case ruleCanonical:
+			if r.Value == "" {
+				return fmt.Errorf("tag rule %s: value required", r.Name)
+			}
+		case ruleFeaturing:
+			if r.Field != "Artist" && r.Field != "AlbumArtist" && r.Field != "Title" {
+				return fmt.Errorf("tag rule %s: featured artists are only in the artist or title", r.Name)
+			}
+		case ruleRegexp:
+			if r.Pattern == "" {
+				return fmt.Errorf("tag rule %s: pattern required", r.Name)
+			}
+		}
+	}
+	_, err := compileTagRules(rules)
+	return err
+}
+
+func hasString(l []string, s string) bool {
+	for _, v := range l {
+		if v == s {
+			return true
+		}
+	}
+	return false
+}
+
+// applyTagRules compiles the saved tag rules, or offers the defaults if none
+// were ever set. It should only be called by the commands() function, or
+// before it starts.
+func (srv *Server) applyTagRules() {
+	if srv.TagRules == nil {
+		srv.TagRules = defaultTagRules
+	}
+	rs, err := compileTagRules(srv.TagRules)
+	if err != nil {
+		// Rules are checked when set, so this is only a bad state file.
+		srv.tagRules = nil
+		return
+	}
+	srv.tagRules = rs
+}
+
+// GetTagRules returns the tag rules, and their kinds and fields.
+func (srv *Server) GetTagRules(body io.Reader, form url.Values, ps httprouter.Params) (interface{}, error) {
+	ch := make(chan []TagRule)
+	srv.ch <- cmdGetTagRules(ch)
+	return struct {
+		Rules  []TagRule
+		Kinds  []string
+		Fields []string
+	}{
+		Rules:  <-ch,
+		Kinds:  tagRuleKinds,
+		Fields: tagRuleFields,
+	}, nil
+}
+
+// SetTagRules sets the tag rules, applied in order to songs as they are
+// listed.
+func (srv *Server) SetTagRules(body io.Reader, form url.Values, ps httprouter.Params) (interface{}, error) {
+	var rules []TagRule
+	if err := json.NewDecoder(body).Decode(&rules); err != nil {
+		return nil, err
+	}
+	if err := checkTagRules(rules); err != nil {
+		return nil, err
+	}
+	enabled := 0
+	for _, r := range rules {
+		if r.Enabled {
+			enabled++
+		}
+	}
+	srv.audit(ps, "tag rules", fmt.Sprintf("%d rules, %d enabled", len(rules), enabled))
+	srv.ch <- cmdSetTagRules(rules)
+	return nil, nil
+}
+
+type cmdGetTagRules chan []TagRule
+
+type cmdSetTagRules []TagRule
@@ -0,0 +1,372 @@
+package server
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io"
+	"log"
+	"mime/multipart"
+	"net/http"
+	"net/url"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/julienschmidt/httprouter"
+)
+
+// TelegramToken, if set, is the token of a Telegram bot that controls
+// playback for the chats in TelegramChats.
+var (
+	TelegramToken string
+	TelegramChats []int64
+)
+
+const (
+	telegramAPI = "https://api.telegram.org/bot"
+	// telegramPoll is how long a getUpdates request waits for updates.
+	telegramPoll = time.Second * 50
+	// telegramRetry is the wait after a failed getUpdates.
+	telegramRetry = time.Second * 5
+	// telegramResults is the number of search results listed.
+	telegramResults = 10
+)
+
+const telegramHelp = `/search <words> lists matching songs to add to the queue
+/now shows the playing song
+/skip plays the next song`
+
+type telegramChat struct {
+	ID int64 `json:"id"`
+}
+
+type telegramMessage struct {
+	Chat telegramChat `json:"chat"`
+	Text string       `json:"text"`
+}
+
+type telegramUpdate struct {
+	UpdateID int64            `json:"update_id"`
+	Message  *telegramMessage `json:"message"`
+	Callback *struct {
+		ID      string           `json:"id"`
+		Message *telegramMessage `json:"message"`
+		Data    string           `json:"data"`
+	} `json:"callback_query"`
+}
+
+type telegramButton struct {
+	Text string `json:"text"`
+	Data string `json:"callback_data"`
+}
+
+// telegramBot is a Telegram bot that sends the commands of its chats through
+// Cmd, like the HTTP API.
+type telegramBot struct {
+	srv    *Server
+	token  string
+	chats  map[int64]bool
+	client http.Client
+	// results are the songs last listed in each chat, which its buttons
+	// refer to by search number and index.
+	results  map[int64][]SongID
+	searches map[int64]int
+}
+
+// runTelegram runs the bot until shutdown.
+func (srv *Server) runTelegram() {
+	b := &telegramBot{
+		srv:      srv,
+		token:    TelegramToken,
+		chats:    make(map[int64]bool),
+		client:   http.Client{Timeout: telegramPoll + time.Second*10},
+		results:  make(map[int64][]SongID),
+		searches: make(map[int64]int),
+	}
+	for _, id := range TelegramChats {
+		b.chats[id] = true
+	}
+	var offset int64
+	for srv.ctx.Err() == nil {
+		var updates []telegramUpdate
+		err := b.call("getUpdates", map[string]interface{}{
+			"offset":          offset,
+			"timeout":         int(telegramPoll / time.Second),
+			"allowed_updates": []string{"message", "callback_query"},
+		}, &updates)
+		if err != nil {
+			if srv.ctx.Err() != nil {
+				return
+			}
+			log.Printf("telegram: %v", err)
+			select {
+			case <-time.After(telegramRetry):
+			case <-srv.ctx.Done():
+			}
+			continue
+		}
+		for _, u := range updates {
+			offset = u.UpdateID + 1
+			if err := b.handle(u); err != nil {
+				log.Printf("telegram: %v", err)
+			}
+		}
+	}
+}
+
+// call calls an API method with params, decoding its result into res, if
+// not nil.
+func (b *telegramBot) call(method string, params interface{}, res interface{}) error {
+	body, err := json.Marshal(params)
+	if err != nil {
+		return err
+	}
+	return b.post(method, "application/json", bytes.NewReader(body), res)
+}
+
+func (b *telegramBot) post(method, contentType string, body io.Reader, res interface{}) error {
+	req, err := http.NewRequestWithContext(b.srv.ctx, "POST", telegramAPI+b.token+"/"+method, body)
+	if err != nil {
+		return err
+	}
+	req.Header.Set("Content-Type", contentType)
+	resp, err := b.client.Do(req)
+	if err != nil {
+		// The URL has the token; don't log it.
+		if ue, ok := err.(*url.Error); ok {
+			err = ue.Err
+		}
+		return fmt.Errorf("%s: %v", method, err)
+	}
+	defer resp.Body.Close()
+	var r struct {
+		OK          bool            `json:"ok"`
+		Description string          `json:"description"`
+		Result      json.RawMessage `json:"result"`
+	}
+	if err := json.NewDecoder(resp.Body).Decode(&r); err != nil {
+		return fmt.Errorf("%s: %s: %v", method, resp.Status, err)
+	}
+	if !r.OK {
+		return fmt.Errorf("%s: %s", method, r.Description)
+	}
+	if res == nil {
+		return nil
+	}
+	return json.Unmarshal(r.Result, res)
+}
+
+func (b *telegramBot) send(chat int64, text string, buttons [][]telegramButton) error {
+	params := map[string]interface{}{
+		"chat_id": chat,
+		"text":    text,
+	}
+	if len(buttons) > 0 {
+		params["reply_markup"] = map[string]interface{}{
+			"inline_keyboard": buttons,
+		}
+	}
+	return b.call("sendMessage", params, nil)
+}
+
+func (b *telegramBot) handle(u telegramUpdate) error {
+	if c := u.Callback; c != nil {
+		if err := b.call("answerCallbackQuery", map[string]interface{}{
+			"callback_query_id": c.ID,
+		}, nil); err != nil {
+			return err
+		}
+		if c.Message == nil || !b.authorized(c.Message.Chat.ID) {
+			return nil
+		}
+		return b.callback(c.Message.Chat.ID, c.Data)
+	}
+	m := u.Message
+	if m == nil || !strings.HasPrefix(m.Text, "/") {
+		return nil
+	}
+	if !b.authorized(m.Chat.ID) {
+		return b.send(m.Chat.ID, fmt.Sprintf("This chat (%d) is not authorized.", m.Chat.ID), nil)
+	}
+	command, args := m.Text, ""
+	if i := strings.IndexByte(m.Text, ' '); i > 0 {
+		command, args = m.Text[:i], strings.TrimSpace(m.Text[i+1:])
+	}
+	// Commands may be addressed to the bot, like /skip@moggio_bot.
+	if i := strings.IndexByte(command, '@'); i > 0 {
+		command = command[:i]
+	}
+	switch command {
+	case "/search":
+		return b.search(m.Chat.ID, args)
+	case "/now":
+		return b.nowPlaying(m.Chat.ID)
+	case "/skip":
+		if _, err := b.cmd(m.Chat.ID, "next"); err != nil {
+			return b.send(m.Chat.ID, err.Error(), nil)
+		}
+		return nil
+	default:
+		return b.send(m.Chat.ID, telegramHelp, nil)
+	}
+}
+
+func (b *telegramBot) authorized(chat int64) bool {
+	if !b.chats[chat] {
+		log.Printf("telegram: unauthorized chat %d", chat)
+		return false
+	}
+	return true
+}
+
+// params returns the parameters of requests on behalf of chat.
+func (b *telegramBot) params(chat int64) httprouter.Params {
+	return httprouter.Params{
+		{Key: paramWho, Value: fmt.Sprintf("telegram:%d", chat)},
+	}
+}
+
+// cmd runs the Cmd command name.
+func (b *telegramBot) cmd(chat int64, name string) (interface{}, error) {
+	ps := append(b.params(chat), httprouter.Param{Key: "cmd", Value: name})
+	return b.srv.Cmd(new(bytes.Buffer), url.Values{}, ps)
+}
+
+func (b *telegramBot) search(chat int64, q string) error {
+	if q == "" {
+		return b.send(chat, "Usage: /search <words>", nil)
+	}
+	res, err := b.srv.Search(nil, url.Values{"q": {q}}, b.params(chat))
+	if err != nil {
+		return b.send(chat, err.Error(), nil)
+	}
+	items := res.([]listItem)
+	if len(items) == 0 {
+		return b.send(chat, "No songs found.", nil)
+	}
+	if len(items) > telegramResults {
+		items = items[:telegramResults]
+	}
+	b.searches[chat]++
+	search := strconv.Itoa(b.searches[chat])
+	var ids []SongID
+	var buttons [][]telegramButton
+	for i, it := range items {
+		ids = append(ids, it.ID)
+		buttons = append(buttons, []telegramButton{{
+			Text: telegramSong(it.ID, it.Info.Title, it.Info.Artist),
+			Data: "add:" + search + ":" + strconv.Itoa(i),
+		}})
+	}
+	b.results[chat] = ids
+	return b.send(chat, "Tap a song to add it to the queue:", buttons)
+}
+
+func (b *telegramBot) callback(chat int64, data string) error {
+	if !strings.HasPrefix(data, "add:") {
+		return nil
+	}
+	var search, i int
+	_, err := fmt.Sscanf(data, "add:%d:%d", &search, &i)
+	ids := b.results[chat]
+	if err != nil || search != b.searches[chat] || i < 0 || i >= len(ids) {
+		return b.send(chat, "That search has expired; search again.", nil)
+	}
+	plc, err := json.Marshal(PlaylistChange{{"add", string(ids[i])}})
+	if err != nil {
+		return err
+	}
+	if _, err := b.srv.QueueChange(bytes.NewReader(plc), nil, b.params(chat)); err != nil {
+		return b.send(chat, err.Error(), nil)
+	}
+	info, _ := b.srv.getSong(ids[i])
+	text := string(ids[i])
+	if info != nil {
+		text = telegramSong(ids[i], info.Title, info.Artist)
+	}
+	return b.send(chat, "Added "+text, nil)
+}
+
+func telegramSong(id SongID, title, artist string) string {
+	if title == "" {
+		title = string(id.ID())
+	}
+	if artist == "" {
+		return title
+	}
+	return artist + " – " + title
+}
+
+func (b *telegramBot) nowPlaying(chat int64) error {
+	st := b.srv.rendererStatus()
+	if st.State == stateStop || st.Song == "" {
+		return b.send(chat, "Nothing is playing.", nil)
+	}
+	info := st.SongInfo
+	caption := telegramSong(st.Song, info.Title, info.Artist)
+	if info.Album != "" {
+		caption += "\n" + info.Album
+	}
+	caption += fmt.Sprintf("\n%v / %v", st.Elapsed.Truncate(time.Second), st.Time.Truncate(time.Second))
+	if st.State == statePause {
+		caption += " (paused)"
+	}
+	if strings.HasPrefix(info.ImageURL, "http") {
+		return b.call("sendPhoto", map[string]interface{}{
+			"chat_id": chat,
+			"photo":   info.ImageURL,
+			"caption": caption,
+		}, nil)
+	}
+	if art := b.srv.art(st.Song); art != nil {
+		return b.sendPhoto(chat, art, caption)
+	}
+	return b.send(chat, caption, nil)
+}
+
+// sendPhoto uploads the image art.
+func (b *telegramBot) sendPhoto(chat int64, art []byte, caption string) error {
+	var buf bytes.Buffer
+	w := multipart.NewWriter(&buf)
+	w.WriteField("chat_id", strconv.FormatInt(chat, 10))
+	w.WriteField("caption", caption)
+	f, err := w.CreateFormFile("photo", "art")
+	if err != nil {
+		return err
+	}
+	f.Write(art)
+	if err := w.Close(); err != nil {
+		return err
+	}
+	return b.post("sendPhoto", w.FormDataContentType(), &buf, nil)
+}
+
+// art returns the artwork of a local song as ArtFile serves it, or nil if it
+// has none.
+func (srv *Server) art(id SongID) []byte {
+	if !localProtocols[id.Protocol()] {
+		return nil
+	}
+	req, err := http.NewRequest("GET", artURL, nil)
+	if err != nil {
+		return nil
+	}
+	w := &artWriter{header: make(http.Header), code: http.StatusOK}
+	srv.ArtFile(w, req, httprouter.Params{{Key: "id", Value: "/" + string(id)}})
+	if w.code != http.StatusOK || w.body.Len() == 0 {
+		return nil
+	}
+	return w.body.Bytes()
+}
+
+// artWriter is a ResponseWriter that keeps the response.
+type artWriter struct {
+	header http.Header
+	code   int
+	body   bytes.Buffer
+}
+
+func (w *artWriter) Header() http.Header         { return w.header }
+func (w *artWriter) Write(b []byte) (int, error) { return w.body.Write(b) }
+func (w *artWriter) WriteHeader(code int)        { w.code = code }
@@ -0,0 +1,141 @@
+package server
+
+import (
+	"bytes"
+	"fmt"
+	"image"
+	"image/color"
+	_ "image/gif"
+	"image/jpeg"
+	_ "image/png"
+	"io/ioutil"
+	"net/http"
+	"os"
+	"strconv"
+	"strings"
+
+	"github.com/julienschmidt/httprouter"
+)
+
+// maxThumbSize is the largest size of artwork thumbnails.
+const maxThumbSize = 1024
+
+// serveThumbnail serves a thumbnail of a song's artwork at most size pixels
+// wide and high. Thumbnails are made when requested and kept in the disk
+// cache; clients revalidate them with the ETag, which is answered without
+// decoding the artwork.
+func (srv *Server) serveThumbnail(w http.ResponseWriter, r *http.Request, ps httprouter.Params, size string) {
+	n, err := strconv.Atoi(size)
+	if err != nil || n < 1 || n > maxThumbSize {
+		http.Error(w, fmt.Sprintf("bad size: %s", size), http.StatusBadRequest)
+		return
+	}
+	b, fi, err := srv.artData(SongID(strings.TrimPrefix(ps.ByName("id"), "/")))
+	if os.IsNotExist(err) {
+		http.NotFound(w, r)
+		return
+	} else if err != nil {
+		serveError(w, err)
+		return
+	}
+	etag := strings.TrimSuffix(fileETag(fi), `"`) + "-" + size + `"`
+	w.Header().Set("ETag", etag)
+	w.Header().Set("Cache-Control", "no-cache")
+	if r.Header.Get("If-None-Match") == etag {
+		w.WriteHeader(http.StatusNotModified)
+		return
+	}
+	w.Header().Set("Content-Type", "image/jpeg")
+	// The ETag changes with the artwork's file and the size, so keys the
+	// thumbnail.
+	key := ps.ByName("id") + etag
+	if t, ok := srv.cache.get(cacheArtwork, key); ok {
+		http.ServeContent(w, r, "", fi.ModTime(), bytes.NewReader(t))
+		return
+	}
+	img, _, err := image.Decode(bytes.NewReader(b))
+	if err != nil {
+		serveError(w, err)
+		return
+	}
+	var buf bytes.Buffer
+	if err := jpeg.Encode(&buf, thumbnail(img, n), &jpeg.Options{Quality: 80}); err != nil {
+		serveError(w, err)
+		return
+	}
+	srv.cache.put(cacheArtwork, key, buf.Bytes())
+	http.ServeContent(w, r, "", fi.ModTime(), bytes.NewReader(buf.Bytes()))
+}
+
+// artData returns the artwork of id, embedded or in its directory, and the
+// info of the file it's in.
+func (srv *Server) artData(id SongID) ([]byte, os.FileInfo, error) {
+	if p, fi, ok := srv.embeddedArt(id); ok {
+		return p.Data, fi, nil
+	}
+	ch := make(chan fileResult)
+	srv.ch <- cmdOpenFile{
+		id:   id,
+		art:  true,
+		done: ch,
+	}
+	res := <-ch
+	if res.err != nil {
+		return nil, nil, res.err
+	}
+	defer res.f.Close()
+	fi, err := res.f.Stat()
+	if err != nil {
+		return nil, nil, err
+	}
+	b, err := ioutil.ReadAll(res.f)
+	return b, fi, err
+}
+
+// thumbnail returns src scaled down to fit in size by size pixels, each the
+// average of the pixels it covers, on white.
+func thumbnail(src image.Image, size int) image.Image {
+	b := src.Bounds()
+	w, h := b.Dx(), b.Dy()
+	if w <= size && h <= size {
+		return src
+	}
+	tw, th := size, size
+	if w > h {
+		th = h * size / w
+	} else {
+		tw = w * size / h
+	}
+	if tw < 1 {
+		tw = 1
+	}
+	if th < 1 {
+		th = 1
+	}
+	dst := image.NewRGBA(image.Rect(0, 0, tw, th))
+	for y := 0; y < th; y++ {
+		y0, y1 := b.Min.Y+y*h/th, b.Min.Y+(y+1)*h/th
+		for x := 0; x < tw; x++ {
+			x0, x1 := b.Min.X+x*w/tw, b.Min.X+(x+1)*w/tw
+			var r, g, bl, n uint64
+			for sy := y0; sy < y1; sy++ {
+				for sx := x0; sx < x1; sx++ {
+					cr, cg, cb, ca := src.At(sx, sy).RGBA()
+					// Colors are premultiplied, so add the white
+					// showing through.
+					r += uint64(cr + 0xffff - ca)
+					g += uint64(cg + 0xffff - ca)
+					bl += uint64(cb + 0xffff - ca)
+					n++
+				}
+			}
+			dst.SetRGBA(x, y, color.RGBA{
+				R: uint8(r / n >> 8),
+				G: uint8(g / n >> 8),
+				B: uint8(bl / n >> 8),
+				A: 0xff,
+			})
+		}
+	}
+	return dst
+}
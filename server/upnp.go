@@ -0,0 +1,315 @@
+package server
+
+import (
+	"bytes"
+	"encoding/xml"
+	"fmt"
+	"io"
+	"log"
+	"net"
+	"net/http"
+	"strings"
+	"time"
+)
+
+// UPnPName, if set, is the friendly name moggio advertises as a UPnP
+// device on the local network. UPnP requests are only accepted from local
+// network addresses.
+var UPnPName string
+
+// UPnPOpen serves the UPnP devices without authentication, which UPnP
+// controllers and players can't do. Anyone on the local network may then
+// control playback, have the server fetch URLs, and stream songs, bypassing
+// the owner token, roles, and party mode. Otherwise UPnP requests need the
+// owner token, if one is set.
+var UPnPOpen bool
+
+const (
+	upnpDeviceNS  = "urn:schemas-upnp-org:device-1-0"
+	upnpServiceNS = "urn:schemas-upnp-org:service-1-0"
+	soapEnvNS     = "http://schemas.xmlsoap.org/soap/envelope/"
+	soapEncoding  = "http://schemas.xmlsoap.org/soap/encoding/"
+)
+
+// upnpService is a UPnP service of a device, whose description and control
+// are at /upnp/<device>/<name>.xml and /upnp/<device>/<name>/control.
+type upnpService struct {
+	name    string
+	typ     string
+	actions []upnpAction
+	vars    []upnpVar
+	// handle performs an action, given its arguments, and returns its out
+	// arguments in order as name, value pairs.
+	handle func(action string, args map[string]string) ([]string, error)
+}
+
+// upnpAction is an action of a service. Arguments are of the form
+// Name=RelatedStateVariable.
+type upnpAction struct {
+	name    string
+	in, out []string
+}
+
+type upnpVar struct {
+	name    string
+	typ     string
+	events  bool
+	allowed []string
+}
+
+// upnpError is a UPnP error returned as a SOAP fault.
+type upnpError struct {
+	code int
+	desc string
+}
+
+func (e upnpError) Error() string {
+	return fmt.Sprintf("upnp error %d: %s", e.code, e.desc)
+}
+
+var (
+	errInvalidAction = upnpError{401, "Invalid Action"}
+	errInvalidArgs   = upnpError{402, "Invalid Args"}
+)
+
+// upnpDeviceHandler serves the description and services of a device at
+// /upnp/<name>/.
+func upnpDeviceHandler(name string, d upnpDevice, services []upnpService) http.Handler {
+	mux := http.NewServeMux()
+	prefix := "/upnp/" + name + "/"
+	mux.HandleFunc(d.path, func(w http.ResponseWriter, r *http.Request) {
+		var b bytes.Buffer
+		fmt.Fprintf(&b, `<?xml version="1.0" encoding="utf-8"?>
+<root xmlns="%s">
+<specVersion><major>1</major><minor>0</minor></specVersion>
+<device>
+<deviceType>%s</deviceType>
+<friendlyName>%s</friendlyName>
+<manufacturer>moggio</manufacturer>
+<manufacturerURL>https://github.com/mjibson/moggio</manufacturerURL>
+<modelName>moggio</modelName>
+<UDN>uuid:%s</UDN>
+<serviceList>
+`, upnpDeviceNS, d.typ, xmlEscape(UPnPName), d.uuid)
+		for _, s := range services {
+			fmt.Fprintf(&b, `<service>
+<serviceType>%s</serviceType>
+<serviceId>urn:upnp-org:serviceId:%s</serviceId>
+<SCPDURL>%s%s.xml</SCPDURL>
+<controlURL>%s%s/control</controlURL>
+<eventSubURL>%s%s/event</eventSubURL>
+</service>
+`, s.typ, s.name, prefix, s.name, prefix, s.name, prefix, s.name)
+		}
+		b.WriteString("</serviceList>\n</device>\n</root>\n")
+		w.Header().Set("Content-Type", `text/xml; charset="utf-8"`)
+		w.Write(b.Bytes())
+	})
+	for _, s := range services {
+		s := s
+		mux.HandleFunc(prefix+s.name+".xml", func(w http.ResponseWriter, r *http.Request) {
+			w.Header().Set("Content-Type", `text/xml; charset="utf-8"`)
+			w.Write(s.scpd())
+		})
+		mux.HandleFunc(prefix+s.name+"/control", s.serveControl)
+		mux.HandleFunc(prefix+s.name+"/event", serveEvent)
+	}
+	return mux
+}
+
+// scpd returns the service description.
+func (s upnpService) scpd() []byte {
+	var b bytes.Buffer
+	fmt.Fprintf(&b, `<?xml version="1.0" encoding="utf-8"?>
+<scpd xmlns="%s">
+<specVersion><major>1</major><minor>0</minor></specVersion>
+<actionList>
+`, upnpServiceNS)
+	for _, a := range s.actions {
+		fmt.Fprintf(&b, "<action><name>%s</name><argumentList>\n", a.name)
+		for _, args := range []struct {
+			dir  string
+			args []string
+		}{{"in", a.in}, {"out", a.out}} {
+			for _, arg := range args.args {
+				sp := strings.SplitN(arg, "=", 2)
+				fmt.Fprintf(&b, "<argument><name>%s</name><direction>%s</direction><relatedStateVariable>%s</relatedStateVariable></argument>\n", sp[0], args.dir, sp[1])
+			}
+		}
+		b.WriteString("</argumentList></action>\n")
+	}
+	b.WriteString("</actionList>\n<serviceStateTable>\n")
+	for _, v := range s.vars {
+		events := "no"
+		if v.events {
+			events = "yes"
+		}
+		fmt.Fprintf(&b, `<stateVariable sendEvents="%s"><name>%s</name><dataType>%s</dataType>`, events, v.name, v.typ)
+		if len(v.allowed) > 0 {
+			b.WriteString("<allowedValueList>")
+			for _, a := range v.allowed {
+				fmt.Fprintf(&b, "<allowedValue>%s</allowedValue>", a)
+			}
+			b.WriteString("</allowedValueList>")
+		}
+		b.WriteString("</stateVariable>\n")
+	}
+	b.WriteString("</serviceStateTable>\n</scpd>\n")
+	return b.Bytes()
+}
+
+// serveControl performs a SOAP action.
+func (s upnpService) serveControl(w http.ResponseWriter, r *http.Request) {
+	if r.Method != "POST" {
+		http.Error(w, "method not allowed", http.StatusMethodNotAllowed)
+		return
+	}
+	action, args, err := parseSOAP(r.Body)
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+	out, err := s.handle(action, args)
+	w.Header().Set("Content-Type", `text/xml; charset="utf-8"`)
+	w.Header().Set("Ext", "")
+	var b bytes.Buffer
+	fmt.Fprintf(&b, `<?xml version="1.0" encoding="utf-8"?>
+<s:Envelope xmlns:s="%s" s:encodingStyle="%s"><s:Body>`, soapEnvNS, soapEncoding)
+	if err != nil {
+		ue, ok := err.(upnpError)
+		if !ok {
+			log.Printf("upnp %s: %v", action, err)
+			ue = upnpError{501, "Action Failed"}
+		}
+		fmt.Fprintf(&b, `<s:Fault><faultcode>s:Client</faultcode><faultstring>UPnPError</faultstring><detail><UPnPError xmlns="urn:schemas-upnp-org:control-1-0"><errorCode>%d</errorCode><errorDescription>%s</errorDescription></UPnPError></detail></s:Fault>`, ue.code, ue.desc)
+		w.WriteHeader(http.StatusInternalServerError)
+	} else {
+		fmt.Fprintf(&b, `<u:%sResponse xmlns:u="%s">`, action, s.typ)
+		for i := 0; i+1 < len(out); i += 2 {
+			fmt.Fprintf(&b, "<%s>%s</%s>", out[i], xmlEscape(out[i+1]), out[i])
+		}
+		fmt.Fprintf(&b, "</u:%sResponse>", action)
+	}
+	b.WriteString("</s:Body></s:Envelope>")
+	w.Write(b.Bytes())
+}
+
+// parseSOAP returns the action and arguments of a SOAP request.
+func parseSOAP(r io.Reader) (string, map[string]string, error) {
+	d := xml.NewDecoder(r)
+	depth := 0
+	var action string
+	args := make(map[string]string)
+	for {
+		t, err := d.Token()
+		if err == io.EOF {
+			break
+		} else if err != nil {
+			return "", nil, err
+		}
+		switch t := t.(type) {
+		case xml.StartElement:
+			depth++
+			// Envelope, Body, action, arguments.
+			switch depth {
+			case 3:
+				action = t.Name.Local
+			case 4:
+				var v string
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return "", nil, err
+				}
+				args[t.Name.Local] = v
+				depth--
+			}
+		case xml.EndElement:
+			depth--
+		}
+	}
+	if action == "" {
+		return "", nil, fmt.Errorf("missing SOAP action")
+	}
+	return action, args, nil
+}
+
+// serveEvent accepts event subscriptions. Controllers are expected to poll,
+// so no events are sent.
+func serveEvent(w http.ResponseWriter, r *http.Request) {
+	switch r.Method {
+	case "SUBSCRIBE":
+		sid := r.Header.Get("Sid")
+		if sid == "" {
+			sid = "uuid:" + upnpUUID(fmt.Sprint(time.Now().UnixNano()))
+		}
+		w.Header().Set("Sid", sid)
+		w.Header().Set("Timeout", "Second-1800")
+	case "UNSUBSCRIBE":
+	default:
+		http.Error(w, "method not allowed", http.StatusMethodNotAllowed)
+	}
+}
+
+func xmlEscape(s string) string {
+	var b bytes.Buffer
+	xml.EscapeText(&b, []byte(s))
+	return b.String()
+}
+
+// upnpTime formats d as H:MM:SS.
+func upnpTime(d time.Duration) string {
+	d /= time.Second
+	return fmt.Sprintf("%d:%02d:%02d", d/3600, d/60%60, d%60)
+}
+
+// parseUPnPTime parses H+:MM:SS[.F+].
+func parseUPnPTime(s string) (time.Duration, error) {
+	var h, m int
+	var sec float64
+	if _, err := fmt.Sscanf(strings.TrimSpace(s), "%d:%d:%f", &h, &m, &sec); err != nil {
+		return 0, fmt.Errorf("bad time: %s", s)
+	}
+	return time.Duration(h)*time.Hour + time.Duration(m)*time.Minute + time.Duration(sec*float64(time.Second)), nil
+}
+
+// localOnly wraps h to refuse requests from outside the local network:
+// those not from loopback, link-local, or private addresses.
+func localOnly(h http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		ip := net.ParseIP(remoteIP(r))
+		if ip == nil || !(ip.IsLoopback() || ip.IsLinkLocalUnicast() || ip.IsPrivate()) {
+			http.Error(w, "forbidden", http.StatusForbidden)
+			return
+		}
+		h.ServeHTTP(w, r)
+	})
+}
+
+// upnpHandler returns the handler of the UPnP devices, and starts
+// advertising them, if UPnPName is set.
+func (srv *Server) upnpHandler(addr string) http.Handler {
+	if UPnPName == "" {
+		return http.NotFoundHandler()
+	}
+	_, port, err := net.SplitHostPort(addr)
+	if err != nil {
+		log.Println("upnp:", err)
+		return http.NotFoundHandler()
+	}
+	mux := http.NewServeMux()
+	var devices []upnpDevice
+	add := func(name string, d upnpDevice, services []upnpService) {
+		for _, s := range services {
+			d.services = append(d.services, s.typ)
+		}
+		devices = append(devices, d)
+		mux.Handle("/upnp/"+name+"/", upnpDeviceHandler(name, d, services))
+	}
+	d, services := srv.upnpRenderer()
+	add("renderer", d, services)
+	d, services, files := srv.upnpMediaServer(port)
+	add("server", d, services)
+	mux.Handle("/upnp/server/song/", files)
+	mux.Handle("/upnp/server/art/", files)
+	go serveSSDP(port, devices)
+	return mux
+}
@@ -0,0 +1,301 @@
+package server
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"sort"
+	"sync"
+
+	"github.com/julienschmidt/httprouter"
+)
+
+// User is a named profile with its own playlists and listening history,
+// selected by its auth token. Users share the library, queue, and output.
+type User struct {
+	Token     string
+	Role      Role
+	Playlists map[string]Playlist
+	History   []SongID
+	Bookmarks map[SongID][]Bookmark
+}
+
+func (u *User) role() Role {
+	if u.Role == "" {
+		return RoleUser
+	}
+	return u.Role
+}
+
+// These are the httprouter parameters JSON handlers receive who made the
+// request in: the user's name, empty for the owner and guests; a description
+// for the audit log; and the remote address.
+const (
+	paramUser = "moggio-user"
+	paramWho  = "moggio-who"
+	paramAddr = "moggio-addr"
+)
+
+// actor is who made a request.
+type actor struct {
+	user string
+	role Role
+}
+
+// who describes a: the user's name, or owner or guest.
+func (a actor) who() string {
+	switch {
+	case a.user != "":
+		return a.user
+	case a.role == RoleGuest:
+		return "guest"
+	}
+	return "owner"
+}
+
+type actorKey struct{}
+
+func withActor(r *http.Request, a actor) *http.Request {
+	return r.WithContext(context.WithValue(r.Context(), actorKey{}, a))
+}
+
+// requestActor returns who made r. Requests without one were made by the
+// owner.
+func requestActor(r *http.Request) actor {
+	if a, ok := r.Context().Value(actorKey{}).(actor); ok {
+		return a
+	}
+	return actor{role: RoleAdmin}
+}
+
+// requestUser returns the name of the user that made r, or empty for the
+// owner.
+func requestUser(r *http.Request) string {
+	return requestActor(r).user
+}
+
+// userTokens maps auth tokens to user names for HTTP handlers, which run
+// outside of the commands() go routine.
+type userTokens struct {
+	sync.Mutex
+	users map[string]tokenUser
+}
+
+type tokenUser struct {
+	name string
+	role Role
+}
+
+func (u *userTokens) set(users map[string]*User) {
+	u.Lock()
+	u.users = make(map[string]tokenUser)
+	for name, user := range users {
+		u.users[user.Token] = tokenUser{name, user.role()}
+	}
+	u.Unlock()
+}
+
+// lookup returns the name and role of the user with token, or an empty name
+// if there is none.
+func (u *userTokens) lookup(token string) (string, Role) {
+	if token == "" {
+		return "", ""
+	}
+	u.Lock()
+	defer u.Unlock()
+	t := u.users[token]
+	return t.name, t.role
+}
+
+// playlists returns the playlists of user, or the owner's if user is empty.
+// It returns nil if there is no such user. It should only be called by the
+// commands() function.
+func (srv *Server) playlists(user string) map[string]Playlist {
+	if user == "" {
+		return srv.Playlists
+	}
+	u := srv.Users[user]
+	if u == nil {
+		return nil
+	}
+	if u.Playlists == nil {
+		u.Playlists = make(map[string]Playlist)
+	}
+	return u.Playlists
+}
+
+// history returns the songs played for user, or all played songs if user is
+// empty. It should only be called by the commands() function.
+func (srv *Server) history(user string) []SongID {
+	if user == "" {
+		return srv.History
+	}
+	if u := srv.Users[user]; u != nil {
+		return u.History
+	}
+	return nil
+}
+
+// UserInfo describes a user to the owner.
+type UserInfo struct {
+	Name      string
+	Token     string
+	Role      Role
+	Playlists int
+	History   int
+}
+
+func (srv *Server) GetUsers(body io.Reader, form url.Values, ps httprouter.Params) (interface{}, error) {
+	ch := make(chan []UserInfo)
+	srv.ch <- cmdUsers(ch)
+	return <-ch, nil
+}
+
+// UserAdd creates a user, with the user role if none is given. If no token
+// is given, one is generated. The user, with its token, is returned.
+func (srv *Server) UserAdd(body io.Reader, form url.Values, ps httprouter.Params) (interface{}, error) {
+	var u struct {
+		Name  string
+		Token string
+		Role  Role
+	}
+	if err := json.NewDecoder(body).Decode(&u); err != nil {
+		return nil, err
+	}
+	if u.Name == "" {
+		return nil, fmt.Errorf("missing name")
+	}
+	if u.Role == "" {
+		u.Role = RoleUser
+	}
+	if !u.Role.valid() {
+		return nil, fmt.Errorf("unknown role: %v", u.Role)
+	}
+	if u.Token == "" {
+		b := make([]byte, 16)
+		if _, err := rand.Read(b); err != nil {
+			return nil, err
+		}
+		u.Token = hex.EncodeToString(b)
+	}
+	if u.Token == OwnerToken {
+		return nil, fmt.Errorf("token in use")
+	}
+	srv.audit(ps, "user add", u.Name)
+	ch := make(chan error)
+	srv.ch <- cmdUserAdd{
+		name:  u.Name,
+		token: u.Token,
+		role:  u.Role,
+		err:   ch,
+	}
+	if err := <-ch; err != nil {
+		return nil, err
+	}
+	return UserInfo{
+		Name:  u.Name,
+		Token: u.Token,
+		Role:  u.Role,
+	}, nil
+}
+
+func (srv *Server) UserSetRole(body io.Reader, form url.Values, ps httprouter.Params) (interface{}, error) {
+	var u struct {
+		Name string
+		Role Role
+	}
+	if err := json.NewDecoder(body).Decode(&u); err != nil {
+		return nil, err
+	}
+	if !u.Role.valid() {
+		return nil, fmt.Errorf("unknown role: %v", u.Role)
+	}
+	srv.audit(ps, "user role", u.Name+" "+string(u.Role))
+	ch := make(chan error)
+	srv.ch <- cmdUserSetRole{
+		name: u.Name,
+		role: u.Role,
+		err:  ch,
+	}
+	return nil, <-ch
+}
+
+func (srv *Server) UserRemove(body io.Reader, form url.Values, ps httprouter.Params) (interface{}, error) {
+	var name string
+	if err := json.NewDecoder(body).Decode(&name); err != nil {
+		return nil, err
+	}
+	srv.audit(ps, "user remove", name)
+	ch := make(chan error)
+	srv.ch <- cmdUserRemove{
+		name: name,
+		err:  ch,
+	}
+	return nil, <-ch
+}
+
+// GetHistory returns the songs played for the requesting user, most recent
+// first.
+func (srv *Server) GetHistory(body io.Reader, form url.Values, ps httprouter.Params) (interface{}, error) {
+	ch := make(chan PlaylistInfo)
+	srv.ch <- cmdHistory{
+		user: ps.ByName(paramUser),
+		done: ch,
+	}
+	return <-ch, nil
+}
+
+// users should only be called by the commands() function.
+func (srv *Server) users() []UserInfo {
+	r := []UserInfo{}
+	for name, u := range srv.Users {
+		r = append(r, UserInfo{
+			Name:      name,
+			Token:     u.Token,
+			Role:      u.role(),
+			Playlists: len(u.Playlists),
+			History:   len(u.History),
+		})
+	}
+	sort.Slice(r, func(i, j int) bool {
+		return r[i].Name < r[j].Name
+	})
+	return r
+}
+
+type cmdUsers chan []UserInfo
+
+type cmdUserAdd struct {
+	name  string
+	token string
+	role  Role
+	err   chan error
+}
+
+type cmdUserSetRole struct {
+	name string
+	role Role
+	err  chan error
+}
+
+type cmdUserRemove struct {
+	name string
+	err  chan error
+}
+
+type cmdHistory struct {
+	user string
+	done chan PlaylistInfo
+}
+
+// cmdListen sets the user on whose behalf cmd, a playback command, plays
+// songs.
+type cmdListen struct {
+	user string
+	cmd  interface{}
+}
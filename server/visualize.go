@@ -0,0 +1,127 @@
+package server
+
+import (
+	"sync"
+	"time"
+
+	"github.com/mjibson/moggio/dsp"
+	"golang.org/x/net/websocket"
+)
+
+// visRate is how often levels are sent to visualization clients.
+const visRate = time.Second / 30
+
+const waitVis waitType = "vis"
+
+// visualizer sends the levels of playing audio to websocket clients. It is
+// used by the audio() and HTTP go routines, so has its own lock.
+type visualizer struct {
+	sync.Mutex
+	analyzer *dsp.Analyzer
+	clients  map[chan dsp.Levels]bool
+	// delay is how long the audio written waits in the output before it is
+	// heard, by which its levels are delayed.
+	delay time.Duration
+}
+
+// visFrame is levels to be sent once their audio is heard, at due.
+type visFrame struct {
+	due    time.Time
+	levels dsp.Levels
+}
+
+// reset starts analyzing a stream of a new format.
+func (v *visualizer) reset(sampleRate, channels int) {
+	v.Lock()
+	v.analyzer = dsp.NewAnalyzer(sampleRate, channels)
+	v.Unlock()
+}
+
+// write analyzes samples pushed to the output, heard after delay.
+func (v *visualizer) write(samples []float32, delay time.Duration) {
+	v.Lock()
+	a := v.analyzer
+	v.delay = delay
+	v.Unlock()
+	if a != nil {
+		a.Write(samples)
+	}
+}
+
+// run sends levels to clients until the process exits. When audio stops, a
+// silent frame is sent so meters fall.
+func (v *visualizer) run() {
+	idle := true
+	var pending []visFrame
+	for now := range time.Tick(visRate) {
+		v.Lock()
+		a, n, delay := v.analyzer, len(v.clients), v.delay
+		v.Unlock()
+		if a == nil || n == 0 {
+			pending = nil
+			continue
+		}
+		if l, ok := a.Levels(); ok || !idle {
+			idle = !ok
+			pending = append(pending, visFrame{now.Add(delay), l})
+		}
+		// Send the latest levels heard.
+		i := 0
+		for i < len(pending) && !pending[i].due.After(now) {
+			i++
+		}
+		if i == 0 {
+			continue
+		}
+		l := pending[i-1].levels
+		pending = pending[i:]
+		v.Lock()
+		for c := range v.clients {
+			// Drop frames for slow clients.
+			select {
+			case c <- l:
+			default:
+			}
+		}
+		v.Unlock()
+	}
+}
+
+// Visualization streams audio levels at about 30 Hz.
+func (srv *Server) Visualization(ws *websocket.Conn) {
+	c := make(chan dsp.Levels, 1)
+	v := &srv.vis
+	v.Lock()
+	if v.clients == nil {
+		v.clients = make(map[chan dsp.Levels]bool)
+	}
+	v.clients[c] = true
+	v.Unlock()
+	defer func() {
+		v.Lock()
+		delete(v.clients, c)
+		v.Unlock()
+	}()
+	closed := make(chan struct{})
+	go func() {
+		// Notice closed connections, which aren't written to while audio
+		// is stopped. Clients don't send anything.
+		var msg []byte
+		for {
+			if err := websocket.Message.Receive(ws, &msg); err != nil {
+				close(closed)
+				return
+			}
+		}
+	}()
+	for {
+		select {
+		case l := <-c:
+			if err := websocket.JSON.Send(ws, &waitData{Type: waitVis, Data: l}); err != nil {
+				return
+			}
+		case <-closed:
+			return
+		}
+	}
+}
@@ -0,0 +1,94 @@
+package server
+
+import (
+	"fmt"
+	"io"
+	"net/url"
+	"strconv"
+	"strings"
+
+	"github.com/boltdb/bolt"
+	"github.com/julienschmidt/httprouter"
+	"github.com/mjibson/moggio/dsp"
+)
+
+// dbWaveform is the bucket waveforms were saved in before the disk cache
+// held them.
+const dbWaveform = "waveform"
+
+// defaultWaveformPoints is the number of points returned if not specified.
+const defaultWaveformPoints = 1000
+
+// loadWaveform returns the cached waveform of id, or nil. Evicted
+// waveforms are made again by analysis.
+func (srv *Server) loadWaveform(id SongID) []byte {
+	peaks, _ := srv.cache.get(cacheWaveform, string(id))
+	return peaks
+}
+
+func (srv *Server) saveWaveform(id SongID, peaks []byte) {
+	srv.cache.put(cacheWaveform, string(id), peaks)
+}
+
+// moveWaveforms moves the waveforms saved in the database to the disk
+// cache.
+func (srv *Server) moveWaveforms() error {
+	return srv.update(func(tx *bolt.Tx) error {
+		b := tx.Bucket([]byte(dbWaveform))
+		if b == nil {
+			return nil
+		}
+		b.ForEach(func(k, v []byte) error {
+			srv.cache.put(cacheWaveform, string(k), v)
+			return nil
+		})
+		return tx.DeleteBucket([]byte(dbWaveform))
+	})
+}
+
+// Waveform returns the waveform overview of a song: its peak levels, 0-255,
+// at the points parameter's resolution. If the song hasn't been analyzed
+// yet, analysis is started and Ready is false.
+func (srv *Server) Waveform(body io.Reader, form url.Values, ps httprouter.Params) (interface{}, error) {
+	id := SongID(strings.TrimPrefix(ps.ByName("id"), "/"))
+	points := defaultWaveformPoints
+	if s := form.Get("points"); s != "" {
+		var err error
+		points, err = strconv.Atoi(s)
+		if err != nil || points < 1 {
+			return nil, fmt.Errorf("bad points: %v", s)
+		}
+	}
+	peaks := srv.loadWaveform(id)
+	if peaks == nil {
+		ch := make(chan error)
+		srv.ch <- cmdWaveform{
+			id:  id,
+			err: ch,
+		}
+		if err := <-ch; err != nil {
+			return nil, err
+		}
+		return struct{ Ready bool }{}, nil
+	}
+	d := dsp.DownsamplePeaks(peaks, points)
+	res := struct {
+		Ready bool
+		// Seconds is the duration of each peak.
+		Seconds float64
+		Peaks   []int
+	}{
+		Ready:   true,
+		Seconds: float64(len(peaks)) / dsp.PeaksPerSecond / float64(len(d)),
+		Peaks:   make([]int, len(d)),
+	}
+	for i, v := range d {
+		res.Peaks[i] = int(v)
+	}
+	return res, nil
+}
+
+type cmdWaveform struct {
+	id  SongID
+	err chan error
+}
@@ -2,18 +2,27 @@ package server
 
 import (
 	"bytes"
+	"compress/gzip"
+	"context"
 	"encoding/json"
+	"expvar"
 	"fmt"
 	"html/template"
 	"io"
 	"io/ioutil"
 	"log"
+	"net"
 	"net/http"
 	"net/url"
+	"os"
+	"os/signal"
 	"strconv"
+	"strings"
+	"syscall"
 	"time"
 
 	"github.com/julienschmidt/httprouter"
+	"github.com/mjibson/moggio/dsp"
 	"github.com/mjibson/moggio/protocol"
 	"golang.org/x/net/websocket"
 )
@@ -28,6 +37,14 @@ func (srv *Server) GetMux(devMode bool) *http.ServeMux {
 	if devMode {
 		log.Println("using local web assets")
 	}
+	static, err := newAssets(webFS, devMode)
+	if err != nil {
+		log.Fatal(err)
+	}
+	sw, err := static.serviceWorker()
+	if err != nil {
+		log.Fatal(err)
+	}
 	index, err := webFS.Open("/static/index.html")
 	if err != nil {
 		log.Fatal(err)
@@ -36,7 +53,9 @@ func (srv *Server) GetMux(devMode bool) *http.ServeMux {
 	if err != nil {
 		log.Fatal(err)
 	}
-	tmpl := template.Must(template.New("").Parse(string(b)))
+	tmpl := template.Must(template.New("").Funcs(template.FuncMap{
+		"asset": static.url,
+	}).Parse(string(b)))
 	var buf bytes.Buffer
 	if err := tmpl.Execute(&buf, struct {
 		Version string
@@ -56,28 +75,179 @@ func (srv *Server) GetMux(devMode bool) *http.ServeMux {
 	router.POST("/api/protocol/add", JSON(srv.ProtocolAdd))
 	router.POST("/api/protocol/remove", JSON(srv.ProtocolRemove))
 	router.POST("/api/protocol/refresh", JSON(srv.ProtocolRefresh))
+	router.POST("/api/protocol/settings", JSON(srv.ProtocolSettings))
+	router.GET("/api/search", JSON(srv.Search))
+	router.GET("/api/eq", JSON(srv.GetEQ))
+	router.POST("/api/eq", JSON(srv.SetEQ))
+	router.GET("/api/plugins", JSON(srv.GetPlugins))
+	router.POST("/api/plugins", JSON(srv.SetPlugins))
+	router.GET("/api/stereo", JSON(srv.GetStereo))
+	router.POST("/api/stereo", JSON(srv.SetStereo))
+	router.GET("/api/hooks", JSON(srv.GetHooks))
+	router.POST("/api/hooks", localSettings(JSON(srv.SetHooks)))
+	router.GET("/api/ha", JSON(srv.HAInfo))
+	router.GET("/api/ha/state", JSON(srv.HAState))
+	router.GET("/api/ha/browse", JSON(srv.HABrowse))
+	router.POST("/api/ha/service/:service", JSON(srv.HAService))
+	router.GET("/api/webhooks", JSON(srv.GetWebhooks))
+	router.POST("/api/webhooks/add", JSON(srv.AddWebhook))
+	router.POST("/api/webhooks/update", JSON(srv.UpdateWebhook))
+	router.POST("/api/webhooks/remove", JSON(srv.RemoveWebhook))
+	router.GET("/api/outputs", JSON(srv.Outputs))
+	router.GET("/api/codecs", JSON(srv.GetCodecs))
+	router.POST("/api/codecs", JSON(srv.SetCodecOptions))
+	router.POST("/api/codecs/test/*id", JSON(srv.TestDecode))
+	router.GET("/api/bluetooth", JSON(srv.GetBluetooth))
+	router.POST("/api/bluetooth/settings", JSON(srv.BluetoothSettings))
+	router.GET("/api/input", JSON(srv.GetInput))
+	router.POST("/api/input", JSON(srv.SetInput))
+	router.GET("/api/gpio", JSON(srv.GetGPIO))
+	router.POST("/api/gpio", JSON(srv.SetGPIO))
+	router.GET("/api/waveform/*id", JSON(srv.Waveform))
+	router.GET("/api/preview/*id", srv.Preview)
+	router.GET("/api/duplicates", JSON(srv.Duplicates))
+	router.POST("/api/duplicates/merge", JSON(srv.DuplicatesMerge))
+	router.POST("/api/duplicates/settings", JSON(srv.DuplicatesSettings))
+	router.POST("/api/duplicates/unhide", JSON(srv.DuplicatesUnhide))
+	router.GET("/api/library/health", JSON(srv.LibraryHealth))
+	router.GET("/api/library/sync", srv.LibrarySync)
+	router.GET("/api/library/export", srv.LibraryExport)
+	router.GET("/api/tagrules", JSON(srv.GetTagRules))
+	router.GET("/api/parental", JSON(srv.GetParental))
+	router.POST("/api/parental", JSON(srv.SetParental))
+	router.GET("/api/announce", JSON(srv.GetAnnouncer))
+	router.POST("/api/announce", localSettings(JSON(srv.SetAnnouncer)))
+	router.GET("/api/classical/composers", JSON(srv.ClassicalComposers))
+	router.GET("/api/classical/works", JSON(srv.ClassicalWorks))
+	router.POST("/api/tagrules", JSON(srv.SetTagRules))
+	router.POST("/api/library/health/check", JSON(srv.LibraryHealthCheck))
+	router.POST("/api/library/health/remove", JSON(srv.LibraryHealthRemove))
+	router.POST("/api/library/health/relocate", JSON(srv.LibraryHealthRelocate))
+	router.GET("/api/library/relocations", JSON(srv.Relocations))
+	router.POST("/api/library/relocations/apply", JSON(srv.RelocationsApply))
+	router.POST("/api/library/relocations/dismiss", JSON(srv.RelocationsDismiss))
+	router.GET("/api/cache", JSON(srv.GetCache))
+	router.POST("/api/cache", JSON(srv.SetCache))
+	router.POST("/api/cache/clear", JSON(srv.ClearCache))
+	router.GET("/api/sync", JSON(srv.GetSync))
+	router.POST("/api/sync", JSON(srv.SetSync))
+	router.POST("/api/sync/run", JSON(srv.SyncRun))
+	router.GET("/api/record", JSON(srv.GetRecord))
+	router.POST("/api/record", JSON(srv.SetRecord))
+	router.POST("/api/record/start", JSON(srv.RecordStart))
+	router.POST("/api/record/stop", JSON(srv.RecordStop))
+	router.GET("/api/levels", JSON(srv.Levels))
+	router.GET("/api/render", JSON(srv.GetRender))
+	router.POST("/api/render/start", JSON(srv.RenderStart))
+	router.POST("/api/render/cancel", JSON(srv.RenderCancel))
+	router.GET("/api/render/file", srv.RenderFile)
+	router.GET("/api/import", JSON(srv.GetImport))
+	router.POST("/api/import/settings", JSON(srv.ImportSettings))
+	router.POST("/api/import/run", JSON(srv.ImportRun))
+	router.GET("/api/analyze", JSON(srv.GetAnalyze))
+	router.POST("/api/analyze", JSON(srv.Analyze))
+	router.POST("/api/analyze/cancel", JSON(srv.AnalyzeCancel))
+	router.GET("/api/attributes/*id", JSON(srv.SongAttributes))
+	router.GET("/api/song/*id", srv.SongFile)
+	router.GET("/api/art/*id", srv.ArtFile)
+	router.GET("/api/download/*id", srv.Download)
+	router.GET("/api/export", srv.Export)
+	router.GET("/api/recommendations", JSON(srv.Recommendations))
+	router.POST("/api/import/spotify", JSON(srv.SpotifyImport))
+	router.POST("/api/import/itunes", JSON(srv.ITunesImport))
+	router.GET("/api/stats/*id", JSON(srv.GetStats))
+	router.POST("/api/rating", JSON(srv.SetRating))
+	router.GET("/api/backup", srv.Backup)
+	router.POST("/api/restore", JSON(srv.Restore))
+	router.POST("/api/party", JSON(srv.PartySet))
+	router.POST("/api/party/add", srv.PartyAdd)
+	router.POST("/api/party/skip", srv.PartySkip)
+	router.GET("/api/users", JSON(srv.GetUsers))
+	router.POST("/api/users/add", JSON(srv.UserAdd))
+	router.POST("/api/users/remove", JSON(srv.UserRemove))
+	router.POST("/api/users/role", JSON(srv.UserSetRole))
+	router.GET("/api/history", JSON(srv.GetHistory))
+	router.GET("/api/cleanup", JSON(srv.GetCleanup))
+	router.POST("/api/cleanup", JSON(srv.SetCleanup))
+	router.GET("/api/positions", JSON(srv.GetPositions))
+	router.POST("/api/positions/set", JSON(srv.SetPosition))
+	router.POST("/api/positions/clear", JSON(srv.ClearPositions))
+	router.GET("/api/bookmarks", JSON(srv.GetBookmarks))
+	router.POST("/api/bookmarks/add", JSON(srv.AddBookmark))
+	router.POST("/api/bookmarks/remove", JSON(srv.RemoveBookmark))
+	router.POST("/api/bookmarks/jump", JSON(srv.JumpBookmark))
+	router.GET("/api/instances", JSON(srv.Instances))
+	router.POST("/api/handoff", JSON(srv.HandoffSend))
+	router.POST("/api/handoff/receive", JSON(srv.HandoffReceive))
+	router.GET("/api/audit", JSON(srv.Audit))
 
 	// Needs POST from local moggio. Needs GET from App Engine redirect.
 	router.GET("/api/token/register", srv.TokenRegister)
 	router.POST("/api/token/register", srv.TokenRegister)
 
 	mux := http.NewServeMux()
-	mux.Handle("/static/", http.FileServer(webFS))
+	mux.Handle("/static/", static)
+	mux.HandleFunc("/sw.js", serveServiceWorker(sw))
 	mux.HandleFunc("/", Index)
 	mux.Handle("/api/", router)
+	mux.Handle("/debug/vars", expvar.Handler())
 	mux.Handle("/ws/", websocket.Handler(srv.WebSocket))
+	mux.Handle("/ws/vis", websocket.Handler(srv.Visualization))
 	return mux
 }
 
+// shutdownTimeout is how long requests in progress are given to finish at
+// shutdown.
+const shutdownTimeout = time.Second * 5
+
 // ListenAndServe listens on the TCP network address addr and then calls
-// Serve to handle requests on incoming connections.
+// Serve to handle requests on incoming connections. It returns nil after
+// an interrupt or termination signal, once requests and reads in progress
+// are canceled.
 func (srv *Server) ListenAndServe(addr string, devMode bool) error {
-	mux := srv.GetMux(devMode)
+	mux := http.NewServeMux()
+	mux.Handle("/", cors(srv.authorize(srv.GetMux(devMode))))
+	// UPnP controllers can't authenticate, so are only authorized if the
+	// UPnP devices aren't open.
+	upnp := srv.upnpHandler(addr)
+	if !UPnPOpen {
+		upnp = srv.authorize(upnp)
+	}
+	mux.Handle("/upnp/", localOnly(upnp))
+	hs := &http.Server{
+		Addr:    addr,
+		Handler: mux,
+		// Cancel requests at shutdown.
+		BaseContext: func(net.Listener) context.Context {
+			return srv.ctx
+		},
+	}
+	go func() {
+		sig := make(chan os.Signal, 1)
+		signal.Notify(sig, os.Interrupt, syscall.SIGTERM)
+		<-sig
+		log.Println("moggio: shutting down")
+		srv.shutdown()
+		ctx, cancel := context.WithTimeout(context.Background(), shutdownTimeout)
+		defer cancel()
+		if err := hs.Shutdown(ctx); err != nil {
+			log.Println(err)
+		}
+	}()
+	go serveMDNS(addr)
+	if TelegramToken != "" {
+		go srv.runTelegram()
+	}
 	log.Println("moggio: listening on", addr)
-	return http.ListenAndServe(addr, mux)
+	if err := hs.ListenAndServe(); err != http.ErrServerClosed {
+		return err
+	}
+	return nil
 }
 
 func Index(w http.ResponseWriter, r *http.Request) {
+	// The page names the versions of assets, so must be revalidated.
+	w.Header().Set("Cache-Control", "no-cache")
 	w.Write(indexHTML)
 }
 
@@ -92,6 +262,13 @@ func JSON(h func(io.Reader, url.Values, httprouter.Params) (interface{}, error))
 			serveError(w, err)
 			return
 		}
+		a := requestActor(r)
+		ps = append(ps,
+			httprouter.Param{Key: paramUser, Value: a.user},
+			httprouter.Param{Key: paramWho, Value: a.who()},
+			httprouter.Param{Key: paramAddr, Value: remoteIP(r)},
+			httprouter.Param{Key: paramProfile, Value: requestProfile(r)},
+		)
 		d, err := h(r.Body, r.Form, ps)
 		if err != nil {
 			serveError(w, err)
@@ -100,14 +277,56 @@ func JSON(h func(io.Reader, url.Values, httprouter.Params) (interface{}, error))
 		if d == nil {
 			return
 		}
-		b, err := json.Marshal(d)
-		if err != nil {
-			serveError(w, err)
-			return
-		}
-		w.Header().Add("Content-Type", "application/json")
+		serveData(w, r, d)
+	}
+}
+
+// serveData writes d as JSON, or MessagePack if r accepts it, compressed if
+// r accepts that.
+func serveData(w http.ResponseWriter, r *http.Request, d interface{}) {
+	marshal, contentType := json.Marshal, "application/json"
+	if acceptsMsgpack(r) {
+		marshal, contentType = msgpackMarshal, msgpackType
+	}
+	b, err := marshal(d)
+	if err != nil {
+		serveError(w, err)
+		return
+	}
+	w.Header().Add("Content-Type", contentType)
+	w.Header().Add("Vary", "Accept")
+	writeCompressed(w, r, b)
+}
+
+// minCompress is the size of the smallest response compressed.
+const minCompress = 1024
+
+// writeCompressed writes b to w, gzipped if r accepts it and b is large
+// enough to benefit.
+func writeCompressed(w http.ResponseWriter, r *http.Request, b []byte) {
+	w.Header().Add("Vary", "Accept-Encoding")
+	if len(b) < minCompress || !acceptsGzip(r) {
 		w.Write(b)
+		return
 	}
+	w.Header().Set("Content-Encoding", "gzip")
+	gz := gzip.NewWriter(w)
+	gz.Write(b)
+	gz.Close()
+}
+
+func acceptsGzip(r *http.Request) bool {
+	for _, e := range strings.Split(r.Header.Get("Accept-Encoding"), ",") {
+		sp := strings.Split(e, ";")
+		if strings.TrimSpace(sp[0]) != "gzip" {
+			continue
+		}
+		if len(sp) > 1 && strings.Replace(sp[1], " ", "", -1) == "q=0" {
+			return false
+		}
+		return true
+	}
+	return false
 }
 
 func (srv *Server) OAuth(w http.ResponseWriter, r *http.Request, ps httprouter.Params) {
@@ -125,25 +344,58 @@ func (srv *Server) OAuth(w http.ResponseWriter, r *http.Request, ps httprouter.P
 	http.Redirect(w, r, "/", http.StatusFound)
 }
 
+// Data returns the data of a type, as sent over the websocket. Tracks may be
+// paged with the list options.
 func (srv *Server) Data(body io.Reader, form url.Values, ps httprouter.Params) (interface{}, error) {
+	opts, err := parseListOptions(form)
+	if err != nil {
+		return nil, err
+	}
 	ch := make(chan *waitData)
 	srv.ch <- cmdWaitData{
 		wt:   waitType(ps.ByName("type")),
+		user: ps.ByName(paramUser),
 		done: ch,
 	}
-	return <-ch, nil
+	wd := <-ch
+	if ps.ByName(paramProfile) == profileMobile {
+		// Libraries are synced instead of paged.
+		if t, ok := wd.Data.(tracksData); ok {
+			wd.Data = srv.mobile.since(form.Get("since"), mobileSongs(t.Tracks))
+			return wd, nil
+		}
+		return srv.mobileData(wd), nil
+	}
+	if t, ok := wd.Data.(tracksData); ok && opts != nil {
+		wd.Data = opts.page(t.Tracks)
+	}
+	return wd, nil
 }
 
 func (srv *Server) Cmd(body io.Reader, form url.Values, ps httprouter.Params) (interface{}, error) {
-	switch cmd := ps.ByName("cmd"); cmd {
+	// listen sends c, a command that plays songs, on behalf of the user.
+	listen := func(c interface{}) {
+		srv.ch <- cmdListen{
+			user: ps.ByName(paramUser),
+			cmd:  c,
+		}
+	}
+	cmd := ps.ByName("cmd")
+	detail := auditForm(form)
+	switch cmd {
+	case "stop", "next", "prev", "play_idx", "play_track", "play_playlist":
+		// Don't wait for a song that is still opening.
+		srv.playing.interrupt()
+	}
+	switch cmd {
 	case "play":
-		srv.ch <- cmdPlay
+		listen(cmdPlay)
 	case "stop":
 		srv.ch <- cmdStop
 	case "next":
-		srv.ch <- cmdNext
+		listen(cmdNext)
 	case "prev":
-		srv.ch <- cmdPrev
+		listen(cmdPrev)
 	case "pause":
 		srv.ch <- cmdPause
 	case "play_idx":
@@ -151,32 +403,228 @@ func (srv *Server) Cmd(body io.Reader, form url.Values, ps httprouter.Params) (i
 		if err != nil {
 			return nil, err
 		}
-		srv.ch <- cmdPlayIdx(i)
+		listen(cmdPlayIdx(i))
 	case "play_track":
+		// Replace the queue with the song's album, playing from it.
 		var uid string
 		if err := json.NewDecoder(body).Decode(&uid); err != nil {
 			return nil, err
 		}
-		srv.ch <- cmdPlayTrack(uid)
+		listen(cmdPlayTrack(uid))
+		detail = uid
+	case "play_playlist":
+		// Replace the queue with the playlist name, playing from idx.
+		var idx int
+		if v := form.Get("idx"); v != "" {
+			i, err := strconv.Atoi(v)
+			if err != nil {
+				return nil, err
+			}
+			idx = i
+		}
+		listen(cmdPlayPlaylist{
+			name: form.Get("name"),
+			user: ps.ByName(paramUser),
+			idx:  idx,
+		})
+	case "insert_next":
+		// Insert songs after the current song.
+		var uids []string
+		if err := json.NewDecoder(body).Decode(&uids); err != nil {
+			return nil, err
+		}
+		ids := make(cmdInsertNext, len(uids))
+		for i, uid := range uids {
+			ids[i] = SongID(uid)
+		}
+		srv.ch <- ids
+		detail = strings.Join(uids, " ")
+	case "append_album":
+		// Append the album of a song in disc and track order.
+		var uid string
+		if err := json.NewDecoder(body).Decode(&uid); err != nil {
+			return nil, err
+		}
+		srv.ch <- cmdAppendAlbum(uid)
+		detail = uid
+	case "append_work":
+		// Append the work of a song in movement order, or its album if it
+		// isn't a movement.
+		var uid string
+		if err := json.NewDecoder(body).Decode(&uid); err != nil {
+			return nil, err
+		}
+		srv.ch <- cmdAppendWork(uid)
+		detail = uid
 	case "random":
 		srv.ch <- cmdRandom
 	case "repeat":
 		srv.ch <- cmdRepeat
+	case "radio":
+		srv.ch <- cmdRadio
+	case "consume":
+		srv.ch <- cmdConsume
 	case "seek":
 		d, err := time.ParseDuration(form.Get("pos"))
 		if err != nil {
 			return nil, err
 		}
 		srv.ch <- cmdSeek(d)
+	case "loop":
+		// Repeat the current song from a to b, or stop repeating without
+		// them. Changing songs stops repeating.
+		var l *Loop
+		if form.Get("a") != "" || form.Get("b") != "" {
+			a, err := time.ParseDuration(form.Get("a"))
+			if err != nil {
+				return nil, err
+			}
+			b, err := time.ParseDuration(form.Get("b"))
+			if err != nil {
+				return nil, err
+			}
+			if a < 0 || b <= a {
+				return nil, fmt.Errorf("bad loop: %v to %v", a, b)
+			}
+			l = &Loop{a, b}
+		}
+		srv.ch <- cmdLoop{l}
 	case "min_duration":
 		d, err := time.ParseDuration(form.Get("d"))
 		if err != nil {
 			return nil, err
 		}
 		srv.ch <- cmdMinDuration(d)
+	case "resume_min":
+		d, err := time.ParseDuration(form.Get("d"))
+		if err != nil {
+			return nil, err
+		}
+		if d < 0 {
+			return nil, fmt.Errorf("negative length: %v", d)
+		}
+		srv.ch <- cmdResumeMin(d)
+	case "volume":
+		v, err := parseVolume(form.Get("v"))
+		if err != nil {
+			return nil, err
+		}
+		// The device parameter sets the volume of another output device.
+		if d, ok := form["device"]; ok && len(d) > 0 {
+			srv.ch <- cmdDeviceVolume{d[0], v}
+			break
+		}
+		srv.ch <- cmdVolume(v)
+	case "preamp":
+		db, err := strconv.ParseFloat(form.Get("db"), 64)
+		if err != nil {
+			return nil, err
+		}
+		if db < -24 || db > 24 {
+			return nil, fmt.Errorf("preamp out of range: %v", db)
+		}
+		srv.ch <- cmdPreamp(db)
+	case "speed":
+		v, err := strconv.ParseFloat(form.Get("v"), 64)
+		if err != nil {
+			return nil, err
+		}
+		if v < 0.25 || v > 4 {
+			return nil, fmt.Errorf("speed out of range: %v", v)
+		}
+		srv.ch <- cmdSpeed(v)
+	case "pitch":
+		v, err := strconv.ParseFloat(form.Get("semitones"), 64)
+		if err != nil {
+			return nil, err
+		}
+		if v < -12 || v > 12 {
+			return nil, fmt.Errorf("pitch out of range: %v", v)
+		}
+		srv.ch <- cmdPitch(v)
+	case "trim_silence":
+		srv.ch <- cmdTrimSilence
+	case "limiter":
+		// Limit peaks so the preamp, ReplayGain, or EQ don't clip.
+		srv.ch <- cmdLimiter
+	case "verify_playback":
+		// Verify songs played to their end against their checksums.
+		srv.ch <- cmdVerifyPlayback
+	case "stop_on_error":
+		// Stop at songs that fail to play instead of skipping them.
+		srv.ch <- cmdStopOnError
+	case "bit_perfect":
+		srv.ch <- cmdBitPerfect
+	case "discord":
+		if DiscordClientID == "" {
+			return nil, fmt.Errorf("no Discord client ID")
+		}
+		srv.ch <- cmdDiscord
+	case "device":
+		srv.ch <- cmdDevice(form.Get("name"))
+	case "output_backend":
+		name := form.Get("name")
+		if err := checkBackend(name); err != nil {
+			return nil, err
+		}
+		srv.ch <- cmdBackend(name)
+	case "latency":
+		name := form.Get("backend")
+		if err := checkBackend(name); err != nil {
+			return nil, err
+		}
+		d, err := time.ParseDuration(form.Get("d"))
+		if err != nil {
+			return nil, err
+		}
+		if d < 0 || d > time.Second*2 {
+			return nil, fmt.Errorf("latency out of range: %v", d)
+		}
+		srv.ch <- cmdLatency{name, d}
+	case "crossfade":
+		d, err := time.ParseDuration(form.Get("d"))
+		if err != nil {
+			return nil, err
+		}
+		if d < 0 || d > time.Second*20 {
+			return nil, fmt.Errorf("crossfade out of range: %v", d)
+		}
+		srv.ch <- cmdCrossfade(d)
+	case "preroll":
+		d, err := time.ParseDuration(form.Get("d"))
+		if err != nil {
+			return nil, err
+		}
+		if d < 0 || d > maxPreroll {
+			return nil, fmt.Errorf("preroll out of range: %v", d)
+		}
+		srv.ch <- cmdPreroll(d)
+	case "output_rate":
+		rate, err := strconv.Atoi(form.Get("rate"))
+		if err != nil {
+			return nil, err
+		}
+		if rate != 0 && (rate < 8000 || rate > 384000) {
+			return nil, fmt.Errorf("output rate out of range: %v", rate)
+		}
+		srv.ch <- cmdOutputRate(rate)
+	case "resampler":
+		q, err := dsp.ParseQuality(form.Get("quality"))
+		if err != nil {
+			return nil, err
+		}
+		srv.ch <- cmdResampler(q)
+	case "replaygain":
+		switch mode := form.Get("mode"); mode {
+		case replayGainOff, replayGainTrack, replayGainAlbum:
+			srv.ch <- cmdReplayGain(mode)
+		default:
+			return nil, fmt.Errorf("unknown replaygain mode: %v", mode)
+		}
 	default:
 		return nil, fmt.Errorf("unknown command: %v", cmd)
 	}
+	srv.audit(ps, "cmd "+cmd, detail)
 	return nil, nil
 }
 
@@ -185,6 +633,7 @@ func (srv *Server) QueueChange(body io.Reader, form url.Values, ps httprouter.Pa
 	if err := json.NewDecoder(body).Decode(&plc); err != nil {
 		return nil, err
 	}
+	srv.audit(ps, "queue change", describeChange(plc))
 	srv.ch <- cmdQueueChange(plc)
 	return nil, nil
 }
@@ -194,9 +643,11 @@ func (srv *Server) PlaylistChange(body io.Reader, form url.Values, ps httprouter
 	if err := json.NewDecoder(body).Decode(&plc); err != nil {
 		return nil, err
 	}
+	srv.audit(ps, "playlist change", ps.ByName("playlist")+": "+describeChange(plc))
 	srv.ch <- cmdPlaylistChange{
 		plc:  plc,
 		name: ps.ByName("playlist"),
+		user: ps.ByName(paramUser),
 	}
 	return nil, nil
 }
@@ -206,6 +657,7 @@ func (srv *Server) ProtocolRefresh(body io.Reader, form url.Values, ps httproute
 	if err := json.NewDecoder(body).Decode(&pd); err != nil {
 		return nil, err
 	}
+	srv.audit(ps, "protocol refresh", pd.Protocol+" "+pd.Key)
 	ch := make(chan error)
 	srv.ch <- cmdProtocolRefresh{
 		protocol: pd.Protocol,
@@ -217,6 +669,28 @@ func (srv *Server) ProtocolRefresh(body io.Reader, form url.Values, ps httproute
 	return nil, <-ch
 }
 
+// SongAttributes returns the attributes of a song beyond its info, like the
+// flexible attributes of beets, by name.
+func (srv *Server) SongAttributes(body io.Reader, form url.Values, ps httprouter.Params) (interface{}, error) {
+	ch := make(chan attributesResult)
+	srv.ch <- cmdSongAttributes{
+		id:   SongID(strings.TrimPrefix(ps.ByName("id"), "/")),
+		done: ch,
+	}
+	r := <-ch
+	return r.attrs, r.err
+}
+
+type attributesResult struct {
+	attrs map[string]string
+	err   error
+}
+
+type cmdSongAttributes struct {
+	id   SongID
+	done chan attributesResult
+}
+
 func (srv *Server) ProtocolAdd(body io.Reader, form url.Values, ps httprouter.Params) (interface{}, error) {
 	var ap struct {
 		Protocol string
@@ -233,6 +707,7 @@ func (srv *Server) ProtocolAdd(body io.Reader, form url.Values, ps httprouter.Pa
 	if err != nil {
 		return nil, err
 	}
+	srv.audit(ps, "protocol add", ap.Protocol+" "+inst.Key())
 	srv.ch <- cmdProtocolAdd{
 		Name:     ap.Protocol,
 		Instance: inst,
@@ -245,6 +720,7 @@ func (srv *Server) ProtocolRemove(body io.Reader, form url.Values, ps httprouter
 	if err := json.NewDecoder(body).Decode(&pd); err != nil {
 		return nil, err
 	}
+	srv.audit(ps, "protocol remove", pd.Protocol+" "+pd.Key)
 	srv.ch <- cmdProtocolRemove{
 		protocol: pd.Protocol,
 		key:      pd.Key,
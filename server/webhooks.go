@@ -0,0 +1,231 @@
+package server
+
+import (
+	"bytes"
+	"context"
+	"crypto/hmac"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"io"
+	"log"
+	"net/http"
+	"net/url"
+	"strconv"
+	"time"
+
+	"github.com/julienschmidt/httprouter"
+)
+
+const (
+	// webhookAttempts is the number of times an event is sent to a webhook
+	// before it is dropped. Retries wait webhookRetry, doubled each time.
+	webhookAttempts = 5
+	webhookRetry    = time.Second
+	// webhookMaxRetry bounds the wait a server may ask for.
+	webhookMaxRetry = time.Minute
+	webhookTimeout  = time.Second * 10
+)
+
+// Webhook is a URL posted JSON events as they happen, so home automation
+// like Home Assistant and Node-RED can react to playback without polling.
+type Webhook struct {
+	ID  string
+	URL string
+	// Secret, if set, signs requests: the X-Moggio-Signature header is
+	// "sha256=" and the hex HMAC-SHA256 of the body keyed by Secret.
+	Secret string
+	// Events are the events sent, or all events if empty.
+	Events []string
+}
+
+// webhookInfo is a Webhook as listed, without its secret.
+type webhookInfo struct {
+	ID     string
+	URL    string
+	Signed bool
+	Events []string
+}
+
+func (w Webhook) validate() error {
+	return Hook{URL: w.URL, Events: w.Events}.Validate()
+}
+
+func (w Webhook) sign(body []byte) string {
+	m := hmac.New(sha256.New, []byte(w.Secret))
+	m.Write(body)
+	return "sha256=" + hex.EncodeToString(m.Sum(nil))
+}
+
+type webhookDelivery struct {
+	hook  Webhook
+	event Event
+}
+
+// sendWebhooks sends events to webhooks, in order for each. Each webhook
+// has its own sender so one that is down doesn't delay the others.
+func (srv *Server) sendWebhooks() {
+	senders := make(map[string]chan webhookDelivery)
+	for d := range srv.webhooks {
+		ch := senders[d.hook.ID]
+		if ch == nil {
+			ch = make(chan webhookDelivery, hookQueue)
+			senders[d.hook.ID] = ch
+			go func() {
+				for d := range ch {
+					if err := d.hook.send(d.event); err != nil {
+						log.Printf("webhook %s: %v", d.hook.URL, err)
+					}
+				}
+			}()
+		}
+		select {
+		case ch <- d:
+		default:
+			log.Printf("webhook %s: too many waiting, dropped", d.hook.URL)
+		}
+	}
+}
+
+// send posts e to w, retrying network errors and 429 and 5xx responses.
+func (w Webhook) send(e Event) error {
+	body, err := json.Marshal(e)
+	if err != nil {
+		return err
+	}
+	wait := webhookRetry
+	for attempt := 1; ; attempt++ {
+		retry, err := w.post(e.Type, body)
+		if err == nil || retry < 0 || attempt == webhookAttempts {
+			return err
+		}
+		if retry == 0 {
+			retry = wait
+			wait *= 2
+		}
+		if retry > webhookMaxRetry {
+			retry = webhookMaxRetry
+		}
+		time.Sleep(retry)
+	}
+}
+
+// post makes one request. If it fails, retry is negative if it shouldn't be
+// retried, or else how long the server asked to wait, if it did.
+func (w Webhook) post(event string, body []byte) (retry time.Duration, err error) {
+	ctx, cancel := context.WithTimeout(context.Background(), webhookTimeout)
+	defer cancel()
+	req, err := http.NewRequestWithContext(ctx, "POST", w.URL, bytes.NewReader(body))
+	if err != nil {
+		return -1, err
+	}
+	req.Header.Set("Content-Type", "application/json")
+	req.Header.Set("X-Moggio-Event", event)
+	if w.Secret != "" {
+		req.Header.Set("X-Moggio-Signature", w.sign(body))
+	}
+	resp, err := http.DefaultClient.Do(req)
+	if err != nil {
+		return 0, err
+	}
+	resp.Body.Close()
+	switch {
+	case resp.StatusCode/100 == 2:
+		return 0, nil
+	case resp.StatusCode == http.StatusTooManyRequests || resp.StatusCode >= 500:
+		s, _ := strconv.Atoi(resp.Header.Get("Retry-After"))
+		return time.Duration(s) * time.Second, fmt.Errorf("%s", resp.Status)
+	}
+	return -1, fmt.Errorf("%s", resp.Status)
+}
+
+func (srv *Server) GetWebhooks(body io.Reader, form url.Values, ps httprouter.Params) (interface{}, error) {
+	ch := make(chan []webhookInfo)
+	srv.ch <- cmdGetWebhooks(ch)
+	return <-ch, nil
+}
+
+// AddWebhook adds the webhook in the body and returns it with its ID.
+func (srv *Server) AddWebhook(body io.Reader, form url.Values, ps httprouter.Params) (interface{}, error) {
+	var w Webhook
+	if err := json.NewDecoder(body).Decode(&w); err != nil {
+		return nil, err
+	}
+	if err := w.validate(); err != nil {
+		return nil, err
+	}
+	b := make([]byte, 8)
+	if _, err := rand.Read(b); err != nil {
+		return nil, err
+	}
+	w.ID = hex.EncodeToString(b)
+	srv.audit(ps, "webhook add", w.URL)
+	ch := make(chan error)
+	srv.ch <- cmdSetWebhook{
+		hook: w,
+		err:  ch,
+	}
+	if err := <-ch; err != nil {
+		return nil, err
+	}
+	return w.info(), nil
+}
+
+// UpdateWebhook replaces the webhook with the ID of the one in the body.
+// An empty Secret keeps the current one.
+func (srv *Server) UpdateWebhook(body io.Reader, form url.Values, ps httprouter.Params) (interface{}, error) {
+	var w Webhook
+	if err := json.NewDecoder(body).Decode(&w); err != nil {
+		return nil, err
+	}
+	if err := w.validate(); err != nil {
+		return nil, err
+	}
+	srv.audit(ps, "webhook update", w.URL)
+	ch := make(chan error)
+	srv.ch <- cmdSetWebhook{
+		hook:   w,
+		update: true,
+		err:    ch,
+	}
+	return nil, <-ch
+}
+
+func (srv *Server) RemoveWebhook(body io.Reader, form url.Values, ps httprouter.Params) (interface{}, error) {
+	var id string
+	if err := json.NewDecoder(body).Decode(&id); err != nil {
+		return nil, err
+	}
+	srv.audit(ps, "webhook remove", id)
+	ch := make(chan error)
+	srv.ch <- cmdRemoveWebhook{
+		id:  id,
+		err: ch,
+	}
+	return nil, <-ch
+}
+
+func (w Webhook) info() webhookInfo {
+	return webhookInfo{
+		ID:     w.ID,
+		URL:    w.URL,
+		Signed: w.Secret != "",
+		Events: w.Events,
+	}
+}
+
+type cmdGetWebhooks chan []webhookInfo
+
+type cmdSetWebhook struct {
+	hook Webhook
+	// update is set to replace an existing webhook.
+	update bool
+	err    chan error
+}
+
+type cmdRemoveWebhook struct {
+	id  string
+	err chan error
+}
@@ -1,10 +1,15 @@
 package server
 
 import (
+	"crypto/rand"
+	"encoding/hex"
 	"fmt"
 	"os"
+	"time"
 
 	"github.com/mjibson/moggio/codec"
+	"github.com/mjibson/moggio/dsp"
+	"github.com/mjibson/moggio/output"
 	"github.com/mjibson/moggio/protocol"
 	"golang.org/x/net/websocket"
 )
@@ -12,6 +17,9 @@ import (
 type waitData struct {
 	Type waitType
 	Data interface{}
+	// Seq is the sequence number of the event, for replay to reconnecting
+	// clients.
+	Seq uint64 `json:",omitempty"`
 }
 
 type waitType string
@@ -22,10 +30,28 @@ const (
 	waitProtocols          = "protocols"
 	waitTracks             = "tracks"
 	waitError              = "error"
+	waitEQ                 = "eq"
+	waitOutputs            = "outputs"
+	waitSession            = "session"
+	waitAnalyze            = "analyze"
 )
 
-// makeWaitData should only be called by the commands() function.
-func (srv *Server) makeWaitData(wt waitType) *waitData {
+const (
+	// sessionTTL is how long after disconnecting a client may reconnect
+	// and have the events it missed replayed.
+	sessionTTL = time.Hour
+	// replayErrors is the number of recent errors replayed.
+	replayErrors = 20
+	// pingInterval is how often clients are pinged to keep connections
+	// open.
+	pingInterval = time.Second * 30
+	// pingTimeout is how long a ping to a client may take.
+	pingTimeout = time.Second * 10
+)
+
+// makeWaitData returns the data of wt for user, which is empty for the
+// owner. It should only be called by the commands() function.
+func (srv *Server) makeWaitData(wt waitType, user string) *waitData {
 	var data interface{}
 	switch wt {
 	case waitProtocols:
@@ -36,27 +62,74 @@ func (srv *Server) makeWaitData(wt waitType) *waitData {
 			}
 		}
 		data = struct {
-			Available  map[string]protocol.Params
-			Current    map[string][]string
-			InProgress map[codec.ID]bool
+			Available           map[string]protocol.Params
+			Current             map[string][]string
+			InProgress          map[codec.ID]bool
+			Connections         map[codec.ID]Connection
+			Refresh             map[string]map[string]time.Duration
+			PauseMeteredRefresh bool
 		}{
 			protocol.Get(),
 			protos,
 			srv.inprogress,
+			srv.connections,
+			srv.RefreshInterval,
+			srv.PauseMeteredRefresh,
 		}
 	case waitStatus:
 		hostname, _ := os.Hostname()
+		outputRate := srv.dspConfig().rate(srv.sampleRate)
+		var resampler dsp.Quality
+		if outputRate != srv.sampleRate {
+			resampler = srv.Resampler
+		}
+		// Report the position being heard.
+		elapsed := srv.elapsed - srv.bluetoothOffset()
+		if elapsed < 0 {
+			elapsed = 0
+		}
 		data = &Status{
 			State:      srv.state,
 			Song:       srv.songID,
 			SongInfo:   srv.info,
-			Elapsed:    srv.elapsed,
+			Elapsed:    elapsed,
 			Time:       srv.info.Time,
+			Remaining:  time.Duration(float64(srv.info.Time-elapsed) / srv.Speed),
+			Loop:       srv.loop,
 			Random:     srv.Random,
 			Repeat:     srv.Repeat,
+			Radio:      srv.Radio,
+			Consume:    srv.Cleanup.Consume,
 			Username:   srv.Username,
 			Hostname:   hostname,
 			CentralURL: srv.centralURL,
+
+			Party:         srv.Party.Enabled,
+			SkipVotes:     len(srv.skipVotes),
+			SkipThreshold: srv.Party.SkipVotes,
+			Volume:        srv.volume(),
+			Preamp:        srv.Preamp,
+			ReplayGain:    srv.ReplayGain,
+			Speed:         srv.Speed,
+			Pitch:         srv.Pitch,
+			TrimSilence:   srv.TrimSilence,
+			Crossfade:     srv.Crossfade,
+			Limiter:       srv.Limiter,
+			Levels:        srv.levels.current(),
+			SampleRate:    srv.sampleRate,
+			OutputRate:    outputRate,
+			Resampler:     resampler,
+			BitPerfect:    srv.BitPerfect,
+			Backend:       srv.Backend,
+			Latency:       srv.Latency[srv.Backend],
+			Device:        srv.Device,
+			Underruns:     output.Underruns(),
+			Preroll:       srv.preroll(),
+			Buffered:      srv.buffered.get(),
+
+			DiscordPresence: srv.DiscordPresence,
+			VerifyPlayback:  srv.VerifyPlayback,
+			StopOnError:     srv.StopOnError,
 		}
 	case waitTracks:
 		var songs []listItem
@@ -65,6 +138,13 @@ func (srv *Server) makeWaitData(wt waitType) *waitData {
 				sl, _ := inst.List()
 				for id, info := range sl {
 					sid := SongID(codec.NewID(name, key, string(id)))
+					if srv.Hidden[sid] {
+						continue
+					}
+					info = srv.songInfo(sid, info)
+					if srv.Parental.hides(info) {
+						continue
+					}
 					songs = append(songs, listItem{
 						ID:   sid,
 						Info: info,
@@ -72,23 +152,42 @@ func (srv *Server) makeWaitData(wt waitType) *waitData {
 				}
 			}
 		}
-		data = struct {
-			Tracks []listItem
-		}{
+		groupAlbums(songs)
+		data = tracksData{
 			Tracks: songs,
 		}
 	case waitPlaylist:
-		d := struct {
-			Queue     PlaylistInfo
-			Playlists map[string]PlaylistInfo
-		}{
+		d := playlistsData{
 			Queue:     srv.playlistInfo(srv.Queue),
 			Playlists: make(map[string]PlaylistInfo),
 		}
-		for name, p := range srv.Playlists {
+		for name, p := range srv.playlists(user) {
 			d.Playlists[name] = srv.playlistInfo(p)
 		}
 		data = d
+	case waitEQ:
+		data = struct {
+			EQ      dsp.EQ
+			Freqs   []float64
+			Presets map[string][]float64
+		}{
+			srv.EQ,
+			dsp.GraphicFreqs,
+			dsp.Presets,
+		}
+	case waitAnalyze:
+		data = srv.loudnessScan.get()
+	case waitOutputs:
+		latency := make(map[string]time.Duration)
+		for _, b := range output.Backends() {
+			latency[b] = srv.Latency[b]
+		}
+		data = outputSettings{
+			Backends: output.Backends(),
+			Backend:  srv.Backend,
+			Latency:  latency,
+			Device:   srv.Device,
+		}
 	default:
 		data = fmt.Errorf("unknown type")
 	}
@@ -98,6 +197,41 @@ func (srv *Server) makeWaitData(wt waitType) *waitData {
 	}
 }
 
+// waiter is a websocket connection receiving updates.
+type waiter struct {
+	done chan struct{}
+	// user is the user the connection was made by.
+	user string
+	// codec encodes the messages sent.
+	codec websocket.Codec
+	// mobile is set if the messages are of the mobile profile.
+	mobile bool
+}
+
+// wsSession is a client that may reconnect with its token to have the
+// events it missed replayed instead of receiving all data again.
+type wsSession struct {
+	user string
+	// ws is the client's connection, or nil since left.
+	ws   *websocket.Conn
+	left time.Time
+}
+
+func newSessionToken() string {
+	b := make([]byte, 16)
+	rand.Read(b)
+	return hex.EncodeToString(b)
+}
+
+type tracksData struct {
+	Tracks []listItem
+}
+
+type playlistsData struct {
+	Queue     PlaylistInfo
+	Playlists map[string]PlaylistInfo
+}
+
 type cmdNewWS struct {
 	ws   *websocket.Conn
 	done chan struct{}
@@ -105,12 +239,43 @@ type cmdNewWS struct {
 
 type cmdDeleteWS *websocket.Conn
 
+// WebSocket sends events to a client, including a session event with a
+// token. A client reconnecting with the resume=token and since=seq
+// parameters, where seq is the highest Seq it received, is only sent the
+// events it missed. Clients of the mobile profile are sent its compact
+// events.
 func (srv *Server) WebSocket(ws *websocket.Conn) {
 	c := make(chan struct{})
 	srv.ch <- cmdNewWS{
 		ws:   ws,
 		done: c,
 	}
-	for range c {
+	go func() {
+		// Notice closed connections. Clients don't send anything else.
+		var msg []byte
+		for {
+			if err := websocket.Message.Receive(ws, &msg); err != nil {
+				srv.ch <- cmdDeleteWS(ws)
+				return
+			}
+		}
+	}()
+	ping := time.NewTicker(pingInterval)
+	defer ping.Stop()
+	// Only this go routine writes with ws.PayloadType.
+	ws.PayloadType = websocket.PingFrame
+	for {
+		select {
+		case <-c:
+			return
+		case <-ping.C:
+			ws.SetWriteDeadline(time.Now().Add(pingTimeout))
+			_, err := ws.Write(nil)
+			ws.SetWriteDeadline(time.Time{})
+			if err != nil {
+				srv.ch <- cmdDeleteWS(ws)
+				return
+			}
+		}
 	}
 }
@@ -0,0 +1,320 @@
+// Package tray puts a controller of a moggio server in the system tray or
+// menu bar.
+//
+// There's no portable tray API in Go, so the icon and menu are shown by a
+// helper process: yad on Linux and other Unixes, PowerShell on Windows, and
+// JavaScript for Automation on macOS. The helper reads the current track
+// from its standard input, a line at a time, and writes the actions of
+// clicked menu items to its standard output.
+package tray
+
+import (
+	"bufio"
+	"flag"
+	"fmt"
+	"io"
+	"log"
+	"net/url"
+	"os"
+	"os/exec"
+	"runtime"
+	"strconv"
+	"strings"
+
+	"github.com/mjibson/moggio/client"
+	"github.com/pkg/browser"
+)
+
+// volumeStep is the change in volume of the volume menu items.
+const volumeStep = 0.05
+
+// actions are the menu items, with their labels.
+var actions = []struct {
+	name, label string
+}{
+	{"pause", "Play/Pause"},
+	{"next", "Next"},
+	{"prev", "Previous"},
+	{"volume_up", "Volume Up"},
+	{"volume_down", "Volume Down"},
+	{"open", "Open moggio"},
+	{"quit", "Quit"},
+}
+
+// Main shows the tray icon of the server with args, the command line after
+// "tray", until Quit is clicked.
+func Main(args []string) error {
+	fs := flag.NewFlagSet("tray", flag.ExitOnError)
+	newClient := client.Flags(fs)
+	fs.Parse(args)
+	c := newClient()
+	st, err := c.Status()
+	if err != nil {
+		return fmt.Errorf("tray: %s: %v", c.URL, err)
+	}
+	h, err := newHelper()
+	if err != nil {
+		return fmt.Errorf("tray: %v", err)
+	}
+	in, err := h.cmd.StdinPipe()
+	if err != nil {
+		return err
+	}
+	out, err := h.cmd.StdoutPipe()
+	if err != nil {
+		return err
+	}
+	h.cmd.Stderr = os.Stderr
+	if err := h.cmd.Start(); err != nil {
+		return fmt.Errorf("tray: %v", err)
+	}
+	defer h.cmd.Process.Kill()
+	clicks := make(chan string)
+	go func() {
+		s := bufio.NewScanner(out)
+		for s.Scan() {
+			clicks <- strings.TrimSpace(s.Text())
+		}
+		close(clicks)
+	}()
+	var shown string
+	show := func() {
+		text := nowPlaying(st)
+		if text == shown {
+			return
+		}
+		shown = text
+		if _, err := io.WriteString(in, h.line(text)); err != nil {
+			log.Printf("tray: %v", err)
+		}
+	}
+	show()
+	statuses := c.Statuses()
+	for {
+		select {
+		case st = <-statuses:
+			show()
+		case a, ok := <-clicks:
+			if !ok {
+				return h.cmd.Wait()
+			}
+			if a == "quit" {
+				return nil
+			}
+			if err := action(c, st, a); err != nil {
+				log.Printf("tray: %s: %v", a, err)
+			}
+		}
+	}
+}
+
+// action runs the menu item a with the status st.
+func action(c *client.Client, st client.Status, a string) error {
+	switch a {
+	case "pause":
+		// Play when stopped, so one item plays and pauses.
+		if st.State == client.StateStop {
+			return c.Cmd("play", nil)
+		}
+		return c.Cmd("pause", nil)
+	case "next", "prev":
+		return c.Cmd(a, nil)
+	case "volume_up", "volume_down":
+		v := st.Volume + volumeStep
+		if a == "volume_down" {
+			v = st.Volume - volumeStep
+		}
+		if v < 0 {
+			v = 0
+		} else if v > 1 {
+			v = 1
+		}
+		return c.Cmd("volume", url.Values{"v": {strconv.FormatFloat(v, 'g', -1, 64)}})
+	case "open":
+		return browser.OpenURL(c.WebURL())
+	}
+	return fmt.Errorf("unknown action")
+}
+
+// nowPlaying describes the current track and volume of st.
+func nowPlaying(st client.Status) string {
+	text := "Stopped"
+	if st.State != client.StateStop && st.Song.UID != "" {
+		info := st.SongInfo
+		text = info.Title
+		if text == "" {
+			text = st.Song.UID
+		}
+		if info.Artist != "" {
+			text = info.Artist + " - " + text
+		}
+		if st.State == client.StatePause {
+			text = "Paused: " + text
+		}
+	}
+	return fmt.Sprintf("%s (volume %d%%)", text, int(st.Volume*100+0.5))
+}
+
+// helper is the process that shows the tray icon.
+type helper struct {
+	cmd *exec.Cmd
+	// line returns the input line that shows the now playing text.
+	line func(text string) string
+}
+
+func newHelper() (*helper, error) {
+	switch runtime.GOOS {
+	case "windows":
+		exe, _ := os.Executable()
+		cmd := exec.Command("powershell", "-NoProfile", "-NonInteractive", "-WindowStyle", "Hidden", "-Command", windowsTray)
+		cmd.Env = append(os.Environ(), "MOGGIO_EXE="+exe, "MOGGIO_MENU="+menu())
+		return &helper{cmd: cmd, line: plainLine}, nil
+	case "darwin":
+		cmd := exec.Command("osascript", "-l", "JavaScript", "-e", macTray)
+		cmd.Env = append(os.Environ(), "MOGGIO_MENU="+menu())
+		return &helper{cmd: cmd, line: plainLine}, nil
+	default:
+		if _, err := exec.LookPath("yad"); err != nil {
+			return nil, fmt.Errorf("yad is needed to show the tray icon: %v", err)
+		}
+		cmd := exec.Command("yad", "--notification", "--listen",
+			"--image=audio-x-generic",
+			"--text=moggio",
+			"--command=echo pause",
+			"--menu="+yadMenu("moggio"),
+		)
+		return &helper{cmd: cmd, line: yadLine}, nil
+	}
+}
+
+// menu returns the actions as name=label items separated by semicolons.
+func menu() string {
+	var items []string
+	for _, a := range actions {
+		items = append(items, a.name+"="+a.label)
+	}
+	return strings.Join(items, ";")
+}
+
+func plainLine(text string) string {
+	return strings.Replace(text, "\n", " ", -1) + "\n"
+}
+
+// yadMenu returns a yad menu whose first item is text. Items are
+// label!command, separated by |; the command's output is the action.
+func yadMenu(text string) string {
+	text = strings.NewReplacer("|", "/", "!", ".", "\n", " ").Replace(text)
+	items := []string{text + "!true"}
+	for _, a := range actions {
+		items = append(items, a.label+"!echo "+a.name)
+	}
+	return strings.Join(items, "|")
+}
+
+func yadLine(text string) string {
+	text = strings.Replace(text, "\n", " ", -1)
+	return "tooltip:" + text + "\nmenu:" + yadMenu(text) + "\n"
+}
+
+// windowsTray shows a NotifyIcon with the icon of MOGGIO_EXE and the items
+// of MOGGIO_MENU. Standard input is read on a timer so the message loop
+// isn't blocked. Left clicks play and pause.
+const windowsTray = `
+Add-Type -AssemblyName System.Windows.Forms, System.Drawing
+$icon = New-Object System.Windows.Forms.NotifyIcon
+$icon.Icon = [System.Drawing.Icon]::ExtractAssociatedIcon($env:MOGGIO_EXE)
+$icon.Text = 'moggio'
+$menu = New-Object System.Windows.Forms.ContextMenuStrip
+$now = $menu.Items.Add('moggio')
+$now.Enabled = $false
+$menu.Items.Add('-') > $null
+foreach ($a in $env:MOGGIO_MENU.Split(';')) {
+	$kv = $a.Split('=')
+	$item = $menu.Items.Add($kv[1])
+	$item.Tag = $kv[0]
+	$item.add_Click({ [Console]::Out.WriteLine($this.Tag); [Console]::Out.Flush() })
+}
+$icon.ContextMenuStrip = $menu
+$icon.add_MouseClick({
+	if ($_.Button -eq [System.Windows.Forms.MouseButtons]::Left) {
+		[Console]::Out.WriteLine('pause'); [Console]::Out.Flush()
+	}
+})
+$icon.Visible = $true
+$script:line = [Console]::In.ReadLineAsync()
+$timer = New-Object System.Windows.Forms.Timer
+$timer.Interval = 200
+$timer.add_Tick({
+	while ($script:line.IsCompleted) {
+		$text = $script:line.Result
+		if ($text -eq $null) {
+			$icon.Visible = $false
+			[System.Windows.Forms.Application]::Exit()
+			return
+		}
+		$now.Text = $text
+		# Tooltips are at most 63 characters.
+		$icon.Text = $text.Substring(0, [Math]::Min(63, $text.Length))
+		$script:line = [Console]::In.ReadLineAsync()
+	}
+})
+$timer.Start()
+[System.Windows.Forms.Application]::Run()
+`
+
+// macTray shows a menu bar item with the items of MOGGIO_MENU, using the
+// Objective-C bridge. Standard input is read in the background, and the
+// last line read is shown.
+const macTray = `
+ObjC.import('Cocoa');
+var app = $.NSApplication.sharedApplication;
+app.setActivationPolicy($.NSApplicationActivationPolicyAccessory);
+var item = $.NSStatusBar.systemStatusBar.statusItemWithLength($.NSVariableStatusItemLength);
+item.button.title = '♫';
+item.button.toolTip = 'moggio';
+var stdin = $.NSFileHandle.fileHandleWithStandardInput;
+var stdout = $.NSFileHandle.fileHandleWithStandardOutput;
+var now;
+ObjC.registerSubclass({
+	name: 'MoggioTray',
+	methods: {
+		'click:': {
+			types: ['void', ['id']],
+			implementation: function(sender) {
+				stdout.writeData($(sender.representedObject.js + '\n').dataUsingEncoding($.NSUTF8StringEncoding));
+			}
+		},
+		'read:': {
+			types: ['void', ['id']],
+			implementation: function(n) {
+				var data = n.userInfo.objectForKey($.NSFileHandleNotificationDataItem);
+				if (data.length == 0) {
+					app.terminate(null);
+					return;
+				}
+				var lines = $.NSString.alloc.initWithDataEncoding(data, $.NSUTF8StringEncoding).js.split('\n').filter(function(l) { return l; });
+				if (lines.length) {
+					now.title = lines[lines.length - 1];
+					item.button.toolTip = lines[lines.length - 1];
+				}
+				stdin.readInBackgroundAndNotify;
+			}
+		}
+	}
+});
+var target = $.MoggioTray.alloc.init;
+var menu = $.NSMenu.alloc.init;
+now = menu.addItemWithTitleActionKeyEquivalent('moggio', null, '');
+menu.addItem($.NSMenuItem.separatorItem);
+var env = $.NSProcessInfo.processInfo.environment.objectForKey('MOGGIO_MENU').js;
+env.split(';').forEach(function(a) {
+	var kv = a.split('=');
+	var m = menu.addItemWithTitleActionKeyEquivalent(kv[1], 'click:', '');
+	m.target = target;
+	m.representedObject = $(kv[0]);
+});
+item.menu = menu;
+$.NSNotificationCenter.defaultCenter.addObserverSelectorNameObject(target, 'read:', $.NSFileHandleReadCompletionNotification, stdin);
+stdin.readInBackgroundAndNotify;
+app.run;
+`
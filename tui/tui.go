@@ -0,0 +1,537 @@
+// Package tui is a terminal client of a moggio server, for use over SSH or
+// where a browser isn't handy.
+package tui
+
+import (
+	"bufio"
+	"flag"
+	"fmt"
+	"net/url"
+	"os"
+	"os/exec"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/mjibson/moggio/client"
+)
+
+const (
+	// pollInterval is how often the status and queue are fetched.
+	pollInterval = time.Second
+	volumeStep   = 0.05
+	seekStep     = time.Second * 10
+	// messageTime is how long messages are shown.
+	messageTime = time.Second * 5
+)
+
+const help = `j/k ↓/↑ move   g/G top/bottom   ^d/^u page   tab switch view   / search
+enter play   a add to queue   x remove from queue   space pause   s stop
+> next   < prev   h/l seek   +/- volume   r random   R repeat   u reload   q quit`
+
+const (
+	viewQueue = iota
+	viewLibrary
+)
+
+var viewNames = []string{"Queue", "Library"}
+
+// Main runs the terminal UI with args, the command line after "tui".
+func Main(args []string) error {
+	fs := flag.NewFlagSet("tui", flag.ExitOnError)
+	newClient := client.Flags(fs)
+	fs.Parse(args)
+	t := &tui{
+		c:       newClient(),
+		updates: make(chan func()),
+	}
+	var err error
+	if t.status, err = t.c.Status(); err != nil {
+		return fmt.Errorf("tui: %s: %v", t.c.URL, err)
+	}
+	restore, err := rawMode()
+	if err != nil {
+		return err
+	}
+	out := bufio.NewWriter(os.Stdout)
+	// Use the alternate screen and hide the cursor.
+	fmt.Fprint(out, "\x1b[?1049h\x1b[?25l")
+	defer func() {
+		fmt.Fprint(out, "\x1b[?25h\x1b[?1049l")
+		out.Flush()
+		restore()
+	}()
+	t.out = out
+	return t.run()
+}
+
+// rawMode puts the terminal in raw mode, returning a function that restores
+// it.
+func rawMode() (func(), error) {
+	stty := func(args ...string) (string, error) {
+		cmd := exec.Command("stty", args...)
+		cmd.Stdin = os.Stdin
+		b, err := cmd.Output()
+		return strings.TrimSpace(string(b)), err
+	}
+	state, err := stty("-g")
+	if err != nil {
+		return nil, fmt.Errorf("tui: needs a terminal with stty: %v", err)
+	}
+	if _, err := stty("raw", "-echo"); err != nil {
+		return nil, err
+	}
+	return func() { stty(state) }, nil
+}
+
+// termSize returns the size of the terminal.
+func termSize() (width, height int) {
+	cmd := exec.Command("stty", "size")
+	cmd.Stdin = os.Stdin
+	b, err := cmd.Output()
+	if err == nil {
+		fmt.Sscan(string(b), &height, &width)
+	}
+	if width < 20 || height < 6 {
+		return 80, 24
+	}
+	return width, height
+}
+
+type tui struct {
+	c   *client.Client
+	out *bufio.Writer
+	// updates are applied to the state by the main go routine.
+	updates chan func()
+
+	status  client.Status
+	polled  time.Time
+	queue   []client.Item
+	library []client.Item
+	// results are the indexes in library of songs matching query.
+	results []int
+	query   string
+	// searching is set while the query is typed.
+	searching bool
+
+	view   int
+	cursor [2]int
+	offset [2]int
+	width  int
+	height int
+
+	message   string
+	messageAt time.Time
+	// showHelp shows the help over the list.
+	showHelp bool
+}
+
+func (t *tui) run() error {
+	keys := make(chan string)
+	go readKeys(keys)
+	go t.poll()
+	go t.loadLibrary()
+	t.width, t.height = termSize()
+	resize := time.NewTicker(pollInterval)
+	defer resize.Stop()
+	for {
+		t.draw()
+		select {
+		case k, ok := <-keys:
+			if !ok || !t.key(k) {
+				return nil
+			}
+		case f := <-t.updates:
+			f()
+		case <-resize.C:
+			t.width, t.height = termSize()
+		}
+	}
+}
+
+// readKeys sends key presses, as their bytes, to keys.
+func readKeys(keys chan<- string) {
+	b := make([]byte, 16)
+	for {
+		n, err := os.Stdin.Read(b)
+		if err != nil {
+			close(keys)
+			return
+		}
+		keys <- string(b[:n])
+	}
+}
+
+func (t *tui) poll() {
+	for {
+		s, err := t.c.Status()
+		q, qerr := t.c.Queue()
+		if err == nil {
+			err = qerr
+		}
+		t.updates <- func() {
+			if err != nil {
+				t.setMessage(err.Error())
+				return
+			}
+			t.status, t.queue, t.polled = s, q, time.Now()
+		}
+		time.Sleep(pollInterval)
+	}
+}
+
+func (t *tui) loadLibrary() {
+	songs, err := t.c.Tracks()
+	sort.Slice(songs, func(i, j int) bool {
+		a, b := songs[i].Info, songs[j].Info
+		if a.Artist != b.Artist {
+			return strings.ToLower(a.Artist) < strings.ToLower(b.Artist)
+		}
+		if a.Album != b.Album {
+			return strings.ToLower(a.Album) < strings.ToLower(b.Album)
+		}
+		if a.Track != b.Track {
+			return a.Track < b.Track
+		}
+		return a.Title < b.Title
+	})
+	t.updates <- func() {
+		if err != nil {
+			t.setMessage(err.Error())
+			return
+		}
+		t.library = songs
+		t.search(t.query)
+	}
+}
+
+// search sets the library results to the songs with all words of q in
+// their title, artist, or album.
+func (t *tui) search(q string) {
+	t.query = q
+	t.results = t.results[:0]
+	words := strings.Fields(strings.ToLower(q))
+outer:
+	for i, it := range t.library {
+		s := strings.ToLower(it.Info.Title + " " + it.Info.Artist + " " + it.Info.Album)
+		for _, w := range words {
+			if !strings.Contains(s, w) {
+				continue outer
+			}
+		}
+		t.results = append(t.results, i)
+	}
+	t.cursor[viewLibrary], t.offset[viewLibrary] = 0, 0
+}
+
+func (t *tui) setMessage(m string) {
+	t.message, t.messageAt = m, time.Now()
+}
+
+// items returns the items of the current view.
+func (t *tui) items() []client.Item {
+	if t.view == viewQueue {
+		return t.queue
+	}
+	items := make([]client.Item, len(t.results))
+	for i, r := range t.results {
+		items[i] = t.library[r]
+	}
+	return items
+}
+
+// selected returns the item under the cursor and its index in the view.
+func (t *tui) selected() (client.Item, int, bool) {
+	items := t.items()
+	i := t.cursor[t.view]
+	if i < 0 || i >= len(items) {
+		return client.Item{}, 0, false
+	}
+	return items[i], i, true
+}
+
+// do runs f, a request, in the background, showing its error.
+func (t *tui) do(f func() error) {
+	go func() {
+		if err := f(); err != nil {
+			t.updates <- func() { t.setMessage(err.Error()) }
+		}
+	}()
+}
+
+// key handles the key press k. It returns false to quit.
+func (t *tui) key(k string) bool {
+	if t.searching {
+		switch k {
+		case "\r", "\n":
+			t.searching = false
+		case "\x1b", "\x03":
+			t.searching = false
+			t.search("")
+		case "\x7f", "\b":
+			if r := []rune(t.query); len(r) > 0 {
+				t.search(string(r[:len(r)-1]))
+			}
+		default:
+			if k[0] >= ' ' && k[0] != 0x7f {
+				t.search(t.query + k)
+			}
+		}
+		return true
+	}
+	page := t.listHeight() / 2
+	if k != "?" {
+		t.showHelp = false
+	}
+	switch k {
+	case "q", "\x03":
+		return false
+	case "j", "\x1b[B":
+		t.move(1)
+	case "k", "\x1b[A":
+		t.move(-1)
+	case "\x04":
+		t.move(page)
+	case "\x15":
+		t.move(-page)
+	case "g", "\x1b[H":
+		t.move(-len(t.items()))
+	case "G", "\x1b[F":
+		t.move(len(t.items()))
+	case "\t", "1", "2":
+		t.view = 1 - t.view
+		if k != "\t" {
+			t.view = int(k[0] - '1')
+		}
+	case "/":
+		t.view = viewLibrary
+		t.searching = true
+	case "\r", "\n":
+		it, i, ok := t.selected()
+		if !ok {
+			break
+		}
+		if t.view == viewQueue {
+			t.do(func() error { return t.c.Cmd("play_idx", url.Values{"idx": {strconv.Itoa(i)}}) })
+		} else {
+			t.do(func() error { return t.c.PlayTrack(it.ID) })
+		}
+	case "a":
+		if it, _, ok := t.selected(); ok && t.view == viewLibrary {
+			t.do(func() error { return t.c.QueueChange([][]string{{"add", it.ID.UID}}) })
+			t.setMessage("Added " + songName(it.Info.Artist, it.Info.Title))
+			t.move(1)
+		}
+	case "x", "d":
+		if _, i, ok := t.selected(); ok && t.view == viewQueue {
+			t.do(func() error { return t.c.QueueChange([][]string{{"rem", strconv.Itoa(i)}}) })
+		}
+	case " ", "p":
+		t.do(func() error { return t.c.Cmd("pause", nil) })
+	case "s":
+		t.do(func() error { return t.c.Cmd("stop", nil) })
+	case ">", "n":
+		t.do(func() error { return t.c.Cmd("next", nil) })
+	case "<", "N":
+		t.do(func() error { return t.c.Cmd("prev", nil) })
+	case "h", "\x1b[D":
+		t.seek(-seekStep)
+	case "l", "\x1b[C":
+		t.seek(seekStep)
+	case "+", "=":
+		t.volume(volumeStep)
+	case "-", "_":
+		t.volume(-volumeStep)
+	case "r":
+		t.do(func() error { return t.c.Cmd("random", nil) })
+	case "R":
+		t.do(func() error { return t.c.Cmd("repeat", nil) })
+	case "u":
+		go t.loadLibrary()
+		t.setMessage("Reloading library")
+	case "?":
+		t.showHelp = !t.showHelp
+	}
+	return true
+}
+
+func (t *tui) move(n int) {
+	c := t.cursor[t.view] + n
+	if max := len(t.items()) - 1; c > max {
+		c = max
+	}
+	if c < 0 {
+		c = 0
+	}
+	t.cursor[t.view] = c
+}
+
+func (t *tui) seek(d time.Duration) {
+	if t.status.State == client.StateStop {
+		return
+	}
+	pos := t.elapsed() + d
+	if pos < 0 {
+		pos = 0
+	}
+	t.status.Elapsed, t.polled = pos, time.Now()
+	t.do(func() error { return t.c.Cmd("seek", url.Values{"pos": {pos.String()}}) })
+}
+
+func (t *tui) volume(d float64) {
+	v := t.status.Volume + d
+	if v < 0 {
+		v = 0
+	} else if v > 1 {
+		v = 1
+	}
+	t.status.Volume = v
+	t.do(func() error {
+		return t.c.Cmd("volume", url.Values{"v": {strconv.FormatFloat(v, 'f', 2, 64)}})
+	})
+}
+
+// elapsed returns the position in the song, counted since the last poll.
+func (t *tui) elapsed() time.Duration {
+	e := t.status.Elapsed
+	if t.status.State == client.StatePlay && !t.polled.IsZero() {
+		e += time.Since(t.polled)
+	}
+	if t.status.Time > 0 && e > t.status.Time {
+		e = t.status.Time
+	}
+	return e
+}
+
+// listHeight is the number of lines of the list: all but the tab bar and
+// the three lines of the player.
+func (t *tui) listHeight() int {
+	return t.height - 4
+}
+
+func songName(artist, title string) string {
+	if artist == "" {
+		return title
+	}
+	return artist + " – " + title
+}
+
+// fit truncates or pads s to width columns.
+func fit(s string, width int) string {
+	r := []rune(s)
+	if len(r) > width {
+		if width < 1 {
+			return ""
+		}
+		return string(r[:width-1]) + "…"
+	}
+	return s + strings.Repeat(" ", width-len(r))
+}
+
+func formatTime(d time.Duration) string {
+	s := int(d / time.Second)
+	return fmt.Sprintf("%d:%02d", s/60, s%60)
+}
+
+func (t *tui) draw() {
+	w := t.out
+	fmt.Fprint(w, "\x1b[H")
+	line := func(s string, reverse bool) {
+		if reverse {
+			fmt.Fprint(w, "\x1b[7m")
+		}
+		fmt.Fprint(w, fit(s, t.width), "\x1b[0m\r\n")
+	}
+
+	// Tab bar.
+	var bar strings.Builder
+	for i, name := range viewNames {
+		if i == t.view {
+			bar.WriteString("\x1b[7m")
+		}
+		fmt.Fprintf(&bar, " %d %s \x1b[0m ", i+1, name)
+	}
+	if t.query != "" || t.searching {
+		fmt.Fprintf(&bar, " /%s", t.query)
+		if t.searching {
+			bar.WriteString("▏")
+		}
+	}
+	fmt.Fprint(w, bar.String(), "\x1b[K\r\n")
+
+	// List.
+	items := t.items()
+	height := t.listHeight()
+	cur, off := t.cursor[t.view], t.offset[t.view]
+	if cur < off {
+		off = cur
+	} else if cur >= off+height {
+		off = cur - height + 1
+	}
+	t.offset[t.view] = off
+	helpLines := strings.Split(help, "\n")
+	for i := off; i < off+height; i++ {
+		if n := i - off; t.showHelp && n < len(helpLines) {
+			line(helpLines[n], true)
+			continue
+		}
+		if i >= len(items) {
+			line("", false)
+			continue
+		}
+		info := items[i].Info
+		if info == nil {
+			line("  "+items[i].ID.UID, i == cur)
+			continue
+		}
+		mark := "  "
+		if t.view == viewQueue && items[i].ID == t.status.Song && t.status.State != client.StateStop {
+			mark = "▶ "
+		}
+		album := ""
+		if info.Album != "" {
+			album = "  (" + info.Album + ")"
+		}
+		dur := formatTime(info.Time)
+		text := fit(mark+songName(info.Artist, info.Title)+album, t.width-len(dur)-1)
+		line(text+" "+dur, i == cur)
+	}
+
+	// Player.
+	s := t.status
+	now := "Stopped"
+	if s.State != client.StateStop {
+		now = songName(s.SongInfo.Artist, s.SongInfo.Title)
+		if s.State == client.StatePause {
+			now = "Paused: " + now
+		}
+	}
+	line(now, false)
+	elapsed := t.elapsed()
+	times := fmt.Sprintf(" %s / %s", formatTime(elapsed), formatTime(s.Time))
+	progress := t.width - len(times)
+	var p strings.Builder
+	if progress > 2 {
+		filled := 0
+		if s.Time > 0 {
+			filled = int(float64(progress-2) * float64(elapsed) / float64(s.Time))
+		}
+		p.WriteString("[" + strings.Repeat("=", filled) + strings.Repeat(" ", progress-2-filled) + "]")
+	}
+	p.WriteString(times)
+	line(p.String(), false)
+	flags := fmt.Sprintf("vol %d%%", int(s.Volume*100+0.5))
+	if s.Random {
+		flags += "  random"
+	}
+	if s.Repeat {
+		flags += "  repeat"
+	}
+	msg := "? help"
+	if t.message != "" && time.Since(t.messageAt) < messageTime {
+		msg = t.message
+	}
+	fmt.Fprint(w, fit(flags+"   "+msg, t.width-1), "\x1b[K")
+	w.Flush()
+}